@@ -6,3 +6,4 @@ ISUCON13_MYSQL_DIALCONFIG_DATABASE="isupipe"
 ISUCON13_MYSQL_DIALCONFIG_PARSETIME="true"
 ISUCON13_POWERDNS_SUBDOMAIN_ADDRESS="52.195.163.192"
 ISUCON13_POWERDNS_DISABLED="false"
+ISUCON13_ICON_REENCODE="false"
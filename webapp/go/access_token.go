@@ -0,0 +1,455 @@
+package main
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/labstack/echo-contrib/session"
+	"github.com/labstack/echo/v4"
+	"github.com/puzpuzpuz/xsync/v3"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// accessTokenPrefix はトークンの接頭辞 (漏洩したトークンを検出しやすくする)
+	accessTokenPrefix = "isupipe_pat_"
+	// accessTokenDisplayLength は一覧に出すトークンの先頭部分の長さ (接頭辞を含む)
+	accessTokenDisplayLength = len(accessTokenPrefix) + 6
+	maxAccessTokensPerUser   = 20
+	maxAccessTokenNameLength = 255
+
+	// accessTokenTouchInterval はlast_used_atを更新する間隔 (リクエストごとに書き込まないため)
+	accessTokenTouchInterval = time.Minute
+
+	accessTokenContextKey = "access_token"
+)
+
+// TokenScope はアクセストークンで使えるAPIの範囲
+type TokenScope string
+
+const (
+	// TokenScopeRead は配信やライブコメントなどの取得
+	TokenScopeRead TokenScope = "read"
+	// TokenScopeComment はライブコメント・リアクションの投稿と通報
+	TokenScopeComment TokenScope = "comment"
+	// TokenScopeModerate は通報の閲覧、NGワードの登録、ライブコメントの非表示、BAN
+	TokenScopeModerate TokenScope = "moderate"
+)
+
+func (s TokenScope) valid() bool {
+	return s == TokenScopeRead || s == TokenScopeComment || s == TokenScopeModerate
+}
+
+// tokenScopeRoutes はアクセストークンで使えるAPIと必要なスコープ ("METHOD パス" で引く)
+// ここにないAPI (トークンの管理、退会、運営向けなど) はCookieのセッションでしか使えない
+var tokenScopeRoutes = map[string]TokenScope{
+	"GET /api/tag":                                                           TokenScopeRead,
+	"GET /api/user/:username/theme":                                          TokenScopeRead,
+	"GET /api/livestream/search":                                             TokenScopeRead,
+	"GET /api/livestream":                                                    TokenScopeRead,
+	"GET /api/user/:username/livestream":                                     TokenScopeRead,
+	"GET /api/livestream/:livestream_id":                                     TokenScopeRead,
+	"GET /api/livestream/:livestream_id/livecomment":                         TokenScopeRead,
+	"GET /api/livestream/:livestream_id/reaction":                            TokenScopeRead,
+	"GET /api/livestream/:livestream_id/supporters":                          TokenScopeRead,
+	"GET /api/livestream/:livestream_id/statistics":                          TokenScopeRead,
+	"GET /api/user/me":                                                       TokenScopeRead,
+	"GET /api/user/:username":                                                TokenScopeRead,
+	"GET /api/user/:username/statistics":                                     TokenScopeRead,
+	"GET /api/user/:username/supporters":                                     TokenScopeRead,
+	"GET /api/user/:username/icon":                                           TokenScopeRead,
+	"POST /api/livestream/:livestream_id/livecomment":                        TokenScopeComment,
+	"POST /api/livestream/:livestream_id/reaction":                           TokenScopeComment,
+	"POST /api/livestream/:livestream_id/livecomment/:livecomment_id/report": TokenScopeComment,
+	"GET /api/livestream/:livestream_id/report":                              TokenScopeModerate,
+	"GET /api/livestream/:livestream_id/ngwords":                             TokenScopeModerate,
+	"POST /api/livestream/:livestream_id/moderate":                           TokenScopeModerate,
+	"DELETE /api/livestream/:livestream_id/livecomment/:livecomment_id":      TokenScopeModerate,
+	"GET /api/livestream/:livestream_id/ban":                                 TokenScopeModerate,
+	"POST /api/livestream/:livestream_id/ban":                                TokenScopeModerate,
+	"DELETE /api/livestream/:livestream_id/ban/:user_id":                     TokenScopeModerate,
+}
+
+// accessTokenLimiters はトークン (token_hash) ごとのレート制限
+// 共有のストアには数えず、インスタンスごとのメモリで数える。複数台で受ける場合、1分あたり最大でrate_limit×台数まで通る
+var accessTokenLimiters = xsync.NewMapOf[string, *accessTokenLimiter]()
+
+// accessTokenLimiterIdleTimeout より長く使われていないトークンのレートリミッタは破棄する
+// 1分間使われなければバケツは満杯に戻っているので、破棄して作り直しても制限は変わらない
+const accessTokenLimiterIdleTimeout = time.Minute
+
+type accessTokenLimiter struct {
+	limiter *rate.Limiter
+	// 最後に使われた時刻 (Unixナノ秒)
+	lastSeen atomic.Int64
+}
+
+// forgetAccessTokenLimiters は取り消したトークンのレートリミッタを破棄する
+func forgetAccessTokenLimiters(tokenHashes ...string) {
+	for _, tokenHash := range tokenHashes {
+		accessTokenLimiters.Delete(tokenHash)
+	}
+}
+
+// runAccessTokenLimiterSweeper はしばらく使われていないトークンのレートリミッタを破棄する
+func runAccessTokenLimiterSweeper(idle time.Duration) {
+	ticker := time.NewTicker(idle)
+	defer ticker.Stop()
+	for now := range ticker.C {
+		sweepAccessTokenLimiters(now, idle)
+	}
+}
+
+func sweepAccessTokenLimiters(now time.Time, idle time.Duration) {
+	accessTokenLimiters.Range(func(tokenHash string, l *accessTokenLimiter) bool {
+		if now.Sub(time.Unix(0, l.lastSeen.Load())) > idle {
+			accessTokenLimiters.Delete(tokenHash)
+		}
+		return true
+	})
+}
+
+type AccessTokenModel struct {
+	ID          int64  `db:"id"`
+	UserID      int64  `db:"user_id"`
+	Name        string `db:"name"`
+	TokenHash   string `db:"token_hash"`
+	TokenPrefix string `db:"token_prefix"`
+	// Scopes はカンマ区切り
+	Scopes     string        `db:"scopes"`
+	RateLimit  int64         `db:"rate_limit"`
+	CreatedAt  int64         `db:"created_at"`
+	ExpiresAt  sql.NullInt64 `db:"expires_at"`
+	LastUsedAt sql.NullInt64 `db:"last_used_at"`
+	RevokedAt  sql.NullInt64 `db:"revoked_at"`
+}
+
+func (t AccessTokenModel) hasScope(scope TokenScope) bool {
+	for _, s := range strings.Split(t.Scopes, ",") {
+		if TokenScope(s) == scope {
+			return true
+		}
+	}
+	return false
+}
+
+type AccessToken struct {
+	ID          int64        `json:"id"`
+	Name        string       `json:"name"`
+	TokenPrefix string       `json:"token_prefix"`
+	Scopes      []TokenScope `json:"scopes"`
+	// RateLimit は1分あたりのリクエスト数の上限
+	RateLimit  int64  `json:"rate_limit"`
+	CreatedAt  int64  `json:"created_at"`
+	ExpiresAt  *int64 `json:"expires_at"`
+	LastUsedAt *int64 `json:"last_used_at"`
+}
+
+type PostAccessTokenRequest struct {
+	Name   string       `json:"name"`
+	Scopes []TokenScope `json:"scopes"`
+	// ExpiresIn は有効期間の秒数 (0なら無期限)
+	ExpiresIn int64 `json:"expires_in"`
+	// RateLimit は1分あたりのリクエスト数の上限 (0なら設定の既定値)
+	RateLimit int64 `json:"rate_limit"`
+}
+
+// PostAccessTokenResponse のTokenは作成時にしか返さない
+type PostAccessTokenResponse struct {
+	AccessToken
+	Token string `json:"token"`
+}
+
+func newAccessToken(tokenModel AccessTokenModel) AccessToken {
+	t := AccessToken{
+		ID:          tokenModel.ID,
+		Name:        tokenModel.Name,
+		TokenPrefix: tokenModel.TokenPrefix,
+		Scopes:      []TokenScope{},
+		RateLimit:   tokenModel.RateLimit,
+		CreatedAt:   tokenModel.CreatedAt,
+	}
+	for _, s := range strings.Split(tokenModel.Scopes, ",") {
+		if s != "" {
+			t.Scopes = append(t.Scopes, TokenScope(s))
+		}
+	}
+	if tokenModel.ExpiresAt.Valid {
+		t.ExpiresAt = &tokenModel.ExpiresAt.Int64
+	}
+	if tokenModel.LastUsedAt.Valid {
+		t.LastUsedAt = &tokenModel.LastUsedAt.Int64
+	}
+	return t
+}
+
+// hashAccessToken はトークンを保存・照合するためのハッシュ
+// トークンは十分に長い乱数なので、パスワードと違いbcryptではなくSHA-256で引けるようにする
+func hashAccessToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func generateAccessToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return accessTokenPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// bearerToken はAuthorization: Bearerのトークンを返す
+func bearerToken(req *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(req.Header.Get(echo.HeaderAuthorization), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
+// verifyAccessToken はAuthorization: Bearerのアクセストークンを検証する
+// ハンドラはセッションからユーザIDを読むので、検証できたらこのリクエストのセッションにユーザを入れる (Cookieには保存しない)
+func verifyAccessToken(c echo.Context, token string) error {
+	// 1リクエストで何度呼ばれても、レート制限は1回だけ数える
+	if _, ok := c.Get(accessTokenContextKey).(AccessTokenModel); ok {
+		return nil
+	}
+	ctx := c.Request().Context()
+
+	var tokenModel AccessTokenModel
+	err := withReadOnlyTx(ctx, func(tx Tx) error {
+		var err error
+		tokenModel, err = tx.AccessTokens().GetByHash(ctx, hashAccessToken(token))
+		return err
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return echo.NewHTTPError(http.StatusUnauthorized, "invalid access token")
+	}
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get access token: "+err.Error())
+	}
+	now := time.Now()
+	if tokenModel.RevokedAt.Valid {
+		return echo.NewHTTPError(http.StatusUnauthorized, "the access token has been revoked")
+	}
+	if tokenModel.ExpiresAt.Valid && now.Unix() >= tokenModel.ExpiresAt.Int64 {
+		return echo.NewHTTPError(http.StatusUnauthorized, "the access token has expired")
+	}
+
+	scope, ok := tokenScopeRoutes[c.Request().Method+" "+c.Path()]
+	if !ok {
+		return echo.NewHTTPError(http.StatusForbidden, "this API can't be used with an access token")
+	}
+	if !tokenModel.hasScope(scope) {
+		return echo.NewHTTPError(http.StatusForbidden, "the access token doesn't have the '"+string(scope)+"' scope")
+	}
+
+	l, _ := accessTokenLimiters.LoadOrCompute(tokenModel.TokenHash, func() *accessTokenLimiter {
+		return &accessTokenLimiter{limiter: rate.NewLimiter(rate.Every(time.Minute/time.Duration(tokenModel.RateLimit)), int(tokenModel.RateLimit))}
+	})
+	l.lastSeen.Store(now.UnixNano())
+	if r := l.limiter.ReserveN(now, 1); r.DelayFrom(now) > 0 {
+		delay := r.DelayFrom(now)
+		r.CancelAt(now)
+		c.Response().Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(delay.Seconds()))))
+		return echo.NewHTTPError(http.StatusTooManyRequests, "too many requests with the access token")
+	}
+
+	userModel, err := verifySessionUser(ctx, tokenModel.UserID)
+	if err != nil {
+		return err
+	}
+
+	sess, err := session.Get(defaultSessionIDKey, c)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "failed to get session")
+	}
+	sess.Values[defaultUserIDKey] = userModel.ID
+	sess.Values[defaultUsernameKey] = userModel.Name
+	sess.Values[defaultSessionExpiresKey] = now.Add(time.Minute).Unix()
+	c.Set(accessTokenContextKey, tokenModel)
+
+	if !tokenModel.LastUsedAt.Valid || now.Unix()-tokenModel.LastUsedAt.Int64 >= int64(accessTokenTouchInterval.Seconds()) {
+		err := withTx(ctx, func(tx Tx) error {
+			return tx.AccessTokens().SetLastUsedAt(ctx, tokenModel.ID, now.Unix())
+		})
+		if err != nil {
+			requestLogger(c).Warn("failed to update last_used_at of access token", "token_id", tokenModel.ID, "error", err)
+		}
+	}
+	return nil
+}
+
+// 自分のアクセストークン一覧 (トークンそのものは返さない)
+// GET /api/user/me/tokens
+func getMyAccessTokensHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	a, err := currentActor(c)
+	if err != nil {
+		return err
+	}
+
+	var tokenModels []AccessTokenModel
+	err = withReadOnlyTx(ctx, func(tx Tx) error {
+		var err error
+		tokenModels, err = tx.AccessTokens().ListByUserID(ctx, a.User.ID)
+		return err
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get access tokens: "+err.Error())
+	}
+
+	tokens := make([]AccessToken, len(tokenModels))
+	for i := range tokenModels {
+		tokens[i] = newAccessToken(tokenModels[i])
+	}
+	return c.JSON(http.StatusOK, tokens)
+}
+
+// アクセストークンを作成する (トークンはこのレスポンスでしか返さない)
+// POST /api/user/me/tokens
+func postMyAccessTokenHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	defer c.Request().Body.Close()
+
+	a, err := currentActor(c)
+	if err != nil {
+		return err
+	}
+
+	var req PostAccessTokenRequest
+	if err := json.NewDecoder(c.Request().Body).Decode(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "failed to decode the request body as json")
+	}
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" || len(req.Name) > maxAccessTokenNameLength {
+		return echo.NewHTTPError(http.StatusBadRequest, "name must be 1 to "+strconv.Itoa(maxAccessTokenNameLength)+" bytes")
+	}
+	if len(req.Scopes) == 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "scopes must not be empty")
+	}
+	scopes := make([]string, 0, len(req.Scopes))
+	seen := make(map[TokenScope]struct{}, len(req.Scopes))
+	for _, scope := range req.Scopes {
+		if !scope.valid() {
+			return echo.NewHTTPError(http.StatusBadRequest, "scopes must be read, comment or moderate (got '"+string(scope)+"')")
+		}
+		if _, ok := seen[scope]; ok {
+			continue
+		}
+		seen[scope] = struct{}{}
+		scopes = append(scopes, string(scope))
+	}
+	if req.ExpiresIn < 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "expires_in must not be negative")
+	}
+	if req.RateLimit < 0 || req.RateLimit > int64(appConfig.Auth.TokenMaxRateLimit) {
+		return echo.NewHTTPError(http.StatusBadRequest, "rate_limit must be between 0 and "+strconv.Itoa(appConfig.Auth.TokenMaxRateLimit))
+	}
+	if req.RateLimit == 0 {
+		req.RateLimit = int64(appConfig.Auth.TokenRateLimit)
+	}
+
+	token, err := generateAccessToken()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to generate access token: "+err.Error())
+	}
+	now := time.Now().Unix()
+	tokenModel := AccessTokenModel{
+		UserID:      a.User.ID,
+		Name:        req.Name,
+		TokenHash:   hashAccessToken(token),
+		TokenPrefix: token[:accessTokenDisplayLength],
+		Scopes:      strings.Join(scopes, ","),
+		RateLimit:   req.RateLimit,
+		CreatedAt:   now,
+	}
+	if req.ExpiresIn > 0 {
+		tokenModel.ExpiresAt = sql.NullInt64{Int64: now + req.ExpiresIn, Valid: true}
+	}
+
+	tx, err := store.Begin(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to begin transaction: "+err.Error())
+	}
+	defer tx.Rollback()
+
+	// 同じユーザの並行した作成で上限を超えないよう、ユーザの行をロックしてから数える
+	if _, err := tx.Users().GetForUpdate(ctx, a.User.ID); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get user: "+err.Error())
+	}
+	tokenModels, err := tx.AccessTokens().ListByUserID(ctx, a.User.ID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get access tokens: "+err.Error())
+	}
+	if len(tokenModels) >= maxAccessTokensPerUser {
+		return echo.NewHTTPError(http.StatusBadRequest, "can't create more than "+strconv.Itoa(maxAccessTokensPerUser)+" access tokens (revoke unused ones)")
+	}
+
+	tokenModel.ID, err = tx.AccessTokens().Create(ctx, tokenModel)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to insert access token: "+err.Error())
+	}
+
+	if err := tx.Commit(); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to commit: "+err.Error())
+	}
+
+	return c.JSON(http.StatusCreated, PostAccessTokenResponse{
+		AccessToken: newAccessToken(tokenModel),
+		Token:       token,
+	})
+}
+
+// アクセストークンを取り消す
+// DELETE /api/user/me/tokens/:token_id
+func deleteMyAccessTokenHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	a, err := currentActor(c)
+	if err != nil {
+		return err
+	}
+	tokenID, err := strconv.ParseInt(c.Param("token_id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "token_id in path must be integer")
+	}
+
+	tx, err := store.Begin(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to begin transaction: "+err.Error())
+	}
+	defer tx.Rollback()
+
+	tokenModel, err := tx.AccessTokens().Get(ctx, tokenID)
+	if errors.Is(err, sql.ErrNoRows) || err == nil && (tokenModel.UserID != a.User.ID || tokenModel.RevokedAt.Valid) {
+		return echo.NewHTTPError(http.StatusNotFound, "not found access token")
+	}
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get access token: "+err.Error())
+	}
+	if err := tx.AccessTokens().Revoke(ctx, tokenID, time.Now().Unix()); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to revoke access token: "+err.Error())
+	}
+
+	if err := tx.Commit(); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to commit: "+err.Error())
+	}
+
+	forgetAccessTokenLimiters(tokenModel.TokenHash)
+
+	return c.NoContent(http.StatusNoContent)
+}
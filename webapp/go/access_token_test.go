@@ -0,0 +1,187 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/sessions"
+	"github.com/labstack/echo-contrib/session"
+	"github.com/labstack/echo/v4"
+)
+
+// setupAccessTokenTest はアクセストークンで使えるAPI・使えないAPIと、トークンを取り消すAPIを持つサーバを作る
+func setupAccessTokenTest(t *testing.T) *echo.Echo {
+	t.Helper()
+	origConfig, origStore := appConfig, store
+	t.Cleanup(func() { appConfig, store = origConfig, origStore })
+
+	appConfig = defaultConfig()
+	s, err := newMemoryStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	store = s
+
+	e := echo.New()
+	e.Use(session.Middleware(sessions.NewCookieStore([]byte("secret"))))
+	handler := func(c echo.Context) error {
+		if err := verifyUserSession(c); err != nil {
+			return err
+		}
+		return c.NoContent(http.StatusOK)
+	}
+	e.GET("/api/tag", handler)
+	e.POST("/api/livestream/:livestream_id/livecomment", handler)
+	e.DELETE("/api/user/me", handler)
+	// トークンの取り消しはCookieのセッションで行うので、ユーザ1でログインしていることにする
+	e.DELETE("/api/user/me/tokens/:token_id", deleteMyAccessTokenHandler, func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			c.Set(actorContextKey, &actor{User: UserModel{ID: 1}})
+			return next(c)
+		}
+	})
+	return e
+}
+
+// createTestAccessToken はユーザ1 (test001) のトークンを作って返す
+func createTestAccessToken(t *testing.T, scopes string, rateLimit int64, revoked bool) string {
+	t.Helper()
+	token, err := generateAccessToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokenModel := AccessTokenModel{
+		UserID:      1,
+		Name:        "test",
+		TokenHash:   hashAccessToken(token),
+		TokenPrefix: token[:accessTokenDisplayLength],
+		Scopes:      scopes,
+		RateLimit:   rateLimit,
+		CreatedAt:   time.Now().Unix(),
+	}
+	if revoked {
+		tokenModel.RevokedAt = sql.NullInt64{Int64: time.Now().Unix(), Valid: true}
+	}
+	ctx := context.Background()
+	err = withTx(ctx, func(tx Tx) error {
+		_, err := tx.AccessTokens().Create(ctx, tokenModel)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { forgetAccessTokenLimiters(tokenModel.TokenHash) })
+	return token
+}
+
+func serveWithAccessToken(e *echo.Echo, method, path, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestVerifyAccessTokenScopes(t *testing.T) {
+	e := setupAccessTokenTest(t)
+
+	tests := []struct {
+		name     string
+		scopes   string
+		revoked  bool
+		method   string
+		path     string
+		wantCode int
+		wantBody string
+	}{
+		{name: "read scope", scopes: "read", method: http.MethodGet, path: "/api/tag", wantCode: http.StatusOK},
+		{name: "missing comment scope", scopes: "read", method: http.MethodPost, path: "/api/livestream/1/livecomment", wantCode: http.StatusForbidden, wantBody: "doesn't have the 'comment' scope"},
+		{name: "comment scope", scopes: "read,comment", method: http.MethodPost, path: "/api/livestream/1/livecomment", wantCode: http.StatusOK},
+		{name: "moderate scope does not include read", scopes: "moderate", method: http.MethodGet, path: "/api/tag", wantCode: http.StatusForbidden, wantBody: "doesn't have the 'read' scope"},
+		{name: "api not allowed for tokens", scopes: "read,comment,moderate", method: http.MethodDelete, path: "/api/user/me", wantCode: http.StatusForbidden, wantBody: "can't be used with an access token"},
+		{name: "revoked token", scopes: "read", revoked: true, method: http.MethodGet, path: "/api/tag", wantCode: http.StatusUnauthorized, wantBody: "has been revoked"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := createTestAccessToken(t, tt.scopes, 100, tt.revoked)
+			rec := serveWithAccessToken(e, tt.method, tt.path, token)
+			if rec.Code != tt.wantCode || !strings.Contains(rec.Body.String(), tt.wantBody) {
+				t.Errorf("%s %s = %d %s, want %d %q", tt.method, tt.path, rec.Code, rec.Body.String(), tt.wantCode, tt.wantBody)
+			}
+		})
+	}
+
+	if rec := serveWithAccessToken(e, http.MethodGet, "/api/tag", accessTokenPrefix+"unknown"); rec.Code != http.StatusUnauthorized {
+		t.Errorf("unknown token = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestVerifyAccessTokenRateLimit(t *testing.T) {
+	e := setupAccessTokenTest(t)
+	// 1分あたり2回なので、使い切ると次は30秒後
+	token := createTestAccessToken(t, "read", 2, false)
+
+	for i := 0; i < 2; i++ {
+		if rec := serveWithAccessToken(e, http.MethodGet, "/api/tag", token); rec.Code != http.StatusOK {
+			t.Fatalf("request %d = %d %s, want %d", i+1, rec.Code, rec.Body.String(), http.StatusOK)
+		}
+	}
+	rec := serveWithAccessToken(e, http.MethodGet, "/api/tag", token)
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("request over the limit = %d %s, want %d", rec.Code, rec.Body.String(), http.StatusTooManyRequests)
+	}
+	if got := rec.Header().Get("Retry-After"); got != "30" {
+		t.Errorf("Retry-After = %q, want %q", got, "30")
+	}
+
+	// 制限は他のトークンには影響しない
+	other := createTestAccessToken(t, "read", 2, false)
+	if rec := serveWithAccessToken(e, http.MethodGet, "/api/tag", other); rec.Code != http.StatusOK {
+		t.Errorf("other token = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	// 取り消したトークンのレートリミッタは破棄する
+	ctx := context.Background()
+	var tokenModel AccessTokenModel
+	err := withReadOnlyTx(ctx, func(tx Tx) error {
+		var err error
+		tokenModel, err = tx.AccessTokens().GetByHash(ctx, hashAccessToken(token))
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := httptest.NewRequest(http.MethodDelete, "/api/user/me/tokens/"+strconv.FormatInt(tokenModel.ID, 10), nil)
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("revoke = %d %s, want %d", rec.Code, rec.Body.String(), http.StatusNoContent)
+	}
+	if _, ok := accessTokenLimiters.Load(tokenModel.TokenHash); ok {
+		t.Error("limiter of the revoked token must be dropped")
+	}
+}
+
+func TestSweepAccessTokenLimiters(t *testing.T) {
+	now := time.Now()
+	idle, active := &accessTokenLimiter{}, &accessTokenLimiter{}
+	idle.lastSeen.Store(now.Add(-2 * accessTokenLimiterIdleTimeout).UnixNano())
+	active.lastSeen.Store(now.Add(-accessTokenLimiterIdleTimeout / 2).UnixNano())
+	accessTokenLimiters.Store("idle", idle)
+	accessTokenLimiters.Store("active", active)
+	t.Cleanup(func() { forgetAccessTokenLimiters("idle", "active") })
+
+	sweepAccessTokenLimiters(now, accessTokenLimiterIdleTimeout)
+	if _, ok := accessTokenLimiters.Load("idle"); ok {
+		t.Error("idle limiter must be evicted")
+	}
+	if _, ok := accessTokenLimiters.Load("active"); !ok {
+		t.Error("active limiter must be kept")
+	}
+}
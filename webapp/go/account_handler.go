@@ -0,0 +1,215 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/sessions"
+	"github.com/labstack/echo-contrib/session"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// 退会したユーザのライブコメント・リアクションの扱い
+	// anonymize: 退会済みユーザの投稿として残す
+	// delete: 削除する (チップ付きのライブコメントは会計のため本文だけ消して残す)
+	accountDeletionPolicyAnonymize = "anonymize"
+	accountDeletionPolicyDelete    = "delete"
+
+	accountDeletionPolicyEnvKey = "ISUCON13_ACCOUNT_DELETION_POLICY"
+
+	deletedUserDisplayName = "退会済みユーザ"
+)
+
+type DeleteAccountResponse struct {
+	Policy string `json:"policy"`
+	// 取り消した配信予約のID
+	CancelledLivestreamIDs []int64 `json:"cancelled_livestream_ids"`
+}
+
+// deletedUserName は退会したユーザの名前
+// ユーザ名として登録できない文字 (_) を含めて、元の名前や他のユーザと衝突しないようにする
+func deletedUserName(userID int64) string {
+	return "_deleted_" + strconv.FormatInt(userID, 10)
+}
+
+// 退会
+// ライブコメントなどから参照されているため、usersの行は退会済みとして残し個人情報を消す
+// DELETE /api/user/me
+func deleteMeHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	if err := verifyUserSession(c); err != nil {
+		// echo.NewHTTPErrorが返っているのでそのまま出力
+		return err
+	}
+
+	// error already checked
+	sess, _ := session.Get(defaultSessionIDKey, c)
+	// existence already checked
+	userID := sess.Values[defaultUserIDKey].(int64)
+
+	tx, err := store.Begin(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to begin transaction: "+err.Error())
+	}
+	defer tx.Rollback()
+
+	userModel, err := tx.Users().GetForUpdate(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return echo.NewHTTPError(http.StatusNotFound, "not found user that has the userid in session")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get user: "+err.Error())
+	}
+	if userModel.DeletedAt.Valid {
+		return echo.NewHTTPError(http.StatusNotFound, "the user has already been deleted")
+	}
+
+	now := time.Now()
+	cancelled, err := cancelFutureReservations(ctx, tx, userID, now.Unix())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to cancel reservations: "+err.Error())
+	}
+
+	if appConfig.Account.DeletionPolicy == accountDeletionPolicyDelete {
+		if err := deleteUserPosts(ctx, tx, userID); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete posts: "+err.Error())
+		}
+	}
+
+	imageHash, err := tx.Icons().GetHashByUserID(ctx, userID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get icon: "+err.Error())
+	}
+	if err := tx.Icons().DeleteByUserID(ctx, userID); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete icon: "+err.Error())
+	}
+	if err := tx.Themes().DeleteByUserID(ctx, userID); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete theme: "+err.Error())
+	}
+	if err := tx.Viewers().DeleteHistoryByUserID(ctx, userID); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete viewers history: "+err.Error())
+	}
+	if err := tx.Moderation().DeleteByUserID(ctx, userID); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete moderators: "+err.Error())
+	}
+	tokenModels, err := tx.AccessTokens().ListByUserID(ctx, userID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get access tokens: "+err.Error())
+	}
+	if err := tx.AccessTokens().RevokeByUserID(ctx, userID, now.Unix()); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to revoke access tokens: "+err.Error())
+	}
+
+	// パスワードを空にするのでログインもできなくなる
+	if err := tx.Users().Deactivate(ctx, userID, deletedUserName(userID), deletedUserDisplayName, now.Unix()); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to deactivate user: "+err.Error())
+	}
+
+	outboxID, err := enqueueDNSOutbox(ctx, tx, dnsOutboxActionDelete, userModel.Name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to enqueue dns record: "+err.Error())
+	}
+
+	if err := tx.Commit(); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to commit: "+err.Error())
+	}
+
+	for _, tokenModel := range tokenModels {
+		forgetAccessTokenLimiters(tokenModel.TokenHash)
+	}
+
+	key := strconv.FormatInt(userID, 10)
+	publishCacheInvalidation(c, cacheTopicUser, key)
+	publishCacheInvalidation(c, cacheTopicTheme, key)
+	publishCacheInvalidation(c, cacheTopicIcon, key)
+	for _, livestreamID := range cancelled {
+		publishCacheInvalidation(c, cacheTopicNGWord, strconv.FormatInt(livestreamID, 10))
+	}
+
+	// 画像の削除に失敗しても退会は完了しているので、ログに残すだけにする
+	if imageHash != "" {
+		released, err := releaseIcon(ctx, imageHash)
+		if err != nil {
+			requestLogger(c).Warn("failed to delete icon image", "icon_hash", imageHash, "error", err)
+		}
+		if released {
+			publishCacheInvalidation(c, cacheTopicIconImage, imageHash)
+		}
+	}
+
+	if err := deliverDNSOutbox(ctx, outboxID); err != nil {
+		requestLogger(c).Warn("failed to delete dns record (will retry)", "name", userModel.Name, "error", err)
+	}
+
+	// このリクエストのセッションは破棄する (他のセッションはverifyUserSessionで拒否される)
+	sess.Options = &sessions.Options{
+		Domain: appConfig.Session.CookieDomain,
+		MaxAge: -1,
+		Path:   "/",
+	}
+	if err := sess.Save(c.Request(), c.Response()); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to save session: "+err.Error())
+	}
+
+	return c.JSON(http.StatusOK, DeleteAccountResponse{
+		Policy:                 appConfig.Account.DeletionPolicy,
+		CancelledLivestreamIDs: cancelled,
+	})
+}
+
+// cancelFutureReservations はまだ始まっていない配信予約を取り消し、予約枠を戻す
+func cancelFutureReservations(ctx context.Context, tx Tx, userID int64, now int64) ([]int64, error) {
+	livestreams, err := tx.Livestreams().ListStartingAfterForUpdate(ctx, userID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	cancelled := make([]int64, len(livestreams))
+	for i, livestream := range livestreams {
+		if err := tx.Reservations().Release(ctx, livestream.StartAt, livestream.EndAt); err != nil {
+			return nil, err
+		}
+		if err := tx.Livestreams().Delete(ctx, livestream.ID); err != nil {
+			return nil, err
+		}
+		cancelled[i] = livestream.ID
+	}
+	return cancelled, nil
+}
+
+// deleteUserPosts は退会したユーザのリアクションとライブコメントを削除する
+// チップ付きのライブコメントは売上の集計に使うので、本文だけ消して残す
+func deleteUserPosts(ctx context.Context, tx Tx, userID int64) error {
+	if err := tx.Reactions().DeleteByUserID(ctx, userID); err != nil {
+		return err
+	}
+	if err := tx.Livecomments().DeleteByUserID(ctx, userID); err != nil {
+		return err
+	}
+	return tx.Reports().DeleteByUserID(ctx, userID)
+}
+
+// getSessionUser はセッションのユーザを返す (退会・利用停止したユーザのセッションを拒否するため)
+// 存在しない場合はsql.ErrNoRowsを返す
+func getSessionUser(ctx context.Context, userID int64) (UserModel, error) {
+	userModel, ok := userCache.Load(userID)
+	if ok {
+		return userModel, nil
+	}
+	err := withReadOnlyTx(ctx, func(tx Tx) error {
+		var err error
+		userModel, err = tx.Users().Get(ctx, userID)
+		return err
+	})
+	if err != nil {
+		return UserModel{}, err
+	}
+	userCache.Store(userID, userModel)
+	return userModel, nil
+}
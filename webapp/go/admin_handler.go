@@ -0,0 +1,567 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	defaultAdminUsersLimit = 100
+	maxAdminUsersLimit     = 1000
+
+	staffPasswordEnvKey = "ISUCON13_STAFF_PASSWORD"
+)
+
+// AdminUser は運営向けのユーザ情報
+type AdminUser struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name"`
+	Role        Role   `json:"role"`
+	SuspendedAt *int64 `json:"suspended_at"`
+	DeletedAt   *int64 `json:"deleted_at"`
+}
+
+type PutUserRoleRequest struct {
+	Role Role `json:"role"`
+}
+
+type PostTagRequest struct {
+	Name string `json:"name"`
+}
+
+type PostReservationCapacityRequest struct {
+	StartAt int64 `json:"start_at"`
+	EndAt   int64 `json:"end_at"`
+	// Delta は各枠の残り数に足す数 (負なら減らす)
+	Delta int64 `json:"delta"`
+}
+
+func newAdminUser(userModel UserModel) AdminUser {
+	u := AdminUser{
+		ID:          userModel.ID,
+		Name:        userModel.Name,
+		DisplayName: userModel.DisplayName,
+		Role:        userModel.Role,
+	}
+	if u.Role == "" {
+		u.Role = RoleViewer
+	}
+	if userModel.SuspendedAt.Valid {
+		u.SuspendedAt = &userModel.SuspendedAt.Int64
+	}
+	if userModel.DeletedAt.Valid {
+		u.DeletedAt = &userModel.DeletedAt.Int64
+	}
+	return u
+}
+
+// registerAdminRoutes は運営向けのAPIを登録する
+// モデレータは通報の閲覧とライブコメントの削除、管理者はすべての操作ができる
+func registerAdminRoutes(e *echo.Echo) {
+	admin := e.Group("/api/admin", requireRole(RoleModerator))
+	admin.GET("/users", adminGetUsersHandler, requireRole(RoleAdmin))
+	admin.PUT("/users/:user_id/role", adminPutUserRoleHandler, requireRole(RoleAdmin))
+	admin.POST("/users/:user_id/suspend", adminSuspendUserHandler, requireRole(RoleAdmin))
+	admin.DELETE("/users/:user_id/suspend", adminUnsuspendUserHandler, requireRole(RoleAdmin))
+	admin.GET("/livestreams/:livestream_id/report", getLivecommentReportsHandler)
+	admin.DELETE("/livecomments/:livecomment_id", adminDeleteLivecommentHandler)
+	admin.POST("/tags", adminPostTagHandler, requireRole(RoleAdmin))
+	admin.DELETE("/tags/:tag_id", adminDeleteTagHandler, requireRole(RoleAdmin))
+	admin.GET("/reservation_slots", adminGetReservationSlotsHandler, requireRole(RoleAdmin))
+	admin.POST("/reservation_slots/capacity", adminPostReservationCapacityHandler, requireRole(RoleAdmin))
+}
+
+// ユーザ一覧 (idの昇順、after_idより後のユーザ)
+// GET /api/admin/users
+func adminGetUsersHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	var afterID int64
+	if v := c.QueryParam("after_id"); v != "" {
+		id, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "after_id query parameter must be integer")
+		}
+		afterID = id
+	}
+	limit := defaultAdminUsersLimit
+	if v := c.QueryParam("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "limit query parameter must be integer")
+		}
+		if n < 0 || n > maxAdminUsersLimit {
+			return echo.NewHTTPError(http.StatusBadRequest, "limit query parameter must be between 0 and "+strconv.Itoa(maxAdminUsersLimit))
+		}
+		limit = n
+	}
+
+	tx, err := store.BeginReadOnly(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to begin transaction: "+err.Error())
+	}
+	defer tx.Rollback()
+
+	userModels, err := tx.Users().ListPage(ctx, afterID, limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get users: "+err.Error())
+	}
+
+	if err := tx.Commit(); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to commit: "+err.Error())
+	}
+
+	users := make([]AdminUser, len(userModels))
+	for i := range userModels {
+		users[i] = newAdminUser(userModels[i])
+	}
+	return c.JSON(http.StatusOK, users)
+}
+
+// ユーザの権限を変更する (viewer, moderator, admin)
+// PUT /api/admin/users/:user_id/role
+func adminPutUserRoleHandler(c echo.Context) error {
+	defer c.Request().Body.Close()
+
+	var req PutUserRoleRequest
+	if err := json.NewDecoder(c.Request().Body).Decode(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "failed to decode the request body as json")
+	}
+	if !req.Role.Stored() {
+		return echo.NewHTTPError(http.StatusBadRequest, "role must be viewer, moderator or admin (streamer is given to the owner of each livestream)")
+	}
+
+	return updateUserByAdmin(c, func(tx Tx, a *actor, userModel UserModel) error {
+		if userModel.ID == a.User.ID && req.Role != RoleAdmin {
+			return echo.NewHTTPError(http.StatusBadRequest, "can't remove your own admin role")
+		}
+		if err := tx.Users().SetRole(c.Request().Context(), userModel.ID, req.Role); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to update role: "+err.Error())
+		}
+		return nil
+	})
+}
+
+// ユーザを利用停止にする (ログインできず、セッションも使えなくなる)
+// POST /api/admin/users/:user_id/suspend
+func adminSuspendUserHandler(c echo.Context) error {
+	return updateUserByAdmin(c, func(tx Tx, a *actor, userModel UserModel) error {
+		if userModel.ID == a.User.ID {
+			return echo.NewHTTPError(http.StatusBadRequest, "can't suspend yourself")
+		}
+		if userModel.SuspendedAt.Valid {
+			return nil
+		}
+		suspendedAt := sql.NullInt64{Int64: time.Now().Unix(), Valid: true}
+		if err := tx.Users().SetSuspendedAt(c.Request().Context(), userModel.ID, suspendedAt); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to suspend user: "+err.Error())
+		}
+		return nil
+	})
+}
+
+// ユーザの利用停止を解除する
+// DELETE /api/admin/users/:user_id/suspend
+func adminUnsuspendUserHandler(c echo.Context) error {
+	return updateUserByAdmin(c, func(tx Tx, a *actor, userModel UserModel) error {
+		if err := tx.Users().SetSuspendedAt(c.Request().Context(), userModel.ID, sql.NullInt64{}); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to unsuspend user: "+err.Error())
+		}
+		return nil
+	})
+}
+
+// updateUserByAdmin は:user_idのユーザをロックしてupdateを実行し、更新後のユーザを返す
+func updateUserByAdmin(c echo.Context, update func(tx Tx, a *actor, userModel UserModel) error) error {
+	ctx := c.Request().Context()
+
+	a, err := currentActor(c)
+	if err != nil {
+		return err
+	}
+	userID, err := strconv.ParseInt(c.Param("user_id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "user_id in path must be integer")
+	}
+
+	tx, err := store.Begin(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to begin transaction: "+err.Error())
+	}
+	defer tx.Rollback()
+
+	userModel, err := tx.Users().GetForUpdate(ctx, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return echo.NewHTTPError(http.StatusNotFound, "not found user")
+	}
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get user: "+err.Error())
+	}
+	if userModel.DeletedAt.Valid {
+		return echo.NewHTTPError(http.StatusNotFound, "the user has been deleted")
+	}
+
+	if err := update(tx, a, userModel); err != nil {
+		return err
+	}
+
+	userModel, err = tx.Users().Get(ctx, userID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get user: "+err.Error())
+	}
+
+	if err := tx.Commit(); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to commit: "+err.Error())
+	}
+
+	// 権限と利用停止はセッションの検証で見るので、すぐに反映する
+	publishCacheInvalidation(c, cacheTopicUser, strconv.FormatInt(userID, 10))
+
+	return c.JSON(http.StatusOK, newAdminUser(userModel))
+}
+
+// どの配信のライブコメントでも削除する
+// DELETE /api/admin/livecomments/:livecomment_id
+func adminDeleteLivecommentHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	a, err := currentActor(c)
+	if err != nil {
+		return err
+	}
+	livecommentID, err := strconv.ParseInt(c.Param("livecomment_id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "livecomment_id in path must be integer")
+	}
+
+	tx, err := store.Begin(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to begin transaction: "+err.Error())
+	}
+	defer tx.Rollback()
+
+	livecommentModel, err := tx.Livecomments().Get(ctx, livecommentID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return echo.NewHTTPError(http.StatusNotFound, "not found livecomment")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get livecomment: "+err.Error())
+	}
+	livestreamModel, err := tx.Livestreams().Get(ctx, livecommentModel.LivestreamID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get livestream: "+err.Error())
+	}
+	if err := tx.Livecomments().Delete(ctx, livecommentID); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete livecomment: "+err.Error())
+	}
+	// 配信者のチャンネルの記録にも残す
+	if err := recordModeration(ctx, tx, ModerationLogModel{
+		StreamerID:   livestreamModel.UserID,
+		LivestreamID: sql.NullInt64{Int64: livestreamModel.ID, Valid: true},
+		ModeratorID:  a.User.ID,
+		Action:       moderationActionHideLivecomment,
+		TargetID:     livecommentID,
+		Detail:       livecommentModel.Comment,
+	}); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to insert moderation log: "+err.Error())
+	}
+
+	if err := tx.Commit(); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to commit: "+err.Error())
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// タグを追加する
+// POST /api/admin/tags
+func adminPostTagHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	defer c.Request().Body.Close()
+
+	var req PostTagRequest
+	if err := json.NewDecoder(c.Request().Body).Decode(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "failed to decode the request body as json")
+	}
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "name must not be empty")
+	}
+
+	tx, err := store.Begin(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to begin transaction: "+err.Error())
+	}
+	defer tx.Rollback()
+
+	tagID, err := tx.Tags().Create(ctx, req.Name)
+	if isDuplicateEntry(err) {
+		return echo.NewHTTPError(http.StatusConflict, "the tag already exists")
+	}
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to insert tag: "+err.Error())
+	}
+
+	if err := tx.Commit(); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to commit: "+err.Error())
+	}
+
+	return c.JSON(http.StatusCreated, Tag{ID: tagID, Name: req.Name})
+}
+
+// タグを削除する (配信に付いたタグも外す)
+// DELETE /api/admin/tags/:tag_id
+func adminDeleteTagHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	tagID, err := strconv.ParseInt(c.Param("tag_id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "tag_id in path must be integer")
+	}
+
+	tx, err := store.Begin(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to begin transaction: "+err.Error())
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Tags().Get(ctx, tagID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return echo.NewHTTPError(http.StatusNotFound, "not found tag")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get tag: "+err.Error())
+	}
+	if err := tx.Tags().Delete(ctx, tagID); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete tag: "+err.Error())
+	}
+
+	if err := tx.Commit(); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to commit: "+err.Error())
+	}
+
+	publishCacheInvalidation(c, cacheTopicTag, strconv.FormatInt(tagID, 10))
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// 期間内の予約枠と残り数
+// GET /api/admin/reservation_slots?start_at=&end_at=
+func adminGetReservationSlotsHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	startAt, endAt, err := parseReservationTermQuery(c)
+	if err != nil {
+		return err
+	}
+
+	tx, err := store.BeginReadOnly(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to begin transaction: "+err.Error())
+	}
+	defer tx.Rollback()
+
+	slots, err := tx.Reservations().List(ctx, startAt, endAt)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get reservation slots: "+err.Error())
+	}
+
+	if err := tx.Commit(); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to commit: "+err.Error())
+	}
+
+	if slots == nil {
+		slots = []ReservationSlotModel{}
+	}
+	return c.JSON(http.StatusOK, slots)
+}
+
+// 期間内の予約枠の残り数を増減する
+// POST /api/admin/reservation_slots/capacity
+func adminPostReservationCapacityHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	defer c.Request().Body.Close()
+
+	var req PostReservationCapacityRequest
+	if err := json.NewDecoder(c.Request().Body).Decode(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "failed to decode the request body as json")
+	}
+	if req.StartAt >= req.EndAt {
+		return echo.NewHTTPError(http.StatusBadRequest, "start_at must be before end_at")
+	}
+
+	tx, err := store.Begin(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to begin transaction: "+err.Error())
+	}
+	defer tx.Rollback()
+
+	// 予約と同じ順でロックする
+	if _, err := tx.Reservations().ListForUpdate(ctx, req.StartAt, req.EndAt); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get reservation slots: "+err.Error())
+	}
+	if err := tx.Reservations().AddCapacity(ctx, req.StartAt, req.EndAt, req.Delta); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to update reservation slots: "+err.Error())
+	}
+	slots, err := tx.Reservations().List(ctx, req.StartAt, req.EndAt)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get reservation slots: "+err.Error())
+	}
+
+	if err := tx.Commit(); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to commit: "+err.Error())
+	}
+
+	if slots == nil {
+		slots = []ReservationSlotModel{}
+	}
+	return c.JSON(http.StatusOK, slots)
+}
+
+func parseReservationTermQuery(c echo.Context) (int64, int64, error) {
+	startAt, err := strconv.ParseInt(c.QueryParam("start_at"), 10, 64)
+	if err != nil {
+		return 0, 0, echo.NewHTTPError(http.StatusBadRequest, "start_at query parameter must be integer")
+	}
+	endAt, err := strconv.ParseInt(c.QueryParam("end_at"), 10, 64)
+	if err != nil {
+		return 0, 0, echo.NewHTTPError(http.StatusBadRequest, "end_at query parameter must be integer")
+	}
+	if startAt >= endAt {
+		return 0, 0, echo.NewHTTPError(http.StatusBadRequest, "start_at must be before end_at")
+	}
+	return startAt, endAt, nil
+}
+
+// StaffGrantModel はisupipe staffで与えた権限
+type StaffGrantModel struct {
+	ID   int64  `db:"id"`
+	Name string `db:"name"`
+	Role Role   `db:"role"`
+	// staffで作ったユーザのパスワード (既存のユーザに与えた場合はNULL)
+	HashedPassword sql.NullString `db:"hashed_password"`
+	CreatedAt      int64          `db:"created_at"`
+}
+
+// runStaff は運営のユーザに権限を与えるサブコマンド
+//
+//	isupipe staff USERNAME ROLE
+//
+// ユーザが存在しなければaccount.staff_password (ISUCON13_STAFF_PASSWORD) のパスワードで作る
+// 予約されたユーザ名 (pipeなど) も使えるので、運営のアカウントはこれで作る
+// 与えた権限はstaff_grantsに記録し、/api/initializeの後にapplyStaffGrantsで与え直す (viewerを指定すると記録を消す)
+func runStaff(ctx context.Context, args []string) error {
+	if len(args) != 2 {
+		return errors.New("usage: staff USERNAME ROLE")
+	}
+	if _, ok := store.(*mysqlStore); !ok {
+		return errors.New("staff is only available with the mysql store")
+	}
+	name, role := args[0], Role(args[1])
+	if !role.Stored() {
+		return fmt.Errorf("role must be viewer, moderator or admin (got '%s')", role)
+	}
+
+	var userID int64
+	err := withTx(ctx, func(tx Tx) error {
+		grant := StaffGrantModel{Name: name, Role: role, CreatedAt: time.Now().Unix()}
+		userModel, err := tx.Users().GetByName(ctx, name)
+		if errors.Is(err, sql.ErrNoRows) {
+			password := appConfig.Account.StaffPassword
+			if password == "" {
+				return fmt.Errorf("user '%s' does not exist (set %s to create it)", name, staffPasswordEnvKey)
+			}
+			hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), appConfig.Auth.BcryptCost)
+			if err != nil {
+				return err
+			}
+			grant.HashedPassword = sql.NullString{String: string(hashedPassword), Valid: true}
+			if userModel, err = createStaffUser(ctx, tx, name, grant.HashedPassword.String); err != nil {
+				return err
+			}
+			fmt.Printf("created user '%s' (id %d)\n", name, userModel.ID)
+		} else if err != nil {
+			return err
+		}
+		if userModel.DeletedAt.Valid {
+			return fmt.Errorf("user '%s' has been deleted", name)
+		}
+		userID = userModel.ID
+		if err := tx.Users().SetRole(ctx, userModel.ID, role); err != nil {
+			return err
+		}
+		if role == RoleViewer {
+			return tx.StaffGrants().DeleteByName(ctx, name)
+		}
+		return tx.StaffGrants().Upsert(ctx, grant)
+	})
+	if err != nil {
+		return err
+	}
+
+	// 起動中のサーバのキャッシュを破棄する (cache_bus.peersがなければ再起動か/api/initializeまで反映されない)
+	if err := setupCacheBus(echo.New(), appConfig.CacheBus); err != nil {
+		return err
+	}
+	if err := cacheBus.Publish(ctx, CacheInvalidation{Topic: cacheTopicUser, Key: strconv.FormatInt(userID, 10)}); err != nil {
+		slog.Warn("failed to publish cache invalidation", "error", err)
+	}
+	fmt.Printf("granted %s to '%s'\n", role, name)
+	return nil
+}
+
+// createStaffUser は運営のユーザを作る (予約されたユーザ名も使える)
+func createStaffUser(ctx context.Context, tx Tx, name string, hashedPassword string) (UserModel, error) {
+	userModel := UserModel{
+		Name:           name,
+		DisplayName:    name,
+		HashedPassword: hashedPassword,
+	}
+	var err error
+	if userModel.ID, err = tx.Users().Create(ctx, userModel); err != nil {
+		return UserModel{}, err
+	}
+	if _, err := tx.Themes().Create(ctx, ThemeModel{UserID: userModel.ID}); err != nil {
+		return UserModel{}, err
+	}
+	return userModel, nil
+}
+
+// applyStaffGrants はstaff_grantsに記録した権限を与え直す (/api/initializeでusersを空にした後)
+// staffで作ったユーザは記録したパスワードで作り直す。初期データのユーザが退会済みなどの場合は飛ばす
+func applyStaffGrants(ctx context.Context) error {
+	return withTx(ctx, func(tx Tx) error {
+		grants, err := tx.StaffGrants().List(ctx)
+		if err != nil {
+			return err
+		}
+		for _, grant := range grants {
+			userModel, err := tx.Users().GetByName(ctx, grant.Name)
+			if errors.Is(err, sql.ErrNoRows) && grant.HashedPassword.Valid {
+				userModel, err = createStaffUser(ctx, tx, grant.Name, grant.HashedPassword.String)
+			}
+			if errors.Is(err, sql.ErrNoRows) || (err == nil && userModel.DeletedAt.Valid) {
+				slog.WarnContext(ctx, "skip staff grant for missing user", "name", grant.Name, "role", grant.Role)
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if err := tx.Users().SetRole(ctx, userModel.ID, grant.Role); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+)
+
+func TestApplyStaffGrantsAfterReset(t *testing.T) {
+	origConfig, origStore := appConfig, store
+	t.Cleanup(func() { appConfig, store = origConfig, origStore })
+
+	appConfig = defaultConfig()
+	s, err := newMemoryStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	store = s
+
+	ctx := context.Background()
+	// 初期データのユーザに与えた権限と、staffで作ったユーザ (pipe) の権限
+	err = withTx(ctx, func(tx Tx) error {
+		if err := tx.StaffGrants().Upsert(ctx, StaffGrantModel{Name: "test001", Role: RoleModerator}); err != nil {
+			return err
+		}
+		return tx.StaffGrants().Upsert(ctx, StaffGrantModel{
+			Name:           "pipe",
+			Role:           RoleAdmin,
+			HashedPassword: sql.NullString{String: "hashed", Valid: true},
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.Reset(ctx, &initializeTimer{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := applyStaffGrants(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	err = withReadOnlyTx(ctx, func(tx Tx) error {
+		moderator, err := tx.Users().GetByName(ctx, "test001")
+		if err != nil {
+			return err
+		}
+		if moderator.Role != RoleModerator {
+			t.Errorf("test001 role = %s, want %s", moderator.Role, RoleModerator)
+		}
+		admin, err := tx.Users().GetByName(ctx, "pipe")
+		if err != nil {
+			return err
+		}
+		if admin.Role != RoleAdmin || admin.HashedPassword != "hashed" {
+			t.Errorf("pipe must be recreated as admin with the recorded password: %+v", admin)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
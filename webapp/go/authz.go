@@ -0,0 +1,167 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo-contrib/session"
+	"github.com/labstack/echo/v4"
+)
+
+// Role はユーザの権限 (viewer < channel_moderator < streamer < moderator < admin)
+// viewer, moderator, adminはusers.roleに保存し、streamerは配信ごとに配信者本人に、
+// channel_moderatorは配信者に任命されたユーザにその配信者の配信ごとに与える
+type Role string
+
+const (
+	RoleViewer           Role = "viewer"
+	RoleChannelModerator Role = "channel_moderator"
+	RoleStreamer         Role = "streamer"
+	RoleModerator        Role = "moderator"
+	RoleAdmin            Role = "admin"
+
+	actorContextKey      = "actor"
+	livestreamContextKey = "livestream"
+)
+
+var roleLevels = map[Role]int{
+	RoleViewer:           0,
+	RoleChannelModerator: 1,
+	RoleStreamer:         2,
+	RoleModerator:        3,
+	RoleAdmin:            4,
+}
+
+// AtLeast はrがmin以上の権限かどうか
+func (r Role) AtLeast(min Role) bool {
+	return roleLevels[r] >= roleLevels[min]
+}
+
+// Stored はusers.roleに保存できる権限かどうか
+func (r Role) Stored() bool {
+	return r == RoleViewer || r == RoleModerator || r == RoleAdmin
+}
+
+// actor はリクエストしたユーザ
+type actor struct {
+	User UserModel
+}
+
+// Role はプラットフォームでの権限 (配信ごとの権限はlivestreamRoleで求める)
+func (a *actor) Role() Role {
+	if a.User.Role == "" {
+		return RoleViewer
+	}
+	return a.User.Role
+}
+
+// currentActor はセッションのユーザを返す (1リクエストで1回だけ読み込む)
+func currentActor(c echo.Context) (*actor, error) {
+	if a, ok := c.Get(actorContextKey).(*actor); ok {
+		return a, nil
+	}
+	if err := verifyUserSession(c); err != nil {
+		return nil, err
+	}
+
+	// error already checked
+	sess, _ := session.Get(defaultSessionIDKey, c)
+	// existence already checked
+	userID := sess.Values[defaultUserIDKey].(int64)
+
+	userModel, err := getSessionUser(c.Request().Context(), userID)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "failed to get user: "+err.Error())
+	}
+	a := &actor{User: userModel}
+	c.Set(actorContextKey, a)
+	return a, nil
+}
+
+// livestreamRole は配信に対する権限を返す
+// 配信者本人はstreamer、配信者が任命したモデレータはchannel_moderator、それ以外はプラットフォームでの権限
+func livestreamRole(ctx context.Context, a *actor, livestream LivestreamModel) (Role, error) {
+	role := a.Role()
+	if role.AtLeast(RoleStreamer) {
+		return role, nil
+	}
+	if livestream.UserID == a.User.ID {
+		return RoleStreamer, nil
+	}
+
+	var isModerator bool
+	err := withReadOnlyTx(ctx, func(tx Tx) error {
+		var err error
+		isModerator, err = tx.Moderation().IsModerator(ctx, livestream.UserID, a.User.ID)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	if isModerator {
+		return RoleChannelModerator, nil
+	}
+	return role, nil
+}
+
+// requireRole はプラットフォームでmin以上の権限を持つユーザだけを通す
+func requireRole(min Role) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			a, err := currentActor(c)
+			if err != nil {
+				return err
+			}
+			if !a.Role().AtLeast(min) {
+				return echo.NewHTTPError(http.StatusForbidden, "permission denied")
+			}
+			return next(c)
+		}
+	}
+}
+
+// requireLivestreamRole は:livestream_idの配信に対してmin以上の権限を持つユーザだけを通す
+// 配信が存在しない場合も、権限がない場合と同じくstatusとmessageで拒否する
+func requireLivestreamRole(min Role, status int, message string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			ctx := c.Request().Context()
+
+			a, err := currentActor(c)
+			if err != nil {
+				return err
+			}
+
+			livestreamID, err := strconv.Atoi(c.Param("livestream_id"))
+			if err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, "livestream_id in path must be integer")
+			}
+
+			var livestreamModel LivestreamModel
+			err = withReadOnlyTx(ctx, func(tx Tx) error {
+				var err error
+				livestreamModel, err = tx.Livestreams().Get(ctx, int64(livestreamID))
+				return err
+			})
+			if errors.Is(err, sql.ErrNoRows) {
+				return echo.NewHTTPError(status, message)
+			}
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, "failed to get livestream: "+err.Error())
+			}
+
+			role, err := livestreamRole(ctx, a, livestreamModel)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, "failed to get role: "+err.Error())
+			}
+			if !role.AtLeast(min) {
+				return echo.NewHTTPError(status, message)
+			}
+			c.Set(livestreamContextKey, livestreamModel)
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,282 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/puzpuzpuz/xsync/v3"
+)
+
+// batchLoader はレスポンスの組み立てに必要な行を、ページ内のIDを集めてからIN句でまとめて取得する
+// 1行ごとにusers、themes、icons、tagsを引くN+1クエリを避けるため、リストを返すAPIはこれを使う
+// トランザクションに紐づくので、1つのリクエストの中だけで使う
+type batchLoader struct {
+	tx Tx
+	// fillCaches はDBから取得した行をプロセス内のキャッシュに入れるかどうか (レプリカから読む場合は入れない)
+	fillCaches bool
+
+	users      map[int64]UserModel
+	themes     map[int64]ThemeModel
+	iconHashes map[int64]string
+	tags       map[int64]TagModel
+	// livestream_id -> tag_id (livestream_tagsのid順)
+	livestreamTags map[int64][]int64
+	livestreams    map[int64]LivestreamModel
+	livecomments   map[int64]LivecommentModel
+}
+
+func newBatchLoader(tx Tx) *batchLoader {
+	return &batchLoader{
+		tx:             tx,
+		fillCaches:     !isReplicaTx(tx),
+		users:          make(map[int64]UserModel),
+		themes:         make(map[int64]ThemeModel),
+		iconHashes:     make(map[int64]string),
+		tags:           make(map[int64]TagModel),
+		livestreamTags: make(map[int64][]int64),
+		livestreams:    make(map[int64]LivestreamModel),
+		livecomments:   make(map[int64]LivecommentModel),
+	}
+}
+
+// missingIDs はloadedにまだないIDを重複なしで返す
+func missingIDs[V any](ids []int64, loaded map[int64]V) []int64 {
+	seen := make(map[int64]struct{}, len(ids))
+	var missing []int64
+	for _, id := range ids {
+		if _, ok := loaded[id]; ok {
+			continue
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		missing = append(missing, id)
+	}
+	return missing
+}
+
+// loadByIDs はcache (nilならDB) から見つからなかったIDだけをfetchでまとめて取得し、loadedに入れる
+// fillCacheならcacheにも入れる
+func loadByIDs[V any](ctx context.Context, loaded map[int64]V, cache *xsync.MapOf[int64, V], fillCache bool, ids []int64, fetch func(context.Context, []int64) ([]V, error), key func(V) int64) error {
+	var rest []int64
+	for _, id := range missingIDs(ids, loaded) {
+		if cache != nil {
+			if v, ok := cache.Load(id); ok {
+				loaded[id] = v
+				continue
+			}
+		}
+		rest = append(rest, id)
+	}
+	if len(rest) == 0 {
+		return nil
+	}
+
+	rows, err := fetch(ctx, rest)
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		loaded[key(row)] = row
+		if cache != nil && fillCache {
+			cache.Store(key(row), row)
+		}
+	}
+	return nil
+}
+
+// loadUsers はユーザとそのテーマ、アイコンのハッシュを取得する
+func (l *batchLoader) loadUsers(ctx context.Context, userIDs []int64) error {
+	if err := loadByIDs(ctx, l.users, userCache, l.fillCaches, userIDs, l.tx.Users().ListByIDs, func(u UserModel) int64 { return u.ID }); err != nil {
+		return err
+	}
+	// 退会済みユーザはテーマがないので、見つからなくてもエラーにしない
+	if err := loadByIDs(ctx, l.themes, themeCache, l.fillCaches, userIDs, l.tx.Themes().ListByUserIDs, func(t ThemeModel) int64 { return t.UserID }); err != nil {
+		return err
+	}
+	return l.loadIconHashes(ctx, userIDs)
+}
+
+func (l *batchLoader) loadIconHashes(ctx context.Context, userIDs []int64) error {
+	var rest []int64
+	for _, id := range missingIDs(userIDs, l.iconHashes) {
+		imageHash, ok := iconImageHashCache.Load(id)
+		observeCache(cacheNameIconHash, ok)
+		if ok {
+			l.iconHashes[id] = imageHash
+			continue
+		}
+		rest = append(rest, id)
+	}
+	if len(rest) == 0 {
+		return nil
+	}
+
+	icons, err := l.tx.Icons().ListHashesByUserIDs(ctx, rest)
+	if err != nil {
+		return err
+	}
+	for _, icon := range icons {
+		l.iconHashes[icon.UserID] = icon.ImageHash
+	}
+	// アイコン未設定のユーザはNoImage.jpg
+	for _, id := range rest {
+		if _, ok := l.iconHashes[id]; !ok {
+			l.iconHashes[id] = fallbackImageHash
+		}
+		if l.fillCaches {
+			iconImageHashCache.Store(id, l.iconHashes[id])
+		}
+	}
+	return nil
+}
+
+func (l *batchLoader) loadTags(ctx context.Context, tagIDs []int64) error {
+	return loadByIDs(ctx, l.tags, tagCache, l.fillCaches, tagIDs, l.tx.Tags().ListByIDs, func(t TagModel) int64 { return t.ID })
+}
+
+// loadLivestreamTags は配信に付いているタグを取得する
+func (l *batchLoader) loadLivestreamTags(ctx context.Context, livestreamIDs []int64) error {
+	ids := missingIDs(livestreamIDs, l.livestreamTags)
+	if len(ids) == 0 {
+		return nil
+	}
+
+	livestreamTagModels, err := l.tx.Livestreams().ListTags(ctx, ids)
+	if err != nil {
+		return err
+	}
+	// タグのない配信も取得済みとして扱う
+	for _, id := range ids {
+		l.livestreamTags[id] = []int64{}
+	}
+	tagIDs := make([]int64, len(livestreamTagModels))
+	for i, m := range livestreamTagModels {
+		l.livestreamTags[m.LivestreamID] = append(l.livestreamTags[m.LivestreamID], m.TagID)
+		tagIDs[i] = m.TagID
+	}
+	return l.loadTags(ctx, tagIDs)
+}
+
+func (l *batchLoader) loadLivestreams(ctx context.Context, livestreamIDs []int64) error {
+	return loadByIDs(ctx, l.livestreams, nil, false, livestreamIDs, l.tx.Livestreams().ListByIDs, func(ls LivestreamModel) int64 { return ls.ID })
+}
+
+func (l *batchLoader) loadLivecomments(ctx context.Context, livecommentIDs []int64) error {
+	return loadByIDs(ctx, l.livecomments, nil, false, livecommentIDs, l.tx.Livecomments().ListByIDs, func(lc LivecommentModel) int64 { return lc.ID })
+}
+
+// loadLivestreamDetails は配信と、その配信者とタグを取得する
+func (l *batchLoader) loadLivestreamDetails(ctx context.Context, livestreamIDs []int64) error {
+	if err := l.loadLivestreams(ctx, livestreamIDs); err != nil {
+		return err
+	}
+	ownerIDs := make([]int64, 0, len(livestreamIDs))
+	for _, id := range livestreamIDs {
+		if livestreamModel, ok := l.livestreams[id]; ok {
+			ownerIDs = append(ownerIDs, livestreamModel.UserID)
+		}
+	}
+	if err := l.loadUsers(ctx, ownerIDs); err != nil {
+		return err
+	}
+	return l.loadLivestreamTags(ctx, livestreamIDs)
+}
+
+// getLivestreams はIDの順に配信を返す (見つからないものがあればsql.ErrNoRows)
+func (l *batchLoader) getLivestreams(ctx context.Context, livestreamIDs []int64) ([]LivestreamModel, error) {
+	if err := l.loadLivestreams(ctx, livestreamIDs); err != nil {
+		return nil, err
+	}
+	livestreamModels := make([]LivestreamModel, len(livestreamIDs))
+	for i, id := range livestreamIDs {
+		livestreamModel, ok := l.livestreams[id]
+		if !ok {
+			return nil, sql.ErrNoRows
+		}
+		livestreamModels[i] = livestreamModel
+	}
+	return livestreamModels, nil
+}
+
+// 以下は取得済みの行からレスポンスを組み立てる (load*を先に呼んでおくこと)
+
+func (l *batchLoader) user(userID int64) (User, error) {
+	userModel, ok := l.users[userID]
+	if !ok {
+		return User{}, sql.ErrNoRows
+	}
+	// 退会済みユーザはテーマが削除されているのでデフォルトのテーマにする
+	themeModel := l.themes[userID]
+	return User{
+		ID:          userModel.ID,
+		Name:        userModel.Name,
+		DisplayName: userModel.DisplayName,
+		Description: userModel.Description,
+		Theme: Theme{
+			ID:       themeModel.ID,
+			DarkMode: themeModel.DarkMode,
+		},
+		IconHash: l.iconHashes[userID],
+	}, nil
+}
+
+func (l *batchLoader) livestream(livestreamModel LivestreamModel) (Livestream, error) {
+	owner, err := l.user(livestreamModel.UserID)
+	if err != nil {
+		return Livestream{}, err
+	}
+
+	tagIDs := l.livestreamTags[livestreamModel.ID]
+	tags := make([]Tag, len(tagIDs))
+	for i, tagID := range tagIDs {
+		tagModel, ok := l.tags[tagID]
+		if !ok {
+			return Livestream{}, sql.ErrNoRows
+		}
+		tags[i] = Tag{
+			ID:   tagModel.ID,
+			Name: tagModel.Name,
+		}
+	}
+
+	return Livestream{
+		ID:           livestreamModel.ID,
+		Owner:        owner,
+		Title:        livestreamModel.Title,
+		Tags:         tags,
+		Description:  livestreamModel.Description,
+		PlaylistUrl:  livestreamModel.PlaylistUrl,
+		ThumbnailUrl: livestreamModel.ThumbnailUrl,
+		StartAt:      livestreamModel.StartAt,
+		EndAt:        livestreamModel.EndAt,
+	}, nil
+}
+
+func (l *batchLoader) livestreamByID(livestreamID int64) (Livestream, error) {
+	livestreamModel, ok := l.livestreams[livestreamID]
+	if !ok {
+		return Livestream{}, sql.ErrNoRows
+	}
+	return l.livestream(livestreamModel)
+}
+
+func (l *batchLoader) livecomment(livecommentModel LivecommentModel) (Livecomment, error) {
+	commentOwner, err := l.user(livecommentModel.UserID)
+	if err != nil {
+		return Livecomment{}, err
+	}
+	livestream, err := l.livestreamByID(livecommentModel.LivestreamID)
+	if err != nil {
+		return Livecomment{}, err
+	}
+	return Livecomment{
+		ID:         livecommentModel.ID,
+		User:       commentOwner,
+		Livestream: livestream,
+		Comment:    livecommentModel.Comment,
+		Tip:        livecommentModel.Tip,
+		CreatedAt:  livecommentModel.CreatedAt,
+	}, nil
+}
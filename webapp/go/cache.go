@@ -1,15 +1,241 @@
 package main
 
 import (
+	"container/list"
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+
 	"github.com/puzpuzpuz/xsync/v3"
 )
 
+// アイコン画像キャッシュのデフォルトの上限 (バイト)
+const defaultIconCacheBytes = 256 << 20
+
+// iconCacheKey のSizeが0の場合はアップロードされた画像そのもの
+type iconCacheKey struct {
+	Hash string
+	Size int
+}
+
+type cachedIcon struct {
+	ContentType string
+	Image       []byte
+}
+
 var iconImageHashCache = xsync.NewMapOf[int64, string]()
 
-var iconImageCache = xsync.NewMapOf[int64, []byte]()
+var userCache = xsync.NewMapOf[int64, UserModel]()
+
+var themeCache = xsync.NewMapOf[int64, ThemeModel]()
+
+var tagCache = xsync.NewMapOf[int64, TagModel]()
+
+// livestream_id -> 配信者が登録したNGワード
+var ngWordCache = xsync.NewMapOf[int64, []*NGWord]()
+
+// アイコンはハッシュで内容が一意に決まるので、IconStoreから削除したときだけ破棄する
+var iconImageCache = newIconLRUCache(defaultIconCacheBytes)
 
 func Copy(src []byte) []byte {
 	dst := make([]byte, len(src))
 	copy(dst, src)
 	return dst
 }
+
+// subscribeCaches は各キャッシュをCacheBusの破棄通知に登録する
+func subscribeCaches(bus CacheBus) {
+	bus.Subscribe(cacheTopicIcon, func(key string) {
+		invalidateCacheByID(iconImageHashCache, key)
+		if key == "" {
+			iconImageCache.Clear()
+		}
+	})
+	bus.Subscribe(cacheTopicIconImage, func(key string) {
+		if key == "" {
+			iconImageCache.Clear()
+			return
+		}
+		iconImageCache.DeleteHash(key)
+	})
+	bus.Subscribe(cacheTopicUser, func(key string) {
+		invalidateCacheByID(userCache, key)
+	})
+	bus.Subscribe(cacheTopicTheme, func(key string) {
+		invalidateCacheByID(themeCache, key)
+	})
+	bus.Subscribe(cacheTopicTag, func(key string) {
+		invalidateCacheByID(tagCache, key)
+	})
+	bus.Subscribe(cacheTopicNGWord, func(key string) {
+		invalidateCacheByID(ngWordCache, key)
+	})
+}
+
+// invalidateCacheByID はkeyのIDに対応するエントリを破棄する (keyが空なら全破棄)
+func invalidateCacheByID[V any](m *xsync.MapOf[int64, V], key string) {
+	if key == "" {
+		m.Clear()
+		return
+	}
+	id, err := strconv.ParseInt(key, 10, 64)
+	if err != nil {
+		m.Clear()
+		return
+	}
+	m.Delete(id)
+}
+
+// iconLRUCache は合計バイト数を上限とするLRUキャッシュ
+type iconLRUCache struct {
+	mu       sync.Mutex
+	maxBytes int64
+	curBytes int64
+	ll       *list.List
+	items    map[iconCacheKey]*list.Element
+}
+
+type iconLRUEntry struct {
+	key  iconCacheKey
+	icon *cachedIcon
+}
+
+func newIconLRUCache(maxBytes int64) *iconLRUCache {
+	return &iconLRUCache{
+		maxBytes: maxBytes,
+		ll:       list.New(),
+		items:    make(map[iconCacheKey]*list.Element),
+	}
+}
+
+func (c *iconLRUCache) Load(key iconCacheKey) (*cachedIcon, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	c.ll.MoveToFront(elem)
+	return elem.Value.(*iconLRUEntry).icon, true
+}
+
+func (c *iconLRUCache) Store(key iconCacheKey, icon *cachedIcon) {
+	size := int64(len(icon.Image))
+	// 上限を超える画像はキャッシュしない
+	if size > c.maxBytes {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.items[key]; ok {
+		c.curBytes -= int64(len(elem.Value.(*iconLRUEntry).icon.Image))
+		elem.Value.(*iconLRUEntry).icon = icon
+		c.curBytes += size
+		c.ll.MoveToFront(elem)
+	} else {
+		c.items[key] = c.ll.PushFront(&iconLRUEntry{key: key, icon: icon})
+		c.curBytes += size
+	}
+
+	for c.curBytes > c.maxBytes {
+		oldest := c.ll.Back()
+		if oldest == nil {
+			break
+		}
+		c.removeElementLocked(oldest)
+	}
+}
+
+func (c *iconLRUCache) Delete(key iconCacheKey) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.items[key]; ok {
+		c.removeElementLocked(elem)
+	}
+}
+
+// DeleteHash は元画像と縮小版のエントリを破棄する
+func (c *iconLRUCache) DeleteHash(hash string) {
+	c.Delete(iconCacheKey{Hash: hash})
+	for _, size := range iconVariantSizes {
+		c.Delete(iconCacheKey{Hash: hash, Size: size})
+	}
+}
+
+// Resize は上限を変更し、超過分を破棄する
+func (c *iconLRUCache) Resize(maxBytes int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.maxBytes = maxBytes
+	for c.curBytes > c.maxBytes {
+		oldest := c.ll.Back()
+		if oldest == nil {
+			break
+		}
+		c.removeElementLocked(oldest)
+	}
+}
+
+// Clear はすべてのエントリを破棄する
+func (c *iconLRUCache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.ll.Init()
+	c.items = make(map[iconCacheKey]*list.Element)
+	c.curBytes = 0
+}
+
+func (c *iconLRUCache) removeElementLocked(elem *list.Element) {
+	entry := c.ll.Remove(elem).(*iconLRUEntry)
+	delete(c.items, entry.key)
+	c.curBytes -= int64(len(entry.icon.Image))
+}
+
+// warmCaches は起動直後や初期化後に、よく参照されるキャッシュを読み込んでおく
+func warmCaches(ctx context.Context) error {
+	tx, err := store.BeginReadOnly(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	tags, err := tx.Tags().List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get tags: %w", err)
+	}
+	for _, tag := range tags {
+		tagCache.Store(tag.ID, tag)
+	}
+
+	users, err := tx.Users().List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get users: %w", err)
+	}
+	for _, user := range users {
+		userCache.Store(user.ID, user)
+	}
+
+	themes, err := tx.Themes().List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get themes: %w", err)
+	}
+	for _, theme := range themes {
+		themeCache.Store(theme.UserID, theme)
+	}
+
+	icons, err := tx.Icons().ListHashes(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get icons: %w", err)
+	}
+	for _, icon := range icons {
+		iconImageHashCache.Store(icon.UserID, icon.ImageHash)
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,284 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// キャッシュ破棄の通知先 (トピック)
+const (
+	cacheTopicIcon      = "icon"       // key: user_id
+	cacheTopicIconImage = "icon_image" // key: icon_hash
+	cacheTopicUser      = "user"       // key: user_id
+	cacheTopicTheme     = "theme"      // key: user_id
+	cacheTopicTag       = "tag"        // key: tag_id
+	cacheTopicNGWord    = "ngword"     // key: livestream_id
+	cacheTopicDNS       = "dns"        // key: users.name
+)
+
+var cacheTopics = []string{cacheTopicIcon, cacheTopicIconImage, cacheTopicUser, cacheTopicTheme, cacheTopicTag, cacheTopicNGWord, cacheTopicDNS}
+
+const (
+	cacheInvalidatePath       = "/internal/cache/invalidate"
+	cacheBusTokenHeader       = "X-Isupipe-Cache-Token"
+	cacheBusBroadcastTimeout  = 3 * time.Second
+	cacheBusSendAttempts      = 3
+	cacheBusRetryInterval     = 100 * time.Millisecond
+	cacheBusPeersEnvKey       = "ISUCON13_CACHE_PEERS"
+	cacheBusTokenEnvKey       = "ISUCON13_CACHE_BUS_TOKEN"
+	cacheBusSelfAddressEnvKey = "ISUCON13_CACHE_SELF"
+)
+
+// CacheInvalidation はキャッシュ破棄の通知
+// Keyが空の場合はトピックのキャッシュをすべて破棄する
+type CacheInvalidation struct {
+	Topic string `json:"topic"`
+	Key   string `json:"key"`
+}
+
+// CacheBus はプロセス内キャッシュの破棄を全インスタンスに伝える
+// Publishは自プロセスのキャッシュにも反映され、届かなかったインスタンスがあればエラーを返す
+type CacheBus interface {
+	Publish(ctx context.Context, inv CacheInvalidation) error
+	Subscribe(topic string, fn func(key string))
+}
+
+var cacheBus CacheBus = newMemoryCacheBus()
+
+// cacheSubscribers はトピックごとの購読者を管理する (各実装で共通)
+type cacheSubscribers struct {
+	mu   sync.RWMutex
+	subs map[string][]func(key string)
+}
+
+func (s *cacheSubscribers) Subscribe(topic string, fn func(key string)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.subs == nil {
+		s.subs = make(map[string][]func(key string))
+	}
+	s.subs[topic] = append(s.subs[topic], fn)
+}
+
+func (s *cacheSubscribers) deliver(inv CacheInvalidation) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, fn := range s.subs[inv.Topic] {
+		fn(inv.Key)
+	}
+}
+
+// memoryCacheBus はプロセス内で完結する実装
+// 同じmemoryCacheHubに参加したバス同士で通知が届くので、複数インスタンスを模したテストに使える
+type memoryCacheBus struct {
+	cacheSubscribers
+	hub *memoryCacheHub
+}
+
+type memoryCacheHub struct {
+	mu    sync.Mutex
+	buses []*memoryCacheBus
+}
+
+func newMemoryCacheBus() *memoryCacheBus {
+	return (&memoryCacheHub{}).Join()
+}
+
+func (h *memoryCacheHub) Join() *memoryCacheBus {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	bus := &memoryCacheBus{hub: h}
+	h.buses = append(h.buses, bus)
+	return bus
+}
+
+func (b *memoryCacheBus) Publish(ctx context.Context, inv CacheInvalidation) error {
+	b.hub.mu.Lock()
+	buses := append([]*memoryCacheBus(nil), b.hub.buses...)
+	b.hub.mu.Unlock()
+
+	for _, bus := range buses {
+		bus.deliver(inv)
+	}
+	return nil
+}
+
+// httpCacheBus は他のアプリケーションサーバへHTTPで直接通知する実装
+// peersはcacheBusPeerURLで正規化したURL
+type httpCacheBus struct {
+	cacheSubscribers
+	peers  []string
+	token  string
+	client *http.Client
+}
+
+func newHTTPCacheBus(peers []string, token string) *httpCacheBus {
+	return &httpCacheBus{
+		peers: peers,
+		token: token,
+		client: &http.Client{
+			Timeout: cacheBusBroadcastTimeout,
+		},
+	}
+}
+
+// cacheBusPeerURL はcache_bus.peersの値を通知先のURLにする
+// "192.168.0.12:8080" のようにスキームを省略した場合はhttpとみなす
+func cacheBusPeerURL(peer string) (string, error) {
+	raw := peer
+	if !strings.Contains(raw, "://") {
+		raw = "http://" + raw
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", fmt.Errorf("invalid cache bus peer %q: %w", peer, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", fmt.Errorf("invalid cache bus peer %q: scheme must be http or https", peer)
+	}
+	if u.Host == "" || (u.Path != "" && u.Path != "/") || u.RawQuery != "" || u.Fragment != "" {
+		return "", fmt.Errorf("invalid cache bus peer %q: must be host:port or a base URL", peer)
+	}
+	return u.Scheme + "://" + u.Host, nil
+}
+
+// Publish は自プロセスに即座に反映し、他のインスタンスへ並行して通知する
+// 失敗した通知はバックオフしながら再送し、それでも届かなかったインスタンスのエラーをまとめて返す
+func (b *httpCacheBus) Publish(ctx context.Context, inv CacheInvalidation) error {
+	b.deliver(inv)
+
+	body, err := json.Marshal(inv)
+	if err != nil {
+		return err
+	}
+
+	errs := make([]error, len(b.peers))
+	var wg sync.WaitGroup
+	for i, peer := range b.peers {
+		wg.Add(1)
+		go func(i int, peer string) {
+			defer wg.Done()
+			errs[i] = b.sendWithRetry(ctx, peer, body)
+		}(i, peer)
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+func (b *httpCacheBus) sendWithRetry(ctx context.Context, peer string, body []byte) error {
+	interval := cacheBusRetryInterval
+	var err error
+	for attempt := 1; ; attempt++ {
+		if err = b.send(ctx, peer, body); err == nil {
+			return nil
+		}
+		if attempt >= cacheBusSendAttempts {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("failed to send cache invalidation to %s: %w", peer, errors.Join(err, ctx.Err()))
+		case <-time.After(interval):
+		}
+		interval *= 2
+	}
+	return fmt.Errorf("failed to send cache invalidation to %s after %d attempts: %w", peer, cacheBusSendAttempts, err)
+}
+
+func (b *httpCacheBus) send(ctx context.Context, peer string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, peer+cacheInvalidatePath, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(cacheBusTokenHeader, b.token)
+
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// 他のインスタンスからのキャッシュ破棄通知を受け取る
+// POST /internal/cache/invalidate
+func (b *httpCacheBus) receiveHandler(c echo.Context) error {
+	// トークンを推測されないよう、比較にかかる時間を一致した長さに依存させない
+	if subtle.ConstantTimeCompare([]byte(c.Request().Header.Get(cacheBusTokenHeader)), []byte(b.token)) != 1 {
+		return echo.NewHTTPError(http.StatusForbidden, "invalid cache bus token")
+	}
+
+	var inv CacheInvalidation
+	if err := json.NewDecoder(c.Request().Body).Decode(&inv); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "failed to decode the request body as json")
+	}
+
+	// 受け取った通知は再送しない
+	b.deliver(inv)
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// setupCacheBus はcache_bus.peersが設定されていればHTTPで通知するバスを使う
+// 設定されていなければ単一インスタンスとしてプロセス内のバスを使う
+// トークンが空でないことと、peersがURLとして正しいことはConfig.Validateで確認している
+func setupCacheBus(e *echo.Echo, cfg CacheBusConfig) error {
+	if len(cfg.Peers) == 0 {
+		cacheBus = newMemoryCacheBus()
+		subscribeCaches(cacheBus)
+		return nil
+	}
+
+	var self string
+	if cfg.SelfAddress != "" {
+		u, err := cacheBusPeerURL(cfg.SelfAddress)
+		if err != nil {
+			return err
+		}
+		self = u
+	}
+
+	// 自分自身のアドレスが含まれていても二重に破棄するだけなので、除外できる場合は除外する
+	var peers []string
+	for _, peer := range cfg.Peers {
+		u, err := cacheBusPeerURL(peer)
+		if err != nil {
+			return err
+		}
+		if u == self {
+			continue
+		}
+		peers = append(peers, u)
+	}
+
+	bus := newHTTPCacheBus(peers, cfg.Token)
+	e.POST(cacheInvalidatePath, bus.receiveHandler)
+	cacheBus = bus
+	subscribeCaches(cacheBus)
+	return nil
+}
+
+// publishCacheInvalidation はキャッシュ破棄を通知する
+// 通知の失敗は他のインスタンスのキャッシュが古くなるだけなので、ログに残して処理は続ける
+func publishCacheInvalidation(c echo.Context, topic string, key string) {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), cacheBusBroadcastTimeout)
+	defer cancel()
+	if err := cacheBus.Publish(ctx, CacheInvalidation{Topic: topic, Key: key}); err != nil {
+		requestLogger(c).Warn("failed to publish cache invalidation", "topic", topic, "key", key, "error", err)
+	}
+}
@@ -0,0 +1,314 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+// 別のインスタンスで書き込みがあったとき、通知を受けたインスタンスのキャッシュが破棄されること
+// キャッシュはプロセスで共有しているので、購読するのは受け取る側のバスだけにする
+func TestMemoryCacheBusEvictsCachesOnOtherInstance(t *testing.T) {
+	const (
+		id   int64 = 42
+		hash       = "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	)
+	tests := []struct {
+		topic   string
+		key     string
+		fill    func()
+		present func() bool
+	}{
+		{
+			topic:   cacheTopicIcon,
+			key:     "42",
+			fill:    func() { iconImageHashCache.Store(id, hash) },
+			present: func() bool { _, ok := iconImageHashCache.Load(id); return ok },
+		},
+		{
+			topic: cacheTopicIconImage,
+			key:   hash,
+			fill: func() {
+				iconImageCache.Store(iconCacheKey{Hash: hash}, &cachedIcon{Image: []byte("original")})
+				iconImageCache.Store(iconCacheKey{Hash: hash, Size: iconVariantSizes[0]}, &cachedIcon{Image: []byte("variant")})
+			},
+			present: func() bool {
+				_, ok := iconImageCache.Load(iconCacheKey{Hash: hash})
+				_, variantOK := iconImageCache.Load(iconCacheKey{Hash: hash, Size: iconVariantSizes[0]})
+				return ok || variantOK
+			},
+		},
+		{
+			topic:   cacheTopicUser,
+			key:     "42",
+			fill:    func() { userCache.Store(id, UserModel{ID: id}) },
+			present: func() bool { _, ok := userCache.Load(id); return ok },
+		},
+		{
+			topic:   cacheTopicTheme,
+			key:     "42",
+			fill:    func() { themeCache.Store(id, ThemeModel{UserID: id}) },
+			present: func() bool { _, ok := themeCache.Load(id); return ok },
+		},
+		{
+			topic:   cacheTopicTag,
+			key:     "42",
+			fill:    func() { tagCache.Store(id, TagModel{ID: id}) },
+			present: func() bool { _, ok := tagCache.Load(id); return ok },
+		},
+		{
+			topic:   cacheTopicNGWord,
+			key:     "42",
+			fill:    func() { ngWordCache.Store(id, []*NGWord{{ID: 1}}) },
+			present: func() bool { _, ok := ngWordCache.Load(id); return ok },
+		},
+	}
+
+	hub := &memoryCacheHub{}
+	writer := hub.Join()
+	reader := hub.Join()
+	subscribeCaches(reader)
+
+	for _, tt := range tests {
+		t.Run(tt.topic, func(t *testing.T) {
+			tt.fill()
+			if !tt.present() {
+				t.Fatal("cache must be filled before the invalidation")
+			}
+			if err := writer.Publish(context.Background(), CacheInvalidation{Topic: tt.topic, Key: tt.key}); err != nil {
+				t.Fatal(err)
+			}
+			if tt.present() {
+				t.Errorf("cache for topic %s must be evicted", tt.topic)
+			}
+		})
+	}
+}
+
+// 他のトピックや別のキーの通知では破棄しないこと
+func TestMemoryCacheBusKeepsUnrelatedEntries(t *testing.T) {
+	hub := &memoryCacheHub{}
+	writer := hub.Join()
+	subscribeCaches(hub.Join())
+
+	userCache.Store(1, UserModel{ID: 1})
+	userCache.Store(2, UserModel{ID: 2})
+	t.Cleanup(userCache.Clear)
+
+	if err := writer.Publish(context.Background(), CacheInvalidation{Topic: cacheTopicTheme, Key: "1"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := writer.Publish(context.Background(), CacheInvalidation{Topic: cacheTopicUser, Key: "2"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := userCache.Load(1); !ok {
+		t.Error("user 1 must stay in the cache")
+	}
+	if _, ok := userCache.Load(2); ok {
+		t.Error("user 2 must be evicted")
+	}
+}
+
+func TestHTTPCacheBusReceiveHandlerChecksToken(t *testing.T) {
+	bus := newHTTPCacheBus(nil, "secret")
+	var received []string
+	bus.Subscribe(cacheTopicUser, func(key string) { received = append(received, key) })
+
+	e := echo.New()
+	e.POST(cacheInvalidatePath, bus.receiveHandler)
+
+	for _, tt := range []struct {
+		token string
+		want  int
+	}{
+		{token: "", want: http.StatusForbidden},
+		{token: "secre", want: http.StatusForbidden},
+		{token: "secret-but-longer", want: http.StatusForbidden},
+		{token: "secret", want: http.StatusNoContent},
+	} {
+		req := httptest.NewRequest(http.MethodPost, cacheInvalidatePath, strings.NewReader(`{"topic":"user","key":"7"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		if tt.token != "" {
+			req.Header.Set(cacheBusTokenHeader, tt.token)
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		if rec.Code != tt.want {
+			t.Errorf("token %q: got status %d, want %d", tt.token, rec.Code, tt.want)
+		}
+	}
+	if len(received) != 1 || received[0] != "7" {
+		t.Errorf("only the request with the valid token must be delivered (got %v)", received)
+	}
+}
+
+func TestConfigRejectsEmptyCacheBusToken(t *testing.T) {
+	cfg := defaultConfig()
+	cfg.DNS.SubdomainAddress = "127.0.0.1"
+	cfg.CacheBus.Peers = []string{"192.168.0.12:8080"}
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "cache_bus.token") {
+		t.Errorf("empty cache_bus.token must be rejected (got %v)", err)
+	}
+
+	cfg.CacheBus.Token = "secret"
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestCacheBusPeerURL(t *testing.T) {
+	tests := []struct {
+		peer    string
+		want    string
+		wantErr bool
+	}{
+		{peer: "192.168.0.12:8080", want: "http://192.168.0.12:8080"},
+		{peer: "http://192.168.0.12:8080/", want: "http://192.168.0.12:8080"},
+		{peer: "https://app2.example.com", want: "https://app2.example.com"},
+		{peer: "", wantErr: true},
+		{peer: "ftp://192.168.0.12", wantErr: true},
+		{peer: "http://192.168.0.12:8080/internal", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := cacheBusPeerURL(tt.peer)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("cacheBusPeerURL(%q): err = %v, wantErr %v", tt.peer, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("cacheBusPeerURL(%q) = %q, want %q", tt.peer, got, tt.want)
+		}
+	}
+
+	cfg := defaultConfig()
+	cfg.DNS.SubdomainAddress = "127.0.0.1"
+	cfg.CacheBus.Token = "secret"
+	cfg.CacheBus.Peers = []string{"ftp://192.168.0.12"}
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "cache_bus.peers") {
+		t.Errorf("invalid cache_bus.peers must be rejected (got %v)", err)
+	}
+}
+
+// config.example.yamlと同じ host:port の形式で設定したインスタンスに通知が届くこと
+func TestHTTPCacheBusPublishesToPeer(t *testing.T) {
+	peer := newHTTPCacheBus(nil, "secret")
+	received := make(chan string, 1)
+	peer.Subscribe(cacheTopicUser, func(key string) { received <- key })
+	e := echo.New()
+	e.POST(cacheInvalidatePath, peer.receiveHandler)
+	srv := httptest.NewServer(e)
+	t.Cleanup(srv.Close)
+
+	e2 := echo.New()
+	cfg := CacheBusConfig{
+		Peers:       []string{strings.TrimPrefix(srv.URL, "http://"), "127.0.0.1:1"},
+		Token:       "secret",
+		SelfAddress: "127.0.0.1:1",
+	}
+	origBus := cacheBus
+	t.Cleanup(func() { cacheBus = origBus })
+	if err := setupCacheBus(e2, cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := cacheBus.Publish(context.Background(), CacheInvalidation{Topic: cacheTopicUser, Key: "7"}); err != nil {
+		t.Fatalf("Publish: %v", err)
+	}
+	select {
+	case key := <-received:
+		if key != "7" {
+			t.Errorf("peer received key %q, want 7", key)
+		}
+	default:
+		t.Error("peer must receive the invalidation before Publish returns")
+	}
+}
+
+// 一時的に失敗するインスタンスには再送し、届かなかった場合はエラーを返すこと
+func TestHTTPCacheBusPublishRetriesAndReportsErrors(t *testing.T) {
+	var calls atomic.Int32
+	flaky := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(flaky.Close)
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(down.Close)
+
+	bus := newHTTPCacheBus([]string{flaky.URL}, "secret")
+	if err := bus.Publish(context.Background(), CacheInvalidation{Topic: cacheTopicUser, Key: "7"}); err != nil {
+		t.Errorf("Publish must succeed after a retry: %v", err)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("flaky peer calls = %d, want 2", got)
+	}
+
+	bus = newHTTPCacheBus([]string{flaky.URL, down.URL}, "secret")
+	err := bus.Publish(context.Background(), CacheInvalidation{Topic: cacheTopicUser, Key: "7"})
+	if err == nil || !strings.Contains(err.Error(), down.URL) || strings.Contains(err.Error(), flaky.URL) {
+		t.Errorf("Publish must report only the peer that failed (got %v)", err)
+	}
+}
+
+// 組み込みDNSサーバへの反映は全インスタンスに届くまでoutboxに残ること
+func TestEmbeddedDNSProvisionerKeepsOutboxUntilPeersAcknowledge(t *testing.T) {
+	origConfig, origStore, origProvisioner, origBus := appConfig, store, dnsProvisioner, cacheBus
+	t.Cleanup(func() { appConfig, store, dnsProvisioner, cacheBus = origConfig, origStore, origProvisioner, origBus })
+
+	appConfig = defaultConfig()
+	s, err := newMemoryStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	store = s
+	dnsProvisioner = embeddedDNSProvisioner{}
+
+	var healthy atomic.Bool
+	peer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !healthy.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(peer.Close)
+	cacheBus = newHTTPCacheBus([]string{peer.URL}, "secret")
+
+	ctx := context.Background()
+	var id int64
+	err = withTx(ctx, func(tx Tx) error {
+		id, err = enqueueDNSOutbox(ctx, tx, dnsOutboxActionAdd, "embedded-test")
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := deliverDNSOutbox(ctx, id); err == nil {
+		t.Fatal("deliverDNSOutbox must fail while a peer is down")
+	}
+	entry, ok := s.data.dnsOutbox.rows[id]
+	if !ok {
+		t.Fatal("outbox entry must stay pending while a peer is down")
+	}
+
+	healthy.Store(true)
+	entry.NextAttemptAt = 0
+	s.data.dnsOutbox.rows[id] = entry
+	if err := deliverDNSOutbox(ctx, id); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := s.data.dnsOutbox.rows[id]; ok {
+		t.Error("outbox entry must be deleted once every peer acknowledged")
+	}
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"fmt"
+)
+
+// サブコマンドの一覧 (引数なしで起動した場合はHTTPサーバとして動作する)
+var commands = map[string]func(ctx context.Context, args []string) error{
+	"migrate":       runMigrate,
+	"migrate-icons": runMigrateIcons,
+	"reconcile-dns": runReconcileDNS,
+	"snapshot":      runSnapshot,
+	"staff":         runStaff,
+}
+
+func runCommand(name string, args []string) error {
+	command, ok := commands[name]
+	if !ok {
+		return fmt.Errorf("unknown command '%s'", name)
+	}
+
+	// サブコマンドはフラグを受け取らないので、設定ファイルと環境変数だけ読む
+	cfg, _, err := loadConfig(nil)
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	if cfg.DNS.SubdomainAddress == "" {
+		cfg.DNS.SubdomainAddress = "127.0.0.1"
+	}
+	if err := cfg.Validate(); err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
+	appConfig = cfg
+
+	s, err := newStore(cfg.Store)
+	if err != nil {
+		return fmt.Errorf("failed to setup store: %w", err)
+	}
+	defer s.Close()
+	store = s
+
+	if err := setupIconStore(); err != nil {
+		return err
+	}
+
+	return command(context.Background(), args)
+}
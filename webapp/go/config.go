@@ -0,0 +1,819 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/go-sql-driver/mysql"
+	"golang.org/x/crypto/bcrypt"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	configFileEnvKey = "ISUCON13_CONFIG_FILE"
+
+	// --print-configで秘密情報の代わりに出力する
+	redactedConfigValue = "<redacted>"
+)
+
+// Config はアプリケーションの設定
+// デフォルト値 < 設定ファイル (YAML/TOML) < 環境変数 < コマンドラインフラグ の順に上書きされる
+type Config struct {
+	Listen      ListenConfig      `yaml:"listen" toml:"listen"`
+	Store       StoreConfig       `yaml:"store" toml:"store"`
+	Database    DatabaseConfig    `yaml:"database" toml:"database"`
+	Session     SessionConfig     `yaml:"session" toml:"session"`
+	Reservation ReservationConfig `yaml:"reservation" toml:"reservation"`
+	Auth        AuthConfig        `yaml:"auth" toml:"auth"`
+	Account     AccountConfig     `yaml:"account" toml:"account"`
+	CacheBus    CacheBusConfig    `yaml:"cache_bus" toml:"cache_bus"`
+	Icon        IconConfig        `yaml:"icon" toml:"icon"`
+	DNS         DNSConfig         `yaml:"dns" toml:"dns"`
+	Tracing     TracingConfig     `yaml:"tracing" toml:"tracing"`
+	Log         LogConfig         `yaml:"log" toml:"log"`
+}
+
+type ListenConfig struct {
+	Port int `yaml:"port" toml:"port"`
+	// SIGTERMを受けてから処理中のリクエストを待つ時間
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" toml:"shutdown_timeout"`
+}
+
+type StoreConfig struct {
+	// mysql, memory (memoryはMySQLとPowerDNSなしでローカル開発するためのもの)
+	Backend string `yaml:"backend" toml:"backend"`
+	// 起動時に未適用のスキーマのマイグレーションを適用する (falseの場合はmigrateサブコマンドで適用する)
+	AutoMigrate bool `yaml:"auto_migrate" toml:"auto_migrate"`
+}
+
+type DatabaseConfig struct {
+	Net       string `yaml:"net" toml:"net"`
+	Address   string `yaml:"address" toml:"address"`
+	Port      int    `yaml:"port" toml:"port"`
+	User      string `yaml:"user" toml:"user"`
+	Password  string `yaml:"password" toml:"password"`
+	Name      string `yaml:"name" toml:"name"`
+	ParseTime bool   `yaml:"parse_time" toml:"parse_time"`
+	// 読み取り専用のハンドラが使うレプリカのDSN (user:password@tcp(host:3306)/isupipe の形式、省略可)
+	Replicas []string `yaml:"replicas" toml:"replicas"`
+	// レプリカの遅延がこれを超えたら、追いつくまでプライマリから読む
+	ReplicaMaxLag time.Duration `yaml:"replica_max_lag" toml:"replica_max_lag"`
+	// 書き込んだクライアントの読み取りを、この間プライマリに向ける
+	ReadYourWritesWindow time.Duration `yaml:"read_your_writes_window" toml:"read_your_writes_window"`
+}
+
+type SessionConfig struct {
+	Secret string `yaml:"secret" toml:"secret"`
+	// セッションCookieのドメイン (各ユーザのサブドメインで共有する)
+	CookieDomain string `yaml:"cookie_domain" toml:"cookie_domain"`
+}
+
+// ReservationConfig は配信を予約できる期間 [TermStart, TermEnd)
+type ReservationConfig struct {
+	TermStart time.Time `yaml:"term_start" toml:"term_start"`
+	TermEnd   time.Time `yaml:"term_end" toml:"term_end"`
+}
+
+type AuthConfig struct {
+	BcryptCost int `yaml:"bcrypt_cost" toml:"bcrypt_cost"`
+	// アクセストークンごとの1分あたりのリクエスト数の上限 (作成時に指定がなければこの値)
+	// インスタンスごとに数えるので、複数台で受ける場合は台数倍まで通る
+	TokenRateLimit int `yaml:"token_rate_limit" toml:"token_rate_limit"`
+	// アクセストークンの作成時に指定できる上限の最大値
+	TokenMaxRateLimit int `yaml:"token_max_rate_limit" toml:"token_max_rate_limit"`
+}
+
+type AccountConfig struct {
+	// 退会したユーザのライブコメント・リアクションの扱い (anonymize, delete)
+	DeletionPolicy string `yaml:"deletion_policy" toml:"deletion_policy"`
+	// 登録できないユーザ名 (pipeとゾーンファイルのレコード名に加える)
+	ReservedUsernames []string `yaml:"reserved_usernames" toml:"reserved_usernames"`
+	// staffサブコマンドでユーザを作るときのパスワード
+	StaffPassword string `yaml:"staff_password" toml:"staff_password"`
+}
+
+type CacheBusConfig struct {
+	// キャッシュの破棄を通知する他のインスタンス (host:port か http(s)://host:port)。空の場合は単一インスタンスとして動く
+	Peers []string `yaml:"peers" toml:"peers"`
+	// インスタンス間の通知に付ける共有トークン
+	Token string `yaml:"token" toml:"token"`
+	// 自分自身のアドレス (peersに含まれていれば除く)
+	SelfAddress string `yaml:"self_address" toml:"self_address"`
+}
+
+type IconConfig struct {
+	// mysql, fs, s3
+	Store string `yaml:"store" toml:"store"`
+	// fsの保存先
+	Dir string `yaml:"dir" toml:"dir"`
+	// アイコン画像のキャッシュの上限 (バイト)
+	CacheBytes int64 `yaml:"cache_bytes" toml:"cache_bytes"`
+	// アップロード時に画像を再エンコードし、EXIF (撮影位置など) のメタデータを除去する
+	// falseにするとアップロードされたバイト列をそのまま保存・配信し、icon_hashがそのSHA-256になるが、
+	// メタデータも公開されてしまう。バイト列の一致を検証するベンチマーカーを流す場合だけfalseにする
+	Reencode bool         `yaml:"reencode" toml:"reencode"`
+	S3       IconS3Config `yaml:"s3" toml:"s3"`
+}
+
+type IconS3Config struct {
+	Endpoint  string `yaml:"endpoint" toml:"endpoint"`
+	Bucket    string `yaml:"bucket" toml:"bucket"`
+	Prefix    string `yaml:"prefix" toml:"prefix"`
+	Region    string `yaml:"region" toml:"region"`
+	AccessKey string `yaml:"access_key" toml:"access_key"`
+	SecretKey string `yaml:"secret_key" toml:"secret_key"`
+	UseSSL    bool   `yaml:"use_ssl" toml:"use_ssl"`
+}
+
+type DNSConfig struct {
+	// ユーザのサブドメインのAレコードに登録するアドレス
+	SubdomainAddress string `yaml:"subdomain_address" toml:"subdomain_address"`
+	// powerdns-api, mysql, embedded, noop (空の場合は他の設定から決める)
+	Provisioner string `yaml:"provisioner" toml:"provisioner"`
+	// 静的なレコードを定義したゾーンファイル
+	ZoneFile  string             `yaml:"zone_file" toml:"zone_file"`
+	PowerDNS  PowerDNSConfig     `yaml:"powerdns" toml:"powerdns"`
+	Server    DNSServerConfig    `yaml:"server" toml:"server"`
+	Reconcile DNSReconcileConfig `yaml:"reconcile" toml:"reconcile"`
+	Outbox    DNSOutboxConfig    `yaml:"outbox" toml:"outbox"`
+}
+
+type PowerDNSConfig struct {
+	// trueの場合、provisionerの指定がなければレコードを登録しない
+	Disabled bool   `yaml:"disabled" toml:"disabled"`
+	APIURL   string `yaml:"api_url" toml:"api_url"`
+	APIKey   string `yaml:"api_key" toml:"api_key"`
+	// provisionerがmysqlの場合に書き込むgmysqlバックエンドのDB
+	MySQL PowerDNSMySQLConfig `yaml:"mysql" toml:"mysql"`
+}
+
+type PowerDNSMySQLConfig struct {
+	// host:port。空の場合はdatabase.addressの3306番
+	Address  string `yaml:"address" toml:"address"`
+	User     string `yaml:"user" toml:"user"`
+	Password string `yaml:"password" toml:"password"`
+	Database string `yaml:"database" toml:"database"`
+}
+
+type DNSServerConfig struct {
+	// 組み込みDNSサーバを起動する
+	Enabled bool   `yaml:"enabled" toml:"enabled"`
+	Addr    string `yaml:"addr" toml:"addr"`
+	// 送信元ごとのNXDOMAINの応答数の上限 (1秒あたり) とバースト
+	NXDomainRate  float64 `yaml:"nxdomain_rate" toml:"nxdomain_rate"`
+	NXDomainBurst int     `yaml:"nxdomain_burst" toml:"nxdomain_burst"`
+}
+
+type DNSReconcileConfig struct {
+	// usersテーブルとゾーンを突き合わせる間隔 (0の場合は定期実行しない)
+	Interval time.Duration `yaml:"interval" toml:"interval"`
+	// 差分を修正する (falseの場合はログに残すだけ)
+	Fix bool `yaml:"fix" toml:"fix"`
+}
+
+type DNSOutboxConfig struct {
+	// この回数失敗したエントリは再送をやめてデッドレターにする
+	MaxAttempts int64 `yaml:"max_attempts" toml:"max_attempts"`
+	// 再送の間隔の上限 (失敗するたびに倍にする)
+	MaxBackoff time.Duration `yaml:"max_backoff" toml:"max_backoff"`
+}
+
+type TracingConfig struct {
+	// none, otlp, stdout
+	Exporter string `yaml:"exporter" toml:"exporter"`
+	// OTLP/HTTPの送信先 (host:port)。空の場合はOTEL_EXPORTER_OTLP_ENDPOINTに従う
+	Endpoint string `yaml:"endpoint" toml:"endpoint"`
+	Insecure bool   `yaml:"insecure" toml:"insecure"`
+	// 記録するトレースの割合 (0から1)
+	SampleRatio float64 `yaml:"sample_ratio" toml:"sample_ratio"`
+}
+
+type LogConfig struct {
+	// debug, info, warn, error
+	Level string `yaml:"level" toml:"level"`
+}
+
+var appConfig = defaultConfig()
+
+func defaultConfig() *Config {
+	return &Config{
+		Listen: ListenConfig{
+			Port:            8080,
+			ShutdownTimeout: 10 * time.Second,
+		},
+		Store: StoreConfig{
+			Backend:     storeBackendMySQL,
+			AutoMigrate: true,
+		},
+		Database: DatabaseConfig{
+			Net:                  "tcp",
+			Address:              "127.0.0.1",
+			Port:                 3306,
+			User:                 "isucon",
+			Password:             "isucon",
+			Name:                 "isupipe",
+			ParseTime:            true,
+			ReplicaMaxLag:        time.Second,
+			ReadYourWritesWindow: 3 * time.Second,
+		},
+		Session: SessionConfig{
+			Secret:       "isucon13_session_cookiestore_defaultsecret",
+			CookieDomain: "u.isucon.dev",
+		},
+		Reservation: ReservationConfig{
+			// 2023/11/25 10:00 (JST) からの1年間
+			TermStart: time.Date(2023, 11, 25, 1, 0, 0, 0, time.UTC),
+			TermEnd:   time.Date(2024, 11, 25, 1, 0, 0, 0, time.UTC),
+		},
+		Auth: AuthConfig{
+			BcryptCost:        bcrypt.MinCost,
+			TokenRateLimit:    120,
+			TokenMaxRateLimit: 600,
+		},
+		Account: AccountConfig{
+			DeletionPolicy: accountDeletionPolicyAnonymize,
+		},
+		Icon: IconConfig{
+			Store:      iconStoreMySQL,
+			Dir:        "../icons",
+			CacheBytes: defaultIconCacheBytes,
+			Reencode:   true,
+			S3: IconS3Config{
+				UseSSL: true,
+			},
+		},
+		DNS: DNSConfig{
+			ZoneFile: "../pdns/u.isucon.dev.zone",
+			PowerDNS: PowerDNSConfig{
+				APIURL: "http://127.0.0.1:8081",
+				APIKey: "isudns",
+				MySQL: PowerDNSMySQLConfig{
+					User:     "isudns",
+					Password: "isudns",
+					Database: "isudns",
+				},
+			},
+			Server: DNSServerConfig{
+				Addr:          ":53",
+				NXDomainRate:  50,
+				NXDomainBurst: 100,
+			},
+			Outbox: DNSOutboxConfig{
+				MaxAttempts: 10,
+				MaxBackoff:  5 * time.Minute,
+			},
+		},
+		Tracing: TracingConfig{
+			Exporter:    tracingExporterNone,
+			SampleRatio: 1,
+		},
+		Log: LogConfig{
+			Level: "info",
+		},
+	}
+}
+
+// DatabaseAddr はhost:port形式のアドレス
+func (c *DatabaseConfig) DatabaseAddr() string {
+	return net.JoinHostPort(c.Address, strconv.Itoa(c.Port))
+}
+
+// loadConfig は設定ファイル、環境変数、フラグの順に読み込む (検証はValidateで行う)
+// printConfigは--print-configが指定されたかどうか
+func loadConfig(args []string) (cfg *Config, printConfig bool, err error) {
+	cfg = defaultConfig()
+
+	fs := flag.NewFlagSet("isupipe", flag.ContinueOnError)
+	configFile := fs.String("config", os.Getenv(configFileEnvKey), "path to the config file (.yaml, .yml or .toml)")
+	fs.BoolVar(&printConfig, "print-config", false, "print the effective config and exit")
+	// フラグの値は、設定ファイルと環境変数を反映した後に明示されたものだけ上書きする
+	flagValues := struct {
+		port             int
+		store            string
+		autoMigrate      bool
+		dbAddress        string
+		dbPort           int
+		dbUser           string
+		dbPassword       string
+		dbName           string
+		dbReplicas       string
+		cookieDomain     string
+		deletionPolicy   string
+		cachePeers       string
+		iconStore        string
+		dnsProvisioner   string
+		dnsServer        bool
+		dnsServerAddr    string
+		termStart        string
+		termEnd          string
+		bcryptCost       int
+		subdomainAddress string
+		tracingExporter  string
+		logLevel         string
+	}{}
+	fs.IntVar(&flagValues.port, "port", 0, "port to listen on")
+	fs.StringVar(&flagValues.store, "store", "", "storage backend (mysql or memory)")
+	fs.BoolVar(&flagValues.autoMigrate, "auto-migrate", false, "apply pending schema migrations on startup")
+	fs.StringVar(&flagValues.dbAddress, "db-address", "", "MySQL host")
+	fs.IntVar(&flagValues.dbPort, "db-port", 0, "MySQL port")
+	fs.StringVar(&flagValues.dbUser, "db-user", "", "MySQL user")
+	fs.StringVar(&flagValues.dbPassword, "db-password", "", "MySQL password")
+	fs.StringVar(&flagValues.dbName, "db-name", "", "MySQL database name")
+	fs.StringVar(&flagValues.dbReplicas, "db-replicas", "", "comma-separated DSNs of MySQL read replicas")
+	fs.StringVar(&flagValues.cookieDomain, "cookie-domain", "", "domain of the session cookie")
+	fs.StringVar(&flagValues.deletionPolicy, "account-deletion-policy", "", "how to treat posts of deleted users (anonymize or delete)")
+	fs.StringVar(&flagValues.cachePeers, "cache-peers", "", "comma-separated addresses of the other instances to notify of cache invalidations")
+	fs.StringVar(&flagValues.iconStore, "icon-store", "", "storage of icon images (mysql, fs or s3)")
+	fs.StringVar(&flagValues.dnsProvisioner, "dns-provisioner", "", "dns provisioner (powerdns-api, mysql, embedded or noop)")
+	fs.BoolVar(&flagValues.dnsServer, "dns-server", false, "start the embedded dns server")
+	fs.StringVar(&flagValues.dnsServerAddr, "dns-server-addr", "", "address of the embedded dns server")
+	fs.StringVar(&flagValues.termStart, "reservation-term-start", "", "start of the reservation term (RFC 3339)")
+	fs.StringVar(&flagValues.termEnd, "reservation-term-end", "", "end of the reservation term (RFC 3339)")
+	fs.IntVar(&flagValues.bcryptCost, "bcrypt-cost", 0, "bcrypt cost for password hashing")
+	fs.StringVar(&flagValues.subdomainAddress, "subdomain-address", "", "address of the A records for user subdomains")
+	fs.StringVar(&flagValues.tracingExporter, "tracing-exporter", "", "trace exporter (none, otlp or stdout)")
+	fs.StringVar(&flagValues.logLevel, "log-level", "", "log level (debug, info, warn or error)")
+	if err := fs.Parse(args); err != nil {
+		return nil, false, err
+	}
+
+	if *configFile != "" {
+		if err := cfg.loadFile(*configFile); err != nil {
+			return nil, false, err
+		}
+	}
+	if err := cfg.loadEnv(); err != nil {
+		return nil, false, err
+	}
+
+	var flagErr error
+	fs.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "port":
+			cfg.Listen.Port = flagValues.port
+		case "store":
+			cfg.Store.Backend = flagValues.store
+		case "auto-migrate":
+			cfg.Store.AutoMigrate = flagValues.autoMigrate
+		case "db-address":
+			cfg.Database.Address = flagValues.dbAddress
+		case "db-port":
+			cfg.Database.Port = flagValues.dbPort
+		case "db-user":
+			cfg.Database.User = flagValues.dbUser
+		case "db-password":
+			cfg.Database.Password = flagValues.dbPassword
+		case "db-name":
+			cfg.Database.Name = flagValues.dbName
+		case "db-replicas":
+			cfg.Database.Replicas = splitList(flagValues.dbReplicas)
+		case "cookie-domain":
+			cfg.Session.CookieDomain = flagValues.cookieDomain
+		case "account-deletion-policy":
+			cfg.Account.DeletionPolicy = flagValues.deletionPolicy
+		case "cache-peers":
+			cfg.CacheBus.Peers = splitList(flagValues.cachePeers)
+		case "icon-store":
+			cfg.Icon.Store = flagValues.iconStore
+		case "dns-provisioner":
+			cfg.DNS.Provisioner = flagValues.dnsProvisioner
+		case "dns-server":
+			cfg.DNS.Server.Enabled = flagValues.dnsServer
+		case "dns-server-addr":
+			cfg.DNS.Server.Addr = flagValues.dnsServerAddr
+		case "reservation-term-start":
+			t, err := time.Parse(time.RFC3339, flagValues.termStart)
+			if err != nil {
+				flagErr = errors.Join(flagErr, fmt.Errorf("failed to parse flag -%s as RFC 3339 time: %+v", f.Name, err))
+			}
+			cfg.Reservation.TermStart = t
+		case "reservation-term-end":
+			t, err := time.Parse(time.RFC3339, flagValues.termEnd)
+			if err != nil {
+				flagErr = errors.Join(flagErr, fmt.Errorf("failed to parse flag -%s as RFC 3339 time: %+v", f.Name, err))
+			}
+			cfg.Reservation.TermEnd = t
+		case "bcrypt-cost":
+			cfg.Auth.BcryptCost = flagValues.bcryptCost
+		case "subdomain-address":
+			cfg.DNS.SubdomainAddress = flagValues.subdomainAddress
+		case "tracing-exporter":
+			cfg.Tracing.Exporter = flagValues.tracingExporter
+		case "log-level":
+			cfg.Log.Level = flagValues.logLevel
+		}
+	})
+	if flagErr != nil {
+		return nil, false, flagErr
+	}
+	return cfg, printConfig, nil
+}
+
+// loadFile は拡張子に応じてYAMLかTOMLとして読み込む
+func (c *Config) loadFile(path string) error {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		dec := yaml.NewDecoder(bytes.NewReader(raw))
+		// 設定項目の書き間違いに気づけるよう、未知のキーはエラーにする
+		dec.KnownFields(true)
+		if err := dec.Decode(c); err != nil && !errors.Is(err, io.EOF) {
+			return fmt.Errorf("failed to parse config file %s as yaml: %w", path, err)
+		}
+	case ".toml":
+		md, err := toml.Decode(string(raw), c)
+		if err != nil {
+			return fmt.Errorf("failed to parse config file %s as toml: %w", path, err)
+		}
+		if undecoded := md.Undecoded(); len(undecoded) > 0 {
+			return fmt.Errorf("unknown keys in config file %s: %v", path, undecoded)
+		}
+	default:
+		return fmt.Errorf("unsupported config file extension '%s' (.yaml, .yml or .toml)", filepath.Ext(path))
+	}
+	return nil
+}
+
+// loadEnv は環境変数で上書きする
+func (c *Config) loadEnv() error {
+	var errs []error
+	lookupString := func(key string, dst *string) {
+		if v, ok := os.LookupEnv(key); ok {
+			*dst = v
+		}
+	}
+	lookupInt := func(key string, dst *int) {
+		if v, ok := os.LookupEnv(key); ok {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to parse environment variable '%s' as int: %+v", key, err))
+				return
+			}
+			*dst = n
+		}
+	}
+	lookupBool := func(key string, dst *bool) {
+		if v, ok := os.LookupEnv(key); ok {
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to parse environment variable '%s' as bool: %+v", key, err))
+				return
+			}
+			*dst = b
+		}
+	}
+	lookupFloat := func(key string, dst *float64) {
+		if v, ok := os.LookupEnv(key); ok {
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to parse environment variable '%s' as float: %+v", key, err))
+				return
+			}
+			*dst = f
+		}
+	}
+	lookupInt64 := func(key string, dst *int64) {
+		if v, ok := os.LookupEnv(key); ok {
+			n, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to parse environment variable '%s' as int: %+v", key, err))
+				return
+			}
+			*dst = n
+		}
+	}
+	lookupList := func(key string, dst *[]string) {
+		if v, ok := os.LookupEnv(key); ok {
+			*dst = splitList(v)
+		}
+	}
+	lookupDuration := func(key string, dst *time.Duration) {
+		if v, ok := os.LookupEnv(key); ok {
+			d, err := time.ParseDuration(v)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to parse environment variable '%s' as duration: %+v", key, err))
+				return
+			}
+			*dst = d
+		}
+	}
+	lookupTime := func(key string, dst *time.Time) {
+		if v, ok := os.LookupEnv(key); ok {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to parse environment variable '%s' as RFC 3339 time: %+v", key, err))
+				return
+			}
+			*dst = t
+		}
+	}
+
+	lookupInt("ISUCON13_LISTEN_PORT", &c.Listen.Port)
+	lookupDuration("ISUCON13_SHUTDOWN_TIMEOUT", &c.Listen.ShutdownTimeout)
+
+	lookupString("ISUCON13_STORE", &c.Store.Backend)
+	lookupBool("ISUCON13_STORE_AUTO_MIGRATE", &c.Store.AutoMigrate)
+
+	lookupString("ISUCON13_MYSQL_DIALCONFIG_NET", &c.Database.Net)
+	lookupString("ISUCON13_MYSQL_DIALCONFIG_ADDRESS", &c.Database.Address)
+	lookupInt("ISUCON13_MYSQL_DIALCONFIG_PORT", &c.Database.Port)
+	lookupString("ISUCON13_MYSQL_DIALCONFIG_USER", &c.Database.User)
+	lookupString("ISUCON13_MYSQL_DIALCONFIG_PASSWORD", &c.Database.Password)
+	lookupString("ISUCON13_MYSQL_DIALCONFIG_DATABASE", &c.Database.Name)
+	lookupBool("ISUCON13_MYSQL_DIALCONFIG_PARSETIME", &c.Database.ParseTime)
+	lookupList("ISUCON13_MYSQL_REPLICA_DSNS", &c.Database.Replicas)
+	lookupDuration("ISUCON13_MYSQL_REPLICA_MAX_LAG", &c.Database.ReplicaMaxLag)
+	lookupDuration("ISUCON13_MYSQL_READ_YOUR_WRITES_WINDOW", &c.Database.ReadYourWritesWindow)
+
+	lookupString("ISUCON13_SESSION_SECRETKEY", &c.Session.Secret)
+	lookupString("ISUCON13_SESSION_COOKIE_DOMAIN", &c.Session.CookieDomain)
+
+	lookupTime("ISUCON13_RESERVATION_TERM_START", &c.Reservation.TermStart)
+	lookupTime("ISUCON13_RESERVATION_TERM_END", &c.Reservation.TermEnd)
+
+	lookupInt("ISUCON13_BCRYPT_COST", &c.Auth.BcryptCost)
+	lookupInt("ISUCON13_TOKEN_RATE_LIMIT", &c.Auth.TokenRateLimit)
+	lookupInt("ISUCON13_TOKEN_MAX_RATE_LIMIT", &c.Auth.TokenMaxRateLimit)
+
+	lookupString(accountDeletionPolicyEnvKey, &c.Account.DeletionPolicy)
+	lookupList(reservedUsernamesEnvKey, &c.Account.ReservedUsernames)
+	lookupString(staffPasswordEnvKey, &c.Account.StaffPassword)
+
+	lookupList(cacheBusPeersEnvKey, &c.CacheBus.Peers)
+	lookupString(cacheBusTokenEnvKey, &c.CacheBus.Token)
+	lookupString(cacheBusSelfAddressEnvKey, &c.CacheBus.SelfAddress)
+
+	lookupString("ISUCON13_ICON_STORE", &c.Icon.Store)
+	lookupString("ISUCON13_ICON_STORE_DIR", &c.Icon.Dir)
+	lookupInt64("ISUCON13_ICON_CACHE_BYTES", &c.Icon.CacheBytes)
+	lookupBool("ISUCON13_ICON_REENCODE", &c.Icon.Reencode)
+	lookupString("ISUCON13_ICON_S3_ENDPOINT", &c.Icon.S3.Endpoint)
+	lookupString("ISUCON13_ICON_S3_BUCKET", &c.Icon.S3.Bucket)
+	lookupString("ISUCON13_ICON_S3_PREFIX", &c.Icon.S3.Prefix)
+	lookupString("ISUCON13_ICON_S3_REGION", &c.Icon.S3.Region)
+	lookupString("ISUCON13_ICON_S3_ACCESS_KEY", &c.Icon.S3.AccessKey)
+	lookupString("ISUCON13_ICON_S3_SECRET_KEY", &c.Icon.S3.SecretKey)
+	lookupBool("ISUCON13_ICON_S3_USE_SSL", &c.Icon.S3.UseSSL)
+
+	lookupString(powerDNSSubdomainAddressEnvKey, &c.DNS.SubdomainAddress)
+	lookupString("ISUCON13_DNS_PROVISIONER", &c.DNS.Provisioner)
+	lookupString(dnsZoneFileEnvKey, &c.DNS.ZoneFile)
+	lookupBool("ISUCON13_POWERDNS_DISABLED", &c.DNS.PowerDNS.Disabled)
+	// ISUCON13_POWERDNS_HOSTはAPIのホストだけを指定する (ISUCON13_POWERDNS_API_URLが優先)
+	if v, ok := os.LookupEnv("ISUCON13_POWERDNS_HOST"); ok {
+		c.DNS.PowerDNS.APIURL = "http://" + net.JoinHostPort(v, "8081")
+	}
+	lookupString("ISUCON13_POWERDNS_API_URL", &c.DNS.PowerDNS.APIURL)
+	lookupString("ISUCON13_POWERDNS_API_KEY", &c.DNS.PowerDNS.APIKey)
+	lookupString("ISUCON13_POWERDNS_MYSQL_ADDRESS", &c.DNS.PowerDNS.MySQL.Address)
+	lookupString("ISUCON13_POWERDNS_MYSQL_USER", &c.DNS.PowerDNS.MySQL.User)
+	lookupString("ISUCON13_POWERDNS_MYSQL_PASSWORD", &c.DNS.PowerDNS.MySQL.Password)
+	lookupString("ISUCON13_POWERDNS_MYSQL_DATABASE", &c.DNS.PowerDNS.MySQL.Database)
+	lookupBool(dnsServerEnabledEnvKey, &c.DNS.Server.Enabled)
+	lookupString(dnsServerAddrEnvKey, &c.DNS.Server.Addr)
+	lookupFloat(dnsServerNXRateEnvKey, &c.DNS.Server.NXDomainRate)
+	lookupInt(dnsServerNXBurstEnvKey, &c.DNS.Server.NXDomainBurst)
+	lookupDuration(dnsReconcileIntervalEnvKey, &c.DNS.Reconcile.Interval)
+	lookupBool(dnsReconcileFixEnvKey, &c.DNS.Reconcile.Fix)
+	lookupInt64("ISUCON13_DNS_OUTBOX_MAX_ATTEMPTS", &c.DNS.Outbox.MaxAttempts)
+	lookupDuration("ISUCON13_DNS_OUTBOX_MAX_BACKOFF", &c.DNS.Outbox.MaxBackoff)
+
+	lookupString("ISUCON13_TRACING_EXPORTER", &c.Tracing.Exporter)
+	lookupString("ISUCON13_TRACING_OTLP_ENDPOINT", &c.Tracing.Endpoint)
+	lookupBool("ISUCON13_TRACING_OTLP_INSECURE", &c.Tracing.Insecure)
+	lookupFloat("ISUCON13_TRACING_SAMPLE_RATIO", &c.Tracing.SampleRatio)
+
+	lookupString("ISUCON13_LOG_LEVEL", &c.Log.Level)
+
+	return errors.Join(errs...)
+}
+
+// Validate は設定値を検証する (問題はまとめて返す)
+func (c *Config) Validate() error {
+	var errs []error
+	if c.Listen.Port < 1 || c.Listen.Port > 65535 {
+		errs = append(errs, fmt.Errorf("listen.port must be between 1 and 65535 (got %d)", c.Listen.Port))
+	}
+	if c.Listen.ShutdownTimeout <= 0 {
+		errs = append(errs, fmt.Errorf("listen.shutdown_timeout must be positive (got %s)", c.Listen.ShutdownTimeout))
+	}
+
+	switch c.Store.Backend {
+	case storeBackendMySQL, storeBackendMemory:
+	default:
+		errs = append(errs, fmt.Errorf("store.backend must be mysql or memory (got '%s')", c.Store.Backend))
+	}
+
+	if c.Database.Net != "tcp" && c.Database.Net != "unix" {
+		errs = append(errs, fmt.Errorf("database.net must be tcp or unix (got '%s')", c.Database.Net))
+	}
+	if c.Database.Address == "" {
+		errs = append(errs, errors.New("database.address must not be empty"))
+	}
+	if c.Database.Net == "tcp" && (c.Database.Port < 1 || c.Database.Port > 65535) {
+		errs = append(errs, fmt.Errorf("database.port must be between 1 and 65535 (got %d)", c.Database.Port))
+	}
+	if c.Database.User == "" {
+		errs = append(errs, errors.New("database.user must not be empty"))
+	}
+	if c.Database.Name == "" {
+		errs = append(errs, errors.New("database.name must not be empty"))
+	}
+	for i, dsn := range c.Database.Replicas {
+		if _, err := mysql.ParseDSN(dsn); err != nil {
+			errs = append(errs, fmt.Errorf("database.replicas[%d] is not a valid dsn: %w", i, err))
+		}
+	}
+	if c.Database.ReplicaMaxLag < 0 {
+		errs = append(errs, fmt.Errorf("database.replica_max_lag must not be negative (got %s)", c.Database.ReplicaMaxLag))
+	}
+	if c.Database.ReadYourWritesWindow < 0 {
+		errs = append(errs, fmt.Errorf("database.read_your_writes_window must not be negative (got %s)", c.Database.ReadYourWritesWindow))
+	}
+
+	if c.Session.Secret == "" {
+		errs = append(errs, errors.New("session.secret must not be empty"))
+	}
+	if c.Session.CookieDomain == "" {
+		errs = append(errs, errors.New("session.cookie_domain must not be empty"))
+	}
+
+	if c.Reservation.TermStart.IsZero() || c.Reservation.TermEnd.IsZero() {
+		errs = append(errs, errors.New("reservation.term_start and reservation.term_end must be set"))
+	} else if !c.Reservation.TermStart.Before(c.Reservation.TermEnd) {
+		errs = append(errs, fmt.Errorf("reservation.term_start (%s) must be before reservation.term_end (%s)", c.Reservation.TermStart.Format(time.RFC3339), c.Reservation.TermEnd.Format(time.RFC3339)))
+	}
+
+	if c.Auth.BcryptCost < bcrypt.MinCost || c.Auth.BcryptCost > bcrypt.MaxCost {
+		errs = append(errs, fmt.Errorf("auth.bcrypt_cost must be between %d and %d (got %d)", bcrypt.MinCost, bcrypt.MaxCost, c.Auth.BcryptCost))
+	}
+	if c.Auth.TokenRateLimit < 1 || c.Auth.TokenRateLimit > c.Auth.TokenMaxRateLimit {
+		errs = append(errs, fmt.Errorf("auth.token_rate_limit must be between 1 and auth.token_max_rate_limit (%d) (got %d)", c.Auth.TokenMaxRateLimit, c.Auth.TokenRateLimit))
+	}
+
+	switch c.Account.DeletionPolicy {
+	case accountDeletionPolicyAnonymize, accountDeletionPolicyDelete:
+	default:
+		errs = append(errs, fmt.Errorf("account.deletion_policy must be anonymize or delete (got '%s')", c.Account.DeletionPolicy))
+	}
+
+	if len(c.CacheBus.Peers) > 0 && c.CacheBus.Token == "" {
+		errs = append(errs, fmt.Errorf("cache_bus.token must be provided when cache_bus.peers is set (or environ %s)", cacheBusTokenEnvKey))
+	}
+	for _, peer := range c.CacheBus.Peers {
+		if _, err := cacheBusPeerURL(peer); err != nil {
+			errs = append(errs, fmt.Errorf("cache_bus.peers: %w", err))
+		}
+	}
+	if c.CacheBus.SelfAddress != "" {
+		if _, err := cacheBusPeerURL(c.CacheBus.SelfAddress); err != nil {
+			errs = append(errs, fmt.Errorf("cache_bus.self_address: %w", err))
+		}
+	}
+
+	switch c.Icon.Store {
+	case iconStoreMySQL:
+	case iconStoreFilesystem:
+		if c.Icon.Dir == "" {
+			errs = append(errs, errors.New("icon.dir must not be empty when icon.store is fs"))
+		}
+	case iconStoreS3:
+		if c.Icon.S3.Endpoint == "" || c.Icon.S3.Bucket == "" {
+			errs = append(errs, errors.New("icon.s3.endpoint and icon.s3.bucket must be set when icon.store is s3"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("icon.store must be mysql, fs or s3 (got '%s')", c.Icon.Store))
+	}
+	if c.Icon.CacheBytes < 0 {
+		errs = append(errs, fmt.Errorf("icon.cache_bytes must not be negative (got %d)", c.Icon.CacheBytes))
+	}
+
+	// インメモリのストアではPowerDNSを使わないので、サブドメインのアドレスは任意
+	if c.DNS.SubdomainAddress == "" {
+		if c.Store.Backend != storeBackendMemory {
+			errs = append(errs, fmt.Errorf("dns.subdomain_address must be provided (or environ %s)", powerDNSSubdomainAddressEnvKey))
+		}
+	} else if net.ParseIP(c.DNS.SubdomainAddress) == nil {
+		errs = append(errs, fmt.Errorf("dns.subdomain_address must be an IP address (got '%s')", c.DNS.SubdomainAddress))
+	}
+
+	switch c.DNS.Provisioner {
+	case "", dnsProvisionerPowerDNSAPI, dnsProvisionerMySQL, dnsProvisionerNoop:
+	case dnsProvisionerEmbedded:
+		if !c.DNS.Server.Enabled {
+			errs = append(errs, errors.New("dns.provisioner embedded requires dns.server.enabled"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("dns.provisioner must be powerdns-api, mysql, embedded or noop (got '%s')", c.DNS.Provisioner))
+	}
+	if c.DNS.ZoneFile == "" {
+		errs = append(errs, errors.New("dns.zone_file must not be empty"))
+	}
+	if c.DNS.Server.Enabled {
+		if c.DNS.Server.Addr == "" {
+			errs = append(errs, errors.New("dns.server.addr must not be empty"))
+		}
+		if c.DNS.Server.NXDomainRate <= 0 || c.DNS.Server.NXDomainBurst < 1 {
+			errs = append(errs, fmt.Errorf("dns.server.nxdomain_rate and dns.server.nxdomain_burst must be positive (got %g, %d)", c.DNS.Server.NXDomainRate, c.DNS.Server.NXDomainBurst))
+		}
+	}
+	if c.DNS.Reconcile.Interval < 0 {
+		errs = append(errs, fmt.Errorf("dns.reconcile.interval must not be negative (got %s)", c.DNS.Reconcile.Interval))
+	}
+	if c.DNS.Outbox.MaxAttempts < 1 {
+		errs = append(errs, fmt.Errorf("dns.outbox.max_attempts must be positive (got %d)", c.DNS.Outbox.MaxAttempts))
+	}
+	if c.DNS.Outbox.MaxBackoff < dnsOutboxRetryInterval {
+		errs = append(errs, fmt.Errorf("dns.outbox.max_backoff must be at least %s (got %s)", dnsOutboxRetryInterval, c.DNS.Outbox.MaxBackoff))
+	}
+
+	switch c.Tracing.Exporter {
+	case tracingExporterNone, tracingExporterOTLP, tracingExporterStdout:
+	default:
+		errs = append(errs, fmt.Errorf("tracing.exporter must be none, otlp or stdout (got '%s')", c.Tracing.Exporter))
+	}
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		errs = append(errs, fmt.Errorf("tracing.sample_ratio must be between 0 and 1 (got %g)", c.Tracing.SampleRatio))
+	}
+
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(c.Log.Level)); err != nil {
+		errs = append(errs, fmt.Errorf("log.level must be debug, info, warn or error (got '%s')", c.Log.Level))
+	}
+
+	return errors.Join(errs...)
+}
+
+// Print は秘密情報を伏せてYAMLで出力する
+func (c *Config) Print(w io.Writer) error {
+	redacted := *c
+	if redacted.Database.Password != "" {
+		redacted.Database.Password = redactedConfigValue
+	}
+	if redacted.Session.Secret != "" {
+		redacted.Session.Secret = redactedConfigValue
+	}
+	for _, secret := range []*string{
+		&redacted.Account.StaffPassword,
+		&redacted.CacheBus.Token,
+		&redacted.Icon.S3.SecretKey,
+		&redacted.DNS.PowerDNS.APIKey,
+		&redacted.DNS.PowerDNS.MySQL.Password,
+	} {
+		if *secret != "" {
+			*secret = redactedConfigValue
+		}
+	}
+	if len(c.Database.Replicas) > 0 {
+		redacted.Database.Replicas = make([]string, len(c.Database.Replicas))
+		for i, dsn := range c.Database.Replicas {
+			redacted.Database.Replicas[i] = redactDSN(dsn)
+		}
+	}
+
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(&redacted); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// splitList はカンマ区切りの値を分割する (空の要素は除く)
+func splitList(v string) []string {
+	var list []string
+	for _, item := range strings.Split(v, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// redactDSN はDSNのパスワードを伏せる
+func redactDSN(dsn string) string {
+	conf, err := mysql.ParseDSN(dsn)
+	if err != nil {
+		// 不正なDSNはパスワードの位置がわからないので、まるごと伏せる
+		return redactedConfigValue
+	}
+	if conf.Passwd != "" {
+		conf.Passwd = redactedConfigValue
+	}
+	return conf.FormatDSN()
+}
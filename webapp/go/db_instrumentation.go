@@ -0,0 +1,218 @@
+package main
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/puzpuzpuz/xsync/v3"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentedConnector はコネクションを包んで、SQLの実行時間をステートメント名ごとに記録し、スパンを作る
+// sqlxの呼び出し箇所を変えずに全クエリを計測するため、ドライバの層で計測する
+type instrumentedConnector struct {
+	driver.Connector
+}
+
+func (c instrumentedConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.Connector.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &instrumentedConn{Conn: conn}, nil
+}
+
+// instrumentedConn はgo-sql-driver/mysqlのコネクションが実装しているインタフェースをそのまま委譲する
+type instrumentedConn struct {
+	driver.Conn
+}
+
+func (c *instrumentedConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	stmt, err := c.Conn.(driver.ConnPrepareContext).PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return &instrumentedStmt{Stmt: stmt, name: statementName(query), query: query}, nil
+}
+
+func (c *instrumentedConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return c.Conn.(driver.ConnBeginTx).BeginTx(ctx, opts)
+}
+
+func (c *instrumentedConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	start := time.Now()
+	rows, err := c.Conn.(driver.QueryerContext).QueryContext(ctx, query, args)
+	// ErrSkipの場合はPrepareしてから実行されるので、そちらで記録する
+	if !errors.Is(err, driver.ErrSkip) {
+		observeQuery(ctx, statementName(query), query, start, err)
+	}
+	return rows, err
+}
+
+func (c *instrumentedConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	start := time.Now()
+	result, err := c.Conn.(driver.ExecerContext).ExecContext(ctx, query, args)
+	if !errors.Is(err, driver.ErrSkip) {
+		observeQuery(ctx, statementName(query), query, start, err)
+	}
+	return result, err
+}
+
+func (c *instrumentedConn) Ping(ctx context.Context) error {
+	return c.Conn.(driver.Pinger).Ping(ctx)
+}
+
+func (c *instrumentedConn) ResetSession(ctx context.Context) error {
+	return c.Conn.(driver.SessionResetter).ResetSession(ctx)
+}
+
+func (c *instrumentedConn) IsValid() bool {
+	return c.Conn.(driver.Validator).IsValid()
+}
+
+func (c *instrumentedConn) CheckNamedValue(nv *driver.NamedValue) error {
+	return c.Conn.(driver.NamedValueChecker).CheckNamedValue(nv)
+}
+
+type instrumentedStmt struct {
+	driver.Stmt
+	name  string
+	query string
+}
+
+func (s *instrumentedStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	start := time.Now()
+	result, err := s.Stmt.(driver.StmtExecContext).ExecContext(ctx, args)
+	observeQuery(ctx, s.name, s.query, start, err)
+	return result, err
+}
+
+func (s *instrumentedStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	start := time.Now()
+	rows, err := s.Stmt.(driver.StmtQueryContext).QueryContext(ctx, args)
+	observeQuery(ctx, s.name, s.query, start, err)
+	return rows, err
+}
+
+func (s *instrumentedStmt) CheckNamedValue(nv *driver.NamedValue) error {
+	return s.Stmt.(driver.NamedValueChecker).CheckNamedValue(nv)
+}
+
+// observeQuery は実行時間をメトリクスに記録し、リクエストのスパンの子としてスパンを作る
+// ErrSkipで実行されなかった呼び出しを除くため、スパンは実行後に開始時刻を指定して作る
+func observeQuery(ctx context.Context, name string, query string, start time.Time, err error) {
+	end := time.Now()
+	dbQueryDuration.WithLabelValues(name).Observe(end.Sub(start).Seconds())
+
+	_, span := tracer.Start(ctx, "sql "+name,
+		trace.WithTimestamp(start),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemMySQL,
+			semconv.DBStatement(query),
+		),
+	)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End(trace.WithTimestamp(end))
+}
+
+// statementNameCacheの上限 (超えたらキャッシュせずに毎回求める)
+const statementNameCacheMaxEntries = 1024
+
+// statementCacheKey(query) -> ステートメント名
+var statementNameCache = xsync.NewMapOf[string, string]()
+
+// statementName はSQLを "select_livestreams" のような動詞とテーブル名の組にする
+// sqlx.InでIN句の長さが変わってもラベルが増えないよう、引数は見ない
+func statementName(query string) string {
+	key := statementCacheKey(query)
+	if name, ok := statementNameCache.Load(key); ok {
+		return name
+	}
+
+	fields := strings.Fields(strings.ToLower(query))
+	name := "unknown"
+	if len(fields) > 0 {
+		verb := fields[0]
+		var keyword string
+		switch verb {
+		case "select", "delete":
+			keyword = "from"
+		case "insert", "replace":
+			keyword = "into"
+		case "update":
+			keyword = "update"
+		}
+		name = verb
+		if table := tableAfter(fields, keyword); table != "" {
+			name += "_" + table
+		}
+	}
+
+	if statementNameCache.Size() < statementNameCacheMaxEntries {
+		statementNameCache.Store(key, name)
+	}
+	return name
+}
+
+// statementCacheKey はプレースホルダの数と数値だけが違うSQLを同じキーにする
+// IN (?, ?, ...) や複数行のVALUES (?, ?), (?, ?) の長さ、withLimitで埋め込んだLIMITの値でキャッシュが増えないようにする
+func statementCacheKey(query string) string {
+	var b strings.Builder
+	b.Grow(len(query))
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case c == '?':
+			b.WriteByte('?')
+			for i+1 < len(query) && strings.IndexByte("?,() \t\n", query[i+1]) >= 0 {
+				i++
+			}
+		case isDigit(c) && (i == 0 || !isIdentByte(query[i-1])):
+			// 識別子の一部 (t1など) は残す
+			b.WriteByte('0')
+			for i+1 < len(query) && isDigit(query[i+1]) {
+				i++
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdentByte(c byte) bool {
+	return isDigit(c) || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_' || c == '$'
+}
+
+func tableAfter(fields []string, keyword string) string {
+	if keyword == "" {
+		return ""
+	}
+	for i, f := range fields {
+		if f != keyword || i+1 >= len(fields) {
+			continue
+		}
+		table := strings.Trim(fields[i+1], "`;")
+		if i := strings.IndexByte(table, '('); i >= 0 {
+			table = table[:i]
+		}
+		if table == "" {
+			return "subquery"
+		}
+		return table
+	}
+	return ""
+}
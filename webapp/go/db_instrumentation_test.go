@@ -0,0 +1,51 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestStatementName(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{"SELECT * FROM users WHERE id = ?", "select_users"},
+		{"SELECT * FROM `livestreams` WHERE id IN (?, ?, ?)", "select_livestreams"},
+		{"INSERT INTO reactions (user_id, livestream_id) VALUES (?, ?), (?, ?)", "insert_reactions"},
+		{"UPDATE dns_outbox SET locked_until = ? WHERE id = ?", "update_dns_outbox"},
+		{"DELETE FROM t1 WHERE id = ?", "delete_t1"},
+		{"DELETE FROM t2 WHERE id = ?", "delete_t2"},
+		{"SHOW TABLES", "show"},
+	}
+	for _, tt := range tests {
+		if got := statementName(tt.query); got != tt.want {
+			t.Errorf("statementName(%q) = %s, want %s", tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestStatementNameCacheIsBounded(t *testing.T) {
+	statementNameCache.Clear()
+	t.Cleanup(statementNameCache.Clear)
+
+	// IN句の長さとLIMITの値だけが違うクエリは1つのキーにまとめる
+	for n := 1; n <= 100; n++ {
+		placeholders := strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+		statementName(fmt.Sprintf("SELECT * FROM users WHERE id IN (%s) ORDER BY id LIMIT %d", placeholders, n))
+		rows := strings.TrimSuffix(strings.Repeat("(?, ?), ", n), ", ")
+		statementName("INSERT INTO reactions (user_id, livestream_id) VALUES " + rows)
+	}
+	if size := statementNameCache.Size(); size != 2 {
+		t.Errorf("cache size = %d, want 2", size)
+	}
+
+	// まとめられないクエリが増えても上限を超えない
+	for n := 0; n < statementNameCacheMaxEntries*2; n++ {
+		statementName(fmt.Sprintf("SELECT * FROM users WHERE name = 'user%d'", n))
+	}
+	if size := statementNameCache.Size(); size > statementNameCacheMaxEntries {
+		t.Errorf("cache size = %d, must not exceed %d", size, statementNameCacheMaxEntries)
+	}
+}
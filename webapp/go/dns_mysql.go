@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jmoiron/sqlx"
+)
+
+// mySQLDNSProvisioner はPowerDNSのgmysqlバックエンド (isudnsスキーマ) に直接レコードを書き込む
+type mySQLDNSProvisioner struct {
+	db      *sqlx.DB
+	zone    string
+	address string
+}
+
+func newMySQLDNSProvisioner(cfg PowerDNSMySQLConfig, address string) (*mySQLDNSProvisioner, error) {
+	conf := mysql.NewConfig()
+	conf.Net = "tcp"
+	conf.Addr = cfg.Address
+	if conf.Addr == "" {
+		conf.Addr = net.JoinHostPort(appConfig.Database.Address, "3306")
+	}
+	conf.User = cfg.User
+	conf.Passwd = cfg.Password
+	conf.DBName = cfg.Database
+
+	db, err := sqlx.Open("mysql", conf.FormatDSN())
+	if err != nil {
+		return nil, err
+	}
+	db.SetMaxOpenConns(5)
+
+	return &mySQLDNSProvisioner{
+		db:      db,
+		zone:    dnsZone,
+		address: address,
+	}, nil
+}
+
+func (p *mySQLDNSProvisioner) domainID(ctx context.Context, tx *sqlx.Tx) (int64, error) {
+	var id int64
+	if err := tx.GetContext(ctx, &id, "SELECT id FROM domains WHERE name = ?", p.zone); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (p *mySQLDNSProvisioner) AddRecord(ctx context.Context, name string) error {
+	tx, err := p.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	domainID, err := p.domainID(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	fqdn := name + "." + p.zone
+	var recordID int64
+	err = tx.GetContext(ctx, &recordID, "SELECT id FROM records WHERE domain_id = ? AND name = ? AND type = 'A' LIMIT 1", domainID, fqdn)
+	switch {
+	case err == nil:
+		if _, err := tx.ExecContext(ctx, "UPDATE records SET content = ?, disabled = 0 WHERE id = ?", p.address, recordID); err != nil {
+			return err
+		}
+	case errors.Is(err, sql.ErrNoRows):
+		if _, err := tx.ExecContext(ctx, "INSERT INTO records (domain_id, name, type, content, ttl, prio, disabled, auth) VALUES (?, ?, 'A', ?, 0, 0, 0, 1)", domainID, fqdn, p.address); err != nil {
+			return err
+		}
+	default:
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (p *mySQLDNSProvisioner) DeleteRecord(ctx context.Context, name string) error {
+	tx, err := p.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	domainID, err := p.domainID(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM records WHERE domain_id = ? AND name = ? AND type = 'A'", domainID, name+"."+p.zone); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
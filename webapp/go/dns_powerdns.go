@@ -0,0 +1,120 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// powerDNSAPIProvisioner はPowerDNSのHTTP API経由でレコードを登録する
+// https://doc.powerdns.com/authoritative/http-api/zone.html#patch--servers-server_id-zones-zone_id
+type powerDNSAPIProvisioner struct {
+	baseURL  string
+	apiKey   string
+	serverID string
+	zone     string
+	address  string
+	client   *http.Client
+}
+
+type powerDNSRRSet struct {
+	Name       string           `json:"name"`
+	Type       string           `json:"type"`
+	TTL        int              `json:"ttl,omitempty"`
+	ChangeType string           `json:"changetype"`
+	Records    []powerDNSRecord `json:"records,omitempty"`
+}
+
+type powerDNSRecord struct {
+	Content  string `json:"content"`
+	Disabled bool   `json:"disabled"`
+}
+
+func newPowerDNSAPIProvisioner(cfg PowerDNSConfig, address string) *powerDNSAPIProvisioner {
+	return &powerDNSAPIProvisioner{
+		baseURL:  strings.TrimSuffix(cfg.APIURL, "/"),
+		apiKey:   cfg.APIKey,
+		serverID: "localhost",
+		zone:     dnsZone,
+		address:  address,
+		client: &http.Client{
+			Timeout: 5 * time.Second,
+		},
+	}
+}
+
+func (p *powerDNSAPIProvisioner) AddRecord(ctx context.Context, name string) error {
+	return p.patch(ctx, powerDNSRRSet{
+		Name:       name + "." + p.zone + ".",
+		Type:       "A",
+		TTL:        0,
+		ChangeType: "REPLACE",
+		Records: []powerDNSRecord{
+			{Content: p.address},
+		},
+	})
+}
+
+func (p *powerDNSAPIProvisioner) DeleteRecord(ctx context.Context, name string) error {
+	return p.patch(ctx, powerDNSRRSet{
+		Name:       name + "." + p.zone + ".",
+		Type:       "A",
+		ChangeType: "DELETE",
+	})
+}
+
+func (p *powerDNSAPIProvisioner) get(ctx context.Context, endpoint string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-API-Key", p.apiKey)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("powerdns api returned status %d: %s", resp.StatusCode, string(msg))
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+func (p *powerDNSAPIProvisioner) patch(ctx context.Context, rrset powerDNSRRSet) error {
+	body, err := json.Marshal(map[string][]powerDNSRRSet{
+		"rrsets": {rrset},
+	})
+	if err != nil {
+		return err
+	}
+
+	endpoint := fmt.Sprintf("%s/api/v1/servers/%s/zones/%s.", p.baseURL, p.serverID, p.zone)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set("X-API-Key", p.apiKey)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("powerdns api returned status %d: %s", resp.StatusCode, string(msg))
+	}
+	return nil
+}
@@ -0,0 +1,218 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// ユーザ名をラベルとしたAレコードを登録するゾーン
+	dnsZone = "u.isucon.dev"
+
+	dnsProvisionerPowerDNSAPI = "powerdns-api"
+	dnsProvisionerMySQL       = "mysql"
+	dnsProvisionerEmbedded    = "embedded"
+	dnsProvisionerNoop        = "noop"
+
+	dnsOutboxActionAdd    = "add"
+	dnsOutboxActionDelete = "delete"
+
+	dnsOutboxRetryInterval = 3 * time.Second
+	// 反映中のエントリを借りておく時間 (この間に終わらなければ他のワーカーが再送する)
+	dnsOutboxLease = 30 * time.Second
+)
+
+// DNSProvisioner はユーザごとのサブドメイン (<name>.u.isucon.dev) のレコードを管理する
+// AddRecord/DeleteRecordは冪等でなければならない (outboxから再送されることがある)
+type DNSProvisioner interface {
+	AddRecord(ctx context.Context, name string) error
+	DeleteRecord(ctx context.Context, name string) error
+}
+
+var dnsProvisioner DNSProvisioner = noopDNSProvisioner{}
+
+// デッドレターになったoutboxのエントリの数 (runDNSOutboxWorkerが更新し、/readyzで返す)
+var dnsOutboxDeadLetters atomic.Int64
+
+// noopDNSProvisioner はDNSを使わない環境 (ローカル開発など) 向けの実装
+type noopDNSProvisioner struct{}
+
+func (noopDNSProvisioner) AddRecord(ctx context.Context, name string) error    { return nil }
+func (noopDNSProvisioner) DeleteRecord(ctx context.Context, name string) error { return nil }
+
+// newDNSProvisioner はdns.provisionerで指定された実装を作る
+// 指定がない場合、組み込みDNSサーバが有効ならembedded、
+// インメモリのストアを使う場合かdns.powerdns.disabledがtrueなら何もしない実装を使う
+func newDNSProvisioner(cfg DNSConfig) (DNSProvisioner, error) {
+	kind := cfg.Provisioner
+	if kind == "" {
+		switch {
+		case cfg.Server.Enabled:
+			kind = dnsProvisionerEmbedded
+		case appConfig.Store.Backend == storeBackendMemory, cfg.PowerDNS.Disabled:
+			kind = dnsProvisionerNoop
+		default:
+			kind = dnsProvisionerPowerDNSAPI
+		}
+	}
+
+	switch kind {
+	case dnsProvisionerPowerDNSAPI:
+		return newPowerDNSAPIProvisioner(cfg.PowerDNS, cfg.SubdomainAddress), nil
+	case dnsProvisionerMySQL:
+		return newMySQLDNSProvisioner(cfg.PowerDNS.MySQL, cfg.SubdomainAddress)
+	case dnsProvisionerEmbedded:
+		if !cfg.Server.Enabled {
+			return nil, fmt.Errorf("dns provisioner '%s' requires dns.server.enabled", kind)
+		}
+		return embeddedDNSProvisioner{}, nil
+	case dnsProvisionerNoop:
+		return noopDNSProvisioner{}, nil
+	default:
+		return nil, fmt.Errorf("unknown dns provisioner '%s' (powerdns-api, mysql, embedded or noop)", kind)
+	}
+}
+
+type DNSOutboxModel struct {
+	ID       int64  `db:"id"`
+	Name     string `db:"name"`
+	Action   string `db:"action"`
+	Attempts int64  `db:"attempts"`
+	// 反映中のワーカーが借りている期限
+	LockedUntil int64 `db:"locked_until"`
+	// 失敗したエントリを次に再送する時刻
+	NextAttemptAt int64          `db:"next_attempt_at"`
+	LastError     sql.NullString `db:"last_error"`
+	// 再送をやめた時刻 (デッドレター)
+	DeadAt    sql.NullInt64 `db:"dead_at"`
+	CreatedAt int64         `db:"created_at"`
+}
+
+// enqueueDNSOutbox はレコードの変更をユーザの変更と同じトランザクションで記録する
+// コミットされた変更だけがDNSに反映されるので、usersテーブルとゾーンが食い違わない
+// ユーザ名は大文字を含むことがあるので、ラベルは小文字にして記録する
+func enqueueDNSOutbox(ctx context.Context, tx Tx, action string, name string) (int64, error) {
+	return tx.DNSOutbox().Enqueue(ctx, action, dnsLabel(name))
+}
+
+// dnsLabel はユーザ名から<label>.u.isucon.devのラベルを作る
+func dnsLabel(name string) string {
+	return strings.ToLower(name)
+}
+
+// deliverDNSOutbox はコミット後にoutboxのエントリをDNSへ反映する
+// DNSへの反映に時間がかかってもDBのロックを持ち続けないよう、短いトランザクションでエントリを借りてから反映する
+// 失敗したエントリは間隔を倍にしながらrunDNSOutboxWorkerが再送し、dns.outbox.max_attempts回失敗したらデッドレターにする
+func deliverDNSOutbox(ctx context.Context, id int64) (err error) {
+	ctx, span := tracer.Start(ctx, "deliverDNSOutbox", trace.WithAttributes(attribute.Int64("dns.outbox_id", id)))
+	defer func() { endSpan(span, err) }()
+
+	// 他のインスタンスやワーカーが処理中のエントリと、再送の時刻になっていないエントリは飛ばす
+	// 同じ名前の古いエントリが残っている場合も飛ばし、そちらが反映されてから再送する
+	// (名前は再利用されるので、追加と削除の順番が入れ替わるとゾーンが壊れる)
+	var entry DNSOutboxModel
+	err = withTx(ctx, func(tx Tx) error {
+		now := time.Now()
+		var err error
+		entry, err = tx.DNSOutbox().Claim(ctx, id, now.Unix(), now.Add(dnsOutboxLease).Unix())
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+
+	// 借りている間に終わらなければ、他のワーカーが再送できるようにする
+	provisionCtx, cancel := context.WithTimeout(ctx, dnsOutboxLease)
+	defer cancel()
+	provisionCtx, provisionSpan := tracer.Start(provisionCtx, "DNSProvisioner "+entry.Action, dnsSpanAttributes(entry.Action, entry.Name))
+	switch entry.Action {
+	case dnsOutboxActionAdd:
+		err = dnsProvisioner.AddRecord(provisionCtx, entry.Name)
+	case dnsOutboxActionDelete:
+		err = dnsProvisioner.DeleteRecord(provisionCtx, entry.Name)
+	default:
+		err = fmt.Errorf("unknown dns outbox action '%s'", entry.Action)
+	}
+	endSpan(provisionSpan, err)
+	if err != nil {
+		if ferr := recordDNSOutboxFailure(ctx, entry, err); ferr != nil {
+			return errors.Join(err, ferr)
+		}
+		return err
+	}
+
+	return withTx(ctx, func(tx Tx) error {
+		return tx.DNSOutbox().Delete(ctx, id)
+	})
+}
+
+// recordDNSOutboxFailure は失敗を記録し、次の再送の時刻を決める
+func recordDNSOutboxFailure(ctx context.Context, entry DNSOutboxModel, cause error) error {
+	now := time.Now()
+	attempts := entry.Attempts + 1
+	var deadAt sql.NullInt64
+	if attempts >= appConfig.DNS.Outbox.MaxAttempts {
+		deadAt = sql.NullInt64{Int64: now.Unix(), Valid: true}
+		slog.ErrorContext(ctx, "gave up delivering dns outbox (dead letter)", "id", entry.ID, "name", entry.Name, "action", entry.Action, "attempts", attempts, "error", cause)
+	}
+	nextAttemptAt := now.Add(dnsOutboxBackoff(attempts, appConfig.DNS.Outbox.MaxBackoff)).Unix()
+	return withTx(ctx, func(tx Tx) error {
+		return tx.DNSOutbox().RecordFailure(ctx, entry.ID, attempts, cause.Error(), nextAttemptAt, deadAt)
+	})
+}
+
+// dnsOutboxBackoff はattempts回失敗したエントリを再送するまでの間隔を返す
+// dnsOutboxRetryIntervalから失敗するたびに倍にし、maxBackoffで打ち止めにする
+func dnsOutboxBackoff(attempts int64, maxBackoff time.Duration) time.Duration {
+	backoff := dnsOutboxRetryInterval
+	for i := int64(1); i < attempts && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, maxBackoff)
+}
+
+// runDNSOutboxWorker は反映に失敗したoutboxのエントリを定期的に再送し、デッドレターの件数を更新する
+func runDNSOutboxWorker(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		ctx := context.Background()
+
+		// 作成直後のエントリはリクエストを処理しているインスタンスに任せる
+		var ids []int64
+		var dead int64
+		err := withReadOnlyTx(ctx, func(tx Tx) error {
+			now := time.Now()
+			var err error
+			ids, err = tx.DNSOutbox().ListDueIDs(ctx, now.Add(-interval).Unix(), now.Unix())
+			if err != nil {
+				return err
+			}
+			dead, err = tx.DNSOutbox().CountDead(ctx)
+			return err
+		})
+		if err != nil {
+			slog.Error("failed to get dns outbox", "error", err)
+			continue
+		}
+		dnsOutboxDeadLetters.Store(dead)
+		dnsOutboxDeadLettersGauge.Set(float64(dead))
+		for _, id := range ids {
+			if err := deliverDNSOutbox(ctx, id); err != nil {
+				slog.Warn("failed to deliver dns outbox", "id", id, "error", err)
+			}
+		}
+	}
+}
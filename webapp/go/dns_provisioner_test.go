@@ -0,0 +1,220 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+)
+
+// failingDNSProvisioner は常に失敗するDNSProvisioner
+type failingDNSProvisioner struct{ calls int }
+
+func (p *failingDNSProvisioner) AddRecord(ctx context.Context, name string) error {
+	p.calls++
+	return errors.New("powerdns is down")
+}
+
+func (p *failingDNSProvisioner) DeleteRecord(ctx context.Context, name string) error {
+	p.calls++
+	return errors.New("powerdns is down")
+}
+
+func TestDNSOutboxBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int64
+		want     time.Duration
+	}{
+		{1, dnsOutboxRetryInterval},
+		{2, 2 * dnsOutboxRetryInterval},
+		{3, 4 * dnsOutboxRetryInterval},
+		{100, time.Minute},
+	}
+	for _, tt := range tests {
+		if got := dnsOutboxBackoff(tt.attempts, time.Minute); got != tt.want {
+			t.Errorf("dnsOutboxBackoff(%d) = %s, want %s", tt.attempts, got, tt.want)
+		}
+	}
+}
+
+func TestDeliverDNSOutboxDeadLetter(t *testing.T) {
+	origConfig, origStore, origProvisioner := appConfig, store, dnsProvisioner
+	t.Cleanup(func() { appConfig, store, dnsProvisioner = origConfig, origStore, origProvisioner })
+
+	appConfig = defaultConfig()
+	appConfig.DNS.Outbox.MaxAttempts = 2
+	s, err := newMemoryStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	store = s
+	provisioner := &failingDNSProvisioner{}
+	dnsProvisioner = provisioner
+
+	ctx := context.Background()
+	var id int64
+	err = withTx(ctx, func(tx Tx) error {
+		id, err = enqueueDNSOutbox(ctx, tx, dnsOutboxActionAdd, "Outbox-Test")
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	getEntry := func() DNSOutboxModel {
+		t.Helper()
+		entry, ok := s.data.dnsOutbox.rows[id]
+		if !ok {
+			t.Fatal("outbox entry must not be deleted on failure")
+		}
+		return entry
+	}
+
+	if err := deliverDNSOutbox(ctx, id); err == nil {
+		t.Fatal("deliverDNSOutbox must return the provisioner error")
+	}
+	entry := getEntry()
+	if entry.Attempts != 1 || entry.DeadAt.Valid || entry.LockedUntil != 0 || !entry.LastError.Valid {
+		t.Fatalf("unexpected entry after the first failure: %+v", entry)
+	}
+
+	// 再送の時刻になるまでは借りられない
+	if err := deliverDNSOutbox(ctx, id); err != nil {
+		t.Fatalf("deliverDNSOutbox before next_attempt_at: %v", err)
+	}
+	if provisioner.calls != 1 {
+		t.Fatalf("provisioner must not be called before next_attempt_at (calls = %d)", provisioner.calls)
+	}
+
+	entry.NextAttemptAt = 0
+	s.data.dnsOutbox.rows[id] = entry
+	if err := deliverDNSOutbox(ctx, id); err == nil {
+		t.Fatal("deliverDNSOutbox must return the provisioner error")
+	}
+	entry = getEntry()
+	if entry.Attempts != 2 || !entry.DeadAt.Valid {
+		t.Fatalf("entry must become a dead letter after max_attempts: %+v", entry)
+	}
+
+	err = withReadOnlyTx(ctx, func(tx Tx) error {
+		dead, err := tx.DNSOutbox().CountDead(ctx)
+		if err != nil {
+			return err
+		}
+		if dead != 1 {
+			t.Errorf("CountDead = %d, want 1", dead)
+		}
+		ids, err := tx.DNSOutbox().ListDueIDs(ctx, time.Now().Unix(), time.Now().Add(time.Hour).Unix())
+		if err != nil {
+			return err
+		}
+		if len(ids) != 0 {
+			t.Errorf("dead letters must not be retried (got %v)", ids)
+		}
+		pending, err := tx.DNSOutbox().ExistsByName(ctx, "outbox-test")
+		if err != nil {
+			return err
+		}
+		if pending {
+			t.Error("dead letters must be left to the reconciler")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = withTx(ctx, func(tx Tx) error {
+		_, err := tx.DNSOutbox().Claim(ctx, id, time.Now().Add(time.Hour).Unix(), time.Now().Add(2*time.Hour).Unix())
+		return err
+	})
+	if !errors.Is(err, sql.ErrNoRows) {
+		t.Fatalf("dead letters must not be claimed (got %v)", err)
+	}
+}
+
+// zoneDNSProvisioner はゾーンのレコードを記録し、failOnceで指定した操作を一度だけ失敗させる
+type zoneDNSProvisioner struct {
+	zone     map[string]bool
+	failOnce map[string]bool
+}
+
+func (p *zoneDNSProvisioner) apply(action string, name string, present bool) error {
+	if p.failOnce[action] {
+		delete(p.failOnce, action)
+		return errors.New("powerdns is down")
+	}
+	p.zone[name] = present
+	return nil
+}
+
+func (p *zoneDNSProvisioner) AddRecord(ctx context.Context, name string) error {
+	return p.apply(dnsOutboxActionAdd, name, true)
+}
+
+func (p *zoneDNSProvisioner) DeleteRecord(ctx context.Context, name string) error {
+	return p.apply(dnsOutboxActionDelete, name, false)
+}
+
+// 削除の反映に失敗した後に同じ名前が再登録されても、削除と追加の順番が入れ替わらないこと
+func TestDeliverDNSOutboxKeepsOrderPerName(t *testing.T) {
+	origConfig, origStore, origProvisioner := appConfig, store, dnsProvisioner
+	t.Cleanup(func() { appConfig, store, dnsProvisioner = origConfig, origStore, origProvisioner })
+
+	appConfig = defaultConfig()
+	s, err := newMemoryStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	store = s
+	provisioner := &zoneDNSProvisioner{zone: map[string]bool{}, failOnce: map[string]bool{dnsOutboxActionDelete: true}}
+	dnsProvisioner = provisioner
+
+	ctx := context.Background()
+	enqueue := func(action string) int64 {
+		t.Helper()
+		var id int64
+		err := withTx(ctx, func(tx Tx) error {
+			var err error
+			id, err = enqueueDNSOutbox(ctx, tx, action, "reused")
+			return err
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return id
+	}
+
+	// 追加 → 削除 (失敗) → 追加
+	if err := deliverDNSOutbox(ctx, enqueue(dnsOutboxActionAdd)); err != nil {
+		t.Fatal(err)
+	}
+	deleteID := enqueue(dnsOutboxActionDelete)
+	if err := deliverDNSOutbox(ctx, deleteID); err == nil {
+		t.Fatal("the first delete must fail")
+	}
+	addID := enqueue(dnsOutboxActionAdd)
+	if err := deliverDNSOutbox(ctx, addID); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := s.data.dnsOutbox.rows[addID]; !ok {
+		t.Fatal("the second add must wait for the pending delete")
+	}
+
+	// 再送の時刻になったらワーカーと同じくIDの昇順に反映する
+	entry := s.data.dnsOutbox.rows[deleteID]
+	entry.NextAttemptAt = 0
+	s.data.dnsOutbox.rows[deleteID] = entry
+	for _, id := range []int64{deleteID, addID} {
+		if err := deliverDNSOutbox(ctx, id); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if len(s.data.dnsOutbox.rows) != 0 {
+		t.Errorf("all entries must be delivered: %+v", s.data.dnsOutbox.rows)
+	}
+	if !provisioner.zone["reused"] {
+		t.Error("the record must exist after add, delete and add")
+	}
+}
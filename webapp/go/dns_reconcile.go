@@ -0,0 +1,277 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	dnsReconcileIntervalEnvKey = "ISUCON13_DNS_RECONCILE_INTERVAL"
+	dnsReconcileFixEnvKey      = "ISUCON13_DNS_RECONCILE_FIX"
+)
+
+var errDNSListUnsupported = errors.New("dns provisioner does not support listing records")
+
+// DNSRecordLister はゾーンに登録されているユーザのAレコードを列挙できるDNSProvisioner
+// 返すのはゾーン直下のラベル (小文字)
+type DNSRecordLister interface {
+	ListRecords(ctx context.Context) ([]string, error)
+}
+
+// DNSReconcileReport はusersテーブルとゾーンの差分
+type DNSReconcileReport struct {
+	// ユーザは存在するがレコードがない
+	Missing []string `json:"missing"`
+	// レコードはあるがユーザが存在しない (ゾーンファイルの静的なレコードは除く)
+	Orphaned []string `json:"orphaned"`
+	// fixを指定した場合に修正した件数
+	Added   int `json:"added"`
+	Deleted int `json:"deleted"`
+	// 修正中に失敗した名前とエラー
+	Errors map[string]string `json:"errors,omitempty"`
+}
+
+// reconcileDNS はusersテーブルとdnsProvisionerのレコードを突き合わせる
+// fixがtrueなら足りないレコードを追加し、不要なレコードを削除する
+func reconcileDNS(ctx context.Context, fix bool) (*DNSReconcileReport, error) {
+	lister, ok := dnsProvisioner.(DNSRecordLister)
+	if !ok {
+		return nil, errDNSListUnsupported
+	}
+
+	static, err := dnsZoneFileLabels(appConfig.DNS.ZoneFile)
+	if err != nil {
+		return nil, err
+	}
+
+	// 登録処理と並行して実行されるので、レコードを先に取得する
+	// (コミット前のユーザのレコードが作られることはないため、レコードがあればユーザも取得できる)
+	records, err := lister.ListRecords(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list dns records: %w", err)
+	}
+	var names []string
+	err = withReadOnlyTx(ctx, func(tx Tx) error {
+		var err error
+		names, err = tx.Users().ListActiveNames(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users: %w", err)
+	}
+
+	recordSet := make(map[string]struct{}, len(records))
+	for _, record := range records {
+		recordSet[strings.ToLower(record)] = struct{}{}
+	}
+	userSet := make(map[string]struct{}, len(names))
+
+	report := &DNSReconcileReport{
+		Missing:  []string{},
+		Orphaned: []string{},
+	}
+	for _, name := range names {
+		label := dnsLabel(name)
+		userSet[label] = struct{}{}
+		if _, ok := recordSet[label]; !ok {
+			report.Missing = append(report.Missing, label)
+		}
+	}
+	for record := range recordSet {
+		if _, ok := userSet[record]; ok {
+			continue
+		}
+		if _, ok := static[record]; ok {
+			continue
+		}
+		report.Orphaned = append(report.Orphaned, record)
+	}
+	sort.Strings(report.Missing)
+	sort.Strings(report.Orphaned)
+
+	if !fix {
+		return report, nil
+	}
+
+	for _, name := range report.Missing {
+		if err := reconcileDNSRecord(ctx, name, true, report); err != nil {
+			report.addError(name, err)
+		}
+	}
+	for _, name := range report.Orphaned {
+		if err := reconcileDNSRecord(ctx, name, false, report); err != nil {
+			report.addError(name, err)
+		}
+	}
+	return report, nil
+}
+
+// reconcileDNSRecord は1件分の差分を修正する
+// 差分を取ってから状態が変わっていることがあるので、修正直前にもう一度確認する
+func reconcileDNSRecord(ctx context.Context, name string, wantExists bool, report *DNSReconcileReport) error {
+	// outboxに残っているものはそちらで反映されるので触らない (デッドレターはここで反映する)
+	var pending, exists bool
+	err := withReadOnlyTx(ctx, func(tx Tx) error {
+		var err error
+		if pending, err = tx.DNSOutbox().ExistsByName(ctx, name); err != nil {
+			return err
+		}
+		exists, err = tx.Users().ActiveNameExists(ctx, name)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if pending {
+		return nil
+	}
+
+	if exists != wantExists {
+		return nil
+	}
+
+	if wantExists {
+		if err := dnsProvisioner.AddRecord(ctx, name); err != nil {
+			return err
+		}
+		report.Added++
+	} else {
+		if err := dnsProvisioner.DeleteRecord(ctx, name); err != nil {
+			return err
+		}
+		report.Deleted++
+	}
+	// 再送をやめたエントリは反映し直したので消す
+	return withTx(ctx, func(tx Tx) error {
+		return tx.DNSOutbox().DeleteDeadByName(ctx, name)
+	})
+}
+
+func (r *DNSReconcileReport) addError(name string, err error) {
+	if r.Errors == nil {
+		r.Errors = make(map[string]string)
+	}
+	r.Errors[name] = err.Error()
+}
+
+// runDNSReconciler は定期的にreconcileDNSを実行する
+// dns.reconcile.intervalが設定されている場合のみmainから起動する
+func runDNSReconciler(interval time.Duration, fix bool) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		report, err := reconcileDNS(context.Background(), fix)
+		if err != nil {
+			slog.Error("failed to reconcile dns records", "error", err)
+			continue
+		}
+		if len(report.Missing) > 0 || len(report.Orphaned) > 0 || len(report.Errors) > 0 {
+			slog.Warn("dns records are out of sync",
+				"missing", len(report.Missing), "orphaned", len(report.Orphaned), "added", report.Added, "deleted", report.Deleted, "errors", len(report.Errors))
+		}
+	}
+}
+
+// reconcile-dns サブコマンド
+// 差分をJSONで出力する。-fix を指定すると修正する
+func runReconcileDNS(ctx context.Context, args []string) error {
+	fix := false
+	for _, arg := range args {
+		switch arg {
+		case "-fix", "--fix":
+			fix = true
+		default:
+			return fmt.Errorf("unknown argument '%s'", arg)
+		}
+	}
+
+	if err := setupDNSForCommand(ctx); err != nil {
+		return err
+	}
+
+	report, err := reconcileDNS(ctx, fix)
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(report); err != nil {
+		return err
+	}
+	if len(report.Errors) > 0 {
+		return fmt.Errorf("failed to fix %d records", len(report.Errors))
+	}
+	return nil
+}
+
+// setupDNSForCommand はサブコマンドからdnsProvisionerを使えるようにする
+// 組み込みDNSサーバは待ち受けず、usersテーブルの読み込みだけ行う
+func setupDNSForCommand(ctx context.Context) error {
+	if err := setupDNSServer(appConfig.DNS); err != nil {
+		return err
+	}
+	if dnsServer != nil {
+		if err := dnsServer.LoadUsers(ctx); err != nil {
+			return fmt.Errorf("failed to load users: %w", err)
+		}
+	}
+
+	provisioner, err := newDNSProvisioner(appConfig.DNS)
+	if err != nil {
+		return err
+	}
+	dnsProvisioner = provisioner
+	return nil
+}
+
+func (p *powerDNSAPIProvisioner) ListRecords(ctx context.Context) ([]string, error) {
+	var zone struct {
+		RRSets []powerDNSRRSet `json:"rrsets"`
+	}
+	endpoint := fmt.Sprintf("%s/api/v1/servers/%s/zones/%s.", p.baseURL, p.serverID, p.zone)
+	if err := p.get(ctx, endpoint, &zone); err != nil {
+		return nil, err
+	}
+
+	var labels []string
+	for _, rrset := range zone.RRSets {
+		if rrset.Type != "A" || len(rrset.Records) == 0 {
+			continue
+		}
+		if label, ok := dnsZoneLabel(rrset.Name); ok {
+			labels = append(labels, label)
+		}
+	}
+	return labels, nil
+}
+
+func (p *mySQLDNSProvisioner) ListRecords(ctx context.Context) ([]string, error) {
+	var names []string
+	query := "SELECT r.name FROM records r INNER JOIN domains d ON d.id = r.domain_id WHERE d.name = ? AND r.type = 'A' AND r.disabled = 0"
+	if err := p.db.SelectContext(ctx, &names, query, p.zone); err != nil {
+		return nil, err
+	}
+
+	var labels []string
+	for _, name := range names {
+		if label, ok := dnsZoneLabel(name); ok {
+			labels = append(labels, label)
+		}
+	}
+	return labels, nil
+}
+
+func (embeddedDNSProvisioner) ListRecords(ctx context.Context) ([]string, error) {
+	if dnsServer == nil {
+		return nil, errors.New("embedded dns server is not running")
+	}
+	return dnsServer.Users(), nil
+}
@@ -0,0 +1,307 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakePowerDNS はPowerDNSのHTTP APIのうち、ゾーンの取得 (GET) とレコードの更新 (PATCH) だけを再現する
+type fakePowerDNS struct {
+	mu sync.Mutex
+	// rrsets はゾーンのレコード (名前とタイプごと)
+	rrsets map[[2]string]powerDNSRRSet
+	// failNames はPATCHを失敗させる名前
+	failNames map[string]bool
+	// failList がtrueならゾーンの取得を失敗させる
+	failList bool
+	patches  []powerDNSRRSet
+}
+
+const fakePowerDNSAPIKey = "test-api-key"
+
+func newFakePowerDNS(t *testing.T, names ...string) (*fakePowerDNS, *httptest.Server) {
+	t.Helper()
+	f := &fakePowerDNS{
+		rrsets:    make(map[[2]string]powerDNSRRSet),
+		failNames: make(map[string]bool),
+	}
+	f.put(powerDNSRRSet{Name: dnsZone + ".", Type: "NS", Records: []powerDNSRecord{{Content: "ns1." + dnsZone + "."}}})
+	for _, name := range names {
+		f.put(powerDNSRRSet{Name: name + "." + dnsZone + ".", Type: "A", Records: []powerDNSRecord{{Content: "192.0.2.1"}}})
+	}
+	srv := httptest.NewServer(f)
+	t.Cleanup(srv.Close)
+	return f, srv
+}
+
+func (f *fakePowerDNS) put(rrset powerDNSRRSet) {
+	f.rrsets[[2]string{strings.ToLower(rrset.Name), rrset.Type}] = rrset
+}
+
+func (f *fakePowerDNS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.Header.Get("X-API-Key") != fakePowerDNSAPIKey {
+		http.Error(w, `{"error": "Unauthorized"}`, http.StatusUnauthorized)
+		return
+	}
+	if r.URL.Path != "/api/v1/servers/localhost/zones/"+dnsZone+"." {
+		http.Error(w, `{"error": "Not Found"}`, http.StatusNotFound)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		if f.failList {
+			http.Error(w, `{"error": "Internal Server Error"}`, http.StatusInternalServerError)
+			return
+		}
+		zone := struct {
+			RRSets []powerDNSRRSet `json:"rrsets"`
+		}{RRSets: []powerDNSRRSet{}}
+		for _, rrset := range f.rrsets {
+			zone.RRSets = append(zone.RRSets, rrset)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(zone)
+	case http.MethodPatch:
+		var body struct {
+			RRSets []powerDNSRRSet `json:"rrsets"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, `{"error": "invalid json"}`, http.StatusBadRequest)
+			return
+		}
+		for _, rrset := range body.RRSets {
+			if f.failNames[strings.TrimSuffix(rrset.Name, "."+dnsZone+".")] {
+				http.Error(w, `{"error": "RRset `+rrset.Name+` could not be changed"}`, http.StatusUnprocessableEntity)
+				return
+			}
+		}
+		for _, rrset := range body.RRSets {
+			f.patches = append(f.patches, rrset)
+			switch rrset.ChangeType {
+			case "REPLACE":
+				f.put(rrset)
+			case "DELETE":
+				delete(f.rrsets, [2]string{strings.ToLower(rrset.Name), rrset.Type})
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, `{"error": "Method Not Allowed"}`, http.StatusMethodNotAllowed)
+	}
+}
+
+// names はAレコードのあるラベルを返す
+func (f *fakePowerDNS) names() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var names []string
+	for _, rrset := range f.rrsets {
+		if label, ok := dnsZoneLabel(rrset.Name); ok && rrset.Type == "A" {
+			names = append(names, label)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func setupDNSReconcileTest(t *testing.T, users []string, records []string) *fakePowerDNS {
+	t.Helper()
+	origConfig, origStore, origProvisioner := appConfig, store, dnsProvisioner
+	t.Cleanup(func() { appConfig, store, dnsProvisioner = origConfig, origStore, origProvisioner })
+
+	appConfig = defaultConfig()
+	store = &memoryStore{data: newMemoryData()}
+	ctx := context.Background()
+	err := withTx(ctx, func(tx Tx) error {
+		for _, name := range users {
+			if _, err := tx.Users().Create(ctx, UserModel{Name: name, DisplayName: name, HashedPassword: "x"}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f, srv := newFakePowerDNS(t, records...)
+	dnsProvisioner = newPowerDNSAPIProvisioner(PowerDNSConfig{APIURL: srv.URL + "/", APIKey: fakePowerDNSAPIKey}, "192.0.2.1")
+	return f
+}
+
+func TestReconcileDNSWithPowerDNS(t *testing.T) {
+	// pipeはゾーンファイルの静的なレコードなので、ユーザがいなくても消さない
+	users := []string{"Alice", "bob", "carol"}
+	records := []string{"alice", "carol", "ghost", "pipe"}
+
+	tests := []struct {
+		name      string
+		fix       bool
+		failNames []string
+		// pendingはoutboxに残っている名前、deadはデッドレターになった名前
+		pending, dead []string
+		want          DNSReconcileReport
+		wantRecords   []string
+		wantDeadLeft  int64
+	}{
+		{
+			name:        "report only",
+			want:        DNSReconcileReport{Missing: []string{"bob"}, Orphaned: []string{"ghost"}},
+			wantRecords: []string{"alice", "carol", "ghost", "pipe"},
+		},
+		{
+			name:        "fix",
+			fix:         true,
+			want:        DNSReconcileReport{Missing: []string{"bob"}, Orphaned: []string{"ghost"}, Added: 1, Deleted: 1},
+			wantRecords: []string{"alice", "bob", "carol", "pipe"},
+		},
+		{
+			name:        "fix reports errors and continues",
+			fix:         true,
+			failNames:   []string{"bob"},
+			want:        DNSReconcileReport{Missing: []string{"bob"}, Orphaned: []string{"ghost"}, Deleted: 1, Errors: map[string]string{"bob": "powerdns api returned status 422"}},
+			wantRecords: []string{"alice", "carol", "pipe"},
+		},
+		{
+			name:        "fix leaves pending outbox entries to the worker",
+			fix:         true,
+			pending:     []string{"bob"},
+			want:        DNSReconcileReport{Missing: []string{"bob"}, Orphaned: []string{"ghost"}, Deleted: 1},
+			wantRecords: []string{"alice", "carol", "pipe"},
+		},
+		{
+			name:        "fix clears dead letters",
+			fix:         true,
+			dead:        []string{"bob", "ghost"},
+			want:        DNSReconcileReport{Missing: []string{"bob"}, Orphaned: []string{"ghost"}, Added: 1, Deleted: 1},
+			wantRecords: []string{"alice", "bob", "carol", "pipe"},
+		},
+		{
+			name:         "failed fix keeps dead letters",
+			fix:          true,
+			failNames:    []string{"bob"},
+			dead:         []string{"bob"},
+			want:         DNSReconcileReport{Missing: []string{"bob"}, Orphaned: []string{"ghost"}, Deleted: 1, Errors: map[string]string{"bob": "powerdns api returned status 422"}},
+			wantRecords:  []string{"alice", "carol", "pipe"},
+			wantDeadLeft: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := setupDNSReconcileTest(t, users, records)
+			for _, name := range tt.failNames {
+				f.failNames[name] = true
+			}
+			ctx := context.Background()
+			err := withTx(ctx, func(tx Tx) error {
+				for _, name := range tt.pending {
+					if _, err := tx.DNSOutbox().Enqueue(ctx, dnsOutboxActionAdd, name); err != nil {
+						return err
+					}
+				}
+				for _, name := range tt.dead {
+					id, err := tx.DNSOutbox().Enqueue(ctx, dnsOutboxActionAdd, name)
+					if err != nil {
+						return err
+					}
+					if err := tx.DNSOutbox().RecordFailure(ctx, id, 10, "powerdns is down", 0, sql.NullInt64{Int64: 1, Valid: true}); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			report, err := reconcileDNS(ctx, tt.fix)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// エラーは先頭だけ比べる (PowerDNSの応答の本文が続く)
+			for name, msg := range report.Errors {
+				if want, ok := tt.want.Errors[name]; ok && strings.HasPrefix(msg, want) {
+					report.Errors[name] = want
+				}
+			}
+			if !reflect.DeepEqual(*report, tt.want) {
+				t.Errorf("report = %+v, want %+v", *report, tt.want)
+			}
+			if got := f.names(); !reflect.DeepEqual(got, tt.wantRecords) {
+				t.Errorf("records = %v, want %v", got, tt.wantRecords)
+			}
+			if !tt.fix && len(f.patches) > 0 {
+				t.Errorf("records must not be changed without fix (got %+v)", f.patches)
+			}
+			var dead int64
+			err = withReadOnlyTx(ctx, func(tx Tx) error {
+				dead, err = tx.DNSOutbox().CountDead(ctx)
+				return err
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if dead != tt.wantDeadLeft {
+				t.Errorf("dead letters = %d, want %d", dead, tt.wantDeadLeft)
+			}
+		})
+	}
+}
+
+func TestReconcileDNSAddsRecordWithSubdomainAddress(t *testing.T) {
+	f := setupDNSReconcileTest(t, []string{"bob"}, nil)
+	if _, err := reconcileDNS(context.Background(), true); err != nil {
+		t.Fatal(err)
+	}
+	want := []powerDNSRRSet{{
+		Name:       "bob." + dnsZone + ".",
+		Type:       "A",
+		ChangeType: "REPLACE",
+		Records:    []powerDNSRecord{{Content: "192.0.2.1"}},
+	}}
+	if !reflect.DeepEqual(f.patches, want) {
+		t.Errorf("patches = %+v, want %+v", f.patches, want)
+	}
+}
+
+func TestReconcileDNSListErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(f *fakePowerDNS)
+		wantErr string
+	}{
+		{name: "server error", setup: func(f *fakePowerDNS) { f.failList = true }, wantErr: "powerdns api returned status 500"},
+		{
+			name: "wrong api key",
+			setup: func(f *fakePowerDNS) {
+				dnsProvisioner.(*powerDNSAPIProvisioner).apiKey = "wrong"
+			},
+			wantErr: "powerdns api returned status 401",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := setupDNSReconcileTest(t, []string{"bob"}, nil)
+			tt.setup(f)
+			_, err := reconcileDNS(context.Background(), true)
+			if err == nil || !strings.Contains(err.Error(), "failed to list dns records") || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("err = %v, want %q", err, tt.wantErr)
+			}
+			if len(f.patches) > 0 {
+				t.Errorf("records must not be changed when listing fails (got %+v)", f.patches)
+			}
+		})
+	}
+}
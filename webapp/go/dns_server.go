@@ -0,0 +1,352 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/puzpuzpuz/xsync/v3"
+	"golang.org/x/time/rate"
+)
+
+const (
+	dnsServerEnabledEnvKey = "ISUCON13_DNS_SERVER_ENABLED"
+	dnsServerAddrEnvKey    = "ISUCON13_DNS_SERVER_ADDR"
+	dnsServerNXRateEnvKey  = "ISUCON13_DNS_NXDOMAIN_RATE"
+	dnsServerNXBurstEnvKey = "ISUCON13_DNS_NXDOMAIN_BURST"
+
+	// 送信元ごとのレートリミッタを破棄するまでの時間
+	dnsRateLimiterIdleTimeout = time.Minute
+)
+
+// 組み込みDNSサーバ (dns.server.enabledがtrueの場合のみ起動する)
+var dnsServer *embeddedDNSServer
+
+// embeddedDNSServer はu.isucon.devの権威サーバ
+// ゾーンファイルの静的なレコードと、usersテーブルから読み込んだユーザ名のAレコードに応答する
+// 存在しない名前にはDBを見ずにNXDOMAINを返し、送信元ごとにNXDOMAINの応答数を制限する
+type embeddedDNSServer struct {
+	zone    string // 末尾のドットを含むFQDN (小文字)
+	address string
+	soa     *dns.SOA
+	// 小文字のFQDN -> レコード
+	static map[string][]dns.RR
+	// 小文字のユーザ名
+	users *xsync.MapOf[string, struct{}]
+
+	nxRate   rate.Limit
+	nxBurst  int
+	limiters *xsync.MapOf[string, *dnsRateLimiter]
+
+	mu      sync.Mutex
+	servers []*dns.Server
+}
+
+type dnsRateLimiter struct {
+	limiter *rate.Limiter
+	// 最後に問い合わせがあった時刻 (Unixナノ秒)
+	lastSeen atomic.Int64
+}
+
+// newEmbeddedDNSServer はゾーンファイルを読み込んでサーバを作る
+func newEmbeddedDNSServer(zoneFile string, address string, nxRate rate.Limit, nxBurst int) (*embeddedDNSServer, error) {
+	rrs, err := parseDNSZoneFile(zoneFile, address)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &embeddedDNSServer{
+		zone:     dns.Fqdn(dnsZone),
+		address:  address,
+		static:   make(map[string][]dns.RR),
+		users:    xsync.NewMapOf[string, struct{}](),
+		nxRate:   nxRate,
+		nxBurst:  nxBurst,
+		limiters: xsync.NewMapOf[string, *dnsRateLimiter](),
+	}
+	for _, rr := range rrs {
+		name := strings.ToLower(rr.Header().Name)
+		s.static[name] = append(s.static[name], rr)
+		if soa, ok := rr.(*dns.SOA); ok {
+			s.soa = soa
+		}
+	}
+	if s.soa == nil {
+		return nil, fmt.Errorf("zone file %s has no SOA record", zoneFile)
+	}
+
+	return s, nil
+}
+
+// setupDNSServer はdns.server.enabledがtrueなら組み込みDNSサーバを作る
+// 起動はStartで行う
+func setupDNSServer(cfg DNSConfig) error {
+	if !cfg.Server.Enabled {
+		return nil
+	}
+
+	server, err := newEmbeddedDNSServer(cfg.ZoneFile, cfg.SubdomainAddress, rate.Limit(cfg.Server.NXDomainRate), cfg.Server.NXDomainBurst)
+	if err != nil {
+		return err
+	}
+	dnsServer = server
+	cacheBus.Subscribe(cacheTopicDNS, server.onInvalidate)
+	return nil
+}
+
+// Start はUDPとTCPで待ち受ける
+func (s *embeddedDNSServer) Start(addr string) error {
+	if err := s.LoadUsers(context.Background()); err != nil {
+		return fmt.Errorf("failed to load users: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, network := range []string{"udp", "tcp"} {
+		server := &dns.Server{
+			Addr:    addr,
+			Net:     network,
+			Handler: s,
+		}
+		started := make(chan error, 1)
+		server.NotifyStartedFunc = func() { started <- nil }
+		go func() {
+			if err := server.ListenAndServe(); err != nil {
+				started <- err
+			}
+		}()
+		if err := <-started; err != nil {
+			return fmt.Errorf("failed to listen %s/%s: %w", addr, network, err)
+		}
+		s.servers = append(s.servers, server)
+	}
+
+	go s.runLimiterSweeper(dnsRateLimiterIdleTimeout)
+	return nil
+}
+
+func (s *embeddedDNSServer) Shutdown(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var err error
+	for _, server := range s.servers {
+		if serr := server.ShutdownContext(ctx); serr != nil {
+			err = serr
+		}
+	}
+	s.servers = nil
+	return err
+}
+
+// Running はUDP/TCPで待ち受けているかを返す
+func (s *embeddedDNSServer) Running() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.servers) > 0
+}
+
+// LoadUsers はusersテーブルからユーザ名の集合を作り直す
+func (s *embeddedDNSServer) LoadUsers(ctx context.Context) error {
+	var names []string
+	err := withReadOnlyTx(ctx, func(tx Tx) error {
+		var err error
+		names, err = tx.Users().ListActiveNames(ctx)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	loaded := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		loaded[strings.ToLower(name)] = struct{}{}
+	}
+	s.users.Range(func(name string, _ struct{}) bool {
+		if _, ok := loaded[name]; !ok {
+			s.users.Delete(name)
+		}
+		return true
+	})
+	for name := range loaded {
+		s.users.Store(name, struct{}{})
+	}
+	return nil
+}
+
+// SyncUser はusersテーブルを見て1ユーザ分のレコードを追加・削除する
+func (s *embeddedDNSServer) SyncUser(ctx context.Context, name string) error {
+	var exists bool
+	err := withReadOnlyTx(ctx, func(tx Tx) error {
+		var err error
+		exists, err = tx.Users().ActiveNameExists(ctx, name)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if exists {
+		s.users.Store(strings.ToLower(name), struct{}{})
+	} else {
+		s.users.Delete(strings.ToLower(name))
+	}
+	return nil
+}
+
+// HasUser はユーザ名のレコードが登録されているかを返す
+func (s *embeddedDNSServer) HasUser(name string) bool {
+	_, ok := s.users.Load(strings.ToLower(name))
+	return ok
+}
+
+// Users は登録されているユーザ名を返す
+func (s *embeddedDNSServer) Users() []string {
+	names := make([]string, 0, s.users.Size())
+	s.users.Range(func(name string, _ struct{}) bool {
+		names = append(names, name)
+		return true
+	})
+	return names
+}
+
+// onInvalidate はCacheBusの通知でユーザ名の集合を更新する (keyが空なら全件読み直す)
+// 他のインスタンスで登録されたユーザもこれで反映される
+func (s *embeddedDNSServer) onInvalidate(key string) {
+	ctx := context.Background()
+	var err error
+	if key == "" {
+		err = s.LoadUsers(ctx)
+	} else {
+		err = s.SyncUser(ctx, key)
+	}
+	if err != nil {
+		slog.Error("failed to sync dns records", "key", key, "error", err)
+	}
+}
+
+func (s *embeddedDNSServer) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
+	if len(req.Question) != 1 || req.Opcode != dns.OpcodeQuery {
+		m := new(dns.Msg)
+		m.SetRcode(req, dns.RcodeNotImplemented)
+		w.WriteMsg(m)
+		return
+	}
+
+	m := s.answer(req)
+	if m.Rcode == dns.RcodeNameError && !s.allowNXDomain(w.RemoteAddr()) {
+		// 制限を超えた送信元には応答しない (ランダムなサブドメインへの問い合わせへの対策)
+		return
+	}
+	if err := w.WriteMsg(m); err != nil {
+		slog.Debug("failed to write dns response", "error", err)
+	}
+}
+
+func (s *embeddedDNSServer) answer(req *dns.Msg) *dns.Msg {
+	q := req.Question[0]
+	m := new(dns.Msg)
+	m.SetReply(req)
+	m.Authoritative = true
+
+	name := strings.ToLower(q.Name)
+	if q.Qclass != dns.ClassINET || !dns.IsSubDomain(s.zone, name) {
+		m.Authoritative = false
+		m.Rcode = dns.RcodeRefused
+		return m
+	}
+
+	if rrs, ok := s.static[name]; ok {
+		for _, rr := range rrs {
+			if q.Qtype == dns.TypeANY || rr.Header().Rrtype == q.Qtype || rr.Header().Rrtype == dns.TypeCNAME {
+				m.Answer = append(m.Answer, dns.Copy(rr))
+			}
+		}
+		if len(m.Answer) == 0 {
+			m.Ns = append(m.Ns, s.negativeSOA())
+		}
+		return m
+	}
+
+	if label, ok := dnsZoneLabel(name); ok {
+		if _, ok := s.users.Load(label); ok {
+			if q.Qtype == dns.TypeA || q.Qtype == dns.TypeANY {
+				m.Answer = append(m.Answer, &dns.A{
+					Hdr: dns.RR_Header{Name: q.Name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 0},
+					A:   net.ParseIP(s.address),
+				})
+			} else {
+				m.Ns = append(m.Ns, s.negativeSOA())
+			}
+			return m
+		}
+	}
+
+	m.Rcode = dns.RcodeNameError
+	m.Ns = append(m.Ns, s.negativeSOA())
+	return m
+}
+
+// negativeSOA は否定応答のキャッシュ期間を伝えるSOA (RFC 2308)
+// TTLはSOAのTTLとMINIMUMの小さい方
+func (s *embeddedDNSServer) negativeSOA() dns.RR {
+	soa := dns.Copy(s.soa).(*dns.SOA)
+	if soa.Minttl < soa.Hdr.Ttl {
+		soa.Hdr.Ttl = soa.Minttl
+	}
+	return soa
+}
+
+func (s *embeddedDNSServer) allowNXDomain(addr net.Addr) bool {
+	if s.nxRate <= 0 {
+		return true
+	}
+
+	var host string
+	switch a := addr.(type) {
+	case *net.UDPAddr:
+		host = a.IP.String()
+	case *net.TCPAddr:
+		host = a.IP.String()
+	default:
+		host = addr.String()
+	}
+
+	now := time.Now()
+	rl, _ := s.limiters.LoadOrCompute(host, func() *dnsRateLimiter {
+		return &dnsRateLimiter{limiter: rate.NewLimiter(s.nxRate, s.nxBurst)}
+	})
+	rl.lastSeen.Store(now.UnixNano())
+	return rl.limiter.AllowN(now, 1)
+}
+
+// runLimiterSweeper はしばらく問い合わせのない送信元のレートリミッタを破棄する
+func (s *embeddedDNSServer) runLimiterSweeper(idle time.Duration) {
+	ticker := time.NewTicker(idle)
+	defer ticker.Stop()
+	for now := range ticker.C {
+		s.limiters.Range(func(host string, rl *dnsRateLimiter) bool {
+			if now.Sub(time.Unix(0, rl.lastSeen.Load())) > idle {
+				s.limiters.Delete(host)
+			}
+			return true
+		})
+	}
+}
+
+// embeddedDNSProvisioner は組み込みDNSサーバにレコードを反映する
+// CacheBusで通知するので、全インスタンスの組み込みDNSサーバに反映される
+// 届かなかったインスタンスがあればエラーを返し、outboxに残して再送させる
+type embeddedDNSProvisioner struct{}
+
+func (embeddedDNSProvisioner) AddRecord(ctx context.Context, name string) error {
+	return cacheBus.Publish(ctx, CacheInvalidation{Topic: cacheTopicDNS, Key: name})
+}
+
+func (embeddedDNSProvisioner) DeleteRecord(ctx context.Context, name string) error {
+	return cacheBus.Publish(ctx, CacheInvalidation{Topic: cacheTopicDNS, Key: name})
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/miekg/dns"
+)
+
+const (
+	dnsZoneFileEnvKey = "ISUCON13_DNS_ZONE_FILE"
+
+	// ゾーンファイル中の置換対象 (pdns/init_zone.shと同じ)
+	dnsZoneFileAddressPlaceholder = "<ISUCON_SUBDOMAIN_ADDRESS>"
+)
+
+// parseDNSZoneFile はゾーンファイルを読み込む (<ISUCON_SUBDOMAIN_ADDRESS>はaddressに置換する)
+func parseDNSZoneFile(path string, address string) ([]dns.RR, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read zone file: %w", err)
+	}
+
+	content := strings.ReplaceAll(string(raw), dnsZoneFileAddressPlaceholder, address)
+	zp := dns.NewZoneParser(strings.NewReader(content), dns.Fqdn(dnsZone), path)
+	var rrs []dns.RR
+	for rr, ok := zp.Next(); ok; rr, ok = zp.Next() {
+		rrs = append(rrs, rr)
+	}
+	if err := zp.Err(); err != nil {
+		return nil, fmt.Errorf("failed to parse zone file: %w", err)
+	}
+	return rrs, nil
+}
+
+// dnsZoneFileLabels はゾーンファイルに定義されたサブドメインのラベル (小文字) を返す
+func dnsZoneFileLabels(path string) (map[string]struct{}, error) {
+	rrs, err := parseDNSZoneFile(path, "127.0.0.1")
+	if err != nil {
+		return nil, err
+	}
+	labels := make(map[string]struct{}, len(rrs))
+	for _, rr := range rrs {
+		if label, ok := dnsZoneLabel(rr.Header().Name); ok {
+			labels[label] = struct{}{}
+		}
+	}
+	return labels, nil
+}
+
+// dnsZoneLabel は<label>.u.isucon.dev(.)からラベルを取り出す (小文字)
+// ゾーン直下の1段のサブドメインでなければfalseを返す
+func dnsZoneLabel(name string) (string, bool) {
+	name = strings.TrimSuffix(strings.ToLower(name), ".")
+	label := strings.TrimSuffix(name, "."+dnsZone)
+	if label == name || label == "" || strings.Contains(label, ".") {
+		return "", false
+	}
+	return label, true
+}
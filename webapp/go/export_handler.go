@@ -0,0 +1,349 @@
+package main
+
+import (
+	"archive/zip"
+	"bufio"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo-contrib/session"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	exportFormatJSON = "json"
+	exportFormatZIP  = "zip"
+
+	// exportPageSize はエクスポートで1回に読む行数 (セクションを丸ごとメモリに載せない)
+	exportPageSize = 1000
+)
+
+// UserExportProfile はエクスポートの先頭に書くユーザ自身の情報
+// JSONではこの後ろに各セクションの配列が続き、ZIPではprofile.jsonになる
+type UserExportProfile struct {
+	ExportedAt int64           `json:"exported_at"`
+	Profile    UserModel       `json:"profile"`
+	Theme      *ThemeModel     `json:"theme"`
+	Icon       *UserExportIcon `json:"icon"`
+}
+
+// UserExportIcon のImageはJSONではbase64になる (ZIPでは別ファイルにする)
+type UserExportIcon struct {
+	Hash        string `json:"hash"`
+	ContentType string `json:"content_type"`
+	Image       []byte `json:"image,omitempty"`
+	File        string `json:"file,omitempty"`
+}
+
+// exportSection はユーザのデータのうち、件数に上限がないもの
+type exportSection struct {
+	name string
+	// write は行をページごとに読んで書き出す
+	write func(ctx context.Context, tx Tx, userID int64, w *exportArrayWriter) error
+}
+
+// newExportSection はidの昇順にexportPageSize件ずつ読むセクションを作る
+func newExportSection[T any](name string, listPage func(ctx context.Context, tx Tx, userID int64, afterID int64, limit int) ([]T, error), id func(T) int64) exportSection {
+	return exportSection{
+		name: name,
+		write: func(ctx context.Context, tx Tx, userID int64, w *exportArrayWriter) error {
+			var afterID int64
+			for {
+				rows, err := listPage(ctx, tx, userID, afterID, exportPageSize)
+				if err != nil {
+					return fmt.Errorf("failed to get %s: %w", name, err)
+				}
+				for _, row := range rows {
+					if err := w.Write(row); err != nil {
+						return err
+					}
+				}
+				if len(rows) < exportPageSize {
+					return nil
+				}
+				afterID = id(rows[len(rows)-1])
+			}
+		},
+	}
+}
+
+// userExportSections はJSONのキーの順に並べる (ZIPでは<name>.jsonにする)
+var userExportSections = []exportSection{
+	newExportSection("livestreams", func(ctx context.Context, tx Tx, userID int64, afterID int64, limit int) ([]LivestreamModel, error) {
+		return tx.Livestreams().ListPageByUserID(ctx, userID, afterID, limit)
+	}, func(l LivestreamModel) int64 { return l.ID }),
+	newExportSection("livecomments", func(ctx context.Context, tx Tx, userID int64, afterID int64, limit int) ([]LivecommentModel, error) {
+		return tx.Livecomments().ListPageByUserID(ctx, userID, false, afterID, limit)
+	}, func(lc LivecommentModel) int64 { return lc.ID }),
+	newExportSection("reactions", func(ctx context.Context, tx Tx, userID int64, afterID int64, limit int) ([]ReactionModel, error) {
+		return tx.Reactions().ListPageByUserID(ctx, userID, afterID, limit)
+	}, func(r ReactionModel) int64 { return r.ID }),
+	// 送ったチップ (livecommentsのうちチップ付きのもの)
+	newExportSection("tips_sent", func(ctx context.Context, tx Tx, userID int64, afterID int64, limit int) ([]LivecommentModel, error) {
+		return tx.Livecomments().ListPageByUserID(ctx, userID, true, afterID, limit)
+	}, func(lc LivecommentModel) int64 { return lc.ID }),
+	// 自分の配信で受け取ったチップ
+	newExportSection("tips_received", func(ctx context.Context, tx Tx, userID int64, afterID int64, limit int) ([]LivecommentModel, error) {
+		return tx.Livecomments().ListTipsReceivedPage(ctx, userID, afterID, limit)
+	}, func(lc LivecommentModel) int64 { return lc.ID }),
+	newExportSection("reports", func(ctx context.Context, tx Tx, userID int64, afterID int64, limit int) ([]LivecommentReportModel, error) {
+		return tx.Reports().ListPageByUserID(ctx, userID, afterID, limit)
+	}, func(r LivecommentReportModel) int64 { return r.ID }),
+	newExportSection("ng_words", func(ctx context.Context, tx Tx, userID int64, afterID int64, limit int) ([]NGWord, error) {
+		return tx.NGWords().ListPageByUserID(ctx, userID, afterID, limit)
+	}, func(w NGWord) int64 { return w.ID }),
+	newExportSection("viewing_history", func(ctx context.Context, tx Tx, userID int64, afterID int64, limit int) ([]LivestreamViewerHistoryModel, error) {
+		return tx.Viewers().ListHistoryPageByUserID(ctx, userID, afterID, limit)
+	}, func(v LivestreamViewerHistoryModel) int64 { return v.ID }),
+}
+
+// exportArrayWriter は要素を1つずつJSONの配列として書き出す
+type exportArrayWriter struct {
+	w io.Writer
+	// 空でなければ要素ごとに改行してインデントする
+	indent string
+	n      int
+}
+
+func (a *exportArrayWriter) Write(v any) error {
+	var (
+		b   []byte
+		err error
+	)
+	sep := ","
+	if a.n == 0 {
+		sep = "["
+	}
+	if a.indent != "" {
+		sep += "\n" + a.indent
+		b, err = json.MarshalIndent(v, a.indent, a.indent)
+	} else {
+		b, err = json.Marshal(v)
+	}
+	if err != nil {
+		return err
+	}
+	if _, err := io.WriteString(a.w, sep); err != nil {
+		return err
+	}
+	if _, err := a.w.Write(b); err != nil {
+		return err
+	}
+	a.n++
+	return nil
+}
+
+// Close は配列を閉じる
+func (a *exportArrayWriter) Close() error {
+	end := "]"
+	switch {
+	case a.n == 0:
+		end = "[]"
+	case a.indent != "":
+		end = "\n]"
+	}
+	_, err := io.WriteString(a.w, end)
+	return err
+}
+
+// 自分のデータのエクスポート
+// ?format=zip の場合はZIP、それ以外はJSONで返す
+// ライブコメントなどは件数に上限がないので、ページごとに読みながらレスポンスに書き出す
+// GET /api/user/me/export
+func exportMeHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	if err := verifyUserSession(c); err != nil {
+		// echo.NewHTTPErrorが返っているのでそのまま出力
+		return err
+	}
+
+	// error already checked
+	sess, _ := session.Get(defaultSessionIDKey, c)
+	// existence already checked
+	userID := sess.Values[defaultUserIDKey].(int64)
+
+	format := exportFormatJSON
+	if v := c.QueryParam("format"); v != "" {
+		format = v
+	}
+	if format != exportFormatJSON && format != exportFormatZIP {
+		return echo.NewHTTPError(http.StatusBadRequest, "format query parameter must be json or zip")
+	}
+
+	// 一貫したスナップショットを取るため、書き出し終わるまで読み取り専用のトランザクションで読む
+	tx, err := store.BeginReplicaRead(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to begin transaction: "+err.Error())
+	}
+	defer tx.Rollback()
+
+	profile, err := buildUserExportProfile(ctx, tx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return echo.NewHTTPError(http.StatusNotFound, "not found user that has the userid in session")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to export user data: "+err.Error())
+	}
+
+	filename := fmt.Sprintf("isupipe-%s-%s.%s", profile.Profile.Name, time.Unix(profile.ExportedAt, 0).UTC().Format("20060102T150405Z"), format)
+	c.Response().Header().Set(echo.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+	c.Response().Header().Set("Cache-Control", "no-store")
+
+	if format == exportFormatZIP {
+		c.Response().Header().Set(echo.HeaderContentType, "application/zip")
+		c.Response().WriteHeader(http.StatusOK)
+		// ヘッダを送った後のエラーはステータスを変えられないので、ログに残すだけ
+		if err := writeUserExportZIP(ctx, c.Response(), tx, profile); err != nil {
+			requestLogger(c).Error("failed to write export zip", "error", err)
+		}
+		return nil
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
+	c.Response().WriteHeader(http.StatusOK)
+	if err := writeUserExportJSON(ctx, c.Response(), tx, profile); err != nil {
+		requestLogger(c).Error("failed to write export json", "error", err)
+	}
+	return nil
+}
+
+func buildUserExportProfile(ctx context.Context, tx Tx, userID int64) (*UserExportProfile, error) {
+	export := &UserExportProfile{
+		ExportedAt: time.Now().Unix(),
+	}
+
+	profile, err := tx.Users().Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	export.Profile = profile
+
+	theme, err := tx.Themes().GetByUserID(ctx, userID)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("failed to get theme: %w", err)
+		}
+	} else {
+		export.Theme = &theme
+	}
+
+	imageHash, err := tx.Icons().GetHashByUserID(ctx, userID)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("failed to get icon: %w", err)
+		}
+	} else {
+		icon, err := loadIcon(ctx, iconCacheKey{Hash: imageHash})
+		if err != nil {
+			return nil, fmt.Errorf("failed to load icon: %w", err)
+		}
+		export.Icon = &UserExportIcon{
+			Hash:        imageHash,
+			ContentType: icon.ContentType,
+			Image:       icon.Image,
+		}
+	}
+
+	return export, nil
+}
+
+// writeUserExportJSON はプロフィールと各セクションを1つのJSONオブジェクトとして書き出す
+func writeUserExportJSON(ctx context.Context, w io.Writer, tx Tx, profile *UserExportProfile) error {
+	bw := bufio.NewWriter(w)
+
+	head, err := json.Marshal(profile)
+	if err != nil {
+		return err
+	}
+	// プロフィールのオブジェクトを閉じずに、続けてセクションを書く
+	if _, err := bw.Write(head[:len(head)-1]); err != nil {
+		return err
+	}
+	for _, section := range userExportSections {
+		if _, err := bw.WriteString(`,"` + section.name + `":`); err != nil {
+			return err
+		}
+		a := &exportArrayWriter{w: bw}
+		if err := section.write(ctx, tx, profile.Profile.ID, a); err != nil {
+			return err
+		}
+		if err := a.Close(); err != nil {
+			return err
+		}
+	}
+	if _, err := bw.WriteString("}\n"); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// writeUserExportZIP はセクションごとのJSONファイルとアイコン画像をZIPに書き出す
+func writeUserExportZIP(ctx context.Context, w io.Writer, tx Tx, profile *UserExportProfile) error {
+	zw := zip.NewWriter(w)
+
+	head := *profile
+	if profile.Icon != nil {
+		head.Icon = &UserExportIcon{
+			Hash:        profile.Icon.Hash,
+			ContentType: profile.Icon.ContentType,
+			File:        "icon" + iconFileExtension(profile.Icon.ContentType),
+		}
+		f, err := zw.Create(head.Icon.File)
+		if err != nil {
+			return err
+		}
+		if _, err := f.Write(profile.Icon.Image); err != nil {
+			return err
+		}
+	}
+
+	f, err := zw.Create("profile.json")
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(head); err != nil {
+		return err
+	}
+
+	for _, section := range userExportSections {
+		f, err := zw.Create(section.name + ".json")
+		if err != nil {
+			return err
+		}
+		a := &exportArrayWriter{w: f, indent: "  "}
+		if err := section.write(ctx, tx, profile.Profile.ID, a); err != nil {
+			return err
+		}
+		if err := a.Close(); err != nil {
+			return err
+		}
+		if _, err := io.WriteString(f, "\n"); err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
+
+func iconFileExtension(contentType string) string {
+	switch contentType {
+	case iconContentTypePNG:
+		return ".png"
+	case iconContentTypeGIF:
+		return ".gif"
+	case iconContentTypeWebP:
+		return ".webp"
+	default:
+		return ".jpg"
+	}
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+)
+
+func TestWriteUserExportJSONPages(t *testing.T) {
+	origConfig, origStore := appConfig, store
+	t.Cleanup(func() { appConfig, store = origConfig, origStore })
+
+	appConfig = defaultConfig()
+	s, err := newMemoryStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	store = s
+
+	// 複数ページにまたがる件数のリアクション
+	const userID, reactions = 1, exportPageSize*2 + 1
+	ctx := context.Background()
+	err = withTx(ctx, func(tx Tx) error {
+		for i := 0; i < reactions; i++ {
+			if _, err := tx.Reactions().Create(ctx, ReactionModel{UserID: userID, LivestreamID: 1, EmojiName: "tada"}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	err = withReadOnlyTx(ctx, func(tx Tx) error {
+		profile, err := buildUserExportProfile(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+		return writeUserExportJSON(ctx, &buf, tx, profile)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var export struct {
+		Profile        UserModel       `json:"profile"`
+		Reactions      []ReactionModel `json:"reactions"`
+		NGWords        []NGWord        `json:"ng_words"`
+		ViewingHistory []any           `json:"viewing_history"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &export); err != nil {
+		t.Fatalf("export is not valid json: %v", err)
+	}
+	if export.Profile.ID != userID {
+		t.Errorf("profile.id = %d, want %d", export.Profile.ID, userID)
+	}
+	if len(export.Reactions) < reactions {
+		t.Fatalf("len(reactions) = %d, want at least %d", len(export.Reactions), reactions)
+	}
+	for i := 1; i < len(export.Reactions); i++ {
+		if export.Reactions[i-1].ID >= export.Reactions[i].ID {
+			t.Fatalf("reactions must be in ascending id order without duplicates (%d, %d)", export.Reactions[i-1].ID, export.Reactions[i].ID)
+		}
+	}
+	if export.NGWords == nil || export.ViewingHistory == nil {
+		t.Error("empty sections must be written as []")
+	}
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	readinessCheckTimeout = 2 * time.Second
+
+	readinessOK       = "ok"
+	readinessNotReady = "not ready"
+)
+
+var (
+	errShuttingDown  = errors.New("shutting down")
+	errInitializing  = errors.New("initialize is running")
+	errCachesWarming = errors.New("caches are warming up")
+)
+
+// readiness はロードバランサに返すための状態
+var readiness = &readinessState{}
+
+type readinessState struct {
+	// 実行中の/api/initializeの数
+	initializing atomic.Int32
+	cachesWarmed atomic.Bool
+	shuttingDown atomic.Bool
+}
+
+// DNSHealthChecker はDNSバックエンドへの疎通を確認できるDNSProvisioner
+type DNSHealthChecker interface {
+	Ping(ctx context.Context) error
+}
+
+type ReadinessResponse struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks"`
+	// 準備完了かどうかには影響しないが、運用者が対応すべきもの
+	Warnings map[string]string `json:"warnings,omitempty"`
+}
+
+// warmCachesInBackground はキャッシュを読み込み終わるまでreadyzを失敗させる
+func warmCachesInBackground() {
+	readiness.cachesWarmed.Store(false)
+	go func() {
+		if err := warmCaches(context.Background()); err != nil {
+			// 読み込めなくてもキャッシュはリクエスト時に埋まるので、準備完了とする
+			slog.Error("failed to warm caches", "error", err)
+		}
+		readiness.cachesWarmed.Store(true)
+	}()
+}
+
+// プロセスが動いているかどうか
+// GET /healthz
+func getHealthzHandler(c echo.Context) error {
+	return c.String(http.StatusOK, readinessOK)
+}
+
+// リクエストを受け付けられるかどうか
+// GET /readyz
+func getReadyzHandler(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), readinessCheckTimeout)
+	defer cancel()
+
+	res := ReadinessResponse{
+		Status: readinessOK,
+		Checks: map[string]string{},
+	}
+	check := func(name string, err error) {
+		if err != nil {
+			res.Status = readinessNotReady
+			res.Checks[name] = err.Error()
+			return
+		}
+		res.Checks[name] = readinessOK
+	}
+
+	if readiness.shuttingDown.Load() {
+		check("shutdown", errShuttingDown)
+	}
+	if readiness.initializing.Load() > 0 {
+		check("initialize", errInitializing)
+	}
+
+	check("db", store.Ping(ctx))
+
+	var dnsErr error
+	if checker, ok := dnsProvisioner.(DNSHealthChecker); ok {
+		dnsErr = checker.Ping(ctx)
+	}
+	check("dns", dnsErr)
+
+	var cacheErr error
+	if !readiness.cachesWarmed.Load() {
+		cacheErr = errCachesWarming
+	}
+	check("caches", cacheErr)
+
+	// デッドレターはどのインスタンスでも同じなので、ロードバランサから外しても直らない
+	if dead := dnsOutboxDeadLetters.Load(); dead > 0 {
+		res.Warnings = map[string]string{
+			"dns_outbox": fmt.Sprintf("%d entries exceeded dns.outbox.max_attempts (see dns_outbox.last_error)", dead),
+		}
+	}
+
+	if res.Status != readinessOK {
+		return c.JSON(http.StatusServiceUnavailable, res)
+	}
+	return c.JSON(http.StatusOK, res)
+}
+
+func (p *powerDNSAPIProvisioner) Ping(ctx context.Context) error {
+	var server map[string]any
+	return p.get(ctx, p.baseURL+"/api/v1/servers/"+p.serverID, &server)
+}
+
+func (p *mySQLDNSProvisioner) Ping(ctx context.Context) error {
+	return p.db.PingContext(ctx)
+}
+
+func (embeddedDNSProvisioner) Ping(ctx context.Context) error {
+	if dnsServer == nil || !dnsServer.Running() {
+		return errors.New("embedded dns server is not running")
+	}
+	return nil
+}
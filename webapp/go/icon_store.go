@@ -0,0 +1,283 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+const (
+	iconStoreMySQL      = "mysql"
+	iconStoreFilesystem = "fs"
+	iconStoreS3         = "s3"
+)
+
+var errIconNotFound = errors.New("icon not found")
+
+// IconStore はアイコン画像の保存先
+// 画像はicon_hashとサイズ (0は元画像) で一意に決まる (content-addressed)
+// どのユーザからも参照されなくなった画像はreleaseIconで削除する
+type IconStore interface {
+	// Putは既にあっても書き直す (削除と入れ違いになっても画像が残るようにする)
+	Put(ctx context.Context, key iconCacheKey, icon *cachedIcon) error
+	// 見つからない場合はerrIconNotFoundを返す
+	Get(ctx context.Context, key iconCacheKey) (*cachedIcon, error)
+	// Deleteは存在しなくてもエラーにしない
+	Delete(ctx context.Context, key iconCacheKey) error
+}
+
+var iconStore IconStore
+
+// newIconStore はicon.storeで指定された保存先を作る
+func newIconStore(cfg IconConfig) (IconStore, error) {
+	switch cfg.Store {
+	case iconStoreMySQL:
+		return &mysqlIconStore{}, nil
+	case iconStoreFilesystem:
+		return newFilesystemIconStore(cfg.Dir)
+	case iconStoreS3:
+		return newS3IconStore(cfg.S3)
+	default:
+		return nil, fmt.Errorf("unknown icon store '%s' (mysql, fs or s3)", cfg.Store)
+	}
+}
+
+// setupIconStore はIconStoreとアイコンキャッシュの上限を設定する
+func setupIconStore() error {
+	s, err := newIconStore(appConfig.Icon)
+	if err != nil {
+		return fmt.Errorf("failed to setup icon store: %w", err)
+	}
+	iconStore = s
+	iconImageCache.Resize(appConfig.Icon.CacheBytes)
+	return nil
+}
+
+// loadIcon はキャッシュ、IconStore、移行前のiconsテーブルの順に画像を探す
+// 縮小版が見つからない場合は元画像を返す
+func loadIcon(ctx context.Context, key iconCacheKey) (*cachedIcon, error) {
+	cached, ok := iconImageCache.Load(key)
+	observeCache(cacheNameIconImage, ok)
+	if ok {
+		return cached, nil
+	}
+
+	icon, err := iconStore.Get(ctx, key)
+	if errors.Is(err, errIconNotFound) && key.Size != 0 {
+		icon, err = loadIcon(ctx, iconCacheKey{Hash: key.Hash})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if errors.Is(err, errIconNotFound) {
+		icon, err = loadLegacyIcon(ctx, key.Hash)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	iconImageCache.Store(key, icon)
+	return icon, nil
+}
+
+// IconStore導入前はiconsテーブルのimageカラムに画像を保存していた
+func loadLegacyIcon(ctx context.Context, imageHash string) (*cachedIcon, error) {
+	var iconModel IconModel
+	err := withReadOnlyTx(ctx, func(tx Tx) error {
+		var err error
+		iconModel, err = tx.Icons().GetLegacyImage(ctx, imageHash)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errIconNotFound
+		}
+		return nil, err
+	}
+	return &cachedIcon{
+		ContentType: iconModel.ContentType,
+		Image:       iconModel.Image,
+	}, nil
+}
+
+// storeIcon は元画像と縮小版をIconStoreに保存する
+func storeIcon(ctx context.Context, imageHash string, icon *processedIcon) error {
+	if err := iconStore.Put(ctx, iconCacheKey{Hash: imageHash}, &cachedIcon{
+		ContentType: icon.ContentType,
+		Image:       icon.Image,
+	}); err != nil {
+		return err
+	}
+	for _, variant := range icon.Variants {
+		if err := iconStore.Put(ctx, iconCacheKey{Hash: imageHash, Size: variant.Size}, &cachedIcon{
+			ContentType: variant.ContentType,
+			Image:       variant.Image,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lockIcon は画像ごとのロックを取る
+// 画像の保存から参照の記録まで (アップロード) と、参照の確認から削除まで (releaseIcon) をこのロックで直列化する
+func lockIcon(ctx context.Context, imageHash string) (func(), error) {
+	// GET_LOCKの名前は64文字までなので、ハッシュの先頭だけを使う (衝突しても待つだけ)
+	return store.Lock(ctx, "isupipe.icon."+imageHash[:min(len(imageHash), 48)])
+}
+
+// replaceUserIcon は画像をIconStoreに保存してからユーザのアイコンを差し替え、新しい行のIDと差し替え前の画像のハッシュを返す
+// 保存してから参照を記録するまでの間に、同じ画像を参照しなくなったユーザのreleaseIconが削除しないようロックを取る
+func replaceUserIcon(ctx context.Context, userID int64, imageHash string, icon *processedIcon) (iconID int64, oldImageHash string, err error) {
+	unlock, err := lockIcon(ctx, imageHash)
+	if err != nil {
+		return 0, "", err
+	}
+	defer unlock()
+
+	// 画像はハッシュで一意に決まるので、コミットに失敗して残っても問題ない
+	if err := storeIcon(ctx, imageHash, icon); err != nil {
+		return 0, "", err
+	}
+
+	err = withTx(ctx, func(tx Tx) error {
+		var err error
+		oldImageHash, err = tx.Icons().GetHashByUserID(ctx, userID)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		iconID, err = tx.Icons().Replace(ctx, userID, imageHash, icon.ContentType)
+		return err
+	})
+	if err != nil {
+		return 0, "", err
+	}
+	return iconID, oldImageHash, nil
+}
+
+// releaseIcon はどのユーザからも参照されなくなった画像を、縮小版を含めてIconStoreから削除する
+// 削除した場合はtrueを返す
+func releaseIcon(ctx context.Context, imageHash string) (bool, error) {
+	unlock, err := lockIcon(ctx, imageHash)
+	if err != nil {
+		return false, err
+	}
+	defer unlock()
+
+	var exists bool
+	err = withReadOnlyTx(ctx, func(tx Tx) error {
+		var err error
+		exists, err = tx.Icons().HashExists(ctx, imageHash)
+		return err
+	})
+	if err != nil || exists {
+		return false, err
+	}
+
+	keys := []iconCacheKey{{Hash: imageHash}}
+	for _, size := range iconVariantSizes {
+		keys = append(keys, iconCacheKey{Hash: imageHash, Size: size})
+	}
+	for _, key := range keys {
+		if err := iconStore.Delete(ctx, key); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// mysqlIconStore はicon_imagesテーブルに画像を保存する
+// (--store=memoryの場合はインメモリのストアに保存する)
+type mysqlIconStore struct{}
+
+func (s *mysqlIconStore) Put(ctx context.Context, key iconCacheKey, icon *cachedIcon) error {
+	return withTx(ctx, func(tx Tx) error {
+		return tx.Icons().PutImage(ctx, key, icon)
+	})
+}
+
+func (s *mysqlIconStore) Get(ctx context.Context, key iconCacheKey) (*cachedIcon, error) {
+	var icon *cachedIcon
+	err := withReadOnlyTx(ctx, func(tx Tx) error {
+		var err error
+		icon, err = tx.Icons().GetImage(ctx, key)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errIconNotFound
+		}
+		return nil, err
+	}
+	return icon, nil
+}
+
+func (s *mysqlIconStore) Delete(ctx context.Context, key iconCacheKey) error {
+	return withTx(ctx, func(tx Tx) error {
+		return tx.Icons().DeleteImage(ctx, key)
+	})
+}
+
+// migrate-icons サブコマンド
+// iconsテーブルのimageカラムに残っている画像を、縮小版を生成しつつIconStoreへ移す
+// -purge を指定すると移行後にimageカラムを空にする
+func runMigrateIcons(ctx context.Context, args []string) error {
+	purge := false
+	for _, arg := range args {
+		switch arg {
+		case "-purge", "--purge":
+			purge = true
+		default:
+			return fmt.Errorf("unknown argument '%s'", arg)
+		}
+	}
+
+	var ids []int64
+	err := withReadOnlyTx(ctx, func(tx Tx) error {
+		var err error
+		ids, err = tx.Icons().ListLegacyIDs(ctx)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get icons: %w", err)
+	}
+
+	for _, id := range ids {
+		var row IconModel
+		err := withReadOnlyTx(ctx, func(tx Tx) error {
+			var err error
+			row, err = tx.Icons().GetLegacy(ctx, id)
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get icon %d: %w", id, err)
+		}
+
+		// 検証に通らない画像も移行はする (縮小版は作らない)
+		// icon_hashが変わらないよう、再エンコードはせず元のバイト列をそのまま保存する
+		icon, err := processIcon(row.Image)
+		if err != nil {
+			icon = &processedIcon{}
+		}
+		icon.ContentType = http.DetectContentType(row.Image)
+		icon.Image = row.Image
+		if err := storeIcon(ctx, row.ImageHash, icon); err != nil {
+			return fmt.Errorf("failed to store icon %d: %w", id, err)
+		}
+
+		if purge {
+			err := withTx(ctx, func(tx Tx) error {
+				return tx.Icons().PurgeLegacyImage(ctx, id)
+			})
+			if err != nil {
+				return fmt.Errorf("failed to purge icon %d: %w", id, err)
+			}
+		}
+		fmt.Printf("migrated icon id=%d hash=%s variants=%d\n", id, row.ImageHash, len(icon.Variants))
+	}
+
+	fmt.Printf("migrated %d icons\n", len(ids))
+	return nil
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// filesystemIconStore はローカルディスクに画像を保存する
+// パスは <dir>/<hashの先頭2文字>/<hash>[_<size>] で、ハッシュから一意に決まる
+type filesystemIconStore struct {
+	dir string
+}
+
+func newFilesystemIconStore(dir string) (*filesystemIconStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create icon store directory: %w", err)
+	}
+	return &filesystemIconStore{dir: dir}, nil
+}
+
+func (s *filesystemIconStore) path(key iconCacheKey) (string, error) {
+	if !isIconHash(key.Hash) {
+		return "", fmt.Errorf("invalid icon hash '%s'", key.Hash)
+	}
+	name := key.Hash
+	if key.Size != 0 {
+		name += "_" + strconv.Itoa(key.Size)
+	}
+	return filepath.Join(s.dir, key.Hash[:2], name), nil
+}
+
+func (s *filesystemIconStore) Put(ctx context.Context, key iconCacheKey, icon *cachedIcon) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	// 書き込み途中のファイルが読まれないよう、一時ファイルに書いてからrenameする
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(icon.Image); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func (s *filesystemIconStore) Get(ctx context.Context, key iconCacheKey) (*cachedIcon, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	image, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, errIconNotFound
+		}
+		return nil, err
+	}
+	return &cachedIcon{
+		ContentType: http.DetectContentType(image),
+		Image:       image,
+	}, nil
+}
+
+func (s *filesystemIconStore) Delete(ctx context.Context, key iconCacheKey) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strconv"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// s3IconStore はS3互換のオブジェクトストレージに画像を保存する
+// ローカルではMinIOを立てて確認できる
+type s3IconStore struct {
+	client *minio.Client
+	bucket string
+	prefix string
+}
+
+func newS3IconStore(cfg IconS3Config) (*s3IconStore, error) {
+	client, err := minio.New(cfg.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(cfg.AccessKey, cfg.SecretKey, ""),
+		Secure: cfg.UseSSL,
+		Region: cfg.Region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &s3IconStore{
+		client: client,
+		bucket: cfg.Bucket,
+		prefix: cfg.Prefix,
+	}, nil
+}
+
+func (s *s3IconStore) objectName(key iconCacheKey) string {
+	name := s.prefix + key.Hash
+	if key.Size != 0 {
+		name += "_" + strconv.Itoa(key.Size)
+	}
+	return name
+}
+
+func (s *s3IconStore) Put(ctx context.Context, key iconCacheKey, icon *cachedIcon) error {
+	_, err := s.client.PutObject(ctx, s.bucket, s.objectName(key), bytes.NewReader(icon.Image), int64(len(icon.Image)), minio.PutObjectOptions{
+		ContentType:  icon.ContentType,
+		CacheControl: iconImmutableCacheControl,
+	})
+	return err
+}
+
+func (s *s3IconStore) Get(ctx context.Context, key iconCacheKey) (*cachedIcon, error) {
+	obj, err := s.client.GetObject(ctx, s.bucket, s.objectName(key), minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer obj.Close()
+
+	info, err := obj.Stat()
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, errIconNotFound
+		}
+		return nil, err
+	}
+	image, err := io.ReadAll(obj)
+	if err != nil {
+		return nil, err
+	}
+	return &cachedIcon{
+		ContentType: info.ContentType,
+		Image:       image,
+	}, nil
+}
+
+// RemoveObjectは存在しないオブジェクトでもエラーにならない
+func (s *s3IconStore) Delete(ctx context.Context, key iconCacheKey) error {
+	return s.client.RemoveObject(ctx, s.bucket, s.objectName(key), minio.RemoveObjectOptions{})
+}
@@ -0,0 +1,161 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"testing"
+	"time"
+)
+
+func setupIconStoreTest(t *testing.T) *filesystemIconStore {
+	t.Helper()
+	origConfig, origStore, origIconStore := appConfig, store, iconStore
+	t.Cleanup(func() { appConfig, store, iconStore = origConfig, origStore, origIconStore })
+
+	appConfig = defaultConfig()
+	s, err := newMemoryStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	store = s
+	fsStore, err := newFilesystemIconStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	iconStore = fsStore
+	return fsStore
+}
+
+func testIcon(t *testing.T, text string) (string, *processedIcon) {
+	t.Helper()
+	icon, err := processIcon(pngWithText(t, text))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 再エンコードでテキストが消えて同じ画像になるので、末尾に付けてハッシュを変える
+	icon.Image = append(icon.Image, text...)
+	sum := sha256.Sum256(icon.Image)
+	return hex.EncodeToString(sum[:]), icon
+}
+
+func iconExists(t *testing.T, hash string) bool {
+	t.Helper()
+	_, err := iconStore.Get(context.Background(), iconCacheKey{Hash: hash})
+	return err == nil
+}
+
+// アイコンを差し替えたら、誰も参照しなくなった画像は削除できること
+func TestReplaceUserIconReleasesOldImage(t *testing.T) {
+	setupIconStoreTest(t)
+	ctx := context.Background()
+	const userID, otherID = 1, 2
+
+	oldHash, oldIcon := testIcon(t, "old")
+	newHash, newIcon := testIcon(t, "new")
+	if _, _, err := replaceUserIcon(ctx, userID, oldHash, oldIcon); err != nil {
+		t.Fatal(err)
+	}
+	// 同じ画像を使っている他のユーザがいる間は消さない
+	if _, _, err := replaceUserIcon(ctx, otherID, oldHash, oldIcon); err != nil {
+		t.Fatal(err)
+	}
+
+	_, prevHash, err := replaceUserIcon(ctx, userID, newHash, newIcon)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if prevHash != oldHash {
+		t.Fatalf("replaceUserIcon must return the previous hash (got %q)", prevHash)
+	}
+	if ok, err := releaseIcon(ctx, oldHash); err != nil || ok {
+		t.Fatalf("image referenced by another user must be kept (released %v, err %v)", ok, err)
+	}
+
+	if _, _, err := replaceUserIcon(ctx, otherID, newHash, newIcon); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := releaseIcon(ctx, oldHash); err != nil || !ok {
+		t.Fatalf("unreferenced image must be released (released %v, err %v)", ok, err)
+	}
+	if iconExists(t, oldHash) {
+		t.Error("old image must be deleted")
+	}
+	if !iconExists(t, newHash) {
+		t.Error("new image must be kept")
+	}
+}
+
+// releaseIconの確認と削除の間に同じ画像がアップロードされても、画像が消えないこと
+func TestReleaseIconWaitsForUpload(t *testing.T) {
+	setupIconStoreTest(t)
+	ctx := context.Background()
+	hash, icon := testIcon(t, "shared")
+	if err := storeIcon(ctx, hash, icon); err != nil {
+		t.Fatal(err)
+	}
+
+	// アップロードが画像を保存してから参照を記録するまでの間にreleaseIconが呼ばれる
+	unlock, err := lockIcon(ctx, hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	type result struct {
+		released bool
+		err      error
+	}
+	done := make(chan result)
+	go func() {
+		released, err := releaseIcon(ctx, hash)
+		done <- result{released, err}
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("releaseIcon must wait for the upload")
+	case <-time.After(50 * time.Millisecond):
+	}
+	err = withTx(ctx, func(tx Tx) error {
+		_, err := tx.Icons().Replace(ctx, 1, hash, icon.ContentType)
+		return err
+	})
+	unlock()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := <-done
+	if r.err != nil || r.released {
+		t.Fatalf("referenced image must not be released (released %v, err %v)", r.released, r.err)
+	}
+	if !iconExists(t, hash) {
+		t.Error("image must be kept")
+	}
+}
+
+func TestFilesystemIconStorePutOverwrites(t *testing.T) {
+	fsStore := setupIconStoreTest(t)
+	ctx := context.Background()
+	hash, icon := testIcon(t, "overwrite")
+	key := iconCacheKey{Hash: hash}
+
+	path, err := fsStore.path(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := fsStore.Put(ctx, key, &cachedIcon{Image: []byte("broken")}); err != nil {
+		t.Fatal(err)
+	}
+	if err := fsStore.Put(ctx, key, &cachedIcon{ContentType: icon.ContentType, Image: icon.Image}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, icon.Image) {
+		t.Error("Put must overwrite the existing file")
+	}
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+)
+
+// pngWithText はtEXtチャンク (メタデータ) を含むPNGを作る
+func pngWithText(t *testing.T, text string) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, 8, 8))
+	for x := 0; x < 8; x++ {
+		for y := 0; y < 8; y++ {
+			img.Set(x, y, color.RGBA{uint8(x * 32), uint8(y * 32), 100, 255})
+		}
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	raw := buf.Bytes()
+
+	// シグネチャ (8バイト) とIHDR (25バイト) の後ろに挿入する
+	data := append([]byte("Comment\x00"), text...)
+	chunk := binary.BigEndian.AppendUint32(nil, uint32(len(data)))
+	chunk = append(chunk, "tEXt"...)
+	chunk = append(chunk, data...)
+	chunk = binary.BigEndian.AppendUint32(chunk, crc32.ChecksumIEEE(chunk[4:]))
+
+	out := append([]byte{}, raw[:33]...)
+	out = append(out, chunk...)
+	return append(out, raw[33:]...)
+}
+
+func TestProcessIconReencodesByDefault(t *testing.T) {
+	if !defaultConfig().Icon.Reencode {
+		t.Fatal("icon.reencode must be enabled by default")
+	}
+
+	const secret = "GPS 35.6812,139.7671"
+	raw := pngWithText(t, secret)
+	if _, err := png.Decode(bytes.NewReader(raw)); err != nil {
+		t.Fatalf("test image is broken: %v", err)
+	}
+
+	orig := appConfig
+	t.Cleanup(func() { appConfig = orig })
+
+	appConfig = defaultConfig()
+	icon, err := processIcon(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(icon.Image, []byte(secret)) {
+		t.Error("metadata must be removed when icon.reencode is enabled")
+	}
+	if icon.ContentType != iconContentTypePNG {
+		t.Errorf("unexpected content type %s", icon.ContentType)
+	}
+
+	// 例外として無効にした場合は、アップロードされたバイト列をそのまま保存する
+	appConfig = defaultConfig()
+	appConfig.Icon.Reencode = false
+	icon, err = processIcon(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(icon.Image, raw) {
+		t.Error("uploaded bytes must be kept when icon.reencode is disabled")
+	}
+}
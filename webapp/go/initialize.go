@@ -0,0 +1,377 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jmoiron/sqlx"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// seedInsertMaxRows はINSERT 1文あたりの最大行数 (プレースホルダは65535個まで)
+	seedInsertMaxRows = 2000
+
+	// snapshotTablePrefix はスナップショットを保存するテーブルの接頭辞 (_snapshot_users など)
+	snapshotTablePrefix = "_snapshot_"
+	snapshotInfoTable   = "_snapshot_info"
+)
+
+// resetTables は/api/initializeで空にするテーブル (sql/init.sqlと同じ、後から追加したテーブルを含む)
+var resetTables = []string{
+	"themes",
+	"icons",
+	"icon_images",
+	"reservation_slots",
+	"livestream_viewers_history",
+	"livestream_unique_viewers",
+	"livestream_presence",
+	"livestream_presence_peaks",
+	"livecomment_reports",
+	"ng_words",
+	"reactions",
+	"tags",
+	"livestream_tags",
+	"livecomments",
+	"livestreams",
+	"users",
+	"dns_outbox",
+	"channel_moderators",
+	"channel_bans",
+	"moderation_logs",
+	"access_tokens",
+}
+
+// snapshotNowColumns は初期データでUNIX_TIMESTAMP()を入れているカラム
+// スナップショットには作った時刻が残るので、戻すときに現在時刻で入れ直す (init.shで流し込んだ場合と同じにする)
+var snapshotNowColumns = map[string]string{
+	"livecomments": "created_at",
+	"reactions":    "created_at",
+	"ng_words":     "created_at",
+}
+
+// InitializePhase は/api/initializeの処理ごとの所要時間
+type InitializePhase struct {
+	Name       string `json:"name"`
+	DurationMS int64  `json:"duration_ms"`
+}
+
+// initializeTimer は処理ごとに時間を計ってスパンを作る
+type initializeTimer struct {
+	phases []InitializePhase
+}
+
+func (t *initializeTimer) measure(ctx context.Context, name string, f func(ctx context.Context) error) error {
+	ctx, span := tracer.Start(ctx, "initialize "+name)
+	start := time.Now()
+	err := f(ctx)
+	endSpan(span, err)
+
+	elapsed := time.Since(start)
+	t.phases = append(t.phases, InitializePhase{Name: name, DurationMS: elapsed.Milliseconds()})
+	slog.InfoContext(ctx, "initialize phase finished", "phase", name, "duration", elapsed, "error", err)
+	return err
+}
+
+// seedTable は初期データのうち、1つのテーブルに同じカラムの組で入れる行
+type seedTable struct {
+	name    string
+	columns []string
+	rows    [][]any
+}
+
+// loadSeedTables は埋め込んだ初期データを読み込み、テーブルごとにまとめる
+// テーブル内の行の順番はファイルに書かれた順のまま (AUTO_INCREMENTのidがinit.shと同じになる)
+func loadSeedTables() ([]*seedTable, error) {
+	now := time.Now().Unix()
+	var (
+		tables []*seedTable
+		byKey  = make(map[string]*seedTable)
+	)
+	for _, name := range seedFileNames {
+		src, err := readSeed(name)
+		if err != nil {
+			return nil, err
+		}
+		p := &seedParser{src: src, now: now}
+		err = p.parseRows(func(table string, columns []string, values []string) error {
+			key := table + "(" + strings.Join(columns, ",") + ")"
+			t, ok := byKey[key]
+			if !ok {
+				t = &seedTable{name: table, columns: columns}
+				byKey[key] = t
+				tables = append(tables, t)
+			}
+			row := make([]any, len(values))
+			for i, v := range values {
+				row[i] = v
+			}
+			t.rows = append(t.rows, row)
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to load seed %s: %w", name, err)
+		}
+	}
+	return tables, nil
+}
+
+// resetMySQL はテーブルを空にして初期データを流し込む
+// 最新のスキーマで作ったスナップショットがあればそこから戻し、なければ初期データのファイルを読み込む
+func resetMySQL(ctx context.Context, db *sqlx.DB, timer *initializeTimer) error {
+	usable, err := snapshotUsable(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	var tables []*seedTable
+	if !usable {
+		err = timer.measure(ctx, "parse_seed", func(ctx context.Context) error {
+			var err error
+			tables, err = loadSeedTables()
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if err := timer.measure(ctx, "truncate", func(ctx context.Context) error {
+		return truncateTables(ctx, db, resetTables)
+	}); err != nil {
+		return err
+	}
+	if usable {
+		return timer.measure(ctx, "restore_snapshot", func(ctx context.Context) error {
+			return restoreSnapshot(ctx, db)
+		})
+	}
+	return timer.measure(ctx, "bulk_insert", func(ctx context.Context) error {
+		return bulkInsertSeed(ctx, db, "", tables)
+	})
+}
+
+func truncateTables(ctx context.Context, db *sqlx.DB, tables []string) error {
+	for _, table := range tables {
+		// TRUNCATEでAUTO_INCREMENTも1に戻る
+		if _, err := db.ExecContext(ctx, "TRUNCATE TABLE `"+table+"`"); err != nil {
+			return fmt.Errorf("failed to truncate %s: %w", table, err)
+		}
+	}
+	return nil
+}
+
+// bulkInsertSeed はテーブルごとに並行して、複数行のINSERTで初期データを入れる
+// 同じテーブルへのINSERTは順番を保つため1つのトランザクションで行う
+func bulkInsertSeed(ctx context.Context, db *sqlx.DB, prefix string, tables []*seedTable) error {
+	byTable := make(map[string][]*seedTable)
+	var names []string
+	for _, t := range tables {
+		if _, ok := byTable[t.name]; !ok {
+			names = append(names, t.name)
+		}
+		byTable[t.name] = append(byTable[t.name], t)
+	}
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+	for _, name := range names {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			if err := insertSeedTable(ctx, db, prefix+name, byTable[name]); err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("failed to insert into %s: %w", prefix+name, err))
+				mu.Unlock()
+			}
+		}(name)
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+func insertSeedTable(ctx context.Context, db *sqlx.DB, table string, parts []*seedTable) error {
+	ctx, span := tracer.Start(ctx, "insertSeedTable", trace.WithAttributes(attribute.String("db.sql.table", table)))
+	var err error
+	defer func() { endSpan(span, err) }()
+
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, part := range parts {
+		placeholder := "(" + strings.TrimSuffix(strings.Repeat("?,", len(part.columns)), ",") + ")"
+		prefix := "INSERT INTO `" + table + "` (" + strings.Join(part.columns, ", ") + ") VALUES "
+		for start := 0; start < len(part.rows); start += seedInsertMaxRows {
+			rows := part.rows[start:min(start+seedInsertMaxRows, len(part.rows))]
+			var query strings.Builder
+			query.WriteString(prefix)
+			args := make([]any, 0, len(rows)*len(part.columns))
+			for i, row := range rows {
+				if i > 0 {
+					query.WriteString(",")
+				}
+				query.WriteString(placeholder)
+				args = append(args, row...)
+			}
+			if _, err = tx.ExecContext(ctx, query.String(), args...); err != nil {
+				return err
+			}
+		}
+	}
+	err = tx.Commit()
+	return err
+}
+
+// snapshotUsable は最新のスキーマと同じ初期データで作ったスナップショットがあるかどうか
+func snapshotUsable(ctx context.Context, db *sqlx.DB) (bool, error) {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return false, err
+	}
+	hash, err := seedHash()
+	if err != nil {
+		return false, err
+	}
+	var info struct {
+		SchemaVersion int64  `db:"schema_version"`
+		SeedHash      string `db:"seed_hash"`
+	}
+	err = db.GetContext(ctx, &info, "SELECT schema_version, seed_hash FROM `"+snapshotInfoTable+"` LIMIT 1")
+	if err != nil {
+		// Error 1146: Table doesn't exist (スナップショットを作っていない)
+		// Error 1054: Unknown column (初期データのハッシュを記録する前のスナップショット)
+		var mysqlErr *mysql.MySQLError
+		if errors.Is(err, sql.ErrNoRows) || errors.As(err, &mysqlErr) && (mysqlErr.Number == 1146 || mysqlErr.Number == 1054) {
+			return false, nil
+		}
+		return false, err
+	}
+	if info.SchemaVersion != int64(len(migrations)) {
+		slog.WarnContext(ctx, "ignore initial data snapshot built with another schema version (run 'isupipe snapshot')", "snapshot_version", info.SchemaVersion, "schema_version", len(migrations))
+		return false, nil
+	}
+	if info.SeedHash != hash {
+		slog.WarnContext(ctx, "ignore initial data snapshot built from other seed files (run 'isupipe snapshot')", "snapshot_seed_hash", info.SeedHash, "seed_hash", hash)
+		return false, nil
+	}
+	return true, nil
+}
+
+// restoreSnapshot はスナップショットのテーブルからINSERT ... SELECTで戻す (MySQLの中だけで完結するので速い)
+func restoreSnapshot(ctx context.Context, db *sqlx.DB) error {
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+	for _, table := range resetTables {
+		wg.Add(1)
+		go func(table string) {
+			defer wg.Done()
+			if err := restoreSnapshotTable(ctx, db, table); err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("failed to restore %s: %w", table, err))
+				mu.Unlock()
+			}
+		}(table)
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+func restoreSnapshotTable(ctx context.Context, db *sqlx.DB, table string) error {
+	if _, err := db.ExecContext(ctx, "INSERT INTO `"+table+"` SELECT * FROM `"+snapshotTablePrefix+table+"`"); err != nil {
+		return err
+	}
+	if column, ok := snapshotNowColumns[table]; ok {
+		if _, err := db.ExecContext(ctx, "UPDATE `"+table+"` SET `"+column+"` = UNIX_TIMESTAMP()"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildSnapshot は初期データを読み込んだスナップショットのテーブルを作る
+// テーブルの定義は現在のスキーマからCREATE TABLE ... LIKEで作るので、マイグレーションの後は作り直すこと
+func buildSnapshot(ctx context.Context, db *sqlx.DB) error {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return err
+	}
+	hash, err := seedHash()
+	if err != nil {
+		return err
+	}
+	tables, err := loadSeedTables()
+	if err != nil {
+		return err
+	}
+
+	if _, err := db.ExecContext(ctx, "DROP TABLE IF EXISTS `"+snapshotInfoTable+"`"); err != nil {
+		return err
+	}
+	for _, table := range resetTables {
+		if _, err := db.ExecContext(ctx, "DROP TABLE IF EXISTS `"+snapshotTablePrefix+table+"`"); err != nil {
+			return err
+		}
+		if _, err := db.ExecContext(ctx, "CREATE TABLE `"+snapshotTablePrefix+table+"` LIKE `"+table+"`"); err != nil {
+			return fmt.Errorf("failed to create snapshot of %s: %w", table, err)
+		}
+	}
+	if err := bulkInsertSeed(ctx, db, snapshotTablePrefix, tables); err != nil {
+		return err
+	}
+
+	// 最後に作ることで、途中で失敗したスナップショットは使われない
+	if _, err := db.ExecContext(ctx, "CREATE TABLE `"+snapshotInfoTable+"` (schema_version BIGINT NOT NULL, seed_hash CHAR(64) NOT NULL, created_at BIGINT NOT NULL)"); err != nil {
+		return err
+	}
+	_, err = db.ExecContext(ctx, "INSERT INTO `"+snapshotInfoTable+"` (schema_version, seed_hash, created_at) VALUES (?, ?, ?)", len(migrations), hash, time.Now().Unix())
+	return err
+}
+
+// runSnapshot は初期データのスナップショットを作り直すサブコマンド
+// 作っておくと/api/initializeは初期データを解析せずにスナップショットから戻す
+func runSnapshot(ctx context.Context, args []string) error {
+	ms, ok := store.(*mysqlStore)
+	if !ok {
+		return errors.New("snapshot is only available with the mysql store")
+	}
+	if err := buildSnapshot(ctx, ms.db); err != nil {
+		return err
+	}
+	fmt.Println("built initial data snapshot")
+	return nil
+}
+
+// rebuildDNSRecords は初期化後のusersテーブルに合わせてユーザのサブドメインを作り直す
+// (以前のinit.shがpdns/init_zone.shでゾーンを読み込み直していたのと同じ)
+func rebuildDNSRecords(ctx context.Context) error {
+	if dnsServer != nil {
+		return dnsServer.LoadUsers(ctx)
+	}
+	if _, ok := dnsProvisioner.(DNSRecordLister); !ok {
+		return nil
+	}
+	report, err := reconcileDNS(ctx, true)
+	if err != nil {
+		return err
+	}
+	if len(report.Errors) > 0 {
+		return fmt.Errorf("failed to fix %d dns records", len(report.Errors))
+	}
+	return nil
+}
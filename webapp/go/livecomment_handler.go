@@ -5,12 +5,10 @@ import (
 	"database/sql"
 	"encoding/json"
 	"errors"
-	"fmt"
 	"net/http"
 	"strconv"
 	"time"
 
-	"github.com/jmoiron/sqlx"
 	"github.com/labstack/echo-contrib/session"
 	"github.com/labstack/echo/v4"
 )
@@ -21,12 +19,12 @@ type PostLivecommentRequest struct {
 }
 
 type LivecommentModel struct {
-	ID           int64  `db:"id"`
-	UserID       int64  `db:"user_id"`
-	LivestreamID int64  `db:"livestream_id"`
-	Comment      string `db:"comment"`
-	Tip          int64  `db:"tip"`
-	CreatedAt    int64  `db:"created_at"`
+	ID           int64  `db:"id" json:"id"`
+	UserID       int64  `db:"user_id" json:"user_id"`
+	LivestreamID int64  `db:"livestream_id" json:"livestream_id"`
+	Comment      string `db:"comment" json:"comment"`
+	Tip          int64  `db:"tip" json:"tip"`
+	CreatedAt    int64  `db:"created_at" json:"created_at"`
 }
 
 type Livecomment struct {
@@ -46,11 +44,11 @@ type LivecommentReport struct {
 }
 
 type LivecommentReportModel struct {
-	ID            int64 `db:"id"`
-	UserID        int64 `db:"user_id"`
-	LivestreamID  int64 `db:"livestream_id"`
-	LivecommentID int64 `db:"livecomment_id"`
-	CreatedAt     int64 `db:"created_at"`
+	ID            int64 `db:"id" json:"id"`
+	UserID        int64 `db:"user_id" json:"user_id"`
+	LivestreamID  int64 `db:"livestream_id" json:"livestream_id"`
+	LivecommentID int64 `db:"livecomment_id" json:"livecomment_id"`
+	CreatedAt     int64 `db:"created_at" json:"created_at"`
 }
 
 type ModerateRequest struct {
@@ -78,38 +76,31 @@ func getLivecommentsHandler(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "livestream_id in path must be integer")
 	}
 
-	tx, err := dbConn.BeginTxx(ctx, nil)
+	tx, err := store.BeginReplicaRead(ctx)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to begin transaction: "+err.Error())
 	}
 	defer tx.Rollback()
 
-	query := "SELECT * FROM livecomments WHERE livestream_id = ? ORDER BY created_at DESC"
+	limit := noLimit
 	if c.QueryParam("limit") != "" {
-		limit, err := strconv.Atoi(c.QueryParam("limit"))
+		limit, err = strconv.Atoi(c.QueryParam("limit"))
 		if err != nil {
 			return echo.NewHTTPError(http.StatusBadRequest, "limit query parameter must be integer")
 		}
-		query += fmt.Sprintf(" LIMIT %d", limit)
+		if limit < 0 {
+			return echo.NewHTTPError(http.StatusBadRequest, "limit query parameter must not be negative")
+		}
 	}
 
-	livecommentModels := []LivecommentModel{}
-	err = tx.SelectContext(ctx, &livecommentModels, query, livestreamID)
-	if errors.Is(err, sql.ErrNoRows) {
-		return c.JSON(http.StatusOK, []*Livecomment{})
-	}
+	livecommentModels, err := tx.Livecomments().ListByLivestreamID(ctx, int64(livestreamID), limit)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get livecomments: "+err.Error())
 	}
 
-	livecomments := make([]Livecomment, len(livecommentModels))
-	for i := range livecommentModels {
-		livecomment, err := fillLivecommentResponse(ctx, tx, livecommentModels[i])
-		if err != nil {
-			return echo.NewHTTPError(http.StatusInternalServerError, "failed to fil livecomments: "+err.Error())
-		}
-
-		livecomments[i] = livecomment
+	livecomments, err := fillLivecommentResponses(ctx, newBatchLoader(tx), livecommentModels)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to fil livecomments: "+err.Error())
 	}
 
 	if err := tx.Commit(); err != nil {
@@ -122,35 +113,47 @@ func getLivecommentsHandler(c echo.Context) error {
 func getNgwords(c echo.Context) error {
 	ctx := c.Request().Context()
 
-	if err := verifyUserSession(c); err != nil {
+	a, err := currentActor(c)
+	if err != nil {
 		return err
 	}
 
-	// error already checked
-	sess, _ := session.Get(defaultSessionIDKey, c)
-	// existence already checked
-	userID := sess.Values[defaultUserIDKey].(int64)
-
 	livestreamID, err := strconv.Atoi(c.Param("livestream_id"))
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, "livestream_id in path must be integer")
 	}
 
-	tx, err := dbConn.BeginTxx(ctx, nil)
+	tx, err := store.BeginReplicaRead(ctx)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to begin transaction: "+err.Error())
 	}
 	defer tx.Rollback()
 
-	var ngWords []*NGWord
-	if err := tx.SelectContext(ctx, &ngWords, "SELECT * FROM ng_words WHERE user_id = ? AND livestream_id = ? ORDER BY created_at DESC", userID, livestreamID); err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return c.JSON(http.StatusOK, []*NGWord{})
-		} else {
-			return echo.NewHTTPError(http.StatusInternalServerError, "failed to get NG words: "+err.Error())
+	// 配信者本人とモデレータには配信者のNGワードを返す (それ以外のユーザは自分が登録したもの)
+	userID := a.User.ID
+	livestreamModel, err := tx.Livestreams().Get(ctx, int64(livestreamID))
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get livestream: "+err.Error())
+	}
+	if err == nil {
+		role, err := livestreamRole(ctx, a, livestreamModel)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to get role: "+err.Error())
+		}
+		if role.AtLeast(RoleChannelModerator) {
+			userID = livestreamModel.UserID
 		}
 	}
 
+	ngWordModels, err := tx.NGWords().ListByUserAndLivestream(ctx, userID, int64(livestreamID))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get NG words: "+err.Error())
+	}
+	ngWords := make([]*NGWord, len(ngWordModels))
+	for i := range ngWordModels {
+		ngWords[i] = &ngWordModels[i]
+	}
+
 	if err := tx.Commit(); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to commit: "+err.Error())
 	}
@@ -181,14 +184,14 @@ func postLivecommentHandler(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "failed to decode the request body as json")
 	}
 
-	tx, err := dbConn.BeginTxx(ctx, nil)
+	tx, err := store.Begin(ctx)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to begin transaction: "+err.Error())
 	}
 	defer tx.Rollback()
 
-	var livestreamModel LivestreamModel
-	if err := tx.GetContext(ctx, &livestreamModel, "SELECT * FROM livestreams WHERE id = ?", livestreamID); err != nil {
+	livestreamModel, err := tx.Livestreams().Get(ctx, int64(livestreamID))
+	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return echo.NewHTTPError(http.StatusNotFound, "livestream not found")
 		} else {
@@ -196,27 +199,24 @@ func postLivecommentHandler(c echo.Context) error {
 		}
 	}
 
+	if err := checkChannelBan(ctx, tx, livestreamModel, userID); err != nil {
+		return err
+	}
+
 	// スパム判定
-	var ngwords []*NGWord
-	if err := tx.SelectContext(ctx, &ngwords, "SELECT id, user_id, livestream_id, word FROM ng_words WHERE user_id = ? AND livestream_id = ?", livestreamModel.UserID, livestreamModel.ID); err != nil && !errors.Is(err, sql.ErrNoRows) {
+	ngwords, err := getNGWordsByLivestream(ctx, tx, livestreamModel)
+	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get NG words: "+err.Error())
 	}
 
-	var hitSpam int
 	for _, ngword := range ngwords {
-		query := `
-		SELECT COUNT(*)
-		FROM
-		(SELECT ? AS text) AS texts
-		INNER JOIN
-		(SELECT CONCAT('%', ?, '%')	AS pattern) AS patterns
-		ON texts.text LIKE patterns.pattern;
-		`
-		if err := tx.GetContext(ctx, &hitSpam, query, req.Comment, ngword.Word); err != nil {
+		hitSpam, err := tx.NGWords().Matches(ctx, req.Comment, ngword.Word)
+		if err != nil {
 			return echo.NewHTTPError(http.StatusInternalServerError, "failed to get hitspam: "+err.Error())
 		}
-		c.Logger().Infof("[hitSpam=%d] comment = %s", hitSpam, req.Comment)
-		if hitSpam >= 1 {
+		requestLogger(c).Debug("checked ng word", "hit_spam", hitSpam, "livestream_id", livestreamID)
+		if hitSpam {
+			spamRejectedTotal.Inc()
 			return echo.NewHTTPError(http.StatusBadRequest, "このコメントがスパム判定されました")
 		}
 	}
@@ -230,15 +230,10 @@ func postLivecommentHandler(c echo.Context) error {
 		CreatedAt:    now,
 	}
 
-	rs, err := tx.NamedExecContext(ctx, "INSERT INTO livecomments (user_id, livestream_id, comment, tip, created_at) VALUES (:user_id, :livestream_id, :comment, :tip, :created_at)", livecommentModel)
+	livecommentID, err := tx.Livecomments().Create(ctx, livecommentModel)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to insert livecomment: "+err.Error())
 	}
-
-	livecommentID, err := rs.LastInsertId()
-	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get last inserted livecomment id: "+err.Error())
-	}
 	livecommentModel.ID = livecommentID
 
 	livecomment, err := fillLivecommentResponse(ctx, tx, livecommentModel)
@@ -250,6 +245,12 @@ func postLivecommentHandler(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to commit: "+err.Error())
 	}
 
+	livecommentsPostedTotal.Inc()
+	if livecomment.Tip > 0 {
+		tipsTotal.Inc()
+		tipAmountTotal.Add(float64(livecomment.Tip))
+	}
+
 	return c.JSON(http.StatusCreated, livecomment)
 }
 
@@ -275,14 +276,14 @@ func reportLivecommentHandler(c echo.Context) error {
 	// existence already checked
 	userID := sess.Values[defaultUserIDKey].(int64)
 
-	tx, err := dbConn.BeginTxx(ctx, nil)
+	tx, err := store.Begin(ctx)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to begin transaction: "+err.Error())
 	}
 	defer tx.Rollback()
 
-	var livestreamModel LivestreamModel
-	if err := tx.GetContext(ctx, &livestreamModel, "SELECT * FROM livestreams WHERE id = ?", livestreamID); err != nil {
+	livestreamModel, err := tx.Livestreams().Get(ctx, int64(livestreamID))
+	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return echo.NewHTTPError(http.StatusNotFound, "livestream not found")
 		} else {
@@ -290,8 +291,11 @@ func reportLivecommentHandler(c echo.Context) error {
 		}
 	}
 
-	var livecommentModel LivecommentModel
-	if err := tx.GetContext(ctx, &livecommentModel, "SELECT * FROM livecomments WHERE id = ?", livecommentID); err != nil {
+	if err := checkChannelBan(ctx, tx, livestreamModel, userID); err != nil {
+		return err
+	}
+
+	if _, err := tx.Livecomments().Get(ctx, int64(livecommentID)); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return echo.NewHTTPError(http.StatusNotFound, "livecomment not found")
 		} else {
@@ -306,14 +310,10 @@ func reportLivecommentHandler(c echo.Context) error {
 		LivecommentID: int64(livecommentID),
 		CreatedAt:     now,
 	}
-	rs, err := tx.NamedExecContext(ctx, "INSERT INTO livecomment_reports(user_id, livestream_id, livecomment_id, created_at) VALUES (:user_id, :livestream_id, :livecomment_id, :created_at)", &reportModel)
+	reportID, err := tx.Reports().Create(ctx, reportModel)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to insert livecomment report: "+err.Error())
 	}
-	reportID, err := rs.LastInsertId()
-	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get last inserted livecomment report id: "+err.Error())
-	}
 	reportModel.ID = reportID
 
 	report, err := fillLivecommentReportResponse(ctx, tx, reportModel)
@@ -332,7 +332,8 @@ func moderateHandler(c echo.Context) error {
 	ctx := c.Request().Context()
 	defer c.Request().Body.Close()
 
-	if err := verifyUserSession(c); err != nil {
+	a, err := currentActor(c)
+	if err != nil {
 		return err
 	}
 
@@ -341,33 +342,26 @@ func moderateHandler(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "livestream_id in path must be integer")
 	}
 
-	// error already checked
-	sess, _ := session.Get(defaultSessionIDKey, c)
-	// existence already checked
-	userID := sess.Values[defaultUserIDKey].(int64)
-
 	var req *ModerateRequest
 	if err := json.NewDecoder(c.Request().Body).Decode(&req); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, "failed to decode the request body as json")
 	}
 
-	tx, err := dbConn.BeginTxx(ctx, nil)
+	tx, err := store.Begin(ctx)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to begin transaction: "+err.Error())
 	}
 	defer tx.Rollback()
 
-	// 配信者自身の配信に対するmoderateなのかを検証
-	var ownedLivestreams []LivestreamModel
-	if err := tx.SelectContext(ctx, &ownedLivestreams, "SELECT * FROM livestreams WHERE id = ? AND user_id = ?", livestreamID, userID); err != nil {
+	// 配信者本人 (またはモデレータ) かどうかはrequireLivestreamRoleで確認済み
+	livestreamModel, err := tx.Livestreams().Get(ctx, int64(livestreamID))
+	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get livestreams: "+err.Error())
 	}
-	if len(ownedLivestreams) == 0 {
-		return echo.NewHTTPError(http.StatusBadRequest, "A streamer can't moderate livestreams that other streamers own")
-	}
 
-	rs, err := tx.NamedExecContext(ctx, "INSERT INTO ng_words(user_id, livestream_id, word, created_at) VALUES (:user_id, :livestream_id, :word, :created_at)", &NGWord{
-		UserID:       int64(userID),
+	// NGワードは配信者のものとして登録する (配信者のNGワード一覧に出るように)
+	wordID, err := tx.NGWords().Create(ctx, NGWord{
+		UserID:       livestreamModel.UserID,
 		LivestreamID: int64(livestreamID),
 		Word:         req.NGWord,
 		CreatedAt:    time.Now().Unix(),
@@ -375,39 +369,39 @@ func moderateHandler(c echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to insert new NG word: "+err.Error())
 	}
-
-	wordID, err := rs.LastInsertId()
-	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get last inserted NG word id: "+err.Error())
+	if err := recordModeration(ctx, tx, ModerationLogModel{
+		StreamerID:   livestreamModel.UserID,
+		LivestreamID: sql.NullInt64{Int64: livestreamModel.ID, Valid: true},
+		ModeratorID:  a.User.ID,
+		Action:       moderationActionAddNGWord,
+		TargetID:     wordID,
+		Detail:       req.NGWord,
+	}); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to insert moderation log: "+err.Error())
 	}
 
-	var ngwords []*NGWord
-	if err := tx.SelectContext(ctx, &ngwords, "SELECT * FROM ng_words WHERE livestream_id = ?", livestreamID); err != nil {
+	ngwords, err := tx.NGWords().ListByLivestreamID(ctx, int64(livestreamID))
+	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get NG words: "+err.Error())
 	}
 
 	// NGワードにヒットする過去の投稿も全削除する
 	for _, ngword := range ngwords {
 		// ライブコメント一覧取得
-		var livecomments []*LivecommentModel
-		if err := tx.SelectContext(ctx, &livecomments, "SELECT * FROM livecomments"); err != nil {
+		livecomments, err := tx.Livecomments().ListByLivestreamID(ctx, int64(livestreamID), noLimit)
+		if err != nil {
 			return echo.NewHTTPError(http.StatusInternalServerError, "failed to get livecomments: "+err.Error())
 		}
 
 		for _, livecomment := range livecomments {
-			query := `
-			DELETE FROM livecomments
-			WHERE
-			id = ? AND
-			livestream_id = ? AND
-			(SELECT COUNT(*)
-			FROM
-			(SELECT ? AS text) AS texts
-			INNER JOIN
-			(SELECT CONCAT('%', ?, '%')	AS pattern) AS patterns
-			ON texts.text LIKE patterns.pattern) >= 1;
-			`
-			if _, err := tx.ExecContext(ctx, query, livecomment.ID, livestreamID, livecomment.Comment, ngword.Word); err != nil {
+			hit, err := tx.NGWords().Matches(ctx, livecomment.Comment, ngword.Word)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete old livecomments that hit spams: "+err.Error())
+			}
+			if !hit {
+				continue
+			}
+			if err := tx.Livecomments().Delete(ctx, livecomment.ID); err != nil {
 				return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete old livecomments that hit spams: "+err.Error())
 			}
 		}
@@ -417,66 +411,124 @@ func moderateHandler(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to commit: "+err.Error())
 	}
 
+	publishCacheInvalidation(c, cacheTopicNGWord, strconv.Itoa(livestreamID))
+
 	return c.JSON(http.StatusCreated, map[string]interface{}{
 		"word_id": wordID,
 	})
 }
 
-func fillLivecommentResponse(ctx context.Context, tx *sqlx.Tx, livecommentModel LivecommentModel) (Livecomment, error) {
-	commentOwnerModel := UserModel{}
-	if err := tx.GetContext(ctx, &commentOwnerModel, "SELECT * FROM users WHERE id = ?", livecommentModel.UserID); err != nil {
-		return Livecomment{}, err
+// getNGWordsByLivestream は配信者が配信に対して登録したNGワードを返す
+func getNGWordsByLivestream(ctx context.Context, tx Tx, livestreamModel LivestreamModel) ([]*NGWord, error) {
+	if ngwords, ok := ngWordCache.Load(livestreamModel.ID); ok {
+		return ngwords, nil
 	}
-	commentOwner, err := fillUserResponse(ctx, tx, commentOwnerModel)
+
+	ngWordModels, err := tx.NGWords().ListByUserAndLivestream(ctx, livestreamModel.UserID, livestreamModel.ID)
 	if err != nil {
-		return Livecomment{}, err
+		return nil, err
 	}
-
-	livestreamModel := LivestreamModel{}
-	if err := tx.GetContext(ctx, &livestreamModel, "SELECT * FROM livestreams WHERE id = ?", livecommentModel.LivestreamID); err != nil {
-		return Livecomment{}, err
+	ngwords := make([]*NGWord, len(ngWordModels))
+	for i := range ngWordModels {
+		ngwords[i] = &ngWordModels[i]
 	}
-	livestream, err := fillLivestreamResponse(ctx, tx, livestreamModel)
+	ngWordCache.Store(livestreamModel.ID, ngwords)
+	return ngwords, nil
+}
+
+func fillLivecommentResponse(ctx context.Context, tx Tx, livecommentModel LivecommentModel) (Livecomment, error) {
+	livecomments, err := fillLivecommentResponses(ctx, newBatchLoader(tx), []LivecommentModel{livecommentModel})
 	if err != nil {
 		return Livecomment{}, err
 	}
+	return livecomments[0], nil
+}
+
+// fillLivecommentResponses は投稿者と配信をまとめて取得してレスポンスを作る
+func fillLivecommentResponses(ctx context.Context, loader *batchLoader, livecommentModels []LivecommentModel) ([]Livecomment, error) {
+	ctx, span := tracer.Start(ctx, "fillLivecommentResponses")
+	defer span.End()
 
-	livecomment := Livecomment{
-		ID:         livecommentModel.ID,
-		User:       commentOwner,
-		Livestream: livestream,
-		Comment:    livecommentModel.Comment,
-		Tip:        livecommentModel.Tip,
-		CreatedAt:  livecommentModel.CreatedAt,
+	userIDs := make([]int64, len(livecommentModels))
+	livestreamIDs := make([]int64, len(livecommentModels))
+	for i, livecommentModel := range livecommentModels {
+		userIDs[i] = livecommentModel.UserID
+		livestreamIDs[i] = livecommentModel.LivestreamID
+	}
+	if err := loader.loadUsers(ctx, userIDs); err != nil {
+		return nil, err
+	}
+	if err := loader.loadLivestreamDetails(ctx, livestreamIDs); err != nil {
+		return nil, err
 	}
 
-	return livecomment, nil
+	livecomments := make([]Livecomment, len(livecommentModels))
+	for i := range livecommentModels {
+		livecomment, err := loader.livecomment(livecommentModels[i])
+		if err != nil {
+			return nil, err
+		}
+		livecomments[i] = livecomment
+	}
+	return livecomments, nil
 }
 
-func fillLivecommentReportResponse(ctx context.Context, tx *sqlx.Tx, reportModel LivecommentReportModel) (LivecommentReport, error) {
-	reporterModel := UserModel{}
-	if err := tx.GetContext(ctx, &reporterModel, "SELECT * FROM users WHERE id = ?", reportModel.UserID); err != nil {
-		return LivecommentReport{}, err
-	}
-	reporter, err := fillUserResponse(ctx, tx, reporterModel)
+func fillLivecommentReportResponse(ctx context.Context, tx Tx, reportModel LivecommentReportModel) (LivecommentReport, error) {
+	reports, err := fillLivecommentReportResponses(ctx, newBatchLoader(tx), []LivecommentReportModel{reportModel})
 	if err != nil {
 		return LivecommentReport{}, err
 	}
+	return reports[0], nil
+}
 
-	livecommentModel := LivecommentModel{}
-	if err := tx.GetContext(ctx, &livecommentModel, "SELECT * FROM livecomments WHERE id = ?", reportModel.LivecommentID); err != nil {
-		return LivecommentReport{}, err
+// fillLivecommentReportResponses は報告者と報告されたライブコメントをまとめて取得してレスポンスを作る
+func fillLivecommentReportResponses(ctx context.Context, loader *batchLoader, reportModels []LivecommentReportModel) ([]LivecommentReport, error) {
+	ctx, span := tracer.Start(ctx, "fillLivecommentReportResponses")
+	defer span.End()
+
+	livecommentIDs := make([]int64, len(reportModels))
+	userIDs := make([]int64, 0, len(reportModels)*2)
+	for i, reportModel := range reportModels {
+		livecommentIDs[i] = reportModel.LivecommentID
+		userIDs = append(userIDs, reportModel.UserID)
+	}
+	if err := loader.loadLivecomments(ctx, livecommentIDs); err != nil {
+		return nil, err
+	}
+	livestreamIDs := make([]int64, 0, len(reportModels))
+	for _, id := range livecommentIDs {
+		if livecommentModel, ok := loader.livecomments[id]; ok {
+			userIDs = append(userIDs, livecommentModel.UserID)
+			livestreamIDs = append(livestreamIDs, livecommentModel.LivestreamID)
+		}
 	}
-	livecomment, err := fillLivecommentResponse(ctx, tx, livecommentModel)
-	if err != nil {
-		return LivecommentReport{}, err
+	if err := loader.loadUsers(ctx, userIDs); err != nil {
+		return nil, err
+	}
+	if err := loader.loadLivestreamDetails(ctx, livestreamIDs); err != nil {
+		return nil, err
 	}
 
-	report := LivecommentReport{
-		ID:          reportModel.ID,
-		Reporter:    reporter,
-		Livecomment: livecomment,
-		CreatedAt:   reportModel.CreatedAt,
+	reports := make([]LivecommentReport, len(reportModels))
+	for i, reportModel := range reportModels {
+		reporter, err := loader.user(reportModel.UserID)
+		if err != nil {
+			return nil, err
+		}
+		livecommentModel, ok := loader.livecomments[reportModel.LivecommentID]
+		if !ok {
+			return nil, sql.ErrNoRows
+		}
+		livecomment, err := loader.livecomment(livecommentModel)
+		if err != nil {
+			return nil, err
+		}
+		reports[i] = LivecommentReport{
+			ID:          reportModel.ID,
+			Reporter:    reporter,
+			Livecomment: livecomment,
+			CreatedAt:   reportModel.CreatedAt,
+		}
 	}
-	return report, nil
+	return reports, nil
 }
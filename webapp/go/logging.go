@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type requestIDContextKey struct{}
+
+// setupLogger はJSON形式のロガーをデフォルトにする
+// logパッケージの出力もこのロガーを通る
+func setupLogger(cfg LogConfig) error {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(cfg.Level)); err != nil {
+		return err
+	}
+	slog.SetDefault(slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: level})))
+	return nil
+}
+
+// requestIDMiddleware はX-Request-Idを付与し (リクエストにあればそれを使う)、レスポンスにも返す
+// IDはリクエストのcontextに載せ、ログとエラーレスポンスに含める
+func requestIDMiddleware() echo.MiddlewareFunc {
+	return middleware.RequestIDWithConfig(middleware.RequestIDConfig{
+		RequestIDHandler: func(c echo.Context, id string) {
+			req := c.Request()
+			c.SetRequest(req.WithContext(context.WithValue(req.Context(), requestIDContextKey{}, id)))
+		},
+	})
+}
+
+// accessLogMiddleware はリクエストごとに1行のアクセスログを出す
+func accessLogMiddleware() echo.MiddlewareFunc {
+	return middleware.RequestLoggerWithConfig(middleware.RequestLoggerConfig{
+		LogMethod:    true,
+		LogURI:       true,
+		LogRoutePath: true,
+		LogStatus:    true,
+		LogLatency:   true,
+		LogError:     true,
+		LogValuesFunc: func(c echo.Context, v middleware.RequestLoggerValues) error {
+			attrs := []any{
+				"method", v.Method,
+				"uri", v.URI,
+				"route", v.RoutePath,
+				"status", v.Status,
+				"latency", v.Latency.Round(time.Microsecond).String(),
+			}
+			if v.Error != nil {
+				attrs = append(attrs, "error", v.Error.Error())
+			}
+			loggerFromContext(c.Request().Context()).Info("request", attrs...)
+			return nil
+		},
+	})
+}
+
+func requestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDContextKey{}).(string)
+	return id
+}
+
+// loggerFromContext はリクエストIDとトレースIDを付けたロガーを返す
+func loggerFromContext(ctx context.Context) *slog.Logger {
+	logger := slog.Default()
+	if id := requestIDFromContext(ctx); id != "" {
+		logger = logger.With("request_id", id)
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		logger = logger.With("trace_id", sc.TraceID().String())
+	}
+	return logger
+}
+
+func requestLogger(c echo.Context) *slog.Logger {
+	return loggerFromContext(c.Request().Context())
+}
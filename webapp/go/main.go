@@ -4,99 +4,64 @@ package main
 // sqlx的な参考: https://jmoiron.github.io/sqlx/
 
 import (
+	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"log"
+	"log/slog"
 	"net"
 	"net/http"
 	"os"
-	"os/exec"
+	"os/signal"
 	"strconv"
+	"strings"
+	"syscall"
 
 	"github.com/go-sql-driver/mysql"
 	"github.com/jmoiron/sqlx"
 	"github.com/labstack/echo/v4"
-	"github.com/labstack/echo/v4/middleware"
 
 	"github.com/gorilla/sessions"
 	"github.com/labstack/echo-contrib/session"
-	echolog "github.com/labstack/gommon/log"
 )
 
 const (
-	listenPort                     = 8080
 	powerDNSSubdomainAddressEnvKey = "ISUCON13_POWERDNS_SUBDOMAIN_ADDRESS"
 )
 
-var (
-	powerDNSSubdomainAddress string
-	dbConn                   *sqlx.DB
-	secret                   = []byte("isucon13_session_cookiestore_defaultsecret")
-)
-
 func init() {
 	log.SetFlags(log.Ldate | log.Ltime | log.Lshortfile)
-	if secretKey, ok := os.LookupEnv("ISUCON13_SESSION_SECRETKEY"); ok {
-		secret = []byte(secretKey)
-	}
 }
 
 type InitializeResponse struct {
-	Language string `json:"language"`
+	Language string            `json:"language"`
+	Phases   []InitializePhase `json:"phases"`
 }
 
-func connectDB(logger echo.Logger) (*sqlx.DB, error) {
-	const (
-		networkTypeEnvKey = "ISUCON13_MYSQL_DIALCONFIG_NET"
-		addrEnvKey        = "ISUCON13_MYSQL_DIALCONFIG_ADDRESS"
-		portEnvKey        = "ISUCON13_MYSQL_DIALCONFIG_PORT"
-		userEnvKey        = "ISUCON13_MYSQL_DIALCONFIG_USER"
-		passwordEnvKey    = "ISUCON13_MYSQL_DIALCONFIG_PASSWORD"
-		dbNameEnvKey      = "ISUCON13_MYSQL_DIALCONFIG_DATABASE"
-		parseTimeEnvKey   = "ISUCON13_MYSQL_DIALCONFIG_PARSETIME"
-	)
-
+func connectDB() (*sqlx.DB, error) {
 	conf := mysql.NewConfig()
-
-	// 環境変数がセットされていなかった場合でも一旦動かせるように、デフォルト値を入れておく
-	// この挙動を変更して、エラーを出すようにしてもいいかもしれない
-	conf.Net = "tcp"
-	conf.Addr = net.JoinHostPort("127.0.0.1", "3306")
-	conf.User = "isucon"
-	conf.Passwd = "isucon"
-	conf.DBName = "isupipe"
-	conf.ParseTime = true
-
-	if v, ok := os.LookupEnv(networkTypeEnvKey); ok {
-		conf.Net = v
-	}
-	if addr, ok := os.LookupEnv(addrEnvKey); ok {
-		if port, ok2 := os.LookupEnv(portEnvKey); ok2 {
-			conf.Addr = net.JoinHostPort(addr, port)
-		} else {
-			conf.Addr = net.JoinHostPort(addr, "3306")
-		}
-	}
-	if v, ok := os.LookupEnv(userEnvKey); ok {
-		conf.User = v
-	}
-	if v, ok := os.LookupEnv(passwordEnvKey); ok {
-		conf.Passwd = v
-	}
-	if v, ok := os.LookupEnv(dbNameEnvKey); ok {
-		conf.DBName = v
-	}
-	if v, ok := os.LookupEnv(parseTimeEnvKey); ok {
-		parseTime, err := strconv.ParseBool(v)
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse environment variable '%s' as bool: %+v", parseTimeEnvKey, err)
-		}
-		conf.ParseTime = parseTime
+	conf.Net = appConfig.Database.Net
+	conf.Addr = appConfig.Database.DatabaseAddr()
+	if conf.Net == "unix" {
+		conf.Addr = appConfig.Database.Address
 	}
+	conf.User = appConfig.Database.User
+	conf.Passwd = appConfig.Database.Password
+	conf.DBName = appConfig.Database.Name
+	conf.ParseTime = appConfig.Database.ParseTime
+
+	return openDB(conf)
+}
 
-	db, err := sqlx.Open("mysql", conf.FormatDSN())
+// openDB はプライマリとレプリカで共通の設定で接続する
+func openDB(conf *mysql.Config) (*sqlx.DB, error) {
+	connector, err := mysql.NewConnector(conf)
 	if err != nil {
 		return nil, err
 	}
+	// クエリの実行時間をメトリクスに記録する
+	db := sqlx.NewDb(sql.OpenDB(instrumentedConnector{connector}), "mysql")
 	db.SetMaxOpenConns(10)
 
 	if err := db.Ping(); err != nil {
@@ -107,30 +72,103 @@ func connectDB(logger echo.Logger) (*sqlx.DB, error) {
 }
 
 func initializeHandler(c echo.Context) error {
-	if out, err := exec.Command("../sql/init.sh").CombinedOutput(); err != nil {
-		c.Logger().Warnf("init.sh failed with err=%s", string(out))
+	// 初期化中はreadyzを失敗させる
+	readiness.initializing.Add(1)
+	defer readiness.initializing.Add(-1)
+
+	ctx := c.Request().Context()
+	timer := &initializeTimer{}
+	if err := store.Reset(ctx, timer); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to initialize: "+err.Error())
 	}
+	// 運営の権限はusersと一緒に消えるので、キャッシュを読み込む前に与え直す
+	if err := timer.measure(ctx, "staff_grants", applyStaffGrants); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to apply staff grants: "+err.Error())
+	}
+	for _, topic := range cacheTopics {
+		publishCacheInvalidation(c, topic, "")
+	}
+
+	// 初期化直後のリクエストがキャッシュミスにならないよう、応答する前に読み込む
+	readiness.cachesWarmed.Store(false)
+	err := timer.measure(ctx, "warm_caches", warmCaches)
+	readiness.cachesWarmed.Store(true)
+	if err != nil {
+		// 読み込めなくてもキャッシュはリクエスト時に埋まるので、失敗にはしない
+		slog.ErrorContext(ctx, "failed to warm caches", "error", err)
+	}
+
+	if err := timer.measure(ctx, "rebuild_dns", rebuildDNSRecords); err != nil {
+		// 残ったレコードは定期的な突き合わせ (dns.reconcile.interval) でも直るので、失敗にはしない
+		slog.ErrorContext(ctx, "failed to rebuild dns records", "error", err)
+	}
 
 	c.Request().Header.Add("Content-Type", "application/json;charset=utf-8")
 	return c.JSON(http.StatusOK, InitializeResponse{
 		Language: "golang",
+		Phases:   timer.phases,
 	})
 }
 
 func main() {
+	// フラグ以外の引数はサブコマンド
+	if len(os.Args) > 1 && !strings.HasPrefix(os.Args[1], "-") {
+		if err := runCommand(os.Args[1], os.Args[2:]); err != nil {
+			log.Fatalf("%s: %+v", os.Args[1], err)
+		}
+		return
+	}
+
+	cfg, printConfig, err := loadConfig(os.Args[1:])
+	if err != nil {
+		log.Fatalf("failed to load config: %+v", err)
+	}
+	if printConfig {
+		// 検証に失敗する設定も確認できるよう、出力してから検証する
+		if err := cfg.Print(os.Stdout); err != nil {
+			log.Fatalf("failed to print config: %+v", err)
+		}
+		if err := cfg.Validate(); err != nil {
+			log.Fatalf("invalid config: %+v", err)
+		}
+		return
+	}
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("invalid config: %+v", err)
+	}
+	appConfig = cfg
+
+	if err := setupLogger(appConfig.Log); err != nil {
+		log.Fatalf("failed to setup logger: %+v", err)
+	}
+
+	shutdownTracing, err := setupTracing(context.Background(), appConfig.Tracing)
+	if err != nil {
+		log.Fatalf("failed to setup tracing: %+v", err)
+	}
+
 	e := echo.New()
-	e.Debug = true
-	e.Logger.SetLevel(echolog.DEBUG)
-	e.Use(middleware.Logger())
-	cookieStore := sessions.NewCookieStore(secret)
-	cookieStore.Options.Domain = "*.u.isucon.dev"
+	e.HideBanner = true
+	e.HidePort = true
+	e.Use(requestIDMiddleware())
+	e.Use(accessLogMiddleware())
+	e.Use(tracingMiddleware)
+	e.Use(metricsMiddleware)
+	cookieStore := sessions.NewCookieStore([]byte(appConfig.Session.Secret))
+	cookieStore.Options.Domain = appConfig.Session.CookieDomain
 	e.Use(session.Middleware(cookieStore))
+	if appConfig.Store.Backend == storeBackendMySQL && len(appConfig.Database.Replicas) > 0 {
+		e.Use(readYourWritesMiddleware)
+	}
 	// e.Use(middleware.Recover())
 
 	// 初期化
 	e.POST("/api/initialize", initializeHandler)
 
+	// ヘルスチェック
+	e.GET("/healthz", getHealthzHandler)
+	e.GET("/readyz", getReadyzHandler)
+
 	// top
 	e.GET("/api/tag", getTagHandler)
 	e.GET("/api/user/:username/theme", getStreamerThemeHandler)
@@ -150,30 +188,58 @@ func main() {
 	e.POST("/api/livestream/:livestream_id/livecomment", postLivecommentHandler)
 	e.POST("/api/livestream/:livestream_id/reaction", postReactionHandler)
 	e.GET("/api/livestream/:livestream_id/reaction", getReactionsHandler)
+	// チップによるサポーターランキング
+	e.GET("/api/livestream/:livestream_id/supporters", getLivestreamSupportersHandler)
 
 	// (配信者向け)ライブコメントの報告一覧取得API
-	e.GET("/api/livestream/:livestream_id/report", getLivecommentReportsHandler)
+	e.GET("/api/livestream/:livestream_id/report", getLivecommentReportsHandler,
+		requireLivestreamRole(RoleChannelModerator, http.StatusForbidden, "can't get other streamer's livecomment reports"))
 	e.GET("/api/livestream/:livestream_id/ngwords", getNgwords)
 	// ライブコメント報告
 	e.POST("/api/livestream/:livestream_id/livecomment/:livecomment_id/report", reportLivecommentHandler)
-	// 配信者によるモデレーション (NGワード登録)
-	e.POST("/api/livestream/:livestream_id/moderate", moderateHandler)
+	// 配信者 (または配信者が任命したモデレータ) によるモデレーション (NGワード登録)
+	e.POST("/api/livestream/:livestream_id/moderate", moderateHandler,
+		requireLivestreamRole(RoleChannelModerator, http.StatusBadRequest, "A streamer can't moderate livestreams that other streamers own"))
+	// ライブコメントの非表示とBAN (配信者の全配信でコメントできなくなる)
+	e.DELETE("/api/livestream/:livestream_id/livecomment/:livecomment_id", hideLivecommentHandler,
+		requireLivestreamRole(RoleChannelModerator, http.StatusForbidden, "can't hide livecomments of other streamer's livestreams"))
+	e.GET("/api/livestream/:livestream_id/ban", getChannelBansHandler,
+		requireLivestreamRole(RoleChannelModerator, http.StatusForbidden, "can't get bans of other streamer's channel"))
+	e.POST("/api/livestream/:livestream_id/ban", postChannelBanHandler,
+		requireLivestreamRole(RoleChannelModerator, http.StatusForbidden, "can't ban users from other streamer's channel"))
+	e.DELETE("/api/livestream/:livestream_id/ban/:user_id", deleteChannelBanHandler,
+		requireLivestreamRole(RoleChannelModerator, http.StatusForbidden, "can't unban users from other streamer's channel"))
 
 	// livestream_viewersにINSERTするため必要
 	// ユーザ視聴開始 (viewer)
 	e.POST("/api/livestream/:livestream_id/enter", enterLivestreamHandler)
 	// ユーザ視聴終了 (viewer)
 	e.DELETE("/api/livestream/:livestream_id/exit", exitLivestreamHandler)
+	// ユーザ視聴継続 (viewer)
+	e.POST("/api/livestream/:livestream_id/heartbeat", heartbeatLivestreamHandler)
 
 	// user
 	e.POST("/api/register", registerHandler)
 	e.POST("/api/login", loginHandler)
 	e.GET("/api/user/me", getMeHandler)
+	e.DELETE("/api/user/me", deleteMeHandler)
+	e.GET("/api/user/me/export", exportMeHandler)
+	// 自分のチャンネルのモデレータとモデレーション操作の記録
+	e.GET("/api/user/me/moderators", getMyModeratorsHandler)
+	e.POST("/api/user/me/moderators", postMyModeratorHandler)
+	e.DELETE("/api/user/me/moderators/:user_id", deleteMyModeratorHandler)
+	e.GET("/api/user/me/moderation_logs", getMyModerationLogsHandler)
+	// ボットや連携ツールのための個人用アクセストークン (Authorization: Bearer)
+	e.GET("/api/user/me/tokens", getMyAccessTokensHandler)
+	e.POST("/api/user/me/tokens", postMyAccessTokenHandler)
+	e.DELETE("/api/user/me/tokens/:token_id", deleteMyAccessTokenHandler)
 	// フロントエンドで、配信予約のコラボレーターを指定する際に必要
 	e.GET("/api/user/:username", getUserHandler)
 	e.GET("/api/user/:username/statistics", getUserStatisticsHandler)
+	e.GET("/api/user/:username/supporters", getUserSupportersHandler)
 	e.GET("/api/user/:username/icon", getIconHandler)
 	e.POST("/api/icon", postIconHandler)
+	e.GET("/api/icon/:hash", getIconByHashHandler)
 
 	// stats
 	// ライブ配信統計情報
@@ -182,46 +248,146 @@ func main() {
 	// 課金情報
 	e.GET("/api/payment", GetPaymentResult)
 
+	// 運営向け
+	registerAdminRoutes(e)
+
 	e.HTTPErrorHandler = errorResponseHandler
 
-	// DB接続
-	conn, err := connectDB(e.Logger)
+	if err := setupCacheBus(e, appConfig.CacheBus); err != nil {
+		slog.Error("failed to setup cache bus", "error", err)
+		os.Exit(1)
+	}
+
+	// DB接続 (--store=memoryの場合はMySQLに接続しない)
+	s, err := newStore(appConfig.Store)
 	if err != nil {
-		e.Logger.Errorf("failed to connect db: %v", err)
+		slog.Error("failed to setup store", "backend", appConfig.Store.Backend, "error", err)
 		os.Exit(1)
 	}
-	defer conn.Close()
-	dbConn = conn
+	defer s.Close()
+	store = s
 
-	subdomainAddr, ok := os.LookupEnv(powerDNSSubdomainAddressEnvKey)
-	if !ok {
-		e.Logger.Errorf("environ %s must be provided", powerDNSSubdomainAddressEnvKey)
+	if err := prepareSchema(context.Background(), s); err != nil {
+		slog.Error("failed to prepare schema", "error", err)
 		os.Exit(1)
 	}
-	powerDNSSubdomainAddress = subdomainAddr
 
-	// HTTPサーバ起動
-	listenAddr := net.JoinHostPort("", strconv.Itoa(listenPort))
-	if err := e.Start(listenAddr); err != nil {
-		e.Logger.Errorf("failed to start HTTP server: %v", err)
+	if err := setupMetrics(e, s); err != nil {
+		slog.Error("failed to setup metrics", "error", err)
+		os.Exit(1)
+	}
+
+	if err := setupIconStore(); err != nil {
+		slog.Error("failed to setup icon store", "error", err)
+		os.Exit(1)
+	}
+
+	if err := setupReservedUsernames(); err != nil {
+		slog.Error("failed to setup reserved usernames", "error", err)
+		os.Exit(1)
+	}
+
+	if err := setupDNSServer(appConfig.DNS); err != nil {
+		slog.Error("failed to setup dns server", "error", err)
+		os.Exit(1)
+	}
+	if dnsServer != nil {
+		if err := dnsServer.Start(appConfig.DNS.Server.Addr); err != nil {
+			slog.Error("failed to start dns server", "error", err)
+			os.Exit(1)
+		}
+	}
+
+	provisioner, err := newDNSProvisioner(appConfig.DNS)
+	if err != nil {
+		slog.Error("failed to setup dns provisioner", "error", err)
 		os.Exit(1)
 	}
+	dnsProvisioner = provisioner
+	go runDNSOutboxWorker(dnsOutboxRetryInterval)
+
+	if appConfig.DNS.Reconcile.Interval > 0 {
+		go runDNSReconciler(appConfig.DNS.Reconcile.Interval, appConfig.DNS.Reconcile.Fix)
+	}
+
+	go presence.runSweeper(viewerPresenceTimeout)
+	go runAccessTokenLimiterSweeper(accessTokenLimiterIdleTimeout)
+
+	warmCachesInBackground()
+
+	// HTTPサーバ起動
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	listenAddr := net.JoinHostPort("", strconv.Itoa(appConfig.Listen.Port))
+	slog.Info("starting HTTP server", "addr", listenAddr)
+	go func() {
+		if err := e.Start(listenAddr); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("failed to start HTTP server", "error", err)
+			os.Exit(1)
+		}
+	}()
+
+	// SIGTERMを受けたら新しいリクエストの受付をやめ、処理中のリクエストが終わるのを待つ
+	<-ctx.Done()
+	stop()
+	readiness.shuttingDown.Store(true)
+	slog.Info("shutting down", "timeout", appConfig.Listen.ShutdownTimeout.String())
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), appConfig.Listen.ShutdownTimeout)
+	defer cancel()
+	if err := e.Shutdown(shutdownCtx); err != nil {
+		slog.Error("failed to shutdown HTTP server", "error", err)
+	}
+	if dnsServer != nil {
+		if err := dnsServer.Shutdown(shutdownCtx); err != nil {
+			slog.Error("failed to shutdown dns server", "error", err)
+		}
+	}
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		slog.Error("failed to flush traces", "error", err)
+	}
 }
 
 type ErrorResponse struct {
 	Error string `json:"error"`
+	// 問い合わせの際にログと突き合わせるためのID
+	RequestID string `json:"request_id,omitempty"`
 }
 
+// errorResponseHandler はエラーをログに残し、クライアントにはリクエストIDだけを返す
+// 5xxのメッセージにはSQLのエラーなど内部の情報が含まれるので、ステータスの説明に置き換える
 func errorResponseHandler(err error, c echo.Context) {
-	c.Logger().Errorf("error at %s: %+v", c.Path(), err)
-	if he, ok := err.(*echo.HTTPError); ok {
-		if e := c.JSON(he.Code, &ErrorResponse{Error: err.Error()}); e != nil {
-			c.Logger().Errorf("%+v", e)
-		}
+	if c.Response().Committed {
 		return
 	}
 
-	if e := c.JSON(http.StatusInternalServerError, &ErrorResponse{Error: err.Error()}); e != nil {
-		c.Logger().Errorf("%+v", e)
+	code := http.StatusInternalServerError
+	message := http.StatusText(code)
+	var he *echo.HTTPError
+	if errors.As(err, &he) {
+		code = he.Code
+		message = http.StatusText(code)
+		if code < http.StatusInternalServerError {
+			message = fmt.Sprint(he.Message)
+		}
+	}
+
+	logger := requestLogger(c)
+	if code >= http.StatusInternalServerError {
+		logger.Error("request failed", "route", c.Path(), "status", code, "error", err.Error())
+	} else {
+		logger.Info("request rejected", "route", c.Path(), "status", code, "error", err.Error())
+	}
+
+	if c.Request().Method == http.MethodHead {
+		err = c.NoContent(code)
+	} else {
+		err = c.JSON(code, &ErrorResponse{
+			Error:     message,
+			RequestID: requestIDFromContext(c.Request().Context()),
+		})
+	}
+	if err != nil {
+		logger.Error("failed to write error response", "error", err.Error())
 	}
 }
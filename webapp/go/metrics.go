@@ -0,0 +1,167 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const metricsNamespace = "isupipe"
+
+const (
+	cacheResultHit  = "hit"
+	cacheResultMiss = "miss"
+
+	cacheNameIconHash  = "icon_hash"
+	cacheNameIconImage = "icon_image"
+)
+
+var (
+	httpRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "HTTP request latency by route.",
+		Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
+	}, []string{"method", "route"})
+	httpRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "http_requests_total",
+		Help:      "HTTP requests by route and status code.",
+	}, []string{"method", "route", "status"})
+
+	dbQueryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "db_query_duration_seconds",
+		Help:      "SQL statement latency by statement name.",
+		Buckets:   []float64{.0001, .00025, .0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1},
+	}, []string{"statement"})
+
+	// レプリカの遅延 (確認できない場合はNaN)
+	dbReplicaLagSeconds = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "db_replica_lag_seconds",
+		Help:      "Replication lag of each read replica.",
+	}, []string{"replica"})
+	dbReadsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "db_replica_reads_total",
+		Help:      "Read-only handler transactions by target (primary or replica).",
+	}, []string{"target"})
+
+	// ヒット率は rate(hit) / rate(hit + miss) で求める
+	cacheRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "cache_requests_total",
+		Help:      "Cache lookups by cache and result (hit or miss).",
+	}, []string{"cache", "result"})
+
+	livecommentsPostedTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "livecomments_posted_total",
+		Help:      "Livecomments posted.",
+	})
+	tipsTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "tips_total",
+		Help:      "Livecomments posted with a tip.",
+	})
+	tipAmountTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "tip_amount_total",
+		Help:      "Sum of tips posted.",
+	})
+	reactionsPostedTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "reactions_posted_total",
+		Help:      "Reactions posted.",
+	})
+	spamRejectedTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "livecomments_spam_rejected_total",
+		Help:      "Livecomments rejected because they matched an NG word.",
+	})
+
+	// 0でなければdns_outboxのlast_errorを見て原因を直し、dns reconcileで反映する
+	dnsOutboxDeadLettersGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "dns_outbox_dead_letters",
+		Help:      "DNS outbox entries that exceeded dns.outbox.max_attempts and are no longer retried.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(
+		httpRequestDuration,
+		httpRequestsTotal,
+		dbQueryDuration,
+		dbReplicaLagSeconds,
+		dbReadsTotal,
+		cacheRequestsTotal,
+		livecommentsPostedTotal,
+		tipsTotal,
+		tipAmountTotal,
+		reactionsPostedTotal,
+		spamRejectedTotal,
+		dnsOutboxDeadLettersGauge,
+	)
+}
+
+// setupMetrics は/metricsを登録し、コネクションプールの統計を公開する (MySQLの場合はレプリカも)
+func setupMetrics(e *echo.Echo, s Store) error {
+	if ms, ok := s.(*mysqlStore); ok {
+		if err := prometheus.Register(collectors.NewDBStatsCollector(ms.db.DB, appConfig.Database.Name)); err != nil {
+			return err
+		}
+		if ms.replicas != nil {
+			for _, r := range ms.replicas.replicas {
+				if err := prometheus.Register(collectors.NewDBStatsCollector(r.db.DB, r.name)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	e.GET("/metrics", echo.WrapHandler(promhttp.Handler()))
+	return nil
+}
+
+// metricsMiddleware はルートごとのレイテンシとステータスコードを記録する
+// ラベルの種類が増えすぎないよう、パスではなくルートのパターンを使う
+func metricsMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		start := time.Now()
+		err := next(c)
+
+		route := c.Path()
+		if route == "" {
+			route = "unmatched"
+		}
+		status := c.Response().Status
+		if err != nil {
+			var he *echo.HTTPError
+			if errors.As(err, &he) {
+				status = he.Code
+			} else {
+				status = http.StatusInternalServerError
+			}
+		}
+
+		method := c.Request().Method
+		httpRequestDuration.WithLabelValues(method, route).Observe(time.Since(start).Seconds())
+		httpRequestsTotal.WithLabelValues(method, route, strconv.Itoa(status)).Inc()
+		return err
+	}
+}
+
+func observeCache(cache string, hit bool) {
+	result := cacheResultMiss
+	if hit {
+		result = cacheResultHit
+	}
+	cacheRequestsTotal.WithLabelValues(cache, result).Inc()
+}
@@ -0,0 +1,558 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// migrations はスキーマの変更 (NNNN_name.up.sql と NNNN_name.down.sql の組)
+// 番号は1から連番で、適用済みのファイルは書き換えずに新しい番号を追加する
+//
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+const (
+	// migrationLockName は複数のサーバが同時にマイグレーションしないためのロック (GET_LOCK)
+	migrationLockName    = "isupipe.schema_migrations"
+	migrationLockTimeout = 60
+)
+
+var migrationFileRegexp = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+type migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+type schemaMigrationModel struct {
+	Version   int64  `db:"version"`
+	Name      string `db:"name"`
+	Dirty     bool   `db:"dirty"`
+	AppliedAt int64  `db:"applied_at"`
+}
+
+// loadMigrations は埋め込んだマイグレーションを番号順に返す
+func loadMigrations() ([]migration, error) {
+	entries, err := fs.ReadDir(migrationFiles, "migrations")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int64]*migration)
+	for _, entry := range entries {
+		m := migrationFileRegexp.FindStringSubmatch(entry.Name())
+		if m == nil {
+			return nil, fmt.Errorf("invalid migration file name '%s' (NNNN_name.up.sql or NNNN_name.down.sql)", entry.Name())
+		}
+		version, _ := strconv.ParseInt(m[1], 10, 64)
+		b, err := migrationFiles.ReadFile(path.Join("migrations", entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		mig, ok := byVersion[version]
+		if !ok {
+			mig = &migration{Version: version, Name: m[2]}
+			byVersion[version] = mig
+		}
+		if mig.Name != m[2] {
+			return nil, fmt.Errorf("migration %d has different names '%s' and '%s'", version, mig.Name, m[2])
+		}
+		if m[3] == "up" {
+			mig.Up = string(b)
+		} else {
+			mig.Down = string(b)
+		}
+	}
+
+	migrations := make([]migration, 0, len(byVersion))
+	for _, mig := range byVersion {
+		migrations = append(migrations, *mig)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	for i, mig := range migrations {
+		if mig.Version != int64(i+1) {
+			return nil, fmt.Errorf("migration versions must be sequential from 1 (missing %d)", i+1)
+		}
+		if strings.TrimSpace(mig.Up) == "" || strings.TrimSpace(mig.Down) == "" {
+			return nil, fmt.Errorf("migration %d_%s must have both up and down", mig.Version, mig.Name)
+		}
+	}
+	return migrations, nil
+}
+
+// splitStatements はマイグレーションのSQLを文ごとに分ける (行末の;で区切る)
+// DDLはトランザクションにできないので、1文ずつ実行してどこで失敗したかわかるようにする
+func splitStatements(src string) []string {
+	var (
+		statements []string
+		current    strings.Builder
+	)
+	for _, line := range strings.Split(src, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "--") {
+			continue
+		}
+		current.WriteString(line)
+		current.WriteString("\n")
+		if strings.HasSuffix(trimmed, ";") {
+			statements = append(statements, strings.TrimSpace(current.String()))
+			current.Reset()
+		}
+	}
+	if rest := strings.TrimSpace(current.String()); rest != "" {
+		statements = append(statements, rest)
+	}
+	return statements
+}
+
+// migrator はschema_migrationsを見てマイグレーションを適用する
+// GET_LOCKはセッション単位なので、1つのコネクションで実行する
+type migrator struct {
+	conn       *sql.Conn
+	migrations []migration
+}
+
+func newMigrator(ctx context.Context, s *mysqlStore, detectLegacy bool) (*migrator, func(), error) {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return nil, nil, err
+	}
+	conn, err := s.db.Conn(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var locked sql.NullInt64
+	if err := conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, ?)", migrationLockName, migrationLockTimeout).Scan(&locked); err != nil {
+		conn.Close()
+		return nil, nil, fmt.Errorf("failed to get migration lock: %w", err)
+	}
+	if !locked.Valid || locked.Int64 != 1 {
+		conn.Close()
+		return nil, nil, errors.New("failed to get migration lock: another migration is running")
+	}
+	release := func() {
+		conn.ExecContext(context.Background(), "SELECT RELEASE_LOCK(?)", migrationLockName)
+		conn.Close()
+	}
+
+	m := &migrator{conn: conn, migrations: migrations}
+	if err := m.ensureTable(ctx, detectLegacy); err != nil {
+		release()
+		return nil, nil, err
+	}
+	return m, release, nil
+}
+
+// ensureTable はschema_migrationsを作る
+// マイグレーション導入前にsql/initdb.dとsql/init.shで作られたデータベースは、適用済みのバージョンを記録する
+// detectLegacyがfalseの場合は確認せずに空のまま作る (migrate forceで記録し直す場合)
+func (m *migrator) ensureTable(ctx context.Context, detectLegacy bool) error {
+	exists, err := m.tableExists(ctx, "schema_migrations")
+	if err != nil {
+		return err
+	}
+	if exists {
+		return nil
+	}
+
+	// 判定できないスキーマではschema_migrationsを作らず、次に起動したときも同じエラーにする
+	var legacyVersion int
+	if detectLegacy {
+		legacyVersion, err = detectLegacyVersion(ctx, m.migrations, m.objectExists)
+		if err != nil {
+			return err
+		}
+	}
+
+	if _, err := m.conn.ExecContext(ctx, `
+	CREATE TABLE schema_migrations (
+		version BIGINT NOT NULL PRIMARY KEY,
+		name VARCHAR(255) NOT NULL,
+		dirty BOOLEAN NOT NULL DEFAULT FALSE,
+		applied_at BIGINT NOT NULL
+	) ENGINE=InnoDB CHARACTER SET utf8mb4 COLLATE utf8mb4_bin`); err != nil {
+		return fmt.Errorf("failed to create schema_migrations: %w", err)
+	}
+
+	for _, mig := range m.migrations[:legacyVersion] {
+		if err := m.record(ctx, mig, false); err != nil {
+			return err
+		}
+	}
+	if legacyVersion > 0 {
+		slog.Info("recorded existing schema as migrated", "version", legacyVersion)
+	}
+	return nil
+}
+
+// schemaObject はマイグレーションが追加するテーブル、カラム、インデックス
+type schemaObject struct {
+	Table  string
+	Column string
+	Index  string
+}
+
+func (o schemaObject) String() string {
+	switch {
+	case o.Column != "":
+		return "column " + o.Table + "." + o.Column
+	case o.Index != "":
+		return "index " + o.Index + " on " + o.Table
+	default:
+		return "table " + o.Table
+	}
+}
+
+var (
+	createTableRegexp = regexp.MustCompile("(?i)CREATE\\s+TABLE\\s+(?:IF\\s+NOT\\s+EXISTS\\s+)?`?(\\w+)`?")
+	addColumnRegexp   = regexp.MustCompile("(?i)ALTER\\s+TABLE\\s+`?(\\w+)`?\\s+ADD\\s+COLUMN\\s+`?(\\w+)`?")
+	createIndexRegexp = regexp.MustCompile("(?i)CREATE\\s+(?:UNIQUE\\s+)?INDEX\\s+`?(\\w+)`?\\s+ON\\s+`?(\\w+)`?")
+)
+
+// schemaObjects はSQLが追加するテーブル、カラム、インデックスを返す
+func schemaObjects(src string) []schemaObject {
+	var objects []schemaObject
+	for _, statement := range splitStatements(src) {
+		for _, m := range createTableRegexp.FindAllStringSubmatch(statement, -1) {
+			objects = append(objects, schemaObject{Table: m[1]})
+		}
+		for _, m := range addColumnRegexp.FindAllStringSubmatch(statement, -1) {
+			objects = append(objects, schemaObject{Table: m[1], Column: m[2]})
+		}
+		for _, m := range createIndexRegexp.FindAllStringSubmatch(statement, -1) {
+			objects = append(objects, schemaObject{Table: m[2], Index: m[1]})
+		}
+	}
+	return objects
+}
+
+// detectLegacyVersion はマイグレーション導入前のスキーマがどこまで適用されているかを返す
+// 各マイグレーションが追加するテーブル、カラム、インデックスがすべてあれば適用済みとみなす
+// (initdb.d/10_schema.sqlは0001、init.shが流すinitial_index.sqlとalter_icons.sqlは0002と0003にあたる)
+// 一部だけ適用されている場合や、適用済みのものが連続していない場合はエラーにする
+func detectLegacyVersion(ctx context.Context, migrations []migration, exists func(context.Context, schemaObject) (bool, error)) (int, error) {
+	var (
+		version  int
+		missing  []string
+		problems []string
+	)
+	for i, mig := range migrations {
+		objects := schemaObjects(mig.Up)
+		var found []schemaObject
+		var notFound []string
+		for _, obj := range objects {
+			ok, err := exists(ctx, obj)
+			if err != nil {
+				return 0, err
+			}
+			if ok {
+				found = append(found, obj)
+			} else {
+				notFound = append(notFound, obj.String())
+			}
+		}
+		name := fmt.Sprintf("%04d_%s", mig.Version, mig.Name)
+		switch {
+		case len(objects) == 0:
+			// 追加するものがないマイグレーションは判定できないので、前後に合わせる
+		case len(found) == len(objects):
+			version = i + 1
+			if len(missing) > 0 {
+				problems = append(problems, fmt.Sprintf("%s is applied but %s is not", name, strings.Join(missing, ", ")))
+				missing = nil
+			}
+		case len(found) == 0:
+			missing = append(missing, name)
+		default:
+			problems = append(problems, fmt.Sprintf("%s is partially applied (missing %s)", name, strings.Join(notFound, ", ")))
+		}
+	}
+	if len(problems) > 0 {
+		return 0, fmt.Errorf("existing schema does not match the migrations: %s (fix the schema by hand, then run 'migrate force N')", strings.Join(problems, "; "))
+	}
+	return version, nil
+}
+
+// objectExists はinformation_schemaでテーブル、カラム、インデックスがあるかを確認する
+func (m *migrator) objectExists(ctx context.Context, obj schemaObject) (bool, error) {
+	var (
+		exists bool
+		err    error
+	)
+	switch {
+	case obj.Column != "":
+		err = m.conn.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM information_schema.columns WHERE table_schema = DATABASE() AND table_name = ? AND column_name = ?)", obj.Table, obj.Column).Scan(&exists)
+	case obj.Index != "":
+		err = m.conn.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM information_schema.statistics WHERE table_schema = DATABASE() AND table_name = ? AND index_name = ?)", obj.Table, obj.Index).Scan(&exists)
+	default:
+		return m.tableExists(ctx, obj.Table)
+	}
+	return exists, err
+}
+
+func (m *migrator) tableExists(ctx context.Context, table string) (bool, error) {
+	var exists bool
+	err := m.conn.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name = ?)", table).Scan(&exists)
+	return exists, err
+}
+
+func (m *migrator) applied(ctx context.Context) ([]schemaMigrationModel, error) {
+	rows, err := m.conn.QueryContext(ctx, "SELECT version, name, dirty, applied_at FROM schema_migrations ORDER BY version")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var applied []schemaMigrationModel
+	for rows.Next() {
+		var row schemaMigrationModel
+		if err := rows.Scan(&row.Version, &row.Name, &row.Dirty, &row.AppliedAt); err != nil {
+			return nil, err
+		}
+		applied = append(applied, row)
+	}
+	return applied, rows.Err()
+}
+
+// version は適用済みの最新バージョンと、途中で失敗したマイグレーションがあるかを返す
+func (m *migrator) version(ctx context.Context) (int64, bool, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+	var (
+		version int64
+		dirty   bool
+	)
+	for _, row := range applied {
+		version = max(version, row.Version)
+		dirty = dirty || row.Dirty
+	}
+	return version, dirty, nil
+}
+
+func (m *migrator) record(ctx context.Context, mig migration, dirty bool) error {
+	_, err := m.conn.ExecContext(ctx, "INSERT INTO schema_migrations (version, name, dirty, applied_at) VALUES (?, ?, ?, ?) ON DUPLICATE KEY UPDATE dirty = VALUES(dirty), applied_at = VALUES(applied_at)", mig.Version, mig.Name, dirty, time.Now().Unix())
+	return err
+}
+
+// up は未適用のマイグレーションをすべて適用する
+// 実行前にdirtyとして記録し、最後まで成功したら外す
+func (m *migrator) up(ctx context.Context) (int, error) {
+	version, dirty, err := m.version(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if dirty {
+		return 0, fmt.Errorf("schema version %d is dirty (fix the schema by hand, then run 'migrate force %d')", version, version)
+	}
+	if version > int64(len(m.migrations)) {
+		return 0, fmt.Errorf("schema version %d is newer than this binary (latest %d)", version, len(m.migrations))
+	}
+
+	count := 0
+	for _, mig := range m.migrations[version:] {
+		if err := m.run(ctx, mig, mig.Up); err != nil {
+			return count, err
+		}
+		if err := m.record(ctx, mig, false); err != nil {
+			return count, err
+		}
+		slog.Info("applied migration", "version", mig.Version, "name", mig.Name)
+		count++
+	}
+	return count, nil
+}
+
+// down は最新のマイグレーションからsteps個を戻す
+func (m *migrator) down(ctx context.Context, steps int) (int, error) {
+	version, dirty, err := m.version(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if dirty {
+		return 0, fmt.Errorf("schema version %d is dirty (fix the schema by hand, then run 'migrate force %d')", version, version)
+	}
+	if version > int64(len(m.migrations)) {
+		return 0, fmt.Errorf("schema version %d is newer than this binary (latest %d)", version, len(m.migrations))
+	}
+
+	count := 0
+	for ; count < steps && version > 0; count++ {
+		mig := m.migrations[version-1]
+		if err := m.run(ctx, mig, mig.Down); err != nil {
+			return count, err
+		}
+		if _, err := m.conn.ExecContext(ctx, "DELETE FROM schema_migrations WHERE version = ?", mig.Version); err != nil {
+			return count, err
+		}
+		slog.Info("reverted migration", "version", mig.Version, "name", mig.Name)
+		version--
+	}
+	return count, nil
+}
+
+func (m *migrator) run(ctx context.Context, mig migration, src string) error {
+	if err := m.record(ctx, mig, true); err != nil {
+		return err
+	}
+	for i, statement := range splitStatements(src) {
+		if _, err := m.conn.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf("migration %d_%s failed at statement %d: %w", mig.Version, mig.Name, i+1, err)
+		}
+	}
+	return nil
+}
+
+// force はマイグレーションを実行せずに、versionまで適用済みとして記録し直す (dirtyも外す)
+func (m *migrator) force(ctx context.Context, version int64) error {
+	if version < 0 || version > int64(len(m.migrations)) {
+		return fmt.Errorf("version must be between 0 and %d", len(m.migrations))
+	}
+	if _, err := m.conn.ExecContext(ctx, "DELETE FROM schema_migrations WHERE version > ?", version); err != nil {
+		return err
+	}
+	for _, mig := range m.migrations[:version] {
+		if err := m.record(ctx, mig, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// prepareSchema は起動時にスキーマのバージョンを確認する (auto_migrateなら先に未適用のマイグレーションを適用する)
+// このバイナリが想定するバージョンと違うデータベースでは起動しない
+func prepareSchema(ctx context.Context, s Store) error {
+	ms, ok := s.(*mysqlStore)
+	if !ok {
+		return nil
+	}
+	m, release, err := newMigrator(ctx, ms, true)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	if appConfig.Store.AutoMigrate {
+		if _, err := m.up(ctx); err != nil {
+			return err
+		}
+	}
+
+	version, dirty, err := m.version(ctx)
+	if err != nil {
+		return err
+	}
+	latest := int64(len(m.migrations))
+	if dirty {
+		return fmt.Errorf("schema version %d is dirty", version)
+	}
+	if version != latest {
+		return fmt.Errorf("unexpected schema version %d (expected %d, run 'isupipe migrate up')", version, latest)
+	}
+	return nil
+}
+
+// runMigrate はマイグレーションのサブコマンド
+//
+//	isupipe migrate up          未適用のマイグレーションをすべて適用する
+//	isupipe migrate down [N]    最新のマイグレーションからN個 (デフォルトは1) を戻す
+//	isupipe migrate status      適用状況を表示する
+//	isupipe migrate force N     途中で失敗したマイグレーションを手で直した後、Nまで適用済みとして記録する
+func runMigrate(ctx context.Context, args []string) error {
+	ms, ok := store.(*mysqlStore)
+	if !ok {
+		return errors.New("migrate is only available with the mysql store")
+	}
+	if len(args) == 0 {
+		return errors.New("usage: migrate up|down [N]|status|force N")
+	}
+
+	// forceは既存のスキーマを判定できない場合に使うので、判定せずに記録し直す
+	m, release, err := newMigrator(ctx, ms, args[0] != "force")
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	switch args[0] {
+	case "up":
+		n, err := m.up(ctx)
+		fmt.Printf("applied %d migrations\n", n)
+		return err
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			steps, err = strconv.Atoi(args[1])
+			if err != nil || steps < 1 {
+				return fmt.Errorf("number of migrations to revert must be positive integer (got '%s')", args[1])
+			}
+		}
+		n, err := m.down(ctx, steps)
+		fmt.Printf("reverted %d migrations\n", n)
+		return err
+	case "status":
+		return m.printStatus(ctx)
+	case "force":
+		if len(args) < 2 {
+			return errors.New("usage: migrate force N")
+		}
+		version, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("version must be integer (got '%s')", args[1])
+		}
+		return m.force(ctx, version)
+	default:
+		return fmt.Errorf("unknown migrate command '%s' (up, down, status or force)", args[0])
+	}
+}
+
+func (m *migrator) printStatus(ctx context.Context) error {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return err
+	}
+	byVersion := make(map[int64]schemaMigrationModel, len(applied))
+	for _, row := range applied {
+		byVersion[row.Version] = row
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNAME\tSTATUS\tAPPLIED AT")
+	for _, mig := range m.migrations {
+		row, ok := byVersion[mig.Version]
+		switch {
+		case !ok:
+			fmt.Fprintf(w, "%04d\t%s\tpending\t-\n", mig.Version, mig.Name)
+		case row.Dirty:
+			fmt.Fprintf(w, "%04d\t%s\tdirty\t%s\n", mig.Version, mig.Name, time.Unix(row.AppliedAt, 0).UTC().Format(time.RFC3339))
+		default:
+			fmt.Fprintf(w, "%04d\t%s\tapplied\t%s\n", mig.Version, mig.Name, time.Unix(row.AppliedAt, 0).UTC().Format(time.RFC3339))
+		}
+		delete(byVersion, mig.Version)
+	}
+	// このバイナリが知らないバージョン (新しいバイナリで適用されたもの)
+	for _, row := range applied {
+		if _, ok := byVersion[row.Version]; ok {
+			fmt.Fprintf(w, "%04d\t%s\tunknown\t%s\n", row.Version, row.Name, time.Unix(row.AppliedAt, 0).UTC().Format(time.RFC3339))
+		}
+	}
+	return w.Flush()
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func readSQLFile(t *testing.T, name string) string {
+	t.Helper()
+	b, err := os.ReadFile("../sql/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+// 0001は導入前のinitdb.d/10_schema.sqlと同じで、以降の変更は別のマイグレーションにあること
+func TestFirstMigrationMatchesBaselineSchema(t *testing.T) {
+	migrations, err := loadMigrations()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var baseline []string
+	for _, statement := range splitStatements(readSQLFile(t, "initdb.d/10_schema.sql")) {
+		if !strings.HasPrefix(statement, "USE ") {
+			baseline = append(baseline, statement)
+		}
+	}
+	if got := splitStatements(migrations[0].Up); !reflect.DeepEqual(got, baseline) {
+		t.Errorf("0001 must be the same as sql/initdb.d/10_schema.sql\ngot:  %q\nwant: %q", got, baseline)
+	}
+}
+
+// fakeSchema は適用したSQLのテーブル、カラム、インデックスを記録する
+type fakeSchema map[schemaObject]bool
+
+func (s fakeSchema) apply(src string) fakeSchema {
+	for _, obj := range schemaObjects(src) {
+		s[obj] = true
+	}
+	return s
+}
+
+func (s fakeSchema) exists(ctx context.Context, obj schemaObject) (bool, error) {
+	return s[obj], nil
+}
+
+func TestDetectLegacyVersion(t *testing.T) {
+	migrations, err := loadMigrations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	baseline := readSQLFile(t, "initdb.d/10_schema.sql")
+	initSh := readSQLFile(t, "initial_index.sql") + "\n" + readSQLFile(t, "alter_icons.sql")
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		schema  fakeSchema
+		want    int
+		wantErr string
+	}{
+		{name: "empty", schema: fakeSchema{}, want: 0},
+		{name: "initdb.d", schema: fakeSchema{}.apply(baseline), want: 1},
+		{name: "initdb.d and init.sh", schema: fakeSchema{}.apply(baseline).apply(initSh), want: 3},
+		{
+			// 途中の版の10_schema.sqlで作り、init.shを流していないデータベース
+			name:    "schema with gaps",
+			schema:  fakeSchema{}.apply(baseline).apply(migrations[3].Up).apply(migrations[5].Up),
+			wantErr: "0004_add_unique_viewers is applied but 0002_create_indexes, 0003_add_icon_hash is not; 0006_add_dns_outbox is applied but 0005_add_icon_images is not",
+		},
+		{
+			name:    "partially applied",
+			schema:  fakeSchema{}.apply(baseline).apply(initSh).apply("ALTER TABLE `icons` ADD COLUMN `content_type` VARCHAR(255);"),
+			wantErr: "0005_add_icon_images is partially applied (missing index idx_icons_image_hash on icons, table icon_images)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := detectLegacyVersion(ctx, migrations, tt.schema.exists)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("err = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("version = %d, want %d", got, tt.want)
+			}
+		})
+	}
+
+	// ベースラインから順に適用すると、それぞれのバージョンと判定されること
+	schema := fakeSchema{}.apply(baseline)
+	for i, mig := range migrations[1:] {
+		schema.apply(mig.Up)
+		got, err := detectLegacyVersion(ctx, migrations, schema.exists)
+		if err != nil {
+			t.Fatalf("after %04d_%s: %v", mig.Version, mig.Name, err)
+		}
+		if got != i+2 {
+			t.Errorf("after %04d_%s: version = %d, want %d", mig.Version, mig.Name, got, i+2)
+		}
+	}
+}
+
+// ISUCON13_TEST_MYSQL_DSNで指定した空のデータベースに、ベースラインのスキーマからマイグレーションを適用する
+// (データベースのテーブルはすべて削除される)
+func TestMigrateFromBaselineSchemaOnMySQL(t *testing.T) {
+	s := openTestMySQL(t)
+	if s == nil {
+		t.Skip("ISUCON13_TEST_MYSQL_DSN is not set")
+	}
+
+	ctx := context.Background()
+	for _, statement := range splitStatements(readSQLFile(t, "initdb.d/10_schema.sql")) {
+		if strings.HasPrefix(statement, "USE ") {
+			continue
+		}
+		if _, err := s.db.ExecContext(ctx, statement); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	m, release, err := newMigrator(ctx, s, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer release()
+
+	version, _, err := m.version(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != 1 {
+		t.Fatalf("baseline schema must be recorded as version 1 (got %d)", version)
+	}
+	if _, err := m.up(ctx); err != nil {
+		t.Fatal(err)
+	}
+	// すべて戻して、もう一度適用できること
+	if _, err := m.down(ctx, len(m.migrations)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.up(ctx); err != nil {
+		t.Fatal(err)
+	}
+	version, dirty, err := m.version(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dirty || version != int64(len(m.migrations)) {
+		t.Errorf("version = %d (dirty %v), want %d", version, dirty, len(m.migrations))
+	}
+}
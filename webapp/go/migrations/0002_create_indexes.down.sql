@@ -0,0 +1,10 @@
+DROP INDEX idx_reactions_1 ON reactions;
+DROP INDEX idx_reservation_slots_1 ON reservation_slots;
+DROP INDEX idx_livestream_tags_2 ON livestream_tags;
+DROP INDEX idx_livestream_tags_1 ON livestream_tags;
+DROP INDEX idx_livecomment_reports_1 ON livecomment_reports;
+DROP INDEX idx_livecomments_2 ON livecomments;
+DROP INDEX idx_livecomments_1 ON livecomments;
+DROP INDEX idx_livestream_1 ON livestreams;
+DROP INDEX idx_livestream_2 ON ng_words;
+DROP INDEX idx_user_livestream ON ng_words;
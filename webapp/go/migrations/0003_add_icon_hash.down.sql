@@ -0,0 +1 @@
+ALTER TABLE `icons` DROP COLUMN `image_hash`;
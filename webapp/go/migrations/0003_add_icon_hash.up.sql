@@ -0,0 +1,2 @@
+-- sql/alter_icons.sql と同じ変更
+ALTER TABLE `icons` ADD COLUMN `image_hash` VARCHAR(255) NOT NULL DEFAULT 'd9f8294e9d895f81ce62e73dc7d5dff862a4fa40bd4e0fecf53f7526a8edcac0';
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS `livestream_unique_viewers`;
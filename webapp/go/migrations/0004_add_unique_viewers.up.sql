@@ -0,0 +1,7 @@
+-- ライブ配信のユニーク視聴者 (退出しても消えない)
+CREATE TABLE `livestream_unique_viewers` (
+  `livestream_id` BIGINT NOT NULL,
+  `user_id` BIGINT NOT NULL,
+  `created_at` BIGINT NOT NULL,
+  PRIMARY KEY (`livestream_id`, `user_id`)
+) ENGINE=InnoDB CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
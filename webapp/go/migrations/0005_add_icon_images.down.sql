@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS `icon_images`;
+DROP INDEX idx_icons_image_hash ON icons;
+ALTER TABLE `icons` DROP COLUMN `content_type`;
@@ -0,0 +1,13 @@
+-- 画像本体はIconStoreに保存し、iconsにはハッシュとContent-Typeだけを持つ
+ALTER TABLE `icons` ADD COLUMN `content_type` VARCHAR(255) NOT NULL DEFAULT 'image/jpeg';
+CREATE INDEX idx_icons_image_hash ON icons (image_hash);
+
+-- プロフィール画像の本体 (ISUCON13_ICON_STORE=mysqlの場合)
+-- 画像のハッシュとサイズ (0は元画像、64/128/256pxは縮小版) で一意に決まる
+CREATE TABLE `icon_images` (
+  `image_hash` VARCHAR(64) NOT NULL,
+  `size` INT NOT NULL,
+  `content_type` VARCHAR(255) NOT NULL,
+  `image` LONGBLOB NOT NULL,
+  PRIMARY KEY (`image_hash`, `size`)
+) ENGINE=InnoDB CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,8 @@
+-- DNSレコードの変更待ち (ユーザの変更と同じトランザクションで記録し、コミット後に反映する)
+CREATE TABLE `dns_outbox` (
+  `id` BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `name` VARCHAR(255) NOT NULL,
+  `action` VARCHAR(16) NOT NULL,
+  `attempts` BIGINT NOT NULL DEFAULT 0,
+  `created_at` BIGINT NOT NULL
+) ENGINE=InnoDB CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
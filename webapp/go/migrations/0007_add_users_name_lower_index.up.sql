@@ -0,0 +1,2 @@
+-- ユーザ名は大文字小文字を区別せず一意 (DNSのラベルとして使うため)
+CREATE UNIQUE INDEX idx_users_name_lower ON users ((LOWER(name)));
@@ -0,0 +1,2 @@
+-- 退会済みの場合は退会日時 (行は他のテーブルから参照されるので残す)
+ALTER TABLE `users` ADD COLUMN `deleted_at` BIGINT NULL DEFAULT NULL;
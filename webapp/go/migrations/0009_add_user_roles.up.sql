@@ -0,0 +1,4 @@
+-- ユーザの権限 (viewer, moderator, admin) と利用停止
+-- streamerは配信ごとに配信者本人に与えられるので、ここには保存しない
+ALTER TABLE `users` ADD COLUMN `role` VARCHAR(32) NOT NULL DEFAULT 'viewer';
+ALTER TABLE `users` ADD COLUMN `suspended_at` BIGINT NULL DEFAULT NULL;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS `moderation_logs`;
+DROP TABLE IF EXISTS `channel_bans`;
+DROP TABLE IF EXISTS `channel_moderators`;
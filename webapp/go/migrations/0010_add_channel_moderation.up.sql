@@ -0,0 +1,32 @@
+-- 配信者が任命したチャンネルのモデレータ (配信者の全配信でNGワード登録・通報閲覧・コメント非表示・BANができる)
+CREATE TABLE `channel_moderators` (
+  `id` BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `streamer_id` BIGINT NOT NULL,
+  `user_id` BIGINT NOT NULL,
+  `created_at` BIGINT NOT NULL,
+  UNIQUE `uniq_channel_moderator` (`streamer_id`, `user_id`)
+) ENGINE=InnoDB CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+CREATE INDEX idx_channel_moderators_user ON channel_moderators (user_id);
+
+-- 配信者の配信にライブコメントを投稿できないユーザ
+CREATE TABLE `channel_bans` (
+  `id` BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `streamer_id` BIGINT NOT NULL,
+  `user_id` BIGINT NOT NULL,
+  `banned_by` BIGINT NOT NULL,
+  `created_at` BIGINT NOT NULL,
+  UNIQUE `uniq_channel_ban` (`streamer_id`, `user_id`)
+) ENGINE=InnoDB CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+
+-- チャンネルでのモデレーション操作の記録 (操作したユーザを残す)
+CREATE TABLE `moderation_logs` (
+  `id` BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `streamer_id` BIGINT NOT NULL,
+  `livestream_id` BIGINT NULL DEFAULT NULL,
+  `moderator_id` BIGINT NOT NULL,
+  `action` VARCHAR(32) NOT NULL,
+  `target_id` BIGINT NOT NULL,
+  `detail` TEXT NOT NULL,
+  `created_at` BIGINT NOT NULL
+) ENGINE=InnoDB CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+CREATE INDEX idx_moderation_logs_streamer ON moderation_logs (streamer_id, id);
@@ -0,0 +1,20 @@
+-- ボットや連携ツールのための個人用アクセストークン
+-- トークンそのものは保存せず、SHA-256のハッシュで照合する
+CREATE TABLE `access_tokens` (
+  `id` BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `user_id` BIGINT NOT NULL,
+  `name` VARCHAR(255) NOT NULL,
+  `token_hash` CHAR(64) NOT NULL,
+  -- 一覧でトークンを見分けるための先頭部分
+  `token_prefix` VARCHAR(32) NOT NULL,
+  -- read, comment, moderateをカンマ区切りで保存する
+  `scopes` VARCHAR(255) NOT NULL,
+  -- 1分あたりのリクエスト数の上限
+  `rate_limit` BIGINT NOT NULL,
+  `created_at` BIGINT NOT NULL,
+  `expires_at` BIGINT NULL DEFAULT NULL,
+  `last_used_at` BIGINT NULL DEFAULT NULL,
+  `revoked_at` BIGINT NULL DEFAULT NULL,
+  UNIQUE `uniq_access_token_hash` (`token_hash`)
+) ENGINE=InnoDB CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+CREATE INDEX idx_access_tokens_user ON access_tokens (user_id);
@@ -0,0 +1,5 @@
+DROP INDEX idx_dns_outbox_due ON dns_outbox;
+ALTER TABLE `dns_outbox` DROP COLUMN `dead_at`;
+ALTER TABLE `dns_outbox` DROP COLUMN `last_error`;
+ALTER TABLE `dns_outbox` DROP COLUMN `next_attempt_at`;
+ALTER TABLE `dns_outbox` DROP COLUMN `locked_until`;
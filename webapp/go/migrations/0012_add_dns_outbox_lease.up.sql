@@ -0,0 +1,8 @@
+-- DNSの反映中はトランザクションを開いたままにせず、locked_untilまでエントリを借りる
+-- 失敗したエントリはnext_attempt_atまで再送しない (間隔を倍にしていく)
+-- dns.outbox.max_attempts回失敗したエントリはdead_atを入れて再送をやめる (デッドレター)
+ALTER TABLE `dns_outbox` ADD COLUMN `locked_until` BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE `dns_outbox` ADD COLUMN `next_attempt_at` BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE `dns_outbox` ADD COLUMN `last_error` TEXT NULL DEFAULT NULL;
+ALTER TABLE `dns_outbox` ADD COLUMN `dead_at` BIGINT NULL DEFAULT NULL;
+CREATE INDEX idx_dns_outbox_due ON dns_outbox (dead_at, next_attempt_at);
@@ -0,0 +1,10 @@
+-- isupipe staffで与えた運営の権限 (/api/initializeでusersを空にしても、ここから与え直す)
+-- hashed_passwordはstaffで作ったユーザのもので、初期化後に同じパスワードで作り直す
+CREATE TABLE `staff_grants` (
+  `id` BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `name` VARCHAR(255) NOT NULL,
+  `role` VARCHAR(32) NOT NULL,
+  `hashed_password` VARCHAR(255) NULL DEFAULT NULL,
+  `created_at` BIGINT NOT NULL,
+  UNIQUE `uniq_staff_grant_name` (`name`)
+) ENGINE=InnoDB CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
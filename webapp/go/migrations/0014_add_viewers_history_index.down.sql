@@ -0,0 +1 @@
+DROP INDEX idx_livestream_viewers_history_user ON livestream_viewers_history;
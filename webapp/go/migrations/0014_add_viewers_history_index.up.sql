@@ -0,0 +1,2 @@
+-- heartbeatでenter済みかを確認する (exitでの削除にも使う)
+CREATE INDEX idx_livestream_viewers_history_user ON livestream_viewers_history (user_id, livestream_id);
@@ -0,0 +1 @@
+DROP INDEX idx_dns_outbox_name ON dns_outbox;
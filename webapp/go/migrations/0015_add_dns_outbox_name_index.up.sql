@@ -0,0 +1,2 @@
+-- 同じ名前のエントリは作られた順に反映するため、古いエントリが残っているかを確認する
+CREATE INDEX idx_dns_outbox_name ON dns_outbox (name, id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS `livestream_presence_peaks`;
+DROP TABLE IF EXISTS `livestream_presence`;
@@ -0,0 +1,15 @@
+-- heartbeatによる視聴者の最終確認時刻 (複数台で受けても同時視聴者数を全インスタンスで数える)
+-- タイムアウトした行は定期的に削除する
+CREATE TABLE `livestream_presence` (
+  `livestream_id` BIGINT NOT NULL,
+  `user_id` BIGINT NOT NULL,
+  `last_seen_at` BIGINT NOT NULL,
+  PRIMARY KEY (`livestream_id`, `user_id`),
+  INDEX `idx_livestream_presence_last_seen_at` (`last_seen_at`)
+) ENGINE=InnoDB CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+
+-- 配信ごとの最大同時視聴者数 (視聴者がいなくなっても残す)
+CREATE TABLE `livestream_presence_peaks` (
+  `livestream_id` BIGINT NOT NULL PRIMARY KEY,
+  `peak` BIGINT NOT NULL
+) ENGINE=InnoDB CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,556 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	defaultModerationLogsLimit = 100
+	maxModerationLogsLimit     = 1000
+
+	// moderation_logs.action
+	moderationActionAddNGWord       = "add_ng_word"
+	moderationActionHideLivecomment = "hide_livecomment"
+	moderationActionBan             = "ban"
+	moderationActionUnban           = "unban"
+	moderationActionAddModerator    = "add_moderator"
+	moderationActionRemoveModerator = "remove_moderator"
+)
+
+type ChannelModeratorModel struct {
+	ID         int64 `db:"id"`
+	StreamerID int64 `db:"streamer_id"`
+	UserID     int64 `db:"user_id"`
+	CreatedAt  int64 `db:"created_at"`
+}
+
+type ChannelBanModel struct {
+	ID         int64 `db:"id"`
+	StreamerID int64 `db:"streamer_id"`
+	UserID     int64 `db:"user_id"`
+	BannedBy   int64 `db:"banned_by"`
+	CreatedAt  int64 `db:"created_at"`
+}
+
+type ModerationLogModel struct {
+	ID         int64 `db:"id"`
+	StreamerID int64 `db:"streamer_id"`
+	// LivestreamID はモデレータの任命など配信に紐づかない操作ではNULL
+	LivestreamID sql.NullInt64 `db:"livestream_id"`
+	ModeratorID  int64         `db:"moderator_id"`
+	Action       string        `db:"action"`
+	// TargetID は操作の対象 (NGワード・ライブコメント・ユーザ) のID
+	TargetID  int64  `db:"target_id"`
+	Detail    string `db:"detail"`
+	CreatedAt int64  `db:"created_at"`
+}
+
+type ChannelModerator struct {
+	User      User  `json:"user"`
+	CreatedAt int64 `json:"created_at"`
+}
+
+type ChannelBan struct {
+	User      User  `json:"user"`
+	BannedBy  User  `json:"banned_by"`
+	CreatedAt int64 `json:"created_at"`
+}
+
+type ModerationLog struct {
+	ID           int64  `json:"id"`
+	Moderator    User   `json:"moderator"`
+	Action       string `json:"action"`
+	LivestreamID *int64 `json:"livestream_id"`
+	TargetID     int64  `json:"target_id"`
+	Detail       string `json:"detail"`
+	CreatedAt    int64  `json:"created_at"`
+}
+
+type PostChannelModeratorRequest struct {
+	Username string `json:"username"`
+}
+
+type PostChannelBanRequest struct {
+	UserID int64 `json:"user_id"`
+}
+
+// recordModeration はチャンネルでのモデレーション操作を記録する (操作したユーザをmoderator_idに残す)
+func recordModeration(ctx context.Context, tx Tx, log ModerationLogModel) error {
+	log.CreatedAt = time.Now().Unix()
+	_, err := tx.Moderation().AddLog(ctx, log)
+	return err
+}
+
+// checkChannelBan は配信者のチャンネルからBANされたユーザの書き込みを拒否する
+// ライブコメント (チップを含む)・リアクション・通報など、配信者の配信に残る書き込みのハンドラで呼ぶ
+func checkChannelBan(ctx context.Context, tx Tx, livestreamModel LivestreamModel, userID int64) error {
+	banned, err := tx.Moderation().IsBanned(ctx, livestreamModel.UserID, userID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get ban: "+err.Error())
+	}
+	if banned {
+		return echo.NewHTTPError(http.StatusForbidden, "you are banned from this channel")
+	}
+	return nil
+}
+
+// livestreamFromContext はrequireLivestreamRoleで確認した配信を返す
+func livestreamFromContext(c echo.Context) LivestreamModel {
+	livestreamModel, _ := c.Get(livestreamContextKey).(LivestreamModel)
+	return livestreamModel
+}
+
+// 自分のチャンネルのモデレータ一覧
+// GET /api/user/me/moderators
+func getMyModeratorsHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	a, err := currentActor(c)
+	if err != nil {
+		return err
+	}
+
+	tx, err := store.BeginReadOnly(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to begin transaction: "+err.Error())
+	}
+	defer tx.Rollback()
+
+	moderatorModels, err := tx.Moderation().ListModerators(ctx, a.User.ID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get moderators: "+err.Error())
+	}
+
+	loader := newBatchLoader(tx)
+	userIDs := make([]int64, len(moderatorModels))
+	for i := range moderatorModels {
+		userIDs[i] = moderatorModels[i].UserID
+	}
+	if err := loader.loadUsers(ctx, userIDs); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get users: "+err.Error())
+	}
+	moderators := make([]ChannelModerator, len(moderatorModels))
+	for i, moderatorModel := range moderatorModels {
+		user, err := loader.user(moderatorModel.UserID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to fill user: "+err.Error())
+		}
+		moderators[i] = ChannelModerator{User: user, CreatedAt: moderatorModel.CreatedAt}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to commit: "+err.Error())
+	}
+
+	return c.JSON(http.StatusOK, moderators)
+}
+
+// 自分のチャンネルのモデレータを任命する
+// POST /api/user/me/moderators
+func postMyModeratorHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	defer c.Request().Body.Close()
+
+	a, err := currentActor(c)
+	if err != nil {
+		return err
+	}
+
+	var req PostChannelModeratorRequest
+	if err := json.NewDecoder(c.Request().Body).Decode(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "failed to decode the request body as json")
+	}
+
+	tx, err := store.Begin(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to begin transaction: "+err.Error())
+	}
+	defer tx.Rollback()
+
+	userModel, err := tx.Users().GetByName(ctx, req.Username)
+	if errors.Is(err, sql.ErrNoRows) || err == nil && userModel.DeletedAt.Valid {
+		return echo.NewHTTPError(http.StatusNotFound, "not found user that has the given username")
+	}
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get user: "+err.Error())
+	}
+	if userModel.ID == a.User.ID {
+		return echo.NewHTTPError(http.StatusBadRequest, "can't make yourself a moderator of your own channel")
+	}
+
+	moderatorModel := ChannelModeratorModel{
+		StreamerID: a.User.ID,
+		UserID:     userModel.ID,
+		CreatedAt:  time.Now().Unix(),
+	}
+	if _, err := tx.Moderation().AddModerator(ctx, moderatorModel); err != nil {
+		if isDuplicateEntry(err) {
+			return echo.NewHTTPError(http.StatusConflict, "the user is already a moderator")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to insert moderator: "+err.Error())
+	}
+	if err := recordModeration(ctx, tx, ModerationLogModel{
+		StreamerID:  a.User.ID,
+		ModeratorID: a.User.ID,
+		Action:      moderationActionAddModerator,
+		TargetID:    userModel.ID,
+		Detail:      userModel.Name,
+	}); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to insert moderation log: "+err.Error())
+	}
+
+	user, err := fillUserResponse(ctx, tx, userModel)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to fill user: "+err.Error())
+	}
+
+	if err := tx.Commit(); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to commit: "+err.Error())
+	}
+
+	return c.JSON(http.StatusCreated, ChannelModerator{User: user, CreatedAt: moderatorModel.CreatedAt})
+}
+
+// 自分のチャンネルのモデレータを解任する
+// DELETE /api/user/me/moderators/:user_id
+func deleteMyModeratorHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	a, err := currentActor(c)
+	if err != nil {
+		return err
+	}
+	userID, err := strconv.ParseInt(c.Param("user_id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "user_id in path must be integer")
+	}
+
+	tx, err := store.Begin(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to begin transaction: "+err.Error())
+	}
+	defer tx.Rollback()
+
+	isModerator, err := tx.Moderation().IsModerator(ctx, a.User.ID, userID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get moderator: "+err.Error())
+	}
+	if !isModerator {
+		return echo.NewHTTPError(http.StatusNotFound, "the user is not a moderator")
+	}
+	if err := tx.Moderation().RemoveModerator(ctx, a.User.ID, userID); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete moderator: "+err.Error())
+	}
+	if err := recordModeration(ctx, tx, ModerationLogModel{
+		StreamerID:  a.User.ID,
+		ModeratorID: a.User.ID,
+		Action:      moderationActionRemoveModerator,
+		TargetID:    userID,
+	}); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to insert moderation log: "+err.Error())
+	}
+
+	if err := tx.Commit(); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to commit: "+err.Error())
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// 自分のチャンネルでのモデレーション操作の記録 (新しい順)
+// GET /api/user/me/moderation_logs
+func getMyModerationLogsHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	a, err := currentActor(c)
+	if err != nil {
+		return err
+	}
+
+	limit := defaultModerationLogsLimit
+	if v := c.QueryParam("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "limit query parameter must be integer")
+		}
+		if n < 0 || n > maxModerationLogsLimit {
+			return echo.NewHTTPError(http.StatusBadRequest, "limit query parameter must be between 0 and "+strconv.Itoa(maxModerationLogsLimit))
+		}
+		limit = n
+	}
+
+	tx, err := store.BeginReadOnly(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to begin transaction: "+err.Error())
+	}
+	defer tx.Rollback()
+
+	logModels, err := tx.Moderation().ListLogs(ctx, a.User.ID, limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get moderation logs: "+err.Error())
+	}
+
+	loader := newBatchLoader(tx)
+	userIDs := make([]int64, len(logModels))
+	for i := range logModels {
+		userIDs[i] = logModels[i].ModeratorID
+	}
+	if err := loader.loadUsers(ctx, userIDs); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get users: "+err.Error())
+	}
+	logs := make([]ModerationLog, len(logModels))
+	for i, logModel := range logModels {
+		moderator, err := loader.user(logModel.ModeratorID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to fill user: "+err.Error())
+		}
+		logs[i] = ModerationLog{
+			ID:        logModel.ID,
+			Moderator: moderator,
+			Action:    logModel.Action,
+			TargetID:  logModel.TargetID,
+			Detail:    logModel.Detail,
+			CreatedAt: logModel.CreatedAt,
+		}
+		if logModel.LivestreamID.Valid {
+			logs[i].LivestreamID = &logModels[i].LivestreamID.Int64
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to commit: "+err.Error())
+	}
+
+	return c.JSON(http.StatusOK, logs)
+}
+
+// 配信のライブコメントを非表示にする (削除する)
+// DELETE /api/livestream/:livestream_id/livecomment/:livecomment_id
+func hideLivecommentHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	a, err := currentActor(c)
+	if err != nil {
+		return err
+	}
+	// 配信者本人 (またはモデレータ) かどうかはrequireLivestreamRoleで確認済み
+	livestreamModel := livestreamFromContext(c)
+
+	livecommentID, err := strconv.ParseInt(c.Param("livecomment_id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "livecomment_id in path must be integer")
+	}
+
+	tx, err := store.Begin(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to begin transaction: "+err.Error())
+	}
+	defer tx.Rollback()
+
+	livecommentModel, err := tx.Livecomments().Get(ctx, livecommentID)
+	if errors.Is(err, sql.ErrNoRows) || err == nil && livecommentModel.LivestreamID != livestreamModel.ID {
+		return echo.NewHTTPError(http.StatusNotFound, "livecomment not found")
+	}
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get livecomment: "+err.Error())
+	}
+	if err := tx.Livecomments().Delete(ctx, livecommentID); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete livecomment: "+err.Error())
+	}
+	if err := recordModeration(ctx, tx, ModerationLogModel{
+		StreamerID:   livestreamModel.UserID,
+		LivestreamID: sql.NullInt64{Int64: livestreamModel.ID, Valid: true},
+		ModeratorID:  a.User.ID,
+		Action:       moderationActionHideLivecomment,
+		TargetID:     livecommentID,
+		Detail:       livecommentModel.Comment,
+	}); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to insert moderation log: "+err.Error())
+	}
+
+	if err := tx.Commit(); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to commit: "+err.Error())
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// 配信者のチャンネルでBANされているユーザ一覧
+// GET /api/livestream/:livestream_id/ban
+func getChannelBansHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	livestreamModel := livestreamFromContext(c)
+
+	tx, err := store.BeginReplicaRead(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to begin transaction: "+err.Error())
+	}
+	defer tx.Rollback()
+
+	banModels, err := tx.Moderation().ListBans(ctx, livestreamModel.UserID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get bans: "+err.Error())
+	}
+
+	loader := newBatchLoader(tx)
+	userIDs := make([]int64, 0, len(banModels)*2)
+	for _, banModel := range banModels {
+		userIDs = append(userIDs, banModel.UserID, banModel.BannedBy)
+	}
+	if err := loader.loadUsers(ctx, userIDs); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get users: "+err.Error())
+	}
+	bans := make([]ChannelBan, len(banModels))
+	for i, banModel := range banModels {
+		user, err := loader.user(banModel.UserID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to fill user: "+err.Error())
+		}
+		bannedBy, err := loader.user(banModel.BannedBy)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to fill user: "+err.Error())
+		}
+		bans[i] = ChannelBan{User: user, BannedBy: bannedBy, CreatedAt: banModel.CreatedAt}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to commit: "+err.Error())
+	}
+
+	return c.JSON(http.StatusOK, bans)
+}
+
+// 配信者のチャンネル (全配信) でユーザがライブコメントを投稿できないようにする
+// POST /api/livestream/:livestream_id/ban
+func postChannelBanHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	defer c.Request().Body.Close()
+
+	a, err := currentActor(c)
+	if err != nil {
+		return err
+	}
+	livestreamModel := livestreamFromContext(c)
+
+	var req PostChannelBanRequest
+	if err := json.NewDecoder(c.Request().Body).Decode(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "failed to decode the request body as json")
+	}
+	if req.UserID == livestreamModel.UserID || req.UserID == a.User.ID {
+		return echo.NewHTTPError(http.StatusBadRequest, "can't ban the streamer or yourself")
+	}
+
+	tx, err := store.Begin(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to begin transaction: "+err.Error())
+	}
+	defer tx.Rollback()
+
+	userModel, err := tx.Users().Get(ctx, req.UserID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return echo.NewHTTPError(http.StatusNotFound, "not found user")
+	}
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get user: "+err.Error())
+	}
+
+	banModel := ChannelBanModel{
+		StreamerID: livestreamModel.UserID,
+		UserID:     userModel.ID,
+		BannedBy:   a.User.ID,
+		CreatedAt:  time.Now().Unix(),
+	}
+	if _, err := tx.Moderation().Ban(ctx, banModel); err != nil {
+		if isDuplicateEntry(err) {
+			return echo.NewHTTPError(http.StatusConflict, "the user is already banned")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to insert ban: "+err.Error())
+	}
+	if err := recordModeration(ctx, tx, ModerationLogModel{
+		StreamerID:   livestreamModel.UserID,
+		LivestreamID: sql.NullInt64{Int64: livestreamModel.ID, Valid: true},
+		ModeratorID:  a.User.ID,
+		Action:       moderationActionBan,
+		TargetID:     userModel.ID,
+		Detail:       userModel.Name,
+	}); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to insert moderation log: "+err.Error())
+	}
+
+	loader := newBatchLoader(tx)
+	if err := loader.loadUsers(ctx, []int64{banModel.UserID, banModel.BannedBy}); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get users: "+err.Error())
+	}
+	user, err := loader.user(banModel.UserID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to fill user: "+err.Error())
+	}
+	bannedBy, err := loader.user(banModel.BannedBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to fill user: "+err.Error())
+	}
+
+	if err := tx.Commit(); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to commit: "+err.Error())
+	}
+
+	return c.JSON(http.StatusCreated, ChannelBan{User: user, BannedBy: bannedBy, CreatedAt: banModel.CreatedAt})
+}
+
+// BANを解除する
+// DELETE /api/livestream/:livestream_id/ban/:user_id
+func deleteChannelBanHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	a, err := currentActor(c)
+	if err != nil {
+		return err
+	}
+	livestreamModel := livestreamFromContext(c)
+
+	userID, err := strconv.ParseInt(c.Param("user_id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "user_id in path must be integer")
+	}
+
+	tx, err := store.Begin(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to begin transaction: "+err.Error())
+	}
+	defer tx.Rollback()
+
+	banned, err := tx.Moderation().IsBanned(ctx, livestreamModel.UserID, userID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get ban: "+err.Error())
+	}
+	if !banned {
+		return echo.NewHTTPError(http.StatusNotFound, "the user is not banned")
+	}
+	if err := tx.Moderation().Unban(ctx, livestreamModel.UserID, userID); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete ban: "+err.Error())
+	}
+	if err := recordModeration(ctx, tx, ModerationLogModel{
+		StreamerID:   livestreamModel.UserID,
+		LivestreamID: sql.NullInt64{Int64: livestreamModel.ID, Valid: true},
+		ModeratorID:  a.User.ID,
+		Action:       moderationActionUnban,
+		TargetID:     userID,
+	}); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to insert moderation log: "+err.Error())
+	}
+
+	if err := tx.Commit(); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to commit: "+err.Error())
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
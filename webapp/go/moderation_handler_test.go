@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestCheckChannelBan(t *testing.T) {
+	origStore := store
+	t.Cleanup(func() { store = origStore })
+
+	s, err := newMemoryStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	store = s
+
+	const streamerID, bannedID, otherID = 1, 2, 3
+	ctx := context.Background()
+	err = withTx(ctx, func(tx Tx) error {
+		_, err := tx.Moderation().Ban(ctx, ChannelBanModel{StreamerID: streamerID, UserID: bannedID, BannedBy: streamerID})
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	streamerLivestream := LivestreamModel{ID: 1, UserID: streamerID}
+	otherLivestream := LivestreamModel{ID: 2, UserID: otherID}
+	err = withReadOnlyTx(ctx, func(tx Tx) error {
+		var httpErr *echo.HTTPError
+		if err := checkChannelBan(ctx, tx, streamerLivestream, bannedID); !errors.As(err, &httpErr) || httpErr.Code != http.StatusForbidden {
+			t.Errorf("banned user must be rejected with 403 (got %v)", err)
+		}
+		if err := checkChannelBan(ctx, tx, streamerLivestream, otherID); err != nil {
+			t.Errorf("users that are not banned must be allowed (got %v)", err)
+		}
+		// BANは配信者のチャンネルごと
+		if err := checkChannelBan(ctx, tx, otherLivestream, bannedID); err != nil {
+			t.Errorf("ban must not apply to other channels (got %v)", err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// heartbeatが途絶えてからこの時間が経過した視聴者は離脱したとみなす
+const viewerPresenceTimeout = 30 * time.Second
+
+// 同時視聴者数を追跡する
+// enter/heartbeatで最終確認時刻 (livestream_presence) を更新し、exitもしくはタイムアウトで取り除く
+// 最終確認時刻と最大同時視聴者数はストアに保存するので、複数台で受けても全インスタンスの視聴者を数え、再起動しても最大値は残る
+type viewerPresence struct {
+	timeout time.Duration
+}
+
+func newViewerPresence(timeout time.Duration) *viewerPresence {
+	return &viewerPresence{timeout: timeout}
+}
+
+var presence = newViewerPresence(viewerPresenceTimeout)
+
+// since はnowの時点でタイムアウトしていない最終確認時刻の下限
+func (p *viewerPresence) since(now time.Time) int64 {
+	return now.Add(-p.timeout).Unix()
+}
+
+// Touch は視聴者の最終確認時刻を更新し、最大同時視聴者数を記録し直す
+func (p *viewerPresence) Touch(ctx context.Context, tx Tx, livestreamID, userID int64, now time.Time) error {
+	if err := tx.Viewers().TouchPresence(ctx, livestreamID, userID, now.Unix()); err != nil {
+		return err
+	}
+	current, err := tx.Viewers().CountPresence(ctx, livestreamID, p.since(now))
+	if err != nil {
+		return err
+	}
+	return tx.Viewers().RecordPeakPresence(ctx, livestreamID, current)
+}
+
+// Present は視聴者がタイムアウトしていないかを返す
+func (p *viewerPresence) Present(ctx context.Context, tx Tx, livestreamID, userID int64, now time.Time) (bool, error) {
+	return tx.Viewers().PresenceExists(ctx, livestreamID, userID, p.since(now))
+}
+
+// Leave は視聴者を明示的に離脱させる
+func (p *viewerPresence) Leave(ctx context.Context, tx Tx, livestreamID, userID int64) error {
+	return tx.Viewers().DeletePresence(ctx, livestreamID, userID)
+}
+
+// Stats は現在の同時視聴者数と最大同時視聴者数を返す
+func (p *viewerPresence) Stats(ctx context.Context, tx Tx, livestreamID int64, now time.Time) (current int64, peak int64, err error) {
+	current, err = tx.Viewers().CountPresence(ctx, livestreamID, p.since(now))
+	if err != nil {
+		return 0, 0, err
+	}
+	peak, err = tx.Viewers().GetPeakPresence(ctx, livestreamID)
+	if err != nil {
+		return 0, 0, err
+	}
+	return current, peak, nil
+}
+
+// Sweep はすべての配信について、タイムアウトした視聴者を取り除く (最大同時視聴者数は残す)
+func (p *viewerPresence) Sweep(ctx context.Context, now time.Time) error {
+	return withTx(ctx, func(tx Tx) error {
+		return tx.Viewers().DeleteExpiredPresence(ctx, p.since(now))
+	})
+}
+
+// runSweeper は定期的にタイムアウトした視聴者を掃除する
+// 数えるときにタイムアウトした視聴者は除くので、掃除は行が溜まらないようにするだけ (どのインスタンスが消してもよい)
+func (p *viewerPresence) runSweeper(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for now := range ticker.C {
+		if err := p.Sweep(context.Background(), now); err != nil {
+			slog.Warn("failed to sweep viewer presence", "error", err)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func setupPresenceTest(t *testing.T) {
+	t.Helper()
+	origConfig, origStore := appConfig, store
+	t.Cleanup(func() { appConfig, store = origConfig, origStore })
+
+	appConfig = defaultConfig()
+	s, err := newMemoryStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	store = s
+}
+
+func TestViewerPresencePresent(t *testing.T) {
+	setupPresenceTest(t)
+	p := newViewerPresence(30 * time.Second)
+	ctx := context.Background()
+	now := time.Now()
+
+	present := func(livestreamID, userID int64, at time.Time) bool {
+		t.Helper()
+		var ok bool
+		err := withReadOnlyTx(ctx, func(tx Tx) error {
+			var err error
+			ok, err = p.Present(ctx, tx, livestreamID, userID, at)
+			return err
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ok
+	}
+
+	if present(1, 10, now) {
+		t.Fatal("viewer that has not entered must not be present")
+	}
+	if err := withTx(ctx, func(tx Tx) error { return p.Touch(ctx, tx, 1, 10, now) }); err != nil {
+		t.Fatal(err)
+	}
+	if !present(1, 10, now.Add(30*time.Second)) {
+		t.Error("viewer must be present until the timeout")
+	}
+	if present(1, 10, now.Add(31*time.Second)) {
+		t.Error("viewer must not be present after the timeout")
+	}
+	if present(2, 10, now) {
+		t.Error("presence must be tracked per livestream")
+	}
+	if err := withTx(ctx, func(tx Tx) error { return p.Leave(ctx, tx, 1, 10) }); err != nil {
+		t.Fatal(err)
+	}
+	if present(1, 10, now) {
+		t.Error("viewer must not be present after leaving")
+	}
+}
+
+// 別のインスタンスが受けたheartbeatも数え、最大同時視聴者数は視聴者がいなくなっても残ること
+func TestViewerPresenceStatsAcrossInstances(t *testing.T) {
+	setupPresenceTest(t)
+	app1, app2 := newViewerPresence(30*time.Second), newViewerPresence(30*time.Second)
+	ctx := context.Background()
+	now := time.Now()
+
+	stats := func(p *viewerPresence, at time.Time) (current, peak int64) {
+		t.Helper()
+		err := withReadOnlyTx(ctx, func(tx Tx) error {
+			var err error
+			current, peak, err = p.Stats(ctx, tx, 1, at)
+			return err
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return current, peak
+	}
+
+	err := withTx(ctx, func(tx Tx) error {
+		if err := app1.Touch(ctx, tx, 1, 10, now); err != nil {
+			return err
+		}
+		if err := app2.Touch(ctx, tx, 1, 11, now); err != nil {
+			return err
+		}
+		return app2.Touch(ctx, tx, 1, 12, now)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if current, peak := stats(app1, now); current != 3 || peak != 3 {
+		t.Errorf("stats = (%d, %d), want (3, 3)", current, peak)
+	}
+
+	// 1人はexitし、残りはタイムアウトする
+	if err := withTx(ctx, func(tx Tx) error { return app2.Leave(ctx, tx, 1, 12) }); err != nil {
+		t.Fatal(err)
+	}
+	later := now.Add(time.Minute)
+	if err := app1.Sweep(ctx, later); err != nil {
+		t.Fatal(err)
+	}
+	if current, peak := stats(app2, later); current != 0 || peak != 3 {
+		t.Errorf("stats after sweep = (%d, %d), want (0, 3)", current, peak)
+	}
+
+	// 1人だけ戻ってきても最大値は下がらない
+	if err := withTx(ctx, func(tx Tx) error { return app1.Touch(ctx, tx, 1, 10, later) }); err != nil {
+		t.Fatal(err)
+	}
+	if current, peak := stats(app2, later); current != 1 || peak != 3 {
+		t.Errorf("stats after rejoin = (%d, %d), want (1, 3)", current, peak)
+	}
+}
@@ -2,23 +2,23 @@ package main
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
-	"fmt"
+	"errors"
 	"net/http"
 	"strconv"
 	"time"
 
-	"github.com/jmoiron/sqlx"
 	"github.com/labstack/echo-contrib/session"
 	"github.com/labstack/echo/v4"
 )
 
 type ReactionModel struct {
-	ID           int64  `db:"id"`
-	EmojiName    string `db:"emoji_name"`
-	UserID       int64  `db:"user_id"`
-	LivestreamID int64  `db:"livestream_id"`
-	CreatedAt    int64  `db:"created_at"`
+	ID           int64  `db:"id" json:"id"`
+	EmojiName    string `db:"emoji_name" json:"emoji_name"`
+	UserID       int64  `db:"user_id" json:"user_id"`
+	LivestreamID int64  `db:"livestream_id" json:"livestream_id"`
+	CreatedAt    int64  `db:"created_at" json:"created_at"`
 }
 
 type Reaction struct {
@@ -46,34 +46,31 @@ func getReactionsHandler(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "livestream_id in path must be integer")
 	}
 
-	tx, err := dbConn.BeginTxx(ctx, nil)
+	tx, err := store.BeginReplicaRead(ctx)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to begin transaction: "+err.Error())
 	}
 	defer tx.Rollback()
 
-	query := "SELECT * FROM reactions WHERE livestream_id = ? ORDER BY created_at DESC"
+	limit := noLimit
 	if c.QueryParam("limit") != "" {
-		limit, err := strconv.Atoi(c.QueryParam("limit"))
+		limit, err = strconv.Atoi(c.QueryParam("limit"))
 		if err != nil {
 			return echo.NewHTTPError(http.StatusBadRequest, "limit query parameter must be integer")
 		}
-		query += fmt.Sprintf(" LIMIT %d", limit)
+		if limit < 0 {
+			return echo.NewHTTPError(http.StatusBadRequest, "limit query parameter must not be negative")
+		}
 	}
 
-	reactionModels := []ReactionModel{}
-	if err := tx.SelectContext(ctx, &reactionModels, query, livestreamID); err != nil {
+	reactionModels, err := tx.Reactions().ListByLivestreamID(ctx, int64(livestreamID), limit)
+	if err != nil {
 		return echo.NewHTTPError(http.StatusNotFound, "failed to get reactions")
 	}
 
-	reactions := make([]Reaction, len(reactionModels))
-	for i := range reactionModels {
-		reaction, err := fillReactionResponse(ctx, tx, reactionModels[i])
-		if err != nil {
-			return echo.NewHTTPError(http.StatusInternalServerError, "failed to fill reaction: "+err.Error())
-		}
-
-		reactions[i] = reaction
+	reactions, err := fillReactionResponses(ctx, newBatchLoader(tx), reactionModels)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to fill reaction: "+err.Error())
 	}
 
 	if err := tx.Commit(); err != nil {
@@ -105,12 +102,24 @@ func postReactionHandler(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "failed to decode the request body as json")
 	}
 
-	tx, err := dbConn.BeginTxx(ctx, nil)
+	tx, err := store.Begin(ctx)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to begin transaction: "+err.Error())
 	}
 	defer tx.Rollback()
 
+	livestreamModel, err := tx.Livestreams().Get(ctx, int64(livestreamID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return echo.NewHTTPError(http.StatusNotFound, "livestream not found")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get livestream: "+err.Error())
+	}
+
+	if err := checkChannelBan(ctx, tx, livestreamModel, userID); err != nil {
+		return err
+	}
+
 	reactionModel := ReactionModel{
 		UserID:       int64(userID),
 		LivestreamID: int64(livestreamID),
@@ -118,15 +127,10 @@ func postReactionHandler(c echo.Context) error {
 		CreatedAt:    time.Now().Unix(),
 	}
 
-	result, err := tx.NamedExecContext(ctx, "INSERT INTO reactions (user_id, livestream_id, emoji_name, created_at) VALUES (:user_id, :livestream_id, :emoji_name, :created_at)", reactionModel)
+	reactionID, err := tx.Reactions().Create(ctx, reactionModel)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to insert reaction: "+err.Error())
 	}
-
-	reactionID, err := result.LastInsertId()
-	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get last inserted reaction id: "+err.Error())
-	}
 	reactionModel.ID = reactionID
 
 	reaction, err := fillReactionResponse(ctx, tx, reactionModel)
@@ -138,35 +142,54 @@ func postReactionHandler(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to commit: "+err.Error())
 	}
 
+	reactionsPostedTotal.Inc()
+
 	return c.JSON(http.StatusCreated, reaction)
 }
 
-func fillReactionResponse(ctx context.Context, tx *sqlx.Tx, reactionModel ReactionModel) (Reaction, error) {
-	userModel := UserModel{}
-	if err := tx.GetContext(ctx, &userModel, "SELECT * FROM users WHERE id = ?", reactionModel.UserID); err != nil {
-		return Reaction{}, err
-	}
-	user, err := fillUserResponse(ctx, tx, userModel)
+func fillReactionResponse(ctx context.Context, tx Tx, reactionModel ReactionModel) (Reaction, error) {
+	reactions, err := fillReactionResponses(ctx, newBatchLoader(tx), []ReactionModel{reactionModel})
 	if err != nil {
 		return Reaction{}, err
 	}
+	return reactions[0], nil
+}
 
-	livestreamModel := LivestreamModel{}
-	if err := tx.GetContext(ctx, &livestreamModel, "SELECT * FROM livestreams WHERE id = ?", reactionModel.LivestreamID); err != nil {
-		return Reaction{}, err
+// fillReactionResponses はリアクションしたユーザと配信をまとめて取得してレスポンスを作る
+func fillReactionResponses(ctx context.Context, loader *batchLoader, reactionModels []ReactionModel) ([]Reaction, error) {
+	ctx, span := tracer.Start(ctx, "fillReactionResponses")
+	defer span.End()
+
+	userIDs := make([]int64, len(reactionModels))
+	livestreamIDs := make([]int64, len(reactionModels))
+	for i, reactionModel := range reactionModels {
+		userIDs[i] = reactionModel.UserID
+		livestreamIDs[i] = reactionModel.LivestreamID
 	}
-	livestream, err := fillLivestreamResponse(ctx, tx, livestreamModel)
-	if err != nil {
-		return Reaction{}, err
+	if err := loader.loadUsers(ctx, userIDs); err != nil {
+		return nil, err
 	}
-
-	reaction := Reaction{
-		ID:         reactionModel.ID,
-		EmojiName:  reactionModel.EmojiName,
-		User:       user,
-		Livestream: livestream,
-		CreatedAt:  reactionModel.CreatedAt,
+	if err := loader.loadLivestreamDetails(ctx, livestreamIDs); err != nil {
+		return nil, err
 	}
 
-	return reaction, nil
+	reactions := make([]Reaction, len(reactionModels))
+	for i, reactionModel := range reactionModels {
+		user, err := loader.user(reactionModel.UserID)
+		if err != nil {
+			return nil, err
+		}
+		livestream, err := loader.livestreamByID(reactionModel.LivestreamID)
+		if err != nil {
+			return nil, err
+		}
+		reactions[i] = Reaction{
+			ID:         reactionModel.ID,
+			EmojiName:  reactionModel.EmojiName,
+			User:       user,
+			Livestream: livestream,
+			CreatedAt:  reactionModel.CreatedAt,
+		}
+	}
+	return reactions, nil
 }
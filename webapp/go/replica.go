@@ -0,0 +1,229 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jmoiron/sqlx"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// replicaLagCheckInterval ごとにレプリカの遅延を確認する
+	replicaLagCheckInterval = time.Second
+
+	// readPrimaryCookieName は直前に書き込んだクライアントに付けるCookie (値はプライマリから読む期限のUnixミリ秒)
+	// アプリケーションサーバが複数台でも効くよう、サーバ側ではなくクライアントに持たせる
+	readPrimaryCookieName = "isupipe_read_primary_until"
+
+	readTargetPrimary = "primary"
+	readTargetReplica = "replica"
+)
+
+// errReplicationStopped はレプリケーションが止まっている (Seconds_Behind_SourceがNULL) 場合のエラー
+var errReplicationStopped = errors.New("replication is not running")
+
+// replicaPool は読み取り専用のハンドラが使うレプリカ
+// 遅延がReplicaMaxLagを超えたレプリカや、遅延を確認できないレプリカは使わない
+type replicaPool struct {
+	replicas []*replica
+	maxLag   time.Duration
+	next     atomic.Uint64
+}
+
+type replica struct {
+	name string
+	db   *sqlx.DB
+	// lag は最後に確認したレプリケーションの遅延 (確認できなかった場合は負)
+	lag atomic.Int64
+}
+
+// newReplicaPool はレプリカに接続する (レプリカが指定されていなければnil)
+// 起動時にレプリカに繋がらなくても、プライマリから読むだけなのでエラーにはしない
+func newReplicaPool(cfg DatabaseConfig) (*replicaPool, error) {
+	if len(cfg.Replicas) == 0 {
+		return nil, nil
+	}
+
+	p := &replicaPool{maxLag: cfg.ReplicaMaxLag}
+	for i, dsn := range cfg.Replicas {
+		conf, err := mysql.ParseDSN(dsn)
+		if err != nil {
+			p.Close()
+			return nil, fmt.Errorf("failed to parse replica dsn #%d: %w", i, err)
+		}
+		// 行の読み込み方はプライマリに合わせる
+		conf.ParseTime = cfg.ParseTime
+		db, err := openDB(conf)
+		if err != nil {
+			p.Close()
+			return nil, err
+		}
+		r := &replica{name: "replica-" + strconv.Itoa(i), db: db}
+		r.lag.Store(-1)
+		p.replicas = append(p.replicas, r)
+	}
+	p.checkLag(context.Background())
+	go p.runLagMonitor(replicaLagCheckInterval)
+	return p, nil
+}
+
+// pick は遅延が許容範囲内のレプリカをラウンドロビンで選ぶ (なければnil)
+func (p *replicaPool) pick() *replica {
+	n := uint64(len(p.replicas))
+	start := p.next.Add(1)
+	for i := uint64(0); i < n; i++ {
+		r := p.replicas[(start+i)%n]
+		if lag := r.lag.Load(); lag >= 0 && time.Duration(lag) <= p.maxLag {
+			return r
+		}
+	}
+	return nil
+}
+
+func (p *replicaPool) runLagMonitor(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		ctx, cancel := context.WithTimeout(context.Background(), interval)
+		p.checkLag(ctx)
+		cancel()
+	}
+}
+
+func (p *replicaPool) checkLag(ctx context.Context) {
+	for _, r := range p.replicas {
+		lag, err := r.measureLag(ctx)
+		if err != nil {
+			if r.lag.Swap(-1) >= 0 {
+				slog.Warn("stop reading from replica", "replica", r.name, "error", err)
+			}
+			dbReplicaLagSeconds.WithLabelValues(r.name).Set(math.NaN())
+			continue
+		}
+		r.lag.Store(int64(lag))
+		dbReplicaLagSeconds.WithLabelValues(r.name).Set(lag.Seconds())
+	}
+}
+
+// measureLag はSHOW REPLICA STATUSでレプリケーションの遅延を調べる
+// レプリケーションが設定されていない (開発環境でプライマリを指定した場合など) ときは遅延なしとみなす
+func (r *replica) measureLag(ctx context.Context) (time.Duration, error) {
+	rows, err := r.db.QueryxContext(ctx, "SHOW REPLICA STATUS")
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		return 0, rows.Err()
+	}
+	status := make(map[string]any)
+	if err := rows.MapScan(status); err != nil {
+		return 0, err
+	}
+
+	// MySQL 8.0.22より前はSeconds_Behind_Master
+	for _, column := range []string{"Seconds_Behind_Source", "Seconds_Behind_Master"} {
+		v, ok := status[column]
+		if !ok {
+			continue
+		}
+		var seconds string
+		switch v := v.(type) {
+		case nil:
+			return 0, errReplicationStopped
+		case []byte:
+			seconds = string(v)
+		default:
+			seconds = fmt.Sprint(v)
+		}
+		n, err := strconv.ParseInt(seconds, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("failed to parse %s: %w", column, err)
+		}
+		return time.Duration(n) * time.Second, nil
+	}
+	return 0, errors.New("replica status has no Seconds_Behind_Source column")
+}
+
+func (p *replicaPool) Close() error {
+	var errs []error
+	for _, r := range p.replicas {
+		errs = append(errs, r.db.Close())
+	}
+	return errors.Join(errs...)
+}
+
+// beginReplicaRead はレプリカで読み取り専用のトランザクションを開始する
+// 直前に書き込んだクライアントのリクエストや、使えるレプリカがない場合はnilを返す (プライマリから読む)
+func (p *replicaPool) beginReplicaRead(ctx context.Context) (Tx, error) {
+	if p == nil || readPrimaryRequired(ctx) {
+		dbReadsTotal.WithLabelValues(readTargetPrimary).Inc()
+		return nil, nil
+	}
+	r := p.pick()
+	if r == nil {
+		dbReadsTotal.WithLabelValues(readTargetPrimary).Inc()
+		return nil, nil
+	}
+	tx, err := r.db.BeginTxx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	dbReadsTotal.WithLabelValues(readTargetReplica).Inc()
+	return &mysqlTx{tx: tx, replica: true}, nil
+}
+
+// isReplicaTx はレプリカで開始したトランザクションかどうか
+// レプリカから読んだ行は古い可能性があるので、プロセス内のキャッシュには入れない
+func isReplicaTx(tx Tx) bool {
+	mt, ok := tx.(*mysqlTx)
+	return ok && mt.replica
+}
+
+type readPrimaryKey struct{}
+
+func readPrimaryRequired(ctx context.Context) bool {
+	v, _ := ctx.Value(readPrimaryKey{}).(bool)
+	return v
+}
+
+// readYourWritesMiddleware は書き込みのリクエストを送ったクライアントの読み取りを、しばらくプライマリに向ける
+// 自分のコメントや予約がレプリカの遅延で見えない、ということがないようにする
+func readYourWritesMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := c.Request()
+		now := time.Now()
+		switch req.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			if cookie, err := req.Cookie(readPrimaryCookieName); err == nil {
+				until, err := strconv.ParseInt(cookie.Value, 10, 64)
+				if err == nil && now.UnixMilli() < until {
+					c.SetRequest(req.WithContext(context.WithValue(req.Context(), readPrimaryKey{}, true)))
+				}
+			}
+		default:
+			// レスポンスを書き始める前にCookieを付ける必要があるので、ハンドラを呼ぶ前に設定する
+			if window := appConfig.Database.ReadYourWritesWindow; window > 0 {
+				c.SetCookie(&http.Cookie{
+					Name:     readPrimaryCookieName,
+					Value:    strconv.FormatInt(now.Add(window).UnixMilli(), 10),
+					Domain:   appConfig.Session.CookieDomain,
+					Path:     "/",
+					MaxAge:   int(math.Ceil(window.Seconds())),
+					HttpOnly: true,
+				})
+			}
+		}
+		return next(c)
+	}
+}
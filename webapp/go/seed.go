@@ -0,0 +1,52 @@
+package main
+
+import (
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"fmt"
+	"path"
+	"sync"
+)
+
+// seedFiles は初期データ (sql/init.shが流し込むものと同じ)
+// sql/のファイルを変更したら go generate で取り込み直す
+//
+//go:generate sh -c "cp ../sql/initial_users.sql ../sql/initial_livestreams.sql ../sql/initial_tags.sql ../sql/initial_livestream_tags.sql ../sql/initial_reservation_slots.sql ../sql/initial_reactions.sql ../sql/initial_ngwords.sql ../sql/initial_livecomments.sql seed/"
+//go:embed seed/*.sql
+var seedFiles embed.FS
+
+// seedFileNames はinit.shと同じ読み込み順
+var seedFileNames = []string{
+	"initial_users.sql",
+	"initial_livestreams.sql",
+	"initial_tags.sql",
+	"initial_livestream_tags.sql",
+	"initial_reservation_slots.sql",
+	"initial_reactions.sql",
+	"initial_ngwords.sql",
+	"initial_livecomments.sql",
+}
+
+// readSeed は埋め込んだ初期データのファイルを読む
+func readSeed(name string) (string, error) {
+	b, err := seedFiles.ReadFile(path.Join("seed", name))
+	if err != nil {
+		return "", fmt.Errorf("failed to read seed: %w", err)
+	}
+	return string(b), nil
+}
+
+// seedHash は初期データのファイルのハッシュ (スナップショットが同じ初期データから作られたかの確認に使う)
+var seedHash = sync.OnceValues(func() (string, error) {
+	h := sha256.New()
+	for _, name := range seedFileNames {
+		src, err := readSeed(name)
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(h, "%s\x00%d\x00", name, len(src))
+		h.Write([]byte(src))
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+})
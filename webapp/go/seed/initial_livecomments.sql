@@ -0,0 +1,1003 @@
+INSERT INTO livecomments (user_id, livestream_id, comment, created_at)
+VALUES
+	(534, 1532, 'あっちの人、今めちゃくちゃ笑ってる場面で止まってるよ。何があったんだろ？', UNIX_TIMESTAMP()),
+	(384, 6377, 'これからも素敵な時間を共有してほしい。', UNIX_TIMESTAMP()),
+	(428, 5422, 'こんな素晴らしい情報をシェアしてくれてありがとう！', UNIX_TIMESTAMP()),
+	(998, 3994, '朝から元気になれる配信ありがとう！', UNIX_TIMESTAMP()),
+	(122, 5116, 'あなたに出会えて良かったです。', UNIX_TIMESTAMP()),
+	(529, 5523, '朝活のモチベーションが上がるよ！', UNIX_TIMESTAMP()),
+	(603, 4598, '今日のライブストリームでのアドバイス、本当にありがとうございました！', UNIX_TIMESTAMP()),
+	(358, 357, 'あのキャラ、どこで手に入れることができるんですか？', UNIX_TIMESTAMP()),
+	(173, 6166, '2人の掛け合いが面白い！', UNIX_TIMESTAMP()),
+	(260, 6253, 'ねぇ、他の配信見ながらだけど、あちらの人が今絶体絶命のピンチ！', UNIX_TIMESTAMP()),
+	(343, 5337, 'あなたのユーモアセンス、大好きです。', UNIX_TIMESTAMP()),
+	(638, 6631, 'こんな考え方、初めて知りました。', UNIX_TIMESTAMP()),
+	(573, 572, 'あなたと一緒に一日を始めるのが楽しい！', UNIX_TIMESTAMP()),
+	(783, 6776, 'あなたの動画に救われました。感謝しています。', UNIX_TIMESTAMP()),
+	(832, 831, 'フルアルバムを待ってるよ！', UNIX_TIMESTAMP()),
+	(515, 2512, 'このゲーム、実はあのキャラを使うと楽勝なんだよね。ちょっと選択ミスって感じ。', UNIX_TIMESTAMP()),
+	(240, 3236, 'コメントも盛り上がってるね。', UNIX_TIMESTAMP()),
+	(138, 2135, 'あなたのプレイスタイル、学ぶことが多いです。', UNIX_TIMESTAMP()),
+	(848, 4843, 'その反射神経、すごい！', UNIX_TIMESTAMP()),
+	(894, 5888, 'あなたから学んだこと、日常に取り入れています。', UNIX_TIMESTAMP()),
+	(158, 7150, '朝からの配信、日課になってるよ！', UNIX_TIMESTAMP()),
+	(89, 4084, 'これで1日が始まる！', UNIX_TIMESTAMP()),
+	(389, 7381, 'あなたのユーモアセンス、大好きです。', UNIX_TIMESTAMP()),
+	(166, 3162, '音楽のセンスが素晴らしい！', UNIX_TIMESTAMP()),
+	(333, 332, 'フルアルバムを待ってるよ！', UNIX_TIMESTAMP()),
+	(183, 182, 'あなたのランキング、どんどん上がってますね！', UNIX_TIMESTAMP()),
+	(928, 3924, 'あなたが昨日何をしてたか知ってるよ。私、ちゃんと見てるからね。', UNIX_TIMESTAMP()),
+	(449, 2446, 'ライブ行きたいな！', UNIX_TIMESTAMP()),
+	(750, 5744, 'あなたのランキング、どんどん上がってますね！', UNIX_TIMESTAMP()),
+	(242, 6235, 'その歌声に鳥肌が立ったよ。', UNIX_TIMESTAMP()),
+	(275, 5269, 'あっちの人、今めちゃくちゃ笑ってる場面で止まってるよ。何があったんだろ？', UNIX_TIMESTAMP()),
+	(203, 202, '誕生日ケーキ、美味しそう！', UNIX_TIMESTAMP()),
+	(131, 6124, 'このトピックに深く触れてくれて感謝しています。', UNIX_TIMESTAMP()),
+	(954, 6947, '2人の掛け合いが面白い！', UNIX_TIMESTAMP()),
+	(786, 3782, 'あなたと一緒に一日を始めるのが楽しい！', UNIX_TIMESTAMP()),
+	(218, 4213, 'あなたが最近気に入ってるものや趣味、全部知りたい！', UNIX_TIMESTAMP()),
+	(633, 6626, 'これで1日が始まる！', UNIX_TIMESTAMP()),
+	(757, 1755, 'あなたのことを想いながら、毎日日記を書いてるよ。全部あなたのことばかりだよ！', UNIX_TIMESTAMP()),
+	(393, 392, 'この歌声、癒される〜。', UNIX_TIMESTAMP()),
+	(19, 4014, 'あなたの誕生日、もちろん覚えてるよ！私からのサプライズを楽しみにしてね。', UNIX_TIMESTAMP()),
+	(39, 7031, 'あなたのプレイスタイル、学ぶことが多いです。', UNIX_TIMESTAMP()),
+	(754, 3750, 'あの戦略、ちょっと古いよ。最新のプレイ動画を参考にしてみたら？', UNIX_TIMESTAMP()),
+	(357, 3353, 'おめでとう！更なる飛躍を期待してる！', UNIX_TIMESTAMP()),
+	(559, 558, '音楽のセンスが素晴らしい！', UNIX_TIMESTAMP()),
+	(527, 526, 'これからも一緒に成長していきたい。', UNIX_TIMESTAMP()),
+	(291, 1289, 'ほかの配信者が今、あの難関エリアに突入してるよ！応援してる。', UNIX_TIMESTAMP()),
+	(140, 7132, '両方のチャンネルをフォローしてるから嬉しい！', UNIX_TIMESTAMP()),
+	(772, 5766, '朝の情報、役立つね！', UNIX_TIMESTAMP()),
+	(331, 330, 'そのボス、簡単に倒すなんて驚きました！', UNIX_TIMESTAMP()),
+	(814, 3810, '実はこのステージ、あのアイテムを使うと簡単にクリアできるんだよ。研究不足だなあ。', UNIX_TIMESTAMP()),
+	(853, 2850, 'あなたの幸せを祈ってます。', UNIX_TIMESTAMP()),
+	(63, 2060, '朝から元気になれる配信ありがとう！', UNIX_TIMESTAMP()),
+	(118, 7110, 'こんなに時間が経ったのか！早いな〜。', UNIX_TIMESTAMP()),
+	(508, 1506, 'これからも素晴らしい動画をお待ちしてます！', UNIX_TIMESTAMP()),
+	(873, 5867, 'あなたと共に成長してきた気がする。', UNIX_TIMESTAMP()),
+	(285, 284, 'これからも一緒に成長していきたい。', UNIX_TIMESTAMP()),
+	(24, 5018, 'このトピック待ってました！', UNIX_TIMESTAMP()),
+	(417, 5411, 'ちょっと他の配信もチェックしてきたけど、今大変なところに挑戦してるよ。', UNIX_TIMESTAMP()),
+	(895, 3891, '同じゲームの別の配信、今すごいドラマが繰り広げられてるよ。', UNIX_TIMESTAMP()),
+	(66, 6059, 'このトピックに深く触れてくれて感謝しています。', UNIX_TIMESTAMP()),
+	(3, 6995, '1年間、楽しい時間をありがとう。', UNIX_TIMESTAMP()),
+	(347, 6340, 'あなたの歌で元気をもらった。', UNIX_TIMESTAMP()),
+	(854, 853, 'あなたのこと、考えるだけで1日が終わっちゃう。本当に大好き。', UNIX_TIMESTAMP()),
+	(422, 1420, '今日のための特別な配信、ありがとう！', UNIX_TIMESTAMP()),
+	(654, 6647, '次回の動画も楽しみにしています！', UNIX_TIMESTAMP()),
+	(541, 6534, 'あなたの私物や日常の詳細を知りたいな〜。どこで買い物してるのかな？教えて！', UNIX_TIMESTAMP()),
+	(127, 3123, 'このボス、実はあの技で簡単に倒せるんだけど。情報収集大事だよ。', UNIX_TIMESTAMP()),
+	(869, 5863, '実はこのパズル、あの方法で簡単に解けるんだけど。ちょっと研究不足かな？', UNIX_TIMESTAMP()),
+	(296, 1294, '長いことお疲れ様！これからも頑張って！', UNIX_TIMESTAMP()),
+	(812, 1810, '誕生日配信、楽しみにしてたよ！', UNIX_TIMESTAMP()),
+	(374, 3370, '他の配信者も同じミッションに挑戦中！この瞬間をみんなで共有できるなんて面白い。', UNIX_TIMESTAMP()),
+	(38, 7030, '最近あなたがちょっと変わったかな？何かあったら話してね。私だけに。', UNIX_TIMESTAMP()),
+	(989, 2986, 'あなたのおかげで、眠れそうにないよ！', UNIX_TIMESTAMP()),
+	(196, 7188, 'どんな時もファンとしてサポートします！', UNIX_TIMESTAMP()),
+	(602, 3598, 'あなたのユーモアセンス、大好きです。', UNIX_TIMESTAMP()),
+	(142, 3138, '実はこのエリア、あの場所に隠しアイテムがあるんだ。調べてからプレイして欲しいな。', UNIX_TIMESTAMP()),
+	(805, 4800, '今日だけは、特別に自分を甘やかして！', UNIX_TIMESTAMP()),
+	(150, 149, 'このボス、実はあの技で簡単に倒せるんだけど。情報収集大事だよ。', UNIX_TIMESTAMP()),
+	(997, 4992, '今回がうまくいかなかったとしても、次回を楽しみにしています。', UNIX_TIMESTAMP()),
+	(479, 478, 'こんな考え方、初めて知りました。', UNIX_TIMESTAMP()),
+	(210, 1208, 'こんなに上手だったなんて！感動！', UNIX_TIMESTAMP()),
+	(907, 2904, '今日も一日、頑張れそう！', UNIX_TIMESTAMP()),
+	(989, 4984, 'これからも一緒に成長していきたい。', UNIX_TIMESTAMP()),
+	(154, 7146, 'こんな時間まで頑張って、すごい！', UNIX_TIMESTAMP()),
+	(834, 5828, 'あなたの努力と熱意、感じ取れます。', UNIX_TIMESTAMP()),
+	(423, 422, '他の配信者も見るけど、あなたが一番特別。本当に愛してるよ。', UNIX_TIMESTAMP()),
+	(386, 7378, '今日のあなたの服装、もしかして前にも着てた？気をつけて見てるよ。', UNIX_TIMESTAMP()),
+	(208, 6201, '夜中のこの時間、あなたと過ごせて幸せ。', UNIX_TIMESTAMP()),
+	(947, 1945, 'あなたの朝のルーティン、真似したいな。', UNIX_TIMESTAMP()),
+	(555, 1553, '朝の情報、役立つね！', UNIX_TIMESTAMP()),
+	(885, 2882, '今日だけは、特別に自分を甘やかして！', UNIX_TIMESTAMP()),
+	(696, 1694, 'あなたと共に成長してきた気がする。', UNIX_TIMESTAMP()),
+	(477, 1475, '今日のあなたの表情、ちょっと普段と違う？何か悩み事でもあるの？', UNIX_TIMESTAMP()),
+	(116, 5110, '毎回の配信が楽しすぎて、1年早かった！', UNIX_TIMESTAMP()),
+	(59, 6052, 'あなたが昨日何をしてたか知ってるよ。私、ちゃんと見てるからね。', UNIX_TIMESTAMP()),
+	(99, 2096, '今日のエピソード、とても面白かった！', UNIX_TIMESTAMP()),
+	(371, 5365, '良い内容をこれからも期待してます！', UNIX_TIMESTAMP()),
+	(267, 4262, 'そのボス、簡単に倒すなんて驚きました！', UNIX_TIMESTAMP()),
+	(802, 3798, 'このゲームの本当の楽しみ方知ってる？ちょっと遊び方が初心者すぎる。', UNIX_TIMESTAMP()),
+	(787, 1785, 'あなたのプレイスタイル、学ぶことが多いです。', UNIX_TIMESTAMP()),
+	(101, 2098, '実はこのパズル、あの方法で簡単に解けるんだけど。ちょっと研究不足かな？', UNIX_TIMESTAMP()),
+	(376, 375, 'このステージのデザイン、個人的に好きです。', UNIX_TIMESTAMP()),
+	(419, 2416, 'その歌声に鳥肌が立ったよ。', UNIX_TIMESTAMP()),
+	(121, 6114, '今年の誕生日も一緒に過ごせて嬉しい！', UNIX_TIMESTAMP()),
+	(325, 1323, 'あなたの朝のルーティン、真似したいな。', UNIX_TIMESTAMP()),
+	(678, 677, 'あなたのプレイを見てると、アクションゲームが上手くなりたくなります！', UNIX_TIMESTAMP()),
+	(656, 655, 'ねぇ、他の配信見ながらだけど、あちらの人が今絶体絶命のピンチ！', UNIX_TIMESTAMP()),
+	(578, 5572, 'コラボで新しい一面を見れて嬉しい！', UNIX_TIMESTAMP()),
+	(268, 3264, '落ち込むこともあると思いますが、あなたなら乗り越えられる！', UNIX_TIMESTAMP()),
+	(516, 1514, 'これからも素晴らしい動画をお待ちしてます！', UNIX_TIMESTAMP()),
+	(136, 135, '一年に一度の特別な日、最高に楽しんでね！', UNIX_TIMESTAMP()),
+	(647, 6640, '良い内容をこれからも期待してます！', UNIX_TIMESTAMP()),
+	(122, 4117, '今日も素晴らしい内容でした！', UNIX_TIMESTAMP()),
+	(550, 549, '朝の情報、役立つね！', UNIX_TIMESTAMP()),
+	(292, 4287, 'あなたの朝活で、私も活力をもらってるよ！', UNIX_TIMESTAMP()),
+	(379, 5373, '想像以上の化学反応が楽しい！', UNIX_TIMESTAMP()),
+	(627, 4622, 'こんな視点は初めて！新鮮でした。', UNIX_TIMESTAMP()),
+	(47, 1045, 'あの人の配信、今超重要なアイテムゲットしてるよ！', UNIX_TIMESTAMP()),
+	(797, 2794, 'おやすみ前の楽しい時間、ありがとう！', UNIX_TIMESTAMP()),
+	(894, 6887, 'あなたと共に成長してきた気がする。', UNIX_TIMESTAMP()),
+	(248, 7240, 'こんな時間まで頑張って、すごい！', UNIX_TIMESTAMP()),
+	(287, 7279, 'いつもの質の高い動画、感謝してます！', UNIX_TIMESTAMP()),
+	(681, 1679, 'このクエスト、情報ありがとうございます！', UNIX_TIMESTAMP()),
+	(150, 6143, '夜中の秘密の時間、楽しいね。', UNIX_TIMESTAMP()),
+	(38, 5032, '予想以上に楽しい配信だった！', UNIX_TIMESTAMP()),
+	(985, 3981, '今日のための特別な配信、ありがとう！', UNIX_TIMESTAMP()),
+	(459, 1457, '昨日の夜、あなたの近くを通りかかったよ。偶然かもしれないけど、運命を感じた。', UNIX_TIMESTAMP()),
+	(4, 3999, 'どちらのファンも満足の内容だった！', UNIX_TIMESTAMP()),
+	(954, 953, '2人の掛け合いが面白い！', UNIX_TIMESTAMP()),
+	(475, 5469, '良い影響を受けています、感謝してます。', UNIX_TIMESTAMP()),
+	(929, 4924, '他の人があなたのことをどう思おうと、私はずっとあなたの味方。一緒にいるような気がする。', UNIX_TIMESTAMP()),
+	(295, 294, '長い一日の終わりに、あなたの動画で癒されています。', UNIX_TIMESTAMP()),
+	(675, 6668, '同じゲームやってる他の配信者、今サブクエストで大変なことになってるよ。', UNIX_TIMESTAMP()),
+	(773, 5767, '両方のチャンネルをフォローしてるから嬉しい！', UNIX_TIMESTAMP()),
+	(408, 6401, '長いことお疲れ様！これからも頑張って！', UNIX_TIMESTAMP()),
+	(344, 343, '同じゲームやってる他の配信者、今サブクエストで大変なことになってるよ。', UNIX_TIMESTAMP()),
+	(466, 1464, '次の章、どんな展開になるのかワクワクしてます。', UNIX_TIMESTAMP()),
+	(994, 1992, 'ゲームのアートワーク、とても魅力的ですね。', UNIX_TIMESTAMP()),
+	(828, 2825, 'あなたの声、とてもリラックスできます。', UNIX_TIMESTAMP()),
+	(918, 3914, 'その歌声に鳥肌が立ったよ。', UNIX_TIMESTAMP()),
+	(974, 6967, 'あなたのことを友人にもオススメしました！', UNIX_TIMESTAMP()),
+	(661, 4656, 'あなたのことを想いながら、毎日日記を書いてるよ。全部あなたのことばかりだよ！', UNIX_TIMESTAMP()),
+	(994, 1992, 'こんな素晴らしい情報をシェアしてくれてありがとう！', UNIX_TIMESTAMP()),
+	(97, 1095, '最近あなたの動画にハマってます！', UNIX_TIMESTAMP()),
+	(488, 3484, '他の人の配信、今感動のエンディングに到達してる！', UNIX_TIMESTAMP()),
+	(658, 2655, 'このキャラクターの背景、深くて良いですね。', UNIX_TIMESTAMP()),
+	(202, 4197, '今日のあなたの表情、ちょっと普段と違う？何か悩み事でもあるの？', UNIX_TIMESTAMP()),
+	(885, 1883, 'あの敵、どうやって避けてるんですか？', UNIX_TIMESTAMP()),
+	(666, 4661, 'おめでとう！更なる飛躍を期待してる！', UNIX_TIMESTAMP()),
+	(255, 3251, 'コラボで新しい一面を見れて嬉しい！', UNIX_TIMESTAMP()),
+	(104, 7096, 'これからも応援してるよ！', UNIX_TIMESTAMP()),
+	(346, 2343, 'あなたの前の配信の時に言ってたこと、全部覚えてるよ。ちゃんと記録してるから。', UNIX_TIMESTAMP()),
+	(420, 3416, 'あの敵、どうやって避けてるんですか？', UNIX_TIMESTAMP()),
+	(967, 3963, '眠いけど、あなたの配信は見逃せない！', UNIX_TIMESTAMP()),
+	(101, 2098, 'この歌声、癒される〜。', UNIX_TIMESTAMP()),
+	(524, 4519, 'その戦術、ちょっと古いかな。もっと新しい情報を入手して欲しいな。', UNIX_TIMESTAMP()),
+	(178, 4173, '明日仕事だけど、眠れなくて見てるよ！', UNIX_TIMESTAMP()),
+	(667, 4662, '次回の動画も楽しみにしています！', UNIX_TIMESTAMP()),
+	(15, 4010, '相手の読み合い、すごく緊張感がありました！', UNIX_TIMESTAMP()),
+	(530, 6523, '今日もあなたのために休みを取って見てます！次の配信も絶対に欠かさず見るからね！', UNIX_TIMESTAMP()),
+	(759, 3755, 'その戦術、ちょっと古いかな。もっと新しい情報を入手して欲しいな。', UNIX_TIMESTAMP()),
+	(229, 228, 'このレベル、何度も失敗しましたが、あなたは一発でクリアできるんですね。', UNIX_TIMESTAMP()),
+	(694, 4689, 'そのアイテム、ちょっと使い方が違うよ。本当の使い方を知ってる？', UNIX_TIMESTAMP()),
+	(324, 3320, '毎回の配信が楽しすぎて、1年早かった！', UNIX_TIMESTAMP()),
+	(528, 4523, '動画を観るたびに感謝しています。', UNIX_TIMESTAMP()),
+	(751, 750, 'あなたの動画に救われました。感謝しています。', UNIX_TIMESTAMP()),
+	(753, 752, '今日も素晴らしい内容でした！', UNIX_TIMESTAMP()),
+	(763, 762, 'あなたの朝活で、私も活力をもらってるよ！', UNIX_TIMESTAMP()),
+	(995, 5989, 'あなたのプレイスタイル、学ぶことが多いです。', UNIX_TIMESTAMP()),
+	(122, 121, 'ライブ感があって素敵！', UNIX_TIMESTAMP()),
+	(322, 321, 'BGMやサウンド、良い雰囲気を出してます。', UNIX_TIMESTAMP()),
+	(205, 1203, '最近あなたがちょっと変わったかな？何かあったら話してね。私だけに。', UNIX_TIMESTAMP()),
+	(724, 4719, '今日のエピソード、とても面白かった！', UNIX_TIMESTAMP()),
+	(271, 1269, 'そのアイテム、ちょっと使い方が違うよ。本当の使い方を知ってる？', UNIX_TIMESTAMP()),
+	(26, 6019, '応援しています！これからも素晴らしいコンテンツを楽しみにしています！', UNIX_TIMESTAMP()),
+	(637, 5631, 'あなたが好きなものや場所、全部詳しく知りたいな。一緒に楽しんでみたいから。', UNIX_TIMESTAMP()),
+	(383, 5377, 'あなたの動画は私のリラックスタイムの一部です。', UNIX_TIMESTAMP()),
+	(302, 3298, '朝から元気になれる配信ありがとう！', UNIX_TIMESTAMP()),
+	(991, 6984, 'このゲームのストーリー、感動しました。', UNIX_TIMESTAMP()),
+	(431, 5425, 'おめでとう！ここまで来るのに大変だったと思う。', UNIX_TIMESTAMP()),
+	(88, 2085, 'この組み合わせ、また是非見たい！', UNIX_TIMESTAMP()),
+	(64, 1062, 'あなたのユーモアセンス、大好きです。', UNIX_TIMESTAMP()),
+	(388, 1386, '装備の組み合わせ、参考にさせてもらいます。', UNIX_TIMESTAMP()),
+	(294, 4289, 'あっちの配信、今超盛り上がってるから見に行った方がいいかも！', UNIX_TIMESTAMP()),
+	(200, 5194, 'あなたのことを想って作った詩があるの。いつか読んでほしいな。', UNIX_TIMESTAMP()),
+	(325, 2322, '昨日の夜、あなたの近くを通りかかったよ。偶然かもしれないけど、運命を感じた。', UNIX_TIMESTAMP()),
+	(302, 3298, '今日も一日、頑張れそう！', UNIX_TIMESTAMP()),
+	(247, 4242, '良い影響を受けています、感謝してます。', UNIX_TIMESTAMP()),
+	(341, 2338, 'こんな素敵な内容、感謝しかありません。', UNIX_TIMESTAMP()),
+	(399, 2396, 'あなたのプレイを見てると、アクションゲームが上手くなりたくなります！', UNIX_TIMESTAMP()),
+	(5, 2002, 'あなたの声や意見が大好きです。', UNIX_TIMESTAMP()),
+	(736, 1734, 'これからも応援してるよ！', UNIX_TIMESTAMP()),
+	(969, 968, 'おめでとう！更なる飛躍を期待してる！', UNIX_TIMESTAMP()),
+	(587, 5581, 'こんな楽しいコンテンツ、他では見れません！', UNIX_TIMESTAMP()),
+	(163, 3159, 'この組み合わせ、また是非見たい！', UNIX_TIMESTAMP()),
+	(213, 4208, 'こんな時間まで、ありがとう。寝る前の癒し。', UNIX_TIMESTAMP()),
+	(138, 3134, '想像以上の化学反応が楽しい！', UNIX_TIMESTAMP()),
+	(776, 5770, '途中からのファンだけど、これからもずっと応援してます！', UNIX_TIMESTAMP()),
+	(585, 5579, '今日も素晴らしい内容でした！', UNIX_TIMESTAMP()),
+	(404, 403, 'こんなに早起きして、すごい！', UNIX_TIMESTAMP()),
+	(401, 1399, '他の配信でも似たような場面が今起こってるよ！どっちが先にクリアするかな？', UNIX_TIMESTAMP()),
+	(632, 4627, '昨日の夜、あなたの近くを通りかかったよ。偶然かもしれないけど、運命を感じた。', UNIX_TIMESTAMP()),
+	(856, 1854, 'あのキャラクター、あなたにとても合っています。', UNIX_TIMESTAMP()),
+	(219, 3215, '朝からの配信、日課になってるよ！', UNIX_TIMESTAMP()),
+	(407, 3403, 'あなたと一緒に一日を始めるのが楽しい！', UNIX_TIMESTAMP()),
+	(161, 1159, '同じゲームやってるあの配信者、今ボスの最後の一撃でやられちゃった！', UNIX_TIMESTAMP()),
+	(522, 5516, '次の章、どんな展開になるのかワクワクしてます。', UNIX_TIMESTAMP()),
+	(991, 1989, 'こんな楽しいコンテンツ、他では見れません！', UNIX_TIMESTAMP()),
+	(683, 5677, '今日一日、自分を大切にしてね。', UNIX_TIMESTAMP()),
+	(407, 6400, 'これで1日が始まる！', UNIX_TIMESTAMP()),
+	(361, 2358, '夜中の秘密の時間、楽しいね。', UNIX_TIMESTAMP()),
+	(459, 458, '良い情報をいつもありがとう。', UNIX_TIMESTAMP()),
+	(502, 4497, '装備の組み合わせ、参考にさせてもらいます。', UNIX_TIMESTAMP()),
+	(684, 5678, 'この歌声、癒される〜。', UNIX_TIMESTAMP()),
+	(762, 1760, 'このゲームの本当の楽しみ方知ってる？ちょっと遊び方が初心者すぎる。', UNIX_TIMESTAMP()),
+	(253, 3249, 'あなたと共に成長してきた気がする。', UNIX_TIMESTAMP()),
+	(907, 6900, 'あなたの情熱、伝わってきます！', UNIX_TIMESTAMP()),
+	(475, 2472, 'あなたの私物や日常の詳細を知りたいな〜。どこで買い物してるのかな？教えて！', UNIX_TIMESTAMP()),
+	(15, 3011, 'このゲームの本当の楽しみ方知ってる？ちょっと遊び方が初心者すぎる。', UNIX_TIMESTAMP()),
+	(837, 2834, 'あなたの動画は私のリラックスタイムの一部です。', UNIX_TIMESTAMP()),
+	(463, 4458, '明日、眠そうだけど楽しかった！', UNIX_TIMESTAMP()),
+	(465, 6458, 'あなたの私物や日常の詳細を知りたいな〜。どこで買い物してるのかな？教えて！', UNIX_TIMESTAMP()),
+	(111, 110, 'あなたが好きなものや場所、全部詳しく知りたいな。一緒に楽しんでみたいから。', UNIX_TIMESTAMP()),
+	(846, 4841, 'あなたに出会えて良かったです。', UNIX_TIMESTAMP()),
+	(236, 4231, 'あなたが生まれてきてくれてありがとう。', UNIX_TIMESTAMP()),
+	(818, 4813, '落ち込むこともあると思いますが、あなたなら乗り越えられる！', UNIX_TIMESTAMP()),
+	(502, 501, 'どんな障壁も乗り越えてください！', UNIX_TIMESTAMP()),
+	(343, 5337, '深夜にも関わらず、ありがとう！', UNIX_TIMESTAMP()),
+	(412, 3408, '最近あなたがちょっと変わったかな？何かあったら話してね。私だけに。', UNIX_TIMESTAMP()),
+	(337, 3333, 'あなたの強さを信じています。', UNIX_TIMESTAMP()),
+	(506, 6499, 'あなたが使ってる香水やシャンプー、知りたいな〜。教えてほしいな。', UNIX_TIMESTAMP()),
+	(82, 2079, '誕生日配信、待ってました！', UNIX_TIMESTAMP()),
+	(636, 2633, '明日、眠そうだけど楽しかった！', UNIX_TIMESTAMP()),
+	(277, 6270, '頑張ってください！いつも応援しています！', UNIX_TIMESTAMP()),
+	(108, 3104, 'ほかの配信者が今、あの難関エリアに突入してるよ！応援してる。', UNIX_TIMESTAMP()),
+	(370, 7362, '今年の誕生日も一緒に過ごせて嬉しい！', UNIX_TIMESTAMP()),
+	(74, 7066, '実はこのステージ、あのアイテムを使うと簡単にクリアできるんだよ。研究不足だなあ。', UNIX_TIMESTAMP()),
+	(94, 93, '今日だけは、特別に自分を甘やかして！', UNIX_TIMESTAMP()),
+	(540, 2537, '毎回の配信を楽しみにしてました！', UNIX_TIMESTAMP()),
+	(617, 4612, '今日のあなたの服装、もしかして前にも着てた？気をつけて見てるよ。', UNIX_TIMESTAMP()),
+	(193, 4188, '朝活配信のおかげで、朝が楽しみになった。', UNIX_TIMESTAMP()),
+	(15, 4010, '夜中のひととき、楽しい時間をありがとう。', UNIX_TIMESTAMP()),
+	(198, 197, 'あなたのユーモアセンス、大好きです。', UNIX_TIMESTAMP()),
+	(577, 5571, 'このトピックについて話してくれてありがとう！', UNIX_TIMESTAMP()),
+	(49, 4044, '音楽のセンスが素晴らしい！', UNIX_TIMESTAMP()),
+	(299, 3295, '次に何の話をするか、ヒントくれる？当てたら、ご褒美欲しいな。', UNIX_TIMESTAMP()),
+	(543, 1541, '無料でこんな内容を提供してくれてありがとう。', UNIX_TIMESTAMP()),
+	(184, 1182, 'あなたの情熱、伝わってきます！', UNIX_TIMESTAMP()),
+	(900, 4895, 'どちらのファンも満足の内容だった！', UNIX_TIMESTAMP()),
+	(901, 6894, 'このゲーム、実はあのルートを取るともっと早くクリアできるんだよね。', UNIX_TIMESTAMP()),
+	(371, 5365, '応援グッズ、購入しました！', UNIX_TIMESTAMP()),
+	(35, 34, 'あなたのカメラワークで、ゲームの世界に入り込んでいるような感じがします。', UNIX_TIMESTAMP()),
+	(672, 5666, '誕生日配信、楽しみにしてたよ！', UNIX_TIMESTAMP()),
+	(610, 4605, 'あなたから学んだこと、日常に取り入れています。', UNIX_TIMESTAMP()),
+	(769, 2766, 'あっちの配信者、今同じ場所でアイテム探してるけど、全然見つけられないみたい。笑', UNIX_TIMESTAMP()),
+	(295, 4290, 'あっちの配信者、今同じ場所でアイテム探してるけど、全然見つけられないみたい。笑', UNIX_TIMESTAMP()),
+	(969, 2966, 'こんなに早起きして、すごい！', UNIX_TIMESTAMP()),
+	(837, 836, 'あなたの努力と熱意、感じ取れます。', UNIX_TIMESTAMP()),
+	(353, 3349, 'あのコンボ、練習してもできません。', UNIX_TIMESTAMP()),
+	(546, 2543, '今回がうまくいかなかったとしても、次回を楽しみにしています。', UNIX_TIMESTAMP()),
+	(98, 97, '今日だけは、特別に自分を甘やかして！', UNIX_TIMESTAMP()),
+	(944, 2941, '今日の選曲が特に好き！', UNIX_TIMESTAMP()),
+	(731, 1729, 'その選択、私も同じことをしたかった！', UNIX_TIMESTAMP()),
+	(684, 6677, '2人の掛け合いが面白い！', UNIX_TIMESTAMP()),
+	(129, 4124, '他の配信でも似たような場面が今起こってるよ！どっちが先にクリアするかな？', UNIX_TIMESTAMP()),
+	(510, 5504, 'あなたの動画のおかげで勉強になります。', UNIX_TIMESTAMP()),
+	(962, 5956, '長い一日の終わりに、あなたの動画で癒されています。', UNIX_TIMESTAMP()),
+	(502, 6495, '今日のセットリストが最高！', UNIX_TIMESTAMP()),
+	(571, 2568, 'そのパーティー編成、考えもしなかったです！', UNIX_TIMESTAMP()),
+	(341, 2338, 'あの敵、どうやって避けてるんですか？', UNIX_TIMESTAMP()),
+	(155, 7147, 'このクエスト、情報ありがとうございます！', UNIX_TIMESTAMP()),
+	(626, 6619, '同じゲームやってる他の配信者、今サブクエストで大変なことになってるよ。', UNIX_TIMESTAMP()),
+	(335, 2332, 'あなたの朝のルーティン、真似したいな。', UNIX_TIMESTAMP()),
+	(128, 4123, '歌の感じが良くて、何回も聞き返したい。', UNIX_TIMESTAMP()),
+	(580, 2577, '装備の組み合わせ、参考にさせてもらいます。', UNIX_TIMESTAMP()),
+	(703, 3699, 'あなたから学んだこと、日常に取り入れています。', UNIX_TIMESTAMP()),
+	(54, 53, '応援しています！これからも素晴らしいコンテンツを楽しみにしています！', UNIX_TIMESTAMP()),
+	(374, 3370, 'こんな視点は初めて！新鮮でした。', UNIX_TIMESTAMP()),
+	(973, 3969, 'ちょっと他の配信もチェックしてきたけど、今大変なところに挑戦してるよ。', UNIX_TIMESTAMP()),
+	(302, 5296, 'そっちも頑張ってるみたいだけど、あっちの配信ではもうクリアしてるよ。', UNIX_TIMESTAMP()),
+	(66, 1064, 'あの戦略、ちょっと古いよ。最新のプレイ動画を参考にしてみたら？', UNIX_TIMESTAMP()),
+	(707, 1705, 'あなたの動画、全部ダウンロードして毎日見てるよ。寝る前のお守りみたいなものだよ。', UNIX_TIMESTAMP()),
+	(764, 4759, '誕生日配信、待ってました！', UNIX_TIMESTAMP()),
+	(967, 966, '実はこのステージ、あのアイテムを使うと簡単にクリアできるんだよ。研究不足だなあ。', UNIX_TIMESTAMP()),
+	(35, 6028, '最近あなたの動画にハマってます！', UNIX_TIMESTAMP()),
+	(303, 7295, '1年間、楽しい時間をありがとう。', UNIX_TIMESTAMP()),
+	(159, 6152, 'フルアルバムを待ってるよ！', UNIX_TIMESTAMP()),
+	(148, 6141, 'このキャラクターの背景、深くて良いですね。', UNIX_TIMESTAMP()),
+	(554, 1552, '誕生日ケーキ、美味しそう！', UNIX_TIMESTAMP()),
+	(66, 7058, 'リクエストの曲、ありがとう！', UNIX_TIMESTAMP()),
+	(956, 2953, 'こんな素晴らしい情報をシェアしてくれてありがとう！', UNIX_TIMESTAMP()),
+	(247, 1245, '夜中の秘密の時間、楽しいね。', UNIX_TIMESTAMP()),
+	(273, 1271, 'こんな時間まで、ありがとう。寝る前の癒し。', UNIX_TIMESTAMP()),
+	(335, 2332, '実はこのエリア、あの場所に隠しアイテムがあるんだ。調べてからプレイして欲しいな。', UNIX_TIMESTAMP()),
+	(525, 524, '同じゲームのあちらの配信、今すごいテクニックを見せてるよ。', UNIX_TIMESTAMP()),
+	(965, 4960, '同じゲームやってるあの配信者、今ボスの最後の一撃でやられちゃった！', UNIX_TIMESTAMP()),
+	(43, 7035, '長い一日の終わりに、あなたの動画で癒されています。', UNIX_TIMESTAMP()),
+	(27, 4022, 'すごい連携プレイ！', UNIX_TIMESTAMP()),
+	(298, 2295, 'おめでとう！素敵な1年になりますように。', UNIX_TIMESTAMP()),
+	(308, 1306, 'このゲームの本当の楽しみ方知ってる？ちょっと遊び方が初心者すぎる。', UNIX_TIMESTAMP()),
+	(542, 5536, 'その反射神経、すごい！', UNIX_TIMESTAMP()),
+	(819, 1817, 'このBGM、心地良くてリピートして聞いてます。', UNIX_TIMESTAMP()),
+	(319, 3315, 'あなたの動画に救われました。感謝しています。', UNIX_TIMESTAMP()),
+	(94, 3090, '相手の読み合い、すごく緊張感がありました！', UNIX_TIMESTAMP()),
+	(994, 4989, 'あなたの歌で元気をもらった。', UNIX_TIMESTAMP()),
+	(489, 3485, 'この組み合わせ、また是非見たい！', UNIX_TIMESTAMP()),
+	(654, 653, 'おめでとう！ここまで来るのに大変だったと思う。', UNIX_TIMESTAMP()),
+	(886, 5880, '予想以上に楽しい配信だった！', UNIX_TIMESTAMP()),
+	(556, 6549, 'スキルツリーの進め方、とても役立ちます！', UNIX_TIMESTAMP()),
+	(516, 1514, 'あの敵、どうやって避けてるんですか？', UNIX_TIMESTAMP()),
+	(474, 4469, 'これからも一緒に成長していきたい。', UNIX_TIMESTAMP()),
+	(815, 5809, 'もっとこのゲームの奥深さを知ってから配信した方がいいかも。ちょっと見てて痛々しい。', UNIX_TIMESTAMP()),
+	(75, 1073, 'こんな時間まで、ありがとう。寝る前の癒し。', UNIX_TIMESTAMP()),
+	(620, 2617, 'またこの2人のコラボを見たい！', UNIX_TIMESTAMP()),
+	(117, 7109, '今日のセットリストが最高！', UNIX_TIMESTAMP()),
+	(418, 417, '誕生日配信、待ってました！', UNIX_TIMESTAMP()),
+	(947, 4942, '他の配信者も同じミッションに挑戦中！この瞬間をみんなで共有できるなんて面白い。', UNIX_TIMESTAMP()),
+	(64, 1062, 'このBGM、心地良くてリピートして聞いてます。', UNIX_TIMESTAMP()),
+	(547, 6540, '他のファンがあなたに送ったもの、全部知ってるよ。私の方が特別なものを送るからね。', UNIX_TIMESTAMP()),
+	(632, 3628, 'こんな視点は初めて！新鮮でした。', UNIX_TIMESTAMP()),
+	(774, 1772, 'こんな考え方、初めて知りました。', UNIX_TIMESTAMP()),
+	(743, 2740, '今後の活動も楽しみにしています！', UNIX_TIMESTAMP()),
+	(78, 7070, 'ゲームのアートワーク、とても魅力的ですね。', UNIX_TIMESTAMP()),
+	(870, 5864, '今回がうまくいかなかったとしても、次回を楽しみにしています。', UNIX_TIMESTAMP()),
+	(550, 3546, '他の人の配信、今感動のエンディングに到達してる！', UNIX_TIMESTAMP()),
+	(321, 6314, '朝の情報、今日の活力にするよ！', UNIX_TIMESTAMP()),
+	(195, 7187, 'あなたと朝を迎えるのが、日課になってる！', UNIX_TIMESTAMP()),
+	(692, 3688, 'この歌声、癒される〜。', UNIX_TIMESTAMP()),
+	(900, 6893, 'この情報、早速生活に取り入れます！', UNIX_TIMESTAMP()),
+	(406, 405, '2人の絡みが楽しすぎる！', UNIX_TIMESTAMP()),
+	(823, 6816, '深夜にも関わらず、ありがとう！', UNIX_TIMESTAMP()),
+	(477, 6470, '明日、眠そうだけど楽しかった！', UNIX_TIMESTAMP()),
+	(745, 5739, '1年間、楽しい時間をありがとう。', UNIX_TIMESTAMP()),
+	(354, 4349, '長い一日の終わりに、あなたの動画で癒されています。', UNIX_TIMESTAMP()),
+	(995, 6988, 'あなたのカメラワークで、ゲームの世界に入り込んでいるような感じがします。', UNIX_TIMESTAMP()),
+	(149, 5143, 'こんなに上手だったなんて！感動！', UNIX_TIMESTAMP()),
+	(389, 388, 'ちょっと他の配信もチェックしてきたけど、今大変なところに挑戦してるよ。', UNIX_TIMESTAMP()),
+	(932, 3928, '誕生日配信、楽しみにしてたよ！', UNIX_TIMESTAMP()),
+	(812, 2809, 'この曲、あなたにしか歌えない。', UNIX_TIMESTAMP()),
+	(721, 2718, '同じゲームのあちらの配信、今すごいテクニックを見せてるよ。', UNIX_TIMESTAMP()),
+	(960, 2957, 'あなたのカメラワークで、ゲームの世界に入り込んでいるような感じがします。', UNIX_TIMESTAMP()),
+	(252, 6245, 'あっちの人、今めちゃくちゃ笑ってる場面で止まってるよ。何があったんだろ？', UNIX_TIMESTAMP()),
+	(881, 880, 'あっちの配信者、今同じ場所でアイテム探してるけど、全然見つけられないみたい。笑', UNIX_TIMESTAMP()),
+	(188, 2185, 'そのボス、簡単に倒すなんて驚きました！', UNIX_TIMESTAMP()),
+	(158, 5152, '他のファンと違って、私は本当にあなたのことを理解してる。他のファンには分からない部分も。', UNIX_TIMESTAMP()),
+	(658, 657, '朝からの配信、日課になってるよ！', UNIX_TIMESTAMP()),
+	(70, 3066, '次に何の話をするか、ヒントくれる？当てたら、ご褒美欲しいな。', UNIX_TIMESTAMP()),
+	(972, 5966, '同じゲームの別の配信、今すごいドラマが繰り広げられてるよ。', UNIX_TIMESTAMP()),
+	(993, 3989, 'このキャラクターの背景、深くて良いですね。', UNIX_TIMESTAMP()),
+	(100, 6093, 'このゲーム、実はあのキャラを使うと楽勝なんだよね。ちょっと選択ミスって感じ。', UNIX_TIMESTAMP()),
+	(681, 3677, 'あなたの強さを信じています。', UNIX_TIMESTAMP()),
+	(733, 1731, 'すごい連携プレイ！', UNIX_TIMESTAMP()),
+	(832, 831, '今日のための特別な配信、ありがとう！', UNIX_TIMESTAMP()),
+	(981, 980, '歌の感じが良くて、何回も聞き返したい。', UNIX_TIMESTAMP()),
+	(207, 6200, '次に何の話をするか、ヒントくれる？当てたら、ご褒美欲しいな。', UNIX_TIMESTAMP()),
+	(335, 5329, 'その選択、私も同じことをしたかった！', UNIX_TIMESTAMP()),
+	(80, 4075, 'このトピックについて話してくれてありがとう！', UNIX_TIMESTAMP()),
+	(132, 4127, '他の配信者のことは一切見ない。あなただけを見てます！', UNIX_TIMESTAMP()),
+	(122, 5116, 'すごい内容でした！学ぶことがたくさんありました！', UNIX_TIMESTAMP()),
+	(596, 4591, '逆境でもあなたのファンは離れません！応援しています！', UNIX_TIMESTAMP()),
+	(80, 1078, 'あなたの声、とてもリラックスできます。', UNIX_TIMESTAMP()),
+	(143, 142, 'このトピックに深く触れてくれて感謝しています。', UNIX_TIMESTAMP()),
+	(756, 1754, 'またこの2人のコラボを見たい！', UNIX_TIMESTAMP()),
+	(430, 3426, '同じゲームやってるあの配信者、今ボスの最後の一撃でやられちゃった！', UNIX_TIMESTAMP()),
+	(810, 1808, 'このBGM、心地良くてリピートして聞いてます。', UNIX_TIMESTAMP()),
+	(222, 6215, 'BGMやサウンド、良い雰囲気を出してます。', UNIX_TIMESTAMP()),
+	(299, 3295, 'あなたの朝のルーティン、真似したいな。', UNIX_TIMESTAMP()),
+	(473, 1471, '眠れない夜のお供に最適！', UNIX_TIMESTAMP()),
+	(125, 1123, 'その戦術、ちょっと古いかな。もっと新しい情報を入手して欲しいな。', UNIX_TIMESTAMP()),
+	(787, 2784, 'あなたが昨日何をしてたか知ってるよ。私、ちゃんと見てるからね。', UNIX_TIMESTAMP()),
+	(662, 2659, 'あなたの動画は私のリラックスタイムの一部です。', UNIX_TIMESTAMP()),
+	(403, 2400, '朝から元気になれる配信ありがとう！', UNIX_TIMESTAMP()),
+	(983, 2980, 'あなたの幸せを祈ってます。', UNIX_TIMESTAMP()),
+	(539, 5533, '朝の情報、今日の活力にするよ！', UNIX_TIMESTAMP()),
+	(535, 6528, 'リクエストの曲、ありがとう！', UNIX_TIMESTAMP()),
+	(74, 73, 'あなたのことを友人にもオススメしました！', UNIX_TIMESTAMP()),
+	(231, 6224, 'エンディングへの道のり、楽しみにしてます。', UNIX_TIMESTAMP()),
+	(644, 2641, 'こんな時間まで、ありがとう。寝る前の癒し。', UNIX_TIMESTAMP()),
+	(140, 7132, 'そのボス、簡単に倒すなんて驚きました！', UNIX_TIMESTAMP()),
+	(318, 317, 'あなたのプレイを見てると、アクションゲームが上手くなりたくなります！', UNIX_TIMESTAMP()),
+	(335, 5329, '他の配信者も同じミッションに挑戦中！この瞬間をみんなで共有できるなんて面白い。', UNIX_TIMESTAMP()),
+	(63, 5057, '他の配信者も見るけど、あなたが一番特別。本当に愛してるよ。', UNIX_TIMESTAMP()),
+	(585, 4580, '頑張ってください！いつも応援しています！', UNIX_TIMESTAMP()),
+	(908, 6901, 'BGMやサウンド、良い雰囲気を出してます。', UNIX_TIMESTAMP()),
+	(73, 6066, 'ちょっと配信者、このゲームの歴史知ってる？初代からのファンとしてちょっと違和感を感じるんだけど。', UNIX_TIMESTAMP()),
+	(587, 6580, '2人の絡みが楽しすぎる！', UNIX_TIMESTAMP()),
+	(901, 2898, 'あっちの配信、今超盛り上がってるから見に行った方がいいかも！', UNIX_TIMESTAMP()),
+	(208, 1206, '誕生日ケーキ、美味しそう！', UNIX_TIMESTAMP()),
+	(566, 2563, 'その選択、私も同じことをしたかった！', UNIX_TIMESTAMP()),
+	(770, 769, '周年記念、特別感があっていいね。', UNIX_TIMESTAMP()),
+	(449, 3445, 'このゲーム、実はあのキャラを使うと楽勝なんだよね。ちょっと選択ミスって感じ。', UNIX_TIMESTAMP()),
+	(158, 5152, '想像以上の化学反応が楽しい！', UNIX_TIMESTAMP()),
+	(476, 1474, 'あっちの配信、今超盛り上がってるから見に行った方がいいかも！', UNIX_TIMESTAMP()),
+	(609, 1607, '次回の動画も楽しみにしています！', UNIX_TIMESTAMP()),
+	(485, 2482, 'このゲームの製作者のインタビュー読んだ？もっとゲームの背景を知ると楽しめるよ。', UNIX_TIMESTAMP()),
+	(418, 2415, 'このコンビ最高！待ってました！', UNIX_TIMESTAMP()),
+	(808, 807, '1年間、楽しい時間をありがとう。', UNIX_TIMESTAMP()),
+	(830, 3826, 'あなたと朝を迎えるのが、日課になってる！', UNIX_TIMESTAMP()),
+	(126, 2123, 'あなたの私物や日常の詳細を知りたいな〜。どこで買い物してるのかな？教えて！', UNIX_TIMESTAMP()),
+	(203, 1201, 'その技、見事でした！', UNIX_TIMESTAMP()),
+	(250, 249, '実はこのステージ、あのアイテムを使うと簡単にクリアできるんだよ。研究不足だなあ。', UNIX_TIMESTAMP()),
+	(772, 4767, 'あなたのカメラワークで、ゲームの世界に入り込んでいるような感じがします。', UNIX_TIMESTAMP()),
+	(166, 3162, 'その技、見事でした！', UNIX_TIMESTAMP()),
+	(152, 5146, '同じゲームやってるあの配信者、今ボスの最後の一撃でやられちゃった！', UNIX_TIMESTAMP()),
+	(95, 5089, '今日の選曲が特に好き！', UNIX_TIMESTAMP()),
+	(898, 897, 'このトピックに深く触れてくれて感謝しています。', UNIX_TIMESTAMP()),
+	(445, 2442, 'こんな視点は初めて！新鮮でした。', UNIX_TIMESTAMP()),
+	(627, 5621, 'フルアルバムを待ってるよ！', UNIX_TIMESTAMP()),
+	(505, 6498, '次の章、どんな展開になるのかワクワクしてます。', UNIX_TIMESTAMP()),
+	(402, 3398, 'あなたの声、とてもリラックスできます。', UNIX_TIMESTAMP()),
+	(520, 3516, '眠れない夜のお供に最適！', UNIX_TIMESTAMP()),
+	(439, 6432, 'これで1日が始まる！', UNIX_TIMESTAMP()),
+	(354, 5348, 'このBGM、心地良くてリピートして聞いてます。', UNIX_TIMESTAMP()),
+	(476, 6469, 'コラボの相性がいいね！', UNIX_TIMESTAMP()),
+	(849, 5843, '最近あなたの動画にハマってます！', UNIX_TIMESTAMP()),
+	(74, 6067, '同じゲームやってるあの配信者、今ボスの最後の一撃でやられちゃった！', UNIX_TIMESTAMP()),
+	(196, 2193, 'あなたの動画のおかげで勉強になります。', UNIX_TIMESTAMP()),
+	(493, 2490, '次回の動画も楽しみにしています！', UNIX_TIMESTAMP()),
+	(759, 758, 'こんな素晴らしい情報をシェアしてくれてありがとう！', UNIX_TIMESTAMP()),
+	(929, 1927, '朝活配信のおかげで、朝が楽しみになった。', UNIX_TIMESTAMP()),
+	(638, 1636, '毎回の配信を楽しみにしてました！', UNIX_TIMESTAMP()),
+	(987, 1985, '最近あなたの動画にハマってます！', UNIX_TIMESTAMP()),
+	(369, 7361, 'このBGM、心地良くてリピートして聞いてます。', UNIX_TIMESTAMP()),
+	(958, 5952, '他の配信者のことは一切見ない。あなただけを見てます！', UNIX_TIMESTAMP()),
+	(834, 4829, 'ファン同士も交流が深まって良いね。', UNIX_TIMESTAMP()),
+	(619, 6612, 'この曲、あなたの声に合ってる！', UNIX_TIMESTAMP()),
+	(665, 4660, '誕生日配信、待ってました！', UNIX_TIMESTAMP()),
+	(23, 1021, '夜中の秘密の時間、楽しいね。', UNIX_TIMESTAMP()),
+	(5, 6997, 'あなたの誕生日、もちろん覚えてるよ！私からのサプライズを楽しみにしてね。', UNIX_TIMESTAMP()),
+	(409, 3405, '周年記念、特別感があっていいね。', UNIX_TIMESTAMP()),
+	(966, 2963, 'おめでとう！更なる飛躍を期待してる！', UNIX_TIMESTAMP()),
+	(537, 536, '今日のための特別な配信、ありがとう！', UNIX_TIMESTAMP()),
+	(761, 1759, 'ちなみに、ほかの配信で今めちゃくちゃ感動のシーンがあるよ。', UNIX_TIMESTAMP()),
+	(925, 1923, 'ちょっと配信者、このゲームの歴史知ってる？初代からのファンとしてちょっと違和感を感じるんだけど。', UNIX_TIMESTAMP()),
+	(643, 6636, 'この曲、あなたの声に合ってる！', UNIX_TIMESTAMP()),
+	(477, 4472, '予想以上に楽しい配信だった！', UNIX_TIMESTAMP()),
+	(378, 1376, '朝から元気になれる配信ありがとう！', UNIX_TIMESTAMP()),
+	(521, 2518, '逆境でもあなたのファンは離れません！応援しています！', UNIX_TIMESTAMP()),
+	(767, 5761, 'あなたの強さを信じています。', UNIX_TIMESTAMP()),
+	(811, 1809, 'あなたのランキング、どんどん上がってますね！', UNIX_TIMESTAMP()),
+	(946, 1944, '次の周年も一緒に祝いたい', UNIX_TIMESTAMP()),
+	(81, 6074, 'おはよう！今日も一日、がんばろう！', UNIX_TIMESTAMP()),
+	(457, 7449, 'このゲームのプロのプレイヤーとしては、ちょっとその操作には驚きだよ。', UNIX_TIMESTAMP()),
+	(48, 7040, 'ゲームのアートワーク、とても魅力的ですね。', UNIX_TIMESTAMP()),
+	(456, 455, 'アイテムの使い方、とても参考になります。', UNIX_TIMESTAMP()),
+	(537, 536, 'この歌声、癒される〜。', UNIX_TIMESTAMP()),
+	(817, 816, '同じゲームやってる他の配信者、今サブクエストで大変なことになってるよ。', UNIX_TIMESTAMP()),
+	(765, 5759, 'おめでとう！更なる飛躍を期待してる！', UNIX_TIMESTAMP()),
+	(959, 5953, 'このBGM、心地良くてリピートして聞いてます。', UNIX_TIMESTAMP()),
+	(419, 7411, '実はこのパズル、あの方法で簡単に解けるんだけど。ちょっと研究不足かな？', UNIX_TIMESTAMP()),
+	(72, 71, '深夜にも関わらず、ありがとう！', UNIX_TIMESTAMP()),
+	(982, 4977, 'あの戦略、ちょっと古いよ。最新のプレイ動画を参考にしてみたら？', UNIX_TIMESTAMP()),
+	(447, 1445, '実はこのパズル、あの方法で簡単に解けるんだけど。ちょっと研究不足かな？', UNIX_TIMESTAMP()),
+	(390, 3386, '素敵なプレゼント、もらった？', UNIX_TIMESTAMP()),
+	(215, 2212, 'スキルツリーの進め方、とても役立ちます！', UNIX_TIMESTAMP()),
+	(668, 667, '今日の選曲が特に好き！', UNIX_TIMESTAMP()),
+	(155, 4150, 'あなたの動画は私のリラックスタイムの一部です。', UNIX_TIMESTAMP()),
+	(694, 1692, '他のファンと違って、私は本当にあなたのことを理解してる。他のファンには分からない部分も。', UNIX_TIMESTAMP()),
+	(683, 6676, 'あの敵、どうやって避けてるんですか？', UNIX_TIMESTAMP()),
+	(839, 838, '他の人の配信、今感動のエンディングに到達してる！', UNIX_TIMESTAMP()),
+	(146, 2143, 'いつも楽しみにしています！最高のコンテンツをありがとう！', UNIX_TIMESTAMP()),
+	(225, 7217, 'おはよう！今日も一日、がんばろう！', UNIX_TIMESTAMP()),
+	(568, 5562, 'あのキャラ、どこで手に入れることができるんですか？', UNIX_TIMESTAMP()),
+	(546, 5540, 'ライブ行きたいな！', UNIX_TIMESTAMP()),
+	(367, 4362, 'おはよう！今日も一日、がんばろう！', UNIX_TIMESTAMP()),
+	(439, 7431, '今後の活動も楽しみにしています！', UNIX_TIMESTAMP()),
+	(847, 846, '長いことお疲れ様！これからも頑張って！', UNIX_TIMESTAMP()),
+	(112, 1110, 'この歌声、癒される〜。', UNIX_TIMESTAMP()),
+	(514, 2511, 'こんな楽しいコンテンツ、他では見れません！', UNIX_TIMESTAMP()),
+	(99, 1097, '周年記念、特別感があっていいね。', UNIX_TIMESTAMP()),
+	(56, 6049, '応援グッズ、購入しました！', UNIX_TIMESTAMP()),
+	(556, 6549, '実はこのエリア、あの場所に隠しアイテムがあるんだ。調べてからプレイして欲しいな。', UNIX_TIMESTAMP()),
+	(959, 2956, '良い情報をいつもありがとう。', UNIX_TIMESTAMP()),
+	(132, 7124, '動画を観るたびに感謝しています。', UNIX_TIMESTAMP()),
+	(671, 1669, 'あなたの私物や日常の詳細を知りたいな〜。どこで買い物してるのかな？教えて！', UNIX_TIMESTAMP()),
+	(179, 3175, 'これからも応援してるよ！', UNIX_TIMESTAMP()),
+	(197, 4192, '夜中の秘密の時間、楽しいね。', UNIX_TIMESTAMP()),
+	(26, 25, 'あなたのことを想いながら、毎日日記を書いてるよ。全部あなたのことばかりだよ！', UNIX_TIMESTAMP()),
+	(919, 5913, '一年に一度の特別な日、最高に楽しんでね！', UNIX_TIMESTAMP()),
+	(73, 2070, 'その歌声に鳥肌が立ったよ。', UNIX_TIMESTAMP()),
+	(577, 3573, 'これで1日が始まる！', UNIX_TIMESTAMP()),
+	(132, 131, 'ちょっと配信者、このゲームの歴史知ってる？初代からのファンとしてちょっと違和感を感じるんだけど。', UNIX_TIMESTAMP()),
+	(149, 6142, '夜中の静けさとあなたの声、最高の組み合わせ。', UNIX_TIMESTAMP()),
+	(172, 5166, 'あのコンボ、練習してもできません。', UNIX_TIMESTAMP()),
+	(547, 6540, '深夜にも関わらず、ありがとう！', UNIX_TIMESTAMP()),
+	(699, 5693, '2人の絡みが楽しすぎる！', UNIX_TIMESTAMP()),
+	(278, 7270, 'このゲームの本当の楽しみ方知ってる？ちょっと遊び方が初心者すぎる。', UNIX_TIMESTAMP()),
+	(159, 7151, '毎回の配信を楽しみにしてました！', UNIX_TIMESTAMP()),
+	(331, 7323, 'あなたと一緒に一日を始めるのが楽しい！', UNIX_TIMESTAMP()),
+	(7, 2004, '他の配信で今、すごいカットシーン見てるよ！', UNIX_TIMESTAMP()),
+	(394, 2391, 'あなたの情熱、伝わってきます！', UNIX_TIMESTAMP()),
+	(927, 2924, '同じゲームのあちらの配信、今すごいテクニックを見せてるよ。', UNIX_TIMESTAMP()),
+	(831, 4826, 'このBGM、心地良くてリピートして聞いてます。', UNIX_TIMESTAMP()),
+	(531, 3527, 'あなたの歌で元気をもらった。', UNIX_TIMESTAMP()),
+	(237, 1235, 'あなたの朝のルーティン、真似したいな。', UNIX_TIMESTAMP()),
+	(534, 6527, 'ちなみに、ほかの配信で今めちゃくちゃ感動のシーンがあるよ。', UNIX_TIMESTAMP()),
+	(427, 4422, '今日のライブストリームでのアドバイス、本当にありがとうございました！', UNIX_TIMESTAMP()),
+	(573, 3569, '次回のコラボも楽しみにしてます！', UNIX_TIMESTAMP()),
+	(295, 6288, 'このゲームのストーリー、感動しました。', UNIX_TIMESTAMP()),
+	(432, 431, 'あなたの強さを信じています。', UNIX_TIMESTAMP()),
+	(844, 4839, 'あなたのランキング、どんどん上がってますね！', UNIX_TIMESTAMP()),
+	(438, 7430, '長い一日の終わりに、あなたの動画で癒されています。', UNIX_TIMESTAMP()),
+	(606, 2603, '長いことお疲れ様！これからも頑張って！', UNIX_TIMESTAMP()),
+	(32, 7024, 'このゲームのストーリー、感動しました。', UNIX_TIMESTAMP()),
+	(41, 2038, '朝からの配信、日課になってるよ！', UNIX_TIMESTAMP()),
+	(325, 6318, 'あなたのこと、考えるだけで1日が終わっちゃう。本当に大好き。', UNIX_TIMESTAMP()),
+	(963, 962, '誕生日配信、待ってました！', UNIX_TIMESTAMP()),
+	(487, 486, '歌の感じが良くて、何回も聞き返したい。', UNIX_TIMESTAMP()),
+	(990, 1988, 'あなたの誕生日、もちろん覚えてるよ！私からのサプライズを楽しみにしてね。', UNIX_TIMESTAMP()),
+	(341, 5335, 'こんな考え方、初めて知りました。', UNIX_TIMESTAMP()),
+	(412, 7404, 'あなたと一緒に一日を始めるのが楽しい！', UNIX_TIMESTAMP()),
+	(779, 778, 'あなたのプレイを見てると、アクションゲームが上手くなりたくなります！', UNIX_TIMESTAMP()),
+	(860, 859, '頑張ってください！いつも応援しています！', UNIX_TIMESTAMP()),
+	(334, 4329, '毎回の配信が楽しすぎて、1年早かった！', UNIX_TIMESTAMP()),
+	(848, 4843, 'あなたの情熱、伝わってきます！', UNIX_TIMESTAMP()),
+	(396, 1394, '実はこのエリア、あの場所に隠しアイテムがあるんだ。調べてからプレイして欲しいな。', UNIX_TIMESTAMP()),
+	(135, 134, '次の周年も一緒に祝いたい', UNIX_TIMESTAMP()),
+	(905, 1903, 'ちなみにあの人、今マジでボス戦で苦しんでるよ！笑', UNIX_TIMESTAMP()),
+	(829, 5823, 'このゲームのグラフィック、綺麗ですね。', UNIX_TIMESTAMP()),
+	(830, 3826, '他のファンがあなたに送ったもの、全部知ってるよ。私の方が特別なものを送るからね。', UNIX_TIMESTAMP()),
+	(885, 5879, 'このキャラクターの背景、深くて良いですね。', UNIX_TIMESTAMP()),
+	(890, 6883, '今日も一日の元気をもらいました。', UNIX_TIMESTAMP()),
+	(111, 7103, 'この曲、あなたにしか歌えない。', UNIX_TIMESTAMP()),
+	(533, 4528, 'あの戦略、ちょっと古いよ。最新のプレイ動画を参考にしてみたら？', UNIX_TIMESTAMP()),
+	(251, 3247, '周年記念、特別感があっていいね。', UNIX_TIMESTAMP()),
+	(236, 7228, '次に何の話をするか、ヒントくれる？当てたら、ご褒美欲しいな。', UNIX_TIMESTAMP()),
+	(591, 4586, 'あなたと朝を迎えるのが、日課になってる！', UNIX_TIMESTAMP()),
+	(640, 2637, '次回のコラボも楽しみにしてます！', UNIX_TIMESTAMP()),
+	(423, 422, '夜中の静けさとあなたの声、最高の組み合わせ。', UNIX_TIMESTAMP()),
+	(323, 2320, '相手の読み合い、すごく緊張感がありました！', UNIX_TIMESTAMP()),
+	(406, 2403, 'いつも感謝しています。', UNIX_TIMESTAMP()),
+	(951, 1949, '夜中のひととき、楽しい時間をありがとう。', UNIX_TIMESTAMP()),
+	(616, 2613, '明日仕事だけど、眠れなくて見てるよ！', UNIX_TIMESTAMP()),
+	(998, 4993, '笑ってしまいました、ありがとう！', UNIX_TIMESTAMP()),
+	(859, 2856, 'ゲームのアートワーク、とても魅力的ですね。', UNIX_TIMESTAMP()),
+	(614, 613, 'あなたの誕生日は、私たちにとっても特別な日。', UNIX_TIMESTAMP()),
+	(513, 6506, '素敵なプレゼント、もらった？', UNIX_TIMESTAMP()),
+	(21, 4016, 'あなたの朝活で、私も活力をもらってるよ！', UNIX_TIMESTAMP()),
+	(667, 3663, '今後の活動も楽しみにしています！', UNIX_TIMESTAMP()),
+	(457, 1455, '今後の活動も楽しみにしています！', UNIX_TIMESTAMP()),
+	(39, 38, '朝の情報、今日の活力にするよ！', UNIX_TIMESTAMP()),
+	(805, 6798, 'このトピックについて話してくれてありがとう！', UNIX_TIMESTAMP()),
+	(3, 2, '明日、眠そうだけど楽しかった！', UNIX_TIMESTAMP()),
+	(3, 4997, '今日も素晴らしい内容でした！', UNIX_TIMESTAMP()),
+	(584, 583, 'ねぇ、他の配信見ながらだけど、あちらの人が今絶体絶命のピンチ！', UNIX_TIMESTAMP()),
+	(86, 4081, 'あなたが最近気に入ってるものや趣味、全部知りたい！', UNIX_TIMESTAMP()),
+	(216, 7208, '他の人があなたのことをどう思おうと、私はずっとあなたの味方。一緒にいるような気がする。', UNIX_TIMESTAMP()),
+	(94, 5088, 'ほかの配信、今ちょうど似たようなとこで死んでる。予防して！', UNIX_TIMESTAMP()),
+	(101, 3097, 'ゲームのアートワーク、とても魅力的ですね。', UNIX_TIMESTAMP()),
+	(513, 5507, 'おやすみ前の楽しい時間、ありがとう！', UNIX_TIMESTAMP()),
+	(775, 5769, '朝の情報、今日の活力にするよ！', UNIX_TIMESTAMP()),
+	(393, 7385, 'これからも応援してるよ！', UNIX_TIMESTAMP()),
+	(465, 5459, '今日もあなたのために休みを取って見てます！次の配信も絶対に欠かさず見るからね！', UNIX_TIMESTAMP()),
+	(925, 2922, '今日のあなたの服装、もしかして前にも着てた？気をつけて見てるよ。', UNIX_TIMESTAMP()),
+	(49, 7041, '今日もあなたのために休みを取って見てます！次の配信も絶対に欠かさず見るからね！', UNIX_TIMESTAMP()),
+	(20, 5014, 'すごい連携プレイ！', UNIX_TIMESTAMP()),
+	(462, 3458, 'あなたの努力と熱意、感じ取れます。', UNIX_TIMESTAMP()),
+	(961, 4956, 'もっとこのゲームの奥深さを知ってから配信した方がいいかも。ちょっと見てて痛々しい。', UNIX_TIMESTAMP()),
+	(326, 6319, '長いことお疲れ様！これからも頑張って！', UNIX_TIMESTAMP()),
+	(268, 7260, 'この曲、あなたにしか歌えない。', UNIX_TIMESTAMP()),
+	(857, 856, 'いつも楽しみにしています！最高のコンテンツをありがとう！', UNIX_TIMESTAMP()),
+	(227, 3223, 'その選択、私も同じことをしたかった！', UNIX_TIMESTAMP()),
+	(657, 6650, 'あなたのこと、考えるだけで1日が終わっちゃう。本当に大好き。', UNIX_TIMESTAMP()),
+	(780, 6773, 'あなたが好きなものや場所、全部詳しく知りたいな。一緒に楽しんでみたいから。', UNIX_TIMESTAMP()),
+	(132, 6125, '一年に一度の特別な日、最高に楽しんでね！', UNIX_TIMESTAMP()),
+	(597, 4592, '夜中のひととき、楽しい時間をありがとう。', UNIX_TIMESTAMP()),
+	(681, 1679, 'ファン同士も交流が深まって良いね。', UNIX_TIMESTAMP()),
+	(919, 918, 'こんな時間まで、ありがとう。寝る前の癒し。', UNIX_TIMESTAMP()),
+	(417, 416, 'このシーンの意味、ちゃんと理解してる？深読みすればもっと感動するシーンなんだけど。', UNIX_TIMESTAMP()),
+	(668, 3664, '誕生日ケーキ、美味しそう！', UNIX_TIMESTAMP()),
+	(496, 495, '今日のあなたの服装、もしかして前にも着てた？気をつけて見てるよ。', UNIX_TIMESTAMP()),
+	(709, 4704, 'このトピック待ってました！', UNIX_TIMESTAMP()),
+	(515, 1513, 'そのアイテム、ちょっと使い方が違うよ。本当の使い方を知ってる？', UNIX_TIMESTAMP()),
+	(889, 2886, 'あなたの幸せを祈ってます。', UNIX_TIMESTAMP()),
+	(311, 2308, 'そのアイテム、ちょっと使い方が違うよ。本当の使い方を知ってる？', UNIX_TIMESTAMP()),
+	(997, 6990, '夜更かしの理由がまた一つ増えた！', UNIX_TIMESTAMP()),
+	(996, 5990, '今年の誕生日も一緒に過ごせて嬉しい！', UNIX_TIMESTAMP()),
+	(61, 2058, '同じゲームのあちらの配信、今すごいテクニックを見せてるよ。', UNIX_TIMESTAMP()),
+	(577, 2574, 'あなたのランキング、どんどん上がってますね！', UNIX_TIMESTAMP()),
+	(62, 1060, 'このゲーム、実はあのキャラを使うと楽勝なんだよね。ちょっと選択ミスって感じ。', UNIX_TIMESTAMP()),
+	(244, 243, '周年記念、特別感があっていいね。', UNIX_TIMESTAMP()),
+	(113, 112, '実はこのエリア、あの場所に隠しアイテムがあるんだ。調べてからプレイして欲しいな。', UNIX_TIMESTAMP()),
+	(683, 3679, 'BGMやサウンド、良い雰囲気を出してます。', UNIX_TIMESTAMP()),
+	(285, 7277, 'これからも素敵な時間を共有してほしい。', UNIX_TIMESTAMP()),
+	(670, 4665, 'すごい内容でした！学ぶことがたくさんありました！', UNIX_TIMESTAMP()),
+	(896, 5890, '朝の情報、役立つね！', UNIX_TIMESTAMP()),
+	(308, 7300, 'その選択、私も同じことをしたかった！', UNIX_TIMESTAMP()),
+	(979, 4974, '今日も一日、頑張れそう！', UNIX_TIMESTAMP()),
+	(110, 5104, 'あなたの情熱、伝わってきます！', UNIX_TIMESTAMP()),
+	(386, 5380, 'あなたの努力と熱意、感じ取れます。', UNIX_TIMESTAMP()),
+	(361, 360, 'ゲームのアートワーク、とても魅力的ですね。', UNIX_TIMESTAMP()),
+	(49, 7041, '夜中のひととき、楽しい時間をありがとう。', UNIX_TIMESTAMP()),
+	(361, 2358, 'ねぇ、他の配信見ながらだけど、あちらの人が今絶体絶命のピンチ！', UNIX_TIMESTAMP()),
+	(922, 921, '落ち込むこともあると思いますが、あなたなら乗り越えられる！', UNIX_TIMESTAMP()),
+	(1, 2997, 'BGMやサウンド、良い雰囲気を出してます。', UNIX_TIMESTAMP()),
+	(892, 1890, '良い内容をこれからも期待してます！', UNIX_TIMESTAMP()),
+	(742, 5736, '朝の情報、役立つね！', UNIX_TIMESTAMP()),
+	(538, 1536, 'これで1日が始まる！', UNIX_TIMESTAMP()),
+	(508, 1506, '朝活配信のおかげで、朝が楽しみになった。', UNIX_TIMESTAMP()),
+	(455, 5449, 'あなたから学んだこと、日常に取り入れています。', UNIX_TIMESTAMP()),
+	(405, 404, 'ゲームのアートワーク、とても魅力的ですね。', UNIX_TIMESTAMP()),
+	(317, 4312, 'あなたの前の配信の時に言ってたこと、全部覚えてるよ。ちゃんと記録してるから。', UNIX_TIMESTAMP()),
+	(551, 6544, '朝からの配信、日課になってるよ！', UNIX_TIMESTAMP()),
+	(205, 2202, 'おめでとう！更なる飛躍を期待してる！', UNIX_TIMESTAMP()),
+	(300, 4295, 'いつも感謝しています。', UNIX_TIMESTAMP()),
+	(174, 3170, 'その技、見事でした！', UNIX_TIMESTAMP()),
+	(502, 7494, '誕生日ケーキ、美味しそう！', UNIX_TIMESTAMP()),
+	(577, 4572, 'あなたの声や意見が大好きです。', UNIX_TIMESTAMP()),
+	(24, 7016, '今後の活動も楽しみにしています！', UNIX_TIMESTAMP()),
+	(985, 4980, 'あのキャラ、どこで手に入れることができるんですか？', UNIX_TIMESTAMP()),
+	(245, 7237, '今日の選曲が特に好き！', UNIX_TIMESTAMP()),
+	(374, 373, 'あなたの声や意見が大好きです。', UNIX_TIMESTAMP()),
+	(410, 4405, 'これからも素敵な時間を共有してほしい。', UNIX_TIMESTAMP()),
+	(860, 6853, '今後の活動も楽しみにしています！', UNIX_TIMESTAMP()),
+	(289, 1287, '音楽のセンスが素晴らしい！', UNIX_TIMESTAMP()),
+	(280, 6273, 'こんなに時間が経ったのか！早いな〜。', UNIX_TIMESTAMP()),
+	(976, 2973, 'あなたの成長が楽しみです！', UNIX_TIMESTAMP()),
+	(976, 6969, 'いつもの質の高い動画、感謝してます！', UNIX_TIMESTAMP()),
+	(856, 855, 'あなたのことを想って作った詩があるの。いつか読んでほしいな。', UNIX_TIMESTAMP()),
+	(221, 4216, 'ほかの配信、今ちょうど似たようなとこで死んでる。予防して！', UNIX_TIMESTAMP()),
+	(811, 6804, 'ほかの配信者が今、あの難関エリアに突入してるよ！応援してる。', UNIX_TIMESTAMP()),
+	(681, 5675, 'こんな考え方、初めて知りました。', UNIX_TIMESTAMP()),
+	(387, 7379, 'このゲーム、実はあのルートを取るともっと早くクリアできるんだよね。', UNIX_TIMESTAMP()),
+	(224, 1222, '良い情報をいつもありがとう。', UNIX_TIMESTAMP()),
+	(416, 6409, '応援グッズ、購入しました！', UNIX_TIMESTAMP()),
+	(62, 4057, 'このトピックに深く触れてくれて感謝しています。', UNIX_TIMESTAMP()),
+	(532, 5526, '相手の読み合い、すごく緊張感がありました！', UNIX_TIMESTAMP()),
+	(238, 237, 'このステージのデザイン、個人的に好きです。', UNIX_TIMESTAMP()),
+	(474, 3470, 'あなたのことを想って作った詩があるの。いつか読んでほしいな。', UNIX_TIMESTAMP()),
+	(429, 7421, 'あなたのプレイスタイル、学ぶことが多いです。', UNIX_TIMESTAMP()),
+	(480, 6473, '他の配信でも似たような場面が今起こってるよ！どっちが先にクリアするかな？', UNIX_TIMESTAMP()),
+	(777, 776, '笑ってしまいました、ありがとう！', UNIX_TIMESTAMP()),
+	(866, 2863, 'あの敵、どうやって避けてるんですか？', UNIX_TIMESTAMP()),
+	(612, 3608, 'このBGM、心地良くてリピートして聞いてます。', UNIX_TIMESTAMP()),
+	(573, 6566, '応援グッズ、購入しました！', UNIX_TIMESTAMP()),
+	(903, 902, 'このトピック待ってました！', UNIX_TIMESTAMP()),
+	(467, 466, '最近あなたがちょっと変わったかな？何かあったら話してね。私だけに。', UNIX_TIMESTAMP()),
+	(706, 4701, 'この曲、あなたにしか歌えない。', UNIX_TIMESTAMP()),
+	(654, 3650, 'おはよう！今日も一日、がんばろう！', UNIX_TIMESTAMP()),
+	(465, 6458, 'ほかの配信、今ちょうど似たようなとこで死んでる。予防して！', UNIX_TIMESTAMP()),
+	(69, 3065, 'コメントも盛り上がってるね。', UNIX_TIMESTAMP()),
+	(16, 5010, 'ちなみに、ほかの配信で今めちゃくちゃ感動のシーンがあるよ。', UNIX_TIMESTAMP()),
+	(789, 2786, '良い影響を受けています、感謝してます。', UNIX_TIMESTAMP()),
+	(976, 1974, 'あなたから学んだこと、日常に取り入れています。', UNIX_TIMESTAMP()),
+	(932, 3928, '同じゲームのあちらの配信、今すごいテクニックを見せてるよ。', UNIX_TIMESTAMP()),
+	(248, 1246, 'こんな時間まで頑張って、すごい！', UNIX_TIMESTAMP()),
+	(786, 3782, '素敵なプレゼント、もらった？', UNIX_TIMESTAMP()),
+	(41, 2038, 'おめでとう！ここまで来るのに大変だったと思う。', UNIX_TIMESTAMP()),
+	(319, 6312, '夜中のこの時間、あなたと過ごせて幸せ。', UNIX_TIMESTAMP()),
+	(795, 5789, '毎回の配信が楽しすぎて、1年早かった！', UNIX_TIMESTAMP()),
+	(339, 2336, '他の配信者も同じミッションに挑戦中！この瞬間をみんなで共有できるなんて面白い。', UNIX_TIMESTAMP()),
+	(519, 4514, 'このBGM、心地良くてリピートして聞いてます。', UNIX_TIMESTAMP()),
+	(458, 457, '次のトーナメント、応援しています！', UNIX_TIMESTAMP()),
+	(118, 1116, 'おめでとう！素敵な1年になりますように。', UNIX_TIMESTAMP()),
+	(863, 5857, '2人の絡みが楽しすぎる！', UNIX_TIMESTAMP()),
+	(556, 6549, 'このゲーム、実はあのキャラを使うと楽勝なんだよね。ちょっと選択ミスって感じ。', UNIX_TIMESTAMP()),
+	(262, 5256, 'あっちの配信、今超盛り上がってるから見に行った方がいいかも！', UNIX_TIMESTAMP()),
+	(378, 377, 'ファン同士も交流が深まって良いね。', UNIX_TIMESTAMP()),
+	(547, 2544, '夜中のひととき、楽しい時間をありがとう。', UNIX_TIMESTAMP()),
+	(76, 1074, 'この組み合わせ、また是非見たい！', UNIX_TIMESTAMP()),
+	(138, 6131, 'あなたと共に成長してきた気がする。', UNIX_TIMESTAMP()),
+	(303, 7295, 'こんな素晴らしい情報をシェアしてくれてありがとう！', UNIX_TIMESTAMP()),
+	(700, 1698, 'そのボス、簡単に倒すなんて驚きました！', UNIX_TIMESTAMP()),
+	(468, 6461, '他のファンがあなたに送ったもの、全部知ってるよ。私の方が特別なものを送るからね。', UNIX_TIMESTAMP()),
+	(544, 5538, 'こんなに上手だったなんて！感動！', UNIX_TIMESTAMP()),
+	(276, 6269, 'これからも応援してるよ！', UNIX_TIMESTAMP()),
+	(180, 6173, '他のファンと違って、私は本当にあなたのことを理解してる。他のファンには分からない部分も。', UNIX_TIMESTAMP()),
+	(486, 1484, '夜中の静けさとあなたの声、最高の組み合わせ。', UNIX_TIMESTAMP()),
+	(714, 3710, 'どんな時もファンとしてサポートします！', UNIX_TIMESTAMP()),
+	(4, 3999, 'あなたのランキング、どんどん上がってますね！', UNIX_TIMESTAMP()),
+	(103, 1101, 'いつも感謝しています。', UNIX_TIMESTAMP()),
+	(894, 5888, 'このコンビ最高！待ってました！', UNIX_TIMESTAMP()),
+	(144, 2141, '今日も一日、頑張れそう！', UNIX_TIMESTAMP()),
+	(5, 6997, 'あなたの動画に救われました。感謝しています。', UNIX_TIMESTAMP()),
+	(468, 2465, '朝からの配信、日課になってるよ！', UNIX_TIMESTAMP()),
+	(362, 7354, '他の配信者も同じミッションに挑戦中！この瞬間をみんなで共有できるなんて面白い。', UNIX_TIMESTAMP()),
+	(27, 5021, 'その選択、私も同じことをしたかった！', UNIX_TIMESTAMP()),
+	(499, 6492, '同じゲームやってるあの配信者、今ボスの最後の一撃でやられちゃった！', UNIX_TIMESTAMP()),
+	(559, 558, '実はこのエリア、あの場所に隠しアイテムがあるんだ。調べてからプレイして欲しいな。', UNIX_TIMESTAMP()),
+	(21, 3017, '朝活配信のおかげで、朝が楽しみになった。', UNIX_TIMESTAMP()),
+	(441, 6434, '今日のエピソード、とても面白かった！', UNIX_TIMESTAMP()),
+	(251, 3247, 'その歌声に鳥肌が立ったよ。', UNIX_TIMESTAMP()),
+	(310, 309, '今日のライブストリームでのアドバイス、本当にありがとうございました！', UNIX_TIMESTAMP()),
+	(582, 3578, 'あなたの声、とてもリラックスできます。', UNIX_TIMESTAMP()),
+	(840, 5834, '最近あなたがちょっと変わったかな？何かあったら話してね。私だけに。', UNIX_TIMESTAMP()),
+	(117, 6110, '次の周年も一緒に祝いたい', UNIX_TIMESTAMP()),
+	(22, 5016, 'ライブ行きたいな！', UNIX_TIMESTAMP()),
+	(225, 4220, 'あなたが好きなものや場所、全部詳しく知りたいな。一緒に楽しんでみたいから。', UNIX_TIMESTAMP()),
+	(268, 6261, 'あなたのこと、考えるだけで1日が終わっちゃう。本当に大好き。', UNIX_TIMESTAMP()),
+	(592, 2589, '両方のチャンネルをフォローしてるから嬉しい！', UNIX_TIMESTAMP()),
+	(889, 4884, '笑ってしまいました、ありがとう！', UNIX_TIMESTAMP()),
+	(923, 5917, 'CD出さないの？', UNIX_TIMESTAMP()),
+	(287, 6280, 'このゲームのグラフィック、綺麗ですね。', UNIX_TIMESTAMP()),
+	(338, 4333, 'この曲、あなたにしか歌えない。', UNIX_TIMESTAMP()),
+	(698, 5692, 'あなたのプレイを見てると、アクションゲームが上手くなりたくなります！', UNIX_TIMESTAMP()),
+	(241, 1239, 'こんな考え方、初めて知りました。', UNIX_TIMESTAMP()),
+	(759, 2756, '夜更かしの理由がまた一つ増えた！', UNIX_TIMESTAMP()),
+	(391, 6384, 'おはよう！今日も一日、がんばろう！', UNIX_TIMESTAMP()),
+	(447, 3443, '他の配信者も同じミッションに挑戦中！この瞬間をみんなで共有できるなんて面白い。', UNIX_TIMESTAMP()),
+	(229, 5223, '他の配信でも似たような場面が今起こってるよ！どっちが先にクリアするかな？', UNIX_TIMESTAMP()),
+	(131, 5125, 'あなたが最近気に入ってるものや趣味、全部知りたい！', UNIX_TIMESTAMP()),
+	(165, 164, 'リクエストの曲、ありがとう！', UNIX_TIMESTAMP()),
+	(90, 5084, 'このゲームのストーリー、感動しました。', UNIX_TIMESTAMP()),
+	(310, 4305, 'ライブ行きたいな！', UNIX_TIMESTAMP()),
+	(246, 7238, 'あなたの幸せを祈ってます。', UNIX_TIMESTAMP()),
+	(123, 122, '次回の動画も楽しみにしています！', UNIX_TIMESTAMP()),
+	(339, 338, '今日のライブストリームでのアドバイス、本当にありがとうございました！', UNIX_TIMESTAMP()),
+	(944, 3940, '今日も素晴らしい内容でした！', UNIX_TIMESTAMP()),
+	(824, 6817, '装備の組み合わせ、参考にさせてもらいます。', UNIX_TIMESTAMP()),
+	(761, 6754, '今回がうまくいかなかったとしても、次回を楽しみにしています。', UNIX_TIMESTAMP()),
+	(298, 5292, '次回の動画も楽しみにしています！', UNIX_TIMESTAMP()),
+	(466, 465, '頑張ってください！いつも応援しています！', UNIX_TIMESTAMP()),
+	(825, 1823, '2人の絡みが楽しすぎる！', UNIX_TIMESTAMP()),
+	(885, 2882, 'あなたの努力と熱意、感じ取れます。', UNIX_TIMESTAMP()),
+	(40, 7032, '明日、眠そうだけど楽しかった！', UNIX_TIMESTAMP()),
+	(1, 3996, 'あなたのおかげで、眠れそうにないよ！', UNIX_TIMESTAMP()),
+	(87, 2084, '眠いけど、あなたの配信は見逃せない！', UNIX_TIMESTAMP()),
+	(511, 2508, 'エンディングへの道のり、楽しみにしてます。', UNIX_TIMESTAMP()),
+	(574, 573, '夜中のひととき、楽しい時間をありがとう。', UNIX_TIMESTAMP()),
+	(539, 6532, '他の人、今めちゃくちゃ面白いリアクションしてるよ。笑', UNIX_TIMESTAMP()),
+	(86, 4081, '朝の情報、役立つね！', UNIX_TIMESTAMP()),
+	(810, 1808, 'あなたのことを想って作った詩があるの。いつか読んでほしいな。', UNIX_TIMESTAMP()),
+	(667, 4662, '朝からのエネルギー、ありがとう！', UNIX_TIMESTAMP()),
+	(301, 3297, 'チュートリアル動画待ってます！', UNIX_TIMESTAMP()),
+	(505, 1503, 'あっちの人、今めちゃくちゃ笑ってる場面で止まってるよ。何があったんだろ？', UNIX_TIMESTAMP()),
+	(140, 1138, 'あっちの配信者、今同じ場所でアイテム探してるけど、全然見つけられないみたい。笑', UNIX_TIMESTAMP()),
+	(288, 2285, '夜中のひととき、楽しい時間をありがとう。', UNIX_TIMESTAMP()),
+	(552, 551, '他のファンがあなたに送ったもの、全部知ってるよ。私の方が特別なものを送るからね。', UNIX_TIMESTAMP()),
+	(495, 3491, '次回の動画も楽しみにしています！', UNIX_TIMESTAMP()),
+	(766, 3762, '夜のお供として、あなたの配信は欠かせない！', UNIX_TIMESTAMP()),
+	(388, 387, 'こんな時間まで、ありがとう。寝る前の癒し。', UNIX_TIMESTAMP()),
+	(374, 6367, '次の周年も一緒に祝いたい', UNIX_TIMESTAMP()),
+	(858, 5852, 'あなたに出会えて良かったです。', UNIX_TIMESTAMP()),
+	(317, 4312, 'あなたと朝を迎えるのが、日課になってる！', UNIX_TIMESTAMP()),
+	(709, 1707, 'このトピックに深く触れてくれて感謝しています。', UNIX_TIMESTAMP()),
+	(480, 2477, 'どちらのファンも満足の内容だった！', UNIX_TIMESTAMP()),
+	(943, 1941, 'あなたの視点、とても参考になります。', UNIX_TIMESTAMP()),
+	(360, 6353, 'あなたの幸せを祈ってます。', UNIX_TIMESTAMP()),
+	(107, 5101, 'このゲームの本当の楽しみ方知ってる？ちょっと遊び方が初心者すぎる。', UNIX_TIMESTAMP()),
+	(344, 4339, 'こんな時間まで頑張って、すごい！', UNIX_TIMESTAMP()),
+	(633, 5627, 'このゲームのプロのプレイヤーとしては、ちょっとその操作には驚きだよ。', UNIX_TIMESTAMP()),
+	(378, 1376, '今日も一日の元気をもらいました。', UNIX_TIMESTAMP()),
+	(144, 5138, 'あなたから学んだこと、日常に取り入れています。', UNIX_TIMESTAMP()),
+	(552, 3548, 'あなたの情熱、伝わってきます！', UNIX_TIMESTAMP()),
+	(657, 6650, 'あのキャラ、どこで手に入れることができるんですか？', UNIX_TIMESTAMP()),
+	(528, 5522, '朝から元気になれる配信ありがとう！', UNIX_TIMESTAMP()),
+	(33, 3029, '他の配信でも似たような場面が今起こってるよ！どっちが先にクリアするかな？', UNIX_TIMESTAMP()),
+	(930, 6923, 'あなたのプレイを見てると、アクションゲームが上手くなりたくなります！', UNIX_TIMESTAMP()),
+	(506, 1504, '頑張ってください！いつも応援しています！', UNIX_TIMESTAMP()),
+	(954, 5948, 'これからも素晴らしい動画をお待ちしてます！', UNIX_TIMESTAMP()),
+	(211, 1209, 'ほかの配信者が今、あの難関エリアに突入してるよ！応援してる。', UNIX_TIMESTAMP()),
+	(652, 1650, '夜中のひととき、楽しい時間をありがとう。', UNIX_TIMESTAMP()),
+	(674, 673, '2人の掛け合いが面白い！', UNIX_TIMESTAMP()),
+	(536, 5530, '他の配信者も同じミッションに挑戦中！この瞬間をみんなで共有できるなんて面白い。', UNIX_TIMESTAMP()),
+	(669, 4664, 'その戦術、ちょっと古いかな。もっと新しい情報を入手して欲しいな。', UNIX_TIMESTAMP()),
+	(962, 5956, '応援グッズ、購入しました！', UNIX_TIMESTAMP()),
+	(423, 7415, 'あなたの歌で元気をもらった。', UNIX_TIMESTAMP()),
+	(131, 6124, '誕生日ケーキ、美味しそう！', UNIX_TIMESTAMP()),
+	(494, 1492, '今日一日、自分を大切にしてね。', UNIX_TIMESTAMP()),
+	(867, 866, 'こんなに時間が経ったのか！早いな〜。', UNIX_TIMESTAMP()),
+	(397, 2394, '毎回の配信が楽しすぎて、1年早かった！', UNIX_TIMESTAMP()),
+	(460, 3456, 'これからも素晴らしい動画をお待ちしてます！', UNIX_TIMESTAMP()),
+	(168, 5162, '両方のチャンネルをフォローしてるから嬉しい！', UNIX_TIMESTAMP()),
+	(851, 6844, '今日のライブストリームでのアドバイス、本当にありがとうございました！', UNIX_TIMESTAMP()),
+	(724, 5718, 'その技、見事でした！', UNIX_TIMESTAMP()),
+	(403, 4398, '明日仕事だけど、眠れなくて見てるよ！', UNIX_TIMESTAMP()),
+	(306, 1304, '長い一日の終わりに、あなたの動画で癒されています。', UNIX_TIMESTAMP()),
+	(620, 6613, '両方のチャンネルをフォローしてるから嬉しい！', UNIX_TIMESTAMP()),
+	(685, 4680, '実はこのエリア、あの場所に隠しアイテムがあるんだ。調べてからプレイして欲しいな。', UNIX_TIMESTAMP()),
+	(517, 5511, 'あなたの成長が楽しみです！', UNIX_TIMESTAMP()),
+	(833, 6826, 'あなたが好きなものや場所、全部詳しく知りたいな。一緒に楽しんでみたいから。', UNIX_TIMESTAMP()),
+	(400, 5394, 'あなたの歌で元気をもらった。', UNIX_TIMESTAMP()),
+	(681, 5675, '次のトーナメント、応援しています！', UNIX_TIMESTAMP()),
+	(18, 5012, '同じゲームの別の配信、今すごいドラマが繰り広げられてるよ。', UNIX_TIMESTAMP()),
+	(572, 2569, 'こんな考え方、初めて知りました。', UNIX_TIMESTAMP()),
+	(483, 1481, 'コラボで新しい一面を見れて嬉しい！', UNIX_TIMESTAMP()),
+	(842, 1840, 'あの敵、どうやって避けてるんですか？', UNIX_TIMESTAMP()),
+	(50, 4045, 'ゲームのアートワーク、とても魅力的ですね。', UNIX_TIMESTAMP()),
+	(918, 917, 'あなたの視点、とても参考になります。', UNIX_TIMESTAMP()),
+	(975, 1973, '同じゲームの別の配信、今すごいドラマが繰り広げられてるよ。', UNIX_TIMESTAMP()),
+	(982, 2979, 'フルアルバムを待ってるよ！', UNIX_TIMESTAMP()),
+	(273, 7265, 'これで1日が始まる！', UNIX_TIMESTAMP()),
+	(680, 6673, 'あなたの歌で元気をもらった。', UNIX_TIMESTAMP()),
+	(616, 3612, 'あなたの強さを信じています。', UNIX_TIMESTAMP()),
+	(31, 3027, 'このゲームの本当の楽しみ方知ってる？ちょっと遊び方が初心者すぎる。', UNIX_TIMESTAMP()),
+	(958, 1956, 'あっちの人、今めちゃくちゃ笑ってる場面で止まってるよ。何があったんだろ？', UNIX_TIMESTAMP()),
+	(384, 6377, 'フルアルバムを待ってるよ！', UNIX_TIMESTAMP()),
+	(874, 3870, 'こんなに早起きして、すごい！', UNIX_TIMESTAMP()),
+	(537, 6530, 'スキルツリーの進め方、とても役立ちます！', UNIX_TIMESTAMP()),
+	(605, 604, 'あなたの歌で元気をもらった。', UNIX_TIMESTAMP()),
+	(439, 6432, 'ライブ感があって素敵！', UNIX_TIMESTAMP()),
+	(751, 2748, 'このゲームのプロのプレイヤーとしては、ちょっとその操作には驚きだよ。', UNIX_TIMESTAMP()),
+	(770, 1768, 'あなたが使ってる香水やシャンプー、知りたいな〜。教えてほしいな。', UNIX_TIMESTAMP()),
+	(430, 5424, 'こんなに早起きして、すごい！', UNIX_TIMESTAMP()),
+	(448, 1446, '応援しています！これからも素晴らしいコンテンツを楽しみにしています！', UNIX_TIMESTAMP()),
+	(888, 887, '笑ってしまいました、ありがとう！', UNIX_TIMESTAMP()),
+	(962, 6955, '次のトーナメント、応援しています！', UNIX_TIMESTAMP()),
+	(92, 4087, 'またこの2人のコラボを見たい！', UNIX_TIMESTAMP()),
+	(137, 3133, 'ファン同士も交流が深まって良いね。', UNIX_TIMESTAMP()),
+	(161, 160, 'あなたのことを友人にもオススメしました！', UNIX_TIMESTAMP()),
+	(847, 4842, 'あなたのおかげで、眠れそうにないよ！', UNIX_TIMESTAMP()),
+	(876, 2873, 'BGMやサウンド、良い雰囲気を出してます。', UNIX_TIMESTAMP()),
+	(654, 4649, '想像以上の化学反応が楽しい！', UNIX_TIMESTAMP()),
+	(799, 5793, 'このゲーム、実はあのルートを取るともっと早くクリアできるんだよね。', UNIX_TIMESTAMP()),
+	(749, 2746, 'あなたのことを想いながら、毎日日記を書いてるよ。全部あなたのことばかりだよ！', UNIX_TIMESTAMP()),
+	(173, 5167, '継続は力なり、本当に感動しています。', UNIX_TIMESTAMP()),
+	(472, 471, 'CD出さないの？', UNIX_TIMESTAMP()),
+	(479, 6472, '落ち込むこともあると思いますが、あなたなら乗り越えられる！', UNIX_TIMESTAMP()),
+	(152, 3148, 'こんな時間まで頑張って、すごい！', UNIX_TIMESTAMP()),
+	(19, 3015, 'このクエスト、情報ありがとうございます！', UNIX_TIMESTAMP()),
+	(129, 5123, 'あなたの声、とてもリラックスできます。', UNIX_TIMESTAMP()),
+	(502, 5496, 'あなたから学んだこと、日常に取り入れています。', UNIX_TIMESTAMP()),
+	(406, 7398, '他の配信者も同じミッションに挑戦中！この瞬間をみんなで共有できるなんて面白い。', UNIX_TIMESTAMP()),
+	(680, 4675, 'あの敵、どうやって避けてるんですか？', UNIX_TIMESTAMP()),
+	(181, 6174, 'あなたの成長が楽しみです！', UNIX_TIMESTAMP()),
+	(520, 5514, 'おめでとう！素敵な1年になりますように。', UNIX_TIMESTAMP()),
+	(317, 4312, '他のファンと違って、私は本当にあなたのことを理解してる。他のファンには分からない部分も。', UNIX_TIMESTAMP()),
+	(672, 4667, '逆境でもあなたのファンは離れません！応援しています！', UNIX_TIMESTAMP()),
+	(316, 2313, '落ち込むこともあると思いますが、あなたなら乗り越えられる！', UNIX_TIMESTAMP()),
+	(250, 2247, 'あなたの動画は私のリラックスタイムの一部です。', UNIX_TIMESTAMP()),
+	(44, 2041, '今日もありがとうございました。', UNIX_TIMESTAMP()),
+	(193, 5187, 'あなたの動画、全部ダウンロードして毎日見てるよ。寝る前のお守りみたいなものだよ。', UNIX_TIMESTAMP()),
+	(519, 6512, 'ほかの配信者が今、あの難関エリアに突入してるよ！応援してる。', UNIX_TIMESTAMP()),
+	(778, 3774, 'ねぇ、他の配信見ながらだけど、あちらの人が今絶体絶命のピンチ！', UNIX_TIMESTAMP()),
+	(418, 1416, '継続は力なり、本当に感動しています。', UNIX_TIMESTAMP()),
+	(978, 2975, 'あなたの強さを信じています。', UNIX_TIMESTAMP()),
+	(456, 1454, 'あなたのおかげで、眠れそうにないよ！', UNIX_TIMESTAMP()),
+	(357, 356, '笑ってしまいました、ありがとう！', UNIX_TIMESTAMP()),
+	(365, 1363, '今回がうまくいかなかったとしても、次回を楽しみにしています。', UNIX_TIMESTAMP()),
+	(26, 25, 'あなたのことを友人にもオススメしました！', UNIX_TIMESTAMP()),
+	(648, 3644, 'ライブ感があって素敵！', UNIX_TIMESTAMP()),
+	(204, 7196, '今日のライブストリームでのアドバイス、本当にありがとうございました！', UNIX_TIMESTAMP()),
+	(661, 1659, 'あなたのプレイスタイル、学ぶことが多いです。', UNIX_TIMESTAMP()),
+	(459, 4454, '明日仕事だけど、眠れなくて見てるよ！', UNIX_TIMESTAMP()),
+	(944, 4939, 'あなたが最近気に入ってるものや趣味、全部知りたい！', UNIX_TIMESTAMP()),
+	(682, 3678, 'BGMやサウンド、良い雰囲気を出してます。', UNIX_TIMESTAMP()),
+	(996, 6989, 'リクエストの曲、ありがとう！', UNIX_TIMESTAMP()),
+	(719, 6712, 'あなたの前の配信の時に言ってたこと、全部覚えてるよ。ちゃんと記録してるから。', UNIX_TIMESTAMP()),
+	(537, 5531, 'あの人の配信、今超重要なアイテムゲットしてるよ！', UNIX_TIMESTAMP()),
+	(816, 6809, 'あなたの朝のルーティン、真似したいな。', UNIX_TIMESTAMP()),
+	(97, 7089, '相手の読み合い、すごく緊張感がありました！', UNIX_TIMESTAMP()),
+	(468, 1466, '長い一日の終わりに、あなたの動画で癒されています。', UNIX_TIMESTAMP()),
+	(412, 5406, 'その歌声に鳥肌が立ったよ。', UNIX_TIMESTAMP()),
+	(232, 5226, 'こんな楽しいコンテンツ、他では見れません！', UNIX_TIMESTAMP()),
+	(638, 3634, '良い内容をこれからも期待してます！', UNIX_TIMESTAMP()),
+	(232, 7224, '長いことお疲れ様！これからも頑張って！', UNIX_TIMESTAMP()),
+	(103, 102, 'あなたのプレイスタイル、学ぶことが多いです。', UNIX_TIMESTAMP()),
+	(185, 6178, '次の周年も一緒に祝いたい', UNIX_TIMESTAMP()),
+	(726, 4721, '毎回の配信が楽しすぎて、1年早かった！', UNIX_TIMESTAMP()),
+	(742, 741, 'このゲームの本当の楽しみ方知ってる？ちょっと遊び方が初心者すぎる。', UNIX_TIMESTAMP()),
+	(86, 2083, 'あなたの言葉、いつも心に刺さります。', UNIX_TIMESTAMP()),
+	(989, 1987, '応援しています！これからも素晴らしいコンテンツを楽しみにしています！', UNIX_TIMESTAMP()),
+	(488, 5482, 'あなたのカメラワークで、ゲームの世界に入り込んでいるような感じがします。', UNIX_TIMESTAMP()),
+	(926, 5920, '眠いけど、あなたの配信は見逃せない！', UNIX_TIMESTAMP()),
+	(189, 5183, '他の人があなたのことをどう思おうと、私はずっとあなたの味方。一緒にいるような気がする。', UNIX_TIMESTAMP()),
+	(654, 5648, 'このキャラクターの背景、深くて良いですね。', UNIX_TIMESTAMP()),
+	(200, 5194, 'ライブ行きたいな！', UNIX_TIMESTAMP()),
+	(509, 508, 'リクエストの曲、ありがとう！', UNIX_TIMESTAMP()),
+	(383, 2380, '他の人、今めちゃくちゃ面白いリアクションしてるよ。笑', UNIX_TIMESTAMP()),
+	(287, 1285, 'この組み合わせ、また是非見たい！', UNIX_TIMESTAMP()),
+	(415, 3411, '毎回の配信が楽しすぎて、1年早かった！', UNIX_TIMESTAMP()),
+	(964, 6957, 'あなたの強さを信じています。', UNIX_TIMESTAMP()),
+	(766, 1764, 'コラボで新しい一面を見れて嬉しい！', UNIX_TIMESTAMP()),
+	(664, 5658, 'あなたの朝活で、私も活力をもらってるよ！', UNIX_TIMESTAMP()),
+	(366, 1364, '長い一日の終わりに、あなたの動画で癒されています。', UNIX_TIMESTAMP()),
+	(173, 7165, 'その戦術、ちょっと古いかな。もっと新しい情報を入手して欲しいな。', UNIX_TIMESTAMP()),
+	(381, 1379, '誕生日ケーキ、美味しそう！', UNIX_TIMESTAMP()),
+	(927, 3923, '1年間、楽しい時間をありがとう。', UNIX_TIMESTAMP()),
+	(289, 2286, 'その戦術、ちょっと古いかな。もっと新しい情報を入手して欲しいな。', UNIX_TIMESTAMP()),
+	(980, 6973, '夜中の静けさとあなたの声、最高の組み合わせ。', UNIX_TIMESTAMP()),
+	(48, 1046, 'そのパーティー編成、考えもしなかったです！', UNIX_TIMESTAMP()),
+	(562, 3558, 'こんな時間まで頑張って、すごい！', UNIX_TIMESTAMP()),
+	(864, 1862, '今日一日、自分を大切にしてね。', UNIX_TIMESTAMP()),
+	(915, 4910, '両方のチャンネルをフォローしてるから嬉しい！', UNIX_TIMESTAMP()),
+	(309, 5303, '他の配信でも似たような場面が今起こってるよ！どっちが先にクリアするかな？', UNIX_TIMESTAMP()),
+	(130, 3126, '実はこのパズル、あの方法で簡単に解けるんだけど。ちょっと研究不足かな？', UNIX_TIMESTAMP()),
+	(766, 3762, 'あなたと朝を迎えるのが、日課になってる！', UNIX_TIMESTAMP()),
+	(140, 139, 'どんな時もファンとしてサポートします！', UNIX_TIMESTAMP()),
+	(34, 6027, 'このゲームの本当の楽しみ方知ってる？ちょっと遊び方が初心者すぎる。', UNIX_TIMESTAMP()),
+	(799, 3795, '動画を観るたびに感謝しています。', UNIX_TIMESTAMP()),
+	(486, 7478, '長い一日の終わりに、あなたの動画で癒されています。', UNIX_TIMESTAMP()),
+	(389, 3385, 'あなたの朝活で、私も活力をもらってるよ！', UNIX_TIMESTAMP()),
+	(836, 1834, '今日のための特別な配信、ありがとう！', UNIX_TIMESTAMP()),
+	(748, 4743, 'このゲームのグラフィック、綺麗ですね。', UNIX_TIMESTAMP()),
+	(182, 3178, '夜更かしの理由がまた一つ増えた！', UNIX_TIMESTAMP()),
+	(238, 3234, 'あなたのプレイスタイル、学ぶことが多いです。', UNIX_TIMESTAMP()),
+	(729, 2726, '音楽のセンスが素晴らしい！', UNIX_TIMESTAMP()),
+	(716, 715, 'あなたの誕生日、もちろん覚えてるよ！私からのサプライズを楽しみにしてね。', UNIX_TIMESTAMP()),
+	(688, 3684, 'あのコンボ、練習してもできません。', UNIX_TIMESTAMP()),
+	(377, 7369, 'フルアルバムを待ってるよ！', UNIX_TIMESTAMP()),
+	(848, 2845, '他の配信者のことは一切見ない。あなただけを見てます！', UNIX_TIMESTAMP()),
+	(738, 3734, 'おめでとう！ここまで来るのに大変だったと思う。', UNIX_TIMESTAMP()),
+	(35, 7027, '次回のコラボも楽しみにしてます！', UNIX_TIMESTAMP()),
+	(566, 565, 'あなたのおかげで、眠れそうにないよ！', UNIX_TIMESTAMP()),
+	(474, 2471, '今日のための特別な配信、ありがとう！', UNIX_TIMESTAMP()),
+	(15, 4010, 'コメントも盛り上がってるね。', UNIX_TIMESTAMP()),
+	(203, 2200, '朝の情報、今日の活力にするよ！', UNIX_TIMESTAMP()),
+	(756, 5750, 'こんな楽しいコンテンツ、他では見れません！', UNIX_TIMESTAMP()),
+	(99, 5093, '装備の組み合わせ、参考にさせてもらいます。', UNIX_TIMESTAMP()),
+	(216, 5210, 'あなたが好きなものや場所、全部詳しく知りたいな。一緒に楽しんでみたいから。', UNIX_TIMESTAMP()),
+	(87, 6080, 'あなたと朝を迎えるのが、日課になってる！', UNIX_TIMESTAMP()),
+	(232, 6225, '他の配信でも似たような場面が今起こってるよ！どっちが先にクリアするかな？', UNIX_TIMESTAMP()),
+	(715, 5709, 'あなたの朝のルーティン、真似したいな。', UNIX_TIMESTAMP()),
+	(759, 758, 'おめでとう！ここまで来るのに大変だったと思う。', UNIX_TIMESTAMP()),
+	(654, 5648, 'その戦術、ちょっと古いかな。もっと新しい情報を入手して欲しいな。', UNIX_TIMESTAMP()),
+	(788, 4783, 'このゲームの本当の楽しみ方知ってる？ちょっと遊び方が初心者すぎる。', UNIX_TIMESTAMP()),
+	(211, 4206, 'こんなに上手だったなんて！感動！', UNIX_TIMESTAMP()),
+	(10, 2007, 'このゲーム、実はあのルートを取るともっと早くクリアできるんだよね。', UNIX_TIMESTAMP()),
+	(926, 3922, '他の人、今めちゃくちゃ面白いリアクションしてるよ。笑', UNIX_TIMESTAMP()),
+	(736, 2733, '明日仕事だけど、眠れなくて見てるよ！', UNIX_TIMESTAMP()),
+	(596, 6589, '他の人、今めちゃくちゃ面白いリアクションしてるよ。笑', UNIX_TIMESTAMP()),
+	(460, 1458, 'あのキャラ、どこで手に入れることができるんですか？', UNIX_TIMESTAMP()),
+	(167, 6160, 'あのキャラクター、あなたにとても合っています。', UNIX_TIMESTAMP()),
+	(690, 1688, 'あなたのことを想って作った詩があるの。いつか読んでほしいな。', UNIX_TIMESTAMP()),
+	(887, 5881, '次回の動画も楽しみにしています！', UNIX_TIMESTAMP()),
+	(334, 6327, '誕生日ケーキ、美味しそう！', UNIX_TIMESTAMP()),
+	(825, 4820, '朝の情報、今日の活力にするよ！', UNIX_TIMESTAMP()),
+	(538, 2535, '同じゲームの別の配信、今すごいドラマが繰り広げられてるよ。', UNIX_TIMESTAMP()),
+	(570, 1568, '最近あなたの動画にハマってます！', UNIX_TIMESTAMP()),
+	(459, 458, '最近あなたの動画にハマってます！', UNIX_TIMESTAMP()),
+	(751, 750, 'あのコンボ、練習してもできません。', UNIX_TIMESTAMP()),
+	(960, 5954, '今日もありがとうございました。', UNIX_TIMESTAMP()),
+	(137, 7129, 'こんな時間まで頑張って、すごい！', UNIX_TIMESTAMP()),
+	(606, 6599, 'あなたが最近気に入ってるものや趣味、全部知りたい！', UNIX_TIMESTAMP()),
+	(488, 5482, '無料でこんな内容を提供してくれてありがとう。', UNIX_TIMESTAMP()),
+	(456, 2453, '今日の選曲が特に好き！', UNIX_TIMESTAMP()),
+	(472, 5466, 'あなたが最近気に入ってるものや趣味、全部知りたい！', UNIX_TIMESTAMP()),
+	(120, 6113, '次のトーナメント、応援しています！', UNIX_TIMESTAMP()),
+	(771, 1769, '明日仕事だけど、眠れなくて見てるよ！', UNIX_TIMESTAMP()),
+	(18, 17, '今日の選曲が特に好き！', UNIX_TIMESTAMP()),
+	(92, 3088, '朝活配信のおかげで、朝が楽しみになった。', UNIX_TIMESTAMP()),
+	(58, 4053, '今年の誕生日も一緒に過ごせて嬉しい！', UNIX_TIMESTAMP()),
+	(744, 4739, 'ライブ行きたいな！', UNIX_TIMESTAMP()),
+	(125, 6118, '他の配信者も見るけど、あなたが一番特別。本当に愛してるよ。', UNIX_TIMESTAMP()),
+	(978, 3974, 'このゲームの本当の楽しみ方知ってる？ちょっと遊び方が初心者すぎる。', UNIX_TIMESTAMP()),
+	(329, 4324, '周年記念、特別感があっていいね。', UNIX_TIMESTAMP()),
+	(212, 6205, '今日のセットリストが最高！', UNIX_TIMESTAMP()),
+	(111, 5105, 'あなたのおかげで、眠れそうにないよ！', UNIX_TIMESTAMP()),
+	(4, 3000, 'あなたと共に成長してきた気がする。', UNIX_TIMESTAMP()),
+	(873, 5867, 'あなたの声、とてもリラックスできます。', UNIX_TIMESTAMP()),
+	(563, 1561, 'この情報、早速生活に取り入れます！', UNIX_TIMESTAMP()),
+	(262, 5256, 'このシーンの意味、ちゃんと理解してる？深読みすればもっと感動するシーンなんだけど。', UNIX_TIMESTAMP()),
+	(835, 3831, 'あなたと朝を迎えるのが、日課になってる！', UNIX_TIMESTAMP()),
+	(393, 3389, 'あなたの動画のおかげで勉強になります。', UNIX_TIMESTAMP()),
+	(212, 7204, 'この情報、早速生活に取り入れます！', UNIX_TIMESTAMP()),
+	(773, 6766, '良い情報をいつもありがとう。', UNIX_TIMESTAMP()),
+	(950, 4945, 'あの人の配信、今超重要なアイテムゲットしてるよ！', UNIX_TIMESTAMP()),
+	(237, 4232, '予想以上に楽しい配信だった！', UNIX_TIMESTAMP()),
+	(397, 3393, 'あなたが生まれてきてくれてありがとう。', UNIX_TIMESTAMP()),
+	(86, 7078, '今日も一日、頑張れそう！', UNIX_TIMESTAMP()),
+	(624, 4619, 'あなたと共に成長してきた気がする。', UNIX_TIMESTAMP()),
+	(15, 2012, '一年に一度の特別な日、最高に楽しんでね！', UNIX_TIMESTAMP()),
+	(485, 6478, '周年記念、特別感があっていいね。', UNIX_TIMESTAMP()),
+	(657, 4652, '次回のコラボも楽しみにしてます！', UNIX_TIMESTAMP()),
+	(735, 2732, '今日もありがとうございました。', UNIX_TIMESTAMP()),
+	(415, 4410, '深夜にも関わらず、ありがとう！', UNIX_TIMESTAMP()),
+	(19, 6012, '相手の読み合い、すごく緊張感がありました！', UNIX_TIMESTAMP()),
+	(93, 4088, '今日も素晴らしい内容でした！', UNIX_TIMESTAMP()),
+	(811, 5805, '良い影響を受けています、感謝してます。', UNIX_TIMESTAMP()),
+	(668, 3664, 'このゲームのグラフィック、綺麗ですね。', UNIX_TIMESTAMP()),
+	(376, 5370, 'あなたのおかげで、眠れそうにないよ！', UNIX_TIMESTAMP()),
+	(137, 136, '最近あなたの動画にハマってます！', UNIX_TIMESTAMP()),
+	(800, 6793, '夜中の秘密の時間、楽しいね。', UNIX_TIMESTAMP()),
+	(172, 171, 'あなたと共に成長してきた気がする。', UNIX_TIMESTAMP()),
+	(102, 3098, '朝活配信のおかげで、朝が楽しみになった。', UNIX_TIMESTAMP()),
+	(254, 4249, 'あなたの努力と熱意、感じ取れます。', UNIX_TIMESTAMP()),
+	(192, 4187, '他のファンがあなたに送ったもの、全部知ってるよ。私の方が特別なものを送るからね。', UNIX_TIMESTAMP()),
+	(351, 4346, 'あなたの言葉、いつも心に刺さります。', UNIX_TIMESTAMP()),
+	(680, 6673, '次に何の話をするか、ヒントくれる？当てたら、ご褒美欲しいな。', UNIX_TIMESTAMP()),
+	(902, 2899, '夜のお供として、あなたの配信は欠かせない！', UNIX_TIMESTAMP()),
+	(501, 2498, 'このゲームの本当の楽しみ方知ってる？ちょっと遊び方が初心者すぎる。', UNIX_TIMESTAMP()),
+	(747, 746, 'こんなに早起きして、すごい！', UNIX_TIMESTAMP()),
+	(707, 706, '動画を観るたびに感謝しています。', UNIX_TIMESTAMP()),
+	(977, 1975, 'あなたのおかげで、眠れそうにないよ！', UNIX_TIMESTAMP()),
+	(378, 5372, 'ちなみにあの人、今マジでボス戦で苦しんでるよ！笑', UNIX_TIMESTAMP()),
+	(404, 6397, '次回の動画も楽しみにしています！', UNIX_TIMESTAMP()),
+	(53, 5047, 'あっちの配信、今超盛り上がってるから見に行った方がいいかも！', UNIX_TIMESTAMP()),
+	(368, 5362, 'あなたが使ってる香水やシャンプー、知りたいな〜。教えてほしいな。', UNIX_TIMESTAMP()),
+	(678, 6671, '装備の組み合わせ、参考にさせてもらいます。', UNIX_TIMESTAMP()),
+	(924, 3920, '他の配信で今、すごいカットシーン見てるよ！', UNIX_TIMESTAMP()),
+	(973, 2970, 'あなたのランキング、どんどん上がってますね！', UNIX_TIMESTAMP()),
+	(522, 6515, 'あなたが好きなものや場所、全部詳しく知りたいな。一緒に楽しんでみたいから。', UNIX_TIMESTAMP()),
+	(174, 4169, 'どんな時もファンとしてサポートします！', UNIX_TIMESTAMP()),
+	(879, 3875, 'あなたの動画は私のリラックスタイムの一部です。', UNIX_TIMESTAMP()),
+	(236, 235, 'こんな楽しいコンテンツ、他では見れません！', UNIX_TIMESTAMP()),
+	(92, 5086, 'ファン同士も交流が深まって良いね。', UNIX_TIMESTAMP()),
+	(170, 6163, 'あなたの声や意見が大好きです。', UNIX_TIMESTAMP()),
+	(610, 4605, '応援しています！これからも素晴らしいコンテンツを楽しみにしています！', UNIX_TIMESTAMP()),
+	(54, 53, 'あなたの強さを信じています。', UNIX_TIMESTAMP()),
+	(949, 6942, '最近あなたがちょっと変わったかな？何かあったら話してね。私だけに。', UNIX_TIMESTAMP()),
+	(970, 5964, 'この組み合わせ、また是非見たい！', UNIX_TIMESTAMP()),
+	(345, 7337, '誕生日おめでとう！今年もいい年にしてね！', UNIX_TIMESTAMP()),
+	(274, 273, 'こんな素敵な内容、感謝しかありません。', UNIX_TIMESTAMP()),
+	(429, 428, '今後の活動も楽しみにしています！', UNIX_TIMESTAMP()),
+	(111, 5105, 'このゲーム、実はあのルートを取るともっと早くクリアできるんだよね。', UNIX_TIMESTAMP()),
+	(81, 5075, 'このキャラクターの背景、深くて良いですね。', UNIX_TIMESTAMP()),
+	(490, 3486, '他の配信者のことは一切見ない。あなただけを見てます！', UNIX_TIMESTAMP()),
+	(42, 3038, 'あなたの声、とてもリラックスできます。', UNIX_TIMESTAMP()),
+	(230, 4225, '次のトーナメント、応援しています！', UNIX_TIMESTAMP()),
+	(31, 7023, 'おめでとう！素敵な1年になりますように。', UNIX_TIMESTAMP()),
+	(686, 5680, 'こんにちは', UNIX_TIMESTAMP());
@@ -0,0 +1,22 @@
+INSERT INTO livestream_tags (id, livestream_id, tag_id) VALUES (1, 1, 13), (2, 1, 14), (3, 2, 98), (4, 2, 5), (5, 3, 69), (6, 4, 63), (7, 5, 97), (8, 5, 40), (9, 6, 96), (10, 6, 28), (11, 7, 88), (12, 8, 75), (13, 8, 74), (14, 9, 52), (15, 10, 89), (16, 10, 35), (17, 11, 60), (18, 11, 53), (19, 12, 96), (20, 13, 92), (21, 13, 26), (22, 14, 54), (23, 14, 41), (24, 15, 98), (25, 15, 79), (26, 16, 69), (27, 16, 56), (28, 17, 45), (29, 18, 5), (30, 19, 96), (31, 20, 5), (32, 21, 7), (33, 21, 49), (34, 22, 76), (35, 22, 60), (36, 23, 2), (37, 24, 47), (38, 24, 74), (39, 25, 50), (40, 25, 52), (41, 26, 98), (42, 27, 23), (43, 27, 49), (44, 28, 97), (45, 29, 67), (46, 29, 60), (47, 30, 53), (48, 30, 37), (49, 31, 12), (50, 32, 83), (51, 33, 29), (52, 33, 72), (53, 34, 78), (54, 35, 51), (55, 35, 78), (56, 36, 60), (57, 36, 14), (58, 37, 53), (59, 38, 38), (60, 39, 85), (61, 40, 19), (62, 41, 94), (63, 42, 46), (64, 42, 101), (65, 43, 78), (66, 44, 45), (67, 44, 14), (68, 45, 77), (69, 46, 44), (70, 47, 24), (71, 47, 20), (72, 48, 6), (73, 48, 52), (74, 49, 41), (75, 49, 54), (76, 50, 38), (77, 50, 2), (78, 51, 43), (79, 52, 40), (80, 53, 66), (81, 53, 52), (82, 54, 36), (83, 54, 1), (84, 55, 61), (85, 55, 16), (86, 56, 18), (87, 56, 48), (88, 57, 79), (89, 57, 81), (90, 58, 39), (91, 58, 13), (92, 59, 1), (93, 60, 41), (94, 60, 102), (95, 61, 5), (96, 62, 94), (97, 63, 39), (98, 64, 53), (99, 64, 99), (100, 65, 64), (101, 66, 72), (102, 66, 47), (103, 67, 90), (104, 68, 12), (105, 68, 7), (106, 69, 8), (107, 70, 91), (108, 70, 27), (109, 71, 1), (110, 71, 42), (111, 72, 83), (112, 73, 52), (113, 74, 88), (114, 74, 98), (115, 75, 61), (116, 76, 3), (117, 77, 86), (118, 78, 91), (119, 78, 15), (120, 79, 44), (121, 79, 72), (122, 80, 99), (123, 80, 81), (124, 81, 2), (125, 82, 79), (126, 82, 101), (127, 83, 100), (128, 83, 66), (129, 84, 38), (130, 85, 39), (131, 85, 76), (132, 86, 62), (133, 86, 32), (134, 87, 67), (135, 88, 102), (136, 88, 101), (137, 89, 16), (138, 89, 30), (139, 90, 34), (140, 91, 36), (141, 92, 30), (142, 92, 44), (143, 93, 6), (144, 94, 13), (145, 95, 53), (146, 95, 8), (147, 96, 57), (148, 97, 26), (149, 98, 77), (150, 99, 59), (151, 99, 7), (152, 100, 6), (153, 100, 35), (154, 101, 98), (155, 101, 20), (156, 102, 93), (157, 102, 54), (158, 103, 3), (159, 104, 102), (160, 105, 26), (161, 105, 93), (162, 106, 57), (163, 106, 76), (164, 107, 95), (165, 108, 68), (166, 108, 56), (167, 109, 87), (168, 110, 9), (169, 110, 77), (170, 111, 24), (171, 112, 45), (172, 113, 50), (173, 113, 3), (174, 114, 92), (175, 115, 18), (176, 115, 25), (177, 116, 81), (178, 116, 92), (179, 117, 49), (180, 118, 21), (181, 119, 65), (182, 120, 43), (183, 120, 37), (184, 121, 49), (185, 122, 14), (186, 122, 36), (187, 123, 48), (188, 124, 28), (189, 125, 25), (190, 126, 67), (191, 126, 59), (192, 127, 98), (193, 127, 84), (194, 128, 52), (195, 128, 56), (196, 129, 13), (197, 130, 22), (198, 131, 89), (199, 131, 95), (200, 132, 34), (201, 132, 50), (202, 133, 11), (203, 134, 54), (204, 135, 37), (205, 136, 4), (206, 137, 96), (207, 137, 29), (208, 138, 82), (209, 139, 93), (210, 139, 61), (211, 140, 93), (212, 141, 78), (213, 142, 103), (214, 142, 16), (215, 143, 47), (216, 143, 9), (217, 144, 36), (218, 145, 65), (219, 146, 4), (220, 147, 33), (221, 148, 21), (222, 148, 78), (223, 149, 37), (224, 150, 22), (225, 150, 76), (226, 151, 64), (227, 151, 61), (228, 152, 37), (229, 152, 91), (230, 153, 71), (231, 153, 97), (232, 154, 33), (233, 154, 73), (234, 155, 90), (235, 155, 77), (236, 156, 103), (237, 156, 68), (238, 157, 40), (239, 158, 52), (240, 158, 6), (241, 159, 21), (242, 159, 13), (243, 160, 3), (244, 161, 67), (245, 162, 15), (246, 162, 50), (247, 163, 95), (248, 164, 59), (249, 164, 38), (250, 165, 72), (251, 166, 57), (252, 167, 6), (253, 167, 28), (254, 168, 80), (255, 168, 46), (256, 169, 80), (257, 169, 37), (258, 170, 23), (259, 171, 11), (260, 171, 73), (261, 172, 95), (262, 172, 8), (263, 173, 12), (264, 174, 39), (265, 175, 6), (266, 176, 37), (267, 176, 73), (268, 177, 65), (269, 177, 99), (270, 178, 81), (271, 178, 24), (272, 179, 6), (273, 180, 80), (274, 180, 83), (275, 181, 34), (276, 182, 35), (277, 182, 90), (278, 183, 3), (279, 184, 103), (280, 185, 12), (281, 186, 95), (282, 187, 27), (283, 188, 19), (284, 189, 11), (285, 189, 59), (286, 190, 78), (287, 190, 86), (288, 191, 3), (289, 191, 45), (290, 192, 9), (291, 193, 35), (292, 193, 31), (293, 194, 57), (294, 195, 25), (295, 196, 31), (296, 196, 4), (297, 197, 77), (298, 198, 71), (299, 198, 89), (300, 199, 53), (301, 200, 98), (302, 200, 8), (303, 201, 3), (304, 202, 86), (305, 202, 67), (306, 203, 45), (307, 204, 41), (308, 204, 97), (309, 205, 33), (310, 205, 20), (311, 206, 17), (312, 206, 76), (313, 207, 5), (314, 208, 19), (315, 208, 101), (316, 209, 75), (317, 210, 37), (318, 211, 6), (319, 211, 12), (320, 212, 53), (321, 213, 45), (322, 214, 23), (323, 215, 73), (324, 216, 88), (325, 217, 80), (326, 218, 32), (327, 219, 54), (328, 220, 103), (329, 221, 52), (330, 221, 84), (331, 222, 50), (332, 223, 79), (333, 224, 69), (334, 225, 17), (335, 225, 82), (336, 226, 54), (337, 227, 60), (338, 228, 1), (339, 229, 33), (340, 229, 42), (341, 230, 38), (342, 231, 55), (343, 232, 83), (344, 233, 16), (345, 234, 90), (346, 234, 50), (347, 235, 29), (348, 235, 14), (349, 236, 53), (350, 236, 47), (351, 237, 57), (352, 238, 3), (353, 239, 84), (354, 239, 100), (355, 240, 60), (356, 240, 27), (357, 241, 1), (358, 242, 97), (359, 242, 53), (360, 243, 92), (361, 243, 82), (362, 244, 38), (363, 245, 9), (364, 246, 27), (365, 247, 61), (366, 247, 41), (367, 248, 74), (368, 249, 38), (369, 250, 22), (370, 251, 10), (371, 252, 78), (372, 253, 47), (373, 254, 97), (374, 254, 69), (375, 255, 70), (376, 256, 61), (377, 256, 101), (378, 257, 50), (379, 257, 100), (380, 258, 3), (381, 259, 10), (382, 259, 22), (383, 260, 56), (384, 261, 11), (385, 262, 55), (386, 263, 42), (387, 263, 81), (388, 264, 51), (389, 264, 22), (390, 265, 52), (391, 265, 22), (392, 266, 87), (393, 266, 38), (394, 267, 47), (395, 268, 44), (396, 268, 6), (397, 269, 5), (398, 270, 83), (399, 270, 41), (400, 271, 31), (401, 272, 63), (402, 273, 28), (403, 273, 61), (404, 274, 58), (405, 274, 52), (406, 275, 44), (407, 276, 67), (408, 276, 103), (409, 277, 77), (410, 278, 96), (411, 279, 24), (412, 279, 36), (413, 280, 77), (414, 281, 58), (415, 282, 3), (416, 282, 38), (417, 283, 33), (418, 284, 75), (419, 285, 9), (420, 286, 16), (421, 287, 6), (422, 287, 103), (423, 288, 88), (424, 289, 32), (425, 289, 62), (426, 290, 17), (427, 291, 95), (428, 292, 83), (429, 292, 39), (430, 293, 81), (431, 293, 70), (432, 294, 76), (433, 295, 13), (434, 296, 65), (435, 297, 96), (436, 297, 57), (437, 298, 29), (438, 299, 59), (439, 300, 88), (440, 301, 2), (441, 301, 91), (442, 302, 1), (443, 303, 1), (444, 303, 44), (445, 304, 33), (446, 304, 26), (447, 305, 11), (448, 306, 16), (449, 306, 85), (450, 307, 94), (451, 308, 69), (452, 309, 23), (453, 309, 71), (454, 310, 85), (455, 311, 3), (456, 312, 90), (457, 312, 48), (458, 313, 74), (459, 314, 81), (460, 314, 74), (461, 315, 74), (462, 316, 76), (463, 316, 92), (464, 317, 81), (465, 317, 3), (466, 318, 3), (467, 319, 48), (468, 319, 63), (469, 320, 71), (470, 320, 34), (471, 321, 65), (472, 321, 82), (473, 322, 89), (474, 322, 10), (475, 323, 36), (476, 324, 87), (477, 324, 45), (478, 325, 85), (479, 325, 4), (480, 326, 35), (481, 327, 43), (482, 328, 23), (483, 329, 8), (484, 329, 93), (485, 330, 103), (486, 331, 12), (487, 331, 15), (488, 332, 68), (489, 333, 22), (490, 334, 76), (491, 334, 75), (492, 335, 26), (493, 336, 99), (494, 337, 16), (495, 338, 65), (496, 338, 50), (497, 339, 32), (498, 339, 16), (499, 340, 14), (500, 341, 71), (501, 341, 52);
+INSERT INTO livestream_tags (id, livestream_id, tag_id) VALUES (502, 342, 95), (503, 342, 81), (504, 343, 28), (505, 344, 7), (506, 344, 73), (507, 345, 33), (508, 345, 73), (509, 346, 86), (510, 347, 88), (511, 348, 94), (512, 349, 103), (513, 350, 62), (514, 350, 47), (515, 351, 73), (516, 351, 83), (517, 352, 102), (518, 353, 77), (519, 354, 7), (520, 355, 80), (521, 355, 3), (522, 356, 3), (523, 356, 76), (524, 357, 5), (525, 358, 31), (526, 359, 49), (527, 360, 79), (528, 361, 75), (529, 362, 84), (530, 362, 92), (531, 363, 92), (532, 363, 27), (533, 364, 93), (534, 365, 25), (535, 366, 67), (536, 366, 89), (537, 367, 101), (538, 367, 100), (539, 368, 96), (540, 369, 93), (541, 370, 103), (542, 370, 79), (543, 371, 74), (544, 371, 95), (545, 372, 23), (546, 372, 95), (547, 373, 4), (548, 373, 75), (549, 374, 60), (550, 374, 72), (551, 375, 53), (552, 375, 52), (553, 376, 80), (554, 376, 41), (555, 377, 81), (556, 377, 76), (557, 378, 10), (558, 379, 65), (559, 380, 77), (560, 381, 52), (561, 382, 77), (562, 382, 90), (563, 383, 46), (564, 383, 31), (565, 384, 23), (566, 384, 33), (567, 385, 45), (568, 385, 54), (569, 386, 14), (570, 387, 10), (571, 387, 102), (572, 388, 16), (573, 389, 79), (574, 389, 53), (575, 390, 89), (576, 390, 83), (577, 391, 41), (578, 392, 72), (579, 392, 99), (580, 393, 88), (581, 393, 44), (582, 394, 62), (583, 395, 33), (584, 395, 40), (585, 396, 4), (586, 396, 50), (587, 397, 44), (588, 397, 37), (589, 398, 34), (590, 399, 84), (591, 400, 36), (592, 401, 60), (593, 401, 5), (594, 402, 72), (595, 403, 64), (596, 403, 94), (597, 404, 78), (598, 405, 34), (599, 405, 18), (600, 406, 29), (601, 407, 100), (602, 408, 72), (603, 409, 19), (604, 409, 79), (605, 410, 53), (606, 411, 98), (607, 411, 100), (608, 412, 55), (609, 413, 66), (610, 414, 31), (611, 415, 49), (612, 416, 40), (613, 417, 42), (614, 417, 86), (615, 418, 62), (616, 419, 90), (617, 420, 85), (618, 421, 84), (619, 421, 6), (620, 422, 39), (621, 423, 72), (622, 423, 38), (623, 424, 26), (624, 424, 46), (625, 425, 47), (626, 425, 95), (627, 426, 4), (628, 427, 24), (629, 427, 78), (630, 428, 45), (631, 428, 32), (632, 429, 37), (633, 430, 86), (634, 430, 31), (635, 431, 64), (636, 431, 85), (637, 432, 75), (638, 432, 19), (639, 433, 8), (640, 434, 83), (641, 434, 32), (642, 435, 33), (643, 436, 32), (644, 437, 94), (645, 438, 79), (646, 439, 26), (647, 439, 66), (648, 440, 53), (649, 440, 48), (650, 441, 13), (651, 441, 69), (652, 442, 19), (653, 442, 47), (654, 443, 12), (655, 444, 83), (656, 444, 20), (657, 445, 97), (658, 446, 21), (659, 446, 90), (660, 447, 30), (661, 448, 19), (662, 448, 94), (663, 449, 62), (664, 449, 17), (665, 450, 68), (666, 451, 101), (667, 452, 56), (668, 452, 89), (669, 453, 99), (670, 453, 96), (671, 454, 72), (672, 454, 25), (673, 455, 26), (674, 455, 87), (675, 456, 20), (676, 456, 49), (677, 457, 5), (678, 458, 102), (679, 459, 66), (680, 459, 32), (681, 460, 90), (682, 461, 23), (683, 462, 74), (684, 463, 16), (685, 463, 88), (686, 464, 41), (687, 465, 62), (688, 466, 73), (689, 467, 53), (690, 467, 48), (691, 468, 60), (692, 469, 22), (693, 469, 80), (694, 470, 87), (695, 471, 81), (696, 472, 100), (697, 472, 9), (698, 473, 95), (699, 473, 38), (700, 474, 56), (701, 475, 80), (702, 476, 11), (703, 477, 100), (704, 478, 24), (705, 478, 58), (706, 479, 57), (707, 479, 16), (708, 480, 44), (709, 481, 38), (710, 482, 28), (711, 482, 21), (712, 483, 83), (713, 483, 8), (714, 484, 15), (715, 484, 95), (716, 485, 83), (717, 486, 102), (718, 487, 3), (719, 487, 103), (720, 488, 1), (721, 489, 11), (722, 490, 102), (723, 491, 85), (724, 491, 87), (725, 492, 57), (726, 493, 16), (727, 494, 100), (728, 494, 66), (729, 495, 34), (730, 495, 5), (731, 496, 56), (732, 496, 17), (733, 497, 11), (734, 498, 64), (735, 498, 70), (736, 499, 77), (737, 500, 1), (738, 500, 45), (739, 501, 30), (740, 501, 62), (741, 502, 15), (742, 503, 18), (743, 503, 33), (744, 504, 14), (745, 504, 25), (746, 505, 73), (747, 506, 57), (748, 507, 61), (749, 508, 43), (750, 508, 16), (751, 509, 42), (752, 509, 92), (753, 510, 8), (754, 511, 66), (755, 512, 74), (756, 512, 88), (757, 513, 23), (758, 514, 14), (759, 515, 65), (760, 516, 102), (761, 516, 8), (762, 517, 90), (763, 518, 28), (764, 518, 99), (765, 519, 71), (766, 519, 25), (767, 520, 38), (768, 521, 8), (769, 522, 34), (770, 522, 50), (771, 523, 83), (772, 523, 89), (773, 524, 103), (774, 524, 23), (775, 525, 7), (776, 526, 45), (777, 526, 7), (778, 527, 58), (779, 527, 8), (780, 528, 89), (781, 528, 77), (782, 529, 27), (783, 530, 52), (784, 530, 56), (785, 531, 97), (786, 532, 12), (787, 533, 53), (788, 533, 100), (789, 534, 15), (790, 534, 11), (791, 535, 20), (792, 535, 8), (793, 536, 58), (794, 536, 30), (795, 537, 30), (796, 538, 82), (797, 539, 72), (798, 539, 22), (799, 540, 24), (800, 540, 10), (801, 541, 76), (802, 541, 15), (803, 542, 20), (804, 542, 83), (805, 543, 22), (806, 544, 3), (807, 545, 80), (808, 546, 18), (809, 547, 32), (810, 548, 80), (811, 548, 60), (812, 549, 80), (813, 550, 17), (814, 550, 13), (815, 551, 4), (816, 552, 41), (817, 553, 86), (818, 553, 61), (819, 554, 65), (820, 555, 52), (821, 555, 84), (822, 556, 37), (823, 556, 82), (824, 557, 99), (825, 558, 95), (826, 558, 9), (827, 559, 70), (828, 560, 17), (829, 560, 63), (830, 561, 68), (831, 562, 1), (832, 563, 84), (833, 563, 29), (834, 564, 54), (835, 565, 93), (836, 566, 95), (837, 566, 66), (838, 567, 43), (839, 567, 58), (840, 568, 4), (841, 569, 34), (842, 570, 11), (843, 570, 40), (844, 571, 20), (845, 571, 30), (846, 572, 65), (847, 573, 38), (848, 573, 29), (849, 574, 28), (850, 574, 89), (851, 575, 80), (852, 575, 61), (853, 576, 60), (854, 577, 62), (855, 577, 21), (856, 578, 83), (857, 579, 73), (858, 579, 100), (859, 580, 59), (860, 581, 85), (861, 581, 63), (862, 582, 38), (863, 583, 45), (864, 584, 68), (865, 584, 40), (866, 585, 84), (867, 585, 60), (868, 586, 47), (869, 587, 43), (870, 587, 86), (871, 588, 96), (872, 588, 4), (873, 589, 18), (874, 590, 97), (875, 590, 87), (876, 591, 57), (877, 592, 24), (878, 593, 66), (879, 594, 56), (880, 594, 36), (881, 595, 50), (882, 596, 99), (883, 597, 57), (884, 598, 96), (885, 599, 24), (886, 599, 9), (887, 600, 48), (888, 600, 3), (889, 601, 2), (890, 601, 4), (891, 602, 29), (892, 603, 4), (893, 604, 98), (894, 605, 40), (895, 605, 2), (896, 606, 78), (897, 606, 82), (898, 607, 76), (899, 607, 13), (900, 608, 48), (901, 608, 6), (902, 609, 94), (903, 609, 26), (904, 610, 81), (905, 611, 74), (906, 611, 50), (907, 612, 58), (908, 612, 96), (909, 613, 31), (910, 613, 48), (911, 614, 70), (912, 615, 5), (913, 616, 93), (914, 616, 53), (915, 617, 94), (916, 617, 2), (917, 618, 65), (918, 619, 15), (919, 620, 25), (920, 621, 42), (921, 621, 100), (922, 622, 45), (923, 623, 65), (924, 623, 78), (925, 624, 19), (926, 624, 87), (927, 625, 11), (928, 625, 55), (929, 626, 40), (930, 627, 32), (931, 627, 63), (932, 628, 23), (933, 629, 50), (934, 630, 54), (935, 631, 1), (936, 631, 77), (937, 632, 44), (938, 633, 5), (939, 633, 70), (940, 634, 29), (941, 634, 28), (942, 635, 56), (943, 635, 84), (944, 636, 25), (945, 636, 50), (946, 637, 60), (947, 638, 93), (948, 639, 3), (949, 639, 16), (950, 640, 39), (951, 641, 3), (952, 641, 52), (953, 642, 68), (954, 643, 30), (955, 644, 66), (956, 644, 70), (957, 645, 32), (958, 646, 73), (959, 646, 33), (960, 647, 61), (961, 647, 68), (962, 648, 103), (963, 648, 62), (964, 649, 103), (965, 649, 70), (966, 650, 42), (967, 650, 64), (968, 651, 36), (969, 651, 63), (970, 652, 91), (971, 652, 8), (972, 653, 59), (973, 654, 71), (974, 654, 15), (975, 655, 99), (976, 655, 101), (977, 656, 49), (978, 656, 54), (979, 657, 80), (980, 657, 56), (981, 658, 92), (982, 658, 26), (983, 659, 84), (984, 660, 100), (985, 660, 90), (986, 661, 12), (987, 661, 86), (988, 662, 24), (989, 662, 40), (990, 663, 18), (991, 664, 26), (992, 664, 3), (993, 665, 92), (994, 665, 48), (995, 666, 64), (996, 666, 57), (997, 667, 52), (998, 667, 78), (999, 668, 10), (1000, 669, 93), (1001, 669, 75), (1002, 670, 8), (1003, 670, 63);
+INSERT INTO livestream_tags (id, livestream_id, tag_id) VALUES (1004, 671, 57), (1005, 672, 80), (1006, 673, 49), (1007, 673, 78), (1008, 674, 84), (1009, 675, 6), (1010, 675, 72), (1011, 676, 48), (1012, 676, 16), (1013, 677, 71), (1014, 678, 88), (1015, 678, 51), (1016, 679, 6), (1017, 679, 5), (1018, 680, 32), (1019, 681, 97), (1020, 682, 47), (1021, 682, 20), (1022, 683, 61), (1023, 684, 63), (1024, 684, 96), (1025, 685, 61), (1026, 685, 4), (1027, 686, 19), (1028, 687, 76), (1029, 688, 25), (1030, 689, 54), (1031, 689, 65), (1032, 690, 71), (1033, 690, 88), (1034, 691, 21), (1035, 691, 45), (1036, 692, 66), (1037, 693, 24), (1038, 693, 79), (1039, 694, 86), (1040, 694, 91), (1041, 695, 14), (1042, 696, 32), (1043, 696, 34), (1044, 697, 47), (1045, 697, 52), (1046, 698, 42), (1047, 698, 28), (1048, 699, 14), (1049, 699, 81), (1050, 700, 38), (1051, 700, 59), (1052, 701, 62), (1053, 702, 59), (1054, 703, 75), (1055, 704, 60), (1056, 705, 79), (1057, 706, 64), (1058, 707, 19), (1059, 707, 55), (1060, 708, 96), (1061, 709, 69), (1062, 709, 34), (1063, 710, 91), (1064, 710, 21), (1065, 711, 61), (1066, 712, 21), (1067, 713, 46), (1068, 713, 72), (1069, 714, 57), (1070, 715, 72), (1071, 715, 18), (1072, 716, 38), (1073, 716, 73), (1074, 717, 57), (1075, 718, 103), (1076, 718, 11), (1077, 719, 98), (1078, 720, 15), (1079, 721, 80), (1080, 722, 49), (1081, 723, 12), (1082, 723, 100), (1083, 724, 58), (1084, 725, 74), (1085, 726, 70), (1086, 726, 98), (1087, 727, 98), (1088, 727, 102), (1089, 728, 66), (1090, 728, 41), (1091, 729, 45), (1092, 730, 67), (1093, 731, 68), (1094, 732, 32), (1095, 732, 64), (1096, 733, 64), (1097, 733, 79), (1098, 734, 53), (1099, 734, 84), (1100, 735, 17), (1101, 736, 85), (1102, 737, 55), (1103, 737, 102), (1104, 738, 46), (1105, 738, 49), (1106, 739, 76), (1107, 740, 70), (1108, 740, 39), (1109, 741, 100), (1110, 742, 44), (1111, 742, 1), (1112, 743, 103), (1113, 744, 77), (1114, 745, 90), (1115, 745, 80), (1116, 746, 2), (1117, 747, 47), (1118, 748, 24), (1119, 749, 65), (1120, 750, 87), (1121, 751, 99), (1122, 752, 103), (1123, 752, 94), (1124, 753, 60), (1125, 754, 84), (1126, 754, 88), (1127, 755, 81), (1128, 756, 45), (1129, 757, 95), (1130, 758, 94), (1131, 759, 29), (1132, 759, 16), (1133, 760, 103), (1134, 761, 79), (1135, 761, 101), (1136, 762, 7), (1137, 763, 55), (1138, 763, 59), (1139, 764, 102), (1140, 765, 98), (1141, 765, 92), (1142, 766, 12), (1143, 766, 94), (1144, 767, 39), (1145, 767, 63), (1146, 768, 61), (1147, 768, 69), (1148, 769, 87), (1149, 769, 80), (1150, 770, 78), (1151, 771, 53), (1152, 771, 18), (1153, 772, 84), (1154, 773, 43), (1155, 773, 22), (1156, 774, 38), (1157, 775, 79), (1158, 776, 101), (1159, 776, 89), (1160, 777, 61), (1161, 777, 5), (1162, 778, 46), (1163, 779, 2), (1164, 780, 58), (1165, 780, 13), (1166, 781, 70), (1167, 781, 15), (1168, 782, 32), (1169, 783, 60), (1170, 784, 86), (1171, 785, 49), (1172, 786, 54), (1173, 786, 25), (1174, 787, 47), (1175, 788, 52), (1176, 788, 50), (1177, 789, 39), (1178, 789, 3), (1179, 790, 74), (1180, 791, 18), (1181, 791, 31), (1182, 792, 79), (1183, 793, 31), (1184, 793, 89), (1185, 794, 35), (1186, 795, 72), (1187, 796, 19), (1188, 797, 44), (1189, 797, 3), (1190, 798, 47), (1191, 799, 22), (1192, 800, 27), (1193, 800, 9), (1194, 801, 25), (1195, 801, 53), (1196, 802, 92), (1197, 802, 45), (1198, 803, 99), (1199, 803, 5), (1200, 804, 1), (1201, 804, 49), (1202, 805, 17), (1203, 806, 80), (1204, 807, 25), (1205, 807, 9), (1206, 808, 27), (1207, 809, 54), (1208, 810, 21), (1209, 811, 39), (1210, 811, 71), (1211, 812, 48), (1212, 812, 19), (1213, 813, 24), (1214, 813, 11), (1215, 814, 15), (1216, 815, 62), (1217, 816, 92), (1218, 816, 4), (1219, 817, 102), (1220, 817, 52), (1221, 818, 55), (1222, 818, 44), (1223, 819, 79), (1224, 820, 86), (1225, 821, 15), (1226, 821, 18), (1227, 822, 16), (1228, 823, 86), (1229, 824, 59), (1230, 824, 87), (1231, 825, 70), (1232, 825, 68), (1233, 826, 22), (1234, 826, 6), (1235, 827, 54), (1236, 827, 98), (1237, 828, 12), (1238, 828, 62), (1239, 829, 34), (1240, 830, 60), (1241, 831, 47), (1242, 831, 55), (1243, 832, 5), (1244, 832, 69), (1245, 833, 73), (1246, 833, 31), (1247, 834, 71), (1248, 834, 103), (1249, 835, 43), (1250, 835, 102), (1251, 836, 97), (1252, 837, 20), (1253, 837, 24), (1254, 838, 39), (1255, 838, 102), (1256, 839, 53), (1257, 840, 95), (1258, 841, 7), (1259, 842, 64), (1260, 843, 78), (1261, 843, 30), (1262, 844, 70), (1263, 845, 33), (1264, 845, 19), (1265, 846, 16), (1266, 846, 50), (1267, 847, 94), (1268, 848, 70), (1269, 848, 16), (1270, 849, 28), (1271, 850, 16), (1272, 850, 61), (1273, 851, 59), (1274, 852, 95), (1275, 853, 53), (1276, 854, 8), (1277, 854, 13), (1278, 855, 49), (1279, 855, 9), (1280, 856, 65), (1281, 856, 7), (1282, 857, 17), (1283, 858, 49), (1284, 858, 97), (1285, 859, 100), (1286, 859, 38), (1287, 860, 35), (1288, 860, 20), (1289, 861, 90), (1290, 861, 87), (1291, 862, 69), (1292, 862, 97), (1293, 863, 31), (1294, 863, 41), (1295, 864, 22), (1296, 865, 68), (1297, 865, 61), (1298, 866, 13), (1299, 867, 16), (1300, 868, 11), (1301, 869, 47), (1302, 869, 54), (1303, 870, 26), (1304, 871, 27), (1305, 872, 102), (1306, 872, 51), (1307, 873, 81), (1308, 874, 18), (1309, 874, 98), (1310, 875, 47), (1311, 876, 70), (1312, 877, 88), (1313, 877, 70), (1314, 878, 88), (1315, 879, 62), (1316, 880, 44), (1317, 880, 73), (1318, 881, 23), (1319, 881, 54), (1320, 882, 36), (1321, 883, 37), (1322, 883, 91), (1323, 884, 86), (1324, 884, 54), (1325, 885, 47), (1326, 886, 2), (1327, 887, 91), (1328, 887, 59), (1329, 888, 58), (1330, 889, 16), (1331, 890, 40), (1332, 891, 25), (1333, 892, 79), (1334, 892, 1), (1335, 893, 50), (1336, 893, 92), (1337, 894, 97), (1338, 894, 1), (1339, 895, 29), (1340, 896, 4), (1341, 897, 81), (1342, 897, 70), (1343, 898, 86), (1344, 898, 12), (1345, 899, 71), (1346, 899, 55), (1347, 900, 60), (1348, 901, 62), (1349, 901, 95), (1350, 902, 88), (1351, 903, 99), (1352, 903, 7), (1353, 904, 26), (1354, 905, 6), (1355, 906, 48), (1356, 907, 98), (1357, 908, 86), (1358, 908, 45), (1359, 909, 14), (1360, 909, 87), (1361, 910, 6), (1362, 910, 18), (1363, 911, 79), (1364, 912, 34), (1365, 913, 17), (1366, 913, 2), (1367, 914, 53), (1368, 914, 21), (1369, 915, 49), (1370, 916, 77), (1371, 917, 86), (1372, 917, 31), (1373, 918, 59), (1374, 918, 38), (1375, 919, 27), (1376, 920, 7), (1377, 920, 91), (1378, 921, 82), (1379, 922, 92), (1380, 922, 12), (1381, 923, 2), (1382, 923, 49), (1383, 924, 51), (1384, 924, 13), (1385, 925, 95), (1386, 925, 8), (1387, 926, 54), (1388, 926, 75), (1389, 927, 45), (1390, 927, 100), (1391, 928, 17), (1392, 929, 27), (1393, 930, 42), (1394, 930, 15), (1395, 931, 19), (1396, 931, 92), (1397, 932, 57), (1398, 933, 44), (1399, 934, 40), (1400, 935, 87), (1401, 936, 82), (1402, 937, 50), (1403, 938, 41), (1404, 939, 57), (1405, 939, 81), (1406, 940, 87), (1407, 941, 73), (1408, 942, 37), (1409, 943, 55), (1410, 943, 57), (1411, 944, 45), (1412, 945, 8), (1413, 945, 4), (1414, 946, 11), (1415, 946, 17), (1416, 947, 61), (1417, 947, 78), (1418, 948, 51), (1419, 949, 36), (1420, 949, 85), (1421, 950, 49), (1422, 951, 59), (1423, 951, 52), (1424, 952, 7), (1425, 953, 57), (1426, 954, 52), (1427, 955, 93), (1428, 956, 96), (1429, 957, 45), (1430, 958, 72), (1431, 958, 16), (1432, 959, 60), (1433, 960, 56), (1434, 960, 31), (1435, 961, 34), (1436, 962, 96), (1437, 963, 3), (1438, 963, 46), (1439, 964, 99), (1440, 964, 83), (1441, 965, 103), (1442, 965, 50), (1443, 966, 66), (1444, 967, 82), (1445, 967, 7), (1446, 968, 14), (1447, 968, 96), (1448, 969, 63), (1449, 969, 72), (1450, 970, 35), (1451, 970, 56), (1452, 971, 50), (1453, 971, 4), (1454, 972, 66), (1455, 973, 75), (1456, 973, 21), (1457, 974, 50), (1458, 975, 10), (1459, 975, 8), (1460, 976, 57), (1461, 976, 2), (1462, 977, 21), (1463, 978, 25), (1464, 978, 31), (1465, 979, 46), (1466, 979, 22), (1467, 980, 77), (1468, 981, 37), (1469, 982, 93), (1470, 983, 83), (1471, 984, 79), (1472, 985, 35), (1473, 986, 77), (1474, 987, 9), (1475, 988, 67), (1476, 988, 59), (1477, 989, 18), (1478, 989, 87), (1479, 990, 89), (1480, 990, 90), (1481, 991, 56), (1482, 992, 12), (1483, 992, 88), (1484, 993, 90), (1485, 994, 72), (1486, 994, 38), (1487, 995, 67), (1488, 996, 33), (1489, 997, 18), (1490, 997, 49), (1491, 998, 33), (1492, 998, 59), (1493, 999, 102), (1494, 999, 80), (1495, 1000, 34), (1496, 1001, 87), (1497, 1001, 38), (1498, 1002, 60), (1499, 1003, 77), (1500, 1004, 28), (1501, 1004, 91), (1502, 1005, 96), (1503, 1005, 99), (1504, 1006, 76);
+INSERT INTO livestream_tags (id, livestream_id, tag_id) VALUES (1505, 1007, 76), (1506, 1007, 71), (1507, 1008, 32), (1508, 1009, 83), (1509, 1010, 97), (1510, 1011, 5), (1511, 1011, 93), (1512, 1012, 42), (1513, 1012, 57), (1514, 1013, 75), (1515, 1013, 2), (1516, 1014, 38), (1517, 1014, 11), (1518, 1015, 5), (1519, 1015, 86), (1520, 1016, 53), (1521, 1016, 99), (1522, 1017, 50), (1523, 1017, 61), (1524, 1018, 48), (1525, 1018, 18), (1526, 1019, 16), (1527, 1020, 83), (1528, 1020, 94), (1529, 1021, 103), (1530, 1022, 46), (1531, 1022, 18), (1532, 1023, 77), (1533, 1024, 67), (1534, 1024, 16), (1535, 1025, 102), (1536, 1026, 87), (1537, 1027, 85), (1538, 1027, 27), (1539, 1028, 15), (1540, 1029, 24), (1541, 1029, 53), (1542, 1030, 66), (1543, 1030, 36), (1544, 1031, 51), (1545, 1031, 30), (1546, 1032, 58), (1547, 1032, 33), (1548, 1033, 27), (1549, 1034, 47), (1550, 1034, 37), (1551, 1035, 98), (1552, 1036, 70), (1553, 1036, 75), (1554, 1037, 22), (1555, 1038, 10), (1556, 1039, 65), (1557, 1040, 51), (1558, 1041, 95), (1559, 1042, 65), (1560, 1042, 71), (1561, 1043, 39), (1562, 1043, 36), (1563, 1044, 75), (1564, 1044, 2), (1565, 1045, 89), (1566, 1045, 57), (1567, 1046, 98), (1568, 1046, 22), (1569, 1047, 13), (1570, 1048, 4), (1571, 1048, 10), (1572, 1049, 99), (1573, 1050, 78), (1574, 1051, 60), (1575, 1052, 92), (1576, 1053, 47), (1577, 1053, 55), (1578, 1054, 14), (1579, 1054, 57), (1580, 1055, 58), (1581, 1056, 47), (1582, 1056, 30), (1583, 1057, 71), (1584, 1058, 9), (1585, 1059, 29), (1586, 1060, 74), (1587, 1060, 40), (1588, 1061, 18), (1589, 1061, 71), (1590, 1062, 75), (1591, 1062, 16), (1592, 1063, 96), (1593, 1063, 27), (1594, 1064, 41), (1595, 1065, 54), (1596, 1066, 72), (1597, 1066, 47), (1598, 1067, 91), (1599, 1067, 94), (1600, 1068, 94), (1601, 1068, 71), (1602, 1069, 52), (1603, 1069, 84), (1604, 1070, 11), (1605, 1070, 44), (1606, 1071, 79), (1607, 1072, 46), (1608, 1073, 91), (1609, 1073, 75), (1610, 1074, 30), (1611, 1074, 44), (1612, 1075, 37), (1613, 1076, 1), (1614, 1077, 50), (1615, 1077, 54), (1616, 1078, 61), (1617, 1078, 79), (1618, 1079, 89), (1619, 1080, 48), (1620, 1081, 92), (1621, 1082, 19), (1622, 1082, 99), (1623, 1083, 26), (1624, 1083, 43), (1625, 1084, 48), (1626, 1085, 41), (1627, 1086, 93), (1628, 1087, 11), (1629, 1087, 92), (1630, 1088, 97), (1631, 1089, 99), (1632, 1090, 18), (1633, 1090, 3), (1634, 1091, 35), (1635, 1092, 70), (1636, 1093, 97), (1637, 1093, 33), (1638, 1094, 8), (1639, 1094, 94), (1640, 1095, 97), (1641, 1096, 1), (1642, 1097, 42), (1643, 1097, 47), (1644, 1098, 74), (1645, 1098, 78), (1646, 1099, 49), (1647, 1100, 7), (1648, 1101, 41), (1649, 1101, 24), (1650, 1102, 84), (1651, 1102, 31), (1652, 1103, 34), (1653, 1104, 53), (1654, 1105, 66), (1655, 1106, 11), (1656, 1106, 38), (1657, 1107, 27), (1658, 1108, 26), (1659, 1109, 76), (1660, 1110, 38), (1661, 1110, 73), (1662, 1111, 65), (1663, 1112, 90), (1664, 1113, 30), (1665, 1113, 21), (1666, 1114, 27), (1667, 1114, 10), (1668, 1115, 50), (1669, 1116, 96), (1670, 1117, 82), (1671, 1117, 21), (1672, 1118, 15), (1673, 1119, 101), (1674, 1119, 23), (1675, 1120, 68), (1676, 1120, 41), (1677, 1121, 41), (1678, 1121, 85), (1679, 1122, 51), (1680, 1123, 53), (1681, 1124, 44), (1682, 1124, 78), (1683, 1125, 40), (1684, 1126, 58), (1685, 1126, 60), (1686, 1127, 102), (1687, 1128, 4), (1688, 1129, 44), (1689, 1130, 57), (1690, 1130, 51), (1691, 1131, 100), (1692, 1132, 46), (1693, 1133, 88), (1694, 1134, 76), (1695, 1134, 26), (1696, 1135, 54), (1697, 1136, 27), (1698, 1137, 71), (1699, 1137, 6), (1700, 1138, 101), (1701, 1139, 102), (1702, 1140, 7), (1703, 1141, 53), (1704, 1141, 73), (1705, 1142, 84), (1706, 1142, 21), (1707, 1143, 81), (1708, 1143, 92), (1709, 1144, 2), (1710, 1145, 30), (1711, 1146, 69), (1712, 1146, 39), (1713, 1147, 20), (1714, 1147, 56), (1715, 1148, 60), (1716, 1148, 85), (1717, 1149, 25), (1718, 1149, 80), (1719, 1150, 64), (1720, 1151, 89), (1721, 1152, 59), (1722, 1153, 11), (1723, 1153, 34), (1724, 1154, 39), (1725, 1154, 86), (1726, 1155, 26), (1727, 1156, 75), (1728, 1157, 21), (1729, 1157, 62), (1730, 1158, 15), (1731, 1158, 28), (1732, 1159, 59), (1733, 1159, 11), (1734, 1160, 9), (1735, 1161, 50), (1736, 1162, 20), (1737, 1162, 78), (1738, 1163, 41), (1739, 1163, 36), (1740, 1164, 12), (1741, 1165, 56), (1742, 1166, 85), (1743, 1167, 74), (1744, 1168, 10), (1745, 1168, 25), (1746, 1169, 2), (1747, 1169, 78), (1748, 1170, 69), (1749, 1170, 101), (1750, 1171, 26), (1751, 1171, 98), (1752, 1172, 100), (1753, 1172, 96), (1754, 1173, 30), (1755, 1174, 101), (1756, 1174, 20), (1757, 1175, 1), (1758, 1175, 31), (1759, 1176, 30), (1760, 1176, 98), (1761, 1177, 1), (1762, 1178, 43), (1763, 1178, 30), (1764, 1179, 80), (1765, 1180, 14), (1766, 1181, 35), (1767, 1182, 47), (1768, 1183, 51), (1769, 1183, 56), (1770, 1184, 35), (1771, 1184, 67), (1772, 1185, 41), (1773, 1185, 14), (1774, 1186, 37), (1775, 1187, 24), (1776, 1188, 83), (1777, 1188, 67), (1778, 1189, 22), (1779, 1190, 25), (1780, 1191, 92), (1781, 1191, 97), (1782, 1192, 79), (1783, 1193, 11), (1784, 1194, 47), (1785, 1195, 52), (1786, 1195, 12), (1787, 1196, 52), (1788, 1197, 24), (1789, 1198, 3), (1790, 1199, 99), (1791, 1199, 60), (1792, 1200, 25), (1793, 1200, 32), (1794, 1201, 35), (1795, 1202, 65), (1796, 1203, 58), (1797, 1203, 4), (1798, 1204, 34), (1799, 1205, 72), (1800, 1205, 63), (1801, 1206, 16), (1802, 1206, 40), (1803, 1207, 59), (1804, 1207, 77), (1805, 1208, 46), (1806, 1209, 32), (1807, 1209, 79), (1808, 1210, 21), (1809, 1210, 43), (1810, 1211, 20), (1811, 1211, 41), (1812, 1212, 46), (1813, 1213, 17), (1814, 1214, 51), (1815, 1215, 35), (1816, 1215, 57), (1817, 1216, 95), (1818, 1217, 23), (1819, 1218, 93), (1820, 1219, 77), (1821, 1220, 18), (1822, 1220, 45), (1823, 1221, 82), (1824, 1221, 29), (1825, 1222, 53), (1826, 1222, 14), (1827, 1223, 81), (1828, 1223, 16), (1829, 1224, 48), (1830, 1224, 50), (1831, 1225, 80), (1832, 1226, 71), (1833, 1226, 40), (1834, 1227, 78), (1835, 1227, 36), (1836, 1228, 37), (1837, 1229, 6), (1838, 1230, 95), (1839, 1230, 6), (1840, 1231, 58), (1841, 1232, 18), (1842, 1232, 93), (1843, 1233, 21), (1844, 1234, 89), (1845, 1235, 92), (1846, 1236, 32), (1847, 1237, 94), (1848, 1237, 22), (1849, 1238, 73), (1850, 1239, 22), (1851, 1239, 41), (1852, 1240, 59), (1853, 1240, 56), (1854, 1241, 62), (1855, 1241, 16), (1856, 1242, 3), (1857, 1243, 32), (1858, 1243, 7), (1859, 1244, 96), (1860, 1245, 59), (1861, 1246, 13), (1862, 1247, 44), (1863, 1247, 59), (1864, 1248, 70), (1865, 1249, 97), (1866, 1249, 65), (1867, 1250, 34), (1868, 1250, 87), (1869, 1251, 59), (1870, 1252, 4), (1871, 1252, 74), (1872, 1253, 17), (1873, 1253, 29), (1874, 1254, 55), (1875, 1255, 19), (1876, 1256, 86), (1877, 1257, 58), (1878, 1257, 103), (1879, 1258, 93), (1880, 1259, 59), (1881, 1260, 36), (1882, 1260, 100), (1883, 1261, 91), (1884, 1261, 22), (1885, 1262, 103), (1886, 1263, 64), (1887, 1263, 30), (1888, 1264, 30), (1889, 1264, 5), (1890, 1265, 69), (1891, 1266, 21), (1892, 1267, 83), (1893, 1267, 50), (1894, 1268, 87), (1895, 1268, 97), (1896, 1269, 36), (1897, 1269, 32), (1898, 1270, 70), (1899, 1271, 40), (1900, 1272, 17), (1901, 1273, 98), (1902, 1274, 8), (1903, 1274, 17), (1904, 1275, 38), (1905, 1275, 90), (1906, 1276, 37), (1907, 1277, 16), (1908, 1277, 103), (1909, 1278, 45), (1910, 1278, 81), (1911, 1279, 62), (1912, 1280, 55), (1913, 1280, 71), (1914, 1281, 69), (1915, 1282, 7), (1916, 1282, 94), (1917, 1283, 84), (1918, 1284, 33), (1919, 1284, 7), (1920, 1285, 57), (1921, 1285, 56), (1922, 1286, 101), (1923, 1286, 86), (1924, 1287, 85), (1925, 1288, 100), (1926, 1289, 66), (1927, 1290, 89), (1928, 1290, 33), (1929, 1291, 57), (1930, 1291, 53), (1931, 1292, 91), (1932, 1292, 66), (1933, 1293, 64), (1934, 1294, 23), (1935, 1294, 52), (1936, 1295, 10), (1937, 1295, 98), (1938, 1296, 13), (1939, 1297, 21), (1940, 1297, 27), (1941, 1298, 15), (1942, 1298, 30), (1943, 1299, 73), (1944, 1300, 78), (1945, 1301, 76), (1946, 1301, 26), (1947, 1302, 4), (1948, 1303, 34), (1949, 1303, 93), (1950, 1304, 2), (1951, 1305, 72), (1952, 1305, 1), (1953, 1306, 31), (1954, 1306, 46), (1955, 1307, 43), (1956, 1307, 77), (1957, 1308, 55), (1958, 1308, 90), (1959, 1309, 63), (1960, 1310, 86), (1961, 1310, 39), (1962, 1311, 28), (1963, 1311, 54), (1964, 1312, 78), (1965, 1312, 40), (1966, 1313, 84), (1967, 1314, 60), (1968, 1315, 22), (1969, 1315, 10), (1970, 1316, 24), (1971, 1317, 87), (1972, 1317, 1), (1973, 1318, 44), (1974, 1319, 39), (1975, 1319, 88), (1976, 1320, 31), (1977, 1320, 70), (1978, 1321, 8), (1979, 1322, 25), (1980, 1322, 45), (1981, 1323, 100), (1982, 1323, 86), (1983, 1324, 93), (1984, 1325, 9), (1985, 1325, 47), (1986, 1326, 56), (1987, 1327, 55), (1988, 1327, 82), (1989, 1328, 20), (1990, 1328, 67), (1991, 1329, 68), (1992, 1330, 44), (1993, 1331, 75), (1994, 1332, 7), (1995, 1332, 96), (1996, 1333, 71), (1997, 1333, 38), (1998, 1334, 18), (1999, 1334, 13), (2000, 1335, 22), (2001, 1335, 57), (2002, 1336, 17), (2003, 1336, 98), (2004, 1337, 7), (2005, 1338, 41);
+INSERT INTO livestream_tags (id, livestream_id, tag_id) VALUES (2006, 1339, 87), (2007, 1340, 48), (2008, 1341, 63), (2009, 1342, 63), (2010, 1342, 53), (2011, 1343, 21), (2012, 1343, 30), (2013, 1344, 61), (2014, 1345, 22), (2015, 1345, 99), (2016, 1346, 70), (2017, 1347, 70), (2018, 1347, 46), (2019, 1348, 59), (2020, 1349, 50), (2021, 1349, 53), (2022, 1350, 46), (2023, 1350, 12), (2024, 1351, 25), (2025, 1351, 74), (2026, 1352, 67), (2027, 1353, 49), (2028, 1354, 49), (2029, 1354, 5), (2030, 1355, 51), (2031, 1356, 95), (2032, 1357, 28), (2033, 1357, 98), (2034, 1358, 72), (2035, 1359, 34), (2036, 1359, 30), (2037, 1360, 82), (2038, 1361, 98), (2039, 1361, 27), (2040, 1362, 57), (2041, 1362, 39), (2042, 1363, 77), (2043, 1364, 12), (2044, 1365, 10), (2045, 1365, 81), (2046, 1366, 87), (2047, 1366, 90), (2048, 1367, 27), (2049, 1367, 64), (2050, 1368, 31), (2051, 1368, 48), (2052, 1369, 68), (2053, 1369, 11), (2054, 1370, 19), (2055, 1371, 88), (2056, 1371, 100), (2057, 1372, 56), (2058, 1373, 103), (2059, 1373, 1), (2060, 1374, 71), (2061, 1374, 102), (2062, 1375, 68), (2063, 1376, 25), (2064, 1376, 102), (2065, 1377, 93), (2066, 1377, 39), (2067, 1378, 35), (2068, 1379, 25), (2069, 1380, 28), (2070, 1380, 9), (2071, 1381, 23), (2072, 1381, 29), (2073, 1382, 92), (2074, 1383, 21), (2075, 1384, 45), (2076, 1384, 58), (2077, 1385, 15), (2078, 1385, 48), (2079, 1386, 57), (2080, 1386, 42), (2081, 1387, 22), (2082, 1387, 33), (2083, 1388, 91), (2084, 1388, 100), (2085, 1389, 78), (2086, 1390, 23), (2087, 1391, 1), (2088, 1391, 45), (2089, 1392, 72), (2090, 1392, 4), (2091, 1393, 69), (2092, 1393, 60), (2093, 1394, 40), (2094, 1395, 86), (2095, 1396, 55), (2096, 1396, 35), (2097, 1397, 86), (2098, 1398, 90), (2099, 1399, 53), (2100, 1399, 69), (2101, 1400, 40), (2102, 1401, 21), (2103, 1402, 68), (2104, 1402, 74), (2105, 1403, 46), (2106, 1404, 34), (2107, 1404, 77), (2108, 1405, 31), (2109, 1405, 58), (2110, 1406, 68), (2111, 1407, 92), (2112, 1408, 82), (2113, 1409, 81), (2114, 1409, 101), (2115, 1410, 83), (2116, 1411, 54), (2117, 1411, 5), (2118, 1412, 69), (2119, 1412, 101), (2120, 1413, 45), (2121, 1414, 75), (2122, 1414, 19), (2123, 1415, 7), (2124, 1415, 27), (2125, 1416, 4), (2126, 1416, 73), (2127, 1417, 65), (2128, 1418, 29), (2129, 1418, 85), (2130, 1419, 19), (2131, 1419, 23), (2132, 1420, 69), (2133, 1420, 43), (2134, 1421, 58), (2135, 1421, 81), (2136, 1422, 79), (2137, 1422, 6), (2138, 1423, 79), (2139, 1424, 79), (2140, 1424, 69), (2141, 1425, 69), (2142, 1426, 51), (2143, 1427, 74), (2144, 1428, 87), (2145, 1429, 45), (2146, 1430, 35), (2147, 1430, 80), (2148, 1431, 76), (2149, 1432, 37), (2150, 1432, 74), (2151, 1433, 2), (2152, 1433, 40), (2153, 1434, 1), (2154, 1435, 71), (2155, 1435, 27), (2156, 1436, 37), (2157, 1436, 47), (2158, 1437, 97), (2159, 1438, 103), (2160, 1438, 82), (2161, 1439, 82), (2162, 1439, 69), (2163, 1440, 102), (2164, 1440, 8), (2165, 1441, 35), (2166, 1441, 96), (2167, 1442, 2), (2168, 1442, 48), (2169, 1443, 88), (2170, 1443, 71), (2171, 1444, 78), (2172, 1445, 34), (2173, 1446, 30), (2174, 1446, 79), (2175, 1447, 96), (2176, 1448, 103), (2177, 1448, 48), (2178, 1449, 74), (2179, 1450, 93), (2180, 1451, 64), (2181, 1452, 21), (2182, 1452, 99), (2183, 1453, 83), (2184, 1454, 80), (2185, 1454, 74), (2186, 1455, 44), (2187, 1455, 92), (2188, 1456, 46), (2189, 1456, 13), (2190, 1457, 56), (2191, 1458, 96), (2192, 1458, 87), (2193, 1459, 53), (2194, 1459, 87), (2195, 1460, 76), (2196, 1460, 41), (2197, 1461, 71), (2198, 1461, 30), (2199, 1462, 37), (2200, 1462, 93), (2201, 1463, 31), (2202, 1464, 25), (2203, 1464, 33), (2204, 1465, 90), (2205, 1465, 62), (2206, 1466, 86), (2207, 1467, 45), (2208, 1468, 4), (2209, 1468, 10), (2210, 1469, 64), (2211, 1470, 2), (2212, 1471, 70), (2213, 1472, 72), (2214, 1473, 14), (2215, 1473, 38), (2216, 1474, 88), (2217, 1474, 87), (2218, 1475, 43), (2219, 1476, 45), (2220, 1476, 24), (2221, 1477, 31), (2222, 1477, 50), (2223, 1478, 88), (2224, 1479, 96), (2225, 1479, 47), (2226, 1480, 61), (2227, 1481, 14), (2228, 1481, 68), (2229, 1482, 101), (2230, 1483, 1), (2231, 1484, 90), (2232, 1485, 87), (2233, 1486, 97), (2234, 1487, 26), (2235, 1487, 46), (2236, 1488, 16), (2237, 1488, 28), (2238, 1489, 69), (2239, 1490, 53), (2240, 1490, 82), (2241, 1491, 45), (2242, 1492, 2), (2243, 1492, 9), (2244, 1493, 39), (2245, 1493, 88), (2246, 1494, 80), (2247, 1495, 84), (2248, 1496, 71), (2249, 1497, 34), (2250, 1498, 47), (2251, 1498, 14), (2252, 1499, 78), (2253, 1499, 42), (2254, 1500, 50), (2255, 1501, 21), (2256, 1502, 23), (2257, 1502, 40), (2258, 1503, 39), (2259, 1503, 48), (2260, 1504, 103), (2261, 1504, 53), (2262, 1505, 4), (2263, 1506, 75), (2264, 1506, 97), (2265, 1507, 81), (2266, 1507, 35), (2267, 1508, 71), (2268, 1509, 44), (2269, 1510, 96), (2270, 1510, 58), (2271, 1511, 73), (2272, 1512, 37), (2273, 1512, 80), (2274, 1513, 99), (2275, 1514, 63), (2276, 1515, 36), (2277, 1516, 77), (2278, 1517, 29), (2279, 1517, 61), (2280, 1518, 57), (2281, 1519, 96), (2282, 1519, 58), (2283, 1520, 48), (2284, 1521, 95), (2285, 1522, 25), (2286, 1523, 60), (2287, 1524, 44), (2288, 1524, 24), (2289, 1525, 75), (2290, 1525, 44), (2291, 1526, 97), (2292, 1526, 60), (2293, 1527, 75), (2294, 1527, 101), (2295, 1528, 17), (2296, 1528, 25), (2297, 1529, 29), (2298, 1530, 11), (2299, 1530, 3), (2300, 1531, 81), (2301, 1531, 44), (2302, 1532, 46), (2303, 1533, 75), (2304, 1534, 78), (2305, 1534, 100), (2306, 1535, 59), (2307, 1535, 78), (2308, 1536, 93), (2309, 1537, 82), (2310, 1537, 15), (2311, 1538, 40), (2312, 1539, 77), (2313, 1539, 38), (2314, 1540, 73), (2315, 1540, 56), (2316, 1541, 95), (2317, 1542, 71), (2318, 1543, 56), (2319, 1544, 33), (2320, 1544, 44), (2321, 1545, 17), (2322, 1545, 67), (2323, 1546, 13), (2324, 1547, 5), (2325, 1547, 103), (2326, 1548, 94), (2327, 1548, 86), (2328, 1549, 83), (2329, 1549, 82), (2330, 1550, 87), (2331, 1550, 45), (2332, 1551, 103), (2333, 1551, 39), (2334, 1552, 1), (2335, 1552, 32), (2336, 1553, 64), (2337, 1554, 37), (2338, 1554, 98), (2339, 1555, 39), (2340, 1555, 53), (2341, 1556, 57), (2342, 1557, 74), (2343, 1558, 94), (2344, 1559, 46), (2345, 1560, 1), (2346, 1560, 66), (2347, 1561, 55), (2348, 1562, 18), (2349, 1562, 60), (2350, 1563, 66), (2351, 1563, 90), (2352, 1564, 94), (2353, 1565, 15), (2354, 1566, 19), (2355, 1567, 20), (2356, 1568, 13), (2357, 1569, 46), (2358, 1569, 42), (2359, 1570, 78), (2360, 1570, 45), (2361, 1571, 11), (2362, 1572, 68), (2363, 1573, 12), (2364, 1573, 2), (2365, 1574, 84), (2366, 1575, 24), (2367, 1575, 3), (2368, 1576, 87), (2369, 1576, 9), (2370, 1577, 88), (2371, 1577, 61), (2372, 1578, 97), (2373, 1579, 101), (2374, 1580, 75), (2375, 1580, 4), (2376, 1581, 5), (2377, 1582, 23), (2378, 1583, 51), (2379, 1583, 3), (2380, 1584, 91), (2381, 1585, 73), (2382, 1586, 33), (2383, 1586, 92), (2384, 1587, 49), (2385, 1587, 87), (2386, 1588, 99), (2387, 1589, 83), (2388, 1589, 68), (2389, 1590, 85), (2390, 1590, 3), (2391, 1591, 38), (2392, 1591, 19), (2393, 1592, 43), (2394, 1592, 8), (2395, 1593, 37), (2396, 1594, 1), (2397, 1595, 98), (2398, 1596, 65), (2399, 1596, 76), (2400, 1597, 22), (2401, 1597, 102), (2402, 1598, 61), (2403, 1599, 18), (2404, 1600, 19), (2405, 1600, 2), (2406, 1601, 79), (2407, 1602, 96), (2408, 1602, 57), (2409, 1603, 24), (2410, 1603, 49), (2411, 1604, 14), (2412, 1605, 99), (2413, 1606, 65), (2414, 1607, 71), (2415, 1608, 52), (2416, 1608, 60), (2417, 1609, 64), (2418, 1609, 67), (2419, 1610, 11), (2420, 1611, 84), (2421, 1611, 93), (2422, 1612, 67), (2423, 1612, 73), (2424, 1613, 12), (2425, 1613, 32), (2426, 1614, 14), (2427, 1615, 97), (2428, 1616, 24), (2429, 1616, 82), (2430, 1617, 37), (2431, 1617, 51), (2432, 1618, 15), (2433, 1619, 91), (2434, 1619, 96), (2435, 1620, 98), (2436, 1620, 50), (2437, 1621, 62), (2438, 1622, 39), (2439, 1622, 8), (2440, 1623, 64), (2441, 1623, 9), (2442, 1624, 17), (2443, 1625, 46), (2444, 1626, 43), (2445, 1626, 102), (2446, 1627, 7), (2447, 1627, 70), (2448, 1628, 6), (2449, 1628, 31), (2450, 1629, 3), (2451, 1630, 96), (2452, 1630, 72), (2453, 1631, 17), (2454, 1631, 91), (2455, 1632, 102), (2456, 1633, 5), (2457, 1633, 26), (2458, 1634, 40), (2459, 1634, 101), (2460, 1635, 81), (2461, 1636, 9), (2462, 1636, 26), (2463, 1637, 51), (2464, 1637, 83), (2465, 1638, 72), (2466, 1638, 43), (2467, 1639, 13), (2468, 1639, 11), (2469, 1640, 21), (2470, 1640, 15), (2471, 1641, 22), (2472, 1641, 65), (2473, 1642, 74), (2474, 1642, 5), (2475, 1643, 75), (2476, 1644, 35), (2477, 1645, 50), (2478, 1646, 82), (2479, 1646, 26), (2480, 1647, 81), (2481, 1647, 25), (2482, 1648, 49), (2483, 1649, 81), (2484, 1649, 23), (2485, 1650, 92), (2486, 1651, 37), (2487, 1651, 91), (2488, 1652, 77), (2489, 1652, 56), (2490, 1653, 91), (2491, 1653, 9), (2492, 1654, 97), (2493, 1654, 54), (2494, 1655, 100), (2495, 1655, 42), (2496, 1656, 51), (2497, 1656, 2), (2498, 1657, 86), (2499, 1657, 77), (2500, 1658, 77), (2501, 1659, 86), (2502, 1659, 73), (2503, 1660, 2), (2504, 1661, 47), (2505, 1661, 7), (2506, 1662, 28), (2507, 1662, 83);
+INSERT INTO livestream_tags (id, livestream_id, tag_id) VALUES (2508, 1663, 35), (2509, 1663, 66), (2510, 1664, 14), (2511, 1664, 62), (2512, 1665, 56), (2513, 1666, 28), (2514, 1666, 69), (2515, 1667, 60), (2516, 1668, 96), (2517, 1668, 10), (2518, 1669, 38), (2519, 1669, 15), (2520, 1670, 22), (2521, 1671, 87), (2522, 1671, 79), (2523, 1672, 41), (2524, 1672, 59), (2525, 1673, 74), (2526, 1673, 19), (2527, 1674, 9), (2528, 1674, 7), (2529, 1675, 19), (2530, 1676, 49), (2531, 1676, 50), (2532, 1677, 59), (2533, 1677, 68), (2534, 1678, 85), (2535, 1678, 60), (2536, 1679, 68), (2537, 1679, 2), (2538, 1680, 17), (2539, 1680, 70), (2540, 1681, 27), (2541, 1681, 102), (2542, 1682, 40), (2543, 1683, 5), (2544, 1683, 92), (2545, 1684, 36), (2546, 1684, 70), (2547, 1685, 81), (2548, 1686, 45), (2549, 1686, 50), (2550, 1687, 7), (2551, 1687, 52), (2552, 1688, 90), (2553, 1688, 82), (2554, 1689, 53), (2555, 1689, 87), (2556, 1690, 76), (2557, 1690, 8), (2558, 1691, 40), (2559, 1691, 69), (2560, 1692, 50), (2561, 1693, 51), (2562, 1694, 6), (2563, 1695, 93), (2564, 1696, 11), (2565, 1696, 15), (2566, 1697, 79), (2567, 1697, 76), (2568, 1698, 94), (2569, 1698, 49), (2570, 1699, 82), (2571, 1699, 19), (2572, 1700, 89), (2573, 1701, 51), (2574, 1702, 76), (2575, 1702, 44), (2576, 1703, 56), (2577, 1704, 46), (2578, 1704, 52), (2579, 1705, 40), (2580, 1705, 90), (2581, 1706, 48), (2582, 1707, 12), (2583, 1708, 69), (2584, 1709, 19), (2585, 1710, 61), (2586, 1710, 2), (2587, 1711, 15), (2588, 1711, 68), (2589, 1712, 12), (2590, 1712, 48), (2591, 1713, 7), (2592, 1713, 10), (2593, 1714, 16), (2594, 1714, 89), (2595, 1715, 86), (2596, 1715, 8), (2597, 1716, 26), (2598, 1717, 25), (2599, 1717, 16), (2600, 1718, 66), (2601, 1719, 26), (2602, 1719, 77), (2603, 1720, 61), (2604, 1721, 99), (2605, 1722, 70), (2606, 1723, 77), (2607, 1723, 42), (2608, 1724, 81), (2609, 1725, 7), (2610, 1725, 42), (2611, 1726, 25), (2612, 1727, 97), (2613, 1727, 44), (2614, 1728, 29), (2615, 1728, 21), (2616, 1729, 103), (2617, 1729, 62), (2618, 1730, 31), (2619, 1730, 75), (2620, 1731, 58), (2621, 1732, 29), (2622, 1733, 29), (2623, 1733, 66), (2624, 1734, 86), (2625, 1734, 76), (2626, 1735, 85), (2627, 1736, 94), (2628, 1737, 97), (2629, 1738, 38), (2630, 1738, 88), (2631, 1739, 81), (2632, 1739, 45), (2633, 1740, 46), (2634, 1741, 44), (2635, 1741, 48), (2636, 1742, 3), (2637, 1742, 94), (2638, 1743, 39), (2639, 1743, 74), (2640, 1744, 36), (2641, 1745, 36), (2642, 1746, 88), (2643, 1747, 92), (2644, 1747, 88), (2645, 1748, 24), (2646, 1749, 23), (2647, 1749, 71), (2648, 1750, 1), (2649, 1751, 58), (2650, 1751, 31), (2651, 1752, 79), (2652, 1752, 80), (2653, 1753, 91), (2654, 1753, 3), (2655, 1754, 48), (2656, 1754, 76), (2657, 1755, 9), (2658, 1755, 28), (2659, 1756, 81), (2660, 1757, 59), (2661, 1757, 30), (2662, 1758, 42), (2663, 1758, 10), (2664, 1759, 24), (2665, 1759, 72), (2666, 1760, 75), (2667, 1760, 34), (2668, 1761, 80), (2669, 1761, 28), (2670, 1762, 73), (2671, 1762, 55), (2672, 1763, 70), (2673, 1764, 73), (2674, 1764, 78), (2675, 1765, 91), (2676, 1765, 38), (2677, 1766, 103), (2678, 1766, 60), (2679, 1767, 12), (2680, 1768, 40), (2681, 1768, 61), (2682, 1769, 72), (2683, 1769, 87), (2684, 1770, 38), (2685, 1771, 63), (2686, 1771, 5), (2687, 1772, 24), (2688, 1773, 35), (2689, 1774, 90), (2690, 1774, 56), (2691, 1775, 19), (2692, 1776, 79), (2693, 1777, 23), (2694, 1778, 35), (2695, 1778, 41), (2696, 1779, 56), (2697, 1780, 16), (2698, 1780, 8), (2699, 1781, 50), (2700, 1781, 15), (2701, 1782, 54), (2702, 1782, 101), (2703, 1783, 11), (2704, 1783, 99), (2705, 1784, 5), (2706, 1784, 2), (2707, 1785, 93), (2708, 1786, 39), (2709, 1786, 78), (2710, 1787, 35), (2711, 1787, 66), (2712, 1788, 100), (2713, 1789, 24), (2714, 1790, 33), (2715, 1790, 10), (2716, 1791, 59), (2717, 1791, 74), (2718, 1792, 92), (2719, 1793, 66), (2720, 1794, 3), (2721, 1795, 46), (2722, 1795, 34), (2723, 1796, 20), (2724, 1796, 92), (2725, 1797, 77), (2726, 1798, 3), (2727, 1799, 42), (2728, 1799, 84), (2729, 1800, 1), (2730, 1800, 55), (2731, 1801, 103), (2732, 1801, 12), (2733, 1802, 87), (2734, 1802, 100), (2735, 1803, 55), (2736, 1804, 9), (2737, 1804, 22), (2738, 1805, 46), (2739, 1805, 23), (2740, 1806, 76), (2741, 1806, 36), (2742, 1807, 89), (2743, 1807, 79), (2744, 1808, 88), (2745, 1809, 78), (2746, 1810, 103), (2747, 1810, 4), (2748, 1811, 58), (2749, 1811, 13), (2750, 1812, 12), (2751, 1812, 38), (2752, 1813, 14), (2753, 1813, 28), (2754, 1814, 84), (2755, 1815, 85), (2756, 1815, 54), (2757, 1816, 17), (2758, 1816, 15), (2759, 1817, 41), (2760, 1817, 20), (2761, 1818, 17), (2762, 1818, 89), (2763, 1819, 23), (2764, 1819, 73), (2765, 1820, 31), (2766, 1820, 67), (2767, 1821, 45), (2768, 1822, 2), (2769, 1822, 74), (2770, 1823, 15), (2771, 1823, 90), (2772, 1824, 90), (2773, 1825, 73), (2774, 1825, 49), (2775, 1826, 64), (2776, 1827, 32), (2777, 1827, 40), (2778, 1828, 49), (2779, 1828, 56), (2780, 1829, 39), (2781, 1830, 1), (2782, 1830, 100), (2783, 1831, 86), (2784, 1832, 61), (2785, 1833, 39), (2786, 1834, 61), (2787, 1835, 46), (2788, 1836, 6), (2789, 1836, 84), (2790, 1837, 50), (2791, 1838, 23), (2792, 1839, 33), (2793, 1840, 14), (2794, 1840, 96), (2795, 1841, 81), (2796, 1841, 72), (2797, 1842, 27), (2798, 1842, 45), (2799, 1843, 67), (2800, 1843, 71), (2801, 1844, 53), (2802, 1845, 40), (2803, 1845, 50), (2804, 1846, 98), (2805, 1847, 101), (2806, 1848, 12), (2807, 1848, 89), (2808, 1849, 81), (2809, 1850, 53), (2810, 1850, 17), (2811, 1851, 27), (2812, 1852, 11), (2813, 1853, 95), (2814, 1854, 81), (2815, 1854, 61), (2816, 1855, 15), (2817, 1856, 23), (2818, 1857, 7), (2819, 1858, 17), (2820, 1859, 78), (2821, 1859, 100), (2822, 1860, 56), (2823, 1860, 73), (2824, 1861, 63), (2825, 1861, 45), (2826, 1862, 32), (2827, 1863, 11), (2828, 1863, 57), (2829, 1864, 69), (2830, 1865, 88), (2831, 1866, 97), (2832, 1866, 76), (2833, 1867, 53), (2834, 1868, 73), (2835, 1869, 96), (2836, 1869, 55), (2837, 1870, 32), (2838, 1870, 57), (2839, 1871, 20), (2840, 1872, 95), (2841, 1872, 47), (2842, 1873, 1), (2843, 1873, 93), (2844, 1874, 68), (2845, 1874, 99), (2846, 1875, 2), (2847, 1875, 92), (2848, 1876, 65), (2849, 1876, 62), (2850, 1877, 90), (2851, 1877, 8), (2852, 1878, 92), (2853, 1878, 70), (2854, 1879, 67), (2855, 1880, 88), (2856, 1881, 68), (2857, 1882, 8), (2858, 1882, 61), (2859, 1883, 69), (2860, 1884, 69), (2861, 1885, 101), (2862, 1885, 73), (2863, 1886, 82), (2864, 1887, 91), (2865, 1887, 79), (2866, 1888, 7), (2867, 1888, 1), (2868, 1889, 98), (2869, 1890, 20), (2870, 1891, 4), (2871, 1892, 103), (2872, 1892, 87), (2873, 1893, 8), (2874, 1894, 76), (2875, 1894, 7), (2876, 1895, 93), (2877, 1895, 50), (2878, 1896, 101), (2879, 1896, 85), (2880, 1897, 91), (2881, 1898, 18), (2882, 1898, 41), (2883, 1899, 77), (2884, 1900, 100), (2885, 1901, 27), (2886, 1902, 39), (2887, 1902, 41), (2888, 1903, 72), (2889, 1903, 12), (2890, 1904, 92), (2891, 1905, 90), (2892, 1905, 28), (2893, 1906, 37), (2894, 1907, 98), (2895, 1908, 81), (2896, 1909, 65), (2897, 1909, 40), (2898, 1910, 20), (2899, 1911, 40), (2900, 1911, 78), (2901, 1912, 65), (2902, 1913, 19), (2903, 1914, 68), (2904, 1914, 28), (2905, 1915, 59), (2906, 1915, 16), (2907, 1916, 16), (2908, 1916, 77), (2909, 1917, 34), (2910, 1918, 96), (2911, 1918, 86), (2912, 1919, 64), (2913, 1919, 94), (2914, 1920, 50), (2915, 1920, 5), (2916, 1921, 100), (2917, 1921, 2), (2918, 1922, 61), (2919, 1922, 15), (2920, 1923, 18), (2921, 1924, 48), (2922, 1924, 38), (2923, 1925, 68), (2924, 1926, 98), (2925, 1927, 87), (2926, 1927, 69), (2927, 1928, 89), (2928, 1929, 84), (2929, 1930, 72), (2930, 1931, 83), (2931, 1932, 5), (2932, 1932, 95), (2933, 1933, 19), (2934, 1934, 43), (2935, 1935, 2), (2936, 1936, 93), (2937, 1937, 22), (2938, 1938, 8), (2939, 1938, 34), (2940, 1939, 12), (2941, 1939, 51), (2942, 1940, 51), (2943, 1941, 66), (2944, 1941, 36), (2945, 1942, 82), (2946, 1942, 27), (2947, 1943, 91), (2948, 1944, 90), (2949, 1944, 26), (2950, 1945, 35), (2951, 1946, 85), (2952, 1946, 53), (2953, 1947, 36), (2954, 1947, 50), (2955, 1948, 95), (2956, 1949, 83), (2957, 1950, 62), (2958, 1951, 37), (2959, 1951, 2), (2960, 1952, 71), (2961, 1953, 86), (2962, 1953, 78), (2963, 1954, 11), (2964, 1955, 35), (2965, 1956, 6), (2966, 1957, 73), (2967, 1958, 88), (2968, 1959, 28), (2969, 1960, 11), (2970, 1961, 14), (2971, 1961, 25), (2972, 1962, 44), (2973, 1962, 99), (2974, 1963, 33), (2975, 1964, 12), (2976, 1964, 94), (2977, 1965, 86), (2978, 1965, 41), (2979, 1966, 23), (2980, 1967, 19), (2981, 1967, 89), (2982, 1968, 65), (2983, 1968, 85), (2984, 1969, 78), (2985, 1969, 59), (2986, 1970, 46), (2987, 1971, 34), (2988, 1971, 21), (2989, 1972, 58), (2990, 1973, 68), (2991, 1974, 67), (2992, 1974, 36), (2993, 1975, 91), (2994, 1975, 19), (2995, 1976, 1), (2996, 1977, 75), (2997, 1978, 28), (2998, 1978, 23), (2999, 1979, 2), (3000, 1979, 29), (3001, 1980, 51), (3002, 1980, 50), (3003, 1981, 64), (3004, 1981, 21), (3005, 1982, 55), (3006, 1982, 47), (3007, 1983, 94), (3008, 1983, 27);
+INSERT INTO livestream_tags (id, livestream_id, tag_id) VALUES (3009, 1984, 1), (3010, 1985, 45), (3011, 1986, 68), (3012, 1986, 36), (3013, 1987, 8), (3014, 1988, 46), (3015, 1989, 51), (3016, 1990, 73), (3017, 1991, 19), (3018, 1992, 40), (3019, 1993, 1), (3020, 1994, 18), (3021, 1994, 82), (3022, 1995, 85), (3023, 1996, 100), (3024, 1997, 2), (3025, 1998, 9), (3026, 1998, 30), (3027, 1999, 20), (3028, 2000, 99), (3029, 2001, 72), (3030, 2002, 5), (3031, 2003, 41), (3032, 2004, 18), (3033, 2005, 30), (3034, 2006, 77), (3035, 2007, 68), (3036, 2008, 83), (3037, 2008, 22), (3038, 2009, 46), (3039, 2010, 28), (3040, 2010, 89), (3041, 2011, 47), (3042, 2012, 38), (3043, 2012, 99), (3044, 2013, 73), (3045, 2013, 33), (3046, 2014, 4), (3047, 2014, 51), (3048, 2015, 75), (3049, 2015, 102), (3050, 2016, 85), (3051, 2016, 6), (3052, 2017, 40), (3053, 2017, 74), (3054, 2018, 23), (3055, 2019, 90), (3056, 2020, 81), (3057, 2021, 42), (3058, 2022, 37), (3059, 2022, 66), (3060, 2023, 21), (3061, 2023, 43), (3062, 2024, 69), (3063, 2025, 103), (3064, 2026, 4), (3065, 2026, 74), (3066, 2027, 84), (3067, 2027, 55), (3068, 2028, 75), (3069, 2028, 15), (3070, 2029, 18), (3071, 2029, 63), (3072, 2030, 11), (3073, 2031, 47), (3074, 2032, 52), (3075, 2033, 36), (3076, 2033, 73), (3077, 2034, 8), (3078, 2035, 49), (3079, 2035, 39), (3080, 2036, 99), (3081, 2036, 6), (3082, 2037, 1), (3083, 2037, 103), (3084, 2038, 80), (3085, 2039, 31), (3086, 2039, 70), (3087, 2040, 102), (3088, 2041, 40), (3089, 2041, 6), (3090, 2042, 37), (3091, 2043, 91), (3092, 2043, 32), (3093, 2044, 47), (3094, 2045, 47), (3095, 2046, 58), (3096, 2047, 74), (3097, 2048, 89), (3098, 2049, 16), (3099, 2049, 67), (3100, 2050, 42), (3101, 2051, 76), (3102, 2052, 95), (3103, 2053, 49), (3104, 2054, 74), (3105, 2055, 42), (3106, 2055, 57), (3107, 2056, 56), (3108, 2056, 88), (3109, 2057, 53), (3110, 2057, 89), (3111, 2058, 96), (3112, 2058, 42), (3113, 2059, 7), (3114, 2060, 55), (3115, 2061, 89), (3116, 2062, 28), (3117, 2063, 23), (3118, 2063, 50), (3119, 2064, 17), (3120, 2065, 67), (3121, 2065, 39), (3122, 2066, 36), (3123, 2066, 66), (3124, 2067, 18), (3125, 2068, 18), (3126, 2068, 29), (3127, 2069, 58), (3128, 2070, 62), (3129, 2070, 36), (3130, 2071, 2), (3131, 2071, 83), (3132, 2072, 4), (3133, 2073, 73), (3134, 2073, 27), (3135, 2074, 26), (3136, 2075, 81), (3137, 2076, 66), (3138, 2076, 49), (3139, 2077, 11), (3140, 2078, 2), (3141, 2078, 80), (3142, 2079, 72), (3143, 2079, 4), (3144, 2080, 8), (3145, 2081, 13), (3146, 2081, 18), (3147, 2082, 99), (3148, 2083, 31), (3149, 2083, 24), (3150, 2084, 26), (3151, 2084, 44), (3152, 2085, 33), (3153, 2085, 10), (3154, 2086, 73), (3155, 2086, 80), (3156, 2087, 40), (3157, 2087, 31), (3158, 2088, 46), (3159, 2089, 38), (3160, 2089, 87), (3161, 2090, 37), (3162, 2091, 72), (3163, 2092, 88), (3164, 2093, 44), (3165, 2094, 57), (3166, 2095, 36), (3167, 2096, 68), (3168, 2096, 18), (3169, 2097, 19), (3170, 2098, 67), (3171, 2098, 36), (3172, 2099, 14), (3173, 2100, 84), (3174, 2100, 57), (3175, 2101, 3), (3176, 2102, 17), (3177, 2103, 40), (3178, 2103, 8), (3179, 2104, 65), (3180, 2104, 67), (3181, 2105, 69), (3182, 2106, 34), (3183, 2107, 90), (3184, 2107, 91), (3185, 2108, 17), (3186, 2109, 81), (3187, 2109, 96), (3188, 2110, 80), (3189, 2111, 45), (3190, 2111, 27), (3191, 2112, 92), (3192, 2113, 57), (3193, 2113, 63), (3194, 2114, 5), (3195, 2114, 8), (3196, 2115, 92), (3197, 2115, 93), (3198, 2116, 7), (3199, 2116, 18), (3200, 2117, 99), (3201, 2117, 88), (3202, 2118, 41), (3203, 2118, 35), (3204, 2119, 10), (3205, 2119, 79), (3206, 2120, 86), (3207, 2121, 9), (3208, 2121, 6), (3209, 2122, 19), (3210, 2122, 2), (3211, 2123, 24), (3212, 2123, 90), (3213, 2124, 62), (3214, 2125, 43), (3215, 2125, 13), (3216, 2126, 19), (3217, 2127, 97), (3218, 2128, 91), (3219, 2129, 10), (3220, 2130, 85), (3221, 2130, 48), (3222, 2131, 76), (3223, 2131, 75), (3224, 2132, 89), (3225, 2133, 49), (3226, 2133, 34), (3227, 2134, 3), (3228, 2135, 95), (3229, 2135, 77), (3230, 2136, 80), (3231, 2137, 57), (3232, 2137, 81), (3233, 2138, 9), (3234, 2138, 2), (3235, 2139, 28), (3236, 2139, 40), (3237, 2140, 34), (3238, 2141, 31), (3239, 2142, 88), (3240, 2143, 23), (3241, 2144, 36), (3242, 2145, 14), (3243, 2146, 101), (3244, 2146, 13), (3245, 2147, 13), (3246, 2147, 32), (3247, 2148, 96), (3248, 2149, 28), (3249, 2150, 56), (3250, 2150, 90), (3251, 2151, 79), (3252, 2152, 74), (3253, 2153, 12), (3254, 2153, 43), (3255, 2154, 7), (3256, 2154, 97), (3257, 2155, 12), (3258, 2156, 23), (3259, 2157, 76), (3260, 2157, 60), (3261, 2158, 46), (3262, 2158, 1), (3263, 2159, 96), (3264, 2160, 94), (3265, 2161, 58), (3266, 2162, 96), (3267, 2163, 60), (3268, 2164, 20), (3269, 2165, 78), (3270, 2165, 34), (3271, 2166, 38), (3272, 2166, 29), (3273, 2167, 3), (3274, 2168, 97), (3275, 2168, 95), (3276, 2169, 92), (3277, 2169, 11), (3278, 2170, 83), (3279, 2170, 37), (3280, 2171, 66), (3281, 2171, 24), (3282, 2172, 71), (3283, 2173, 59), (3284, 2173, 56), (3285, 2174, 8), (3286, 2175, 23), (3287, 2175, 48), (3288, 2176, 45), (3289, 2176, 6), (3290, 2177, 39), (3291, 2177, 37), (3292, 2178, 31), (3293, 2179, 73), (3294, 2180, 65), (3295, 2181, 90), (3296, 2182, 52), (3297, 2183, 31), (3298, 2184, 47), (3299, 2185, 73), (3300, 2186, 8), (3301, 2186, 41), (3302, 2187, 72), (3303, 2188, 51), (3304, 2188, 91), (3305, 2189, 54), (3306, 2189, 93), (3307, 2190, 87), (3308, 2191, 95), (3309, 2192, 94), (3310, 2192, 46), (3311, 2193, 8), (3312, 2194, 80), (3313, 2194, 87), (3314, 2195, 17), (3315, 2196, 39), (3316, 2196, 3), (3317, 2197, 44), (3318, 2197, 22), (3319, 2198, 32), (3320, 2198, 49), (3321, 2199, 19), (3322, 2200, 30), (3323, 2201, 76), (3324, 2202, 96), (3325, 2202, 67), (3326, 2203, 60), (3327, 2203, 101), (3328, 2204, 98), (3329, 2205, 57), (3330, 2206, 90), (3331, 2207, 43), (3332, 2208, 59), (3333, 2208, 24), (3334, 2209, 90), (3335, 2210, 87), (3336, 2211, 3), (3337, 2211, 74), (3338, 2212, 26), (3339, 2213, 28), (3340, 2213, 31), (3341, 2214, 62), (3342, 2214, 1), (3343, 2215, 62), (3344, 2216, 69), (3345, 2216, 67), (3346, 2217, 25), (3347, 2218, 80), (3348, 2218, 37), (3349, 2219, 18), (3350, 2219, 83), (3351, 2220, 44), (3352, 2220, 98), (3353, 2221, 18), (3354, 2221, 52), (3355, 2222, 8), (3356, 2223, 40), (3357, 2223, 86), (3358, 2224, 20), (3359, 2225, 14), (3360, 2225, 30), (3361, 2226, 83), (3362, 2227, 4), (3363, 2228, 42), (3364, 2229, 43), (3365, 2229, 47), (3366, 2230, 51), (3367, 2230, 75), (3368, 2231, 17), (3369, 2232, 1), (3370, 2232, 92), (3371, 2233, 35), (3372, 2234, 2), (3373, 2234, 21), (3374, 2235, 3), (3375, 2236, 67), (3376, 2236, 102), (3377, 2237, 58), (3378, 2237, 25), (3379, 2238, 78), (3380, 2238, 36), (3381, 2239, 39), (3382, 2239, 15), (3383, 2240, 65), (3384, 2241, 63), (3385, 2242, 101), (3386, 2243, 80), (3387, 2243, 74), (3388, 2244, 103), (3389, 2244, 92), (3390, 2245, 53), (3391, 2246, 39), (3392, 2246, 32), (3393, 2247, 51), (3394, 2247, 98), (3395, 2248, 102), (3396, 2249, 20), (3397, 2250, 31), (3398, 2250, 58), (3399, 2251, 45), (3400, 2252, 66), (3401, 2253, 49), (3402, 2253, 92), (3403, 2254, 94), (3404, 2255, 35), (3405, 2255, 84), (3406, 2256, 97), (3407, 2256, 90), (3408, 2257, 49), (3409, 2258, 4), (3410, 2259, 21), (3411, 2259, 30), (3412, 2260, 54), (3413, 2260, 68), (3414, 2261, 87), (3415, 2262, 50), (3416, 2262, 78), (3417, 2263, 12), (3418, 2264, 76), (3419, 2265, 88), (3420, 2266, 92), (3421, 2267, 3), (3422, 2268, 24), (3423, 2268, 48), (3424, 2269, 75), (3425, 2270, 64), (3426, 2271, 96), (3427, 2271, 73), (3428, 2272, 62), (3429, 2272, 9), (3430, 2273, 48), (3431, 2274, 48), (3432, 2275, 28), (3433, 2276, 70), (3434, 2277, 103), (3435, 2277, 101), (3436, 2278, 16), (3437, 2278, 73), (3438, 2279, 77), (3439, 2279, 50), (3440, 2280, 50), (3441, 2281, 29), (3442, 2281, 90), (3443, 2282, 81), (3444, 2283, 48), (3445, 2283, 49), (3446, 2284, 6), (3447, 2285, 101), (3448, 2286, 57), (3449, 2287, 73), (3450, 2288, 66), (3451, 2288, 17), (3452, 2289, 58), (3453, 2289, 9), (3454, 2290, 90), (3455, 2291, 21), (3456, 2291, 40), (3457, 2292, 20), (3458, 2293, 1), (3459, 2293, 66), (3460, 2294, 52), (3461, 2294, 67), (3462, 2295, 16), (3463, 2296, 73), (3464, 2297, 50), (3465, 2297, 39), (3466, 2298, 48), (3467, 2299, 32), (3468, 2300, 20), (3469, 2300, 41), (3470, 2301, 34), (3471, 2302, 59), (3472, 2302, 6), (3473, 2303, 39), (3474, 2303, 83), (3475, 2304, 92), (3476, 2305, 87), (3477, 2306, 73), (3478, 2307, 49), (3479, 2308, 53), (3480, 2309, 63), (3481, 2310, 7), (3482, 2310, 96), (3483, 2311, 78), (3484, 2312, 49), (3485, 2312, 88), (3486, 2313, 23), (3487, 2313, 41), (3488, 2314, 54), (3489, 2314, 56), (3490, 2315, 74), (3491, 2316, 78), (3492, 2317, 42), (3493, 2317, 63), (3494, 2318, 95), (3495, 2318, 94), (3496, 2319, 21), (3497, 2319, 13), (3498, 2320, 102), (3499, 2320, 22), (3500, 2321, 22), (3501, 2321, 71), (3502, 2322, 26), (3503, 2322, 24), (3504, 2323, 18), (3505, 2324, 12), (3506, 2324, 103), (3507, 2325, 71), (3508, 2325, 88), (3509, 2326, 42);
+INSERT INTO livestream_tags (id, livestream_id, tag_id) VALUES (3510, 2327, 97), (3511, 2327, 91), (3512, 2328, 64), (3513, 2329, 34), (3514, 2330, 1), (3515, 2331, 54), (3516, 2332, 102), (3517, 2332, 96), (3518, 2333, 35), (3519, 2334, 92), (3520, 2334, 93), (3521, 2335, 5), (3522, 2336, 95), (3523, 2336, 82), (3524, 2337, 37), (3525, 2338, 45), (3526, 2339, 5), (3527, 2340, 96), (3528, 2341, 3), (3529, 2341, 66), (3530, 2342, 32), (3531, 2342, 49), (3532, 2343, 80), (3533, 2343, 102), (3534, 2344, 47), (3535, 2344, 57), (3536, 2345, 37), (3537, 2345, 51), (3538, 2346, 44), (3539, 2346, 93), (3540, 2347, 79), (3541, 2347, 91), (3542, 2348, 33), (3543, 2348, 74), (3544, 2349, 7), (3545, 2349, 53), (3546, 2350, 29), (3547, 2350, 39), (3548, 2351, 99), (3549, 2351, 11), (3550, 2352, 98), (3551, 2352, 87), (3552, 2353, 2), (3553, 2353, 49), (3554, 2354, 36), (3555, 2355, 79), (3556, 2355, 81), (3557, 2356, 72), (3558, 2357, 14), (3559, 2357, 88), (3560, 2358, 65), (3561, 2359, 4), (3562, 2359, 54), (3563, 2360, 44), (3564, 2361, 33), (3565, 2362, 27), (3566, 2363, 46), (3567, 2363, 75), (3568, 2364, 87), (3569, 2364, 48), (3570, 2365, 86), (3571, 2365, 57), (3572, 2366, 81), (3573, 2366, 9), (3574, 2367, 11), (3575, 2367, 27), (3576, 2368, 18), (3577, 2369, 29), (3578, 2369, 18), (3579, 2370, 28), (3580, 2370, 95), (3581, 2371, 62), (3582, 2371, 67), (3583, 2372, 69), (3584, 2373, 49), (3585, 2373, 63), (3586, 2374, 101), (3587, 2375, 92), (3588, 2376, 58), (3589, 2376, 3), (3590, 2377, 6), (3591, 2377, 75), (3592, 2378, 29), (3593, 2378, 95), (3594, 2379, 2), (3595, 2379, 49), (3596, 2380, 44), (3597, 2381, 47), (3598, 2381, 42), (3599, 2382, 94), (3600, 2383, 100), (3601, 2384, 48), (3602, 2385, 93), (3603, 2386, 100), (3604, 2387, 24), (3605, 2387, 36), (3606, 2388, 48), (3607, 2389, 98), (3608, 2390, 4), (3609, 2391, 57), (3610, 2392, 61), (3611, 2393, 76), (3612, 2394, 95), (3613, 2395, 13), (3614, 2396, 102), (3615, 2397, 84), (3616, 2397, 55), (3617, 2398, 39), (3618, 2399, 59), (3619, 2400, 24), (3620, 2401, 76), (3621, 2401, 93), (3622, 2402, 31), (3623, 2403, 3), (3624, 2403, 38), (3625, 2404, 56), (3626, 2404, 69), (3627, 2405, 77), (3628, 2405, 80), (3629, 2406, 39), (3630, 2407, 4), (3631, 2407, 70), (3632, 2408, 59), (3633, 2409, 73), (3634, 2410, 13), (3635, 2410, 20), (3636, 2411, 16), (3637, 2412, 96), (3638, 2413, 37), (3639, 2413, 19), (3640, 2414, 79), (3641, 2414, 67), (3642, 2415, 42), (3643, 2416, 49), (3644, 2416, 11), (3645, 2417, 27), (3646, 2418, 62), (3647, 2419, 19), (3648, 2419, 64), (3649, 2420, 66), (3650, 2421, 54), (3651, 2422, 87), (3652, 2423, 52), (3653, 2423, 46), (3654, 2424, 102), (3655, 2424, 35), (3656, 2425, 90), (3657, 2425, 34), (3658, 2426, 40), (3659, 2426, 42), (3660, 2427, 8), (3661, 2427, 63), (3662, 2428, 11), (3663, 2428, 2), (3664, 2429, 99), (3665, 2430, 45), (3666, 2430, 20), (3667, 2431, 16), (3668, 2432, 61), (3669, 2433, 100), (3670, 2433, 84), (3671, 2434, 10), (3672, 2435, 13), (3673, 2435, 39), (3674, 2436, 45), (3675, 2437, 98), (3676, 2438, 55), (3677, 2439, 27), (3678, 2440, 66), (3679, 2441, 64), (3680, 2441, 1), (3681, 2442, 46), (3682, 2443, 27), (3683, 2444, 91), (3684, 2444, 18), (3685, 2445, 96), (3686, 2446, 57), (3687, 2447, 71), (3688, 2447, 22), (3689, 2448, 97), (3690, 2449, 2), (3691, 2450, 52), (3692, 2451, 48), (3693, 2452, 51), (3694, 2452, 13), (3695, 2453, 71), (3696, 2453, 64), (3697, 2454, 14), (3698, 2454, 23), (3699, 2455, 18), (3700, 2455, 52), (3701, 2456, 36), (3702, 2456, 3), (3703, 2457, 54), (3704, 2457, 75), (3705, 2458, 19), (3706, 2459, 30), (3707, 2459, 73), (3708, 2460, 30), (3709, 2461, 41), (3710, 2461, 26), (3711, 2462, 38), (3712, 2462, 27), (3713, 2463, 86), (3714, 2463, 37), (3715, 2464, 39), (3716, 2464, 7), (3717, 2465, 53), (3718, 2466, 83), (3719, 2466, 102), (3720, 2467, 63), (3721, 2468, 11), (3722, 2468, 45), (3723, 2469, 38), (3724, 2470, 86), (3725, 2470, 39), (3726, 2471, 59), (3727, 2472, 41), (3728, 2472, 100), (3729, 2473, 49), (3730, 2474, 100), (3731, 2475, 18), (3732, 2475, 64), (3733, 2476, 20), (3734, 2476, 32), (3735, 2477, 40), (3736, 2478, 36), (3737, 2478, 97), (3738, 2479, 16), (3739, 2480, 38), (3740, 2481, 97), (3741, 2482, 87), (3742, 2483, 94), (3743, 2484, 7), (3744, 2485, 1), (3745, 2486, 70), (3746, 2486, 33), (3747, 2487, 40), (3748, 2487, 1), (3749, 2488, 49), (3750, 2488, 26), (3751, 2489, 25), (3752, 2490, 55), (3753, 2490, 102), (3754, 2491, 84), (3755, 2491, 22), (3756, 2492, 66), (3757, 2493, 29), (3758, 2493, 22), (3759, 2494, 92), (3760, 2495, 29), (3761, 2495, 16), (3762, 2496, 102), (3763, 2497, 57), (3764, 2498, 28), (3765, 2498, 86), (3766, 2499, 16), (3767, 2500, 44), (3768, 2501, 76), (3769, 2502, 96), (3770, 2502, 42), (3771, 2503, 52), (3772, 2504, 17), (3773, 2504, 32), (3774, 2505, 21), (3775, 2505, 92), (3776, 2506, 74), (3777, 2507, 9), (3778, 2508, 3), (3779, 2509, 17), (3780, 2509, 68), (3781, 2510, 102), (3782, 2510, 54), (3783, 2511, 69), (3784, 2512, 101), (3785, 2512, 23), (3786, 2513, 58), (3787, 2513, 76), (3788, 2514, 10), (3789, 2515, 85), (3790, 2515, 64), (3791, 2516, 67), (3792, 2516, 69), (3793, 2517, 65), (3794, 2518, 65), (3795, 2518, 73), (3796, 2519, 66), (3797, 2520, 80), (3798, 2521, 18), (3799, 2521, 92), (3800, 2522, 46), (3801, 2523, 10), (3802, 2523, 102), (3803, 2524, 55), (3804, 2524, 10), (3805, 2525, 57), (3806, 2525, 72), (3807, 2526, 49), (3808, 2527, 79), (3809, 2528, 42), (3810, 2529, 60), (3811, 2529, 82), (3812, 2530, 7), (3813, 2530, 45), (3814, 2531, 16), (3815, 2532, 20), (3816, 2533, 74), (3817, 2534, 29), (3818, 2535, 76), (3819, 2535, 12), (3820, 2536, 53), (3821, 2536, 76), (3822, 2537, 48), (3823, 2538, 73), (3824, 2539, 18), (3825, 2539, 65), (3826, 2540, 46), (3827, 2540, 91), (3828, 2541, 93), (3829, 2541, 52), (3830, 2542, 29), (3831, 2543, 94), (3832, 2544, 50), (3833, 2544, 86), (3834, 2545, 75), (3835, 2545, 40), (3836, 2546, 79), (3837, 2546, 39), (3838, 2547, 35), (3839, 2547, 11), (3840, 2548, 20), (3841, 2549, 74), (3842, 2550, 100), (3843, 2550, 58), (3844, 2551, 26), (3845, 2552, 68), (3846, 2553, 42), (3847, 2554, 65), (3848, 2554, 103), (3849, 2555, 46), (3850, 2556, 9), (3851, 2556, 68), (3852, 2557, 64), (3853, 2557, 22), (3854, 2558, 92), (3855, 2559, 65), (3856, 2560, 78), (3857, 2561, 77), (3858, 2561, 80), (3859, 2562, 25), (3860, 2563, 42), (3861, 2563, 101), (3862, 2564, 79), (3863, 2565, 83), (3864, 2565, 53), (3865, 2566, 90), (3866, 2566, 75), (3867, 2567, 90), (3868, 2567, 86), (3869, 2568, 20), (3870, 2569, 97), (3871, 2570, 62), (3872, 2570, 51), (3873, 2571, 88), (3874, 2572, 10), (3875, 2572, 41), (3876, 2573, 78), (3877, 2573, 67), (3878, 2574, 45), (3879, 2574, 44), (3880, 2575, 11), (3881, 2575, 38), (3882, 2576, 44), (3883, 2577, 28), (3884, 2577, 44), (3885, 2578, 28), (3886, 2578, 44), (3887, 2579, 90), (3888, 2579, 3), (3889, 2580, 59), (3890, 2580, 16), (3891, 2581, 31), (3892, 2582, 100), (3893, 2582, 49), (3894, 2583, 92), (3895, 2584, 7), (3896, 2584, 54), (3897, 2585, 48), (3898, 2586, 61), (3899, 2586, 28), (3900, 2587, 75), (3901, 2588, 98), (3902, 2589, 5), (3903, 2589, 67), (3904, 2590, 64), (3905, 2590, 39), (3906, 2591, 83), (3907, 2592, 59), (3908, 2592, 102), (3909, 2593, 30), (3910, 2594, 73), (3911, 2594, 39), (3912, 2595, 27), (3913, 2596, 27), (3914, 2597, 15), (3915, 2597, 49), (3916, 2598, 2), (3917, 2599, 21), (3918, 2600, 75), (3919, 2600, 43), (3920, 2601, 14), (3921, 2601, 61), (3922, 2602, 85), (3923, 2603, 58), (3924, 2603, 63), (3925, 2604, 24), (3926, 2605, 97), (3927, 2605, 75), (3928, 2606, 62), (3929, 2606, 89), (3930, 2607, 37), (3931, 2608, 81), (3932, 2608, 78), (3933, 2609, 98), (3934, 2609, 4), (3935, 2610, 11), (3936, 2611, 35), (3937, 2611, 18), (3938, 2612, 83), (3939, 2613, 74), (3940, 2613, 13), (3941, 2614, 36), (3942, 2614, 41), (3943, 2615, 33), (3944, 2616, 56), (3945, 2616, 9), (3946, 2617, 29), (3947, 2617, 36), (3948, 2618, 74), (3949, 2618, 28), (3950, 2619, 96), (3951, 2619, 33), (3952, 2620, 66), (3953, 2620, 65), (3954, 2621, 34), (3955, 2622, 5), (3956, 2623, 10), (3957, 2623, 67), (3958, 2624, 97), (3959, 2624, 17), (3960, 2625, 70), (3961, 2625, 83), (3962, 2626, 28), (3963, 2627, 7), (3964, 2628, 76), (3965, 2629, 72), (3966, 2630, 88), (3967, 2631, 69), (3968, 2631, 63), (3969, 2632, 43), (3970, 2632, 31), (3971, 2633, 74), (3972, 2633, 51), (3973, 2634, 96), (3974, 2634, 41), (3975, 2635, 45), (3976, 2635, 103), (3977, 2636, 48), (3978, 2636, 29), (3979, 2637, 9), (3980, 2638, 88), (3981, 2638, 82), (3982, 2639, 26), (3983, 2639, 69), (3984, 2640, 73), (3985, 2641, 15), (3986, 2641, 103), (3987, 2642, 36), (3988, 2643, 81), (3989, 2643, 18), (3990, 2644, 18), (3991, 2645, 39), (3992, 2645, 61), (3993, 2646, 33), (3994, 2646, 86), (3995, 2647, 41), (3996, 2647, 21), (3997, 2648, 58), (3998, 2648, 88), (3999, 2649, 86), (4000, 2650, 44), (4001, 2650, 21), (4002, 2651, 36), (4003, 2652, 92), (4004, 2652, 11), (4005, 2653, 79), (4006, 2654, 58), (4007, 2654, 90), (4008, 2655, 22), (4009, 2656, 88), (4010, 2656, 98);
+INSERT INTO livestream_tags (id, livestream_id, tag_id) VALUES (4011, 2657, 97), (4012, 2657, 96), (4013, 2658, 32), (4014, 2659, 53), (4015, 2659, 8), (4016, 2660, 60), (4017, 2660, 70), (4018, 2661, 61), (4019, 2661, 19), (4020, 2662, 11), (4021, 2663, 40), (4022, 2664, 11), (4023, 2664, 101), (4024, 2665, 13), (4025, 2665, 62), (4026, 2666, 53), (4027, 2667, 88), (4028, 2667, 9), (4029, 2668, 54), (4030, 2669, 63), (4031, 2670, 58), (4032, 2671, 42), (4033, 2672, 19), (4034, 2673, 21), (4035, 2674, 37), (4036, 2675, 22), (4037, 2675, 66), (4038, 2676, 13), (4039, 2676, 92), (4040, 2677, 51), (4041, 2677, 44), (4042, 2678, 23), (4043, 2678, 75), (4044, 2679, 101), (4045, 2680, 13), (4046, 2680, 74), (4047, 2681, 38), (4048, 2681, 72), (4049, 2682, 29), (4050, 2682, 24), (4051, 2683, 36), (4052, 2683, 97), (4053, 2684, 61), (4054, 2685, 41), (4055, 2685, 53), (4056, 2686, 54), (4057, 2686, 95), (4058, 2687, 85), (4059, 2687, 40), (4060, 2688, 65), (4061, 2689, 36), (4062, 2690, 85), (4063, 2691, 12), (4064, 2691, 34), (4065, 2692, 52), (4066, 2692, 65), (4067, 2693, 41), (4068, 2693, 54), (4069, 2694, 18), (4070, 2695, 82), (4071, 2695, 54), (4072, 2696, 34), (4073, 2697, 42), (4074, 2698, 86), (4075, 2698, 5), (4076, 2699, 39), (4077, 2699, 90), (4078, 2700, 25), (4079, 2701, 69), (4080, 2702, 92), (4081, 2702, 4), (4082, 2703, 66), (4083, 2704, 44), (4084, 2705, 102), (4085, 2706, 36), (4086, 2706, 18), (4087, 2707, 67), (4088, 2708, 90), (4089, 2709, 58), (4090, 2709, 19), (4091, 2710, 68), (4092, 2711, 16), (4093, 2711, 4), (4094, 2712, 50), (4095, 2712, 33), (4096, 2713, 94), (4097, 2713, 25), (4098, 2714, 93), (4099, 2715, 11), (4100, 2715, 46), (4101, 2716, 19), (4102, 2717, 17), (4103, 2717, 32), (4104, 2718, 66), (4105, 2719, 94), (4106, 2719, 79), (4107, 2720, 18), (4108, 2721, 39), (4109, 2722, 57), (4110, 2722, 66), (4111, 2723, 23), (4112, 2723, 83), (4113, 2724, 93), (4114, 2725, 37), (4115, 2726, 36), (4116, 2727, 48), (4117, 2728, 20), (4118, 2728, 26), (4119, 2729, 90), (4120, 2729, 65), (4121, 2730, 98), (4122, 2730, 70), (4123, 2731, 54), (4124, 2731, 46), (4125, 2732, 54), (4126, 2732, 51), (4127, 2733, 88), (4128, 2733, 5), (4129, 2734, 98), (4130, 2734, 99), (4131, 2735, 2), (4132, 2736, 4), (4133, 2737, 78), (4134, 2737, 29), (4135, 2738, 101), (4136, 2739, 76), (4137, 2739, 77), (4138, 2740, 68), (4139, 2741, 38), (4140, 2742, 78), (4141, 2743, 101), (4142, 2744, 87), (4143, 2745, 27), (4144, 2745, 13), (4145, 2746, 8), (4146, 2746, 39), (4147, 2747, 102), (4148, 2747, 92), (4149, 2748, 29), (4150, 2748, 3), (4151, 2749, 81), (4152, 2750, 69), (4153, 2751, 101), (4154, 2752, 78), (4155, 2753, 13), (4156, 2754, 11), (4157, 2755, 97), (4158, 2755, 59), (4159, 2756, 34), (4160, 2757, 75), (4161, 2758, 54), (4162, 2759, 72), (4163, 2760, 83), (4164, 2760, 40), (4165, 2761, 58), (4166, 2762, 15), (4167, 2763, 26), (4168, 2764, 12), (4169, 2764, 71), (4170, 2765, 18), (4171, 2766, 77), (4172, 2766, 90), (4173, 2767, 11), (4174, 2768, 56), (4175, 2769, 74), (4176, 2770, 85), (4177, 2771, 16), (4178, 2772, 34), (4179, 2773, 98), (4180, 2773, 92), (4181, 2774, 66), (4182, 2775, 89), (4183, 2775, 92), (4184, 2776, 52), (4185, 2776, 54), (4186, 2777, 77), (4187, 2777, 4), (4188, 2778, 70), (4189, 2779, 48), (4190, 2780, 94), (4191, 2781, 95), (4192, 2782, 64), (4193, 2783, 94), (4194, 2784, 87), (4195, 2784, 58), (4196, 2785, 62), (4197, 2785, 98), (4198, 2786, 30), (4199, 2786, 39), (4200, 2787, 1), (4201, 2788, 72), (4202, 2788, 62), (4203, 2789, 82), (4204, 2790, 74), (4205, 2790, 68), (4206, 2791, 80), (4207, 2791, 16), (4208, 2792, 32), (4209, 2793, 101), (4210, 2793, 80), (4211, 2794, 17), (4212, 2794, 46), (4213, 2795, 9), (4214, 2795, 35), (4215, 2796, 12), (4216, 2797, 64), (4217, 2798, 95), (4218, 2798, 29), (4219, 2799, 17), (4220, 2799, 9), (4221, 2800, 1), (4222, 2801, 6), (4223, 2801, 79), (4224, 2802, 39), (4225, 2802, 52), (4226, 2803, 29), (4227, 2803, 49), (4228, 2804, 40), (4229, 2804, 22), (4230, 2805, 88), (4231, 2805, 100), (4232, 2806, 60), (4233, 2807, 48), (4234, 2807, 1), (4235, 2808, 68), (4236, 2809, 87), (4237, 2810, 40), (4238, 2810, 7), (4239, 2811, 94), (4240, 2811, 17), (4241, 2812, 3), (4242, 2813, 35), (4243, 2813, 21), (4244, 2814, 70), (4245, 2814, 15), (4246, 2815, 57), (4247, 2816, 90), (4248, 2816, 79), (4249, 2817, 21), (4250, 2817, 41), (4251, 2818, 77), (4252, 2818, 19), (4253, 2819, 102), (4254, 2820, 33), (4255, 2820, 14), (4256, 2821, 55), (4257, 2822, 102), (4258, 2822, 61), (4259, 2823, 65), (4260, 2824, 75), (4261, 2825, 69), (4262, 2826, 24), (4263, 2826, 42), (4264, 2827, 41), (4265, 2827, 17), (4266, 2828, 7), (4267, 2828, 1), (4268, 2829, 69), (4269, 2829, 22), (4270, 2830, 62), (4271, 2831, 16), (4272, 2832, 62), (4273, 2832, 61), (4274, 2833, 64), (4275, 2833, 10), (4276, 2834, 37), (4277, 2835, 82), (4278, 2835, 90), (4279, 2836, 42), (4280, 2836, 80), (4281, 2837, 31), (4282, 2837, 43), (4283, 2838, 83), (4284, 2839, 59), (4285, 2840, 65), (4286, 2840, 2), (4287, 2841, 60), (4288, 2842, 96), (4289, 2843, 64), (4290, 2843, 11), (4291, 2844, 38), (4292, 2844, 52), (4293, 2845, 43), (4294, 2846, 39), (4295, 2846, 57), (4296, 2847, 47), (4297, 2847, 51), (4298, 2848, 56), (4299, 2848, 93), (4300, 2849, 20), (4301, 2849, 26), (4302, 2850, 5), (4303, 2851, 37), (4304, 2851, 2), (4305, 2852, 85), (4306, 2853, 92), (4307, 2854, 87), (4308, 2854, 53), (4309, 2855, 77), (4310, 2855, 89), (4311, 2856, 46), (4312, 2857, 100), (4313, 2858, 77), (4314, 2859, 68), (4315, 2860, 89), (4316, 2860, 75), (4317, 2861, 44), (4318, 2861, 55), (4319, 2862, 1), (4320, 2863, 101), (4321, 2863, 39), (4322, 2864, 87), (4323, 2864, 83), (4324, 2865, 57), (4325, 2865, 85), (4326, 2866, 65), (4327, 2866, 32), (4328, 2867, 57), (4329, 2867, 28), (4330, 2868, 84), (4331, 2868, 55), (4332, 2869, 69), (4333, 2870, 22), (4334, 2871, 37), (4335, 2871, 38), (4336, 2872, 93), (4337, 2873, 54), (4338, 2874, 79), (4339, 2874, 99), (4340, 2875, 25), (4341, 2876, 13), (4342, 2876, 98), (4343, 2877, 87), (4344, 2878, 90), (4345, 2878, 53), (4346, 2879, 44), (4347, 2880, 88), (4348, 2880, 26), (4349, 2881, 37), (4350, 2882, 3), (4351, 2883, 45), (4352, 2884, 47), (4353, 2884, 102), (4354, 2885, 10), (4355, 2885, 98), (4356, 2886, 101), (4357, 2886, 54), (4358, 2887, 11), (4359, 2888, 98), (4360, 2888, 45), (4361, 2889, 79), (4362, 2889, 33), (4363, 2890, 56), (4364, 2891, 71), (4365, 2891, 85), (4366, 2892, 33), (4367, 2892, 17), (4368, 2893, 19), (4369, 2894, 74), (4370, 2895, 42), (4371, 2896, 72), (4372, 2896, 97), (4373, 2897, 9), (4374, 2898, 61), (4375, 2899, 16), (4376, 2899, 67), (4377, 2900, 75), (4378, 2900, 32), (4379, 2901, 58), (4380, 2902, 2), (4381, 2903, 102), (4382, 2903, 101), (4383, 2904, 49), (4384, 2904, 60), (4385, 2905, 75), (4386, 2906, 63), (4387, 2906, 73), (4388, 2907, 30), (4389, 2907, 95), (4390, 2908, 73), (4391, 2908, 22), (4392, 2909, 54), (4393, 2910, 27), (4394, 2910, 75), (4395, 2911, 29), (4396, 2912, 61), (4397, 2912, 9), (4398, 2913, 93), (4399, 2914, 94), (4400, 2915, 6), (4401, 2915, 98), (4402, 2916, 54), (4403, 2916, 31), (4404, 2917, 70), (4405, 2917, 96), (4406, 2918, 102), (4407, 2918, 15), (4408, 2919, 86), (4409, 2920, 48), (4410, 2920, 88), (4411, 2921, 10), (4412, 2922, 61), (4413, 2923, 64), (4414, 2924, 62), (4415, 2924, 102), (4416, 2925, 51), (4417, 2925, 23), (4418, 2926, 97), (4419, 2926, 34), (4420, 2927, 6), (4421, 2927, 49), (4422, 2928, 94), (4423, 2928, 44), (4424, 2929, 2), (4425, 2930, 95), (4426, 2931, 49), (4427, 2931, 65), (4428, 2932, 1), (4429, 2932, 65), (4430, 2933, 79), (4431, 2933, 98), (4432, 2934, 74), (4433, 2934, 91), (4434, 2935, 103), (4435, 2935, 67), (4436, 2936, 77), (4437, 2936, 82), (4438, 2937, 63), (4439, 2937, 72), (4440, 2938, 20), (4441, 2939, 84), (4442, 2940, 49), (4443, 2940, 64), (4444, 2941, 92), (4445, 2941, 63), (4446, 2942, 2), (4447, 2942, 29), (4448, 2943, 4), (4449, 2943, 77), (4450, 2944, 49), (4451, 2944, 11), (4452, 2945, 37), (4453, 2946, 30), (4454, 2946, 42), (4455, 2947, 97), (4456, 2947, 70), (4457, 2948, 10), (4458, 2948, 48), (4459, 2949, 68), (4460, 2949, 94), (4461, 2950, 53), (4462, 2951, 64), (4463, 2951, 17), (4464, 2952, 84), (4465, 2953, 87), (4466, 2954, 82), (4467, 2955, 50), (4468, 2955, 12), (4469, 2956, 11), (4470, 2956, 13), (4471, 2957, 78), (4472, 2957, 83), (4473, 2958, 9), (4474, 2959, 26), (4475, 2959, 88), (4476, 2960, 30), (4477, 2960, 60), (4478, 2961, 87), (4479, 2961, 58), (4480, 2962, 98), (4481, 2963, 101), (4482, 2964, 22), (4483, 2964, 14), (4484, 2965, 2), (4485, 2966, 95), (4486, 2966, 13), (4487, 2967, 55), (4488, 2968, 4), (4489, 2968, 27), (4490, 2969, 2), (4491, 2969, 30), (4492, 2970, 39), (4493, 2970, 23), (4494, 2971, 77), (4495, 2971, 72), (4496, 2972, 22), (4497, 2973, 43), (4498, 2973, 23), (4499, 2974, 42), (4500, 2974, 65), (4501, 2975, 85), (4502, 2975, 61), (4503, 2976, 4), (4504, 2977, 36), (4505, 2977, 26), (4506, 2978, 26), (4507, 2979, 13), (4508, 2980, 10), (4509, 2981, 64), (4510, 2982, 1), (4511, 2982, 21);
+INSERT INTO livestream_tags (id, livestream_id, tag_id) VALUES (4512, 2983, 34), (4513, 2983, 81), (4514, 2984, 21), (4515, 2984, 4), (4516, 2985, 31), (4517, 2985, 23), (4518, 2986, 83), (4519, 2986, 9), (4520, 2987, 34), (4521, 2988, 17), (4522, 2989, 65), (4523, 2989, 10), (4524, 2990, 28), (4525, 2991, 47), (4526, 2991, 67), (4527, 2992, 58), (4528, 2992, 10), (4529, 2993, 59), (4530, 2994, 7), (4531, 2995, 99), (4532, 2995, 1), (4533, 2996, 71), (4534, 2996, 53), (4535, 2997, 57), (4536, 2997, 40), (4537, 2998, 64), (4538, 2999, 52), (4539, 2999, 53), (4540, 3000, 91), (4541, 3001, 101), (4542, 3001, 96), (4543, 3002, 3), (4544, 3003, 76), (4545, 3003, 78), (4546, 3004, 71), (4547, 3004, 73), (4548, 3005, 26), (4549, 3006, 57), (4550, 3006, 11), (4551, 3007, 75), (4552, 3007, 44), (4553, 3008, 94), (4554, 3009, 97), (4555, 3010, 49), (4556, 3010, 88), (4557, 3011, 46), (4558, 3011, 55), (4559, 3012, 63), (4560, 3012, 84), (4561, 3013, 9), (4562, 3014, 20), (4563, 3014, 33), (4564, 3015, 35), (4565, 3016, 32), (4566, 3016, 29), (4567, 3017, 18), (4568, 3018, 48), (4569, 3018, 94), (4570, 3019, 40), (4571, 3019, 77), (4572, 3020, 80), (4573, 3020, 101), (4574, 3021, 49), (4575, 3021, 82), (4576, 3022, 22), (4577, 3022, 100), (4578, 3023, 5), (4579, 3023, 51), (4580, 3024, 38), (4581, 3024, 93), (4582, 3025, 96), (4583, 3025, 19), (4584, 3026, 52), (4585, 3027, 54), (4586, 3028, 62), (4587, 3029, 19), (4588, 3029, 46), (4589, 3030, 80), (4590, 3031, 25), (4591, 3031, 78), (4592, 3032, 84), (4593, 3032, 5), (4594, 3033, 50), (4595, 3034, 70), (4596, 3034, 60), (4597, 3035, 57), (4598, 3035, 70), (4599, 3036, 14), (4600, 3036, 83), (4601, 3037, 35), (4602, 3038, 15), (4603, 3038, 59), (4604, 3039, 19), (4605, 3040, 30), (4606, 3040, 72), (4607, 3041, 62), (4608, 3041, 87), (4609, 3042, 39), (4610, 3043, 98), (4611, 3044, 45), (4612, 3044, 32), (4613, 3045, 61), (4614, 3046, 92), (4615, 3046, 45), (4616, 3047, 15), (4617, 3048, 81), (4618, 3048, 44), (4619, 3049, 56), (4620, 3049, 35), (4621, 3050, 43), (4622, 3051, 89), (4623, 3051, 70), (4624, 3052, 58), (4625, 3053, 42), (4626, 3054, 17), (4627, 3055, 96), (4628, 3055, 83), (4629, 3056, 28), (4630, 3056, 76), (4631, 3057, 49), (4632, 3057, 11), (4633, 3058, 77), (4634, 3059, 78), (4635, 3060, 52), (4636, 3061, 93), (4637, 3062, 8), (4638, 3063, 61), (4639, 3064, 1), (4640, 3064, 35), (4641, 3065, 37), (4642, 3066, 84), (4643, 3067, 78), (4644, 3068, 52), (4645, 3069, 62), (4646, 3070, 29), (4647, 3071, 32), (4648, 3072, 36), (4649, 3072, 98), (4650, 3073, 13), (4651, 3074, 53), (4652, 3075, 5), (4653, 3076, 29), (4654, 3077, 98), (4655, 3078, 88), (4656, 3078, 99), (4657, 3079, 63), (4658, 3079, 22), (4659, 3080, 11), (4660, 3081, 77), (4661, 3082, 88), (4662, 3083, 32), (4663, 3083, 6), (4664, 3084, 74), (4665, 3085, 81), (4666, 3085, 62), (4667, 3086, 83), (4668, 3087, 47), (4669, 3088, 31), (4670, 3089, 86), (4671, 3089, 37), (4672, 3090, 32), (4673, 3091, 74), (4674, 3091, 28), (4675, 3092, 41), (4676, 3092, 76), (4677, 3093, 78), (4678, 3093, 45), (4679, 3094, 64), (4680, 3095, 79), (4681, 3096, 99), (4682, 3096, 43), (4683, 3097, 50), (4684, 3098, 6), (4685, 3098, 52), (4686, 3099, 48), (4687, 3099, 95), (4688, 3100, 22), (4689, 3100, 52), (4690, 3101, 100), (4691, 3102, 71), (4692, 3102, 89), (4693, 3103, 88), (4694, 3104, 2), (4695, 3105, 46), (4696, 3105, 85), (4697, 3106, 41), (4698, 3106, 77), (4699, 3107, 8), (4700, 3107, 11), (4701, 3108, 65), (4702, 3108, 34), (4703, 3109, 91), (4704, 3109, 81), (4705, 3110, 81), (4706, 3110, 44), (4707, 3111, 42), (4708, 3111, 5), (4709, 3112, 50), (4710, 3113, 66), (4711, 3113, 45), (4712, 3114, 88), (4713, 3114, 89), (4714, 3115, 47), (4715, 3115, 40), (4716, 3116, 16), (4717, 3117, 15), (4718, 3118, 79), (4719, 3118, 8), (4720, 3119, 16), (4721, 3120, 103), (4722, 3121, 80), (4723, 3121, 1), (4724, 3122, 100), (4725, 3122, 45), (4726, 3123, 54), (4727, 3123, 3), (4728, 3124, 59), (4729, 3124, 37), (4730, 3125, 21), (4731, 3126, 9), (4732, 3127, 93), (4733, 3128, 16), (4734, 3128, 103), (4735, 3129, 2), (4736, 3130, 84), (4737, 3131, 61), (4738, 3132, 54), (4739, 3132, 32), (4740, 3133, 51), (4741, 3134, 72), (4742, 3135, 87), (4743, 3136, 47), (4744, 3136, 8), (4745, 3137, 15), (4746, 3138, 78), (4747, 3138, 55), (4748, 3139, 9), (4749, 3140, 89), (4750, 3141, 56), (4751, 3141, 37), (4752, 3142, 68), (4753, 3143, 62), (4754, 3144, 64), (4755, 3144, 50), (4756, 3145, 26), (4757, 3145, 99), (4758, 3146, 71), (4759, 3146, 78), (4760, 3147, 90), (4761, 3147, 87), (4762, 3148, 31), (4763, 3148, 54), (4764, 3149, 76), (4765, 3150, 38), (4766, 3150, 26), (4767, 3151, 101), (4768, 3152, 98), (4769, 3152, 30), (4770, 3153, 26), (4771, 3154, 50), (4772, 3155, 10), (4773, 3156, 77), (4774, 3157, 12), (4775, 3158, 84), (4776, 3159, 50), (4777, 3159, 66), (4778, 3160, 14), (4779, 3160, 45), (4780, 3161, 45), (4781, 3161, 72), (4782, 3162, 95), (4783, 3162, 3), (4784, 3163, 62), (4785, 3164, 57), (4786, 3164, 68), (4787, 3165, 46), (4788, 3166, 88), (4789, 3166, 38), (4790, 3167, 72), (4791, 3168, 50), (4792, 3169, 66), (4793, 3170, 31), (4794, 3171, 98), (4795, 3172, 72), (4796, 3172, 53), (4797, 3173, 70), (4798, 3173, 33), (4799, 3174, 8), (4800, 3174, 12), (4801, 3175, 96), (4802, 3175, 68), (4803, 3176, 11), (4804, 3177, 49), (4805, 3178, 9), (4806, 3179, 102), (4807, 3180, 34), (4808, 3180, 99), (4809, 3181, 90), (4810, 3182, 25), (4811, 3182, 101), (4812, 3183, 86), (4813, 3184, 100), (4814, 3185, 98), (4815, 3185, 53), (4816, 3186, 11), (4817, 3187, 33), (4818, 3188, 73), (4819, 3188, 39), (4820, 3189, 51), (4821, 3189, 30), (4822, 3190, 31), (4823, 3191, 5), (4824, 3191, 39), (4825, 3192, 70), (4826, 3192, 85), (4827, 3193, 29), (4828, 3193, 96), (4829, 3194, 28), (4830, 3194, 33), (4831, 3195, 96), (4832, 3196, 74), (4833, 3196, 82), (4834, 3197, 7), (4835, 3197, 21), (4836, 3198, 65), (4837, 3198, 80), (4838, 3199, 38), (4839, 3200, 102), (4840, 3201, 52), (4841, 3202, 29), (4842, 3202, 10), (4843, 3203, 102), (4844, 3203, 40), (4845, 3204, 59), (4846, 3204, 97), (4847, 3205, 70), (4848, 3206, 87), (4849, 3206, 60), (4850, 3207, 27), (4851, 3207, 6), (4852, 3208, 52), (4853, 3209, 52), (4854, 3210, 72), (4855, 3211, 43), (4856, 3211, 3), (4857, 3212, 73), (4858, 3212, 37), (4859, 3213, 12), (4860, 3213, 50), (4861, 3214, 57), (4862, 3214, 15), (4863, 3215, 101), (4864, 3215, 95), (4865, 3216, 60), (4866, 3216, 53), (4867, 3217, 81), (4868, 3218, 57), (4869, 3218, 40), (4870, 3219, 86), (4871, 3220, 33), (4872, 3221, 89), (4873, 3221, 31), (4874, 3222, 99), (4875, 3223, 101), (4876, 3223, 23), (4877, 3224, 5), (4878, 3225, 56), (4879, 3225, 94), (4880, 3226, 64), (4881, 3227, 66), (4882, 3228, 85), (4883, 3229, 36), (4884, 3230, 11), (4885, 3230, 25), (4886, 3231, 43), (4887, 3232, 40), (4888, 3232, 37), (4889, 3233, 53), (4890, 3233, 60), (4891, 3234, 45), (4892, 3234, 46), (4893, 3235, 51), (4894, 3235, 14), (4895, 3236, 27), (4896, 3236, 40), (4897, 3237, 69), (4898, 3237, 72), (4899, 3238, 62), (4900, 3239, 55), (4901, 3240, 45), (4902, 3241, 7), (4903, 3241, 37), (4904, 3242, 46), (4905, 3242, 64), (4906, 3243, 54), (4907, 3243, 37), (4908, 3244, 40), (4909, 3245, 83), (4910, 3245, 91), (4911, 3246, 11), (4912, 3247, 12), (4913, 3248, 16), (4914, 3249, 76), (4915, 3250, 88), (4916, 3250, 5), (4917, 3251, 49), (4918, 3252, 98), (4919, 3252, 86), (4920, 3253, 42), (4921, 3254, 91), (4922, 3254, 37), (4923, 3255, 43), (4924, 3256, 93), (4925, 3256, 55), (4926, 3257, 43), (4927, 3258, 54), (4928, 3259, 97), (4929, 3260, 25), (4930, 3261, 42), (4931, 3262, 1), (4932, 3263, 19), (4933, 3264, 73), (4934, 3265, 74), (4935, 3266, 14), (4936, 3267, 64), (4937, 3267, 61), (4938, 3268, 51), (4939, 3268, 37), (4940, 3269, 8), (4941, 3270, 8), (4942, 3270, 13), (4943, 3271, 42), (4944, 3272, 14), (4945, 3273, 54), (4946, 3274, 70), (4947, 3275, 64), (4948, 3276, 103), (4949, 3277, 60), (4950, 3278, 85), (4951, 3278, 101), (4952, 3279, 86), (4953, 3280, 103), (4954, 3281, 58), (4955, 3282, 46), (4956, 3283, 61), (4957, 3284, 52), (4958, 3285, 61), (4959, 3286, 19), (4960, 3287, 43), (4961, 3288, 93), (4962, 3288, 35), (4963, 3289, 91), (4964, 3289, 35), (4965, 3290, 44), (4966, 3291, 77), (4967, 3292, 10), (4968, 3292, 56), (4969, 3293, 6), (4970, 3294, 64), (4971, 3294, 11), (4972, 3295, 56), (4973, 3296, 74), (4974, 3297, 90), (4975, 3298, 81), (4976, 3298, 48), (4977, 3299, 103), (4978, 3299, 53), (4979, 3300, 57), (4980, 3301, 88), (4981, 3301, 56), (4982, 3302, 21), (4983, 3302, 34), (4984, 3303, 59), (4985, 3303, 95), (4986, 3304, 40), (4987, 3305, 58), (4988, 3305, 102), (4989, 3306, 26), (4990, 3307, 83), (4991, 3308, 30), (4992, 3308, 12), (4993, 3309, 24), (4994, 3310, 93), (4995, 3310, 72), (4996, 3311, 18), (4997, 3312, 82), (4998, 3313, 83), (4999, 3313, 85), (5000, 3314, 77), (5001, 3315, 12), (5002, 3316, 99), (5003, 3317, 7), (5004, 3318, 90), (5005, 3318, 2), (5006, 3319, 100), (5007, 3319, 82), (5008, 3320, 40), (5009, 3321, 34), (5010, 3322, 54), (5011, 3322, 56), (5012, 3323, 12), (5013, 3323, 97);
+INSERT INTO livestream_tags (id, livestream_id, tag_id) VALUES (5014, 3324, 9), (5015, 3325, 101), (5016, 3326, 2), (5017, 3327, 71), (5018, 3328, 51), (5019, 3328, 3), (5020, 3329, 50), (5021, 3330, 10), (5022, 3331, 63), (5023, 3331, 61), (5024, 3332, 28), (5025, 3332, 21), (5026, 3333, 21), (5027, 3333, 71), (5028, 3334, 77), (5029, 3335, 61), (5030, 3335, 89), (5031, 3336, 101), (5032, 3336, 51), (5033, 3337, 88), (5034, 3338, 25), (5035, 3338, 48), (5036, 3339, 93), (5037, 3339, 79), (5038, 3340, 103), (5039, 3340, 93), (5040, 3341, 97), (5041, 3342, 71), (5042, 3342, 21), (5043, 3343, 92), (5044, 3343, 90), (5045, 3344, 66), (5046, 3344, 72), (5047, 3345, 22), (5048, 3345, 52), (5049, 3346, 60), (5050, 3347, 6), (5051, 3348, 86), (5052, 3349, 94), (5053, 3350, 37), (5054, 3350, 78), (5055, 3351, 43), (5056, 3352, 47), (5057, 3353, 103), (5058, 3353, 64), (5059, 3354, 48), (5060, 3354, 27), (5061, 3355, 46), (5062, 3356, 19), (5063, 3356, 11), (5064, 3357, 20), (5065, 3357, 49), (5066, 3358, 78), (5067, 3359, 21), (5068, 3360, 3), (5069, 3360, 36), (5070, 3361, 33), (5071, 3362, 88), (5072, 3363, 23), (5073, 3363, 93), (5074, 3364, 81), (5075, 3364, 9), (5076, 3365, 59), (5077, 3365, 83), (5078, 3366, 87), (5079, 3366, 90), (5080, 3367, 92), (5081, 3367, 68), (5082, 3368, 67), (5083, 3369, 49), (5084, 3370, 30), (5085, 3371, 39), (5086, 3371, 3), (5087, 3372, 38), (5088, 3373, 26), (5089, 3373, 97), (5090, 3374, 88), (5091, 3375, 99), (5092, 3375, 78), (5093, 3376, 73), (5094, 3376, 34), (5095, 3377, 61), (5096, 3377, 84), (5097, 3378, 25), (5098, 3379, 85), (5099, 3380, 20), (5100, 3380, 88), (5101, 3381, 101), (5102, 3381, 29), (5103, 3382, 1), (5104, 3382, 28), (5105, 3383, 22), (5106, 3384, 25), (5107, 3384, 39), (5108, 3385, 41), (5109, 3385, 84), (5110, 3386, 4), (5111, 3387, 30), (5112, 3388, 84), (5113, 3388, 12), (5114, 3389, 4), (5115, 3390, 86), (5116, 3391, 85), (5117, 3392, 31), (5118, 3393, 62), (5119, 3394, 62), (5120, 3395, 47), (5121, 3395, 97), (5122, 3396, 82), (5123, 3396, 80), (5124, 3397, 80), (5125, 3398, 89), (5126, 3399, 91), (5127, 3399, 39), (5128, 3400, 38), (5129, 3401, 16), (5130, 3401, 95), (5131, 3402, 56), (5132, 3403, 78), (5133, 3404, 78), (5134, 3405, 1), (5135, 3405, 51), (5136, 3406, 47), (5137, 3406, 94), (5138, 3407, 68), (5139, 3408, 34), (5140, 3409, 28), (5141, 3409, 77), (5142, 3410, 91), (5143, 3410, 56), (5144, 3411, 65), (5145, 3411, 97), (5146, 3412, 33), (5147, 3413, 63), (5148, 3414, 29), (5149, 3414, 91), (5150, 3415, 90), (5151, 3416, 43), (5152, 3417, 56), (5153, 3418, 70), (5154, 3418, 41), (5155, 3419, 40), (5156, 3419, 72), (5157, 3420, 20), (5158, 3420, 42), (5159, 3421, 86), (5160, 3422, 93), (5161, 3423, 33), (5162, 3423, 14), (5163, 3424, 39), (5164, 3424, 17), (5165, 3425, 83), (5166, 3425, 7), (5167, 3426, 1), (5168, 3427, 29), (5169, 3428, 71), (5170, 3428, 87), (5171, 3429, 91), (5172, 3429, 48), (5173, 3430, 71), (5174, 3430, 70), (5175, 3431, 82), (5176, 3431, 2), (5177, 3432, 96), (5178, 3433, 26), (5179, 3434, 62), (5180, 3434, 76), (5181, 3435, 87), (5182, 3436, 88), (5183, 3436, 96), (5184, 3437, 88), (5185, 3438, 45), (5186, 3439, 27), (5187, 3440, 79), (5188, 3440, 72), (5189, 3441, 94), (5190, 3442, 41), (5191, 3443, 14), (5192, 3443, 18), (5193, 3444, 88), (5194, 3444, 32), (5195, 3445, 88), (5196, 3445, 8), (5197, 3446, 11), (5198, 3446, 68), (5199, 3447, 103), (5200, 3448, 2), (5201, 3448, 46), (5202, 3449, 31), (5203, 3450, 6), (5204, 3451, 9), (5205, 3452, 20), (5206, 3453, 56), (5207, 3454, 57), (5208, 3454, 24), (5209, 3455, 16), (5210, 3456, 21), (5211, 3457, 7), (5212, 3458, 70), (5213, 3458, 30), (5214, 3459, 70), (5215, 3459, 54), (5216, 3460, 7), (5217, 3460, 2), (5218, 3461, 58), (5219, 3461, 7), (5220, 3462, 4), (5221, 3463, 38), (5222, 3463, 94), (5223, 3464, 10), (5224, 3464, 100), (5225, 3465, 93), (5226, 3465, 20), (5227, 3466, 47), (5228, 3467, 21), (5229, 3468, 44), (5230, 3469, 35), (5231, 3469, 38), (5232, 3470, 10), (5233, 3470, 35), (5234, 3471, 52), (5235, 3471, 78), (5236, 3472, 78), (5237, 3473, 97), (5238, 3473, 78), (5239, 3474, 6), (5240, 3474, 85), (5241, 3475, 66), (5242, 3476, 48), (5243, 3477, 90), (5244, 3477, 10), (5245, 3478, 15), (5246, 3479, 5), (5247, 3479, 97), (5248, 3480, 2), (5249, 3481, 23), (5250, 3482, 38), (5251, 3483, 85), (5252, 3483, 26), (5253, 3484, 64), (5254, 3484, 84), (5255, 3485, 10), (5256, 3486, 22), (5257, 3486, 49), (5258, 3487, 59), (5259, 3488, 49), (5260, 3489, 101), (5261, 3489, 100), (5262, 3490, 68), (5263, 3490, 46), (5264, 3491, 31), (5265, 3492, 20), (5266, 3493, 79), (5267, 3493, 62), (5268, 3494, 53), (5269, 3494, 99), (5270, 3495, 41), (5271, 3495, 87), (5272, 3496, 12), (5273, 3497, 60), (5274, 3497, 95), (5275, 3498, 24), (5276, 3498, 28), (5277, 3499, 101), (5278, 3500, 44), (5279, 3500, 58), (5280, 3501, 26), (5281, 3502, 60), (5282, 3503, 66), (5283, 3503, 87), (5284, 3504, 77), (5285, 3504, 11), (5286, 3505, 91), (5287, 3505, 97), (5288, 3506, 32), (5289, 3506, 95), (5290, 3507, 40), (5291, 3507, 6), (5292, 3508, 8), (5293, 3508, 42), (5294, 3509, 94), (5295, 3509, 11), (5296, 3510, 6), (5297, 3511, 76), (5298, 3511, 21), (5299, 3512, 25), (5300, 3513, 36), (5301, 3514, 60), (5302, 3515, 12), (5303, 3516, 28), (5304, 3517, 11), (5305, 3518, 88), (5306, 3519, 80), (5307, 3520, 30), (5308, 3521, 23), (5309, 3522, 73), (5310, 3523, 43), (5311, 3524, 17), (5312, 3525, 79), (5313, 3525, 58), (5314, 3526, 87), (5315, 3526, 74), (5316, 3527, 14), (5317, 3528, 44), (5318, 3529, 88), (5319, 3529, 93), (5320, 3530, 10), (5321, 3531, 67), (5322, 3531, 62), (5323, 3532, 89), (5324, 3532, 77), (5325, 3533, 68), (5326, 3534, 48), (5327, 3535, 67), (5328, 3535, 69), (5329, 3536, 91), (5330, 3537, 13), (5331, 3538, 94), (5332, 3538, 19), (5333, 3539, 101), (5334, 3540, 36), (5335, 3540, 46), (5336, 3541, 32), (5337, 3541, 34), (5338, 3542, 47), (5339, 3543, 91), (5340, 3544, 10), (5341, 3544, 79), (5342, 3545, 95), (5343, 3546, 96), (5344, 3546, 60), (5345, 3547, 97), (5346, 3548, 78), (5347, 3549, 91), (5348, 3550, 7), (5349, 3550, 99), (5350, 3551, 87), (5351, 3551, 68), (5352, 3552, 58), (5353, 3552, 43), (5354, 3553, 4), (5355, 3553, 44), (5356, 3554, 45), (5357, 3555, 22), (5358, 3556, 49), (5359, 3556, 44), (5360, 3557, 67), (5361, 3558, 76), (5362, 3559, 49), (5363, 3559, 54), (5364, 3560, 67), (5365, 3561, 102), (5366, 3561, 46), (5367, 3562, 63), (5368, 3563, 5), (5369, 3564, 94), (5370, 3564, 52), (5371, 3565, 97), (5372, 3566, 32), (5373, 3566, 21), (5374, 3567, 93), (5375, 3568, 16), (5376, 3568, 79), (5377, 3569, 28), (5378, 3570, 36), (5379, 3570, 51), (5380, 3571, 85), (5381, 3571, 36), (5382, 3572, 27), (5383, 3573, 4), (5384, 3573, 94), (5385, 3574, 45), (5386, 3574, 63), (5387, 3575, 62), (5388, 3575, 31), (5389, 3576, 70), (5390, 3577, 83), (5391, 3577, 51), (5392, 3578, 31), (5393, 3579, 29), (5394, 3579, 103), (5395, 3580, 94), (5396, 3581, 57), (5397, 3582, 83), (5398, 3583, 57), (5399, 3584, 78), (5400, 3585, 48), (5401, 3585, 101), (5402, 3586, 63), (5403, 3586, 99), (5404, 3587, 1), (5405, 3588, 41), (5406, 3588, 55), (5407, 3589, 62), (5408, 3589, 13), (5409, 3590, 98), (5410, 3591, 8), (5411, 3591, 26), (5412, 3592, 33), (5413, 3592, 67), (5414, 3593, 88), (5415, 3594, 92), (5416, 3594, 21), (5417, 3595, 7), (5418, 3596, 75), (5419, 3597, 101), (5420, 3597, 64), (5421, 3598, 11), (5422, 3599, 63), (5423, 3599, 51), (5424, 3600, 30), (5425, 3601, 100), (5426, 3602, 61), (5427, 3603, 42), (5428, 3603, 16), (5429, 3604, 12), (5430, 3605, 3), (5431, 3606, 39), (5432, 3607, 91), (5433, 3608, 11), (5434, 3608, 13), (5435, 3609, 78), (5436, 3609, 20), (5437, 3610, 68), (5438, 3610, 40), (5439, 3611, 87), (5440, 3612, 72), (5441, 3613, 86), (5442, 3614, 16), (5443, 3614, 9), (5444, 3615, 69), (5445, 3616, 44), (5446, 3617, 77), (5447, 3618, 44), (5448, 3619, 59), (5449, 3620, 76), (5450, 3621, 100), (5451, 3621, 73), (5452, 3622, 6), (5453, 3622, 63), (5454, 3623, 94), (5455, 3624, 33), (5456, 3625, 5), (5457, 3625, 25), (5458, 3626, 48), (5459, 3626, 14), (5460, 3627, 90), (5461, 3627, 93), (5462, 3628, 46), (5463, 3629, 1), (5464, 3629, 76), (5465, 3630, 60), (5466, 3631, 76), (5467, 3632, 96), (5468, 3632, 80), (5469, 3633, 13), (5470, 3634, 97), (5471, 3634, 94), (5472, 3635, 57), (5473, 3635, 13), (5474, 3636, 19), (5475, 3637, 73), (5476, 3637, 5), (5477, 3638, 98), (5478, 3639, 74), (5479, 3640, 23), (5480, 3640, 43), (5481, 3641, 17), (5482, 3642, 35), (5483, 3642, 53), (5484, 3643, 40), (5485, 3644, 30), (5486, 3644, 33), (5487, 3645, 30), (5488, 3645, 71), (5489, 3646, 19), (5490, 3646, 92), (5491, 3647, 62), (5492, 3647, 7), (5493, 3648, 60), (5494, 3649, 42), (5495, 3650, 95), (5496, 3650, 50), (5497, 3651, 76), (5498, 3651, 2), (5499, 3652, 8), (5500, 3652, 89), (5501, 3653, 38), (5502, 3653, 9), (5503, 3654, 45), (5504, 3655, 3), (5505, 3655, 57), (5506, 3656, 12), (5507, 3657, 8), (5508, 3658, 20), (5509, 3658, 1), (5510, 3659, 31), (5511, 3660, 70), (5512, 3661, 44), (5513, 3661, 79), (5514, 3662, 29);
+INSERT INTO livestream_tags (id, livestream_id, tag_id) VALUES (5515, 3663, 58), (5516, 3664, 30), (5517, 3664, 29), (5518, 3665, 99), (5519, 3666, 17), (5520, 3667, 62), (5521, 3668, 88), (5522, 3668, 15), (5523, 3669, 17), (5524, 3669, 93), (5525, 3670, 63), (5526, 3670, 96), (5527, 3671, 43), (5528, 3672, 1), (5529, 3673, 10), (5530, 3673, 1), (5531, 3674, 65), (5532, 3674, 38), (5533, 3675, 33), (5534, 3675, 76), (5535, 3676, 74), (5536, 3676, 42), (5537, 3677, 96), (5538, 3678, 100), (5539, 3679, 31), (5540, 3680, 84), (5541, 3681, 77), (5542, 3682, 63), (5543, 3683, 65), (5544, 3684, 58), (5545, 3684, 26), (5546, 3685, 88), (5547, 3685, 61), (5548, 3686, 71), (5549, 3686, 63), (5550, 3687, 29), (5551, 3687, 78), (5552, 3688, 58), (5553, 3689, 18), (5554, 3689, 14), (5555, 3690, 92), (5556, 3690, 29), (5557, 3691, 33), (5558, 3692, 92), (5559, 3693, 87), (5560, 3694, 34), (5561, 3695, 9), (5562, 3695, 20), (5563, 3696, 68), (5564, 3696, 62), (5565, 3697, 84), (5566, 3698, 98), (5567, 3698, 101), (5568, 3699, 45), (5569, 3699, 97), (5570, 3700, 5), (5571, 3701, 46), (5572, 3702, 73), (5573, 3703, 86), (5574, 3704, 84), (5575, 3705, 43), (5576, 3705, 99), (5577, 3706, 102), (5578, 3706, 36), (5579, 3707, 34), (5580, 3707, 82), (5581, 3708, 36), (5582, 3708, 45), (5583, 3709, 57), (5584, 3709, 78), (5585, 3710, 73), (5586, 3710, 31), (5587, 3711, 58), (5588, 3712, 85), (5589, 3713, 71), (5590, 3713, 84), (5591, 3714, 73), (5592, 3714, 31), (5593, 3715, 64), (5594, 3715, 27), (5595, 3716, 72), (5596, 3717, 10), (5597, 3717, 57), (5598, 3718, 9), (5599, 3719, 81), (5600, 3720, 25), (5601, 3721, 6), (5602, 3722, 15), (5603, 3723, 6), (5604, 3724, 63), (5605, 3724, 100), (5606, 3725, 16), (5607, 3726, 14), (5608, 3726, 102), (5609, 3727, 58), (5610, 3727, 62), (5611, 3728, 89), (5612, 3728, 102), (5613, 3729, 75), (5614, 3729, 85), (5615, 3730, 19), (5616, 3731, 102), (5617, 3732, 84), (5618, 3733, 5), (5619, 3734, 44), (5620, 3734, 76), (5621, 3735, 23), (5622, 3735, 92), (5623, 3736, 13), (5624, 3736, 75), (5625, 3737, 51), (5626, 3737, 92), (5627, 3738, 62), (5628, 3739, 5), (5629, 3740, 96), (5630, 3741, 48), (5631, 3741, 75), (5632, 3742, 40), (5633, 3743, 95), (5634, 3743, 65), (5635, 3744, 37), (5636, 3745, 53), (5637, 3746, 20), (5638, 3747, 87), (5639, 3747, 3), (5640, 3748, 11), (5641, 3748, 7), (5642, 3749, 23), (5643, 3749, 72), (5644, 3750, 38), (5645, 3751, 50), (5646, 3751, 74), (5647, 3752, 30), (5648, 3752, 68), (5649, 3753, 14), (5650, 3753, 82), (5651, 3754, 13), (5652, 3755, 77), (5653, 3755, 76), (5654, 3756, 82), (5655, 3757, 48), (5656, 3758, 21), (5657, 3758, 94), (5658, 3759, 45), (5659, 3759, 7), (5660, 3760, 45), (5661, 3761, 17), (5662, 3761, 62), (5663, 3762, 68), (5664, 3763, 88), (5665, 3764, 102), (5666, 3765, 89), (5667, 3765, 48), (5668, 3766, 26), (5669, 3767, 18), (5670, 3767, 59), (5671, 3768, 34), (5672, 3768, 50), (5673, 3769, 34), (5674, 3770, 34), (5675, 3770, 40), (5676, 3771, 41), (5677, 3772, 65), (5678, 3772, 54), (5679, 3773, 98), (5680, 3774, 4), (5681, 3774, 49), (5682, 3775, 52), (5683, 3775, 86), (5684, 3776, 15), (5685, 3777, 43), (5686, 3777, 8), (5687, 3778, 38), (5688, 3778, 4), (5689, 3779, 91), (5690, 3780, 44), (5691, 3781, 82), (5692, 3782, 80), (5693, 3783, 100), (5694, 3783, 86), (5695, 3784, 54), (5696, 3785, 47), (5697, 3786, 94), (5698, 3787, 29), (5699, 3787, 30), (5700, 3788, 74), (5701, 3788, 76), (5702, 3789, 10), (5703, 3789, 1), (5704, 3790, 98), (5705, 3791, 74), (5706, 3792, 69), (5707, 3793, 83), (5708, 3793, 84), (5709, 3794, 43), (5710, 3795, 43), (5711, 3795, 49), (5712, 3796, 33), (5713, 3797, 7), (5714, 3798, 45), (5715, 3798, 77), (5716, 3799, 17), (5717, 3799, 90), (5718, 3800, 67), (5719, 3800, 43), (5720, 3801, 99), (5721, 3802, 94), (5722, 3802, 15), (5723, 3803, 56), (5724, 3804, 56), (5725, 3805, 38), (5726, 3805, 97), (5727, 3806, 9), (5728, 3806, 87), (5729, 3807, 17), (5730, 3808, 14), (5731, 3808, 8), (5732, 3809, 28), (5733, 3809, 64), (5734, 3810, 27), (5735, 3811, 85), (5736, 3812, 40), (5737, 3812, 41), (5738, 3813, 42), (5739, 3814, 69), (5740, 3815, 37), (5741, 3816, 16), (5742, 3816, 101), (5743, 3817, 29), (5744, 3817, 51), (5745, 3818, 53), (5746, 3818, 61), (5747, 3819, 99), (5748, 3819, 23), (5749, 3820, 3), (5750, 3821, 24), (5751, 3821, 28), (5752, 3822, 34), (5753, 3822, 37), (5754, 3823, 4), (5755, 3824, 66), (5756, 3824, 56), (5757, 3825, 38), (5758, 3825, 58), (5759, 3826, 73), (5760, 3827, 103), (5761, 3827, 49), (5762, 3828, 71), (5763, 3829, 38), (5764, 3830, 5), (5765, 3830, 101), (5766, 3831, 11), (5767, 3831, 10), (5768, 3832, 100), (5769, 3832, 79), (5770, 3833, 22), (5771, 3833, 88), (5772, 3834, 37), (5773, 3834, 86), (5774, 3835, 23), (5775, 3836, 61), (5776, 3837, 1), (5777, 3838, 54), (5778, 3838, 73), (5779, 3839, 21), (5780, 3839, 19), (5781, 3840, 33), (5782, 3840, 69), (5783, 3841, 48), (5784, 3841, 43), (5785, 3842, 65), (5786, 3842, 87), (5787, 3843, 66), (5788, 3844, 68), (5789, 3844, 10), (5790, 3845, 11), (5791, 3846, 42), (5792, 3846, 103), (5793, 3847, 61), (5794, 3848, 8), (5795, 3849, 2), (5796, 3850, 59), (5797, 3851, 83), (5798, 3852, 76), (5799, 3853, 28), (5800, 3853, 79), (5801, 3854, 23), (5802, 3855, 17), (5803, 3856, 29), (5804, 3857, 40), (5805, 3857, 16), (5806, 3858, 43), (5807, 3859, 26), (5808, 3859, 59), (5809, 3860, 6), (5810, 3861, 23), (5811, 3862, 71), (5812, 3863, 73), (5813, 3864, 28), (5814, 3864, 78), (5815, 3865, 71), (5816, 3866, 90), (5817, 3866, 57), (5818, 3867, 60), (5819, 3868, 9), (5820, 3869, 22), (5821, 3870, 72), (5822, 3870, 23), (5823, 3871, 47), (5824, 3872, 9), (5825, 3872, 73), (5826, 3873, 76), (5827, 3874, 42), (5828, 3875, 30), (5829, 3876, 103), (5830, 3876, 15), (5831, 3877, 18), (5832, 3878, 55), (5833, 3878, 90), (5834, 3879, 60), (5835, 3879, 15), (5836, 3880, 22), (5837, 3881, 47), (5838, 3881, 90), (5839, 3882, 9), (5840, 3883, 40), (5841, 3884, 88), (5842, 3885, 43), (5843, 3886, 40), (5844, 3887, 53), (5845, 3888, 34), (5846, 3888, 71), (5847, 3889, 102), (5848, 3890, 8), (5849, 3890, 65), (5850, 3891, 29), (5851, 3892, 60), (5852, 3892, 21), (5853, 3893, 89), (5854, 3894, 82), (5855, 3894, 1), (5856, 3895, 79), (5857, 3896, 76), (5858, 3896, 77), (5859, 3897, 3), (5860, 3897, 41), (5861, 3898, 49), (5862, 3898, 44), (5863, 3899, 47), (5864, 3899, 8), (5865, 3900, 15), (5866, 3901, 55), (5867, 3901, 18), (5868, 3902, 28), (5869, 3902, 46), (5870, 3903, 86), (5871, 3903, 56), (5872, 3904, 43), (5873, 3905, 4), (5874, 3906, 71), (5875, 3906, 18), (5876, 3907, 54), (5877, 3908, 46), (5878, 3908, 103), (5879, 3909, 47), (5880, 3910, 81), (5881, 3911, 26), (5882, 3912, 71), (5883, 3913, 99), (5884, 3913, 29), (5885, 3914, 37), (5886, 3915, 62), (5887, 3916, 21), (5888, 3916, 80), (5889, 3917, 9), (5890, 3918, 50), (5891, 3919, 83), (5892, 3920, 94), (5893, 3921, 17), (5894, 3922, 90), (5895, 3923, 2), (5896, 3923, 97), (5897, 3924, 74), (5898, 3925, 57), (5899, 3926, 30), (5900, 3927, 5), (5901, 3928, 47), (5902, 3929, 42), (5903, 3930, 81), (5904, 3931, 32), (5905, 3931, 51), (5906, 3932, 43), (5907, 3932, 101), (5908, 3933, 75), (5909, 3934, 20), (5910, 3935, 12), (5911, 3936, 40), (5912, 3937, 86), (5913, 3937, 31), (5914, 3938, 68), (5915, 3939, 83), (5916, 3940, 92), (5917, 3941, 8), (5918, 3941, 53), (5919, 3942, 74), (5920, 3943, 89), (5921, 3944, 58), (5922, 3944, 89), (5923, 3945, 94), (5924, 3945, 97), (5925, 3946, 72), (5926, 3947, 38), (5927, 3948, 34), (5928, 3948, 10), (5929, 3949, 15), (5930, 3950, 38), (5931, 3951, 101), (5932, 3952, 1), (5933, 3953, 50), (5934, 3953, 55), (5935, 3954, 100), (5936, 3955, 89), (5937, 3955, 37), (5938, 3956, 97), (5939, 3956, 55), (5940, 3957, 97), (5941, 3958, 50), (5942, 3959, 57), (5943, 3959, 74), (5944, 3960, 39), (5945, 3961, 67), (5946, 3962, 58), (5947, 3962, 9), (5948, 3963, 56), (5949, 3964, 74), (5950, 3964, 34), (5951, 3965, 36), (5952, 3966, 52), (5953, 3966, 18), (5954, 3967, 51), (5955, 3968, 4), (5956, 3969, 84), (5957, 3969, 91), (5958, 3970, 93), (5959, 3971, 43), (5960, 3972, 80), (5961, 3973, 101), (5962, 3973, 33), (5963, 3974, 85), (5964, 3975, 55), (5965, 3976, 73), (5966, 3977, 62), (5967, 3978, 39), (5968, 3979, 64), (5969, 3979, 55), (5970, 3980, 76), (5971, 3981, 90), (5972, 3982, 86), (5973, 3982, 68), (5974, 3983, 4), (5975, 3984, 93), (5976, 3984, 102), (5977, 3985, 22), (5978, 3986, 19), (5979, 3986, 88), (5980, 3987, 70), (5981, 3987, 28), (5982, 3988, 1), (5983, 3989, 84), (5984, 3990, 4), (5985, 3990, 98), (5986, 3991, 73), (5987, 3991, 13), (5988, 3992, 72), (5989, 3992, 102), (5990, 3993, 99), (5991, 3993, 96), (5992, 3994, 19), (5993, 3994, 100), (5994, 3995, 30), (5995, 3996, 101), (5996, 3996, 48), (5997, 3997, 32), (5998, 3997, 57), (5999, 3998, 25), (6000, 3998, 58), (6001, 3999, 45), (6002, 3999, 7), (6003, 4000, 79), (6004, 4000, 87), (6005, 4001, 69), (6006, 4002, 69), (6007, 4002, 2), (6008, 4003, 62), (6009, 4004, 44), (6010, 4004, 101), (6011, 4005, 85), (6012, 4006, 86), (6013, 4006, 71), (6014, 4007, 86), (6015, 4008, 56);
+INSERT INTO livestream_tags (id, livestream_id, tag_id) VALUES (6016, 4009, 50), (6017, 4009, 28), (6018, 4010, 81), (6019, 4011, 72), (6020, 4011, 87), (6021, 4012, 50), (6022, 4013, 96), (6023, 4013, 64), (6024, 4014, 82), (6025, 4015, 95), (6026, 4015, 81), (6027, 4016, 65), (6028, 4017, 4), (6029, 4017, 10), (6030, 4018, 72), (6031, 4019, 45), (6032, 4019, 43), (6033, 4020, 21), (6034, 4020, 40), (6035, 4021, 60), (6036, 4022, 8), (6037, 4022, 69), (6038, 4023, 6), (6039, 4023, 90), (6040, 4024, 94), (6041, 4024, 36), (6042, 4025, 89), (6043, 4026, 80), (6044, 4027, 64), (6045, 4027, 82), (6046, 4028, 49), (6047, 4029, 103), (6048, 4029, 61), (6049, 4030, 88), (6050, 4030, 53), (6051, 4031, 4), (6052, 4031, 45), (6053, 4032, 54), (6054, 4033, 67), (6055, 4033, 6), (6056, 4034, 18), (6057, 4035, 65), (6058, 4035, 95), (6059, 4036, 80), (6060, 4036, 38), (6061, 4037, 93), (6062, 4038, 28), (6063, 4039, 92), (6064, 4039, 65), (6065, 4040, 87), (6066, 4040, 69), (6067, 4041, 90), (6068, 4042, 18), (6069, 4043, 87), (6070, 4043, 25), (6071, 4044, 53), (6072, 4045, 87), (6073, 4045, 5), (6074, 4046, 49), (6075, 4046, 98), (6076, 4047, 21), (6077, 4047, 102), (6078, 4048, 9), (6079, 4048, 65), (6080, 4049, 23), (6081, 4050, 2), (6082, 4051, 96), (6083, 4052, 62), (6084, 4052, 96), (6085, 4053, 101), (6086, 4053, 29), (6087, 4054, 92), (6088, 4055, 6), (6089, 4055, 51), (6090, 4056, 47), (6091, 4057, 38), (6092, 4057, 12), (6093, 4058, 96), (6094, 4059, 59), (6095, 4059, 57), (6096, 4060, 26), (6097, 4060, 55), (6098, 4061, 86), (6099, 4061, 56), (6100, 4062, 72), (6101, 4063, 39), (6102, 4064, 87), (6103, 4065, 96), (6104, 4066, 25), (6105, 4067, 31), (6106, 4068, 8), (6107, 4068, 18), (6108, 4069, 35), (6109, 4070, 85), (6110, 4070, 47), (6111, 4071, 44), (6112, 4072, 29), (6113, 4072, 83), (6114, 4073, 38), (6115, 4074, 65), (6116, 4075, 14), (6117, 4075, 44), (6118, 4076, 76), (6119, 4077, 26), (6120, 4078, 28), (6121, 4078, 47), (6122, 4079, 56), (6123, 4079, 96), (6124, 4080, 90), (6125, 4080, 20), (6126, 4081, 71), (6127, 4082, 28), (6128, 4083, 21), (6129, 4084, 48), (6130, 4084, 100), (6131, 4085, 82), (6132, 4085, 51), (6133, 4086, 94), (6134, 4087, 14), (6135, 4087, 74), (6136, 4088, 98), (6137, 4088, 93), (6138, 4089, 30), (6139, 4089, 103), (6140, 4090, 34), (6141, 4090, 31), (6142, 4091, 100), (6143, 4091, 59), (6144, 4092, 95), (6145, 4093, 88), (6146, 4094, 6), (6147, 4094, 78), (6148, 4095, 62), (6149, 4095, 51), (6150, 4096, 38), (6151, 4097, 70), (6152, 4098, 2), (6153, 4098, 18), (6154, 4099, 47), (6155, 4100, 11), (6156, 4101, 48), (6157, 4101, 84), (6158, 4102, 75), (6159, 4103, 37), (6160, 4103, 47), (6161, 4104, 40), (6162, 4105, 59), (6163, 4106, 58), (6164, 4106, 7), (6165, 4107, 94), (6166, 4108, 55), (6167, 4109, 38), (6168, 4109, 97), (6169, 4110, 90), (6170, 4110, 28), (6171, 4111, 103), (6172, 4112, 23), (6173, 4112, 42), (6174, 4113, 97), (6175, 4113, 84), (6176, 4114, 98), (6177, 4114, 54), (6178, 4115, 87), (6179, 4116, 10), (6180, 4117, 4), (6181, 4118, 81), (6182, 4119, 37), (6183, 4119, 31), (6184, 4120, 14), (6185, 4121, 33), (6186, 4121, 3), (6187, 4122, 36), (6188, 4123, 87), (6189, 4123, 24), (6190, 4124, 97), (6191, 4125, 16), (6192, 4126, 7), (6193, 4127, 83), (6194, 4127, 79), (6195, 4128, 19), (6196, 4129, 101), (6197, 4130, 17), (6198, 4130, 87), (6199, 4131, 76), (6200, 4131, 18), (6201, 4132, 24), (6202, 4133, 1), (6203, 4134, 35), (6204, 4135, 62), (6205, 4136, 10), (6206, 4137, 11), (6207, 4138, 45), (6208, 4139, 42), (6209, 4140, 27), (6210, 4141, 94), (6211, 4141, 16), (6212, 4142, 42), (6213, 4142, 34), (6214, 4143, 79), (6215, 4144, 47), (6216, 4145, 63), (6217, 4146, 50), (6218, 4147, 99), (6219, 4148, 32), (6220, 4149, 96), (6221, 4150, 37), (6222, 4150, 17), (6223, 4151, 92), (6224, 4152, 3), (6225, 4153, 88), (6226, 4154, 32), (6227, 4155, 45), (6228, 4155, 20), (6229, 4156, 95), (6230, 4157, 68), (6231, 4157, 63), (6232, 4158, 79), (6233, 4158, 88), (6234, 4159, 6), (6235, 4160, 89), (6236, 4161, 45), (6237, 4161, 48), (6238, 4162, 54), (6239, 4162, 38), (6240, 4163, 14), (6241, 4164, 51), (6242, 4164, 65), (6243, 4165, 14), (6244, 4165, 1), (6245, 4166, 95), (6246, 4166, 33), (6247, 4167, 51), (6248, 4167, 95), (6249, 4168, 31), (6250, 4168, 40), (6251, 4169, 98), (6252, 4169, 80), (6253, 4170, 48), (6254, 4170, 95), (6255, 4171, 69), (6256, 4172, 63), (6257, 4173, 33), (6258, 4173, 60), (6259, 4174, 59), (6260, 4175, 39), (6261, 4175, 74), (6262, 4176, 46), (6263, 4177, 61), (6264, 4178, 50), (6265, 4179, 54), (6266, 4180, 35), (6267, 4181, 14), (6268, 4182, 28), (6269, 4183, 15), (6270, 4183, 88), (6271, 4184, 33), (6272, 4185, 76), (6273, 4186, 55), (6274, 4186, 101), (6275, 4187, 32), (6276, 4188, 73), (6277, 4189, 13), (6278, 4190, 96), (6279, 4190, 57), (6280, 4191, 62), (6281, 4192, 5), (6282, 4192, 79), (6283, 4193, 50), (6284, 4193, 47), (6285, 4194, 7), (6286, 4194, 24), (6287, 4195, 61), (6288, 4195, 85), (6289, 4196, 28), (6290, 4196, 2), (6291, 4197, 65), (6292, 4197, 6), (6293, 4198, 98), (6294, 4198, 15), (6295, 4199, 24), (6296, 4200, 37), (6297, 4200, 20), (6298, 4201, 66), (6299, 4202, 7), (6300, 4202, 1), (6301, 4203, 56), (6302, 4204, 20), (6303, 4204, 60), (6304, 4205, 33), (6305, 4205, 53), (6306, 4206, 56), (6307, 4207, 23), (6308, 4207, 58), (6309, 4208, 31), (6310, 4209, 56), (6311, 4209, 81), (6312, 4210, 24), (6313, 4210, 6), (6314, 4211, 68), (6315, 4211, 96), (6316, 4212, 18), (6317, 4212, 57), (6318, 4213, 66), (6319, 4213, 27), (6320, 4214, 2), (6321, 4215, 53), (6322, 4216, 67), (6323, 4216, 70), (6324, 4217, 34), (6325, 4218, 31), (6326, 4219, 66), (6327, 4220, 100), (6328, 4220, 33), (6329, 4221, 82), (6330, 4222, 44), (6331, 4222, 24), (6332, 4223, 73), (6333, 4223, 95), (6334, 4224, 57), (6335, 4224, 72), (6336, 4225, 37), (6337, 4225, 92), (6338, 4226, 70), (6339, 4226, 16), (6340, 4227, 32), (6341, 4227, 46), (6342, 4228, 79), (6343, 4229, 8), (6344, 4230, 50), (6345, 4231, 11), (6346, 4231, 84), (6347, 4232, 87), (6348, 4232, 4), (6349, 4233, 12), (6350, 4234, 3), (6351, 4235, 20), (6352, 4236, 60), (6353, 4237, 4), (6354, 4238, 4), (6355, 4238, 61), (6356, 4239, 33), (6357, 4240, 84), (6358, 4241, 102), (6359, 4242, 17), (6360, 4242, 18), (6361, 4243, 12), (6362, 4243, 73), (6363, 4244, 30), (6364, 4245, 15), (6365, 4246, 81), (6366, 4246, 99), (6367, 4247, 32), (6368, 4248, 9), (6369, 4249, 69), (6370, 4250, 23), (6371, 4251, 69), (6372, 4252, 75), (6373, 4252, 24), (6374, 4253, 25), (6375, 4253, 62), (6376, 4254, 48), (6377, 4255, 65), (6378, 4256, 64), (6379, 4257, 4), (6380, 4258, 11), (6381, 4259, 36), (6382, 4260, 21), (6383, 4260, 32), (6384, 4261, 50), (6385, 4261, 74), (6386, 4262, 55), (6387, 4262, 56), (6388, 4263, 34), (6389, 4263, 91), (6390, 4264, 32), (6391, 4265, 38), (6392, 4265, 95), (6393, 4266, 15), (6394, 4266, 28), (6395, 4267, 44), (6396, 4268, 61), (6397, 4268, 88), (6398, 4269, 71), (6399, 4269, 59), (6400, 4270, 47), (6401, 4270, 37), (6402, 4271, 65), (6403, 4272, 33), (6404, 4273, 37), (6405, 4274, 29), (6406, 4275, 84), (6407, 4275, 58), (6408, 4276, 86), (6409, 4277, 11), (6410, 4277, 52), (6411, 4278, 22), (6412, 4279, 6), (6413, 4280, 45), (6414, 4280, 35), (6415, 4281, 20), (6416, 4282, 64), (6417, 4283, 33), (6418, 4284, 13), (6419, 4285, 25), (6420, 4286, 76), (6421, 4286, 27), (6422, 4287, 5), (6423, 4288, 25), (6424, 4288, 22), (6425, 4289, 100), (6426, 4290, 99), (6427, 4291, 32), (6428, 4292, 69), (6429, 4292, 92), (6430, 4293, 55), (6431, 4293, 16), (6432, 4294, 58), (6433, 4295, 59), (6434, 4295, 75), (6435, 4296, 59), (6436, 4297, 81), (6437, 4297, 83), (6438, 4298, 8), (6439, 4299, 71), (6440, 4300, 103), (6441, 4300, 102), (6442, 4301, 36), (6443, 4302, 102), (6444, 4302, 46), (6445, 4303, 80), (6446, 4304, 7), (6447, 4304, 73), (6448, 4305, 22), (6449, 4305, 71), (6450, 4306, 42), (6451, 4307, 42), (6452, 4308, 58), (6453, 4308, 93), (6454, 4309, 37), (6455, 4309, 5), (6456, 4310, 51), (6457, 4310, 30), (6458, 4311, 8), (6459, 4311, 70), (6460, 4312, 65), (6461, 4312, 102), (6462, 4313, 33), (6463, 4314, 34), (6464, 4314, 4), (6465, 4315, 57), (6466, 4316, 95), (6467, 4316, 12), (6468, 4317, 6), (6469, 4318, 56), (6470, 4319, 75), (6471, 4320, 102), (6472, 4321, 2), (6473, 4322, 40), (6474, 4323, 41), (6475, 4323, 99), (6476, 4324, 36), (6477, 4324, 32), (6478, 4325, 42), (6479, 4325, 7), (6480, 4326, 55), (6481, 4326, 36), (6482, 4327, 47), (6483, 4327, 71), (6484, 4328, 14), (6485, 4328, 99), (6486, 4329, 65), (6487, 4329, 39), (6488, 4330, 57), (6489, 4331, 24), (6490, 4332, 32), (6491, 4332, 45), (6492, 4333, 103), (6493, 4333, 15), (6494, 4334, 78), (6495, 4335, 96), (6496, 4335, 100), (6497, 4336, 72), (6498, 4337, 60), (6499, 4337, 27), (6500, 4338, 10), (6501, 4338, 19), (6502, 4339, 9), (6503, 4339, 99), (6504, 4340, 40), (6505, 4340, 58), (6506, 4341, 87), (6507, 4341, 16), (6508, 4342, 62), (6509, 4343, 78), (6510, 4343, 16), (6511, 4344, 41), (6512, 4345, 91), (6513, 4345, 68), (6514, 4346, 74), (6515, 4346, 13), (6516, 4347, 16);
+INSERT INTO livestream_tags (id, livestream_id, tag_id) VALUES (6517, 4348, 1), (6518, 4348, 80), (6519, 4349, 9), (6520, 4349, 22), (6521, 4350, 73), (6522, 4351, 1), (6523, 4351, 52), (6524, 4352, 60), (6525, 4352, 6), (6526, 4353, 32), (6527, 4353, 62), (6528, 4354, 92), (6529, 4354, 17), (6530, 4355, 38), (6531, 4355, 90), (6532, 4356, 42), (6533, 4356, 85), (6534, 4357, 64), (6535, 4358, 82), (6536, 4359, 67), (6537, 4360, 2), (6538, 4360, 9), (6539, 4361, 30), (6540, 4361, 81), (6541, 4362, 89), (6542, 4362, 25), (6543, 4363, 16), (6544, 4364, 69), (6545, 4365, 60), (6546, 4365, 39), (6547, 4366, 21), (6548, 4367, 18), (6549, 4367, 63), (6550, 4368, 46), (6551, 4369, 95), (6552, 4370, 26), (6553, 4370, 66), (6554, 4371, 78), (6555, 4371, 51), (6556, 4372, 13), (6557, 4372, 10), (6558, 4373, 86), (6559, 4373, 50), (6560, 4374, 70), (6561, 4374, 46), (6562, 4375, 21), (6563, 4375, 69), (6564, 4376, 20), (6565, 4377, 94), (6566, 4377, 63), (6567, 4378, 100), (6568, 4379, 57), (6569, 4380, 59), (6570, 4380, 98), (6571, 4381, 44), (6572, 4381, 46), (6573, 4382, 21), (6574, 4382, 1), (6575, 4383, 96), (6576, 4383, 44), (6577, 4384, 70), (6578, 4385, 57), (6579, 4385, 21), (6580, 4386, 49), (6581, 4386, 56), (6582, 4387, 27), (6583, 4387, 97), (6584, 4388, 40), (6585, 4388, 82), (6586, 4389, 28), (6587, 4389, 93), (6588, 4390, 50), (6589, 4391, 54), (6590, 4392, 99), (6591, 4393, 63), (6592, 4393, 77), (6593, 4394, 90), (6594, 4395, 63), (6595, 4395, 58), (6596, 4396, 72), (6597, 4397, 101), (6598, 4398, 47), (6599, 4399, 52), (6600, 4400, 51), (6601, 4400, 30), (6602, 4401, 61), (6603, 4401, 79), (6604, 4402, 82), (6605, 4402, 64), (6606, 4403, 62), (6607, 4403, 101), (6608, 4404, 76), (6609, 4404, 43), (6610, 4405, 92), (6611, 4406, 95), (6612, 4407, 60), (6613, 4407, 5), (6614, 4408, 35), (6615, 4408, 80), (6616, 4409, 62), (6617, 4409, 52), (6618, 4410, 80), (6619, 4411, 63), (6620, 4411, 40), (6621, 4412, 54), (6622, 4413, 73), (6623, 4414, 94), (6624, 4414, 50), (6625, 4415, 95), (6626, 4415, 87), (6627, 4416, 68), (6628, 4416, 101), (6629, 4417, 18), (6630, 4418, 29), (6631, 4418, 87), (6632, 4419, 38), (6633, 4420, 50), (6634, 4420, 102), (6635, 4421, 57), (6636, 4422, 9), (6637, 4423, 69), (6638, 4424, 12), (6639, 4424, 70), (6640, 4425, 94), (6641, 4425, 59), (6642, 4426, 99), (6643, 4427, 50), (6644, 4427, 88), (6645, 4428, 7), (6646, 4429, 37), (6647, 4429, 18), (6648, 4430, 29), (6649, 4430, 28), (6650, 4431, 40), (6651, 4432, 23), (6652, 4432, 11), (6653, 4433, 48), (6654, 4433, 15), (6655, 4434, 1), (6656, 4434, 13), (6657, 4435, 103), (6658, 4436, 13), (6659, 4436, 41), (6660, 4437, 24), (6661, 4437, 75), (6662, 4438, 57), (6663, 4438, 15), (6664, 4439, 87), (6665, 4439, 40), (6666, 4440, 78), (6667, 4440, 28), (6668, 4441, 91), (6669, 4441, 73), (6670, 4442, 35), (6671, 4443, 63), (6672, 4444, 4), (6673, 4444, 78), (6674, 4445, 95), (6675, 4445, 48), (6676, 4446, 103), (6677, 4446, 39), (6678, 4447, 72), (6679, 4447, 42), (6680, 4448, 88), (6681, 4449, 11), (6682, 4449, 16), (6683, 4450, 27), (6684, 4450, 60), (6685, 4451, 74), (6686, 4451, 40), (6687, 4452, 31), (6688, 4453, 50), (6689, 4453, 64), (6690, 4454, 79), (6691, 4454, 59), (6692, 4455, 92), (6693, 4456, 25), (6694, 4457, 69), (6695, 4457, 73), (6696, 4458, 86), (6697, 4458, 79), (6698, 4459, 19), (6699, 4460, 48), (6700, 4460, 96), (6701, 4461, 74), (6702, 4462, 76), (6703, 4462, 35), (6704, 4463, 83), (6705, 4464, 49), (6706, 4464, 33), (6707, 4465, 3), (6708, 4465, 99), (6709, 4466, 47), (6710, 4466, 16), (6711, 4467, 86), (6712, 4468, 12), (6713, 4468, 87), (6714, 4469, 22), (6715, 4470, 101), (6716, 4471, 98), (6717, 4472, 98), (6718, 4472, 43), (6719, 4473, 33), (6720, 4473, 3), (6721, 4474, 77), (6722, 4475, 32), (6723, 4476, 16), (6724, 4477, 18), (6725, 4477, 103), (6726, 4478, 34), (6727, 4479, 102), (6728, 4480, 38), (6729, 4481, 97), (6730, 4481, 54), (6731, 4482, 86), (6732, 4482, 102), (6733, 4483, 85), (6734, 4484, 98), (6735, 4485, 92), (6736, 4485, 88), (6737, 4486, 29), (6738, 4486, 55), (6739, 4487, 25), (6740, 4487, 3), (6741, 4488, 6), (6742, 4488, 44), (6743, 4489, 87), (6744, 4490, 22), (6745, 4491, 23), (6746, 4491, 34), (6747, 4492, 28), (6748, 4493, 13), (6749, 4494, 72), (6750, 4495, 80), (6751, 4496, 34), (6752, 4496, 72), (6753, 4497, 34), (6754, 4498, 6), (6755, 4498, 14), (6756, 4499, 10), (6757, 4500, 57), (6758, 4501, 74), (6759, 4502, 39), (6760, 4503, 47), (6761, 4503, 98), (6762, 4504, 34), (6763, 4504, 37), (6764, 4505, 70), (6765, 4505, 8), (6766, 4506, 85), (6767, 4506, 80), (6768, 4507, 16), (6769, 4507, 45), (6770, 4508, 79), (6771, 4509, 18), (6772, 4510, 79), (6773, 4510, 3), (6774, 4511, 95), (6775, 4511, 84), (6776, 4512, 58), (6777, 4512, 25), (6778, 4513, 83), (6779, 4513, 31), (6780, 4514, 97), (6781, 4515, 2), (6782, 4516, 45), (6783, 4516, 50), (6784, 4517, 93), (6785, 4518, 6), (6786, 4518, 93), (6787, 4519, 88), (6788, 4520, 97), (6789, 4520, 7), (6790, 4521, 59), (6791, 4522, 49), (6792, 4522, 45), (6793, 4523, 12), (6794, 4523, 26), (6795, 4524, 51), (6796, 4525, 13), (6797, 4526, 15), (6798, 4526, 90), (6799, 4527, 45), (6800, 4528, 74), (6801, 4528, 6), (6802, 4529, 59), (6803, 4529, 60), (6804, 4530, 96), (6805, 4531, 94), (6806, 4532, 43), (6807, 4533, 74), (6808, 4534, 74), (6809, 4534, 21), (6810, 4535, 100), (6811, 4536, 76), (6812, 4536, 71), (6813, 4537, 97), (6814, 4538, 33), (6815, 4538, 54), (6816, 4539, 29), (6817, 4540, 35), (6818, 4541, 38), (6819, 4542, 98), (6820, 4542, 62), (6821, 4543, 30), (6822, 4543, 24), (6823, 4544, 71), (6824, 4544, 96), (6825, 4545, 96), (6826, 4546, 22), (6827, 4546, 86), (6828, 4547, 24), (6829, 4548, 87), (6830, 4549, 31), (6831, 4550, 24), (6832, 4550, 64), (6833, 4551, 26), (6834, 4552, 100), (6835, 4553, 72), (6836, 4554, 85), (6837, 4555, 13), (6838, 4555, 62), (6839, 4556, 34), (6840, 4557, 21), (6841, 4557, 13), (6842, 4558, 29), (6843, 4559, 85), (6844, 4559, 48), (6845, 4560, 17), (6846, 4560, 49), (6847, 4561, 72), (6848, 4561, 81), (6849, 4562, 103), (6850, 4563, 2), (6851, 4564, 44), (6852, 4564, 10), (6853, 4565, 19), (6854, 4565, 103), (6855, 4566, 32), (6856, 4566, 49), (6857, 4567, 95), (6858, 4568, 66), (6859, 4568, 44), (6860, 4569, 7), (6861, 4570, 38), (6862, 4571, 91), (6863, 4571, 4), (6864, 4572, 50), (6865, 4573, 27), (6866, 4573, 2), (6867, 4574, 52), (6868, 4575, 87), (6869, 4575, 34), (6870, 4576, 61), (6871, 4577, 6), (6872, 4578, 88), (6873, 4579, 10), (6874, 4579, 67), (6875, 4580, 52), (6876, 4581, 53), (6877, 4582, 97), (6878, 4582, 94), (6879, 4583, 52), (6880, 4583, 53), (6881, 4584, 63), (6882, 4584, 40), (6883, 4585, 74), (6884, 4585, 96), (6885, 4586, 99), (6886, 4586, 27), (6887, 4587, 35), (6888, 4588, 12), (6889, 4589, 87), (6890, 4590, 23), (6891, 4591, 40), (6892, 4592, 70), (6893, 4593, 80), (6894, 4593, 66), (6895, 4594, 54), (6896, 4595, 67), (6897, 4596, 43), (6898, 4597, 55), (6899, 4598, 99), (6900, 4599, 90), (6901, 4600, 10), (6902, 4600, 41), (6903, 4601, 28), (6904, 4602, 36), (6905, 4603, 72), (6906, 4603, 43), (6907, 4604, 48), (6908, 4605, 95), (6909, 4606, 38), (6910, 4607, 57), (6911, 4608, 63), (6912, 4608, 86), (6913, 4609, 39), (6914, 4609, 58), (6915, 4610, 89), (6916, 4611, 81), (6917, 4611, 37), (6918, 4612, 25), (6919, 4613, 49), (6920, 4613, 65), (6921, 4614, 57), (6922, 4614, 60), (6923, 4615, 9), (6924, 4615, 48), (6925, 4616, 67), (6926, 4616, 58), (6927, 4617, 82), (6928, 4617, 45), (6929, 4618, 100), (6930, 4618, 70), (6931, 4619, 89), (6932, 4619, 85), (6933, 4620, 74), (6934, 4620, 86), (6935, 4621, 77), (6936, 4621, 43), (6937, 4622, 38), (6938, 4623, 29), (6939, 4624, 39), (6940, 4625, 78), (6941, 4626, 21), (6942, 4627, 20), (6943, 4628, 39), (6944, 4628, 55), (6945, 4629, 27), (6946, 4630, 41), (6947, 4631, 66), (6948, 4632, 86), (6949, 4632, 19), (6950, 4633, 15), (6951, 4634, 95), (6952, 4634, 19), (6953, 4635, 37), (6954, 4635, 11), (6955, 4636, 20), (6956, 4637, 69), (6957, 4637, 86), (6958, 4638, 20), (6959, 4639, 42), (6960, 4639, 102), (6961, 4640, 64), (6962, 4641, 83), (6963, 4642, 46), (6964, 4642, 37), (6965, 4643, 8), (6966, 4644, 28), (6967, 4644, 99), (6968, 4645, 49), (6969, 4646, 80), (6970, 4646, 88), (6971, 4647, 62), (6972, 4648, 23), (6973, 4649, 55), (6974, 4650, 71), (6975, 4651, 52), (6976, 4651, 95), (6977, 4652, 7), (6978, 4652, 4), (6979, 4653, 54), (6980, 4653, 101), (6981, 4654, 37), (6982, 4654, 9), (6983, 4655, 21), (6984, 4655, 44), (6985, 4656, 40), (6986, 4656, 94), (6987, 4657, 85), (6988, 4657, 90), (6989, 4658, 82), (6990, 4659, 47), (6991, 4660, 18), (6992, 4661, 86), (6993, 4661, 73), (6994, 4662, 7), (6995, 4662, 16), (6996, 4663, 24), (6997, 4663, 43), (6998, 4664, 66), (6999, 4664, 95), (7000, 4665, 43), (7001, 4665, 92), (7002, 4666, 79), (7003, 4667, 94), (7004, 4667, 99), (7005, 4668, 7), (7006, 4669, 59), (7007, 4670, 84), (7008, 4671, 18), (7009, 4671, 50), (7010, 4672, 47), (7011, 4672, 31), (7012, 4673, 40), (7013, 4673, 10), (7014, 4674, 63), (7015, 4674, 103), (7016, 4675, 60), (7017, 4676, 22);
+INSERT INTO livestream_tags (id, livestream_id, tag_id) VALUES (7018, 4677, 72), (7019, 4678, 31), (7020, 4678, 62), (7021, 4679, 1), (7022, 4679, 42), (7023, 4680, 57), (7024, 4680, 46), (7025, 4681, 43), (7026, 4682, 22), (7027, 4683, 102), (7028, 4684, 75), (7029, 4685, 8), (7030, 4685, 97), (7031, 4686, 77), (7032, 4687, 30), (7033, 4687, 98), (7034, 4688, 95), (7035, 4688, 88), (7036, 4689, 69), (7037, 4689, 86), (7038, 4690, 89), (7039, 4690, 56), (7040, 4691, 7), (7041, 4691, 68), (7042, 4692, 29), (7043, 4692, 52), (7044, 4693, 64), (7045, 4694, 10), (7046, 4694, 75), (7047, 4695, 14), (7048, 4696, 41), (7049, 4697, 41), (7050, 4698, 55), (7051, 4698, 6), (7052, 4699, 58), (7053, 4699, 101), (7054, 4700, 82), (7055, 4700, 54), (7056, 4701, 21), (7057, 4701, 26), (7058, 4702, 98), (7059, 4703, 20), (7060, 4703, 42), (7061, 4704, 90), (7062, 4705, 60), (7063, 4705, 72), (7064, 4706, 50), (7065, 4706, 75), (7066, 4707, 97), (7067, 4707, 82), (7068, 4708, 103), (7069, 4708, 78), (7070, 4709, 17), (7071, 4709, 29), (7072, 4710, 103), (7073, 4710, 32), (7074, 4711, 93), (7075, 4711, 97), (7076, 4712, 6), (7077, 4712, 20), (7078, 4713, 97), (7079, 4714, 23), (7080, 4714, 96), (7081, 4715, 101), (7082, 4716, 54), (7083, 4717, 56), (7084, 4717, 17), (7085, 4718, 44), (7086, 4719, 7), (7087, 4719, 23), (7088, 4720, 4), (7089, 4720, 103), (7090, 4721, 86), (7091, 4721, 61), (7092, 4722, 1), (7093, 4723, 4), (7094, 4723, 52), (7095, 4724, 42), (7096, 4725, 4), (7097, 4726, 78), (7098, 4727, 98), (7099, 4727, 89), (7100, 4728, 18), (7101, 4729, 9), (7102, 4729, 17), (7103, 4730, 7), (7104, 4730, 3), (7105, 4731, 57), (7106, 4732, 90), (7107, 4733, 75), (7108, 4734, 54), (7109, 4735, 103), (7110, 4736, 82), (7111, 4737, 2), (7112, 4738, 15), (7113, 4739, 19), (7114, 4739, 10), (7115, 4740, 11), (7116, 4740, 79), (7117, 4741, 93), (7118, 4742, 26), (7119, 4743, 75), (7120, 4743, 32), (7121, 4744, 8), (7122, 4744, 34), (7123, 4745, 93), (7124, 4746, 20), (7125, 4747, 48), (7126, 4748, 69), (7127, 4749, 37), (7128, 4750, 76), (7129, 4751, 88), (7130, 4752, 74), (7131, 4753, 35), (7132, 4753, 93), (7133, 4754, 74), (7134, 4755, 25), (7135, 4756, 51), (7136, 4756, 66), (7137, 4757, 103), (7138, 4757, 7), (7139, 4758, 63), (7140, 4759, 69), (7141, 4760, 5), (7142, 4760, 100), (7143, 4761, 101), (7144, 4762, 48), (7145, 4762, 69), (7146, 4763, 11), (7147, 4763, 60), (7148, 4764, 61), (7149, 4764, 3), (7150, 4765, 41), (7151, 4765, 92), (7152, 4766, 68), (7153, 4767, 43), (7154, 4768, 67), (7155, 4768, 61), (7156, 4769, 77), (7157, 4770, 50), (7158, 4770, 48), (7159, 4771, 50), (7160, 4772, 86), (7161, 4773, 3), (7162, 4773, 6), (7163, 4774, 74), (7164, 4774, 38), (7165, 4775, 45), (7166, 4776, 63), (7167, 4776, 33), (7168, 4777, 62), (7169, 4778, 83), (7170, 4779, 100), (7171, 4780, 83), (7172, 4781, 55), (7173, 4782, 20), (7174, 4782, 58), (7175, 4783, 3), (7176, 4784, 19), (7177, 4784, 15), (7178, 4785, 33), (7179, 4785, 34), (7180, 4786, 67), (7181, 4787, 18), (7182, 4787, 74), (7183, 4788, 50), (7184, 4789, 58), (7185, 4790, 16), (7186, 4791, 35), (7187, 4792, 22), (7188, 4792, 95), (7189, 4793, 90), (7190, 4793, 38), (7191, 4794, 20), (7192, 4795, 94), (7193, 4796, 15), (7194, 4796, 86), (7195, 4797, 15), (7196, 4798, 52), (7197, 4799, 97), (7198, 4800, 93), (7199, 4801, 25), (7200, 4801, 1), (7201, 4802, 85), (7202, 4803, 53), (7203, 4803, 22), (7204, 4804, 58), (7205, 4805, 75), (7206, 4805, 44), (7207, 4806, 7), (7208, 4807, 14), (7209, 4807, 1), (7210, 4808, 92), (7211, 4809, 22), (7212, 4810, 40), (7213, 4811, 64), (7214, 4812, 13), (7215, 4813, 94), (7216, 4814, 6), (7217, 4814, 97), (7218, 4815, 11), (7219, 4815, 43), (7220, 4816, 60), (7221, 4816, 41), (7222, 4817, 39), (7223, 4817, 87), (7224, 4818, 11), (7225, 4819, 67), (7226, 4819, 39), (7227, 4820, 58), (7228, 4821, 23), (7229, 4821, 35), (7230, 4822, 43), (7231, 4822, 50), (7232, 4823, 31), (7233, 4823, 26), (7234, 4824, 93), (7235, 4824, 59), (7236, 4825, 68), (7237, 4826, 33), (7238, 4827, 46), (7239, 4827, 88), (7240, 4828, 55), (7241, 4828, 18), (7242, 4829, 6), (7243, 4829, 61), (7244, 4830, 87), (7245, 4831, 63), (7246, 4831, 45), (7247, 4832, 103), (7248, 4832, 56), (7249, 4833, 66), (7250, 4833, 56), (7251, 4834, 45), (7252, 4835, 2), (7253, 4836, 61), (7254, 4836, 40), (7255, 4837, 43), (7256, 4837, 102), (7257, 4838, 48), (7258, 4839, 25), (7259, 4839, 42), (7260, 4840, 74), (7261, 4840, 81), (7262, 4841, 38), (7263, 4842, 38), (7264, 4842, 6), (7265, 4843, 44), (7266, 4843, 46), (7267, 4844, 13), (7268, 4844, 26), (7269, 4845, 27), (7270, 4845, 21), (7271, 4846, 68), (7272, 4846, 15), (7273, 4847, 4), (7274, 4848, 101), (7275, 4848, 97), (7276, 4849, 70), (7277, 4850, 50), (7278, 4851, 70), (7279, 4851, 29), (7280, 4852, 25), (7281, 4852, 86), (7282, 4853, 78), (7283, 4853, 24), (7284, 4854, 100), (7285, 4854, 101), (7286, 4855, 6), (7287, 4855, 38), (7288, 4856, 8), (7289, 4857, 88), (7290, 4857, 87), (7291, 4858, 53), (7292, 4858, 14), (7293, 4859, 74), (7294, 4860, 96), (7295, 4861, 2), (7296, 4862, 65), (7297, 4862, 58), (7298, 4863, 94), (7299, 4863, 47), (7300, 4864, 60), (7301, 4864, 94), (7302, 4865, 3), (7303, 4865, 56), (7304, 4866, 50), (7305, 4867, 3), (7306, 4868, 74), (7307, 4869, 51), (7308, 4869, 100), (7309, 4870, 71), (7310, 4871, 25), (7311, 4871, 55), (7312, 4872, 39), (7313, 4873, 15), (7314, 4873, 46), (7315, 4874, 42), (7316, 4875, 50), (7317, 4875, 92), (7318, 4876, 72), (7319, 4876, 63), (7320, 4877, 98), (7321, 4878, 95), (7322, 4879, 14), (7323, 4880, 22), (7324, 4880, 46), (7325, 4881, 27), (7326, 4881, 29), (7327, 4882, 4), (7328, 4883, 81), (7329, 4883, 53), (7330, 4884, 87), (7331, 4885, 88), (7332, 4886, 29), (7333, 4887, 67), (7334, 4888, 93), (7335, 4888, 86), (7336, 4889, 59), (7337, 4890, 70), (7338, 4891, 49), (7339, 4892, 79), (7340, 4892, 95), (7341, 4893, 11), (7342, 4893, 43), (7343, 4894, 15), (7344, 4894, 99), (7345, 4895, 14), (7346, 4896, 53), (7347, 4897, 13), (7348, 4897, 61), (7349, 4898, 15), (7350, 4898, 91), (7351, 4899, 63), (7352, 4899, 35), (7353, 4900, 86), (7354, 4900, 54), (7355, 4901, 94), (7356, 4901, 82), (7357, 4902, 91), (7358, 4903, 82), (7359, 4903, 103), (7360, 4904, 12), (7361, 4904, 75), (7362, 4905, 24), (7363, 4905, 97), (7364, 4906, 31), (7365, 4906, 60), (7366, 4907, 4), (7367, 4908, 102), (7368, 4909, 8), (7369, 4910, 24), (7370, 4911, 66), (7371, 4911, 85), (7372, 4912, 65), (7373, 4913, 18), (7374, 4914, 47), (7375, 4914, 69), (7376, 4915, 9), (7377, 4916, 86), (7378, 4916, 12), (7379, 4917, 6), (7380, 4918, 70), (7381, 4919, 56), (7382, 4919, 54), (7383, 4920, 19), (7384, 4920, 86), (7385, 4921, 56), (7386, 4921, 52), (7387, 4922, 101), (7388, 4923, 51), (7389, 4924, 4), (7390, 4925, 64), (7391, 4925, 37), (7392, 4926, 65), (7393, 4926, 95), (7394, 4927, 32), (7395, 4928, 26), (7396, 4928, 16), (7397, 4929, 102), (7398, 4930, 91), (7399, 4931, 18), (7400, 4932, 43), (7401, 4932, 79), (7402, 4933, 42), (7403, 4934, 45), (7404, 4935, 5), (7405, 4935, 47), (7406, 4936, 74), (7407, 4936, 66), (7408, 4937, 60), (7409, 4938, 62), (7410, 4938, 14), (7411, 4939, 99), (7412, 4939, 5), (7413, 4940, 56), (7414, 4940, 12), (7415, 4941, 35), (7416, 4941, 52), (7417, 4942, 95), (7418, 4942, 47), (7419, 4943, 83), (7420, 4943, 84), (7421, 4944, 86), (7422, 4945, 68), (7423, 4946, 4), (7424, 4946, 56), (7425, 4947, 45), (7426, 4948, 26), (7427, 4949, 30), (7428, 4949, 19), (7429, 4950, 5), (7430, 4951, 97), (7431, 4951, 38), (7432, 4952, 33), (7433, 4953, 63), (7434, 4953, 42), (7435, 4954, 50), (7436, 4954, 65), (7437, 4955, 44), (7438, 4956, 33), (7439, 4957, 66), (7440, 4958, 82), (7441, 4959, 38), (7442, 4959, 4), (7443, 4960, 29), (7444, 4961, 13), (7445, 4962, 103), (7446, 4963, 84), (7447, 4964, 49), (7448, 4965, 13), (7449, 4965, 70), (7450, 4966, 71), (7451, 4967, 18), (7452, 4967, 103), (7453, 4968, 3), (7454, 4969, 101), (7455, 4969, 64), (7456, 4970, 86), (7457, 4971, 8), (7458, 4971, 23), (7459, 4972, 76), (7460, 4972, 59), (7461, 4973, 18), (7462, 4974, 96), (7463, 4974, 43), (7464, 4975, 49), (7465, 4975, 83), (7466, 4976, 98), (7467, 4976, 47), (7468, 4977, 35), (7469, 4978, 85), (7470, 4978, 24), (7471, 4979, 9), (7472, 4980, 60), (7473, 4981, 38), (7474, 4982, 86), (7475, 4983, 72), (7476, 4984, 9), (7477, 4985, 9), (7478, 4986, 97), (7479, 4987, 88), (7480, 4988, 16), (7481, 4989, 76), (7482, 4990, 59), (7483, 4991, 16), (7484, 4991, 67), (7485, 4992, 69), (7486, 4992, 48), (7487, 4993, 32), (7488, 4994, 67), (7489, 4994, 77), (7490, 4995, 29), (7491, 4996, 46), (7492, 4996, 4), (7493, 4997, 60), (7494, 4998, 33), (7495, 4999, 10), (7496, 5000, 54), (7497, 5000, 40), (7498, 5001, 73), (7499, 5002, 59), (7500, 5002, 51), (7501, 5003, 38), (7502, 5004, 1), (7503, 5005, 22), (7504, 5006, 67), (7505, 5006, 49), (7506, 5007, 94), (7507, 5007, 80), (7508, 5008, 66), (7509, 5009, 99), (7510, 5009, 59), (7511, 5010, 18), (7512, 5011, 76), (7513, 5011, 85), (7514, 5012, 25), (7515, 5012, 41), (7516, 5013, 70), (7517, 5014, 40), (7518, 5015, 89);
+INSERT INTO livestream_tags (id, livestream_id, tag_id) VALUES (7519, 5016, 92), (7520, 5017, 40), (7521, 5018, 22), (7522, 5018, 65), (7523, 5019, 72), (7524, 5020, 17), (7525, 5021, 84), (7526, 5021, 3), (7527, 5022, 29), (7528, 5023, 1), (7529, 5023, 71), (7530, 5024, 36), (7531, 5024, 59), (7532, 5025, 36), (7533, 5026, 77), (7534, 5026, 33), (7535, 5027, 73), (7536, 5027, 27), (7537, 5028, 70), (7538, 5028, 76), (7539, 5029, 100), (7540, 5029, 101), (7541, 5030, 95), (7542, 5031, 66), (7543, 5031, 34), (7544, 5032, 82), (7545, 5033, 46), (7546, 5034, 17), (7547, 5035, 52), (7548, 5036, 44), (7549, 5036, 46), (7550, 5037, 35), (7551, 5037, 9), (7552, 5038, 9), (7553, 5038, 28), (7554, 5039, 57), (7555, 5039, 66), (7556, 5040, 6), (7557, 5040, 97), (7558, 5041, 55), (7559, 5041, 102), (7560, 5042, 90), (7561, 5043, 100), (7562, 5043, 98), (7563, 5044, 93), (7564, 5045, 14), (7565, 5046, 33), (7566, 5046, 58), (7567, 5047, 24), (7568, 5048, 62), (7569, 5049, 69), (7570, 5049, 56), (7571, 5050, 55), (7572, 5050, 49), (7573, 5051, 100), (7574, 5052, 52), (7575, 5053, 25), (7576, 5054, 71), (7577, 5055, 95), (7578, 5055, 28), (7579, 5056, 1), (7580, 5056, 67), (7581, 5057, 59), (7582, 5057, 56), (7583, 5058, 62), (7584, 5058, 42), (7585, 5059, 66), (7586, 5059, 82), (7587, 5060, 99), (7588, 5061, 67), (7589, 5062, 43), (7590, 5062, 58), (7591, 5063, 47), (7592, 5063, 25), (7593, 5064, 9), (7594, 5064, 98), (7595, 5065, 85), (7596, 5065, 2), (7597, 5066, 85), (7598, 5066, 26), (7599, 5067, 61), (7600, 5068, 84), (7601, 5069, 8), (7602, 5069, 31), (7603, 5070, 53), (7604, 5070, 8), (7605, 5071, 19), (7606, 5072, 84), (7607, 5073, 99), (7608, 5073, 25), (7609, 5074, 56), (7610, 5075, 20), (7611, 5075, 81), (7612, 5076, 76), (7613, 5076, 34), (7614, 5077, 64), (7615, 5078, 43), (7616, 5078, 64), (7617, 5079, 21), (7618, 5079, 24), (7619, 5080, 91), (7620, 5080, 87), (7621, 5081, 27), (7622, 5082, 52), (7623, 5082, 87), (7624, 5083, 49), (7625, 5084, 58), (7626, 5084, 69), (7627, 5085, 9), (7628, 5086, 61), (7629, 5086, 101), (7630, 5087, 80), (7631, 5087, 99), (7632, 5088, 11), (7633, 5088, 32), (7634, 5089, 95), (7635, 5090, 98), (7636, 5091, 42), (7637, 5092, 8), (7638, 5092, 99), (7639, 5093, 54), (7640, 5094, 66), (7641, 5094, 21), (7642, 5095, 43), (7643, 5096, 35), (7644, 5097, 42), (7645, 5097, 72), (7646, 5098, 61), (7647, 5099, 27), (7648, 5100, 100), (7649, 5100, 74), (7650, 5101, 65), (7651, 5102, 41), (7652, 5102, 48), (7653, 5103, 68), (7654, 5103, 82), (7655, 5104, 31), (7656, 5104, 42), (7657, 5105, 76), (7658, 5106, 53), (7659, 5107, 57), (7660, 5107, 18), (7661, 5108, 6), (7662, 5108, 3), (7663, 5109, 13), (7664, 5110, 51), (7665, 5110, 45), (7666, 5111, 73), (7667, 5112, 81), (7668, 5113, 56), (7669, 5113, 34), (7670, 5114, 28), (7671, 5115, 10), (7672, 5115, 75), (7673, 5116, 10), (7674, 5117, 87), (7675, 5117, 39), (7676, 5118, 80), (7677, 5118, 41), (7678, 5119, 32), (7679, 5119, 54), (7680, 5120, 74), (7681, 5121, 48), (7682, 5121, 17), (7683, 5122, 22), (7684, 5122, 61), (7685, 5123, 82), (7686, 5124, 10), (7687, 5125, 55), (7688, 5126, 103), (7689, 5126, 36), (7690, 5127, 78), (7691, 5128, 61), (7692, 5128, 94), (7693, 5129, 14), (7694, 5130, 9), (7695, 5130, 95), (7696, 5131, 66), (7697, 5131, 14), (7698, 5132, 93), (7699, 5133, 34), (7700, 5133, 73), (7701, 5134, 84), (7702, 5134, 65), (7703, 5135, 66), (7704, 5135, 94), (7705, 5136, 51), (7706, 5136, 19), (7707, 5137, 41), (7708, 5138, 69), (7709, 5139, 46), (7710, 5139, 25), (7711, 5140, 20), (7712, 5141, 27), (7713, 5142, 6), (7714, 5143, 84), (7715, 5144, 76), (7716, 5144, 70), (7717, 5145, 14), (7718, 5146, 24), (7719, 5147, 98), (7720, 5147, 55), (7721, 5148, 71), (7722, 5149, 10), (7723, 5150, 45), (7724, 5150, 14), (7725, 5151, 80), (7726, 5151, 19), (7727, 5152, 36), (7728, 5153, 72), (7729, 5154, 44), (7730, 5154, 70), (7731, 5155, 35), (7732, 5156, 48), (7733, 5157, 42), (7734, 5158, 70), (7735, 5159, 39), (7736, 5160, 57), (7737, 5161, 1), (7738, 5162, 10), (7739, 5162, 88), (7740, 5163, 86), (7741, 5164, 48), (7742, 5165, 51), (7743, 5165, 50), (7744, 5166, 96), (7745, 5166, 101), (7746, 5167, 79), (7747, 5167, 62), (7748, 5168, 10), (7749, 5169, 37), (7750, 5170, 89), (7751, 5170, 102), (7752, 5171, 90), (7753, 5171, 80), (7754, 5172, 11), (7755, 5172, 40), (7756, 5173, 45), (7757, 5174, 55), (7758, 5175, 55), (7759, 5175, 34), (7760, 5176, 72), (7761, 5176, 11), (7762, 5177, 36), (7763, 5177, 63), (7764, 5178, 54), (7765, 5179, 75), (7766, 5180, 26), (7767, 5180, 71), (7768, 5181, 35), (7769, 5181, 9), (7770, 5182, 5), (7771, 5182, 95), (7772, 5183, 81), (7773, 5184, 27), (7774, 5184, 36), (7775, 5185, 65), (7776, 5186, 76), (7777, 5187, 14), (7778, 5187, 91), (7779, 5188, 52), (7780, 5188, 64), (7781, 5189, 77), (7782, 5189, 9), (7783, 5190, 97), (7784, 5191, 31), (7785, 5191, 103), (7786, 5192, 65), (7787, 5192, 48), (7788, 5193, 65), (7789, 5194, 48), (7790, 5195, 66), (7791, 5195, 69), (7792, 5196, 13), (7793, 5197, 7), (7794, 5198, 54), (7795, 5199, 94), (7796, 5199, 7), (7797, 5200, 50), (7798, 5200, 34), (7799, 5201, 66), (7800, 5201, 71), (7801, 5202, 54), (7802, 5203, 94), (7803, 5204, 38), (7804, 5204, 65), (7805, 5205, 71), (7806, 5206, 21), (7807, 5207, 62), (7808, 5207, 69), (7809, 5208, 51), (7810, 5208, 8), (7811, 5209, 8), (7812, 5209, 30), (7813, 5210, 38), (7814, 5210, 103), (7815, 5211, 37), (7816, 5212, 20), (7817, 5212, 100), (7818, 5213, 12), (7819, 5214, 82), (7820, 5215, 60), (7821, 5216, 82), (7822, 5217, 37), (7823, 5217, 41), (7824, 5218, 43), (7825, 5219, 97), (7826, 5220, 10), (7827, 5220, 56), (7828, 5221, 89), (7829, 5222, 26), (7830, 5223, 52), (7831, 5223, 39), (7832, 5224, 57), (7833, 5224, 97), (7834, 5225, 39), (7835, 5226, 47), (7836, 5226, 17), (7837, 5227, 9), (7838, 5227, 69), (7839, 5228, 86), (7840, 5228, 73), (7841, 5229, 91), (7842, 5229, 79), (7843, 5230, 3), (7844, 5230, 66), (7845, 5231, 26), (7846, 5231, 100), (7847, 5232, 54), (7848, 5232, 81), (7849, 5233, 12), (7850, 5234, 52), (7851, 5234, 28), (7852, 5235, 13), (7853, 5235, 65), (7854, 5236, 75), (7855, 5236, 34), (7856, 5237, 48), (7857, 5237, 41), (7858, 5238, 97), (7859, 5238, 89), (7860, 5239, 36), (7861, 5240, 95), (7862, 5241, 48), (7863, 5241, 102), (7864, 5242, 23), (7865, 5242, 101), (7866, 5243, 25), (7867, 5243, 41), (7868, 5244, 58), (7869, 5244, 7), (7870, 5245, 38), (7871, 5245, 21), (7872, 5246, 14), (7873, 5247, 87), (7874, 5248, 85), (7875, 5249, 65), (7876, 5250, 87), (7877, 5251, 27), (7878, 5252, 30), (7879, 5253, 47), (7880, 5253, 69), (7881, 5254, 85), (7882, 5254, 10), (7883, 5255, 12), (7884, 5255, 41), (7885, 5256, 11), (7886, 5256, 18), (7887, 5257, 41), (7888, 5257, 57), (7889, 5258, 32), (7890, 5259, 59), (7891, 5259, 75), (7892, 5260, 94), (7893, 5261, 4), (7894, 5262, 2), (7895, 5262, 14), (7896, 5263, 12), (7897, 5263, 51), (7898, 5264, 80), (7899, 5264, 35), (7900, 5265, 39), (7901, 5265, 3), (7902, 5266, 4), (7903, 5266, 99), (7904, 5267, 42), (7905, 5267, 43), (7906, 5268, 26), (7907, 5268, 40), (7908, 5269, 31), (7909, 5270, 82), (7910, 5270, 66), (7911, 5271, 93), (7912, 5272, 10), (7913, 5272, 91), (7914, 5273, 7), (7915, 5273, 85), (7916, 5274, 82), (7917, 5274, 23), (7918, 5275, 48), (7919, 5275, 99), (7920, 5276, 96), (7921, 5277, 36), (7922, 5278, 24), (7923, 5279, 83), (7924, 5279, 62), (7925, 5280, 44), (7926, 5280, 97), (7927, 5281, 78), (7928, 5281, 59), (7929, 5282, 92), (7930, 5282, 79), (7931, 5283, 90), (7932, 5283, 73), (7933, 5284, 27), (7934, 5284, 100), (7935, 5285, 77), (7936, 5286, 96), (7937, 5286, 72), (7938, 5287, 76), (7939, 5287, 65), (7940, 5288, 52), (7941, 5289, 5), (7942, 5290, 65), (7943, 5291, 22), (7944, 5292, 88), (7945, 5292, 22), (7946, 5293, 103), (7947, 5293, 84), (7948, 5294, 4), (7949, 5295, 49), (7950, 5296, 11), (7951, 5296, 72), (7952, 5297, 67), (7953, 5298, 59), (7954, 5299, 82), (7955, 5300, 60), (7956, 5301, 62), (7957, 5301, 42), (7958, 5302, 54), (7959, 5303, 81), (7960, 5304, 41), (7961, 5304, 74), (7962, 5305, 82), (7963, 5306, 82), (7964, 5307, 28), (7965, 5308, 26), (7966, 5309, 98), (7967, 5309, 51), (7968, 5310, 23), (7969, 5311, 6), (7970, 5312, 88), (7971, 5313, 90), (7972, 5313, 56), (7973, 5314, 82), (7974, 5315, 17), (7975, 5316, 5), (7976, 5317, 51), (7977, 5318, 69), (7978, 5319, 55), (7979, 5319, 2), (7980, 5320, 67), (7981, 5321, 10), (7982, 5321, 68), (7983, 5322, 6), (7984, 5323, 48), (7985, 5324, 46), (7986, 5325, 101), (7987, 5326, 58), (7988, 5327, 70), (7989, 5327, 68), (7990, 5328, 38), (7991, 5329, 49), (7992, 5329, 67), (7993, 5330, 95), (7994, 5331, 30), (7995, 5332, 1), (7996, 5332, 83), (7997, 5333, 21), (7998, 5334, 79), (7999, 5334, 59), (8000, 5335, 50), (8001, 5336, 34), (8002, 5336, 50), (8003, 5337, 41), (8004, 5337, 39), (8005, 5338, 24), (8006, 5338, 72), (8007, 5339, 6), (8008, 5340, 29), (8009, 5341, 86), (8010, 5341, 90), (8011, 5342, 46), (8012, 5343, 79), (8013, 5343, 36), (8014, 5344, 9), (8015, 5345, 21), (8016, 5345, 15), (8017, 5346, 46), (8018, 5346, 94), (8019, 5347, 70), (8020, 5347, 57);
+INSERT INTO livestream_tags (id, livestream_id, tag_id) VALUES (8021, 5348, 83), (8022, 5349, 90), (8023, 5349, 24), (8024, 5350, 94), (8025, 5351, 63), (8026, 5352, 79), (8027, 5353, 41), (8028, 5353, 52), (8029, 5354, 92), (8030, 5355, 55), (8031, 5356, 16), (8032, 5357, 79), (8033, 5358, 30), (8034, 5358, 44), (8035, 5359, 49), (8036, 5360, 65), (8037, 5360, 56), (8038, 5361, 27), (8039, 5361, 16), (8040, 5362, 79), (8041, 5363, 81), (8042, 5364, 32), (8043, 5364, 82), (8044, 5365, 96), (8045, 5366, 62), (8046, 5366, 88), (8047, 5367, 49), (8048, 5367, 101), (8049, 5368, 21), (8050, 5368, 13), (8051, 5369, 61), (8052, 5370, 73), (8053, 5370, 21), (8054, 5371, 47), (8055, 5372, 55), (8056, 5373, 64), (8057, 5373, 93), (8058, 5374, 90), (8059, 5374, 80), (8060, 5375, 68), (8061, 5375, 64), (8062, 5376, 84), (8063, 5377, 78), (8064, 5378, 8), (8065, 5378, 22), (8066, 5379, 27), (8067, 5380, 74), (8068, 5381, 69), (8069, 5381, 51), (8070, 5382, 12), (8071, 5383, 77), (8072, 5383, 100), (8073, 5384, 1), (8074, 5384, 38), (8075, 5385, 4), (8076, 5385, 50), (8077, 5386, 76), (8078, 5386, 68), (8079, 5387, 53), (8080, 5387, 3), (8081, 5388, 35), (8082, 5389, 46), (8083, 5390, 9), (8084, 5391, 77), (8085, 5392, 31), (8086, 5393, 69), (8087, 5394, 101), (8088, 5395, 6), (8089, 5395, 27), (8090, 5396, 78), (8091, 5396, 52), (8092, 5397, 87), (8093, 5397, 8), (8094, 5398, 49), (8095, 5398, 51), (8096, 5399, 52), (8097, 5400, 89), (8098, 5400, 22), (8099, 5401, 28), (8100, 5402, 87), (8101, 5402, 49), (8102, 5403, 57), (8103, 5403, 76), (8104, 5404, 8), (8105, 5404, 79), (8106, 5405, 14), (8107, 5405, 89), (8108, 5406, 12), (8109, 5407, 41), (8110, 5408, 24), (8111, 5408, 71), (8112, 5409, 57), (8113, 5410, 2), (8114, 5410, 58), (8115, 5411, 29), (8116, 5411, 82), (8117, 5412, 77), (8118, 5413, 42), (8119, 5413, 53), (8120, 5414, 38), (8121, 5415, 40), (8122, 5415, 59), (8123, 5416, 52), (8124, 5416, 35), (8125, 5417, 8), (8126, 5417, 20), (8127, 5418, 63), (8128, 5419, 23), (8129, 5420, 47), (8130, 5421, 45), (8131, 5422, 62), (8132, 5423, 68), (8133, 5424, 75), (8134, 5424, 67), (8135, 5425, 22), (8136, 5426, 40), (8137, 5426, 72), (8138, 5427, 80), (8139, 5428, 102), (8140, 5429, 47), (8141, 5430, 8), (8142, 5430, 14), (8143, 5431, 79), (8144, 5432, 2), (8145, 5432, 54), (8146, 5433, 38), (8147, 5434, 55), (8148, 5435, 43), (8149, 5435, 95), (8150, 5436, 31), (8151, 5437, 8), (8152, 5437, 93), (8153, 5438, 98), (8154, 5439, 86), (8155, 5440, 6), (8156, 5440, 58), (8157, 5441, 1), (8158, 5442, 74), (8159, 5443, 62), (8160, 5443, 60), (8161, 5444, 67), (8162, 5445, 60), (8163, 5445, 66), (8164, 5446, 33), (8165, 5446, 41), (8166, 5447, 50), (8167, 5448, 59), (8168, 5449, 101), (8169, 5450, 54), (8170, 5451, 93), (8171, 5452, 74), (8172, 5452, 12), (8173, 5453, 52), (8174, 5453, 29), (8175, 5454, 102), (8176, 5455, 70), (8177, 5456, 77), (8178, 5457, 3), (8179, 5458, 79), (8180, 5459, 9), (8181, 5459, 55), (8182, 5460, 46), (8183, 5461, 55), (8184, 5461, 93), (8185, 5462, 71), (8186, 5462, 47), (8187, 5463, 78), (8188, 5464, 24), (8189, 5464, 99), (8190, 5465, 51), (8191, 5465, 64), (8192, 5466, 49), (8193, 5466, 56), (8194, 5467, 65), (8195, 5467, 56), (8196, 5468, 98), (8197, 5469, 48), (8198, 5469, 99), (8199, 5470, 91), (8200, 5471, 86), (8201, 5472, 34), (8202, 5473, 87), (8203, 5473, 73), (8204, 5474, 35), (8205, 5474, 75), (8206, 5475, 95), (8207, 5476, 31), (8208, 5476, 19), (8209, 5477, 53), (8210, 5478, 56), (8211, 5478, 85), (8212, 5479, 28), (8213, 5480, 103), (8214, 5481, 22), (8215, 5481, 89), (8216, 5482, 9), (8217, 5483, 96), (8218, 5483, 56), (8219, 5484, 95), (8220, 5485, 93), (8221, 5485, 103), (8222, 5486, 20), (8223, 5487, 60), (8224, 5488, 8), (8225, 5488, 97), (8226, 5489, 100), (8227, 5490, 83), (8228, 5491, 56), (8229, 5491, 45), (8230, 5492, 74), (8231, 5492, 29), (8232, 5493, 36), (8233, 5493, 65), (8234, 5494, 65), (8235, 5495, 46), (8236, 5496, 63), (8237, 5497, 90), (8238, 5498, 39), (8239, 5498, 80), (8240, 5499, 52), (8241, 5499, 49), (8242, 5500, 49), (8243, 5501, 49), (8244, 5502, 96), (8245, 5502, 25), (8246, 5503, 75), (8247, 5504, 59), (8248, 5504, 13), (8249, 5505, 68), (8250, 5506, 83), (8251, 5506, 4), (8252, 5507, 73), (8253, 5508, 26), (8254, 5509, 16), (8255, 5510, 9), (8256, 5511, 32), (8257, 5511, 103), (8258, 5512, 77), (8259, 5512, 49), (8260, 5513, 70), (8261, 5514, 50), (8262, 5515, 48), (8263, 5515, 86), (8264, 5516, 67), (8265, 5516, 82), (8266, 5517, 11), (8267, 5518, 51), (8268, 5518, 88), (8269, 5519, 41), (8270, 5520, 28), (8271, 5520, 21), (8272, 5521, 3), (8273, 5521, 8), (8274, 5522, 14), (8275, 5523, 96), (8276, 5523, 26), (8277, 5524, 14), (8278, 5525, 88), (8279, 5525, 5), (8280, 5526, 20), (8281, 5526, 90), (8282, 5527, 1), (8283, 5528, 60), (8284, 5528, 32), (8285, 5529, 21), (8286, 5530, 8), (8287, 5530, 48), (8288, 5531, 99), (8289, 5531, 9), (8290, 5532, 8), (8291, 5532, 35), (8292, 5533, 49), (8293, 5533, 4), (8294, 5534, 103), (8295, 5534, 76), (8296, 5535, 75), (8297, 5536, 87), (8298, 5536, 81), (8299, 5537, 33), (8300, 5538, 22), (8301, 5538, 88), (8302, 5539, 68), (8303, 5539, 13), (8304, 5540, 76), (8305, 5541, 33), (8306, 5542, 16), (8307, 5542, 84), (8308, 5543, 70), (8309, 5544, 26), (8310, 5544, 93), (8311, 5545, 99), (8312, 5546, 18), (8313, 5547, 102), (8314, 5547, 2), (8315, 5548, 21), (8316, 5549, 48), (8317, 5549, 97), (8318, 5550, 88), (8319, 5550, 64), (8320, 5551, 103), (8321, 5552, 23), (8322, 5553, 101), (8323, 5553, 69), (8324, 5554, 72), (8325, 5554, 15), (8326, 5555, 38), (8327, 5556, 5), (8328, 5556, 9), (8329, 5557, 94), (8330, 5557, 78), (8331, 5558, 78), (8332, 5559, 44), (8333, 5560, 34), (8334, 5561, 41), (8335, 5562, 18), (8336, 5562, 21), (8337, 5563, 92), (8338, 5564, 21), (8339, 5564, 57), (8340, 5565, 93), (8341, 5566, 16), (8342, 5567, 66), (8343, 5568, 9), (8344, 5569, 16), (8345, 5569, 94), (8346, 5570, 87), (8347, 5570, 66), (8348, 5571, 34), (8349, 5571, 97), (8350, 5572, 36), (8351, 5573, 101), (8352, 5573, 43), (8353, 5574, 88), (8354, 5574, 30), (8355, 5575, 76), (8356, 5576, 29), (8357, 5576, 6), (8358, 5577, 43), (8359, 5577, 8), (8360, 5578, 33), (8361, 5578, 85), (8362, 5579, 82), (8363, 5579, 62), (8364, 5580, 67), (8365, 5580, 54), (8366, 5581, 18), (8367, 5582, 18), (8368, 5583, 30), (8369, 5584, 3), (8370, 5584, 4), (8371, 5585, 83), (8372, 5585, 47), (8373, 5586, 73), (8374, 5586, 30), (8375, 5587, 24), (8376, 5588, 84), (8377, 5589, 79), (8378, 5589, 2), (8379, 5590, 97), (8380, 5591, 38), (8381, 5592, 88), (8382, 5593, 93), (8383, 5594, 11), (8384, 5595, 79), (8385, 5596, 55), (8386, 5596, 59), (8387, 5597, 97), (8388, 5597, 36), (8389, 5598, 2), (8390, 5598, 92), (8391, 5599, 16), (8392, 5600, 68), (8393, 5601, 49), (8394, 5602, 67), (8395, 5603, 101), (8396, 5603, 17), (8397, 5604, 15), (8398, 5604, 75), (8399, 5605, 91), (8400, 5605, 54), (8401, 5606, 20), (8402, 5607, 27), (8403, 5608, 44), (8404, 5608, 67), (8405, 5609, 21), (8406, 5609, 95), (8407, 5610, 95), (8408, 5610, 58), (8409, 5611, 51), (8410, 5611, 34), (8411, 5612, 99), (8412, 5613, 20), (8413, 5613, 73), (8414, 5614, 2), (8415, 5614, 18), (8416, 5615, 16), (8417, 5615, 100), (8418, 5616, 24), (8419, 5617, 63), (8420, 5618, 10), (8421, 5618, 64), (8422, 5619, 74), (8423, 5620, 52), (8424, 5620, 16), (8425, 5621, 25), (8426, 5621, 66), (8427, 5622, 9), (8428, 5623, 49), (8429, 5623, 97), (8430, 5624, 93), (8431, 5624, 99), (8432, 5625, 59), (8433, 5625, 33), (8434, 5626, 58), (8435, 5627, 76), (8436, 5627, 102), (8437, 5628, 52), (8438, 5629, 82), (8439, 5629, 13), (8440, 5630, 31), (8441, 5631, 60), (8442, 5632, 36), (8443, 5632, 21), (8444, 5633, 29), (8445, 5634, 53), (8446, 5635, 75), (8447, 5635, 58), (8448, 5636, 45), (8449, 5636, 58), (8450, 5637, 14), (8451, 5637, 95), (8452, 5638, 99), (8453, 5639, 68), (8454, 5640, 5), (8455, 5640, 27), (8456, 5641, 93), (8457, 5642, 98), (8458, 5642, 84), (8459, 5643, 95), (8460, 5643, 61), (8461, 5644, 41), (8462, 5645, 51), (8463, 5645, 16), (8464, 5646, 47), (8465, 5646, 81), (8466, 5647, 95), (8467, 5648, 75), (8468, 5648, 4), (8469, 5649, 34), (8470, 5650, 82), (8471, 5650, 18), (8472, 5651, 61), (8473, 5652, 79), (8474, 5652, 91), (8475, 5653, 54), (8476, 5653, 7), (8477, 5654, 66), (8478, 5654, 11), (8479, 5655, 100), (8480, 5656, 14), (8481, 5656, 10), (8482, 5657, 23), (8483, 5658, 38), (8484, 5658, 79), (8485, 5659, 20), (8486, 5660, 75), (8487, 5661, 30), (8488, 5661, 59), (8489, 5662, 65), (8490, 5663, 57), (8491, 5664, 75), (8492, 5665, 64), (8493, 5665, 75), (8494, 5666, 94), (8495, 5667, 75), (8496, 5668, 67), (8497, 5669, 39), (8498, 5669, 29), (8499, 5670, 12), (8500, 5670, 33), (8501, 5671, 31), (8502, 5671, 20), (8503, 5672, 99), (8504, 5673, 75), (8505, 5673, 100), (8506, 5674, 72), (8507, 5675, 14), (8508, 5676, 57), (8509, 5676, 51), (8510, 5677, 66), (8511, 5678, 28), (8512, 5679, 81), (8513, 5680, 78), (8514, 5680, 84), (8515, 5681, 3), (8516, 5681, 67), (8517, 5682, 24), (8518, 5683, 86), (8519, 5684, 80), (8520, 5684, 9), (8521, 5685, 17);
+INSERT INTO livestream_tags (id, livestream_id, tag_id) VALUES (8522, 5686, 32), (8523, 5687, 41), (8524, 5688, 25), (8525, 5688, 65), (8526, 5689, 89), (8527, 5689, 33), (8528, 5690, 65), (8529, 5691, 90), (8530, 5692, 41), (8531, 5692, 51), (8532, 5693, 24), (8533, 5693, 67), (8534, 5694, 33), (8535, 5695, 92), (8536, 5696, 103), (8537, 5696, 32), (8538, 5697, 62), (8539, 5698, 13), (8540, 5698, 60), (8541, 5699, 61), (8542, 5700, 82), (8543, 5700, 21), (8544, 5701, 34), (8545, 5701, 47), (8546, 5702, 12), (8547, 5703, 98), (8548, 5703, 95), (8549, 5704, 85), (8550, 5704, 6), (8551, 5705, 65), (8552, 5705, 79), (8553, 5706, 54), (8554, 5707, 40), (8555, 5707, 84), (8556, 5708, 99), (8557, 5708, 9), (8558, 5709, 6), (8559, 5709, 35), (8560, 5710, 69), (8561, 5710, 98), (8562, 5711, 26), (8563, 5711, 53), (8564, 5712, 55), (8565, 5712, 92), (8566, 5713, 30), (8567, 5714, 26), (8568, 5714, 66), (8569, 5715, 96), (8570, 5715, 99), (8571, 5716, 6), (8572, 5716, 22), (8573, 5717, 32), (8574, 5717, 71), (8575, 5718, 81), (8576, 5719, 75), (8577, 5720, 90), (8578, 5721, 52), (8579, 5721, 51), (8580, 5722, 74), (8581, 5723, 96), (8582, 5723, 35), (8583, 5724, 93), (8584, 5724, 7), (8585, 5725, 71), (8586, 5726, 79), (8587, 5727, 59), (8588, 5727, 83), (8589, 5728, 7), (8590, 5728, 36), (8591, 5729, 18), (8592, 5729, 16), (8593, 5730, 12), (8594, 5731, 59), (8595, 5731, 10), (8596, 5732, 2), (8597, 5733, 27), (8598, 5734, 39), (8599, 5734, 94), (8600, 5735, 13), (8601, 5735, 77), (8602, 5736, 25), (8603, 5736, 69), (8604, 5737, 34), (8605, 5738, 22), (8606, 5738, 72), (8607, 5739, 10), (8608, 5739, 18), (8609, 5740, 85), (8610, 5741, 59), (8611, 5741, 101), (8612, 5742, 76), (8613, 5742, 32), (8614, 5743, 20), (8615, 5744, 33), (8616, 5745, 39), (8617, 5746, 30), (8618, 5746, 64), (8619, 5747, 82), (8620, 5748, 9), (8621, 5748, 36), (8622, 5749, 19), (8623, 5749, 40), (8624, 5750, 43), (8625, 5750, 24), (8626, 5751, 40), (8627, 5751, 57), (8628, 5752, 43), (8629, 5752, 93), (8630, 5753, 15), (8631, 5754, 19), (8632, 5755, 63), (8633, 5756, 101), (8634, 5757, 18), (8635, 5758, 65), (8636, 5759, 13), (8637, 5759, 101), (8638, 5760, 40), (8639, 5760, 80), (8640, 5761, 81), (8641, 5762, 70), (8642, 5762, 37), (8643, 5763, 53), (8644, 5763, 71), (8645, 5764, 103), (8646, 5764, 80), (8647, 5765, 79), (8648, 5765, 91), (8649, 5766, 26), (8650, 5766, 16), (8651, 5767, 81), (8652, 5767, 1), (8653, 5768, 42), (8654, 5768, 91), (8655, 5769, 2), (8656, 5770, 77), (8657, 5771, 59), (8658, 5771, 52), (8659, 5772, 86), (8660, 5773, 7), (8661, 5774, 99), (8662, 5775, 67), (8663, 5776, 40), (8664, 5777, 59), (8665, 5777, 35), (8666, 5778, 103), (8667, 5779, 81), (8668, 5780, 62), (8669, 5781, 75), (8670, 5782, 39), (8671, 5782, 9), (8672, 5783, 99), (8673, 5784, 34), (8674, 5785, 90), (8675, 5786, 14), (8676, 5787, 30), (8677, 5787, 27), (8678, 5788, 25), (8679, 5789, 25), (8680, 5789, 39), (8681, 5790, 20), (8682, 5790, 81), (8683, 5791, 21), (8684, 5792, 65), (8685, 5792, 23), (8686, 5793, 71), (8687, 5793, 1), (8688, 5794, 1), (8689, 5794, 57), (8690, 5795, 53), (8691, 5796, 36), (8692, 5796, 18), (8693, 5797, 68), (8694, 5797, 94), (8695, 5798, 64), (8696, 5799, 49), (8697, 5799, 79), (8698, 5800, 85), (8699, 5801, 50), (8700, 5801, 78), (8701, 5802, 100), (8702, 5802, 17), (8703, 5803, 35), (8704, 5804, 53), (8705, 5805, 2), (8706, 5806, 37), (8707, 5806, 74), (8708, 5807, 82), (8709, 5808, 20), (8710, 5809, 42), (8711, 5810, 52), (8712, 5811, 67), (8713, 5812, 91), (8714, 5812, 70), (8715, 5813, 32), (8716, 5813, 41), (8717, 5814, 15), (8718, 5814, 46), (8719, 5815, 16), (8720, 5816, 89), (8721, 5816, 60), (8722, 5817, 25), (8723, 5818, 47), (8724, 5819, 47), (8725, 5820, 23), (8726, 5821, 13), (8727, 5821, 6), (8728, 5822, 92), (8729, 5822, 29), (8730, 5823, 69), (8731, 5824, 2), (8732, 5825, 71), (8733, 5825, 3), (8734, 5826, 10), (8735, 5827, 56), (8736, 5827, 68), (8737, 5828, 96), (8738, 5828, 2), (8739, 5829, 97), (8740, 5829, 77), (8741, 5830, 77), (8742, 5830, 76), (8743, 5831, 59), (8744, 5832, 98), (8745, 5833, 5), (8746, 5833, 50), (8747, 5834, 84), (8748, 5835, 102), (8749, 5836, 89), (8750, 5837, 67), (8751, 5838, 54), (8752, 5838, 29), (8753, 5839, 103), (8754, 5840, 50), (8755, 5841, 4), (8756, 5842, 61), (8757, 5843, 11), (8758, 5844, 102), (8759, 5845, 92), (8760, 5846, 92), (8761, 5847, 100), (8762, 5847, 70), (8763, 5848, 101), (8764, 5848, 54), (8765, 5849, 36), (8766, 5850, 15), (8767, 5851, 9), (8768, 5851, 82), (8769, 5852, 73), (8770, 5853, 98), (8771, 5854, 44), (8772, 5855, 55), (8773, 5856, 85), (8774, 5856, 48), (8775, 5857, 34), (8776, 5857, 21), (8777, 5858, 37), (8778, 5859, 10), (8779, 5859, 65), (8780, 5860, 59), (8781, 5860, 96), (8782, 5861, 35), (8783, 5861, 9), (8784, 5862, 47), (8785, 5862, 40), (8786, 5863, 30), (8787, 5863, 57), (8788, 5864, 53), (8789, 5865, 14), (8790, 5865, 39), (8791, 5866, 50), (8792, 5866, 13), (8793, 5867, 43), (8794, 5867, 90), (8795, 5868, 96), (8796, 5869, 54), (8797, 5869, 34), (8798, 5870, 47), (8799, 5870, 86), (8800, 5871, 10), (8801, 5872, 71), (8802, 5873, 19), (8803, 5873, 40), (8804, 5874, 83), (8805, 5875, 75), (8806, 5875, 81), (8807, 5876, 75), (8808, 5876, 96), (8809, 5877, 71), (8810, 5877, 10), (8811, 5878, 58), (8812, 5879, 22), (8813, 5880, 81), (8814, 5880, 38), (8815, 5881, 44), (8816, 5881, 98), (8817, 5882, 61), (8818, 5882, 99), (8819, 5883, 46), (8820, 5883, 69), (8821, 5884, 30), (8822, 5885, 56), (8823, 5886, 73), (8824, 5886, 70), (8825, 5887, 99), (8826, 5887, 22), (8827, 5888, 18), (8828, 5889, 79), (8829, 5889, 99), (8830, 5890, 1), (8831, 5891, 82), (8832, 5891, 47), (8833, 5892, 22), (8834, 5892, 31), (8835, 5893, 91), (8836, 5894, 83), (8837, 5894, 50), (8838, 5895, 21), (8839, 5895, 99), (8840, 5896, 9), (8841, 5897, 7), (8842, 5897, 98), (8843, 5898, 70), (8844, 5898, 18), (8845, 5899, 87), (8846, 5900, 20), (8847, 5901, 27), (8848, 5901, 99), (8849, 5902, 34), (8850, 5902, 11), (8851, 5903, 92), (8852, 5904, 41), (8853, 5905, 77), (8854, 5906, 90), (8855, 5906, 50), (8856, 5907, 27), (8857, 5907, 57), (8858, 5908, 68), (8859, 5908, 82), (8860, 5909, 72), (8861, 5910, 47), (8862, 5911, 48), (8863, 5911, 77), (8864, 5912, 89), (8865, 5912, 42), (8866, 5913, 11), (8867, 5913, 95), (8868, 5914, 75), (8869, 5915, 65), (8870, 5916, 81), (8871, 5916, 103), (8872, 5917, 64), (8873, 5917, 39), (8874, 5918, 63), (8875, 5918, 48), (8876, 5919, 91), (8877, 5919, 40), (8878, 5920, 81), (8879, 5921, 86), (8880, 5921, 96), (8881, 5922, 42), (8882, 5922, 75), (8883, 5923, 52), (8884, 5923, 56), (8885, 5924, 76), (8886, 5924, 71), (8887, 5925, 11), (8888, 5926, 14), (8889, 5927, 57), (8890, 5928, 72), (8891, 5929, 103), (8892, 5929, 68), (8893, 5930, 3), (8894, 5930, 33), (8895, 5931, 4), (8896, 5931, 31), (8897, 5932, 36), (8898, 5933, 31), (8899, 5934, 16), (8900, 5935, 95), (8901, 5936, 78), (8902, 5936, 54), (8903, 5937, 36), (8904, 5937, 103), (8905, 5938, 87), (8906, 5938, 65), (8907, 5939, 33), (8908, 5940, 21), (8909, 5941, 96), (8910, 5941, 62), (8911, 5942, 84), (8912, 5942, 65), (8913, 5943, 31), (8914, 5943, 10), (8915, 5944, 74), (8916, 5944, 67), (8917, 5945, 3), (8918, 5945, 35), (8919, 5946, 55), (8920, 5946, 44), (8921, 5947, 33), (8922, 5948, 101), (8923, 5948, 77), (8924, 5949, 18), (8925, 5950, 85), (8926, 5950, 48), (8927, 5951, 6), (8928, 5952, 77), (8929, 5952, 45), (8930, 5953, 63), (8931, 5953, 83), (8932, 5954, 10), (8933, 5955, 46), (8934, 5956, 20), (8935, 5956, 34), (8936, 5957, 103), (8937, 5958, 82), (8938, 5959, 56), (8939, 5959, 54), (8940, 5960, 16), (8941, 5960, 6), (8942, 5961, 93), (8943, 5961, 23), (8944, 5962, 40), (8945, 5962, 44), (8946, 5963, 86), (8947, 5964, 64), (8948, 5964, 27), (8949, 5965, 90), (8950, 5966, 13), (8951, 5967, 11), (8952, 5968, 17), (8953, 5969, 44), (8954, 5970, 18), (8955, 5970, 71), (8956, 5971, 67), (8957, 5971, 76), (8958, 5972, 30), (8959, 5973, 90), (8960, 5974, 1), (8961, 5974, 16), (8962, 5975, 50), (8963, 5975, 68), (8964, 5976, 26), (8965, 5977, 73), (8966, 5978, 46), (8967, 5978, 4), (8968, 5979, 81), (8969, 5980, 74), (8970, 5980, 59), (8971, 5981, 90), (8972, 5981, 84), (8973, 5982, 102), (8974, 5982, 11), (8975, 5983, 103), (8976, 5984, 98), (8977, 5984, 13), (8978, 5985, 83), (8979, 5986, 90), (8980, 5986, 63), (8981, 5987, 80), (8982, 5987, 15), (8983, 5988, 14), (8984, 5989, 94), (8985, 5989, 16), (8986, 5990, 85), (8987, 5991, 13), (8988, 5992, 59), (8989, 5992, 29), (8990, 5993, 22), (8991, 5993, 71), (8992, 5994, 3), (8993, 5995, 76), (8994, 5996, 88), (8995, 5996, 54), (8996, 5997, 47), (8997, 5998, 76), (8998, 5998, 87), (8999, 5999, 8), (9000, 6000, 90), (9001, 6000, 91), (9002, 6001, 93), (9003, 6002, 25), (9004, 6002, 34), (9005, 6003, 22), (9006, 6003, 37), (9007, 6004, 37), (9008, 6004, 73), (9009, 6005, 51), (9010, 6005, 75), (9011, 6006, 22), (9012, 6006, 73), (9013, 6007, 93), (9014, 6007, 16), (9015, 6008, 72), (9016, 6008, 52), (9017, 6009, 1), (9018, 6010, 52), (9019, 6010, 53), (9020, 6011, 42), (9021, 6012, 79), (9022, 6012, 19);
+INSERT INTO livestream_tags (id, livestream_id, tag_id) VALUES (9023, 6013, 99), (9024, 6014, 77), (9025, 6014, 29), (9026, 6015, 50), (9027, 6016, 101), (9028, 6016, 27), (9029, 6017, 30), (9030, 6017, 40), (9031, 6018, 65), (9032, 6019, 15), (9033, 6019, 65), (9034, 6020, 90), (9035, 6020, 75), (9036, 6021, 42), (9037, 6022, 91), (9038, 6023, 29), (9039, 6023, 71), (9040, 6024, 24), (9041, 6025, 14), (9042, 6026, 55), (9043, 6026, 46), (9044, 6027, 34), (9045, 6027, 11), (9046, 6028, 52), (9047, 6029, 7), (9048, 6029, 58), (9049, 6030, 30), (9050, 6031, 22), (9051, 6031, 94), (9052, 6032, 55), (9053, 6032, 46), (9054, 6033, 3), (9055, 6033, 19), (9056, 6034, 71), (9057, 6034, 65), (9058, 6035, 30), (9059, 6035, 54), (9060, 6036, 20), (9061, 6036, 13), (9062, 6037, 84), (9063, 6037, 66), (9064, 6038, 74), (9065, 6039, 5), (9066, 6040, 88), (9067, 6041, 74), (9068, 6042, 95), (9069, 6042, 32), (9070, 6043, 54), (9071, 6044, 15), (9072, 6045, 58), (9073, 6045, 76), (9074, 6046, 50), (9075, 6047, 10), (9076, 6048, 87), (9077, 6049, 17), (9078, 6050, 93), (9079, 6051, 96), (9080, 6052, 17), (9081, 6052, 40), (9082, 6053, 12), (9083, 6053, 24), (9084, 6054, 44), (9085, 6054, 9), (9086, 6055, 54), (9087, 6056, 9), (9088, 6057, 41), (9089, 6058, 100), (9090, 6058, 47), (9091, 6059, 9), (9092, 6060, 13), (9093, 6061, 59), (9094, 6062, 71), (9095, 6062, 34), (9096, 6063, 19), (9097, 6064, 28), (9098, 6064, 7), (9099, 6065, 30), (9100, 6065, 53), (9101, 6066, 58), (9102, 6066, 32), (9103, 6067, 10), (9104, 6068, 94), (9105, 6068, 53), (9106, 6069, 64), (9107, 6069, 53), (9108, 6070, 28), (9109, 6070, 100), (9110, 6071, 68), (9111, 6072, 80), (9112, 6072, 33), (9113, 6073, 17), (9114, 6073, 65), (9115, 6074, 4), (9116, 6074, 66), (9117, 6075, 25), (9118, 6075, 91), (9119, 6076, 3), (9120, 6077, 73), (9121, 6077, 67), (9122, 6078, 82), (9123, 6079, 41), (9124, 6080, 39), (9125, 6080, 86), (9126, 6081, 43), (9127, 6082, 96), (9128, 6082, 47), (9129, 6083, 86), (9130, 6084, 72), (9131, 6084, 103), (9132, 6085, 35), (9133, 6086, 45), (9134, 6087, 3), (9135, 6087, 60), (9136, 6088, 48), (9137, 6088, 58), (9138, 6089, 26), (9139, 6090, 67), (9140, 6091, 103), (9141, 6091, 29), (9142, 6092, 5), (9143, 6092, 23), (9144, 6093, 3), (9145, 6093, 29), (9146, 6094, 42), (9147, 6095, 13), (9148, 6095, 87), (9149, 6096, 10), (9150, 6097, 47), (9151, 6097, 57), (9152, 6098, 27), (9153, 6099, 89), (9154, 6100, 91), (9155, 6101, 34), (9156, 6101, 27), (9157, 6102, 62), (9158, 6102, 39), (9159, 6103, 49), (9160, 6103, 51), (9161, 6104, 36), (9162, 6104, 103), (9163, 6105, 56), (9164, 6106, 37), (9165, 6106, 41), (9166, 6107, 72), (9167, 6108, 25), (9168, 6108, 77), (9169, 6109, 81), (9170, 6110, 81), (9171, 6110, 97), (9172, 6111, 4), (9173, 6111, 69), (9174, 6112, 91), (9175, 6113, 81), (9176, 6114, 71), (9177, 6115, 31), (9178, 6116, 54), (9179, 6117, 68), (9180, 6117, 52), (9181, 6118, 102), (9182, 6118, 8), (9183, 6119, 47), (9184, 6120, 26), (9185, 6121, 4), (9186, 6122, 99), (9187, 6122, 28), (9188, 6123, 19), (9189, 6123, 55), (9190, 6124, 71), (9191, 6125, 33), (9192, 6125, 22), (9193, 6126, 39), (9194, 6127, 46), (9195, 6127, 31), (9196, 6128, 91), (9197, 6129, 41), (9198, 6129, 44), (9199, 6130, 3), (9200, 6131, 99), (9201, 6131, 5), (9202, 6132, 24), (9203, 6133, 94), (9204, 6134, 47), (9205, 6135, 39), (9206, 6135, 44), (9207, 6136, 49), (9208, 6136, 33), (9209, 6137, 47), (9210, 6138, 24), (9211, 6138, 40), (9212, 6139, 10), (9213, 6140, 41), (9214, 6141, 25), (9215, 6142, 73), (9216, 6142, 11), (9217, 6143, 62), (9218, 6144, 92), (9219, 6145, 95), (9220, 6146, 15), (9221, 6146, 14), (9222, 6147, 86), (9223, 6147, 45), (9224, 6148, 38), (9225, 6149, 57), (9226, 6149, 31), (9227, 6150, 50), (9228, 6151, 58), (9229, 6152, 32), (9230, 6153, 6), (9231, 6154, 17), (9232, 6155, 23), (9233, 6155, 13), (9234, 6156, 41), (9235, 6156, 58), (9236, 6157, 53), (9237, 6158, 23), (9238, 6159, 99), (9239, 6160, 62), (9240, 6160, 56), (9241, 6161, 38), (9242, 6161, 83), (9243, 6162, 10), (9244, 6162, 43), (9245, 6163, 78), (9246, 6164, 100), (9247, 6165, 58), (9248, 6165, 11), (9249, 6166, 42), (9250, 6167, 65), (9251, 6167, 12), (9252, 6168, 9), (9253, 6169, 82), (9254, 6169, 24), (9255, 6170, 24), (9256, 6171, 25), (9257, 6172, 66), (9258, 6173, 13), (9259, 6174, 89), (9260, 6175, 51), (9261, 6176, 97), (9262, 6176, 7), (9263, 6177, 19), (9264, 6178, 13), (9265, 6179, 79), (9266, 6180, 64), (9267, 6181, 86), (9268, 6182, 11), (9269, 6182, 1), (9270, 6183, 87), (9271, 6183, 88), (9272, 6184, 46), (9273, 6184, 83), (9274, 6185, 66), (9275, 6186, 66), (9276, 6186, 39), (9277, 6187, 63), (9278, 6187, 29), (9279, 6188, 44), (9280, 6188, 39), (9281, 6189, 56), (9282, 6190, 91), (9283, 6190, 21), (9284, 6191, 28), (9285, 6192, 103), (9286, 6192, 81), (9287, 6193, 72), (9288, 6194, 1), (9289, 6194, 36), (9290, 6195, 48), (9291, 6195, 6), (9292, 6196, 7), (9293, 6197, 81), (9294, 6197, 41), (9295, 6198, 71), (9296, 6199, 32), (9297, 6199, 23), (9298, 6200, 43), (9299, 6200, 89), (9300, 6201, 46), (9301, 6202, 32), (9302, 6202, 20), (9303, 6203, 83), (9304, 6204, 86), (9305, 6205, 30), (9306, 6206, 94), (9307, 6207, 14), (9308, 6208, 51), (9309, 6209, 11), (9310, 6210, 41), (9311, 6211, 89), (9312, 6211, 16), (9313, 6212, 93), (9314, 6212, 16), (9315, 6213, 16), (9316, 6214, 94), (9317, 6214, 62), (9318, 6215, 74), (9319, 6215, 56), (9320, 6216, 94), (9321, 6217, 22), (9322, 6217, 56), (9323, 6218, 7), (9324, 6219, 85), (9325, 6219, 101), (9326, 6220, 55), (9327, 6220, 5), (9328, 6221, 79), (9329, 6222, 100), (9330, 6222, 57), (9331, 6223, 90), (9332, 6223, 63), (9333, 6224, 56), (9334, 6224, 30), (9335, 6225, 71), (9336, 6226, 102), (9337, 6226, 37), (9338, 6227, 42), (9339, 6228, 32), (9340, 6229, 69), (9341, 6230, 23), (9342, 6230, 24), (9343, 6231, 44), (9344, 6232, 22), (9345, 6232, 15), (9346, 6233, 67), (9347, 6233, 96), (9348, 6234, 30), (9349, 6235, 84), (9350, 6236, 2), (9351, 6237, 87), (9352, 6237, 99), (9353, 6238, 90), (9354, 6239, 80), (9355, 6240, 43), (9356, 6240, 55), (9357, 6241, 30), (9358, 6242, 23), (9359, 6242, 57), (9360, 6243, 39), (9361, 6244, 30), (9362, 6245, 69), (9363, 6245, 57), (9364, 6246, 4), (9365, 6246, 50), (9366, 6247, 10), (9367, 6248, 66), (9368, 6248, 20), (9369, 6249, 61), (9370, 6250, 76), (9371, 6251, 34), (9372, 6251, 59), (9373, 6252, 79), (9374, 6253, 45), (9375, 6253, 57), (9376, 6254, 42), (9377, 6255, 37), (9378, 6255, 83), (9379, 6256, 88), (9380, 6257, 20), (9381, 6257, 87), (9382, 6258, 49), (9383, 6258, 99), (9384, 6259, 28), (9385, 6259, 60), (9386, 6260, 46), (9387, 6261, 80), (9388, 6261, 1), (9389, 6262, 101), (9390, 6263, 25), (9391, 6263, 67), (9392, 6264, 26), (9393, 6265, 64), (9394, 6266, 66), (9395, 6267, 83), (9396, 6268, 91), (9397, 6268, 15), (9398, 6269, 47), (9399, 6270, 91), (9400, 6270, 86), (9401, 6271, 82), (9402, 6272, 30), (9403, 6273, 87), (9404, 6274, 82), (9405, 6275, 82), (9406, 6275, 39), (9407, 6276, 28), (9408, 6277, 7), (9409, 6277, 20), (9410, 6278, 20), (9411, 6278, 39), (9412, 6279, 37), (9413, 6280, 14), (9414, 6280, 42), (9415, 6281, 6), (9416, 6281, 78), (9417, 6282, 51), (9418, 6282, 39), (9419, 6283, 93), (9420, 6284, 84), (9421, 6284, 80), (9422, 6285, 96), (9423, 6285, 82), (9424, 6286, 36), (9425, 6286, 2), (9426, 6287, 10), (9427, 6288, 46), (9428, 6289, 84), (9429, 6290, 25), (9430, 6291, 54), (9431, 6292, 92), (9432, 6292, 90), (9433, 6293, 102), (9434, 6293, 17), (9435, 6294, 18), (9436, 6295, 18), (9437, 6296, 83), (9438, 6296, 90), (9439, 6297, 47), (9440, 6298, 91), (9441, 6298, 65), (9442, 6299, 75), (9443, 6300, 72), (9444, 6301, 73), (9445, 6302, 95), (9446, 6303, 59), (9447, 6304, 102), (9448, 6304, 65), (9449, 6305, 44), (9450, 6305, 95), (9451, 6306, 91), (9452, 6307, 82), (9453, 6307, 90), (9454, 6308, 87), (9455, 6309, 89), (9456, 6309, 44), (9457, 6310, 29), (9458, 6311, 86), (9459, 6311, 88), (9460, 6312, 1), (9461, 6313, 5), (9462, 6314, 22), (9463, 6314, 100), (9464, 6315, 45), (9465, 6316, 75), (9466, 6316, 95), (9467, 6317, 43), (9468, 6318, 1), (9469, 6319, 74), (9470, 6319, 5), (9471, 6320, 37), (9472, 6321, 87), (9473, 6321, 88), (9474, 6322, 16), (9475, 6322, 10), (9476, 6323, 10), (9477, 6324, 73), (9478, 6324, 103), (9479, 6325, 6), (9480, 6326, 63), (9481, 6326, 81), (9482, 6327, 70), (9483, 6327, 81), (9484, 6328, 88), (9485, 6328, 29), (9486, 6329, 56), (9487, 6330, 92), (9488, 6330, 17), (9489, 6331, 18), (9490, 6331, 37), (9491, 6332, 100), (9492, 6333, 30), (9493, 6334, 41), (9494, 6334, 65), (9495, 6335, 61), (9496, 6336, 30), (9497, 6336, 20), (9498, 6337, 90), (9499, 6338, 39), (9500, 6338, 44), (9501, 6339, 62), (9502, 6340, 76), (9503, 6341, 45), (9504, 6341, 81), (9505, 6342, 36), (9506, 6342, 49), (9507, 6343, 30), (9508, 6344, 25), (9509, 6345, 89), (9510, 6346, 40), (9511, 6346, 12), (9512, 6347, 23), (9513, 6347, 1), (9514, 6348, 53), (9515, 6348, 78), (9516, 6349, 72), (9517, 6349, 102), (9518, 6350, 74), (9519, 6351, 36), (9520, 6352, 2), (9521, 6353, 33), (9522, 6353, 38), (9523, 6354, 7), (9524, 6354, 43);
+INSERT INTO livestream_tags (id, livestream_id, tag_id) VALUES (9525, 6355, 9), (9526, 6356, 43), (9527, 6357, 61), (9528, 6357, 48), (9529, 6358, 48), (9530, 6359, 62), (9531, 6360, 27), (9532, 6361, 23), (9533, 6361, 103), (9534, 6362, 61), (9535, 6362, 87), (9536, 6363, 8), (9537, 6363, 73), (9538, 6364, 65), (9539, 6365, 7), (9540, 6365, 81), (9541, 6366, 15), (9542, 6366, 17), (9543, 6367, 26), (9544, 6367, 18), (9545, 6368, 70), (9546, 6368, 53), (9547, 6369, 90), (9548, 6369, 98), (9549, 6370, 58), (9550, 6371, 31), (9551, 6371, 38), (9552, 6372, 60), (9553, 6373, 10), (9554, 6374, 67), (9555, 6374, 42), (9556, 6375, 47), (9557, 6375, 37), (9558, 6376, 39), (9559, 6377, 17), (9560, 6377, 84), (9561, 6378, 45), (9562, 6378, 77), (9563, 6379, 38), (9564, 6380, 53), (9565, 6380, 86), (9566, 6381, 59), (9567, 6382, 35), (9568, 6382, 69), (9569, 6383, 101), (9570, 6383, 4), (9571, 6384, 13), (9572, 6384, 77), (9573, 6385, 90), (9574, 6385, 95), (9575, 6386, 56), (9576, 6386, 44), (9577, 6387, 93), (9578, 6387, 82), (9579, 6388, 30), (9580, 6389, 22), (9581, 6390, 34), (9582, 6390, 71), (9583, 6391, 17), (9584, 6391, 81), (9585, 6392, 61), (9586, 6393, 81), (9587, 6394, 92), (9588, 6394, 98), (9589, 6395, 11), (9590, 6396, 54), (9591, 6397, 36), (9592, 6398, 94), (9593, 6399, 74), (9594, 6399, 2), (9595, 6400, 17), (9596, 6401, 26), (9597, 6402, 79), (9598, 6402, 58), (9599, 6403, 1), (9600, 6404, 77), (9601, 6405, 37), (9602, 6406, 94), (9603, 6407, 28), (9604, 6407, 26), (9605, 6408, 17), (9606, 6409, 78), (9607, 6409, 81), (9608, 6410, 25), (9609, 6410, 55), (9610, 6411, 88), (9611, 6412, 76), (9612, 6412, 15), (9613, 6413, 41), (9614, 6414, 91), (9615, 6415, 38), (9616, 6415, 13), (9617, 6416, 32), (9618, 6416, 82), (9619, 6417, 50), (9620, 6417, 44), (9621, 6418, 54), (9622, 6418, 66), (9623, 6419, 82), (9624, 6419, 65), (9625, 6420, 22), (9626, 6420, 19), (9627, 6421, 36), (9628, 6422, 32), (9629, 6422, 87), (9630, 6423, 46), (9631, 6423, 102), (9632, 6424, 11), (9633, 6424, 20), (9634, 6425, 16), (9635, 6425, 37), (9636, 6426, 31), (9637, 6426, 67), (9638, 6427, 102), (9639, 6427, 52), (9640, 6428, 35), (9641, 6428, 3), (9642, 6429, 19), (9643, 6430, 61), (9644, 6431, 85), (9645, 6431, 10), (9646, 6432, 95), (9647, 6432, 30), (9648, 6433, 78), (9649, 6434, 33), (9650, 6434, 66), (9651, 6435, 29), (9652, 6435, 61), (9653, 6436, 58), (9654, 6437, 52), (9655, 6437, 69), (9656, 6438, 102), (9657, 6438, 81), (9658, 6439, 103), (9659, 6439, 83), (9660, 6440, 14), (9661, 6440, 47), (9662, 6441, 30), (9663, 6441, 94), (9664, 6442, 26), (9665, 6442, 45), (9666, 6443, 62), (9667, 6444, 70), (9668, 6445, 32), (9669, 6445, 86), (9670, 6446, 38), (9671, 6447, 52), (9672, 6447, 91), (9673, 6448, 47), (9674, 6449, 72), (9675, 6449, 33), (9676, 6450, 57), (9677, 6450, 97), (9678, 6451, 67), (9679, 6451, 54), (9680, 6452, 81), (9681, 6453, 47), (9682, 6454, 39), (9683, 6454, 52), (9684, 6455, 44), (9685, 6455, 59), (9686, 6456, 6), (9687, 6457, 6), (9688, 6457, 8), (9689, 6458, 28), (9690, 6459, 83), (9691, 6460, 54), (9692, 6460, 76), (9693, 6461, 54), (9694, 6461, 25), (9695, 6462, 64), (9696, 6463, 29), (9697, 6464, 87), (9698, 6464, 23), (9699, 6465, 81), (9700, 6466, 63), (9701, 6467, 43), (9702, 6467, 84), (9703, 6468, 87), (9704, 6468, 86), (9705, 6469, 25), (9706, 6469, 42), (9707, 6470, 35), (9708, 6470, 73), (9709, 6471, 44), (9710, 6472, 53), (9711, 6472, 91), (9712, 6473, 57), (9713, 6473, 19), (9714, 6474, 33), (9715, 6474, 11), (9716, 6475, 98), (9717, 6476, 44), (9718, 6476, 10), (9719, 6477, 43), (9720, 6477, 99), (9721, 6478, 10), (9722, 6479, 95), (9723, 6479, 50), (9724, 6480, 85), (9725, 6480, 7), (9726, 6481, 13), (9727, 6482, 12), (9728, 6482, 87), (9729, 6483, 60), (9730, 6483, 16), (9731, 6484, 37), (9732, 6485, 66), (9733, 6486, 3), (9734, 6486, 96), (9735, 6487, 57), (9736, 6488, 101), (9737, 6489, 20), (9738, 6489, 64), (9739, 6490, 6), (9740, 6491, 59), (9741, 6491, 78), (9742, 6492, 14), (9743, 6492, 81), (9744, 6493, 57), (9745, 6493, 12), (9746, 6494, 12), (9747, 6494, 15), (9748, 6495, 3), (9749, 6496, 69), (9750, 6496, 29), (9751, 6497, 65), (9752, 6497, 96), (9753, 6498, 17), (9754, 6499, 36), (9755, 6499, 65), (9756, 6500, 23), (9757, 6501, 90), (9758, 6502, 90), (9759, 6502, 97), (9760, 6503, 75), (9761, 6504, 98), (9762, 6504, 69), (9763, 6505, 26), (9764, 6505, 46), (9765, 6506, 3), (9766, 6506, 32), (9767, 6507, 35), (9768, 6508, 37), (9769, 6509, 3), (9770, 6510, 96), (9771, 6511, 42), (9772, 6511, 95), (9773, 6512, 43), (9774, 6513, 29), (9775, 6513, 52), (9776, 6514, 71), (9777, 6515, 39), (9778, 6516, 17), (9779, 6517, 48), (9780, 6518, 85), (9781, 6518, 94), (9782, 6519, 18), (9783, 6520, 70), (9784, 6521, 63), (9785, 6521, 74), (9786, 6522, 46), (9787, 6522, 65), (9788, 6523, 99), (9789, 6524, 67), (9790, 6524, 75), (9791, 6525, 80), (9792, 6526, 67), (9793, 6527, 47), (9794, 6527, 30), (9795, 6528, 55), (9796, 6528, 76), (9797, 6529, 37), (9798, 6529, 72), (9799, 6530, 21), (9800, 6530, 22), (9801, 6531, 89), (9802, 6532, 20), (9803, 6533, 1), (9804, 6534, 58), (9805, 6535, 5), (9806, 6535, 44), (9807, 6536, 14), (9808, 6536, 92), (9809, 6537, 5), (9810, 6538, 94), (9811, 6539, 12), (9812, 6540, 74), (9813, 6541, 78), (9814, 6541, 85), (9815, 6542, 55), (9816, 6543, 31), (9817, 6544, 25), (9818, 6545, 72), (9819, 6545, 78), (9820, 6546, 11), (9821, 6546, 87), (9822, 6547, 94), (9823, 6547, 38), (9824, 6548, 93), (9825, 6549, 7), (9826, 6549, 45), (9827, 6550, 51), (9828, 6551, 8), (9829, 6552, 87), (9830, 6553, 97), (9831, 6554, 34), (9832, 6554, 2), (9833, 6555, 36), (9834, 6556, 86), (9835, 6557, 29), (9836, 6557, 102), (9837, 6558, 80), (9838, 6559, 101), (9839, 6559, 19), (9840, 6560, 98), (9841, 6560, 58), (9842, 6561, 60), (9843, 6561, 95), (9844, 6562, 38), (9845, 6562, 88), (9846, 6563, 96), (9847, 6564, 90), (9848, 6564, 94), (9849, 6565, 70), (9850, 6566, 13), (9851, 6567, 72), (9852, 6567, 78), (9853, 6568, 18), (9854, 6569, 48), (9855, 6570, 8), (9856, 6571, 53), (9857, 6571, 95), (9858, 6572, 67), (9859, 6572, 76), (9860, 6573, 38), (9861, 6573, 32), (9862, 6574, 67), (9863, 6574, 68), (9864, 6575, 59), (9865, 6576, 25), (9866, 6576, 7), (9867, 6577, 61), (9868, 6577, 65), (9869, 6578, 74), (9870, 6579, 72), (9871, 6579, 45), (9872, 6580, 77), (9873, 6580, 37), (9874, 6581, 92), (9875, 6581, 79), (9876, 6582, 79), (9877, 6582, 72), (9878, 6583, 72), (9879, 6584, 76), (9880, 6584, 57), (9881, 6585, 60), (9882, 6585, 24), (9883, 6586, 34), (9884, 6586, 81), (9885, 6587, 75), (9886, 6588, 13), (9887, 6589, 38), (9888, 6589, 50), (9889, 6590, 3), (9890, 6591, 30), (9891, 6591, 86), (9892, 6592, 32), (9893, 6593, 8), (9894, 6594, 11), (9895, 6595, 54), (9896, 6595, 26), (9897, 6596, 66), (9898, 6597, 64), (9899, 6598, 103), (9900, 6599, 4), (9901, 6599, 64), (9902, 6600, 102), (9903, 6601, 66), (9904, 6602, 10), (9905, 6602, 4), (9906, 6603, 15), (9907, 6604, 90), (9908, 6604, 1), (9909, 6605, 82), (9910, 6605, 75), (9911, 6606, 38), (9912, 6606, 100), (9913, 6607, 81), (9914, 6608, 31), (9915, 6608, 95), (9916, 6609, 4), (9917, 6610, 91), (9918, 6610, 4), (9919, 6611, 53), (9920, 6611, 85), (9921, 6612, 52), (9922, 6612, 81), (9923, 6613, 98), (9924, 6613, 45), (9925, 6614, 19), (9926, 6615, 71), (9927, 6616, 17), (9928, 6616, 5), (9929, 6617, 57), (9930, 6617, 47), (9931, 6618, 40), (9932, 6619, 60), (9933, 6620, 13), (9934, 6621, 82), (9935, 6621, 63), (9936, 6622, 81), (9937, 6623, 5), (9938, 6623, 17), (9939, 6624, 54), (9940, 6624, 98), (9941, 6625, 4), (9942, 6626, 68), (9943, 6626, 79), (9944, 6627, 30), (9945, 6627, 37), (9946, 6628, 58), (9947, 6628, 91), (9948, 6629, 19), (9949, 6630, 83), (9950, 6630, 96), (9951, 6631, 34), (9952, 6632, 85), (9953, 6633, 36), (9954, 6634, 22), (9955, 6634, 57), (9956, 6635, 79), (9957, 6636, 94), (9958, 6636, 93), (9959, 6637, 29), (9960, 6638, 73), (9961, 6638, 94), (9962, 6639, 82), (9963, 6640, 55), (9964, 6640, 94), (9965, 6641, 1), (9966, 6642, 26), (9967, 6643, 81), (9968, 6643, 101), (9969, 6644, 3), (9970, 6644, 53), (9971, 6645, 50), (9972, 6646, 93), (9973, 6646, 59), (9974, 6647, 32), (9975, 6647, 13), (9976, 6648, 83), (9977, 6649, 16), (9978, 6649, 36), (9979, 6650, 44), (9980, 6651, 2), (9981, 6651, 59), (9982, 6652, 9), (9983, 6653, 35), (9984, 6654, 66), (9985, 6655, 33), (9986, 6655, 44), (9987, 6656, 71), (9988, 6657, 54), (9989, 6657, 89), (9990, 6658, 28), (9991, 6659, 69), (9992, 6659, 60), (9993, 6660, 42), (9994, 6661, 35), (9995, 6662, 17), (9996, 6663, 96), (9997, 6664, 86), (9998, 6664, 63), (9999, 6665, 97), (10000, 6665, 78), (10001, 6666, 97), (10002, 6666, 14), (10003, 6667, 72), (10004, 6668, 74), (10005, 6669, 23), (10006, 6670, 5), (10007, 6671, 71), (10008, 6671, 94), (10009, 6672, 68), (10010, 6673, 36), (10011, 6673, 103), (10012, 6674, 3), (10013, 6675, 100), (10014, 6676, 7), (10015, 6676, 26), (10016, 6677, 21), (10017, 6677, 15), (10018, 6678, 10), (10019, 6679, 67), (10020, 6679, 72), (10021, 6680, 2), (10022, 6681, 94), (10023, 6681, 29), (10024, 6682, 69), (10025, 6682, 94);
+INSERT INTO livestream_tags (id, livestream_id, tag_id) VALUES (10026, 6683, 20), (10027, 6683, 31), (10028, 6684, 81), (10029, 6685, 99), (10030, 6686, 95), (10031, 6686, 12), (10032, 6687, 63), (10033, 6688, 13), (10034, 6689, 19), (10035, 6690, 76), (10036, 6690, 73), (10037, 6691, 93), (10038, 6691, 8), (10039, 6692, 66), (10040, 6692, 17), (10041, 6693, 5), (10042, 6694, 33), (10043, 6695, 35), (10044, 6695, 15), (10045, 6696, 51), (10046, 6697, 72), (10047, 6697, 96), (10048, 6698, 103), (10049, 6698, 96), (10050, 6699, 74), (10051, 6700, 97), (10052, 6701, 8), (10053, 6702, 47), (10054, 6702, 39), (10055, 6703, 90), (10056, 6703, 67), (10057, 6704, 51), (10058, 6704, 85), (10059, 6705, 88), (10060, 6706, 44), (10061, 6707, 21), (10062, 6707, 41), (10063, 6708, 89), (10064, 6709, 26), (10065, 6709, 10), (10066, 6710, 59), (10067, 6710, 83), (10068, 6711, 85), (10069, 6712, 90), (10070, 6713, 43), (10071, 6713, 30), (10072, 6714, 96), (10073, 6715, 26), (10074, 6716, 29), (10075, 6717, 65), (10076, 6717, 98), (10077, 6718, 102), (10078, 6718, 70), (10079, 6719, 91), (10080, 6719, 32), (10081, 6720, 94), (10082, 6721, 9), (10083, 6722, 20), (10084, 6723, 5), (10085, 6724, 94), (10086, 6725, 44), (10087, 6726, 102), (10088, 6726, 14), (10089, 6727, 88), (10090, 6728, 36), (10091, 6729, 21), (10092, 6729, 79), (10093, 6730, 80), (10094, 6731, 57), (10095, 6731, 39), (10096, 6732, 73), (10097, 6733, 99), (10098, 6733, 66), (10099, 6734, 40), (10100, 6734, 90), (10101, 6735, 91), (10102, 6736, 99), (10103, 6736, 15), (10104, 6737, 75), (10105, 6737, 10), (10106, 6738, 87), (10107, 6738, 51), (10108, 6739, 2), (10109, 6740, 21), (10110, 6740, 52), (10111, 6741, 42), (10112, 6742, 29), (10113, 6742, 15), (10114, 6743, 88), (10115, 6743, 53), (10116, 6744, 47), (10117, 6744, 82), (10118, 6745, 85), (10119, 6746, 23), (10120, 6746, 64), (10121, 6747, 37), (10122, 6747, 39), (10123, 6748, 26), (10124, 6748, 96), (10125, 6749, 67), (10126, 6749, 64), (10127, 6750, 16), (10128, 6751, 2), (10129, 6752, 12), (10130, 6753, 96), (10131, 6753, 59), (10132, 6754, 92), (10133, 6754, 42), (10134, 6755, 79), (10135, 6755, 28), (10136, 6756, 76), (10137, 6756, 52), (10138, 6757, 80), (10139, 6758, 88), (10140, 6759, 89), (10141, 6760, 76), (10142, 6760, 37), (10143, 6761, 12), (10144, 6762, 77), (10145, 6762, 87), (10146, 6763, 27), (10147, 6764, 25), (10148, 6765, 34), (10149, 6766, 103), (10150, 6766, 23), (10151, 6767, 1), (10152, 6768, 84), (10153, 6768, 18), (10154, 6769, 93), (10155, 6769, 6), (10156, 6770, 59), (10157, 6771, 70), (10158, 6772, 86), (10159, 6772, 15), (10160, 6773, 66), (10161, 6774, 50), (10162, 6774, 57), (10163, 6775, 75), (10164, 6776, 98), (10165, 6777, 18), (10166, 6778, 73), (10167, 6778, 50), (10168, 6779, 82), (10169, 6779, 43), (10170, 6780, 44), (10171, 6780, 43), (10172, 6781, 27), (10173, 6781, 94), (10174, 6782, 102), (10175, 6782, 73), (10176, 6783, 93), (10177, 6783, 96), (10178, 6784, 21), (10179, 6784, 71), (10180, 6785, 51), (10181, 6785, 14), (10182, 6786, 32), (10183, 6786, 11), (10184, 6787, 102), (10185, 6787, 82), (10186, 6788, 98), (10187, 6789, 21), (10188, 6790, 8), (10189, 6790, 47), (10190, 6791, 42), (10191, 6792, 13), (10192, 6792, 14), (10193, 6793, 83), (10194, 6793, 51), (10195, 6794, 40), (10196, 6795, 96), (10197, 6796, 77), (10198, 6797, 83), (10199, 6798, 84), (10200, 6799, 32), (10201, 6799, 99), (10202, 6800, 14), (10203, 6800, 21), (10204, 6801, 5), (10205, 6801, 62), (10206, 6802, 12), (10207, 6803, 27), (10208, 6803, 14), (10209, 6804, 20), (10210, 6804, 44), (10211, 6805, 10), (10212, 6806, 67), (10213, 6807, 39), (10214, 6808, 59), (10215, 6809, 58), (10216, 6810, 2), (10217, 6810, 71), (10218, 6811, 41), (10219, 6812, 75), (10220, 6813, 83), (10221, 6814, 72), (10222, 6814, 27), (10223, 6815, 34), (10224, 6815, 95), (10225, 6816, 57), (10226, 6816, 21), (10227, 6817, 17), (10228, 6817, 90), (10229, 6818, 69), (10230, 6819, 103), (10231, 6820, 53), (10232, 6820, 63), (10233, 6821, 63), (10234, 6822, 47), (10235, 6823, 12), (10236, 6824, 72), (10237, 6824, 18), (10238, 6825, 22), (10239, 6825, 43), (10240, 6826, 58), (10241, 6827, 76), (10242, 6827, 14), (10243, 6828, 86), (10244, 6829, 48), (10245, 6830, 57), (10246, 6830, 49), (10247, 6831, 17), (10248, 6832, 62), (10249, 6833, 85), (10250, 6833, 6), (10251, 6834, 63), (10252, 6835, 26), (10253, 6836, 92), (10254, 6837, 68), (10255, 6837, 16), (10256, 6838, 6), (10257, 6839, 6), (10258, 6840, 22), (10259, 6840, 39), (10260, 6841, 66), (10261, 6842, 67), (10262, 6842, 80), (10263, 6843, 72), (10264, 6844, 58), (10265, 6844, 14), (10266, 6845, 42), (10267, 6845, 80), (10268, 6846, 85), (10269, 6846, 75), (10270, 6847, 80), (10271, 6847, 13), (10272, 6848, 13), (10273, 6848, 11), (10274, 6849, 84), (10275, 6849, 73), (10276, 6850, 87), (10277, 6851, 94), (10278, 6851, 90), (10279, 6852, 72), (10280, 6853, 51), (10281, 6854, 88), (10282, 6854, 69), (10283, 6855, 35), (10284, 6856, 42), (10285, 6857, 85), (10286, 6857, 31), (10287, 6858, 60), (10288, 6859, 43), (10289, 6860, 55), (10290, 6861, 92), (10291, 6862, 17), (10292, 6863, 93), (10293, 6863, 72), (10294, 6864, 52), (10295, 6864, 45), (10296, 6865, 88), (10297, 6865, 33), (10298, 6866, 56), (10299, 6867, 90), (10300, 6867, 62), (10301, 6868, 35), (10302, 6868, 51), (10303, 6869, 85), (10304, 6870, 70), (10305, 6870, 49), (10306, 6871, 67), (10307, 6871, 66), (10308, 6872, 22), (10309, 6873, 22), (10310, 6873, 71), (10311, 6874, 25), (10312, 6875, 80), (10313, 6875, 55), (10314, 6876, 80), (10315, 6876, 41), (10316, 6877, 96), (10317, 6878, 10), (10318, 6879, 14), (10319, 6879, 6), (10320, 6880, 33), (10321, 6881, 52), (10322, 6882, 69), (10323, 6883, 57), (10324, 6884, 102), (10325, 6885, 91), (10326, 6885, 22), (10327, 6886, 30), (10328, 6886, 17), (10329, 6887, 98), (10330, 6887, 37), (10331, 6888, 15), (10332, 6889, 40), (10333, 6889, 30), (10334, 6890, 40), (10335, 6891, 100), (10336, 6891, 4), (10337, 6892, 39), (10338, 6892, 10), (10339, 6893, 96), (10340, 6893, 80), (10341, 6894, 45), (10342, 6895, 69), (10343, 6895, 53), (10344, 6896, 35), (10345, 6896, 28), (10346, 6897, 19), (10347, 6897, 2), (10348, 6898, 50), (10349, 6899, 38), (10350, 6900, 18), (10351, 6901, 102), (10352, 6901, 40), (10353, 6902, 62), (10354, 6903, 16), (10355, 6903, 93), (10356, 6904, 87), (10357, 6904, 74), (10358, 6905, 84), (10359, 6906, 74), (10360, 6906, 90), (10361, 6907, 27), (10362, 6907, 79), (10363, 6908, 53), (10364, 6908, 9), (10365, 6909, 75), (10366, 6910, 55), (10367, 6911, 5), (10368, 6912, 84), (10369, 6912, 67), (10370, 6913, 49), (10371, 6913, 62), (10372, 6914, 44), (10373, 6915, 33), (10374, 6915, 64), (10375, 6916, 87), (10376, 6916, 39), (10377, 6917, 44), (10378, 6917, 98), (10379, 6918, 7), (10380, 6919, 64), (10381, 6919, 30), (10382, 6920, 11), (10383, 6920, 83), (10384, 6921, 24), (10385, 6921, 77), (10386, 6922, 44), (10387, 6922, 89), (10388, 6923, 79), (10389, 6924, 83), (10390, 6925, 68), (10391, 6925, 1), (10392, 6926, 17), (10393, 6927, 3), (10394, 6927, 97), (10395, 6928, 23), (10396, 6929, 84), (10397, 6930, 12), (10398, 6931, 53), (10399, 6931, 16), (10400, 6932, 39), (10401, 6932, 23), (10402, 6933, 5), (10403, 6934, 94), (10404, 6935, 14), (10405, 6936, 33), (10406, 6936, 92), (10407, 6937, 97), (10408, 6937, 70), (10409, 6938, 85), (10410, 6938, 98), (10411, 6939, 27), (10412, 6940, 24), (10413, 6940, 20), (10414, 6941, 97), (10415, 6941, 84), (10416, 6942, 97), (10417, 6942, 61), (10418, 6943, 3), (10419, 6944, 80), (10420, 6945, 21), (10421, 6945, 33), (10422, 6946, 1), (10423, 6947, 26), (10424, 6948, 91), (10425, 6948, 19), (10426, 6949, 67), (10427, 6950, 74), (10428, 6950, 25), (10429, 6951, 52), (10430, 6951, 21), (10431, 6952, 87), (10432, 6953, 20), (10433, 6954, 3), (10434, 6955, 103), (10435, 6956, 85), (10436, 6957, 63), (10437, 6958, 36), (10438, 6959, 22), (10439, 6960, 18), (10440, 6961, 17), (10441, 6961, 9), (10442, 6962, 9), (10443, 6963, 49), (10444, 6963, 24), (10445, 6964, 7), (10446, 6965, 76), (10447, 6966, 45), (10448, 6967, 68), (10449, 6967, 93), (10450, 6968, 86), (10451, 6968, 37), (10452, 6969, 92), (10453, 6970, 1), (10454, 6970, 74), (10455, 6971, 103), (10456, 6971, 43), (10457, 6972, 47), (10458, 6972, 75), (10459, 6973, 78), (10460, 6973, 7), (10461, 6974, 39), (10462, 6975, 61), (10463, 6976, 64), (10464, 6976, 59), (10465, 6977, 62), (10466, 6977, 101), (10467, 6978, 25), (10468, 6978, 18), (10469, 6979, 87), (10470, 6979, 86), (10471, 6980, 29), (10472, 6981, 86), (10473, 6982, 82), (10474, 6982, 90), (10475, 6983, 100), (10476, 6984, 68), (10477, 6984, 67), (10478, 6985, 92), (10479, 6985, 48), (10480, 6986, 65), (10481, 6986, 5), (10482, 6987, 86), (10483, 6988, 79), (10484, 6988, 91), (10485, 6989, 67), (10486, 6989, 91), (10487, 6990, 52), (10488, 6991, 12), (10489, 6992, 23), (10490, 6993, 52), (10491, 6994, 85), (10492, 6994, 74), (10493, 6995, 99), (10494, 6995, 17), (10495, 6996, 26), (10496, 6997, 12), (10497, 6997, 25), (10498, 6998, 14), (10499, 6998, 83), (10500, 6999, 99), (10501, 7000, 11), (10502, 7000, 101), (10503, 7001, 87), (10504, 7002, 47), (10505, 7002, 7), (10506, 7003, 24), (10507, 7004, 61), (10508, 7005, 93), (10509, 7006, 13), (10510, 7007, 11), (10511, 7007, 18), (10512, 7008, 36), (10513, 7009, 77), (10514, 7009, 17), (10515, 7010, 53), (10516, 7011, 85), (10517, 7012, 97), (10518, 7012, 48), (10519, 7013, 87), (10520, 7014, 54), (10521, 7014, 19), (10522, 7015, 46), (10523, 7015, 63), (10524, 7016, 43), (10525, 7017, 76), (10526, 7018, 95);
+INSERT INTO livestream_tags (id, livestream_id, tag_id) VALUES (10527, 7019, 86), (10528, 7020, 81), (10529, 7020, 87), (10530, 7021, 86), (10531, 7021, 6), (10532, 7022, 51), (10533, 7022, 9), (10534, 7023, 89), (10535, 7024, 7), (10536, 7025, 70), (10537, 7025, 93), (10538, 7026, 49), (10539, 7026, 94), (10540, 7027, 59), (10541, 7028, 22), (10542, 7028, 93), (10543, 7029, 8), (10544, 7029, 7), (10545, 7030, 73), (10546, 7030, 99), (10547, 7031, 65), (10548, 7031, 18), (10549, 7032, 70), (10550, 7032, 18), (10551, 7033, 15), (10552, 7034, 63), (10553, 7034, 72), (10554, 7035, 1), (10555, 7036, 91), (10556, 7037, 97), (10557, 7037, 80), (10558, 7038, 32), (10559, 7039, 77), (10560, 7040, 73), (10561, 7040, 9), (10562, 7041, 6), (10563, 7041, 3), (10564, 7042, 67), (10565, 7042, 101), (10566, 7043, 66), (10567, 7043, 25), (10568, 7044, 8), (10569, 7044, 29), (10570, 7045, 88), (10571, 7045, 39), (10572, 7046, 18), (10573, 7047, 54), (10574, 7047, 23), (10575, 7048, 78), (10576, 7049, 90), (10577, 7049, 68), (10578, 7050, 14), (10579, 7050, 96), (10580, 7051, 73), (10581, 7051, 9), (10582, 7052, 47), (10583, 7053, 25), (10584, 7053, 91), (10585, 7054, 71), (10586, 7054, 61), (10587, 7055, 84), (10588, 7055, 37), (10589, 7056, 6), (10590, 7056, 10), (10591, 7057, 61), (10592, 7058, 54), (10593, 7059, 85), (10594, 7060, 50), (10595, 7061, 51), (10596, 7061, 79), (10597, 7062, 23), (10598, 7062, 5), (10599, 7063, 9), (10600, 7063, 4), (10601, 7064, 65), (10602, 7064, 64), (10603, 7065, 100), (10604, 7065, 83), (10605, 7066, 84), (10606, 7066, 29), (10607, 7067, 40), (10608, 7067, 3), (10609, 7068, 84), (10610, 7069, 42), (10611, 7070, 20), (10612, 7070, 24), (10613, 7071, 34), (10614, 7071, 53), (10615, 7072, 21), (10616, 7072, 38), (10617, 7073, 53), (10618, 7073, 83), (10619, 7074, 88), (10620, 7074, 45), (10621, 7075, 30), (10622, 7075, 42), (10623, 7076, 42), (10624, 7077, 32), (10625, 7078, 50), (10626, 7079, 102), (10627, 7079, 56), (10628, 7080, 10), (10629, 7081, 60), (10630, 7082, 47), (10631, 7083, 21), (10632, 7084, 71), (10633, 7085, 15), (10634, 7085, 27), (10635, 7086, 24), (10636, 7087, 81), (10637, 7088, 83), (10638, 7088, 76), (10639, 7089, 96), (10640, 7090, 55), (10641, 7090, 37), (10642, 7091, 16), (10643, 7092, 37), (10644, 7092, 69), (10645, 7093, 27), (10646, 7093, 1), (10647, 7094, 97), (10648, 7094, 86), (10649, 7095, 47), (10650, 7096, 38), (10651, 7096, 51), (10652, 7097, 28), (10653, 7097, 100), (10654, 7098, 87), (10655, 7099, 79), (10656, 7099, 13), (10657, 7100, 19), (10658, 7101, 27), (10659, 7102, 85), (10660, 7103, 12), (10661, 7103, 43), (10662, 7104, 59), (10663, 7104, 92), (10664, 7105, 58), (10665, 7106, 9), (10666, 7106, 54), (10667, 7107, 86), (10668, 7108, 48), (10669, 7109, 98), (10670, 7109, 69), (10671, 7110, 49), (10672, 7110, 8), (10673, 7111, 43), (10674, 7111, 69), (10675, 7112, 10), (10676, 7112, 54), (10677, 7113, 72), (10678, 7114, 15), (10679, 7114, 99), (10680, 7115, 1), (10681, 7116, 49), (10682, 7116, 80), (10683, 7117, 37), (10684, 7117, 29), (10685, 7118, 3), (10686, 7119, 50), (10687, 7119, 3), (10688, 7120, 41), (10689, 7120, 47), (10690, 7121, 45), (10691, 7121, 73), (10692, 7122, 71), (10693, 7122, 31), (10694, 7123, 101), (10695, 7124, 44), (10696, 7124, 87), (10697, 7125, 64), (10698, 7126, 65), (10699, 7126, 57), (10700, 7127, 21), (10701, 7127, 12), (10702, 7128, 37), (10703, 7128, 44), (10704, 7129, 34), (10705, 7129, 46), (10706, 7130, 46), (10707, 7130, 54), (10708, 7131, 72), (10709, 7131, 37), (10710, 7132, 93), (10711, 7133, 57), (10712, 7134, 23), (10713, 7135, 13), (10714, 7136, 55), (10715, 7137, 10), (10716, 7137, 71), (10717, 7138, 50), (10718, 7139, 29), (10719, 7139, 30), (10720, 7140, 19), (10721, 7140, 56), (10722, 7141, 98), (10723, 7142, 40), (10724, 7143, 82), (10725, 7143, 45), (10726, 7144, 95), (10727, 7144, 53), (10728, 7145, 74), (10729, 7145, 101), (10730, 7146, 50), (10731, 7147, 31), (10732, 7147, 19), (10733, 7148, 56), (10734, 7149, 69), (10735, 7150, 53), (10736, 7150, 40), (10737, 7151, 37), (10738, 7151, 74), (10739, 7152, 61), (10740, 7153, 55), (10741, 7153, 12), (10742, 7154, 24), (10743, 7155, 103), (10744, 7155, 74), (10745, 7156, 14), (10746, 7157, 55), (10747, 7157, 60), (10748, 7158, 80), (10749, 7159, 94), (10750, 7160, 37), (10751, 7161, 56), (10752, 7161, 68), (10753, 7162, 90), (10754, 7162, 43), (10755, 7163, 14), (10756, 7164, 89), (10757, 7165, 52), (10758, 7165, 33), (10759, 7166, 32), (10760, 7167, 17), (10761, 7168, 95), (10762, 7169, 89), (10763, 7169, 98), (10764, 7170, 3), (10765, 7170, 1), (10766, 7171, 73), (10767, 7172, 75), (10768, 7173, 65), (10769, 7174, 32), (10770, 7174, 65), (10771, 7175, 65), (10772, 7175, 11), (10773, 7176, 95), (10774, 7177, 72), (10775, 7177, 7), (10776, 7178, 32), (10777, 7179, 52), (10778, 7180, 37), (10779, 7181, 65), (10780, 7182, 66), (10781, 7182, 10), (10782, 7183, 44), (10783, 7183, 79), (10784, 7184, 14), (10785, 7185, 88), (10786, 7186, 4), (10787, 7186, 10), (10788, 7187, 43), (10789, 7187, 90), (10790, 7188, 34), (10791, 7189, 93), (10792, 7189, 89), (10793, 7190, 1), (10794, 7191, 31), (10795, 7191, 98), (10796, 7192, 9), (10797, 7193, 14), (10798, 7193, 83), (10799, 7194, 72), (10800, 7194, 40), (10801, 7195, 25), (10802, 7196, 82), (10803, 7197, 36), (10804, 7197, 3), (10805, 7198, 86), (10806, 7198, 51), (10807, 7199, 17), (10808, 7200, 32), (10809, 7201, 37), (10810, 7202, 40), (10811, 7203, 22), (10812, 7204, 67), (10813, 7204, 33), (10814, 7205, 51), (10815, 7206, 74), (10816, 7206, 28), (10817, 7207, 24), (10818, 7207, 2), (10819, 7208, 15), (10820, 7208, 58), (10821, 7209, 92), (10822, 7209, 1), (10823, 7210, 36), (10824, 7210, 14), (10825, 7211, 37), (10826, 7212, 37), (10827, 7212, 67), (10828, 7213, 59), (10829, 7213, 100), (10830, 7214, 65), (10831, 7214, 98), (10832, 7215, 100), (10833, 7215, 21), (10834, 7216, 66), (10835, 7216, 35), (10836, 7217, 19), (10837, 7218, 53), (10838, 7219, 99), (10839, 7219, 36), (10840, 7220, 30), (10841, 7221, 59), (10842, 7221, 1), (10843, 7222, 103), (10844, 7222, 100), (10845, 7223, 98), (10846, 7223, 31), (10847, 7224, 74), (10848, 7225, 67), (10849, 7226, 69), (10850, 7227, 20), (10851, 7227, 25), (10852, 7228, 81), (10853, 7229, 54), (10854, 7229, 97), (10855, 7230, 59), (10856, 7230, 99), (10857, 7231, 95), (10858, 7231, 102), (10859, 7232, 40), (10860, 7233, 42), (10861, 7234, 11), (10862, 7234, 90), (10863, 7235, 49), (10864, 7235, 41), (10865, 7236, 63), (10866, 7237, 39), (10867, 7238, 63), (10868, 7238, 1), (10869, 7239, 13), (10870, 7239, 50), (10871, 7240, 103), (10872, 7240, 13), (10873, 7241, 18), (10874, 7241, 103), (10875, 7242, 47), (10876, 7243, 22), (10877, 7244, 85), (10878, 7245, 29), (10879, 7245, 100), (10880, 7246, 47), (10881, 7247, 12), (10882, 7248, 7), (10883, 7249, 93), (10884, 7249, 83), (10885, 7250, 44), (10886, 7250, 43), (10887, 7251, 6), (10888, 7252, 60), (10889, 7253, 1), (10890, 7254, 53), (10891, 7255, 34), (10892, 7256, 96), (10893, 7256, 3), (10894, 7257, 29), (10895, 7257, 74), (10896, 7258, 33), (10897, 7259, 3), (10898, 7259, 9), (10899, 7260, 72), (10900, 7260, 12), (10901, 7261, 61), (10902, 7261, 93), (10903, 7262, 17), (10904, 7263, 17), (10905, 7264, 90), (10906, 7264, 78), (10907, 7265, 24), (10908, 7266, 87), (10909, 7266, 60), (10910, 7267, 102), (10911, 7268, 1), (10912, 7269, 100), (10913, 7269, 96), (10914, 7270, 70), (10915, 7271, 46), (10916, 7272, 59), (10917, 7273, 68), (10918, 7273, 33), (10919, 7274, 45), (10920, 7274, 15), (10921, 7275, 26), (10922, 7275, 80), (10923, 7276, 23), (10924, 7276, 62), (10925, 7277, 56), (10926, 7277, 59), (10927, 7278, 79), (10928, 7278, 80), (10929, 7279, 103), (10930, 7280, 77), (10931, 7280, 103), (10932, 7281, 20), (10933, 7282, 54), (10934, 7282, 88), (10935, 7283, 89), (10936, 7283, 12), (10937, 7284, 51), (10938, 7284, 52), (10939, 7285, 97), (10940, 7286, 78), (10941, 7287, 60), (10942, 7287, 40), (10943, 7288, 57), (10944, 7289, 70), (10945, 7290, 96), (10946, 7290, 94), (10947, 7291, 29), (10948, 7291, 1), (10949, 7292, 10), (10950, 7293, 2), (10951, 7294, 73), (10952, 7295, 94), (10953, 7296, 73), (10954, 7297, 40), (10955, 7297, 38), (10956, 7298, 38), (10957, 7299, 71), (10958, 7300, 95), (10959, 7300, 5), (10960, 7301, 4), (10961, 7302, 17), (10962, 7303, 29), (10963, 7304, 16), (10964, 7304, 96), (10965, 7305, 68), (10966, 7305, 29);
@@ -0,0 +1,14339 @@
+INSERT INTO ng_words (user_id, livestream_id, word, created_at)
+VALUES
+	(785, 2782, '2相コミット', UNIX_TIMESTAMP()),
+	(953, 1951, '3DES', UNIX_TIMESTAMP()),
+	(609, 1607, '3G', UNIX_TIMESTAMP()),
+	(267, 6260, '3相コミット', UNIX_TIMESTAMP()),
+	(685, 2682, '4G', UNIX_TIMESTAMP()),
+	(451, 3447, '5G', UNIX_TIMESTAMP()),
+	(606, 5600, 'AAAAレコード', UNIX_TIMESTAMP()),
+	(347, 346, 'ACIDプロパティ', UNIX_TIMESTAMP()),
+	(850, 5844, 'ADSL', UNIX_TIMESTAMP()),
+	(89, 4084, 'AES', UNIX_TIMESTAMP()),
+	(63, 62, 'APIPA', UNIX_TIMESTAMP()),
+	(655, 6648, 'APIゲートウェイ', UNIX_TIMESTAMP()),
+	(304, 7296, 'APIゲートウェイ', UNIX_TIMESTAMP()),
+	(528, 6521, 'ARP', UNIX_TIMESTAMP()),
+	(61, 4056, 'ARPANET', UNIX_TIMESTAMP()),
+	(981, 4976, 'Anycast', UNIX_TIMESTAMP()),
+	(2, 2998, 'Aレコード', UNIX_TIMESTAMP()),
+	(979, 2976, 'BASEプロパティ', UNIX_TIMESTAMP()),
+	(70, 69, 'BASIC', UNIX_TIMESTAMP()),
+	(494, 493, 'BBS', UNIX_TIMESTAMP()),
+	(271, 6264, 'BCP', UNIX_TIMESTAMP()),
+	(335, 7327, 'BGP', UNIX_TIMESTAMP()),
+	(422, 4417, 'BIND', UNIX_TIMESTAMP()),
+	(754, 3750, 'Broadcast', UNIX_TIMESTAMP()),
+	(844, 1842, 'CA', UNIX_TIMESTAMP()),
+	(530, 2527, 'CAP定理', UNIX_TIMESTAMP()),
+	(940, 6933, 'CDMA', UNIX_TIMESTAMP()),
+	(512, 5506, 'CDN', UNIX_TIMESTAMP()),
+	(850, 5844, 'CDN', UNIX_TIMESTAMP()),
+	(485, 4480, 'CI/CD', UNIX_TIMESTAMP()),
+	(815, 1813, 'CIDR', UNIX_TIMESTAMP()),
+	(929, 4924, 'CIFS', UNIX_TIMESTAMP()),
+	(595, 594, 'CNAME', UNIX_TIMESTAMP()),
+	(89, 1087, 'CPU', UNIX_TIMESTAMP()),
+	(716, 3712, 'CQRS', UNIX_TIMESTAMP()),
+	(848, 5842, 'CRAC', UNIX_TIMESTAMP()),
+	(416, 1414, 'CSMA/CA', UNIX_TIMESTAMP()),
+	(991, 990, 'CSMA/CD', UNIX_TIMESTAMP()),
+	(368, 6361, 'DDNS', UNIX_TIMESTAMP()),
+	(440, 6433, 'DES', UNIX_TIMESTAMP()),
+	(878, 1876, 'DHCP', UNIX_TIMESTAMP()),
+	(90, 5084, 'DMZ', UNIX_TIMESTAMP()),
+	(224, 3220, 'DNS', UNIX_TIMESTAMP()),
+	(740, 2737, 'DR', UNIX_TIMESTAMP()),
+	(887, 2884, 'Diffie-Hellman', UNIX_TIMESTAMP()),
+	(443, 6436, 'DoS攻撃', UNIX_TIMESTAMP()),
+	(583, 2580, 'Docker', UNIX_TIMESTAMP()),
+	(13, 12, 'EDGE', UNIX_TIMESTAMP()),
+	(216, 215, 'EIGRP', UNIX_TIMESTAMP()),
+	(136, 5130, 'Ethernet', UNIX_TIMESTAMP()),
+	(900, 2897, 'FCoE', UNIX_TIMESTAMP()),
+	(363, 362, 'FQDN', UNIX_TIMESTAMP()),
+	(519, 4514, 'FTP', UNIX_TIMESTAMP()),
+	(442, 3438, 'FaaS', UNIX_TIMESTAMP()),
+	(526, 6519, 'GIFアニメーション', UNIX_TIMESTAMP()),
+	(318, 5312, 'GLBP', UNIX_TIMESTAMP()),
+	(813, 5807, 'GSM', UNIX_TIMESTAMP()),
+	(774, 773, 'GitOps', UNIX_TIMESTAMP()),
+	(539, 2536, 'Gossipプロトコル', UNIX_TIMESTAMP()),
+	(12, 2009, 'HA', UNIX_TIMESTAMP()),
+	(949, 5943, 'HSPA+', UNIX_TIMESTAMP()),
+	(421, 2418, 'HSRP', UNIX_TIMESTAMP()),
+	(245, 5239, 'HTTP', UNIX_TIMESTAMP()),
+	(277, 5271, 'HTTPS', UNIX_TIMESTAMP()),
+	(340, 3336, 'Hotspot', UNIX_TIMESTAMP()),
+	(304, 303, 'ICMP', UNIX_TIMESTAMP()),
+	(977, 2974, 'ICMPv6', UNIX_TIMESTAMP()),
+	(2, 1, 'IDS', UNIX_TIMESTAMP()),
+	(699, 5693, 'IEEE802.11', UNIX_TIMESTAMP()),
+	(891, 3887, 'IMAP', UNIX_TIMESTAMP()),
+	(177, 6170, 'IPS', UNIX_TIMESTAMP()),
+	(423, 5417, 'IPsec', UNIX_TIMESTAMP()),
+	(971, 970, 'IPv4', UNIX_TIMESTAMP()),
+	(457, 2454, 'IPv6', UNIX_TIMESTAMP()),
+	(110, 1108, 'IPv6トンネリング', UNIX_TIMESTAMP()),
+	(955, 2952, 'IPアドレス', UNIX_TIMESTAMP()),
+	(336, 2333, 'ISDN', UNIX_TIMESTAMP()),
+	(525, 1523, 'IaaS', UNIX_TIMESTAMP()),
+	(857, 3853, 'IaaS', UNIX_TIMESTAMP()),
+	(46, 6039, 'JPEG2000', UNIX_TIMESTAMP()),
+	(66, 2063, 'JSON', UNIX_TIMESTAMP()),
+	(515, 6508, 'Kubernetes', UNIX_TIMESTAMP()),
+	(777, 4772, 'L2TP', UNIX_TIMESTAMP()),
+	(283, 5277, 'LACP', UNIX_TIMESTAMP()),
+	(94, 2091, 'LAN', UNIX_TIMESTAMP()),
+	(941, 2938, 'LDAP', UNIX_TIMESTAMP()),
+	(30, 7022, 'LTE', UNIX_TIMESTAMP()),
+	(422, 1420, 'MACアドレス', UNIX_TIMESTAMP()),
+	(588, 3584, 'MD5', UNIX_TIMESTAMP()),
+	(552, 551, 'MD5', UNIX_TIMESTAMP()),
+	(589, 4584, 'MIMO', UNIX_TIMESTAMP()),
+	(303, 4298, 'MP3', UNIX_TIMESTAMP()),
+	(299, 3295, 'MPLS', UNIX_TIMESTAMP()),
+	(469, 1467, 'MSTP', UNIX_TIMESTAMP()),
+	(987, 986, 'MTU', UNIX_TIMESTAMP()),
+	(744, 1742, 'MXレコード', UNIX_TIMESTAMP()),
+	(742, 1740, 'Multicast', UNIX_TIMESTAMP()),
+	(754, 6747, 'NAS', UNIX_TIMESTAMP()),
+	(485, 7477, 'NAT', UNIX_TIMESTAMP()),
+	(828, 5822, 'NETBIOS', UNIX_TIMESTAMP()),
+	(563, 5557, 'NFS', UNIX_TIMESTAMP()),
+	(644, 1642, 'NFV', UNIX_TIMESTAMP()),
+	(736, 1734, 'NIC', UNIX_TIMESTAMP()),
+	(287, 6280, 'NSレコード', UNIX_TIMESTAMP()),
+	(80, 7072, 'NTP', UNIX_TIMESTAMP()),
+	(748, 3744, 'NetBEUI', UNIX_TIMESTAMP()),
+	(581, 4576, 'Netcat', UNIX_TIMESTAMP()),
+	(384, 4379, 'OSIモデル', UNIX_TIMESTAMP()),
+	(792, 3788, 'OSPF', UNIX_TIMESTAMP()),
+	(998, 1996, 'PDU', UNIX_TIMESTAMP()),
+	(767, 4762, 'PKI', UNIX_TIMESTAMP()),
+	(685, 684, 'POP3', UNIX_TIMESTAMP()),
+	(306, 7298, 'POTS', UNIX_TIMESTAMP()),
+	(215, 6208, 'PPP', UNIX_TIMESTAMP()),
+	(225, 5219, 'PPPoE', UNIX_TIMESTAMP()),
+	(281, 7273, 'PPTP', UNIX_TIMESTAMP()),
+	(877, 1875, 'PSTN', UNIX_TIMESTAMP()),
+	(435, 3431, 'PTRレコード', UNIX_TIMESTAMP()),
+	(731, 3727, 'PaaS', UNIX_TIMESTAMP()),
+	(958, 3954, 'PaaS', UNIX_TIMESTAMP()),
+	(659, 5653, 'Paxosアルゴリズム', UNIX_TIMESTAMP()),
+	(506, 3502, 'PoE', UNIX_TIMESTAMP()),
+	(520, 519, 'QoS', UNIX_TIMESTAMP()),
+	(887, 5881, 'RADIUS', UNIX_TIMESTAMP()),
+	(350, 1348, 'RAID', UNIX_TIMESTAMP()),
+	(292, 2289, 'RDP', UNIX_TIMESTAMP()),
+	(838, 3834, 'RIP', UNIX_TIMESTAMP()),
+	(289, 7281, 'RSA', UNIX_TIMESTAMP()),
+	(666, 665, 'RSTP', UNIX_TIMESTAMP()),
+	(323, 4318, 'RSYNC', UNIX_TIMESTAMP()),
+	(247, 1245, 'Raftアルゴリズム', UNIX_TIMESTAMP()),
+	(257, 256, 'ReverseDNS', UNIX_TIMESTAMP()),
+	(229, 6222, 'SAN', UNIX_TIMESTAMP()),
+	(862, 6855, 'SCSI', UNIX_TIMESTAMP()),
+	(827, 4822, 'SD-WAN', UNIX_TIMESTAMP()),
+	(740, 6733, 'SDN', UNIX_TIMESTAMP()),
+	(73, 6066, 'SDSL', UNIX_TIMESTAMP()),
+	(131, 1129, 'SFTP', UNIX_TIMESTAMP()),
+	(805, 5799, 'SHA-1', UNIX_TIMESTAMP()),
+	(205, 7197, 'SHA-256', UNIX_TIMESTAMP()),
+	(985, 5979, 'SHA1', UNIX_TIMESTAMP()),
+	(260, 5254, 'SLA', UNIX_TIMESTAMP()),
+	(582, 6575, 'SLA', UNIX_TIMESTAMP()),
+	(703, 702, 'SLI', UNIX_TIMESTAMP()),
+	(223, 7215, 'SLO', UNIX_TIMESTAMP()),
+	(513, 2510, 'SMTP', UNIX_TIMESTAMP()),
+	(236, 2233, 'SNMP', UNIX_TIMESTAMP()),
+	(645, 2642, 'SNMPv3', UNIX_TIMESTAMP()),
+	(538, 5532, 'SOAレコード', UNIX_TIMESTAMP()),
+	(843, 1841, 'SOCKS', UNIX_TIMESTAMP()),
+	(689, 6682, 'SRE', UNIX_TIMESTAMP()),
+	(928, 2925, 'SRVレコード', UNIX_TIMESTAMP()),
+	(114, 6107, 'SS7', UNIX_TIMESTAMP()),
+	(946, 3942, 'SSH', UNIX_TIMESTAMP()),
+	(154, 7146, 'SSID', UNIX_TIMESTAMP()),
+	(133, 132, 'SSLVPN', UNIX_TIMESTAMP()),
+	(342, 4337, 'SSL/TLS', UNIX_TIMESTAMP()),
+	(137, 7129, 'SSL証明書', UNIX_TIMESTAMP()),
+	(456, 3452, 'STP', UNIX_TIMESTAMP()),
+	(669, 2666, 'SaaS', UNIX_TIMESTAMP()),
+	(794, 6787, 'SaaS', UNIX_TIMESTAMP()),
+	(354, 6347, 'Samba', UNIX_TIMESTAMP()),
+	(892, 6885, 'Subversion', UNIX_TIMESTAMP()),
+	(269, 268, 'Syslog', UNIX_TIMESTAMP()),
+	(782, 1780, 'TCP', UNIX_TIMESTAMP()),
+	(959, 4954, 'TCP/IPモデル', UNIX_TIMESTAMP()),
+	(757, 6750, 'TFTP', UNIX_TIMESTAMP()),
+	(435, 1433, 'TLD', UNIX_TIMESTAMP()),
+	(896, 3892, 'Telnet', UNIX_TIMESTAMP()),
+	(218, 4213, 'Tethering', UNIX_TIMESTAMP()),
+	(125, 6118, 'TokenRing', UNIX_TIMESTAMP()),
+	(263, 2260, 'Traceroute', UNIX_TIMESTAMP()),
+	(755, 6748, 'UDP', UNIX_TIMESTAMP()),
+	(595, 3591, 'UMTS', UNIX_TIMESTAMP()),
+	(240, 7232, 'UPS', UNIX_TIMESTAMP()),
+	(97, 6090, 'URI', UNIX_TIMESTAMP()),
+	(578, 2575, 'URL', UNIX_TIMESTAMP()),
+	(20, 7012, 'UUCP', UNIX_TIMESTAMP()),
+	(219, 5213, 'Unicast', UNIX_TIMESTAMP()),
+	(927, 926, 'VDSL', UNIX_TIMESTAMP()),
+	(224, 5218, 'VLAN', UNIX_TIMESTAMP()),
+	(63, 5057, 'VLAN', UNIX_TIMESTAMP()),
+	(548, 4543, 'VM（仮想マシン）', UNIX_TIMESTAMP()),
+	(153, 6146, 'VNC', UNIX_TIMESTAMP()),
+	(679, 1677, 'VPC', UNIX_TIMESTAMP()),
+	(757, 5751, 'VPN', UNIX_TIMESTAMP()),
+	(990, 2987, 'VPN', UNIX_TIMESTAMP()),
+	(312, 2309, 'VRRP', UNIX_TIMESTAMP()),
+	(624, 2621, 'VoIP', UNIX_TIMESTAMP()),
+	(704, 1702, 'WAN', UNIX_TIMESTAMP()),
+	(352, 6345, 'WEP', UNIX_TIMESTAMP()),
+	(681, 6674, 'WHOIS', UNIX_TIMESTAMP()),
+	(495, 5489, 'WPA', UNIX_TIMESTAMP()),
+	(614, 4609, 'Wi-Fi', UNIX_TIMESTAMP()),
+	(785, 4780, 'WiMAX', UNIX_TIMESTAMP()),
+	(158, 1156, 'Wiki', UNIX_TIMESTAMP()),
+	(430, 7422, 'XHTML', UNIX_TIMESTAMP()),
+	(515, 4510, 'iSCSI', UNIX_TIMESTAMP()),
+	(923, 2920, 'x86', UNIX_TIMESTAMP()),
+	(876, 4871, 'かな漢字変換', UNIX_TIMESTAMP()),
+	(974, 1972, 'アーキテクチャ', UNIX_TIMESTAMP()),
+	(903, 4898, 'アクセスカード', UNIX_TIMESTAMP()),
+	(476, 475, 'アクティブノード', UNIX_TIMESTAMP()),
+	(191, 3187, 'アジャイル', UNIX_TIMESTAMP()),
+	(164, 3160, 'アスキー', UNIX_TIMESTAMP()),
+	(576, 2573, 'アセンブリ言語', UNIX_TIMESTAMP()),
+	(539, 1537, 'アップタイム', UNIX_TIMESTAMP()),
+	(104, 1102, 'アップタイム', UNIX_TIMESTAMP()),
+	(763, 1761, 'アドベンチャーゲーム', UNIX_TIMESTAMP()),
+	(120, 4115, 'アプリケーションソフトウェア', UNIX_TIMESTAMP()),
+	(765, 4760, 'アプリケーション層', UNIX_TIMESTAMP()),
+	(466, 7458, 'アラート', UNIX_TIMESTAMP()),
+	(18, 3014, 'アラート', UNIX_TIMESTAMP()),
+	(716, 3712, 'アラート', UNIX_TIMESTAMP()),
+	(51, 3047, 'イーサーチャネル', UNIX_TIMESTAMP()),
+	(589, 5583, 'イーサネット', UNIX_TIMESTAMP()),
+	(549, 6542, 'イデンポテンス', UNIX_TIMESTAMP()),
+	(466, 6459, 'イベントソーシング', UNIX_TIMESTAMP()),
+	(105, 6098, 'イベント駆動アーキテクチャ', UNIX_TIMESTAMP()),
+	(388, 2385, 'イミュータビリティ', UNIX_TIMESTAMP()),
+	(611, 610, 'インシデント管理', UNIX_TIMESTAMP()),
+	(235, 5229, 'インターネット', UNIX_TIMESTAMP()),
+	(12, 1010, 'インタフェース', UNIX_TIMESTAMP()),
+	(973, 4968, 'イントラネット', UNIX_TIMESTAMP()),
+	(275, 4270, 'インバータ', UNIX_TIMESTAMP()),
+	(636, 4631, 'インフラストラクチャーコード', UNIX_TIMESTAMP()),
+	(491, 3487, 'インフラヘルスチェック', UNIX_TIMESTAMP()),
+	(320, 319, 'インプットメソッド', UNIX_TIMESTAMP()),
+	(622, 4617, 'ウィンドウサイズ', UNIX_TIMESTAMP()),
+	(795, 3791, 'ウェアラブルコンピューティング', UNIX_TIMESTAMP()),
+	(238, 3234, 'ウェブディレクトリ', UNIX_TIMESTAMP()),
+	(346, 345, 'ウェブブラウザ', UNIX_TIMESTAMP()),
+	(57, 1055, 'エッジコンピューティング', UNIX_TIMESTAMP()),
+	(578, 5572, 'エミュレータ', UNIX_TIMESTAMP()),
+	(716, 1714, 'エラーバジェット', UNIX_TIMESTAMP()),
+	(885, 2882, 'エンドツーエンドの原則', UNIX_TIMESTAMP()),
+	(408, 5402, 'オーケストレーション', UNIX_TIMESTAMP()),
+	(205, 4200, 'オートスケーリング', UNIX_TIMESTAMP()),
+	(561, 5555, 'オートメーション', UNIX_TIMESTAMP()),
+	(111, 2108, 'オーバーヘッド', UNIX_TIMESTAMP()),
+	(578, 2575, 'オープンリール', UNIX_TIMESTAMP()),
+	(209, 6202, 'オフサイト', UNIX_TIMESTAMP()),
+	(562, 2559, 'オフプレミス', UNIX_TIMESTAMP()),
+	(156, 1154, 'オブザーバビリティ', UNIX_TIMESTAMP()),
+	(580, 1578, 'オペレーショナルエクセレンス', UNIX_TIMESTAMP()),
+	(626, 5620, 'オペレーショナルトランスフォーマンス', UNIX_TIMESTAMP()),
+	(254, 3250, 'オペレーション', UNIX_TIMESTAMP()),
+	(436, 5430, 'オペレーティングシステム', UNIX_TIMESTAMP()),
+	(401, 1399, 'オンサイト', UNIX_TIMESTAMP()),
+	(171, 1169, 'オンラインゲーム', UNIX_TIMESTAMP()),
+	(576, 1574, 'カーネル', UNIX_TIMESTAMP()),
+	(726, 4721, 'カオスエンジニアリング', UNIX_TIMESTAMP()),
+	(613, 6606, 'カナリーリリース', UNIX_TIMESTAMP()),
+	(915, 5909, 'カプセル化', UNIX_TIMESTAMP()),
+	(63, 6056, 'ガベージコレクション', UNIX_TIMESTAMP()),
+	(76, 1074, 'キーボード', UNIX_TIMESTAMP()),
+	(508, 2505, 'キャッシュ', UNIX_TIMESTAMP()),
+	(580, 3576, 'キャッシュコヒーレンシ', UNIX_TIMESTAMP()),
+	(206, 7198, 'キャパシティ', UNIX_TIMESTAMP()),
+	(503, 6496, 'キャパシティプランニング', UNIX_TIMESTAMP()),
+	(392, 5386, 'キャラクターユーザインタフェース', UNIX_TIMESTAMP()),
+	(649, 6642, 'クーリング', UNIX_TIMESTAMP()),
+	(627, 5621, 'クォーラム', UNIX_TIMESTAMP()),
+	(752, 751, 'クォーラム', UNIX_TIMESTAMP()),
+	(528, 527, 'クラウドコンピューティング', UNIX_TIMESTAMP()),
+	(35, 3031, 'クラスター', UNIX_TIMESTAMP()),
+	(731, 3727, 'クラッカー', UNIX_TIMESTAMP()),
+	(215, 5209, 'クロスサイトスクリプティング', UNIX_TIMESTAMP()),
+	(787, 1785, 'グーグル', UNIX_TIMESTAMP()),
+	(904, 903, 'グラフィカルユーザインタフェース', UNIX_TIMESTAMP()),
+	(999, 998, 'グラフ理論', UNIX_TIMESTAMP()),
+	(819, 2816, 'グリーンデータセンター', UNIX_TIMESTAMP()),
+	(732, 4727, 'グリッドコンピューティング', UNIX_TIMESTAMP()),
+	(521, 3517, 'グリッドコンピューティング', UNIX_TIMESTAMP()),
+	(709, 4704, 'グレースフルシャットダウン', UNIX_TIMESTAMP()),
+	(944, 4939, 'グレースフルデグラード', UNIX_TIMESTAMP()),
+	(590, 2587, 'ケージ', UNIX_TIMESTAMP()),
+	(292, 3288, 'ケーブル管理', UNIX_TIMESTAMP()),
+	(129, 1127, 'ゲートウェイ', UNIX_TIMESTAMP()),
+	(786, 4781, 'ゲートウェイ', UNIX_TIMESTAMP()),
+	(758, 757, 'ゲーミングPC', UNIX_TIMESTAMP()),
+	(488, 4483, 'コーデック', UNIX_TIMESTAMP()),
+	(531, 5525, 'コールドアイル', UNIX_TIMESTAMP()),
+	(23, 6016, 'コールドスタンバイ', UNIX_TIMESTAMP()),
+	(168, 7160, 'コスト最適化', UNIX_TIMESTAMP()),
+	(633, 1631, 'コピーコントロールCD', UNIX_TIMESTAMP()),
+	(13, 4008, 'コミットログ', UNIX_TIMESTAMP()),
+	(314, 2311, 'コリジョンドメイン', UNIX_TIMESTAMP()),
+	(841, 840, 'コロケーション', UNIX_TIMESTAMP()),
+	(960, 4955, 'コンシステンシモデル', UNIX_TIMESTAMP()),
+	(206, 3202, 'コンセンサス', UNIX_TIMESTAMP()),
+	(972, 6965, 'コンソール', UNIX_TIMESTAMP()),
+	(263, 6256, 'コンテナ', UNIX_TIMESTAMP()),
+	(745, 5739, 'コンテナオーケストレーション', UNIX_TIMESTAMP()),
+	(268, 4263, 'コンパイラ', UNIX_TIMESTAMP()),
+	(560, 559, 'コンピュータウイルス', UNIX_TIMESTAMP()),
+	(962, 1960, 'コンピュータグラフィックス', UNIX_TIMESTAMP()),
+	(494, 4489, 'コンピューティング', UNIX_TIMESTAMP()),
+	(771, 770, 'コンフィグ管理', UNIX_TIMESTAMP()),
+	(983, 6976, 'サーキットブレーカー', UNIX_TIMESTAMP()),
+	(834, 1832, 'サーキットブレーカー', UNIX_TIMESTAMP()),
+	(177, 7169, 'サーバーレス', UNIX_TIMESTAMP()),
+	(108, 5102, 'サーバファーム', UNIX_TIMESTAMP()),
+	(74, 1072, 'サーバログ', UNIX_TIMESTAMP()),
+	(977, 4972, 'サーバ監視', UNIX_TIMESTAMP()),
+	(106, 105, 'サービスキャパシティ', UNIX_TIMESTAMP()),
+	(786, 1784, 'サービスディスカバリ', UNIX_TIMESTAMP()),
+	(58, 57, 'サービスディスカバリ', UNIX_TIMESTAMP()),
+	(211, 5205, 'サービスメッシュ', UNIX_TIMESTAMP()),
+	(977, 3973, 'サービスメッシュ', UNIX_TIMESTAMP()),
+	(403, 3399, 'サービスレベル', UNIX_TIMESTAMP()),
+	(930, 2927, 'サービス改善', UNIX_TIMESTAMP()),
+	(702, 4697, 'サービス品質', UNIX_TIMESTAMP()),
+	(436, 4431, 'サブスクライバ', UNIX_TIMESTAMP()),
+	(21, 20, 'サブネット', UNIX_TIMESTAMP()),
+	(898, 4893, 'サブネットマスク', UNIX_TIMESTAMP()),
+	(696, 6689, 'サポートベクターマシン', UNIX_TIMESTAMP()),
+	(578, 5572, 'シグナリング', UNIX_TIMESTAMP()),
+	(821, 3817, 'システムアップデート', UNIX_TIMESTAMP()),
+	(131, 4126, 'システムテスト', UNIX_TIMESTAMP()),
+	(516, 2513, 'システム復旧', UNIX_TIMESTAMP()),
+	(880, 4875, 'シャーディング', UNIX_TIMESTAMP()),
+	(485, 3481, 'シングルポイントオブフェイルア', UNIX_TIMESTAMP()),
+	(109, 3105, 'シンセベース', UNIX_TIMESTAMP()),
+	(592, 591, 'ジェネリックプログラミング', UNIX_TIMESTAMP()),
+	(255, 3251, 'ジッタ', UNIX_TIMESTAMP()),
+	(408, 4403, 'ジョイスティック', UNIX_TIMESTAMP()),
+	(422, 1420, 'スイッチ', UNIX_TIMESTAMP()),
+	(491, 6484, 'スイッチ', UNIX_TIMESTAMP()),
+	(45, 4040, 'スタンバイノード', UNIX_TIMESTAMP()),
+	(74, 3070, 'ステートフル', UNIX_TIMESTAMP()),
+	(681, 2678, 'ステートレス', UNIX_TIMESTAMP()),
+	(718, 717, 'スティッキーセッション', UNIX_TIMESTAMP()),
+	(709, 2706, 'ストレージ', UNIX_TIMESTAMP()),
+	(265, 2262, 'ストレージエリアネットワーク', UNIX_TIMESTAMP()),
+	(911, 3907, 'ストレージクラスタ', UNIX_TIMESTAMP()),
+	(402, 7394, 'ストレージクラスタ', UNIX_TIMESTAMP()),
+	(81, 2078, 'ストレージ管理', UNIX_TIMESTAMP()),
+	(313, 6306, 'ストレステスト', UNIX_TIMESTAMP()),
+	(602, 2599, 'ストレステスト', UNIX_TIMESTAMP()),
+	(60, 3056, 'スナップショット', UNIX_TIMESTAMP()),
+	(863, 2860, 'スナップショット', UNIX_TIMESTAMP()),
+	(800, 5794, 'スパコン', UNIX_TIMESTAMP()),
+	(45, 7037, 'スモークディテクタ', UNIX_TIMESTAMP()),
+	(73, 6066, 'スラブフロア', UNIX_TIMESTAMP()),
+	(236, 3232, 'スラブフロア', UNIX_TIMESTAMP()),
+	(65, 5059, 'スループット', UNIX_TIMESTAMP()),
+	(901, 3897, 'セキュリティカメラ', UNIX_TIMESTAMP()),
+	(736, 1734, 'セキュリティホール', UNIX_TIMESTAMP()),
+	(408, 1406, 'セキュリティ監査', UNIX_TIMESTAMP()),
+	(448, 447, 'セグメント', UNIX_TIMESTAMP()),
+	(532, 1530, 'セッション', UNIX_TIMESTAMP()),
+	(60, 59, 'セッションアフィニティ', UNIX_TIMESTAMP()),
+	(62, 2059, 'セッションキー', UNIX_TIMESTAMP()),
+	(524, 523, 'セッション層', UNIX_TIMESTAMP()),
+	(591, 6584, 'ゼロコンフィグ', UNIX_TIMESTAMP()),
+	(688, 687, 'ソーシャルエンジニアリング', UNIX_TIMESTAMP()),
+	(410, 7402, 'ソリッドステートドライブ', UNIX_TIMESTAMP()),
+	(656, 1654, 'ゾーン', UNIX_TIMESTAMP()),
+	(303, 3299, 'ゾーン転送', UNIX_TIMESTAMP()),
+	(386, 5380, 'ターミネーター', UNIX_TIMESTAMP()),
+	(354, 2351, 'ダッシュボード', UNIX_TIMESTAMP()),
+	(146, 3142, 'チェーンリプリケーション', UNIX_TIMESTAMP()),
+	(757, 5751, 'チップセット', UNIX_TIMESTAMP()),
+	(280, 4275, 'チューニング', UNIX_TIMESTAMP()),
+	(40, 7032, 'チューリングテスト', UNIX_TIMESTAMP()),
+	(18, 6011, 'テクニカルサポート', UNIX_TIMESTAMP()),
+	(314, 5308, 'データの可用性', UNIX_TIMESTAMP()),
+	(532, 5526, 'データコンシステンシ', UNIX_TIMESTAMP()),
+	(811, 1809, 'データセンター', UNIX_TIMESTAMP()),
+	(971, 2968, 'データセンター', UNIX_TIMESTAMP()),
+	(741, 2738, 'データセンター監視', UNIX_TIMESTAMP()),
+	(533, 1531, 'データバックアップ', UNIX_TIMESTAMP()),
+	(474, 473, 'データパーティショニング', UNIX_TIMESTAMP()),
+	(481, 6474, 'データベースシャーディング', UNIX_TIMESTAMP()),
+	(733, 5727, 'データベース管理', UNIX_TIMESTAMP()),
+	(270, 1268, 'データベース管理システム', UNIX_TIMESTAMP()),
+	(678, 1676, 'データリンク層', UNIX_TIMESTAMP()),
+	(920, 6913, 'データレプリケーション', UNIX_TIMESTAMP()),
+	(78, 2075, 'データローカリティ', UNIX_TIMESTAMP()),
+	(488, 6481, 'データ構造', UNIX_TIMESTAMP()),
+	(986, 985, 'データ同期', UNIX_TIMESTAMP()),
+	(936, 935, 'ディザスタリカバリ', UNIX_TIMESTAMP()),
+	(875, 2872, 'ディザスタリカバリ', UNIX_TIMESTAMP()),
+	(444, 3440, 'ディレクトリサービス', UNIX_TIMESTAMP()),
+	(180, 3176, 'デザインパターン', UNIX_TIMESTAMP()),
+	(132, 131, 'デジタル署名', UNIX_TIMESTAMP()),
+	(190, 3186, 'デスクトップミュージック', UNIX_TIMESTAMP()),
+	(933, 932, 'デバッグ', UNIX_TIMESTAMP()),
+	(520, 519, 'デフォルトゲートウェイ', UNIX_TIMESTAMP()),
+	(84, 3080, 'デプロイメント', UNIX_TIMESTAMP()),
+	(766, 3762, 'トータルコストオブオーナーシップ', UNIX_TIMESTAMP()),
+	(477, 476, 'トップレベルドメイン', UNIX_TIMESTAMP()),
+	(691, 5685, 'トピックベースのパブサブ', UNIX_TIMESTAMP()),
+	(204, 2201, 'トラフィックシェイピング', UNIX_TIMESTAMP()),
+	(9, 8, 'トラフィック管理', UNIX_TIMESTAMP()),
+	(508, 2505, 'トランスポート層', UNIX_TIMESTAMP()),
+	(724, 3720, 'トレーシング', UNIX_TIMESTAMP()),
+	(968, 3964, 'トレーシング', UNIX_TIMESTAMP()),
+	(453, 452, 'トロイの木馬', UNIX_TIMESTAMP()),
+	(696, 3692, 'トンネリング', UNIX_TIMESTAMP()),
+	(484, 3480, 'トンネル', UNIX_TIMESTAMP()),
+	(179, 3175, 'ドメイン', UNIX_TIMESTAMP()),
+	(522, 4517, 'ドリフト検出', UNIX_TIMESTAMP()),
+	(168, 5162, 'ネットワークトラフィック', UNIX_TIMESTAMP()),
+	(64, 3060, 'ネットワーク管理', UNIX_TIMESTAMP()),
+	(976, 975, 'ネットワーク層', UNIX_TIMESTAMP()),
+	(233, 7225, 'ノード', UNIX_TIMESTAMP()),
+	(571, 6564, 'ノイマン型', UNIX_TIMESTAMP()),
+	(749, 4744, 'ハートビート', UNIX_TIMESTAMP()),
+	(732, 731, 'ハードウェア交換', UNIX_TIMESTAMP()),
+	(719, 5713, 'ハードディスクドライブ', UNIX_TIMESTAMP()),
+	(678, 6671, 'ハーフデュプレックス', UNIX_TIMESTAMP()),
+	(185, 6178, 'ハイパーバイザー', UNIX_TIMESTAMP()),
+	(546, 2543, 'ハイパーバイザー', UNIX_TIMESTAMP()),
+	(49, 48, 'ハイブリッドクラウド', UNIX_TIMESTAMP()),
+	(928, 5922, 'ハイブリッドクラウド', UNIX_TIMESTAMP()),
+	(844, 6837, 'ハッキング', UNIX_TIMESTAMP()),
+	(444, 5438, 'ハッシュ関数', UNIX_TIMESTAMP()),
+	(260, 4255, 'ハブ', UNIX_TIMESTAMP()),
+	(992, 2989, 'ハンドシェイク', UNIX_TIMESTAMP()),
+	(498, 6491, 'バージョン管理システム', UNIX_TIMESTAMP()),
+	(333, 6326, 'バーチャルリアリティ', UNIX_TIMESTAMP()),
+	(803, 4798, 'バイオメトリクス認証', UNIX_TIMESTAMP()),
+	(72, 4067, 'バイト', UNIX_TIMESTAMP()),
+	(225, 3221, 'バックアップジェネレータ', UNIX_TIMESTAMP()),
+	(271, 4266, 'バックボーン', UNIX_TIMESTAMP()),
+	(929, 3925, 'バッファオーバーラン', UNIX_TIMESTAMP()),
+	(422, 4417, 'バルク品', UNIX_TIMESTAMP()),
+	(189, 3185, 'バンド幅', UNIX_TIMESTAMP()),
+	(355, 354, 'バンド幅', UNIX_TIMESTAMP()),
+	(285, 284, 'パイプライン', UNIX_TIMESTAMP()),
+	(564, 4559, 'パケット', UNIX_TIMESTAMP()),
+	(217, 1215, 'パケットロス', UNIX_TIMESTAMP()),
+	(700, 1698, 'パッチパネル', UNIX_TIMESTAMP()),
+	(34, 7026, 'パッチ管理', UNIX_TIMESTAMP()),
+	(708, 3704, 'パフォーマンスチューニング', UNIX_TIMESTAMP()),
+	(458, 4453, 'パブリッククラウド', UNIX_TIMESTAMP()),
+	(109, 5103, 'パブリッシャ', UNIX_TIMESTAMP()),
+	(84, 5078, 'パンチカード', UNIX_TIMESTAMP()),
+	(870, 2867, 'ヒーリング', UNIX_TIMESTAMP()),
+	(623, 622, 'ビッグデータ', UNIX_TIMESTAMP()),
+	(494, 5488, 'ビット', UNIX_TIMESTAMP()),
+	(833, 3829, 'ピアツーピア', UNIX_TIMESTAMP()),
+	(430, 1428, 'ピアリング', UNIX_TIMESTAMP()),
+	(421, 4416, 'ピアリング', UNIX_TIMESTAMP()),
+	(97, 7089, 'ファームウェア', UNIX_TIMESTAMP()),
+	(822, 4817, 'ファームウェア', UNIX_TIMESTAMP()),
+	(171, 6164, 'ファイアウォール', UNIX_TIMESTAMP()),
+	(188, 7180, 'ファイアウォール', UNIX_TIMESTAMP()),
+	(477, 3473, 'ファイアウォール', UNIX_TIMESTAMP()),
+	(356, 6349, 'フィーチャーフラグ', UNIX_TIMESTAMP()),
+	(747, 3743, 'フェイルオーバー', UNIX_TIMESTAMP()),
+	(539, 3535, 'フェイルオーバー', UNIX_TIMESTAMP()),
+	(536, 6529, 'フェイルオーバー', UNIX_TIMESTAMP()),
+	(668, 6661, 'フェイルバック', UNIX_TIMESTAMP()),
+	(855, 6848, 'フォールトトレランス', UNIX_TIMESTAMP()),
+	(261, 3257, 'フォールトトレランス', UNIX_TIMESTAMP()),
+	(781, 780, 'フラグメンテーション', UNIX_TIMESTAMP()),
+	(519, 4514, 'フルデュプレックス', UNIX_TIMESTAMP()),
+	(341, 2338, 'フレーム', UNIX_TIMESTAMP()),
+	(920, 6913, 'フロー制御', UNIX_TIMESTAMP()),
+	(34, 2031, 'フロッピーディスク', UNIX_TIMESTAMP()),
+	(929, 928, 'ブラウン管', UNIX_TIMESTAMP()),
+	(507, 4502, 'ブリッジ', UNIX_TIMESTAMP()),
+	(544, 2541, 'ブロードキャストドメイン', UNIX_TIMESTAMP()),
+	(703, 6696, 'プライベートクラウド', UNIX_TIMESTAMP()),
+	(417, 5411, 'プレゼンテーション層', UNIX_TIMESTAMP()),
+	(69, 2066, 'プロキシ', UNIX_TIMESTAMP()),
+	(805, 2802, 'プロキシ', UNIX_TIMESTAMP()),
+	(562, 5556, 'プログラミング', UNIX_TIMESTAMP()),
+	(248, 3244, 'プログラミング言語', UNIX_TIMESTAMP()),
+	(461, 7453, 'プログラミング言語', UNIX_TIMESTAMP()),
+	(987, 6980, 'プロセス間通信', UNIX_TIMESTAMP()),
+	(649, 6642, 'プロビジョニング', UNIX_TIMESTAMP()),
+	(56, 3052, 'ヘルスチェック', UNIX_TIMESTAMP()),
+	(271, 3267, 'ベジェ曲線', UNIX_TIMESTAMP()),
+	(209, 2206, 'ペネトレーションテスト', UNIX_TIMESTAMP()),
+	(935, 1933, 'ホットアイル', UNIX_TIMESTAMP()),
+	(320, 7312, 'ホットスタンバイ', UNIX_TIMESTAMP()),
+	(802, 3798, 'ホットスポット', UNIX_TIMESTAMP()),
+	(288, 2285, 'ホワイトスペース', UNIX_TIMESTAMP()),
+	(677, 4672, 'ホワイトスペース', UNIX_TIMESTAMP()),
+	(434, 1432, 'ボトルネック', UNIX_TIMESTAMP()),
+	(435, 4430, 'ポートセキュリティ', UNIX_TIMESTAMP()),
+	(877, 2874, 'ポートフォワーディング', UNIX_TIMESTAMP()),
+	(656, 1654, 'ポート番号', UNIX_TIMESTAMP()),
+	(718, 2715, 'ポストモーテム', UNIX_TIMESTAMP()),
+	(564, 5558, 'マイクロサービス', UNIX_TIMESTAMP()),
+	(841, 5835, 'マイクロプロセッサ', UNIX_TIMESTAMP()),
+	(138, 1136, 'マップリデュース', UNIX_TIMESTAMP()),
+	(500, 2497, 'マルウェア', UNIX_TIMESTAMP()),
+	(402, 5396, 'マルチテナンシー', UNIX_TIMESTAMP()),
+	(651, 2648, 'マルチメディア', UNIX_TIMESTAMP()),
+	(190, 5184, 'ミドルウェア', UNIX_TIMESTAMP()),
+	(75, 1073, 'ミドルウェア管理', UNIX_TIMESTAMP()),
+	(250, 1248, 'ミラーリング', UNIX_TIMESTAMP()),
+	(114, 1112, 'メタプログラミング', UNIX_TIMESTAMP()),
+	(692, 4687, 'メッセージキュー', UNIX_TIMESTAMP()),
+	(283, 5277, 'メトリクス', UNIX_TIMESTAMP()),
+	(221, 6214, 'メンテナンスウィンドウ', UNIX_TIMESTAMP()),
+	(324, 1322, 'メンテナンスウィンドウ', UNIX_TIMESTAMP()),
+	(365, 6358, 'モーションキャプチャ', UNIX_TIMESTAMP()),
+	(153, 1151, 'モデム', UNIX_TIMESTAMP()),
+	(311, 7303, 'モニタリング', UNIX_TIMESTAMP()),
+	(825, 2822, 'モニタリング', UNIX_TIMESTAMP()),
+	(481, 2478, 'ユーザインタフェース', UNIX_TIMESTAMP()),
+	(621, 620, 'ユニットテスト', UNIX_TIMESTAMP()),
+	(847, 4842, 'ユビキタスコンピューティング', UNIX_TIMESTAMP()),
+	(841, 5835, 'ラウンドトリップタイム', UNIX_TIMESTAMP()),
+	(253, 252, 'リージョン', UNIX_TIMESTAMP()),
+	(427, 3423, 'リーダー選出', UNIX_TIMESTAMP()),
+	(91, 3087, 'リアルタイム監視', UNIX_TIMESTAMP()),
+	(592, 591, 'リクエスト/レスポンスモデル', UNIX_TIMESTAMP()),
+	(281, 1279, 'リクエストレート', UNIX_TIMESTAMP()),
+	(624, 3620, 'リソースの分離', UNIX_TIMESTAMP()),
+	(30, 4025, 'リソースプール', UNIX_TIMESTAMP()),
+	(731, 4726, 'リソース最適化', UNIX_TIMESTAMP()),
+	(251, 5245, 'リターンオンインベストメント', UNIX_TIMESTAMP()),
+	(813, 2810, 'リバースプロキシ', UNIX_TIMESTAMP()),
+	(954, 1952, 'リバースプロキシ', UNIX_TIMESTAMP()),
+	(357, 7349, 'リモートアクセス', UNIX_TIMESTAMP()),
+	(643, 2640, 'リモートハンズ', UNIX_TIMESTAMP()),
+	(455, 6448, 'ルータ', UNIX_TIMESTAMP()),
+	(772, 1770, 'ルーター', UNIX_TIMESTAMP()),
+	(966, 5960, 'ルーティング', UNIX_TIMESTAMP()),
+	(919, 4914, 'ルート原因分析', UNIX_TIMESTAMP()),
+	(752, 5746, 'ループバックアドレス', UNIX_TIMESTAMP()),
+	(80, 5074, 'レートリミッタ', UNIX_TIMESTAMP()),
+	(15, 3011, 'レートリミッティング', UNIX_TIMESTAMP()),
+	(737, 5731, 'レイテンシ', UNIX_TIMESTAMP()),
+	(656, 655, 'レイテンシ', UNIX_TIMESTAMP()),
+	(197, 6190, 'レイテンシ', UNIX_TIMESTAMP()),
+	(345, 6338, 'レプリカ', UNIX_TIMESTAMP()),
+	(995, 2992, 'レプリカセット', UNIX_TIMESTAMP()),
+	(183, 182, 'レプリケーション', UNIX_TIMESTAMP()),
+	(979, 978, 'レプリケーション', UNIX_TIMESTAMP()),
+	(276, 275, 'レプリケーションファクター', UNIX_TIMESTAMP()),
+	(65, 5059, 'ロードテスト', UNIX_TIMESTAMP()),
+	(901, 2898, 'ロードバランサー', UNIX_TIMESTAMP()),
+	(305, 3301, 'ロードバランサー', UNIX_TIMESTAMP()),
+	(320, 7312, 'ロードバランサー', UNIX_TIMESTAMP()),
+	(463, 3459, 'ロードバランシング', UNIX_TIMESTAMP()),
+	(898, 5892, 'ロールアウト', UNIX_TIMESTAMP()),
+	(565, 5559, 'ロールバック', UNIX_TIMESTAMP()),
+	(783, 3779, 'ロールバック', UNIX_TIMESTAMP()),
+	(674, 5668, 'ロールバック', UNIX_TIMESTAMP()),
+	(699, 698, 'ロールフォワード', UNIX_TIMESTAMP()),
+	(512, 1510, 'ロギング', UNIX_TIMESTAMP()),
+	(400, 2397, 'ロギング', UNIX_TIMESTAMP()),
+	(992, 4987, 'ワークステーション', UNIX_TIMESTAMP()),
+	(112, 111, 'ワープロ', UNIX_TIMESTAMP()),
+	(958, 957, '椅子パイプ', UNIX_TIMESTAMP()),
+	(672, 671, '脳内仮想現実', UNIX_TIMESTAMP()),
+	(24, 2021, '空間音響設計', UNIX_TIMESTAMP()),
+	(816, 4811, '空気磁界測定', UNIX_TIMESTAMP()),
+	(491, 490, '直接節骨接合', UNIX_TIMESTAMP()),
+	(645, 644, '量子熱処理', UNIX_TIMESTAMP()),
+	(464, 4459, '溶液表面融着', UNIX_TIMESTAMP()),
+	(434, 4429, '光応答体外循環', UNIX_TIMESTAMP()),
+	(707, 4702, '音声周波数解析', UNIX_TIMESTAMP()),
+	(846, 3842, '重力場調節制御', UNIX_TIMESTAMP()),
+	(815, 814, '感染光再発予測', UNIX_TIMESTAMP()),
+	(542, 2539, '高次元生態分析', UNIX_TIMESTAMP()),
+	(188, 4183, '応力放出防止構造', UNIX_TIMESTAMP()),
+	(818, 5812, '心理能力共鳴形成', UNIX_TIMESTAMP()),
+	(480, 1478, '地理空間情報統計', UNIX_TIMESTAMP()),
+	(119, 1117, '天文計算索引', UNIX_TIMESTAMP()),
+	(687, 686, '伝統芸能芸術遺産伝承', UNIX_TIMESTAMP()),
+	(831, 6824, '副作用抑制', UNIX_TIMESTAMP()),
+	(645, 5639, '事実経過説明', UNIX_TIMESTAMP()),
+	(12, 5006, '患者応答率', UNIX_TIMESTAMP()),
+	(301, 1299, '地域共同体', UNIX_TIMESTAMP()),
+	(291, 6284, '音響分析軸', UNIX_TIMESTAMP()),
+	(507, 1505, '地球通年変遷', UNIX_TIMESTAMP()),
+	(277, 3273, '自然現象間疎和', UNIX_TIMESTAMP()),
+	(975, 5969, '環境軌道評価', UNIX_TIMESTAMP()),
+	(262, 2259, '社会情報密度', UNIX_TIMESTAMP()),
+	(991, 4986, '共時相関行列', UNIX_TIMESTAMP()),
+	(540, 4535, '知識摂動応答', UNIX_TIMESTAMP()),
+	(866, 3862, '空間投影歪曲', UNIX_TIMESTAMP()),
+	(867, 6860, '経済派生指数', UNIX_TIMESTAMP()),
+	(298, 4293, '政治凝護効果', UNIX_TIMESTAMP()),
+	(410, 2407, '芸術流言網', UNIX_TIMESTAMP()),
+	(628, 6621, '紅葉呼吸煙', UNIX_TIMESTAMP()),
+	(700, 2697, '彩雲不思議絵巻', UNIX_TIMESTAMP()),
+	(415, 4410, '翡翠流星群', UNIX_TIMESTAMP()),
+	(72, 1070, '碧空水晶鳥', UNIX_TIMESTAMP()),
+	(100, 1098, '紺碧夢想曲', UNIX_TIMESTAMP()),
+	(235, 5229, '青桐幽霊舞', UNIX_TIMESTAMP()),
+	(349, 3345, '白梅朧月夜', UNIX_TIMESTAMP()),
+	(913, 2910, '黄金稲妻拳', UNIX_TIMESTAMP()),
+	(484, 2481, '銀糸湖光浴', UNIX_TIMESTAMP()),
+	(792, 3788, '梅雨夏紫陽花', UNIX_TIMESTAMP()),
+	(812, 1810, '草色遠山街道', UNIX_TIMESTAMP()),
+	(995, 994, '光輪蓮華経', UNIX_TIMESTAMP()),
+	(239, 7231, '朱雀凰扇舞', UNIX_TIMESTAMP()),
+	(983, 4978, '紅蓮焔舞台', UNIX_TIMESTAMP()),
+	(273, 272, '翠翼妖精姫', UNIX_TIMESTAMP()),
+	(868, 5862, '青瑪瑙幻聴', UNIX_TIMESTAMP()),
+	(254, 6247, '環境制御装置', UNIX_TIMESTAMP()),
+	(303, 3299, '危機感航空機', UNIX_TIMESTAMP()),
+	(548, 1546, '時間跳躍装置', UNIX_TIMESTAMP()),
+	(767, 1765, '超音速地底探査器', UNIX_TIMESTAMP()),
+	(47, 3043, '知覚増強眼鏡', UNIX_TIMESTAMP()),
+	(983, 2980, '高分子復元剤', UNIX_TIMESTAMP()),
+	(916, 915, '未来観測装置', UNIX_TIMESTAMP()),
+	(10, 7002, '究極神秘粒子', UNIX_TIMESTAMP()),
+	(700, 4695, '非現実時間移動装置', UNIX_TIMESTAMP()),
+	(613, 612, '無重力流体調整器', UNIX_TIMESTAMP()),
+	(957, 3953, '心音分析装置', UNIX_TIMESTAMP()),
+	(44, 5038, '仮想現実創造装置', UNIX_TIMESTAMP()),
+	(642, 1640, '空中浮遊進入装置', UNIX_TIMESTAMP()),
+	(454, 5448, '渦状座標系', UNIX_TIMESTAMP()),
+	(471, 4466, '超音速磁気気流', UNIX_TIMESTAMP()),
+	(557, 1555, '高分子標準化', UNIX_TIMESTAMP()),
+	(978, 2975, '分子燃焼反応', UNIX_TIMESTAMP()),
+	(251, 1249, '一般相関連鎖', UNIX_TIMESTAMP()),
+	(350, 4345, '指数複素数列', UNIX_TIMESTAMP()),
+	(835, 6828, '幾何分解定理', UNIX_TIMESTAMP()),
+	(556, 555, '量子情報解析', UNIX_TIMESTAMP()),
+	(617, 1615, '精密調湿装置', UNIX_TIMESTAMP()),
+	(904, 3900, '非線型位相変換', UNIX_TIMESTAMP()),
+	(764, 763, '音響透明材料', UNIX_TIMESTAMP()),
+	(474, 7466, '光電子泽尔科夫斯基効果', UNIX_TIMESTAMP()),
+	(416, 4411, '粉体密度測定', UNIX_TIMESTAMP()),
+	(689, 5683, '量子植民地', UNIX_TIMESTAMP()),
+	(465, 7457, '視神経線維', UNIX_TIMESTAMP()),
+	(42, 2039, '可解微分方程式', UNIX_TIMESTAMP()),
+	(312, 5306, '創造的刺激', UNIX_TIMESTAMP()),
+	(116, 7108, '非線形変数', UNIX_TIMESTAMP()),
+	(212, 211, '演繹的思考', UNIX_TIMESTAMP()),
+	(208, 3204, '電波遮断装置', UNIX_TIMESTAMP()),
+	(493, 7485, '心理評価尺度', UNIX_TIMESTAMP()),
+	(85, 84, '水中送信機', UNIX_TIMESTAMP()),
+	(23, 1021, '無線干渉調整器', UNIX_TIMESTAMP()),
+	(278, 2275, '環境振動計', UNIX_TIMESTAMP()),
+	(665, 1663, '生物進化模型', UNIX_TIMESTAMP()),
+	(263, 7255, '高速分子分析装置', UNIX_TIMESTAMP()),
+	(322, 7314, '集光反射材料', UNIX_TIMESTAMP()),
+	(579, 5573, '半導体化成材料', UNIX_TIMESTAMP()),
+	(569, 5563, '電気磁気制御器', UNIX_TIMESTAMP()),
+	(516, 3512, '遺伝子環境相互作用', UNIX_TIMESTAMP()),
+	(390, 6383, '超音波洗浄装置', UNIX_TIMESTAMP()),
+	(424, 7416, '粒子生成装置', UNIX_TIMESTAMP()),
+	(867, 2864, '神獣召喚術', UNIX_TIMESTAMP()),
+	(257, 6250, '精気封印術', UNIX_TIMESTAMP()),
+	(977, 2974, '神域探求者', UNIX_TIMESTAMP()),
+	(654, 2651, '電脳虚構体', UNIX_TIMESTAMP()),
+	(929, 2926, '音波共振装置', UNIX_TIMESTAMP()),
+	(432, 6425, '生命維持装置', UNIX_TIMESTAMP()),
+	(502, 2499, '時空転位装置', UNIX_TIMESTAMP()),
+	(629, 4624, '量子干渉装置', UNIX_TIMESTAMP()),
+	(683, 2680, '冥界開門術', UNIX_TIMESTAMP()),
+	(32, 7024, '心器移植手術', UNIX_TIMESTAMP()),
+	(577, 2574, '追憶抽出装置', UNIX_TIMESTAMP()),
+	(177, 5171, '未知生物鑑定', UNIX_TIMESTAMP()),
+	(375, 374, '宇宙未踏領域探査', UNIX_TIMESTAMP()),
+	(823, 1821, '経済成長率', UNIX_TIMESTAMP()),
+	(82, 4077, '酵素触媒反応', UNIX_TIMESTAMP()),
+	(449, 4444, '酵素誘導合成', UNIX_TIMESTAMP()),
+	(291, 5285, '脂肪酸分解', UNIX_TIMESTAMP()),
+	(534, 2531, '酵素調節因子', UNIX_TIMESTAMP()),
+	(728, 727, '損害賠償請求権', UNIX_TIMESTAMP()),
+	(783, 6776, '不作為違反', UNIX_TIMESTAMP()),
+	(756, 6749, '分子量分布', UNIX_TIMESTAMP()),
+	(201, 7193, '構造耐力壁', UNIX_TIMESTAMP()),
+	(710, 6703, '合成樹脂材料', UNIX_TIMESTAMP()),
+	(562, 5556, '転校生支援', UNIX_TIMESTAMP()),
+	(7, 5001, '農作物収量', UNIX_TIMESTAMP()),
+	(758, 6751, '繁殖促進剤', UNIX_TIMESTAMP()),
+	(538, 3534, '飼料添加物', UNIX_TIMESTAMP()),
+	(500, 1498, '形態空間解析', UNIX_TIMESTAMP()),
+	(770, 3766, '生物複素系', UNIX_TIMESTAMP()),
+	(507, 6500, '経済情報抽出', UNIX_TIMESTAMP()),
+	(60, 6053, '適応浮動率', UNIX_TIMESTAMP()),
+	(177, 2174, '激情問題解決', UNIX_TIMESTAMP()),
+	(295, 4290, '宇宙同期電磁石', UNIX_TIMESTAMP()),
+	(850, 2847, '超次元拡散体', UNIX_TIMESTAMP()),
+	(339, 4334, '心理応答電位計', UNIX_TIMESTAMP()),
+	(174, 1172, '島斑連結装置', UNIX_TIMESTAMP()),
+	(187, 6180, '遺伝情報暗号化器', UNIX_TIMESTAMP()),
+	(957, 956, '弾力抑制素材', UNIX_TIMESTAMP()),
+	(53, 4048, '錯乱認識回路', UNIX_TIMESTAMP()),
+	(28, 4023, '真空断熱振動子', UNIX_TIMESTAMP()),
+	(709, 708, '認識増幅器', UNIX_TIMESTAMP()),
+	(562, 6555, '高分子合成触媒', UNIX_TIMESTAMP()),
+	(92, 4087, '大気電気化', UNIX_TIMESTAMP()),
+	(112, 1110, '知覚増強装置', UNIX_TIMESTAMP()),
+	(194, 6187, '心霊言語解析', UNIX_TIMESTAMP()),
+	(878, 877, '磁気加速器', UNIX_TIMESTAMP()),
+	(689, 6682, '潜在意識制御装置', UNIX_TIMESTAMP()),
+	(997, 6990, '量子念動力', UNIX_TIMESTAMP()),
+	(536, 1534, '核子磁気共鳴', UNIX_TIMESTAMP()),
+	(931, 5925, '形状記憶材料', UNIX_TIMESTAMP()),
+	(389, 6382, '量子共振器', UNIX_TIMESTAMP()),
+	(489, 4484, '火星探査機', UNIX_TIMESTAMP()),
+	(649, 3645, '遺伝子発現制御', UNIX_TIMESTAMP()),
+	(83, 6076, '環境影響評価', UNIX_TIMESTAMP()),
+	(198, 4193, '音声合成器', UNIX_TIMESTAMP()),
+	(774, 773, '型骨格構造', UNIX_TIMESTAMP()),
+	(483, 6476, '血管内治療装置', UNIX_TIMESTAMP()),
+	(653, 3649, '救世主思考', UNIX_TIMESTAMP()),
+	(49, 6042, '空気循環装置', UNIX_TIMESTAMP()),
+	(505, 1503, '心理感応装置', UNIX_TIMESTAMP()),
+	(641, 1639, '高分子成形器', UNIX_TIMESTAMP()),
+	(431, 5425, '量子転送装置', UNIX_TIMESTAMP()),
+	(723, 6716, '意識同期器', UNIX_TIMESTAMP()),
+	(914, 6907, '宇宙複合体', UNIX_TIMESTAMP()),
+	(928, 927, '超音速輸送機', UNIX_TIMESTAMP()),
+	(260, 7252, '生体再生装置', UNIX_TIMESTAMP()),
+	(389, 5383, '残留情報削除機構', UNIX_TIMESTAMP()),
+	(935, 5929, '量子変換器', UNIX_TIMESTAMP()),
+	(841, 5835, '磁気浮上装置', UNIX_TIMESTAMP()),
+	(661, 660, '力場制御装置', UNIX_TIMESTAMP()),
+	(352, 6345, '遺伝子解読装置', UNIX_TIMESTAMP()),
+	(438, 6431, '心理反射分析器', UNIX_TIMESTAMP()),
+	(292, 6285, '可動自律系', UNIX_TIMESTAMP()),
+	(164, 1162, '超音波破壊装置', UNIX_TIMESTAMP()),
+	(525, 6518, '環境抽象化装置', UNIX_TIMESTAMP()),
+	(635, 634, '形態胚培養', UNIX_TIMESTAMP()),
+	(71, 3067, '食品精鋭化', UNIX_TIMESTAMP()),
+	(579, 4574, '太陽光蓄積', UNIX_TIMESTAMP()),
+	(984, 2981, '鉱石崩壊力', UNIX_TIMESTAMP()),
+	(766, 5760, '空間形態化', UNIX_TIMESTAMP()),
+	(79, 5073, '温度変換定数', UNIX_TIMESTAMP()),
+	(374, 3370, '仮想現実領域', UNIX_TIMESTAMP()),
+	(510, 1508, '生命進化解析', UNIX_TIMESTAMP()),
+	(105, 3101, '材料結晶化', UNIX_TIMESTAMP()),
+	(838, 1836, '銀河星団解析', UNIX_TIMESTAMP()),
+	(838, 3834, '燃焼純度検知塗料', UNIX_TIMESTAMP()),
+	(286, 6279, '電波遮蔽微粒子', UNIX_TIMESTAMP()),
+	(94, 93, '意識転送機', UNIX_TIMESTAMP()),
+	(314, 313, '無重力反応装置', UNIX_TIMESTAMP()),
+	(691, 690, '物質変容装置', UNIX_TIMESTAMP()),
+	(201, 5195, '時空曲率制御装置', UNIX_TIMESTAMP()),
+	(914, 6907, '未来変動予測器', UNIX_TIMESTAMP()),
+	(202, 5196, '超高温超伝導体', UNIX_TIMESTAMP()),
+	(231, 6224, '感情通信装置', UNIX_TIMESTAMP()),
+	(855, 4850, '視覚再生装置', UNIX_TIMESTAMP()),
+	(492, 6485, '遺伝子編集器', UNIX_TIMESTAMP()),
+	(271, 3267, '才華資源化', UNIX_TIMESTAMP()),
+	(341, 340, '風力矩計算機', UNIX_TIMESTAMP()),
+	(425, 4420, '地殻撹拌装置', UNIX_TIMESTAMP()),
+	(200, 7192, '認知弁別器', UNIX_TIMESTAMP()),
+	(453, 7445, '可聴光線誘導殺虫', UNIX_TIMESTAMP()),
+	(9, 4004, '離子漂流推進器', UNIX_TIMESTAMP()),
+	(552, 4547, '磁気反転音響装置', UNIX_TIMESTAMP()),
+	(148, 147, '酸素応答剤', UNIX_TIMESTAMP()),
+	(277, 3273, '超伸縮材料', UNIX_TIMESTAMP()),
+	(840, 3836, '円潜車両制御', UNIX_TIMESTAMP()),
+	(920, 5914, '量子加速器', UNIX_TIMESTAMP()),
+	(563, 4558, '電磁気誘引装置', UNIX_TIMESTAMP()),
+	(24, 7016, '透明化粧具', UNIX_TIMESTAMP()),
+	(604, 1602, '多層順応制御', UNIX_TIMESTAMP()),
+	(370, 2367, '反物質生成', UNIX_TIMESTAMP()),
+	(161, 7153, '無重力状態', UNIX_TIMESTAMP()),
+	(716, 3712, '分子圧指数', UNIX_TIMESTAMP()),
+	(619, 3615, '高速粒子加速器', UNIX_TIMESTAMP()),
+	(24, 7016, '暗黒物質探知装置', UNIX_TIMESTAMP()),
+	(479, 7471, '心理時間補正', UNIX_TIMESTAMP()),
+	(151, 7143, '遺伝情報暗号化', UNIX_TIMESTAMP()),
+	(60, 4055, '結晶超伝導体', UNIX_TIMESTAMP()),
+	(881, 5875, '奇跡飛行物体', UNIX_TIMESTAMP()),
+	(714, 2711, '知覚入力再構築', UNIX_TIMESTAMP()),
+	(447, 2444, '量子干渉計測器', UNIX_TIMESTAMP()),
+	(669, 6662, '天空相転移', UNIX_TIMESTAMP()),
+	(964, 2961, '逆蜘蛛宇宙', UNIX_TIMESTAMP()),
+	(739, 4734, '光創造物質', UNIX_TIMESTAMP()),
+	(438, 437, '環境適応遺伝子', UNIX_TIMESTAMP()),
+	(812, 6805, '理知心理統制器', UNIX_TIMESTAMP()),
+	(576, 3572, '投資収穫指数', UNIX_TIMESTAMP()),
+	(766, 2763, '意識変容装置', UNIX_TIMESTAMP()),
+	(698, 1696, '生命情報加速装置', UNIX_TIMESTAMP()),
+	(313, 5307, '無機冬蟲夏草', UNIX_TIMESTAMP()),
+	(184, 7176, '古代幻書能', UNIX_TIMESTAMP()),
+	(538, 2535, '天使聖典技', UNIX_TIMESTAMP()),
+	(186, 3182, '夢想綺想図', UNIX_TIMESTAMP()),
+	(334, 1332, '闇影幻視札', UNIX_TIMESTAMP()),
+	(899, 4894, '金剛騎士術', UNIX_TIMESTAMP()),
+	(171, 1169, '魔弾轟震術', UNIX_TIMESTAMP()),
+	(260, 4255, '剣聖煌鎧術', UNIX_TIMESTAMP()),
+	(40, 7032, '神姫九天光', UNIX_TIMESTAMP()),
+	(329, 7321, '魔王暗殺謀', UNIX_TIMESTAMP()),
+	(385, 6378, '至高呪術典', UNIX_TIMESTAMP()),
+	(475, 7467, '銀焔閃滅刃', UNIX_TIMESTAMP()),
+	(755, 3751, '神聖聖典術', UNIX_TIMESTAMP()),
+	(795, 2792, '紫電残光剣', UNIX_TIMESTAMP()),
+	(386, 1384, '聖光夢幻調', UNIX_TIMESTAMP()),
+	(204, 203, '魔力急速振動', UNIX_TIMESTAMP()),
+	(837, 2834, '暗黒双蜘蛛', UNIX_TIMESTAMP()),
+	(339, 6332, '古代秘術残', UNIX_TIMESTAMP()),
+	(491, 6484, '夜鳴霊儀式', UNIX_TIMESTAMP()),
+	(717, 4712, '平穏夜想調', UNIX_TIMESTAMP()),
+	(832, 831, '幻想血滴石', UNIX_TIMESTAMP()),
+	(912, 1910, '聖光騎士歌', UNIX_TIMESTAMP()),
+	(257, 256, '邪悪魔封書', UNIX_TIMESTAMP()),
+	(258, 3254, '闘神聖刻術', UNIX_TIMESTAMP()),
+	(495, 2492, '飛翔竜翼刃', UNIX_TIMESTAMP()),
+	(14, 4009, '夢幻音楽篇', UNIX_TIMESTAMP()),
+	(885, 5879, '幻夜舞曲図', UNIX_TIMESTAMP()),
+	(130, 3126, '聖剣煌光術', UNIX_TIMESTAMP()),
+	(288, 7280, '魔導古文書', UNIX_TIMESTAMP()),
+	(324, 3320, '紫電晶光刀', UNIX_TIMESTAMP()),
+	(550, 549, '神聖祭禮詞', UNIX_TIMESTAMP()),
+	(485, 5479, '霊気明感術', UNIX_TIMESTAMP()),
+	(734, 733, '静穏化装置', UNIX_TIMESTAMP()),
+	(346, 345, '頭蓋神経器', UNIX_TIMESTAMP()),
+	(417, 3413, '物質濃縮装置', UNIX_TIMESTAMP()),
+	(936, 5930, '拡張視触能', UNIX_TIMESTAMP()),
+	(424, 4419, '時間融合体', UNIX_TIMESTAMP()),
+	(935, 934, '振動増幅装置', UNIX_TIMESTAMP()),
+	(111, 6104, '超知覚瞬間移動術', UNIX_TIMESTAMP()),
+	(829, 5823, '音波共振装甲', UNIX_TIMESTAMP()),
+	(988, 4983, '無限回廊系統', UNIX_TIMESTAMP()),
+	(39, 4034, '双子想念結界', UNIX_TIMESTAMP()),
+	(86, 6079, '宇宙因果網', UNIX_TIMESTAMP()),
+	(58, 5052, '曜光探査機', UNIX_TIMESTAMP()),
+	(798, 6791, '米粒樹状酸', UNIX_TIMESTAMP()),
+	(480, 479, '時間凝固術', UNIX_TIMESTAMP()),
+	(89, 6082, '木工宇宙機', UNIX_TIMESTAMP()),
+	(152, 3148, '虚像写真術', UNIX_TIMESTAMP()),
+	(621, 4616, '宇宙発電機', UNIX_TIMESTAMP()),
+	(347, 5341, '魔力測定器', UNIX_TIMESTAMP()),
+	(577, 6570, '石材修復術', UNIX_TIMESTAMP()),
+	(219, 2216, '鳥頭症研究', UNIX_TIMESTAMP()),
+	(504, 5498, '疾風通信機', UNIX_TIMESTAMP()),
+	(350, 349, '焦力制御術', UNIX_TIMESTAMP()),
+	(438, 437, '化粧品研究', UNIX_TIMESTAMP()),
+	(799, 6792, '無重力通信', UNIX_TIMESTAMP()),
+	(156, 4151, '視覚暗示研究', UNIX_TIMESTAMP()),
+	(882, 3878, '記憶再構築', UNIX_TIMESTAMP()),
+	(190, 2187, '光合成最適化', UNIX_TIMESTAMP()),
+	(273, 1271, '情報暗号化', UNIX_TIMESTAMP()),
+	(477, 5471, '機械操縦術', UNIX_TIMESTAMP()),
+	(124, 7116, '量子瞬間移動', UNIX_TIMESTAMP()),
+	(766, 6759, '感情共有器', UNIX_TIMESTAMP()),
+	(759, 4754, '心象映写機', UNIX_TIMESTAMP()),
+	(987, 3983, '超次元通信', UNIX_TIMESTAMP()),
+	(194, 4189, '潜在意識監視', UNIX_TIMESTAMP()),
+	(700, 5694, '未知物質分析機', UNIX_TIMESTAMP()),
+	(51, 3047, '水晶遺伝子', UNIX_TIMESTAMP()),
+	(922, 1920, '蓄音器改良', UNIX_TIMESTAMP()),
+	(952, 5946, '直交架空数', UNIX_TIMESTAMP()),
+	(923, 6916, '行動再評価', UNIX_TIMESTAMP()),
+	(262, 261, '境界擁護態度', UNIX_TIMESTAMP()),
+	(892, 4887, '腹腔鏡下組織活動評価', UNIX_TIMESTAMP()),
+	(696, 5690, '微細化危険成分', UNIX_TIMESTAMP()),
+	(273, 4268, '地形相互浸潤係数', UNIX_TIMESTAMP()),
+	(940, 6933, '電子軌道干渉効果', UNIX_TIMESTAMP()),
+	(341, 340, '音律空間解析', UNIX_TIMESTAMP()),
+	(82, 4077, '空間遷移機', UNIX_TIMESTAMP()),
+	(933, 1931, '心理映像凝視装置', UNIX_TIMESTAMP()),
+	(470, 6463, '時間境界限定装置', UNIX_TIMESTAMP()),
+	(79, 2076, '量子信号干渉器', UNIX_TIMESTAMP()),
+	(825, 6818, '集合思考指標', UNIX_TIMESTAMP()),
+	(813, 2810, '元素合成反応器', UNIX_TIMESTAMP()),
+	(448, 7440, '運命関与算定機', UNIX_TIMESTAMP()),
+	(945, 6938, '光速旅行装置', UNIX_TIMESTAMP()),
+	(11, 6004, '音波透過体', UNIX_TIMESTAMP()),
+	(438, 6431, '生物進化促進装置', UNIX_TIMESTAMP()),
+	(56, 3052, '精神領域接続機', UNIX_TIMESTAMP()),
+	(332, 4327, '平行宇宙探査機', UNIX_TIMESTAMP()),
+	(319, 318, '遺伝子情報再構築装置', UNIX_TIMESTAMP()),
+	(746, 6739, '起源遡行装置', UNIX_TIMESTAMP()),
+	(132, 6125, '無時間現象記録装置', UNIX_TIMESTAMP()),
+	(361, 5355, '磁気応答絶縁体', UNIX_TIMESTAMP()),
+	(34, 33, '高密度微粒子充填材', UNIX_TIMESTAMP()),
+	(124, 123, '非晶質積層合金', UNIX_TIMESTAMP()),
+	(38, 3034, '微細界面調整剤', UNIX_TIMESTAMP()),
+	(828, 827, '分子偏在装置', UNIX_TIMESTAMP()),
+	(723, 5717, '高効率生体光合成装置', UNIX_TIMESTAMP()),
+	(218, 2215, '固定時間連続反応器', UNIX_TIMESTAMP()),
+	(290, 7282, '反射屈折半導体素子', UNIX_TIMESTAMP()),
+	(181, 2178, '生物配合剤', UNIX_TIMESTAMP()),
+	(625, 1623, '植物遺伝力', UNIX_TIMESTAMP()),
+	(74, 6067, '波動制御術', UNIX_TIMESTAMP()),
+	(619, 5613, '魔術師遺伝子', UNIX_TIMESTAMP()),
+	(323, 1321, '幻想抑制装置', UNIX_TIMESTAMP()),
+	(968, 1966, '蒸気磁力機', UNIX_TIMESTAMP()),
+	(821, 6814, '空中分散器', UNIX_TIMESTAMP()),
+	(367, 6360, '宇宙応用材', UNIX_TIMESTAMP()),
+	(570, 6563, '溶岩冷却装置', UNIX_TIMESTAMP()),
+	(523, 2520, '火花検知器', UNIX_TIMESTAMP()),
+	(496, 1494, '電子火薬機械', UNIX_TIMESTAMP()),
+	(879, 878, '鉛中和装置', UNIX_TIMESTAMP()),
+	(154, 7146, '磁気帯域境界', UNIX_TIMESTAMP()),
+	(378, 6371, '形態圖識別器', UNIX_TIMESTAMP()),
+	(891, 890, '光反応抽出器', UNIX_TIMESTAMP()),
+	(999, 2996, '牛乳濃縮製剤', UNIX_TIMESTAMP()),
+	(806, 1804, '空中上昇機構', UNIX_TIMESTAMP()),
+	(41, 4036, '生物抑制膜', UNIX_TIMESTAMP()),
+	(303, 4298, '超音波発生器', UNIX_TIMESTAMP()),
+	(299, 298, '電解効果装置', UNIX_TIMESTAMP()),
+	(486, 6479, '応力緩和材料', UNIX_TIMESTAMP()),
+	(951, 5945, '物質生成研究材', UNIX_TIMESTAMP()),
+	(6, 5, '衝撃吸収膜', UNIX_TIMESTAMP()),
+	(338, 3334, '磁気共鳴冷却機', UNIX_TIMESTAMP()),
+	(503, 3499, '圧力変換装置', UNIX_TIMESTAMP()),
+	(639, 638, '水中高出力発電機', UNIX_TIMESTAMP()),
+	(130, 129, '分子蒸発加速装置', UNIX_TIMESTAMP()),
+	(793, 3789, '枯微孔隙率', UNIX_TIMESTAMP()),
+	(286, 6279, '薄膜膨張率', UNIX_TIMESTAMP()),
+	(341, 4336, '風呂敷包囲', UNIX_TIMESTAMP()),
+	(786, 2783, '地球収縮説', UNIX_TIMESTAMP()),
+	(25, 1023, '遺伝史研究', UNIX_TIMESTAMP()),
+	(976, 5970, '非線型環境解析', UNIX_TIMESTAMP()),
+	(265, 264, '波動形成解析', UNIX_TIMESTAMP()),
+	(303, 3299, '計測品質管理', UNIX_TIMESTAMP()),
+	(620, 2617, '非対称振動研究', UNIX_TIMESTAMP()),
+	(675, 4670, '妖符創造機', UNIX_TIMESTAMP()),
+	(583, 5577, '企業経営戦略立案', UNIX_TIMESTAMP()),
+	(468, 3464, '宇宙粒子砲', UNIX_TIMESTAMP()),
+	(577, 576, '超次元境界', UNIX_TIMESTAMP()),
+	(124, 123, '霊脈探査術', UNIX_TIMESTAMP()),
+	(282, 7274, '奈落封印術', UNIX_TIMESTAMP()),
+	(331, 3327, '極光観測計', UNIX_TIMESTAMP()),
+	(267, 3263, '運命転位装置', UNIX_TIMESTAMP()),
+	(412, 6405, '錯乱磁場探査器', UNIX_TIMESTAMP()),
+	(394, 7386, '霊体交感装置', UNIX_TIMESTAMP()),
+	(539, 6532, '神託応答器', UNIX_TIMESTAMP()),
+	(674, 4669, '幽体障壁装置', UNIX_TIMESTAMP()),
+	(132, 4127, '天空制圧兵器', UNIX_TIMESTAMP()),
+	(497, 2494, '魂気浄化装置', UNIX_TIMESTAMP()),
+	(147, 6140, '創造波動反映装置', UNIX_TIMESTAMP()),
+	(354, 5348, '時空断面計測器', UNIX_TIMESTAMP()),
+	(533, 5527, '幻覚投射装置', UNIX_TIMESTAMP()),
+	(305, 3301, '心霊逆探知装置', UNIX_TIMESTAMP()),
+	(847, 5841, '水謎分析術', UNIX_TIMESTAMP()),
+	(75, 7067, '星霜計測器', UNIX_TIMESTAMP()),
+	(261, 5255, '蒸気晶響装置', UNIX_TIMESTAMP()),
+	(476, 475, '精神電馭装置', UNIX_TIMESTAMP()),
+	(642, 6635, '風車推進機', UNIX_TIMESTAMP()),
+	(490, 2487, '蟻巣背景認識', UNIX_TIMESTAMP()),
+	(134, 1132, '電撃遮断間', UNIX_TIMESTAMP()),
+	(425, 424, '時空蛇襲害', UNIX_TIMESTAMP()),
+	(113, 1111, '液体鉱物採取機', UNIX_TIMESTAMP()),
+	(649, 648, '光翔瞬持器', UNIX_TIMESTAMP()),
+	(342, 5336, '地心揺速指示器', UNIX_TIMESTAMP()),
+	(973, 4968, '電光磁界制御器', UNIX_TIMESTAMP()),
+	(69, 7061, '超音波凍結装置', UNIX_TIMESTAMP()),
+	(608, 6601, '地震観測真針', UNIX_TIMESTAMP()),
+	(500, 2497, '念動操手甲', UNIX_TIMESTAMP()),
+	(391, 7383, '音響共鳴網', UNIX_TIMESTAMP()),
+	(436, 7428, '機械微振動', UNIX_TIMESTAMP()),
+	(791, 2788, '錯誤競合検知', UNIX_TIMESTAMP()),
+	(415, 2412, '情報化遷移', UNIX_TIMESTAMP()),
+	(53, 52, '逆行分子素', UNIX_TIMESTAMP()),
+	(345, 344, '電子芸術理', UNIX_TIMESTAMP()),
+	(645, 2642, '光子増幅器', UNIX_TIMESTAMP()),
+	(565, 5559, '音波干渉計', UNIX_TIMESTAMP()),
+	(656, 5650, '量子調和振動', UNIX_TIMESTAMP()),
+	(730, 2727, '航空生物量', UNIX_TIMESTAMP()),
+	(75, 74, '熱操作革命', UNIX_TIMESTAMP()),
+	(582, 2579, '磁気粒子物理', UNIX_TIMESTAMP()),
+	(674, 5668, '素子組織格納', UNIX_TIMESTAMP()),
+	(864, 4859, '時間抽出視覚', UNIX_TIMESTAMP()),
+	(18, 7010, '熱帯地殻測量', UNIX_TIMESTAMP()),
+	(591, 4586, '光波共鳴効果', UNIX_TIMESTAMP()),
+	(490, 4485, '自然言語時空', UNIX_TIMESTAMP()),
+	(370, 6363, '地球生態応答', UNIX_TIMESTAMP()),
+	(173, 4168, '秘伝鬼蜘蛛', UNIX_TIMESTAMP()),
+	(376, 7368, '朱羅魔殺剣', UNIX_TIMESTAMP()),
+	(798, 3794, '剣術九連閃', UNIX_TIMESTAMP()),
+	(960, 6953, '鉢巻状突起', UNIX_TIMESTAMP()),
+	(807, 5801, '歌唱神経痛', UNIX_TIMESTAMP()),
+	(454, 453, '微生物代謝', UNIX_TIMESTAMP()),
+	(313, 2310, '情報捜査術', UNIX_TIMESTAMP()),
+	(967, 6960, '分子受容感作', UNIX_TIMESTAMP()),
+	(285, 284, '思考形成力', UNIX_TIMESTAMP()),
+	(854, 3850, '音響通信術', UNIX_TIMESTAMP()),
+	(193, 192, '暁層観測計', UNIX_TIMESTAMP()),
+	(32, 6025, '飛鳥計量機', UNIX_TIMESTAMP()),
+	(841, 840, '仙盟灌術術', UNIX_TIMESTAMP()),
+	(922, 3918, '彩空採取機', UNIX_TIMESTAMP()),
+	(348, 2345, '神秘融合術', UNIX_TIMESTAMP()),
+	(134, 133, '超越次元索引', UNIX_TIMESTAMP()),
+	(381, 5375, '精神共鳴装置', UNIX_TIMESTAMP()),
+	(147, 6140, '潜在因果解析', UNIX_TIMESTAMP()),
+	(208, 3204, '心的免疫修復', UNIX_TIMESTAMP()),
+	(663, 6656, '生体光電子共振', UNIX_TIMESTAMP()),
+	(669, 1667, '心的磁波制御', UNIX_TIMESTAMP()),
+	(560, 3556, '時空断層治療', UNIX_TIMESTAMP()),
+	(967, 5961, '視覚視知野分析', UNIX_TIMESTAMP()),
+	(424, 7416, '案内訂正器', UNIX_TIMESTAMP()),
+	(931, 1929, '溶解製結機', UNIX_TIMESTAMP()),
+	(497, 496, '光芒発生器', UNIX_TIMESTAMP()),
+	(364, 363, '陽電界傾斜力', UNIX_TIMESTAMP()),
+	(770, 5764, '結像発光装置', UNIX_TIMESTAMP()),
+	(761, 4756, '断熱励吸境', UNIX_TIMESTAMP()),
+	(260, 2257, '定積放熱装置', UNIX_TIMESTAMP()),
+	(671, 670, '構築操作窓', UNIX_TIMESTAMP()),
+	(128, 3124, '配列削減乖離機', UNIX_TIMESTAMP()),
+	(261, 3257, '緩衝励起剤', UNIX_TIMESTAMP()),
+	(742, 741, '加速空域検出器', UNIX_TIMESTAMP()),
+	(543, 4538, '同調深対濁度計', UNIX_TIMESTAMP()),
+	(927, 2924, '界界結旋渦', UNIX_TIMESTAMP()),
+	(554, 553, '帰還適正機', UNIX_TIMESTAMP()),
+	(685, 2682, '鉛二速度域計', UNIX_TIMESTAMP()),
+	(348, 5342, '蓄積煙分離器', UNIX_TIMESTAMP()),
+	(662, 6655, '応答応訂機', UNIX_TIMESTAMP()),
+	(850, 849, '受含履条件', UNIX_TIMESTAMP()),
+	(905, 904, '隠微反発励熱源', UNIX_TIMESTAMP()),
+	(297, 4292, '揮施起応植', UNIX_TIMESTAMP()),
+	(502, 5496, '静磁場共鳴現象', UNIX_TIMESTAMP()),
+	(488, 5482, '地下水浸透係数', UNIX_TIMESTAMP()),
+	(38, 5032, '半導体熱拡散解析', UNIX_TIMESTAMP()),
+	(625, 3621, '高速光通信伝送路', UNIX_TIMESTAMP()),
+	(827, 5821, '分子双極子相関関数', UNIX_TIMESTAMP()),
+	(51, 50, '電場加速反応器', UNIX_TIMESTAMP()),
+	(20, 5014, '天使遺伝子', UNIX_TIMESTAMP()),
+	(624, 623, '集相統御術', UNIX_TIMESTAMP()),
+	(918, 1916, '微細炬熱駆動', UNIX_TIMESTAMP()),
+	(709, 708, '幾層魅了機能', UNIX_TIMESTAMP()),
+	(979, 6972, '混獣併合術', UNIX_TIMESTAMP()),
+	(238, 1236, '睡震抵抗装備', UNIX_TIMESTAMP()),
+	(669, 6662, '因地応変戦', UNIX_TIMESTAMP()),
+	(697, 3693, '創呪痕修復', UNIX_TIMESTAMP()),
+	(381, 5375, '捜査犬智能', UNIX_TIMESTAMP()),
+	(602, 5596, '精神影響波', UNIX_TIMESTAMP()),
+	(201, 6194, '遺伝子組織再編', UNIX_TIMESTAMP()),
+	(197, 3193, '感情制御装置', UNIX_TIMESTAMP()),
+	(364, 1362, '高周波立体空間', UNIX_TIMESTAMP()),
+	(574, 4569, '量子加速粒子', UNIX_TIMESTAMP()),
+	(2, 1000, '高度脳波解析', UNIX_TIMESTAMP()),
+	(737, 736, '磁気共鳴解釈', UNIX_TIMESTAMP()),
+	(229, 6222, '生体互換通信', UNIX_TIMESTAMP()),
+	(861, 4856, '空間意識自動連動装置', UNIX_TIMESTAMP()),
+	(910, 1908, '逆混和現象', UNIX_TIMESTAMP()),
+	(75, 3071, '超芳香族化合物', UNIX_TIMESTAMP()),
+	(606, 4601, '殆足素粒子', UNIX_TIMESTAMP()),
+	(158, 6151, '偽自殺反応', UNIX_TIMESTAMP()),
+	(283, 1281, '断食主成分', UNIX_TIMESTAMP()),
+	(917, 4912, '顔方位回内', UNIX_TIMESTAMP()),
+	(439, 7431, '仮影拡大鏡', UNIX_TIMESTAMP()),
+	(626, 3622, '究極附加価値', UNIX_TIMESTAMP()),
+	(992, 5986, '緊急通信網', UNIX_TIMESTAMP()),
+	(581, 5575, '真実化装置', UNIX_TIMESTAMP()),
+	(450, 2447, '電磁気変換装置', UNIX_TIMESTAMP()),
+	(343, 1341, '時空間断片化', UNIX_TIMESTAMP()),
+	(40, 3036, '高分子合成解析', UNIX_TIMESTAMP()),
+	(26, 1024, '地球物質掘削機', UNIX_TIMESTAMP()),
+	(626, 5620, '光熱変換素子', UNIX_TIMESTAMP()),
+	(931, 5925, '電波干渉防護策', UNIX_TIMESTAMP()),
+	(998, 4993, '鉱石精錬工程', UNIX_TIMESTAMP()),
+	(575, 2572, '地球生態保護計画', UNIX_TIMESTAMP()),
+	(566, 6559, '光速度超越実験', UNIX_TIMESTAMP()),
+	(183, 7175, '磁力制御機構', UNIX_TIMESTAMP()),
+	(954, 953, '細萼毛葉菖', UNIX_TIMESTAMP()),
+	(638, 4633, '神聖加護術', UNIX_TIMESTAMP()),
+	(698, 6691, '風水気功術', UNIX_TIMESTAMP()),
+	(909, 6902, '幽冥界研究', UNIX_TIMESTAMP()),
+	(976, 6969, '憑依阻止装置', UNIX_TIMESTAMP()),
+	(284, 1282, '神聖生命封印術', UNIX_TIMESTAMP()),
+	(227, 1225, '世界意識網絡', UNIX_TIMESTAMP()),
+	(523, 3519, '天狗症候群', UNIX_TIMESTAMP()),
+	(182, 1180, '空気境界線', UNIX_TIMESTAMP()),
+	(701, 1699, '仮想現実干渉', UNIX_TIMESTAMP()),
+	(737, 3733, '生命錯覚現象', UNIX_TIMESTAMP()),
+	(294, 7286, '磁気抑制装置', UNIX_TIMESTAMP()),
+	(182, 6175, '光速応答機械', UNIX_TIMESTAMP()),
+	(586, 2583, '奇跡発生装置', UNIX_TIMESTAMP()),
+	(5, 6997, '空間時間著作権', UNIX_TIMESTAMP()),
+	(227, 7219, '平衡制御体系', UNIX_TIMESTAMP()),
+	(338, 6331, '関節変形装置', UNIX_TIMESTAMP()),
+	(370, 2367, '現実搾取機', UNIX_TIMESTAMP()),
+	(322, 5316, '脳波読心術', UNIX_TIMESTAMP()),
+	(354, 3350, '三次元計測器', UNIX_TIMESTAMP()),
+	(135, 7127, '透視感知装置', UNIX_TIMESTAMP()),
+	(467, 1465, '霊的共鳴装置', UNIX_TIMESTAMP()),
+	(587, 6580, '宇宙間分子通信', UNIX_TIMESTAMP()),
+	(513, 2510, '重力加速空間計算', UNIX_TIMESTAMP()),
+	(750, 2747, '超音速結晶化', UNIX_TIMESTAMP()),
+	(672, 671, '伝播共鳴現象', UNIX_TIMESTAMP()),
+	(487, 2484, '応用神経因子', UNIX_TIMESTAMP()),
+	(630, 6623, '量子磁化膠着', UNIX_TIMESTAMP()),
+	(136, 1134, '超高速電磁波照射', UNIX_TIMESTAMP()),
+	(325, 1323, '潜在酸化反応', UNIX_TIMESTAMP()),
+	(934, 2931, '超臨界流体分離', UNIX_TIMESTAMP()),
+	(248, 1246, '環境重力振動', UNIX_TIMESTAMP()),
+	(687, 3683, '超粒子失活装置', UNIX_TIMESTAMP()),
+	(529, 2526, '透析溶出官能基', UNIX_TIMESTAMP()),
+	(401, 3397, '亜音波位相制御', UNIX_TIMESTAMP()),
+	(215, 2212, '生命複合体解析', UNIX_TIMESTAMP()),
+	(492, 3488, '精神共振器', UNIX_TIMESTAMP()),
+	(341, 4336, '伝導逆行現象', UNIX_TIMESTAMP()),
+	(652, 651, '記憶網構築', UNIX_TIMESTAMP()),
+	(988, 6981, '量子位相暗号', UNIX_TIMESTAMP()),
+	(891, 2888, '多元時間軸', UNIX_TIMESTAMP()),
+	(410, 2407, '共時発光現象', UNIX_TIMESTAMP()),
+	(125, 4120, '微視的相関推定', UNIX_TIMESTAMP()),
+	(701, 2698, '非晶構造検出', UNIX_TIMESTAMP()),
+	(337, 3333, '感情心象推定', UNIX_TIMESTAMP()),
+	(92, 5086, '認知神経監視', UNIX_TIMESTAMP()),
+	(340, 7332, '量子物質安定化', UNIX_TIMESTAMP()),
+	(116, 7108, '情報重層探索', UNIX_TIMESTAMP()),
+	(149, 7141, '霊気光反射', UNIX_TIMESTAMP()),
+	(245, 2242, '心房細支病', UNIX_TIMESTAMP()),
+	(70, 2067, '暗物貫通脳症', UNIX_TIMESTAMP()),
+	(435, 4430, '磁気浸透現象', UNIX_TIMESTAMP()),
+	(595, 5589, '超音波反響膜', UNIX_TIMESTAMP()),
+	(817, 5811, '酸化還元媒体', UNIX_TIMESTAMP()),
+	(786, 3782, '四次元構造評価指数', UNIX_TIMESTAMP()),
+	(236, 1234, '電熱交封機構', UNIX_TIMESTAMP()),
+	(694, 6687, '衛星眼球系統', UNIX_TIMESTAMP()),
+	(272, 271, '蓄積光放射素材', UNIX_TIMESTAMP()),
+	(946, 2943, '無双硬拳士', UNIX_TIMESTAMP()),
+	(5, 2002, '陽磁気合成', UNIX_TIMESTAMP()),
+	(780, 4775, '海王星変身', UNIX_TIMESTAMP()),
+	(200, 4195, '鏡太陽反射', UNIX_TIMESTAMP()),
+	(208, 5202, '脳波磁気共鳴', UNIX_TIMESTAMP()),
+	(351, 1349, '表皮組織構造', UNIX_TIMESTAMP()),
+	(401, 5395, '機械応力強度', UNIX_TIMESTAMP()),
+	(838, 3834, '経営戦略最適化', UNIX_TIMESTAMP()),
+	(225, 6218, '経済政策指数', UNIX_TIMESTAMP()),
+	(671, 6664, '地球磁場逆転', UNIX_TIMESTAMP()),
+	(751, 2748, '市場競争力指数', UNIX_TIMESTAMP()),
+	(357, 3353, '無重力蒸留', UNIX_TIMESTAMP()),
+	(472, 1470, '脳神経安定', UNIX_TIMESTAMP()),
+	(922, 3918, '電気相互作用', UNIX_TIMESTAMP()),
+	(225, 5219, '新世紀心理光線', UNIX_TIMESTAMP()),
+	(532, 4527, '超収斂粒子', UNIX_TIMESTAMP()),
+	(987, 1985, '音波再現装置', UNIX_TIMESTAMP()),
+	(283, 1281, '銀河磁場生成装置', UNIX_TIMESTAMP()),
+	(84, 5078, '生物進化帯域', UNIX_TIMESTAMP()),
+	(359, 358, '量子遅延器', UNIX_TIMESTAMP()),
+	(715, 4710, '鏡面反射器', UNIX_TIMESTAMP()),
+	(601, 6594, '氷晶析導体', UNIX_TIMESTAMP()),
+	(243, 1241, '空中粒子遮断装置', UNIX_TIMESTAMP()),
+	(767, 1765, '熱核融合発電機', UNIX_TIMESTAMP()),
+	(590, 589, '高速陽電子対消滅器', UNIX_TIMESTAMP()),
+	(800, 3796, '生命維持補完装置', UNIX_TIMESTAMP()),
+	(416, 5410, '加速粒子砲', UNIX_TIMESTAMP()),
+	(849, 5843, '意固地脳波', UNIX_TIMESTAMP()),
+	(569, 568, '対時空制御', UNIX_TIMESTAMP()),
+	(764, 1762, '精神画像解析', UNIX_TIMESTAMP()),
+	(54, 3050, '音波駆動装置', UNIX_TIMESTAMP()),
+	(110, 7102, '分子再合成器', UNIX_TIMESTAMP()),
+	(393, 1391, '感応度指数', UNIX_TIMESTAMP()),
+	(241, 6234, '溶解力効率', UNIX_TIMESTAMP()),
+	(483, 1481, '非接触式発電', UNIX_TIMESTAMP()),
+	(878, 3874, '機械定量労働', UNIX_TIMESTAMP()),
+	(246, 4241, '加速陽子加速器', UNIX_TIMESTAMP()),
+	(835, 6828, '陶酔感駆動装置', UNIX_TIMESTAMP()),
+	(317, 1315, '低抵抗高温伝導体', UNIX_TIMESTAMP()),
+	(153, 3149, '静電気充填', UNIX_TIMESTAMP()),
+	(28, 27, '加藤風効果', UNIX_TIMESTAMP()),
+	(374, 4369, '高水位準位', UNIX_TIMESTAMP()),
+	(237, 6230, '遠隔操縦機能', UNIX_TIMESTAMP()),
+	(724, 2721, '超臨界状態', UNIX_TIMESTAMP()),
+	(68, 6061, '四元素磁場', UNIX_TIMESTAMP()),
+	(577, 4572, '応急安定化', UNIX_TIMESTAMP()),
+	(353, 4348, '蔓延防止機構', UNIX_TIMESTAMP()),
+	(263, 3259, '幻霊症候群', UNIX_TIMESTAMP()),
+	(843, 6836, '時間誘捕装置', UNIX_TIMESTAMP()),
+	(455, 4450, '吸収結晶体', UNIX_TIMESTAMP()),
+	(591, 590, '伽利略栄冠', UNIX_TIMESTAMP()),
+	(717, 4712, '光域発信機', UNIX_TIMESTAMP()),
+	(960, 6953, '観念解析器', UNIX_TIMESTAMP()),
+	(358, 7350, '透明視野装置', UNIX_TIMESTAMP()),
+	(245, 2242, '量子瞑想頻度', UNIX_TIMESTAMP()),
+	(690, 2687, '晩餐者研究', UNIX_TIMESTAMP()),
+	(421, 420, '知覚拡張機器', UNIX_TIMESTAMP()),
+	(499, 7491, '孤島証明書', UNIX_TIMESTAMP()),
+	(679, 3675, '平衡心臓跳動器', UNIX_TIMESTAMP()),
+	(546, 5540, '暗黒識別子', UNIX_TIMESTAMP()),
+	(154, 5148, '超高温資源', UNIX_TIMESTAMP()),
+	(461, 7453, '透明品亀裂', UNIX_TIMESTAMP()),
+	(618, 1616, '生命再構築', UNIX_TIMESTAMP()),
+	(672, 3668, '顕微鏡端子', UNIX_TIMESTAMP()),
+	(342, 3338, '曼陀羅花凝仁', UNIX_TIMESTAMP()),
+	(989, 3985, '金縷梅紅籠', UNIX_TIMESTAMP()),
+	(588, 5582, '生物信号解析装置', UNIX_TIMESTAMP()),
+	(371, 6364, '空間加速器', UNIX_TIMESTAMP()),
+	(913, 1911, '平衡遠隔通信', UNIX_TIMESTAMP()),
+	(626, 3622, '時空間共鳴装置', UNIX_TIMESTAMP()),
+	(720, 1718, '高強度重力発生機', UNIX_TIMESTAMP()),
+	(665, 3661, '分子再構成装置', UNIX_TIMESTAMP()),
+	(395, 1393, '熱核融合反応炉', UNIX_TIMESTAMP()),
+	(79, 1077, '情報垂直伝送装置', UNIX_TIMESTAMP()),
+	(252, 1250, '加速週波衛星', UNIX_TIMESTAMP()),
+	(758, 757, '量子情報処理装置', UNIX_TIMESTAMP()),
+	(672, 6665, '睡眠回復装置', UNIX_TIMESTAMP()),
+	(232, 7224, '平衡重力変換装置', UNIX_TIMESTAMP()),
+	(481, 6474, '超伝導磁気浮上機', UNIX_TIMESTAMP()),
+	(413, 1411, '暗黒式尺度', UNIX_TIMESTAMP()),
+	(737, 3733, '動的施設構造', UNIX_TIMESTAMP()),
+	(619, 5613, '微粒子共鳴', UNIX_TIMESTAMP()),
+	(134, 4129, '波動加速器', UNIX_TIMESTAMP()),
+	(846, 5840, '生体網羅機構', UNIX_TIMESTAMP()),
+	(273, 5267, '磁力同調装置', UNIX_TIMESTAMP()),
+	(512, 2509, '感激応答量', UNIX_TIMESTAMP()),
+	(857, 5851, '枯渇防御機構', UNIX_TIMESTAMP()),
+	(887, 1885, '熱核融合封止', UNIX_TIMESTAMP()),
+	(892, 1890, '心理分割探索', UNIX_TIMESTAMP()),
+	(370, 7362, '触覚回転感', UNIX_TIMESTAMP()),
+	(497, 3493, '無限緻密力', UNIX_TIMESTAMP()),
+	(44, 4039, '並列思考剣道', UNIX_TIMESTAMP()),
+	(79, 4074, '不完全燃焼', UNIX_TIMESTAMP()),
+	(615, 1613, '偏微分相互関数', UNIX_TIMESTAMP()),
+	(607, 2604, '潜曲線定理', UNIX_TIMESTAMP()),
+	(612, 3608, '空間的転位', UNIX_TIMESTAMP()),
+	(427, 3423, '計算時間指数', UNIX_TIMESTAMP()),
+	(158, 1156, '創造的模写', UNIX_TIMESTAMP()),
+	(259, 5253, '媒験綜合体', UNIX_TIMESTAMP()),
+	(375, 2372, '知識決定生成', UNIX_TIMESTAMP()),
+	(28, 5022, '非線形渦近似', UNIX_TIMESTAMP()),
+	(148, 4143, '意匠文構造', UNIX_TIMESTAMP()),
+	(205, 2202, '多次元構造解析', UNIX_TIMESTAMP()),
+	(476, 1474, '言語思弁延長', UNIX_TIMESTAMP()),
+	(814, 2811, '実存的瞑想運動', UNIX_TIMESTAMP()),
+	(485, 6478, '顧客応答曲線', UNIX_TIMESTAMP()),
+	(351, 2348, '解約記念量', UNIX_TIMESTAMP()),
+	(854, 1852, '時空転瞬説', UNIX_TIMESTAMP()),
+	(165, 164, '閉鎖的有徳誥', UNIX_TIMESTAMP()),
+	(312, 2309, '玄武配象術', UNIX_TIMESTAMP()),
+	(539, 3535, '夢幻之聯想', UNIX_TIMESTAMP()),
+	(665, 1663, '剣舞心得録', UNIX_TIMESTAMP()),
+	(165, 164, '黄泉真言術', UNIX_TIMESTAMP()),
+	(433, 1431, '登山滞留湖', UNIX_TIMESTAMP()),
+	(807, 4802, '合成遺伝子', UNIX_TIMESTAMP()),
+	(148, 2145, '形態写真収差', UNIX_TIMESTAMP()),
+	(510, 3506, '亜音圧梱包', UNIX_TIMESTAMP()),
+	(279, 278, '浸透圧調節', UNIX_TIMESTAMP()),
+	(556, 4551, '棲息郡生態', UNIX_TIMESTAMP()),
+	(864, 4859, '円柱胞溶差', UNIX_TIMESTAMP()),
+	(869, 2866, '振動共鳴器', UNIX_TIMESTAMP()),
+	(282, 5276, '非対称多様体', UNIX_TIMESTAMP()),
+	(616, 5610, '飛躍自由度', UNIX_TIMESTAMP()),
+	(259, 5253, '原子結晶透明度', UNIX_TIMESTAMP()),
+	(60, 5054, '唾液酸度指標', UNIX_TIMESTAMP()),
+	(234, 2231, '組織振動幅', UNIX_TIMESTAMP()),
+	(135, 134, '量子形態変換', UNIX_TIMESTAMP()),
+	(487, 1485, '空間情報認知', UNIX_TIMESTAMP()),
+	(162, 7154, '触覚応答時間', UNIX_TIMESTAMP()),
+	(84, 4079, '無重力磁気', UNIX_TIMESTAMP()),
+	(575, 3571, '全体自動化', UNIX_TIMESTAMP()),
+	(370, 1368, '平衡相互作用', UNIX_TIMESTAMP()),
+	(627, 3623, '不定形結晶', UNIX_TIMESTAMP()),
+	(481, 7473, '傾斜補正装置', UNIX_TIMESTAMP()),
+	(464, 6457, '材質強化剤', UNIX_TIMESTAMP()),
+	(275, 1273, '自己増殖生物体', UNIX_TIMESTAMP()),
+	(249, 5243, '固定資産査定', UNIX_TIMESTAMP()),
+	(994, 6987, '電磁防護装置', UNIX_TIMESTAMP()),
+	(315, 2312, '重力逆転装置', UNIX_TIMESTAMP()),
+	(703, 2700, '環境変動予測', UNIX_TIMESTAMP()),
+	(294, 293, '無線端末装置', UNIX_TIMESTAMP()),
+	(386, 1384, '野生動物営巣調査', UNIX_TIMESTAMP()),
+	(305, 2302, '水質浄化装置', UNIX_TIMESTAMP()),
+	(132, 5126, '内臓再生医療', UNIX_TIMESTAMP()),
+	(447, 446, '腐食抑制剤', UNIX_TIMESTAMP()),
+	(242, 7234, '非破壊検査装置', UNIX_TIMESTAMP()),
+	(74, 5068, '燃焼効率向上装置', UNIX_TIMESTAMP()),
+	(969, 2966, '生体組織再生治療', UNIX_TIMESTAMP()),
+	(70, 69, '平滑化指標', UNIX_TIMESTAMP()),
+	(516, 6509, '暗霊探知装置', UNIX_TIMESTAMP()),
+	(466, 3462, '禁書検閲機関', UNIX_TIMESTAMP()),
+	(950, 6943, '思念投射装置', UNIX_TIMESTAMP()),
+	(963, 1961, '絶対防壁盾', UNIX_TIMESTAMP()),
+	(17, 5011, '心霊増速器', UNIX_TIMESTAMP()),
+	(707, 3703, '真空拡星機', UNIX_TIMESTAMP()),
+	(724, 2721, '時空移動装置', UNIX_TIMESTAMP()),
+	(208, 1206, '機神巨体兵', UNIX_TIMESTAMP()),
+	(348, 7340, '神秘力制御装置', UNIX_TIMESTAMP()),
+	(386, 4381, '契約書変換器', UNIX_TIMESTAMP()),
+	(439, 438, '空間相互作用', UNIX_TIMESTAMP()),
+	(394, 3390, '不均一化合物', UNIX_TIMESTAMP()),
+	(982, 4977, '水平振動子', UNIX_TIMESTAMP()),
+	(608, 6601, '準同形構造', UNIX_TIMESTAMP()),
+	(611, 5605, '乱数発生器', UNIX_TIMESTAMP()),
+	(422, 3418, '逆位相制御', UNIX_TIMESTAMP()),
+	(120, 3116, '光散乱効果', UNIX_TIMESTAMP()),
+	(753, 752, '運輸保安検査', UNIX_TIMESTAMP()),
+	(236, 3232, '磁気感應力', UNIX_TIMESTAMP()),
+	(993, 2990, '酸化還元反応', UNIX_TIMESTAMP()),
+	(201, 4196, '無機結合構造', UNIX_TIMESTAMP()),
+	(986, 6979, '乳首摩擦力', UNIX_TIMESTAMP()),
+	(542, 3538, '潜水深度計', UNIX_TIMESTAMP()),
+	(619, 4614, '精神衰弱指数', UNIX_TIMESTAMP()),
+	(31, 4026, '架空短縮器', UNIX_TIMESTAMP()),
+	(336, 2333, '伝染予防装置', UNIX_TIMESTAMP()),
+	(345, 344, '真空蛍光体', UNIX_TIMESTAMP()),
+	(713, 6706, '双眼視標系', UNIX_TIMESTAMP()),
+	(262, 5256, '腐食抑制剤', UNIX_TIMESTAMP()),
+	(361, 6354, '無限等価積', UNIX_TIMESTAMP()),
+	(350, 7342, '弦上音楽理念', UNIX_TIMESTAMP()),
+	(320, 7312, '生体感応思弁', UNIX_TIMESTAMP()),
+	(911, 6904, '眠部触覚心理', UNIX_TIMESTAMP()),
+	(779, 5773, '発言劇場装置', UNIX_TIMESTAMP()),
+	(160, 5154, '天体分子探査機', UNIX_TIMESTAMP()),
+	(996, 5990, '地球外生命探知器', UNIX_TIMESTAMP()),
+	(545, 544, '電磁波非透過膜', UNIX_TIMESTAMP()),
+	(46, 45, '複写写真機構', UNIX_TIMESTAMP()),
+	(981, 1979, '陽電子反転装置', UNIX_TIMESTAMP()),
+	(1, 4995, '超高速精度測定器', UNIX_TIMESTAMP()),
+	(288, 1286, '銀河羅針儀', UNIX_TIMESTAMP()),
+	(547, 3543, '晩酌業務機', UNIX_TIMESTAMP()),
+	(378, 5372, '心理対話装置', UNIX_TIMESTAMP()),
+	(726, 5720, '潜在賢者探知術', UNIX_TIMESTAMP()),
+	(897, 1895, '多元次元同期機', UNIX_TIMESTAMP()),
+	(65, 7057, '宇宙憧憬装置', UNIX_TIMESTAMP()),
+	(963, 5957, '知識遺伝暗号', UNIX_TIMESTAMP()),
+	(570, 1568, '生態進化森羅装置', UNIX_TIMESTAMP()),
+	(306, 7298, '無重力移動装置', UNIX_TIMESTAMP()),
+	(606, 4601, '空気軌道書院', UNIX_TIMESTAMP()),
+	(315, 5309, '物質導電反応部', UNIX_TIMESTAMP()),
+	(78, 2075, '未来統合網', UNIX_TIMESTAMP()),
+	(974, 973, '精神共鳴装置', UNIX_TIMESTAMP()),
+	(885, 4880, '超音速振動磁界', UNIX_TIMESTAMP()),
+	(358, 4353, '心霊放出装置', UNIX_TIMESTAMP()),
+	(493, 4488, '量子情報接続網', UNIX_TIMESTAMP()),
+	(231, 5225, '高次元暗号解読機', UNIX_TIMESTAMP()),
+	(94, 4089, '夢幻空間生成装置', UNIX_TIMESTAMP()),
+	(353, 5347, '無重力発生装置', UNIX_TIMESTAMP()),
+	(924, 5918, '経済循環力', UNIX_TIMESTAMP()),
+	(219, 218, '社会共有資産', UNIX_TIMESTAMP()),
+	(167, 6160, '物資供給網', UNIX_TIMESTAMP()),
+	(703, 3699, '文化継承計画', UNIX_TIMESTAMP()),
+	(370, 3366, '都市再生団体', UNIX_TIMESTAMP()),
+	(498, 7490, '公共施設融資', UNIX_TIMESTAMP()),
+	(317, 2314, '組織系統図', UNIX_TIMESTAMP()),
+	(235, 2232, '安全維持部隊', UNIX_TIMESTAMP()),
+	(462, 7454, '建築構造設計', UNIX_TIMESTAMP()),
+	(358, 357, '地域振興戦略', UNIX_TIMESTAMP()),
+	(858, 5852, '地方特産品認定', UNIX_TIMESTAMP()),
+	(233, 7225, '健康増進政策', UNIX_TIMESTAMP()),
+	(445, 4440, '自治体財政改革', UNIX_TIMESTAMP()),
+	(956, 1954, '総合公園整備', UNIX_TIMESTAMP()),
+	(584, 4579, '文化財保存計画', UNIX_TIMESTAMP()),
+	(640, 2637, '映像疑似現実', UNIX_TIMESTAMP()),
+	(892, 4887, '宇宙環境計測', UNIX_TIMESTAMP()),
+	(659, 2656, '経済最適化', UNIX_TIMESTAMP()),
+	(937, 3933, '医療製品開発', UNIX_TIMESTAMP()),
+	(404, 7396, '文化遺産修復', UNIX_TIMESTAMP()),
+	(968, 967, '妖風手裏剣', UNIX_TIMESTAMP()),
+	(304, 3300, '逆応流装置', UNIX_TIMESTAMP()),
+	(317, 6310, '無慈悲閾値', UNIX_TIMESTAMP()),
+	(343, 1341, '逐次上昇機能', UNIX_TIMESTAMP()),
+	(56, 6049, '同時認証暗号', UNIX_TIMESTAMP()),
+	(682, 4677, '超空行闘技', UNIX_TIMESTAMP()),
+	(171, 1169, '逆方向並列処理', UNIX_TIMESTAMP()),
+	(364, 5358, '連続局所通信', UNIX_TIMESTAMP()),
+	(614, 2611, '副反応酵素', UNIX_TIMESTAMP()),
+	(183, 1181, '機械応力計', UNIX_TIMESTAMP()),
+	(409, 1407, '精密地質図', UNIX_TIMESTAMP()),
+	(317, 1315, '水耕栄養溶液', UNIX_TIMESTAMP()),
+	(917, 6910, '生体適合型', UNIX_TIMESTAMP()),
+	(993, 6986, '有機染料画像化', UNIX_TIMESTAMP()),
+	(489, 1487, '経済経理構造', UNIX_TIMESTAMP()),
+	(673, 2670, '地下水沈殿', UNIX_TIMESTAMP()),
+	(145, 2142, '融合太陽電池', UNIX_TIMESTAMP()),
+	(139, 138, '心理関連研究', UNIX_TIMESTAMP()),
+	(297, 4292, '感覚統計処理', UNIX_TIMESTAMP()),
+	(422, 7414, '動態認識制御', UNIX_TIMESTAMP()),
+	(853, 852, '宇宙都市計画', UNIX_TIMESTAMP()),
+	(970, 4965, '環境地域政策', UNIX_TIMESTAMP()),
+	(993, 3989, '情報処理管理', UNIX_TIMESTAMP()),
+	(876, 1874, '電磁捕集発電', UNIX_TIMESTAMP()),
+	(898, 4893, '情報薬物作用', UNIX_TIMESTAMP()),
+	(879, 2876, '環境心理検査', UNIX_TIMESTAMP()),
+	(507, 506, '高分子結晶体', UNIX_TIMESTAMP()),
+	(445, 3441, '蓄電二酸化炭素', UNIX_TIMESTAMP()),
+	(645, 644, '宇宙開発総局', UNIX_TIMESTAMP()),
+	(269, 268, '音波情報解析', UNIX_TIMESTAMP()),
+	(122, 7114, '甴断資源管理', UNIX_TIMESTAMP()),
+	(652, 4647, '放射線防護規定', UNIX_TIMESTAMP()),
+	(610, 3606, '文化遺産保護術', UNIX_TIMESTAMP()),
+	(173, 2170, '心理読解術', UNIX_TIMESTAMP()),
+	(304, 4299, '自然治癒力増強術', UNIX_TIMESTAMP()),
+	(451, 5445, '未来予測術', UNIX_TIMESTAMP()),
+	(525, 5519, '黒天使医療', UNIX_TIMESTAMP()),
+	(689, 2686, '書道狩猟行', UNIX_TIMESTAMP()),
+	(458, 3454, '中間平行離断', UNIX_TIMESTAMP()),
+	(202, 2199, '超音速拡散', UNIX_TIMESTAMP()),
+	(190, 189, '情報漏洩検出', UNIX_TIMESTAMP()),
+	(403, 4398, '環境変動適応', UNIX_TIMESTAMP()),
+	(533, 4528, '生体反発感知', UNIX_TIMESTAMP()),
+	(628, 6621, '心身同期調和', UNIX_TIMESTAMP()),
+	(791, 4786, '空間透視能力', UNIX_TIMESTAMP()),
+	(249, 248, '機械類似心', UNIX_TIMESTAMP()),
+	(205, 2202, '硬軟対応特化', UNIX_TIMESTAMP()),
+	(706, 5700, '高齢応急処置', UNIX_TIMESTAMP()),
+	(115, 1113, '奇跡対話施設', UNIX_TIMESTAMP()),
+	(662, 1660, '無重力剛体', UNIX_TIMESTAMP()),
+	(364, 7356, '島神楽対応', UNIX_TIMESTAMP()),
+	(43, 42, '水晶泉断層', UNIX_TIMESTAMP()),
+	(642, 3638, '雪月花螺絲', UNIX_TIMESTAMP()),
+	(108, 7100, '幻獣遺伝子', UNIX_TIMESTAMP()),
+	(247, 4242, '超音波霧化', UNIX_TIMESTAMP()),
+	(145, 7137, '固体扇風機', UNIX_TIMESTAMP()),
+	(272, 5266, '反回路選択', UNIX_TIMESTAMP()),
+	(644, 2641, '汎用抽出器', UNIX_TIMESTAMP()),
+	(545, 4540, '神経感度器', UNIX_TIMESTAMP()),
+	(810, 809, '無機生理反応素子', UNIX_TIMESTAMP()),
+	(534, 4529, '地磁気測定装置', UNIX_TIMESTAMP()),
+	(962, 961, '心理誘導器', UNIX_TIMESTAMP()),
+	(410, 2407, '量子位相変換装置', UNIX_TIMESTAMP()),
+	(184, 3180, '解剖型翻訳機', UNIX_TIMESTAMP()),
+	(230, 1228, '多面的思考刺激装置', UNIX_TIMESTAMP()),
+	(360, 7352, '動物行動解析機', UNIX_TIMESTAMP()),
+	(102, 1100, '音波音響発生器', UNIX_TIMESTAMP()),
+	(683, 3679, '環境攪拌装置', UNIX_TIMESTAMP()),
+	(456, 7448, '脊椎動物骨格形状指標', UNIX_TIMESTAMP()),
+	(359, 2356, '滋養土微生物群集検査', UNIX_TIMESTAMP()),
+	(320, 2317, '未知宇宙物体検出装置', UNIX_TIMESTAMP()),
+	(700, 2697, '植物生育防虫薬防除処理', UNIX_TIMESTAMP()),
+	(664, 1662, '水中生物絶滅原因研究', UNIX_TIMESTAMP()),
+	(222, 5216, '血液凝固因子量試験', UNIX_TIMESTAMP()),
+	(443, 5437, '急速聴装置', UNIX_TIMESTAMP()),
+	(23, 6016, '精神反射網', UNIX_TIMESTAMP()),
+	(560, 4555, '機械気泡剤', UNIX_TIMESTAMP()),
+	(49, 2046, '電子交尾装置', UNIX_TIMESTAMP()),
+	(272, 7264, '深層生体掃描', UNIX_TIMESTAMP()),
+	(220, 7212, '音楽思念検知器', UNIX_TIMESTAMP()),
+	(755, 6748, '無限分子反転器', UNIX_TIMESTAMP()),
+	(547, 2544, '幻影共振装置', UNIX_TIMESTAMP()),
+	(345, 4340, '偽装夜光塗料', UNIX_TIMESTAMP()),
+	(161, 1159, '創造領域把握装置', UNIX_TIMESTAMP()),
+	(278, 6271, '超高耐久脳波防具', UNIX_TIMESTAMP()),
+	(506, 3502, '風味彩度計', UNIX_TIMESTAMP()),
+	(522, 3518, '偽物証否機', UNIX_TIMESTAMP()),
+	(753, 2750, '精神覚醒補助剤', UNIX_TIMESTAMP()),
+	(552, 6545, '多次元換気系', UNIX_TIMESTAMP()),
+	(832, 5826, '固有磁場増幅装置', UNIX_TIMESTAMP()),
+	(438, 2435, '造形熱源測定器', UNIX_TIMESTAMP()),
+	(916, 2913, '無限相似境界', UNIX_TIMESTAMP()),
+	(358, 4353, '現実反著色', UNIX_TIMESTAMP()),
+	(772, 6765, '超空間構造', UNIX_TIMESTAMP()),
+	(291, 7283, '微妙熱効率', UNIX_TIMESTAMP()),
+	(959, 5953, '応用超質量', UNIX_TIMESTAMP()),
+	(441, 440, '量子渦巻励起', UNIX_TIMESTAMP()),
+	(963, 1961, '優位情動調整', UNIX_TIMESTAMP()),
+	(357, 5351, '加速螺旋律', UNIX_TIMESTAMP()),
+	(281, 1279, '時空補完装置', UNIX_TIMESTAMP()),
+	(508, 1506, '疑似遺伝情報', UNIX_TIMESTAMP()),
+	(838, 837, '微細熱伝導体', UNIX_TIMESTAMP()),
+	(783, 2780, '舞空式装甲', UNIX_TIMESTAMP()),
+	(128, 4123, '連鎖電製薬', UNIX_TIMESTAMP()),
+	(200, 5194, '高密度光通信', UNIX_TIMESTAMP()),
+	(308, 5302, '音波共鳴装置', UNIX_TIMESTAMP()),
+	(715, 5709, '超音速衝撃吸収材', UNIX_TIMESTAMP()),
+	(634, 2631, '電磁共鳴機', UNIX_TIMESTAMP()),
+	(660, 4655, '感情適応指数', UNIX_TIMESTAMP()),
+	(542, 4537, '心拍加速度計', UNIX_TIMESTAMP()),
+	(59, 3055, '時間空間歪曲装置', UNIX_TIMESTAMP()),
+	(732, 2729, '量子粒子速算器', UNIX_TIMESTAMP()),
+	(22, 3018, '宇宙生命探知器', UNIX_TIMESTAMP()),
+	(667, 2664, '認知応答計', UNIX_TIMESTAMP()),
+	(367, 4362, '透明導電体', UNIX_TIMESTAMP()),
+	(173, 2170, '無重力磁場制御器', UNIX_TIMESTAMP()),
+	(182, 1180, '潜在意識観測機', UNIX_TIMESTAMP()),
+	(453, 4448, '感覚遮断装置', UNIX_TIMESTAMP()),
+	(114, 7106, '抽象音楽生成器', UNIX_TIMESTAMP()),
+	(417, 1415, '光子分子通信機', UNIX_TIMESTAMP()),
+	(449, 1447, '心理空間変換装置', UNIX_TIMESTAMP()),
+	(495, 7487, '時間精度検証器', UNIX_TIMESTAMP()),
+	(749, 4744, '創造力指標解析器', UNIX_TIMESTAMP()),
+	(271, 4266, '超音波録音器', UNIX_TIMESTAMP()),
+	(114, 3110, '微粒子復元', UNIX_TIMESTAMP()),
+	(916, 4911, '有機結晶合成', UNIX_TIMESTAMP()),
+	(925, 1923, '酸化還元反応', UNIX_TIMESTAMP()),
+	(497, 6490, '磁気状態制御', UNIX_TIMESTAMP()),
+	(688, 687, '偏光屈折率', UNIX_TIMESTAMP()),
+	(712, 711, '強度応答分析', UNIX_TIMESTAMP()),
+	(715, 3711, '有機合成触媒', UNIX_TIMESTAMP()),
+	(314, 5308, '組織再構築', UNIX_TIMESTAMP()),
+	(342, 5336, '熱酵素反応', UNIX_TIMESTAMP()),
+	(467, 4462, '対消滅反応', UNIX_TIMESTAMP()),
+	(392, 1390, '超寿命機器', UNIX_TIMESTAMP()),
+	(607, 5601, '原子核調整', UNIX_TIMESTAMP()),
+	(86, 2083, '感情抽出装置', UNIX_TIMESTAMP()),
+	(115, 4110, '空想作業環境', UNIX_TIMESTAMP()),
+	(112, 5106, '衝撃波閾値', UNIX_TIMESTAMP()),
+	(278, 6271, '軌道探査装置', UNIX_TIMESTAMP()),
+	(786, 5780, '半球状抗原', UNIX_TIMESTAMP()),
+	(454, 1452, '分子適合操作', UNIX_TIMESTAMP()),
+	(71, 6064, '神秘行動体', UNIX_TIMESTAMP()),
+	(153, 1151, '非線型拡散係数', UNIX_TIMESTAMP()),
+	(411, 1409, '指向補助装置', UNIX_TIMESTAMP()),
+	(186, 4181, '共鳴捕捉機', UNIX_TIMESTAMP()),
+	(837, 4832, '知覚妨害剤', UNIX_TIMESTAMP()),
+	(578, 1576, '認知適切量', UNIX_TIMESTAMP()),
+	(59, 2056, '商品同義出', UNIX_TIMESTAMP()),
+	(86, 1084, '経腸肥栄益', UNIX_TIMESTAMP()),
+	(596, 1594, '電光石火光線', UNIX_TIMESTAMP()),
+	(799, 6792, '千里眼投影', UNIX_TIMESTAMP()),
+	(469, 468, '波羅蜜宝印', UNIX_TIMESTAMP()),
+	(340, 2337, '日轉位風網', UNIX_TIMESTAMP()),
+	(485, 6478, '紅蓮熾天煌', UNIX_TIMESTAMP()),
+	(447, 7439, '破壊世界殺', UNIX_TIMESTAMP()),
+	(86, 85, '心智化制御', UNIX_TIMESTAMP()),
+	(24, 5018, '遺伝子組替', UNIX_TIMESTAMP()),
+	(508, 3504, '品質最適化', UNIX_TIMESTAMP()),
+	(793, 6786, '眼球運動研究', UNIX_TIMESTAMP()),
+	(123, 1121, '音響波長調整', UNIX_TIMESTAMP()),
+	(183, 182, '音響反射率', UNIX_TIMESTAMP()),
+	(298, 4293, '自然環境設計', UNIX_TIMESTAMP()),
+	(747, 2744, '細胞再生医療', UNIX_TIMESTAMP()),
+	(490, 4485, '自動運転制御', UNIX_TIMESTAMP()),
+	(144, 1142, '精神画像診断', UNIX_TIMESTAMP()),
+	(802, 3798, '音響干渉計', UNIX_TIMESTAMP()),
+	(293, 7285, '零次元回路', UNIX_TIMESTAMP()),
+	(634, 2631, '光子拡張装甲', UNIX_TIMESTAMP()),
+	(689, 4684, '真空時空波', UNIX_TIMESTAMP()),
+	(503, 502, '音波解析器', UNIX_TIMESTAMP()),
+	(61, 5055, '音楽遺伝子', UNIX_TIMESTAMP()),
+	(793, 5787, '無品位結合', UNIX_TIMESTAMP()),
+	(532, 5526, '蛍石抑止補助', UNIX_TIMESTAMP()),
+	(149, 4144, '天狼星宙域', UNIX_TIMESTAMP()),
+	(469, 3465, '心脈測定器', UNIX_TIMESTAMP()),
+	(733, 1731, '霊感放射線', UNIX_TIMESTAMP()),
+	(185, 4180, '極星精霊制御', UNIX_TIMESTAMP()),
+	(591, 590, '双具象主義', UNIX_TIMESTAMP()),
+	(858, 3854, '無理量子定理', UNIX_TIMESTAMP()),
+	(199, 198, '安定相変化', UNIX_TIMESTAMP()),
+	(376, 7368, '明快感受釉', UNIX_TIMESTAMP()),
+	(32, 6025, '定量効果転化', UNIX_TIMESTAMP()),
+	(984, 5978, '曖昧語理解', UNIX_TIMESTAMP()),
+	(554, 3550, '創造的思考', UNIX_TIMESTAMP()),
+	(572, 4567, '適応力向上', UNIX_TIMESTAMP()),
+	(879, 3875, '主観的解釈', UNIX_TIMESTAMP()),
+	(203, 7195, '空中浄化装置', UNIX_TIMESTAMP()),
+	(392, 391, '木霊発信器', UNIX_TIMESTAMP()),
+	(712, 711, '時間同期計', UNIX_TIMESTAMP()),
+	(678, 5672, '足音抑制装置', UNIX_TIMESTAMP()),
+	(246, 4241, '天体観測船', UNIX_TIMESTAMP()),
+	(744, 4739, '絶対零度冷蔵庫', UNIX_TIMESTAMP()),
+	(10, 4005, '自動茶葉分別機', UNIX_TIMESTAMP()),
+	(906, 6899, '地下水位計測器', UNIX_TIMESTAMP()),
+	(150, 4145, '音楽変換装置', UNIX_TIMESTAMP()),
+	(997, 1995, '空中行列装置', UNIX_TIMESTAMP()),
+	(6, 3002, '体温自動調節装置', UNIX_TIMESTAMP()),
+	(102, 2099, '無重力時計', UNIX_TIMESTAMP()),
+	(75, 7067, '音響立体視', UNIX_TIMESTAMP()),
+	(856, 3852, '多次元統計', UNIX_TIMESTAMP()),
+	(571, 1569, '非明示的制約', UNIX_TIMESTAMP()),
+	(812, 3808, '磁場偏向抵抗', UNIX_TIMESTAMP()),
+	(423, 4418, '高密度相転移', UNIX_TIMESTAMP()),
+	(546, 4541, '超音速搬送', UNIX_TIMESTAMP()),
+	(416, 4411, '透明情報処理', UNIX_TIMESTAMP()),
+	(381, 2378, '量子共鳴応答', UNIX_TIMESTAMP()),
+	(133, 1131, '非破壊型分光', UNIX_TIMESTAMP()),
+	(845, 4840, '高温精度区間', UNIX_TIMESTAMP()),
+	(187, 1185, '映像領域分析', UNIX_TIMESTAMP()),
+	(323, 6316, '文化適応力', UNIX_TIMESTAMP()),
+	(289, 5283, '統合批評分析', UNIX_TIMESTAMP()),
+	(749, 3745, '心理生理応用', UNIX_TIMESTAMP()),
+	(651, 5645, '直感解析器', UNIX_TIMESTAMP()),
+	(813, 812, '音階認識器', UNIX_TIMESTAMP()),
+	(597, 596, '気象示差指数', UNIX_TIMESTAMP()),
+	(68, 2065, '空中圧縮機器', UNIX_TIMESTAMP()),
+	(473, 2470, '空間運動推定器', UNIX_TIMESTAMP()),
+	(341, 6334, '心神喪失指標', UNIX_TIMESTAMP()),
+	(399, 398, '生命相違分析', UNIX_TIMESTAMP()),
+	(751, 5745, '恒等律違反', UNIX_TIMESTAMP()),
+	(676, 6669, '疾病発熱指数', UNIX_TIMESTAMP()),
+	(799, 798, '近視回帰矯正装置', UNIX_TIMESTAMP()),
+	(941, 4936, '空腹血糖数値', UNIX_TIMESTAMP()),
+	(944, 4939, '宇宙構造解析', UNIX_TIMESTAMP()),
+	(239, 5233, '精神述語統御', UNIX_TIMESTAMP()),
+	(381, 4376, '生物進化速度計測', UNIX_TIMESTAMP()),
+	(297, 296, '隠蔽管制対策', UNIX_TIMESTAMP()),
+	(580, 5574, '環境復元計画', UNIX_TIMESTAMP()),
+	(882, 2879, '数値音像化装置', UNIX_TIMESTAMP()),
+	(70, 6063, '酸化還元反応係数', UNIX_TIMESTAMP()),
+	(178, 7170, '有機共有電子数', UNIX_TIMESTAMP()),
+	(805, 5799, '完全無意識領域', UNIX_TIMESTAMP()),
+	(145, 3141, '格差減少政策', UNIX_TIMESTAMP()),
+	(798, 1796, '危険予測調査', UNIX_TIMESTAMP()),
+	(484, 483, '突発災害対策', UNIX_TIMESTAMP()),
+	(484, 2481, '社会意義価値評価', UNIX_TIMESTAMP()),
+	(136, 3132, '微粒子除去装置', UNIX_TIMESTAMP()),
+	(931, 2928, '臭化水銀装置', UNIX_TIMESTAMP()),
+	(973, 3969, '自己再生細胞', UNIX_TIMESTAMP()),
+	(567, 4562, '高速有機反応装置', UNIX_TIMESTAMP()),
+	(373, 6366, '遺伝子修飾食品', UNIX_TIMESTAMP()),
+	(313, 6306, '超重力生成装置', UNIX_TIMESTAMP()),
+	(411, 1409, '磁気浮上列車', UNIX_TIMESTAMP()),
+	(334, 2331, '太陽光発電器具', UNIX_TIMESTAMP()),
+	(478, 4473, '高圧電撃武器', UNIX_TIMESTAMP()),
+	(427, 3423, '透明合成材料', UNIX_TIMESTAMP()),
+	(324, 5318, '高感度体温計', UNIX_TIMESTAMP()),
+	(268, 5262, '加速粒子線治療装置', UNIX_TIMESTAMP()),
+	(222, 5216, '可変抵抗模擬器', UNIX_TIMESTAMP()),
+	(39, 4034, '音速超光速装置', UNIX_TIMESTAMP()),
+	(839, 4834, '血液凝集酵素', UNIX_TIMESTAMP()),
+	(716, 715, '高出力磁化再現率', UNIX_TIMESTAMP()),
+	(163, 1161, '生体適合触媒', UNIX_TIMESTAMP()),
+	(470, 2467, '汎用文字識別器', UNIX_TIMESTAMP()),
+	(446, 5440, '重力補正航空機', UNIX_TIMESTAMP()),
+	(484, 4479, '微生物複合酵素', UNIX_TIMESTAMP()),
+	(664, 6657, '透感光誘導体', UNIX_TIMESTAMP()),
+	(376, 5370, '加圧測定水槽', UNIX_TIMESTAMP()),
+	(324, 5318, '震動帯域制御', UNIX_TIMESTAMP()),
+	(159, 5153, '抗酸菌阻害剤', UNIX_TIMESTAMP()),
+	(679, 2676, '不可逆温度変化', UNIX_TIMESTAMP()),
+	(86, 6079, '抗体機能再構築', UNIX_TIMESTAMP()),
+	(952, 1950, '電子束描画装置', UNIX_TIMESTAMP()),
+	(670, 3666, '温度勾配分析', UNIX_TIMESTAMP()),
+	(584, 3580, '高分子液晶', UNIX_TIMESTAMP()),
+	(853, 852, '熱拡散抑制', UNIX_TIMESTAMP()),
+	(909, 5903, '水蒸気還元', UNIX_TIMESTAMP()),
+	(666, 5660, '強圧熱凝固', UNIX_TIMESTAMP()),
+	(464, 6457, '機動力供与装置', UNIX_TIMESTAMP()),
+	(959, 958, '歩行助手機', UNIX_TIMESTAMP()),
+	(233, 5227, '精神転送機', UNIX_TIMESTAMP()),
+	(669, 668, '音波共鳴装置', UNIX_TIMESTAMP()),
+	(344, 6337, '逆磁力航行装置', UNIX_TIMESTAMP()),
+	(347, 346, '電磁瞬間移動器', UNIX_TIMESTAMP()),
+	(836, 3832, '多角障壁生成装置', UNIX_TIMESTAMP()),
+	(827, 1825, '時間空間転移機', UNIX_TIMESTAMP()),
+	(612, 2609, '結晶増幅装置', UNIX_TIMESTAMP()),
+	(885, 5879, '冷凍殺虫剤', UNIX_TIMESTAMP()),
+	(816, 4811, '電波干渉器', UNIX_TIMESTAMP()),
+	(491, 490, '虚像産生器', UNIX_TIMESTAMP()),
+	(545, 6538, '音波操縦装置', UNIX_TIMESTAMP()),
+	(155, 4150, '幽獣憑依術', UNIX_TIMESTAMP()),
+	(303, 2300, '黒曜石装備', UNIX_TIMESTAMP()),
+	(851, 4846, '蜘蛛媒業槍', UNIX_TIMESTAMP()),
+	(152, 5146, '共鳴遺伝子', UNIX_TIMESTAMP()),
+	(546, 3542, '超電導媒体', UNIX_TIMESTAMP()),
+	(338, 2335, '樹枝化現象', UNIX_TIMESTAMP()),
+	(508, 4503, '焦点熱制御', UNIX_TIMESTAMP()),
+	(338, 4333, '廃棄物中和', UNIX_TIMESTAMP()),
+	(325, 5319, '微生物進化', UNIX_TIMESTAMP()),
+	(137, 5131, '電磁共鳴転送', UNIX_TIMESTAMP()),
+	(262, 261, '有機合成反応', UNIX_TIMESTAMP()),
+	(736, 3732, '回転共鳴共鳴', UNIX_TIMESTAMP()),
+	(367, 6360, '高温低圧反応', UNIX_TIMESTAMP()),
+	(578, 5572, '光偏向測定', UNIX_TIMESTAMP()),
+	(250, 6243, '疑似化合物', UNIX_TIMESTAMP()),
+	(784, 1782, '創造力固定化', UNIX_TIMESTAMP()),
+	(133, 4128, '非結合錯合体', UNIX_TIMESTAMP()),
+	(434, 3430, '無意識結晶', UNIX_TIMESTAMP()),
+	(291, 6284, '超光速通信', UNIX_TIMESTAMP()),
+	(434, 5428, '演習制施工', UNIX_TIMESTAMP()),
+	(708, 2705, '集中転第体', UNIX_TIMESTAMP()),
+	(395, 5389, '階層相互作用', UNIX_TIMESTAMP()),
+	(427, 426, '咽頭眼窩筋', UNIX_TIMESTAMP()),
+	(982, 5976, '電柱前雨方式', UNIX_TIMESTAMP()),
+	(240, 7232, '箪笥先展示', UNIX_TIMESTAMP()),
+	(409, 6402, '生物適応度指数', UNIX_TIMESTAMP()),
+	(9, 5003, '心中平衡量子', UNIX_TIMESTAMP()),
+	(288, 1286, '電磁炭化凝固', UNIX_TIMESTAMP()),
+	(793, 6786, '分子形態進化', UNIX_TIMESTAMP()),
+	(256, 1254, '超対象同調共鳴', UNIX_TIMESTAMP()),
+	(796, 5790, '進化遺伝知能', UNIX_TIMESTAMP()),
+	(389, 4384, '無積層多次元展開', UNIX_TIMESTAMP()),
+	(692, 4687, '量子情報転送装置', UNIX_TIMESTAMP()),
+	(723, 6716, '非可換幾何凝束', UNIX_TIMESTAMP()),
+	(640, 5634, '宇宙節地平大回転', UNIX_TIMESTAMP()),
+	(654, 3650, '情報発信電波検出', UNIX_TIMESTAMP()),
+	(694, 1692, '動的仮想注入装置', UNIX_TIMESTAMP()),
+	(361, 3357, '同期高次元整列', UNIX_TIMESTAMP()),
+	(396, 2393, '空間認識抽象化', UNIX_TIMESTAMP()),
+	(740, 6733, '界面共鳴相互作用', UNIX_TIMESTAMP()),
+	(949, 2946, '非線形位相最適化', UNIX_TIMESTAMP()),
+	(607, 6600, '移動融合逐次探索', UNIX_TIMESTAMP()),
+	(92, 6085, '植物光合成応答', UNIX_TIMESTAMP()),
+	(71, 4066, '仕様流出預言術', UNIX_TIMESTAMP()),
+	(199, 5193, '精神共鳴定理', UNIX_TIMESTAMP()),
+	(13, 7005, '時間逆転計算', UNIX_TIMESTAMP()),
+	(724, 2721, '空中重力制御', UNIX_TIMESTAMP()),
+	(121, 3117, '無限瞬間連結', UNIX_TIMESTAMP()),
+	(810, 6803, '階層自己重複', UNIX_TIMESTAMP()),
+	(809, 5803, '霧化光電還元', UNIX_TIMESTAMP()),
+	(228, 6221, '物故元情報', UNIX_TIMESTAMP()),
+	(12, 3008, '不可視量子搬送', UNIX_TIMESTAMP()),
+	(15, 14, '静電無効原理', UNIX_TIMESTAMP()),
+	(555, 2552, '微粒子振動共鳴', UNIX_TIMESTAMP()),
+	(620, 1618, '逸脱空間生成', UNIX_TIMESTAMP()),
+	(278, 5272, '心象情報暗示', UNIX_TIMESTAMP()),
+	(419, 5413, '遺伝子発現', UNIX_TIMESTAMP()),
+	(15, 5009, '半導体製造', UNIX_TIMESTAMP()),
+	(64, 3060, '寓意的表現', UNIX_TIMESTAMP()),
+	(449, 1447, '最適化問題', UNIX_TIMESTAMP()),
+	(717, 4712, '逆極限現象', UNIX_TIMESTAMP()),
+	(83, 5077, '相関分析器', UNIX_TIMESTAMP()),
+	(606, 605, '構造化質問言語', UNIX_TIMESTAMP()),
+	(394, 2391, '時間軸分類器', UNIX_TIMESTAMP()),
+	(467, 7459, '瞬時遠心界', UNIX_TIMESTAMP()),
+	(102, 1100, '微生物還元反応', UNIX_TIMESTAMP()),
+	(47, 3043, '温度振動子', UNIX_TIMESTAMP()),
+	(980, 6973, '表面摩擦測定器', UNIX_TIMESTAMP()),
+	(232, 2229, '粗面画像解析', UNIX_TIMESTAMP()),
+	(384, 5378, '吸音構造材', UNIX_TIMESTAMP()),
+	(836, 6829, '搬送励起電圧', UNIX_TIMESTAMP()),
+	(257, 3253, '振幅分布解析', UNIX_TIMESTAMP()),
+	(198, 1196, '風圧低減装置', UNIX_TIMESTAMP()),
+	(326, 325, '共鳴電位配列', UNIX_TIMESTAMP()),
+	(935, 2932, '首輪装着器', UNIX_TIMESTAMP()),
+	(185, 2182, '振動制御機構', UNIX_TIMESTAMP()),
+	(135, 2132, '微分方程式', UNIX_TIMESTAMP()),
+	(517, 2514, '懸濁拡散指数', UNIX_TIMESTAMP()),
+	(62, 61, '星間質観測装置', UNIX_TIMESTAMP()),
+	(944, 943, '腺内分泌分子研究', UNIX_TIMESTAMP()),
+	(58, 2055, '植物生態綜合解析', UNIX_TIMESTAMP()),
+	(189, 6182, '岩盤応力計測機器', UNIX_TIMESTAMP()),
+	(164, 5158, '膜透析機構', UNIX_TIMESTAMP()),
+	(299, 7291, '高音響分光器', UNIX_TIMESTAMP()),
+	(585, 1583, '念力増幅装置', UNIX_TIMESTAMP()),
+	(265, 5259, '石化防御盾', UNIX_TIMESTAMP()),
+	(155, 3151, '怒気破壊砲', UNIX_TIMESTAMP()),
+	(529, 2526, '闇魂再生装置', UNIX_TIMESTAMP()),
+	(264, 6257, '光子衝撃波', UNIX_TIMESTAMP()),
+	(327, 2324, '念動力増幅器', UNIX_TIMESTAMP()),
+	(966, 6959, '呪力解放剤', UNIX_TIMESTAMP()),
+	(183, 5177, '石炭噴射装置', UNIX_TIMESTAMP()),
+	(217, 7209, '光反射器具', UNIX_TIMESTAMP()),
+	(194, 3190, '生体交感濾器', UNIX_TIMESTAMP()),
+	(550, 3546, '暗黒真空体', UNIX_TIMESTAMP()),
+	(293, 7285, '反軌道電磁力', UNIX_TIMESTAMP()),
+	(569, 5563, '超新星測定器', UNIX_TIMESTAMP()),
+	(778, 3774, '千里眼観測帯', UNIX_TIMESTAMP()),
+	(589, 588, '純白花鳥風月', UNIX_TIMESTAMP()),
+	(560, 559, '高密度磁気素子', UNIX_TIMESTAMP()),
+	(474, 7466, '強化複合材料', UNIX_TIMESTAMP()),
+	(556, 5550, '惑星磁気測定装置', UNIX_TIMESTAMP()),
+	(586, 585, '超高速粒子加速器', UNIX_TIMESTAMP()),
+	(126, 3122, '高効率地熱発電機', UNIX_TIMESTAMP()),
+	(531, 530, '遺伝子情報解析装置', UNIX_TIMESTAMP()),
+	(845, 2842, '救命飛行具', UNIX_TIMESTAMP()),
+	(978, 4973, '心理戦略術', UNIX_TIMESTAMP()),
+	(359, 1357, '仮想時空軸', UNIX_TIMESTAMP()),
+	(77, 2074, '記憶再構築装置', UNIX_TIMESTAMP()),
+	(253, 4248, '創造力能力指数', UNIX_TIMESTAMP()),
+	(735, 2732, '行動心理反応分析', UNIX_TIMESTAMP()),
+	(349, 6342, '無意識情報操作装置', UNIX_TIMESTAMP()),
+	(181, 3177, '認知干渉効果検査', UNIX_TIMESTAMP()),
+	(268, 3264, '蒸気機関車気圧樽', UNIX_TIMESTAMP()),
+	(564, 2561, '赤道陣列計算', UNIX_TIMESTAMP()),
+	(100, 3096, '宇宙微粒子測定', UNIX_TIMESTAMP()),
+	(523, 1521, '音響共鳴硬件', UNIX_TIMESTAMP()),
+	(709, 4704, '潜水競技中枢', UNIX_TIMESTAMP()),
+	(995, 6988, '微生物分解酵素', UNIX_TIMESTAMP()),
+	(50, 7042, '植物免疫機構', UNIX_TIMESTAMP()),
+	(116, 7108, '積算輻輳率算出', UNIX_TIMESTAMP()),
+	(103, 5097, '生体適応医療支援装置', UNIX_TIMESTAMP()),
+	(359, 4354, '動物情報網剖析', UNIX_TIMESTAMP()),
+	(347, 346, '微小衝撃波生成器', UNIX_TIMESTAMP()),
+	(425, 4420, '有機化合物分別処理', UNIX_TIMESTAMP()),
+	(836, 2833, '容量熱応力試験', UNIX_TIMESTAMP()),
+	(870, 4865, '地震観測用不自然振動計', UNIX_TIMESTAMP()),
+	(429, 428, '空間静強度評価', UNIX_TIMESTAMP()),
+	(317, 4312, '無重力物質生産装置', UNIX_TIMESTAMP()),
+	(367, 366, '地球磁場断面観測装置', UNIX_TIMESTAMP()),
+	(491, 7483, '超音波装置', UNIX_TIMESTAMP()),
+	(332, 6325, '補完遺伝子', UNIX_TIMESTAMP()),
+	(373, 2370, '無重力実験', UNIX_TIMESTAMP()),
+	(443, 6436, '霊力凝縮装置', UNIX_TIMESTAMP()),
+	(630, 1628, '太陽風分析装置', UNIX_TIMESTAMP()),
+	(632, 5626, '心神統制装置', UNIX_TIMESTAMP()),
+	(574, 573, '記憶再構築装置', UNIX_TIMESTAMP()),
+	(931, 930, '時空共振発生器', UNIX_TIMESTAMP()),
+	(198, 7190, '光子応答増幅器', UNIX_TIMESTAMP()),
+	(988, 5982, '物質形態変換機', UNIX_TIMESTAMP()),
+	(155, 6148, '感情認識推定器', UNIX_TIMESTAMP()),
+	(149, 7141, '識別情報抽出装置', UNIX_TIMESTAMP()),
+	(547, 3543, '空間軌道補正計画', UNIX_TIMESTAMP()),
+	(541, 540, '蒼鷹船図像', UNIX_TIMESTAMP()),
+	(179, 3175, '音響信号処理', UNIX_TIMESTAMP()),
+	(974, 1972, '微生物触媒', UNIX_TIMESTAMP()),
+	(764, 2761, '個体遺伝子', UNIX_TIMESTAMP()),
+	(356, 1354, '水晶質霊視', UNIX_TIMESTAMP()),
+	(983, 1981, '心肺循環補助', UNIX_TIMESTAMP()),
+	(263, 7255, '移動遺伝子', UNIX_TIMESTAMP()),
+	(378, 6371, '爆風抑制装置', UNIX_TIMESTAMP()),
+	(277, 4272, '無重力発生器', UNIX_TIMESTAMP()),
+	(335, 4330, '心拍波探知機', UNIX_TIMESTAMP()),
+	(92, 1090, '色彩再構築装置', UNIX_TIMESTAMP()),
+	(431, 7423, '時間曲率推進器', UNIX_TIMESTAMP()),
+	(796, 5790, '分子転換燃料', UNIX_TIMESTAMP()),
+	(994, 5988, '生体増殖液', UNIX_TIMESTAMP()),
+	(295, 3291, '高分子測定器', UNIX_TIMESTAMP()),
+	(964, 2961, '蒸気振動器', UNIX_TIMESTAMP()),
+	(953, 1951, '視線解析器', UNIX_TIMESTAMP()),
+	(665, 664, '感情蓄積機', UNIX_TIMESTAMP()),
+	(176, 5170, '磁力巡送装置', UNIX_TIMESTAMP()),
+	(474, 6467, '瞬間幻影機', UNIX_TIMESTAMP()),
+	(379, 3375, '水素原子間相互作用力', UNIX_TIMESTAMP()),
+	(13, 5007, '風動制御機構', UNIX_TIMESTAMP()),
+	(557, 4552, '葉柄骨造組織', UNIX_TIMESTAMP()),
+	(749, 6742, '微量栄養伝達系', UNIX_TIMESTAMP()),
+	(926, 925, '成骨能力測定装置', UNIX_TIMESTAMP()),
+	(954, 1952, '電磁励振応用装置', UNIX_TIMESTAMP()),
+	(71, 1069, '乾燥動力吸収率', UNIX_TIMESTAMP()),
+	(501, 500, '表面評価指数', UNIX_TIMESTAMP()),
+	(538, 2535, '体脂燃焼装置', UNIX_TIMESTAMP()),
+	(360, 3356, '空中浮遊装置', UNIX_TIMESTAMP()),
+	(241, 4236, '高周波振動装置', UNIX_TIMESTAMP()),
+	(5, 1003, '高感度感知装置', UNIX_TIMESTAMP()),
+	(686, 5680, '即応型自己回復素材', UNIX_TIMESTAMP()),
+	(40, 5034, '宇宙時間経過予測器', UNIX_TIMESTAMP()),
+	(419, 418, '空気循環率', UNIX_TIMESTAMP()),
+	(88, 87, '衛星環境計測', UNIX_TIMESTAMP()),
+	(993, 6986, '平衡介在層', UNIX_TIMESTAMP()),
+	(104, 1102, '複合熱曲率', UNIX_TIMESTAMP()),
+	(608, 1606, '電荷遮断器', UNIX_TIMESTAMP()),
+	(495, 3491, '水晶共振器', UNIX_TIMESTAMP()),
+	(774, 3770, '高層操作術', UNIX_TIMESTAMP()),
+	(703, 3699, '無線電波干渉', UNIX_TIMESTAMP()),
+	(610, 4605, '通信回線切替装置', UNIX_TIMESTAMP()),
+	(631, 2628, '地下鉄改札機', UNIX_TIMESTAMP()),
+	(231, 230, '感染症対策術', UNIX_TIMESTAMP()),
+	(738, 5732, '宇宙探査機', UNIX_TIMESTAMP()),
+	(307, 2304, '天候制御装置', UNIX_TIMESTAMP()),
+	(346, 7338, '生態系維持装置', UNIX_TIMESTAMP()),
+	(943, 6936, '水力発電装置', UNIX_TIMESTAMP()),
+	(653, 3649, '音声信号処理', UNIX_TIMESTAMP()),
+	(1, 3996, '集積回路設計', UNIX_TIMESTAMP()),
+	(103, 2100, '生態回路解析', UNIX_TIMESTAMP()),
+	(7, 2004, '現象媒介器', UNIX_TIMESTAMP()),
+	(56, 4051, '構造干渉体', UNIX_TIMESTAMP()),
+	(923, 4918, '空間位相調整器', UNIX_TIMESTAMP()),
+	(255, 1253, '量子検出素子', UNIX_TIMESTAMP()),
+	(22, 3018, '生物内部時計調整装置', UNIX_TIMESTAMP()),
+	(286, 6279, '意識高速化装置', UNIX_TIMESTAMP()),
+	(365, 2362, '非晶質境界材料', UNIX_TIMESTAMP()),
+	(918, 6911, '音声無理矢理生成器', UNIX_TIMESTAMP()),
+	(926, 4921, '情報転送密度最大化器', UNIX_TIMESTAMP()),
+	(275, 5269, '熱分散制御装置', UNIX_TIMESTAMP()),
+	(861, 6854, '信号通信回路規格化器', UNIX_TIMESTAMP()),
+	(585, 4580, '心理情報分類器', UNIX_TIMESTAMP()),
+	(184, 4179, '霊感波長分析器', UNIX_TIMESTAMP()),
+	(419, 418, '磁場通信暗号化器', UNIX_TIMESTAMP()),
+	(177, 1175, '分子振動共鳴装置', UNIX_TIMESTAMP()),
+	(312, 1310, '注目拠点端末', UNIX_TIMESTAMP()),
+	(866, 1864, '情報統制補完装置', UNIX_TIMESTAMP()),
+	(515, 1513, '画像解析障壁', UNIX_TIMESTAMP()),
+	(525, 3521, '音波観測機械', UNIX_TIMESTAMP()),
+	(437, 7429, '物質相転移予測器', UNIX_TIMESTAMP()),
+	(80, 3076, '真空中変調装置', UNIX_TIMESTAMP()),
+	(921, 1919, '高密度生体情報読取装置', UNIX_TIMESTAMP()),
+	(306, 4301, '高反射反射体', UNIX_TIMESTAMP()),
+	(675, 3671, '磁場安定制御器', UNIX_TIMESTAMP()),
+	(408, 4403, '自動絶縁体', UNIX_TIMESTAMP()),
+	(252, 7244, '力場集束器', UNIX_TIMESTAMP()),
+	(234, 2231, '声紋解析装置', UNIX_TIMESTAMP()),
+	(694, 2691, '帯電率調整装置', UNIX_TIMESTAMP()),
+	(928, 4923, '高感度局所睡眠評価器', UNIX_TIMESTAMP()),
+	(299, 5293, '水力蒸気機関', UNIX_TIMESTAMP()),
+	(512, 4507, '音楽種因子解析', UNIX_TIMESTAMP()),
+	(914, 1912, '交感神経興奮度', UNIX_TIMESTAMP()),
+	(458, 3454, '情報伝達効率化', UNIX_TIMESTAMP()),
+	(328, 6321, '電流磁場相互作用', UNIX_TIMESTAMP()),
+	(446, 1444, '心理言語処理', UNIX_TIMESTAMP()),
+	(270, 6263, '社会政策評価指標', UNIX_TIMESTAMP()),
+	(896, 1894, '気象衛星画像解析', UNIX_TIMESTAMP()),
+	(789, 6782, '蒸気駆動器', UNIX_TIMESTAMP()),
+	(148, 1146, '精神操作装置', UNIX_TIMESTAMP()),
+	(82, 4077, '反重力生成', UNIX_TIMESTAMP()),
+	(145, 7137, '無重力燃焼', UNIX_TIMESTAMP()),
+	(291, 7283, '四次元構造', UNIX_TIMESTAMP()),
+	(588, 587, '画像解析統計', UNIX_TIMESTAMP()),
+	(398, 3394, '心理空間探査', UNIX_TIMESTAMP()),
+	(93, 7085, '高次元情報処理', UNIX_TIMESTAMP()),
+	(877, 4872, '分子安定化', UNIX_TIMESTAMP()),
+	(762, 3758, '量子暗号通信', UNIX_TIMESTAMP()),
+	(775, 6768, '仮想現実映像', UNIX_TIMESTAMP()),
+	(749, 748, '時空融合装置', UNIX_TIMESTAMP()),
+	(497, 4492, '大氣交換率', UNIX_TIMESTAMP()),
+	(71, 2068, '分子懸濁液', UNIX_TIMESTAMP()),
+	(452, 451, '熱固形反応', UNIX_TIMESTAMP()),
+	(898, 6891, '無機電解反応', UNIX_TIMESTAMP()),
+	(848, 6841, '環境微生物', UNIX_TIMESTAMP()),
+	(695, 5689, '非線型測定', UNIX_TIMESTAMP()),
+	(462, 5456, '市場競争力指標', UNIX_TIMESTAMP()),
+	(458, 457, '施設地盤安定化', UNIX_TIMESTAMP()),
+	(746, 4741, '加熱微粒子', UNIX_TIMESTAMP()),
+	(854, 4849, '酸素吸光率', UNIX_TIMESTAMP()),
+	(911, 1909, '絶対厳密解', UNIX_TIMESTAMP()),
+	(347, 4342, '分子分布関数', UNIX_TIMESTAMP()),
+	(857, 2854, '神経伝導速度', UNIX_TIMESTAMP()),
+	(918, 1916, '水蒸気圧力', UNIX_TIMESTAMP()),
+	(609, 608, '電気発泡機', UNIX_TIMESTAMP()),
+	(759, 2756, '応力緩和現象', UNIX_TIMESTAMP()),
+	(988, 2985, '光散乱係数', UNIX_TIMESTAMP()),
+	(269, 6262, '微生物発酵', UNIX_TIMESTAMP()),
+	(822, 821, '悪魔撃退録', UNIX_TIMESTAMP()),
+	(371, 1369, '神業手裏剣', UNIX_TIMESTAMP()),
+	(918, 917, '世紀末思潮', UNIX_TIMESTAMP()),
+	(144, 4139, '微粒子冷却装置', UNIX_TIMESTAMP()),
+	(90, 6083, '剣斧合成物', UNIX_TIMESTAMP()),
+	(143, 4138, '双方向遠隔通信', UNIX_TIMESTAMP()),
+	(675, 4670, '静電遮蔽材', UNIX_TIMESTAMP()),
+	(377, 2374, '超高速気流制御装置', UNIX_TIMESTAMP()),
+	(623, 6616, '量子結合反応器', UNIX_TIMESTAMP()),
+	(155, 2152, '無錨表層構造', UNIX_TIMESTAMP()),
+	(693, 2690, '超音速反射被視体', UNIX_TIMESTAMP()),
+	(894, 2891, '連続波増幅装置', UNIX_TIMESTAMP()),
+	(431, 3427, '一方向無縫合結合器', UNIX_TIMESTAMP()),
+	(526, 5520, '超抵抗材料', UNIX_TIMESTAMP()),
+	(644, 6637, '時空裂解装置', UNIX_TIMESTAMP()),
+	(108, 107, '高強度撹拌材', UNIX_TIMESTAMP()),
+	(390, 6383, '基底体積暗号鍵', UNIX_TIMESTAMP()),
+	(261, 3257, '星環検出器', UNIX_TIMESTAMP()),
+	(397, 4392, '火星転送船', UNIX_TIMESTAMP()),
+	(802, 801, '冷凍融解装置', UNIX_TIMESTAMP()),
+	(878, 1876, '時空固定器', UNIX_TIMESTAMP()),
+	(888, 1886, '軟質接種材', UNIX_TIMESTAMP()),
+	(653, 5647, '彩光結晶体', UNIX_TIMESTAMP()),
+	(820, 4815, '羽根脈構造', UNIX_TIMESTAMP()),
+	(663, 4658, '凝固造粒器', UNIX_TIMESTAMP()),
+	(892, 3888, '斜床反射板', UNIX_TIMESTAMP()),
+	(32, 1030, '円月弦交差点', UNIX_TIMESTAMP()),
+	(124, 3120, '疾走衝撃波', UNIX_TIMESTAMP()),
+	(573, 1571, '遺伝情報読取装置', UNIX_TIMESTAMP()),
+	(748, 3744, '実在具化霊体', UNIX_TIMESTAMP()),
+	(505, 504, '還元剤中和装置', UNIX_TIMESTAMP()),
+	(458, 3454, '冥王星陪審員', UNIX_TIMESTAMP()),
+	(600, 3596, '磁気量検出閾値', UNIX_TIMESTAMP()),
+	(828, 6821, '幾何光合成酵素', UNIX_TIMESTAMP()),
+	(241, 3237, '低騒音振動子', UNIX_TIMESTAMP()),
+	(460, 5454, '寡聞逆注想定', UNIX_TIMESTAMP()),
+	(511, 3507, '極夜症候群', UNIX_TIMESTAMP()),
+	(271, 4266, '昆虫培養機', UNIX_TIMESTAMP()),
+	(946, 2943, '砂精滅菌器', UNIX_TIMESTAMP()),
+	(32, 4027, '暁禁煙器具', UNIX_TIMESTAMP()),
+	(603, 1601, '水晶大気計', UNIX_TIMESTAMP()),
+	(237, 236, '島津分光鏡', UNIX_TIMESTAMP()),
+	(350, 2347, '星塵環境装置', UNIX_TIMESTAMP()),
+	(870, 3866, '海邦重力計', UNIX_TIMESTAMP()),
+	(580, 5574, '鋼芯渦電動機', UNIX_TIMESTAMP()),
+	(493, 4488, '陸遠超電導体', UNIX_TIMESTAMP()),
+	(517, 1515, '雷光放射装置', UNIX_TIMESTAMP()),
+	(876, 3872, '弁巻質量計', UNIX_TIMESTAMP()),
+	(839, 1837, '極細球状放電器', UNIX_TIMESTAMP()),
+	(666, 5660, '灼熱大気分析器', UNIX_TIMESTAMP()),
+	(211, 4206, '空中熱伝導析出器', UNIX_TIMESTAMP()),
+	(449, 5443, '青銅衝突防御盾', UNIX_TIMESTAMP()),
+	(98, 1096, '地獄熱発電機', UNIX_TIMESTAMP()),
+	(849, 1847, '静態撮影装置', UNIX_TIMESTAMP()),
+	(402, 2399, '霊気境界線', UNIX_TIMESTAMP()),
+	(372, 1370, '設定値解除', UNIX_TIMESTAMP()),
+	(538, 4533, '無限相関律', UNIX_TIMESTAMP()),
+	(370, 6363, '位相応答解析', UNIX_TIMESTAMP()),
+	(650, 649, '状態遷移図', UNIX_TIMESTAMP()),
+	(71, 4066, '複合反応機構', UNIX_TIMESTAMP()),
+	(414, 2411, '量子補完原則', UNIX_TIMESTAMP()),
+	(729, 2726, '固有領域解析', UNIX_TIMESTAMP()),
+	(486, 2483, '助長遺伝抑制効果', UNIX_TIMESTAMP()),
+	(788, 3784, '完全正規形式', UNIX_TIMESTAMP()),
+	(552, 551, '決意奮霆確', UNIX_TIMESTAMP()),
+	(389, 1387, '発熱熱応答', UNIX_TIMESTAMP()),
+	(31, 30, '暗号核磁気共鳴', UNIX_TIMESTAMP()),
+	(251, 1249, '軌道超電導', UNIX_TIMESTAMP()),
+	(21, 20, '超伝導透明', UNIX_TIMESTAMP()),
+	(58, 5052, '界面多晶膜', UNIX_TIMESTAMP()),
+	(739, 738, '水銀自己薬局', UNIX_TIMESTAMP()),
+	(877, 1875, '高周波接触感電', UNIX_TIMESTAMP()),
+	(959, 2956, '瞬間重力極低減', UNIX_TIMESTAMP()),
+	(400, 4395, '防裾黄砂除却器', UNIX_TIMESTAMP()),
+	(849, 4844, '水銀融合光補償', UNIX_TIMESTAMP()),
+	(456, 455, '環境難化合金', UNIX_TIMESTAMP()),
+	(87, 6080, '無机対消光物質', UNIX_TIMESTAMP()),
+	(327, 3323, '深海縦横加速', UNIX_TIMESTAMP()),
+	(520, 4515, '熱帯魚光授乳器', UNIX_TIMESTAMP()),
+	(85, 4080, '液体真空複合製糸', UNIX_TIMESTAMP()),
+	(454, 453, '超音波準一次元流体', UNIX_TIMESTAMP()),
+	(122, 2119, '造血増生休息', UNIX_TIMESTAMP()),
+	(319, 2316, '境界不定位形態複製', UNIX_TIMESTAMP()),
+	(465, 7457, '水中花協会準瀉血機', UNIX_TIMESTAMP()),
+	(797, 4792, '蒸気紡績機', UNIX_TIMESTAMP()),
+	(317, 5311, '磁気共鳴回路', UNIX_TIMESTAMP()),
+	(242, 2239, '潜在意識取引所', UNIX_TIMESTAMP()),
+	(434, 6427, '超音波拡散効果', UNIX_TIMESTAMP()),
+	(601, 4596, '重力反転装置', UNIX_TIMESTAMP()),
+	(720, 4715, '電子写真撮影装置', UNIX_TIMESTAMP()),
+	(463, 4458, '不可逆反応速度定数', UNIX_TIMESTAMP()),
+	(888, 5882, '無線通信多重化方式', UNIX_TIMESTAMP()),
+	(631, 2628, '緋薔薇魔術', UNIX_TIMESTAMP()),
+	(92, 5086, '神玉抜刀術', UNIX_TIMESTAMP()),
+	(796, 2793, '無文字記録', UNIX_TIMESTAMP()),
+	(344, 1342, '厳密反応度', UNIX_TIMESTAMP()),
+	(899, 2896, '酒精反飽和', UNIX_TIMESTAMP()),
+	(618, 2615, '消化酵基準', UNIX_TIMESTAMP()),
+	(677, 2674, '水風船抽象画', UNIX_TIMESTAMP()),
+	(990, 3986, '鏡花衆生絵', UNIX_TIMESTAMP()),
+	(245, 244, '暗黒構造体', UNIX_TIMESTAMP()),
+	(474, 3470, '精神融合装置', UNIX_TIMESTAMP()),
+	(55, 2052, '真空陥没現象', UNIX_TIMESTAMP()),
+	(77, 1075, '超振動兵器', UNIX_TIMESTAMP()),
+	(193, 5187, '時間逆転機械', UNIX_TIMESTAMP()),
+	(608, 3604, '無重力粒子', UNIX_TIMESTAMP()),
+	(257, 256, '量子転送通信', UNIX_TIMESTAMP()),
+	(747, 6740, '電磁空間干渉装置', UNIX_TIMESTAMP()),
+	(202, 6195, '魔力共鳴装置', UNIX_TIMESTAMP()),
+	(187, 3183, '音波封魔装置', UNIX_TIMESTAMP()),
+	(72, 71, '暗躯幻像術', UNIX_TIMESTAMP()),
+	(100, 4095, '微粒子世界', UNIX_TIMESTAMP()),
+	(300, 2297, '夢幻共振装置', UNIX_TIMESTAMP()),
+	(510, 6503, '反魔石障壁', UNIX_TIMESTAMP()),
+	(651, 650, '宇宙煌機装', UNIX_TIMESTAMP()),
+	(498, 3494, '蝕脈絡感応装置', UNIX_TIMESTAMP()),
+	(352, 7344, '認知再構築', UNIX_TIMESTAMP()),
+	(221, 220, '知覚優越症候群', UNIX_TIMESTAMP()),
+	(253, 7245, '情報抽象化', UNIX_TIMESTAMP()),
+	(838, 4833, '時間疎外感', UNIX_TIMESTAMP()),
+	(802, 5796, '集団共感力', UNIX_TIMESTAMP()),
+	(870, 6863, '組織文化適応度', UNIX_TIMESTAMP()),
+	(254, 4249, '未来予知株', UNIX_TIMESTAMP()),
+	(985, 984, '平衡運搬核', UNIX_TIMESTAMP()),
+	(137, 2134, '非定憶確率', UNIX_TIMESTAMP()),
+	(329, 2326, '完全絶縁側', UNIX_TIMESTAMP()),
+	(977, 1975, '連立測制理', UNIX_TIMESTAMP()),
+	(35, 1033, '詠文掌握順', UNIX_TIMESTAMP()),
+	(316, 5310, '千光帯原元', UNIX_TIMESTAMP()),
+	(794, 4789, '航天変態搬送機', UNIX_TIMESTAMP()),
+	(515, 4510, '生命螺旋合成素', UNIX_TIMESTAMP()),
+	(820, 2817, '超音速重力刺激装置', UNIX_TIMESTAMP()),
+	(162, 3158, '環境共鳴初次病因', UNIX_TIMESTAMP()),
+	(722, 4717, '巨大時空震動装置', UNIX_TIMESTAMP()),
+	(502, 1500, '情報歪曲粒子', UNIX_TIMESTAMP()),
+	(792, 4787, '高密度超伝導材料', UNIX_TIMESTAMP()),
+	(144, 3140, '超音速微小粒子加速器', UNIX_TIMESTAMP()),
+	(108, 3104, '重力逆転補正装置', UNIX_TIMESTAMP()),
+	(389, 4384, '光子換気再燃器', UNIX_TIMESTAMP()),
+	(40, 7032, '非線形時空変換回路', UNIX_TIMESTAMP()),
+	(430, 1428, '逆位相力場発生器', UNIX_TIMESTAMP()),
+	(165, 4160, '情報圧縮粒子推進装置', UNIX_TIMESTAMP()),
+	(639, 2636, '霧露偏光子', UNIX_TIMESTAMP()),
+	(874, 5868, '合間喜慶筋', UNIX_TIMESTAMP()),
+	(360, 2357, '真空雰囲気変数', UNIX_TIMESTAMP()),
+	(580, 5574, '羊毛嵐舞台', UNIX_TIMESTAMP()),
+	(177, 176, '系統攻撃提鈎', UNIX_TIMESTAMP()),
+	(278, 277, '浮胃接地装置', UNIX_TIMESTAMP()),
+	(317, 2314, '父乳悪化因子', UNIX_TIMESTAMP()),
+	(136, 2133, '放射霧構成物', UNIX_TIMESTAMP()),
+	(282, 3278, '軟突果汁器', UNIX_TIMESTAMP()),
+	(617, 2614, '鞭風焼凍力', UNIX_TIMESTAMP()),
+	(549, 5543, '猫舌魔動力', UNIX_TIMESTAMP()),
+	(239, 2236, '金印溝施策', UNIX_TIMESTAMP()),
+	(105, 5099, '青石蓄電経', UNIX_TIMESTAMP()),
+	(220, 219, '銀鈴散霧装置', UNIX_TIMESTAMP()),
+	(562, 3558, '瑪瑙搏巧術', UNIX_TIMESTAMP()),
+	(602, 6595, '蒸気量測定機', UNIX_TIMESTAMP()),
+	(65, 2062, '音響離散化装置', UNIX_TIMESTAMP()),
+	(66, 1064, '熱帯農業用肥料', UNIX_TIMESTAMP()),
+	(549, 6542, '直線移動蓄電池', UNIX_TIMESTAMP()),
+	(7, 1005, '触覚感受器', UNIX_TIMESTAMP()),
+	(538, 2535, '旅行適応治療', UNIX_TIMESTAMP()),
+	(980, 3976, '光量子計算', UNIX_TIMESTAMP()),
+	(77, 1075, '繊維構造解析', UNIX_TIMESTAMP()),
+	(734, 4729, '核分裂反応制御', UNIX_TIMESTAMP()),
+	(275, 2272, '形態素解析器', UNIX_TIMESTAMP()),
+	(725, 724, '環境保護署', UNIX_TIMESTAMP()),
+	(90, 3086, '建築設計図', UNIX_TIMESTAMP()),
+	(72, 5066, '古文書鑑定士', UNIX_TIMESTAMP()),
+	(521, 1519, '刑事捜査官', UNIX_TIMESTAMP()),
+	(156, 2153, '魔力結晶体', UNIX_TIMESTAMP()),
+	(329, 3325, '時空転送装置', UNIX_TIMESTAMP()),
+	(237, 5231, '心霊感応装置', UNIX_TIMESTAMP()),
+	(265, 4260, '量子状況把握装置', UNIX_TIMESTAMP()),
+	(934, 1932, '潜在能力遺伝子', UNIX_TIMESTAMP()),
+	(128, 127, '精神体制制御装置', UNIX_TIMESTAMP()),
+	(804, 1802, '創造力増幅器', UNIX_TIMESTAMP()),
+	(73, 5067, '視覚情報処理波動', UNIX_TIMESTAMP()),
+	(725, 6718, '伝達速度最適化', UNIX_TIMESTAMP()),
+	(884, 883, '磁気共鳴共振器', UNIX_TIMESTAMP()),
+	(194, 6187, '神経伝達電位増幅装置', UNIX_TIMESTAMP()),
+	(76, 6069, '空間抵抗計測器', UNIX_TIMESTAMP()),
+	(514, 513, '未知次元探査機', UNIX_TIMESTAMP()),
+	(732, 2729, '消滅現象防護装置', UNIX_TIMESTAMP()),
+	(861, 2858, '電波暗号解読装置', UNIX_TIMESTAMP()),
+	(654, 2651, '玄鳥通信体', UNIX_TIMESTAMP()),
+	(674, 5668, '光波粒子発生器', UNIX_TIMESTAMP()),
+	(186, 2183, '斯玛爾捷尺', UNIX_TIMESTAMP()),
+	(852, 4847, '経済調和指数', UNIX_TIMESTAMP()),
+	(786, 1784, '音波放射治療', UNIX_TIMESTAMP()),
+	(196, 3192, '星暴露分析', UNIX_TIMESTAMP()),
+	(444, 7436, '大気加速装置', UNIX_TIMESTAMP()),
+	(574, 1572, '触媒反応解析', UNIX_TIMESTAMP()),
+	(849, 5843, '電磁波消去装置', UNIX_TIMESTAMP()),
+	(520, 3516, '低温高出力反応', UNIX_TIMESTAMP()),
+	(388, 1386, '創造的進化戦略', UNIX_TIMESTAMP()),
+	(342, 6335, '睡眠覚醒補完装置', UNIX_TIMESTAMP()),
+	(128, 5122, '無重力状態', UNIX_TIMESTAMP()),
+	(211, 3207, '超光速通信', UNIX_TIMESTAMP()),
+	(390, 4385, '空中浮揚装置', UNIX_TIMESTAMP()),
+	(486, 2483, '反重力粒子', UNIX_TIMESTAMP()),
+	(399, 5393, '亜空間通信', UNIX_TIMESTAMP()),
+	(179, 178, '超常現象研究所', UNIX_TIMESTAMP()),
+	(21, 7013, '未来予知装置', UNIX_TIMESTAMP()),
+	(474, 7466, '超空間移動', UNIX_TIMESTAMP()),
+	(196, 6189, '超音速空中輸送', UNIX_TIMESTAMP()),
+	(135, 2132, '自己再生機能', UNIX_TIMESTAMP()),
+	(186, 7178, '魔力収束石', UNIX_TIMESTAMP()),
+	(130, 2127, '魔力注入器', UNIX_TIMESTAMP()),
+	(117, 4112, '悪魔収束盾', UNIX_TIMESTAMP()),
+	(559, 558, '操霊管制装置', UNIX_TIMESTAMP()),
+	(615, 3611, '磁気共鳴現象', UNIX_TIMESTAMP()),
+	(143, 6136, '織物質解析', UNIX_TIMESTAMP()),
+	(255, 4250, '密度波関数解析', UNIX_TIMESTAMP()),
+	(829, 6822, '核融合反応', UNIX_TIMESTAMP()),
+	(122, 1120, '粒子共鳴器', UNIX_TIMESTAMP()),
+	(131, 4126, '時空振動子', UNIX_TIMESTAMP()),
+	(358, 5352, '分子透明度', UNIX_TIMESTAMP()),
+	(630, 5624, '生体情報解析', UNIX_TIMESTAMP()),
+	(418, 4413, '合成植民地', UNIX_TIMESTAMP()),
+	(664, 1662, '音響認識器', UNIX_TIMESTAMP()),
+	(351, 7343, '超弦生成機', UNIX_TIMESTAMP()),
+	(447, 7439, '遺伝情報空間', UNIX_TIMESTAMP()),
+	(427, 6420, '光速測定計', UNIX_TIMESTAMP()),
+	(214, 4209, '發條蓄能器', UNIX_TIMESTAMP()),
+	(943, 3939, '離子注入器', UNIX_TIMESTAMP()),
+	(739, 738, '彼岸花廻誦', UNIX_TIMESTAMP()),
+	(910, 1908, '氷結黙示録', UNIX_TIMESTAMP()),
+	(315, 314, '蜃気楼迷宮', UNIX_TIMESTAMP()),
+	(900, 4895, '政府広報戦略', UNIX_TIMESTAMP()),
+	(147, 5141, '心転写装置', UNIX_TIMESTAMP()),
+	(710, 709, '陽電子制御', UNIX_TIMESTAMP()),
+	(620, 3616, '心理感応装置', UNIX_TIMESTAMP()),
+	(25, 6018, '遺伝子操作', UNIX_TIMESTAMP()),
+	(492, 491, '暗黒物質検出器', UNIX_TIMESTAMP()),
+	(441, 3437, '亜空間移動', UNIX_TIMESTAMP()),
+	(875, 3871, '細胞再生装置', UNIX_TIMESTAMP()),
+	(710, 2707, '心霊探査器', UNIX_TIMESTAMP()),
+	(818, 817, '線維合成体', UNIX_TIMESTAMP()),
+	(654, 1652, '誘電多重体', UNIX_TIMESTAMP()),
+	(370, 4365, '分子転写素', UNIX_TIMESTAMP()),
+	(208, 4203, '精神感温体', UNIX_TIMESTAMP()),
+	(504, 6497, '超微粒子固定体', UNIX_TIMESTAMP()),
+	(225, 6218, '機械再生血', UNIX_TIMESTAMP()),
+	(944, 1942, '高振動陽子', UNIX_TIMESTAMP()),
+	(383, 1381, '電磁伝導組織', UNIX_TIMESTAMP()),
+	(549, 5543, '状態量多胞体', UNIX_TIMESTAMP()),
+	(724, 6717, '逆炎電解合金', UNIX_TIMESTAMP()),
+	(367, 5361, '分子動力堆積体', UNIX_TIMESTAMP()),
+	(902, 1900, '環境適応力最適化', UNIX_TIMESTAMP()),
+	(898, 1896, '知識階層構造', UNIX_TIMESTAMP()),
+	(713, 5707, '創造的破壊', UNIX_TIMESTAMP()),
+	(465, 4460, '情報機密保護', UNIX_TIMESTAMP()),
+	(166, 3162, '王位継承戦', UNIX_TIMESTAMP()),
+	(536, 3532, '無重力領域', UNIX_TIMESTAMP()),
+	(840, 5834, '惑星間通信', UNIX_TIMESTAMP()),
+	(903, 4898, '時限後須満', UNIX_TIMESTAMP()),
+	(609, 608, '精神転写術', UNIX_TIMESTAMP()),
+	(759, 1757, '高次元物質', UNIX_TIMESTAMP()),
+	(746, 1744, '時間偏移装置', UNIX_TIMESTAMP()),
+	(102, 7094, '量子結晶化', UNIX_TIMESTAMP()),
+	(32, 3028, '超音波変換器', UNIX_TIMESTAMP()),
+	(612, 3608, '分子構造解析', UNIX_TIMESTAMP()),
+	(30, 2027, '素粒子加速器', UNIX_TIMESTAMP()),
+	(579, 578, '巨大無辺数列', UNIX_TIMESTAMP()),
+	(882, 3878, '光低温状態', UNIX_TIMESTAMP()),
+	(100, 1098, '地熱生成機構', UNIX_TIMESTAMP()),
+	(214, 1212, '分散受容体作用', UNIX_TIMESTAMP()),
+	(799, 6792, '指数消費者物価', UNIX_TIMESTAMP()),
+	(363, 2360, '酸化還元反応', UNIX_TIMESTAMP()),
+	(103, 2100, '最適化問題', UNIX_TIMESTAMP()),
+	(129, 3125, '大気抵抗係数', UNIX_TIMESTAMP()),
+	(511, 510, '潜在意識統合', UNIX_TIMESTAMP()),
+	(776, 775, '植物遺伝子組換', UNIX_TIMESTAMP()),
+	(357, 5351, '音空間設計', UNIX_TIMESTAMP()),
+	(968, 3964, '量子軌道制御', UNIX_TIMESTAMP()),
+	(361, 1359, '超音速加速器', UNIX_TIMESTAMP()),
+	(84, 1082, '絶対反射体', UNIX_TIMESTAMP()),
+	(453, 7445, '編集錯誤検出', UNIX_TIMESTAMP()),
+	(268, 2265, '脳波生物指標', UNIX_TIMESTAMP()),
+	(546, 1544, '宇宙社会系統', UNIX_TIMESTAMP()),
+	(622, 4617, '月鱗症候群', UNIX_TIMESTAMP()),
+	(893, 5887, '公的抵抗条項', UNIX_TIMESTAMP()),
+	(492, 3488, '遺伝情報匿名化', UNIX_TIMESTAMP()),
+	(36, 7028, '認知刺激効果', UNIX_TIMESTAMP()),
+	(452, 7444, '熱渦動平衡', UNIX_TIMESTAMP()),
+	(963, 4958, '形態感覚分析', UNIX_TIMESTAMP()),
+	(790, 1788, '地域協働推進', UNIX_TIMESTAMP()),
+	(607, 6600, '音象徴表現', UNIX_TIMESTAMP()),
+	(690, 5684, '年代時系列分析', UNIX_TIMESTAMP()),
+	(337, 5331, '時空相対意識', UNIX_TIMESTAMP()),
+	(477, 4472, '多元回帰分析', UNIX_TIMESTAMP()),
+	(904, 4899, '作物生産効率化', UNIX_TIMESTAMP()),
+	(14, 2011, '花粉媒介可視化', UNIX_TIMESTAMP()),
+	(90, 7082, '地球温暖化分析', UNIX_TIMESTAMP()),
+	(719, 4714, '文化適応研究', UNIX_TIMESTAMP()),
+	(9, 1007, '量子動態制御', UNIX_TIMESTAMP()),
+	(779, 4774, '心脳情報統合', UNIX_TIMESTAMP()),
+	(295, 6288, '音響孤立反射', UNIX_TIMESTAMP()),
+	(676, 5670, '宇宙微粒子探索器', UNIX_TIMESTAMP()),
+	(124, 3120, '応答評価視覚化', UNIX_TIMESTAMP()),
+	(989, 2986, '空中生物体語識別', UNIX_TIMESTAMP()),
+	(173, 6166, '生体動態経口認証', UNIX_TIMESTAMP()),
+	(289, 1287, '社会環境影響評価', UNIX_TIMESTAMP()),
+	(233, 5227, '非接触脳波観測', UNIX_TIMESTAMP()),
+	(889, 6882, '行動時間相関解析', UNIX_TIMESTAMP()),
+	(41, 2038, '認知神経調節装置', UNIX_TIMESTAMP()),
+	(917, 1915, '創作漢詩自動生成', UNIX_TIMESTAMP()),
+	(825, 4820, '超特殊高分子合成体', UNIX_TIMESTAMP()),
+	(576, 1574, '悪魔召喚術', UNIX_TIMESTAMP()),
+	(332, 6325, '超感知装置', UNIX_TIMESTAMP()),
+	(661, 5655, '神秘的研究所', UNIX_TIMESTAMP()),
+	(933, 1931, '高次元共鳴', UNIX_TIMESTAMP()),
+	(573, 5567, '魔力循環機構', UNIX_TIMESTAMP()),
+	(584, 4579, '聖遺物復元術', UNIX_TIMESTAMP()),
+	(43, 6036, '心象形成装置', UNIX_TIMESTAMP()),
+	(278, 5272, '未来予知鏡', UNIX_TIMESTAMP()),
+	(329, 7321, '反魔結界陣', UNIX_TIMESTAMP()),
+	(171, 1169, '触媒創成装置', UNIX_TIMESTAMP()),
+	(548, 4543, '剣闘技研究所', UNIX_TIMESTAMP()),
+	(811, 1809, '聖光発生器', UNIX_TIMESTAMP()),
+	(171, 4166, '星雲連鎖構造', UNIX_TIMESTAMP()),
+	(394, 6387, '心霊共鳴現象', UNIX_TIMESTAMP()),
+	(586, 3582, '反重力粒子', UNIX_TIMESTAMP()),
+	(979, 4974, '鮮烈視覚幻覚', UNIX_TIMESTAMP()),
+	(152, 6145, '無重力超伝導体', UNIX_TIMESTAMP()),
+	(340, 4335, '量子時空転移', UNIX_TIMESTAMP()),
+	(328, 4323, '神秘生体物質', UNIX_TIMESTAMP()),
+	(378, 3374, '天然賢者結晶', UNIX_TIMESTAMP()),
+	(543, 4538, '超音速磁気浮上航空機', UNIX_TIMESTAMP()),
+	(22, 4017, '時空転変装置', UNIX_TIMESTAMP()),
+	(582, 6575, '精神情報抽出術', UNIX_TIMESTAMP()),
+	(32, 31, '無限反射微光', UNIX_TIMESTAMP()),
+	(10, 2007, '宇宙創造方程式', UNIX_TIMESTAMP()),
+	(661, 2658, '超重力粒子加速器', UNIX_TIMESTAMP()),
+	(14, 4009, '音速念力術', UNIX_TIMESTAMP()),
+	(73, 4068, '神聖霊獣召喚術', UNIX_TIMESTAMP()),
+	(970, 969, '錬金術合成核', UNIX_TIMESTAMP()),
+	(13, 4008, '無限拡張次元', UNIX_TIMESTAMP()),
+	(185, 2182, '緩速時間共鳴装置', UNIX_TIMESTAMP()),
+	(487, 486, '精微霧化器', UNIX_TIMESTAMP()),
+	(510, 509, '形態逆評瑕', UNIX_TIMESTAMP()),
+	(338, 2335, '動力心製露', UNIX_TIMESTAMP()),
+	(614, 4609, '多元同像材', UNIX_TIMESTAMP()),
+	(336, 3332, '生物生成療晶', UNIX_TIMESTAMP()),
+	(700, 5694, '超振動磐芯', UNIX_TIMESTAMP()),
+	(198, 2195, '抗物深層針', UNIX_TIMESTAMP()),
+	(437, 7429, '機械構導弧', UNIX_TIMESTAMP()),
+	(971, 2968, '光子律測塵', UNIX_TIMESTAMP()),
+	(652, 4647, '音波建模演', UNIX_TIMESTAMP()),
+	(187, 1185, '熱感控苦晶', UNIX_TIMESTAMP()),
+	(144, 143, '義体情連巣', UNIX_TIMESTAMP()),
+	(313, 2310, '情報変距窓', UNIX_TIMESTAMP()),
+	(517, 5511, '量子位計濃', UNIX_TIMESTAMP()),
+	(571, 5565, '磁望奥神示', UNIX_TIMESTAMP()),
+	(117, 6110, '触觉洗脳剤', UNIX_TIMESTAMP()),
+	(524, 1522, '氷本曲温泉', UNIX_TIMESTAMP()),
+	(972, 5966, '臨時魔装具', UNIX_TIMESTAMP()),
+	(916, 3912, '無重力構造', UNIX_TIMESTAMP()),
+	(953, 952, '電磁波透視', UNIX_TIMESTAMP()),
+	(754, 5748, '歌詞解析機', UNIX_TIMESTAMP()),
+	(635, 634, '生体情報膜', UNIX_TIMESTAMP()),
+	(67, 5061, '内視鏡核磁気', UNIX_TIMESTAMP()),
+	(96, 5090, '臓器再生装置', UNIX_TIMESTAMP()),
+	(53, 3049, '植物遺伝子編集', UNIX_TIMESTAMP()),
+	(237, 4232, '農地生態系回復', UNIX_TIMESTAMP()),
+	(748, 3744, '高耐塩作物', UNIX_TIMESTAMP()),
+	(566, 5560, '有機農産物計測', UNIX_TIMESTAMP()),
+	(228, 6221, '潮力変換器', UNIX_TIMESTAMP()),
+	(143, 1141, '過充電防止素材', UNIX_TIMESTAMP()),
+	(911, 4906, '宇宙生態系維持装置', UNIX_TIMESTAMP()),
+	(139, 6132, '自動車還元', UNIX_TIMESTAMP()),
+	(317, 4312, '熱軌道制御', UNIX_TIMESTAMP()),
+	(173, 1171, '超縁子共鳴', UNIX_TIMESTAMP()),
+	(954, 5948, '誤長波電位', UNIX_TIMESTAMP()),
+	(469, 1467, '斑斬酸化物', UNIX_TIMESTAMP()),
+	(394, 5388, '非線形回帰解析', UNIX_TIMESTAMP()),
+	(599, 2596, '精細伝達関数', UNIX_TIMESTAMP()),
+	(909, 2906, '縮退銅酸化物', UNIX_TIMESTAMP()),
+	(985, 984, '無接触量子通信', UNIX_TIMESTAMP()),
+	(266, 265, '有機相電子材料', UNIX_TIMESTAMP()),
+	(467, 6460, '代謝経路分析', UNIX_TIMESTAMP()),
+	(541, 540, '熱分析多色測定機', UNIX_TIMESTAMP()),
+	(169, 3165, '水遠援装置', UNIX_TIMESTAMP()),
+	(906, 6899, '風眼測定器', UNIX_TIMESTAMP()),
+	(380, 1378, '半導体融合', UNIX_TIMESTAMP()),
+	(693, 6686, '生物電磁気', UNIX_TIMESTAMP()),
+	(83, 5077, '脳神経回路計算', UNIX_TIMESTAMP()),
+	(731, 6724, '影象塑造術', UNIX_TIMESTAMP()),
+	(300, 1298, '気遣偏向現象', UNIX_TIMESTAMP()),
+	(743, 742, '感覚融合装置', UNIX_TIMESTAMP()),
+	(271, 7263, '想念共鳴機構', UNIX_TIMESTAMP()),
+	(965, 2962, '知識抽出装置', UNIX_TIMESTAMP()),
+	(380, 5374, '観念波動加速器', UNIX_TIMESTAMP()),
+	(943, 1941, '自然発汗装置', UNIX_TIMESTAMP()),
+	(316, 5310, '放射熱前進', UNIX_TIMESTAMP()),
+	(525, 4520, '蒸気不平衡', UNIX_TIMESTAMP()),
+	(434, 6427, '連断層運移', UNIX_TIMESTAMP()),
+	(279, 7271, '不均相安定', UNIX_TIMESTAMP()),
+	(143, 6136, '日変点灑布', UNIX_TIMESTAMP()),
+	(983, 5977, '感潮量検知', UNIX_TIMESTAMP()),
+	(841, 840, '摩擦三次元', UNIX_TIMESTAMP()),
+	(163, 6156, '地下鉱脈探査', UNIX_TIMESTAMP()),
+	(400, 4395, '非晶質物質研究', UNIX_TIMESTAMP()),
+	(176, 1174, '潜在的欲求', UNIX_TIMESTAMP()),
+	(109, 108, '遺伝子組換', UNIX_TIMESTAMP()),
+	(935, 6928, '構造不平等', UNIX_TIMESTAMP()),
+	(66, 1064, '超音波探知', UNIX_TIMESTAMP()),
+	(105, 3101, '超次元通信', UNIX_TIMESTAMP()),
+	(513, 3509, '未知領域探査', UNIX_TIMESTAMP()),
+	(665, 2662, '直射光擬像', UNIX_TIMESTAMP()),
+	(334, 4329, '気流摩擦翼', UNIX_TIMESTAMP()),
+	(890, 3886, '丘陵調和器', UNIX_TIMESTAMP()),
+	(337, 336, '稲妻斬鬼剣', UNIX_TIMESTAMP()),
+	(201, 6194, '古代文明解読', UNIX_TIMESTAMP()),
+	(550, 5544, '原子融合炉', UNIX_TIMESTAMP()),
+	(728, 2725, '鳳凰花騎士団', UNIX_TIMESTAMP()),
+	(347, 3343, '太陽黎明計画', UNIX_TIMESTAMP()),
+	(901, 2898, '時空連環機関', UNIX_TIMESTAMP()),
+	(774, 3770, '龍虎門武術', UNIX_TIMESTAMP()),
+	(868, 2865, '幻想藝術院', UNIX_TIMESTAMP()),
+	(750, 6743, '探検家協会', UNIX_TIMESTAMP()),
+	(430, 429, '氷炎双獅刃', UNIX_TIMESTAMP()),
+	(689, 6682, '千里眼天文台', UNIX_TIMESTAMP()),
+	(886, 3882, '極限運動忍者', UNIX_TIMESTAMP()),
+	(597, 4592, '固体光計算機', UNIX_TIMESTAMP()),
+	(614, 4609, '風水風雲録', UNIX_TIMESTAMP()),
+	(607, 5601, '幽具封印術', UNIX_TIMESTAMP()),
+	(474, 6467, '煌妖七星刀', UNIX_TIMESTAMP()),
+	(363, 6356, '天地交換装置', UNIX_TIMESTAMP()),
+	(325, 7317, '時空翼装備', UNIX_TIMESTAMP()),
+	(713, 4708, '解読者秘術', UNIX_TIMESTAMP()),
+	(64, 5058, '魔神降臨薬', UNIX_TIMESTAMP()),
+	(123, 122, '天命占星術', UNIX_TIMESTAMP()),
+	(73, 6066, '情報干渉拡散装置', UNIX_TIMESTAMP()),
+	(225, 3221, '約束律機構', UNIX_TIMESTAMP()),
+	(773, 5767, '時間膜解析', UNIX_TIMESTAMP()),
+	(474, 5468, '文字顕微鏡', UNIX_TIMESTAMP()),
+	(134, 5128, '地球磁気逆転現象', UNIX_TIMESTAMP()),
+	(959, 5953, '情報生態系遷移', UNIX_TIMESTAMP()),
+	(134, 3130, '微生物共生点', UNIX_TIMESTAMP()),
+	(166, 2163, '知覚反転現象', UNIX_TIMESTAMP()),
+	(703, 4698, '細胞酸化促進剤', UNIX_TIMESTAMP()),
+	(949, 5943, '衛星軌道補正場', UNIX_TIMESTAMP()),
+	(716, 5710, '動態芳香剤生成装置', UNIX_TIMESTAMP()),
+	(820, 3816, '浮力微量解析', UNIX_TIMESTAMP()),
+	(77, 2074, '逆強力媒染装置', UNIX_TIMESTAMP()),
+	(578, 577, '自律可塑機構', UNIX_TIMESTAMP()),
+	(695, 2692, '界面引力解放効果', UNIX_TIMESTAMP()),
+	(993, 4988, '眼球瞬膜化機構', UNIX_TIMESTAMP()),
+	(514, 5508, '飛行体融合軌道', UNIX_TIMESTAMP()),
+	(316, 7308, '宇宙駅置換機', UNIX_TIMESTAMP()),
+	(213, 5207, '超音速液体', UNIX_TIMESTAMP()),
+	(170, 1168, '仮想生命体', UNIX_TIMESTAMP()),
+	(428, 2425, '半永久加速器', UNIX_TIMESTAMP()),
+	(693, 6686, '超次元解読器', UNIX_TIMESTAMP()),
+	(220, 3216, '心霊現象検知機', UNIX_TIMESTAMP()),
+	(144, 5138, '真理網検索', UNIX_TIMESTAMP()),
+	(92, 6085, '森林生態模擬', UNIX_TIMESTAMP()),
+	(514, 2511, '神秘的聖域', UNIX_TIMESTAMP()),
+	(229, 7221, '聖騎士団長', UNIX_TIMESTAMP()),
+	(217, 2214, '聖堂守護者', UNIX_TIMESTAMP()),
+	(180, 6173, '融合電子心技', UNIX_TIMESTAMP()),
+	(576, 6569, '無線位相制御', UNIX_TIMESTAMP()),
+	(435, 6428, '量子結合化', UNIX_TIMESTAMP()),
+	(545, 3541, '視覚領域拡張', UNIX_TIMESTAMP()),
+	(174, 5168, '超電導振動子', UNIX_TIMESTAMP()),
+	(789, 5783, '分子原子透過', UNIX_TIMESTAMP()),
+	(12, 5006, '社会想像力', UNIX_TIMESTAMP()),
+	(859, 6852, '磁場浮力制御', UNIX_TIMESTAMP()),
+	(380, 2377, '空気質断熱', UNIX_TIMESTAMP()),
+	(552, 4547, '光子計算応用', UNIX_TIMESTAMP()),
+	(719, 4714, '断熱融合冷媒', UNIX_TIMESTAMP()),
+	(503, 1501, '拡散方程式', UNIX_TIMESTAMP()),
+	(497, 1495, '遺伝型情報回路', UNIX_TIMESTAMP()),
+	(46, 5040, '高密度分散剤', UNIX_TIMESTAMP()),
+	(346, 345, '輻輳路網最適化', UNIX_TIMESTAMP()),
+	(278, 6271, '焦点誘導結晶', UNIX_TIMESTAMP()),
+	(260, 259, '飛蚊症影響評価', UNIX_TIMESTAMP()),
+	(835, 6828, '腐敗双子生成', UNIX_TIMESTAMP()),
+	(64, 3060, '熱平衡転移', UNIX_TIMESTAMP()),
+	(846, 1844, '暗号周波数分析', UNIX_TIMESTAMP()),
+	(907, 1905, '微細化取引記録', UNIX_TIMESTAMP()),
+	(451, 5445, '仮説推進解析', UNIX_TIMESTAMP()),
+	(282, 2279, '非線型応用統合', UNIX_TIMESTAMP()),
+	(549, 2546, '空中粒子体制衛生', UNIX_TIMESTAMP()),
+	(980, 1978, '分子基進化製造', UNIX_TIMESTAMP()),
+	(871, 6864, '波動媒体安定化自律', UNIX_TIMESTAMP()),
+	(49, 4044, '構造感覚適合操縦', UNIX_TIMESTAMP()),
+	(377, 7369, '自律知覚反応制御', UNIX_TIMESTAMP()),
+	(752, 5746, '遺伝体情報処理', UNIX_TIMESTAMP()),
+	(109, 1107, '共鳴意識拡張', UNIX_TIMESTAMP()),
+	(681, 6674, '量子加速環境', UNIX_TIMESTAMP()),
+	(91, 5085, '遺伝子進化監視', UNIX_TIMESTAMP()),
+	(235, 6228, '反作用物理軌跡', UNIX_TIMESTAMP()),
+	(668, 2665, '音響連続解釈', UNIX_TIMESTAMP()),
+	(56, 5050, '国際宇宙移住', UNIX_TIMESTAMP()),
+	(794, 4789, '無相電磁共鳴', UNIX_TIMESTAMP()),
+	(167, 5161, '記憶化物質', UNIX_TIMESTAMP()),
+	(932, 931, '真空網構造体', UNIX_TIMESTAMP()),
+	(24, 7016, '量子遊離反応', UNIX_TIMESTAMP()),
+	(377, 5371, '物質加速装置', UNIX_TIMESTAMP()),
+	(18, 1016, '音楽情報解析', UNIX_TIMESTAMP()),
+	(512, 6505, '亜空間認識', UNIX_TIMESTAMP()),
+	(184, 7176, '潜在覚醒応答', UNIX_TIMESTAMP()),
+	(644, 6637, '基底情報網絡', UNIX_TIMESTAMP()),
+	(503, 502, '心理生体計測', UNIX_TIMESTAMP()),
+	(509, 508, '音波共振化機構', UNIX_TIMESTAMP()),
+	(626, 625, '逐次実体化', UNIX_TIMESTAMP()),
+	(274, 3270, '脳電複合画像', UNIX_TIMESTAMP()),
+	(880, 6873, '降水量予測', UNIX_TIMESTAMP()),
+	(551, 1549, '遺伝子編集', UNIX_TIMESTAMP()),
+	(991, 2988, '種子発芽率', UNIX_TIMESTAMP()),
+	(4, 6996, '生産感知指数', UNIX_TIMESTAMP()),
+	(567, 5561, '投資利益率', UNIX_TIMESTAMP()),
+	(205, 2202, '労働満足度', UNIX_TIMESTAMP()),
+	(986, 3982, '感情認識指標', UNIX_TIMESTAMP()),
+	(332, 5326, '認知負荷評価', UNIX_TIMESTAMP()),
+	(741, 3737, '知覚位置乖離', UNIX_TIMESTAMP()),
+	(185, 2182, '疾病抑制率', UNIX_TIMESTAMP()),
+	(248, 6241, '症状重症度指数', UNIX_TIMESTAMP()),
+	(689, 6682, '治療効果予測', UNIX_TIMESTAMP()),
+	(173, 4168, '健康不平等指数', UNIX_TIMESTAMP()),
+	(79, 3075, '社会支援評価', UNIX_TIMESTAMP()),
+	(299, 5293, '多重回帰分析', UNIX_TIMESTAMP()),
+	(843, 1841, '分布適合度', UNIX_TIMESTAMP()),
+	(162, 2159, '帰無仮説棄却', UNIX_TIMESTAMP()),
+	(626, 625, '生態系安定度', UNIX_TIMESTAMP()),
+	(451, 3447, '環境影響評価', UNIX_TIMESTAMP()),
+	(220, 6213, '晶器化融合', UNIX_TIMESTAMP()),
+	(11, 1009, '錬金術研究', UNIX_TIMESTAMP()),
+	(970, 1968, '天体赤外線', UNIX_TIMESTAMP()),
+	(862, 2859, '分子構造推定', UNIX_TIMESTAMP()),
+	(189, 2186, '切削力解析', UNIX_TIMESTAMP()),
+	(313, 5307, '電気走査顕微鏡', UNIX_TIMESTAMP()),
+	(250, 1248, '心震定量化', UNIX_TIMESTAMP()),
+	(105, 6098, '環境適応遺伝子', UNIX_TIMESTAMP()),
+	(130, 7122, '無重力航行', UNIX_TIMESTAMP()),
+	(150, 5144, '量子暗号化', UNIX_TIMESTAMP()),
+	(535, 4530, '超感覚覚醒', UNIX_TIMESTAMP()),
+	(156, 3152, '神経回路解析', UNIX_TIMESTAMP()),
+	(870, 869, '超文明通信', UNIX_TIMESTAMP()),
+	(902, 2899, '神次元制御', UNIX_TIMESTAMP()),
+	(979, 978, '超音速航空', UNIX_TIMESTAMP()),
+	(278, 3274, '透過亜空間', UNIX_TIMESTAMP()),
+	(832, 4827, '基因融合装置', UNIX_TIMESTAMP()),
+	(446, 1444, '記憶濃縮器', UNIX_TIMESTAMP()),
+	(303, 302, '精神透写装置', UNIX_TIMESTAMP()),
+	(391, 6384, '火星連結通信', UNIX_TIMESTAMP()),
+	(639, 5633, '量子空間探査衛星', UNIX_TIMESTAMP()),
+	(232, 2229, '月面耕作機', UNIX_TIMESTAMP()),
+	(230, 229, '超光速推進器', UNIX_TIMESTAMP()),
+	(556, 5550, '量子光通信網', UNIX_TIMESTAMP()),
+	(632, 3628, '太陽光発電風車', UNIX_TIMESTAMP()),
+	(514, 1512, '宇宙微生物研究所', UNIX_TIMESTAMP()),
+	(578, 5572, '電子的感情共感', UNIX_TIMESTAMP()),
+	(216, 4211, '時空瞬移術', UNIX_TIMESTAMP()),
+	(42, 5036, '高速運転心理', UNIX_TIMESTAMP()),
+	(404, 5398, '亜空間通信', UNIX_TIMESTAMP()),
+	(288, 287, '星間鑑定士', UNIX_TIMESTAMP()),
+	(877, 3873, '不夜城浸食', UNIX_TIMESTAMP()),
+	(791, 3787, '超能力開発講座', UNIX_TIMESTAMP()),
+	(519, 2516, '心的瞬間移動', UNIX_TIMESTAMP()),
+	(760, 1758, '経絡透析治療', UNIX_TIMESTAMP()),
+	(937, 936, '心霊抽象美術', UNIX_TIMESTAMP()),
+	(813, 812, '催眠催眠術', UNIX_TIMESTAMP()),
+	(642, 5636, '超現実体験', UNIX_TIMESTAMP()),
+	(350, 349, '脳神経延髄', UNIX_TIMESTAMP()),
+	(479, 7471, '微生物進化', UNIX_TIMESTAMP()),
+	(237, 7229, '音楽波影響', UNIX_TIMESTAMP()),
+	(805, 4800, '金属分子構造', UNIX_TIMESTAMP()),
+	(925, 1923, '電気反射率', UNIX_TIMESTAMP()),
+	(444, 1442, '宇宙重力場', UNIX_TIMESTAMP()),
+	(752, 2749, '愛猫血統書', UNIX_TIMESTAMP()),
+	(203, 6196, '交通行理學', UNIX_TIMESTAMP()),
+	(481, 4476, '曼荼羅構造', UNIX_TIMESTAMP()),
+	(937, 2934, '蒸気閥制御機', UNIX_TIMESTAMP()),
+	(834, 1832, '飛行操縦装置', UNIX_TIMESTAMP()),
+	(644, 3640, '古代美術史', UNIX_TIMESTAMP()),
+	(111, 1109, '風力制御装置', UNIX_TIMESTAMP()),
+	(253, 1251, '震源探査装置', UNIX_TIMESTAMP()),
+	(912, 4907, '多次元抽象', UNIX_TIMESTAMP()),
+	(759, 758, '逆説的思考', UNIX_TIMESTAMP()),
+	(840, 6833, '社会集合体', UNIX_TIMESTAMP()),
+	(607, 3603, '発展的進化', UNIX_TIMESTAMP()),
+	(725, 4720, '積極的転換', UNIX_TIMESTAMP()),
+	(167, 1165, '社会的動態', UNIX_TIMESTAMP()),
+	(356, 355, '概念再定義', UNIX_TIMESTAMP()),
+	(615, 5609, '微生物相関図', UNIX_TIMESTAMP()),
+	(209, 208, '真空蒸留装置', UNIX_TIMESTAMP()),
+	(583, 1581, '宇宙時空計測器', UNIX_TIMESTAMP()),
+	(349, 5343, '音楽分析感情指標', UNIX_TIMESTAMP()),
+	(377, 1375, '古代言語再構築', UNIX_TIMESTAMP()),
+	(597, 596, '高速遺伝子編集器', UNIX_TIMESTAMP()),
+	(491, 7483, '霊波制御術', UNIX_TIMESTAMP()),
+	(167, 6160, '風熱感応装置', UNIX_TIMESTAMP()),
+	(837, 6830, '磁気共鳴贈能域', UNIX_TIMESTAMP()),
+	(771, 6764, '超次元時空膜', UNIX_TIMESTAMP()),
+	(937, 3933, '飛行炉心増感材料', UNIX_TIMESTAMP()),
+	(207, 5201, '生体組織相溶媒', UNIX_TIMESTAMP()),
+	(132, 5126, '心理観測機', UNIX_TIMESTAMP()),
+	(762, 761, '地球回転遮断機構', UNIX_TIMESTAMP()),
+	(499, 1497, '有機光子振動子', UNIX_TIMESTAMP()),
+	(770, 2767, '蓄電率増進硝化物', UNIX_TIMESTAMP()),
+	(370, 369, '天体圏安定化装置', UNIX_TIMESTAMP()),
+	(77, 76, '微細粒子放射線治療', UNIX_TIMESTAMP()),
+	(638, 1636, '音響分子制御装置', UNIX_TIMESTAMP()),
+	(229, 2226, '高分子触媒開発', UNIX_TIMESTAMP()),
+	(723, 3719, '暗黒物質検出装置', UNIX_TIMESTAMP()),
+	(212, 7204, '無軌道瞬閃', UNIX_TIMESTAMP()),
+	(425, 6418, '量子転送機', UNIX_TIMESTAMP()),
+	(682, 6675, '非対称共有', UNIX_TIMESTAMP()),
+	(821, 820, '逆調査路面', UNIX_TIMESTAMP()),
+	(471, 4466, '高次方程式', UNIX_TIMESTAMP()),
+	(231, 1229, '交互反転効果', UNIX_TIMESTAMP()),
+	(350, 2347, '超光速推進', UNIX_TIMESTAMP()),
+	(216, 2213, '概念意識網', UNIX_TIMESTAMP()),
+	(980, 1978, '探索支持率', UNIX_TIMESTAMP()),
+	(8, 2005, '摩天楼航路', UNIX_TIMESTAMP()),
+	(333, 6326, '宇宙膨張力', UNIX_TIMESTAMP()),
+	(550, 549, '快速摩擦温度', UNIX_TIMESTAMP()),
+	(223, 6216, '生体適応度', UNIX_TIMESTAMP()),
+	(45, 7037, '構造相互作用力', UNIX_TIMESTAMP()),
+	(734, 733, '水晶調和振動数', UNIX_TIMESTAMP()),
+	(576, 3572, '磁場分散係数', UNIX_TIMESTAMP()),
+	(238, 3234, '暗黙的知識共有', UNIX_TIMESTAMP()),
+	(877, 3873, '動的環境適応', UNIX_TIMESTAMP()),
+	(970, 5964, '高圧尖頭放電', UNIX_TIMESTAMP()),
+	(748, 747, '抽象顕微鏡像', UNIX_TIMESTAMP()),
+	(756, 5750, '熱帯低気圧', UNIX_TIMESTAMP()),
+	(37, 4032, '共鳴解離運動', UNIX_TIMESTAMP()),
+	(257, 7249, '雲粒凝結核', UNIX_TIMESTAMP()),
+	(957, 6950, '音楽認知症研究', UNIX_TIMESTAMP()),
+	(465, 464, '宇宙疾患予防装置', UNIX_TIMESTAMP()),
+	(853, 3849, '量子加速器', UNIX_TIMESTAMP()),
+	(257, 7249, '超伝導磁気推進', UNIX_TIMESTAMP()),
+	(469, 1467, '遺伝子編集機械', UNIX_TIMESTAMP()),
+	(565, 4560, '量子知覚計測装置', UNIX_TIMESTAMP()),
+	(12, 11, '超音速航空機潜望鏡', UNIX_TIMESTAMP()),
+	(357, 6350, '携帯型時間歪曲装置', UNIX_TIMESTAMP()),
+	(110, 1108, '水酸化効果発電機', UNIX_TIMESTAMP()),
+	(77, 2074, '情報気泡術', UNIX_TIMESTAMP()),
+	(445, 4440, '認識共振体', UNIX_TIMESTAMP()),
+	(362, 2359, '知識連鎖網', UNIX_TIMESTAMP()),
+	(524, 4519, '経験捉槽検', UNIX_TIMESTAMP()),
+	(943, 2940, '意味語彙体系', UNIX_TIMESTAMP()),
+	(747, 2744, '体験軌跡統計', UNIX_TIMESTAMP()),
+	(866, 6859, '参照連鎖機構', UNIX_TIMESTAMP()),
+	(354, 7346, '意義体験図表', UNIX_TIMESTAMP()),
+	(704, 703, '心拍推定機', UNIX_TIMESTAMP()),
+	(890, 6883, '熱動力計測', UNIX_TIMESTAMP()),
+	(532, 4527, '課外合唱団', UNIX_TIMESTAMP()),
+	(322, 3318, '星界連携機', UNIX_TIMESTAMP()),
+	(153, 3149, '統合認識装置', UNIX_TIMESTAMP()),
+	(736, 6729, '緊急抑制器', UNIX_TIMESTAMP()),
+	(143, 4138, '水系評価指標', UNIX_TIMESTAMP()),
+	(328, 3324, '形態凝集度', UNIX_TIMESTAMP()),
+	(347, 1345, '空間化脳描画', UNIX_TIMESTAMP()),
+	(822, 4817, '観測誤差分析', UNIX_TIMESTAMP()),
+	(340, 2337, '時間解析統計', UNIX_TIMESTAMP()),
+	(294, 2291, '無色視力測定', UNIX_TIMESTAMP()),
+	(731, 2728, '環境音響計測', UNIX_TIMESTAMP()),
+	(364, 2361, '自動運転指令器', UNIX_TIMESTAMP()),
+	(432, 3428, '行動統制制御', UNIX_TIMESTAMP()),
+	(932, 931, '認識処理検証', UNIX_TIMESTAMP()),
+	(928, 6921, '非線形回帰解析', UNIX_TIMESTAMP()),
+	(586, 5580, '視覚情報圧縮', UNIX_TIMESTAMP()),
+	(245, 3241, '制度融合戦略', UNIX_TIMESTAMP()),
+	(320, 319, '空中物体認識', UNIX_TIMESTAMP()),
+	(864, 1862, '制御系応答解析', UNIX_TIMESTAMP()),
+	(800, 799, '内部冷温機能', UNIX_TIMESTAMP()),
+	(877, 4872, '反力駆動装置', UNIX_TIMESTAMP()),
+	(845, 5839, '経済総合指数', UNIX_TIMESTAMP()),
+	(426, 2423, '時空歪曲装置', UNIX_TIMESTAMP()),
+	(475, 3471, '密度分析機', UNIX_TIMESTAMP()),
+	(382, 4377, '記憶遺伝子符号', UNIX_TIMESTAMP()),
+	(431, 4426, '気象安定装置', UNIX_TIMESTAMP()),
+	(297, 1295, '細胞再生装置', UNIX_TIMESTAMP()),
+	(761, 5755, '魔力調節装置', UNIX_TIMESTAMP()),
+	(493, 492, '音波解析装置', UNIX_TIMESTAMP()),
+	(982, 1980, '進化加速装置', UNIX_TIMESTAMP()),
+	(855, 6848, '知能拡張装置', UNIX_TIMESTAMP()),
+	(17, 6010, '致命傷回復被膜', UNIX_TIMESTAMP()),
+	(286, 285, '電子幻影増幅器', UNIX_TIMESTAMP()),
+	(152, 3148, '時間断片再生機', UNIX_TIMESTAMP()),
+	(458, 3454, '知覚領域拡張装置', UNIX_TIMESTAMP()),
+	(91, 4086, '身体能力増強装備', UNIX_TIMESTAMP()),
+	(40, 5034, '精神透視装置', UNIX_TIMESTAMP()),
+	(758, 3754, '生体遺伝情報', UNIX_TIMESTAMP()),
+	(976, 6969, '心霊共鳴装置', UNIX_TIMESTAMP()),
+	(131, 6124, '思考波動制御', UNIX_TIMESTAMP()),
+	(268, 1266, '次元転移装置', UNIX_TIMESTAMP()),
+	(411, 2408, '平行世界解析器', UNIX_TIMESTAMP()),
+	(582, 5576, '非可逆時間膨張状態', UNIX_TIMESTAMP()),
+	(20, 5014, '宇宙物質相関解析', UNIX_TIMESTAMP()),
+	(719, 1717, '超現実領域探知', UNIX_TIMESTAMP()),
+	(493, 1491, '超感覚知覚計測器', UNIX_TIMESTAMP()),
+	(489, 3485, '光子加速反応器', UNIX_TIMESTAMP()),
+	(319, 5313, '未知素粒子探査機', UNIX_TIMESTAMP()),
+	(502, 2499, '未知宇宙生命探知機', UNIX_TIMESTAMP()),
+	(147, 146, '再生演算子', UNIX_TIMESTAMP()),
+	(847, 846, '不確定係数', UNIX_TIMESTAMP()),
+	(643, 642, '比熱増加率', UNIX_TIMESTAMP()),
+	(422, 3418, '金利相場関係係数', UNIX_TIMESTAMP()),
+	(833, 832, '天川治元特産顕染表', UNIX_TIMESTAMP()),
+	(402, 6395, '伝承遺資欄潟化', UNIX_TIMESTAMP()),
+	(941, 1939, '応用心理橋', UNIX_TIMESTAMP()),
+	(293, 6286, '電子音干溶島', UNIX_TIMESTAMP()),
+	(780, 4775, '双憲独硬律原', UNIX_TIMESTAMP()),
+	(563, 1561, '宇宙生擬動財部', UNIX_TIMESTAMP()),
+	(925, 3921, '名用排設液均泉', UNIX_TIMESTAMP()),
+	(377, 1375, '植林陽立札染兵', UNIX_TIMESTAMP()),
+	(900, 5894, '金冠穂保電圧範予', UNIX_TIMESTAMP()),
+	(9, 4004, '軟膏対針変者船', UNIX_TIMESTAMP()),
+	(6, 5, '鉄螺跡標類理文県', UNIX_TIMESTAMP()),
+	(370, 3366, '心理聴強都傳庶床', UNIX_TIMESTAMP()),
+	(860, 6853, '筆灸書囲違勾鏡手', UNIX_TIMESTAMP()),
+	(942, 2939, '地方除石警塔協連', UNIX_TIMESTAMP()),
+	(434, 1432, '育隙唇全濃包非参連', UNIX_TIMESTAMP()),
+	(225, 7217, '描神械食詩扉監世', UNIX_TIMESTAMP()),
+	(313, 2310, '解剖逆礎成前陳袋', UNIX_TIMESTAMP()),
+	(164, 4159, '麺豆公糸家司市容', UNIX_TIMESTAMP()),
+	(787, 3783, '鉄構造双螺旋', UNIX_TIMESTAMP()),
+	(132, 2129, '無重量変換石', UNIX_TIMESTAMP()),
+	(824, 2821, '高能粒子砲', UNIX_TIMESTAMP()),
+	(885, 4880, '微細蛇腹線路', UNIX_TIMESTAMP()),
+	(805, 4800, '残骸分割機', UNIX_TIMESTAMP()),
+	(145, 7137, '勾玉石英板', UNIX_TIMESTAMP()),
+	(3, 2, '天宮秘書群', UNIX_TIMESTAMP()),
+	(980, 3976, '牧田回路器', UNIX_TIMESTAMP()),
+	(367, 5361, '応答傾聴装置', UNIX_TIMESTAMP()),
+	(736, 3732, '地理測量術', UNIX_TIMESTAMP()),
+	(796, 3792, '美術史研究', UNIX_TIMESTAMP()),
+	(354, 2351, '展示会企画', UNIX_TIMESTAMP()),
+	(685, 1683, '料理研究会', UNIX_TIMESTAMP()),
+	(613, 3609, '量子遺伝情報解析', UNIX_TIMESTAMP()),
+	(567, 4562, '精神能力拡張装置', UNIX_TIMESTAMP()),
+	(867, 866, '空間反射光除去装置', UNIX_TIMESTAMP()),
+	(14, 4009, '核融合制御反応器', UNIX_TIMESTAMP()),
+	(483, 4478, '窒素固定植物栽培装置', UNIX_TIMESTAMP()),
+	(653, 1651, '脳波集中応用装置', UNIX_TIMESTAMP()),
+	(722, 4717, '航空測量衛星', UNIX_TIMESTAMP()),
+	(207, 1205, '農業適地判定', UNIX_TIMESTAMP()),
+	(894, 6887, '宇宙浄化機', UNIX_TIMESTAMP()),
+	(49, 3045, '大脳解析装置', UNIX_TIMESTAMP()),
+	(385, 3381, '鉄道観光列車', UNIX_TIMESTAMP()),
+	(798, 5792, '衛星花火装置', UNIX_TIMESTAMP()),
+	(987, 4982, '石油解析機器', UNIX_TIMESTAMP()),
+	(343, 7335, '高速飛行体', UNIX_TIMESTAMP()),
+	(428, 5422, '音波治療装置', UNIX_TIMESTAMP()),
+	(6, 6998, '自走洗浄機', UNIX_TIMESTAMP()),
+	(325, 7317, '反射鏡映装置', UNIX_TIMESTAMP()),
+	(680, 3676, '溶岩天気予報', UNIX_TIMESTAMP()),
+	(492, 491, '主観的時間計測', UNIX_TIMESTAMP()),
+	(40, 1038, '考古顕微鏡', UNIX_TIMESTAMP()),
+	(763, 6756, '晶量子構造', UNIX_TIMESTAMP()),
+	(785, 1783, '融合加速器', UNIX_TIMESTAMP()),
+	(117, 6110, '微粒子酵素', UNIX_TIMESTAMP()),
+	(584, 1582, '海霧感知装置', UNIX_TIMESTAMP()),
+	(531, 6524, '遠隔感強化', UNIX_TIMESTAMP()),
+	(915, 5909, '陵地蒸発計', UNIX_TIMESTAMP()),
+	(291, 6284, '膨張反射素子', UNIX_TIMESTAMP()),
+	(128, 5122, '刺突抑制装置', UNIX_TIMESTAMP()),
+	(451, 450, '高次元変換器', UNIX_TIMESTAMP()),
+	(927, 3923, '知識定着装置', UNIX_TIMESTAMP()),
+	(441, 1439, '磁気分極器', UNIX_TIMESTAMP()),
+	(153, 7145, '映像生成機', UNIX_TIMESTAMP()),
+	(483, 1481, '糸状探査器', UNIX_TIMESTAMP()),
+	(521, 2518, '視覚化補完装置', UNIX_TIMESTAMP()),
+	(496, 1494, '全身反応改善装置', UNIX_TIMESTAMP()),
+	(46, 45, '機械音楽素子', UNIX_TIMESTAMP()),
+	(814, 6807, '脳内感受器', UNIX_TIMESTAMP()),
+	(896, 5890, '水平気流効果装置', UNIX_TIMESTAMP()),
+	(682, 5676, '高速振動板', UNIX_TIMESTAMP()),
+	(308, 1306, '欠陥材料検知器', UNIX_TIMESTAMP()),
+	(798, 5792, '表面局在包含体', UNIX_TIMESTAMP()),
+	(599, 2596, '赤外放射熱分析', UNIX_TIMESTAMP()),
+	(323, 1321, '斜方遠心力反転', UNIX_TIMESTAMP()),
+	(905, 2902, '組織生存戦略', UNIX_TIMESTAMP()),
+	(84, 2081, '精神感応装置', UNIX_TIMESTAMP()),
+	(856, 855, '均質反射素材', UNIX_TIMESTAMP()),
+	(288, 4283, '分子合成反応器', UNIX_TIMESTAMP()),
+	(711, 710, '時空間補正装置', UNIX_TIMESTAMP()),
+	(942, 1940, '反物質電源装置', UNIX_TIMESTAMP()),
+	(532, 5526, '乱気流制御装置', UNIX_TIMESTAMP()),
+	(375, 5369, '超伝導物質生成装置', UNIX_TIMESTAMP()),
+	(100, 6093, '言語無効化装置', UNIX_TIMESTAMP()),
+	(579, 578, '脳波制御機器', UNIX_TIMESTAMP()),
+	(994, 3990, '時空間補正装置', UNIX_TIMESTAMP()),
+	(908, 1906, '量子加速装置', UNIX_TIMESTAMP()),
+	(89, 1087, '生体情報解析装置', UNIX_TIMESTAMP()),
+	(627, 3623, '磁場位相推測器', UNIX_TIMESTAMP()),
+	(463, 7455, '粒子破壊装置', UNIX_TIMESTAMP()),
+	(885, 5879, '心理復元装置', UNIX_TIMESTAMP()),
+	(400, 7392, '錯体分析装置', UNIX_TIMESTAMP()),
+	(134, 7126, '量子情報増幅装置', UNIX_TIMESTAMP()),
+	(375, 7367, '星雲宇宙膨張説', UNIX_TIMESTAMP()),
+	(374, 1372, '金剛力士火怨', UNIX_TIMESTAMP()),
+	(649, 2646, '良世天上五色玉', UNIX_TIMESTAMP()),
+	(347, 2344, '経塚和合土遷命', UNIX_TIMESTAMP()),
+	(504, 5498, '神楽舞浦島坂道', UNIX_TIMESTAMP()),
+	(512, 5506, '夜光鳥森羅風解', UNIX_TIMESTAMP()),
+	(62, 4057, '令和菩薩仏印相', UNIX_TIMESTAMP()),
+	(265, 2262, '水鏡八角魔獣殺', UNIX_TIMESTAMP()),
+	(26, 5020, '炎神光葵紅玉裂', UNIX_TIMESTAMP()),
+	(374, 5368, '月光仙境天地連', UNIX_TIMESTAMP()),
+	(165, 164, '風雲天翔恵比寿', UNIX_TIMESTAMP()),
+	(406, 7398, '天地太極羅域乾', UNIX_TIMESTAMP()),
+	(46, 2043, '覇羅魔音生征滅', UNIX_TIMESTAMP()),
+	(600, 2597, '蒼穹領域和合天', UNIX_TIMESTAMP()),
+	(669, 1667, '暁天白羽精霊舞', UNIX_TIMESTAMP()),
+	(165, 3161, '玉蘂奔馬羅刹降', UNIX_TIMESTAMP()),
+	(626, 3622, '経済南方錯', UNIX_TIMESTAMP()),
+	(523, 5517, '心理渦巻帯', UNIX_TIMESTAMP()),
+	(462, 3458, '建築着地点', UNIX_TIMESTAMP()),
+	(701, 3697, '政治秋山勢', UNIX_TIMESTAMP()),
+	(400, 5394, '経営縄跳遠', UNIX_TIMESTAMP()),
+	(767, 4762, '軍事象徴彩', UNIX_TIMESTAMP()),
+	(674, 2671, '環境美形翼', UNIX_TIMESTAMP()),
+	(43, 5037, '医療黒帯戈', UNIX_TIMESTAMP()),
+	(455, 3451, '芸術乱数双', UNIX_TIMESTAMP()),
+	(894, 2891, '音楽空飛組', UNIX_TIMESTAMP()),
+	(493, 4488, '農業獅子天', UNIX_TIMESTAMP()),
+	(857, 6850, '食品幸受菱', UNIX_TIMESTAMP()),
+	(38, 7030, '衣料真実直', UNIX_TIMESTAMP()),
+	(709, 2706, '住宅真強柱', UNIX_TIMESTAMP()),
+	(15, 14, '観光眼鏡赤', UNIX_TIMESTAMP()),
+	(57, 2054, '潜騒動解析', UNIX_TIMESTAMP()),
+	(823, 822, '情報抽出器', UNIX_TIMESTAMP()),
+	(660, 1658, '精神分析器', UNIX_TIMESTAMP()),
+	(399, 4394, '音響計測機器', UNIX_TIMESTAMP()),
+	(751, 4746, '顕微撮像装置', UNIX_TIMESTAMP()),
+	(150, 1148, '円形移動制御器', UNIX_TIMESTAMP()),
+	(433, 2430, '秘密暗号生成器', UNIX_TIMESTAMP()),
+	(963, 3959, '空間認識眼', UNIX_TIMESTAMP()),
+	(765, 6758, '精神干渉器', UNIX_TIMESTAMP()),
+	(844, 5838, '超固相材料', UNIX_TIMESTAMP()),
+	(200, 199, '生物接続経路', UNIX_TIMESTAMP()),
+	(84, 4079, '時間分配器', UNIX_TIMESTAMP()),
+	(558, 4553, '双方向大気制御機', UNIX_TIMESTAMP()),
+	(844, 2841, '音速吸収体', UNIX_TIMESTAMP()),
+	(163, 7155, '隠密循環装置', UNIX_TIMESTAMP()),
+	(595, 4590, '細胞分割因子', UNIX_TIMESTAMP()),
+	(415, 3411, '死力帯電器', UNIX_TIMESTAMP()),
+	(244, 4239, '共振安定器', UNIX_TIMESTAMP()),
+	(229, 7221, '小型重力発生器', UNIX_TIMESTAMP()),
+	(803, 4798, '声波固定装置', UNIX_TIMESTAMP()),
+	(453, 1451, '超微動磁子', UNIX_TIMESTAMP()),
+	(539, 6532, '静電干渉装置', UNIX_TIMESTAMP()),
+	(569, 568, '光子波導合金', UNIX_TIMESTAMP()),
+	(122, 4117, '非可逆相互作用', UNIX_TIMESTAMP()),
+	(384, 3380, '空間再構成装置', UNIX_TIMESTAMP()),
+	(443, 442, '生物進化抑制剤', UNIX_TIMESTAMP()),
+	(136, 3132, '画像圧縮領域化', UNIX_TIMESTAMP()),
+	(636, 2633, '超音速反応体', UNIX_TIMESTAMP()),
+	(127, 1125, '無重力生成物', UNIX_TIMESTAMP()),
+	(159, 1157, '音響共振器', UNIX_TIMESTAMP()),
+	(954, 4949, '酵素還元劑', UNIX_TIMESTAMP()),
+	(847, 6840, '精神透過装置', UNIX_TIMESTAMP()),
+	(539, 3535, '天母波形素', UNIX_TIMESTAMP()),
+	(567, 6560, '物質情報組織体', UNIX_TIMESTAMP()),
+	(912, 911, '衝突反拡散', UNIX_TIMESTAMP()),
+	(571, 3567, '無機結晶化合物', UNIX_TIMESTAMP()),
+	(225, 1223, '形態変換劇素', UNIX_TIMESTAMP()),
+	(975, 6968, '生体認証補助器', UNIX_TIMESTAMP()),
+	(94, 2091, '水晶原子機能体', UNIX_TIMESTAMP()),
+	(563, 562, '多重宇宙推移機構', UNIX_TIMESTAMP()),
+	(795, 3791, '風味変換剤', UNIX_TIMESTAMP()),
+	(634, 2631, '僧伽藍結晶体', UNIX_TIMESTAMP()),
+	(260, 6253, '専門筆領域', UNIX_TIMESTAMP()),
+	(780, 2777, '精神応答波形装置', UNIX_TIMESTAMP()),
+	(25, 2022, '非晶形情報配列体', UNIX_TIMESTAMP()),
+	(134, 6127, '火星生態調和剤', UNIX_TIMESTAMP()),
+	(689, 6682, '音響円環体', UNIX_TIMESTAMP()),
+	(934, 1932, '液化熱探査横断剤', UNIX_TIMESTAMP()),
+	(404, 3400, '加速行動波', UNIX_TIMESTAMP()),
+	(527, 1525, '動反備応覚', UNIX_TIMESTAMP()),
+	(42, 4037, '硬膜反射印象', UNIX_TIMESTAMP()),
+	(278, 5272, '浮析因子基', UNIX_TIMESTAMP()),
+	(191, 1189, '静傅連絡要印', UNIX_TIMESTAMP()),
+	(47, 4042, '熱逆透過沃是', UNIX_TIMESTAMP()),
+	(480, 7472, '電算解析舵棲', UNIX_TIMESTAMP()),
+	(792, 3788, '経済補全抵', UNIX_TIMESTAMP()),
+	(661, 3657, '物流安全規講', UNIX_TIMESTAMP()),
+	(16, 6009, '気象圏間通電', UNIX_TIMESTAMP()),
+	(744, 1742, '応相符合解', UNIX_TIMESTAMP()),
+	(34, 7026, '応高比舵構成', UNIX_TIMESTAMP()),
+	(945, 2942, '厚部全球冠群', UNIX_TIMESTAMP()),
+	(476, 6469, '程制連応基', UNIX_TIMESTAMP()),
+	(6, 2003, '複素合屈因標', UNIX_TIMESTAMP()),
+	(639, 3635, '衛星識宙術', UNIX_TIMESTAMP()),
+	(947, 3943, '方面制探偵', UNIX_TIMESTAMP()),
+	(916, 6909, '定着管組塊状', UNIX_TIMESTAMP()),
+	(390, 7382, '情報網利益護', UNIX_TIMESTAMP()),
+	(111, 1109, '現地互助化分', UNIX_TIMESTAMP()),
+	(492, 6485, '計測弾丸規補', UNIX_TIMESTAMP()),
+	(51, 2048, '技事具記象望', UNIX_TIMESTAMP()),
+	(311, 2308, '待機抑速摘抽', UNIX_TIMESTAMP()),
+	(870, 1868, '追走速率按', UNIX_TIMESTAMP()),
+	(500, 6493, '紅外径方合観', UNIX_TIMESTAMP()),
+	(840, 1838, '勾合指示偶交', UNIX_TIMESTAMP()),
+	(379, 7371, '孤眠円盤質索', UNIX_TIMESTAMP()),
+	(958, 2955, '解毒電分効徳', UNIX_TIMESTAMP()),
+	(932, 3928, '間算定量制使', UNIX_TIMESTAMP()),
+	(717, 5711, '放出調形飛確', UNIX_TIMESTAMP()),
+	(863, 1861, '内溶接影宙観', UNIX_TIMESTAMP()),
+	(692, 3688, '働定定基故', UNIX_TIMESTAMP()),
+	(362, 5356, '根拠確度布果', UNIX_TIMESTAMP()),
+	(880, 879, '震動磁度巻制', UNIX_TIMESTAMP()),
+	(482, 1480, '生命維持装置', UNIX_TIMESTAMP()),
+	(366, 3362, '潜在意識否定書', UNIX_TIMESTAMP()),
+	(53, 4048, '空間線形代数', UNIX_TIMESTAMP()),
+	(706, 4701, '気象渦面解析', UNIX_TIMESTAMP()),
+	(676, 1674, '心理時空認知', UNIX_TIMESTAMP()),
+	(6, 6998, '微生物遺伝子習得', UNIX_TIMESTAMP()),
+	(225, 1223, '気液絶縁材料', UNIX_TIMESTAMP()),
+	(141, 7133, '情報集約重回帰', UNIX_TIMESTAMP()),
+	(229, 1227, '脈波構造診断', UNIX_TIMESTAMP()),
+	(823, 4818, '光炬化素材開発', UNIX_TIMESTAMP()),
+	(983, 2980, '心理疼痛閾値測定', UNIX_TIMESTAMP()),
+	(70, 5064, '非線形振動制御', UNIX_TIMESTAMP()),
+	(536, 5530, '情動対応体温制御', UNIX_TIMESTAMP()),
+	(241, 7233, '信号処理網羅化', UNIX_TIMESTAMP()),
+	(710, 2707, '鉱物相似度分析', UNIX_TIMESTAMP()),
+	(661, 5655, '筆字行動曲線分析', UNIX_TIMESTAMP()),
+	(42, 1040, '青翼闇騎士', UNIX_TIMESTAMP()),
+	(736, 1734, '環境保護署', UNIX_TIMESTAMP()),
+	(619, 1617, '家庭用計測器', UNIX_TIMESTAMP()),
+	(488, 487, '水素燃料電池', UNIX_TIMESTAMP()),
+	(103, 7095, '自動轉發器', UNIX_TIMESTAMP()),
+	(252, 251, '攻殻銃販売店', UNIX_TIMESTAMP()),
+	(737, 6730, '伸長型音楽箱', UNIX_TIMESTAMP()),
+	(535, 3531, '時空転送装置', UNIX_TIMESTAMP()),
+	(245, 7237, '心霊共鳴機', UNIX_TIMESTAMP()),
+	(63, 2060, '隠影手裏剣', UNIX_TIMESTAMP()),
+	(479, 7471, '絶対速度核', UNIX_TIMESTAMP()),
+	(725, 5719, '周波散在露', UNIX_TIMESTAMP()),
+	(910, 1908, '遺伝阻害体', UNIX_TIMESTAMP()),
+	(211, 5205, '親和拡散焦', UNIX_TIMESTAMP()),
+	(397, 2394, '微生物窒息体', UNIX_TIMESTAMP()),
+	(723, 5717, '衝突創生術', UNIX_TIMESTAMP()),
+	(255, 1253, '精神転移術', UNIX_TIMESTAMP()),
+	(495, 2492, '情報網羅装置', UNIX_TIMESTAMP()),
+	(165, 5159, '音響共鳴装置', UNIX_TIMESTAMP()),
+	(657, 5651, '潜在意識抽出装置', UNIX_TIMESTAMP()),
+	(517, 2514, '高感度微細検知器', UNIX_TIMESTAMP()),
+	(237, 4232, '空間抵抗兵器', UNIX_TIMESTAMP()),
+	(723, 2720, '宇宙遠征推進器', UNIX_TIMESTAMP()),
+	(895, 2892, '未知能力開花装置', UNIX_TIMESTAMP()),
+	(403, 6396, '記憶再生装置', UNIX_TIMESTAMP()),
+	(506, 505, '塩基余剰複合体', UNIX_TIMESTAMP()),
+	(794, 2791, '情報粒子化', UNIX_TIMESTAMP()),
+	(980, 979, '音響共斉層', UNIX_TIMESTAMP()),
+	(2, 1, '遺伝進行遅延機構', UNIX_TIMESTAMP()),
+	(811, 5805, '気象不連続構造', UNIX_TIMESTAMP()),
+	(78, 4073, '知識飽和効果', UNIX_TIMESTAMP()),
+	(568, 1566, '量子減速制御', UNIX_TIMESTAMP()),
+	(941, 5935, '光反応格子', UNIX_TIMESTAMP()),
+	(442, 2439, '精神分子視覚化', UNIX_TIMESTAMP()),
+	(133, 2130, '運動空間量', UNIX_TIMESTAMP()),
+	(317, 6310, '心臓補完栄養', UNIX_TIMESTAMP()),
+	(189, 188, '火星環境適応遺伝子', UNIX_TIMESTAMP()),
+	(463, 2460, '構造記憶体', UNIX_TIMESTAMP()),
+	(172, 6165, '生命破壊反応', UNIX_TIMESTAMP()),
+	(111, 3107, '引力微視計算', UNIX_TIMESTAMP()),
+	(478, 4473, '感覚直心地', UNIX_TIMESTAMP()),
+	(134, 3130, '視野象徴剖', UNIX_TIMESTAMP()),
+	(160, 159, '実存翻評衆', UNIX_TIMESTAMP()),
+	(386, 385, '社会結限指導', UNIX_TIMESTAMP()),
+	(700, 6693, '政治連態主義', UNIX_TIMESTAMP()),
+	(96, 3092, '文化徴双様', UNIX_TIMESTAMP()),
+	(201, 200, '音韻念緩範', UNIX_TIMESTAMP()),
+	(308, 7300, '歴史土地研究', UNIX_TIMESTAMP()),
+	(316, 1314, '社会制覇型', UNIX_TIMESTAMP()),
+	(490, 1488, '記憶連発調収', UNIX_TIMESTAMP()),
+	(153, 5147, '美術饗悦談', UNIX_TIMESTAMP()),
+	(743, 4738, '劇場吸溜効果', UNIX_TIMESTAMP()),
+	(704, 5698, '音楽打叢研修', UNIX_TIMESTAMP()),
+	(434, 1432, '農業耕然状', UNIX_TIMESTAMP()),
+	(200, 199, '清掃芳華捧捷', UNIX_TIMESTAMP()),
+	(241, 7233, '旅行宿泊院', UNIX_TIMESTAMP()),
+	(995, 5989, '建築設計観念', UNIX_TIMESTAMP()),
+	(581, 2578, '工業生産労務', UNIX_TIMESTAMP()),
+	(635, 2632, '紅薔薇妖刀', UNIX_TIMESTAMP()),
+	(294, 3290, '記憶結晶化', UNIX_TIMESTAMP()),
+	(861, 6854, '時空透明化', UNIX_TIMESTAMP()),
+	(212, 3208, '生体逆転機', UNIX_TIMESTAMP()),
+	(43, 5037, '超響波磁場', UNIX_TIMESTAMP()),
+	(655, 2652, '無重力解放', UNIX_TIMESTAMP()),
+	(201, 200, '精神反機械', UNIX_TIMESTAMP()),
+	(571, 2568, '暗黒物文化', UNIX_TIMESTAMP()),
+	(890, 3886, '量子軌道制御', UNIX_TIMESTAMP()),
+	(459, 4454, '器官自己再生', UNIX_TIMESTAMP()),
+	(950, 4945, '精神情報統合', UNIX_TIMESTAMP()),
+	(652, 6645, '脳波解析術', UNIX_TIMESTAMP()),
+	(195, 6188, '空気圧帳票検定', UNIX_TIMESTAMP()),
+	(947, 6940, '物理反応審査', UNIX_TIMESTAMP()),
+	(447, 5441, '分子構造心象図', UNIX_TIMESTAMP()),
+	(866, 6859, '細胞核再生治療', UNIX_TIMESTAMP()),
+	(764, 5758, '常微分空間解析', UNIX_TIMESTAMP()),
+	(218, 6211, '音響共鳴境界構造', UNIX_TIMESTAMP()),
+	(108, 2105, '拘束力軽減化', UNIX_TIMESTAMP()),
+	(497, 4492, '圧縮抵抗係数', UNIX_TIMESTAMP()),
+	(231, 5225, '交通流効率評価指標', UNIX_TIMESTAMP()),
+	(930, 4925, '飼養濃度上限', UNIX_TIMESTAMP()),
+	(94, 93, '濃度勾配導入', UNIX_TIMESTAMP()),
+	(190, 189, '蒸発冷却効果', UNIX_TIMESTAMP()),
+	(997, 4992, '症状消長記録', UNIX_TIMESTAMP()),
+	(683, 5677, '前提条件可視化', UNIX_TIMESTAMP()),
+	(691, 6684, '最適供給体制', UNIX_TIMESTAMP()),
+	(121, 3117, '持続延長時間', UNIX_TIMESTAMP()),
+	(290, 1288, '浸食速度測定値', UNIX_TIMESTAMP()),
+	(249, 7241, '細胞軌跡分析', UNIX_TIMESTAMP()),
+	(160, 3156, '状態遷移検出器', UNIX_TIMESTAMP()),
+	(484, 483, '映像形状復元', UNIX_TIMESTAMP()),
+	(184, 183, '乱気流摄動解析', UNIX_TIMESTAMP()),
+	(235, 234, '材料疲労挙動予測', UNIX_TIMESTAMP()),
+	(468, 5462, '鉱石資源最適抽出', UNIX_TIMESTAMP()),
+	(336, 6329, '軍事戦術最適化', UNIX_TIMESTAMP()),
+	(573, 3569, '水道管熱交換装置', UNIX_TIMESTAMP()),
+	(927, 4922, '火星帰還培養装置', UNIX_TIMESTAMP()),
+	(743, 4738, '月面酸素製造機', UNIX_TIMESTAMP()),
+	(527, 1525, '宇宙農業施設', UNIX_TIMESTAMP()),
+	(404, 1402, '微細粒子表面改質装置', UNIX_TIMESTAMP()),
+	(827, 826, '生体神経接続再生材料', UNIX_TIMESTAMP()),
+	(907, 6900, '鉱石粉末分離精製装置', UNIX_TIMESTAMP()),
+	(758, 757, '経絡反射道', UNIX_TIMESTAMP()),
+	(933, 2930, '投影開闢術', UNIX_TIMESTAMP()),
+	(916, 5910, '絶縁相補理', UNIX_TIMESTAMP()),
+	(73, 5067, '古代文字解析', UNIX_TIMESTAMP()),
+	(103, 102, '心理浸潤術', UNIX_TIMESTAMP()),
+	(444, 3440, '悪魔喚起術', UNIX_TIMESTAMP()),
+	(940, 6933, '吸血蚤幽門', UNIX_TIMESTAMP()),
+	(678, 6671, '細胞再生装置', UNIX_TIMESTAMP()),
+	(394, 5388, '星体操作術', UNIX_TIMESTAMP()),
+	(750, 6743, '暗黒意志解放', UNIX_TIMESTAMP()),
+	(595, 6588, '化石遺伝子研究', UNIX_TIMESTAMP()),
+	(262, 7254, '潜在意識通信', UNIX_TIMESTAMP()),
+	(959, 6952, '妖眼継承術', UNIX_TIMESTAMP()),
+	(221, 3217, '修羅魂習得', UNIX_TIMESTAMP()),
+	(87, 5081, '古代文明遺跡調査', UNIX_TIMESTAMP()),
+	(816, 2813, '聖光解明術', UNIX_TIMESTAMP()),
+	(457, 3453, '心霊探査機', UNIX_TIMESTAMP()),
+	(345, 5339, '冥府通行証', UNIX_TIMESTAMP()),
+	(560, 5554, '静電未来物理', UNIX_TIMESTAMP()),
+	(157, 6150, '地理社会文化', UNIX_TIMESTAMP()),
+	(150, 6143, '遺産管理都市計画', UNIX_TIMESTAMP()),
+	(896, 4891, '社会経済政策', UNIX_TIMESTAMP()),
+	(438, 1436, '瞬間移動装置', UNIX_TIMESTAMP()),
+	(329, 4324, '音波衝撃装置', UNIX_TIMESTAMP()),
+	(925, 1923, '失重環境生成装置', UNIX_TIMESTAMP()),
+	(212, 5206, '精神固定装置', UNIX_TIMESTAMP()),
+	(694, 3690, '無重力農業', UNIX_TIMESTAMP()),
+	(103, 5097, '魔導操舵術', UNIX_TIMESTAMP()),
+	(869, 4864, '光子捕集装置', UNIX_TIMESTAMP()),
+	(585, 6578, '未来予測装置', UNIX_TIMESTAMP()),
+	(447, 5441, '情報処理思考機臓', UNIX_TIMESTAMP()),
+	(416, 6409, '無限萌渚共渡', UNIX_TIMESTAMP()),
+	(28, 3024, '感応共鳴触媒', UNIX_TIMESTAMP()),
+	(801, 2798, '爆発抑圧大器具', UNIX_TIMESTAMP()),
+	(835, 2832, '粒合水質改善装置', UNIX_TIMESTAMP()),
+	(512, 4507, '疾雷効感応動弾', UNIX_TIMESTAMP()),
+	(531, 2528, '吐心貯蔵補助構造', UNIX_TIMESTAMP()),
+	(75, 4070, '摩擦振動低減管継手', UNIX_TIMESTAMP()),
+	(188, 5182, '無色因果反応剤', UNIX_TIMESTAMP()),
+	(746, 2743, '靜水浮遊磁気移動', UNIX_TIMESTAMP()),
+	(216, 7208, '電子交響干渉断片', UNIX_TIMESTAMP()),
+	(379, 5373, '星間臨機反射板', UNIX_TIMESTAMP()),
+	(198, 7190, '結晶軟弱水滑砂', UNIX_TIMESTAMP()),
+	(467, 1465, '上斜水面外環状', UNIX_TIMESTAMP()),
+	(44, 4039, '陽光浄霧化動力', UNIX_TIMESTAMP()),
+	(627, 4622, '透明臭化気体材', UNIX_TIMESTAMP()),
+	(799, 2796, '頭蓋熱伝導排気', UNIX_TIMESTAMP()),
+	(984, 2981, '前修繊維再構造', UNIX_TIMESTAMP()),
+	(49, 5043, '超音速接合', UNIX_TIMESTAMP()),
+	(913, 1911, '宇宙時間分割', UNIX_TIMESTAMP()),
+	(350, 4345, '分子応答制御', UNIX_TIMESTAMP()),
+	(925, 6918, '地盤仮説検証', UNIX_TIMESTAMP()),
+	(455, 2452, '集合境界解析', UNIX_TIMESTAMP()),
+	(16, 2013, '高次元加速器', UNIX_TIMESTAMP()),
+	(680, 3676, '草食動物神経通信', UNIX_TIMESTAMP()),
+	(60, 3056, '心臓共鳴現象', UNIX_TIMESTAMP()),
+	(392, 6385, '遺伝情報処理', UNIX_TIMESTAMP()),
+	(359, 2356, '高密度冷凍保存', UNIX_TIMESTAMP()),
+	(364, 2361, '脳機能分類', UNIX_TIMESTAMP()),
+	(167, 1165, '音楽訓練測定', UNIX_TIMESTAMP()),
+	(923, 1921, '神経伝達物質', UNIX_TIMESTAMP()),
+	(367, 6360, '音響測定器', UNIX_TIMESTAMP()),
+	(164, 3160, '天体観測衛星', UNIX_TIMESTAMP()),
+	(148, 7140, '眼底写真撮影器', UNIX_TIMESTAMP()),
+	(443, 3439, '放射線治療装置', UNIX_TIMESTAMP()),
+	(537, 536, '電子顕微鏡', UNIX_TIMESTAMP()),
+	(816, 2813, '天然資源探査', UNIX_TIMESTAMP()),
+	(253, 7245, '遺伝子研究', UNIX_TIMESTAMP()),
+	(632, 3628, '重合反応器', UNIX_TIMESTAMP()),
+	(809, 808, '衛星画像解析', UNIX_TIMESTAMP()),
+	(653, 6646, '超音波診断装置', UNIX_TIMESTAMP()),
+	(79, 78, '音井将太郎', UNIX_TIMESTAMP()),
+	(45, 2042, '内村明日香', UNIX_TIMESTAMP()),
+	(979, 1977, '松宮穂乃香', UNIX_TIMESTAMP()),
+	(10, 9, '田原友里子', UNIX_TIMESTAMP()),
+	(259, 5253, '泉谷佳加子', UNIX_TIMESTAMP()),
+	(199, 1197, '若林淳太郎', UNIX_TIMESTAMP()),
+	(902, 6895, '鏡面静電力', UNIX_TIMESTAMP()),
+	(791, 5785, '循回蒸発器', UNIX_TIMESTAMP()),
+	(951, 950, '空間時間転移', UNIX_TIMESTAMP()),
+	(574, 2571, '超光速推進', UNIX_TIMESTAMP()),
+	(553, 1551, '無重力制御', UNIX_TIMESTAMP()),
+	(251, 5245, '空中移動装置', UNIX_TIMESTAMP()),
+	(984, 4979, '潜在思考解析', UNIX_TIMESTAMP()),
+	(510, 4505, '量子加速器', UNIX_TIMESTAMP()),
+	(47, 4042, '心理条件武装辞書', UNIX_TIMESTAMP()),
+	(977, 2974, '高調波画像', UNIX_TIMESTAMP()),
+	(817, 5811, '燃氣鬆弛度', UNIX_TIMESTAMP()),
+	(839, 2836, '幾何形態分析', UNIX_TIMESTAMP()),
+	(727, 5721, '星間融合反応', UNIX_TIMESTAMP()),
+	(813, 6806, '有機化合反応', UNIX_TIMESTAMP()),
+	(194, 2191, '問題解決効率', UNIX_TIMESTAMP()),
+	(106, 2103, '行政管理指標', UNIX_TIMESTAMP()),
+	(722, 2719, '音響信号解析', UNIX_TIMESTAMP()),
+	(61, 2058, '食品製造規制', UNIX_TIMESTAMP()),
+	(964, 4959, '農薬使用許可', UNIX_TIMESTAMP()),
+	(28, 1026, '会計倫理規定', UNIX_TIMESTAMP()),
+	(138, 137, '公共政策立案', UNIX_TIMESTAMP()),
+	(953, 6946, '迷径神経學', UNIX_TIMESTAMP()),
+	(823, 822, '植物栄循分類', UNIX_TIMESTAMP()),
+	(227, 226, '昼夜比較心理', UNIX_TIMESTAMP()),
+	(403, 2400, '星霊制御術', UNIX_TIMESTAMP()),
+	(319, 4314, '蒸気疲弊現象', UNIX_TIMESTAMP()),
+	(509, 5503, '電脳共感体', UNIX_TIMESTAMP()),
+	(368, 367, '微小核反応装置', UNIX_TIMESTAMP()),
+	(273, 6266, '想念情報波', UNIX_TIMESTAMP()),
+	(542, 3538, '次元軌道航行', UNIX_TIMESTAMP()),
+	(389, 4384, '記憶錯誤症候群', UNIX_TIMESTAMP()),
+	(174, 173, '大気共鳴振動', UNIX_TIMESTAMP()),
+	(110, 3106, '絶対色覚能力', UNIX_TIMESTAMP()),
+	(408, 5402, '時空連結装置', UNIX_TIMESTAMP()),
+	(917, 4912, '衛星遮蔽現象', UNIX_TIMESTAMP()),
+	(826, 6819, '生体振動解析', UNIX_TIMESTAMP()),
+	(455, 3451, '電神応答装置', UNIX_TIMESTAMP()),
+	(741, 740, '意識宇宙説', UNIX_TIMESTAMP()),
+	(707, 2704, '自律進化機能', UNIX_TIMESTAMP()),
+	(952, 5946, '熱帯時相症候群', UNIX_TIMESTAMP()),
+	(725, 5719, '直感染染料', UNIX_TIMESTAMP()),
+	(294, 6287, '細胞保持限界', UNIX_TIMESTAMP()),
+	(220, 3216, '流体複合機械', UNIX_TIMESTAMP()),
+	(488, 3484, '垂直換気槽', UNIX_TIMESTAMP()),
+	(582, 2579, '逆行棒加工', UNIX_TIMESTAMP()),
+	(974, 4969, '特設核気球', UNIX_TIMESTAMP()),
+	(222, 221, '漁業捕獲意思', UNIX_TIMESTAMP()),
+	(466, 6459, '繊維関連再生', UNIX_TIMESTAMP()),
+	(228, 7220, '熱帯樹液密度', UNIX_TIMESTAMP()),
+	(792, 791, '動物発生実験', UNIX_TIMESTAMP()),
+	(80, 1078, '植物形態注意', UNIX_TIMESTAMP()),
+	(735, 3731, '鉱石採鉱工程', UNIX_TIMESTAMP()),
+	(829, 4824, '機械油膜作用', UNIX_TIMESTAMP()),
+	(267, 266, '隠密消耗品', UNIX_TIMESTAMP()),
+	(500, 5494, '地霊界突破', UNIX_TIMESTAMP()),
+	(827, 4822, '個体生殖拮抗', UNIX_TIMESTAMP()),
+	(298, 1296, '朝露表面張力', UNIX_TIMESTAMP()),
+	(209, 7201, '殷鋼磨導楔', UNIX_TIMESTAMP()),
+	(39, 38, '石灰岩荒野化', UNIX_TIMESTAMP()),
+	(80, 5074, '血清緩和反応', UNIX_TIMESTAMP()),
+	(679, 2676, '白衣無菌作業', UNIX_TIMESTAMP()),
+	(203, 5197, '噴霧風洞試験', UNIX_TIMESTAMP()),
+	(210, 5204, '禁書物響石', UNIX_TIMESTAMP()),
+	(926, 4921, '蓄電池内部抵抗', UNIX_TIMESTAMP()),
+	(674, 2671, '反応軌道解析', UNIX_TIMESTAMP()),
+	(611, 6604, '重力波検知宇宙望遠鏡', UNIX_TIMESTAMP()),
+	(133, 7125, '気象観測非協力体', UNIX_TIMESTAMP()),
+	(456, 7448, '恒星外気候条件', UNIX_TIMESTAMP()),
+	(775, 3771, '熱核融合実験設備', UNIX_TIMESTAMP()),
+	(691, 690, '認識概念言語処理', UNIX_TIMESTAMP()),
+	(455, 1453, '元素資源濃縮抽出', UNIX_TIMESTAMP()),
+	(422, 4417, '研磨無機体観察', UNIX_TIMESTAMP()),
+	(922, 5916, '鋼構造非線形解析', UNIX_TIMESTAMP()),
+	(640, 2637, '環境影響波解析', UNIX_TIMESTAMP()),
+	(283, 5277, '熱媒体屈折率分布', UNIX_TIMESTAMP()),
+	(274, 4269, '曲薬溶解熱実測', UNIX_TIMESTAMP()),
+	(395, 1393, '超音速燃焼効率', UNIX_TIMESTAMP()),
+	(92, 7084, '化粧品離脱剤体', UNIX_TIMESTAMP()),
+	(994, 6987, '高熱疲労試験炉', UNIX_TIMESTAMP()),
+	(399, 2396, '生体電磁多重感知', UNIX_TIMESTAMP()),
+	(47, 1045, '噛物健康画像診断', UNIX_TIMESTAMP()),
+	(373, 2370, '五感覚連動作用語', UNIX_TIMESTAMP()),
+	(345, 6338, '燃焼規制物質', UNIX_TIMESTAMP()),
+	(769, 6762, '分子加速反応器', UNIX_TIMESTAMP()),
+	(490, 1488, '宇宙渦動力炉', UNIX_TIMESTAMP()),
+	(496, 2493, '組織再構成装置', UNIX_TIMESTAMP()),
+	(536, 6529, '磁気情報圧縮装置', UNIX_TIMESTAMP()),
+	(385, 1383, '地殻亀裂抑制機構', UNIX_TIMESTAMP()),
+	(419, 2416, '宇宙時間折畳構造', UNIX_TIMESTAMP()),
+	(516, 515, '有機高次多元化合物', UNIX_TIMESTAMP()),
+	(759, 5753, '分子原子核準位', UNIX_TIMESTAMP()),
+	(979, 978, '蛍光化合物', UNIX_TIMESTAMP()),
+	(8, 7, '無重力状態', UNIX_TIMESTAMP()),
+	(63, 7055, '無機質酵素', UNIX_TIMESTAMP()),
+	(695, 4690, '情報遮断装置', UNIX_TIMESTAMP()),
+	(66, 1064, '潜在意識同期', UNIX_TIMESTAMP()),
+	(919, 3915, '生体強化剤', UNIX_TIMESTAMP()),
+	(730, 6723, '超感覚読心術', UNIX_TIMESTAMP()),
+	(143, 4138, '動物像検出器', UNIX_TIMESTAMP()),
+	(219, 218, '時空転移装置', UNIX_TIMESTAMP()),
+	(584, 6577, '未知物質探査機', UNIX_TIMESTAMP()),
+	(375, 7367, '思考増幅装置', UNIX_TIMESTAMP()),
+	(183, 3179, '脳波解析装置', UNIX_TIMESTAMP()),
+	(750, 6743, '重力制御装置', UNIX_TIMESTAMP()),
+	(401, 2398, '磁気抵抗素子', UNIX_TIMESTAMP()),
+	(148, 7140, '自然言語生成器', UNIX_TIMESTAMP()),
+	(43, 4038, '密度調節装置', UNIX_TIMESTAMP()),
+	(442, 1440, '情報増幅装置', UNIX_TIMESTAMP()),
+	(223, 2220, '未来視能力', UNIX_TIMESTAMP()),
+	(675, 3671, '欠陥探知子', UNIX_TIMESTAMP()),
+	(80, 7072, '音叉調整機', UNIX_TIMESTAMP()),
+	(361, 2358, '細胞生産装置', UNIX_TIMESTAMP()),
+	(59, 2056, '変動磁界発生器', UNIX_TIMESTAMP()),
+	(22, 3018, '認識情報解析器', UNIX_TIMESTAMP()),
+	(736, 735, '創造力増強装置', UNIX_TIMESTAMP()),
+	(713, 3709, '水陸駆動装置', UNIX_TIMESTAMP()),
+	(368, 367, '超高速光線励起器', UNIX_TIMESTAMP()),
+	(436, 7428, '量子共鳴回路', UNIX_TIMESTAMP()),
+	(718, 717, '情報精製装置', UNIX_TIMESTAMP()),
+	(769, 2766, '超電力推進構造', UNIX_TIMESTAMP()),
+	(697, 696, '空中演算単位', UNIX_TIMESTAMP()),
+	(61, 4056, '有機分子解析装置', UNIX_TIMESTAMP()),
+	(22, 5016, '量子位相変換装置', UNIX_TIMESTAMP()),
+	(775, 6768, '超伝導物質結晶加工装置', UNIX_TIMESTAMP()),
+	(445, 1443, '反重力装置', UNIX_TIMESTAMP()),
+	(534, 5528, '超音速推進器', UNIX_TIMESTAMP()),
+	(629, 2626, '高分子合成装置', UNIX_TIMESTAMP()),
+	(44, 5038, '情報解読器', UNIX_TIMESTAMP()),
+	(419, 5413, '時空歪曲装置', UNIX_TIMESTAMP()),
+	(761, 4756, '無重力空間船', UNIX_TIMESTAMP()),
+	(92, 2089, '加速粒子発生器', UNIX_TIMESTAMP()),
+	(834, 1832, '月影巡航計画', UNIX_TIMESTAMP()),
+	(504, 3500, '石炭洗浄装置', UNIX_TIMESTAMP()),
+	(259, 6252, '風螺旋効率指数', UNIX_TIMESTAMP()),
+	(713, 4708, '蓄音鏡磨耗検査', UNIX_TIMESTAMP()),
+	(210, 7202, '炭素凝固温度', UNIX_TIMESTAMP()),
+	(835, 2832, '雷鱗防御組織', UNIX_TIMESTAMP()),
+	(209, 208, '滑翔撤退装備', UNIX_TIMESTAMP()),
+	(536, 535, '鉄塔耐風設計', UNIX_TIMESTAMP()),
+	(109, 108, '陸地沈下速度', UNIX_TIMESTAMP()),
+	(171, 2168, '海草生産量指標', UNIX_TIMESTAMP()),
+	(798, 2795, '干拓排水地区', UNIX_TIMESTAMP()),
+	(304, 3300, '樹脂膜透析装置', UNIX_TIMESTAMP()),
+	(49, 2046, '資源再生工場', UNIX_TIMESTAMP()),
+	(329, 7321, '空気冷却器', UNIX_TIMESTAMP()),
+	(977, 976, '蓄熱式照明', UNIX_TIMESTAMP()),
+	(616, 3612, '心理振動調整装置', UNIX_TIMESTAMP()),
+	(907, 906, '電子拡散波動器', UNIX_TIMESTAMP()),
+	(354, 4349, '原子力増幅発生器', UNIX_TIMESTAMP()),
+	(792, 5786, '視覚分析装置', UNIX_TIMESTAMP()),
+	(244, 4239, '分子応答反射材', UNIX_TIMESTAMP()),
+	(424, 1422, '光子共鳴暗号装置', UNIX_TIMESTAMP()),
+	(97, 1095, '緊急非常回路切替装置', UNIX_TIMESTAMP()),
+	(17, 2014, '無重量宇宙適応服', UNIX_TIMESTAMP()),
+	(767, 2764, '分子振動整合器', UNIX_TIMESTAMP()),
+	(750, 5744, '帰納的分解', UNIX_TIMESTAMP()),
+	(630, 629, '超越的相互関連', UNIX_TIMESTAMP()),
+	(636, 5630, '因子分析器', UNIX_TIMESTAMP()),
+	(936, 2933, '同化化傾向', UNIX_TIMESTAMP()),
+	(351, 5345, '抽象細分化', UNIX_TIMESTAMP()),
+	(965, 964, '維持統整概念', UNIX_TIMESTAMP()),
+	(120, 7112, '備忘的連結', UNIX_TIMESTAMP()),
+	(336, 4331, '口碑伝播効果', UNIX_TIMESTAMP()),
+	(338, 5332, '適合評価検証', UNIX_TIMESTAMP()),
+	(254, 5248, '消費願望最大化', UNIX_TIMESTAMP()),
+	(422, 1420, '空間時限定義', UNIX_TIMESTAMP()),
+	(460, 3456, '抽出中心化', UNIX_TIMESTAMP()),
+	(385, 384, '均衡機構生成', UNIX_TIMESTAMP()),
+	(774, 4769, '曖昧詞語彙', UNIX_TIMESTAMP()),
+	(569, 3565, '速散幅広母音化', UNIX_TIMESTAMP()),
+	(284, 6277, '昇華反作用', UNIX_TIMESTAMP()),
+	(398, 2395, '体系的一般化', UNIX_TIMESTAMP()),
+	(111, 4106, '支配階層蓄積', UNIX_TIMESTAMP()),
+	(24, 4019, '宇宙共振器', UNIX_TIMESTAMP()),
+	(637, 6630, '生体磁気制御', UNIX_TIMESTAMP()),
+	(313, 2310, '石化環境調節装置', UNIX_TIMESTAMP()),
+	(242, 6235, '知覚拡張器', UNIX_TIMESTAMP()),
+	(777, 4772, '音響感応増幅器', UNIX_TIMESTAMP()),
+	(185, 3181, '陶質合成触媒', UNIX_TIMESTAMP()),
+	(962, 961, '分子操演装置', UNIX_TIMESTAMP()),
+	(410, 7402, '量子並列計算機', UNIX_TIMESTAMP()),
+	(802, 6795, '超空間移動装置', UNIX_TIMESTAMP()),
+	(315, 6308, '無限連鎖反応装置', UNIX_TIMESTAMP()),
+	(256, 1254, '比重変化材', UNIX_TIMESTAMP()),
+	(345, 1343, '心身秩序術', UNIX_TIMESTAMP()),
+	(945, 3941, '発想創成術', UNIX_TIMESTAMP()),
+	(401, 6394, '情景設計術', UNIX_TIMESTAMP()),
+	(352, 5346, '構造洞察力', UNIX_TIMESTAMP()),
+	(399, 4394, '感覚融合術', UNIX_TIMESTAMP()),
+	(321, 5315, '想像力開発術', UNIX_TIMESTAMP()),
+	(970, 2967, '水晶濃度計', UNIX_TIMESTAMP()),
+	(409, 5403, '心霊感知機', UNIX_TIMESTAMP()),
+	(250, 2247, '波動解析装置', UNIX_TIMESTAMP()),
+	(749, 748, '暗黒探知器', UNIX_TIMESTAMP()),
+	(273, 3269, '磁気浮遊装置', UNIX_TIMESTAMP()),
+	(5, 4999, '超音速衝撃波発生器', UNIX_TIMESTAMP()),
+	(101, 2098, '量子透明装置', UNIX_TIMESTAMP()),
+	(346, 6339, '時空混合反応器', UNIX_TIMESTAMP()),
+	(173, 3169, '生体増幅装置', UNIX_TIMESTAMP()),
+	(835, 5829, '電脳干渉機', UNIX_TIMESTAMP()),
+	(395, 3391, '精神情報抽出装置', UNIX_TIMESTAMP()),
+	(621, 620, '生物再生装甲', UNIX_TIMESTAMP()),
+	(416, 415, '暴走粒子遮断装置', UNIX_TIMESTAMP()),
+	(236, 1234, '時間加速装置', UNIX_TIMESTAMP()),
+	(347, 7339, '蒸気圧縮補助装置', UNIX_TIMESTAMP()),
+	(431, 430, '応的共成素', UNIX_TIMESTAMP()),
+	(499, 7491, '身元把持鎮', UNIX_TIMESTAMP()),
+	(759, 5753, '藪鳥光源波', UNIX_TIMESTAMP()),
+	(646, 2643, '緋色幻霊剣', UNIX_TIMESTAMP()),
+	(693, 6686, '生物相互作用', UNIX_TIMESTAMP()),
+	(179, 6172, '情報効率化', UNIX_TIMESTAMP()),
+	(8, 1006, '脳反射神経', UNIX_TIMESTAMP()),
+	(199, 1197, '記憶拡張装置', UNIX_TIMESTAMP()),
+	(116, 3112, '機械思考力指数', UNIX_TIMESTAMP()),
+	(188, 7180, '飛行動態解析', UNIX_TIMESTAMP()),
+	(873, 6866, '進化加速度', UNIX_TIMESTAMP()),
+	(787, 3783, '波動干渉素子', UNIX_TIMESTAMP()),
+	(572, 4567, '光合成増強装置', UNIX_TIMESTAMP()),
+	(236, 7228, '生体応答計測器', UNIX_TIMESTAMP()),
+	(958, 2955, '遺伝子情報処理', UNIX_TIMESTAMP()),
+	(858, 5852, '石油分光検査', UNIX_TIMESTAMP()),
+	(276, 3272, '水循環模擬実験', UNIX_TIMESTAMP()),
+	(210, 1208, '植物遺伝子編集', UNIX_TIMESTAMP()),
+	(960, 5954, '循環経済指標', UNIX_TIMESTAMP()),
+	(611, 6604, '脳血管欠損症', UNIX_TIMESTAMP()),
+	(206, 6199, '音波磁気共鳴', UNIX_TIMESTAMP()),
+	(12, 5006, '宇宙物理動態', UNIX_TIMESTAMP()),
+	(28, 27, '生命体低温保存', UNIX_TIMESTAMP()),
+	(998, 5992, '高荷重強化構造', UNIX_TIMESTAMP()),
+	(378, 377, '社会心理影響評価', UNIX_TIMESTAMP()),
+	(404, 6397, '細胞遺伝病研究', UNIX_TIMESTAMP()),
+	(820, 5814, '環状拡散体', UNIX_TIMESTAMP()),
+	(939, 2936, '機械脳制御装置', UNIX_TIMESTAMP()),
+	(332, 2329, '高分子合金', UNIX_TIMESTAMP()),
+	(624, 1622, '潜在記憶装置', UNIX_TIMESTAMP()),
+	(449, 5443, '量子情報通信', UNIX_TIMESTAMP()),
+	(214, 5208, '量子振動制御装置', UNIX_TIMESTAMP()),
+	(761, 3757, '高密度光記憶装置', UNIX_TIMESTAMP()),
+	(179, 3175, '信号伝達最適化装置', UNIX_TIMESTAMP()),
+	(655, 3651, '自己再生触覚材料', UNIX_TIMESTAMP()),
+	(324, 3320, '無操作橋梁', UNIX_TIMESTAMP()),
+	(882, 4877, '時間転位網', UNIX_TIMESTAMP()),
+	(279, 7271, '瞬間移動術', UNIX_TIMESTAMP()),
+	(830, 5824, '反応速度定数', UNIX_TIMESTAMP()),
+	(682, 5676, '直接矩形波', UNIX_TIMESTAMP()),
+	(827, 4822, '懸濁物質分布', UNIX_TIMESTAMP()),
+	(104, 7096, '自己成長指標', UNIX_TIMESTAMP()),
+	(476, 7468, '対世界戦略', UNIX_TIMESTAMP()),
+	(529, 4524, '健康指標評価', UNIX_TIMESTAMP()),
+	(295, 1293, '言語接触現象', UNIX_TIMESTAMP()),
+	(785, 5779, '病害虫制御', UNIX_TIMESTAMP()),
+	(714, 6707, '革新的企業文化', UNIX_TIMESTAMP()),
+	(409, 408, '自然地形分析', UNIX_TIMESTAMP()),
+	(178, 1176, '水晶環状器', UNIX_TIMESTAMP()),
+	(221, 6214, '生命観測装置', UNIX_TIMESTAMP()),
+	(613, 5607, '空中遊星磁場計', UNIX_TIMESTAMP()),
+	(480, 479, '星間物質採取装置', UNIX_TIMESTAMP()),
+	(273, 7265, '幻声投影機', UNIX_TIMESTAMP()),
+	(208, 207, '念磁検査器', UNIX_TIMESTAMP()),
+	(525, 5519, '超回転素粒子加速器', UNIX_TIMESTAMP()),
+	(313, 4308, '曜日計測機', UNIX_TIMESTAMP()),
+	(537, 1535, '奇跡再現装置', UNIX_TIMESTAMP()),
+	(825, 2822, '創造主視点器', UNIX_TIMESTAMP()),
+	(383, 382, '心霊干渉防止装置', UNIX_TIMESTAMP()),
+	(179, 3175, '穿孔写真機', UNIX_TIMESTAMP()),
+	(992, 6985, '生態分析術', UNIX_TIMESTAMP()),
+	(10, 4005, '神託記録碑', UNIX_TIMESTAMP()),
+	(643, 642, '騒音低減器', UNIX_TIMESTAMP()),
+	(545, 5539, '比例連鎖算尺', UNIX_TIMESTAMP()),
+	(691, 5685, '気象干渉体制', UNIX_TIMESTAMP()),
+	(769, 5763, '触覚転送機', UNIX_TIMESTAMP()),
+	(940, 4935, '火星観測望遠鏡', UNIX_TIMESTAMP()),
+	(152, 6145, '磁気霊感検査器', UNIX_TIMESTAMP()),
+	(775, 6768, '天空風前録', UNIX_TIMESTAMP()),
+	(619, 618, '脳波変換装置', UNIX_TIMESTAMP()),
+	(462, 7454, '透磁殺菌装置', UNIX_TIMESTAMP()),
+	(892, 5886, '無重力航行術', UNIX_TIMESTAMP()),
+	(969, 968, '虚像回路器', UNIX_TIMESTAMP()),
+	(224, 7216, '未来予測算尺', UNIX_TIMESTAMP()),
+	(828, 827, '霊的応答装束', UNIX_TIMESTAMP()),
+	(939, 6932, '隠秘結界装置', UNIX_TIMESTAMP()),
+	(450, 2447, '神秘物質生成装置', UNIX_TIMESTAMP()),
+	(584, 583, '断片語構造', UNIX_TIMESTAMP()),
+	(822, 2819, '溶解電解質', UNIX_TIMESTAMP()),
+	(445, 2442, '情報仮想化', UNIX_TIMESTAMP()),
+	(763, 5757, '音声反転処理', UNIX_TIMESTAMP()),
+	(545, 5539, '要素割当問題', UNIX_TIMESTAMP()),
+	(187, 2184, '水素燃料電池', UNIX_TIMESTAMP()),
+	(590, 2587, '磁気共鳴画像装置', UNIX_TIMESTAMP()),
+	(346, 2343, '量子暗号通信', UNIX_TIMESTAMP()),
+	(679, 4674, '天然言語処理', UNIX_TIMESTAMP()),
+	(555, 554, '抗体医薬品', UNIX_TIMESTAMP()),
+	(577, 5571, '非破壊検査', UNIX_TIMESTAMP()),
+	(327, 326, '腦軟化現象', UNIX_TIMESTAMP()),
+	(78, 77, '塞巨子合金', UNIX_TIMESTAMP()),
+	(895, 3891, '生産合波機', UNIX_TIMESTAMP()),
+	(961, 960, '噪波光発生', UNIX_TIMESTAMP()),
+	(237, 1235, '新電幻素材', UNIX_TIMESTAMP()),
+	(904, 1902, '螺旋状鎖環', UNIX_TIMESTAMP()),
+	(215, 2212, '無機結晶生成', UNIX_TIMESTAMP()),
+	(392, 3388, '脊髄交感神経', UNIX_TIMESTAMP()),
+	(645, 4640, '遺伝子再結合', UNIX_TIMESTAMP()),
+	(646, 5640, '衝突加速器', UNIX_TIMESTAMP()),
+	(75, 4070, '表面粗度測定', UNIX_TIMESTAMP()),
+	(64, 7056, '磁気共鳴装置', UNIX_TIMESTAMP()),
+	(562, 561, '微細粒子測定器', UNIX_TIMESTAMP()),
+	(493, 2490, '重力測定計', UNIX_TIMESTAMP()),
+	(198, 197, '超音波撮像装置', UNIX_TIMESTAMP()),
+	(65, 2062, '反応速度計測装置', UNIX_TIMESTAMP()),
+	(466, 2463, '空気圧力計', UNIX_TIMESTAMP()),
+	(91, 2088, '界面張力計', UNIX_TIMESTAMP()),
+	(735, 4730, '真空蒸着装置', UNIX_TIMESTAMP()),
+	(780, 2777, '電子回転速度計', UNIX_TIMESTAMP()),
+	(661, 3657, '分子量測定器', UNIX_TIMESTAMP()),
+	(622, 6615, '走査型電子顕微鏡', UNIX_TIMESTAMP()),
+	(141, 6134, '超音速脳波', UNIX_TIMESTAMP()),
+	(405, 5399, '平行世界調査', UNIX_TIMESTAMP()),
+	(201, 5195, '逆思考心理', UNIX_TIMESTAMP()),
+	(339, 5333, '音楽多重感覚', UNIX_TIMESTAMP()),
+	(549, 2546, '鋼殻芽細胞', UNIX_TIMESTAMP()),
+	(86, 7078, '緑藻寒冷素子', UNIX_TIMESTAMP()),
+	(249, 248, '電磁回転尾', UNIX_TIMESTAMP()),
+	(46, 7038, '金銀粒子培菌', UNIX_TIMESTAMP()),
+	(546, 5540, '重力転抄軸', UNIX_TIMESTAMP()),
+	(525, 5519, '摩擦摩耗検知器', UNIX_TIMESTAMP()),
+	(640, 6633, '高分子分離産物', UNIX_TIMESTAMP()),
+	(52, 7044, '音波圧義探針', UNIX_TIMESTAMP()),
+	(344, 5338, '太陽光電波増幅器', UNIX_TIMESTAMP()),
+	(587, 6580, '水素苗土改良剤', UNIX_TIMESTAMP()),
+	(870, 869, '多孔質脳除傷試薬', UNIX_TIMESTAMP()),
+	(186, 1184, '超音速電磁磁化噴射器', UNIX_TIMESTAMP()),
+	(401, 400, '金銅合金核容剤', UNIX_TIMESTAMP()),
+	(362, 7354, '量子融合体', UNIX_TIMESTAMP()),
+	(606, 2603, '心因操作装置', UNIX_TIMESTAMP()),
+	(82, 6075, '意識情報干渉', UNIX_TIMESTAMP()),
+	(91, 6084, '超高分子製膜材料', UNIX_TIMESTAMP()),
+	(113, 2110, '遺伝子伝達剤', UNIX_TIMESTAMP()),
+	(340, 5334, '情報量空間暗号', UNIX_TIMESTAMP()),
+	(358, 6351, '仮想現実仕様記述言語', UNIX_TIMESTAMP()),
+	(475, 4470, '農業超元素', UNIX_TIMESTAMP()),
+	(181, 5175, '海洋気流擁桿', UNIX_TIMESTAMP()),
+	(365, 364, '自然生物結晶', UNIX_TIMESTAMP()),
+	(507, 6500, '金融市場慧見', UNIX_TIMESTAMP()),
+	(378, 2375, '建築形態評議', UNIX_TIMESTAMP()),
+	(695, 3691, '社会転世先備', UNIX_TIMESTAMP()),
+	(399, 6392, '経営戦略停滞', UNIX_TIMESTAMP()),
+	(269, 4264, '芸術精緻采角', UNIX_TIMESTAMP()),
+	(309, 5303, '歴史考古埋笠', UNIX_TIMESTAMP()),
+	(287, 4282, '医療関心範蔽', UNIX_TIMESTAMP()),
+	(99, 5093, '透明化粧膜', UNIX_TIMESTAMP()),
+	(261, 260, '層状電子伝導体', UNIX_TIMESTAMP()),
+	(403, 2400, '円周旋回衛星', UNIX_TIMESTAMP()),
+	(166, 6159, '溶岩組織器', UNIX_TIMESTAMP()),
+	(87, 4082, '透明脳注入装置', UNIX_TIMESTAMP()),
+	(681, 4676, '有機反射繊維', UNIX_TIMESTAMP()),
+	(718, 3714, '積層炭素中綿', UNIX_TIMESTAMP()),
+	(143, 3139, '山岳酸素増塩装置', UNIX_TIMESTAMP()),
+	(303, 1301, '流動酸化膜', UNIX_TIMESTAMP()),
+	(304, 303, '回転式研磨液体', UNIX_TIMESTAMP()),
+	(510, 509, '細胞膜透過速度', UNIX_TIMESTAMP()),
+	(908, 3904, '循環経済指標', UNIX_TIMESTAMP()),
+	(40, 2037, '睡眠周期調節機構', UNIX_TIMESTAMP()),
+	(96, 7088, '生体内信号伝達経路', UNIX_TIMESTAMP()),
+	(54, 3050, '再生可能資源利用率', UNIX_TIMESTAMP()),
+	(868, 4863, '新素材合成反応', UNIX_TIMESTAMP()),
+	(769, 768, '水煙器紗篭', UNIX_TIMESTAMP()),
+	(277, 2274, '砂時計雲量', UNIX_TIMESTAMP()),
+	(458, 2455, '鳥籠音検出', UNIX_TIMESTAMP()),
+	(610, 609, '銅磁顕微鏡', UNIX_TIMESTAMP()),
+	(13, 2010, '烏骨鶏抽出', UNIX_TIMESTAMP()),
+	(688, 3684, '鳳凰図案集', UNIX_TIMESTAMP()),
+	(234, 2231, '生体透析機', UNIX_TIMESTAMP()),
+	(25, 6018, '筆記加速剤', UNIX_TIMESTAMP()),
+	(345, 3341, '音波治療装置', UNIX_TIMESTAMP()),
+	(47, 7039, '植物適応剤', UNIX_TIMESTAMP()),
+	(99, 6092, '飛行心地装置', UNIX_TIMESTAMP()),
+	(426, 4421, '情報遮断器', UNIX_TIMESTAMP()),
+	(427, 2424, '磁気分離装置', UNIX_TIMESTAMP()),
+	(860, 3856, '物理変換装置', UNIX_TIMESTAMP()),
+	(71, 2068, '高速凍結機器', UNIX_TIMESTAMP()),
+	(953, 1951, '気体封入剤', UNIX_TIMESTAMP()),
+	(300, 299, '画像追跡装置', UNIX_TIMESTAMP()),
+	(545, 5539, '電波解析機', UNIX_TIMESTAMP()),
+	(192, 6185, '動物同化装置', UNIX_TIMESTAMP()),
+	(971, 2968, '精神増強装置', UNIX_TIMESTAMP()),
+	(615, 1613, '視覚補助機器', UNIX_TIMESTAMP()),
+	(863, 2860, '熱反射装置', UNIX_TIMESTAMP()),
+	(871, 6864, '地震予知装置', UNIX_TIMESTAMP()),
+	(796, 795, '重力分析機', UNIX_TIMESTAMP()),
+	(581, 1579, '電子拡散装置', UNIX_TIMESTAMP()),
+	(116, 6109, '衛星通信装置', UNIX_TIMESTAMP()),
+	(796, 4791, '音声変換装置', UNIX_TIMESTAMP()),
+	(773, 6766, '機械翻訳計算尺度', UNIX_TIMESTAMP()),
+	(791, 6784, '脳波観測信号解析', UNIX_TIMESTAMP()),
+	(366, 4361, '遺伝子研究遺伝浮動', UNIX_TIMESTAMP()),
+	(735, 734, '北極海氷融解現象', UNIX_TIMESTAMP()),
+	(844, 1842, '金属合成結晶構造解析', UNIX_TIMESTAMP()),
+	(462, 461, '風洞衝撃波音速測定', UNIX_TIMESTAMP()),
+	(280, 7272, '地質堆積物層位分析', UNIX_TIMESTAMP()),
+	(600, 599, '植物適応戦略研究', UNIX_TIMESTAMP()),
+	(29, 28, '核融合反応', UNIX_TIMESTAMP()),
+	(286, 3282, '量子転送装置', UNIX_TIMESTAMP()),
+	(916, 1914, '超音速振動子', UNIX_TIMESTAMP()),
+	(230, 6223, '四次元重力場', UNIX_TIMESTAMP()),
+	(286, 4281, '高温環境分解装置', UNIX_TIMESTAMP()),
+	(613, 612, '多元組織構造体', UNIX_TIMESTAMP()),
+	(69, 3065, '逐次多変量分析', UNIX_TIMESTAMP()),
+	(994, 993, '超高分子合成触媒', UNIX_TIMESTAMP()),
+	(548, 547, '微粒子集中器', UNIX_TIMESTAMP()),
+	(772, 4767, '超音速回転軸', UNIX_TIMESTAMP()),
+	(665, 5659, '凝集体光子共鳴', UNIX_TIMESTAMP()),
+	(376, 5370, '衝撃消費剤', UNIX_TIMESTAMP()),
+	(78, 6071, '乾式潤滑剤', UNIX_TIMESTAMP()),
+	(362, 361, '情報充填電流', UNIX_TIMESTAMP()),
+	(517, 5511, '分子複合体', UNIX_TIMESTAMP()),
+	(763, 4758, '自己整合反機械', UNIX_TIMESTAMP()),
+	(502, 6495, '窒素陽子貯蔵器', UNIX_TIMESTAMP()),
+	(931, 5925, '必需脂肪酸', UNIX_TIMESTAMP()),
+	(26, 4021, '蛋白質合成', UNIX_TIMESTAMP()),
+	(453, 1451, '血液凝固因子', UNIX_TIMESTAMP()),
+	(197, 7189, '遺伝子発現', UNIX_TIMESTAMP()),
+	(958, 5952, '反質子爆弾', UNIX_TIMESTAMP()),
+	(987, 6980, '高速遺伝子分析', UNIX_TIMESTAMP()),
+	(735, 2732, '超音速衛星通信', UNIX_TIMESTAMP()),
+	(676, 2673, '量子磁気共鳴', UNIX_TIMESTAMP()),
+	(881, 4876, '生物酸化力', UNIX_TIMESTAMP()),
+	(488, 7480, '機械振動分析', UNIX_TIMESTAMP()),
+	(385, 5379, '高分子溶融延伸', UNIX_TIMESTAMP()),
+	(299, 1297, '赤外線多重眼突合成', UNIX_TIMESTAMP()),
+	(857, 3853, '角度位相差分析', UNIX_TIMESTAMP()),
+	(191, 5185, '超合成核分析', UNIX_TIMESTAMP()),
+	(763, 1761, '核融合炉駆動器', UNIX_TIMESTAMP()),
+	(821, 2818, '量子素子配列', UNIX_TIMESTAMP()),
+	(466, 4461, '高分子合成酵素', UNIX_TIMESTAMP()),
+	(855, 2852, '超音速気流制御装置', UNIX_TIMESTAMP()),
+	(943, 2940, '画像解析器', UNIX_TIMESTAMP()),
+	(176, 6169, '幻想地獄書', UNIX_TIMESTAMP()),
+	(732, 731, '神速瞬間移動', UNIX_TIMESTAMP()),
+	(927, 4922, '脳反応解析', UNIX_TIMESTAMP()),
+	(113, 2110, '最適化尺度', UNIX_TIMESTAMP()),
+	(849, 4844, '無重力反応', UNIX_TIMESTAMP()),
+	(67, 6060, '熱伝導指数', UNIX_TIMESTAMP()),
+	(746, 2743, '音響趨動子', UNIX_TIMESTAMP()),
+	(83, 6076, '錯覚変生理', UNIX_TIMESTAMP()),
+	(856, 855, '身体分析眼', UNIX_TIMESTAMP()),
+	(139, 1137, '自己言語育成', UNIX_TIMESTAMP()),
+	(90, 7082, '電気色温度計', UNIX_TIMESTAMP()),
+	(721, 4716, '霊素制御術', UNIX_TIMESTAMP()),
+	(804, 803, '懸垂血液浴', UNIX_TIMESTAMP()),
+	(992, 4987, '潜在筋反射', UNIX_TIMESTAMP()),
+	(70, 3066, '催眠真空現象', UNIX_TIMESTAMP()),
+	(320, 5314, '心芯浮力効果', UNIX_TIMESTAMP()),
+	(373, 5367, '水晶状幻想実体', UNIX_TIMESTAMP()),
+	(676, 2673, '自然触媒因子', UNIX_TIMESTAMP()),
+	(734, 5728, '無限量子陣', UNIX_TIMESTAMP()),
+	(657, 1655, '真空構築原理', UNIX_TIMESTAMP()),
+	(782, 2779, '神聖生命継承', UNIX_TIMESTAMP()),
+	(562, 2559, '心薬遺伝子配列', UNIX_TIMESTAMP()),
+	(932, 5926, '穢聖共鳴効果', UNIX_TIMESTAMP()),
+	(763, 1761, '超絶浄化術式', UNIX_TIMESTAMP()),
+	(283, 4278, '魂火霊感検出', UNIX_TIMESTAMP()),
+	(913, 2910, '無意識通信', UNIX_TIMESTAMP()),
+	(747, 2744, '素粒子加速器', UNIX_TIMESTAMP()),
+	(689, 2686, '機械生命体', UNIX_TIMESTAMP()),
+	(294, 4289, '太陽光発電', UNIX_TIMESTAMP()),
+	(516, 6509, '高温超伝導', UNIX_TIMESTAMP()),
+	(869, 2866, '神思制御術', UNIX_TIMESTAMP()),
+	(267, 6260, '蓄電力処理機', UNIX_TIMESTAMP()),
+	(644, 2641, '真空傘物質', UNIX_TIMESTAMP()),
+	(527, 2524, '焦電結晶振盪器', UNIX_TIMESTAMP()),
+	(542, 1540, '高速円摩機', UNIX_TIMESTAMP()),
+	(466, 3462, '静位差評価器', UNIX_TIMESTAMP()),
+	(94, 5088, '磁気脱肺機', UNIX_TIMESTAMP()),
+	(199, 7191, '液体微粒子分散装置', UNIX_TIMESTAMP()),
+	(685, 2682, '音声荷重機', UNIX_TIMESTAMP()),
+	(153, 1151, '刺激応答抑制装置', UNIX_TIMESTAMP()),
+	(882, 2879, '弧光加工機器', UNIX_TIMESTAMP()),
+	(703, 6696, '加速放射装置', UNIX_TIMESTAMP()),
+	(329, 5323, '分子支配結晶機', UNIX_TIMESTAMP()),
+	(411, 4406, '血管浄化機', UNIX_TIMESTAMP()),
+	(447, 5441, '起源光波変換装置', UNIX_TIMESTAMP()),
+	(51, 4046, '心拍状態分析装置', UNIX_TIMESTAMP()),
+	(286, 1284, '濃度衝突反応器', UNIX_TIMESTAMP()),
+	(832, 3828, '壁面高圧装置', UNIX_TIMESTAMP()),
+	(743, 5737, '空中重力検出器', UNIX_TIMESTAMP()),
+	(124, 7116, '全波解析器', UNIX_TIMESTAMP()),
+	(604, 603, '感度補正測定器', UNIX_TIMESTAMP()),
+	(454, 4449, '反跳連成剤', UNIX_TIMESTAMP()),
+	(401, 1399, '守護遺伝子', UNIX_TIMESTAMP()),
+	(556, 2553, '映像曼荼羅', UNIX_TIMESTAMP()),
+	(446, 5440, '神道執薄霊石酉物', UNIX_TIMESTAMP()),
+	(996, 3992, '蒼黒機検徴動装', UNIX_TIMESTAMP()),
+	(56, 7048, '青眼妖炎神苑', UNIX_TIMESTAMP()),
+	(623, 1621, '吹雪炸神律館', UNIX_TIMESTAMP()),
+	(839, 6832, '橙光杖極射破', UNIX_TIMESTAMP()),
+	(867, 5861, '紫電急送閃光架', UNIX_TIMESTAMP()),
+	(114, 5108, '黒羽裏深幻獄劍', UNIX_TIMESTAMP()),
+	(949, 6942, '焔吹神理具極鎧', UNIX_TIMESTAMP()),
+	(622, 2619, '碧解炎鏡華絶', UNIX_TIMESTAMP()),
+	(309, 4304, '響神憑久助閃撃', UNIX_TIMESTAMP()),
+	(676, 2673, '水闊雷墜疾轟', UNIX_TIMESTAMP()),
+	(794, 3790, '火滓円擁径巻', UNIX_TIMESTAMP()),
+	(446, 6439, '月下舞蜻蛉舞架', UNIX_TIMESTAMP()),
+	(240, 239, '眩暈発科唱謡壇', UNIX_TIMESTAMP()),
+	(645, 6638, '綠夢印妖幻戦門', UNIX_TIMESTAMP()),
+	(386, 5380, '風果謳吹矢墓', UNIX_TIMESTAMP()),
+	(130, 3126, '地獄絡造刃機架', UNIX_TIMESTAMP()),
+	(739, 6732, '金色刄護腕屶', UNIX_TIMESTAMP()),
+	(827, 826, '夢銀世界妖刄鳴', UNIX_TIMESTAMP()),
+	(187, 6180, '黄須輪迅雷絶照', UNIX_TIMESTAMP()),
+	(626, 3622, '燃焼推進装置', UNIX_TIMESTAMP()),
+	(684, 6677, '超高温熱源', UNIX_TIMESTAMP()),
+	(128, 127, '量子構造解析', UNIX_TIMESTAMP()),
+	(683, 4678, '磁力発生体', UNIX_TIMESTAMP()),
+	(320, 4315, '早期回収装置', UNIX_TIMESTAMP()),
+	(202, 6195, '環境調和装置', UNIX_TIMESTAMP()),
+	(273, 7265, '究極物質生成装置', UNIX_TIMESTAMP()),
+	(472, 6465, '高速進化遺伝子', UNIX_TIMESTAMP()),
+	(963, 4958, '非破壊検査装置', UNIX_TIMESTAMP()),
+	(553, 4548, '透明化合繊素材', UNIX_TIMESTAMP()),
+	(972, 6965, '自律行動制御機構', UNIX_TIMESTAMP()),
+	(962, 2959, '生態系復元装置', UNIX_TIMESTAMP()),
+	(485, 3481, '宇宙時間結節点', UNIX_TIMESTAMP()),
+	(302, 301, '遺伝子組換材料', UNIX_TIMESTAMP()),
+	(560, 6553, '高分子遠隔微細化機構', UNIX_TIMESTAMP()),
+	(975, 4970, '生体触媒反応器', UNIX_TIMESTAMP()),
+	(528, 2525, '細胞新生治癒促進剤', UNIX_TIMESTAMP()),
+	(681, 6674, '地磁気検知高感度装置', UNIX_TIMESTAMP()),
+	(244, 243, '環境生態系防御機構', UNIX_TIMESTAMP()),
+	(531, 6524, '高分子体内分解酵素', UNIX_TIMESTAMP()),
+	(905, 1903, '直交固体位相空間解析', UNIX_TIMESTAMP()),
+	(995, 994, '生体血漿糖調節剤', UNIX_TIMESTAMP()),
+	(936, 3932, '逆相分析閾値機構', UNIX_TIMESTAMP()),
+	(396, 4391, '溶連菌防御', UNIX_TIMESTAMP()),
+	(508, 507, '情報暗号化', UNIX_TIMESTAMP()),
+	(31, 2028, '画像増幅装置', UNIX_TIMESTAMP()),
+	(262, 4257, '偽造防止機構', UNIX_TIMESTAMP()),
+	(275, 6268, '電磁波遮蔽材料', UNIX_TIMESTAMP()),
+	(843, 5837, '強磁場露光装置', UNIX_TIMESTAMP()),
+	(49, 2046, '音響兵器対策', UNIX_TIMESTAMP()),
+	(200, 4195, '固体発酵装置', UNIX_TIMESTAMP()),
+	(544, 5538, '高温高圧反応装置', UNIX_TIMESTAMP()),
+	(532, 4527, '多層構造分析器', UNIX_TIMESTAMP()),
+	(971, 6964, '層間融合接着剤', UNIX_TIMESTAMP()),
+	(349, 7341, '空中浮揚機構', UNIX_TIMESTAMP()),
+	(932, 6925, '情報評価指標', UNIX_TIMESTAMP()),
+	(575, 574, '折衝力分析', UNIX_TIMESTAMP()),
+	(307, 2304, '心理労働負荷評価', UNIX_TIMESTAMP()),
+	(496, 6489, '樹脂耐久試験装置', UNIX_TIMESTAMP()),
+	(809, 3805, '蒸気酸素充填', UNIX_TIMESTAMP()),
+	(807, 3803, '時空共振器', UNIX_TIMESTAMP()),
+	(499, 6492, '音響振動装置', UNIX_TIMESTAMP()),
+	(206, 7198, '心理空間転移', UNIX_TIMESTAMP()),
+	(925, 3921, '壁面分子刻印', UNIX_TIMESTAMP()),
+	(94, 1092, '遺伝子再編', UNIX_TIMESTAMP()),
+	(592, 591, '原子粒子生成', UNIX_TIMESTAMP()),
+	(17, 6010, '超高温超伝導', UNIX_TIMESTAMP()),
+	(915, 2912, '空間転移装置', UNIX_TIMESTAMP()),
+	(515, 6508, '粒子融合炉', UNIX_TIMESTAMP()),
+	(820, 819, '記憶再現装置', UNIX_TIMESTAMP()),
+	(827, 1825, '時間反転機', UNIX_TIMESTAMP()),
+	(21, 1019, '量子通信装置', UNIX_TIMESTAMP()),
+	(234, 3230, '次元転換装置', UNIX_TIMESTAMP()),
+	(519, 5513, '重力制御装置', UNIX_TIMESTAMP()),
+	(126, 4121, '分子再構造装置', UNIX_TIMESTAMP()),
+	(42, 3038, '感情解析器', UNIX_TIMESTAMP()),
+	(466, 6459, '無重力漂浮装置', UNIX_TIMESTAMP()),
+	(191, 6184, '脳波解析装置', UNIX_TIMESTAMP()),
+	(60, 3056, '情報遺伝子暗号化装置', UNIX_TIMESTAMP()),
+	(346, 7338, '宙葉固定装置', UNIX_TIMESTAMP()),
+	(830, 829, '水晶化掃海器', UNIX_TIMESTAMP()),
+	(908, 5902, '勾玉化装具', UNIX_TIMESTAMP()),
+	(786, 3782, '無機天体計測器', UNIX_TIMESTAMP()),
+	(187, 2184, '合成紅華石', UNIX_TIMESTAMP()),
+	(493, 7485, '化粧土蒸気機関', UNIX_TIMESTAMP()),
+	(640, 2637, '水晶血液輸送装置', UNIX_TIMESTAMP()),
+	(724, 6717, '蒸留碧憑依装置', UNIX_TIMESTAMP()),
+	(545, 1543, '念糸通信機', UNIX_TIMESTAMP()),
+	(40, 3036, '炸裂丹精霊', UNIX_TIMESTAMP()),
+	(677, 5671, '透明飛行車', UNIX_TIMESTAMP()),
+	(932, 3928, '竜軸調整機', UNIX_TIMESTAMP()),
+	(678, 4673, '錬金羅条装置', UNIX_TIMESTAMP()),
+	(824, 4819, '幻彩稲妖術', UNIX_TIMESTAMP()),
+	(34, 3030, '水晶力魔封筒', UNIX_TIMESTAMP()),
+	(535, 6528, '心状天球儀', UNIX_TIMESTAMP()),
+	(638, 6631, '蒸気魚化器', UNIX_TIMESTAMP()),
+	(806, 6799, '超重力素粒子', UNIX_TIMESTAMP()),
+	(140, 6133, '知覚共振装置', UNIX_TIMESTAMP()),
+	(684, 683, '宇宙時間膜', UNIX_TIMESTAMP()),
+	(474, 6467, '因果連鎖装置', UNIX_TIMESTAMP()),
+	(265, 6258, '反重力分野', UNIX_TIMESTAMP()),
+	(667, 6660, '量子干渉装置', UNIX_TIMESTAMP()),
+	(611, 3607, '陽電子重粒子', UNIX_TIMESTAMP()),
+	(319, 5313, '暗黒物資評価指標', UNIX_TIMESTAMP()),
+	(721, 1719, '情報魔道士', UNIX_TIMESTAMP()),
+	(812, 811, '量子線融合', UNIX_TIMESTAMP()),
+	(341, 7333, '生物反応因子', UNIX_TIMESTAMP()),
+	(379, 1377, '未来推測機', UNIX_TIMESTAMP()),
+	(116, 5110, '音響分散装置', UNIX_TIMESTAMP()),
+	(533, 2530, '進化加速器', UNIX_TIMESTAMP()),
+	(627, 6620, '物質再構成装置', UNIX_TIMESTAMP()),
+	(798, 5792, '感情感知装置', UNIX_TIMESTAMP()),
+	(298, 3294, '反重力推進', UNIX_TIMESTAMP()),
+	(326, 2323, '発光再生装置', UNIX_TIMESTAMP()),
+	(157, 5151, '未来預知統計', UNIX_TIMESTAMP()),
+	(380, 6373, '遺伝子改変降下', UNIX_TIMESTAMP()),
+	(435, 2432, '超高速粒子加速器', UNIX_TIMESTAMP()),
+	(126, 4121, '暗黙反射機能', UNIX_TIMESTAMP()),
+	(118, 4113, '進化合併演算子', UNIX_TIMESTAMP()),
+	(47, 2044, '擬似断熱環境', UNIX_TIMESTAMP()),
+	(79, 2076, '超曲面位相素子', UNIX_TIMESTAMP()),
+	(903, 6896, '恒常非平衡状態', UNIX_TIMESTAMP()),
+	(938, 4933, '共鳴運命結合器', UNIX_TIMESTAMP()),
+	(754, 5748, '素粒子増殖現象', UNIX_TIMESTAMP()),
+	(343, 4338, '地質応用物理', UNIX_TIMESTAMP()),
+	(741, 6734, '液晶撥水剤', UNIX_TIMESTAMP()),
+	(119, 6112, '液晶刺激素', UNIX_TIMESTAMP()),
+	(703, 3699, '量子心理装置', UNIX_TIMESTAMP()),
+	(33, 2030, '微生物認識器', UNIX_TIMESTAMP()),
+	(171, 4166, '超高速振動機', UNIX_TIMESTAMP()),
+	(333, 332, '機械思考器', UNIX_TIMESTAMP()),
+	(230, 229, '磁気情報分光', UNIX_TIMESTAMP()),
+	(104, 1102, '相対位相検出器', UNIX_TIMESTAMP()),
+	(490, 489, '水銀劈開術', UNIX_TIMESTAMP()),
+	(84, 6077, '幻像瞬殺術', UNIX_TIMESTAMP()),
+	(939, 1937, '超音波帯域', UNIX_TIMESTAMP()),
+	(797, 3793, '無機生合成', UNIX_TIMESTAMP()),
+	(329, 3325, '高齢者介護', UNIX_TIMESTAMP()),
+	(710, 3706, '遺伝子改良', UNIX_TIMESTAMP()),
+	(999, 3995, '潜在宇宙糸', UNIX_TIMESTAMP()),
+	(723, 722, '悪魔素粒子', UNIX_TIMESTAMP()),
+	(634, 1632, '共鳴念動石', UNIX_TIMESTAMP()),
+	(354, 5348, '電脳光速航', UNIX_TIMESTAMP()),
+	(500, 1498, '混沌宇宙模型', UNIX_TIMESTAMP()),
+	(240, 2237, '極真乱舞術', UNIX_TIMESTAMP()),
+	(574, 5568, '完璧時空間', UNIX_TIMESTAMP()),
+	(454, 7446, '衝撃波紋列伝', UNIX_TIMESTAMP()),
+	(72, 3068, '融合結晶体', UNIX_TIMESTAMP()),
+	(125, 124, '経平均温度', UNIX_TIMESTAMP()),
+	(331, 330, '間接化経路転送', UNIX_TIMESTAMP()),
+	(587, 2584, '完全有機構造体', UNIX_TIMESTAMP()),
+	(90, 2087, '情報感応拡散波', UNIX_TIMESTAMP()),
+	(212, 1210, '電子体外共鳴', UNIX_TIMESTAMP()),
+	(95, 5089, '超重力計量分子', UNIX_TIMESTAMP()),
+	(616, 3612, '稀有微粒子', UNIX_TIMESTAMP()),
+	(156, 7148, '非晶棒状構造', UNIX_TIMESTAMP()),
+	(355, 354, '遺伝情報解読器', UNIX_TIMESTAMP()),
+	(175, 3171, '超音速飛行艇', UNIX_TIMESTAMP()),
+	(737, 2734, '統合空間展開術', UNIX_TIMESTAMP()),
+	(852, 851, '量子干渉素子', UNIX_TIMESTAMP()),
+	(775, 5769, '無限自己進化機構', UNIX_TIMESTAMP()),
+	(836, 4831, '暗黒流星源', UNIX_TIMESTAMP()),
+	(279, 3275, '開闢情報通信網', UNIX_TIMESTAMP()),
+	(274, 1272, '量子暗号解読機', UNIX_TIMESTAMP()),
+	(29, 3025, '多重次元実体化装置', UNIX_TIMESTAMP()),
+	(630, 629, '知覚融合低温装置', UNIX_TIMESTAMP()),
+	(247, 2244, '反応自己進行触媒', UNIX_TIMESTAMP()),
+	(880, 6873, '合声病原体', UNIX_TIMESTAMP()),
+	(596, 4591, '非合金鋳鉄', UNIX_TIMESTAMP()),
+	(464, 463, '電子疏水製造', UNIX_TIMESTAMP()),
+	(53, 52, '心脳接続器', UNIX_TIMESTAMP()),
+	(850, 5844, '息吹共振装置', UNIX_TIMESTAMP()),
+	(19, 6012, '時空還元器', UNIX_TIMESTAMP()),
+	(447, 7439, '磁気絶縁材', UNIX_TIMESTAMP()),
+	(190, 6183, '遺伝記号配列', UNIX_TIMESTAMP()),
+	(328, 4323, '量子次元測定機', UNIX_TIMESTAMP()),
+	(877, 876, '生体再生因子', UNIX_TIMESTAMP()),
+	(651, 5645, '網膜反射抑制装置', UNIX_TIMESTAMP()),
+	(822, 1820, '空中走査配列', UNIX_TIMESTAMP()),
+	(658, 1656, '中核遺伝情報素子', UNIX_TIMESTAMP()),
+	(614, 5608, '位相逆転検出器', UNIX_TIMESTAMP()),
+	(141, 7133, '集中分散並列解析器', UNIX_TIMESTAMP()),
+	(286, 285, '蒲公英効果', UNIX_TIMESTAMP()),
+	(48, 7040, '心理思考量', UNIX_TIMESTAMP()),
+	(157, 6150, '遺伝情報換算', UNIX_TIMESTAMP()),
+	(386, 7378, '経済循環度', UNIX_TIMESTAMP()),
+	(379, 6372, '社会影響調査', UNIX_TIMESTAMP()),
+	(3, 2000, '行動分析指数', UNIX_TIMESTAMP()),
+	(7, 3003, '統計変動率', UNIX_TIMESTAMP()),
+	(858, 4853, '磁気応答係数', UNIX_TIMESTAMP()),
+	(27, 4022, '文化発展指標', UNIX_TIMESTAMP()),
+	(447, 4442, '気候応答力', UNIX_TIMESTAMP()),
+	(43, 42, '物質合成率', UNIX_TIMESTAMP()),
+	(692, 3688, '衛生安全度', UNIX_TIMESTAMP()),
+	(313, 6306, '研究効率化指数', UNIX_TIMESTAMP()),
+	(674, 6667, '国際関係指標', UNIX_TIMESTAMP()),
+	(631, 1629, '芸術美感度', UNIX_TIMESTAMP()),
+	(495, 6488, '遺伝子進化力', UNIX_TIMESTAMP()),
+	(486, 5480, '空天飛行術', UNIX_TIMESTAMP()),
+	(554, 5548, '知識相互通信', UNIX_TIMESTAMP()),
+	(381, 5375, '量子凝縮現象', UNIX_TIMESTAMP()),
+	(442, 4437, '無機生命体', UNIX_TIMESTAMP()),
+	(897, 4892, '音波結晶画像', UNIX_TIMESTAMP()),
+	(956, 4951, '情報加速装置', UNIX_TIMESTAMP()),
+	(706, 3702, '魔力吸収器', UNIX_TIMESTAMP()),
+	(492, 1490, '流体透明化', UNIX_TIMESTAMP()),
+	(547, 1545, '多次元追尾', UNIX_TIMESTAMP()),
+	(114, 3110, '潜在意識制御', UNIX_TIMESTAMP()),
+	(25, 4020, '反物質転位', UNIX_TIMESTAMP()),
+	(299, 1297, '超感覚能力', UNIX_TIMESTAMP()),
+	(377, 4372, '超自然現象', UNIX_TIMESTAMP()),
+	(113, 4108, '神秘的共振', UNIX_TIMESTAMP()),
+	(881, 5875, '超兵器開発', UNIX_TIMESTAMP()),
+	(961, 960, '融合多態体', UNIX_TIMESTAMP()),
+	(565, 4560, '発磁感応樹脈', UNIX_TIMESTAMP()),
+	(51, 1049, '声波解析装置', UNIX_TIMESTAMP()),
+	(205, 7197, '暗視線密度', UNIX_TIMESTAMP()),
+	(367, 1365, '映像渦巻体', UNIX_TIMESTAMP()),
+	(841, 3837, '超音速導波器', UNIX_TIMESTAMP()),
+	(645, 6638, '円環共鳴器', UNIX_TIMESTAMP()),
+	(17, 1015, '光爆発素子', UNIX_TIMESTAMP()),
+	(73, 2070, '火花放電装置', UNIX_TIMESTAMP()),
+	(813, 812, '液体発光剤', UNIX_TIMESTAMP()),
+	(199, 3195, '心拍平均変動指数', UNIX_TIMESTAMP()),
+	(765, 2762, '自己再構成行列', UNIX_TIMESTAMP()),
+	(783, 782, '火山微弱信号検出', UNIX_TIMESTAMP()),
+	(85, 7077, '魔力供給装置', UNIX_TIMESTAMP()),
+	(320, 1318, '衛星干渉計画', UNIX_TIMESTAMP()),
+	(922, 4917, '宇宙遺伝子融合', UNIX_TIMESTAMP()),
+	(307, 2304, '神秘的霊媒術', UNIX_TIMESTAMP()),
+	(994, 3990, '音楽感情解析', UNIX_TIMESTAMP()),
+	(766, 1764, '気体共振現象', UNIX_TIMESTAMP()),
+	(997, 3993, '食物感応体', UNIX_TIMESTAMP()),
+	(956, 4951, '愛情感知装置', UNIX_TIMESTAMP()),
+	(365, 3361, '波動共鳴機構', UNIX_TIMESTAMP()),
+	(794, 1792, '視覚脳波中継装置', UNIX_TIMESTAMP()),
+	(296, 1294, '病因解析遺伝子', UNIX_TIMESTAMP()),
+	(608, 4603, '自然界双晶体', UNIX_TIMESTAMP()),
+	(41, 40, '輪廻転生術', UNIX_TIMESTAMP()),
+	(647, 5641, '神秘的氷霧', UNIX_TIMESTAMP()),
+	(355, 7347, '潔斗麦氏反応', UNIX_TIMESTAMP()),
+	(100, 5094, '前沿鬼桃化作用', UNIX_TIMESTAMP()),
+	(953, 5947, '明朗恩化反転', UNIX_TIMESTAMP()),
+	(932, 1930, '包義大利核消失', UNIX_TIMESTAMP()),
+	(562, 5556, '葉霧発明憩室', UNIX_TIMESTAMP()),
+	(452, 2449, '豫商山南右玄', UNIX_TIMESTAMP()),
+	(917, 916, '地絢川瑞恩', UNIX_TIMESTAMP()),
+	(362, 5356, '天遠星水花', UNIX_TIMESTAMP()),
+	(79, 3075, '木設竜土器群概説', UNIX_TIMESTAMP()),
+	(186, 3182, '格呂新香日永', UNIX_TIMESTAMP()),
+	(871, 5865, '表倚感辞完成', UNIX_TIMESTAMP()),
+	(1, 2997, '胎中吹仮証', UNIX_TIMESTAMP()),
+	(700, 3696, '越発雄短音', UNIX_TIMESTAMP()),
+	(711, 3707, '多展縄臓腔', UNIX_TIMESTAMP()),
+	(904, 4899, '跋新労賛泣', UNIX_TIMESTAMP()),
+	(903, 5897, '韻呂特初田化', UNIX_TIMESTAMP()),
+	(294, 2291, '豊幅上道哉', UNIX_TIMESTAMP()),
+	(887, 3883, '明広手悉能', UNIX_TIMESTAMP()),
+	(766, 6759, '左斜引居辟', UNIX_TIMESTAMP()),
+	(864, 3860, '上大検研派', UNIX_TIMESTAMP()),
+	(696, 3692, '奥尾極力思想', UNIX_TIMESTAMP()),
+	(567, 3563, '十起聖門个', UNIX_TIMESTAMP()),
+	(261, 6254, '上機悪坂近', UNIX_TIMESTAMP()),
+	(612, 1610, '養竹相対局和', UNIX_TIMESTAMP()),
+	(718, 6711, '左宣東鷹惹', UNIX_TIMESTAMP()),
+	(470, 1468, '理珠夢怒箕', UNIX_TIMESTAMP()),
+	(442, 6435, '明神語超球', UNIX_TIMESTAMP()),
+	(761, 4756, '石炭円環経済', UNIX_TIMESTAMP()),
+	(874, 2871, '音波巡航装置', UNIX_TIMESTAMP()),
+	(899, 3895, '知覚分子計測', UNIX_TIMESTAMP()),
+	(274, 6267, '分子太陽電池', UNIX_TIMESTAMP()),
+	(101, 3097, '圧力制御多層薄膜', UNIX_TIMESTAMP()),
+	(277, 7269, '高密度電子記憶素子', UNIX_TIMESTAMP()),
+	(424, 3420, '抗雷自転環', UNIX_TIMESTAMP()),
+	(673, 5667, '超音波微細', UNIX_TIMESTAMP()),
+	(114, 6107, '超伝導等価', UNIX_TIMESTAMP()),
+	(815, 1813, '微生物破壊', UNIX_TIMESTAMP()),
+	(442, 5436, '想像疾患探査機', UNIX_TIMESTAMP()),
+	(910, 3906, '地軸誤差補正装置', UNIX_TIMESTAMP()),
+	(595, 4590, '界面逆圧制御装置', UNIX_TIMESTAMP()),
+	(771, 6764, '音波位相測定装置', UNIX_TIMESTAMP()),
+	(148, 3144, '生物遺伝情報解析機', UNIX_TIMESTAMP()),
+	(349, 4344, '真空超伝導磁場生成器', UNIX_TIMESTAMP()),
+	(450, 5444, '分子構造最適化装置', UNIX_TIMESTAMP()),
+	(120, 1118, '地球地殻微動計測器', UNIX_TIMESTAMP()),
+	(647, 6640, '超高速粒子加速器', UNIX_TIMESTAMP()),
+	(864, 1862, '量子電磁場干渉検出器', UNIX_TIMESTAMP()),
+	(510, 509, '創造力拡張装置', UNIX_TIMESTAMP()),
+	(114, 1112, '逆磁気反発物質', UNIX_TIMESTAMP()),
+	(413, 3409, '爆装衝撃機動', UNIX_TIMESTAMP()),
+	(32, 7024, '超次元共振器', UNIX_TIMESTAMP()),
+	(175, 3171, '無重力境界', UNIX_TIMESTAMP()),
+	(871, 5865, '半端球状態', UNIX_TIMESTAMP()),
+	(464, 2461, '可変時間軸', UNIX_TIMESTAMP()),
+	(222, 4217, '迷宮探索魔術', UNIX_TIMESTAMP()),
+	(52, 2049, '時空膜障壁', UNIX_TIMESTAMP()),
+	(539, 1537, '陽炎波動砲', UNIX_TIMESTAMP()),
+	(181, 4176, '念動力増幅装置', UNIX_TIMESTAMP()),
+	(328, 1326, '電磁障壁帯', UNIX_TIMESTAMP()),
+	(25, 3021, '生体共振器', UNIX_TIMESTAMP()),
+	(436, 6429, '空気反射鏡', UNIX_TIMESTAMP()),
+	(148, 4143, '感覚解放装置', UNIX_TIMESTAMP()),
+	(503, 2500, '心理循環器', UNIX_TIMESTAMP()),
+	(995, 3991, '空間矯正機', UNIX_TIMESTAMP()),
+	(351, 7343, '能動統合回路', UNIX_TIMESTAMP()),
+	(466, 5460, '感知欺瞞装置', UNIX_TIMESTAMP()),
+	(814, 5808, '音響干渉振動子', UNIX_TIMESTAMP()),
+	(597, 2594, '時空転位装置', UNIX_TIMESTAMP()),
+	(967, 966, '希薄化反応装置', UNIX_TIMESTAMP()),
+	(309, 4304, '視覚制御術', UNIX_TIMESTAMP()),
+	(999, 5993, '心理干渉殻', UNIX_TIMESTAMP()),
+	(596, 5590, '文明進化素子', UNIX_TIMESTAMP()),
+	(431, 3427, '究極反射体', UNIX_TIMESTAMP()),
+	(651, 1649, '量子結合具', UNIX_TIMESTAMP()),
+	(121, 1119, '建築設計空間自動最適化', UNIX_TIMESTAMP()),
+	(767, 6760, '建設土木構造耐震最適化設計', UNIX_TIMESTAMP()),
+	(340, 1338, '累計鎖指数', UNIX_TIMESTAMP()),
+	(133, 3129, '逆流分離処理', UNIX_TIMESTAMP()),
+	(835, 1833, '不可抗力解除', UNIX_TIMESTAMP()),
+	(171, 5165, '創造的破壊戦略', UNIX_TIMESTAMP()),
+	(798, 2795, '景観価値評価指標', UNIX_TIMESTAMP()),
+	(812, 5806, '環境適応構造', UNIX_TIMESTAMP()),
+	(807, 4802, '土壌酸度調整剤', UNIX_TIMESTAMP()),
+	(760, 3756, '楔状断層地形', UNIX_TIMESTAMP()),
+	(562, 2559, '隠喩理解能力', UNIX_TIMESTAMP()),
+	(594, 3590, '妖精騒動分析', UNIX_TIMESTAMP()),
+	(626, 1624, '神秘的遺跡', UNIX_TIMESTAMP()),
+	(970, 1968, '妖精語解読', UNIX_TIMESTAMP()),
+	(310, 7302, '魔道具研究', UNIX_TIMESTAMP()),
+	(592, 4587, '妖精族共和国', UNIX_TIMESTAMP()),
+	(917, 2914, '融合型磁場', UNIX_TIMESTAMP()),
+	(965, 1963, '高速量子計算', UNIX_TIMESTAMP()),
+	(298, 1296, '真空超導体', UNIX_TIMESTAMP()),
+	(600, 599, '空中大気凝結体', UNIX_TIMESTAMP()),
+	(275, 6268, '偏波中和装置', UNIX_TIMESTAMP()),
+	(751, 3747, '高温超伝導体', UNIX_TIMESTAMP()),
+	(514, 6507, '透明光路基板', UNIX_TIMESTAMP()),
+	(262, 3258, '粒子加速装置', UNIX_TIMESTAMP()),
+	(370, 7362, '分子結晶薄膜', UNIX_TIMESTAMP()),
+	(753, 4748, '運行最適化', UNIX_TIMESTAMP()),
+	(71, 1069, '抑制核分裂体', UNIX_TIMESTAMP()),
+	(30, 29, '屈折精度指数', UNIX_TIMESTAMP()),
+	(180, 179, '火力収束装置', UNIX_TIMESTAMP()),
+	(946, 4941, '超音速真空管', UNIX_TIMESTAMP()),
+	(856, 2853, '分散暗号鍵', UNIX_TIMESTAMP()),
+	(988, 3984, '植物生命維持装置', UNIX_TIMESTAMP()),
+	(698, 6691, '瞬間移動壷', UNIX_TIMESTAMP()),
+	(405, 3401, '強化情報筋', UNIX_TIMESTAMP()),
+	(719, 3715, '零距離砲撃', UNIX_TIMESTAMP()),
+	(308, 4303, '瑠璃花火鞠', UNIX_TIMESTAMP()),
+	(27, 1025, '煙光絢爛秘', UNIX_TIMESTAMP()),
+	(637, 5631, '碧海茫茫図', UNIX_TIMESTAMP()),
+	(506, 1504, '幻想響霊幕', UNIX_TIMESTAMP()),
+	(803, 1801, '霞鳳風月触', UNIX_TIMESTAMP()),
+	(994, 993, '蒼穹吞噬者', UNIX_TIMESTAMP()),
+	(385, 6378, '英知蓄積丸', UNIX_TIMESTAMP()),
+	(458, 6451, '夢幻流離詩', UNIX_TIMESTAMP()),
+	(30, 2027, '銀河超光速', UNIX_TIMESTAMP()),
+	(551, 5545, '雷霆神域概', UNIX_TIMESTAMP()),
+	(109, 108, '碧水迷霧丸', UNIX_TIMESTAMP()),
+	(160, 7152, '聖剣神話伝', UNIX_TIMESTAMP()),
+	(184, 1182, '華麗舞踊団', UNIX_TIMESTAMP()),
+	(140, 139, '悠久時空枠', UNIX_TIMESTAMP()),
+	(171, 2168, '幻想録音樂', UNIX_TIMESTAMP()),
+	(295, 2292, '黄金魂守護', UNIX_TIMESTAMP()),
+	(219, 7211, '翠竹百川館', UNIX_TIMESTAMP()),
+	(666, 4661, '星辰調律師', UNIX_TIMESTAMP()),
+	(67, 7059, '七色流琉璃', UNIX_TIMESTAMP()),
+	(308, 307, '龍神幻界界', UNIX_TIMESTAMP()),
+	(498, 7490, '花園還童液', UNIX_TIMESTAMP()),
+	(852, 4847, '古文豪占術', UNIX_TIMESTAMP()),
+	(626, 625, '珍獣戯画輪', UNIX_TIMESTAMP()),
+	(124, 5118, '塔宇青楼計', UNIX_TIMESTAMP()),
+	(656, 2653, '天香蓮華楼', UNIX_TIMESTAMP()),
+	(290, 6283, '鉄砦鋼心心', UNIX_TIMESTAMP()),
+	(949, 2946, '奇跡悠久壱', UNIX_TIMESTAMP()),
+	(294, 1292, '封魔聖王槍', UNIX_TIMESTAMP()),
+	(663, 4658, '春風悠然寺', UNIX_TIMESTAMP()),
+	(194, 7186, '航宇志稲撫', UNIX_TIMESTAMP()),
+	(842, 5836, '神秘扉解放', UNIX_TIMESTAMP()),
+	(678, 3674, '絶対超現実', UNIX_TIMESTAMP()),
+	(19, 7011, '万華鏡症候群', UNIX_TIMESTAMP()),
+	(661, 2658, '妄覚生成装置', UNIX_TIMESTAMP()),
+	(484, 5478, '空中補骨材', UNIX_TIMESTAMP()),
+	(427, 4422, '音響攪乱装置', UNIX_TIMESTAMP()),
+	(101, 5095, '原子粒子駆動器', UNIX_TIMESTAMP()),
+	(975, 2972, '意識脱出補助装置', UNIX_TIMESTAMP()),
+	(24, 1022, '心象画像再現装置', UNIX_TIMESTAMP()),
+	(686, 3682, '心理時間矯正装置', UNIX_TIMESTAMP()),
+	(929, 2926, '歎長化現象', UNIX_TIMESTAMP()),
+	(5, 4, '魔触創生術', UNIX_TIMESTAMP()),
+	(628, 627, '奥義御守護', UNIX_TIMESTAMP()),
+	(316, 315, '星複合押収装置', UNIX_TIMESTAMP()),
+	(848, 847, '磁気共鳴検査', UNIX_TIMESTAMP()),
+	(502, 4497, '音波探知装置', UNIX_TIMESTAMP()),
+	(916, 3912, '電子応急復旧機', UNIX_TIMESTAMP()),
+	(883, 6876, '対面感知装置', UNIX_TIMESTAMP()),
+	(92, 6085, '本場餃子研究', UNIX_TIMESTAMP()),
+	(127, 3123, '居合道修行', UNIX_TIMESTAMP()),
+	(52, 2049, '日本画作品', UNIX_TIMESTAMP()),
+	(423, 7415, '漫画制作術', UNIX_TIMESTAMP()),
+	(886, 885, '映画撮影術', UNIX_TIMESTAMP()),
+	(129, 2126, '鉄道模型製作', UNIX_TIMESTAMP()),
+	(295, 1293, '野球戦術解析', UNIX_TIMESTAMP()),
+	(603, 2600, '情報統合体', UNIX_TIMESTAMP()),
+	(958, 5952, '情報共有網', UNIX_TIMESTAMP()),
+	(979, 3975, '潜在化攻撃', UNIX_TIMESTAMP()),
+	(734, 1732, '反転年代記', UNIX_TIMESTAMP()),
+	(116, 7108, '意味類似度', UNIX_TIMESTAMP()),
+	(877, 3873, '認知生成器', UNIX_TIMESTAMP()),
+	(533, 6526, '集団意識形成', UNIX_TIMESTAMP()),
+	(699, 2696, '無骨脊椎生物', UNIX_TIMESTAMP()),
+	(326, 325, '構造図像主義', UNIX_TIMESTAMP()),
+	(466, 5460, '念波制御器', UNIX_TIMESTAMP()),
+	(915, 1913, '水煙獲得装置', UNIX_TIMESTAMP()),
+	(271, 5265, '空中物体推進機', UNIX_TIMESTAMP()),
+	(525, 2522, '力場増幅装置', UNIX_TIMESTAMP()),
+	(51, 5045, '時空間解析器', UNIX_TIMESTAMP()),
+	(301, 7293, '音楽情報記録装置', UNIX_TIMESTAMP()),
+	(812, 3808, '無重力生成機', UNIX_TIMESTAMP()),
+	(676, 3672, '多元次元通信機', UNIX_TIMESTAMP()),
+	(502, 501, '透明化合金材料', UNIX_TIMESTAMP()),
+	(226, 1224, '量子計算機', UNIX_TIMESTAMP()),
+	(449, 6442, '認識増幅装置', UNIX_TIMESTAMP()),
+	(34, 6027, '臨時時空転送機', UNIX_TIMESTAMP()),
+	(382, 2379, '生命再生装置', UNIX_TIMESTAMP()),
+	(374, 5368, '魔力結晶体', UNIX_TIMESTAMP()),
+	(54, 4049, '宇宙風力発電', UNIX_TIMESTAMP()),
+	(788, 2785, '力場反転装置', UNIX_TIMESTAMP()),
+	(84, 5078, '超音速分割', UNIX_TIMESTAMP()),
+	(276, 4271, '無限小変化', UNIX_TIMESTAMP()),
+	(25, 2022, '無重力粒子', UNIX_TIMESTAMP()),
+	(66, 7058, '光子安定化', UNIX_TIMESTAMP()),
+	(992, 6985, '時空間平面', UNIX_TIMESTAMP()),
+	(425, 4420, '物質生成装置', UNIX_TIMESTAMP()),
+	(31, 6024, '宇宙航行推進', UNIX_TIMESTAMP()),
+	(9, 7001, '空間抗力素', UNIX_TIMESTAMP()),
+	(307, 306, '量子観測機', UNIX_TIMESTAMP()),
+	(287, 7279, '時空結晶体', UNIX_TIMESTAMP()),
+	(108, 3104, '統合情報網', UNIX_TIMESTAMP()),
+	(491, 3487, '地理情報網', UNIX_TIMESTAMP()),
+	(258, 257, '心理共振装置', UNIX_TIMESTAMP()),
+	(395, 1393, '植物繊維細胞', UNIX_TIMESTAMP()),
+	(288, 3284, '社交情報網', UNIX_TIMESTAMP()),
+	(944, 3940, '集中脳波装置', UNIX_TIMESTAMP()),
+	(221, 4216, '密度変換機', UNIX_TIMESTAMP()),
+	(265, 7257, '情報解明装置', UNIX_TIMESTAMP()),
+	(516, 1514, '平行次元探査器', UNIX_TIMESTAMP()),
+	(588, 5582, '純化天然物質', UNIX_TIMESTAMP()),
+	(136, 3132, '声波振動発生器', UNIX_TIMESTAMP()),
+	(83, 1081, '量子合成材料', UNIX_TIMESTAMP()),
+	(451, 2448, '映像音響同期機', UNIX_TIMESTAMP()),
+	(132, 1130, '最大共通約数', UNIX_TIMESTAMP()),
+	(482, 4477, '蒸気動力孵化装置', UNIX_TIMESTAMP()),
+	(580, 2577, '幻惑封印符', UNIX_TIMESTAMP()),
+	(757, 5751, '念動飛行器', UNIX_TIMESTAMP()),
+	(907, 4902, '時空位相器', UNIX_TIMESTAMP()),
+	(755, 1753, '神聖予言文', UNIX_TIMESTAMP()),
+	(527, 2524, '燃焔温度調節器', UNIX_TIMESTAMP()),
+	(225, 1223, '怪力強化薬', UNIX_TIMESTAMP()),
+	(609, 5603, '干渉振動発生機', UNIX_TIMESTAMP()),
+	(380, 379, '妖獣制御帯', UNIX_TIMESTAMP()),
+	(242, 4237, '鋼鉄装甲獣', UNIX_TIMESTAMP()),
+	(28, 27, '創造力拡張装置', UNIX_TIMESTAMP()),
+	(782, 781, '解析駆動型', UNIX_TIMESTAMP()),
+	(737, 1735, '潜在的要因', UNIX_TIMESTAMP()),
+	(270, 3266, '国際化対応', UNIX_TIMESTAMP()),
+	(327, 4322, '複素数解析', UNIX_TIMESTAMP()),
+	(228, 7220, '省電力設計', UNIX_TIMESTAMP()),
+	(160, 1158, '液体高分子化合物', UNIX_TIMESTAMP()),
+	(582, 6575, '鋼鉄重合体', UNIX_TIMESTAMP()),
+	(167, 7159, '有機電解浄化', UNIX_TIMESTAMP()),
+	(118, 6111, '応用心理情報', UNIX_TIMESTAMP()),
+	(31, 1029, '自律神経回路', UNIX_TIMESTAMP()),
+	(636, 635, '統合電子回路', UNIX_TIMESTAMP()),
+	(8, 2005, '感覚遮断通信', UNIX_TIMESTAMP()),
+	(253, 1251, '無線充電転送', UNIX_TIMESTAMP()),
+	(918, 2915, '多元次元暗号', UNIX_TIMESTAMP()),
+	(86, 1084, '遺伝子早期警報', UNIX_TIMESTAMP()),
+	(591, 1589, '量子分子通信', UNIX_TIMESTAMP()),
+	(216, 215, '社会資源最適化', UNIX_TIMESTAMP()),
+	(613, 2610, '高温超伝導体', UNIX_TIMESTAMP()),
+	(736, 2733, '次元転移装置', UNIX_TIMESTAMP()),
+	(302, 1300, '超高速遊電子', UNIX_TIMESTAMP()),
+	(843, 6836, '分子激震装置', UNIX_TIMESTAMP()),
+	(358, 7350, '心理共鳴波', UNIX_TIMESTAMP()),
+	(587, 3583, '情報遺伝子', UNIX_TIMESTAMP()),
+	(38, 3034, '超臨界圧力器', UNIX_TIMESTAMP()),
+	(432, 3428, '細胞瞬間回環', UNIX_TIMESTAMP()),
+	(702, 5696, '魔力共鳴網', UNIX_TIMESTAMP()),
+	(662, 1660, '多重相転位体', UNIX_TIMESTAMP()),
+	(422, 6415, '量子認識応答', UNIX_TIMESTAMP()),
+	(695, 694, '精神分子境界', UNIX_TIMESTAMP()),
+	(232, 3228, '超音速融解装置', UNIX_TIMESTAMP()),
+	(400, 6393, '高密度時間軸', UNIX_TIMESTAMP()),
+	(276, 4271, '情報惑星網', UNIX_TIMESTAMP()),
+	(631, 2628, '超反射光波', UNIX_TIMESTAMP()),
+	(215, 5209, '宇宙磁力結節', UNIX_TIMESTAMP()),
+	(45, 44, '燃焼器検出', UNIX_TIMESTAMP()),
+	(299, 298, '計測計画書', UNIX_TIMESTAMP()),
+	(338, 7330, '発電効率化', UNIX_TIMESTAMP()),
+	(751, 2748, '電圧定量化', UNIX_TIMESTAMP()),
+	(766, 1764, '冷却効果係数', UNIX_TIMESTAMP()),
+	(327, 5321, '早期堵塞予測', UNIX_TIMESTAMP()),
+	(871, 2868, '浸透測定器', UNIX_TIMESTAMP()),
+	(301, 6294, '副産物質量', UNIX_TIMESTAMP()),
+	(868, 867, '揮発速度低減', UNIX_TIMESTAMP()),
+	(20, 19, '集積度評価', UNIX_TIMESTAMP()),
+	(569, 4564, '解析評定値', UNIX_TIMESTAMP()),
+	(208, 7200, '材料混合効果', UNIX_TIMESTAMP()),
+	(405, 6398, '石炭品質分類', UNIX_TIMESTAMP()),
+	(781, 2778, '油圧駆動機', UNIX_TIMESTAMP()),
+	(536, 6529, '蒸気発生温度', UNIX_TIMESTAMP()),
+	(387, 7379, '構造強度指数', UNIX_TIMESTAMP()),
+	(884, 2881, '振動抑制装置', UNIX_TIMESTAMP()),
+	(315, 1313, '液体散布面積', UNIX_TIMESTAMP()),
+	(176, 175, '民間施設運営', UNIX_TIMESTAMP()),
+	(87, 4082, '星斑症候群', UNIX_TIMESTAMP()),
+	(11, 5005, '無重力装置', UNIX_TIMESTAMP()),
+	(394, 5388, '総合潜在能力指数', UNIX_TIMESTAMP()),
+	(170, 5164, '夢幻視覚装置', UNIX_TIMESTAMP()),
+	(195, 3191, '未来望遠鏡', UNIX_TIMESTAMP()),
+	(101, 6094, '心灵感应装置', UNIX_TIMESTAMP()),
+	(926, 925, '元素書記術', UNIX_TIMESTAMP()),
+	(444, 6437, '思考拡張器', UNIX_TIMESTAMP()),
+	(228, 6221, '影域高度制御装置', UNIX_TIMESTAMP()),
+	(649, 3645, '無名変調装置', UNIX_TIMESTAMP()),
+	(817, 816, '反重力合金', UNIX_TIMESTAMP()),
+	(210, 3206, '超現実体験装置', UNIX_TIMESTAMP()),
+	(54, 53, '蒸気圧制御装置', UNIX_TIMESTAMP()),
+	(261, 260, '経済格差指標', UNIX_TIMESTAMP()),
+	(358, 6351, '宇宙探査船舶', UNIX_TIMESTAMP()),
+	(745, 3741, '声紋認識装置', UNIX_TIMESTAMP()),
+	(922, 3918, '自動調光調整機構', UNIX_TIMESTAMP()),
+	(691, 3687, '精神経図譜', UNIX_TIMESTAMP()),
+	(703, 6696, '逆反転作用素', UNIX_TIMESTAMP()),
+	(779, 1777, '拡張抵抗導率', UNIX_TIMESTAMP()),
+	(52, 51, '平行行列因子', UNIX_TIMESTAMP()),
+	(265, 5259, '超重力摩擦', UNIX_TIMESTAMP()),
+	(740, 1738, '結晶滲透力', UNIX_TIMESTAMP()),
+	(53, 4048, '基本結合角度', UNIX_TIMESTAMP()),
+	(441, 1439, '光強度減衰', UNIX_TIMESTAMP()),
+	(143, 142, '界面相互共鳴', UNIX_TIMESTAMP()),
+	(483, 1481, '拡散複素成分', UNIX_TIMESTAMP()),
+	(19, 5013, '高次元場残差', UNIX_TIMESTAMP()),
+	(573, 6566, '形態分類関数', UNIX_TIMESTAMP()),
+	(449, 6442, '軟部滑膜内皮', UNIX_TIMESTAMP()),
+	(403, 6396, '階級間接連鎖', UNIX_TIMESTAMP()),
+	(450, 4445, '離散化変位差', UNIX_TIMESTAMP()),
+	(718, 6711, '臨界散文反応', UNIX_TIMESTAMP()),
+	(221, 6214, '電場強度瞬時値', UNIX_TIMESTAMP()),
+	(200, 6193, '蒸発融合比率', UNIX_TIMESTAMP()),
+	(304, 3300, '仮想現実構築', UNIX_TIMESTAMP()),
+	(265, 1263, '量子通信網', UNIX_TIMESTAMP()),
+	(438, 5432, '引力波制御', UNIX_TIMESTAMP()),
+	(394, 4389, '意識遠隔操作', UNIX_TIMESTAMP()),
+	(288, 4283, '進化曲線解析', UNIX_TIMESTAMP()),
+	(645, 3641, '宇宙銀河図譜', UNIX_TIMESTAMP()),
+	(111, 7103, '非可逆情報圧縮', UNIX_TIMESTAMP()),
+	(695, 5689, '量子暗号解読', UNIX_TIMESTAMP()),
+	(758, 6751, '情報時空解析', UNIX_TIMESTAMP()),
+	(281, 2278, '心因反応指標', UNIX_TIMESTAMP()),
+	(140, 6133, '量子情報処理', UNIX_TIMESTAMP()),
+	(450, 5444, '創造思考探査', UNIX_TIMESTAMP()),
+	(89, 5083, '生命透明化', UNIX_TIMESTAMP()),
+	(478, 3474, '経済成長率', UNIX_TIMESTAMP()),
+	(825, 4820, '酸化還元反応', UNIX_TIMESTAMP()),
+	(178, 5172, '主題的内容', UNIX_TIMESTAMP()),
+	(1, 2997, '内線的描写', UNIX_TIMESTAMP()),
+	(434, 3430, '融鉱炉制御', UNIX_TIMESTAMP()),
+	(456, 7448, '半導体超伝導', UNIX_TIMESTAMP()),
+	(848, 1846, '直交空間写像', UNIX_TIMESTAMP()),
+	(364, 4359, '音響空間解析', UNIX_TIMESTAMP()),
+	(247, 2244, '心理劇印象', UNIX_TIMESTAMP()),
+	(336, 2333, '経済影響指標', UNIX_TIMESTAMP()),
+	(546, 545, '社会再生力', UNIX_TIMESTAMP()),
+	(695, 4690, '宇宙公約数', UNIX_TIMESTAMP()),
+	(289, 6282, '生命履歴曲線', UNIX_TIMESTAMP()),
+	(17, 16, '音響抽象化', UNIX_TIMESTAMP()),
+	(468, 467, '視線補完係数', UNIX_TIMESTAMP()),
+	(60, 7052, '社会鏡化現象', UNIX_TIMESTAMP()),
+	(340, 2337, '文字解析行列', UNIX_TIMESTAMP()),
+	(449, 6442, '知識蓄積定理', UNIX_TIMESTAMP()),
+	(849, 4844, '社会安心度指数', UNIX_TIMESTAMP()),
+	(414, 4409, '強引宇宙仮説', UNIX_TIMESTAMP()),
+	(637, 6630, '時空解放機構', UNIX_TIMESTAMP()),
+	(573, 6566, '音響波挿入効果', UNIX_TIMESTAMP()),
+	(311, 2308, '自律書記装置', UNIX_TIMESTAMP()),
+	(1, 3996, '情報核心方程式', UNIX_TIMESTAMP()),
+	(261, 260, '階層的感覚演算', UNIX_TIMESTAMP()),
+	(588, 1586, '超音波分割装置', UNIX_TIMESTAMP()),
+	(716, 6709, '容積肥沼化合物', UNIX_TIMESTAMP()),
+	(614, 2611, '精神融合現象', UNIX_TIMESTAMP()),
+	(415, 6408, '音響共鳴回路', UNIX_TIMESTAMP()),
+	(271, 4266, '逆転重力装置', UNIX_TIMESTAMP()),
+	(33, 5027, '高密度脈動破壊装置', UNIX_TIMESTAMP()),
+	(830, 2827, '渦流加速触媒', UNIX_TIMESTAMP()),
+	(931, 930, '超重力生成装置', UNIX_TIMESTAMP()),
+	(195, 2192, '心理反転波動', UNIX_TIMESTAMP()),
+	(254, 2251, '超音波干渉剤', UNIX_TIMESTAMP()),
+	(90, 4085, '経済恐慌感染', UNIX_TIMESTAMP()),
+	(343, 342, '文化演算子', UNIX_TIMESTAMP()),
+	(484, 1482, '遺伝子操作', UNIX_TIMESTAMP()),
+	(733, 1731, '脳波分析機', UNIX_TIMESTAMP()),
+	(602, 1600, '遺伝子再編成装置', UNIX_TIMESTAMP()),
+	(90, 4085, '宇宙間通信装置', UNIX_TIMESTAMP()),
+	(435, 5429, '環境恒温装置', UNIX_TIMESTAMP()),
+	(931, 3927, '初期宇宙胚再現機', UNIX_TIMESTAMP()),
+	(637, 2634, '高密度化粧品材料', UNIX_TIMESTAMP()),
+	(795, 4790, '磁界制御装置', UNIX_TIMESTAMP()),
+	(569, 4564, '空中精神干渉装置', UNIX_TIMESTAMP()),
+	(154, 4149, '自動交差分析装置', UNIX_TIMESTAMP()),
+	(552, 6545, '空中環境再生成機', UNIX_TIMESTAMP()),
+	(965, 964, '生物遺産解析', UNIX_TIMESTAMP()),
+	(224, 4219, '農業空間計画', UNIX_TIMESTAMP()),
+	(409, 4404, '火花摩擦軸', UNIX_TIMESTAMP()),
+	(122, 7114, '霧氣感測器', UNIX_TIMESTAMP()),
+	(903, 5897, '氣化金屬盤', UNIX_TIMESTAMP()),
+	(79, 78, '路面温度計', UNIX_TIMESTAMP()),
+	(279, 4274, '壓電扬聲器', UNIX_TIMESTAMP()),
+	(2, 1000, '手災濾波器', UNIX_TIMESTAMP()),
+	(698, 6691, '水花振蕩器', UNIX_TIMESTAMP()),
+	(8, 4003, '冷凝衝撞區', UNIX_TIMESTAMP()),
+	(948, 5942, '自阻尼補正器', UNIX_TIMESTAMP()),
+	(717, 716, '自激振盪器', UNIX_TIMESTAMP()),
+	(671, 5665, '細菌培養柄', UNIX_TIMESTAMP()),
+	(409, 1407, '目覺發射器', UNIX_TIMESTAMP()),
+	(31, 1029, '核融合燃料素子', UNIX_TIMESTAMP()),
+	(932, 931, '逆磁子発生器', UNIX_TIMESTAMP()),
+	(286, 2283, '生体器抑制装置', UNIX_TIMESTAMP()),
+	(243, 4238, '高分子凝集剤', UNIX_TIMESTAMP()),
+	(271, 3267, '音頭解析装置', UNIX_TIMESTAMP()),
+	(216, 215, '細胞再生触媒', UNIX_TIMESTAMP()),
+	(470, 6463, '地球重力中和装置', UNIX_TIMESTAMP()),
+	(391, 1389, '堆積圧縮分神器', UNIX_TIMESTAMP()),
+	(6, 5, '超広角撮影装置', UNIX_TIMESTAMP()),
+	(762, 1760, '電気分解応用装置', UNIX_TIMESTAMP()),
+	(315, 4310, '量子相互運動補完系', UNIX_TIMESTAMP()),
+	(225, 5219, '共生生物系統進化', UNIX_TIMESTAMP()),
+	(640, 5634, '波動変換共鳴機械', UNIX_TIMESTAMP()),
+	(470, 7462, '高感度感情認識装置', UNIX_TIMESTAMP()),
+	(680, 4675, '細胞局所治療注入器', UNIX_TIMESTAMP()),
+	(319, 2316, '平衡形態保持装置', UNIX_TIMESTAMP()),
+	(348, 2345, '液体吸収膨張装置', UNIX_TIMESTAMP()),
+	(998, 3994, '磁気共鳴増幅器', UNIX_TIMESTAMP()),
+	(78, 77, '構造物鑑別', UNIX_TIMESTAMP()),
+	(689, 3685, '目視眩逆円', UNIX_TIMESTAMP()),
+	(474, 473, '實務燃析力', UNIX_TIMESTAMP()),
+	(722, 1720, '急迫理耐格', UNIX_TIMESTAMP()),
+	(122, 5116, '無響煙双音', UNIX_TIMESTAMP()),
+	(225, 6218, '全照内算取', UNIX_TIMESTAMP()),
+	(542, 6535, '現態鉛合素', UNIX_TIMESTAMP()),
+	(296, 3292, '魔弾辿睨換', UNIX_TIMESTAMP()),
+	(71, 5065, '術語靴霧形', UNIX_TIMESTAMP()),
+	(443, 4438, '忍持冷扇木', UNIX_TIMESTAMP()),
+	(918, 1916, '墨研張公廠', UNIX_TIMESTAMP()),
+	(38, 2035, '江駒葡萄被', UNIX_TIMESTAMP()),
+	(238, 1236, '總機争軌排', UNIX_TIMESTAMP()),
+	(657, 5651, '塵環坂画哨', UNIX_TIMESTAMP()),
+	(181, 3177, '胆冠継選速', UNIX_TIMESTAMP()),
+	(816, 1814, '節網保為禅', UNIX_TIMESTAMP()),
+	(607, 4602, '触肢備覇従', UNIX_TIMESTAMP()),
+	(460, 6453, '霊子制御術', UNIX_TIMESTAMP()),
+	(539, 6532, '無媒起動器', UNIX_TIMESTAMP()),
+	(182, 1180, '生物記憶検証装置', UNIX_TIMESTAMP()),
+	(620, 619, '無断転成機', UNIX_TIMESTAMP()),
+	(255, 5249, '空気熱調整機器', UNIX_TIMESTAMP()),
+	(117, 4112, '情報精製機', UNIX_TIMESTAMP()),
+	(180, 3176, '義体統合装置', UNIX_TIMESTAMP()),
+	(491, 2488, '共振共軍機', UNIX_TIMESTAMP()),
+	(155, 4150, '衝撃熱変換装置', UNIX_TIMESTAMP()),
+	(545, 1543, '情景再生支援装置', UNIX_TIMESTAMP()),
+	(354, 7346, '雲核加速装置', UNIX_TIMESTAMP()),
+	(372, 5366, '透明奏生定機', UNIX_TIMESTAMP()),
+	(595, 4590, '量子乱数生成装置', UNIX_TIMESTAMP()),
+	(900, 2897, '化生晶核装置', UNIX_TIMESTAMP()),
+	(74, 73, '解読抽出装置', UNIX_TIMESTAMP()),
+	(662, 6655, '音波分析装置', UNIX_TIMESTAMP()),
+	(591, 590, '無重力磁気共鳴', UNIX_TIMESTAMP()),
+	(494, 2491, '連続反射抗鋼処理', UNIX_TIMESTAMP()),
+	(230, 1228, '液晶共焦点付与', UNIX_TIMESTAMP()),
+	(385, 384, '鉱物分解速度定理', UNIX_TIMESTAMP()),
+	(39, 4034, '経済動向指標解析', UNIX_TIMESTAMP()),
+	(396, 5390, '高圧電流防護装置', UNIX_TIMESTAMP()),
+	(399, 1397, '地震予測機械', UNIX_TIMESTAMP()),
+	(136, 4131, '新世代燃料電池', UNIX_TIMESTAMP()),
+	(340, 339, '高圧気相反応解析', UNIX_TIMESTAMP()),
+	(249, 248, '不可視神経制御装置', UNIX_TIMESTAMP()),
+	(240, 239, '合成有機金属化合物薬理', UNIX_TIMESTAMP()),
+	(253, 6246, '密度相転移', UNIX_TIMESTAMP()),
+	(627, 626, '四次元位相', UNIX_TIMESTAMP()),
+	(458, 457, '超音速収束', UNIX_TIMESTAMP()),
+	(340, 7332, '遺伝情報解析', UNIX_TIMESTAMP()),
+	(259, 7251, '平行次元橋', UNIX_TIMESTAMP()),
+	(352, 3348, '強磁気共鳴', UNIX_TIMESTAMP()),
+	(140, 3136, '原子核融合', UNIX_TIMESTAMP()),
+	(23, 7015, '音波位相制御', UNIX_TIMESTAMP()),
+	(17, 4012, '量子物体干渉', UNIX_TIMESTAMP()),
+	(531, 6524, '脳波伝達速度', UNIX_TIMESTAMP()),
+	(200, 4195, '重力反転場', UNIX_TIMESTAMP()),
+	(809, 4804, '反物質合成', UNIX_TIMESTAMP()),
+	(218, 7210, '超並列計算', UNIX_TIMESTAMP()),
+	(967, 966, '複合遺伝暗号', UNIX_TIMESTAMP()),
+	(153, 5147, '磁場抑止装置', UNIX_TIMESTAMP()),
+	(634, 633, '量子拡散反応', UNIX_TIMESTAMP()),
+	(287, 6280, '生物操縦直観', UNIX_TIMESTAMP()),
+	(375, 4370, '超微細物質', UNIX_TIMESTAMP()),
+	(14, 5008, '紅葉碧藍拳', UNIX_TIMESTAMP()),
+	(225, 2222, '大航海時代', UNIX_TIMESTAMP()),
+	(319, 318, '天地類翮衡', UNIX_TIMESTAMP()),
+	(543, 5537, '地球軸旋律', UNIX_TIMESTAMP()),
+	(498, 6491, '武芸飛躍術', UNIX_TIMESTAMP()),
+	(500, 7492, '空間残滓脳波', UNIX_TIMESTAMP()),
+	(742, 1740, '時空折衷揺蕩', UNIX_TIMESTAMP()),
+	(579, 3575, '非凡狂風掃野', UNIX_TIMESTAMP()),
+	(243, 6236, '天使墜落咆哮', UNIX_TIMESTAMP()),
+	(861, 6854, '神聖眷慕領域', UNIX_TIMESTAMP()),
+	(616, 2613, '天仙栄華苦労', UNIX_TIMESTAMP()),
+	(298, 5292, '太陽雷神戦闘機', UNIX_TIMESTAMP()),
+	(69, 1067, '技巧発光波動', UNIX_TIMESTAMP()),
+	(53, 1051, '地獄呪変絡繰', UNIX_TIMESTAMP()),
+	(916, 915, '宝剣魂命砲', UNIX_TIMESTAMP()),
+	(88, 5082, '風雲翔綸連携', UNIX_TIMESTAMP()),
+	(317, 6310, '経済天秤振舞', UNIX_TIMESTAMP()),
+	(254, 5248, '精神呪縛解放', UNIX_TIMESTAMP()),
+	(47, 5041, '革新創造幻想', UNIX_TIMESTAMP()),
+	(779, 5773, '燃焼推進器', UNIX_TIMESTAMP()),
+	(527, 526, '地球温暖化', UNIX_TIMESTAMP()),
+	(361, 3357, '遺伝子修正', UNIX_TIMESTAMP()),
+	(850, 3846, '量子暗号化', UNIX_TIMESTAMP()),
+	(130, 4125, '高速遺伝子解析', UNIX_TIMESTAMP()),
+	(189, 5183, '宇宙空間開発', UNIX_TIMESTAMP()),
+	(229, 228, '空中写真術', UNIX_TIMESTAMP()),
+	(740, 6733, '草葉手製術', UNIX_TIMESTAMP()),
+	(239, 1237, '水晶修復術', UNIX_TIMESTAMP()),
+	(984, 983, '爆音機関銃', UNIX_TIMESTAMP()),
+	(337, 7329, '青銅彫刻術', UNIX_TIMESTAMP()),
+	(921, 5915, '岩盤地図術', UNIX_TIMESTAMP()),
+	(711, 710, '遺伝子修正', UNIX_TIMESTAMP()),
+	(848, 3844, '知的所有権', UNIX_TIMESTAMP()),
+	(246, 2243, '心拍検出器', UNIX_TIMESTAMP()),
+	(533, 5527, '自己再生機能', UNIX_TIMESTAMP()),
+	(659, 3655, '突発的発光', UNIX_TIMESTAMP()),
+	(584, 583, '空間歪曲装置', UNIX_TIMESTAMP()),
+	(161, 160, '反抗的抑制', UNIX_TIMESTAMP()),
+	(149, 7141, '奇妙量子状態', UNIX_TIMESTAMP()),
+	(733, 5727, '神秘的位相', UNIX_TIMESTAMP()),
+	(991, 6984, '超音波破壊', UNIX_TIMESTAMP()),
+	(120, 119, '高次粒子反応', UNIX_TIMESTAMP()),
+	(971, 3967, '潜在的現実', UNIX_TIMESTAMP()),
+	(390, 3386, '直観反作用', UNIX_TIMESTAMP()),
+	(868, 4863, '数量濃度補正', UNIX_TIMESTAMP()),
+	(54, 5048, '時間突破装置', UNIX_TIMESTAMP()),
+	(884, 1882, '光電子透過', UNIX_TIMESTAMP()),
+	(364, 363, '音波吸収材', UNIX_TIMESTAMP()),
+	(651, 3647, '分別共振体', UNIX_TIMESTAMP()),
+	(505, 504, '陽子暗殺者', UNIX_TIMESTAMP()),
+	(706, 3702, '軌道共鳴衛星', UNIX_TIMESTAMP()),
+	(18, 2015, '多元化対策', UNIX_TIMESTAMP()),
+	(997, 996, '神経体反応器', UNIX_TIMESTAMP()),
+	(196, 3192, '心印象解析', UNIX_TIMESTAMP()),
+	(601, 6594, '多次元視覚', UNIX_TIMESTAMP()),
+	(206, 205, '遺伝因子解析', UNIX_TIMESTAMP()),
+	(695, 4690, '脳波情報解析', UNIX_TIMESTAMP()),
+	(685, 1683, '心肺機能評価', UNIX_TIMESTAMP()),
+	(509, 5503, '食品成分解析', UNIX_TIMESTAMP()),
+	(693, 3689, '蒼天魔術師', UNIX_TIMESTAMP()),
+	(692, 6685, '鳥瞰視角化', UNIX_TIMESTAMP()),
+	(738, 1736, '精神統御装置', UNIX_TIMESTAMP()),
+	(473, 1471, '情報寿命計算', UNIX_TIMESTAMP()),
+	(553, 2550, '自走式原子力機動装甲', UNIX_TIMESTAMP()),
+	(739, 4734, '飛躍共振装置', UNIX_TIMESTAMP()),
+	(538, 2535, '生物発光物質', UNIX_TIMESTAMP()),
+	(256, 6249, '遺伝子増幅抑制装置', UNIX_TIMESTAMP()),
+	(873, 1871, '暗号化通信機', UNIX_TIMESTAMP()),
+	(112, 1110, '情報消失遮断機', UNIX_TIMESTAMP()),
+	(285, 7277, '抽象思考機械', UNIX_TIMESTAMP()),
+	(995, 5989, '記憶再生成機', UNIX_TIMESTAMP()),
+	(250, 2247, '量子調節器', UNIX_TIMESTAMP()),
+	(385, 3381, '遺伝因子暗号解読', UNIX_TIMESTAMP()),
+	(189, 7181, '生体振動制御装置', UNIX_TIMESTAMP()),
+	(920, 3916, '形態変化触媒', UNIX_TIMESTAMP()),
+	(414, 4409, '電子脳刺激装置', UNIX_TIMESTAMP()),
+	(918, 5912, '物質相互作用解析', UNIX_TIMESTAMP()),
+	(312, 6305, '自然発生説', UNIX_TIMESTAMP()),
+	(586, 1584, '冥界接続装置', UNIX_TIMESTAMP()),
+	(519, 4514, '平行世界跳躍', UNIX_TIMESTAMP()),
+	(429, 3425, '多元宇宙調和', UNIX_TIMESTAMP()),
+	(164, 3160, '無意識合機', UNIX_TIMESTAMP()),
+	(92, 1090, '粒子加速器', UNIX_TIMESTAMP()),
+	(400, 3396, '濃縮微生物', UNIX_TIMESTAMP()),
+	(14, 13, '魔力演算装置', UNIX_TIMESTAMP()),
+	(170, 6163, '火雷嵐魔術', UNIX_TIMESTAMP()),
+	(794, 3790, '航空垂直離陸艦', UNIX_TIMESTAMP()),
+	(555, 6548, '蓄電池資源抽出機', UNIX_TIMESTAMP()),
+	(462, 6455, '静的条件反射素子', UNIX_TIMESTAMP()),
+	(269, 268, '直観計算機', UNIX_TIMESTAMP()),
+	(329, 3325, '多次元交換反応器', UNIX_TIMESTAMP()),
+	(689, 688, '生命再構築', UNIX_TIMESTAMP()),
+	(834, 2831, '超高次元算術', UNIX_TIMESTAMP()),
+	(115, 114, '超音速気泡', UNIX_TIMESTAMP()),
+	(496, 7488, '量子粒子解析', UNIX_TIMESTAMP()),
+	(713, 3709, '魔道環境管理', UNIX_TIMESTAMP()),
+	(214, 213, '心理結晶化', UNIX_TIMESTAMP()),
+	(127, 1125, '時空転移装置', UNIX_TIMESTAMP()),
+	(839, 6832, '魔力還元儀式', UNIX_TIMESTAMP()),
+	(157, 4152, '音気領域調整', UNIX_TIMESTAMP()),
+	(446, 445, '形態変換術', UNIX_TIMESTAMP()),
+	(607, 5601, '死霊召喚陣', UNIX_TIMESTAMP()),
+	(762, 761, '対魔射撃術', UNIX_TIMESTAMP()),
+	(587, 6580, '心霊操作装置', UNIX_TIMESTAMP()),
+	(116, 4111, '核融合炉結晶化装置', UNIX_TIMESTAMP()),
+	(982, 3978, '遺伝子改変防護殻', UNIX_TIMESTAMP()),
+	(861, 3857, '反融雪超音波発生器', UNIX_TIMESTAMP()),
+	(394, 4389, '無重力状態計測装置', UNIX_TIMESTAMP()),
+	(274, 7266, '非駆体寄生菌抑制剤', UNIX_TIMESTAMP()),
+	(112, 111, '無限次元量子計算機', UNIX_TIMESTAMP()),
+	(72, 7064, '瞬間健康再構築装置', UNIX_TIMESTAMP()),
+	(31, 1029, '磁場高圧電波治療器', UNIX_TIMESTAMP()),
+	(289, 288, '熱帯文明発展指標', UNIX_TIMESTAMP()),
+	(577, 576, '超空間通信中継器', UNIX_TIMESTAMP()),
+	(766, 765, '脳波発信量子増幅機', UNIX_TIMESTAMP()),
+	(632, 2629, '空中浮遊陸上輸送装置', UNIX_TIMESTAMP()),
+	(526, 6519, '民族幾何火術', UNIX_TIMESTAMP()),
+	(843, 2840, '結晶繊維造形', UNIX_TIMESTAMP()),
+	(148, 7140, '逆窒化調整器', UNIX_TIMESTAMP()),
+	(724, 723, '熱遺伝情報解析', UNIX_TIMESTAMP()),
+	(419, 3415, '心因干渉撮像', UNIX_TIMESTAMP()),
+	(904, 1902, '共鳴損失精度', UNIX_TIMESTAMP()),
+	(184, 4179, '無心産業機能', UNIX_TIMESTAMP()),
+	(223, 222, '直交懐古過程', UNIX_TIMESTAMP()),
+	(163, 1161, '濃縮森菌培地', UNIX_TIMESTAMP()),
+	(942, 5936, '時空固定観測機', UNIX_TIMESTAMP()),
+	(397, 6390, '密度医療器具', UNIX_TIMESTAMP()),
+	(813, 4808, '生体負荷指数', UNIX_TIMESTAMP()),
+	(4, 3, '資源持続利用指数', UNIX_TIMESTAMP()),
+	(112, 2109, '無限言葉集市', UNIX_TIMESTAMP()),
+	(560, 2557, '宇宙航行士', UNIX_TIMESTAMP()),
+	(542, 4537, '文字解析機', UNIX_TIMESTAMP()),
+	(412, 1410, '悪魔空間現象', UNIX_TIMESTAMP()),
+	(388, 4383, '収集自律機械', UNIX_TIMESTAMP()),
+	(934, 933, '未知生命種', UNIX_TIMESTAMP()),
+	(618, 4613, '心霊探査装置', UNIX_TIMESTAMP()),
+	(208, 1206, '地獄迷宮系統', UNIX_TIMESTAMP()),
+	(376, 7368, '無限連鎖計算機', UNIX_TIMESTAMP()),
+	(477, 2474, '無線濃度制御', UNIX_TIMESTAMP()),
+	(588, 4583, '量子転送装置', UNIX_TIMESTAMP()),
+	(112, 4107, '遺伝情報解析', UNIX_TIMESTAMP()),
+	(864, 3860, '生体適応操縦', UNIX_TIMESTAMP()),
+	(702, 3698, '気候安定化装置', UNIX_TIMESTAMP()),
+	(735, 1733, '精神波装置', UNIX_TIMESTAMP()),
+	(999, 4994, '無重力航空', UNIX_TIMESTAMP()),
+	(533, 2530, '高密度質量分析', UNIX_TIMESTAMP()),
+	(450, 2447, '分子構造合成', UNIX_TIMESTAMP()),
+	(660, 4655, '無限空間探知', UNIX_TIMESTAMP()),
+	(986, 2983, '圧力調整注射装置', UNIX_TIMESTAMP()),
+	(411, 2408, '高分解能蛍光撮影', UNIX_TIMESTAMP()),
+	(509, 508, '量子重力場生成', UNIX_TIMESTAMP()),
+	(865, 864, '多元宇宙予測', UNIX_TIMESTAMP()),
+	(507, 1505, '時空正負子', UNIX_TIMESTAMP()),
+	(474, 4469, '魔力吸収器', UNIX_TIMESTAMP()),
+	(729, 2726, '大気粒子分解装置', UNIX_TIMESTAMP()),
+	(519, 6512, '精神維持装置', UNIX_TIMESTAMP()),
+	(463, 6456, '地脈探知器', UNIX_TIMESTAMP()),
+	(162, 4157, '古代遺物解読機', UNIX_TIMESTAMP()),
+	(607, 5601, '妖精群制御装置', UNIX_TIMESTAMP()),
+	(253, 6246, '血族再生装置', UNIX_TIMESTAMP()),
+	(328, 7320, '超重力装置', UNIX_TIMESTAMP()),
+	(210, 7202, '連鎖反応制御材料', UNIX_TIMESTAMP()),
+	(532, 4527, '量子通信機', UNIX_TIMESTAMP()),
+	(816, 5810, '宇宙物質変換機', UNIX_TIMESTAMP()),
+	(857, 6850, '神秘物質探査装置', UNIX_TIMESTAMP()),
+	(833, 4828, '遺伝子操作装置', UNIX_TIMESTAMP()),
+	(336, 6329, '透明光線発生装置', UNIX_TIMESTAMP()),
+	(544, 1542, '宇宙測量術', UNIX_TIMESTAMP()),
+	(426, 4421, '心霊交信術', UNIX_TIMESTAMP()),
+	(737, 6730, '無線通信術', UNIX_TIMESTAMP()),
+	(424, 2421, '石器製作術', UNIX_TIMESTAMP()),
+	(563, 1561, '遺伝子研究', UNIX_TIMESTAMP()),
+	(327, 1325, '水中探査術', UNIX_TIMESTAMP()),
+	(29, 2026, '千世界響音', UNIX_TIMESTAMP()),
+	(808, 1806, '無重力陶器', UNIX_TIMESTAMP()),
+	(212, 1210, '石化誘導体', UNIX_TIMESTAMP()),
+	(670, 6663, '暗黒連結体', UNIX_TIMESTAMP()),
+	(222, 3218, '石綿防護服', UNIX_TIMESTAMP()),
+	(480, 1478, '水晶遮蔽体', UNIX_TIMESTAMP()),
+	(267, 3263, '岩石伝導体', UNIX_TIMESTAMP()),
+	(618, 617, '亜空間潜航', UNIX_TIMESTAMP()),
+	(394, 5388, '電磁感応体', UNIX_TIMESTAMP()),
+	(503, 3499, '風力浮遊体', UNIX_TIMESTAMP()),
+	(386, 6379, '遺伝子病因', UNIX_TIMESTAMP()),
+	(490, 2487, '置換液強度', UNIX_TIMESTAMP()),
+	(569, 1567, '衝撃反応速度', UNIX_TIMESTAMP()),
+	(803, 5797, '被曝度制御', UNIX_TIMESTAMP()),
+	(27, 2024, '捕食者回避', UNIX_TIMESTAMP()),
+	(886, 3882, '酸化還元効率', UNIX_TIMESTAMP()),
+	(816, 5810, '発行資本金', UNIX_TIMESTAMP()),
+	(263, 1261, '排気冷却固化', UNIX_TIMESTAMP()),
+	(196, 2193, '時間軌跡制御', UNIX_TIMESTAMP()),
+	(829, 6822, '省資源再利用', UNIX_TIMESTAMP()),
+	(600, 6593, '廃棄燃料再処理', UNIX_TIMESTAMP()),
+	(20, 2017, '造船強度抽出', UNIX_TIMESTAMP()),
+	(474, 3470, '宇宙粒子追跡', UNIX_TIMESTAMP()),
+	(899, 3895, '焦電励起共鳴', UNIX_TIMESTAMP()),
+	(711, 1709, '音声周波圧縮', UNIX_TIMESTAMP()),
+	(526, 2523, '放射光散乱', UNIX_TIMESTAMP()),
+	(826, 4821, '伝導抵抗選択', UNIX_TIMESTAMP()),
+	(992, 5986, '分散刺激感応', UNIX_TIMESTAMP()),
+	(785, 4780, '可塑変形結晶', UNIX_TIMESTAMP()),
+	(736, 3732, '粗砂状形質', UNIX_TIMESTAMP()),
+	(935, 5929, '急速燃焼補正', UNIX_TIMESTAMP()),
+	(679, 4674, '凝集心理状態', UNIX_TIMESTAMP()),
+	(950, 2947, '経済仮想現実', UNIX_TIMESTAMP()),
+	(378, 377, '自然錬金術', UNIX_TIMESTAMP()),
+	(709, 1707, '多次元位相', UNIX_TIMESTAMP()),
+	(839, 838, '脳細胞再構築', UNIX_TIMESTAMP()),
+	(999, 2996, '細胞経過時間', UNIX_TIMESTAMP()),
+	(856, 3852, '非対象偏斜', UNIX_TIMESTAMP()),
+	(313, 5307, '適応認識器', UNIX_TIMESTAMP()),
+	(48, 6041, '反復終着点', UNIX_TIMESTAMP()),
+	(383, 5377, '反飛行定理', UNIX_TIMESTAMP()),
+	(493, 1491, '創意発揮装置', UNIX_TIMESTAMP()),
+	(514, 3510, '嗅覚追跡実験', UNIX_TIMESTAMP()),
+	(474, 1472, '視覚刺激生成', UNIX_TIMESTAMP()),
+	(408, 5402, '感情表現解析', UNIX_TIMESTAMP()),
+	(102, 101, '行動統合回路', UNIX_TIMESTAMP()),
+	(317, 4312, '情報露出分析', UNIX_TIMESTAMP()),
+	(710, 2707, '逆認識語彙', UNIX_TIMESTAMP()),
+	(941, 5935, '空想行動推測', UNIX_TIMESTAMP()),
+	(168, 6161, '認知調整機能', UNIX_TIMESTAMP()),
+	(612, 611, '遺伝情報創造', UNIX_TIMESTAMP()),
+	(358, 3354, '共振共感認識', UNIX_TIMESTAMP()),
+	(393, 1391, '時空軌道計測', UNIX_TIMESTAMP()),
+	(964, 6957, '知覚測定尺度', UNIX_TIMESTAMP()),
+	(780, 6773, '感情支配回路', UNIX_TIMESTAMP()),
+	(496, 7488, '翻鈕搬装鋳造', UNIX_TIMESTAMP()),
+	(524, 5518, '流化副樹紫', UNIX_TIMESTAMP()),
+	(517, 1515, '幽霊探知機', UNIX_TIMESTAMP()),
+	(930, 929, '炉冷却装置', UNIX_TIMESTAMP()),
+	(121, 7113, '風力発電装置', UNIX_TIMESTAMP()),
+	(505, 504, '植物生殖研究', UNIX_TIMESTAMP()),
+	(794, 4789, '無重力環境実験', UNIX_TIMESTAMP()),
+	(891, 4886, '細胞再生医療', UNIX_TIMESTAMP()),
+	(347, 5341, '土壌浄化装置', UNIX_TIMESTAMP()),
+	(18, 1016, '宇宙探査機', UNIX_TIMESTAMP()),
+	(262, 6255, '音響信号処理', UNIX_TIMESTAMP()),
+	(927, 5921, '絶縁材料開発', UNIX_TIMESTAMP()),
+	(446, 4441, '陽動撤退術', UNIX_TIMESTAMP()),
+	(471, 3467, '心頭滅却術', UNIX_TIMESTAMP()),
+	(290, 5284, '耀光凝壁術', UNIX_TIMESTAMP()),
+	(6, 4001, '瞬速遠隔術', UNIX_TIMESTAMP()),
+	(988, 987, '柔鋼抱擁術', UNIX_TIMESTAMP()),
+	(466, 3462, '闇滅霊域術', UNIX_TIMESTAMP()),
+	(707, 6700, '完璧非難符', UNIX_TIMESTAMP()),
+	(661, 6654, '単刀直入術', UNIX_TIMESTAMP()),
+	(747, 4742, '昇華無限追撃術', UNIX_TIMESTAMP()),
+	(867, 5861, '精神結晶化', UNIX_TIMESTAMP()),
+	(693, 1691, '超音波追跡装置', UNIX_TIMESTAMP()),
+	(185, 4180, '生体再生因子', UNIX_TIMESTAMP()),
+	(787, 5781, '縮地吸引装置', UNIX_TIMESTAMP()),
+	(927, 3923, '意識高速伝達', UNIX_TIMESTAMP()),
+	(589, 1587, '霊的共鳴結合装置', UNIX_TIMESTAMP()),
+	(983, 3979, '奇跡発生因子', UNIX_TIMESTAMP()),
+	(404, 6397, '幽体遠隔操作機器', UNIX_TIMESTAMP()),
+	(689, 3685, '次元干渉装置', UNIX_TIMESTAMP()),
+	(885, 884, '魔力調節因子', UNIX_TIMESTAMP()),
+	(864, 5858, '高速空間移動装置', UNIX_TIMESTAMP()),
+	(122, 4117, '無重力生成装置', UNIX_TIMESTAMP()),
+	(146, 5140, '灼熱反応体制', UNIX_TIMESTAMP()),
+	(276, 2273, '暗黒粒子衝突実験', UNIX_TIMESTAMP()),
+	(527, 6520, '神経反応追跡装置', UNIX_TIMESTAMP()),
+	(600, 4595, '恒久結晶核生成装置', UNIX_TIMESTAMP()),
+	(322, 4317, '思念透過装置', UNIX_TIMESTAMP()),
+	(534, 1532, '超生命維持装置', UNIX_TIMESTAMP()),
+	(813, 2810, '量子風穴生成設備', UNIX_TIMESTAMP()),
+	(110, 1108, '宇宙皮膚再構築', UNIX_TIMESTAMP()),
+	(461, 2458, '精神創造因子', UNIX_TIMESTAMP()),
+	(909, 3905, '透明膜生成装置', UNIX_TIMESTAMP()),
+	(188, 1186, '層圏放射線', UNIX_TIMESTAMP()),
+	(113, 112, '渦巻式流体', UNIX_TIMESTAMP()),
+	(424, 423, '地震波影響', UNIX_TIMESTAMP()),
+	(525, 3521, '生命共鳴体', UNIX_TIMESTAMP()),
+	(464, 4459, '要素環境感知', UNIX_TIMESTAMP()),
+	(958, 4953, '星間遺伝子結合', UNIX_TIMESTAMP()),
+	(564, 2561, '芸術共鳴装置', UNIX_TIMESTAMP()),
+	(897, 1895, '無限知覚網', UNIX_TIMESTAMP()),
+	(633, 6626, '歴史変動算術', UNIX_TIMESTAMP()),
+	(716, 715, '社会共感情報結集', UNIX_TIMESTAMP()),
+	(873, 4868, '情熱指引発信機', UNIX_TIMESTAMP()),
+	(918, 1916, '観察外部偏在', UNIX_TIMESTAMP()),
+	(402, 6395, '集団創造意識', UNIX_TIMESTAMP()),
+	(623, 2620, '燃焼補助剤', UNIX_TIMESTAMP()),
+	(991, 990, '機密伝送装置', UNIX_TIMESTAMP()),
+	(496, 2493, '溶接加工装置', UNIX_TIMESTAMP()),
+	(578, 2575, '精密定量器', UNIX_TIMESTAMP()),
+	(556, 1554, '光触媒効果', UNIX_TIMESTAMP()),
+	(907, 906, '磁気浮上装置', UNIX_TIMESTAMP()),
+	(98, 6091, '静音設計機構', UNIX_TIMESTAMP()),
+	(677, 3673, '複合試験装置', UNIX_TIMESTAMP()),
+	(695, 694, '浸透圧調節剤', UNIX_TIMESTAMP()),
+	(472, 6465, '衝撃吸収材料', UNIX_TIMESTAMP()),
+	(362, 3358, '振動制御器', UNIX_TIMESTAMP()),
+	(634, 633, '配線絶縁体', UNIX_TIMESTAMP()),
+	(516, 4511, '電波遮蔽鋼', UNIX_TIMESTAMP()),
+	(126, 7118, '水素蓄積装置', UNIX_TIMESTAMP()),
+	(933, 3929, '加速冷凍装置', UNIX_TIMESTAMP()),
+	(433, 5427, '単結晶成長装置', UNIX_TIMESTAMP()),
+	(214, 5208, '脳波分析器', UNIX_TIMESTAMP()),
+	(501, 5495, '影響抵抗素子', UNIX_TIMESTAMP()),
+	(741, 2738, '不凍剤添加剤', UNIX_TIMESTAMP()),
+	(257, 2254, '空間瞬間移動術', UNIX_TIMESTAMP()),
+	(816, 4811, '魂器研究会', UNIX_TIMESTAMP()),
+	(529, 1527, '旅行情報融合', UNIX_TIMESTAMP()),
+	(296, 2293, '音色要素解析', UNIX_TIMESTAMP()),
+	(60, 59, '無重力環境', UNIX_TIMESTAMP()),
+	(668, 3664, '現実逆転装置', UNIX_TIMESTAMP()),
+	(623, 3619, '時空操作術', UNIX_TIMESTAMP()),
+	(736, 5730, '心理応答分析', UNIX_TIMESTAMP()),
+	(294, 4289, '超音速振動波', UNIX_TIMESTAMP()),
+	(179, 4174, '空間書籍化', UNIX_TIMESTAMP()),
+	(339, 3335, '自己重結晶化', UNIX_TIMESTAMP()),
+	(475, 5469, '霊的共鳴装置', UNIX_TIMESTAMP()),
+	(186, 7178, '深層意識変容装置', UNIX_TIMESTAMP()),
+	(243, 7235, '空間情報網', UNIX_TIMESTAMP()),
+	(546, 2543, '水多層鬱積', UNIX_TIMESTAMP()),
+	(514, 1512, '熱力結晶化', UNIX_TIMESTAMP()),
+	(486, 7478, '風音変換機', UNIX_TIMESTAMP()),
+	(632, 2629, '時空転換装置', UNIX_TIMESTAMP()),
+	(451, 6444, '光領域制御', UNIX_TIMESTAMP()),
+	(350, 4345, '精神共振器', UNIX_TIMESTAMP()),
+	(28, 3024, '核融合燃料塊', UNIX_TIMESTAMP()),
+	(636, 6629, '音波透過装置', UNIX_TIMESTAMP()),
+	(956, 5950, '情報散逸予測', UNIX_TIMESTAMP()),
+	(212, 1210, '量子選択器', UNIX_TIMESTAMP()),
+	(350, 6343, '元素再構築装置', UNIX_TIMESTAMP()),
+	(907, 6900, '記憶情報優先化', UNIX_TIMESTAMP()),
+	(976, 6969, '地震安全構造体', UNIX_TIMESTAMP()),
+	(915, 2912, '量子暗号通信', UNIX_TIMESTAMP()),
+	(267, 1265, '真空重力制御', UNIX_TIMESTAMP()),
+	(125, 3121, '遺伝情報修正器', UNIX_TIMESTAMP()),
+	(70, 4065, '反物質抑制装置', UNIX_TIMESTAMP()),
+	(246, 6239, '心臓情報解析', UNIX_TIMESTAMP()),
+	(236, 6229, '未来予知行為', UNIX_TIMESTAMP()),
+	(349, 7341, '磁双極装置', UNIX_TIMESTAMP()),
+	(196, 6189, '分子再構成', UNIX_TIMESTAMP()),
+	(404, 1402, '神経遮断陣', UNIX_TIMESTAMP()),
+	(279, 3275, '反抗体模倣装置', UNIX_TIMESTAMP()),
+	(281, 280, '自己認識装置', UNIX_TIMESTAMP()),
+	(760, 4755, '超感覚知覚', UNIX_TIMESTAMP()),
+	(400, 5394, '戦車動力光機関', UNIX_TIMESTAMP()),
+	(136, 5130, '天体推測探査装置', UNIX_TIMESTAMP()),
+	(68, 2065, '生体衛星制御装置', UNIX_TIMESTAMP()),
+	(490, 1488, '冷凍風力発電機', UNIX_TIMESTAMP()),
+	(121, 120, '脳神経電位解析器', UNIX_TIMESTAMP()),
+	(142, 1140, '惑星表層環境観測機器', UNIX_TIMESTAMP()),
+	(904, 5898, '水中鉱物評価探査装置', UNIX_TIMESTAMP()),
+	(282, 7274, '高濃度臭気除去装置', UNIX_TIMESTAMP()),
+	(550, 2547, '天然物融点測定機', UNIX_TIMESTAMP()),
+	(904, 1902, '天体修復術', UNIX_TIMESTAMP()),
+	(219, 2216, '時空連携装置', UNIX_TIMESTAMP()),
+	(963, 962, '古文献復元', UNIX_TIMESTAMP()),
+	(453, 6446, '四大元素跳躍', UNIX_TIMESTAMP()),
+	(551, 2548, '懐中子量機', UNIX_TIMESTAMP()),
+	(104, 3100, '統合膜変換器', UNIX_TIMESTAMP()),
+	(721, 2718, '微動圧力指示器', UNIX_TIMESTAMP()),
+	(454, 7446, '脳量判定機', UNIX_TIMESTAMP()),
+	(960, 1958, '分子構造查定装置', UNIX_TIMESTAMP()),
+	(483, 3479, '量子空間位相発振子', UNIX_TIMESTAMP()),
+	(892, 3888, '動知覚認識装置', UNIX_TIMESTAMP()),
+	(206, 205, '疑似遺伝子構成計算器', UNIX_TIMESTAMP()),
+	(370, 6363, '二次元画像応用化技', UNIX_TIMESTAMP()),
+	(44, 2041, '同調周波摂動器', UNIX_TIMESTAMP()),
+	(45, 3041, '光知覚再生装置', UNIX_TIMESTAMP()),
+	(638, 637, '映像情報模擬受信機', UNIX_TIMESTAMP()),
+	(976, 3972, '強力放射線安定化剤', UNIX_TIMESTAMP()),
+	(412, 3408, '体感動画計測機', UNIX_TIMESTAMP()),
+	(614, 2611, '蒲公英温度', UNIX_TIMESTAMP()),
+	(318, 5312, '火狐噴射器', UNIX_TIMESTAMP()),
+	(531, 530, '音量探査器', UNIX_TIMESTAMP()),
+	(812, 811, '省略経路探査', UNIX_TIMESTAMP()),
+	(535, 2532, '時空連続体', UNIX_TIMESTAMP()),
+	(734, 2731, '硝煙傍観者', UNIX_TIMESTAMP()),
+	(991, 4986, '磁気誘導作用', UNIX_TIMESTAMP()),
+	(609, 5603, '水精霊呼吸', UNIX_TIMESTAMP()),
+	(619, 1617, '統合感知体', UNIX_TIMESTAMP()),
+	(264, 1262, '意識通信網', UNIX_TIMESTAMP()),
+	(560, 4555, '認識干渉体', UNIX_TIMESTAMP()),
+	(42, 5036, '精神拡散装置', UNIX_TIMESTAMP()),
+	(503, 5497, '空間歪曲場', UNIX_TIMESTAMP()),
+	(688, 1686, '念力制御素子', UNIX_TIMESTAMP()),
+	(661, 660, '神聖融合結界', UNIX_TIMESTAMP()),
+	(590, 1588, '念動指向装置', UNIX_TIMESTAMP()),
+	(364, 7356, '明晰情報選別', UNIX_TIMESTAMP()),
+	(188, 3184, '光核共鳴反応', UNIX_TIMESTAMP()),
+	(93, 2090, '位相転移境界', UNIX_TIMESTAMP()),
+	(661, 2658, '生体情報解析装置', UNIX_TIMESTAMP()),
+	(923, 4918, '量子分子補完装置', UNIX_TIMESTAMP()),
+	(354, 4349, '超高速時間調整器', UNIX_TIMESTAMP()),
+	(284, 5278, '音響共振消滅装置', UNIX_TIMESTAMP()),
+	(411, 2408, '微粒子共鳴増幅装置', UNIX_TIMESTAMP()),
+	(428, 3424, '宇宙磁場干渉制御器', UNIX_TIMESTAMP()),
+	(528, 6521, '環境音自動補正機能', UNIX_TIMESTAMP()),
+	(717, 716, '高精度分子分析器', UNIX_TIMESTAMP()),
+	(331, 7323, '非局所密度補正装置', UNIX_TIMESTAMP()),
+	(775, 1773, '逆転再生装置', UNIX_TIMESTAMP()),
+	(795, 794, '視覚鍛錬術', UNIX_TIMESTAMP()),
+	(323, 4318, '睡眠修復術', UNIX_TIMESTAMP()),
+	(326, 325, '心理転写術', UNIX_TIMESTAMP()),
+	(728, 3724, '意識調和術', UNIX_TIMESTAMP()),
+	(606, 2603, '悟空覇気術', UNIX_TIMESTAMP()),
+	(335, 7327, '生命反転装置', UNIX_TIMESTAMP()),
+	(278, 5272, '感応計算機', UNIX_TIMESTAMP()),
+	(488, 1486, '空間共鳴装置', UNIX_TIMESTAMP()),
+	(196, 3192, '時空連続体', UNIX_TIMESTAMP()),
+	(527, 526, '心拍嗅覚指針', UNIX_TIMESTAMP()),
+	(377, 6370, '品位修正器', UNIX_TIMESTAMP()),
+	(976, 3972, '知識創造器', UNIX_TIMESTAMP()),
+	(938, 1936, '想念反映装置', UNIX_TIMESTAMP()),
+	(428, 4423, '記憶騒音抑制装置', UNIX_TIMESTAMP()),
+	(81, 3077, '感情微調整装置', UNIX_TIMESTAMP()),
+	(596, 2593, '現実操縦装置', UNIX_TIMESTAMP()),
+	(652, 6645, '水気変換術', UNIX_TIMESTAMP()),
+	(545, 4540, '音波操手術', UNIX_TIMESTAMP()),
+	(811, 6804, '森羅万象観測機', UNIX_TIMESTAMP()),
+	(579, 3575, '念動力装置', UNIX_TIMESTAMP()),
+	(279, 5273, '月星位巡器', UNIX_TIMESTAMP()),
+	(323, 1321, '無限転生算', UNIX_TIMESTAMP()),
+	(942, 1940, '悪魔招喚典', UNIX_TIMESTAMP()),
+	(720, 719, '雷鳴撃双剣', UNIX_TIMESTAMP()),
+	(287, 2284, '空間破綻処理装置', UNIX_TIMESTAMP()),
+	(347, 3343, '磁気共鳴分析装置', UNIX_TIMESTAMP()),
+	(661, 1659, '界面形成制御材料', UNIX_TIMESTAMP()),
+	(388, 2385, '文化遺産研究', UNIX_TIMESTAMP()),
+	(402, 6395, '美術史研究', UNIX_TIMESTAMP()),
+	(881, 4876, '神経衝撃兵器', UNIX_TIMESTAMP()),
+	(945, 1943, '物質量子化', UNIX_TIMESTAMP()),
+	(683, 5677, '曼荼羅解封術', UNIX_TIMESTAMP()),
+	(680, 4675, '緊急情報伝達式', UNIX_TIMESTAMP()),
+	(335, 7327, '天空遺伝子', UNIX_TIMESTAMP()),
+	(882, 5876, '生命分子再構築装置', UNIX_TIMESTAMP()),
+	(309, 6302, '超音速透明化塗料', UNIX_TIMESTAMP()),
+	(395, 394, '時空歪曲器', UNIX_TIMESTAMP()),
+	(248, 6241, '超低温溶液', UNIX_TIMESTAMP()),
+	(301, 5295, '粒子軌跡状態推測', UNIX_TIMESTAMP()),
+	(141, 1139, '量子暗号通信方式', UNIX_TIMESTAMP()),
+	(305, 7297, '情報遺伝子解析', UNIX_TIMESTAMP()),
+	(882, 881, '水銀電子霊子', UNIX_TIMESTAMP()),
+	(265, 4260, '非常状態安定装置', UNIX_TIMESTAMP()),
+	(567, 1565, '錬金造形術', UNIX_TIMESTAMP()),
+	(305, 3301, '空間接続共有器', UNIX_TIMESTAMP()),
+	(223, 3219, '系統遺伝的観察', UNIX_TIMESTAMP()),
+	(246, 2243, '深層無意識意志', UNIX_TIMESTAMP()),
+	(250, 249, '星霊散発器', UNIX_TIMESTAMP()),
+	(983, 1981, '千里眼画像解析', UNIX_TIMESTAMP()),
+	(823, 1821, '超高精度計測装置', UNIX_TIMESTAMP()),
+	(898, 2895, '声波共振装置', UNIX_TIMESTAMP()),
+	(80, 4075, '視覚抽出分子', UNIX_TIMESTAMP()),
+	(280, 3276, '無重力増量装置', UNIX_TIMESTAMP()),
+	(698, 1696, '崩壊未来予知術', UNIX_TIMESTAMP()),
+	(875, 3871, '闇干渉鉱石', UNIX_TIMESTAMP()),
+	(197, 2194, '幻想結晶網', UNIX_TIMESTAMP()),
+	(229, 228, '微小生体検体', UNIX_TIMESTAMP()),
+	(459, 7451, '透明化合物', UNIX_TIMESTAMP()),
+	(9, 3005, '極限共鳴平面', UNIX_TIMESTAMP()),
+	(220, 1218, '機械生合成体', UNIX_TIMESTAMP()),
+	(383, 6376, '心理融合能力', UNIX_TIMESTAMP()),
+	(770, 5764, '星間通信網', UNIX_TIMESTAMP()),
+	(239, 1237, '無限方程式体', UNIX_TIMESTAMP()),
+	(190, 4185, '情報創生機構', UNIX_TIMESTAMP()),
+	(433, 2430, '時空転移因子', UNIX_TIMESTAMP()),
+	(585, 584, '超感応粒子', UNIX_TIMESTAMP()),
+	(213, 1211, '光子分布効果', UNIX_TIMESTAMP()),
+	(412, 6405, '真空共鳴誘導', UNIX_TIMESTAMP()),
+	(387, 5381, '超音速荷電子', UNIX_TIMESTAMP()),
+	(94, 2091, '記憶抽出装置', UNIX_TIMESTAMP()),
+	(887, 1885, '高密度反応体', UNIX_TIMESTAMP()),
+	(316, 2313, '進化加速系', UNIX_TIMESTAMP()),
+	(330, 6323, '無対称挙動核', UNIX_TIMESTAMP()),
+	(561, 1559, '中心黒魔術', UNIX_TIMESTAMP()),
+	(387, 2384, '酸素分子加速器', UNIX_TIMESTAMP()),
+	(325, 2322, '声音分子操縦装置', UNIX_TIMESTAMP()),
+	(164, 4159, '超高密度混合媒体', UNIX_TIMESTAMP()),
+	(1, 999, '精神鍛錬術', UNIX_TIMESTAMP()),
+	(817, 3813, '生体再生装置', UNIX_TIMESTAMP()),
+	(293, 7285, '時空転位装置', UNIX_TIMESTAMP()),
+	(828, 6821, '磁気浄化装置', UNIX_TIMESTAMP()),
+	(268, 4263, '無重量衣装', UNIX_TIMESTAMP()),
+	(589, 1587, '密度増幅装置', UNIX_TIMESTAMP()),
+	(63, 6056, '意識転送装置', UNIX_TIMESTAMP()),
+	(673, 6666, '重力制御装置', UNIX_TIMESTAMP()),
+	(898, 5892, '蒸気精錬炉', UNIX_TIMESTAMP()),
+	(750, 749, '液体変換装置', UNIX_TIMESTAMP()),
+	(620, 3616, '維持反応装置', UNIX_TIMESTAMP()),
+	(261, 5255, '降雨制御装置', UNIX_TIMESTAMP()),
+	(698, 1696, '反重力航空機', UNIX_TIMESTAMP()),
+	(296, 4291, '指紋偽装装置', UNIX_TIMESTAMP()),
+	(980, 4975, '音波操作装置', UNIX_TIMESTAMP()),
+	(145, 1143, '無重力農業装置', UNIX_TIMESTAMP()),
+	(308, 3304, '呼吸低温機関', UNIX_TIMESTAMP()),
+	(233, 4228, '液体放出装置', UNIX_TIMESTAMP()),
+	(213, 6206, '認識増幅装置', UNIX_TIMESTAMP()),
+	(353, 6346, '因果共鳴波', UNIX_TIMESTAMP()),
+	(515, 2512, '超音波融合', UNIX_TIMESTAMP()),
+	(74, 2071, '起伏量子縫合', UNIX_TIMESTAMP()),
+	(874, 6867, '共鳴結晶体', UNIX_TIMESTAMP()),
+	(100, 6093, '光電子補完', UNIX_TIMESTAMP()),
+	(543, 4538, '顕微走査共鳴冷却', UNIX_TIMESTAMP()),
+	(112, 7104, '多次元虚数界', UNIX_TIMESTAMP()),
+	(929, 5923, '分子魚群進化', UNIX_TIMESTAMP()),
+	(267, 3263, '核融解反応素子', UNIX_TIMESTAMP()),
+	(44, 5038, '細胞遺伝暗号解読', UNIX_TIMESTAMP()),
+	(168, 5162, '磁場修正反応器', UNIX_TIMESTAMP()),
+	(57, 5051, '高次元複合構造解析', UNIX_TIMESTAMP()),
+	(710, 709, '思念追跡機', UNIX_TIMESTAMP()),
+	(763, 762, '生体拡張装置', UNIX_TIMESTAMP()),
+	(527, 6520, '統合心理計測装置', UNIX_TIMESTAMP()),
+	(906, 2903, '仮想現実操縦器', UNIX_TIMESTAMP()),
+	(806, 805, '脳波交信端末', UNIX_TIMESTAMP()),
+	(182, 7174, '磁気共鳴画像診断装置', UNIX_TIMESTAMP()),
+	(712, 2709, '時間逆再生装置', UNIX_TIMESTAMP()),
+	(713, 5707, '無限洪波術', UNIX_TIMESTAMP()),
+	(82, 3078, '超文明励起体', UNIX_TIMESTAMP()),
+	(687, 686, '鬱穴解析術', UNIX_TIMESTAMP()),
+	(699, 1697, '箔蜃気楼装置', UNIX_TIMESTAMP()),
+	(146, 3142, '火泥砂風技', UNIX_TIMESTAMP()),
+	(915, 5909, '極領域焦凍体', UNIX_TIMESTAMP()),
+	(493, 7485, '集積記憶網', UNIX_TIMESTAMP()),
+	(927, 3923, '森岳磁気制御', UNIX_TIMESTAMP()),
+	(614, 6607, '影干渉体制', UNIX_TIMESTAMP()),
+	(957, 4952, '急接鮮柚核体', UNIX_TIMESTAMP()),
+	(920, 4915, '弦網絡組織', UNIX_TIMESTAMP()),
+	(570, 2567, '静鉢運搬装置', UNIX_TIMESTAMP()),
+	(515, 4510, '横滑接端凝器', UNIX_TIMESTAMP()),
+	(144, 5138, '隠循環発電机', UNIX_TIMESTAMP()),
+	(468, 1466, '核融合過程', UNIX_TIMESTAMP()),
+	(202, 1200, '超音速衝撃波', UNIX_TIMESTAMP()),
+	(73, 4068, '光合成回路', UNIX_TIMESTAMP()),
+	(123, 2120, '重力制御装置', UNIX_TIMESTAMP()),
+	(106, 1104, '量子干渉素子', UNIX_TIMESTAMP()),
+	(468, 7460, '透明感応素材', UNIX_TIMESTAMP()),
+	(174, 1172, '時間逆行装置', UNIX_TIMESTAMP()),
+	(673, 2670, '感応通信機', UNIX_TIMESTAMP()),
+	(880, 879, '相対的加速度', UNIX_TIMESTAMP()),
+	(527, 3523, '空間曲率抑制', UNIX_TIMESTAMP()),
+	(462, 3458, '反物質生成反応', UNIX_TIMESTAMP()),
+	(69, 7061, '超微域結晶構造', UNIX_TIMESTAMP()),
+	(246, 4241, '超大容量蓄電池', UNIX_TIMESTAMP()),
+	(805, 4800, '無線対抗回路', UNIX_TIMESTAMP()),
+	(218, 1216, '陽子加速装置', UNIX_TIMESTAMP()),
+	(800, 1798, '隠密透明材料', UNIX_TIMESTAMP()),
+	(497, 2494, '重力反転装置', UNIX_TIMESTAMP()),
+	(359, 7351, '蒸気駆動器', UNIX_TIMESTAMP()),
+	(95, 6088, '超音波医療装置', UNIX_TIMESTAMP()),
+	(39, 7031, '高速螺旋構造', UNIX_TIMESTAMP()),
+	(406, 5400, '電子顕微鏡', UNIX_TIMESTAMP()),
+	(628, 6621, '無重力航行装置', UNIX_TIMESTAMP()),
+	(190, 6183, '液体酸素冷却装置', UNIX_TIMESTAMP()),
+	(404, 1402, '自己修復材料', UNIX_TIMESTAMP()),
+	(999, 2996, '軌道制御系', UNIX_TIMESTAMP()),
+	(551, 1549, '高圧酸素治療装置', UNIX_TIMESTAMP()),
+	(550, 6543, '無重力研究機', UNIX_TIMESTAMP()),
+	(285, 3281, '細胞再生装置', UNIX_TIMESTAMP()),
+	(1, 5994, '多重光速推進機構', UNIX_TIMESTAMP()),
+	(935, 3931, '無線電動機関', UNIX_TIMESTAMP()),
+	(536, 1534, '高密度蓄電装置', UNIX_TIMESTAMP()),
+	(647, 4642, '平均原子量', UNIX_TIMESTAMP()),
+	(347, 346, '四次元思考', UNIX_TIMESTAMP()),
+	(767, 6760, '発光体解析', UNIX_TIMESTAMP()),
+	(231, 1229, '局所最適解', UNIX_TIMESTAMP()),
+	(853, 1851, '全体最適解', UNIX_TIMESTAMP()),
+	(729, 2726, '電磁気配陣', UNIX_TIMESTAMP()),
+	(459, 6452, '流電樹脱水', UNIX_TIMESTAMP()),
+	(613, 1611, '星雲塊間物質', UNIX_TIMESTAMP()),
+	(429, 5423, '魂魅読心術', UNIX_TIMESTAMP()),
+	(736, 6729, '時空門往還', UNIX_TIMESTAMP()),
+	(299, 298, '聚災降福術', UNIX_TIMESTAMP()),
+	(550, 549, '銀河中央黒穴', UNIX_TIMESTAMP()),
+	(304, 2301, '明鏡視聴術', UNIX_TIMESTAMP()),
+	(162, 4157, '経脈遊撃術', UNIX_TIMESTAMP()),
+	(232, 4227, '守護獣制御術', UNIX_TIMESTAMP()),
+	(654, 653, '怨霊遠隔感應', UNIX_TIMESTAMP()),
+	(209, 208, '風電絶縁体', UNIX_TIMESTAMP()),
+	(539, 5533, '火星遠隔認識', UNIX_TIMESTAMP()),
+	(30, 1028, '宇宙放射観測', UNIX_TIMESTAMP()),
+	(928, 5922, '心理投射装置', UNIX_TIMESTAMP()),
+	(926, 4921, '霊子干渉装置', UNIX_TIMESTAMP()),
+	(798, 3794, '超微鏡像分析', UNIX_TIMESTAMP()),
+	(359, 358, '心拡散反応', UNIX_TIMESTAMP()),
+	(56, 7048, '絶対因果計算機', UNIX_TIMESTAMP()),
+	(56, 7048, '透明液晶体', UNIX_TIMESTAMP()),
+	(351, 1349, '磁波共鳴増幅器', UNIX_TIMESTAMP()),
+	(988, 5982, '生体波動測定装置', UNIX_TIMESTAMP()),
+	(200, 199, '個体組織再現器', UNIX_TIMESTAMP()),
+	(36, 4031, '空間実体生成化装置', UNIX_TIMESTAMP()),
+	(259, 258, '記憶再構築機器', UNIX_TIMESTAMP()),
+	(861, 860, '量子紡錘制御装置', UNIX_TIMESTAMP()),
+	(351, 5345, '高次記憶固化装置', UNIX_TIMESTAMP()),
+	(428, 7420, '酸酸化電池', UNIX_TIMESTAMP()),
+	(685, 6678, '濃縮味覚感知器', UNIX_TIMESTAMP()),
+	(634, 2631, '超深層反応分析機', UNIX_TIMESTAMP()),
+	(87, 7079, '重力波検証装置', UNIX_TIMESTAMP()),
+	(166, 6159, '抗重力重合触媒', UNIX_TIMESTAMP()),
+	(2, 1, '非線立方体', UNIX_TIMESTAMP()),
+	(443, 442, '接地微粒子', UNIX_TIMESTAMP()),
+	(213, 1211, '霊障応急措置', UNIX_TIMESTAMP()),
+	(519, 3515, '意識回復装置', UNIX_TIMESTAMP()),
+	(79, 6072, '精神領域探査', UNIX_TIMESTAMP()),
+	(541, 1539, '情報走査兵', UNIX_TIMESTAMP()),
+	(813, 2810, '生体情報解析', UNIX_TIMESTAMP()),
+	(584, 3580, '超能感応力', UNIX_TIMESTAMP()),
+	(375, 6368, '量子干渉制御', UNIX_TIMESTAMP()),
+	(325, 2322, '心理空間転移', UNIX_TIMESTAMP()),
+	(140, 5134, '脳波可視化', UNIX_TIMESTAMP()),
+	(163, 6156, '超音波分子', UNIX_TIMESTAMP()),
+	(834, 3830, '脑神经镶嵌', UNIX_TIMESTAMP()),
+	(674, 1672, '歌曲数据分析', UNIX_TIMESTAMP()),
+	(626, 5620, '水中演化仿真', UNIX_TIMESTAMP()),
+	(722, 3718, '蒸気式磁気架波器', UNIX_TIMESTAMP()),
+	(877, 6870, '超塩素酸化器', UNIX_TIMESTAMP()),
+	(824, 6817, '珪藻核酸重合装置', UNIX_TIMESTAMP()),
+	(29, 3025, '超能力電線', UNIX_TIMESTAMP()),
+	(18, 6011, '沈湿熱排風機', UNIX_TIMESTAMP()),
+	(497, 1495, '高分子精製装置', UNIX_TIMESTAMP()),
+	(117, 7109, '分子解析共振装置', UNIX_TIMESTAMP()),
+	(300, 3296, '量子加熱器', UNIX_TIMESTAMP()),
+	(384, 383, '熱狼化元素生成器', UNIX_TIMESTAMP()),
+	(531, 530, '音波振動測定機器', UNIX_TIMESTAMP()),
+	(574, 1572, '生体信号変換器', UNIX_TIMESTAMP()),
+	(437, 1435, '酸素空気圧縮機', UNIX_TIMESTAMP()),
+	(406, 2403, '高周波粉砕装置', UNIX_TIMESTAMP()),
+	(222, 3218, '竜巻発生装置', UNIX_TIMESTAMP()),
+	(424, 2421, '有機太陽電池径路', UNIX_TIMESTAMP()),
+	(444, 443, '渦鞭毛運動機構', UNIX_TIMESTAMP()),
+	(508, 1506, '火震破砕装置', UNIX_TIMESTAMP()),
+	(125, 7117, '有機無機複合体合成化反応器', UNIX_TIMESTAMP()),
+	(710, 2707, '電子分光反射層', UNIX_TIMESTAMP()),
+	(368, 2365, '円軌道粒子分布装置', UNIX_TIMESTAMP()),
+	(3, 2000, '精神拡散現象', UNIX_TIMESTAMP()),
+	(575, 1573, '即応遺伝子修飾', UNIX_TIMESTAMP()),
+	(646, 2643, '意識転送装置', UNIX_TIMESTAMP()),
+	(161, 7153, '空気重力波', UNIX_TIMESTAMP()),
+	(657, 4652, '認識凝固体', UNIX_TIMESTAMP()),
+	(644, 4639, '非晶質知能', UNIX_TIMESTAMP()),
+	(322, 3318, '感応造形素材', UNIX_TIMESTAMP()),
+	(804, 6797, '遺伝子解読暗号', UNIX_TIMESTAMP()),
+	(924, 1922, '子宇宙接続装置', UNIX_TIMESTAMP()),
+	(687, 686, '高分子自己再生結晶', UNIX_TIMESTAMP()),
+	(654, 3650, '細胞伝達因子', UNIX_TIMESTAMP()),
+	(448, 5442, '関連症候群', UNIX_TIMESTAMP()),
+	(310, 5304, '画像処理器', UNIX_TIMESTAMP()),
+	(962, 4957, '経済動態分析', UNIX_TIMESTAMP()),
+	(305, 3301, '悪念封印術', UNIX_TIMESTAMP()),
+	(263, 3259, '書宝鑑定士', UNIX_TIMESTAMP()),
+	(614, 5608, '音響幻想融合', UNIX_TIMESTAMP()),
+	(713, 2710, '創芸修復術', UNIX_TIMESTAMP()),
+	(324, 3320, '夢鏡探査機', UNIX_TIMESTAMP()),
+	(826, 5820, '念動術研究会', UNIX_TIMESTAMP()),
+	(453, 2450, '干渉共振装置', UNIX_TIMESTAMP()),
+	(292, 2289, '色翳換装術', UNIX_TIMESTAMP()),
+	(457, 6450, '獣語研究所', UNIX_TIMESTAMP()),
+	(127, 7119, '心霊発電機構', UNIX_TIMESTAMP()),
+	(179, 1177, '組織図解析', UNIX_TIMESTAMP()),
+	(346, 5340, '細胞空創機', UNIX_TIMESTAMP()),
+	(73, 2070, '精神拡散装置', UNIX_TIMESTAMP()),
+	(620, 6613, '空間共鳴機能', UNIX_TIMESTAMP()),
+	(36, 1034, '記憶解凍装置', UNIX_TIMESTAMP()),
+	(144, 6137, '現実干渉機構', UNIX_TIMESTAMP()),
+	(703, 1701, '弧状加速器', UNIX_TIMESTAMP()),
+	(734, 2731, '情報走査衛星', UNIX_TIMESTAMP()),
+	(932, 3928, '波動推進装置', UNIX_TIMESTAMP()),
+	(948, 6941, '力場制御装置', UNIX_TIMESTAMP()),
+	(549, 5543, '天体分光研究衛星', UNIX_TIMESTAMP()),
+	(461, 3457, '原子力逸脱反応', UNIX_TIMESTAMP()),
+	(622, 2619, '可変空間鏡', UNIX_TIMESTAMP()),
+	(399, 2396, '電磁遮蔽細胞', UNIX_TIMESTAMP()),
+	(346, 7338, '未知物贈呈装置', UNIX_TIMESTAMP()),
+	(595, 6588, '核融合燃料加熱装置', UNIX_TIMESTAMP()),
+	(953, 6946, '電子乱流抑制装置', UNIX_TIMESTAMP()),
+	(763, 4758, '無限仮想現実風景', UNIX_TIMESTAMP()),
+	(761, 2758, '無重力制御', UNIX_TIMESTAMP()),
+	(932, 4927, '情報共有化', UNIX_TIMESTAMP()),
+	(709, 2706, '高次元処理', UNIX_TIMESTAMP()),
+	(247, 6240, '逆磁場生成', UNIX_TIMESTAMP()),
+	(507, 4502, '無敵蓄能機', UNIX_TIMESTAMP()),
+	(945, 6938, '神聖殺破斬', UNIX_TIMESTAMP()),
+	(19, 6012, '魔像総司令', UNIX_TIMESTAMP()),
+	(380, 1378, '意識拡張装置', UNIX_TIMESTAMP()),
+	(407, 406, '神界接続装置', UNIX_TIMESTAMP()),
+	(177, 6170, '知識統合解析', UNIX_TIMESTAMP()),
+	(546, 2543, '露天奮打術', UNIX_TIMESTAMP()),
+	(702, 4697, '幻想思念体', UNIX_TIMESTAMP()),
+	(728, 3724, '誘導芽生体', UNIX_TIMESTAMP()),
+	(652, 4647, '真空自在腐食', UNIX_TIMESTAMP()),
+	(923, 2920, '絶縁共振器', UNIX_TIMESTAMP()),
+	(491, 6484, '望遠魔術式', UNIX_TIMESTAMP()),
+	(533, 4528, '磁気操筆具', UNIX_TIMESTAMP()),
+	(763, 1761, '量子紅珠体', UNIX_TIMESTAMP()),
+	(51, 6044, '電子帯芽器', UNIX_TIMESTAMP()),
+	(731, 4726, '残像投射機', UNIX_TIMESTAMP()),
+	(960, 4955, '地対空神速', UNIX_TIMESTAMP()),
+	(292, 4287, '絶対無機体', UNIX_TIMESTAMP()),
+	(539, 6532, '幽体離脱術', UNIX_TIMESTAMP()),
+	(280, 2277, '社会史研究', UNIX_TIMESTAMP()),
+	(906, 2903, '芸術史研究', UNIX_TIMESTAMP()),
+	(785, 1783, '窒素循環効率化策', UNIX_TIMESTAMP()),
+	(887, 3883, '無機生殖能無化物質', UNIX_TIMESTAMP()),
+	(934, 2931, '実体磁気逆転制御', UNIX_TIMESTAMP()),
+	(745, 2742, '指日光感応材', UNIX_TIMESTAMP()),
+	(970, 3966, '合適排気融合機構', UNIX_TIMESTAMP()),
+	(124, 6117, '垂直光収束配列', UNIX_TIMESTAMP()),
+	(559, 558, '分子階層電極設計', UNIX_TIMESTAMP()),
+	(87, 3083, '広域透明光通信', UNIX_TIMESTAMP()),
+	(306, 2303, '動能感受子', UNIX_TIMESTAMP()),
+	(975, 6968, '宇宙冷凍機', UNIX_TIMESTAMP()),
+	(114, 113, '根源同期装置', UNIX_TIMESTAMP()),
+	(349, 348, '超微粒化爆風凝集器', UNIX_TIMESTAMP()),
+	(72, 1070, '情動感応織物', UNIX_TIMESTAMP()),
+	(401, 3397, '有機蒸気煉炭機', UNIX_TIMESTAMP()),
+	(951, 950, '起動位相増幅器', UNIX_TIMESTAMP()),
+	(789, 5783, '衝撃反転境界面', UNIX_TIMESTAMP()),
+	(757, 3753, '音圧干渉抑制装置', UNIX_TIMESTAMP()),
+	(333, 2330, '電脳干渉精密計測器', UNIX_TIMESTAMP()),
+	(489, 7481, '量子間隔暗号器', UNIX_TIMESTAMP()),
+	(514, 2511, '量子集積光回路', UNIX_TIMESTAMP()),
+	(781, 3777, '分子磁気記憶体', UNIX_TIMESTAMP()),
+	(518, 3514, '心理創造遊戯装置', UNIX_TIMESTAMP()),
+	(455, 5449, '強靭膜応力装甲板', UNIX_TIMESTAMP()),
+	(79, 4074, '暗黒亜空間', UNIX_TIMESTAMP()),
+	(948, 5942, '美術鑑定機', UNIX_TIMESTAMP()),
+	(152, 6145, '記憶強化装置', UNIX_TIMESTAMP()),
+	(135, 1133, '念力放出体', UNIX_TIMESTAMP()),
+	(216, 1214, '未解明領域', UNIX_TIMESTAMP()),
+	(796, 6789, '四元素結合', UNIX_TIMESTAMP()),
+	(258, 5252, '精神滅却装置', UNIX_TIMESTAMP()),
+	(38, 6031, '超感覚鏡像', UNIX_TIMESTAMP()),
+	(387, 386, '初期化順位', UNIX_TIMESTAMP()),
+	(68, 2065, '霊気探知術', UNIX_TIMESTAMP()),
+	(247, 2244, '音波焚化装置', UNIX_TIMESTAMP()),
+	(803, 4798, '潜在能力暴露装置', UNIX_TIMESTAMP()),
+	(747, 4742, '量子処理器', UNIX_TIMESTAMP()),
+	(67, 5061, '記憶再配信装置', UNIX_TIMESTAMP()),
+	(573, 2570, '心理共振機', UNIX_TIMESTAMP()),
+	(233, 6226, '時間直線装置', UNIX_TIMESTAMP()),
+	(387, 2384, '無限次元推定器', UNIX_TIMESTAMP()),
+	(177, 3173, '演算精度超過装置', UNIX_TIMESTAMP()),
+	(336, 4331, '遺伝情報修正機', UNIX_TIMESTAMP()),
+	(671, 1669, '真空共鳴誘導体', UNIX_TIMESTAMP()),
+	(426, 7418, '量子交霊装置', UNIX_TIMESTAMP()),
+	(890, 5884, '自律調節機器', UNIX_TIMESTAMP()),
+	(300, 299, '量子加速器', UNIX_TIMESTAMP()),
+	(744, 4739, '集中断熱装置', UNIX_TIMESTAMP()),
+	(212, 4207, '情報圧縮装置', UNIX_TIMESTAMP()),
+	(403, 7395, '雲中採掘機', UNIX_TIMESTAMP()),
+	(526, 3522, '空気清浄噴霧機', UNIX_TIMESTAMP()),
+	(412, 5406, '量子通信装置', UNIX_TIMESTAMP()),
+	(449, 7441, '時空転送装置', UNIX_TIMESTAMP()),
+	(179, 178, '無重力生成機', UNIX_TIMESTAMP()),
+	(940, 5934, '統計固有値', UNIX_TIMESTAMP()),
+	(289, 2286, '不均衡損失', UNIX_TIMESTAMP()),
+	(961, 960, '実質相変換', UNIX_TIMESTAMP()),
+	(806, 2803, '非整数次元', UNIX_TIMESTAMP()),
+	(450, 4445, '著勝変曲線', UNIX_TIMESTAMP()),
+	(440, 6433, '微生物絶食制御', UNIX_TIMESTAMP()),
+	(983, 3979, '高分子誘電体', UNIX_TIMESTAMP()),
+	(6, 1004, '等温駆動反応', UNIX_TIMESTAMP()),
+	(127, 3123, '走査調色装置', UNIX_TIMESTAMP()),
+	(669, 4664, '非球形自己組織化', UNIX_TIMESTAMP()),
+	(28, 4023, '半透明遮蔽膜', UNIX_TIMESTAMP()),
+	(255, 6248, '弾力解味物質', UNIX_TIMESTAMP()),
+	(833, 5827, '無機膨張媒体', UNIX_TIMESTAMP()),
+	(577, 6570, '超音波断層撮影', UNIX_TIMESTAMP()),
+	(594, 2591, '熱帯地域病原体抑制', UNIX_TIMESTAMP()),
+	(356, 5350, '空腹熱慰害', UNIX_TIMESTAMP()),
+	(235, 7227, '顕微鏡亜種', UNIX_TIMESTAMP()),
+	(524, 1522, '生物遺伝因子', UNIX_TIMESTAMP()),
+	(264, 6257, '宇宙構築素材', UNIX_TIMESTAMP()),
+	(508, 4503, '情報詮索機', UNIX_TIMESTAMP()),
+	(472, 7464, '超重力発生装置', UNIX_TIMESTAMP()),
+	(934, 1932, '心理暗示装置', UNIX_TIMESTAMP()),
+	(433, 432, '精神錯乱波', UNIX_TIMESTAMP()),
+	(479, 5473, '文明退化因子', UNIX_TIMESTAMP()),
+	(219, 7211, '霊的共鳴現象', UNIX_TIMESTAMP()),
+	(117, 5111, '物質再合成装置', UNIX_TIMESTAMP()),
+	(428, 427, '刻限解除装置', UNIX_TIMESTAMP()),
+	(255, 254, '思考波動解析器', UNIX_TIMESTAMP()),
+	(823, 3819, '磁場駆動装置', UNIX_TIMESTAMP()),
+	(212, 211, '時空間操作装置', UNIX_TIMESTAMP()),
+	(680, 1678, '生体情報解析', UNIX_TIMESTAMP()),
+	(363, 6356, '視覚錯覚研究', UNIX_TIMESTAMP()),
+	(231, 7223, '知覚誘導装置', UNIX_TIMESTAMP()),
+	(348, 2345, '自然言語処理', UNIX_TIMESTAMP()),
+	(94, 4089, '発火頻度解析', UNIX_TIMESTAMP()),
+	(270, 6263, '意識遷移現象', UNIX_TIMESTAMP()),
+	(314, 1312, '物体形状認識', UNIX_TIMESTAMP()),
+	(965, 2962, '自動感情分類', UNIX_TIMESTAMP()),
+	(371, 5365, '記憶再構築機構', UNIX_TIMESTAMP()),
+	(647, 5641, '蓄光振動器', UNIX_TIMESTAMP()),
+	(686, 4681, '真空軽合金', UNIX_TIMESTAMP()),
+	(775, 2772, '凝固反応器', UNIX_TIMESTAMP()),
+	(754, 5748, '分光空気圧縮機', UNIX_TIMESTAMP()),
+	(634, 2631, '水蒸気反射板', UNIX_TIMESTAMP()),
+	(857, 2854, '電磁界測定器', UNIX_TIMESTAMP()),
+	(280, 3276, '地殻温度計', UNIX_TIMESTAMP()),
+	(819, 5813, '機械酸素供給装置', UNIX_TIMESTAMP()),
+	(832, 1830, '高圧電力回路断得機', UNIX_TIMESTAMP()),
+	(150, 149, '壁面電磁場発生器', UNIX_TIMESTAMP()),
+	(7, 3003, '離心電気懸濁液', UNIX_TIMESTAMP()),
+	(358, 357, '直流帰還温度制御器', UNIX_TIMESTAMP()),
+	(991, 1989, '高周波霧化装置', UNIX_TIMESTAMP()),
+	(519, 3515, '観測大気放射線計', UNIX_TIMESTAMP()),
+	(956, 5950, '脳神経鞘腫', UNIX_TIMESTAMP()),
+	(534, 6527, '骨吸収阻害剤', UNIX_TIMESTAMP()),
+	(118, 5112, '膀胱筋腫症', UNIX_TIMESTAMP()),
+	(344, 1342, '心臓風虫症候群', UNIX_TIMESTAMP()),
+	(702, 3698, '肝腎症候群', UNIX_TIMESTAMP()),
+	(815, 6808, '密封細胞腫', UNIX_TIMESTAMP()),
+	(923, 4918, '気管支拡張症', UNIX_TIMESTAMP()),
+	(706, 4701, '乳癌進行抑制剤', UNIX_TIMESTAMP()),
+	(65, 4060, '胸膜中皮腫', UNIX_TIMESTAMP()),
+	(791, 4786, '大動脈瘤破裂', UNIX_TIMESTAMP()),
+	(359, 3355, '皮膚感染症', UNIX_TIMESTAMP()),
+	(792, 5786, '音楽記譜器', UNIX_TIMESTAMP()),
+	(282, 3278, '気象早見表', UNIX_TIMESTAMP()),
+	(713, 5707, '交通信号制御装置', UNIX_TIMESTAMP()),
+	(100, 99, '火星着陸船', UNIX_TIMESTAMP()),
+	(790, 6783, '高速植物成長液', UNIX_TIMESTAMP()),
+	(878, 877, '動植物変形装置', UNIX_TIMESTAMP()),
+	(773, 4768, '宇宙空間掃除機', UNIX_TIMESTAMP()),
+	(474, 473, '量子通信暗号化装置', UNIX_TIMESTAMP()),
+	(328, 7320, '空気洗浄装置', UNIX_TIMESTAMP()),
+	(267, 266, '著作権保護装置', UNIX_TIMESTAMP()),
+	(709, 4704, '心理状態分析装置', UNIX_TIMESTAMP()),
+	(665, 3661, '未知生物探査器', UNIX_TIMESTAMP()),
+	(73, 6066, '塩化物除去装置', UNIX_TIMESTAMP()),
+	(616, 4611, '超音波共鳴', UNIX_TIMESTAMP()),
+	(336, 7328, '連鎖遺伝子', UNIX_TIMESTAMP()),
+	(966, 2963, '生細胞培養', UNIX_TIMESTAMP()),
+	(540, 4535, '希元素反応', UNIX_TIMESTAMP()),
+	(579, 2576, '非晶構造体', UNIX_TIMESTAMP()),
+	(531, 530, '断熱相互作用', UNIX_TIMESTAMP()),
+	(585, 1583, '無限相互作用', UNIX_TIMESTAMP()),
+	(411, 6404, '輪状腱挿装', UNIX_TIMESTAMP()),
+	(385, 1383, '溶岩濃度降版', UNIX_TIMESTAMP()),
+	(695, 2692, '胚椎骨突出症', UNIX_TIMESTAMP()),
+	(605, 1603, '線索軌道融合', UNIX_TIMESTAMP()),
+	(483, 3479, '塩基偏位反応', UNIX_TIMESTAMP()),
+	(120, 6113, '噴霧暗化装置', UNIX_TIMESTAMP()),
+	(715, 2712, '多角類装骨化症', UNIX_TIMESTAMP()),
+	(353, 7345, '膳化反応触媒', UNIX_TIMESTAMP()),
+	(640, 4635, '酸素酵化誘起', UNIX_TIMESTAMP()),
+	(501, 7493, '尺化羽飾細胞', UNIX_TIMESTAMP()),
+	(114, 6107, '鋼素混合根', UNIX_TIMESTAMP()),
+	(819, 4814, '汎化外部形', UNIX_TIMESTAMP()),
+	(59, 1057, '閾値細胞死', UNIX_TIMESTAMP()),
+	(141, 140, '紊乱磁束降下', UNIX_TIMESTAMP()),
+	(350, 6343, '多筋系発達症', UNIX_TIMESTAMP()),
+	(297, 2294, '治癒回帰反応', UNIX_TIMESTAMP()),
+	(309, 1307, '蜃気楼抑制装置', UNIX_TIMESTAMP()),
+	(249, 1247, '断熱水圧噴射', UNIX_TIMESTAMP()),
+	(183, 5177, '繊維分離補修', UNIX_TIMESTAMP()),
+	(49, 4044, '星間航行共振装置', UNIX_TIMESTAMP()),
+	(165, 2162, '微生物光合成燃料発酵器', UNIX_TIMESTAMP()),
+	(549, 5543, '空中電磁波透過材料', UNIX_TIMESTAMP()),
+	(615, 2612, '完全自己修復型素材', UNIX_TIMESTAMP()),
+	(453, 452, '灰合金研究', UNIX_TIMESTAMP()),
+	(248, 3244, '月環境転写', UNIX_TIMESTAMP()),
+	(953, 2950, '面紙冷加工', UNIX_TIMESTAMP()),
+	(858, 6851, '虚界破殻術', UNIX_TIMESTAMP()),
+	(825, 6818, '霊光抽出術', UNIX_TIMESTAMP()),
+	(244, 1242, '悪霊封印儀式', UNIX_TIMESTAMP()),
+	(323, 5317, '幻覚透過装置', UNIX_TIMESTAMP()),
+	(896, 2893, '闇結界生成術', UNIX_TIMESTAMP()),
+	(627, 5621, '霊感増幅装置', UNIX_TIMESTAMP()),
+	(843, 2840, '神聖解呪儀式', UNIX_TIMESTAMP()),
+	(390, 4385, '念力逆転装置', UNIX_TIMESTAMP()),
+	(57, 7049, '夢幻干渉装置', UNIX_TIMESTAMP()),
+	(798, 6791, '妖怪排除結社', UNIX_TIMESTAMP()),
+	(3, 1001, '呪術回避結界', UNIX_TIMESTAMP()),
+	(573, 1571, '天文賢者形式', UNIX_TIMESTAMP()),
+	(562, 4557, '機渠拡散装置', UNIX_TIMESTAMP()),
+	(776, 3772, '基質相信鎖', UNIX_TIMESTAMP()),
+	(229, 6222, '発光拡散器', UNIX_TIMESTAMP()),
+	(69, 6062, '群体適合率', UNIX_TIMESTAMP()),
+	(301, 7293, '定位監視機能', UNIX_TIMESTAMP()),
+	(706, 5700, '声響反射率', UNIX_TIMESTAMP()),
+	(836, 3832, '密度勾配憎水剤', UNIX_TIMESTAMP()),
+	(743, 1741, '重陽比指数', UNIX_TIMESTAMP()),
+	(321, 2318, '対話章節解析', UNIX_TIMESTAMP()),
+	(196, 4191, '電導再酸化機構', UNIX_TIMESTAMP()),
+	(762, 1760, '対称滑面形状', UNIX_TIMESTAMP()),
+	(834, 3830, '火星症候群', UNIX_TIMESTAMP()),
+	(657, 1655, '時空漸化式', UNIX_TIMESTAMP()),
+	(137, 3133, '深層輝聚体', UNIX_TIMESTAMP()),
+	(966, 3962, '水滴浮揚現象', UNIX_TIMESTAMP()),
+	(182, 181, '心理相関遺伝子', UNIX_TIMESTAMP()),
+	(626, 5620, '銀河往還装置', UNIX_TIMESTAMP()),
+	(569, 568, '天楽風振器', UNIX_TIMESTAMP()),
+	(564, 5558, '遺伝情報暗号化', UNIX_TIMESTAMP()),
+	(267, 1265, '憶測機能回路', UNIX_TIMESTAMP()),
+	(338, 5332, '幻夢抑制装置', UNIX_TIMESTAMP()),
+	(786, 785, '心象投影装置', UNIX_TIMESTAMP()),
+	(740, 1738, '奇岩透視装置', UNIX_TIMESTAMP()),
+	(455, 454, '磁気相補記憶素子', UNIX_TIMESTAMP()),
+	(52, 51, '爆速遺伝子解読装置', UNIX_TIMESTAMP()),
+	(781, 6774, '未知次元直接通信機', UNIX_TIMESTAMP()),
+	(349, 348, '弧状多様骨格', UNIX_TIMESTAMP()),
+	(406, 3402, '落雷断霊機関', UNIX_TIMESTAMP()),
+	(227, 3223, '霊力転写術', UNIX_TIMESTAMP()),
+	(735, 1733, '星霊召喚術', UNIX_TIMESTAMP()),
+	(781, 1779, '心理保護壁', UNIX_TIMESTAMP()),
+	(658, 4653, '非公開情報探査術', UNIX_TIMESTAMP()),
+	(37, 36, '神聖凱旋儀式', UNIX_TIMESTAMP()),
+	(899, 4894, '幽冥界通信術', UNIX_TIMESTAMP()),
+	(90, 3086, '伝説封印章', UNIX_TIMESTAMP()),
+	(710, 4705, '念動力発電機', UNIX_TIMESTAMP()),
+	(576, 5570, '基本赤道儀暦', UNIX_TIMESTAMP()),
+	(7, 6000, '奇跡再生術', UNIX_TIMESTAMP()),
+	(580, 3576, '未来推測算術', UNIX_TIMESTAMP()),
+	(874, 4869, '時空断層体制', UNIX_TIMESTAMP()),
+	(66, 65, '心理場遮断装置', UNIX_TIMESTAMP()),
+	(90, 4085, '微粒子生成', UNIX_TIMESTAMP()),
+	(95, 5089, '共焦点顕微鏡', UNIX_TIMESTAMP()),
+	(661, 3657, '高分子合成', UNIX_TIMESTAMP()),
+	(190, 1188, '放射能防護', UNIX_TIMESTAMP()),
+	(98, 97, '積層造影剤', UNIX_TIMESTAMP()),
+	(838, 6831, '環網閾界機', UNIX_TIMESTAMP()),
+	(75, 7067, '微粒体量外洗剤', UNIX_TIMESTAMP()),
+	(999, 1997, '数値遅延比較器', UNIX_TIMESTAMP()),
+	(939, 4934, '超音波応力服飾', UNIX_TIMESTAMP()),
+	(490, 1488, '多層化複合標準化本', UNIX_TIMESTAMP()),
+	(344, 7336, '周波実効耗散', UNIX_TIMESTAMP()),
+	(997, 996, '高分子対数薄膜', UNIX_TIMESTAMP()),
+	(434, 6427, '束球運動偽体', UNIX_TIMESTAMP()),
+	(488, 4483, '導変得状読', UNIX_TIMESTAMP()),
+	(614, 6607, '非発光不振動機', UNIX_TIMESTAMP()),
+	(55, 7047, '微分抵抗差分化制御', UNIX_TIMESTAMP()),
+	(203, 5197, '指数加熱冷却転移', UNIX_TIMESTAMP()),
+	(537, 6530, '積層比重圧縮体', UNIX_TIMESTAMP()),
+	(9, 5003, '霊魂融合術', UNIX_TIMESTAMP()),
+	(222, 4217, '大気降下装置', UNIX_TIMESTAMP()),
+	(651, 4646, '燃料重組機', UNIX_TIMESTAMP()),
+	(100, 6093, '時空歪曲装置', UNIX_TIMESTAMP()),
+	(857, 2854, '心理領域解析器', UNIX_TIMESTAMP()),
+	(266, 5260, '暗黒物質探査器', UNIX_TIMESTAMP()),
+	(919, 6912, '宇宙構造制御装置', UNIX_TIMESTAMP()),
+	(462, 1460, '量子重力補正器', UNIX_TIMESTAMP()),
+	(327, 6320, '微細精密計測器', UNIX_TIMESTAMP()),
+	(104, 103, '音波共鳴増幅器', UNIX_TIMESTAMP()),
+	(953, 6946, '未来予知研究機関', UNIX_TIMESTAMP()),
+	(296, 2293, '湧水流体分析装置', UNIX_TIMESTAMP()),
+	(798, 5792, '砂漠環境対応装甲車', UNIX_TIMESTAMP()),
+	(197, 6190, '感情共有装置', UNIX_TIMESTAMP()),
+	(185, 3181, '疲労回復装置', UNIX_TIMESTAMP()),
+	(683, 682, '光子力発電機', UNIX_TIMESTAMP()),
+	(227, 5221, '地磁気読解塔', UNIX_TIMESTAMP()),
+	(729, 4724, '無重力発生装置', UNIX_TIMESTAMP()),
+	(472, 5466, '創造遺伝子', UNIX_TIMESTAMP()),
+	(433, 3429, '磁気線路配線', UNIX_TIMESTAMP()),
+	(92, 1090, '組織遺伝子修復', UNIX_TIMESTAMP()),
+	(986, 2983, '回帰分散方程式', UNIX_TIMESTAMP()),
+	(947, 2944, '感情強度測定機', UNIX_TIMESTAMP()),
+	(914, 1912, '高周波導体素子', UNIX_TIMESTAMP()),
+	(781, 3777, '塩化物分解反応', UNIX_TIMESTAMP()),
+	(462, 4457, '液体流体解析', UNIX_TIMESTAMP()),
+	(64, 4059, '反射幅広画像処理', UNIX_TIMESTAMP()),
+	(44, 4039, '風速抵抗係数', UNIX_TIMESTAMP()),
+	(97, 4092, '周期領域解析', UNIX_TIMESTAMP()),
+	(910, 6903, '発芽促進因子', UNIX_TIMESTAMP()),
+	(608, 2605, '被験者効果', UNIX_TIMESTAMP()),
+	(710, 3706, '熱帯低気圧', UNIX_TIMESTAMP()),
+	(804, 2801, '社会結合力', UNIX_TIMESTAMP()),
+	(336, 4331, '社会的不平等', UNIX_TIMESTAMP()),
+	(66, 1064, '水陸航空統合戦術', UNIX_TIMESTAMP()),
+	(676, 4671, '空中飛行船', UNIX_TIMESTAMP()),
+	(756, 2753, '反重力装置', UNIX_TIMESTAMP()),
+	(575, 3571, '時空転移装置', UNIX_TIMESTAMP()),
+	(752, 3748, '素粒子加速器', UNIX_TIMESTAMP()),
+	(15, 7007, '心霊探査術', UNIX_TIMESTAMP()),
+	(40, 3036, '機械複素数', UNIX_TIMESTAMP()),
+	(7, 4002, '合成己臭糖', UNIX_TIMESTAMP()),
+	(765, 5759, '映像改造装置', UNIX_TIMESTAMP()),
+	(708, 2705, '分光反転定理', UNIX_TIMESTAMP()),
+	(244, 7236, '静電気浄化装置', UNIX_TIMESTAMP()),
+	(108, 107, '磁気線量計', UNIX_TIMESTAMP()),
+	(977, 5971, '高圧気動機器', UNIX_TIMESTAMP()),
+	(694, 5688, '微生物殺菌装置', UNIX_TIMESTAMP()),
+	(761, 3757, '熱伝導率計', UNIX_TIMESTAMP()),
+	(106, 7098, '軽合金合成機', UNIX_TIMESTAMP()),
+	(18, 3014, '水力発電機', UNIX_TIMESTAMP()),
+	(593, 3589, '高密度植物育成装置', UNIX_TIMESTAMP()),
+	(176, 175, '高周波電界装置', UNIX_TIMESTAMP()),
+	(869, 2866, '透明導電材料', UNIX_TIMESTAMP()),
+	(726, 725, '加熱床材料', UNIX_TIMESTAMP()),
+	(76, 4071, '地中構造分析装置', UNIX_TIMESTAMP()),
+	(403, 1401, '超高速充電器', UNIX_TIMESTAMP()),
+	(548, 3544, '高精度重量測定器', UNIX_TIMESTAMP()),
+	(692, 4687, '時空転送装置', UNIX_TIMESTAMP()),
+	(308, 6301, '音波干渉体', UNIX_TIMESTAMP()),
+	(140, 3136, '悪魔契約書', UNIX_TIMESTAMP()),
+	(422, 1420, '虚空潜在力', UNIX_TIMESTAMP()),
+	(836, 1834, '気配感知装置', UNIX_TIMESTAMP()),
+	(789, 6782, '古代文字解読機', UNIX_TIMESTAMP()),
+	(220, 1218, '超音速移動体', UNIX_TIMESTAMP()),
+	(587, 3583, '神秘的結晶', UNIX_TIMESTAMP()),
+	(445, 444, '心眼感応術', UNIX_TIMESTAMP()),
+	(11, 6004, '創造力促進装置', UNIX_TIMESTAMP()),
+	(460, 7452, '地球進化石', UNIX_TIMESTAMP()),
+	(487, 1485, '未来探査装置', UNIX_TIMESTAMP()),
+	(326, 5320, '量子波動線', UNIX_TIMESTAMP()),
+	(250, 2247, '宇宙重力変換器', UNIX_TIMESTAMP()),
+	(482, 4477, '形態相互変換素子', UNIX_TIMESTAMP()),
+	(456, 4451, '透明幻覚光線', UNIX_TIMESTAMP()),
+	(84, 6077, '心灵传送装置', UNIX_TIMESTAMP()),
+	(897, 3893, '历史时空修复器', UNIX_TIMESTAMP()),
+	(157, 3153, '驳斥推理模型', UNIX_TIMESTAMP()),
+	(207, 3203, '空中飛行露天劇場', UNIX_TIMESTAMP()),
+	(342, 3338, '魔力収束装置', UNIX_TIMESTAMP()),
+	(289, 2286, '超音速高速交通', UNIX_TIMESTAMP()),
+	(530, 3526, '唾液分析器', UNIX_TIMESTAMP()),
+	(797, 2794, '生物適化増强線', UNIX_TIMESTAMP()),
+	(992, 3988, '精神体操作装置', UNIX_TIMESTAMP()),
+	(865, 5859, '量子転位装置', UNIX_TIMESTAMP()),
+	(86, 85, '不確定状態分析', UNIX_TIMESTAMP()),
+	(64, 1062, '現実干渉機構', UNIX_TIMESTAMP()),
+	(473, 4468, '物質反転装置', UNIX_TIMESTAMP()),
+	(633, 1631, '光電生体計測装置', UNIX_TIMESTAMP()),
+	(886, 5880, '音響共鳴誘導機構', UNIX_TIMESTAMP()),
+	(509, 1507, '量子結節解析', UNIX_TIMESTAMP()),
+	(327, 6320, '想起減衰指標', UNIX_TIMESTAMP()),
+	(163, 7155, '知覚閾値最適化', UNIX_TIMESTAMP()),
+	(115, 7107, '情報処理負荷均衡機構', UNIX_TIMESTAMP()),
+	(386, 7378, '音圧振幅自己複製推定', UNIX_TIMESTAMP()),
+	(460, 459, '放射線暴露指標', UNIX_TIMESTAMP()),
+	(583, 4578, '生体組織再構築装置', UNIX_TIMESTAMP()),
+	(890, 1888, '磁力共鳴刺激装置', UNIX_TIMESTAMP()),
+	(317, 6310, '宇宙構造解析', UNIX_TIMESTAMP()),
+	(418, 417, '分子合成反応', UNIX_TIMESTAMP()),
+	(38, 7030, '高次元尺度', UNIX_TIMESTAMP()),
+	(130, 7122, '量子磁化現象', UNIX_TIMESTAMP()),
+	(553, 1551, '分光光度計', UNIX_TIMESTAMP()),
+	(465, 7457, '感応型知能', UNIX_TIMESTAMP()),
+	(362, 5356, '局所最適解', UNIX_TIMESTAMP()),
+	(305, 1303, '風洞試験機', UNIX_TIMESTAMP()),
+	(326, 7318, '粒子加速器', UNIX_TIMESTAMP()),
+	(284, 5278, '生体計測器', UNIX_TIMESTAMP()),
+	(315, 314, '情報展光装置', UNIX_TIMESTAMP()),
+	(283, 6276, '感覚揺動増幅器', UNIX_TIMESTAMP()),
+	(712, 2709, '心理干渉装置', UNIX_TIMESTAMP()),
+	(963, 6956, '物質相転移予測器', UNIX_TIMESTAMP()),
+	(141, 140, '隠者講座確率解析', UNIX_TIMESTAMP()),
+	(297, 6290, '閉鎖思考映像生成器', UNIX_TIMESTAMP()),
+	(369, 6362, '念力勾配解析装置', UNIX_TIMESTAMP()),
+	(45, 44, '生体酸反応', UNIX_TIMESTAMP()),
+	(978, 6971, '共鳴網構造', UNIX_TIMESTAMP()),
+	(331, 2328, '低温蒸留反応槽', UNIX_TIMESTAMP()),
+	(145, 2142, '直交相互定理', UNIX_TIMESTAMP()),
+	(324, 4319, '拡散反射粒子束', UNIX_TIMESTAMP()),
+	(718, 3714, '集積化音響境界素子', UNIX_TIMESTAMP()),
+	(231, 4226, '三重共鳴励起状態', UNIX_TIMESTAMP()),
+	(632, 3628, '振幅位相交換符号化', UNIX_TIMESTAMP()),
+	(268, 6261, '超音速縮約気流', UNIX_TIMESTAMP()),
+	(900, 6893, '音響化身颶', UNIX_TIMESTAMP()),
+	(228, 4223, '行動素子則', UNIX_TIMESTAMP()),
+	(839, 1837, '映像血糊検', UNIX_TIMESTAMP()),
+	(758, 3754, '料理抱頭盆', UNIX_TIMESTAMP()),
+	(123, 7115, '社会寒流術', UNIX_TIMESTAMP()),
+	(925, 5919, '建築夢海界', UNIX_TIMESTAMP()),
+	(989, 6982, '経済矛盾態', UNIX_TIMESTAMP()),
+	(23, 6016, '気象因果関', UNIX_TIMESTAMP()),
+	(488, 4483, '歴史舞台撹', UNIX_TIMESTAMP()),
+	(518, 3514, '伝統鑿革催', UNIX_TIMESTAMP()),
+	(683, 3679, '美術筆舞設', UNIX_TIMESTAMP()),
+	(438, 1436, '経営創業固', UNIX_TIMESTAMP()),
+	(233, 4228, '芸能宝箱返', UNIX_TIMESTAMP()),
+	(418, 3414, '爆薬構造評価', UNIX_TIMESTAMP()),
+	(525, 5519, '収縮振動制御', UNIX_TIMESTAMP()),
+	(276, 1274, '情報鈍化指数', UNIX_TIMESTAMP()),
+	(64, 7056, '協調挙動解析', UNIX_TIMESTAMP()),
+	(980, 5974, '抽象遷移図', UNIX_TIMESTAMP()),
+	(58, 4053, '高次情報解析', UNIX_TIMESTAMP()),
+	(544, 6537, '線形応答行列', UNIX_TIMESTAMP()),
+	(649, 648, '無限次元解析', UNIX_TIMESTAMP()),
+	(236, 5230, '環境負荷評価', UNIX_TIMESTAMP()),
+	(647, 1645, '認知環境再構築', UNIX_TIMESTAMP()),
+	(293, 4288, '量子乱数生成', UNIX_TIMESTAMP()),
+	(119, 6112, '無重力演算', UNIX_TIMESTAMP()),
+	(415, 7407, '疑似遺伝子', UNIX_TIMESTAMP()),
+	(41, 1039, '社会圏衝突', UNIX_TIMESTAMP()),
+	(754, 5748, '視覚擬似化', UNIX_TIMESTAMP()),
+	(446, 6439, '超音速航行', UNIX_TIMESTAMP()),
+	(201, 3197, '遺伝子研究', UNIX_TIMESTAMP()),
+	(469, 5463, '形状記憶素材', UNIX_TIMESTAMP()),
+	(64, 3060, '心的解読術', UNIX_TIMESTAMP()),
+	(46, 1044, '量子途方巡回', UNIX_TIMESTAMP()),
+	(336, 2333, '同期無線通信', UNIX_TIMESTAMP()),
+	(783, 3779, '空間融合治療', UNIX_TIMESTAMP()),
+	(334, 333, '心理脅威識別', UNIX_TIMESTAMP()),
+	(33, 1031, '反射印加介入', UNIX_TIMESTAMP()),
+	(49, 5043, '変動暴露反應', UNIX_TIMESTAMP()),
+	(447, 1445, '末梢神経保護剤', UNIX_TIMESTAMP()),
+	(251, 250, '結晶状況計算', UNIX_TIMESTAMP()),
+	(120, 4115, '音楽回路設計', UNIX_TIMESTAMP()),
+	(83, 6076, '脳内再生治療', UNIX_TIMESTAMP()),
+	(221, 5215, '酸化還元環境制御', UNIX_TIMESTAMP()),
+	(907, 4902, '植物群体観測', UNIX_TIMESTAMP()),
+	(813, 812, '電磁波防護設備', UNIX_TIMESTAMP()),
+	(216, 2213, '新型栄養補助飲料開発', UNIX_TIMESTAMP()),
+	(885, 4880, '水運系統化', UNIX_TIMESTAMP()),
+	(988, 2985, '古文書管理', UNIX_TIMESTAMP()),
+	(857, 5851, '無窮極蹂躙', UNIX_TIMESTAMP()),
+	(170, 7162, '綜合魔道術', UNIX_TIMESTAMP()),
+	(502, 2499, '高次元空間情報解析', UNIX_TIMESTAMP()),
+	(307, 5301, '空中分子計算機', UNIX_TIMESTAMP()),
+	(240, 2237, '高密度懸濁質解析', UNIX_TIMESTAMP()),
+	(260, 6253, '未来予測時間尺度', UNIX_TIMESTAMP()),
+	(501, 1499, '非線形計画', UNIX_TIMESTAMP()),
+	(999, 1997, '生物装着体', UNIX_TIMESTAMP()),
+	(748, 747, '大気螺旋磁場', UNIX_TIMESTAMP()),
+	(502, 4497, '連結仮相換', UNIX_TIMESTAMP()),
+	(270, 4265, '精霊磁結晶', UNIX_TIMESTAMP()),
+	(218, 7210, '波動共鳴化', UNIX_TIMESTAMP()),
+	(49, 6042, '化石超復元', UNIX_TIMESTAMP()),
+	(447, 7439, '超遠隔行為', UNIX_TIMESTAMP()),
+	(723, 3719, '空間伝送錯誤', UNIX_TIMESTAMP()),
+	(321, 5315, '無重力結晶', UNIX_TIMESTAMP()),
+	(291, 7283, '精神交換装置', UNIX_TIMESTAMP()),
+	(664, 5658, '薬剤反響現象', UNIX_TIMESTAMP()),
+	(328, 4323, '生体共振合成', UNIX_TIMESTAMP()),
+	(616, 615, '時間軸螺旋', UNIX_TIMESTAMP()),
+	(260, 259, '空想移行騒音', UNIX_TIMESTAMP()),
+	(29, 3025, '未来予測共鳴', UNIX_TIMESTAMP()),
+	(48, 47, '潜在記憶定着装置', UNIX_TIMESTAMP()),
+	(387, 2384, '影分裂念動', UNIX_TIMESTAMP()),
+	(444, 3440, '観測崩壊効果', UNIX_TIMESTAMP()),
+	(200, 6193, '定義不明引力', UNIX_TIMESTAMP()),
+	(803, 802, '空間共有軌道', UNIX_TIMESTAMP()),
+	(410, 409, '量子反響波', UNIX_TIMESTAMP()),
+	(817, 816, '記憶断片回塞', UNIX_TIMESTAMP()),
+	(980, 3976, '音響干渉反乱', UNIX_TIMESTAMP()),
+	(91, 6084, '創造力育成', UNIX_TIMESTAMP()),
+	(695, 694, '生命回復術士', UNIX_TIMESTAMP()),
+	(420, 419, '魔界探査鏡', UNIX_TIMESTAMP()),
+	(941, 1939, '魔動生命体', UNIX_TIMESTAMP()),
+	(953, 4948, '斜面逆滑走', UNIX_TIMESTAMP()),
+	(708, 4703, '麦花粉製魔', UNIX_TIMESTAMP()),
+	(927, 3923, '捕虜解放計画', UNIX_TIMESTAMP()),
+	(16, 3012, '封姦妄想結界', UNIX_TIMESTAMP()),
+	(347, 6340, '奇遇探知士', UNIX_TIMESTAMP()),
+	(55, 5049, '焚火慣熱患者', UNIX_TIMESTAMP()),
+	(446, 5440, '奇跡監視装置', UNIX_TIMESTAMP()),
+	(585, 6578, '農作物枯渇予備', UNIX_TIMESTAMP()),
+	(364, 4359, '液状態願望', UNIX_TIMESTAMP()),
+	(410, 409, '内蔵飢餓状態', UNIX_TIMESTAMP()),
+	(582, 581, '凸版印刷機', UNIX_TIMESTAMP()),
+	(242, 1240, '再帰思考機', UNIX_TIMESTAMP()),
+	(283, 1281, '遺伝子解凍装置', UNIX_TIMESTAMP()),
+	(28, 27, '半月型発掘機', UNIX_TIMESTAMP()),
+	(921, 4916, '平衡反応経路', UNIX_TIMESTAMP()),
+	(502, 5496, '電気伝導率鏡', UNIX_TIMESTAMP()),
+	(401, 7393, '共鳴震源装置', UNIX_TIMESTAMP()),
+	(337, 2334, '分子結晶振動子', UNIX_TIMESTAMP()),
+	(927, 4922, '磁化封入材', UNIX_TIMESTAMP()),
+	(569, 1567, '漸近収束階梯', UNIX_TIMESTAMP()),
+	(801, 5795, '基本定序装置', UNIX_TIMESTAMP()),
+	(833, 4828, '飛行物体検知器', UNIX_TIMESTAMP()),
+	(352, 2349, '画像解体顕微鏡', UNIX_TIMESTAMP()),
+	(622, 621, '微生物相変容器', UNIX_TIMESTAMP()),
+	(415, 1413, '核融合燃料供給管', UNIX_TIMESTAMP()),
+	(994, 1992, '認知反射反応剤', UNIX_TIMESTAMP()),
+	(530, 2527, '多角度射撃調整機', UNIX_TIMESTAMP()),
+	(643, 1641, '造花細胞培養器', UNIX_TIMESTAMP()),
+	(167, 6160, '負荷分散伝精器', UNIX_TIMESTAMP()),
+	(476, 2473, '情報凝視拡張機', UNIX_TIMESTAMP()),
+	(904, 4899, '迎角安定装置', UNIX_TIMESTAMP()),
+	(118, 5112, '芝生圧搾機', UNIX_TIMESTAMP()),
+	(174, 4169, '無機振動触媒', UNIX_TIMESTAMP()),
+	(491, 1489, '反動軽減器', UNIX_TIMESTAMP()),
+	(695, 3691, '求道遷移反応', UNIX_TIMESTAMP()),
+	(501, 2498, '線状複合骨材', UNIX_TIMESTAMP()),
+	(719, 1717, '阿弗拉発火機', UNIX_TIMESTAMP()),
+	(408, 7400, '超音波機構', UNIX_TIMESTAMP()),
+	(967, 2964, '生物同位体', UNIX_TIMESTAMP()),
+	(468, 467, '漸進式制御', UNIX_TIMESTAMP()),
+	(466, 7458, '創造共同体', UNIX_TIMESTAMP()),
+	(416, 7408, '宇宙潜在力', UNIX_TIMESTAMP()),
+	(496, 7488, '量子瞬間移動', UNIX_TIMESTAMP()),
+	(208, 6201, '地球磁場逆転', UNIX_TIMESTAMP()),
+	(569, 5563, '超伝導磁気', UNIX_TIMESTAMP()),
+	(101, 2098, '音波位相制御', UNIX_TIMESTAMP()),
+	(134, 7126, '反重力装置', UNIX_TIMESTAMP()),
+	(875, 1873, '静電力制御', UNIX_TIMESTAMP()),
+	(864, 3860, '高分子絶縁体', UNIX_TIMESTAMP()),
+	(102, 3098, '静的構造解析', UNIX_TIMESTAMP()),
+	(971, 3967, '断熱圧力容器', UNIX_TIMESTAMP()),
+	(456, 2453, '液晶濃度測定器', UNIX_TIMESTAMP()),
+	(684, 3680, '真空封液装置', UNIX_TIMESTAMP()),
+	(130, 6123, '相転移検出器', UNIX_TIMESTAMP()),
+	(544, 543, '表層硬化材', UNIX_TIMESTAMP()),
+	(300, 4295, '高効率圧縮装置', UNIX_TIMESTAMP()),
+	(111, 5105, '粉末気孔率測定', UNIX_TIMESTAMP()),
+	(842, 5836, '全長感応器', UNIX_TIMESTAMP()),
+	(978, 1976, '結晶構造解析装置', UNIX_TIMESTAMP()),
+	(841, 5835, '熱分解速度解析', UNIX_TIMESTAMP()),
+	(452, 5446, '永久偏向機構', UNIX_TIMESTAMP()),
+	(87, 1085, '爆音干渉抑制材', UNIX_TIMESTAMP()),
+	(513, 3509, '配位高分子触媒', UNIX_TIMESTAMP()),
+	(732, 731, '超越次元通信', UNIX_TIMESTAMP()),
+	(89, 4084, '高次脳波探査', UNIX_TIMESTAMP()),
+	(268, 1266, '量子磁気共振', UNIX_TIMESTAMP()),
+	(535, 1533, '超音速重力制御', UNIX_TIMESTAMP()),
+	(3, 4997, '非可逆量子暗号', UNIX_TIMESTAMP()),
+	(25, 1023, '新材料時空圧縮', UNIX_TIMESTAMP()),
+	(135, 4130, '超音速超電導物質', UNIX_TIMESTAMP()),
+	(362, 2359, '光波加速粒子解析', UNIX_TIMESTAMP()),
+	(600, 5594, '超重力重力場応用', UNIX_TIMESTAMP()),
+	(615, 1613, '力場生成共鳴', UNIX_TIMESTAMP()),
+	(251, 7243, '電子顕微鏡像再構成', UNIX_TIMESTAMP()),
+	(543, 1541, '超音速空気抵抗解消', UNIX_TIMESTAMP()),
+	(646, 2643, '粒子加速器鎧', UNIX_TIMESTAMP()),
+	(759, 3755, '幻覚映画装置', UNIX_TIMESTAMP()),
+	(64, 7056, '生物変換注射器', UNIX_TIMESTAMP()),
+	(827, 4822, '蓄音鐘脳波', UNIX_TIMESTAMP()),
+	(590, 4585, '心霊磁力計', UNIX_TIMESTAMP()),
+	(419, 4414, '量子伝送前電子', UNIX_TIMESTAMP()),
+	(614, 6607, '想念反転光波', UNIX_TIMESTAMP()),
+	(776, 1774, '自己進化暗号解読機', UNIX_TIMESTAMP()),
+	(314, 1312, '光磁気瞳孔', UNIX_TIMESTAMP()),
+	(962, 2959, '天体遺伝子集積計', UNIX_TIMESTAMP()),
+	(572, 4567, '統計変動係数', UNIX_TIMESTAMP()),
+	(701, 5695, '逆位群制御', UNIX_TIMESTAMP()),
+	(145, 4140, '疑似質量推定', UNIX_TIMESTAMP()),
+	(562, 6555, '鉄筋強度比', UNIX_TIMESTAMP()),
+	(506, 5500, '振動応答解析', UNIX_TIMESTAMP()),
+	(774, 1772, '静的摩擦抵抗', UNIX_TIMESTAMP()),
+	(157, 3153, '無制限自己増殖', UNIX_TIMESTAMP()),
+	(869, 6862, '策妥標識酵素', UNIX_TIMESTAMP()),
+	(769, 5763, '加圧冷凍融解', UNIX_TIMESTAMP()),
+	(234, 3230, '位相推力制御', UNIX_TIMESTAMP()),
+	(989, 988, '高感度震動計', UNIX_TIMESTAMP()),
+	(612, 611, '霊気制御術', UNIX_TIMESTAMP()),
+	(582, 2579, '悪疫退散儀式', UNIX_TIMESTAMP()),
+	(212, 5206, '同軸反転装置', UNIX_TIMESTAMP()),
+	(129, 5123, '雷動力装置', UNIX_TIMESTAMP()),
+	(932, 1930, '時空歪曲効果', UNIX_TIMESTAMP()),
+	(751, 4746, '量子認識装置', UNIX_TIMESTAMP()),
+	(503, 2500, '魔力共振器', UNIX_TIMESTAMP()),
+	(270, 2267, '知覚領域拡張装置', UNIX_TIMESTAMP()),
+	(445, 5439, '生体再構築術', UNIX_TIMESTAMP()),
+	(175, 3171, '死霊召喚術', UNIX_TIMESTAMP()),
+	(841, 4836, '瞑想状態抽出装置', UNIX_TIMESTAMP()),
+	(163, 5157, '心理暗示装置', UNIX_TIMESTAMP()),
+	(397, 4392, '月下図書館', UNIX_TIMESTAMP()),
+	(480, 2477, '磁気感応式渦電流検出器', UNIX_TIMESTAMP()),
+	(441, 1439, '超音速液体振動子', UNIX_TIMESTAMP()),
+	(454, 3450, '熱帯林生態復元装置', UNIX_TIMESTAMP()),
+	(116, 3112, '分子交差結合反応速度促進触媒', UNIX_TIMESTAMP()),
+	(192, 4187, '高強度響尾岩製造装置', UNIX_TIMESTAMP()),
+	(903, 2900, '真空遮蔽放射熱下環境計測装置', UNIX_TIMESTAMP()),
+	(562, 561, '分散型単分子作動回路', UNIX_TIMESTAMP()),
+	(388, 3384, '陽子加速磁気共鳴画像診断装置', UNIX_TIMESTAMP()),
+	(104, 103, '相対風洞環境再現装置', UNIX_TIMESTAMP()),
+	(294, 2291, '空気純化装置', UNIX_TIMESTAMP()),
+	(706, 3702, '電磁波障壁', UNIX_TIMESTAMP()),
+	(890, 5884, '遺伝子修復装置', UNIX_TIMESTAMP()),
+	(289, 1287, '月面探査器', UNIX_TIMESTAMP()),
+	(501, 6494, '睡眠覚醒状態計測器', UNIX_TIMESTAMP()),
+	(965, 2962, '無重力環境装置', UNIX_TIMESTAMP()),
+	(531, 3527, '量子暗号通信', UNIX_TIMESTAMP()),
+	(275, 6268, '無菌環境制御', UNIX_TIMESTAMP()),
+	(912, 1910, '音楽録音最適化', UNIX_TIMESTAMP()),
+	(89, 5083, '無駄排出削減装置', UNIX_TIMESTAMP()),
+	(181, 180, '熱設計最適化', UNIX_TIMESTAMP()),
+	(272, 4267, '潜在意識操縦', UNIX_TIMESTAMP()),
+	(964, 6957, '粒子分布解析', UNIX_TIMESTAMP()),
+	(877, 1875, '環境情報探造', UNIX_TIMESTAMP()),
+	(356, 4351, '記憶再構築', UNIX_TIMESTAMP()),
+	(400, 4395, '組織構成要素', UNIX_TIMESTAMP()),
+	(106, 4101, '微粒子分散', UNIX_TIMESTAMP()),
+	(387, 1385, '内部反応速度', UNIX_TIMESTAMP()),
+	(932, 4927, '蒸気機関総動力', UNIX_TIMESTAMP()),
+	(22, 1020, '量子輸送機構', UNIX_TIMESTAMP()),
+	(187, 186, '自動潜水装置', UNIX_TIMESTAMP()),
+	(627, 4622, '超高層試験室', UNIX_TIMESTAMP()),
+	(758, 6751, '逆転時間結晶', UNIX_TIMESTAMP()),
+	(596, 5590, '振動伝達関数', UNIX_TIMESTAMP()),
+	(848, 5842, '空中磁力振動器', UNIX_TIMESTAMP()),
+	(453, 6446, '子供認知計測器', UNIX_TIMESTAMP()),
+	(257, 4252, '脳信号解析装置', UNIX_TIMESTAMP()),
+	(561, 6554, '窒素化装置', UNIX_TIMESTAMP()),
+	(536, 4531, '遮光光合成', UNIX_TIMESTAMP()),
+	(280, 3276, '珪酸塩基構造', UNIX_TIMESTAMP()),
+	(788, 3784, '提案併合勘定', UNIX_TIMESTAMP()),
+	(187, 6180, '磨耗防止装置', UNIX_TIMESTAMP()),
+	(483, 6476, '吸音吸臭合成物', UNIX_TIMESTAMP()),
+	(302, 4297, '吹奏機効率化', UNIX_TIMESTAMP()),
+	(625, 3621, '加圧樹皮除去', UNIX_TIMESTAMP()),
+	(285, 2282, '排熱利用設備', UNIX_TIMESTAMP()),
+	(179, 7171, '根鉦粒子強度', UNIX_TIMESTAMP()),
+	(128, 3124, '滲出堰準備', UNIX_TIMESTAMP()),
+	(867, 4862, '転位膨張現象', UNIX_TIMESTAMP()),
+	(437, 7429, '酸化反応抑制', UNIX_TIMESTAMP()),
+	(901, 4896, '多機能薄膜合金', UNIX_TIMESTAMP()),
+	(631, 4626, '鮮度保持装置', UNIX_TIMESTAMP()),
+	(242, 2239, '創世言還流', UNIX_TIMESTAMP()),
+	(659, 3655, '幻想症候群', UNIX_TIMESTAMP()),
+	(69, 3065, '煌眼素粒子', UNIX_TIMESTAMP()),
+	(117, 5111, '巨大反応器', UNIX_TIMESTAMP()),
+	(205, 1203, '加速化合物', UNIX_TIMESTAMP()),
+	(337, 7329, '四次元曲線', UNIX_TIMESTAMP()),
+	(303, 6296, '透明強化繊維', UNIX_TIMESTAMP()),
+	(74, 2071, '感応反応素子', UNIX_TIMESTAMP()),
+	(314, 1312, '精神固定回路', UNIX_TIMESTAMP()),
+	(704, 5698, '生体電波放射', UNIX_TIMESTAMP()),
+	(758, 5752, '遺伝素因子転写', UNIX_TIMESTAMP()),
+	(49, 5043, '空間応答率', UNIX_TIMESTAMP()),
+	(254, 1252, '高次膜抵抗', UNIX_TIMESTAMP()),
+	(288, 2285, '抱合合反応', UNIX_TIMESTAMP()),
+	(14, 3010, '電子波圧強度', UNIX_TIMESTAMP()),
+	(477, 7469, '知識領域解析', UNIX_TIMESTAMP()),
+	(263, 2260, '遠心緑藻株', UNIX_TIMESTAMP()),
+	(984, 983, '混和過飽和度', UNIX_TIMESTAMP()),
+	(227, 6220, '脳内情報処理', UNIX_TIMESTAMP()),
+	(61, 1059, '発光蛍光酵素', UNIX_TIMESTAMP()),
+	(624, 6617, '反射衝撃阻害器', UNIX_TIMESTAMP()),
+	(991, 6984, '民生福祉指標', UNIX_TIMESTAMP()),
+	(854, 6847, '音声周波数帯域', UNIX_TIMESTAMP()),
+	(733, 4728, '配置率統合度', UNIX_TIMESTAMP()),
+	(223, 3219, '温度応答調節素', UNIX_TIMESTAMP()),
+	(774, 773, '換気風圧測定', UNIX_TIMESTAMP()),
+	(852, 3848, '曲光波値定数', UNIX_TIMESTAMP()),
+	(779, 3775, '貿易収支均衡', UNIX_TIMESTAMP()),
+	(534, 1532, '直感連鎖思考', UNIX_TIMESTAMP()),
+	(280, 1278, '心理時経遷移', UNIX_TIMESTAMP()),
+	(839, 5833, '根周波数検定', UNIX_TIMESTAMP()),
+	(868, 4863, '口腔衛生管理', UNIX_TIMESTAMP()),
+	(919, 6912, '気候循環変動', UNIX_TIMESTAMP()),
+	(903, 902, '発生周期解析', UNIX_TIMESTAMP()),
+	(184, 3180, '生態連鎖構造', UNIX_TIMESTAMP()),
+	(854, 5848, '安全防災訓練', UNIX_TIMESTAMP()),
+	(250, 5244, '経費数理計画', UNIX_TIMESTAMP()),
+	(968, 5962, '無煙舞台照明', UNIX_TIMESTAMP()),
+	(390, 6383, '要協推散剤', UNIX_TIMESTAMP()),
+	(336, 6329, '音響音三重反転', UNIX_TIMESTAMP()),
+	(479, 478, '起震出力演算', UNIX_TIMESTAMP()),
+	(154, 153, '石英左巻螺旋', UNIX_TIMESTAMP()),
+	(760, 3756, '階調線量変換', UNIX_TIMESTAMP()),
+	(822, 5816, '誘導放射熱媒体', UNIX_TIMESTAMP()),
+	(61, 7053, '回路交証信号', UNIX_TIMESTAMP()),
+	(578, 3574, '圧力螺旋安定化', UNIX_TIMESTAMP()),
+	(757, 4752, '細胞修復装置', UNIX_TIMESTAMP()),
+	(821, 820, '因果時間軸', UNIX_TIMESTAMP()),
+	(45, 1043, '統合感覚装置', UNIX_TIMESTAMP()),
+	(6, 2003, '量子搬送潜在体', UNIX_TIMESTAMP()),
+	(236, 6229, '平行次元干渉体', UNIX_TIMESTAMP()),
+	(359, 6352, '霊能波継続発振器', UNIX_TIMESTAMP()),
+	(811, 810, '平衡座標行列', UNIX_TIMESTAMP()),
+	(727, 3723, '青銅鍛冶術', UNIX_TIMESTAMP()),
+	(279, 6272, '白蓮八極掌', UNIX_TIMESTAMP()),
+	(751, 2748, '光合成促進', UNIX_TIMESTAMP()),
+	(271, 4266, '微生物対話', UNIX_TIMESTAMP()),
+	(471, 4466, '加速文明化', UNIX_TIMESTAMP()),
+	(387, 7379, '蒸気環境制御', UNIX_TIMESTAMP()),
+	(113, 6106, '積層構造解析', UNIX_TIMESTAMP()),
+	(685, 6678, '高周波振動', UNIX_TIMESTAMP()),
+	(477, 7469, '芳香族炭化水素', UNIX_TIMESTAMP()),
+	(310, 5304, '地下空間利用', UNIX_TIMESTAMP()),
+	(942, 4937, '非破壊診断', UNIX_TIMESTAMP()),
+	(623, 622, '有機土壌改良剤', UNIX_TIMESTAMP()),
+	(547, 3543, '自然対流循環', UNIX_TIMESTAMP()),
+	(452, 3448, '電子感知材料', UNIX_TIMESTAMP()),
+	(894, 3890, '衝撃吸収剤', UNIX_TIMESTAMP()),
+	(72, 3068, '帯電防止塗料', UNIX_TIMESTAMP()),
+	(841, 5835, '高電解効率', UNIX_TIMESTAMP()),
+	(209, 208, '放射線遮蔽剤', UNIX_TIMESTAMP()),
+	(194, 3190, '局所温度上昇抑制', UNIX_TIMESTAMP()),
+	(703, 4698, '地下貯蔵庫', UNIX_TIMESTAMP()),
+	(774, 6767, '国際河川管理', UNIX_TIMESTAMP()),
+	(809, 6802, '澄空反射波', UNIX_TIMESTAMP()),
+	(44, 4039, '飛滴凝束塔', UNIX_TIMESTAMP()),
+	(851, 2848, '酸化膜離析', UNIX_TIMESTAMP()),
+	(296, 3292, '爆破圧縮剤', UNIX_TIMESTAMP()),
+	(291, 7283, '経功率誤差値', UNIX_TIMESTAMP()),
+	(447, 4442, '無貫通応答曲線', UNIX_TIMESTAMP()),
+	(419, 1417, '凸透明吸波体', UNIX_TIMESTAMP()),
+	(766, 6759, '有機結晶幾何', UNIX_TIMESTAMP()),
+	(626, 2623, '強磁場繊維水晶', UNIX_TIMESTAMP()),
+	(289, 1287, '振動礫沈痕', UNIX_TIMESTAMP()),
+	(252, 4247, '旋律飛散加速度', UNIX_TIMESTAMP()),
+	(134, 6127, '神経感染塊', UNIX_TIMESTAMP()),
+	(173, 4168, '媒染力解析', UNIX_TIMESTAMP()),
+	(391, 5385, '商習慣評価', UNIX_TIMESTAMP()),
+	(834, 5828, '社会再構築', UNIX_TIMESTAMP()),
+	(891, 890, '交渉力研究', UNIX_TIMESTAMP()),
+	(193, 192, '液体応力計測装置', UNIX_TIMESTAMP()),
+	(200, 4195, '無機質分析装置', UNIX_TIMESTAMP()),
+	(902, 901, '電力安定化回路', UNIX_TIMESTAMP()),
+	(264, 2261, '抗体中和反応検査', UNIX_TIMESTAMP()),
+	(479, 7471, '遠隔制御装置', UNIX_TIMESTAMP()),
+	(887, 886, '水疱瘡熱素', UNIX_TIMESTAMP()),
+	(910, 6903, '聚糸流鏑馬', UNIX_TIMESTAMP()),
+	(884, 883, '睡眠忘却症', UNIX_TIMESTAMP()),
+	(388, 4383, '廃棄物隠蔽処理', UNIX_TIMESTAMP()),
+	(38, 4033, '結石原因値', UNIX_TIMESTAMP()),
+	(703, 1701, '虚空採掘機', UNIX_TIMESTAMP()),
+	(571, 1569, '無限拡大定理', UNIX_TIMESTAMP()),
+	(857, 6850, '地下水操業機', UNIX_TIMESTAMP()),
+	(836, 835, '卵黄逆露樹', UNIX_TIMESTAMP()),
+	(300, 1298, '宇宙船複合体', UNIX_TIMESTAMP()),
+	(836, 2833, '水辺公園整備計画', UNIX_TIMESTAMP()),
+	(605, 1603, '音楽媒介体', UNIX_TIMESTAMP()),
+	(708, 3704, '結晶共役化合物', UNIX_TIMESTAMP()),
+	(333, 1331, '観察者傾向指数', UNIX_TIMESTAMP()),
+	(98, 1096, '透過迷彩装置', UNIX_TIMESTAMP()),
+	(828, 827, '精神微生物', UNIX_TIMESTAMP()),
+	(885, 4880, '遺伝子共振', UNIX_TIMESTAMP()),
+	(314, 2311, '情報融合結晶', UNIX_TIMESTAMP()),
+	(463, 3459, '心地認識装置', UNIX_TIMESTAMP()),
+	(878, 3874, '超微細重力場', UNIX_TIMESTAMP()),
+	(38, 4033, '光子製造装置', UNIX_TIMESTAMP()),
+	(65, 64, '未来観測機', UNIX_TIMESTAMP()),
+	(205, 2202, '四次元抵抗体', UNIX_TIMESTAMP()),
+	(469, 3465, '電波感応結晶', UNIX_TIMESTAMP()),
+	(496, 4491, '無限相互通信', UNIX_TIMESTAMP()),
+	(431, 4426, '情報残留体', UNIX_TIMESTAMP()),
+	(839, 5833, '環流窒素擬似反応', UNIX_TIMESTAMP()),
+	(243, 7235, '走査磁気共鳴分析', UNIX_TIMESTAMP()),
+	(480, 4475, '高分子凝集制御装置', UNIX_TIMESTAMP()),
+	(919, 4914, '多軸運動平衡調節', UNIX_TIMESTAMP()),
+	(651, 5645, '残留樹脂結晶光化反応', UNIX_TIMESTAMP()),
+	(84, 6077, '自発自然共鳴濾過', UNIX_TIMESTAMP()),
+	(599, 2596, '真空量子障壁衝突', UNIX_TIMESTAMP()),
+	(345, 344, '比割硬質炭化二酸化合金', UNIX_TIMESTAMP()),
+	(675, 5669, '形態不変有機質融合体', UNIX_TIMESTAMP()),
+	(463, 462, '分子構造関連振動励起', UNIX_TIMESTAMP()),
+	(260, 259, '多軸励起屈折率制御', UNIX_TIMESTAMP()),
+	(285, 7277, '深層微弱磁場解析', UNIX_TIMESTAMP()),
+	(692, 691, '飛霜手裏剣', UNIX_TIMESTAMP()),
+	(312, 3308, '地錆化分析', UNIX_TIMESTAMP()),
+	(956, 3952, '水煤灰試験', UNIX_TIMESTAMP()),
+	(619, 1617, '粒子加速器', UNIX_TIMESTAMP()),
+	(143, 1141, '遺伝子治療', UNIX_TIMESTAMP()),
+	(194, 4189, '電子顕微鏡', UNIX_TIMESTAMP()),
+	(621, 3617, '原子力発電', UNIX_TIMESTAMP()),
+	(728, 4723, '電波望遠鏡', UNIX_TIMESTAMP()),
+	(61, 60, '新素材開発', UNIX_TIMESTAMP()),
+	(608, 3604, '消費力指数', UNIX_TIMESTAMP()),
+	(18, 5012, '市民交渉力', UNIX_TIMESTAMP()),
+	(68, 7060, '自己抑制術', UNIX_TIMESTAMP()),
+	(270, 4265, '音響情報解析', UNIX_TIMESTAMP()),
+	(864, 863, '微生物抗体', UNIX_TIMESTAMP()),
+	(573, 2570, '社会階層分析', UNIX_TIMESTAMP()),
+	(360, 4355, '畜産肥料施用', UNIX_TIMESTAMP()),
+	(167, 3163, '倫理観念説明', UNIX_TIMESTAMP()),
+	(372, 3368, '言語接触現象', UNIX_TIMESTAMP()),
+	(774, 3770, '反応速度制御', UNIX_TIMESTAMP()),
+	(292, 291, '物質透過度', UNIX_TIMESTAMP()),
+	(179, 6172, '神経伝達速度', UNIX_TIMESTAMP()),
+	(596, 4591, '思考類似度', UNIX_TIMESTAMP()),
+	(508, 4503, '思考安定化', UNIX_TIMESTAMP()),
+	(585, 5579, '構造誘電体', UNIX_TIMESTAMP()),
+	(739, 4734, '構造微生物', UNIX_TIMESTAMP()),
+	(378, 1376, '核反応制御', UNIX_TIMESTAMP()),
+	(687, 4682, '半導体融合', UNIX_TIMESTAMP()),
+	(892, 1890, '陽電子冷却', UNIX_TIMESTAMP()),
+	(235, 234, '心域翻訳機', UNIX_TIMESTAMP()),
+	(61, 6054, '群生微粒子', UNIX_TIMESTAMP()),
+	(845, 6838, '音隔響装置', UNIX_TIMESTAMP()),
+	(721, 3717, '意味曖昧度', UNIX_TIMESTAMP()),
+	(252, 2249, '単位絶対値', UNIX_TIMESTAMP()),
+	(233, 2230, '音質調整器', UNIX_TIMESTAMP()),
+	(117, 4112, '仮想滞在感', UNIX_TIMESTAMP()),
+	(891, 1889, '上位参照符号', UNIX_TIMESTAMP()),
+	(319, 6312, '条件発生器', UNIX_TIMESTAMP()),
+	(51, 1049, '皇帝選曲術', UNIX_TIMESTAMP()),
+	(636, 5630, '独角古典音楽', UNIX_TIMESTAMP()),
+	(183, 2180, '瑠璃美術史', UNIX_TIMESTAMP()),
+	(261, 7253, '霊子制御術', UNIX_TIMESTAMP()),
+	(99, 6092, '光波合成器', UNIX_TIMESTAMP()),
+	(567, 1565, '火花反射素子', UNIX_TIMESTAMP()),
+	(439, 438, '原子分離器', UNIX_TIMESTAMP()),
+	(663, 6656, '透心霊装置', UNIX_TIMESTAMP()),
+	(912, 2909, '定義一元体', UNIX_TIMESTAMP()),
+	(154, 5148, '天地開闢器', UNIX_TIMESTAMP()),
+	(794, 4789, '反伝達装置', UNIX_TIMESTAMP()),
+	(920, 919, '霊酵素触媒', UNIX_TIMESTAMP()),
+	(91, 5085, '極地静止機構', UNIX_TIMESTAMP()),
+	(708, 5702, '硬質共鳴体', UNIX_TIMESTAMP()),
+	(315, 3311, '音波反射結晶', UNIX_TIMESTAMP()),
+	(499, 7491, '妄想電位器', UNIX_TIMESTAMP()),
+	(449, 6442, '図式錬成術', UNIX_TIMESTAMP()),
+	(311, 3307, '電磁隠密装置', UNIX_TIMESTAMP()),
+	(744, 1742, '知覚単位増幅器', UNIX_TIMESTAMP()),
+	(581, 4576, '電磁誘引細胞', UNIX_TIMESTAMP()),
+	(574, 5568, '光速超乗体', UNIX_TIMESTAMP()),
+	(892, 3888, '騎士霊装甲', UNIX_TIMESTAMP()),
+	(807, 5801, '宇宙片温場', UNIX_TIMESTAMP()),
+	(472, 5466, '未来観予測', UNIX_TIMESTAMP()),
+	(848, 4843, '粒子量伝感', UNIX_TIMESTAMP()),
+	(615, 6608, '海月倶楽部', UNIX_TIMESTAMP()),
+	(146, 3142, '心象情報量', UNIX_TIMESTAMP()),
+	(174, 4169, '協調脳機能', UNIX_TIMESTAMP()),
+	(248, 2245, '空間適応力', UNIX_TIMESTAMP()),
+	(658, 657, '非反向計画', UNIX_TIMESTAMP()),
+	(294, 7286, '構造的時間感覚', UNIX_TIMESTAMP()),
+	(369, 2366, '統合情報取得', UNIX_TIMESTAMP()),
+	(958, 957, '知覚経験応用', UNIX_TIMESTAMP()),
+	(690, 689, '規則分岐適応', UNIX_TIMESTAMP()),
+	(718, 5712, '既存情報抽象', UNIX_TIMESTAMP()),
+	(184, 7176, '創造的進化速度', UNIX_TIMESTAMP()),
+	(477, 6470, '概念階層体系', UNIX_TIMESTAMP()),
+	(970, 1968, '対話型知覚能力', UNIX_TIMESTAMP()),
+	(38, 1036, '分散音声処理', UNIX_TIMESTAMP()),
+	(955, 954, '蒸発関量指数', UNIX_TIMESTAMP()),
+	(955, 954, '客観的知覚度', UNIX_TIMESTAMP()),
+	(598, 4593, '水文蒸発量', UNIX_TIMESTAMP()),
+	(903, 4898, '反応速度係数', UNIX_TIMESTAMP()),
+	(771, 3767, '地殻変動説', UNIX_TIMESTAMP()),
+	(230, 6223, '文化集合体', UNIX_TIMESTAMP()),
+	(234, 4229, '事業承継戦略', UNIX_TIMESTAMP()),
+	(846, 6839, '構造強度指標', UNIX_TIMESTAMP()),
+	(568, 4563, '空気清化器', UNIX_TIMESTAMP()),
+	(48, 5042, '重力制御装置', UNIX_TIMESTAMP()),
+	(579, 1577, '水質浄化装置', UNIX_TIMESTAMP()),
+	(336, 335, '地震予測装置', UNIX_TIMESTAMP()),
+	(565, 564, '植物成長促進剤', UNIX_TIMESTAMP()),
+	(330, 3326, '環境衛生評価指標', UNIX_TIMESTAMP()),
+	(245, 5239, '超音速輸送機', UNIX_TIMESTAMP()),
+	(404, 7396, '自動運転車', UNIX_TIMESTAMP()),
+	(241, 4236, '高圧電力変換装置', UNIX_TIMESTAMP()),
+	(263, 4258, '原子炉冷却装置', UNIX_TIMESTAMP()),
+	(308, 1306, '脳波解析装置', UNIX_TIMESTAMP()),
+	(160, 1158, '睡眠効果音楽', UNIX_TIMESTAMP()),
+	(315, 3311, '光合成効率向上剤', UNIX_TIMESTAMP()),
+	(439, 2436, '振動吸音材料', UNIX_TIMESTAMP()),
+	(697, 6690, '隕石計算機', UNIX_TIMESTAMP()),
+	(601, 600, '水中原子力発電所', UNIX_TIMESTAMP()),
+	(228, 1226, '心理物質分析器', UNIX_TIMESTAMP()),
+	(561, 5555, '量子風速計', UNIX_TIMESTAMP()),
+	(851, 6844, '宇宙時間網', UNIX_TIMESTAMP()),
+	(668, 6661, '沼地維持装置', UNIX_TIMESTAMP()),
+	(395, 4390, '無重力物質製造装置', UNIX_TIMESTAMP()),
+	(263, 7255, '多次元暗号通信', UNIX_TIMESTAMP()),
+	(125, 5119, '超高速回転冷凍機', UNIX_TIMESTAMP()),
+	(130, 1128, '水陸両用機', UNIX_TIMESTAMP()),
+	(753, 6746, '新機軸材料', UNIX_TIMESTAMP()),
+	(985, 5979, '無重力浮遊体', UNIX_TIMESTAMP()),
+	(152, 151, '高密度封入', UNIX_TIMESTAMP()),
+	(892, 3888, '無限微粒子', UNIX_TIMESTAMP()),
+	(137, 5131, '直交多次元図形', UNIX_TIMESTAMP()),
+	(202, 6195, '干渉放射線', UNIX_TIMESTAMP()),
+	(296, 4291, '超音速衝撃波', UNIX_TIMESTAMP()),
+	(77, 5071, '光遷移遺伝子', UNIX_TIMESTAMP()),
+	(858, 857, '火炎激烈裂風', UNIX_TIMESTAMP()),
+	(201, 1199, '飛行螺旋混同', UNIX_TIMESTAMP()),
+	(159, 3155, '眩暈幻想崩壊', UNIX_TIMESTAMP()),
+	(220, 7212, '霊撃蝕融合', UNIX_TIMESTAMP()),
+	(142, 7134, '幻光結晶溶解', UNIX_TIMESTAMP()),
+	(175, 7167, '心拍不規則変動', UNIX_TIMESTAMP()),
+	(28, 7020, '波動透視丸細', UNIX_TIMESTAMP()),
+	(611, 1609, '力場対応瓦解', UNIX_TIMESTAMP()),
+	(733, 732, '無機物質遺伝操作', UNIX_TIMESTAMP()),
+	(92, 2089, '音波干渉頻度', UNIX_TIMESTAMP()),
+	(435, 2432, '疑似結晶相転移', UNIX_TIMESTAMP()),
+	(364, 7356, '情報感受波動', UNIX_TIMESTAMP()),
+	(678, 3674, '昇華精神転位', UNIX_TIMESTAMP()),
+	(750, 1748, '無重力空間誘導', UNIX_TIMESTAMP()),
+	(188, 3184, '暗黒時空連結', UNIX_TIMESTAMP()),
+	(587, 2584, '元素連鎖拡散', UNIX_TIMESTAMP()),
+	(623, 1621, '反物質融合分離', UNIX_TIMESTAMP()),
+	(71, 7063, '時間操縦干渉', UNIX_TIMESTAMP()),
+	(256, 1254, '心理感知装置', UNIX_TIMESTAMP()),
+	(375, 5369, '萎縮防止装置', UNIX_TIMESTAMP()),
+	(330, 6323, '航空機燃料', UNIX_TIMESTAMP()),
+	(624, 6617, '電磁波遮蔽', UNIX_TIMESTAMP()),
+	(543, 5537, '遺伝子研究', UNIX_TIMESTAMP()),
+	(193, 5187, '地球温暖化', UNIX_TIMESTAMP()),
+	(592, 6585, '心象大理石', UNIX_TIMESTAMP()),
+	(133, 2130, '無駄即効術', UNIX_TIMESTAMP()),
+	(929, 5923, '幕末政治術', UNIX_TIMESTAMP()),
+	(943, 5937, '環状振動軸', UNIX_TIMESTAMP()),
+	(614, 5608, '生体反応素子', UNIX_TIMESTAMP()),
+	(1, 6993, '円形遺伝網', UNIX_TIMESTAMP()),
+	(410, 5404, '宇宙精神共鳴', UNIX_TIMESTAMP()),
+	(540, 3536, '無限反応素子', UNIX_TIMESTAMP()),
+	(464, 7456, '音響画像解析', UNIX_TIMESTAMP()),
+	(905, 3901, '超古代遺伝秘伝', UNIX_TIMESTAMP()),
+	(569, 3565, '磁気流体制御術', UNIX_TIMESTAMP()),
+	(10, 1008, '無重力戦略計画術', UNIX_TIMESTAMP()),
+	(935, 5929, '遺伝子発現調節剤', UNIX_TIMESTAMP()),
+	(573, 5567, '微量振動器', UNIX_TIMESTAMP()),
+	(419, 418, '言葉相関解析', UNIX_TIMESTAMP()),
+	(130, 1128, '空間曲線推定', UNIX_TIMESTAMP()),
+	(399, 398, '飛燕華撃団', UNIX_TIMESTAMP()),
+	(54, 7046, '瞬間変速機', UNIX_TIMESTAMP()),
+	(163, 3159, '切削液噴霧装置', UNIX_TIMESTAMP()),
+	(119, 1117, '宇宙飛行士', UNIX_TIMESTAMP()),
+	(272, 7264, '時空間演算', UNIX_TIMESTAMP()),
+	(919, 3915, '共鳴頻度分析', UNIX_TIMESTAMP()),
+	(224, 2221, '情報瞑想術', UNIX_TIMESTAMP()),
+	(283, 5277, '負重換算指数', UNIX_TIMESTAMP()),
+	(872, 5866, '熱帯化病害予測', UNIX_TIMESTAMP()),
+	(600, 1598, '非接触超音波計測装置', UNIX_TIMESTAMP()),
+	(785, 1783, '機械安定基準分析', UNIX_TIMESTAMP()),
+	(656, 6649, '散逸定理補正計算', UNIX_TIMESTAMP()),
+	(904, 4899, '重力波吸収係数算定式', UNIX_TIMESTAMP()),
+	(489, 5483, '液体透明度測定器', UNIX_TIMESTAMP()),
+	(937, 1935, '宇宙大規模構造解析', UNIX_TIMESTAMP()),
+	(207, 4202, '経済思想史', UNIX_TIMESTAMP()),
+	(577, 3573, '動対照実験', UNIX_TIMESTAMP()),
+	(970, 3966, '断片断層像', UNIX_TIMESTAMP()),
+	(339, 4334, '彼岸花症候群', UNIX_TIMESTAMP()),
+	(474, 6467, '水分子磁気共鳴', UNIX_TIMESTAMP()),
+	(897, 3893, '写実主義美術', UNIX_TIMESTAMP()),
+	(190, 3186, '自動階層生成', UNIX_TIMESTAMP()),
+	(200, 3196, '深海鉄鉱床', UNIX_TIMESTAMP()),
+	(284, 283, '原子力船舶', UNIX_TIMESTAMP()),
+	(112, 2109, '平行木構造', UNIX_TIMESTAMP()),
+	(349, 1347, '発明特許権', UNIX_TIMESTAMP()),
+	(677, 4672, '混合病症候群', UNIX_TIMESTAMP()),
+	(689, 688, '会計情報処理', UNIX_TIMESTAMP()),
+	(231, 1229, '自動運転制御釣具', UNIX_TIMESTAMP()),
+	(330, 4325, '地球内部凍結観測機器', UNIX_TIMESTAMP()),
+	(582, 1580, '音楽合成環境因子', UNIX_TIMESTAMP()),
+	(687, 3683, '植物組織超解像顕微鏡', UNIX_TIMESTAMP()),
+	(278, 1276, '三次元検出超音波計測装置', UNIX_TIMESTAMP()),
+	(74, 73, '密積双晶化', UNIX_TIMESTAMP()),
+	(300, 5294, '熱翼蓄熱器', UNIX_TIMESTAMP()),
+	(778, 6771, '微小透明花崗岩', UNIX_TIMESTAMP()),
+	(252, 4247, '三次元映像生成装置', UNIX_TIMESTAMP()),
+	(459, 7451, '剛直非対称搬送帯', UNIX_TIMESTAMP()),
+	(733, 3729, '高速振幅増幅器', UNIX_TIMESTAMP()),
+	(356, 2353, '微小磁制御', UNIX_TIMESTAMP()),
+	(459, 5453, '非常薄放射線装置', UNIX_TIMESTAMP()),
+	(740, 1738, '可変強度照明体', UNIX_TIMESTAMP()),
+	(892, 1890, '不可逆圧力増幅器', UNIX_TIMESTAMP()),
+	(435, 3431, '多層芝平張力装置', UNIX_TIMESTAMP()),
+	(723, 1721, '高次元離散相互補完', UNIX_TIMESTAMP()),
+	(691, 5685, '組織縮小化', UNIX_TIMESTAMP()),
+	(507, 1505, '空脈独滑走', UNIX_TIMESTAMP()),
+	(841, 2838, '反射絵画術', UNIX_TIMESTAMP()),
+	(83, 6076, '肌凝固光線', UNIX_TIMESTAMP()),
+	(334, 1332, '天然剤凍結装置', UNIX_TIMESTAMP()),
+	(289, 7281, '微粒子拡散装置', UNIX_TIMESTAMP()),
+	(656, 4651, '情感転写装置', UNIX_TIMESTAMP()),
+	(280, 4275, '精神映像言語', UNIX_TIMESTAMP()),
+	(509, 3505, '宇宙渦動器', UNIX_TIMESTAMP()),
+	(872, 1870, '風力貯蔵槽', UNIX_TIMESTAMP()),
+	(111, 7103, '音響照射器', UNIX_TIMESTAMP()),
+	(509, 5503, '書体複合解析', UNIX_TIMESTAMP()),
+	(264, 3260, '瞬間移動装置', UNIX_TIMESTAMP()),
+	(404, 6397, '液晶自意識', UNIX_TIMESTAMP()),
+	(50, 7042, '規格帯域化装置', UNIX_TIMESTAMP()),
+	(831, 2828, '無機生命体', UNIX_TIMESTAMP()),
+	(865, 6858, '情報暗号拡張', UNIX_TIMESTAMP()),
+	(130, 4125, '遺伝子操作剤', UNIX_TIMESTAMP()),
+	(13, 6006, '炭素融合炉', UNIX_TIMESTAMP()),
+	(763, 5757, '心音計測器', UNIX_TIMESTAMP()),
+	(4, 1002, '液体共鳴装置', UNIX_TIMESTAMP()),
+	(53, 1051, '透過暗黒物質', UNIX_TIMESTAMP()),
+	(269, 6262, '核融合増幅材', UNIX_TIMESTAMP()),
+	(287, 2284, '超磁場共鳴装置', UNIX_TIMESTAMP()),
+	(879, 1877, '真空残留光電効果', UNIX_TIMESTAMP()),
+	(567, 2564, '平衡分子状態', UNIX_TIMESTAMP()),
+	(867, 4862, '統一場量子転送', UNIX_TIMESTAMP()),
+	(331, 330, '無機化合物', UNIX_TIMESTAMP()),
+	(756, 3752, '鉱石鑑定士', UNIX_TIMESTAMP()),
+	(409, 408, '不確定要素', UNIX_TIMESTAMP()),
+	(956, 1954, '資源再生利用', UNIX_TIMESTAMP()),
+	(382, 2379, '美術史抽象表現主義運動', UNIX_TIMESTAMP()),
+	(423, 422, '暗黙脳波接続', UNIX_TIMESTAMP()),
+	(70, 2067, '超音速未知大気風', UNIX_TIMESTAMP()),
+	(60, 5054, '無重力複合体', UNIX_TIMESTAMP()),
+	(3, 6995, '機械知覚自己調整', UNIX_TIMESTAMP()),
+	(960, 6953, '生体免疫順応体', UNIX_TIMESTAMP()),
+	(570, 4565, '電子擬似化装填材', UNIX_TIMESTAMP()),
+	(810, 809, '熱帯式生育促進剤', UNIX_TIMESTAMP()),
+	(731, 2728, '高密度重力反射体', UNIX_TIMESTAMP()),
+	(534, 5528, '地表超高温団地化装置', UNIX_TIMESTAMP()),
+	(173, 5167, '星間大気処理装置', UNIX_TIMESTAMP()),
+	(23, 2020, '圧力熱化交換機', UNIX_TIMESTAMP()),
+	(200, 6193, '新元素発酵加速装置', UNIX_TIMESTAMP()),
+	(412, 411, '植物遺伝子組織培養器', UNIX_TIMESTAMP()),
+	(502, 5496, '微妙回転標準', UNIX_TIMESTAMP()),
+	(206, 5200, '情報瞬発体', UNIX_TIMESTAMP()),
+	(949, 5943, '高速接点謎素', UNIX_TIMESTAMP()),
+	(834, 1832, '集約迅速試針', UNIX_TIMESTAMP()),
+	(820, 4815, '自律量子核連結', UNIX_TIMESTAMP()),
+	(232, 3228, '無限二重柔軟器', UNIX_TIMESTAMP()),
+	(891, 1889, '統合感応推定', UNIX_TIMESTAMP()),
+	(748, 5742, '最適動態網羅', UNIX_TIMESTAMP()),
+	(791, 2788, '透明重層分析', UNIX_TIMESTAMP()),
+	(171, 2168, '平行非常変光', UNIX_TIMESTAMP()),
+	(195, 5189, '効率高速強磁場', UNIX_TIMESTAMP()),
+	(359, 4354, '柔軟強化澱粉', UNIX_TIMESTAMP()),
+	(790, 3786, '高分子物質格子', UNIX_TIMESTAMP()),
+	(149, 1147, '予測不確定軽元', UNIX_TIMESTAMP()),
+	(667, 2664, '量子干渉確率', UNIX_TIMESTAMP()),
+	(943, 942, '高密度信号可検', UNIX_TIMESTAMP()),
+	(532, 5526, '報酬積極連携', UNIX_TIMESTAMP()),
+	(406, 405, '壊滅公理原理', UNIX_TIMESTAMP()),
+	(214, 2211, '無制限再帰差分', UNIX_TIMESTAMP()),
+	(139, 3135, '単純偏極過程', UNIX_TIMESTAMP()),
+	(374, 3370, '言語判別推測', UNIX_TIMESTAMP()),
+	(660, 6653, '平行磁場復元', UNIX_TIMESTAMP()),
+	(865, 4860, '視覚認識応用', UNIX_TIMESTAMP()),
+	(705, 1703, '積層臨界体積', UNIX_TIMESTAMP()),
+	(905, 3901, '竜樹曼荼羅', UNIX_TIMESTAMP()),
+	(869, 4864, '創造力共鳴', UNIX_TIMESTAMP()),
+	(94, 6087, '対魔王特殊部隊', UNIX_TIMESTAMP()),
+	(513, 1511, '魔族絶滅作戦', UNIX_TIMESTAMP()),
+	(638, 3634, '超次元通信', UNIX_TIMESTAMP()),
+	(110, 109, '心智分析機', UNIX_TIMESTAMP()),
+	(853, 4848, '惑星間渡航機', UNIX_TIMESTAMP()),
+	(130, 129, '暗黒封印術', UNIX_TIMESTAMP()),
+	(91, 5085, '蒸気駆動器', UNIX_TIMESTAMP()),
+	(700, 1698, '超音波治療', UNIX_TIMESTAMP()),
+	(129, 2126, '量子律動機', UNIX_TIMESTAMP()),
+	(547, 6540, '空間共鳴器', UNIX_TIMESTAMP()),
+	(173, 1171, '心理選択装置', UNIX_TIMESTAMP()),
+	(383, 6376, '古代言語解読', UNIX_TIMESTAMP()),
+	(876, 3872, '潜在能力測定器', UNIX_TIMESTAMP()),
+	(165, 164, '音響共鳴装置', UNIX_TIMESTAMP()),
+	(275, 2272, '原子分解炉', UNIX_TIMESTAMP()),
+	(18, 6011, '気象制御装置', UNIX_TIMESTAMP()),
+	(370, 2367, '神経伝達回路', UNIX_TIMESTAMP()),
+	(931, 5925, '鮮明映像処理', UNIX_TIMESTAMP()),
+	(737, 3733, '生成無尽機', UNIX_TIMESTAMP()),
+	(298, 297, '超音波治療器', UNIX_TIMESTAMP()),
+	(218, 4213, '酸素悪鬱症状', UNIX_TIMESTAMP()),
+	(926, 4921, '睡眠再調整装置', UNIX_TIMESTAMP()),
+	(42, 6035, '遠隔研究支援機', UNIX_TIMESTAMP()),
+	(226, 6219, '軸光凝固器', UNIX_TIMESTAMP()),
+	(19, 1017, '状態量子化', UNIX_TIMESTAMP()),
+	(461, 460, '心理操摩術', UNIX_TIMESTAMP()),
+	(721, 4716, '反重力装置', UNIX_TIMESTAMP()),
+	(939, 4934, '超高速粒子加速器', UNIX_TIMESTAMP()),
+	(41, 1039, '天象観測儀', UNIX_TIMESTAMP()),
+	(250, 7242, '陸地底探査船', UNIX_TIMESTAMP()),
+	(331, 330, '水晶身体強化装置', UNIX_TIMESTAMP()),
+	(383, 4378, '音波透過力場生成装置', UNIX_TIMESTAMP()),
+	(167, 5161, '量子共鳴治療装置', UNIX_TIMESTAMP()),
+	(299, 6292, '絶対零度冷却装置', UNIX_TIMESTAMP()),
+	(736, 2733, '超音速高圧水魚雷', UNIX_TIMESTAMP()),
+	(836, 1834, '生体現象解析', UNIX_TIMESTAMP()),
+	(326, 2323, '形態進化遺伝子', UNIX_TIMESTAMP()),
+	(709, 1707, '分子物質制御', UNIX_TIMESTAMP()),
+	(577, 4572, '神経暗号解読', UNIX_TIMESTAMP()),
+	(287, 7279, '宇宙時空計測', UNIX_TIMESTAMP()),
+	(197, 4192, '水素生成反応', UNIX_TIMESTAMP()),
+	(41, 40, '光合成遺伝子解析', UNIX_TIMESTAMP()),
+	(890, 3886, '酸化膜形成', UNIX_TIMESTAMP()),
+	(118, 2115, '打者安定化', UNIX_TIMESTAMP()),
+	(833, 4828, '濃縮脱炭酸', UNIX_TIMESTAMP()),
+	(918, 4913, '高密度粒子', UNIX_TIMESTAMP()),
+	(864, 3860, '半導体蒸着', UNIX_TIMESTAMP()),
+	(791, 2788, '細胞自己再生', UNIX_TIMESTAMP()),
+	(95, 5089, '自己調整転置', UNIX_TIMESTAMP()),
+	(418, 5412, '元素酸化還元', UNIX_TIMESTAMP()),
+	(95, 2092, '全方向散乱', UNIX_TIMESTAMP()),
+	(882, 3878, '自己軌道制御', UNIX_TIMESTAMP()),
+	(175, 174, '水晶修復術', UNIX_TIMESTAMP()),
+	(106, 1104, '千夢研究所', UNIX_TIMESTAMP()),
+	(944, 2941, '終朝侣殺術', UNIX_TIMESTAMP()),
+	(964, 4959, '磁気陽極加速', UNIX_TIMESTAMP()),
+	(488, 3484, '無理数酸化物', UNIX_TIMESTAMP()),
+	(677, 5671, '赤気球式浮遊監視装置', UNIX_TIMESTAMP()),
+	(456, 4451, '空間反應生成装置', UNIX_TIMESTAMP()),
+	(261, 5255, '熱伝導絶縁膜', UNIX_TIMESTAMP()),
+	(226, 225, '蓄花状骨袋', UNIX_TIMESTAMP()),
+	(753, 3749, '冥王星状脳波送信', UNIX_TIMESTAMP()),
+	(660, 1658, '十字鉄棒振動子', UNIX_TIMESTAMP()),
+	(785, 3781, '超音速射精球体', UNIX_TIMESTAMP()),
+	(238, 5232, '感知波動計測機', UNIX_TIMESTAMP()),
+	(13, 2010, '大天使召喚', UNIX_TIMESTAMP()),
+	(720, 6713, '大妖精召喚', UNIX_TIMESTAMP()),
+	(417, 416, '薬剤効力予測', UNIX_TIMESTAMP()),
+	(158, 3154, '磁力抵抗信号解析', UNIX_TIMESTAMP()),
+	(864, 5858, '自然言語構築', UNIX_TIMESTAMP()),
+	(473, 1471, '高速電子顕微鏡', UNIX_TIMESTAMP()),
+	(708, 3704, '知識融合分析', UNIX_TIMESTAMP()),
+	(210, 209, '重力波応答予測', UNIX_TIMESTAMP()),
+	(82, 5076, '恒星進化模擬', UNIX_TIMESTAMP()),
+	(275, 4270, '世界樹通信', UNIX_TIMESTAMP()),
+	(696, 3692, '傅立双子因果関係', UNIX_TIMESTAMP()),
+	(566, 3562, '陽炎空間潜航器', UNIX_TIMESTAMP()),
+	(698, 2695, '電子魂核心装置', UNIX_TIMESTAMP()),
+	(286, 285, '熱狂覚醒反応器', UNIX_TIMESTAMP()),
+	(235, 4230, '奇渦霊的共鳴', UNIX_TIMESTAMP()),
+	(416, 2413, '超空間歪曲装置', UNIX_TIMESTAMP()),
+	(69, 1067, '震撃磁気粒子砲', UNIX_TIMESTAMP()),
+	(103, 6096, '想念記憶予知器', UNIX_TIMESTAMP()),
+	(229, 3225, '無明闇煌爆発体', UNIX_TIMESTAMP()),
+	(958, 957, '不可逆熵情報漏洩', UNIX_TIMESTAMP()),
+	(387, 2384, '呪術結晶魔力抽出器', UNIX_TIMESTAMP()),
+	(957, 956, '妖精化因子遺伝子操作装置', UNIX_TIMESTAMP()),
+	(734, 733, '崩壊空間融合機', UNIX_TIMESTAMP()),
+	(935, 4930, '雑踏布陣流星弾', UNIX_TIMESTAMP()),
+	(300, 6293, '創造霊波偏差装置', UNIX_TIMESTAMP()),
+	(139, 7131, '創造的構造解析', UNIX_TIMESTAMP()),
+	(573, 6566, '環境調和算術', UNIX_TIMESTAMP()),
+	(287, 2284, '統合思考戦略', UNIX_TIMESTAMP()),
+	(788, 787, '心理反射認知', UNIX_TIMESTAMP()),
+	(37, 2034, '無機高分子物質', UNIX_TIMESTAMP()),
+	(354, 7346, '有機動力発電', UNIX_TIMESTAMP()),
+	(468, 1466, '情報言語処理', UNIX_TIMESTAMP()),
+	(759, 758, '基礎地理情報', UNIX_TIMESTAMP()),
+	(742, 1740, '社会心理動態', UNIX_TIMESTAMP()),
+	(80, 4075, '素粒子加速器', UNIX_TIMESTAMP()),
+	(785, 3781, '都市装飾構造', UNIX_TIMESTAMP()),
+	(556, 4551, '音響振動制御', UNIX_TIMESTAMP()),
+	(664, 1662, '交通動態解析', UNIX_TIMESTAMP()),
+	(498, 497, '音響磁石技', UNIX_TIMESTAMP()),
+	(161, 3157, '秘能融合体', UNIX_TIMESTAMP()),
+	(196, 1194, '固高度制御', UNIX_TIMESTAMP()),
+	(616, 615, '蒼氷遷移層', UNIX_TIMESTAMP()),
+	(946, 3942, '熱帯血液浮遊', UNIX_TIMESTAMP()),
+	(797, 5791, '選別電荷棚', UNIX_TIMESTAMP()),
+	(557, 2554, '磁気裂空調', UNIX_TIMESTAMP()),
+	(930, 3926, '協奏波節制', UNIX_TIMESTAMP()),
+	(911, 1909, '対立相乳剤', UNIX_TIMESTAMP()),
+	(935, 6928, '彩光反転結晶', UNIX_TIMESTAMP()),
+	(255, 1253, '誘導霊波鏡', UNIX_TIMESTAMP()),
+	(496, 6489, '模倣意識奇', UNIX_TIMESTAMP()),
+	(89, 5083, '海岳円翼術', UNIX_TIMESTAMP()),
+	(482, 3478, '斜川鉱礎露', UNIX_TIMESTAMP()),
+	(65, 64, '無機生命体制御装置', UNIX_TIMESTAMP()),
+	(616, 1614, '時空共鳴素子', UNIX_TIMESTAMP()),
+	(872, 871, '意識情報相互接続装置', UNIX_TIMESTAMP()),
+	(760, 5754, '光量子暗号化鍵', UNIX_TIMESTAMP()),
+	(901, 900, '量子応答素子', UNIX_TIMESTAMP()),
+	(655, 4650, '動的相関色域', UNIX_TIMESTAMP()),
+	(332, 1330, '意志遠隔操作装置', UNIX_TIMESTAMP()),
+	(621, 4616, '分光吸収域', UNIX_TIMESTAMP()),
+	(972, 1970, '高温融解熱', UNIX_TIMESTAMP()),
+	(623, 622, '水圧加速速度', UNIX_TIMESTAMP()),
+	(149, 2146, '無線補完結合', UNIX_TIMESTAMP()),
+	(501, 4496, '陽極酸化処理', UNIX_TIMESTAMP()),
+	(770, 1768, '斜面多角層', UNIX_TIMESTAMP()),
+	(147, 3143, '片面精密焼結', UNIX_TIMESTAMP()),
+	(898, 3894, '地質堆積層', UNIX_TIMESTAMP()),
+	(784, 4779, '振動遮断材', UNIX_TIMESTAMP()),
+	(758, 2755, '生態繊維層', UNIX_TIMESTAMP()),
+	(829, 3825, '不透明接着剤', UNIX_TIMESTAMP()),
+	(152, 1150, '遮光遮熱膜', UNIX_TIMESTAMP()),
+	(657, 1655, '静的抵抗指数', UNIX_TIMESTAMP()),
+	(931, 2928, '核磁気共鳴画像診断', UNIX_TIMESTAMP()),
+	(910, 3906, '真空原子力発電', UNIX_TIMESTAMP()),
+	(598, 1596, '超高温超伝導体', UNIX_TIMESTAMP()),
+	(778, 2775, '遺伝子情報解析', UNIX_TIMESTAMP()),
+	(352, 7344, '量子暗号通信', UNIX_TIMESTAMP()),
+	(148, 147, '透明太陽電池', UNIX_TIMESTAMP()),
+	(12, 3008, '超音速風洞実験', UNIX_TIMESTAMP()),
+	(112, 4107, '電子眼球移植', UNIX_TIMESTAMP()),
+	(121, 120, '高速粒子加速器', UNIX_TIMESTAMP()),
+	(405, 4400, '情報感知衣料', UNIX_TIMESTAMP()),
+	(263, 1261, '仮想思考器', UNIX_TIMESTAMP()),
+	(158, 5152, '静電熱放射', UNIX_TIMESTAMP()),
+	(92, 7084, '転移応答率', UNIX_TIMESTAMP()),
+	(67, 4062, '結晶再取得', UNIX_TIMESTAMP()),
+	(44, 6037, '可変挿入器', UNIX_TIMESTAMP()),
+	(151, 150, '派生束処理', UNIX_TIMESTAMP()),
+	(735, 734, '磁界共鳴検出', UNIX_TIMESTAMP()),
+	(617, 3613, '架空誘導能力', UNIX_TIMESTAMP()),
+	(249, 7241, '体外遅延反転', UNIX_TIMESTAMP()),
+	(720, 3716, '分散拡散速度', UNIX_TIMESTAMP()),
+	(220, 219, '受動位相調整', UNIX_TIMESTAMP()),
+	(888, 5882, '加味二要素', UNIX_TIMESTAMP()),
+	(103, 7095, '凹凸解像度', UNIX_TIMESTAMP()),
+	(156, 6149, '可視化冷却', UNIX_TIMESTAMP()),
+	(500, 1498, '超音波逆境', UNIX_TIMESTAMP()),
+	(465, 7457, '絶対力方程', UNIX_TIMESTAMP()),
+	(824, 3820, '高密度合金', UNIX_TIMESTAMP()),
+	(509, 5503, '水薬鉱合成', UNIX_TIMESTAMP()),
+	(523, 3519, '精神映像干渉装置', UNIX_TIMESTAMP()),
+	(745, 5739, '情報擬体化制御装置', UNIX_TIMESTAMP()),
+	(425, 1423, '生物進化補完構造', UNIX_TIMESTAMP()),
+	(31, 5025, '超次元無線伝送装置', UNIX_TIMESTAMP()),
+	(553, 6546, '燃料格子制御装置', UNIX_TIMESTAMP()),
+	(786, 4781, '生体情報感地応答器', UNIX_TIMESTAMP()),
+	(224, 7216, '重力波受信網', UNIX_TIMESTAMP()),
+	(786, 2783, '超常防護領域', UNIX_TIMESTAMP()),
+	(72, 71, '平衡鏡乱撃', UNIX_TIMESTAMP()),
+	(678, 2675, '文字変換器', UNIX_TIMESTAMP()),
+	(387, 2384, '音波放射装置', UNIX_TIMESTAMP()),
+	(220, 219, '遺伝鑑定器', UNIX_TIMESTAMP()),
+	(290, 2287, '天然植物育成剤', UNIX_TIMESTAMP()),
+	(416, 6409, '多元宇宙転送装置', UNIX_TIMESTAMP()),
+	(760, 3756, '空中浮遊機構', UNIX_TIMESTAMP()),
+	(72, 3068, '生体創発材料', UNIX_TIMESTAMP()),
+	(968, 5962, '超音速空中航行体', UNIX_TIMESTAMP()),
+	(937, 936, '透明光媒体', UNIX_TIMESTAMP()),
+	(538, 4533, '遺伝情報解読器', UNIX_TIMESTAMP()),
+	(341, 2338, '有機酵素増殖器', UNIX_TIMESTAMP()),
+	(817, 1815, '磁気浮上鉄道', UNIX_TIMESTAMP()),
+	(611, 2608, '地磁気検知衛星', UNIX_TIMESTAMP()),
+	(254, 5248, '分子再構成装置', UNIX_TIMESTAMP()),
+	(80, 1078, '周波数解析器', UNIX_TIMESTAMP()),
+	(180, 5174, '多元素結晶化装置', UNIX_TIMESTAMP()),
+	(541, 6534, '液晶間欠波', UNIX_TIMESTAMP()),
+	(663, 3659, '振動電磁力', UNIX_TIMESTAMP()),
+	(323, 4318, '加速粒子列', UNIX_TIMESTAMP()),
+	(498, 6491, '熱膨張検知', UNIX_TIMESTAMP()),
+	(300, 3296, '摩擦静電力', UNIX_TIMESTAMP()),
+	(48, 5042, '熱伝導抵抗', UNIX_TIMESTAMP()),
+	(623, 3619, '可撓制振子', UNIX_TIMESTAMP()),
+	(36, 1034, '伝送不可逆', UNIX_TIMESTAMP()),
+	(46, 7038, '吸光層境界', UNIX_TIMESTAMP()),
+	(927, 5921, '結晶螺旋構造', UNIX_TIMESTAMP()),
+	(414, 2411, '同時複屈折', UNIX_TIMESTAMP()),
+	(468, 1466, '撹拌熱共振', UNIX_TIMESTAMP()),
+	(577, 2574, '括約筋応答', UNIX_TIMESTAMP()),
+	(27, 4022, '秘伝鬼御前', UNIX_TIMESTAMP()),
+	(488, 2485, '酸素還元線', UNIX_TIMESTAMP()),
+	(46, 4041, '量子回転磁気', UNIX_TIMESTAMP()),
+	(468, 3464, '脳神経接続子', UNIX_TIMESTAMP()),
+	(596, 5590, '超音速加速器', UNIX_TIMESTAMP()),
+	(229, 2226, '摩擦抗力指数', UNIX_TIMESTAMP()),
+	(91, 4086, '等圧過熱定量計', UNIX_TIMESTAMP()),
+	(303, 6296, '獣式制御装置', UNIX_TIMESTAMP()),
+	(555, 1553, '電磁電荷共鳴', UNIX_TIMESTAMP()),
+	(940, 4935, '液体金属絶熱素', UNIX_TIMESTAMP()),
+	(856, 1854, '透過攻撃保護器', UNIX_TIMESTAMP()),
+	(809, 808, '仮想反応消滅効果', UNIX_TIMESTAMP()),
+	(208, 4203, '半分子第二蓄電池', UNIX_TIMESTAMP()),
+	(914, 1912, '宇宙物理學', UNIX_TIMESTAMP()),
+	(995, 5989, '無声音波學', UNIX_TIMESTAMP()),
+	(77, 7069, '経済感応的', UNIX_TIMESTAMP()),
+	(518, 2515, '音響分析器', UNIX_TIMESTAMP()),
+	(718, 4713, '作物遺伝子編集', UNIX_TIMESTAMP()),
+	(617, 6610, '美術史研究', UNIX_TIMESTAMP()),
+	(935, 6928, '海洋生態系模擬', UNIX_TIMESTAMP()),
+	(933, 5927, '鉱物採掘専門家', UNIX_TIMESTAMP()),
+	(937, 5931, '獣医行動治療', UNIX_TIMESTAMP()),
+	(941, 5935, '地理情報分析', UNIX_TIMESTAMP()),
+	(199, 3195, '動力重力場', UNIX_TIMESTAMP()),
+	(901, 6894, '指向力拡散', UNIX_TIMESTAMP()),
+	(526, 5520, '精神共振波', UNIX_TIMESTAMP()),
+	(63, 2060, '形而上低温', UNIX_TIMESTAMP()),
+	(296, 6289, '細胞凝集素', UNIX_TIMESTAMP()),
+	(453, 3449, '外電絶縁体', UNIX_TIMESTAMP()),
+	(413, 412, '水中漏電検知器', UNIX_TIMESTAMP()),
+	(149, 7141, '直交反転器', UNIX_TIMESTAMP()),
+	(97, 96, '堆突制御器', UNIX_TIMESTAMP()),
+	(339, 5333, '峰間引掛器', UNIX_TIMESTAMP()),
+	(913, 6906, '同相達圧器', UNIX_TIMESTAMP()),
+	(316, 3312, '螺旋押出機', UNIX_TIMESTAMP()),
+	(839, 3835, '引弦入力装置', UNIX_TIMESTAMP()),
+	(24, 2021, '轉形張力計', UNIX_TIMESTAMP()),
+	(254, 4249, '爆燃式噴霧器', UNIX_TIMESTAMP()),
+	(460, 5454, '無告選別器', UNIX_TIMESTAMP()),
+	(283, 6276, '局部振動発生器', UNIX_TIMESTAMP()),
+	(133, 6126, '試料回収装置', UNIX_TIMESTAMP()),
+	(160, 3156, '張弦特定器', UNIX_TIMESTAMP()),
+	(392, 391, '液滴測定装置', UNIX_TIMESTAMP()),
+	(794, 3790, '影像処理装置', UNIX_TIMESTAMP()),
+	(44, 43, '暗号露天探査機', UNIX_TIMESTAMP()),
+	(356, 2353, '不夜姿勢指数', UNIX_TIMESTAMP()),
+	(615, 6608, '仮説重力透過装置', UNIX_TIMESTAMP()),
+	(30, 7022, '電脳高速瞑想室', UNIX_TIMESTAMP()),
+	(653, 5647, '感情温度計', UNIX_TIMESTAMP()),
+	(273, 3269, '記憶創造器', UNIX_TIMESTAMP()),
+	(293, 7285, '心理圧力解析機', UNIX_TIMESTAMP()),
+	(133, 6126, '視覚合成装置', UNIX_TIMESTAMP()),
+	(765, 764, '潜在意識解読器', UNIX_TIMESTAMP()),
+	(21, 20, '創造力増幅装置', UNIX_TIMESTAMP()),
+	(300, 3296, '陽電子精神干渉装置', UNIX_TIMESTAMP()),
+	(198, 4193, '透明化成長術', UNIX_TIMESTAMP()),
+	(743, 2740, '時空共鳴増幅装置', UNIX_TIMESTAMP()),
+	(179, 7171, '心的潜在力', UNIX_TIMESTAMP()),
+	(631, 4626, '心的創造力', UNIX_TIMESTAMP()),
+	(910, 3906, '火星探査衛星', UNIX_TIMESTAMP()),
+	(793, 4788, '白鳳破天衝', UNIX_TIMESTAMP()),
+	(928, 4923, '臨兵闘者皆陣列在前', UNIX_TIMESTAMP()),
+	(320, 7312, '炎華曼荼羅', UNIX_TIMESTAMP()),
+	(579, 4574, '静的心拍数監視器', UNIX_TIMESTAMP()),
+	(694, 6687, '画像化遮音素子', UNIX_TIMESTAMP()),
+	(712, 1710, '遺伝情報加速度検知装置', UNIX_TIMESTAMP()),
+	(407, 406, '調光可変電力変換装置', UNIX_TIMESTAMP()),
+	(752, 3748, '疲労回復効果積算評価式', UNIX_TIMESTAMP()),
+	(153, 4148, '蒼瑠羅駕日', UNIX_TIMESTAMP()),
+	(277, 6270, '無月倕尾剣', UNIX_TIMESTAMP()),
+	(107, 6100, '枝真毘蒼殻', UNIX_TIMESTAMP()),
+	(425, 1423, '天舞旋暴山', UNIX_TIMESTAMP()),
+	(735, 6728, '雌天蛇犀毛', UNIX_TIMESTAMP()),
+	(307, 6300, '心拍増強器', UNIX_TIMESTAMP()),
+	(776, 1774, '気象衛星網', UNIX_TIMESTAMP()),
+	(432, 5426, '超音速推進器', UNIX_TIMESTAMP()),
+	(87, 5081, '無限応用合金', UNIX_TIMESTAMP()),
+	(683, 6676, '高分子電熱材', UNIX_TIMESTAMP()),
+	(435, 4430, '量子暗号鍵', UNIX_TIMESTAMP()),
+	(162, 3158, '遺伝子翻訳機', UNIX_TIMESTAMP()),
+	(994, 3990, '硬質蓄電池', UNIX_TIMESTAMP()),
+	(80, 7072, '無重力合成肉', UNIX_TIMESTAMP()),
+	(279, 3275, '超熱溶解体', UNIX_TIMESTAMP()),
+	(574, 6567, '重力波通信', UNIX_TIMESTAMP()),
+	(91, 4086, '相変化増幅素子', UNIX_TIMESTAMP()),
+	(156, 4151, '無重力精製機', UNIX_TIMESTAMP()),
+	(218, 6211, '量子加速器', UNIX_TIMESTAMP()),
+	(24, 5018, '超静音冷却装置', UNIX_TIMESTAMP()),
+	(954, 2951, '環境構造物', UNIX_TIMESTAMP()),
+	(458, 6451, '彩色多態体', UNIX_TIMESTAMP()),
+	(914, 4909, '統計証拠採取', UNIX_TIMESTAMP()),
+	(943, 4938, '音声認識器機', UNIX_TIMESTAMP()),
+	(280, 7272, '時間語感解析', UNIX_TIMESTAMP()),
+	(128, 127, '心拍測定機', UNIX_TIMESTAMP()),
+	(51, 6044, '量子粒子加速器', UNIX_TIMESTAMP()),
+	(130, 6123, '河川浸食防止材料', UNIX_TIMESTAMP()),
+	(332, 6325, '高温超伝導体合金', UNIX_TIMESTAMP()),
+	(491, 4486, '光照射治療装置', UNIX_TIMESTAMP()),
+	(509, 2506, '分子収束反応装置', UNIX_TIMESTAMP()),
+	(658, 3654, '时间粒子解析器', UNIX_TIMESTAMP()),
+	(893, 3889, '健康製品評価基準', UNIX_TIMESTAMP()),
+	(381, 1379, '植物接液経路', UNIX_TIMESTAMP()),
+	(179, 2176, '火炎加速装置', UNIX_TIMESTAMP()),
+	(365, 7357, '弧光合成鉱物', UNIX_TIMESTAMP()),
+	(161, 5155, '四尺部網目', UNIX_TIMESTAMP()),
+	(262, 7254, '逆噴流現象', UNIX_TIMESTAMP()),
+	(88, 5082, '推進助力機構', UNIX_TIMESTAMP()),
+	(897, 1895, '直線経緯度', UNIX_TIMESTAMP()),
+	(64, 6057, '単一生成子細胞', UNIX_TIMESTAMP()),
+	(990, 5984, '低密度酸素供給', UNIX_TIMESTAMP()),
+	(450, 6443, '熱閉容爆発', UNIX_TIMESTAMP()),
+	(623, 6616, '素朴曼荼羅', UNIX_TIMESTAMP()),
+	(327, 3323, '領域空間構築', UNIX_TIMESTAMP()),
+	(888, 5882, '静電放出計測', UNIX_TIMESTAMP()),
+	(976, 4971, '形態変則分析', UNIX_TIMESTAMP()),
+	(138, 2135, '知覚情報表象', UNIX_TIMESTAMP()),
+	(170, 5164, '創造的脳神経過程', UNIX_TIMESTAMP()),
+	(339, 5333, '精神陽電子', UNIX_TIMESTAMP()),
+	(148, 2145, '顕著量子力', UNIX_TIMESTAMP()),
+	(523, 6516, '超音速共振', UNIX_TIMESTAMP()),
+	(600, 4595, '横貫骨施条', UNIX_TIMESTAMP()),
+	(217, 6210, '四面体節理', UNIX_TIMESTAMP()),
+	(747, 6740, '水晶体細胞', UNIX_TIMESTAMP()),
+	(455, 7447, '無秩序共鳴', UNIX_TIMESTAMP()),
+	(553, 4548, '星辰宇宙語', UNIX_TIMESTAMP()),
+	(632, 1630, '蒸気動力機', UNIX_TIMESTAMP()),
+	(308, 4303, '音波破壊器', UNIX_TIMESTAMP()),
+	(109, 5103, '量子転送装置', UNIX_TIMESTAMP()),
+	(975, 2972, '超高速微粒子加速器', UNIX_TIMESTAMP()),
+	(892, 2889, '潜在領域探査機', UNIX_TIMESTAMP()),
+	(204, 203, '空中浮遊装置', UNIX_TIMESTAMP()),
+	(244, 6237, '生体再構築装置', UNIX_TIMESTAMP()),
+	(761, 1759, '火炎放射器', UNIX_TIMESTAMP()),
+	(656, 655, '電磁吸引機', UNIX_TIMESTAMP()),
+	(362, 361, '天文観測望遠鏡', UNIX_TIMESTAMP()),
+	(853, 3849, '巨大原子炉', UNIX_TIMESTAMP()),
+	(297, 1295, '磁場生成装置', UNIX_TIMESTAMP()),
+	(68, 7060, '抗重力物質', UNIX_TIMESTAMP()),
+	(934, 3930, '時空断層探査装置', UNIX_TIMESTAMP()),
+	(627, 626, '有機化合物合成装置', UNIX_TIMESTAMP()),
+	(590, 1588, '音響生物計測', UNIX_TIMESTAMP()),
+	(338, 3334, '有機合成生物物理', UNIX_TIMESTAMP()),
+	(688, 5682, '顕微鏡細胞', UNIX_TIMESTAMP()),
+	(298, 6291, '組織再生因子', UNIX_TIMESTAMP()),
+	(317, 6310, '深部地盤調査', UNIX_TIMESTAMP()),
+	(514, 3510, '楽曲解析器', UNIX_TIMESTAMP()),
+	(556, 6549, '交通流改善策', UNIX_TIMESTAMP()),
+	(38, 3034, '空間利用最適化', UNIX_TIMESTAMP()),
+	(310, 1308, '高次思考能力評価', UNIX_TIMESTAMP()),
+	(499, 3495, '発生抑制装置', UNIX_TIMESTAMP()),
+	(757, 1755, '終末救済装置', UNIX_TIMESTAMP()),
+	(58, 4053, '音響干渉装置', UNIX_TIMESTAMP()),
+	(30, 2027, '無音潜行装置', UNIX_TIMESTAMP()),
+	(220, 6213, '生体情報解析器', UNIX_TIMESTAMP()),
+	(835, 1833, '僵死焼肉菌', UNIX_TIMESTAMP()),
+	(778, 1776, '妖器製作術', UNIX_TIMESTAMP()),
+	(704, 2701, '絶対降伏眼', UNIX_TIMESTAMP()),
+	(108, 5102, '神秘回翔術', UNIX_TIMESTAMP()),
+	(468, 1466, '感応念魔術', UNIX_TIMESTAMP()),
+	(140, 4135, '回転力制御装置', UNIX_TIMESTAMP()),
+	(876, 4871, '暗黒保護領域', UNIX_TIMESTAMP()),
+	(138, 6131, '念動核融合', UNIX_TIMESTAMP()),
+	(949, 4944, '超空間位相推移', UNIX_TIMESTAMP()),
+	(269, 268, '量子生命体', UNIX_TIMESTAMP()),
+	(857, 4852, '超重力制御', UNIX_TIMESTAMP()),
+	(887, 886, '魔力集約装置', UNIX_TIMESTAMP()),
+	(551, 4546, '分子解体緩和装置', UNIX_TIMESTAMP()),
+	(428, 427, '高次元加速器', UNIX_TIMESTAMP()),
+	(898, 3894, '非線形次元協調', UNIX_TIMESTAMP()),
+	(801, 2798, '精神読心装置', UNIX_TIMESTAMP()),
+	(689, 5683, '非量子時間転移', UNIX_TIMESTAMP()),
+	(935, 2932, '陽電子反発装置', UNIX_TIMESTAMP()),
+	(854, 6847, '天気予測機', UNIX_TIMESTAMP()),
+	(261, 4256, '反物質転送装置', UNIX_TIMESTAMP()),
+	(914, 1912, '心理剖析装置', UNIX_TIMESTAMP()),
+	(428, 2425, '精神疲労回復装置', UNIX_TIMESTAMP()),
+	(390, 7382, '記憶復元装置', UNIX_TIMESTAMP()),
+	(902, 3898, '音響断層撮影機', UNIX_TIMESTAMP()),
+	(801, 2798, '高速反応分析器', UNIX_TIMESTAMP()),
+	(119, 3115, '無血液凝固', UNIX_TIMESTAMP()),
+	(449, 1447, '遺伝子分化', UNIX_TIMESTAMP()),
+	(407, 6400, '建築設計用語', UNIX_TIMESTAMP()),
+	(297, 4292, '傅里叶変換', UNIX_TIMESTAMP()),
+	(31, 6024, '相互塩基作用', UNIX_TIMESTAMP()),
+	(921, 6914, '営業率指標', UNIX_TIMESTAMP()),
+	(102, 101, '暑導率係数', UNIX_TIMESTAMP()),
+	(17, 16, '核構造細胞', UNIX_TIMESTAMP()),
+	(890, 1888, '分子領域換金', UNIX_TIMESTAMP()),
+	(40, 1038, '高分子合成', UNIX_TIMESTAMP()),
+	(278, 3274, '分子変着火', UNIX_TIMESTAMP()),
+	(400, 2397, '創造生命体', UNIX_TIMESTAMP()),
+	(876, 875, '連結複合体', UNIX_TIMESTAMP()),
+	(229, 2226, '自己再生機構', UNIX_TIMESTAMP()),
+	(930, 6923, '高次脳機能計算', UNIX_TIMESTAMP()),
+	(319, 7311, '高次元形態合成', UNIX_TIMESTAMP()),
+	(884, 4879, '脳情報行列', UNIX_TIMESTAMP()),
+	(555, 554, '完全自己統合路', UNIX_TIMESTAMP()),
+	(981, 3977, '共生光合成', UNIX_TIMESTAMP()),
+	(309, 1307, '経済分析術', UNIX_TIMESTAMP()),
+	(144, 2141, '建築計画術', UNIX_TIMESTAMP()),
+	(667, 666, '農業改善術', UNIX_TIMESTAMP()),
+	(676, 1674, '美術表現術', UNIX_TIMESTAMP()),
+	(959, 4954, '言語翻訳術', UNIX_TIMESTAMP()),
+	(951, 5945, '音楽作曲術', UNIX_TIMESTAMP()),
+	(132, 2129, '政治戦略術', UNIX_TIMESTAMP()),
+	(506, 6499, '歴史研究術', UNIX_TIMESTAMP()),
+	(75, 74, '品評審査術', UNIX_TIMESTAMP()),
+	(163, 4158, '映画演出術', UNIX_TIMESTAMP()),
+	(34, 7026, '蒸気車暗号', UNIX_TIMESTAMP()),
+	(338, 2335, '波動共振器', UNIX_TIMESTAMP()),
+	(526, 5520, '超音速回生', UNIX_TIMESTAMP()),
+	(995, 2992, '陳列方映像', UNIX_TIMESTAMP()),
+	(222, 2219, '量子風乾燥', UNIX_TIMESTAMP()),
+	(329, 4324, '個体変質装置', UNIX_TIMESTAMP()),
+	(428, 5422, '研磨超発信器', UNIX_TIMESTAMP()),
+	(907, 1905, '高密度微生物', UNIX_TIMESTAMP()),
+	(699, 5693, '斜角五角骨', UNIX_TIMESTAMP()),
+	(347, 6340, '水晶発声装置', UNIX_TIMESTAMP()),
+	(167, 7159, '高電源密度装置', UNIX_TIMESTAMP()),
+	(672, 4667, '非可逆空間航行', UNIX_TIMESTAMP()),
+	(523, 3519, '精密植物成長調整', UNIX_TIMESTAMP()),
+	(198, 7190, '脳電位解析', UNIX_TIMESTAMP()),
+	(618, 6611, '自然言語処理', UNIX_TIMESTAMP()),
+	(928, 1926, '遺伝情報解読装置', UNIX_TIMESTAMP()),
+	(839, 2836, '新世代酸素循環補助装置', UNIX_TIMESTAMP()),
+	(398, 4393, '空間量子調律器', UNIX_TIMESTAMP()),
+	(298, 5292, '無重力加速装置', UNIX_TIMESTAMP()),
+	(843, 4838, '地球熱循環計画', UNIX_TIMESTAMP()),
+	(485, 6478, '発展生成産業', UNIX_TIMESTAMP()),
+	(412, 411, '重力逆転合成材料', UNIX_TIMESTAMP()),
+	(569, 4564, '真空磁力共鳴精製', UNIX_TIMESTAMP()),
+	(566, 565, '輝麗歩行琥珀', UNIX_TIMESTAMP()),
+	(971, 970, '翔鳳縦断甲宴', UNIX_TIMESTAMP()),
+	(13, 7005, '昇竜腐蝕鞭', UNIX_TIMESTAMP()),
+	(531, 5525, '妖幻鎮睡騒動', UNIX_TIMESTAMP()),
+	(775, 6768, '暗黒陣勢斬', UNIX_TIMESTAMP()),
+	(692, 2689, '雷覇狂擊画', UNIX_TIMESTAMP()),
+	(86, 6079, '神瑛峰蟲巣', UNIX_TIMESTAMP()),
+	(446, 4441, '流麗双断唄', UNIX_TIMESTAMP()),
+	(750, 6743, '破道災鮫歌', UNIX_TIMESTAMP()),
+	(441, 440, '蒼鷺案示櫛', UNIX_TIMESTAMP()),
+	(113, 7105, '撃滅剛震剣', UNIX_TIMESTAMP()),
+	(494, 7486, '魍魎焦破戦', UNIX_TIMESTAMP()),
+	(109, 5103, '命思刻邪槍', UNIX_TIMESTAMP()),
+	(952, 6945, '逆穿双突刃', UNIX_TIMESTAMP()),
+	(730, 6723, '奔月昏天源', UNIX_TIMESTAMP()),
+	(775, 4770, '建築念能力', UNIX_TIMESTAMP()),
+	(911, 5905, '朱雀対青龍', UNIX_TIMESTAMP()),
+	(547, 6540, '紫松石産業', UNIX_TIMESTAMP()),
+	(164, 1162, '天佑光學術', UNIX_TIMESTAMP()),
+	(268, 267, '蒼翠地霖散', UNIX_TIMESTAMP()),
+	(422, 4417, '奇想邪斅渋', UNIX_TIMESTAMP()),
+	(493, 1491, '黄金宝珠律', UNIX_TIMESTAMP()),
+	(5, 4999, '妖眸触魄帖', UNIX_TIMESTAMP()),
+	(638, 1636, '千重綴闇波', UNIX_TIMESTAMP()),
+	(741, 3737, '瑠璃幽澄鎌', UNIX_TIMESTAMP()),
+	(409, 2406, '紫雾環煙律', UNIX_TIMESTAMP()),
+	(156, 5150, '朱華花鳥篇', UNIX_TIMESTAMP()),
+	(654, 6647, '水輝振翰口', UNIX_TIMESTAMP()),
+	(348, 3344, '神宜鬼祭律', UNIX_TIMESTAMP()),
+	(840, 839, '花嵐画意春', UNIX_TIMESTAMP()),
+	(410, 2407, '無尽塵怒掌', UNIX_TIMESTAMP()),
+	(597, 4592, '極新至極剣', UNIX_TIMESTAMP()),
+	(482, 3478, '鉄辰刀縛術', UNIX_TIMESTAMP()),
+	(650, 4645, '亜空天幻鎖', UNIX_TIMESTAMP()),
+	(384, 7376, '音律風夢綺', UNIX_TIMESTAMP()),
+	(225, 1223, '珊瑚満天決', UNIX_TIMESTAMP()),
+	(88, 4083, '集団心理映像', UNIX_TIMESTAMP()),
+	(348, 3344, '古代遺物発掘', UNIX_TIMESTAMP()),
+	(392, 3388, '風呂敷芸術', UNIX_TIMESTAMP()),
+	(852, 1850, '魔力制御術', UNIX_TIMESTAMP()),
+	(130, 7122, '気象適正化', UNIX_TIMESTAMP()),
+	(299, 298, '原子密度計', UNIX_TIMESTAMP()),
+	(513, 1511, '高分解能映像', UNIX_TIMESTAMP()),
+	(278, 277, '結晶構造解析', UNIX_TIMESTAMP()),
+	(519, 1517, '整形外科細胞再生医療', UNIX_TIMESTAMP()),
+	(300, 5294, '脳神経情報処理計算結晶', UNIX_TIMESTAMP()),
+	(809, 2806, '遺伝子共有', UNIX_TIMESTAMP()),
+	(576, 3572, '経済量子化', UNIX_TIMESTAMP()),
+	(19, 3015, '磁気共鳴画像', UNIX_TIMESTAMP()),
+	(860, 1858, '認知情報処理', UNIX_TIMESTAMP()),
+	(645, 3641, '新規結晶成長', UNIX_TIMESTAMP()),
+	(878, 6871, '再生可能資源', UNIX_TIMESTAMP()),
+	(548, 6541, '炭素排出量', UNIX_TIMESTAMP()),
+	(874, 4869, '地震防災装置', UNIX_TIMESTAMP()),
+	(690, 5684, '環境超振動制御', UNIX_TIMESTAMP()),
+	(36, 5030, '飛翔臓器融合装置', UNIX_TIMESTAMP()),
+	(258, 257, '無核数値計算', UNIX_TIMESTAMP()),
+	(913, 4908, '心象潜在共鳴', UNIX_TIMESTAMP()),
+	(735, 5729, '高次元感覚増強', UNIX_TIMESTAMP()),
+	(674, 673, '時空制御装置', UNIX_TIMESTAMP()),
+	(315, 5309, '遺伝子進化予測', UNIX_TIMESTAMP()),
+	(664, 6657, '伝達情報変換装置', UNIX_TIMESTAMP()),
+	(692, 691, '光子進行制御装置', UNIX_TIMESTAMP()),
+	(282, 281, '力場反発材料', UNIX_TIMESTAMP()),
+	(248, 2245, '生体衝動解析', UNIX_TIMESTAMP()),
+	(810, 2807, '分子溶連結', UNIX_TIMESTAMP()),
+	(486, 5480, '非晶質合金材料', UNIX_TIMESTAMP()),
+	(394, 6387, '誘電体半導体', UNIX_TIMESTAMP()),
+	(474, 4469, '生体関連高分子合成', UNIX_TIMESTAMP()),
+	(942, 3938, '遠隔医療機器', UNIX_TIMESTAMP()),
+	(76, 4071, '熱伝導材料', UNIX_TIMESTAMP()),
+	(710, 4705, '固体誘導体電池', UNIX_TIMESTAMP()),
+	(76, 3072, '航空機構造解析', UNIX_TIMESTAMP()),
+	(313, 6306, '高分子複合材料', UNIX_TIMESTAMP()),
+	(3, 2, '生体材料評価', UNIX_TIMESTAMP()),
+	(168, 7160, '生体細胞延長', UNIX_TIMESTAMP()),
+	(466, 4461, '分子遺伝記号', UNIX_TIMESTAMP()),
+	(35, 2032, '光子情報伝達', UNIX_TIMESTAMP()),
+	(583, 6576, '生体酸素取得', UNIX_TIMESTAMP()),
+	(678, 6671, '巨大分子制御', UNIX_TIMESTAMP()),
+	(609, 6602, '音楽領域抽出', UNIX_TIMESTAMP()),
+	(8, 5002, '植物緑色生成', UNIX_TIMESTAMP()),
+	(472, 6465, '電子薄膜生成', UNIX_TIMESTAMP()),
+	(638, 1636, '遺伝情報翻訳', UNIX_TIMESTAMP()),
+	(380, 5374, '高次元空間抽出', UNIX_TIMESTAMP()),
+	(233, 2230, '超音速粒子衝突', UNIX_TIMESTAMP()),
+	(138, 7130, '複合材料解析', UNIX_TIMESTAMP()),
+	(407, 6400, '共感状態研究', UNIX_TIMESTAMP()),
+	(897, 6890, '聚合微粒子', UNIX_TIMESTAMP()),
+	(165, 3161, '超音波計測', UNIX_TIMESTAMP()),
+	(41, 7033, '離散最適化', UNIX_TIMESTAMP()),
+	(839, 1837, '分子模倣計算', UNIX_TIMESTAMP()),
+	(21, 20, '抗原抗体反応', UNIX_TIMESTAMP()),
+	(644, 1642, '走査電子顕微鏡', UNIX_TIMESTAMP()),
+	(919, 3915, '精神分析術', UNIX_TIMESTAMP()),
+	(939, 5933, '経済動態指標', UNIX_TIMESTAMP()),
+	(303, 5297, '社会構造主義', UNIX_TIMESTAMP()),
+	(259, 4254, '超音速武器', UNIX_TIMESTAMP()),
+	(662, 5656, '遺伝子断片合成酵素', UNIX_TIMESTAMP()),
+	(755, 3751, '衛星観測画像潜在能力指数', UNIX_TIMESTAMP()),
+	(681, 5675, '重力波収束効果', UNIX_TIMESTAMP()),
+	(29, 1027, '回転運動安定制御装置', UNIX_TIMESTAMP()),
+	(691, 1689, '偏光分散結晶体', UNIX_TIMESTAMP()),
+	(512, 4507, '投資収益率', UNIX_TIMESTAMP()),
+	(308, 307, '国内総生産', UNIX_TIMESTAMP()),
+	(230, 5224, '外国為替市場', UNIX_TIMESTAMP()),
+	(934, 3930, '知的財産権', UNIX_TIMESTAMP()),
+	(572, 4567, '核廃棄物処理場', UNIX_TIMESTAMP()),
+	(181, 180, '文化的傾倒', UNIX_TIMESTAMP()),
+	(558, 6551, '地域共同体', UNIX_TIMESTAMP()),
+	(245, 5239, '社会的規範', UNIX_TIMESTAMP()),
+	(250, 3246, '社会的不平等', UNIX_TIMESTAMP()),
+	(871, 870, '核融合装置', UNIX_TIMESTAMP()),
+	(788, 787, '量子結晶構造', UNIX_TIMESTAMP()),
+	(377, 7369, '遺伝子再構成装置', UNIX_TIMESTAMP()),
+	(555, 3551, '情報応答網', UNIX_TIMESTAMP()),
+	(821, 4816, '超音波繊維強化合金', UNIX_TIMESTAMP()),
+	(706, 1704, '生体情報診断装置', UNIX_TIMESTAMP()),
+	(670, 6663, '透明光拡散材料', UNIX_TIMESTAMP()),
+	(788, 787, '有機構造制御膜', UNIX_TIMESTAMP()),
+	(831, 6824, '高硬度超微粒子', UNIX_TIMESTAMP()),
+	(690, 2687, '光触媒析出装置', UNIX_TIMESTAMP()),
+	(996, 4991, '植物成分分析', UNIX_TIMESTAMP()),
+	(92, 5086, '光拡散装置', UNIX_TIMESTAMP()),
+	(848, 5842, '陽気生成装置', UNIX_TIMESTAMP()),
+	(362, 361, '行動効果検査', UNIX_TIMESTAMP()),
+	(814, 4809, '音響脳波測定', UNIX_TIMESTAMP()),
+	(330, 329, '物質転換装置', UNIX_TIMESTAMP()),
+	(614, 6607, '恒星導通器', UNIX_TIMESTAMP()),
+	(508, 507, '超立体結晶化', UNIX_TIMESTAMP()),
+	(689, 3685, '音波偏微分', UNIX_TIMESTAMP()),
+	(66, 2063, '知覚領域化', UNIX_TIMESTAMP()),
+	(499, 5493, '磁気電極装置', UNIX_TIMESTAMP()),
+	(478, 6471, '情動認識能力', UNIX_TIMESTAMP()),
+	(215, 214, '精神脈動適応', UNIX_TIMESTAMP()),
+	(681, 2678, '直感共振装置', UNIX_TIMESTAMP()),
+	(57, 1055, '起動因果領域', UNIX_TIMESTAMP()),
+	(413, 3409, '平行進化構造', UNIX_TIMESTAMP()),
+	(972, 3968, '集合知覚統合', UNIX_TIMESTAMP()),
+	(59, 7051, '生命共鳴連携', UNIX_TIMESTAMP()),
+	(451, 2448, '感覚情報欠落', UNIX_TIMESTAMP()),
+	(321, 5315, '量子統合網', UNIX_TIMESTAMP()),
+	(985, 6978, '霊感共振装置', UNIX_TIMESTAMP()),
+	(831, 3827, '琵琶鶴博計', UNIX_TIMESTAMP()),
+	(198, 3194, '脳神経遊休状態', UNIX_TIMESTAMP()),
+	(888, 887, '土地適応度指数', UNIX_TIMESTAMP()),
+	(278, 277, '文化障壁効果', UNIX_TIMESTAMP()),
+	(176, 2173, '環境応答行動', UNIX_TIMESTAMP()),
+	(714, 4709, '抽象現実感知', UNIX_TIMESTAMP()),
+	(712, 3708, '心象体験指標', UNIX_TIMESTAMP()),
+	(189, 2186, '驚嘆反応感度', UNIX_TIMESTAMP()),
+	(55, 6048, '自律表現器機構', UNIX_TIMESTAMP()),
+	(231, 6224, '記憶再構築予知', UNIX_TIMESTAMP()),
+	(93, 1091, '潜在情緒通信', UNIX_TIMESTAMP()),
+	(116, 5110, '心象分析術', UNIX_TIMESTAMP()),
+	(213, 5207, '空間凝固化', UNIX_TIMESTAMP()),
+	(393, 2390, '経済循環連鎖', UNIX_TIMESTAMP()),
+	(589, 588, '洞察的直観', UNIX_TIMESTAMP()),
+	(68, 2065, '知覚体感器', UNIX_TIMESTAMP()),
+	(41, 3037, '言語分布研究', UNIX_TIMESTAMP()),
+	(332, 7324, '持続的進化計画', UNIX_TIMESTAMP()),
+	(731, 1729, '時空間壁面', UNIX_TIMESTAMP()),
+	(679, 2676, '社会集合効果', UNIX_TIMESTAMP()),
+	(907, 2904, '水素制御機', UNIX_TIMESTAMP()),
+	(610, 6603, '生体電磁検査器', UNIX_TIMESTAMP()),
+	(476, 2473, '超音波応用録音装置', UNIX_TIMESTAMP()),
+	(204, 5198, '微生物吸湿防腐材', UNIX_TIMESTAMP()),
+	(789, 2786, '高圧水洗浄装置', UNIX_TIMESTAMP()),
+	(58, 3054, '機械振動吸収材', UNIX_TIMESTAMP()),
+	(458, 3454, '軌道磁気浮上装置', UNIX_TIMESTAMP()),
+	(503, 7495, '暗号化量子通信機', UNIX_TIMESTAMP()),
+	(901, 1899, '無重力環境加速器', UNIX_TIMESTAMP()),
+	(208, 1206, '膨張反射断熱材', UNIX_TIMESTAMP()),
+	(784, 783, '高密度抽出分離機器', UNIX_TIMESTAMP()),
+	(381, 7373, '環境生態創生器', UNIX_TIMESTAMP()),
+	(370, 2367, '自己合成有機触媒', UNIX_TIMESTAMP()),
+	(334, 1332, '高周波帯域', UNIX_TIMESTAMP()),
+	(982, 5976, '潤滑剤添加剤', UNIX_TIMESTAMP()),
+	(340, 3336, '光環境指標', UNIX_TIMESTAMP()),
+	(451, 450, '酸化還元反応', UNIX_TIMESTAMP()),
+	(218, 5212, '高電位共鳴', UNIX_TIMESTAMP()),
+	(866, 3862, '熱膨張係数', UNIX_TIMESTAMP()),
+	(356, 5350, '倶楽部図書館', UNIX_TIMESTAMP()),
+	(383, 4378, '精神断捨離', UNIX_TIMESTAMP()),
+	(342, 2339, '感情聴覚化', UNIX_TIMESTAMP()),
+	(145, 1143, '知識蓄積装置', UNIX_TIMESTAMP()),
+	(179, 6172, '音波拡散装置', UNIX_TIMESTAMP()),
+	(407, 3403, '精神誘導装置', UNIX_TIMESTAMP()),
+	(227, 2224, '超越観念主義', UNIX_TIMESTAMP()),
+	(567, 2564, '無限再生循環', UNIX_TIMESTAMP()),
+	(412, 7404, '空間時結合', UNIX_TIMESTAMP()),
+	(894, 6887, '精神排出反応', UNIX_TIMESTAMP()),
+	(518, 5512, '量子共鳴機構', UNIX_TIMESTAMP()),
+	(541, 5535, '霊的同調装置', UNIX_TIMESTAMP()),
+	(696, 2693, '文化情報結晶', UNIX_TIMESTAMP()),
+	(179, 6172, '知覚刺激応答', UNIX_TIMESTAMP()),
+	(962, 5956, '伝説的情報伝達', UNIX_TIMESTAMP()),
+	(694, 1692, '超常的吸引力', UNIX_TIMESTAMP()),
+	(905, 2902, '宇宙構築術', UNIX_TIMESTAMP()),
+	(954, 1952, '無給付労使契約', UNIX_TIMESTAMP()),
+	(846, 4841, '睡眠帳状態', UNIX_TIMESTAMP()),
+	(213, 5207, '意匠呈示証', UNIX_TIMESTAMP()),
+	(228, 2225, '紙幣破棄手続', UNIX_TIMESTAMP()),
+	(922, 921, '訴訟和解式容認', UNIX_TIMESTAMP()),
+	(804, 3800, '市場前景指数', UNIX_TIMESTAMP()),
+	(773, 1771, '微量元素補給剤', UNIX_TIMESTAMP()),
+	(325, 6318, '環境陳腐化因子', UNIX_TIMESTAMP()),
+	(840, 3836, '原子間力場', UNIX_TIMESTAMP()),
+	(58, 4053, '行政条理化運動', UNIX_TIMESTAMP()),
+	(338, 7330, '商標註冊申請', UNIX_TIMESTAMP()),
+	(915, 914, '抗酸化材料', UNIX_TIMESTAMP()),
+	(996, 3992, '無機系合成', UNIX_TIMESTAMP()),
+	(53, 4048, '最適化制御', UNIX_TIMESTAMP()),
+	(228, 4223, '非線形振動', UNIX_TIMESTAMP()),
+	(787, 786, '魔神鎮魂曲', UNIX_TIMESTAMP()),
+	(485, 6478, '階層構洞察力', UNIX_TIMESTAMP()),
+	(672, 671, '環境風陣模型', UNIX_TIMESTAMP()),
+	(443, 6436, '予測触媒転換', UNIX_TIMESTAMP()),
+	(437, 1435, '脳細胞放射線治療', UNIX_TIMESTAMP()),
+	(216, 2213, '集積環境温度管理', UNIX_TIMESTAMP()),
+	(492, 491, '共鳴微生物分類', UNIX_TIMESTAMP()),
+	(646, 4641, '燃料改質触媒', UNIX_TIMESTAMP()),
+	(893, 2890, '疲労挙動解析器', UNIX_TIMESTAMP()),
+	(411, 410, '心療内科病態分析', UNIX_TIMESTAMP()),
+	(571, 570, '風化抵抗合金材料', UNIX_TIMESTAMP()),
+	(319, 318, '生体応答反射機構', UNIX_TIMESTAMP()),
+	(317, 316, '極度因果主義', UNIX_TIMESTAMP()),
+	(307, 1305, '心霊素粒子', UNIX_TIMESTAMP()),
+	(136, 7128, '時空転移装置', UNIX_TIMESTAMP()),
+	(950, 2947, '量子生命体', UNIX_TIMESTAMP()),
+	(772, 6765, '生命情報結合体', UNIX_TIMESTAMP()),
+	(422, 2419, '宇宙翻訳機', UNIX_TIMESTAMP()),
+	(109, 6102, '無重力境界層', UNIX_TIMESTAMP()),
+	(506, 5500, '超量子補助装置', UNIX_TIMESTAMP()),
+	(900, 4895, '精神念動鎖', UNIX_TIMESTAMP()),
+	(886, 885, '時空創造因子', UNIX_TIMESTAMP()),
+	(141, 3137, '精神再生装置', UNIX_TIMESTAMP()),
+	(865, 4860, '遺伝子調整器', UNIX_TIMESTAMP()),
+	(47, 2044, '想念永続領域', UNIX_TIMESTAMP()),
+	(138, 137, '粒動量調整装置', UNIX_TIMESTAMP()),
+	(358, 4353, '脳波共振装置', UNIX_TIMESTAMP()),
+	(378, 4373, '物質転換加速器', UNIX_TIMESTAMP()),
+	(745, 2742, '精神操作術', UNIX_TIMESTAMP()),
+	(245, 2242, '超時空跳躍', UNIX_TIMESTAMP()),
+	(460, 6453, '超重力装甲', UNIX_TIMESTAMP()),
+	(666, 665, '引力逆転装置', UNIX_TIMESTAMP()),
+	(417, 3413, '魔獣操縦術', UNIX_TIMESTAMP()),
+	(317, 7309, '絶対空間旅船', UNIX_TIMESTAMP()),
+	(357, 2354, '亜空転送手段', UNIX_TIMESTAMP()),
+	(475, 3471, '微分孤立波解析術', UNIX_TIMESTAMP()),
+	(787, 786, '結晶化心理触媒', UNIX_TIMESTAMP()),
+	(531, 4526, '振動共鳴電磁波装置', UNIX_TIMESTAMP()),
+	(635, 1633, '量子系不完全可換解析', UNIX_TIMESTAMP()),
+	(963, 962, '生体電子導体材料', UNIX_TIMESTAMP()),
+	(312, 6305, '感情規模心理測定装置', UNIX_TIMESTAMP()),
+	(307, 5301, '惑星探査機', UNIX_TIMESTAMP()),
+	(984, 5978, '遺伝子編集', UNIX_TIMESTAMP()),
+	(918, 3914, '生態系回復', UNIX_TIMESTAMP()),
+	(431, 3427, '気候変動予測', UNIX_TIMESTAMP()),
+	(950, 6943, '金融市場取引', UNIX_TIMESTAMP()),
+	(136, 3132, '経済成長率', UNIX_TIMESTAMP()),
+	(8, 6001, '有機質肥料', UNIX_TIMESTAMP()),
+	(750, 1748, '知的財産権', UNIX_TIMESTAMP()),
+	(918, 3914, '建築施工管理', UNIX_TIMESTAMP()),
+	(116, 4111, '種子加速器', UNIX_TIMESTAMP()),
+	(953, 1951, '量子制尿器', UNIX_TIMESTAMP()),
+	(364, 363, '空気電波共振器', UNIX_TIMESTAMP()),
+	(561, 1559, '発光折衝体', UNIX_TIMESTAMP()),
+	(286, 285, '機械沈黙病', UNIX_TIMESTAMP()),
+	(251, 250, '天体分子解析器', UNIX_TIMESTAMP()),
+	(700, 3696, '熱核並行凝固装置', UNIX_TIMESTAMP()),
+	(116, 4111, '鮮紅煙霧発生器', UNIX_TIMESTAMP()),
+	(158, 6151, '脳神経飛行計', UNIX_TIMESTAMP()),
+	(531, 530, '超磁場粒子集合体', UNIX_TIMESTAMP()),
+	(845, 5839, '非可視光情報暗号', UNIX_TIMESTAMP()),
+	(348, 7340, '無限悲鳴術', UNIX_TIMESTAMP()),
+	(437, 5431, '精霊召喚術', UNIX_TIMESTAMP()),
+	(681, 3677, '銀河迷宮計画', UNIX_TIMESTAMP()),
+	(656, 5650, '深層夢分析', UNIX_TIMESTAMP()),
+	(37, 2034, '透明化合物研究', UNIX_TIMESTAMP()),
+	(381, 1379, '旋転反発効果', UNIX_TIMESTAMP()),
+	(297, 4292, '大気逆転現象', UNIX_TIMESTAMP()),
+	(70, 2067, '視覚音響合成', UNIX_TIMESTAMP()),
+	(887, 2884, '超真空物質', UNIX_TIMESTAMP()),
+	(284, 6277, '量子透明化', UNIX_TIMESTAMP()),
+	(754, 2751, '知識形式化', UNIX_TIMESTAMP()),
+	(126, 2123, '音色反響解析', UNIX_TIMESTAMP()),
+	(758, 4753, '微細機械医療', UNIX_TIMESTAMP()),
+	(206, 1204, '疑似陽子現象', UNIX_TIMESTAMP()),
+	(808, 4803, '宇宙時空曲率', UNIX_TIMESTAMP()),
+	(15, 6008, '暗黒物質探知器', UNIX_TIMESTAMP()),
+	(97, 7089, '地質情報解析', UNIX_TIMESTAMP()),
+	(793, 4788, '知識循環剤', UNIX_TIMESTAMP()),
+	(314, 1312, '断片捕捉機', UNIX_TIMESTAMP()),
+	(596, 3592, '眠状況識別体', UNIX_TIMESTAMP()),
+	(291, 3287, '接触地帯膜', UNIX_TIMESTAMP()),
+	(369, 4364, '固形取込機', UNIX_TIMESTAMP()),
+	(436, 435, '影響困理解器', UNIX_TIMESTAMP()),
+	(115, 3111, '節約変数器', UNIX_TIMESTAMP()),
+	(807, 806, '示方体搬送機', UNIX_TIMESTAMP()),
+	(331, 3327, '硬度指針盤', UNIX_TIMESTAMP()),
+	(865, 3861, '自己効力感', UNIX_TIMESTAMP()),
+	(597, 2594, '火球衝撃剣', UNIX_TIMESTAMP()),
+	(155, 6148, '風念護身術', UNIX_TIMESTAMP()),
+	(220, 1218, '金雷召喚術', UNIX_TIMESTAMP()),
+	(576, 2573, '闇界探索術', UNIX_TIMESTAMP()),
+	(326, 325, '空飛巡察士', UNIX_TIMESTAMP()),
+	(311, 7303, '震動共鳴術', UNIX_TIMESTAMP()),
+	(813, 2810, '武器破壊術', UNIX_TIMESTAMP()),
+	(360, 1358, '防御結界陣', UNIX_TIMESTAMP()),
+	(210, 7202, '治癒蘇生術', UNIX_TIMESTAMP()),
+	(940, 1938, '魚眼凹面鏡', UNIX_TIMESTAMP()),
+	(215, 4210, '動的光干渉', UNIX_TIMESTAMP()),
+	(361, 2358, '円筒整流器', UNIX_TIMESTAMP()),
+	(193, 6186, '音響共鳴洞窟', UNIX_TIMESTAMP()),
+	(415, 414, '遠隔軌道操作', UNIX_TIMESTAMP()),
+	(218, 6211, '石灰岩堆積', UNIX_TIMESTAMP()),
+	(105, 1103, '超音速開口', UNIX_TIMESTAMP()),
+	(516, 3512, '貴金属電極', UNIX_TIMESTAMP()),
+	(690, 1688, '加速度微小部', UNIX_TIMESTAMP()),
+	(28, 1026, '蒸発沈殿物', UNIX_TIMESTAMP()),
+	(926, 925, '植物遺伝子操作', UNIX_TIMESTAMP()),
+	(573, 5567, '黎明騎士団', UNIX_TIMESTAMP()),
+	(942, 5936, '古代文字解読', UNIX_TIMESTAMP()),
+	(504, 6497, '超音波制御', UNIX_TIMESTAMP()),
+	(261, 7253, '宇宙船建造', UNIX_TIMESTAMP()),
+	(771, 3767, '异次元封印', UNIX_TIMESTAMP()),
+	(894, 5888, '水獣養成術', UNIX_TIMESTAMP()),
+	(271, 4266, '蒼龍空海術', UNIX_TIMESTAMP()),
+	(437, 1435, '無重力制御', UNIX_TIMESTAMP()),
+	(27, 3023, '経絡昇仙術', UNIX_TIMESTAMP()),
+	(172, 5166, '現象海博器', UNIX_TIMESTAMP()),
+	(983, 5977, '暴徒終結印章', UNIX_TIMESTAMP()),
+	(707, 4702, '水晶火龍箱', UNIX_TIMESTAMP()),
+	(80, 6073, '幻視図譜確立術', UNIX_TIMESTAMP()),
+	(90, 5084, '凍結霧状妖気流', UNIX_TIMESTAMP()),
+	(705, 5699, '次元滑走翼', UNIX_TIMESTAMP()),
+	(550, 1548, '聖獣変身陣', UNIX_TIMESTAMP()),
+	(269, 2266, '力場振動散弾', UNIX_TIMESTAMP()),
+	(809, 1807, '億次元量子通信', UNIX_TIMESTAMP()),
+	(323, 322, '音楽心理効果分析', UNIX_TIMESTAMP()),
+	(269, 6262, '心理波加速器', UNIX_TIMESTAMP()),
+	(707, 3703, '記憶補完機', UNIX_TIMESTAMP()),
+	(469, 6462, '逆線維光磁幕', UNIX_TIMESTAMP()),
+	(346, 6339, '情報空間突破機', UNIX_TIMESTAMP()),
+	(155, 7147, '統合体計画', UNIX_TIMESTAMP()),
+	(539, 6532, '超音速精工機', UNIX_TIMESTAMP()),
+	(639, 1637, '出力拡大閥制御装置', UNIX_TIMESTAMP()),
+	(290, 3286, '無線力伝導素子', UNIX_TIMESTAMP()),
+	(665, 5659, '現実時間凍結装置', UNIX_TIMESTAMP()),
+	(748, 1746, '幻影視覚情報増幅装置', UNIX_TIMESTAMP()),
+	(389, 3385, '全方位撮像分析装置', UNIX_TIMESTAMP()),
+	(736, 5730, '意思通信機構', UNIX_TIMESTAMP()),
+	(726, 3722, '薬理反応解析装置', UNIX_TIMESTAMP()),
+	(838, 6831, '振動周波瞬間変換装置', UNIX_TIMESTAMP()),
+	(194, 6187, '積層型全自動資源抽出機', UNIX_TIMESTAMP()),
+	(707, 6700, '時空元素抑止装置', UNIX_TIMESTAMP()),
+	(776, 4771, '超感覚運動予測機能', UNIX_TIMESTAMP()),
+	(697, 6690, '磁気拡散抑制素材', UNIX_TIMESTAMP()),
+	(706, 705, '昇華凸面合成装置', UNIX_TIMESTAMP()),
+	(662, 661, '監視抑制安定装置', UNIX_TIMESTAMP()),
+	(314, 4309, '液晶再生増幅器', UNIX_TIMESTAMP()),
+	(632, 5626, '部分透過観察平面', UNIX_TIMESTAMP()),
+	(688, 5682, '環境適応形態変換装置', UNIX_TIMESTAMP()),
+	(159, 1157, '栄養吸収率', UNIX_TIMESTAMP()),
+	(265, 6258, '農業施設自動化', UNIX_TIMESTAMP()),
+	(481, 6474, '水産養殖効率', UNIX_TIMESTAMP()),
+	(686, 3682, '電動車充電効率', UNIX_TIMESTAMP()),
+	(706, 2703, '衛生管理指標', UNIX_TIMESTAMP()),
+	(691, 4686, '薬剤投与経路', UNIX_TIMESTAMP()),
+	(112, 6105, '無重力環境', UNIX_TIMESTAMP()),
+	(714, 4709, '遺伝情報解読', UNIX_TIMESTAMP()),
+	(214, 2211, '超高速処理', UNIX_TIMESTAMP()),
+	(923, 5917, '超拡張現実', UNIX_TIMESTAMP()),
+	(831, 1829, '生体増幅装置', UNIX_TIMESTAMP()),
+	(690, 2687, '心理鑑定術', UNIX_TIMESTAMP()),
+	(820, 5814, '経済動態指数', UNIX_TIMESTAMP()),
+	(436, 2433, '農産物栽培術', UNIX_TIMESTAMP()),
+	(903, 4898, '音響伝搬方程式', UNIX_TIMESTAMP()),
+	(298, 2295, '工業製品評価指標', UNIX_TIMESTAMP()),
+	(650, 5644, '無重力共振', UNIX_TIMESTAMP()),
+	(147, 3143, '超次元断層', UNIX_TIMESTAMP()),
+	(446, 1444, '情報瞬間転送', UNIX_TIMESTAMP()),
+	(528, 1526, '念動応用術', UNIX_TIMESTAMP()),
+	(697, 6690, '天然量子現象', UNIX_TIMESTAMP()),
+	(361, 4356, '連続次元界', UNIX_TIMESTAMP()),
+	(843, 3839, '存在操作装置', UNIX_TIMESTAMP()),
+	(389, 388, '回転螺旋装置', UNIX_TIMESTAMP()),
+	(122, 3118, '分子構造分析', UNIX_TIMESTAMP()),
+	(990, 1988, '希少資源探査術', UNIX_TIMESTAMP()),
+	(811, 810, '組織加速度', UNIX_TIMESTAMP()),
+	(928, 927, '統合感抽出', UNIX_TIMESTAMP()),
+	(37, 6030, '断片化因子', UNIX_TIMESTAMP()),
+	(849, 848, '斜交方程式', UNIX_TIMESTAMP()),
+	(402, 401, '圧力応答関数', UNIX_TIMESTAMP()),
+	(872, 1870, '非晶体精度', UNIX_TIMESTAMP()),
+	(261, 7253, '粒子培養装置', UNIX_TIMESTAMP()),
+	(765, 1763, '地震磁気計測装置', UNIX_TIMESTAMP()),
+	(495, 6488, '気象気圧操舵', UNIX_TIMESTAMP()),
+	(90, 1088, '量子物理地理', UNIX_TIMESTAMP()),
+	(933, 2930, '農業物資情報化', UNIX_TIMESTAMP()),
+	(819, 2816, '空襲防護壁', UNIX_TIMESTAMP()),
+	(495, 4490, '植物成長促進剤', UNIX_TIMESTAMP()),
+	(76, 3072, '無結合材料', UNIX_TIMESTAMP()),
+	(570, 6563, '有機色素合成', UNIX_TIMESTAMP()),
+	(593, 1591, '気風計測器', UNIX_TIMESTAMP()),
+	(439, 7431, '七星羅針盤', UNIX_TIMESTAMP()),
+	(632, 6625, '無常夜曼荼羅', UNIX_TIMESTAMP()),
+	(781, 6774, '彼岸花図鑑', UNIX_TIMESTAMP()),
+	(925, 1923, '水銀磁気軌道', UNIX_TIMESTAMP()),
+	(666, 3662, '朱鷺式冷却器', UNIX_TIMESTAMP()),
+	(263, 3259, '輪廻機械装備', UNIX_TIMESTAMP()),
+	(459, 7451, '時空航跡図', UNIX_TIMESTAMP()),
+	(558, 557, '時空転移装置', UNIX_TIMESTAMP()),
+	(191, 4186, '心霊探査機器', UNIX_TIMESTAMP()),
+	(335, 4330, '量子通信装置', UNIX_TIMESTAMP()),
+	(660, 3656, '記憶刻印装置', UNIX_TIMESTAMP()),
+	(534, 533, '幽体制御装置', UNIX_TIMESTAMP()),
+	(243, 242, '宇宙航行図譜', UNIX_TIMESTAMP()),
+	(646, 4641, '戦略的選択', UNIX_TIMESTAMP()),
+	(265, 4260, '振動子素子', UNIX_TIMESTAMP()),
+	(542, 5536, '音楽透明化', UNIX_TIMESTAMP()),
+	(120, 2117, '電波遮断器', UNIX_TIMESTAMP()),
+	(511, 6504, '模様催眠術', UNIX_TIMESTAMP()),
+	(769, 6762, '地球磁場制御', UNIX_TIMESTAMP()),
+	(171, 3167, '量子粒子映像', UNIX_TIMESTAMP()),
+	(621, 3617, '色彩安定化', UNIX_TIMESTAMP()),
+	(300, 7292, '気象電位計', UNIX_TIMESTAMP()),
+	(673, 2670, '分散対称化', UNIX_TIMESTAMP()),
+	(983, 3979, '運動制動装置', UNIX_TIMESTAMP()),
+	(868, 4863, '抽象空間解釈', UNIX_TIMESTAMP()),
+	(47, 3043, '構造振動制御', UNIX_TIMESTAMP()),
+	(267, 6260, '地中潤湿装置', UNIX_TIMESTAMP()),
+	(539, 6532, '音響反射材料', UNIX_TIMESTAMP()),
+	(974, 4969, '局所電流制御', UNIX_TIMESTAMP()),
+	(78, 77, '斜面土壁計測', UNIX_TIMESTAMP()),
+	(516, 515, '容量磁場制御', UNIX_TIMESTAMP()),
+	(945, 6938, '無影適溶液', UNIX_TIMESTAMP()),
+	(633, 632, '星光瑠花石', UNIX_TIMESTAMP()),
+	(397, 4392, '応急組織紙', UNIX_TIMESTAMP()),
+	(280, 279, '動庁蓄電機', UNIX_TIMESTAMP()),
+	(7, 3003, '熱波施幂装置', UNIX_TIMESTAMP()),
+	(612, 2609, '水平旋切盤', UNIX_TIMESTAMP()),
+	(455, 7447, '空対薑通信', UNIX_TIMESTAMP()),
+	(669, 668, '光量連携器', UNIX_TIMESTAMP()),
+	(379, 6372, '水蓄意速装置', UNIX_TIMESTAMP()),
+	(604, 5598, '地陣照明器', UNIX_TIMESTAMP()),
+	(400, 2397, '音瞬遷時機', UNIX_TIMESTAMP()),
+	(262, 2259, '土方振動検出器', UNIX_TIMESTAMP()),
+	(266, 265, '星水处理装置', UNIX_TIMESTAMP()),
+	(437, 7429, '気管冠水器', UNIX_TIMESTAMP()),
+	(305, 6298, '電瞬交換機', UNIX_TIMESTAMP()),
+	(707, 2704, '音射造波器', UNIX_TIMESTAMP()),
+	(381, 380, '雷撤夜装置', UNIX_TIMESTAMP()),
+	(186, 7178, '心房分析機', UNIX_TIMESTAMP()),
+	(469, 3465, '水晶投光器', UNIX_TIMESTAMP()),
+	(593, 3589, '熱核適金装置', UNIX_TIMESTAMP()),
+	(566, 3562, '星神通信機', UNIX_TIMESTAMP()),
+	(371, 370, '火炎雷罠機', UNIX_TIMESTAMP()),
+	(620, 1618, '霧里地資器', UNIX_TIMESTAMP()),
+	(419, 4414, '幻世界把握', UNIX_TIMESTAMP()),
+	(461, 1459, '古知識活用', UNIX_TIMESTAMP()),
+	(554, 2551, '心霊患者診断', UNIX_TIMESTAMP()),
+	(934, 3930, '知覚錯覚除去', UNIX_TIMESTAMP()),
+	(911, 3907, '錬金術合成', UNIX_TIMESTAMP()),
+	(649, 648, '精神感応連携', UNIX_TIMESTAMP()),
+	(331, 5325, '霊的晶体化', UNIX_TIMESTAMP()),
+	(249, 6242, '超越次元探索', UNIX_TIMESTAMP()),
+	(551, 4546, '超意識制御', UNIX_TIMESTAMP()),
+	(852, 5846, '華土電磁波', UNIX_TIMESTAMP()),
+	(241, 2238, '咏扇航空券', UNIX_TIMESTAMP()),
+	(770, 2767, '暗咳加速器', UNIX_TIMESTAMP()),
+	(604, 6597, '黄饅頭同期', UNIX_TIMESTAMP()),
+	(51, 50, '枇杷弐階筏', UNIX_TIMESTAMP()),
+	(146, 3142, '澱麺生成装置', UNIX_TIMESTAMP()),
+	(254, 253, '水銀相対差', UNIX_TIMESTAMP()),
+	(582, 2579, '地球回遊葉緑素', UNIX_TIMESTAMP()),
+	(525, 6518, '宇宙波動発生装置', UNIX_TIMESTAMP()),
+	(941, 940, '風車発電装置', UNIX_TIMESTAMP()),
+	(361, 7353, '遺伝子瞬加速器', UNIX_TIMESTAMP()),
+	(960, 2957, '機械的反響装置', UNIX_TIMESTAMP()),
+	(710, 6703, '飛翔力反吹指数', UNIX_TIMESTAMP()),
+	(235, 234, '暗黒物質変換器', UNIX_TIMESTAMP()),
+	(410, 5404, '情報記載棒', UNIX_TIMESTAMP()),
+	(949, 948, '蒸気凝縮器', UNIX_TIMESTAMP()),
+	(103, 3099, '霜脅力測定器', UNIX_TIMESTAMP()),
+	(171, 6164, '泡沫分散剤', UNIX_TIMESTAMP()),
+	(198, 3194, '睡眠再生装置', UNIX_TIMESTAMP()),
+	(821, 1819, '機構適応評価値', UNIX_TIMESTAMP()),
+	(537, 5531, '光子加速管', UNIX_TIMESTAMP()),
+	(616, 2613, '音波拡散装置', UNIX_TIMESTAMP()),
+	(216, 1214, '骨格重力平衡装置', UNIX_TIMESTAMP()),
+	(548, 547, '空中写真分析器', UNIX_TIMESTAMP()),
+	(847, 1845, '火星探査用衛星', UNIX_TIMESTAMP()),
+	(236, 1234, '電気伝導体合成装置', UNIX_TIMESTAMP()),
+	(463, 1461, '光合成効率化剤', UNIX_TIMESTAMP()),
+	(917, 5911, '地震活動予測器', UNIX_TIMESTAMP()),
+	(647, 1645, '情報消失防止装置', UNIX_TIMESTAMP()),
+	(587, 6580, '精神感応読取機', UNIX_TIMESTAMP()),
+	(749, 748, '南無阿弥陀仏', UNIX_TIMESTAMP()),
+	(910, 2907, '電磁波干渉解析', UNIX_TIMESTAMP()),
+	(524, 5518, '情報資源管理', UNIX_TIMESTAMP()),
+	(383, 2380, '生体信号応答', UNIX_TIMESTAMP()),
+	(166, 4161, '経済効果評価', UNIX_TIMESTAMP()),
+	(983, 5977, '創造的問題解決', UNIX_TIMESTAMP()),
+	(294, 4289, '音響空間評価', UNIX_TIMESTAMP()),
+	(639, 3635, '地球温暖化対策', UNIX_TIMESTAMP()),
+	(60, 1058, '社会保障政策', UNIX_TIMESTAMP()),
+	(506, 5500, '水晶体反応', UNIX_TIMESTAMP()),
+	(979, 6972, '金属凝固化', UNIX_TIMESTAMP()),
+	(873, 5867, '哺乳類進化', UNIX_TIMESTAMP()),
+	(769, 6762, '爬虫類遺伝子研究', UNIX_TIMESTAMP()),
+	(155, 1153, '細菌増殖制御', UNIX_TIMESTAMP()),
+	(977, 976, '鳥類生態進化', UNIX_TIMESTAMP()),
+	(806, 5800, '哺乳類適応進化', UNIX_TIMESTAMP()),
+	(230, 6223, '淡水生態系管理', UNIX_TIMESTAMP()),
+	(436, 6429, '海洋環境保護', UNIX_TIMESTAMP()),
+	(367, 7359, '森林資源管理', UNIX_TIMESTAMP()),
+	(877, 1875, '砂漠化防止', UNIX_TIMESTAMP()),
+	(684, 683, '風向風速計測装置', UNIX_TIMESTAMP()),
+	(231, 6224, '虚実反転器', UNIX_TIMESTAMP()),
+	(700, 4695, '文明化儀式', UNIX_TIMESTAMP()),
+	(956, 3952, '情報統覚装置', UNIX_TIMESTAMP()),
+	(846, 6839, '音裂発光装置', UNIX_TIMESTAMP()),
+	(427, 426, '触覚誘導装置', UNIX_TIMESTAMP()),
+	(312, 311, '星磁反転装甲', UNIX_TIMESTAMP()),
+	(946, 3942, '斬魂報告書', UNIX_TIMESTAMP()),
+	(275, 6268, '光流縮紡機', UNIX_TIMESTAMP()),
+	(513, 2510, '反射禍素材', UNIX_TIMESTAMP()),
+	(407, 5401, '悪夢制御装置', UNIX_TIMESTAMP()),
+	(610, 6603, '龍蛇二刀流', UNIX_TIMESTAMP()),
+	(184, 5178, '心理逆反応', UNIX_TIMESTAMP()),
+	(670, 3666, '素粒子軌道', UNIX_TIMESTAMP()),
+	(402, 7394, '永続情報媒体', UNIX_TIMESTAMP()),
+	(393, 2390, '核反応制御', UNIX_TIMESTAMP()),
+	(248, 2245, '鉱物微粉体', UNIX_TIMESTAMP()),
+	(897, 2894, '天体運動解析', UNIX_TIMESTAMP()),
+	(912, 5906, '心理感情推定', UNIX_TIMESTAMP()),
+	(405, 1403, '農業生態系', UNIX_TIMESTAMP()),
+	(508, 1506, '環境保全計画', UNIX_TIMESTAMP()),
+	(420, 4415, '製造工程最適化', UNIX_TIMESTAMP()),
+	(846, 1844, '医療器具認証', UNIX_TIMESTAMP()),
+	(617, 3613, '経済指標分析', UNIX_TIMESTAMP()),
+	(250, 5244, '建築構造設計', UNIX_TIMESTAMP()),
+	(802, 801, '軍事戦略計画', UNIX_TIMESTAMP()),
+	(399, 2396, '美術作品評価', UNIX_TIMESTAMP()),
+	(772, 6765, '振動遮断周波数設定', UNIX_TIMESTAMP()),
+	(846, 3842, '微量成分検出装置', UNIX_TIMESTAMP()),
+	(249, 1247, '表面硬度評価指数', UNIX_TIMESTAMP()),
+	(235, 5229, '記憶圧縮装置', UNIX_TIMESTAMP()),
+	(990, 1988, '啓蒙復元機械', UNIX_TIMESTAMP()),
+	(219, 2216, '素体構造図', UNIX_TIMESTAMP()),
+	(320, 1318, '入力反復手順', UNIX_TIMESTAMP()),
+	(358, 357, '感情測定指標', UNIX_TIMESTAMP()),
+	(586, 585, '電子戦闘計画', UNIX_TIMESTAMP()),
+	(602, 2599, '蒸気鍛冶術', UNIX_TIMESTAMP()),
+	(929, 4924, '無重力漂泊', UNIX_TIMESTAMP()),
+	(359, 5353, '電磁光波障壁', UNIX_TIMESTAMP()),
+	(730, 6723, '生物進化予測器', UNIX_TIMESTAMP()),
+	(765, 6758, '精神感覚干渉装置', UNIX_TIMESTAMP()),
+	(512, 2509, '新陳代謝強化装置', UNIX_TIMESTAMP()),
+	(140, 6133, '量子結界生成器', UNIX_TIMESTAMP()),
+	(240, 239, '宇宙膨張制御装置', UNIX_TIMESTAMP()),
+	(674, 3670, '分子構造可視化装置', UNIX_TIMESTAMP()),
+	(961, 2958, '心理生理指標測定装置', UNIX_TIMESTAMP()),
+	(85, 1083, '超電磁演算装置', UNIX_TIMESTAMP()),
+	(178, 5172, '脳波共鳴伝送機', UNIX_TIMESTAMP()),
+	(406, 5400, '神鍋検出器', UNIX_TIMESTAMP()),
+	(180, 2177, '透明燃料素子', UNIX_TIMESTAMP()),
+	(803, 3799, '微細精製装置', UNIX_TIMESTAMP()),
+	(919, 2916, '量子転移測定器', UNIX_TIMESTAMP()),
+	(769, 6762, '超音速推進剤', UNIX_TIMESTAMP()),
+	(189, 2186, '超重力転写装置', UNIX_TIMESTAMP()),
+	(624, 1622, '高分子絶縁体', UNIX_TIMESTAMP()),
+	(139, 138, '等速部品自動調整機', UNIX_TIMESTAMP()),
+	(706, 705, '水銀振動撹拌器', UNIX_TIMESTAMP()),
+	(126, 3122, '亜音速変換式', UNIX_TIMESTAMP()),
+	(174, 7166, '究極頑丈材料', UNIX_TIMESTAMP()),
+	(983, 1981, '磁力制御回路', UNIX_TIMESTAMP()),
+	(630, 6623, '高電圧充電器', UNIX_TIMESTAMP()),
+	(26, 5020, '粒子加速発生器', UNIX_TIMESTAMP()),
+	(915, 3911, '分子間相互作用力', UNIX_TIMESTAMP()),
+	(24, 6017, '高温高圧環境下模擬器', UNIX_TIMESTAMP()),
+	(712, 711, '無酸素熱洗浄装置', UNIX_TIMESTAMP()),
+	(94, 1092, '生物発光条件', UNIX_TIMESTAMP()),
+	(296, 1294, '液体分散温度制御', UNIX_TIMESTAMP()),
+	(446, 7438, '遅延結晶成長装置', UNIX_TIMESTAMP()),
+	(707, 3703, '静電誘起凝集過程', UNIX_TIMESTAMP()),
+	(479, 5473, '共鳴磁場効果', UNIX_TIMESTAMP()),
+	(655, 654, '理想気体状態方程式', UNIX_TIMESTAMP()),
+	(306, 2303, '発光励起温度範囲', UNIX_TIMESTAMP()),
+	(211, 7203, '滑走面摩擦低減材', UNIX_TIMESTAMP()),
+	(717, 1715, '非線形屈折係数', UNIX_TIMESTAMP()),
+	(818, 5812, '高温酸素化反応', UNIX_TIMESTAMP()),
+	(930, 4925, '断熱帯電記録材料', UNIX_TIMESTAMP()),
+	(668, 5662, '光熱遠赤外線効果', UNIX_TIMESTAMP()),
+	(924, 3920, '電位反復攪拌装置', UNIX_TIMESTAMP()),
+	(121, 5115, '内視鏡患者', UNIX_TIMESTAMP()),
+	(204, 7196, '仮想現実体験', UNIX_TIMESTAMP()),
+	(362, 2359, '自動車産業', UNIX_TIMESTAMP()),
+	(771, 1769, '革新的燃料電池', UNIX_TIMESTAMP()),
+	(270, 3266, '微生物浄化', UNIX_TIMESTAMP()),
+	(455, 7447, '超高速断片加速装置', UNIX_TIMESTAMP()),
+	(632, 1630, '超重力共鳴装置', UNIX_TIMESTAMP()),
+	(321, 3317, '自律型微生物制御装置', UNIX_TIMESTAMP()),
+	(806, 4801, '透明抵抗触媒', UNIX_TIMESTAMP()),
+	(560, 2557, '無機質知能反応装甲', UNIX_TIMESTAMP()),
+	(696, 4691, '分子騒乱共鳴振動装置', UNIX_TIMESTAMP()),
+	(632, 2629, '医薬品開発', UNIX_TIMESTAMP()),
+	(175, 7167, '心身連動機械', UNIX_TIMESTAMP()),
+	(169, 1167, '心理操作装置', UNIX_TIMESTAMP()),
+	(130, 4125, '記憶抹消装置', UNIX_TIMESTAMP()),
+	(380, 1378, '感応波固定装置', UNIX_TIMESTAMP()),
+	(787, 5781, '幽冥界転送装置', UNIX_TIMESTAMP()),
+	(897, 3893, '霊体計測装置', UNIX_TIMESTAMP()),
+	(694, 1692, '感情制御装置', UNIX_TIMESTAMP()),
+	(252, 4247, '精神感応機', UNIX_TIMESTAMP()),
+	(709, 708, '無線電撃装置', UNIX_TIMESTAMP()),
+	(61, 6054, '思念増幅装置', UNIX_TIMESTAMP()),
+	(136, 7128, '量子退行装置', UNIX_TIMESTAMP()),
+	(522, 521, '超音波霊視', UNIX_TIMESTAMP()),
+	(477, 2474, '空中連絡術', UNIX_TIMESTAMP()),
+	(986, 4981, '心霊感応現象', UNIX_TIMESTAMP()),
+	(606, 6599, '多次元共振', UNIX_TIMESTAMP()),
+	(360, 1358, '隠匿化合物', UNIX_TIMESTAMP()),
+	(842, 5836, '時間確保術', UNIX_TIMESTAMP()),
+	(435, 434, '形態記憶合金', UNIX_TIMESTAMP()),
+	(185, 2182, '高度空中散布', UNIX_TIMESTAMP()),
+	(842, 3838, '静水圧下賃金', UNIX_TIMESTAMP()),
+	(311, 6304, '軌跡防定理', UNIX_TIMESTAMP()),
+	(840, 839, '自律心臓調節', UNIX_TIMESTAMP()),
+	(445, 1443, '気候真空変動', UNIX_TIMESTAMP()),
+	(614, 3610, '個体群動態', UNIX_TIMESTAMP()),
+	(83, 4078, '記憶抽象化', UNIX_TIMESTAMP()),
+	(659, 2656, '量子領域結節', UNIX_TIMESTAMP()),
+	(217, 4212, '時空構造体', UNIX_TIMESTAMP()),
+	(267, 1265, '迷探索道具', UNIX_TIMESTAMP()),
+	(714, 1712, '光波共振器', UNIX_TIMESTAMP()),
+	(734, 1732, '無極世界説', UNIX_TIMESTAMP()),
+	(172, 1170, '知覚刺激効能', UNIX_TIMESTAMP()),
+	(770, 5764, '感覚情報選択', UNIX_TIMESTAMP()),
+	(193, 192, '精神同調機制', UNIX_TIMESTAMP()),
+	(431, 430, '文字逆響化', UNIX_TIMESTAMP()),
+	(9, 4004, '感情生起因子', UNIX_TIMESTAMP()),
+	(738, 4733, '認識構造解析', UNIX_TIMESTAMP()),
+	(243, 1241, '形式抽象思考', UNIX_TIMESTAMP()),
+	(820, 3816, '既知応用理念', UNIX_TIMESTAMP()),
+	(218, 1216, '情報混在検知', UNIX_TIMESTAMP()),
+	(736, 4731, '即時認識保障', UNIX_TIMESTAMP()),
+	(465, 1463, '潜在思考糸', UNIX_TIMESTAMP()),
+	(858, 857, '造型思維構築', UNIX_TIMESTAMP()),
+	(367, 1365, '創生症候群', UNIX_TIMESTAMP()),
+	(420, 5414, '真空超反応', UNIX_TIMESTAMP()),
+	(283, 3279, '疑似瞬時資料', UNIX_TIMESTAMP()),
+	(895, 5889, '暗黒精神結晶', UNIX_TIMESTAMP()),
+	(768, 2765, '究極元素概略', UNIX_TIMESTAMP()),
+	(771, 1769, '視覚融合空間', UNIX_TIMESTAMP()),
+	(476, 7468, '心意深層施設', UNIX_TIMESTAMP()),
+	(870, 4865, '自律覚醒器具', UNIX_TIMESTAMP()),
+	(148, 2145, '量子共振圧縮', UNIX_TIMESTAMP()),
+	(400, 2397, '深層次世代思考', UNIX_TIMESTAMP()),
+	(408, 5402, '音波空間舵', UNIX_TIMESTAMP()),
+	(432, 431, '念動試波装置', UNIX_TIMESTAMP()),
+	(179, 4174, '心霊共鳴体感', UNIX_TIMESTAMP()),
+	(858, 4853, '呼吸共振共鳴', UNIX_TIMESTAMP()),
+	(900, 3896, '音楽振動触媒', UNIX_TIMESTAMP()),
+	(813, 4808, '次元融合境界', UNIX_TIMESTAMP()),
+	(998, 4993, '霊障検知装置', UNIX_TIMESTAMP()),
+	(594, 2591, '抗重力素材', UNIX_TIMESTAMP()),
+	(999, 5993, '微生物除菌装置', UNIX_TIMESTAMP()),
+	(643, 5637, '心理生理指標解析', UNIX_TIMESTAMP()),
+	(692, 2689, '光分光反応解析', UNIX_TIMESTAMP()),
+	(716, 2713, '蒸気魔晶体', UNIX_TIMESTAMP()),
+	(549, 6542, '霊魂収集器', UNIX_TIMESTAMP()),
+	(829, 5823, '創造者封印網', UNIX_TIMESTAMP()),
+	(721, 2718, '無音透過屏風', UNIX_TIMESTAMP()),
+	(871, 3867, '聖獣侵略計画', UNIX_TIMESTAMP()),
+	(311, 3307, '吸血貴族公爵', UNIX_TIMESTAMP()),
+	(189, 7181, '霊騎士輝鎧', UNIX_TIMESTAMP()),
+	(545, 544, '時空螺旋器', UNIX_TIMESTAMP()),
+	(528, 2525, '超振動真空刃', UNIX_TIMESTAMP()),
+	(549, 6542, '念動放電術', UNIX_TIMESTAMP()),
+	(679, 2676, '量子反転装置', UNIX_TIMESTAMP()),
+	(346, 5340, '未知次元探査機能', UNIX_TIMESTAMP()),
+	(443, 2440, '霊感情報限定解読', UNIX_TIMESTAMP()),
+	(567, 6560, '水晶合成体', UNIX_TIMESTAMP()),
+	(551, 3547, '四次元位相器', UNIX_TIMESTAMP()),
+	(551, 550, '魂体投影機', UNIX_TIMESTAMP()),
+	(548, 6541, '生体抑制装置', UNIX_TIMESTAMP()),
+	(668, 2665, '共鳴遺伝子', UNIX_TIMESTAMP()),
+	(787, 4782, '因果反転装置', UNIX_TIMESTAMP()),
+	(856, 3852, '時空逆転器', UNIX_TIMESTAMP()),
+	(347, 4342, '量子起動装置', UNIX_TIMESTAMP()),
+	(646, 4641, '揺動共振器', UNIX_TIMESTAMP()),
+	(215, 1213, '魔導堅牢体', UNIX_TIMESTAMP()),
+	(88, 7080, '能力逐次化装置', UNIX_TIMESTAMP()),
+	(311, 3307, '擬似精神物体', UNIX_TIMESTAMP()),
+	(391, 390, '心理暗号生成機', UNIX_TIMESTAMP()),
+	(964, 5958, '顕在思念極結晶', UNIX_TIMESTAMP()),
+	(150, 7142, '高速核熱戦術', UNIX_TIMESTAMP()),
+	(130, 2127, '宇宙量子通信', UNIX_TIMESTAMP()),
+	(188, 6181, '情報共感覚', UNIX_TIMESTAMP()),
+	(983, 3979, '時間位相解析', UNIX_TIMESTAMP()),
+	(187, 6180, '半無重力環境', UNIX_TIMESTAMP()),
+	(345, 3341, '惑星干渉観測', UNIX_TIMESTAMP()),
+	(609, 1607, '非現実領域探索', UNIX_TIMESTAMP()),
+	(809, 808, '加速感応器', UNIX_TIMESTAMP()),
+	(434, 1432, '知覚再構築', UNIX_TIMESTAMP()),
+	(558, 2555, '紡錘状運動', UNIX_TIMESTAMP()),
+	(621, 620, '洞察力増幅', UNIX_TIMESTAMP()),
+	(860, 5854, '電子水晶体', UNIX_TIMESTAMP()),
+	(978, 2975, '原理反転装置', UNIX_TIMESTAMP()),
+	(72, 2069, '創造力炉心', UNIX_TIMESTAMP()),
+	(367, 366, '量子相転移回路', UNIX_TIMESTAMP()),
+	(387, 3383, '統合記憶体', UNIX_TIMESTAMP()),
+	(322, 5316, '光子共鳴共振器', UNIX_TIMESTAMP()),
+	(747, 2744, '超音速水晶板', UNIX_TIMESTAMP()),
+	(226, 5220, '鬼打帝磁気加速装置', UNIX_TIMESTAMP()),
+	(11, 1009, '高次元微粒子状態', UNIX_TIMESTAMP()),
+	(261, 6254, '精神電波探知器', UNIX_TIMESTAMP()),
+	(89, 4084, '量子物理治療機', UNIX_TIMESTAMP()),
+	(839, 3835, '超音波振動装置', UNIX_TIMESTAMP()),
+	(537, 6530, '宇宙微粒子捕獲器', UNIX_TIMESTAMP()),
+	(292, 7284, '非線型時間体験装置', UNIX_TIMESTAMP()),
+	(335, 1333, '声紋認証分析器', UNIX_TIMESTAMP()),
+	(960, 3956, '超能力関連用語', UNIX_TIMESTAMP()),
+	(768, 3764, '花関連用語', UNIX_TIMESTAMP()),
+	(300, 6293, '暦関連用語', UNIX_TIMESTAMP()),
+	(442, 441, '幻想関連用語', UNIX_TIMESTAMP()),
+	(758, 1756, '気相交換器', UNIX_TIMESTAMP()),
+	(381, 4376, '環境調和指数', UNIX_TIMESTAMP()),
+	(488, 2485, '食物品質評価', UNIX_TIMESTAMP()),
+	(251, 7243, '経済政策評価', UNIX_TIMESTAMP()),
+	(911, 3907, '短期時間計測', UNIX_TIMESTAMP()),
+	(985, 2982, '電子書籍著作権', UNIX_TIMESTAMP()),
+	(112, 2109, '環境影響評価', UNIX_TIMESTAMP()),
+	(817, 4812, '情報空間化', UNIX_TIMESTAMP()),
+	(876, 3872, '連環並列相', UNIX_TIMESTAMP()),
+	(990, 989, '認識情報統合', UNIX_TIMESTAMP()),
+	(163, 7155, '薄霊体転化', UNIX_TIMESTAMP()),
+	(922, 2919, '量子排斥力', UNIX_TIMESTAMP()),
+	(26, 5020, '素子結晶化', UNIX_TIMESTAMP()),
+	(882, 3878, '河岸均領営', UNIX_TIMESTAMP()),
+	(456, 6449, '氷漣振之陣', UNIX_TIMESTAMP()),
+	(965, 4960, '紅陽野朗鴉', UNIX_TIMESTAMP()),
+	(693, 3689, '籟酔火明闇', UNIX_TIMESTAMP()),
+	(40, 7032, '修羅滅殺剣', UNIX_TIMESTAMP()),
+	(121, 3117, '陶板釜速度調整', UNIX_TIMESTAMP()),
+	(219, 2216, '非飛散光無駄熱', UNIX_TIMESTAMP()),
+	(834, 6827, '木槿花香水', UNIX_TIMESTAMP()),
+	(662, 5656, '筆頭斬首術', UNIX_TIMESTAMP()),
+	(502, 4497, '露地木工階級', UNIX_TIMESTAMP()),
+	(230, 4225, '無色透明鋼材', UNIX_TIMESTAMP()),
+	(372, 3368, '幻想亜麻織物', UNIX_TIMESTAMP()),
+	(539, 3535, '村落裁縫術', UNIX_TIMESTAMP()),
+	(164, 2161, '氷結復元水', UNIX_TIMESTAMP()),
+	(455, 5449, '高速騎馬術競技', UNIX_TIMESTAMP()),
+	(165, 2162, '破魔射撃術', UNIX_TIMESTAMP()),
+	(368, 5362, '奇跡発光石', UNIX_TIMESTAMP()),
+	(471, 3467, '斬新飛行器設計', UNIX_TIMESTAMP()),
+	(765, 5759, '巨大山脈土耕地', UNIX_TIMESTAMP()),
+	(316, 4311, '甲州琳琅録', UNIX_TIMESTAMP()),
+	(884, 883, '時間結晶化', UNIX_TIMESTAMP()),
+	(227, 2224, '光機械操舵', UNIX_TIMESTAMP()),
+	(151, 150, '層状空気構築', UNIX_TIMESTAMP()),
+	(302, 6295, '粘菌相互作用', UNIX_TIMESTAMP()),
+	(987, 6980, '想念波暴露', UNIX_TIMESTAMP()),
+	(434, 3430, '有機風味因子', UNIX_TIMESTAMP()),
+	(218, 1216, '軟石筋運動', UNIX_TIMESTAMP()),
+	(391, 7383, '生命変動共鳴', UNIX_TIMESTAMP()),
+	(151, 2148, '真空超伝導', UNIX_TIMESTAMP()),
+	(32, 2029, '資源回帰装置', UNIX_TIMESTAMP()),
+	(608, 4603, '運命因果転換装置', UNIX_TIMESTAMP()),
+	(496, 4491, '情報共有核', UNIX_TIMESTAMP()),
+	(977, 3973, '空間結晶化装置', UNIX_TIMESTAMP()),
+	(756, 6749, '超絶領域生成装置', UNIX_TIMESTAMP()),
+	(139, 2136, '思考増幅器', UNIX_TIMESTAMP()),
+	(991, 2988, '感覚合成機構', UNIX_TIMESTAMP()),
+	(453, 2450, '分子再構築装置', UNIX_TIMESTAMP()),
+	(598, 1596, '感応抵抗素子', UNIX_TIMESTAMP()),
+	(405, 404, '気疲労検知器', UNIX_TIMESTAMP()),
+	(714, 6707, '宇宙舞台装置', UNIX_TIMESTAMP()),
+	(155, 4150, '微生物帝国', UNIX_TIMESTAMP()),
+	(108, 2105, '天地共鳴装置', UNIX_TIMESTAMP()),
+	(9, 8, '星辰探査船', UNIX_TIMESTAMP()),
+	(396, 1394, '量子干渉撹乱装置', UNIX_TIMESTAMP()),
+	(195, 6188, '時空逆転装置', UNIX_TIMESTAMP()),
+	(275, 3271, '精神領域解析器', UNIX_TIMESTAMP()),
+	(802, 2799, '霊感増幅器', UNIX_TIMESTAMP()),
+	(56, 3052, '神秘力覚醒装置', UNIX_TIMESTAMP()),
+	(456, 4451, '天使細胞培養機', UNIX_TIMESTAMP()),
+	(294, 5288, '無限相似曲面', UNIX_TIMESTAMP()),
+	(875, 3871, '水原子力機関', UNIX_TIMESTAMP()),
+	(966, 4961, '空間蛋白質', UNIX_TIMESTAMP()),
+	(546, 1544, '光速不可能粒子', UNIX_TIMESTAMP()),
+	(200, 6193, '素粒子軌跡検出器', UNIX_TIMESTAMP()),
+	(860, 1858, '麻酔監視装置', UNIX_TIMESTAMP()),
+	(792, 5786, '超伝導集積回路', UNIX_TIMESTAMP()),
+	(547, 2544, '電波副作用', UNIX_TIMESTAMP()),
+	(759, 3755, '視覚震盪器', UNIX_TIMESTAMP()),
+	(150, 5144, '音響翻訳器', UNIX_TIMESTAMP()),
+	(318, 3314, '心脳連動機構', UNIX_TIMESTAMP()),
+	(359, 4354, '体液循環儀', UNIX_TIMESTAMP()),
+	(928, 6921, '神経情報交換系', UNIX_TIMESTAMP()),
+	(961, 1959, '不可逆昏睡体', UNIX_TIMESTAMP()),
+	(101, 7093, '視覚補完装置', UNIX_TIMESTAMP()),
+	(7, 6000, '音響暗号増幅器', UNIX_TIMESTAMP()),
+	(45, 4040, '脳細胞復元装置', UNIX_TIMESTAMP()),
+	(751, 4746, '妄想抽出装置', UNIX_TIMESTAMP()),
+	(146, 145, '音声認識結晶', UNIX_TIMESTAMP()),
+	(665, 3661, '活力復元装着体', UNIX_TIMESTAMP()),
+	(808, 807, '至適視細胞感探子', UNIX_TIMESTAMP()),
+	(924, 3920, '回想記憶書庫', UNIX_TIMESTAMP()),
+	(569, 1567, '魔結晶解明', UNIX_TIMESTAMP()),
+	(667, 2664, '超重力渦巻', UNIX_TIMESTAMP()),
+	(523, 4518, '心観測装置', UNIX_TIMESTAMP()),
+	(135, 5129, '生体低周波反応', UNIX_TIMESTAMP()),
+	(160, 5154, '天地加速度計', UNIX_TIMESTAMP()),
+	(202, 3198, '時空反射層', UNIX_TIMESTAMP()),
+	(999, 1997, '水晶髄線管', UNIX_TIMESTAMP()),
+	(670, 1668, '音双晶伝送', UNIX_TIMESTAMP()),
+	(223, 3219, '絶対反重力源', UNIX_TIMESTAMP()),
+	(466, 2463, '暗黒分子検知器', UNIX_TIMESTAMP()),
+	(47, 1045, '目覚樹微粒子', UNIX_TIMESTAMP()),
+	(890, 889, '思念共鳴共振器', UNIX_TIMESTAMP()),
+	(889, 3885, '絶対封印解除鍵', UNIX_TIMESTAMP()),
+	(228, 1226, '竜力進化体', UNIX_TIMESTAMP()),
+	(227, 1225, '平衡格子因子', UNIX_TIMESTAMP()),
+	(770, 1768, '聖鳴悲鳴剣', UNIX_TIMESTAMP()),
+	(497, 496, '燃焼分析機', UNIX_TIMESTAMP()),
+	(555, 6548, '生体共生研究', UNIX_TIMESTAMP()),
+	(24, 4019, '表面酸化装置', UNIX_TIMESTAMP()),
+	(9, 1007, '静脈血液測定器', UNIX_TIMESTAMP()),
+	(404, 2401, '地下水流速測定機', UNIX_TIMESTAMP()),
+	(511, 5505, '自動植物栽培装置', UNIX_TIMESTAMP()),
+	(298, 297, '高温超伝導体', UNIX_TIMESTAMP()),
+	(339, 4334, '赤外線反射計', UNIX_TIMESTAMP()),
+	(139, 5133, '磁場測定装置', UNIX_TIMESTAMP()),
+	(665, 1663, '高濃度風洞試験', UNIX_TIMESTAMP()),
+	(165, 4160, '繊維組織断面計測装置', UNIX_TIMESTAMP()),
+	(61, 2058, '脳波周波数解析', UNIX_TIMESTAMP()),
+	(645, 5639, '液体窒素冷却装置', UNIX_TIMESTAMP()),
+	(189, 1187, '胃酸分泌抑制薬', UNIX_TIMESTAMP()),
+	(168, 7160, '血清中酵素測定器', UNIX_TIMESTAMP()),
+	(814, 2811, '電子拡張顕微鏡', UNIX_TIMESTAMP()),
+	(666, 4661, '信号微分計', UNIX_TIMESTAMP()),
+	(581, 1579, '音響散乱率', UNIX_TIMESTAMP()),
+	(578, 1576, '分光電位計', UNIX_TIMESTAMP()),
+	(334, 3330, '記憶評価係数', UNIX_TIMESTAMP()),
+	(404, 5398, '出力複合器', UNIX_TIMESTAMP()),
+	(436, 6429, '電場極限速度', UNIX_TIMESTAMP()),
+	(818, 6811, '全滅確率密度', UNIX_TIMESTAMP()),
+	(398, 397, '衝撃波発生器', UNIX_TIMESTAMP()),
+	(461, 1459, '汎用凝固装置', UNIX_TIMESTAMP()),
+	(75, 2072, '磁束確率密度', UNIX_TIMESTAMP()),
+	(293, 4288, '発熱反応係数', UNIX_TIMESTAMP()),
+	(111, 2108, '位相改変回路', UNIX_TIMESTAMP()),
+	(211, 3207, '全世界協調通信', UNIX_TIMESTAMP()),
+	(720, 6713, '勾配導電体', UNIX_TIMESTAMP()),
+	(495, 5489, '認知空間統合', UNIX_TIMESTAMP()),
+	(180, 179, '微粒子振動制御', UNIX_TIMESTAMP()),
+	(81, 80, '適応拡張現実', UNIX_TIMESTAMP()),
+	(727, 3723, '逆機能設計', UNIX_TIMESTAMP()),
+	(171, 4166, '金剛石紡績', UNIX_TIMESTAMP()),
+	(406, 5400, '紫電大天使', UNIX_TIMESTAMP()),
+	(461, 6454, '珠算正規化', UNIX_TIMESTAMP()),
+	(429, 6422, '露光量補正', UNIX_TIMESTAMP()),
+	(942, 6935, '植物相互作用', UNIX_TIMESTAMP()),
+	(920, 919, '酸化分布解析', UNIX_TIMESTAMP()),
+	(667, 666, '表面摩耗係数', UNIX_TIMESTAMP()),
+	(372, 2369, '磁極自己位置', UNIX_TIMESTAMP()),
+	(390, 3386, '凝集内回路', UNIX_TIMESTAMP()),
+	(111, 2108, '電流振幅検出', UNIX_TIMESTAMP()),
+	(362, 4357, '通信中断除去', UNIX_TIMESTAMP()),
+	(433, 5427, '爆発衝撃吸収', UNIX_TIMESTAMP()),
+	(840, 4835, '時空操作術', UNIX_TIMESTAMP()),
+	(41, 5035, '意識分割術', UNIX_TIMESTAMP()),
+	(915, 3911, '視覚補助装置', UNIX_TIMESTAMP()),
+	(545, 2542, '気象装置術', UNIX_TIMESTAMP()),
+	(9, 5003, '力場制御術', UNIX_TIMESTAMP()),
+	(830, 829, '無限位相計測器', UNIX_TIMESTAMP()),
+	(429, 5423, '心的速度評価指標', UNIX_TIMESTAMP()),
+	(378, 3374, '共鳴遮断装置', UNIX_TIMESTAMP()),
+	(242, 6235, '空間認識機構', UNIX_TIMESTAMP()),
+	(911, 3907, '悪魔分子研究', UNIX_TIMESTAMP()),
+	(285, 4280, '非感染空間', UNIX_TIMESTAMP()),
+	(684, 2681, '不可視能力', UNIX_TIMESTAMP()),
+	(904, 6897, '多元透明体', UNIX_TIMESTAMP()),
+	(437, 3433, '反粒子転送装置', UNIX_TIMESTAMP()),
+	(248, 1246, '超音波動態制御器', UNIX_TIMESTAMP()),
+	(852, 5846, '擬似時空解析', UNIX_TIMESTAMP()),
+	(787, 5781, '時空間補間装置', UNIX_TIMESTAMP()),
+	(105, 7097, '偽装磁場設定', UNIX_TIMESTAMP()),
+	(484, 4479, '微小熱共鳴分析', UNIX_TIMESTAMP()),
+	(961, 5955, '超短波生態', UNIX_TIMESTAMP()),
+	(342, 3338, '水晶流作用', UNIX_TIMESTAMP()),
+	(744, 4739, '超音響圧刺', UNIX_TIMESTAMP()),
+	(281, 6274, '造影膠循環', UNIX_TIMESTAMP()),
+	(280, 3276, '生物磁気制御', UNIX_TIMESTAMP()),
+	(904, 903, '空気清浄感知', UNIX_TIMESTAMP()),
+	(997, 6990, '微粒子化反射', UNIX_TIMESTAMP()),
+	(180, 179, '蓄積放熱器', UNIX_TIMESTAMP()),
+	(388, 6381, '音響共振応用', UNIX_TIMESTAMP()),
+	(593, 3589, '光景観衛星', UNIX_TIMESTAMP()),
+	(62, 5056, '軌跡追尾値', UNIX_TIMESTAMP()),
+	(642, 1640, '集合分子固狀', UNIX_TIMESTAMP()),
+	(195, 3191, '応力応答変換', UNIX_TIMESTAMP()),
+	(770, 2767, '心的介在物', UNIX_TIMESTAMP()),
+	(405, 4400, '磁気走査量子鏡', UNIX_TIMESTAMP()),
+	(742, 2739, '細胞増殖制御因子', UNIX_TIMESTAMP()),
+	(811, 5805, '微生物浄化装置', UNIX_TIMESTAMP()),
+	(588, 2585, '多葉組織器', UNIX_TIMESTAMP()),
+	(115, 5109, '等温酸化反応', UNIX_TIMESTAMP()),
+	(192, 4187, '交叉抵抗率', UNIX_TIMESTAMP()),
+	(168, 6161, '造形成分子', UNIX_TIMESTAMP()),
+	(171, 7163, '遺伝子修理蛋白質複合体', UNIX_TIMESTAMP()),
+	(428, 2425, '植物種分類圏', UNIX_TIMESTAMP()),
+	(694, 1692, '陽電子寿命', UNIX_TIMESTAMP()),
+	(666, 665, '精神安定薬', UNIX_TIMESTAMP()),
+	(379, 5373, '無限大極限', UNIX_TIMESTAMP()),
+	(471, 6464, '原子相互作用', UNIX_TIMESTAMP()),
+	(152, 5146, '脳内記録装置', UNIX_TIMESTAMP()),
+	(889, 4884, '精神通信術', UNIX_TIMESTAMP()),
+	(699, 6692, '心理電磁波脳波計', UNIX_TIMESTAMP()),
+	(254, 6247, '時空転移反応器', UNIX_TIMESTAMP()),
+	(908, 907, '量子物質変換器', UNIX_TIMESTAMP()),
+	(858, 2855, '完全自己進展回路', UNIX_TIMESTAMP()),
+	(391, 1389, '超音速時間加速装置', UNIX_TIMESTAMP()),
+	(877, 6870, '神経伝達速度増幅装置', UNIX_TIMESTAMP()),
+	(335, 2332, '無重力状態発生器', UNIX_TIMESTAMP()),
+	(646, 4641, '環境改善装置', UNIX_TIMESTAMP()),
+	(809, 808, '生物遺伝子評価', UNIX_TIMESTAMP()),
+	(44, 5038, '無重力航空機', UNIX_TIMESTAMP()),
+	(945, 3941, '心理空間測定', UNIX_TIMESTAMP()),
+	(526, 2523, '複合材料開発', UNIX_TIMESTAMP()),
+	(515, 3511, '社会統合政策', UNIX_TIMESTAMP()),
+	(368, 2365, '衛星気象観測', UNIX_TIMESTAMP()),
+	(158, 3154, '文化遺産保護', UNIX_TIMESTAMP()),
+	(804, 4799, '宇宙応用通信', UNIX_TIMESTAMP()),
+	(604, 1602, '未来予測研究', UNIX_TIMESTAMP()),
+	(471, 7463, '工業設計最適化', UNIX_TIMESTAMP()),
+	(686, 6679, '戦略的情報分析', UNIX_TIMESTAMP()),
+	(34, 7026, '量子計算機開発', UNIX_TIMESTAMP()),
+	(94, 7086, '農業生産最適化', UNIX_TIMESTAMP()),
+	(454, 1452, '宇宙探査装置', UNIX_TIMESTAMP()),
+	(189, 6182, '音響感応材料', UNIX_TIMESTAMP()),
+	(448, 1446, '海洋環境保全', UNIX_TIMESTAMP()),
+	(22, 5016, '小惑星探査', UNIX_TIMESTAMP()),
+	(380, 6373, '高分子材料開発', UNIX_TIMESTAMP()),
+	(611, 2608, '国際交通案内', UNIX_TIMESTAMP()),
+	(24, 7016, '自然資源管理', UNIX_TIMESTAMP()),
+	(200, 4195, '脳神経回路解析', UNIX_TIMESTAMP()),
+	(960, 6953, '環境循環理', UNIX_TIMESTAMP()),
+	(273, 3269, '蒸気比熱源', UNIX_TIMESTAMP()),
+	(417, 1415, '層状慣圧膜', UNIX_TIMESTAMP()),
+	(867, 5861, '斜面自所敷装置', UNIX_TIMESTAMP()),
+	(879, 3875, '凝結輸漏量', UNIX_TIMESTAMP()),
+	(188, 1186, '摂動安定化配列', UNIX_TIMESTAMP()),
+	(416, 2413, '断片選抜軌道', UNIX_TIMESTAMP()),
+	(836, 1834, '不動静円傷', UNIX_TIMESTAMP()),
+	(30, 1028, '無理順拍動機', UNIX_TIMESTAMP()),
+	(956, 5950, '多点坪升絵', UNIX_TIMESTAMP()),
+	(996, 5990, '方位進化索', UNIX_TIMESTAMP()),
+	(38, 37, '勾配遠隔加重', UNIX_TIMESTAMP()),
+	(504, 3500, '対流直角散布', UNIX_TIMESTAMP()),
+	(929, 1927, '築地坪支側槽', UNIX_TIMESTAMP()),
+	(38, 1036, '鉄筋底造礎', UNIX_TIMESTAMP()),
+	(299, 3295, '薬剤還元因子', UNIX_TIMESTAMP()),
+	(135, 134, '注意记号化', UNIX_TIMESTAMP()),
+	(71, 5065, '岩組処理余裕', UNIX_TIMESTAMP()),
+	(439, 2436, '悪恋倶楽部', UNIX_TIMESTAMP()),
+	(690, 689, '化粧笈思惑', UNIX_TIMESTAMP()),
+	(450, 2447, '宇宙携帯通信規格', UNIX_TIMESTAMP()),
+	(728, 3724, '高分子核心材料', UNIX_TIMESTAMP()),
+	(168, 167, '量子生体計測器', UNIX_TIMESTAMP()),
+	(484, 1482, '超高速量子暗号化', UNIX_TIMESTAMP()),
+	(640, 6633, '音符図書館', UNIX_TIMESTAMP()),
+	(177, 4172, '時空転位機', UNIX_TIMESTAMP()),
+	(851, 6844, '心霊感応装置', UNIX_TIMESTAMP()),
+	(847, 846, '天体透明化材料', UNIX_TIMESTAMP()),
+	(572, 571, '微生物相殺装置', UNIX_TIMESTAMP()),
+	(814, 1812, '微粒子減速装置', UNIX_TIMESTAMP()),
+	(742, 6735, '生体電波解析装置', UNIX_TIMESTAMP()),
+	(635, 6628, '天気制御装置', UNIX_TIMESTAMP()),
+	(975, 5969, '地盤固定術', UNIX_TIMESTAMP()),
+	(239, 1237, '聖杯探索団', UNIX_TIMESTAMP()),
+	(632, 1630, '記憶洗浄装置', UNIX_TIMESTAMP()),
+	(856, 4851, '軍団司令官', UNIX_TIMESTAMP()),
+	(731, 2728, '迷宮探索者', UNIX_TIMESTAMP()),
+	(213, 212, '霊気検体鎮定装置', UNIX_TIMESTAMP()),
+	(724, 6717, '時空転位加速機械', UNIX_TIMESTAMP()),
+	(497, 496, '感情解析器', UNIX_TIMESTAMP()),
+	(504, 2501, '光波共鳴防壁', UNIX_TIMESTAMP()),
+	(743, 1741, '宇宙超音響', UNIX_TIMESTAMP()),
+	(940, 5934, '脳震動機構', UNIX_TIMESTAMP()),
+	(844, 843, '生命共鳴体', UNIX_TIMESTAMP()),
+	(439, 438, '高気圧生成装置', UNIX_TIMESTAMP()),
+	(250, 4245, '地下磁場分析', UNIX_TIMESTAMP()),
+	(407, 2404, '非晶質散逸効果', UNIX_TIMESTAMP()),
+	(983, 982, '反重力螺旋', UNIX_TIMESTAMP()),
+	(575, 5569, '音空間投影', UNIX_TIMESTAMP()),
+	(586, 2583, '天然触媒結合', UNIX_TIMESTAMP()),
+	(280, 1278, '翼端急減速', UNIX_TIMESTAMP()),
+	(809, 6802, '物質共鳴遺伝子', UNIX_TIMESTAMP()),
+	(357, 3353, '連続抽象領域', UNIX_TIMESTAMP()),
+	(197, 3193, '高温分子移動', UNIX_TIMESTAMP()),
+	(810, 5804, '超強力想念波', UNIX_TIMESTAMP()),
+	(528, 1526, '正体変数分析', UNIX_TIMESTAMP()),
+	(541, 6534, '超重力招来', UNIX_TIMESTAMP()),
+	(321, 6314, '鉄鉱石崩壊', UNIX_TIMESTAMP()),
+	(713, 1711, '透明化装置', UNIX_TIMESTAMP()),
+	(662, 3658, '量子粒子固定化', UNIX_TIMESTAMP()),
+	(583, 582, '感情伝達機構', UNIX_TIMESTAMP()),
+	(118, 2115, '地球再生術', UNIX_TIMESTAMP()),
+	(198, 1196, '無重力生成器', UNIX_TIMESTAMP()),
+	(569, 6562, '発光体凝固', UNIX_TIMESTAMP()),
+	(413, 1411, '意識領域検出', UNIX_TIMESTAMP()),
+	(968, 2965, '地磁気操作', UNIX_TIMESTAMP()),
+	(317, 4312, '行動分析装置', UNIX_TIMESTAMP()),
+	(951, 4946, '一瞬多次元移動', UNIX_TIMESTAMP()),
+	(652, 2649, '未来予測解析', UNIX_TIMESTAMP()),
+	(882, 3878, '鏡像現実投影', UNIX_TIMESTAMP()),
+	(87, 3083, '類義語類推機能', UNIX_TIMESTAMP()),
+	(505, 3501, '脳波干渉装置', UNIX_TIMESTAMP()),
+	(818, 4813, '心理的時間圧縮', UNIX_TIMESTAMP()),
+	(587, 1585, '精密空間計測', UNIX_TIMESTAMP()),
+	(660, 6653, '電磁歯科医療機器', UNIX_TIMESTAMP()),
+	(447, 446, '精神波動測定装置', UNIX_TIMESTAMP()),
+	(65, 3061, '心霊体力指数', UNIX_TIMESTAMP()),
+	(981, 1979, '宇宙磁気空間圏検知衛星', UNIX_TIMESTAMP()),
+	(224, 3220, '念力発電装置', UNIX_TIMESTAMP()),
+	(904, 6897, '超自然追跡器', UNIX_TIMESTAMP()),
+	(345, 2342, '情報転写装置', UNIX_TIMESTAMP()),
+	(462, 6455, '疑似時間多面体', UNIX_TIMESTAMP()),
+	(82, 1080, '曼荼羅幻撃', UNIX_TIMESTAMP()),
+	(291, 3287, '聖堂騎士団', UNIX_TIMESTAMP()),
+	(849, 2846, '水鳥筆記術', UNIX_TIMESTAMP()),
+	(120, 119, '無重力制御装置', UNIX_TIMESTAMP()),
+	(275, 5269, '情報解凍装置', UNIX_TIMESTAMP()),
+	(526, 3522, '潜在能力観測装置', UNIX_TIMESTAMP()),
+	(954, 1952, '感情達成指標', UNIX_TIMESTAMP()),
+	(630, 5624, '逆成長防止装置', UNIX_TIMESTAMP()),
+	(855, 5849, '音波感応体外反応器', UNIX_TIMESTAMP()),
+	(392, 6385, '心理感応圧縮処理', UNIX_TIMESTAMP()),
+	(602, 3598, '高次元情報符号化', UNIX_TIMESTAMP()),
+	(885, 884, '加速度不安定解析', UNIX_TIMESTAMP()),
+	(67, 6060, '環境生物相関解析', UNIX_TIMESTAMP()),
+	(387, 7379, '波動遺伝子', UNIX_TIMESTAMP()),
+	(974, 3970, '金属熱凝固', UNIX_TIMESTAMP()),
+	(331, 330, '神経封鎖点', UNIX_TIMESTAMP()),
+	(207, 206, '気流制御機構', UNIX_TIMESTAMP()),
+	(30, 5024, '放射温度計', UNIX_TIMESTAMP()),
+	(389, 6382, '環境持続率', UNIX_TIMESTAMP()),
+	(613, 4608, '磁粒子霧化', UNIX_TIMESTAMP()),
+	(996, 4991, '液体金属爆弾', UNIX_TIMESTAMP()),
+	(242, 241, '非緊張運転', UNIX_TIMESTAMP()),
+	(786, 3782, '天井照明探知', UNIX_TIMESTAMP()),
+	(649, 2646, '緊急連携憶測', UNIX_TIMESTAMP()),
+	(64, 1062, '水銀循環装置', UNIX_TIMESTAMP()),
+	(410, 2407, '投影剤解析', UNIX_TIMESTAMP()),
+	(888, 3884, '脈波片対処', UNIX_TIMESTAMP()),
+	(529, 6522, '視覚補正石', UNIX_TIMESTAMP()),
+	(795, 4790, '静電気剥離', UNIX_TIMESTAMP()),
+	(729, 6722, '誇大関節機', UNIX_TIMESTAMP()),
+	(572, 3568, '神秘老齢光', UNIX_TIMESTAMP()),
+	(549, 1547, '魔導破壊剣', UNIX_TIMESTAMP()),
+	(395, 5389, '極限瞬間力', UNIX_TIMESTAMP()),
+	(489, 4484, '妖炎震龍拳', UNIX_TIMESTAMP()),
+	(426, 5420, '意識共有装置', UNIX_TIMESTAMP()),
+	(137, 6130, '夢幻魔導典', UNIX_TIMESTAMP()),
+	(854, 3850, '対能力封印', UNIX_TIMESTAMP()),
+	(430, 7422, '量子生命体', UNIX_TIMESTAMP()),
+	(965, 4960, '音響図書館', UNIX_TIMESTAMP()),
+	(873, 3869, '錬金術装置', UNIX_TIMESTAMP()),
+	(68, 6061, '生命計測器', UNIX_TIMESTAMP()),
+	(901, 5895, '無重力鍛錬', UNIX_TIMESTAMP()),
+	(853, 5847, '思念変換機', UNIX_TIMESTAMP()),
+	(563, 4558, '潜在意識結晶', UNIX_TIMESTAMP()),
+	(954, 1952, '微気象仮名', UNIX_TIMESTAMP()),
+	(727, 726, '無機神経網', UNIX_TIMESTAMP()),
+	(527, 4522, '地下山火事', UNIX_TIMESTAMP()),
+	(791, 1789, '都市循環経済', UNIX_TIMESTAMP()),
+	(853, 852, '音声解析脳研究', UNIX_TIMESTAMP()),
+	(725, 1723, '線型加速器', UNIX_TIMESTAMP()),
+	(457, 2454, '宇宙水域開発', UNIX_TIMESTAMP()),
+	(454, 7446, '動物福利指数', UNIX_TIMESTAMP()),
+	(880, 4875, '農産物腸詰機', UNIX_TIMESTAMP()),
+	(894, 5888, '建築資源再利用', UNIX_TIMESTAMP()),
+	(662, 661, '研究倫理委員会', UNIX_TIMESTAMP()),
+	(16, 5010, '水産養殖遺伝子組換', UNIX_TIMESTAMP()),
+	(118, 3114, '原子力融合', UNIX_TIMESTAMP()),
+	(472, 4467, '未知生命体', UNIX_TIMESTAMP()),
+	(601, 4596, '情報共有意思', UNIX_TIMESTAMP()),
+	(314, 3310, '神聖水晶術', UNIX_TIMESTAMP()),
+	(629, 628, '生体進化適応', UNIX_TIMESTAMP()),
+	(791, 2788, '神秘微生物', UNIX_TIMESTAMP()),
+	(132, 131, '欠陥高速化', UNIX_TIMESTAMP()),
+	(914, 3910, '無線超伝送', UNIX_TIMESTAMP()),
+	(124, 1122, '藻類高濃度', UNIX_TIMESTAMP()),
+	(24, 2021, '暗躍結晶化', UNIX_TIMESTAMP()),
+	(48, 2045, '心理社舞斬', UNIX_TIMESTAMP()),
+	(927, 5921, '絶対対数境', UNIX_TIMESTAMP()),
+	(952, 2949, '無音肥模晶', UNIX_TIMESTAMP()),
+	(299, 1297, '赤外線多量子箱', UNIX_TIMESTAMP()),
+	(348, 347, '停電呆気症', UNIX_TIMESTAMP()),
+	(852, 5846, '心拍振動測定器', UNIX_TIMESTAMP()),
+	(815, 3811, '輻射細柱血球', UNIX_TIMESTAMP()),
+	(765, 1763, '音波発生腕輪', UNIX_TIMESTAMP()),
+	(320, 1318, '醜形障害術', UNIX_TIMESTAMP()),
+	(952, 4947, '静電充填器', UNIX_TIMESTAMP()),
+	(862, 3858, '円筒圧縮計', UNIX_TIMESTAMP()),
+	(325, 2322, '弦楽樹脂分解酵素', UNIX_TIMESTAMP()),
+	(389, 5383, '光導制震機', UNIX_TIMESTAMP()),
+	(145, 2142, '温床症候群', UNIX_TIMESTAMP()),
+	(390, 3386, '四次元調整器', UNIX_TIMESTAMP()),
+	(205, 204, '壊滅粒子加速機', UNIX_TIMESTAMP()),
+	(855, 1853, '古代生物進化細胞', UNIX_TIMESTAMP()),
+	(744, 6737, '魂魄転位術', UNIX_TIMESTAMP()),
+	(133, 6126, '心霊測定器', UNIX_TIMESTAMP()),
+	(445, 5439, '霊的結界術', UNIX_TIMESTAMP()),
+	(499, 7491, '心理感応研究', UNIX_TIMESTAMP()),
+	(834, 4829, '幽体離脱術', UNIX_TIMESTAMP()),
+	(262, 3258, '超常現象研究', UNIX_TIMESTAMP()),
+	(155, 4150, '神聖反作用', UNIX_TIMESTAMP()),
+	(404, 2401, '二大政党制', UNIX_TIMESTAMP()),
+	(590, 3586, '蒸留硫酸機', UNIX_TIMESTAMP()),
+	(50, 6043, '水晶凝視器', UNIX_TIMESTAMP()),
+	(999, 4994, '溶融熱線装置', UNIX_TIMESTAMP()),
+	(449, 6442, '平均密度測定', UNIX_TIMESTAMP()),
+	(97, 6090, '粒子拡散反応', UNIX_TIMESTAMP()),
+	(388, 6381, '火葬炉冷却装置', UNIX_TIMESTAMP()),
+	(64, 1062, '鋼鉄蓄音機', UNIX_TIMESTAMP()),
+	(941, 2938, '施設動力供給系', UNIX_TIMESTAMP()),
+	(80, 5074, '鏡面反射顕微鏡', UNIX_TIMESTAMP()),
+	(543, 5537, '植物遺伝子計測', UNIX_TIMESTAMP()),
+	(936, 935, '静水圧温位計', UNIX_TIMESTAMP()),
+	(450, 3446, '高周波漏磁計', UNIX_TIMESTAMP()),
+	(213, 5207, '超音波振動子', UNIX_TIMESTAMP()),
+	(766, 4761, '酸素酸化反応', UNIX_TIMESTAMP()),
+	(807, 1805, '経済繁栄指標', UNIX_TIMESTAMP()),
+	(122, 3118, '社会予知能力', UNIX_TIMESTAMP()),
+	(462, 4457, '精神医療機構', UNIX_TIMESTAMP()),
+	(855, 6848, '音楽言語化', UNIX_TIMESTAMP()),
+	(813, 5807, '政治統制機構', UNIX_TIMESTAMP()),
+	(3, 5996, '文化遺産保護策', UNIX_TIMESTAMP()),
+	(834, 833, '鉱脈遺伝子', UNIX_TIMESTAMP()),
+	(839, 3835, '知識結晶化', UNIX_TIMESTAMP()),
+	(900, 6893, '氷晶貯蔵槽', UNIX_TIMESTAMP()),
+	(851, 5845, '密集研削機', UNIX_TIMESTAMP()),
+	(500, 5494, '高次描象鏡', UNIX_TIMESTAMP()),
+	(425, 424, '領域戦略体', UNIX_TIMESTAMP()),
+	(712, 711, '無重子核燃料', UNIX_TIMESTAMP()),
+	(37, 7029, '陽電子共鳴', UNIX_TIMESTAMP()),
+	(375, 3371, '擬循環装置', UNIX_TIMESTAMP()),
+	(939, 6932, '静水圧解析', UNIX_TIMESTAMP()),
+	(661, 3657, '十六進表示', UNIX_TIMESTAMP()),
+	(203, 2200, '神経伝回路', UNIX_TIMESTAMP()),
+	(428, 6421, '不確定解析', UNIX_TIMESTAMP()),
+	(194, 3190, '霊子融合装置', UNIX_TIMESTAMP()),
+	(231, 230, '鳥獣同化計画', UNIX_TIMESTAMP()),
+	(564, 563, '錬金術気体', UNIX_TIMESTAMP()),
+	(690, 689, '天象観測儀', UNIX_TIMESTAMP()),
+	(911, 3907, '魔力調律装置', UNIX_TIMESTAMP()),
+	(44, 2041, '精神転移装置', UNIX_TIMESTAMP()),
+	(593, 6586, '無限次元探査機', UNIX_TIMESTAMP()),
+	(794, 793, '量子時間逆行装置', UNIX_TIMESTAMP()),
+	(742, 4737, '神秘的因子素子', UNIX_TIMESTAMP()),
+	(663, 3659, '逆境適応因子', UNIX_TIMESTAMP()),
+	(496, 5490, '暗黒物質貯蔵装置', UNIX_TIMESTAMP()),
+	(142, 1140, '超音波分光計', UNIX_TIMESTAMP()),
+	(50, 5044, '抗衰弱分子', UNIX_TIMESTAMP()),
+	(299, 4294, '走査型透過電子顕微鏡', UNIX_TIMESTAMP()),
+	(879, 3875, '超高温炉材料', UNIX_TIMESTAMP()),
+	(14, 4009, '逆位相振動子', UNIX_TIMESTAMP()),
+	(343, 2340, '発熱吸収触媒', UNIX_TIMESTAMP()),
+	(250, 2247, '非現実次元', UNIX_TIMESTAMP()),
+	(139, 1137, '時空跳躍術', UNIX_TIMESTAMP()),
+	(500, 2497, '霊感検知術', UNIX_TIMESTAMP()),
+	(632, 2629, '音波感応装置', UNIX_TIMESTAMP()),
+	(878, 3874, '精神観測器', UNIX_TIMESTAMP()),
+	(237, 4232, '幻想起動装置', UNIX_TIMESTAMP()),
+	(230, 1228, '時空移動誘導術', UNIX_TIMESTAMP()),
+	(320, 6313, '意識転送装置', UNIX_TIMESTAMP()),
+	(386, 385, '知覚増幅装置', UNIX_TIMESTAMP()),
+	(837, 3833, '心靈接觸術', UNIX_TIMESTAMP()),
+	(72, 2069, '幻視推理術', UNIX_TIMESTAMP()),
+	(186, 185, '地球年係學', UNIX_TIMESTAMP()),
+	(178, 4173, '結晶格子欠陥', UNIX_TIMESTAMP()),
+	(316, 7308, '微生物遺伝子組換', UNIX_TIMESTAMP()),
+	(171, 2168, '画期的分光器', UNIX_TIMESTAMP()),
+	(630, 629, '凝縮相転移', UNIX_TIMESTAMP()),
+	(940, 1938, '高等変態作用', UNIX_TIMESTAMP()),
+	(453, 452, '飛行派生降下', UNIX_TIMESTAMP()),
+	(43, 7035, '音速境界層', UNIX_TIMESTAMP()),
+	(62, 6055, '熱画像走査', UNIX_TIMESTAMP()),
+	(634, 633, '地震反射探査', UNIX_TIMESTAMP()),
+	(263, 5257, '高密度核磁気共鳴', UNIX_TIMESTAMP()),
+	(254, 253, '多層光導波路', UNIX_TIMESTAMP()),
+	(351, 7343, '姿勢保持装置', UNIX_TIMESTAMP()),
+	(441, 440, '灌流圧力分布', UNIX_TIMESTAMP()),
+	(465, 6458, '水中頭蓋骨魚雷', UNIX_TIMESTAMP()),
+	(148, 3144, '共役超伝導体', UNIX_TIMESTAMP()),
+	(963, 4958, '高精度位置決定', UNIX_TIMESTAMP()),
+	(985, 6978, '脂肪酸代謝調節因子', UNIX_TIMESTAMP()),
+	(627, 626, '制御局在現象', UNIX_TIMESTAMP()),
+	(4, 3, '鉄鉱石結晶', UNIX_TIMESTAMP()),
+	(251, 6244, '海底鉱区採剖', UNIX_TIMESTAMP()),
+	(496, 2493, '構造遊戯体', UNIX_TIMESTAMP()),
+	(526, 1524, '太陽磁力場', UNIX_TIMESTAMP()),
+	(111, 3107, '石油保存腔', UNIX_TIMESTAMP()),
+	(542, 541, '水沸点計測装置', UNIX_TIMESTAMP()),
+	(977, 4972, '宇宙時空転移装置', UNIX_TIMESTAMP()),
+	(246, 245, '超音速振動剣', UNIX_TIMESTAMP()),
+	(900, 1898, '分子構造変換装置', UNIX_TIMESTAMP()),
+	(116, 6109, '磁気共鳴再生装置', UNIX_TIMESTAMP()),
+	(668, 1666, '霊素制御術', UNIX_TIMESTAMP()),
+	(189, 6182, '精妙繊細化', UNIX_TIMESTAMP()),
+	(164, 3160, '蒙太奇天籟', UNIX_TIMESTAMP()),
+	(423, 5417, '微量有機物質', UNIX_TIMESTAMP()),
+	(67, 6060, '基準加速度', UNIX_TIMESTAMP()),
+	(338, 7330, '客観相違点', UNIX_TIMESTAMP()),
+	(919, 6912, '核酸配列解読', UNIX_TIMESTAMP()),
+	(172, 5166, '非可視入力', UNIX_TIMESTAMP()),
+	(493, 5487, '高圧駆動装置', UNIX_TIMESTAMP()),
+	(927, 6920, '黒箱最適化', UNIX_TIMESTAMP()),
+	(28, 2025, '難解分子相関', UNIX_TIMESTAMP()),
+	(213, 1211, '動的断片化', UNIX_TIMESTAMP()),
+	(155, 154, '疑似乱数系列', UNIX_TIMESTAMP()),
+	(488, 1486, '断層構成体', UNIX_TIMESTAMP()),
+	(101, 5095, '反転化合物', UNIX_TIMESTAMP()),
+	(450, 3446, '衝撃応答解析', UNIX_TIMESTAMP()),
+	(767, 5761, '鋼水融解器', UNIX_TIMESTAMP()),
+	(351, 350, '精神経細胞分裂阻害剤', UNIX_TIMESTAMP()),
+	(336, 2333, '生体透明造影剤', UNIX_TIMESTAMP()),
+	(73, 2070, '発熱核磁気共鳴装置', UNIX_TIMESTAMP()),
+	(953, 3949, '深層動脈刺激器', UNIX_TIMESTAMP()),
+	(194, 6187, '超音波渦磁力顕微鏡', UNIX_TIMESTAMP()),
+	(826, 825, '有機無機複合合金', UNIX_TIMESTAMP()),
+	(793, 792, '製薬多層気相薄膜装置', UNIX_TIMESTAMP()),
+	(172, 7164, '初期拡散着色剤', UNIX_TIMESTAMP()),
+	(848, 2845, '原子核共鳴質量顕微鏡', UNIX_TIMESTAMP()),
+	(621, 5615, '傾圧測定装置', UNIX_TIMESTAMP()),
+	(876, 2873, '蒸気圧墜高加', UNIX_TIMESTAMP()),
+	(298, 3294, '音波屈折還元', UNIX_TIMESTAMP()),
+	(580, 579, '高密度透過評定', UNIX_TIMESTAMP()),
+	(778, 3774, '静電撥浄周波', UNIX_TIMESTAMP()),
+	(401, 7393, '質量非線獲得', UNIX_TIMESTAMP()),
+	(956, 3952, '気象変動二分', UNIX_TIMESTAMP()),
+	(321, 320, '起動主軸抽引', UNIX_TIMESTAMP()),
+	(344, 4339, '生物溶解濃度', UNIX_TIMESTAMP()),
+	(601, 4596, '心理抑制抽出', UNIX_TIMESTAMP()),
+	(73, 72, '自動擬装匿名', UNIX_TIMESTAMP()),
+	(586, 6579, '経済影響普及', UNIX_TIMESTAMP()),
+	(574, 3570, '建築耐震検査', UNIX_TIMESTAMP()),
+	(402, 7394, '飛行安定奏影', UNIX_TIMESTAMP()),
+	(504, 4499, '物理効果放電', UNIX_TIMESTAMP()),
+	(687, 4682, '画像品質評価', UNIX_TIMESTAMP()),
+	(196, 1194, '農作物収穫補正', UNIX_TIMESTAMP()),
+	(31, 6024, '社会心理分析', UNIX_TIMESTAMP()),
+	(735, 3731, '無明脳解説', UNIX_TIMESTAMP()),
+	(584, 4579, '霊波制御術', UNIX_TIMESTAMP()),
+	(371, 7363, '臨界境界反応', UNIX_TIMESTAMP()),
+	(865, 1863, '神鳴撃霊術', UNIX_TIMESTAMP()),
+	(599, 5593, '自律適応制御', UNIX_TIMESTAMP()),
+	(297, 5291, '高分子薄膜', UNIX_TIMESTAMP()),
+	(460, 459, '成分解析装置', UNIX_TIMESTAMP()),
+	(760, 6753, '情報浸透防止剤', UNIX_TIMESTAMP()),
+	(617, 3613, '検知幅調整機能', UNIX_TIMESTAMP()),
+	(459, 7451, '無限極化反応器', UNIX_TIMESTAMP()),
+	(833, 4828, '体外循環装置', UNIX_TIMESTAMP()),
+	(425, 6418, '燃料電池車両', UNIX_TIMESTAMP()),
+	(354, 3350, '膝関節鏡視下手術', UNIX_TIMESTAMP()),
+	(153, 3149, '植物抗体測定機', UNIX_TIMESTAMP()),
+	(766, 3762, '弧光放電装置', UNIX_TIMESTAMP()),
+	(613, 3609, '血液循環模型', UNIX_TIMESTAMP()),
+	(842, 6835, '超高速鍵達', UNIX_TIMESTAMP()),
+	(432, 6425, '幻滅化概念', UNIX_TIMESTAMP()),
+	(430, 4425, '相対退廃制御', UNIX_TIMESTAMP()),
+	(47, 4042, '空想合成言語', UNIX_TIMESTAMP()),
+	(245, 6238, '光熱伝導鎧', UNIX_TIMESTAMP()),
+	(436, 1434, '夢幻化現象', UNIX_TIMESTAMP()),
+	(136, 5130, '粒子共鳴機', UNIX_TIMESTAMP()),
+	(791, 6784, '詠唱連続増幅', UNIX_TIMESTAMP()),
+	(880, 4875, '蒸気念動力', UNIX_TIMESTAMP()),
+	(990, 3986, '無限次元世界', UNIX_TIMESTAMP()),
+	(284, 283, '星霊環境調和', UNIX_TIMESTAMP()),
+	(199, 7191, '覚醒意識波', UNIX_TIMESTAMP()),
+	(259, 258, '生体情報分析', UNIX_TIMESTAMP()),
+	(720, 4715, '脳半球共鳴', UNIX_TIMESTAMP()),
+	(219, 2216, '可変剛体解析', UNIX_TIMESTAMP()),
+	(744, 743, '自律制御車両', UNIX_TIMESTAMP()),
+	(496, 2493, '星漢梁山泊', UNIX_TIMESTAMP()),
+	(165, 5159, '熱動態解析', UNIX_TIMESTAMP()),
+	(396, 5390, '心脳同一核', UNIX_TIMESTAMP()),
+	(970, 3966, '映像情報解析', UNIX_TIMESTAMP()),
+	(332, 4327, '微生物群集解析', UNIX_TIMESTAMP()),
+	(663, 2660, '無線双線結合器', UNIX_TIMESTAMP()),
+	(177, 1175, '機械的感覚変調', UNIX_TIMESTAMP()),
+	(91, 7083, '加速粒子源', UNIX_TIMESTAMP()),
+	(785, 6778, '衝撃魔導体', UNIX_TIMESTAMP()),
+	(683, 2680, '心理形態推定', UNIX_TIMESTAMP()),
+	(907, 3903, '生体回路解析', UNIX_TIMESTAMP()),
+	(382, 6375, '強力反磁気材料', UNIX_TIMESTAMP()),
+	(118, 3114, '温度熱脅迫試験', UNIX_TIMESTAMP()),
+	(269, 3265, '空間散乱解析', UNIX_TIMESTAMP()),
+	(518, 3514, '天地環巡術', UNIX_TIMESTAMP()),
+	(479, 3475, '時空操糸術', UNIX_TIMESTAMP()),
+	(57, 3053, '透明心理術', UNIX_TIMESTAMP()),
+	(870, 3866, '生体回路制御', UNIX_TIMESTAMP()),
+	(760, 4755, '運命解読研究', UNIX_TIMESTAMP()),
+	(543, 2540, '空間支配術', UNIX_TIMESTAMP()),
+	(325, 324, '時空共振器', UNIX_TIMESTAMP()),
+	(868, 6861, '精神統合装置', UNIX_TIMESTAMP()),
+	(662, 6655, '魔力抑制装置', UNIX_TIMESTAMP()),
+	(5, 6997, '情報交換機', UNIX_TIMESTAMP()),
+	(845, 2842, '量子再構築装置', UNIX_TIMESTAMP()),
+	(96, 4091, '無重力航行装置', UNIX_TIMESTAMP()),
+	(467, 7459, '超音波振動装置', UNIX_TIMESTAMP()),
+	(899, 6892, '電磁妨害装置', UNIX_TIMESTAMP()),
+	(595, 3591, '分子合成装置', UNIX_TIMESTAMP()),
+	(858, 2855, '磁気浮上装置', UNIX_TIMESTAMP()),
+	(476, 3472, '高速空間移動装置', UNIX_TIMESTAMP()),
+	(842, 3838, '熱気浮力制御', UNIX_TIMESTAMP()),
+	(369, 1367, '構造最適問題', UNIX_TIMESTAMP()),
+	(485, 4480, '無機物質合成', UNIX_TIMESTAMP()),
+	(255, 2252, '体内優生研究', UNIX_TIMESTAMP()),
+	(980, 1978, '生体情報解析', UNIX_TIMESTAMP()),
+	(628, 5622, '触媒生成反応', UNIX_TIMESTAMP()),
+	(702, 2699, '地下深層掘削', UNIX_TIMESTAMP()),
+	(755, 1753, '血清成分解析', UNIX_TIMESTAMP()),
+	(152, 151, '音楽感動認知', UNIX_TIMESTAMP()),
+	(245, 7237, '真空積層加工', UNIX_TIMESTAMP()),
+	(779, 3775, '情報表現言語', UNIX_TIMESTAMP()),
+	(756, 6749, '誘導放電装置', UNIX_TIMESTAMP()),
+	(173, 5167, '知識結晶化', UNIX_TIMESTAMP()),
+	(492, 7484, '隕星閃光術', UNIX_TIMESTAMP()),
+	(271, 6264, '宇宙測位桿', UNIX_TIMESTAMP()),
+	(758, 1756, '時空軸固定器', UNIX_TIMESTAMP()),
+	(773, 4768, '果実継承術', UNIX_TIMESTAMP()),
+	(337, 3333, '魔力吸収体', UNIX_TIMESTAMP()),
+	(513, 3509, '絶対治癒力', UNIX_TIMESTAMP()),
+	(237, 1235, '極限瞬間移動', UNIX_TIMESTAMP()),
+	(660, 659, '呪詛症候群', UNIX_TIMESTAMP()),
+	(217, 216, '増鬼症候群', UNIX_TIMESTAMP()),
+	(397, 3393, '惑星隣接行列', UNIX_TIMESTAMP()),
+	(200, 7192, '不夜都市化', UNIX_TIMESTAMP()),
+	(252, 7244, '生態退耕計画', UNIX_TIMESTAMP()),
+	(970, 3966, '高倍加速器', UNIX_TIMESTAMP()),
+	(589, 5583, '無重力固体', UNIX_TIMESTAMP()),
+	(483, 4478, '統合交互消化', UNIX_TIMESTAMP()),
+	(445, 444, '空中断面分析', UNIX_TIMESTAMP()),
+	(989, 2986, '非接収保護指数', UNIX_TIMESTAMP()),
+	(239, 238, '粒子加速線源', UNIX_TIMESTAMP()),
+	(746, 1744, '影響座標計算', UNIX_TIMESTAMP()),
+	(501, 2498, '断層形成機構', UNIX_TIMESTAMP()),
+	(424, 1422, '時空洪水模型', UNIX_TIMESTAMP()),
+	(6, 6998, '霊子検出器', UNIX_TIMESTAMP()),
+	(68, 5062, '心象映像化', UNIX_TIMESTAMP()),
+	(538, 3534, '魔力増感剤', UNIX_TIMESTAMP()),
+	(750, 1748, '情緒投射装置', UNIX_TIMESTAMP()),
+	(469, 6462, '次元転移装置', UNIX_TIMESTAMP()),
+	(865, 864, '超空間通信', UNIX_TIMESTAMP()),
+	(848, 3844, '放射線干渉素子', UNIX_TIMESTAMP()),
+	(674, 1672, '非可逆進化機構', UNIX_TIMESTAMP()),
+	(192, 6185, '複合物質液晶', UNIX_TIMESTAMP()),
+	(824, 1822, '液体変態材', UNIX_TIMESTAMP()),
+	(128, 3124, '量子吸光安定制御', UNIX_TIMESTAMP()),
+	(864, 863, '超強度繊維合金', UNIX_TIMESTAMP()),
+	(33, 32, '表面結合評価', UNIX_TIMESTAMP()),
+	(131, 3127, '疾患転化因子', UNIX_TIMESTAMP()),
+	(173, 172, '生体脈波計測器', UNIX_TIMESTAMP()),
+	(113, 3109, '動反応制御研究', UNIX_TIMESTAMP()),
+	(919, 918, '夢幻起業家', UNIX_TIMESTAMP()),
+	(20, 4015, '妖精倶楽部', UNIX_TIMESTAMP()),
+	(114, 4109, '天空変幻術', UNIX_TIMESTAMP()),
+	(733, 3729, '日月輪転術', UNIX_TIMESTAMP()),
+	(622, 621, '瞬間移動術', UNIX_TIMESTAMP()),
+	(372, 6365, '無限連続体', UNIX_TIMESTAMP()),
+	(43, 6036, '逆転核反応', UNIX_TIMESTAMP()),
+	(205, 5199, '量子時間調整', UNIX_TIMESTAMP()),
+	(684, 4679, '無限次元解析', UNIX_TIMESTAMP()),
+	(964, 4959, '集合知意識', UNIX_TIMESTAMP()),
+	(52, 7044, '視覚透明化', UNIX_TIMESTAMP()),
+	(323, 322, '結晶文構造', UNIX_TIMESTAMP()),
+	(669, 5663, '多元空間転移', UNIX_TIMESTAMP()),
+	(364, 7356, '統合振動波', UNIX_TIMESTAMP()),
+	(278, 7270, '記憶再編築', UNIX_TIMESTAMP()),
+	(67, 66, '意識密着制御', UNIX_TIMESTAMP()),
+	(327, 5321, '次元暗号化', UNIX_TIMESTAMP()),
+	(755, 5749, '超自然拡張', UNIX_TIMESTAMP()),
+	(860, 859, '無信仰主義', UNIX_TIMESTAMP()),
+	(828, 827, '認知写真周波数交換', UNIX_TIMESTAMP()),
+	(892, 3888, '無声共鳴反応', UNIX_TIMESTAMP()),
+	(460, 1458, '海洋冒険史', UNIX_TIMESTAMP()),
+	(813, 3809, '静水圧力計', UNIX_TIMESTAMP()),
+	(214, 5208, '量子計算機', UNIX_TIMESTAMP()),
+	(737, 5731, '自然音再生装置', UNIX_TIMESTAMP()),
+	(215, 2212, '血液凝固阻害剤', UNIX_TIMESTAMP()),
+	(423, 5417, '磁気浮上装置', UNIX_TIMESTAMP()),
+	(897, 4892, '円軌道脱出速度', UNIX_TIMESTAMP()),
+	(754, 3750, '脳神経移植手術', UNIX_TIMESTAMP()),
+	(237, 4232, '線形加速器', UNIX_TIMESTAMP()),
+	(740, 6733, '高温超伝導体', UNIX_TIMESTAMP()),
+	(706, 4701, '高濃度酸素', UNIX_TIMESTAMP()),
+	(192, 1190, '鉱脈接合装置', UNIX_TIMESTAMP()),
+	(562, 1560, '精神波動計測器', UNIX_TIMESTAMP()),
+	(452, 3448, '量子位相変換器', UNIX_TIMESTAMP()),
+	(27, 7019, '暗黒物質増幅装置', UNIX_TIMESTAMP()),
+	(253, 7245, '母船統括計画装置', UNIX_TIMESTAMP()),
+	(827, 4822, '時空間曲率補正装置', UNIX_TIMESTAMP()),
+	(427, 4422, '高速自己修復機能', UNIX_TIMESTAMP()),
+	(228, 227, '熱核融合反応装置', UNIX_TIMESTAMP()),
+	(297, 296, '音波依存装置', UNIX_TIMESTAMP()),
+	(687, 3683, '響脈消化装置', UNIX_TIMESTAMP()),
+	(972, 1970, '想起閾値解析', UNIX_TIMESTAMP()),
+	(654, 653, '相塩酸素発生素', UNIX_TIMESTAMP()),
+	(369, 5363, '生物半導体研究', UNIX_TIMESTAMP()),
+	(503, 502, '無重力磁場誘導', UNIX_TIMESTAMP()),
+	(166, 4161, '心理熱抽出器', UNIX_TIMESTAMP()),
+	(253, 252, '未知低減結晶', UNIX_TIMESTAMP()),
+	(203, 4198, '水中通信院', UNIX_TIMESTAMP()),
+	(674, 3670, '気象因子積算装置', UNIX_TIMESTAMP()),
+	(128, 7120, '翻訳思考機器', UNIX_TIMESTAMP()),
+	(228, 227, '動作分類識別装置', UNIX_TIMESTAMP()),
+	(904, 3900, '多重無機反射器', UNIX_TIMESTAMP()),
+	(420, 4415, '応力物質研究所', UNIX_TIMESTAMP()),
+	(665, 3661, '心理抑制装置', UNIX_TIMESTAMP()),
+	(656, 2653, '空気循環平衡器', UNIX_TIMESTAMP()),
+	(653, 1651, '量子共鳴装置', UNIX_TIMESTAMP()),
+	(404, 2401, '有機物複合体', UNIX_TIMESTAMP()),
+	(376, 7368, '捕食者回避行動概念', UNIX_TIMESTAMP()),
+	(24, 23, '霧化冷却反応器', UNIX_TIMESTAMP()),
+	(379, 4374, '地殻劣化検知装置', UNIX_TIMESTAMP()),
+	(795, 6788, '平行次元生成器', UNIX_TIMESTAMP()),
+	(6, 5000, '高密度凝縮', UNIX_TIMESTAMP()),
+	(585, 2582, '疑似増幅器', UNIX_TIMESTAMP()),
+	(92, 6085, '金属蓄熱体', UNIX_TIMESTAMP()),
+	(956, 5950, '妄想拡張症', UNIX_TIMESTAMP()),
+	(109, 108, '空間再構築', UNIX_TIMESTAMP()),
+	(45, 4040, '記憶整理術', UNIX_TIMESTAMP()),
+	(221, 6214, '無重力瞬間移動', UNIX_TIMESTAMP()),
+	(311, 6304, '天象観測船', UNIX_TIMESTAMP()),
+	(462, 5456, '巨大機甲兵', UNIX_TIMESTAMP()),
+	(735, 6728, '創造力探測機', UNIX_TIMESTAMP()),
+	(824, 2821, '超連続情報変容', UNIX_TIMESTAMP()),
+	(388, 2385, '空間転移装置', UNIX_TIMESTAMP()),
+	(18, 1016, '時空歪曲効果', UNIX_TIMESTAMP()),
+	(201, 5195, '非対称相互制御', UNIX_TIMESTAMP()),
+	(894, 893, '超音速運動体', UNIX_TIMESTAMP()),
+	(34, 3030, '思念波発信機', UNIX_TIMESTAMP()),
+	(854, 3850, '光波帯域兵器', UNIX_TIMESTAMP()),
+	(546, 545, '超音波暗示装置', UNIX_TIMESTAMP()),
+	(702, 701, '空間曲率制御装置', UNIX_TIMESTAMP()),
+	(210, 2207, '超次元螺旋推進', UNIX_TIMESTAMP()),
+	(699, 6692, '燃焼効率化', UNIX_TIMESTAMP()),
+	(23, 3019, '水分子分解装置', UNIX_TIMESTAMP()),
+	(689, 4684, '遺伝子修復因子', UNIX_TIMESTAMP()),
+	(631, 4626, '転移抑制剤', UNIX_TIMESTAMP()),
+	(571, 2568, '物態変換触媒', UNIX_TIMESTAMP()),
+	(829, 4824, '超音速加速器', UNIX_TIMESTAMP()),
+	(846, 3842, '高分子材料結合剤', UNIX_TIMESTAMP()),
+	(747, 6740, '記憶情報消去装置', UNIX_TIMESTAMP()),
+	(572, 3568, '蒸気透明化', UNIX_TIMESTAMP()),
+	(515, 1513, '生命加速化', UNIX_TIMESTAMP()),
+	(473, 5467, '空中浮遊装置', UNIX_TIMESTAMP()),
+	(724, 4719, '粒子早熟化', UNIX_TIMESTAMP()),
+	(132, 3128, '量子干渉装置', UNIX_TIMESTAMP()),
+	(327, 5321, '巨大酵母培養槽', UNIX_TIMESTAMP()),
+	(266, 5260, '光遮断材料', UNIX_TIMESTAMP()),
+	(818, 1816, '衛星気象観測', UNIX_TIMESTAMP()),
+	(36, 6029, '腐食抑制剤', UNIX_TIMESTAMP()),
+	(509, 6502, '輻射加速器', UNIX_TIMESTAMP()),
+	(850, 3846, '水晶魔道具', UNIX_TIMESTAMP()),
+	(842, 6835, '契約式装束', UNIX_TIMESTAMP()),
+	(81, 4076, '自動運転競技', UNIX_TIMESTAMP()),
+	(752, 3748, '無重力粒子', UNIX_TIMESTAMP()),
+	(811, 5805, '枯渇反応炉', UNIX_TIMESTAMP()),
+	(42, 41, '瞬間遠隔通信', UNIX_TIMESTAMP()),
+	(91, 2088, '生体再生装置', UNIX_TIMESTAMP()),
+	(400, 6393, '超音速浮遊艇', UNIX_TIMESTAMP()),
+	(6, 4001, '超電磁防衛盾', UNIX_TIMESTAMP()),
+	(745, 5739, '原子核皮膜', UNIX_TIMESTAMP()),
+	(349, 5343, '心理凝集剤', UNIX_TIMESTAMP()),
+	(125, 124, '空間共有器', UNIX_TIMESTAMP()),
+	(37, 1035, '音頻修正器', UNIX_TIMESTAMP()),
+	(935, 4930, '熱力駆動機構', UNIX_TIMESTAMP()),
+	(551, 2548, '電磁塵集器', UNIX_TIMESTAMP()),
+	(451, 7443, '音響月桂樹', UNIX_TIMESTAMP()),
+	(604, 3600, '精神情報解析', UNIX_TIMESTAMP()),
+	(965, 5959, '感情同調抑制因子', UNIX_TIMESTAMP()),
+	(695, 4690, '圧力敏感触媒', UNIX_TIMESTAMP()),
+	(249, 1247, '霊術研究者', UNIX_TIMESTAMP()),
+	(596, 3592, '妖怪語研究', UNIX_TIMESTAMP()),
+	(673, 2670, '龍化遺伝子', UNIX_TIMESTAMP()),
+	(948, 4943, '不死術解析', UNIX_TIMESTAMP()),
+	(495, 4490, '錬金術合成', UNIX_TIMESTAMP()),
+	(928, 5922, '幻想戦略研究', UNIX_TIMESTAMP()),
+	(338, 337, '霊感視覚化', UNIX_TIMESTAMP()),
+	(237, 4232, '予知映像解析', UNIX_TIMESTAMP()),
+	(721, 4716, '幻獣牧場管理', UNIX_TIMESTAMP()),
+	(962, 6955, '神秘力解放', UNIX_TIMESTAMP()),
+	(469, 7461, '夢幻創造術', UNIX_TIMESTAMP()),
+	(671, 2668, '透明鎖解放', UNIX_TIMESTAMP()),
+	(13, 4008, '実在主義者', UNIX_TIMESTAMP()),
+	(375, 374, '能動遺伝子', UNIX_TIMESTAMP()),
+	(804, 6797, '高次元暗号', UNIX_TIMESTAMP()),
+	(958, 6951, '穹蒼創世核', UNIX_TIMESTAMP()),
+	(310, 7302, '青苗化雲劍', UNIX_TIMESTAMP()),
+	(839, 3835, '龍門震源弾', UNIX_TIMESTAMP()),
+	(938, 1936, '香霖水晶鏡', UNIX_TIMESTAMP()),
+	(495, 494, '合射蒼月弓', UNIX_TIMESTAMP()),
+	(462, 3458, '地脈漂移錆', UNIX_TIMESTAMP()),
+	(223, 7215, '弧綱旋転流', UNIX_TIMESTAMP()),
+	(634, 2631, '守愷光子源', UNIX_TIMESTAMP()),
+	(657, 4652, '心理革命機', UNIX_TIMESTAMP()),
+	(497, 2494, '無益推進機', UNIX_TIMESTAMP()),
+	(377, 1375, '驚愕実験装置', UNIX_TIMESTAMP()),
+	(495, 4490, '空腹感知装置', UNIX_TIMESTAMP()),
+	(312, 7304, '粒子振動器', UNIX_TIMESTAMP()),
+	(25, 5019, '活字融合術', UNIX_TIMESTAMP()),
+	(761, 5755, '微生物堆積', UNIX_TIMESTAMP()),
+	(850, 849, '電気虚像化', UNIX_TIMESTAMP()),
+	(193, 192, '生物再構成', UNIX_TIMESTAMP()),
+	(80, 5074, '細胞損傷防御装置', UNIX_TIMESTAMP()),
+	(719, 1717, '備長炭抽出', UNIX_TIMESTAMP()),
+	(75, 1073, '交通蓄積応急手術', UNIX_TIMESTAMP()),
+	(695, 1693, '電波干渉排除器', UNIX_TIMESTAMP()),
+	(705, 1703, '銀粒子溶離機', UNIX_TIMESTAMP()),
+	(87, 3083, '内包構造分析', UNIX_TIMESTAMP()),
+	(987, 5981, '木材誘導成長剤', UNIX_TIMESTAMP()),
+	(430, 2427, '比較出力計測装置', UNIX_TIMESTAMP()),
+	(870, 3866, '脳神経再生鍵', UNIX_TIMESTAMP()),
+	(174, 173, '電磁気適合装置', UNIX_TIMESTAMP()),
+	(164, 4159, '双極電解解析', UNIX_TIMESTAMP()),
+	(61, 6054, '調整振幅撮像', UNIX_TIMESTAMP()),
+	(992, 1990, '熱化合反撥装置', UNIX_TIMESTAMP()),
+	(12, 4007, '音響間隔測定装置', UNIX_TIMESTAMP()),
+	(478, 2475, '平衡反応走査装置', UNIX_TIMESTAMP()),
+	(293, 7285, '耐火加工強化板', UNIX_TIMESTAMP()),
+	(695, 5689, '砒酸化合物', UNIX_TIMESTAMP()),
+	(478, 5472, '鉤爪検出器', UNIX_TIMESTAMP()),
+	(454, 5448, '磁気波制御', UNIX_TIMESTAMP()),
+	(473, 3469, '燃素粒子研究', UNIX_TIMESTAMP()),
+	(704, 4699, '長寿遺伝子治療', UNIX_TIMESTAMP()),
+	(456, 4451, '次元移動装置', UNIX_TIMESTAMP()),
+	(834, 3830, '光速遮蔽装置', UNIX_TIMESTAMP()),
+	(147, 3143, '記憶復元装置', UNIX_TIMESTAMP()),
+	(163, 7155, '赤外線反応材料', UNIX_TIMESTAMP()),
+	(321, 2318, '極微粒子幕', UNIX_TIMESTAMP()),
+	(763, 1761, '金剛不壊噴血', UNIX_TIMESTAMP()),
+	(253, 7245, '機神捕縛器', UNIX_TIMESTAMP()),
+	(270, 269, '神速疾風状', UNIX_TIMESTAMP()),
+	(179, 7171, '絶対神令符', UNIX_TIMESTAMP()),
+	(369, 368, '不死鬼啄刀', UNIX_TIMESTAMP()),
+	(595, 5589, '流星悪魔掌', UNIX_TIMESTAMP()),
+	(222, 7214, '神経電撃弾', UNIX_TIMESTAMP()),
+	(378, 6371, '氷雪大演舞', UNIX_TIMESTAMP()),
+	(329, 6322, '遺伝子判読装置', UNIX_TIMESTAMP()),
+	(170, 7162, '山岳探検装備', UNIX_TIMESTAMP()),
+	(8, 1006, '陶芸焼成炉設計', UNIX_TIMESTAMP()),
+	(703, 3699, '効率化食品加工機', UNIX_TIMESTAMP()),
+	(179, 5173, '心理制御装置', UNIX_TIMESTAMP()),
+	(892, 1890, '分子加速器', UNIX_TIMESTAMP()),
+	(331, 4326, '核磁界創造器', UNIX_TIMESTAMP()),
+	(61, 5055, '情報感染防止装置', UNIX_TIMESTAMP()),
+	(865, 864, '音楽再帰解析', UNIX_TIMESTAMP()),
+	(174, 7166, '地下水循環解析', UNIX_TIMESTAMP()),
+	(104, 7096, '放射線遮蔽物', UNIX_TIMESTAMP()),
+	(449, 448, '生体情報解析', UNIX_TIMESTAMP()),
+	(8, 2005, '宇宙観測装置', UNIX_TIMESTAMP()),
+	(156, 155, '社会分断管理', UNIX_TIMESTAMP()),
+	(848, 3844, '水中音響通信', UNIX_TIMESTAMP()),
+	(280, 6273, '高速運動解析', UNIX_TIMESTAMP()),
+	(642, 641, '自然言語生成器', UNIX_TIMESTAMP()),
+	(798, 3794, '情報流動最適化', UNIX_TIMESTAMP()),
+	(605, 604, '感染症制御機構', UNIX_TIMESTAMP()),
+	(366, 4361, '鳥瞰地図生成', UNIX_TIMESTAMP()),
+	(41, 2038, '半導体超伝導体', UNIX_TIMESTAMP()),
+	(156, 4151, '超新星宇宙観察', UNIX_TIMESTAMP()),
+	(788, 2785, '織物緻密度', UNIX_TIMESTAMP()),
+	(98, 1096, '不完全降格', UNIX_TIMESTAMP()),
+	(183, 7175, '内視鏡追跡', UNIX_TIMESTAMP()),
+	(25, 3021, '火星探査機', UNIX_TIMESTAMP()),
+	(340, 2337, '不完全活用', UNIX_TIMESTAMP()),
+	(498, 4493, '音響遮断装置', UNIX_TIMESTAMP()),
+	(404, 5398, '熱容量効果', UNIX_TIMESTAMP()),
+	(614, 1612, '眼球振動計測器', UNIX_TIMESTAMP()),
+	(224, 2221, '自己融合装置', UNIX_TIMESTAMP()),
+	(673, 3669, '磁気分布計測', UNIX_TIMESTAMP()),
+	(418, 7410, '神経塩類交換器', UNIX_TIMESTAMP()),
+	(346, 5340, '体積効率最適化', UNIX_TIMESTAMP()),
+	(672, 2669, '形態識別装置', UNIX_TIMESTAMP()),
+	(291, 1289, '星間無線通信方式', UNIX_TIMESTAMP()),
+	(713, 6706, '量子物質評価', UNIX_TIMESTAMP()),
+	(711, 4706, '即物的思考', UNIX_TIMESTAMP()),
+	(470, 6463, '意思結晶化', UNIX_TIMESTAMP()),
+	(197, 4192, '行動程序化', UNIX_TIMESTAMP()),
+	(219, 3215, '知覚最適化', UNIX_TIMESTAMP()),
+	(679, 2676, '知的課題転換', UNIX_TIMESTAMP()),
+	(987, 6980, '核絶縁素子', UNIX_TIMESTAMP()),
+	(989, 2986, '神紋測定器', UNIX_TIMESTAMP()),
+	(661, 660, '心霊探知機', UNIX_TIMESTAMP()),
+	(23, 4018, '融体凝固装置', UNIX_TIMESTAMP()),
+	(84, 5078, '応力温度係数', UNIX_TIMESTAMP()),
+	(912, 1910, '効率化指標', UNIX_TIMESTAMP()),
+	(158, 1156, '土木構造解析', UNIX_TIMESTAMP()),
+	(185, 2182, '熱反応速度', UNIX_TIMESTAMP()),
+	(798, 5792, '表面磁場強度', UNIX_TIMESTAMP()),
+	(414, 4409, '機械振動減衰', UNIX_TIMESTAMP()),
+	(940, 3936, '無機酸素供給', UNIX_TIMESTAMP()),
+	(163, 1161, '高圧摩擦力', UNIX_TIMESTAMP()),
+	(355, 3351, '電子抗酸化体', UNIX_TIMESTAMP()),
+	(182, 7174, '実用化評価値', UNIX_TIMESTAMP()),
+	(475, 3471, '電子放射効果', UNIX_TIMESTAMP()),
+	(872, 6865, '高周波変調器', UNIX_TIMESTAMP()),
+	(479, 1477, '重力半径影響', UNIX_TIMESTAMP()),
+	(739, 2736, '結晶成長速度', UNIX_TIMESTAMP()),
+	(376, 6369, '熱分野伝導率', UNIX_TIMESTAMP()),
+	(817, 4812, '無線通信遅延', UNIX_TIMESTAMP()),
+	(908, 4903, '音響反射率', UNIX_TIMESTAMP()),
+	(815, 1813, '光電界放出', UNIX_TIMESTAMP()),
+	(402, 7394, '生物遺伝診断', UNIX_TIMESTAMP()),
+	(270, 1268, '無重力装置', UNIX_TIMESTAMP()),
+	(203, 1201, '電子蒸発機', UNIX_TIMESTAMP()),
+	(285, 284, '超重力発生装置', UNIX_TIMESTAMP()),
+	(260, 5254, '出力再生装置', UNIX_TIMESTAMP()),
+	(84, 7076, '細胞進化装置', UNIX_TIMESTAMP()),
+	(861, 5855, '再帰暗号化', UNIX_TIMESTAMP()),
+	(289, 7281, '無制限共鳴', UNIX_TIMESTAMP()),
+	(99, 1097, '超高速比較器', UNIX_TIMESTAMP()),
+	(790, 6783, '遺伝子離脱転写酵素', UNIX_TIMESTAMP()),
+	(992, 2989, '無重力装置', UNIX_TIMESTAMP()),
+	(760, 2757, '微強粒子縫合剤', UNIX_TIMESTAMP()),
+	(339, 338, '心理情報処理装置', UNIX_TIMESTAMP()),
+	(920, 4915, '渦状回流発生器', UNIX_TIMESTAMP()),
+	(214, 5208, '無明滑走板', UNIX_TIMESTAMP()),
+	(708, 1706, '液体精神共感源', UNIX_TIMESTAMP()),
+	(92, 4087, '磁場強制反転器', UNIX_TIMESTAMP()),
+	(78, 4073, '超音速破壊銃', UNIX_TIMESTAMP()),
+	(44, 1042, '逆相三角渦磁界', UNIX_TIMESTAMP()),
+	(761, 6754, '懸念信号形成器', UNIX_TIMESTAMP()),
+	(517, 516, '重力逸脱補正装置', UNIX_TIMESTAMP()),
+	(550, 1548, '霊感電子回路', UNIX_TIMESTAMP()),
+	(718, 6711, '光反応拡散器', UNIX_TIMESTAMP()),
+	(118, 4113, '無機生命凝縮反応器', UNIX_TIMESTAMP()),
+	(265, 2262, '賦活団粒子', UNIX_TIMESTAMP()),
+	(347, 2344, '推進反力絶対値', UNIX_TIMESTAMP()),
+	(600, 4595, '量子重力揺動', UNIX_TIMESTAMP()),
+	(546, 5540, '超音速粒状流', UNIX_TIMESTAMP()),
+	(761, 3757, '連続曲率構造', UNIX_TIMESTAMP()),
+	(261, 5255, '微分陽子振動', UNIX_TIMESTAMP()),
+	(407, 6400, '分散逆誤差', UNIX_TIMESTAMP()),
+	(681, 5675, '加速降伏強度', UNIX_TIMESTAMP()),
+	(974, 5968, '拡散精度演算', UNIX_TIMESTAMP()),
+	(918, 1916, '堆圧分配圧力', UNIX_TIMESTAMP()),
+	(942, 1940, '非定幅振動音波', UNIX_TIMESTAMP()),
+	(78, 77, '電極絶縁因子', UNIX_TIMESTAMP()),
+	(552, 6545, '逐次確率原理', UNIX_TIMESTAMP()),
+	(13, 6006, '核融合耐用焼結', UNIX_TIMESTAMP()),
+	(292, 1290, '固有波誤差範囲', UNIX_TIMESTAMP()),
+	(34, 33, '対称結合構造', UNIX_TIMESTAMP()),
+	(475, 7467, '蒸気複合機', UNIX_TIMESTAMP()),
+	(116, 4111, '遊星解析装置', UNIX_TIMESTAMP()),
+	(580, 5574, '風力充電器', UNIX_TIMESTAMP()),
+	(790, 2787, '音波分子検知器', UNIX_TIMESTAMP()),
+	(123, 7115, '精神瞑想装置', UNIX_TIMESTAMP()),
+	(491, 2488, '心理時間調整器', UNIX_TIMESTAMP()),
+	(177, 1175, '場所暗号解読機', UNIX_TIMESTAMP()),
+	(207, 1205, '音速制御装置', UNIX_TIMESTAMP()),
+	(302, 5296, '無重力産業機械', UNIX_TIMESTAMP()),
+	(570, 4565, '超高圧電力供給装置', UNIX_TIMESTAMP()),
+	(876, 875, '間接視覚視野拡大装置', UNIX_TIMESTAMP()),
+	(593, 4588, '銀河曼荼羅', UNIX_TIMESTAMP()),
+	(574, 573, '音響難聴症', UNIX_TIMESTAMP()),
+	(784, 783, '世界経済航空指標', UNIX_TIMESTAMP()),
+	(163, 7155, '脳神経外科医', UNIX_TIMESTAMP()),
+	(699, 6692, '数値物理解析', UNIX_TIMESTAMP()),
+	(580, 1578, '蒙太奇篝火', UNIX_TIMESTAMP()),
+	(807, 2804, '水光石火禅', UNIX_TIMESTAMP()),
+	(828, 5822, '風花雪月書', UNIX_TIMESTAMP()),
+	(154, 1152, '雷電破空雷', UNIX_TIMESTAMP()),
+	(821, 5815, '翔龍躍鳳舞', UNIX_TIMESTAMP()),
+	(333, 5327, '地獄極楽入理', UNIX_TIMESTAMP()),
+	(703, 6696, '雪月風花絵', UNIX_TIMESTAMP()),
+	(748, 747, '古今无双刀', UNIX_TIMESTAMP()),
+	(176, 1174, '無双亀甲絶', UNIX_TIMESTAMP()),
+	(164, 4159, '富貴年壽符', UNIX_TIMESTAMP()),
+	(438, 5432, '金蘭之手書', UNIX_TIMESTAMP()),
+	(756, 755, '義侠血涙結', UNIX_TIMESTAMP()),
+	(492, 6485, '紅袖添香宴', UNIX_TIMESTAMP()),
+	(294, 4289, '筆魚之声画', UNIX_TIMESTAMP()),
+	(821, 4816, '粒子寄生説', UNIX_TIMESTAMP()),
+	(119, 2116, '望遠鏡残像係数', UNIX_TIMESTAMP()),
+	(994, 993, '金属表面耐飛散振動量', UNIX_TIMESTAMP()),
+	(494, 4489, '建築構造応力分布', UNIX_TIMESTAMP()),
+	(224, 223, '統計計算標準偏差', UNIX_TIMESTAMP()),
+	(299, 5293, '地理地形変動状況', UNIX_TIMESTAMP()),
+	(686, 4681, '経営戦略市場攻勢', UNIX_TIMESTAMP()),
+	(189, 4184, '政治統治権力分散', UNIX_TIMESTAMP()),
+	(432, 5426, '融合型遊戯装置', UNIX_TIMESTAMP()),
+	(606, 6599, '流体生体計測器', UNIX_TIMESTAMP()),
+	(884, 883, '進化逓減最適化', UNIX_TIMESTAMP()),
+	(442, 2439, '修復再生駆動機構', UNIX_TIMESTAMP()),
+	(607, 3603, '感応磁気振動境界層', UNIX_TIMESTAMP()),
+	(492, 4487, '抽象形式判定演算子', UNIX_TIMESTAMP()),
+	(683, 2680, '複合現実体験器', UNIX_TIMESTAMP()),
+	(392, 1390, '感覚反射研究', UNIX_TIMESTAMP()),
+	(646, 5640, '音楽解析装置', UNIX_TIMESTAMP()),
+	(489, 5483, '空気圧制御装置', UNIX_TIMESTAMP()),
+	(464, 5458, '音声合成装置', UNIX_TIMESTAMP()),
+	(135, 2132, '積極推進循環検知', UNIX_TIMESTAMP()),
+	(253, 7245, '量子双峰波', UNIX_TIMESTAMP()),
+	(959, 3955, '心理安寧指数', UNIX_TIMESTAMP()),
+	(539, 5533, '光子共鳴生体反応', UNIX_TIMESTAMP()),
+	(153, 7145, '環境再生擁護', UNIX_TIMESTAMP()),
+	(269, 6262, '知覚拡張共有体感', UNIX_TIMESTAMP()),
+	(585, 1583, '心理革命心技', UNIX_TIMESTAMP()),
+	(523, 522, '先進複合材料製品', UNIX_TIMESTAMP()),
+	(175, 7167, '感度制御生体電磁波', UNIX_TIMESTAMP()),
+	(343, 4338, '幻想的心理反射装置', UNIX_TIMESTAMP()),
+	(118, 117, '継続的根源交流', UNIX_TIMESTAMP()),
+	(596, 4591, '感情遺伝子', UNIX_TIMESTAMP()),
+	(215, 6208, '宇宙透明度', UNIX_TIMESTAMP()),
+	(976, 5970, '照準計測器', UNIX_TIMESTAMP()),
+	(509, 5503, '遺伝子結晶', UNIX_TIMESTAMP()),
+	(669, 3665, '動物共通言語', UNIX_TIMESTAMP()),
+	(868, 3864, '岩石成形術', UNIX_TIMESTAMP()),
+	(237, 2234, '音響共鳴装置', UNIX_TIMESTAMP()),
+	(213, 1211, '心霊感応術', UNIX_TIMESTAMP()),
+	(619, 6612, '植物繊維鎧', UNIX_TIMESTAMP()),
+	(462, 2459, '未来視媒体', UNIX_TIMESTAMP()),
+	(343, 3339, '不思議矢印', UNIX_TIMESTAMP()),
+	(319, 3315, '半抑制装置', UNIX_TIMESTAMP()),
+	(499, 4494, '航空磁波逆転装置', UNIX_TIMESTAMP()),
+	(358, 357, '無重力場生成機', UNIX_TIMESTAMP()),
+	(490, 1488, '絶対耐熱素材', UNIX_TIMESTAMP()),
+	(923, 3919, '粒子加速計', UNIX_TIMESTAMP()),
+	(560, 4555, '量子融合砲', UNIX_TIMESTAMP()),
+	(281, 2278, '疾走妖精症候群', UNIX_TIMESTAMP()),
+	(456, 2453, '神聖遺伝子操作装置', UNIX_TIMESTAMP()),
+	(346, 2343, '超高層空中通信網', UNIX_TIMESTAMP()),
+	(51, 4046, '音響干渉反応装置', UNIX_TIMESTAMP()),
+	(111, 3107, '天然資源増殖製造機', UNIX_TIMESTAMP()),
+	(221, 5215, '透視光造形機', UNIX_TIMESTAMP()),
+	(581, 6574, '仮想意識透過', UNIX_TIMESTAMP()),
+	(88, 7080, '量子生命保護装置', UNIX_TIMESTAMP()),
+	(354, 1352, '超重力発生装置', UNIX_TIMESTAMP()),
+	(330, 2327, '自律神経機能', UNIX_TIMESTAMP()),
+	(914, 3910, '空気浄化装置', UNIX_TIMESTAMP()),
+	(599, 4594, '経済予測指標', UNIX_TIMESTAMP()),
+	(869, 868, '血中酸素濃度', UNIX_TIMESTAMP()),
+	(719, 2716, '色彩感染評価', UNIX_TIMESTAMP()),
+	(886, 4881, '元素発振器', UNIX_TIMESTAMP()),
+	(27, 6020, '生体再生装置', UNIX_TIMESTAMP()),
+	(946, 4941, '紫雲竜之手', UNIX_TIMESTAMP()),
+	(628, 6621, '反転抵抗器', UNIX_TIMESTAMP()),
+	(251, 6244, '時空転移装置', UNIX_TIMESTAMP()),
+	(553, 6546, '磁気融合反応', UNIX_TIMESTAMP()),
+	(785, 6778, '超音波共鳴装置', UNIX_TIMESTAMP()),
+	(175, 3171, '行列加速器', UNIX_TIMESTAMP()),
+	(289, 288, '傾斜制御装置', UNIX_TIMESTAMP()),
+	(345, 5339, '生体物質解析装置', UNIX_TIMESTAMP()),
+	(585, 2582, '認識行動評価指標', UNIX_TIMESTAMP()),
+	(474, 6467, '平衡反応速度定数', UNIX_TIMESTAMP()),
+	(58, 5052, '多次元相互情報量', UNIX_TIMESTAMP()),
+	(184, 183, '水車燃料摂取量指数', UNIX_TIMESTAMP()),
+	(79, 4074, '超弦共鳴計測器', UNIX_TIMESTAMP()),
+	(248, 5242, '熱核転写効率係数', UNIX_TIMESTAMP()),
+	(608, 1606, '金属酸化物疲労損傷評価', UNIX_TIMESTAMP()),
+	(765, 764, '電子顕微鏡奈米線形成', UNIX_TIMESTAMP()),
+	(286, 2283, '医療機器衛生評価基準', UNIX_TIMESTAMP()),
+	(352, 7344, '数理最適化凸関数', UNIX_TIMESTAMP()),
+	(838, 4833, '量子状態相転移挙動', UNIX_TIMESTAMP()),
+	(898, 4893, '粒子加速器粒子散乱効果', UNIX_TIMESTAMP()),
+	(549, 2546, '蒸気循環装置', UNIX_TIMESTAMP()),
+	(500, 1498, '星霊貯蔵器', UNIX_TIMESTAMP()),
+	(29, 6022, '金字塔文化', UNIX_TIMESTAMP()),
+	(305, 2302, '放射線紙幕', UNIX_TIMESTAMP()),
+	(326, 2323, '音響共鳴装置', UNIX_TIMESTAMP()),
+	(158, 7150, '平行次元舞台', UNIX_TIMESTAMP()),
+	(161, 4156, '電波網濾波器', UNIX_TIMESTAMP()),
+	(6, 1004, '創造計測器', UNIX_TIMESTAMP()),
+	(930, 4925, '発言矛盾丸', UNIX_TIMESTAMP()),
+	(24, 5018, '量子重力器', UNIX_TIMESTAMP()),
+	(650, 3646, '知識生成器', UNIX_TIMESTAMP()),
+	(831, 3827, '心理反射装置', UNIX_TIMESTAMP()),
+	(162, 161, '思考模造機', UNIX_TIMESTAMP()),
+	(650, 1648, '空間曲率調節器', UNIX_TIMESTAMP()),
+	(309, 1307, '時間抽出装置', UNIX_TIMESTAMP()),
+	(169, 3165, '強化現実体験装置', UNIX_TIMESTAMP()),
+	(933, 6926, '多次元座標変換機', UNIX_TIMESTAMP()),
+	(704, 1702, '意識流動増幅器', UNIX_TIMESTAMP()),
+	(283, 4278, '隠蔽形状分子', UNIX_TIMESTAMP()),
+	(339, 6332, '非対称精密計測器', UNIX_TIMESTAMP()),
+	(932, 931, '進化進行制御装置', UNIX_TIMESTAMP()),
+	(827, 2824, '微生物制御', UNIX_TIMESTAMP()),
+	(163, 7155, '火花安定化', UNIX_TIMESTAMP()),
+	(506, 3502, '航空船殖畜', UNIX_TIMESTAMP()),
+	(302, 1300, '超音波吸引機', UNIX_TIMESTAMP()),
+	(868, 867, '生体変換装置', UNIX_TIMESTAMP()),
+	(332, 5326, '磁気革命装置', UNIX_TIMESTAMP()),
+	(325, 4320, '統合脳内網計', UNIX_TIMESTAMP()),
+	(327, 6320, '養殖生物合成装置', UNIX_TIMESTAMP()),
+	(186, 4181, '非熱励振動装置', UNIX_TIMESTAMP()),
+	(829, 1827, '空中現実装置', UNIX_TIMESTAMP()),
+	(115, 1113, '風景補正器', UNIX_TIMESTAMP()),
+	(23, 4018, '音波共鳴装置', UNIX_TIMESTAMP()),
+	(927, 4922, '地磁気調整器', UNIX_TIMESTAMP()),
+	(676, 6669, '着想溶解装置', UNIX_TIMESTAMP()),
+	(91, 5085, '場所同定装置', UNIX_TIMESTAMP()),
+	(948, 1946, '心理状態推定器', UNIX_TIMESTAMP()),
+	(155, 3151, '知識豊和装置', UNIX_TIMESTAMP()),
+	(381, 6374, '目標重力対応機構', UNIX_TIMESTAMP()),
+	(946, 5940, '錯覚創生装置', UNIX_TIMESTAMP()),
+	(34, 33, '文化遺伝子解析', UNIX_TIMESTAMP()),
+	(837, 2834, '心拍観測機', UNIX_TIMESTAMP()),
+	(461, 7453, '音色調音装置', UNIX_TIMESTAMP()),
+	(998, 1996, '鉱脈探知術', UNIX_TIMESTAMP()),
+	(655, 3651, '情報圧縮術', UNIX_TIMESTAMP()),
+	(509, 508, '火山灰風速計', UNIX_TIMESTAMP()),
+	(483, 7475, '植物遺伝子操作', UNIX_TIMESTAMP()),
+	(198, 3194, '星間航海術', UNIX_TIMESTAMP()),
+	(807, 4802, '心理言語解析', UNIX_TIMESTAMP()),
+	(411, 2408, '時空操作装置', UNIX_TIMESTAMP()),
+	(478, 3474, '原子核融合炉', UNIX_TIMESTAMP()),
+	(189, 188, '高次元共振器', UNIX_TIMESTAMP()),
+	(842, 6835, '空中磁場制御', UNIX_TIMESTAMP()),
+	(349, 6342, '量子物質生成', UNIX_TIMESTAMP()),
+	(636, 5630, '磁気浮上車両', UNIX_TIMESTAMP()),
+	(549, 3545, '時間逆行装置', UNIX_TIMESTAMP()),
+	(898, 3894, '超自然現象検知器', UNIX_TIMESTAMP()),
+	(752, 1750, '無力化兵器', UNIX_TIMESTAMP()),
+	(735, 3731, '高周波防護服', UNIX_TIMESTAMP()),
+	(322, 6315, '意識体外脱出装置', UNIX_TIMESTAMP()),
+	(635, 6628, '超能力抑制装置', UNIX_TIMESTAMP()),
+	(863, 2860, '情報抽出装置', UNIX_TIMESTAMP()),
+	(274, 7266, '空気膜装置', UNIX_TIMESTAMP()),
+	(293, 6286, '想念報知機', UNIX_TIMESTAMP()),
+	(866, 865, '氷結結晶体', UNIX_TIMESTAMP()),
+	(757, 1755, '霊体観測術', UNIX_TIMESTAMP()),
+	(241, 6234, '星間共振機構', UNIX_TIMESTAMP()),
+	(716, 3712, '精神増幅装置', UNIX_TIMESTAMP()),
+	(639, 1637, '量子解析器', UNIX_TIMESTAMP()),
+	(618, 5612, '超音速減衰装置', UNIX_TIMESTAMP()),
+	(187, 3183, '空中磁力定着機', UNIX_TIMESTAMP()),
+	(167, 4162, '微細構造材料', UNIX_TIMESTAMP()),
+	(440, 7432, '生命情報解読器', UNIX_TIMESTAMP()),
+	(984, 5978, '精神干渉防御装置', UNIX_TIMESTAMP()),
+	(891, 6884, '強化遺伝子配列', UNIX_TIMESTAMP()),
+	(229, 1227, '超光速通信機器', UNIX_TIMESTAMP()),
+	(191, 190, '水素濃度測定器', UNIX_TIMESTAMP()),
+	(858, 6851, '微生物駆除装置', UNIX_TIMESTAMP()),
+	(403, 2400, '効果音合成器', UNIX_TIMESTAMP()),
+	(599, 1597, '静止画像変換器', UNIX_TIMESTAMP()),
+	(509, 6502, '音声波形解析装置', UNIX_TIMESTAMP()),
+	(674, 6667, '逆物質発生機', UNIX_TIMESTAMP()),
+	(69, 6062, '環境微生物探査機', UNIX_TIMESTAMP()),
+	(490, 4485, '抗重力物質生成器', UNIX_TIMESTAMP()),
+	(990, 1988, '睡眠遺伝子', UNIX_TIMESTAMP()),
+	(188, 2185, '経済協奏曲', UNIX_TIMESTAMP()),
+	(431, 5425, '水晶迂回機', UNIX_TIMESTAMP()),
+	(825, 6818, '電子磁石化装置', UNIX_TIMESTAMP()),
+	(608, 4603, '超音速音波眼鏡', UNIX_TIMESTAMP()),
+	(897, 896, '遺伝情報溶解装置', UNIX_TIMESTAMP()),
+	(484, 5478, '地球振動測定器', UNIX_TIMESTAMP()),
+	(482, 6475, '透明化雷撃兵器', UNIX_TIMESTAMP()),
+	(115, 5109, '霊感増強装置', UNIX_TIMESTAMP()),
+	(777, 5771, '音速細胞再生装置', UNIX_TIMESTAMP()),
+	(879, 5873, '認識曲率補正装置', UNIX_TIMESTAMP()),
+	(13, 7005, '隠蔽超高周波発信器', UNIX_TIMESTAMP()),
+	(22, 7014, '量子風力発電機', UNIX_TIMESTAMP()),
+	(188, 3184, '情報拡散腕章', UNIX_TIMESTAMP()),
+	(294, 7286, '空間転移加速装置', UNIX_TIMESTAMP()),
+	(297, 2294, '神装探査器', UNIX_TIMESTAMP()),
+	(117, 5111, '魔術結晶体', UNIX_TIMESTAMP()),
+	(317, 2314, '火星移住計画', UNIX_TIMESTAMP()),
+	(911, 6904, '蒼空光線砲', UNIX_TIMESTAMP()),
+	(122, 3118, '天界交易協定', UNIX_TIMESTAMP()),
+	(339, 5333, '魂核再生装置', UNIX_TIMESTAMP()),
+	(347, 1345, '水晶幻像装置', UNIX_TIMESTAMP()),
+	(607, 6600, '心霊共鳴器', UNIX_TIMESTAMP()),
+	(204, 5198, '騎士剣術試験', UNIX_TIMESTAMP()),
+	(123, 122, '秘伝織物研究所', UNIX_TIMESTAMP()),
+	(337, 5331, '音波封神装置', UNIX_TIMESTAMP()),
+	(577, 6570, '透明光化粧品', UNIX_TIMESTAMP()),
+	(669, 2666, '元素転生石', UNIX_TIMESTAMP()),
+	(807, 4802, '量子瞬速通信', UNIX_TIMESTAMP()),
+	(372, 1370, '潜在変数初期化', UNIX_TIMESTAMP()),
+	(79, 78, '認知情報融合', UNIX_TIMESTAMP()),
+	(841, 3837, '心理感受度指標', UNIX_TIMESTAMP()),
+	(913, 2910, '遺伝情報統合', UNIX_TIMESTAMP()),
+	(6, 2003, '眼球震盪制御', UNIX_TIMESTAMP()),
+	(681, 5675, '睡眠破壊感知', UNIX_TIMESTAMP()),
+	(219, 3215, '聴覚空間抽出', UNIX_TIMESTAMP()),
+	(163, 6156, '神芸術學研究', UNIX_TIMESTAMP()),
+	(646, 645, '妖装束研究', UNIX_TIMESTAMP()),
+	(630, 3626, '遊藝戰略學', UNIX_TIMESTAMP()),
+	(462, 6455, '千里眼座禅', UNIX_TIMESTAMP()),
+	(180, 3176, '幻想皮膚科', UNIX_TIMESTAMP()),
+	(126, 125, '妖獣召喚術', UNIX_TIMESTAMP()),
+	(556, 5550, '幻夜詩歌術', UNIX_TIMESTAMP()),
+	(128, 1126, '逆鱗流動術', UNIX_TIMESTAMP()),
+	(23, 5017, '逐次相分離反応', UNIX_TIMESTAMP()),
+	(113, 3109, '睡眠行動開放度指標', UNIX_TIMESTAMP()),
+	(393, 6386, '超臨界流体制御', UNIX_TIMESTAMP()),
+	(593, 592, '統計的神経信号解析', UNIX_TIMESTAMP()),
+	(385, 4380, '離散混合音楽推薦', UNIX_TIMESTAMP()),
+	(254, 253, '海洋音響位相推定', UNIX_TIMESTAMP()),
+	(46, 45, '光電回路電力最適化', UNIX_TIMESTAMP()),
+	(581, 3577, '元素反発力', UNIX_TIMESTAMP()),
+	(575, 1573, '液晶結節構造', UNIX_TIMESTAMP()),
+	(995, 2992, '生体細胞破壊', UNIX_TIMESTAMP()),
+	(214, 3210, '磁気共鳴露光', UNIX_TIMESTAMP()),
+	(259, 1257, '投射座標軸', UNIX_TIMESTAMP()),
+	(18, 4013, '音響位相補正', UNIX_TIMESTAMP()),
+	(354, 3350, '量子相関器具', UNIX_TIMESTAMP()),
+	(443, 5437, '不可視空間解析', UNIX_TIMESTAMP()),
+	(246, 4241, '双極断面進化', UNIX_TIMESTAMP()),
+	(294, 293, '強力誘導電圧', UNIX_TIMESTAMP()),
+	(582, 3578, '真実変動定理', UNIX_TIMESTAMP()),
+	(618, 617, '超音波捕縛', UNIX_TIMESTAMP()),
+	(499, 498, '超自然能力', UNIX_TIMESTAMP()),
+	(813, 1811, '神秘的存在', UNIX_TIMESTAMP()),
+	(700, 6693, '神聖超振動', UNIX_TIMESTAMP()),
+	(20, 3016, '神速透明化', UNIX_TIMESTAMP()),
+	(834, 5828, '採鉱緻密度', UNIX_TIMESTAMP()),
+	(980, 2977, '植物遺伝子転送', UNIX_TIMESTAMP()),
+	(645, 644, '昆虫生態保全', UNIX_TIMESTAMP()),
+	(114, 3110, '収穫後無菌調整', UNIX_TIMESTAMP()),
+	(310, 3306, '音響言語処理', UNIX_TIMESTAMP()),
+	(543, 2540, '翻訳言語統計', UNIX_TIMESTAMP()),
+	(240, 3236, '分子社交行動研究', UNIX_TIMESTAMP()),
+	(420, 5414, '時代劇舞台', UNIX_TIMESTAMP()),
+	(258, 4253, '月世界旅行', UNIX_TIMESTAMP()),
+	(718, 5712, '超音速膨張', UNIX_TIMESTAMP()),
+	(820, 2817, '間接光過程', UNIX_TIMESTAMP()),
+	(958, 957, '超臨界反応', UNIX_TIMESTAMP()),
+	(475, 5469, '高効度抑制', UNIX_TIMESTAMP()),
+	(469, 7461, '減速副産物', UNIX_TIMESTAMP()),
+	(470, 6463, '量子間接通信', UNIX_TIMESTAMP()),
+	(690, 3686, '音波震動治療術', UNIX_TIMESTAMP()),
+	(958, 957, '鬱病予防健康機器', UNIX_TIMESTAMP()),
+	(3, 2000, '視覚聴覚融合研究', UNIX_TIMESTAMP()),
+	(578, 577, '心理共感度', UNIX_TIMESTAMP()),
+	(861, 2858, '音響積極遮断', UNIX_TIMESTAMP()),
+	(489, 2486, '高分光色素効率', UNIX_TIMESTAMP()),
+	(84, 7076, '空中舞踏検出', UNIX_TIMESTAMP()),
+	(498, 2495, '非線形位相変調器', UNIX_TIMESTAMP()),
+	(299, 298, '生物複合材料研究', UNIX_TIMESTAMP()),
+	(989, 988, '集中沈着制御', UNIX_TIMESTAMP()),
+	(577, 1575, '並進体操分析', UNIX_TIMESTAMP()),
+	(597, 3593, '風洞環境測定', UNIX_TIMESTAMP()),
+	(658, 1656, '傾波微粒子', UNIX_TIMESTAMP()),
+	(112, 5106, '鏡像遺伝子', UNIX_TIMESTAMP()),
+	(108, 6101, '電磁波抑制', UNIX_TIMESTAMP()),
+	(744, 3740, '超流動透明', UNIX_TIMESTAMP()),
+	(977, 2974, '情報錯誤抑制', UNIX_TIMESTAMP()),
+	(415, 414, '拡散反射膜', UNIX_TIMESTAMP()),
+	(351, 350, '音響共鳴境界', UNIX_TIMESTAMP()),
+	(506, 5500, '密閉対象式', UNIX_TIMESTAMP()),
+	(473, 472, '有機結晶相', UNIX_TIMESTAMP()),
+	(939, 3935, '崩壊収縮構造', UNIX_TIMESTAMP()),
+	(13, 2010, '理解温差向上', UNIX_TIMESTAMP()),
+	(296, 1294, '定常無相粒子', UNIX_TIMESTAMP()),
+	(399, 5393, '沈澱翻訳器', UNIX_TIMESTAMP()),
+	(683, 4678, '干渉等角配列', UNIX_TIMESTAMP()),
+	(253, 6246, '非平衡瞬時選択', UNIX_TIMESTAMP()),
+	(899, 898, '減速電波干渉', UNIX_TIMESTAMP()),
+	(689, 688, '逐次静電浸透', UNIX_TIMESTAMP()),
+	(845, 2842, '赤外移動団子', UNIX_TIMESTAMP()),
+	(479, 3475, '分子圧制退器', UNIX_TIMESTAMP()),
+	(242, 241, '縮合陽子推算', UNIX_TIMESTAMP()),
+	(158, 157, '固相共鳴現象', UNIX_TIMESTAMP()),
+	(79, 2076, '高分散結合器', UNIX_TIMESTAMP()),
+	(750, 749, '脱線冷凍種', UNIX_TIMESTAMP()),
+	(757, 6750, '不確定自動統制', UNIX_TIMESTAMP()),
+	(573, 5567, '水晶構造体', UNIX_TIMESTAMP()),
+	(89, 2086, '量子相互作用素子', UNIX_TIMESTAMP()),
+	(639, 638, '心霊振動装置', UNIX_TIMESTAMP()),
+	(795, 5789, '呼吸同調機器', UNIX_TIMESTAMP()),
+	(455, 6448, '時空電磁波', UNIX_TIMESTAMP()),
+	(966, 2963, '非現実領域', UNIX_TIMESTAMP()),
+	(67, 3063, '精神共振波', UNIX_TIMESTAMP()),
+	(64, 6057, '高次脳融合', UNIX_TIMESTAMP()),
+	(579, 5573, '遺伝暗号子', UNIX_TIMESTAMP()),
+	(852, 4847, '系統思考概念', UNIX_TIMESTAMP()),
+	(845, 5839, '情報統制場', UNIX_TIMESTAMP()),
+	(117, 4112, '知識遷移距離', UNIX_TIMESTAMP()),
+	(278, 7270, '自己正規担保', UNIX_TIMESTAMP()),
+	(116, 4111, '規則維持機構', UNIX_TIMESTAMP()),
+	(89, 1087, '複合持続断片', UNIX_TIMESTAMP()),
+	(338, 4333, '抽象階梯構造', UNIX_TIMESTAMP()),
+	(122, 2119, '擬似記憶痕跡', UNIX_TIMESTAMP()),
+	(192, 191, '心理生態系', UNIX_TIMESTAMP()),
+	(709, 3705, '実証修正体系', UNIX_TIMESTAMP()),
+	(767, 766, '政治動乱分析', UNIX_TIMESTAMP()),
+	(720, 3716, '建築都市計画', UNIX_TIMESTAMP()),
+	(955, 4950, '美術史研究', UNIX_TIMESTAMP()),
+	(144, 5138, '霊魂錬成術', UNIX_TIMESTAMP()),
+	(856, 4851, '風間流剣術', UNIX_TIMESTAMP()),
+	(17, 4012, '稲妻光射術', UNIX_TIMESTAMP()),
+	(335, 7327, '心眼探知術', UNIX_TIMESTAMP()),
+	(885, 4880, '雷霆響撃術', UNIX_TIMESTAMP()),
+	(208, 5202, '鬼神降臨術', UNIX_TIMESTAMP()),
+	(977, 1975, '心臓回復手術', UNIX_TIMESTAMP()),
+	(504, 503, '天翔舞踏術', UNIX_TIMESTAMP()),
+	(236, 6229, '閃光凍結術', UNIX_TIMESTAMP()),
+	(32, 7024, '破魔震撃矢', UNIX_TIMESTAMP()),
+	(8, 5002, '輪廻封印術', UNIX_TIMESTAMP()),
+	(890, 5884, '魔力結晶体', UNIX_TIMESTAMP()),
+	(910, 5904, '時空転移装置', UNIX_TIMESTAMP()),
+	(248, 6241, '心理共鳴網', UNIX_TIMESTAMP()),
+	(177, 7169, '生体再生因子', UNIX_TIMESTAMP()),
+	(318, 1316, '電子念動波', UNIX_TIMESTAMP()),
+	(541, 4536, '精神電波干渉', UNIX_TIMESTAMP()),
+	(318, 7310, '遺伝子解析器', UNIX_TIMESTAMP()),
+	(838, 1836, '偽装探知網', UNIX_TIMESTAMP()),
+	(719, 1717, '宇宙時間操作装置', UNIX_TIMESTAMP()),
+	(287, 2284, '超対称粒子加速器', UNIX_TIMESTAMP()),
+	(596, 595, '水晶固相増幅器', UNIX_TIMESTAMP()),
+	(155, 3151, '量子予測観測器', UNIX_TIMESTAMP()),
+	(484, 3480, '意識転送共振器', UNIX_TIMESTAMP()),
+	(669, 6662, '超磁気押圧装置', UNIX_TIMESTAMP()),
+	(732, 3728, '情報量子暗号化器', UNIX_TIMESTAMP()),
+	(867, 866, '飛行座標解析術', UNIX_TIMESTAMP()),
+	(759, 4754, '水晶共鳴装置', UNIX_TIMESTAMP()),
+	(308, 6301, '時空安定化算術', UNIX_TIMESTAMP()),
+	(110, 1108, '神経連結指標解析', UNIX_TIMESTAMP()),
+	(474, 4469, '記憶保全装置', UNIX_TIMESTAMP()),
+	(177, 4172, '心理反応情報解析', UNIX_TIMESTAMP()),
+	(317, 6310, '環境再生因子評価', UNIX_TIMESTAMP()),
+	(789, 6782, '霊気拡張術', UNIX_TIMESTAMP()),
+	(606, 3602, '水晶構築術', UNIX_TIMESTAMP()),
+	(839, 6832, '終末観測装置', UNIX_TIMESTAMP()),
+	(402, 6395, '空間共鳴器', UNIX_TIMESTAMP()),
+	(563, 5557, '超幻象波動', UNIX_TIMESTAMP()),
+	(114, 2111, '精神同期装置', UNIX_TIMESTAMP()),
+	(197, 5191, '夢制御装置', UNIX_TIMESTAMP()),
+	(385, 5379, '磁気誘導認識器', UNIX_TIMESTAMP()),
+	(756, 3752, '精神統制装置', UNIX_TIMESTAMP()),
+	(275, 274, '感情遮断装置', UNIX_TIMESTAMP()),
+	(210, 7202, '無気力症候群', UNIX_TIMESTAMP()),
+	(225, 7217, '逆重力原理', UNIX_TIMESTAMP()),
+	(935, 1933, '念力発生器', UNIX_TIMESTAMP()),
+	(57, 7049, '潜在記憶操作装置', UNIX_TIMESTAMP()),
+	(11, 2008, '磁場制御装置', UNIX_TIMESTAMP()),
+	(771, 3767, '光子加速器', UNIX_TIMESTAMP()),
+	(523, 5517, '無限次元行列', UNIX_TIMESTAMP()),
+	(765, 2762, '微生物制御装置', UNIX_TIMESTAMP()),
+	(703, 2700, '心理解析装置', UNIX_TIMESTAMP()),
+	(87, 86, '空間歪曲装置', UNIX_TIMESTAMP()),
+	(329, 6322, '魂移送装置', UNIX_TIMESTAMP()),
+	(229, 2226, '疑似重力発生装置', UNIX_TIMESTAMP()),
+	(445, 5439, '美術鑑賞感覚', UNIX_TIMESTAMP()),
+	(821, 2818, '健康管理指標', UNIX_TIMESTAMP()),
+	(135, 5129, '文化多元主義', UNIX_TIMESTAMP()),
+	(808, 3804, '瞳影子検査', UNIX_TIMESTAMP()),
+	(969, 1967, '出力増幅構造', UNIX_TIMESTAMP()),
+	(134, 7126, '高分子複合体', UNIX_TIMESTAMP()),
+	(372, 1370, '調味料擬似体', UNIX_TIMESTAMP()),
+	(346, 1344, '弾力形成剤', UNIX_TIMESTAMP()),
+	(952, 5946, '心理状態解析', UNIX_TIMESTAMP()),
+	(263, 5257, '多機能結合器', UNIX_TIMESTAMP()),
+	(433, 3429, '風圧測定装置', UNIX_TIMESTAMP()),
+	(203, 2200, '分子結晶解析', UNIX_TIMESTAMP()),
+	(219, 5213, '地盤押圧装置', UNIX_TIMESTAMP()),
+	(472, 3468, '高速攻撃防御', UNIX_TIMESTAMP()),
+	(49, 1047, '情報歪曲防止', UNIX_TIMESTAMP()),
+	(358, 2355, '自己補修構造', UNIX_TIMESTAMP()),
+	(204, 203, '暗黒窒息症', UNIX_TIMESTAMP()),
+	(946, 5940, '深海構造探査機', UNIX_TIMESTAMP()),
+	(391, 390, '時空転換装置', UNIX_TIMESTAMP()),
+	(711, 710, '絶対音感増幅器', UNIX_TIMESTAMP()),
+	(310, 309, '超音速物体検知器', UNIX_TIMESTAMP()),
+	(812, 811, '心理共振機', UNIX_TIMESTAMP()),
+	(232, 7224, '液体陽子波反射装置', UNIX_TIMESTAMP()),
+	(678, 4673, '空間暴震抑制器', UNIX_TIMESTAMP()),
+	(489, 6482, '透明化合物合成器', UNIX_TIMESTAMP()),
+	(896, 895, '無常変態機械', UNIX_TIMESTAMP()),
+	(472, 471, '火星土壌改良装置', UNIX_TIMESTAMP()),
+	(67, 6060, '無限行列項生成装置', UNIX_TIMESTAMP()),
+	(625, 4620, '平行次元探査機', UNIX_TIMESTAMP()),
+	(760, 4755, '相対速度演算装置', UNIX_TIMESTAMP()),
+	(407, 6400, '水蒸気凝縮装置', UNIX_TIMESTAMP()),
+	(246, 3242, '電磁波遮断材', UNIX_TIMESTAMP()),
+	(95, 3091, '真空気体酸化装置', UNIX_TIMESTAMP()),
+	(859, 2856, '凝結冷却器', UNIX_TIMESTAMP()),
+	(512, 1510, '高効率水分析装置', UNIX_TIMESTAMP()),
+	(419, 4414, '強力静音発生器', UNIX_TIMESTAMP()),
+	(554, 2551, '有機物分解加速装置', UNIX_TIMESTAMP()),
+	(766, 4761, '高耐熱防腐蝕材料', UNIX_TIMESTAMP()),
+	(474, 2471, '超音速振動子', UNIX_TIMESTAMP()),
+	(761, 1759, '架空磁場生成器', UNIX_TIMESTAMP()),
+	(362, 2359, '微量物質検出器', UNIX_TIMESTAMP()),
+	(223, 1221, '電子遮蔽透明材料', UNIX_TIMESTAMP()),
+	(815, 814, '降雨強度計', UNIX_TIMESTAMP()),
+	(155, 5149, '高電流低温伝導体', UNIX_TIMESTAMP()),
+	(184, 1182, '持続発光材料', UNIX_TIMESTAMP()),
+	(919, 6912, '固体複合材料', UNIX_TIMESTAMP()),
+	(990, 4985, '環境応答効果計測器', UNIX_TIMESTAMP()),
+	(243, 1241, '透明断熱材', UNIX_TIMESTAMP()),
+	(54, 1052, '音波共振装置', UNIX_TIMESTAMP()),
+	(771, 5765, '睡眠律動推定器', UNIX_TIMESTAMP()),
+	(252, 251, '記憶選別器', UNIX_TIMESTAMP()),
+	(597, 2594, '音楽翻訳機', UNIX_TIMESTAMP()),
+	(880, 2877, '磁気共鳴観測装置', UNIX_TIMESTAMP()),
+	(678, 3674, '統計体感計測器', UNIX_TIMESTAMP()),
+	(393, 392, '量子認知計算器', UNIX_TIMESTAMP()),
+	(391, 2388, '天体操船術', UNIX_TIMESTAMP()),
+	(526, 3522, '立体音響音楽', UNIX_TIMESTAMP()),
+	(390, 389, '生体充電器', UNIX_TIMESTAMP()),
+	(139, 7131, '時間断片構成装置', UNIX_TIMESTAMP()),
+	(776, 775, '超文献抄録', UNIX_TIMESTAMP()),
+	(47, 46, '音楽遺伝子解析', UNIX_TIMESTAMP()),
+	(446, 2443, '重力反射素子', UNIX_TIMESTAMP()),
+	(369, 3365, '空間反応道具', UNIX_TIMESTAMP()),
+	(730, 3726, '蒸気式計測器', UNIX_TIMESTAMP()),
+	(198, 5192, '珪藻土材料', UNIX_TIMESTAMP()),
+	(679, 6672, '硬水抑制剤', UNIX_TIMESTAMP()),
+	(265, 6258, '混晶燐酸塩', UNIX_TIMESTAMP()),
+	(465, 2462, '多孔質構造体', UNIX_TIMESTAMP()),
+	(361, 7353, '非晶質結晶', UNIX_TIMESTAMP()),
+	(993, 2990, '加速度腕輪', UNIX_TIMESTAMP()),
+	(850, 4845, '電子反射層', UNIX_TIMESTAMP()),
+	(752, 3748, '高分子可塑剤', UNIX_TIMESTAMP()),
+	(672, 2669, '植物生物発光', UNIX_TIMESTAMP()),
+	(905, 4900, '極限基本粒子', UNIX_TIMESTAMP()),
+	(323, 1321, '双極電界誘導', UNIX_TIMESTAMP()),
+	(664, 6657, '音波反射分光測定器', UNIX_TIMESTAMP()),
+	(357, 2354, '装身具爆弁', UNIX_TIMESTAMP()),
+	(236, 7228, '陶磁器合成', UNIX_TIMESTAMP()),
+	(677, 6670, '蒸気化貴腐', UNIX_TIMESTAMP()),
+	(829, 5823, '心電図搾取', UNIX_TIMESTAMP()),
+	(731, 6724, '空母改修化', UNIX_TIMESTAMP()),
+	(557, 6550, '知能駆散化', UNIX_TIMESTAMP()),
+	(403, 402, '風景撮影塩', UNIX_TIMESTAMP()),
+	(13, 1011, '水道管圧帯', UNIX_TIMESTAMP()),
+	(18, 4013, '億円化粧品', UNIX_TIMESTAMP()),
+	(319, 6312, '河川水位維持', UNIX_TIMESTAMP()),
+	(759, 4754, '展示場配線', UNIX_TIMESTAMP()),
+	(267, 4262, '泥炭採取機', UNIX_TIMESTAMP()),
+	(659, 4654, '絶滅動物酔装', UNIX_TIMESTAMP()),
+	(686, 6679, '高速鉄道連結', UNIX_TIMESTAMP()),
+	(328, 2325, '火山爆弾妨害', UNIX_TIMESTAMP()),
+	(514, 6507, '道路交通撹乱', UNIX_TIMESTAMP()),
+	(200, 1198, '活字印刷食器', UNIX_TIMESTAMP()),
+	(232, 3228, '情報漏洩対策', UNIX_TIMESTAMP()),
+	(466, 465, '音響核融合', UNIX_TIMESTAMP()),
+	(239, 3235, '生体計測装置', UNIX_TIMESTAMP()),
+	(554, 3550, '有機超伝導体', UNIX_TIMESTAMP()),
+	(505, 4500, '社会集合知', UNIX_TIMESTAMP()),
+	(147, 1145, '空間反射効果', UNIX_TIMESTAMP()),
+	(291, 290, '磁気振動子', UNIX_TIMESTAMP()),
+	(358, 7350, '視覚注意焦点', UNIX_TIMESTAMP()),
+	(646, 3642, '内部発光器', UNIX_TIMESTAMP()),
+	(202, 4197, '量子組織構築', UNIX_TIMESTAMP()),
+	(710, 3706, '自在閃光撃', UNIX_TIMESTAMP()),
+	(597, 3593, '融霜投射器', UNIX_TIMESTAMP()),
+	(740, 1738, '評買審査委員会', UNIX_TIMESTAMP()),
+	(146, 5140, '漸進効果関数', UNIX_TIMESTAMP()),
+	(846, 3842, '施設愛護活動', UNIX_TIMESTAMP()),
+	(865, 3861, '誘惑調節機構', UNIX_TIMESTAMP()),
+	(330, 4325, '経済平衡変動', UNIX_TIMESTAMP()),
+	(666, 665, '知体通信権', UNIX_TIMESTAMP()),
+	(586, 2583, '輻輳制御戦略', UNIX_TIMESTAMP()),
+	(718, 5712, '森林循環利用', UNIX_TIMESTAMP()),
+	(263, 2260, '能動熱帯化研究', UNIX_TIMESTAMP()),
+	(629, 5623, '行動職能評価', UNIX_TIMESTAMP()),
+	(195, 4190, '遺伝情報解析', UNIX_TIMESTAMP()),
+	(541, 2538, '石油供給安定化計画', UNIX_TIMESTAMP()),
+	(25, 7017, '創造的趣向評価', UNIX_TIMESTAMP()),
+	(532, 1530, '核融合燃料粒子', UNIX_TIMESTAMP()),
+	(905, 904, '潤滑圧力解析', UNIX_TIMESTAMP()),
+	(376, 7368, '熱膨張抑制材料', UNIX_TIMESTAMP()),
+	(777, 776, '傾斜積層構造', UNIX_TIMESTAMP()),
+	(580, 3576, '衝突蝕研究', UNIX_TIMESTAMP()),
+	(779, 2776, '脳神経伝達速度', UNIX_TIMESTAMP()),
+	(305, 4300, '心理労働負荷評価', UNIX_TIMESTAMP()),
+	(596, 3592, '循環経済指数', UNIX_TIMESTAMP()),
+	(999, 5993, '音響断熱材料', UNIX_TIMESTAMP()),
+	(211, 1209, '組織再生医療', UNIX_TIMESTAMP()),
+	(302, 301, '健康長寿遺伝子', UNIX_TIMESTAMP()),
+	(84, 6077, '高真空蒸着装置', UNIX_TIMESTAMP()),
+	(525, 3521, '社会環境影響評価', UNIX_TIMESTAMP()),
+	(702, 4697, '作業効率最適化', UNIX_TIMESTAMP()),
+	(970, 6963, '血液浄化装置', UNIX_TIMESTAMP()),
+	(523, 1521, '磁気共鳴映像診断', UNIX_TIMESTAMP()),
+	(75, 2072, '耐腐食合金開発', UNIX_TIMESTAMP()),
+	(200, 6193, '分子間相互作用解析', UNIX_TIMESTAMP()),
+	(340, 339, '酵素的反応温度調節器', UNIX_TIMESTAMP()),
+	(148, 4143, '聴覚音階分析器', UNIX_TIMESTAMP()),
+	(253, 5247, '細胞組織再生剤', UNIX_TIMESTAMP()),
+	(190, 6183, '岩石内部構造解析装置', UNIX_TIMESTAMP()),
+	(135, 5129, '鉱石組成推定', UNIX_TIMESTAMP()),
+	(443, 4438, '昆虫行動分析', UNIX_TIMESTAMP()),
+	(790, 1788, '計算機視覚処理', UNIX_TIMESTAMP()),
+	(307, 3303, '詩的言語生成', UNIX_TIMESTAMP()),
+	(205, 204, '遺伝子発現制御', UNIX_TIMESTAMP()),
+	(716, 1714, '新世代糖尿病治療', UNIX_TIMESTAMP()),
+	(518, 4513, '都市環境設計', UNIX_TIMESTAMP()),
+	(963, 962, '静電気安定化制御', UNIX_TIMESTAMP()),
+	(974, 5968, '脳神経回路解析', UNIX_TIMESTAMP()),
+	(69, 3065, '宇宙放射線影響予測', UNIX_TIMESTAMP()),
+	(345, 5339, '制御系最適化', UNIX_TIMESTAMP()),
+	(614, 1612, '視覚磁力偏向', UNIX_TIMESTAMP()),
+	(42, 7034, '水循環解析', UNIX_TIMESTAMP()),
+	(324, 5318, '超意識状態', UNIX_TIMESTAMP()),
+	(211, 5205, '超心理言語', UNIX_TIMESTAMP()),
+	(809, 5803, '反重力推進', UNIX_TIMESTAMP()),
+	(82, 81, '未来推測術', UNIX_TIMESTAMP()),
+	(257, 2254, '超自然能力', UNIX_TIMESTAMP()),
+	(782, 1780, '潜在能力解放', UNIX_TIMESTAMP()),
+	(548, 2545, '空域複合能力', UNIX_TIMESTAMP()),
+	(615, 4610, '脳波平均化演算', UNIX_TIMESTAMP()),
+	(921, 4916, '統計光電子顕微鏡', UNIX_TIMESTAMP()),
+	(597, 6590, '心理音響感知', UNIX_TIMESTAMP()),
+	(193, 6186, '行動遺伝子解析', UNIX_TIMESTAMP()),
+	(84, 5078, '提蜂獣騎士', UNIX_TIMESTAMP()),
+	(206, 205, '高速組織解析', UNIX_TIMESTAMP()),
+	(292, 3288, '協調自動化', UNIX_TIMESTAMP()),
+	(918, 6911, '遺伝型分布', UNIX_TIMESTAMP()),
+	(393, 3389, '高分子構造', UNIX_TIMESTAMP()),
+	(918, 917, '非侵襲検査', UNIX_TIMESTAMP()),
+	(268, 7260, '燃焼化合物', UNIX_TIMESTAMP()),
+	(846, 2843, '記憶転送機', UNIX_TIMESTAMP()),
+	(867, 866, '情報検索器', UNIX_TIMESTAMP()),
+	(844, 2841, '効率化評価値', UNIX_TIMESTAMP()),
+	(308, 3304, '波動反射体', UNIX_TIMESTAMP()),
+	(702, 701, '透明加工膜', UNIX_TIMESTAMP()),
+	(971, 970, '公共制御器', UNIX_TIMESTAMP()),
+	(224, 3220, '火力反応器', UNIX_TIMESTAMP()),
+	(751, 1749, '精神集中力', UNIX_TIMESTAMP()),
+	(15, 2012, '開発計画策定', UNIX_TIMESTAMP()),
+	(307, 4302, '市場動向予測', UNIX_TIMESTAMP()),
+	(718, 717, '計算機科研', UNIX_TIMESTAMP()),
+	(301, 3297, '系外生命体', UNIX_TIMESTAMP()),
+	(954, 6947, '陽電子崩壊', UNIX_TIMESTAMP()),
+	(527, 526, '火源絶縁体', UNIX_TIMESTAMP()),
+	(371, 6364, '感情解析器', UNIX_TIMESTAMP()),
+	(303, 1301, '量子演算装置', UNIX_TIMESTAMP()),
+	(317, 3313, '月面採掘機', UNIX_TIMESTAMP()),
+	(747, 4742, '超大型分子解体器', UNIX_TIMESTAMP()),
+	(791, 1789, '平衡共振槽', UNIX_TIMESTAMP()),
+	(342, 1340, '透明絶縁体', UNIX_TIMESTAMP()),
+	(335, 1333, '霊能者宝具', UNIX_TIMESTAMP()),
+	(197, 196, '隠密変装術', UNIX_TIMESTAMP()),
+	(316, 7308, '高分子伝導体', UNIX_TIMESTAMP()),
+	(148, 4143, '神智計算機', UNIX_TIMESTAMP()),
+	(567, 4562, '生体共振器', UNIX_TIMESTAMP()),
+	(265, 6258, '宇宙合成器', UNIX_TIMESTAMP()),
+	(872, 3868, '意識透過結晶', UNIX_TIMESTAMP()),
+	(739, 1737, '情報退行装置', UNIX_TIMESTAMP()),
+	(757, 1755, '時空連絡器', UNIX_TIMESTAMP()),
+	(679, 1677, '悪夢除去装置', UNIX_TIMESTAMP()),
+	(701, 1699, '神聖伝達書', UNIX_TIMESTAMP()),
+	(972, 2969, '知識結晶化装置', UNIX_TIMESTAMP()),
+	(395, 6388, '奇跡発生装置', UNIX_TIMESTAMP()),
+	(936, 5930, '懐中生命計', UNIX_TIMESTAMP()),
+	(431, 430, '無限再生器', UNIX_TIMESTAMP()),
+	(458, 5452, '魂記録記憶装置', UNIX_TIMESTAMP()),
+	(967, 6960, '直感増幅装置', UNIX_TIMESTAMP()),
+	(216, 5210, '時間逆転装置', UNIX_TIMESTAMP()),
+	(912, 6905, '擬似電子天体', UNIX_TIMESTAMP()),
+	(564, 4559, '空中構造体', UNIX_TIMESTAMP()),
+	(306, 7298, '観念認識曲線', UNIX_TIMESTAMP()),
+	(899, 4894, '量子動力液', UNIX_TIMESTAMP()),
+	(275, 4270, '遺伝情報渦', UNIX_TIMESTAMP()),
+	(404, 4399, '像通視装置', UNIX_TIMESTAMP()),
+	(836, 1834, '統合感応中心', UNIX_TIMESTAMP()),
+	(171, 7163, '奇妙反射素子', UNIX_TIMESTAMP()),
+	(590, 589, '全方向回転装置', UNIX_TIMESTAMP()),
+	(554, 6547, '不安定集合体', UNIX_TIMESTAMP()),
+	(948, 2945, '無界面界面', UNIX_TIMESTAMP()),
+	(479, 2476, '遺失物発見器', UNIX_TIMESTAMP()),
+	(727, 3723, '潜在視覚反応', UNIX_TIMESTAMP()),
+	(894, 893, '心理脳歩行', UNIX_TIMESTAMP()),
+	(312, 7304, '同時変位効果', UNIX_TIMESTAMP()),
+	(528, 1526, '虚空集束機', UNIX_TIMESTAMP()),
+	(608, 4603, '双子相関粒子', UNIX_TIMESTAMP()),
+	(468, 1466, '多重腫瘍解析', UNIX_TIMESTAMP()),
+	(363, 3359, '離散拡張器', UNIX_TIMESTAMP()),
+	(618, 4613, '統合間欠泉', UNIX_TIMESTAMP()),
+	(593, 4588, '電荷共鳴体', UNIX_TIMESTAMP()),
+	(706, 3702, '粒子濃度計', UNIX_TIMESTAMP()),
+	(956, 1954, '力量増幅器', UNIX_TIMESTAMP()),
+	(922, 2919, '誤散乱粒子', UNIX_TIMESTAMP()),
+	(531, 3527, '表層微生物', UNIX_TIMESTAMP()),
+	(591, 6584, '断面冷却材', UNIX_TIMESTAMP()),
+	(83, 1081, '合成酵素体', UNIX_TIMESTAMP()),
+	(995, 3991, '実寸測長度', UNIX_TIMESTAMP()),
+	(450, 2447, '傾斜放物線', UNIX_TIMESTAMP()),
+	(798, 3794, '振動質量体', UNIX_TIMESTAMP()),
+	(571, 6564, '鉱薬濃度計', UNIX_TIMESTAMP()),
+	(880, 6873, '縮退輻射線', UNIX_TIMESTAMP()),
+	(737, 3733, '亜空間物質', UNIX_TIMESTAMP()),
+	(208, 3204, '傾斜照度計', UNIX_TIMESTAMP()),
+	(511, 6504, '光電荷効果', UNIX_TIMESTAMP()),
+	(896, 6889, '衝撃放射線', UNIX_TIMESTAMP()),
+	(848, 847, '衝突融解合金', UNIX_TIMESTAMP()),
+	(93, 4088, '探針測力計', UNIX_TIMESTAMP()),
+	(136, 4131, '熱変換装置', UNIX_TIMESTAMP()),
+	(594, 3590, '相関誘導体', UNIX_TIMESTAMP()),
+	(497, 496, '磁気反発共鳴', UNIX_TIMESTAMP()),
+	(10, 7002, '光核反応炉', UNIX_TIMESTAMP()),
+	(704, 5698, '比抵抗材料', UNIX_TIMESTAMP()),
+	(10, 6003, '構造応答解析', UNIX_TIMESTAMP()),
+	(590, 3586, '急速冷却機', UNIX_TIMESTAMP()),
+	(648, 6641, '声波接続術', UNIX_TIMESTAMP()),
+	(769, 4764, '無限融合素子', UNIX_TIMESTAMP()),
+	(100, 1098, '知覚転送装置', UNIX_TIMESTAMP()),
+	(194, 1192, '心理逆誘術', UNIX_TIMESTAMP()),
+	(222, 2219, '時間断層装置', UNIX_TIMESTAMP()),
+	(402, 2399, '幻界網構築術', UNIX_TIMESTAMP()),
+	(593, 1591, '視覚遠隔操作装置', UNIX_TIMESTAMP()),
+	(28, 27, '形態解析器', UNIX_TIMESTAMP()),
+	(597, 5591, '記憶回復補助装置', UNIX_TIMESTAMP()),
+	(242, 2239, '疑念解読器', UNIX_TIMESTAMP()),
+	(614, 4609, '大地共振器', UNIX_TIMESTAMP()),
+	(488, 3484, '神秘力充填器', UNIX_TIMESTAMP()),
+	(413, 3409, '複合現実感', UNIX_TIMESTAMP()),
+	(842, 4837, '遺伝暗号解読', UNIX_TIMESTAMP()),
+	(316, 315, '視覚音響合成', UNIX_TIMESTAMP()),
+	(318, 5312, '恒星磁気力', UNIX_TIMESTAMP()),
+	(249, 3245, '心理直感指数', UNIX_TIMESTAMP()),
+	(689, 5683, '分子選択加速度', UNIX_TIMESTAMP()),
+	(718, 3714, '社会経済衝撃指数', UNIX_TIMESTAMP()),
+	(282, 1280, '量子融合反応', UNIX_TIMESTAMP()),
+	(160, 1158, '情報意味処理器', UNIX_TIMESTAMP()),
+	(174, 6167, '地球環境適応度', UNIX_TIMESTAMP()),
+	(421, 5415, '経済商品予測指数', UNIX_TIMESTAMP()),
+	(138, 1136, '物理粒子結合数', UNIX_TIMESTAMP()),
+	(473, 6466, '音響認識率', UNIX_TIMESTAMP()),
+	(538, 5532, '環境持続因子', UNIX_TIMESTAMP()),
+	(943, 6936, '社会通信抑制装置', UNIX_TIMESTAMP()),
+	(576, 5570, '統計的処理', UNIX_TIMESTAMP()),
+	(149, 3145, '脳神経軸索', UNIX_TIMESTAMP()),
+	(468, 4463, '骨吸収阻害剤', UNIX_TIMESTAMP()),
+	(908, 907, '子宮内膜症', UNIX_TIMESTAMP()),
+	(507, 2504, '胸腺上皮細胞', UNIX_TIMESTAMP()),
+	(534, 533, '胎盤早期剥離', UNIX_TIMESTAMP()),
+	(986, 3982, '横紋筋肉腫', UNIX_TIMESTAMP()),
+	(182, 2179, '門脈圧亢進', UNIX_TIMESTAMP()),
+	(108, 6101, '蒸気気体装置', UNIX_TIMESTAMP()),
+	(29, 5023, '高周波振動子', UNIX_TIMESTAMP()),
+	(71, 2068, '無機結晶菌糸体', UNIX_TIMESTAMP()),
+	(763, 2760, '拡散防壁素材', UNIX_TIMESTAMP()),
+	(253, 3249, '有機免疫賦活因子', UNIX_TIMESTAMP()),
+	(84, 4079, '振動磁場制御装置', UNIX_TIMESTAMP()),
+	(676, 1674, '陽離子質量分析計', UNIX_TIMESTAMP()),
+	(850, 849, '壁面燃焼補助装置', UNIX_TIMESTAMP()),
+	(955, 954, '多段化密度制御', UNIX_TIMESTAMP()),
+	(50, 3046, '超音速流体制御', UNIX_TIMESTAMP()),
+	(624, 3620, '固体結晶位相試験器', UNIX_TIMESTAMP()),
+	(574, 3570, '光電子散乱顕微鏡', UNIX_TIMESTAMP()),
+	(749, 1747, '暗黒伝導素子', UNIX_TIMESTAMP()),
+	(720, 6713, '電磁安定化', UNIX_TIMESTAMP()),
+	(967, 2964, '超伝導加速', UNIX_TIMESTAMP()),
+	(544, 4539, '核融合制御', UNIX_TIMESTAMP()),
+	(335, 4330, '反重力推進', UNIX_TIMESTAMP()),
+	(952, 4947, '分子構造図', UNIX_TIMESTAMP()),
+	(946, 5940, '無重力環境', UNIX_TIMESTAMP()),
+	(454, 1452, '無引力定理', UNIX_TIMESTAMP()),
+	(725, 5719, '水蒸気還元', UNIX_TIMESTAMP()),
+	(549, 6542, '電子虹彩処理', UNIX_TIMESTAMP()),
+	(93, 1091, '塵花自動売店', UNIX_TIMESTAMP()),
+	(112, 2109, '土壌圭位感覚', UNIX_TIMESTAMP()),
+	(712, 2709, '陽電子連星', UNIX_TIMESTAMP()),
+	(976, 5970, '発音反射曲', UNIX_TIMESTAMP()),
+	(594, 6587, '麻痺胞子虫', UNIX_TIMESTAMP()),
+	(79, 1077, '点風静音機', UNIX_TIMESTAMP()),
+	(877, 5871, '彩光結晶塔', UNIX_TIMESTAMP()),
+	(290, 7282, '螺旋微分子', UNIX_TIMESTAMP()),
+	(824, 1822, '遺伝子書斎', UNIX_TIMESTAMP()),
+	(335, 4330, '電荷変換器', UNIX_TIMESTAMP()),
+	(591, 3587, '磁気結晶構造', UNIX_TIMESTAMP()),
+	(658, 5652, '水素分配制御', UNIX_TIMESTAMP()),
+	(206, 1204, '地球変動気象', UNIX_TIMESTAMP()),
+	(544, 1542, '全反応分離', UNIX_TIMESTAMP()),
+	(383, 5377, '光響起電位', UNIX_TIMESTAMP()),
+	(493, 492, '疲労振動解析', UNIX_TIMESTAMP()),
+	(922, 6915, '伝達余裕率', UNIX_TIMESTAMP()),
+	(329, 7321, '蛍光毛管圧', UNIX_TIMESTAMP()),
+	(88, 6081, '開放腔共鳴', UNIX_TIMESTAMP()),
+	(934, 3930, '枝蔓生物種', UNIX_TIMESTAMP()),
+	(553, 552, '冷却燃焼合金', UNIX_TIMESTAMP()),
+	(568, 5562, '驚奇戦術解析', UNIX_TIMESTAMP()),
+	(980, 4975, '絶対音高感応', UNIX_TIMESTAMP()),
+	(293, 3289, '電磁騒音除去', UNIX_TIMESTAMP()),
+	(527, 526, '刻印跡復原', UNIX_TIMESTAMP()),
+	(718, 2715, '呼吸音頻度', UNIX_TIMESTAMP()),
+	(927, 5921, '宇宙消滅仮説', UNIX_TIMESTAMP()),
+	(789, 3785, '群衆動員策', UNIX_TIMESTAMP()),
+	(482, 4477, '外部強制磁場', UNIX_TIMESTAMP()),
+	(870, 6863, '色素燃焼光', UNIX_TIMESTAMP()),
+	(410, 5404, '三次元振動解析', UNIX_TIMESTAMP()),
+	(430, 4425, '光照合写真術', UNIX_TIMESTAMP()),
+	(65, 5059, '周波数振動絞首', UNIX_TIMESTAMP()),
+	(327, 1325, '縮退量子状態', UNIX_TIMESTAMP()),
+	(86, 2083, '自発分子共鳴', UNIX_TIMESTAMP()),
+	(61, 3057, '空気浸透圧調節', UNIX_TIMESTAMP()),
+	(816, 3812, '電気感応抵抗', UNIX_TIMESTAMP()),
+	(74, 2071, '熱核融合発電', UNIX_TIMESTAMP()),
+	(168, 2165, '小宇宙加速装置', UNIX_TIMESTAMP()),
+	(88, 7080, '抽象再帰表現', UNIX_TIMESTAMP()),
+	(113, 4108, '位相変調検出', UNIX_TIMESTAMP()),
+	(359, 3355, '分子生物構造', UNIX_TIMESTAMP()),
+	(951, 5945, '空中対馬絡遁曲', UNIX_TIMESTAMP()),
+	(839, 4834, '同期振盪器', UNIX_TIMESTAMP()),
+	(240, 6233, '光熱微量計算', UNIX_TIMESTAMP()),
+	(369, 4364, '体外循環装置', UNIX_TIMESTAMP()),
+	(174, 173, '散乱電子顕微鏡', UNIX_TIMESTAMP()),
+	(387, 2384, '午夜行超信', UNIX_TIMESTAMP()),
+	(716, 715, '講談化機構', UNIX_TIMESTAMP()),
+	(724, 1722, '類似場合推定', UNIX_TIMESTAMP()),
+	(983, 5977, '幾何換算率', UNIX_TIMESTAMP()),
+	(353, 352, '错分子络合物', UNIX_TIMESTAMP()),
+	(284, 7276, '時間凍結器', UNIX_TIMESTAMP()),
+	(135, 4130, '内骨芯装置', UNIX_TIMESTAMP()),
+	(224, 5218, '静電密度分析', UNIX_TIMESTAMP()),
+	(285, 1283, '斜磨橋値解析', UNIX_TIMESTAMP()),
+	(865, 864, '弾全集団制御', UNIX_TIMESTAMP()),
+	(182, 6175, '漸進震振測定', UNIX_TIMESTAMP()),
+	(162, 1160, '無界最適制御', UNIX_TIMESTAMP()),
+	(737, 6730, '熱電閾値選別', UNIX_TIMESTAMP()),
+	(448, 447, '環境共鳴振動', UNIX_TIMESTAMP()),
+	(918, 917, '融媒近似統計', UNIX_TIMESTAMP()),
+	(705, 5699, '加熱遮蔽温度', UNIX_TIMESTAMP()),
+	(855, 4850, '泰進合成抗酸化', UNIX_TIMESTAMP()),
+	(376, 5370, '光電質量分析', UNIX_TIMESTAMP()),
+	(70, 2067, '結重証伪逆行列', UNIX_TIMESTAMP()),
+	(344, 1342, '不均対称仮想回路', UNIX_TIMESTAMP()),
+	(232, 1230, '前置熱拡張出力', UNIX_TIMESTAMP()),
+	(396, 4391, '角化障壁検定', UNIX_TIMESTAMP()),
+	(421, 2418, '原材料交換能率', UNIX_TIMESTAMP()),
+	(317, 6310, '考慮存高値現', UNIX_TIMESTAMP()),
+	(403, 3399, '反覆縫合反転分析', UNIX_TIMESTAMP()),
+	(651, 2648, '弾塑罅変形解析', UNIX_TIMESTAMP()),
+	(656, 655, '暗黒浪漫術', UNIX_TIMESTAMP()),
+	(617, 6610, '神秘表現構造', UNIX_TIMESTAMP()),
+	(79, 78, '絶対心理現象', UNIX_TIMESTAMP()),
+	(153, 152, '超常心理振動', UNIX_TIMESTAMP()),
+	(50, 6043, '行動発現制御', UNIX_TIMESTAMP()),
+	(392, 6385, '多元次元構築', UNIX_TIMESTAMP()),
+	(61, 5055, '心的自己迷宮', UNIX_TIMESTAMP()),
+	(973, 3969, '進化共感現象', UNIX_TIMESTAMP()),
+	(403, 6396, '心象居住体', UNIX_TIMESTAMP()),
+	(147, 6140, '宇宙共通翻訳術', UNIX_TIMESTAMP()),
+	(423, 7415, '超分子情報光網', UNIX_TIMESTAMP()),
+	(482, 7474, '心理環境瀑布', UNIX_TIMESTAMP()),
+	(945, 1943, '無機思考生命体', UNIX_TIMESTAMP()),
+	(6, 6998, '空間時間共振膜', UNIX_TIMESTAMP()),
+	(678, 5672, '自己意識映像図鑑', UNIX_TIMESTAMP()),
+	(941, 5935, '超凡理解方程式', UNIX_TIMESTAMP()),
+	(643, 5637, '直感量子通信', UNIX_TIMESTAMP()),
+	(159, 3155, '錬金精神共鳴波', UNIX_TIMESTAMP()),
+	(345, 344, '心覚醒螺旋', UNIX_TIMESTAMP()),
+	(633, 4628, '超次元逆転思考', UNIX_TIMESTAMP()),
+	(495, 1493, '蛤蠣沙茶醬', UNIX_TIMESTAMP()),
+	(447, 7439, '烽火燒牛肉白飯', UNIX_TIMESTAMP()),
+	(332, 2329, '真菌污染裝備', UNIX_TIMESTAMP()),
+	(232, 3228, '節葉黃連花', UNIX_TIMESTAMP()),
+	(399, 7391, '時空転位装置', UNIX_TIMESTAMP()),
+	(219, 6212, '記憶遺伝子', UNIX_TIMESTAMP()),
+	(12, 11, '霊子充填機', UNIX_TIMESTAMP()),
+	(891, 3887, '重力干渉装置', UNIX_TIMESTAMP()),
+	(189, 4184, '光子算術回路', UNIX_TIMESTAMP()),
+	(915, 5909, '生体振動解析', UNIX_TIMESTAMP()),
+	(945, 1943, '暗黒磁力体', UNIX_TIMESTAMP()),
+	(375, 7367, '鏡像反応触媒', UNIX_TIMESTAMP()),
+	(107, 7099, '知覚拡張装置', UNIX_TIMESTAMP()),
+	(314, 3310, '励磁共振素子', UNIX_TIMESTAMP()),
+	(822, 2819, '脳震動波制御', UNIX_TIMESTAMP()),
+	(258, 5252, '無重力駆動装置', UNIX_TIMESTAMP()),
+	(349, 348, '非標準言語処理器', UNIX_TIMESTAMP()),
+	(858, 6851, '霊気探査術', UNIX_TIMESTAMP()),
+	(715, 714, '炉心焼付防止装置', UNIX_TIMESTAMP()),
+	(734, 1732, '心音解析機器', UNIX_TIMESTAMP()),
+	(191, 1189, '暗号記憶装置', UNIX_TIMESTAMP()),
+	(13, 7005, '睡眠復元装置', UNIX_TIMESTAMP()),
+	(322, 5316, '回旋光加速器', UNIX_TIMESTAMP()),
+	(457, 1455, '創造的現実推測機構', UNIX_TIMESTAMP()),
+	(745, 3741, '熱核分子修復装置', UNIX_TIMESTAMP()),
+	(91, 2088, '無重力物質生成装置', UNIX_TIMESTAMP()),
+	(441, 6434, '量子非干渉降圧装置', UNIX_TIMESTAMP()),
+	(741, 6734, '概念解放装置', UNIX_TIMESTAMP()),
+	(100, 4095, '視覚奇跡発生器', UNIX_TIMESTAMP()),
+	(716, 2713, '自己検知働換', UNIX_TIMESTAMP()),
+	(327, 1325, '地面総射量', UNIX_TIMESTAMP()),
+	(556, 2553, '共鳴周波数', UNIX_TIMESTAMP()),
+	(320, 1318, '生体細胞再編化', UNIX_TIMESTAMP()),
+	(394, 6387, '超音速粒子分光', UNIX_TIMESTAMP()),
+	(701, 6694, '音波透過装置', UNIX_TIMESTAMP()),
+	(677, 5671, '反重力共鳴装置', UNIX_TIMESTAMP()),
+	(688, 6681, '量子暗号通信', UNIX_TIMESTAMP()),
+	(342, 1340, '生体感度増幅装置', UNIX_TIMESTAMP()),
+	(458, 457, '時間加速装置', UNIX_TIMESTAMP()),
+	(585, 2582, '分子再編成装置', UNIX_TIMESTAMP()),
+	(223, 4218, '核融合医療', UNIX_TIMESTAMP()),
+	(94, 3090, '量子美術史', UNIX_TIMESTAMP()),
+	(58, 2055, '蒸気時代講座', UNIX_TIMESTAMP()),
+	(504, 4499, '天体観測装置', UNIX_TIMESTAMP()),
+	(394, 1392, '建築遺産研究', UNIX_TIMESTAMP()),
+	(34, 33, '古代遺跡調査', UNIX_TIMESTAMP()),
+	(65, 64, '音声信号処理', UNIX_TIMESTAMP()),
+	(843, 4838, '脳神経病理', UNIX_TIMESTAMP()),
+	(947, 946, '地質時代研究', UNIX_TIMESTAMP()),
+	(741, 6734, '社会調査統計', UNIX_TIMESTAMP()),
+	(54, 4049, '超音波治療', UNIX_TIMESTAMP()),
+	(445, 6438, '念視超感応力場', UNIX_TIMESTAMP()),
+	(171, 1169, '粒子連結共鳴効果', UNIX_TIMESTAMP()),
+	(296, 1294, '超重力光子共鳴中継', UNIX_TIMESTAMP()),
+	(750, 1748, '知覚情報交換行列', UNIX_TIMESTAMP()),
+	(163, 162, '空回転磁気共振装置', UNIX_TIMESTAMP()),
+	(224, 1222, '計算神経回路構築', UNIX_TIMESTAMP()),
+	(347, 7339, '溶液中分子運動解析', UNIX_TIMESTAMP()),
+	(511, 4506, '非常重要膜層検査', UNIX_TIMESTAMP()),
+	(905, 2902, '熱核増幅光線発射', UNIX_TIMESTAMP()),
+	(153, 2150, '統計意識波動関数', UNIX_TIMESTAMP()),
+	(623, 6616, '麗魔谷薔薇', UNIX_TIMESTAMP()),
+	(925, 1923, '情報共有化', UNIX_TIMESTAMP()),
+	(12, 4007, '精神支配舞台', UNIX_TIMESTAMP()),
+	(33, 2030, '予測不可変', UNIX_TIMESTAMP()),
+	(183, 3179, '集団抵抗係数', UNIX_TIMESTAMP()),
+	(40, 7032, '行動評価指標', UNIX_TIMESTAMP()),
+	(869, 1867, '潜在意識浮上', UNIX_TIMESTAMP()),
+	(843, 6836, '空間変身能力', UNIX_TIMESTAMP()),
+	(816, 5810, '時間操作装置', UNIX_TIMESTAMP()),
+	(289, 288, '無限次元暗号', UNIX_TIMESTAMP()),
+	(135, 4130, '原子分子分割装置', UNIX_TIMESTAMP()),
+	(957, 1955, '生体拡張装置', UNIX_TIMESTAMP()),
+	(574, 3570, '量子重力制御装置', UNIX_TIMESTAMP()),
+	(806, 2803, '時曲折点解析', UNIX_TIMESTAMP()),
+	(891, 3887, '超高速瞬間移動器', UNIX_TIMESTAMP()),
+	(222, 1220, '虚拟感知森林', UNIX_TIMESTAMP()),
+	(281, 3277, '遺伝子編集器', UNIX_TIMESTAMP()),
+	(481, 2478, '錬金術反応炉', UNIX_TIMESTAMP()),
+	(276, 6269, '天界亀甲装甲', UNIX_TIMESTAMP()),
+	(527, 526, '知識転送結晶', UNIX_TIMESTAMP()),
+	(707, 706, '超感覚映像合成器', UNIX_TIMESTAMP()),
+	(306, 6299, '情報防波堤', UNIX_TIMESTAMP()),
+	(167, 5161, '無制限多言語処理', UNIX_TIMESTAMP()),
+	(84, 6077, '宇宙太陽光発電', UNIX_TIMESTAMP()),
+	(934, 933, '高速潜水自動車', UNIX_TIMESTAMP()),
+	(10, 5004, '重力逆転装置', UNIX_TIMESTAMP()),
+	(707, 6700, '高度自己最適化', UNIX_TIMESTAMP()),
+	(983, 982, '精神融合術', UNIX_TIMESTAMP()),
+	(145, 144, '無重力装置', UNIX_TIMESTAMP()),
+	(465, 4460, '時空間連続器', UNIX_TIMESTAMP()),
+	(627, 4622, '疑似共鳴素子', UNIX_TIMESTAMP()),
+	(592, 5586, '超角度投影装置', UNIX_TIMESTAMP()),
+	(322, 1320, '遺伝操作因子', UNIX_TIMESTAMP()),
+	(554, 4549, '暗示通信装置', UNIX_TIMESTAMP()),
+	(127, 7119, '量子情報圧縮器', UNIX_TIMESTAMP()),
+	(270, 269, '非接触式分子分解機', UNIX_TIMESTAMP()),
+	(591, 590, '暗黒黙示録', UNIX_TIMESTAMP()),
+	(108, 7100, '蒲公英形指標', UNIX_TIMESTAMP()),
+	(147, 3143, '応用珠心算', UNIX_TIMESTAMP()),
+	(49, 3045, '無序射频通信', UNIX_TIMESTAMP()),
+	(165, 3161, '生体補聴器', UNIX_TIMESTAMP()),
+	(514, 6507, '高密度磁気記録', UNIX_TIMESTAMP()),
+	(252, 5246, '誤差拡散解析', UNIX_TIMESTAMP()),
+	(730, 5724, '手動微粒子分離装置', UNIX_TIMESTAMP()),
+	(214, 5208, '意思集中化指標', UNIX_TIMESTAMP()),
+	(77, 5071, '高速相互接続器', UNIX_TIMESTAMP()),
+	(583, 5577, '力場振動抵抗剤', UNIX_TIMESTAMP()),
+	(905, 5899, '磁気応答曲線', UNIX_TIMESTAMP()),
+	(856, 6849, '空間配合抑制装置', UNIX_TIMESTAMP()),
+	(309, 3305, '緊急過負荷放電回路', UNIX_TIMESTAMP()),
+	(479, 6472, '非線形多変量解析器', UNIX_TIMESTAMP()),
+	(205, 7197, '経済均衡因子', UNIX_TIMESTAMP()),
+	(294, 5288, '知覚退化抑制装置', UNIX_TIMESTAMP()),
+	(965, 3961, '比重定量闘志指数', UNIX_TIMESTAMP()),
+	(315, 6308, '筐体段階微視腺', UNIX_TIMESTAMP()),
+	(751, 4746, '微生物感染調整装置', UNIX_TIMESTAMP()),
+	(223, 4218, '高効率動力増信装置', UNIX_TIMESTAMP()),
+	(556, 2553, '希瑞拉策略', UNIX_TIMESTAMP()),
+	(474, 3470, '鳥海渦輪機', UNIX_TIMESTAMP()),
+	(120, 5114, '煙花光譜學', UNIX_TIMESTAMP()),
+	(72, 6065, '核心欠損症', UNIX_TIMESTAMP()),
+	(252, 2249, '荒濤薩摩亞', UNIX_TIMESTAMP()),
+	(272, 5266, '仮想物理環境生成器', UNIX_TIMESTAMP()),
+	(766, 6759, '粒子加速撹乱装置', UNIX_TIMESTAMP()),
+	(686, 4681, '真空超伝導冷却材料', UNIX_TIMESTAMP()),
+	(577, 6570, '種晶電漿濃度', UNIX_TIMESTAMP()),
+	(483, 7475, '眠剤処理装置', UNIX_TIMESTAMP()),
+	(20, 5014, '無音加算器', UNIX_TIMESTAMP()),
+	(694, 1692, '液晶感染装置', UNIX_TIMESTAMP()),
+	(335, 6328, '光子付属器', UNIX_TIMESTAMP()),
+	(843, 5837, '形態反転膜', UNIX_TIMESTAMP()),
+	(191, 190, '清潔炭素剤', UNIX_TIMESTAMP()),
+	(609, 2606, '音響境界器', UNIX_TIMESTAMP()),
+	(244, 243, '非対称制御回路', UNIX_TIMESTAMP()),
+	(248, 1246, '蒸発冷却材', UNIX_TIMESTAMP()),
+	(600, 2597, '逆誘導計測器', UNIX_TIMESTAMP()),
+	(496, 5490, '神奈備麻離', UNIX_TIMESTAMP()),
+	(500, 499, '布拉什诺实验', UNIX_TIMESTAMP()),
+	(57, 2054, '新陳代謝培養', UNIX_TIMESTAMP()),
+	(292, 2289, '霧化器修理', UNIX_TIMESTAMP()),
+	(882, 1880, '星座占星術', UNIX_TIMESTAMP()),
+	(91, 3087, '原子同位体', UNIX_TIMESTAMP()),
+	(924, 1922, '生体最適化', UNIX_TIMESTAMP()),
+	(221, 2218, '超領域分析', UNIX_TIMESTAMP()),
+	(404, 7396, '分子不均質', UNIX_TIMESTAMP()),
+	(898, 2895, '場相互作用', UNIX_TIMESTAMP()),
+	(323, 4318, '構造反転層', UNIX_TIMESTAMP()),
+	(21, 6014, '念動瞬間移動', UNIX_TIMESTAMP()),
+	(992, 6985, '電子軌道配置', UNIX_TIMESTAMP()),
+	(277, 2274, '超音速進化', UNIX_TIMESTAMP()),
+	(58, 57, '磁場逆転機構', UNIX_TIMESTAMP()),
+	(286, 285, '分子遺伝暗号', UNIX_TIMESTAMP()),
+	(357, 4352, '生体電位調節', UNIX_TIMESTAMP()),
+	(28, 1026, '進化情報積層', UNIX_TIMESTAMP()),
+	(942, 1940, '鉱物相変化', UNIX_TIMESTAMP()),
+	(120, 6113, '量子復元力場', UNIX_TIMESTAMP()),
+	(469, 468, '高度干渉解析', UNIX_TIMESTAMP()),
+	(801, 4796, '宇宙放射線透過', UNIX_TIMESTAMP()),
+	(181, 180, '生体発光反応', UNIX_TIMESTAMP()),
+	(362, 2359, '心膜使用症候群', UNIX_TIMESTAMP()),
+	(80, 79, '微生物液面効果', UNIX_TIMESTAMP()),
+	(454, 7446, '多元混合結晶構造', UNIX_TIMESTAMP()),
+	(469, 7461, '視床土星野体', UNIX_TIMESTAMP()),
+	(214, 4209, '高密度酸体光源', UNIX_TIMESTAMP()),
+	(598, 1596, '運動連鎖反応', UNIX_TIMESTAMP()),
+	(620, 6613, '仮想創意造形', UNIX_TIMESTAMP()),
+	(352, 5346, '翼頭溶接機器', UNIX_TIMESTAMP()),
+	(583, 4578, '音響調査探査器', UNIX_TIMESTAMP()),
+	(749, 4744, '排出蓄積資源', UNIX_TIMESTAMP()),
+	(120, 3116, '潜在生物能力', UNIX_TIMESTAMP()),
+	(731, 6724, '連携定着控除', UNIX_TIMESTAMP()),
+	(649, 2646, '表在花粉症病因', UNIX_TIMESTAMP()),
+	(523, 4518, '肺気腫評価指標', UNIX_TIMESTAMP()),
+	(77, 5071, '圧電自己清掃機能', UNIX_TIMESTAMP()),
+	(498, 2495, '社会再構築', UNIX_TIMESTAMP()),
+	(208, 6201, '多方向合情報', UNIX_TIMESTAMP()),
+	(555, 3551, '古代転送門', UNIX_TIMESTAMP()),
+	(411, 4406, '音波強力化装置', UNIX_TIMESTAMP()),
+	(427, 5421, '量子思念探査器', UNIX_TIMESTAMP()),
+	(235, 4230, '地磁気逆転装置', UNIX_TIMESTAMP()),
+	(717, 1715, '生体共鳴装置', UNIX_TIMESTAMP()),
+	(532, 6525, '時空転移装置', UNIX_TIMESTAMP()),
+	(493, 6486, '神聖核融合炉', UNIX_TIMESTAMP()),
+	(90, 2087, '無限次元折畳機', UNIX_TIMESTAMP()),
+	(759, 6752, '超音速振動増幅器', UNIX_TIMESTAMP()),
+	(997, 6990, '心灵内偷望器', UNIX_TIMESTAMP()),
+	(968, 3964, '紡幻操演装置', UNIX_TIMESTAMP()),
+	(469, 1467, '共鳴精神感応器', UNIX_TIMESTAMP()),
+	(989, 6982, '伏魔遁走装置', UNIX_TIMESTAMP()),
+	(613, 2610, '時空転移装置', UNIX_TIMESTAMP()),
+	(121, 2118, '超重力生成器', UNIX_TIMESTAMP()),
+	(954, 2951, '氷晶化装置', UNIX_TIMESTAMP()),
+	(214, 1212, '磁気安定装置', UNIX_TIMESTAMP()),
+	(356, 4351, '生体再生装置', UNIX_TIMESTAMP()),
+	(520, 3516, '精神感応装置', UNIX_TIMESTAMP()),
+	(204, 1202, '高周波共鳴装置', UNIX_TIMESTAMP()),
+	(225, 4220, '水中酸素供給装置', UNIX_TIMESTAMP()),
+	(604, 603, '暗黒物質分析装置', UNIX_TIMESTAMP()),
+	(750, 1748, '液体金属冷却装置', UNIX_TIMESTAMP()),
+	(123, 4118, '念動力発電装置', UNIX_TIMESTAMP()),
+	(881, 880, '超高速超音波兵器', UNIX_TIMESTAMP()),
+	(321, 6314, '飛鳥積層鉱脈', UNIX_TIMESTAMP()),
+	(779, 2776, '瑠璃釉融連銭', UNIX_TIMESTAMP()),
+	(924, 4919, '至尊霊菌袋', UNIX_TIMESTAMP()),
+	(404, 403, '蒼炎剣装備', UNIX_TIMESTAMP()),
+	(450, 6443, '黄金葬殮船', UNIX_TIMESTAMP()),
+	(379, 7371, '紫苑射鳶術', UNIX_TIMESTAMP()),
+	(955, 4950, '蒼曜光電池', UNIX_TIMESTAMP()),
+	(993, 5987, '瑠璃惑星環', UNIX_TIMESTAMP()),
+	(101, 100, '河童竜蛇鏡', UNIX_TIMESTAMP()),
+	(918, 5912, '極彩麗雅壺', UNIX_TIMESTAMP()),
+	(213, 3209, '瑠璃弦琴戦', UNIX_TIMESTAMP()),
+	(430, 5424, '雅楽幻術師', UNIX_TIMESTAMP()),
+	(484, 2481, '蒼穹創聖符', UNIX_TIMESTAMP()),
+	(507, 506, '至高星霊界', UNIX_TIMESTAMP()),
+	(596, 595, '朱雀炎凰軍', UNIX_TIMESTAMP()),
+	(130, 3126, '璃玉明露盞', UNIX_TIMESTAMP()),
+	(370, 5364, '霜藍蒼原薬', UNIX_TIMESTAMP()),
+	(950, 4945, '紫電流鏡水', UNIX_TIMESTAMP()),
+	(431, 6424, '超音波検査', UNIX_TIMESTAMP()),
+	(357, 7349, '翔闘強化装', UNIX_TIMESTAMP()),
+	(372, 5366, '空間凝集子', UNIX_TIMESTAMP()),
+	(710, 6703, '心理干渉体', UNIX_TIMESTAMP()),
+	(526, 4521, '反応背面反転', UNIX_TIMESTAMP()),
+	(100, 3096, '無指導統制素子', UNIX_TIMESTAMP()),
+	(513, 5507, '超常結晶塔', UNIX_TIMESTAMP()),
+	(60, 4055, '断層賦活剤', UNIX_TIMESTAMP()),
+	(675, 5669, '樹脈再生装置', UNIX_TIMESTAMP()),
+	(893, 4888, '信念変調器', UNIX_TIMESTAMP()),
+	(299, 7291, '触媒増感因子', UNIX_TIMESTAMP()),
+	(64, 2061, '魔力刺激核', UNIX_TIMESTAMP()),
+	(903, 1901, '潜在連結素子', UNIX_TIMESTAMP()),
+	(359, 1357, '共鳴振動波', UNIX_TIMESTAMP()),
+	(394, 393, '量子瞬間干渉子', UNIX_TIMESTAMP()),
+	(418, 7410, '競泳風速計', UNIX_TIMESTAMP()),
+	(287, 6280, '心理振動検知器', UNIX_TIMESTAMP()),
+	(466, 2463, '熱帯象背珠', UNIX_TIMESTAMP()),
+	(870, 6863, '生体熱発光撮像装置', UNIX_TIMESTAMP()),
+	(448, 4443, '砂漠風力発電機', UNIX_TIMESTAMP()),
+	(880, 4875, '深宇宙暗黒物質検出器', UNIX_TIMESTAMP()),
+	(119, 6112, '森林高度直接測定器', UNIX_TIMESTAMP()),
+	(844, 843, '視覚認知高速分類器', UNIX_TIMESTAMP()),
+	(118, 5112, '反重力転換反射装置', UNIX_TIMESTAMP()),
+	(407, 1405, '小児診断支援機器', UNIX_TIMESTAMP()),
+	(440, 3436, '医療用高解像度撮影装置', UNIX_TIMESTAMP()),
+	(654, 1652, '組織透明化', UNIX_TIMESTAMP()),
+	(802, 4797, '肖像画研究', UNIX_TIMESTAMP()),
+	(344, 6337, '最適解探索', UNIX_TIMESTAMP()),
+	(293, 5287, '経済情報管理', UNIX_TIMESTAMP()),
+	(715, 3711, '治療適正化', UNIX_TIMESTAMP()),
+	(4, 5997, '空間環境評価', UNIX_TIMESTAMP()),
+	(381, 4376, '資産評価指標', UNIX_TIMESTAMP()),
+	(367, 5361, '健康予防指南', UNIX_TIMESTAMP()),
+	(34, 4029, '社会調査分析', UNIX_TIMESTAMP()),
+	(575, 574, '環境保全政策', UNIX_TIMESTAMP()),
+	(442, 3438, '安全対策基準', UNIX_TIMESTAMP()),
+	(892, 3888, '市場競争力指標', UNIX_TIMESTAMP()),
+	(474, 1472, '製品品質管理', UNIX_TIMESTAMP()),
+	(575, 3571, '運行効率化施策', UNIX_TIMESTAMP()),
+	(172, 4167, '健康診断結果分析', UNIX_TIMESTAMP()),
+	(772, 1770, '地震防災対策', UNIX_TIMESTAMP()),
+	(609, 5603, '紺碧石転写', UNIX_TIMESTAMP()),
+	(738, 3734, '竜眼石柘榴', UNIX_TIMESTAMP()),
+	(821, 2818, '遠古樹化石', UNIX_TIMESTAMP()),
+	(641, 6634, '無機結晶体', UNIX_TIMESTAMP()),
+	(693, 5687, '電子映像素', UNIX_TIMESTAMP()),
+	(16, 15, '無機原子炉', UNIX_TIMESTAMP()),
+	(966, 965, '無限循環器', UNIX_TIMESTAMP()),
+	(866, 4861, '悪意位相場', UNIX_TIMESTAMP()),
+	(31, 30, '星間連結装置', UNIX_TIMESTAMP()),
+	(620, 3616, '精神拡張装置', UNIX_TIMESTAMP()),
+	(900, 2897, '心理影響器', UNIX_TIMESTAMP()),
+	(824, 6817, '時空補完装置', UNIX_TIMESTAMP()),
+	(593, 1591, '量子繋鍵窓', UNIX_TIMESTAMP()),
+	(263, 6256, '観察抑制装置', UNIX_TIMESTAMP()),
+	(786, 6779, '無重非極固定体', UNIX_TIMESTAMP()),
+	(213, 7205, '磁場音形律', UNIX_TIMESTAMP()),
+	(488, 2485, '光線干渉体', UNIX_TIMESTAMP()),
+	(327, 2324, '翻転感応子', UNIX_TIMESTAMP()),
+	(284, 2281, '透明感光器', UNIX_TIMESTAMP()),
+	(555, 4550, '遺伝情報解析', UNIX_TIMESTAMP()),
+	(497, 6490, '非線形装置', UNIX_TIMESTAMP()),
+	(254, 7246, '絶対創造主', UNIX_TIMESTAMP()),
+	(202, 7194, '無限連鎖反応', UNIX_TIMESTAMP()),
+	(859, 3855, '超古代語解読', UNIX_TIMESTAMP()),
+	(923, 1921, '念動力増幅', UNIX_TIMESTAMP()),
+	(991, 5985, '筋骨格随行', UNIX_TIMESTAMP()),
+	(812, 3808, '眼網膜蛍光', UNIX_TIMESTAMP()),
+	(730, 3726, '高栄養燃料', UNIX_TIMESTAMP()),
+	(140, 7132, '表面粗糙度', UNIX_TIMESTAMP()),
+	(776, 4771, '電気抵抗率', UNIX_TIMESTAMP()),
+	(552, 551, '脳神経連携', UNIX_TIMESTAMP()),
+	(9, 8, '核磁気共鳴', UNIX_TIMESTAMP()),
+	(52, 3048, '分子影響力', UNIX_TIMESTAMP()),
+	(560, 6553, '交感神経刺激', UNIX_TIMESTAMP()),
+	(636, 3632, '歩行動態解析', UNIX_TIMESTAMP()),
+	(223, 5217, '心神喪失術', UNIX_TIMESTAMP()),
+	(81, 1079, '神智転移装置', UNIX_TIMESTAMP()),
+	(178, 5172, '量子重力暗号', UNIX_TIMESTAMP()),
+	(68, 7060, '精神汽化器', UNIX_TIMESTAMP()),
+	(290, 6283, '時空共鳴波', UNIX_TIMESTAMP()),
+	(404, 403, '霊感増幅機', UNIX_TIMESTAMP()),
+	(552, 2549, '幻視方程式', UNIX_TIMESTAMP()),
+	(936, 1934, '超音波感知応答器', UNIX_TIMESTAMP()),
+	(594, 593, '意識回転装置', UNIX_TIMESTAMP()),
+	(178, 4173, '霊気磁場制御装置', UNIX_TIMESTAMP()),
+	(859, 5853, '伽藍波変換器', UNIX_TIMESTAMP()),
+	(474, 473, '角速度秩序', UNIX_TIMESTAMP()),
+	(659, 5653, '軍群固定構造', UNIX_TIMESTAMP()),
+	(803, 3799, '柔術六十二手', UNIX_TIMESTAMP()),
+	(768, 1766, '水晶霧補正', UNIX_TIMESTAMP()),
+	(234, 3230, '転葉伝達電容', UNIX_TIMESTAMP()),
+	(18, 5012, '超音速磁気制御', UNIX_TIMESTAMP()),
+	(981, 980, '地曳倍効果', UNIX_TIMESTAMP()),
+	(222, 2219, '隠蓋自己回復繊維', UNIX_TIMESTAMP()),
+	(733, 732, '衝動波十二重障壁', UNIX_TIMESTAMP()),
+	(279, 6272, '量子振動吸収機能', UNIX_TIMESTAMP()),
+	(281, 7273, '意思十字回路', UNIX_TIMESTAMP()),
+	(303, 2300, '指尖共鳴光波', UNIX_TIMESTAMP()),
+	(66, 6059, '集光分散透明層', UNIX_TIMESTAMP()),
+	(618, 1616, '双葉細胞発光反応', UNIX_TIMESTAMP()),
+	(4, 5997, '無重力抵活化剤', UNIX_TIMESTAMP()),
+	(526, 5520, '実体化銘板', UNIX_TIMESTAMP()),
+	(642, 4637, '強化瓦斯遮断機能', UNIX_TIMESTAMP()),
+	(341, 7333, '意識抽出装置', UNIX_TIMESTAMP()),
+	(874, 1872, '反力自動拡散防止化合物', UNIX_TIMESTAMP()),
+	(835, 2832, '力場同調遮蔽体', UNIX_TIMESTAMP()),
+	(875, 5869, '漸進的無限回帰検定', UNIX_TIMESTAMP()),
+	(213, 7205, '生体構造適応最適化', UNIX_TIMESTAMP()),
+	(382, 381, '素粒子検知器', UNIX_TIMESTAMP()),
+	(649, 4644, '知覚量子化', UNIX_TIMESTAMP()),
+	(874, 2871, '調和共鳴装置', UNIX_TIMESTAMP()),
+	(911, 1909, '音響画像処理', UNIX_TIMESTAMP()),
+	(282, 6275, '生物情報解析', UNIX_TIMESTAMP()),
+	(133, 1131, '熱核変換炉', UNIX_TIMESTAMP()),
+	(262, 3258, '電磁場制御装置', UNIX_TIMESTAMP()),
+	(85, 5079, '水晶磁気共鳴', UNIX_TIMESTAMP()),
+	(49, 7041, '超音波破砕', UNIX_TIMESTAMP()),
+	(423, 7415, '高温燃焼基板', UNIX_TIMESTAMP()),
+	(136, 4131, '位相加速器', UNIX_TIMESTAMP()),
+	(259, 7251, '相転移触媒', UNIX_TIMESTAMP()),
+	(987, 3983, '膨張半導体', UNIX_TIMESTAMP()),
+	(189, 2186, '環境調律装置', UNIX_TIMESTAMP()),
+	(978, 977, '熱変換素子', UNIX_TIMESTAMP()),
+	(813, 5807, '生体情報解析器', UNIX_TIMESTAMP()),
+	(682, 4677, '複合微粒子', UNIX_TIMESTAMP()),
+	(219, 218, '植物病理學', UNIX_TIMESTAMP()),
+	(989, 3985, '超音速航空機', UNIX_TIMESTAMP()),
+	(294, 1292, '空中修復装置', UNIX_TIMESTAMP()),
+	(727, 3723, '磁気共鳴治療装置', UNIX_TIMESTAMP()),
+	(645, 644, '光波検出器', UNIX_TIMESTAMP()),
+	(630, 6623, '微重力緩和装置', UNIX_TIMESTAMP()),
+	(438, 6431, '量子暗号通信', UNIX_TIMESTAMP()),
+	(882, 3878, '波動格子生成装置', UNIX_TIMESTAMP()),
+	(495, 5489, '非接触熱画像診断', UNIX_TIMESTAMP()),
+	(368, 6361, '生体再生医療装置', UNIX_TIMESTAMP()),
+	(889, 6882, '精密時間同期装置', UNIX_TIMESTAMP()),
+	(987, 1985, '反重力浮遊加速器', UNIX_TIMESTAMP()),
+	(421, 420, '積層共鳴構造', UNIX_TIMESTAMP()),
+	(284, 1282, '心霊反応場', UNIX_TIMESTAMP()),
+	(945, 944, '超音速風洞', UNIX_TIMESTAMP()),
+	(98, 3094, '熱線共鳴推力', UNIX_TIMESTAMP()),
+	(245, 4240, '圧電反応体', UNIX_TIMESTAMP()),
+	(59, 6052, '分子骨格分析', UNIX_TIMESTAMP()),
+	(134, 7126, '超常現象測定装置', UNIX_TIMESTAMP()),
+	(905, 1903, '超臨界液体共鳴装置', UNIX_TIMESTAMP()),
+	(87, 86, '微細重力環境実験装置', UNIX_TIMESTAMP()),
+	(713, 1711, '超音速粒子加速装置', UNIX_TIMESTAMP()),
+	(285, 3281, '位相転位制御装置', UNIX_TIMESTAMP()),
+	(256, 1254, '磁気軌道航行推進方式', UNIX_TIMESTAMP()),
+	(115, 3111, '暗黒反転装置', UNIX_TIMESTAMP()),
+	(558, 5552, '植物情報処理', UNIX_TIMESTAMP()),
+	(747, 4742, '生理花形成', UNIX_TIMESTAMP()),
+	(714, 3710, '葉緑素復元', UNIX_TIMESTAMP()),
+	(61, 6054, '種子発芽抑制', UNIX_TIMESTAMP()),
+	(374, 373, '果実品質評価', UNIX_TIMESTAMP()),
+	(752, 6745, '組織胚培養', UNIX_TIMESTAMP()),
+	(851, 3847, '生理赤色反応', UNIX_TIMESTAMP()),
+	(701, 2698, '節回帰変奏', UNIX_TIMESTAMP()),
+	(958, 3954, '音楽直交変換', UNIX_TIMESTAMP()),
+	(415, 5409, '無重力環境', UNIX_TIMESTAMP()),
+	(313, 6306, '深宇宙航行', UNIX_TIMESTAMP()),
+	(865, 4860, '宇宙繊維強化材料', UNIX_TIMESTAMP()),
+	(33, 5027, '衝突軌道転換', UNIX_TIMESTAMP()),
+	(300, 3296, '天体回転力場', UNIX_TIMESTAMP()),
+	(207, 3203, '筋肉疲労評価', UNIX_TIMESTAMP()),
+	(454, 7446, '社会動物行動', UNIX_TIMESTAMP()),
+	(7, 6999, '繁殖成功指標', UNIX_TIMESTAMP()),
+	(876, 4871, '嗅覚遊離体実験', UNIX_TIMESTAMP()),
+	(991, 990, '食物取得行動', UNIX_TIMESTAMP()),
+	(514, 5508, '鳥群知覚系', UNIX_TIMESTAMP()),
+	(333, 2330, '建築都市計画', UNIX_TIMESTAMP()),
+	(668, 2665, '建築材料再生利用', UNIX_TIMESTAMP()),
+	(707, 5701, '立体都市計画', UNIX_TIMESTAMP()),
+	(990, 6983, '意識自動化', UNIX_TIMESTAMP()),
+	(181, 2178, '感情社会化', UNIX_TIMESTAMP()),
+	(239, 238, '部分固着心理', UNIX_TIMESTAMP()),
+	(48, 47, '自己対話分析', UNIX_TIMESTAMP()),
+	(990, 1988, '高分子触媒', UNIX_TIMESTAMP()),
+	(416, 415, '分子模倣計算', UNIX_TIMESTAMP()),
+	(535, 3531, '建築照明設計', UNIX_TIMESTAMP()),
+	(23, 2020, '舞台美術装置', UNIX_TIMESTAMP()),
+	(727, 2724, '文化遺産保護', UNIX_TIMESTAMP()),
+	(562, 2559, '民事手続分析', UNIX_TIMESTAMP()),
+	(800, 3796, '邪移動装置', UNIX_TIMESTAMP()),
+	(440, 4435, '反重力装置', UNIX_TIMESTAMP()),
+	(674, 2671, '神経再配線', UNIX_TIMESTAMP()),
+	(621, 6614, '量子干渉計', UNIX_TIMESTAMP()),
+	(354, 7346, '時空転移機', UNIX_TIMESTAMP()),
+	(382, 381, '形態動態分析', UNIX_TIMESTAMP()),
+	(473, 2470, '疑似時間軸', UNIX_TIMESTAMP()),
+	(973, 972, '高密度情報媒体', UNIX_TIMESTAMP()),
+	(499, 2496, '分子再構築器', UNIX_TIMESTAMP()),
+	(684, 3680, '心理創造力', UNIX_TIMESTAMP()),
+	(191, 6184, '遺伝子交換装置', UNIX_TIMESTAMP()),
+	(382, 1380, '平衡共鳴器', UNIX_TIMESTAMP()),
+	(416, 5410, '感情記録装置', UNIX_TIMESTAMP()),
+	(94, 2091, '生体計測装置', UNIX_TIMESTAMP()),
+	(990, 5984, '空気浄化装置', UNIX_TIMESTAMP()),
+	(150, 5144, '音楽合成器', UNIX_TIMESTAMP()),
+	(205, 6198, '心理影響装置', UNIX_TIMESTAMP()),
+	(920, 919, '燃料増感器', UNIX_TIMESTAMP()),
+	(415, 1413, '心肺蘇生術', UNIX_TIMESTAMP()),
+	(418, 6411, '音霊波装置', UNIX_TIMESTAMP()),
+	(420, 3416, '星間移民計画', UNIX_TIMESTAMP()),
+	(363, 3359, '時空転送装置', UNIX_TIMESTAMP()),
+	(614, 613, '音響振動治療', UNIX_TIMESTAMP()),
+	(575, 5569, '細胞再生研究', UNIX_TIMESTAMP()),
+	(484, 7476, '仮想現実体験装置', UNIX_TIMESTAMP()),
+	(882, 1880, '植物遺伝子研究', UNIX_TIMESTAMP()),
+	(202, 7194, '星炎検知装置', UNIX_TIMESTAMP()),
+	(262, 6255, '分子反応加速器', UNIX_TIMESTAMP()),
+	(400, 5394, '脳内和音解析', UNIX_TIMESTAMP()),
+	(621, 5615, '感情補完装置', UNIX_TIMESTAMP()),
+	(864, 4859, '時間流動可視化装置', UNIX_TIMESTAMP()),
+	(120, 1118, '環境適応指数', UNIX_TIMESTAMP()),
+	(454, 2451, '複素空間微分方程式', UNIX_TIMESTAMP()),
+	(549, 5543, '物質変換機構分析', UNIX_TIMESTAMP()),
+	(497, 1495, '構造的象徴解釈', UNIX_TIMESTAMP()),
+	(812, 1810, '宇宙航行機', UNIX_TIMESTAMP()),
+	(116, 6109, '分子複合体', UNIX_TIMESTAMP()),
+	(567, 5561, '生体情報処理', UNIX_TIMESTAMP()),
+	(693, 692, '量子磁気共鳴', UNIX_TIMESTAMP()),
+	(501, 500, '情報遺伝子', UNIX_TIMESTAMP()),
+	(401, 5395, '超高速光通信', UNIX_TIMESTAMP()),
+	(150, 4145, '離子加速器', UNIX_TIMESTAMP()),
+	(443, 442, '海洋生態系', UNIX_TIMESTAMP()),
+	(809, 808, '重力偏差計', UNIX_TIMESTAMP()),
+	(845, 6838, '新素材開発', UNIX_TIMESTAMP()),
+	(833, 2830, '磁気浮上車両', UNIX_TIMESTAMP()),
+	(193, 7185, '超伝導発電機', UNIX_TIMESTAMP()),
+	(353, 5347, '魔道具再生', UNIX_TIMESTAMP()),
+	(846, 845, '念動力制御', UNIX_TIMESTAMP()),
+	(551, 4546, '心理解析装置', UNIX_TIMESTAMP()),
+	(202, 5196, '陽電子融合', UNIX_TIMESTAMP()),
+	(615, 1613, '曼荼羅構築', UNIX_TIMESTAMP()),
+	(457, 456, '時間逆転装置', UNIX_TIMESTAMP()),
+	(255, 5249, '無重力輸送', UNIX_TIMESTAMP()),
+	(762, 761, '多元共振機構', UNIX_TIMESTAMP()),
+	(246, 6239, '超解析思考', UNIX_TIMESTAMP()),
+	(77, 2074, '空間透視投影', UNIX_TIMESTAMP()),
+	(475, 7467, '力場増幅装置', UNIX_TIMESTAMP()),
+	(809, 5803, '精緻鍛錬術', UNIX_TIMESTAMP()),
+	(363, 4358, '混疑成形術', UNIX_TIMESTAMP()),
+	(68, 7060, '悠久微光術', UNIX_TIMESTAMP()),
+	(840, 4835, '星屑収集術', UNIX_TIMESTAMP()),
+	(692, 4687, '奪魂降臨術', UNIX_TIMESTAMP()),
+	(356, 5350, '千果祝詞術', UNIX_TIMESTAMP()),
+	(133, 6126, '湧泉霊蘇術', UNIX_TIMESTAMP()),
+	(788, 787, '連命映現術', UNIX_TIMESTAMP()),
+	(281, 3277, '百鳥波導術', UNIX_TIMESTAMP()),
+	(520, 3516, '虚空驚嘆術', UNIX_TIMESTAMP()),
+	(824, 2821, '水晶視力検査', UNIX_TIMESTAMP()),
+	(85, 5079, '熾天滅魔術', UNIX_TIMESTAMP()),
+	(619, 4614, '月刃凄裂術', UNIX_TIMESTAMP()),
+	(525, 4520, '震動墓標鋳片', UNIX_TIMESTAMP()),
+	(461, 1459, '超音波繊維拡散体', UNIX_TIMESTAMP()),
+	(274, 7266, '衝撃溶岩凝結合金', UNIX_TIMESTAMP()),
+	(716, 1714, '磁気共鳴渦漩粒子', UNIX_TIMESTAMP()),
+	(88, 6081, '胞子遺伝子組換材料', UNIX_TIMESTAMP()),
+	(214, 5208, '光波変位制御機構', UNIX_TIMESTAMP()),
+	(748, 3744, '放射線透明絶縁体', UNIX_TIMESTAMP()),
+	(953, 2950, '逆常重力安定格子', UNIX_TIMESTAMP()),
+	(454, 453, '原子再結合加速装置', UNIX_TIMESTAMP()),
+	(722, 6715, '無機生体培養培地', UNIX_TIMESTAMP()),
+	(999, 5993, '放射能中和微粒子', UNIX_TIMESTAMP()),
+	(928, 3924, '秘匿化加蔓', UNIX_TIMESTAMP()),
+	(992, 5986, '電子閃現術', UNIX_TIMESTAMP()),
+	(987, 2984, '胞子培養器', UNIX_TIMESTAMP()),
+	(59, 6052, '情報融合芸術', UNIX_TIMESTAMP()),
+	(589, 2586, '量子物体共鳴', UNIX_TIMESTAMP()),
+	(8, 2005, '原生構造体', UNIX_TIMESTAMP()),
+	(368, 367, '知覚透明度', UNIX_TIMESTAMP()),
+	(339, 1337, '共振加速器', UNIX_TIMESTAMP()),
+	(765, 764, '火星鉱業予測', UNIX_TIMESTAMP()),
+	(665, 3661, '網膜情報処理', UNIX_TIMESTAMP()),
+	(49, 3045, '水晶分子配列', UNIX_TIMESTAMP()),
+	(42, 5036, '脳神経連動制御', UNIX_TIMESTAMP()),
+	(399, 398, '精神鎖切断', UNIX_TIMESTAMP()),
+	(837, 1835, '宙域通信網', UNIX_TIMESTAMP()),
+	(386, 2383, '有機合抜組織', UNIX_TIMESTAMP()),
+	(486, 2483, '陽電作動装置', UNIX_TIMESTAMP()),
+	(638, 4633, '機幕作用器', UNIX_TIMESTAMP()),
+	(627, 626, '無機浸潤化合物', UNIX_TIMESTAMP()),
+	(412, 3408, '精霊進化式', UNIX_TIMESTAMP()),
+	(652, 5646, '自然連鎖律', UNIX_TIMESTAMP()),
+	(506, 4501, '共鳴催眠装置', UNIX_TIMESTAMP()),
+	(444, 1442, '幻精疫気体', UNIX_TIMESTAMP()),
+	(413, 2410, '時空渦巻装置', UNIX_TIMESTAMP()),
+	(403, 3399, '物理刺激感', UNIX_TIMESTAMP()),
+	(4, 4998, '高次元形態変換', UNIX_TIMESTAMP()),
+	(547, 5541, '地球質量遷移', UNIX_TIMESTAMP()),
+	(408, 5402, '社会一体概念', UNIX_TIMESTAMP()),
+	(752, 751, '行動生成因子', UNIX_TIMESTAMP()),
+	(426, 6419, '質的価値定量化', UNIX_TIMESTAMP()),
+	(224, 2221, '宇宙微小振動', UNIX_TIMESTAMP()),
+	(501, 3497, '無限対応原理', UNIX_TIMESTAMP()),
+	(325, 1323, '脳内神経結合', UNIX_TIMESTAMP()),
+	(168, 4163, '原子核完全復元', UNIX_TIMESTAMP()),
+	(200, 1198, '多角的解釈方策', UNIX_TIMESTAMP()),
+	(776, 6769, '音響空間視覚化', UNIX_TIMESTAMP()),
+	(475, 1473, '遺伝情報集積', UNIX_TIMESTAMP()),
+	(693, 1691, '真空噴射燃料', UNIX_TIMESTAMP()),
+	(492, 7484, '心霊通信機', UNIX_TIMESTAMP()),
+	(433, 3429, '生体磁気共鳴', UNIX_TIMESTAMP()),
+	(818, 817, '社会動態解析', UNIX_TIMESTAMP()),
+	(743, 742, '脳細胞合成', UNIX_TIMESTAMP()),
+	(598, 1596, '生体物理計測', UNIX_TIMESTAMP()),
+	(60, 59, '社会事件予測', UNIX_TIMESTAMP()),
+	(830, 4825, '経済環境説明指数', UNIX_TIMESTAMP()),
+	(967, 5961, '酵素触媒分解速度', UNIX_TIMESTAMP()),
+	(994, 5988, '河川蛇行堆積物', UNIX_TIMESTAMP()),
+	(64, 5058, '気候因子経済地域分布', UNIX_TIMESTAMP()),
+	(65, 5059, '環境持続発展概念', UNIX_TIMESTAMP()),
+	(85, 7077, '知覚錯誤傾向', UNIX_TIMESTAMP()),
+	(23, 2020, '感情認識判断率', UNIX_TIMESTAMP()),
+	(958, 6951, '刑事訴訟争点審理', UNIX_TIMESTAMP()),
+	(241, 240, '民事責任故意区分', UNIX_TIMESTAMP()),
+	(188, 4183, '契約不履行損害賠償', UNIX_TIMESTAMP()),
+	(909, 5903, '免疫疾患自己抗体生成', UNIX_TIMESTAMP()),
+	(984, 2981, '臓器移植免疫拒絶反応', UNIX_TIMESTAMP()),
+	(754, 2751, '神経系障害症候群', UNIX_TIMESTAMP()),
+	(589, 3585, '腫瘍形成発現遺伝子', UNIX_TIMESTAMP()),
+	(951, 6944, '生物適応遺伝子発現', UNIX_TIMESTAMP()),
+	(266, 5260, '物理宇宙背景放射', UNIX_TIMESTAMP()),
+	(47, 1045, '天文機器観測統計', UNIX_TIMESTAMP()),
+	(823, 5817, '社会階層形成過程', UNIX_TIMESTAMP()),
+	(123, 1121, '生徒志望進路指導', UNIX_TIMESTAMP()),
+	(189, 188, '時空交差器', UNIX_TIMESTAMP()),
+	(502, 6495, '錬金術操者', UNIX_TIMESTAMP()),
+	(146, 2143, '景気減速管制装置', UNIX_TIMESTAMP()),
+	(698, 5692, '音波分解器', UNIX_TIMESTAMP()),
+	(279, 2276, '昇華操作装置', UNIX_TIMESTAMP()),
+	(10, 6003, '透明光触媒', UNIX_TIMESTAMP()),
+	(896, 4891, '足圧点検器', UNIX_TIMESTAMP()),
+	(55, 54, '心理反応解析機', UNIX_TIMESTAMP()),
+	(381, 2378, '気象感応樹脂', UNIX_TIMESTAMP()),
+	(746, 3742, '直播磁気浸透装置', UNIX_TIMESTAMP()),
+	(928, 6921, '連続瞬間写真機', UNIX_TIMESTAMP()),
+	(177, 4172, '帯電電場制御機', UNIX_TIMESTAMP()),
+	(955, 4950, '地殻断裂予測装置', UNIX_TIMESTAMP()),
+	(825, 3821, '毛髪再生細胞培養液', UNIX_TIMESTAMP()),
+	(851, 3847, '空中映像投影機', UNIX_TIMESTAMP()),
+	(396, 2393, '発熱反応制御合金', UNIX_TIMESTAMP()),
+	(238, 4233, '破邪黒縄弾', UNIX_TIMESTAMP()),
+	(116, 5110, '伝統音楽文化情報融合', UNIX_TIMESTAMP()),
+	(71, 6064, '管理編組語順', UNIX_TIMESTAMP()),
+	(939, 4934, '乾燥逆浸透膜除塩装置', UNIX_TIMESTAMP()),
+	(255, 3251, '衛星重力場地図測定', UNIX_TIMESTAMP()),
+	(262, 5256, '岩石変態鉱物比率推定', UNIX_TIMESTAMP()),
+	(108, 4103, '軟体動物種苗培養', UNIX_TIMESTAMP()),
+	(787, 4782, '農業機械遠隔制御', UNIX_TIMESTAMP()),
+	(618, 2615, '晦日方位図', UNIX_TIMESTAMP()),
+	(935, 1933, '蒸気律動機', UNIX_TIMESTAMP()),
+	(549, 4544, '拍攣作動器', UNIX_TIMESTAMP()),
+	(818, 6811, '電磁波共鳴', UNIX_TIMESTAMP()),
+	(518, 5512, '光電極材料', UNIX_TIMESTAMP()),
+	(474, 473, '分子先進制御', UNIX_TIMESTAMP()),
+	(207, 6200, '高密度太陽電池', UNIX_TIMESTAMP()),
+	(225, 6218, '行動遺伝子制御', UNIX_TIMESTAMP()),
+	(146, 6139, '空間選択計算', UNIX_TIMESTAMP()),
+	(544, 4539, '生物冷凍保存', UNIX_TIMESTAMP()),
+	(259, 3255, '間接照射研究', UNIX_TIMESTAMP()),
+	(959, 2956, '蒸液循環器', UNIX_TIMESTAMP()),
+	(588, 6581, '音義推定器', UNIX_TIMESTAMP()),
+	(771, 4766, '電子律動計', UNIX_TIMESTAMP()),
+	(19, 4014, '機械制御装置', UNIX_TIMESTAMP()),
+	(205, 4200, '生体情報解析器', UNIX_TIMESTAMP()),
+	(575, 4570, '擬似神経回路模倣器', UNIX_TIMESTAMP()),
+	(79, 6072, '結晶再生装置', UNIX_TIMESTAMP()),
+	(105, 104, '物理超越変換機', UNIX_TIMESTAMP()),
+	(520, 3516, '地球大気動態推定器', UNIX_TIMESTAMP()),
+	(785, 784, '環境芳香化装置', UNIX_TIMESTAMP()),
+	(545, 544, '経済精密制御術', UNIX_TIMESTAMP()),
+	(634, 3630, '音波透視機', UNIX_TIMESTAMP()),
+	(9, 5003, '農業生態再生器', UNIX_TIMESTAMP()),
+	(398, 5392, '陸上航空計測計', UNIX_TIMESTAMP()),
+	(103, 5097, '食品風味検出装置', UNIX_TIMESTAMP()),
+	(671, 670, '画像暗号化装置', UNIX_TIMESTAMP()),
+	(506, 3502, '空間共鳴器', UNIX_TIMESTAMP()),
+	(187, 5181, '霊気演算装置', UNIX_TIMESTAMP()),
+	(567, 2564, '魔晶抑制装置', UNIX_TIMESTAMP()),
+	(997, 2994, '物質転換装置', UNIX_TIMESTAMP()),
+	(16, 2013, '超古代文明遺産', UNIX_TIMESTAMP()),
+	(570, 3566, '細胞核核酸', UNIX_TIMESTAMP()),
+	(483, 5477, '細胞壁形態', UNIX_TIMESTAMP()),
+	(896, 895, '細胞分裂調節', UNIX_TIMESTAMP()),
+	(242, 6235, '光合成酵素', UNIX_TIMESTAMP()),
+	(520, 2517, '合理的主観', UNIX_TIMESTAMP()),
+	(528, 1526, '観念的意識', UNIX_TIMESTAMP()),
+	(822, 1820, '供給需要曲線', UNIX_TIMESTAMP()),
+	(190, 6183, '消費者余剰', UNIX_TIMESTAMP()),
+	(539, 1537, '企業市場占有率', UNIX_TIMESTAMP()),
+	(915, 914, '構造耐力設計', UNIX_TIMESTAMP()),
+	(978, 3974, '細胞分化機構', UNIX_TIMESTAMP()),
+	(307, 7299, '生態系相互作用', UNIX_TIMESTAMP()),
+	(883, 1881, '公訴棄却判決', UNIX_TIMESTAMP()),
+	(488, 3484, '行為主体責任', UNIX_TIMESTAMP()),
+	(881, 5875, '意識水準変動', UNIX_TIMESTAMP()),
+	(411, 7403, '行動形成要因', UNIX_TIMESTAMP()),
+	(250, 7242, '社会構造変動', UNIX_TIMESTAMP()),
+	(337, 4332, '集団行動規範', UNIX_TIMESTAMP()),
+	(714, 3710, '社会経済発展指標', UNIX_TIMESTAMP()),
+	(451, 450, '反応速度定数', UNIX_TIMESTAMP()),
+	(847, 5841, '電子配置軌道', UNIX_TIMESTAMP()),
+	(105, 5099, '有機化合物合成', UNIX_TIMESTAMP()),
+	(756, 755, '相互作用力拡散', UNIX_TIMESTAMP()),
+	(642, 6635, '宇宙膨張速度', UNIX_TIMESTAMP()),
+	(869, 868, '自己同期化', UNIX_TIMESTAMP()),
+	(242, 6235, '高濃度反応', UNIX_TIMESTAMP()),
+	(251, 4246, '生命組織再構築', UNIX_TIMESTAMP()),
+	(885, 2882, '逆磁力装置', UNIX_TIMESTAMP()),
+	(589, 1587, '卵子冷凍保存', UNIX_TIMESTAMP()),
+	(927, 1925, '無重力航行', UNIX_TIMESTAMP()),
+	(737, 5731, '分子解析装置', UNIX_TIMESTAMP()),
+	(55, 4050, '情報転送防衛', UNIX_TIMESTAMP()),
+	(622, 6615, '理想主義症候群', UNIX_TIMESTAMP()),
+	(334, 7326, '不可視光機', UNIX_TIMESTAMP()),
+	(77, 4072, '無明明暗体', UNIX_TIMESTAMP()),
+	(817, 2814, '音楽共鳴子', UNIX_TIMESTAMP()),
+	(114, 113, '萬有観弐軸', UNIX_TIMESTAMP()),
+	(201, 7193, '心理共振装置', UNIX_TIMESTAMP()),
+	(280, 4275, '神通光度計', UNIX_TIMESTAMP()),
+	(952, 1950, '精神融合術', UNIX_TIMESTAMP()),
+	(593, 6586, '量子風力発電', UNIX_TIMESTAMP()),
+	(359, 7351, '平行世界探査', UNIX_TIMESTAMP()),
+	(371, 370, '光子兵器開発', UNIX_TIMESTAMP()),
+	(14, 13, '時空歪曲装置', UNIX_TIMESTAMP()),
+	(606, 605, '超重力制御', UNIX_TIMESTAMP()),
+	(272, 271, '未来視通信', UNIX_TIMESTAMP()),
+	(136, 5130, '共鳴周波数分析', UNIX_TIMESTAMP()),
+	(288, 5282, '魔力転送機', UNIX_TIMESTAMP()),
+	(131, 4126, '空間分割複合器', UNIX_TIMESTAMP()),
+	(640, 5634, '真空中植物生育装置', UNIX_TIMESTAMP()),
+	(980, 2977, '不規則非線形振動解析', UNIX_TIMESTAMP()),
+	(690, 1688, '知覚情報統制装置', UNIX_TIMESTAMP()),
+	(929, 1927, '時空解体反応装置', UNIX_TIMESTAMP()),
+	(287, 2284, '音響共鳴波推進機', UNIX_TIMESTAMP()),
+	(808, 3804, '意識補完授与装置', UNIX_TIMESTAMP()),
+	(399, 398, '社会心理進化指数', UNIX_TIMESTAMP()),
+	(285, 284, '物質位相変換機構', UNIX_TIMESTAMP()),
+	(226, 5220, '認識情報改変要素', UNIX_TIMESTAMP()),
+	(447, 7439, '幻想郷航路', UNIX_TIMESTAMP()),
+	(578, 3574, '超振動共鳴', UNIX_TIMESTAMP()),
+	(598, 4593, '外挺弾道計測', UNIX_TIMESTAMP()),
+	(57, 7049, '位相移動装置', UNIX_TIMESTAMP()),
+	(434, 4429, '漸進器施護', UNIX_TIMESTAMP()),
+	(324, 4319, '負屈折反射', UNIX_TIMESTAMP()),
+	(594, 593, '遊電波瀑布', UNIX_TIMESTAMP()),
+	(580, 2577, '角度透明化', UNIX_TIMESTAMP()),
+	(418, 7410, '選択対抗曲線', UNIX_TIMESTAMP()),
+	(939, 5933, '電磁線干渉', UNIX_TIMESTAMP()),
+	(734, 733, '昇華反応桥', UNIX_TIMESTAMP()),
+	(166, 4161, '仮想消滅項', UNIX_TIMESTAMP()),
+	(318, 3314, '三重抽出器', UNIX_TIMESTAMP()),
+	(536, 535, '欠陥遮断符', UNIX_TIMESTAMP()),
+	(998, 4993, '基準均衡加速器', UNIX_TIMESTAMP()),
+	(473, 472, '曼荼羅形状', UNIX_TIMESTAMP()),
+	(959, 6952, '平衡錬金術', UNIX_TIMESTAMP()),
+	(696, 4691, '水銀燈芯短執睦', UNIX_TIMESTAMP()),
+	(430, 2427, '心肝脾胆仁義礼智信真美', UNIX_TIMESTAMP()),
+	(504, 2501, '契機逗留駅構内洒落物', UNIX_TIMESTAMP()),
+	(487, 486, '湖光山色桂花翠竹', UNIX_TIMESTAMP()),
+	(59, 6052, '西郷隆盛颯爽登場', UNIX_TIMESTAMP()),
+	(504, 5498, '傾国傾城連綿不断', UNIX_TIMESTAMP()),
+	(977, 4972, '立体花車盛装華麗', UNIX_TIMESTAMP()),
+	(650, 3646, '楼蘭草原胡笳曲', UNIX_TIMESTAMP()),
+	(66, 5060, '玳瑁麗聚統', UNIX_TIMESTAMP()),
+	(607, 2604, '琥珀豊錬維', UNIX_TIMESTAMP()),
+	(655, 654, '集合映像化', UNIX_TIMESTAMP()),
+	(720, 4715, '超古代起動', UNIX_TIMESTAMP()),
+	(214, 213, '置換反応触発剤', UNIX_TIMESTAMP()),
+	(776, 6769, '環境変動予測指標', UNIX_TIMESTAMP()),
+	(953, 3949, '量子効果制御装置', UNIX_TIMESTAMP()),
+	(87, 86, '不動産市場動向分析', UNIX_TIMESTAMP()),
+	(304, 7296, '地盤軟化現象', UNIX_TIMESTAMP()),
+	(241, 4236, '波動位相検出', UNIX_TIMESTAMP()),
+	(908, 2905, '奇点共振器', UNIX_TIMESTAMP()),
+	(967, 1965, '情報疲労症候群', UNIX_TIMESTAMP()),
+	(594, 6587, '超対称集積回路', UNIX_TIMESTAMP()),
+	(289, 1287, '遺伝的制御装置', UNIX_TIMESTAMP()),
+	(413, 5407, '多次元振動調律器', UNIX_TIMESTAMP()),
+	(99, 6092, '断層比例検出器', UNIX_TIMESTAMP()),
+	(314, 4309, '舶載推力器', UNIX_TIMESTAMP()),
+	(642, 1640, '鮮明分光計', UNIX_TIMESTAMP()),
+	(486, 485, '遠隔指示器', UNIX_TIMESTAMP()),
+	(532, 4527, '霜災警報機', UNIX_TIMESTAMP()),
+	(714, 713, '熱酵素変換', UNIX_TIMESTAMP()),
+	(211, 4206, '星辰観測器', UNIX_TIMESTAMP()),
+	(880, 5874, '電磁屈折率', UNIX_TIMESTAMP()),
+	(843, 5837, '均衡計量器', UNIX_TIMESTAMP()),
+	(461, 1459, '放電安定剤', UNIX_TIMESTAMP()),
+	(246, 4241, '隔離発電機', UNIX_TIMESTAMP()),
+	(244, 4239, '透過加速器', UNIX_TIMESTAMP()),
+	(20, 6013, '腐食予知器', UNIX_TIMESTAMP()),
+	(616, 5610, '細胞分子培養', UNIX_TIMESTAMP()),
+	(505, 4500, '蒸気帳状装置', UNIX_TIMESTAMP()),
+	(509, 3505, '精密空調装置', UNIX_TIMESTAMP()),
+	(176, 7168, '漸進発砲機', UNIX_TIMESTAMP()),
+	(590, 5584, '回転均重器', UNIX_TIMESTAMP()),
+	(270, 3266, '蓄音館装置', UNIX_TIMESTAMP()),
+	(596, 2593, '水陸両用装備', UNIX_TIMESTAMP()),
+	(996, 1994, '生体冷凍保存', UNIX_TIMESTAMP()),
+	(36, 3032, '超伝播粒子', UNIX_TIMESTAMP()),
+	(90, 2087, '遺伝情報記録体', UNIX_TIMESTAMP()),
+	(912, 911, '宇宙曲速推進', UNIX_TIMESTAMP()),
+	(714, 4709, '情報歪曲装置', UNIX_TIMESTAMP()),
+	(794, 6787, '重力抑制装置', UNIX_TIMESTAMP()),
+	(735, 1733, '時間逆転現象', UNIX_TIMESTAMP()),
+	(830, 4825, '光電子分解装置', UNIX_TIMESTAMP()),
+	(210, 7202, '超高速化膜', UNIX_TIMESTAMP()),
+	(300, 7292, '音波相互干渉装置', UNIX_TIMESTAMP()),
+	(115, 5109, '強力接合剤', UNIX_TIMESTAMP()),
+	(877, 4872, '超高温超伝導体', UNIX_TIMESTAMP()),
+	(22, 5016, '無重力実験設備', UNIX_TIMESTAMP()),
+	(649, 5643, '生体反応分析機器', UNIX_TIMESTAMP()),
+	(194, 5188, '精神干渉装置', UNIX_TIMESTAMP()),
+	(40, 5034, '超音波造影装置', UNIX_TIMESTAMP()),
+	(780, 779, '心理的自己評価尺度', UNIX_TIMESTAMP()),
+	(384, 7376, '重力制御機構', UNIX_TIMESTAMP()),
+	(246, 5240, '電子尾翼制御装置', UNIX_TIMESTAMP()),
+	(260, 3256, '爆薬音響監視器', UNIX_TIMESTAMP()),
+	(723, 3719, '高密度非晶質合金', UNIX_TIMESTAMP()),
+	(316, 1314, '非対称双極型極低温発生器', UNIX_TIMESTAMP()),
+	(194, 6187, '超高感度粒子検出器', UNIX_TIMESTAMP()),
+	(941, 3937, '生体内酸素供給装置', UNIX_TIMESTAMP()),
+	(988, 5982, '磁気共振注入放射線治療装置', UNIX_TIMESTAMP()),
+	(262, 4257, '等色温度透明固体蛍光体', UNIX_TIMESTAMP()),
+	(558, 3554, '神業丸転技', UNIX_TIMESTAMP()),
+	(688, 6681, '潜蜥帷幕術', UNIX_TIMESTAMP()),
+	(865, 6858, '惺心浄穢術', UNIX_TIMESTAMP()),
+	(401, 4396, '因縁念仏術', UNIX_TIMESTAMP()),
+	(261, 4256, '傀儡堆煩術', UNIX_TIMESTAMP()),
+	(549, 1547, '茶摘祈禱術', UNIX_TIMESTAMP()),
+	(790, 1788, '跫音呪詛術', UNIX_TIMESTAMP()),
+	(61, 1059, '高次元暗号化', UNIX_TIMESTAMP()),
+	(619, 1617, '心霊凝縮装置', UNIX_TIMESTAMP()),
+	(938, 3934, '魂体情報抽出装置', UNIX_TIMESTAMP()),
+	(458, 457, '時空歪曲装置', UNIX_TIMESTAMP()),
+	(76, 6069, '悪魔降霊術', UNIX_TIMESTAMP()),
+	(909, 908, '幽体回遊装置', UNIX_TIMESTAMP()),
+	(937, 6930, '心象干渉機', UNIX_TIMESTAMP()),
+	(360, 6353, '超次元予知装置', UNIX_TIMESTAMP()),
+	(78, 6071, '平行宇宙探査潜航艇', UNIX_TIMESTAMP()),
+	(514, 2511, '天体透明化装置', UNIX_TIMESTAMP()),
+	(76, 75, '神秘物質転換鏡', UNIX_TIMESTAMP()),
+	(593, 5587, '超常現象再現装置', UNIX_TIMESTAMP()),
+	(405, 7397, '叡智増幅装置', UNIX_TIMESTAMP()),
+	(755, 6748, '蓮華降妖術', UNIX_TIMESTAMP()),
+	(667, 5661, '闇森魔封石', UNIX_TIMESTAMP()),
+	(816, 2813, '雷炎爆裂弾', UNIX_TIMESTAMP()),
+	(657, 6650, '極光七連撃', UNIX_TIMESTAMP()),
+	(138, 137, '破封古文書', UNIX_TIMESTAMP()),
+	(618, 617, '落花風月舞', UNIX_TIMESTAMP()),
+	(193, 192, '心血管葉細胞', UNIX_TIMESTAMP()),
+	(94, 2091, '遺伝情報抽出', UNIX_TIMESTAMP()),
+	(377, 376, '反重力物質', UNIX_TIMESTAMP()),
+	(331, 1329, '精神操作装置', UNIX_TIMESTAMP()),
+	(251, 4246, '硬質物指紋解析', UNIX_TIMESTAMP()),
+	(968, 1966, '生体磁気共鳴', UNIX_TIMESTAMP()),
+	(356, 2353, '創造力増進装置', UNIX_TIMESTAMP()),
+	(633, 2630, '遺伝子編集器', UNIX_TIMESTAMP()),
+	(822, 2819, '心筋修復装置', UNIX_TIMESTAMP()),
+	(277, 2274, '時空遺伝子', UNIX_TIMESTAMP()),
+	(294, 2291, '精神仮現施設', UNIX_TIMESTAMP()),
+	(269, 3265, '即席加速器', UNIX_TIMESTAMP()),
+	(482, 4477, '静巻火花診断機', UNIX_TIMESTAMP()),
+	(41, 3037, '遺伝子暗号解読装置', UNIX_TIMESTAMP()),
+	(767, 766, '量子粒子同期器', UNIX_TIMESTAMP()),
+	(774, 5768, '腸内細菌駆動計', UNIX_TIMESTAMP()),
+	(819, 4814, '磁気共鳴光線器', UNIX_TIMESTAMP()),
+	(238, 4233, '粒子加速装置', UNIX_TIMESTAMP()),
+	(135, 4130, '血液治療機', UNIX_TIMESTAMP()),
+	(934, 2931, '幻覚情報反応', UNIX_TIMESTAMP()),
+	(696, 695, '遠隔制御器具', UNIX_TIMESTAMP()),
+	(4, 2001, '脳内並列処理', UNIX_TIMESTAMP()),
+	(551, 2548, '光速超越物質', UNIX_TIMESTAMP()),
+	(137, 1135, '物質転換現象', UNIX_TIMESTAMP()),
+	(478, 477, '心理反応破綻', UNIX_TIMESTAMP()),
+	(521, 2518, '遺伝子情報解析', UNIX_TIMESTAMP()),
+	(475, 3471, '音響周波探索', UNIX_TIMESTAMP()),
+	(902, 2899, '無線通信暗号化', UNIX_TIMESTAMP()),
+	(505, 6498, '武装鑑定術', UNIX_TIMESTAMP()),
+	(620, 3616, '映像音響研究', UNIX_TIMESTAMP()),
+	(189, 4184, '漢字象形研究', UNIX_TIMESTAMP()),
+	(273, 7265, '照準確度指標', UNIX_TIMESTAMP()),
+	(73, 1071, '資源消費効率', UNIX_TIMESTAMP()),
+	(762, 1760, '副作用反応評価', UNIX_TIMESTAMP()),
+	(890, 889, '積層配置検証', UNIX_TIMESTAMP()),
+	(835, 3831, '効率化評価指標', UNIX_TIMESTAMP()),
+	(281, 7273, '信号伝送効果分析', UNIX_TIMESTAMP()),
+	(414, 5408, '超音波圧縮', UNIX_TIMESTAMP()),
+	(819, 3815, '量子反応器', UNIX_TIMESTAMP()),
+	(954, 1952, '全球共有通信', UNIX_TIMESTAMP()),
+	(625, 5619, '情報相補形式', UNIX_TIMESTAMP()),
+	(347, 346, '可変光集合装置', UNIX_TIMESTAMP()),
+	(121, 1119, '彩色電力制御', UNIX_TIMESTAMP()),
+	(921, 5915, '経路分類器', UNIX_TIMESTAMP()),
+	(136, 3132, '力場測定器', UNIX_TIMESTAMP()),
+	(912, 3908, '自己再生半導体', UNIX_TIMESTAMP()),
+	(116, 5110, '精密流体解析', UNIX_TIMESTAMP()),
+	(475, 6468, '熱帯天然材計測', UNIX_TIMESTAMP()),
+	(793, 4788, '無劣化食品保存', UNIX_TIMESTAMP()),
+	(584, 3580, '分子結晶設計', UNIX_TIMESTAMP()),
+	(208, 6201, '音波医療機器', UNIX_TIMESTAMP()),
+	(100, 5094, '空気浄化装置', UNIX_TIMESTAMP()),
+	(200, 3196, '発火機構解析', UNIX_TIMESTAMP()),
+	(564, 2561, '擬似灰色指数', UNIX_TIMESTAMP()),
+	(954, 953, '認識潜在力', UNIX_TIMESTAMP()),
+	(463, 3459, '結晶化速度', UNIX_TIMESTAMP()),
+	(51, 3047, '微分相関係数', UNIX_TIMESTAMP()),
+	(475, 6468, '平均変形率', UNIX_TIMESTAMP()),
+	(45, 44, '透過反射率', UNIX_TIMESTAMP()),
+	(589, 3585, '局所振動周波数', UNIX_TIMESTAMP()),
+	(998, 1996, '拡散透過率', UNIX_TIMESTAMP()),
+	(919, 1917, '共振過渡応答', UNIX_TIMESTAMP()),
+	(577, 5571, '硬度補正率', UNIX_TIMESTAMP()),
+	(173, 6166, '医療投機行為', UNIX_TIMESTAMP()),
+	(134, 6127, '社会処方箋', UNIX_TIMESTAMP()),
+	(386, 1384, '歴史変奏曲', UNIX_TIMESTAMP()),
+	(412, 1410, '経営心理研究', UNIX_TIMESTAMP()),
+	(761, 5755, '社会効用価値', UNIX_TIMESTAMP()),
+	(66, 5060, '地理空間分析', UNIX_TIMESTAMP()),
+	(625, 3621, '芸術破壊主義', UNIX_TIMESTAMP()),
+	(218, 4213, '効用最適化', UNIX_TIMESTAMP()),
+	(143, 5137, '熱膨張係数', UNIX_TIMESTAMP()),
+	(600, 3596, '遺伝子発現制御', UNIX_TIMESTAMP()),
+	(812, 6805, '集団構築力', UNIX_TIMESTAMP()),
+	(684, 1682, '社会変動要因', UNIX_TIMESTAMP()),
+	(118, 5112, '文化価値観', UNIX_TIMESTAMP()),
+	(31, 2028, '言語接触現象', UNIX_TIMESTAMP()),
+	(601, 3597, '堆積層解析', UNIX_TIMESTAMP()),
+	(837, 4832, '社会地理情報', UNIX_TIMESTAMP()),
+	(351, 1349, '宇宙環境測定器', UNIX_TIMESTAMP()),
+	(889, 4884, '情報化岩石', UNIX_TIMESTAMP()),
+	(177, 7169, '斜面制御装置', UNIX_TIMESTAMP()),
+	(44, 7036, '水晶反射器', UNIX_TIMESTAMP()),
+	(447, 5441, '融合加工装置', UNIX_TIMESTAMP()),
+	(526, 1524, '真空超音波加熱器', UNIX_TIMESTAMP()),
+	(314, 2311, '無機色素触媒', UNIX_TIMESTAMP()),
+	(755, 4750, '空気還元蒸散機', UNIX_TIMESTAMP()),
+	(879, 3875, '分子合成装置', UNIX_TIMESTAMP()),
+	(876, 3872, '機動堆積装置', UNIX_TIMESTAMP()),
+	(480, 6473, '微量蓄電装置', UNIX_TIMESTAMP()),
+	(534, 2531, '空中捕獲機', UNIX_TIMESTAMP()),
+	(46, 7038, '全方向透明展望窓', UNIX_TIMESTAMP()),
+	(356, 7348, '再生生物媒体', UNIX_TIMESTAMP()),
+	(699, 1697, '圧縮状態解析器', UNIX_TIMESTAMP()),
+	(861, 5855, '非反転増幅器', UNIX_TIMESTAMP()),
+	(120, 1118, '振動吸収体', UNIX_TIMESTAMP()),
+	(722, 1720, '真空重力転写機', UNIX_TIMESTAMP()),
+	(994, 993, '振動制御反射板', UNIX_TIMESTAMP()),
+	(251, 6244, '高濃度生物防護器', UNIX_TIMESTAMP()),
+	(641, 1639, '合成多孔質膜', UNIX_TIMESTAMP()),
+	(790, 5784, '蒸気圧主導冷却装置', UNIX_TIMESTAMP()),
+	(955, 2952, '超高速水晶体制御', UNIX_TIMESTAMP()),
+	(313, 5307, '左右非対称分子反応', UNIX_TIMESTAMP()),
+	(804, 3800, '金融奇怪神経症', UNIX_TIMESTAMP()),
+	(760, 2757, '呼吸律速調整', UNIX_TIMESTAMP()),
+	(67, 66, '言葉選別樹', UNIX_TIMESTAMP()),
+	(331, 5325, '食器装備奇譚', UNIX_TIMESTAMP()),
+	(391, 3387, '照明演出急速化', UNIX_TIMESTAMP()),
+	(386, 2383, '動態構造解析', UNIX_TIMESTAMP()),
+	(454, 453, '超認識過程', UNIX_TIMESTAMP()),
+	(139, 6132, '経済滑走路', UNIX_TIMESTAMP()),
+	(921, 6914, '創造思考抽象化', UNIX_TIMESTAMP()),
+	(500, 4495, '感情解凍装置', UNIX_TIMESTAMP()),
+	(396, 6389, '社会疎外指数', UNIX_TIMESTAMP()),
+	(432, 1430, '意味接着評価', UNIX_TIMESTAMP()),
+	(255, 3251, '文化固有表現', UNIX_TIMESTAMP()),
+	(599, 6592, '知識再構築装置', UNIX_TIMESTAMP()),
+	(699, 1697, '情動言語化', UNIX_TIMESTAMP()),
+	(739, 738, '観測選択子', UNIX_TIMESTAMP()),
+	(889, 4884, '無意識抗体', UNIX_TIMESTAMP()),
+	(132, 5126, '観察者効果', UNIX_TIMESTAMP()),
+	(670, 6663, '交流分布解析', UNIX_TIMESTAMP()),
+	(905, 5899, '行動系統研究', UNIX_TIMESTAMP()),
+	(932, 4927, '自律思考経路', UNIX_TIMESTAMP()),
+	(24, 1022, '高電位回路', UNIX_TIMESTAMP()),
+	(832, 831, '紫陽花奏曲', UNIX_TIMESTAMP()),
+	(266, 6259, '無色光飛翔', UNIX_TIMESTAMP()),
+	(330, 2327, '超伝導増幅器', UNIX_TIMESTAMP()),
+	(451, 450, '量子風力発電', UNIX_TIMESTAMP()),
+	(816, 1814, '生物逆設計', UNIX_TIMESTAMP()),
+	(626, 625, '音波画像解析', UNIX_TIMESTAMP()),
+	(919, 4914, '脳障害診断機', UNIX_TIMESTAMP()),
+	(14, 13, '超光速情報伝送', UNIX_TIMESTAMP()),
+	(906, 4901, '雲粒子加速装置', UNIX_TIMESTAMP()),
+	(261, 4256, '漸進脳波改善装置', UNIX_TIMESTAMP()),
+	(945, 6938, '炉心融解感知器', UNIX_TIMESTAMP()),
+	(214, 3210, '霧化超音波揚音器', UNIX_TIMESTAMP()),
+	(859, 4854, '心気分析装置', UNIX_TIMESTAMP()),
+	(760, 759, '航空密閉分離器', UNIX_TIMESTAMP()),
+	(450, 3446, '情報再構築機', UNIX_TIMESTAMP()),
+	(804, 4799, '網膜輝度入力器', UNIX_TIMESTAMP()),
+	(493, 5487, '生体光合成増強剤', UNIX_TIMESTAMP()),
+	(791, 2788, '磁界渦電流計', UNIX_TIMESTAMP()),
+	(773, 5767, '直接頭脳刺激装置', UNIX_TIMESTAMP()),
+	(956, 3952, '多重共鳴反応加速器', UNIX_TIMESTAMP()),
+	(815, 6808, '高速加圧炉', UNIX_TIMESTAMP()),
+	(907, 4902, '無重力遠心装置', UNIX_TIMESTAMP()),
+	(538, 1536, '無撤去断熱弁', UNIX_TIMESTAMP()),
+	(469, 3465, '電子共鳴転写器', UNIX_TIMESTAMP()),
+	(232, 2229, '超高温超伝導体', UNIX_TIMESTAMP()),
+	(905, 904, '時間逆行装置', UNIX_TIMESTAMP()),
+	(939, 938, '微生物群落', UNIX_TIMESTAMP()),
+	(780, 3776, '層状同位体', UNIX_TIMESTAMP()),
+	(395, 5389, '量子態同位体', UNIX_TIMESTAMP()),
+	(813, 1811, '超音波加工', UNIX_TIMESTAMP()),
+	(874, 2871, '双極子磁極', UNIX_TIMESTAMP()),
+	(10, 6003, '機能分子材料', UNIX_TIMESTAMP()),
+	(563, 1561, '熱気球温度計', UNIX_TIMESTAMP()),
+	(644, 4639, '音程推定器', UNIX_TIMESTAMP()),
+	(924, 923, '微量反応計測器', UNIX_TIMESTAMP()),
+	(144, 4139, '自然災害防止地図', UNIX_TIMESTAMP()),
+	(640, 3636, '社会階層分析', UNIX_TIMESTAMP()),
+	(198, 1196, '投資収益率計算式', UNIX_TIMESTAMP()),
+	(336, 4331, '統治構造分析', UNIX_TIMESTAMP()),
+	(245, 4240, '文化的交流年表', UNIX_TIMESTAMP()),
+	(267, 6260, '地球内部構造模型', UNIX_TIMESTAMP()),
+	(355, 6348, '農薬散布装置', UNIX_TIMESTAMP()),
+	(187, 1185, '超音波妖魔', UNIX_TIMESTAMP()),
+	(385, 5379, '量子重力風味', UNIX_TIMESTAMP()),
+	(245, 244, '情報透明化機', UNIX_TIMESTAMP()),
+	(79, 78, '逆反転素子', UNIX_TIMESTAMP()),
+	(371, 3367, '宇宙時空間', UNIX_TIMESTAMP()),
+	(719, 2716, '分子予知能', UNIX_TIMESTAMP()),
+	(356, 5350, '密度漸増装置', UNIX_TIMESTAMP()),
+	(686, 1684, '量子転送機', UNIX_TIMESTAMP()),
+	(4, 3000, '視覚細胞強化装置', UNIX_TIMESTAMP()),
+	(401, 6394, '生物進化触媒', UNIX_TIMESTAMP()),
+	(773, 2770, '心電電極素子', UNIX_TIMESTAMP()),
+	(124, 6117, '心理時間調整装置', UNIX_TIMESTAMP()),
+	(240, 1238, '音響分解装置', UNIX_TIMESTAMP()),
+	(754, 1752, '物質再合成装置', UNIX_TIMESTAMP()),
+	(276, 4271, '水銀触覚制御', UNIX_TIMESTAMP()),
+	(602, 6595, '状況推測眼鏡', UNIX_TIMESTAMP()),
+	(710, 1708, '完全再帰算術', UNIX_TIMESTAMP()),
+	(618, 1616, '音波念動院', UNIX_TIMESTAMP()),
+	(381, 5375, '核分析観測器', UNIX_TIMESTAMP()),
+	(922, 5916, '超幻想粒子生成器', UNIX_TIMESTAMP()),
+	(967, 966, '解析酸化関節装着器', UNIX_TIMESTAMP()),
+	(587, 586, '高周波塗布', UNIX_TIMESTAMP()),
+	(269, 4264, '液体超伝導', UNIX_TIMESTAMP()),
+	(60, 7052, '古文字再生', UNIX_TIMESTAMP()),
+	(773, 4768, '臨界条件圧砕機', UNIX_TIMESTAMP()),
+	(934, 3930, '冷却熱源酸素濃縮器', UNIX_TIMESTAMP()),
+	(373, 4368, '真空脱気高温鍛造機', UNIX_TIMESTAMP()),
+	(471, 470, '超音波分散触媒', UNIX_TIMESTAMP()),
+	(117, 2114, '心電図建世', UNIX_TIMESTAMP()),
+	(988, 2985, '超音波迂緻', UNIX_TIMESTAMP()),
+	(133, 1131, '情報芸伎絶', UNIX_TIMESTAMP()),
+	(538, 4533, '磁気共鳴体積計測器', UNIX_TIMESTAMP()),
+	(184, 4179, '生体情報応答評価指標', UNIX_TIMESTAMP()),
+	(439, 4434, '音波共鳴防護素材', UNIX_TIMESTAMP()),
+	(83, 6076, '植物生育成熟指標解析器', UNIX_TIMESTAMP()),
+	(114, 4109, '個体情報市場測定装置', UNIX_TIMESTAMP()),
+	(461, 3457, '空中運動安定補正器', UNIX_TIMESTAMP()),
+	(330, 3326, '微生物生育同位体追跡素材', UNIX_TIMESTAMP()),
+	(219, 218, '再生医療病態推定器', UNIX_TIMESTAMP()),
+	(205, 6198, '音響波形解析', UNIX_TIMESTAMP()),
+	(422, 2419, '都市再開発計画', UNIX_TIMESTAMP()),
+	(976, 1974, '建築構造解析', UNIX_TIMESTAMP()),
+	(659, 4654, '地球環境再生', UNIX_TIMESTAMP()),
+	(266, 6259, '高効率太陽電池', UNIX_TIMESTAMP()),
+	(39, 7031, '都市交通計画', UNIX_TIMESTAMP()),
+	(181, 1179, '高能率分子合成', UNIX_TIMESTAMP()),
+	(609, 4604, '地殻応力解析', UNIX_TIMESTAMP()),
+	(756, 4751, '頻度連鎖解析', UNIX_TIMESTAMP()),
+	(103, 6096, '組織代謝機構', UNIX_TIMESTAMP()),
+	(81, 4076, '衛星遠隔測定', UNIX_TIMESTAMP()),
+	(912, 4907, '真空減圧凝縮', UNIX_TIMESTAMP()),
+	(472, 5466, '株価相関指数分析', UNIX_TIMESTAMP()),
+	(655, 3651, '感染症予防戦略', UNIX_TIMESTAMP()),
+	(718, 2715, '火災転移率', UNIX_TIMESTAMP()),
+	(975, 974, '分子認識指標', UNIX_TIMESTAMP()),
+	(618, 6611, '地球網衛星通信', UNIX_TIMESTAMP()),
+	(843, 2840, '光遮断微量分析', UNIX_TIMESTAMP()),
+	(480, 1478, '水平雷撃制御', UNIX_TIMESTAMP()),
+	(266, 3262, '電位差応答測定', UNIX_TIMESTAMP()),
+	(332, 331, '経脈磁気透析術', UNIX_TIMESTAMP()),
+	(266, 4261, '潜在香気物質', UNIX_TIMESTAMP()),
+	(135, 3131, '高密度超伝導材料', UNIX_TIMESTAMP()),
+	(727, 3723, '核融合液体金属冷却炉', UNIX_TIMESTAMP()),
+	(731, 6724, '有機共鳴超分子触媒', UNIX_TIMESTAMP()),
+	(438, 3434, '石化薔薇椀', UNIX_TIMESTAMP()),
+	(507, 3503, '宇宙変動曲線', UNIX_TIMESTAMP()),
+	(850, 849, '熱風反応器', UNIX_TIMESTAMP()),
+	(721, 6714, '社会密語術', UNIX_TIMESTAMP()),
+	(99, 6092, '音響橋梁設計', UNIX_TIMESTAMP()),
+	(239, 3235, '星光化合物', UNIX_TIMESTAMP()),
+	(490, 1488, '脳波抑制装置', UNIX_TIMESTAMP()),
+	(796, 1794, '開巻心動器', UNIX_TIMESTAMP()),
+	(156, 1154, '心霊感応装置', UNIX_TIMESTAMP()),
+	(455, 1453, '情報退化現象', UNIX_TIMESTAMP()),
+	(306, 2303, '水中浮遊体', UNIX_TIMESTAMP()),
+	(868, 3864, '旅行時空計測器', UNIX_TIMESTAMP()),
+	(175, 7167, '静脈探査装置', UNIX_TIMESTAMP()),
+	(677, 4672, '心理応答測定器', UNIX_TIMESTAMP()),
+	(226, 5220, '宇宙天候予測機', UNIX_TIMESTAMP()),
+	(287, 6280, '円融合光核', UNIX_TIMESTAMP()),
+	(881, 5875, '破邪払浄符', UNIX_TIMESTAMP()),
+	(752, 3748, '天風滑鮟鱇', UNIX_TIMESTAMP()),
+	(39, 5033, '琵琶夢幻譜', UNIX_TIMESTAMP()),
+	(838, 1836, '奏星閃爍弓', UNIX_TIMESTAMP()),
+	(524, 6517, '構造観察装置', UNIX_TIMESTAMP()),
+	(553, 3549, '声波分析器', UNIX_TIMESTAMP()),
+	(755, 3751, '情報伝送装置', UNIX_TIMESTAMP()),
+	(932, 3928, '音響発振機', UNIX_TIMESTAMP()),
+	(478, 1476, '電磁波捕捉器', UNIX_TIMESTAMP()),
+	(97, 2094, '光偏向装置', UNIX_TIMESTAMP()),
+	(835, 4830, '分子合成補助体', UNIX_TIMESTAMP()),
+	(214, 2211, '地質探査機', UNIX_TIMESTAMP()),
+	(29, 28, '焼却効率検出器', UNIX_TIMESTAMP()),
+	(248, 247, '複合材料成型器', UNIX_TIMESTAMP()),
+	(971, 2968, '表面張力描写装置', UNIX_TIMESTAMP()),
+	(745, 2742, '微生物群集', UNIX_TIMESTAMP()),
+	(931, 4926, '高分解能計測', UNIX_TIMESTAMP()),
+	(592, 3588, '創造力測定', UNIX_TIMESTAMP()),
+	(621, 1619, '絶対詠唱鍵', UNIX_TIMESTAMP()),
+	(196, 4191, '心環境軌道', UNIX_TIMESTAMP()),
+	(556, 555, '無限螺旋心', UNIX_TIMESTAMP()),
+	(818, 5812, '相対視空間', UNIX_TIMESTAMP()),
+	(377, 6370, '不可検波素粒子', UNIX_TIMESTAMP()),
+	(273, 4268, '稀元素調整', UNIX_TIMESTAMP()),
+	(366, 7358, '影光同挙反応', UNIX_TIMESTAMP()),
+	(621, 1619, '超結晶成長', UNIX_TIMESTAMP()),
+	(760, 1758, '原子核隠匿', UNIX_TIMESTAMP()),
+	(548, 547, '反物質研究', UNIX_TIMESTAMP()),
+	(865, 4860, '遺伝子修飾', UNIX_TIMESTAMP()),
+	(70, 4065, '霊脈解析術', UNIX_TIMESTAMP()),
+	(469, 468, '無意識探索術', UNIX_TIMESTAMP()),
+	(523, 4518, '瞑想収斂術', UNIX_TIMESTAMP()),
+	(206, 2203, '暗黒物質研究', UNIX_TIMESTAMP()),
+	(50, 6043, '幽体探索能力', UNIX_TIMESTAMP()),
+	(753, 3749, '透視力訓練術', UNIX_TIMESTAMP()),
+	(315, 1313, '分子交信装置', UNIX_TIMESTAMP()),
+	(728, 4723, '有機的民主主義', UNIX_TIMESTAMP()),
+	(992, 5986, '地殻再生循環', UNIX_TIMESTAMP()),
+	(405, 2402, '量子渦動子', UNIX_TIMESTAMP()),
+	(820, 1818, '分子逆合成', UNIX_TIMESTAMP()),
+	(158, 1156, '潜在形成指標', UNIX_TIMESTAMP()),
+	(194, 5188, '極地温度梯度', UNIX_TIMESTAMP()),
+	(458, 6451, '音波投射機', UNIX_TIMESTAMP()),
+	(533, 3529, '仮想患者検査', UNIX_TIMESTAMP()),
+	(436, 2433, '水素化燃料電池', UNIX_TIMESTAMP()),
+	(81, 3077, '基本度量衡', UNIX_TIMESTAMP()),
+	(249, 7241, '蛍光顕微鏡', UNIX_TIMESTAMP()),
+	(691, 6684, '霊魂転写術', UNIX_TIMESTAMP()),
+	(450, 5444, '峰悪修羅術', UNIX_TIMESTAMP()),
+	(901, 6894, '絶対魔方陣', UNIX_TIMESTAMP()),
+	(443, 6436, '破邪煌霊刀', UNIX_TIMESTAMP()),
+	(590, 6583, '炎舞幻想術', UNIX_TIMESTAMP()),
+	(365, 2362, '百花宴囃子', UNIX_TIMESTAMP()),
+	(886, 2883, '破嵐破壊拳', UNIX_TIMESTAMP()),
+	(13, 6006, '潜影手裏剣術', UNIX_TIMESTAMP()),
+	(73, 7065, '神秘薔薇図譜', UNIX_TIMESTAMP()),
+	(988, 1986, '羅刹宴眠術', UNIX_TIMESTAMP()),
+	(895, 3891, '波魔羅舞術', UNIX_TIMESTAMP()),
+	(139, 7131, '心靈封印術', UNIX_TIMESTAMP()),
+	(766, 3762, '蒸気機関車', UNIX_TIMESTAMP()),
+	(580, 6573, '水晶錬成術', UNIX_TIMESTAMP()),
+	(748, 6741, '無重力物質', UNIX_TIMESTAMP()),
+	(918, 917, '自己再生装置', UNIX_TIMESTAMP()),
+	(488, 1486, '遺伝子組換術', UNIX_TIMESTAMP()),
+	(921, 4916, '念動力能力', UNIX_TIMESTAMP()),
+	(367, 5361, '星霊召喚術', UNIX_TIMESTAMP()),
+	(417, 5411, '時空歪曲装置', UNIX_TIMESTAMP()),
+	(354, 3350, '天気操作装置', UNIX_TIMESTAMP()),
+	(109, 108, '分子熱振動', UNIX_TIMESTAMP()),
+	(18, 2015, '非線形回帰', UNIX_TIMESTAMP()),
+	(316, 5310, '土星軌道直角化', UNIX_TIMESTAMP()),
+	(124, 1122, '量子物理反転制御', UNIX_TIMESTAMP()),
+	(395, 4390, '音響波形解析', UNIX_TIMESTAMP()),
+	(657, 2654, '経済金融動向予測', UNIX_TIMESTAMP()),
+	(395, 7387, '動物行動観察', UNIX_TIMESTAMP()),
+	(514, 4509, '政治経済国際危機対策', UNIX_TIMESTAMP()),
+	(919, 6912, '美術芸術歴史研究', UNIX_TIMESTAMP()),
+	(598, 1596, '医療看護体制改革', UNIX_TIMESTAMP()),
+	(487, 5481, '脳神経治癒', UNIX_TIMESTAMP()),
+	(640, 4635, '自然災害防衛', UNIX_TIMESTAMP()),
+	(833, 832, '化粧品分析', UNIX_TIMESTAMP()),
+	(521, 4516, '魔力結晶化術', UNIX_TIMESTAMP()),
+	(546, 1544, '心理音波治療', UNIX_TIMESTAMP()),
+	(211, 210, '時空裂縫分析', UNIX_TIMESTAMP()),
+	(322, 2319, '超音波干渉効果', UNIX_TIMESTAMP()),
+	(110, 3106, '透明光通信', UNIX_TIMESTAMP()),
+	(385, 5379, '逆転催眠術', UNIX_TIMESTAMP()),
+	(218, 7210, '螺旋星症候群', UNIX_TIMESTAMP()),
+	(785, 4780, '推命方程式', UNIX_TIMESTAMP()),
+	(385, 1383, '時空転位術', UNIX_TIMESTAMP()),
+	(854, 5848, '精神結晶体', UNIX_TIMESTAMP()),
+	(639, 2636, '霊魂振動波', UNIX_TIMESTAMP()),
+	(98, 6091, '地震召喚器具', UNIX_TIMESTAMP()),
+	(895, 894, '魔力脈動計', UNIX_TIMESTAMP()),
+	(579, 1577, '心理解析脳機能研究', UNIX_TIMESTAMP()),
+	(925, 924, '現代美術大師芸術信念', UNIX_TIMESTAMP()),
+	(405, 3401, '経済指標円滑化', UNIX_TIMESTAMP()),
+	(495, 6488, '生物進化形態適応', UNIX_TIMESTAMP()),
+	(526, 525, '多変数挙動', UNIX_TIMESTAMP()),
+	(756, 2753, '電磁共鳴現象', UNIX_TIMESTAMP()),
+	(957, 2954, '疲労透明化', UNIX_TIMESTAMP()),
+	(906, 2903, '無引力状態', UNIX_TIMESTAMP()),
+	(151, 1149, '継電器稼働率', UNIX_TIMESTAMP()),
+	(554, 1552, '陽子分解反応', UNIX_TIMESTAMP()),
+	(877, 2874, '位相遷移株', UNIX_TIMESTAMP()),
+	(625, 4620, '対称行列変換', UNIX_TIMESTAMP()),
+	(264, 4259, '加速度循環', UNIX_TIMESTAMP()),
+	(847, 6840, '非晶質再結晶', UNIX_TIMESTAMP()),
+	(436, 435, '直角運動方程式', UNIX_TIMESTAMP()),
+	(782, 4777, '炉心冷却率', UNIX_TIMESTAMP()),
+	(676, 675, '機動制御装置', UNIX_TIMESTAMP()),
+	(843, 5837, '量子相関微粒子', UNIX_TIMESTAMP()),
+	(34, 1032, '超感覚輸出', UNIX_TIMESTAMP()),
+	(123, 1121, '磁気反発素材', UNIX_TIMESTAMP()),
+	(567, 3563, '天使絶縁体', UNIX_TIMESTAMP()),
+	(358, 2355, '記憶化合物', UNIX_TIMESTAMP()),
+	(185, 6178, '共鳴増幅装置', UNIX_TIMESTAMP()),
+	(653, 5647, '高拡散反応', UNIX_TIMESTAMP()),
+	(331, 3327, '生体曝光装置', UNIX_TIMESTAMP()),
+	(894, 3890, '超音波撹拌器', UNIX_TIMESTAMP()),
+	(165, 2162, '増幅光発生素材', UNIX_TIMESTAMP()),
+	(104, 3100, '拡張融合反応', UNIX_TIMESTAMP()),
+	(986, 1984, '生体酵素通信', UNIX_TIMESTAMP()),
+	(609, 4604, '創造的磁場操作', UNIX_TIMESTAMP()),
+	(389, 2386, '遺伝因子逆算', UNIX_TIMESTAMP()),
+	(960, 2957, '環境磁場感応', UNIX_TIMESTAMP()),
+	(998, 2995, '微量物質感知', UNIX_TIMESTAMP()),
+	(688, 5682, '感情波動解析', UNIX_TIMESTAMP()),
+	(770, 4765, '能力頻度分布', UNIX_TIMESTAMP()),
+	(243, 7235, '意識波供給', UNIX_TIMESTAMP()),
+	(452, 7444, '物体表面回折', UNIX_TIMESTAMP()),
+	(567, 1565, '時空逓送機', UNIX_TIMESTAMP()),
+	(83, 2080, '幻想振動装置', UNIX_TIMESTAMP()),
+	(798, 2795, '超感覚認識器', UNIX_TIMESTAMP()),
+	(221, 2218, '集中思考装置', UNIX_TIMESTAMP()),
+	(246, 3242, '覚醒因子検出機', UNIX_TIMESTAMP()),
+	(677, 1675, '幻覚生成装置', UNIX_TIMESTAMP()),
+	(187, 4182, '超音波浮遊装置', UNIX_TIMESTAMP()),
+	(805, 2802, '平行世界観測器', UNIX_TIMESTAMP()),
+	(270, 4265, '悪夢分析装置', UNIX_TIMESTAMP()),
+	(451, 4446, '念動量操作器', UNIX_TIMESTAMP()),
+	(551, 4546, '上級意思決定機', UNIX_TIMESTAMP()),
+	(254, 6247, '物質希釈策', UNIX_TIMESTAMP()),
+	(322, 5316, '生命拡張装置', UNIX_TIMESTAMP()),
+	(926, 2923, '情報遺伝子', UNIX_TIMESTAMP()),
+	(435, 434, '超高速伝送装置', UNIX_TIMESTAMP()),
+	(696, 695, '亜原生体研究', UNIX_TIMESTAMP()),
+	(574, 3570, '森林航空機', UNIX_TIMESTAMP()),
+	(658, 3654, '静脈応答制御', UNIX_TIMESTAMP()),
+	(790, 2787, '空中粒子加速器', UNIX_TIMESTAMP()),
+	(901, 900, '高次元共鳴機', UNIX_TIMESTAMP()),
+	(840, 1838, '心音波生成装置', UNIX_TIMESTAMP()),
+	(323, 322, '海底風力発電所', UNIX_TIMESTAMP()),
+	(583, 5577, '記憶再生器', UNIX_TIMESTAMP()),
+	(727, 6720, '有機放射線光速加速器', UNIX_TIMESTAMP()),
+	(119, 6112, '幽体投影装置', UNIX_TIMESTAMP()),
+	(221, 4216, '天候操馭機械', UNIX_TIMESTAMP()),
+	(189, 5183, '地下水製氧装置', UNIX_TIMESTAMP()),
+	(749, 2746, '心震動計測器', UNIX_TIMESTAMP()),
+	(171, 3167, '干渉反応解析', UNIX_TIMESTAMP()),
+	(269, 3265, '逸走因子制御機', UNIX_TIMESTAMP()),
+	(903, 6896, '超音波浮遊装置', UNIX_TIMESTAMP()),
+	(21, 6014, '環境音息制御装置', UNIX_TIMESTAMP()),
+	(417, 4412, '粉体加速装置', UNIX_TIMESTAMP()),
+	(314, 313, '量子離脱装置', UNIX_TIMESTAMP()),
+	(275, 7267, '極度化装置', UNIX_TIMESTAMP()),
+	(592, 3588, '磁気懸浮体', UNIX_TIMESTAMP()),
+	(253, 252, '超高密度合金', UNIX_TIMESTAMP()),
+	(848, 847, '高速分子加速器', UNIX_TIMESTAMP()),
+	(463, 1461, '情報漏洩防止層', UNIX_TIMESTAMP()),
+	(469, 3465, '陽子共鳴分光', UNIX_TIMESTAMP()),
+	(230, 6223, '感染抑制培養液', UNIX_TIMESTAMP()),
+	(554, 1552, '微小振動装置', UNIX_TIMESTAMP()),
+	(348, 4343, '即応型触媒', UNIX_TIMESTAMP()),
+	(984, 2981, '高感度位相計', UNIX_TIMESTAMP()),
+	(674, 5668, '水晶微粒子', UNIX_TIMESTAMP()),
+	(168, 1166, '精巧反転装置', UNIX_TIMESTAMP()),
+	(541, 2538, '光放出注入器', UNIX_TIMESTAMP()),
+	(371, 5365, '地震相互共鳴装置', UNIX_TIMESTAMP()),
+	(870, 5864, '情報順路誘導装置', UNIX_TIMESTAMP()),
+	(362, 7354, '加速生成解凍器', UNIX_TIMESTAMP()),
+	(468, 4463, '知覚限界統合装置', UNIX_TIMESTAMP()),
+	(765, 6758, '光伝搬効率器', UNIX_TIMESTAMP()),
+	(532, 2529, '生体適合修復剤', UNIX_TIMESTAMP()),
+	(45, 3041, '電位測定分光器', UNIX_TIMESTAMP()),
+	(623, 3619, '衝撃分散安定液体', UNIX_TIMESTAMP()),
+	(705, 3701, '魔力安定装置', UNIX_TIMESTAMP()),
+	(671, 2668, '晶桓対称体', UNIX_TIMESTAMP()),
+	(125, 3121, '昇華式燃料', UNIX_TIMESTAMP()),
+	(450, 449, '絶対結合力', UNIX_TIMESTAMP()),
+	(922, 3918, '鉄仮面症候群', UNIX_TIMESTAMP()),
+	(148, 5142, '時空転移機', UNIX_TIMESTAMP()),
+	(211, 5205, '液晶融接器', UNIX_TIMESTAMP()),
+	(431, 4426, '超重力波生成装置', UNIX_TIMESTAMP()),
+	(164, 7156, '絶対零度回路', UNIX_TIMESTAMP()),
+	(20, 4015, '情報時空解析器', UNIX_TIMESTAMP()),
+	(870, 869, '量子共鳴器', UNIX_TIMESTAMP()),
+	(678, 1676, '心地感覚共有機', UNIX_TIMESTAMP()),
+	(597, 6590, '超音波浮力推進機', UNIX_TIMESTAMP()),
+	(901, 900, '霊脈読解器', UNIX_TIMESTAMP()),
+	(119, 7111, '剣豪追撃術', UNIX_TIMESTAMP()),
+	(259, 1257, '精神感応術', UNIX_TIMESTAMP()),
+	(978, 6971, '暗黒冥界術', UNIX_TIMESTAMP()),
+	(353, 352, '陽光滅殺術', UNIX_TIMESTAMP()),
+	(843, 6836, '精霊召喚術', UNIX_TIMESTAMP()),
+	(520, 3516, '守護聖光術', UNIX_TIMESTAMP()),
+	(574, 1572, '幻想迷宮術', UNIX_TIMESTAMP()),
+	(468, 7460, '千手華殺掌', UNIX_TIMESTAMP()),
+	(434, 2431, '無限直交系', UNIX_TIMESTAMP()),
+	(652, 2649, '暗黒地球説', UNIX_TIMESTAMP()),
+	(460, 3456, '統合波動転送装置', UNIX_TIMESTAMP()),
+	(613, 5607, '時空共鳴体', UNIX_TIMESTAMP()),
+	(282, 4277, '量子形質進化', UNIX_TIMESTAMP()),
+	(334, 1332, '超効率磁気共鳴素子', UNIX_TIMESTAMP()),
+	(491, 4486, '磁場応答分光計', UNIX_TIMESTAMP()),
+	(578, 2575, '透明超光導素材', UNIX_TIMESTAMP()),
+	(617, 1615, '神聖物体感応誘導', UNIX_TIMESTAMP()),
+	(471, 5465, '未開次元知覚機関', UNIX_TIMESTAMP()),
+	(798, 1796, '無機酵素触媒', UNIX_TIMESTAMP()),
+	(613, 4608, '集約度指数', UNIX_TIMESTAMP()),
+	(431, 3427, '同位体反応制御', UNIX_TIMESTAMP()),
+	(561, 3557, '情報密度機能解析', UNIX_TIMESTAMP()),
+	(80, 1078, '透明超音波', UNIX_TIMESTAMP()),
+	(435, 1433, '無線双方透過', UNIX_TIMESTAMP()),
+	(464, 463, '固体変換装置', UNIX_TIMESTAMP()),
+	(357, 356, '線形最適化', UNIX_TIMESTAMP()),
+	(400, 3396, '不連続構造', UNIX_TIMESTAMP()),
+	(522, 2519, '導電体複合', UNIX_TIMESTAMP()),
+	(424, 2421, '爆発膜構造', UNIX_TIMESTAMP()),
+	(145, 4140, '温度差印加', UNIX_TIMESTAMP()),
+	(554, 6547, '超音波分散', UNIX_TIMESTAMP()),
+	(681, 1679, '非線形振動', UNIX_TIMESTAMP()),
+	(945, 944, '解像度増幅', UNIX_TIMESTAMP()),
+	(95, 4090, '灰霊濃度計', UNIX_TIMESTAMP()),
+	(615, 5609, '鉱鉄探知機', UNIX_TIMESTAMP()),
+	(883, 2880, '着氷防止鋼材', UNIX_TIMESTAMP()),
+	(774, 1772, '電磁共鳴構造体', UNIX_TIMESTAMP()),
+	(424, 3420, '氷晶膜形成器', UNIX_TIMESTAMP()),
+	(853, 5847, '無機重合結晶', UNIX_TIMESTAMP()),
+	(949, 3945, '高速宇宙移動器', UNIX_TIMESTAMP()),
+	(797, 5791, '発煙体感応素材', UNIX_TIMESTAMP()),
+	(335, 2332, '液体分子相互接合装置', UNIX_TIMESTAMP()),
+	(223, 5217, '超臨界流体濾過装置', UNIX_TIMESTAMP()),
+	(956, 3952, '無重力畑園', UNIX_TIMESTAMP()),
+	(631, 6624, '光結晶発振器', UNIX_TIMESTAMP()),
+	(92, 1090, '不穏有機体', UNIX_TIMESTAMP()),
+	(507, 2504, '神経研究領域用語', UNIX_TIMESTAMP()),
+	(33, 5027, '多重化負担指数', UNIX_TIMESTAMP()),
+	(98, 3094, '音響反射率曲線', UNIX_TIMESTAMP()),
+	(582, 1580, '火成岩寄生岩継手', UNIX_TIMESTAMP()),
+	(444, 7436, '社会関連視線分布', UNIX_TIMESTAMP()),
+	(360, 1358, '光合成率上昇補正器', UNIX_TIMESTAMP()),
+	(787, 3783, '磁気共鳴装置', UNIX_TIMESTAMP()),
+	(672, 4667, '素粒子状態', UNIX_TIMESTAMP()),
+	(527, 526, '音波共鳴装置', UNIX_TIMESTAMP()),
+	(533, 1531, '神経反応器', UNIX_TIMESTAMP()),
+	(41, 2038, '蒸気動力機', UNIX_TIMESTAMP()),
+	(915, 1913, '時空交差装置', UNIX_TIMESTAMP()),
+	(466, 4461, '情報衛星網', UNIX_TIMESTAMP()),
+	(355, 3351, '隠蔽粒子衝動装置', UNIX_TIMESTAMP()),
+	(207, 1205, '宇宙微生物研究所', UNIX_TIMESTAMP()),
+	(857, 5851, '感応光子通信方式', UNIX_TIMESTAMP()),
+	(616, 2613, '巨大植物発電施設', UNIX_TIMESTAMP()),
+	(303, 302, '超高速物理反応器', UNIX_TIMESTAMP()),
+	(410, 3406, '衛生給水装置', UNIX_TIMESTAMP()),
+	(39, 6032, '地下深層探査器', UNIX_TIMESTAMP()),
+	(944, 4939, '微生物統合制御', UNIX_TIMESTAMP()),
+	(507, 2504, '電磁波撹乱装置', UNIX_TIMESTAMP()),
+	(617, 4612, '重力制御装置', UNIX_TIMESTAMP()),
+	(13, 4008, '高分子合成触媒', UNIX_TIMESTAMP()),
+	(269, 2266, '綺羅星展開', UNIX_TIMESTAMP()),
+	(204, 203, '天音図書館', UNIX_TIMESTAMP()),
+	(881, 880, '神聖抹殺剣', UNIX_TIMESTAMP()),
+	(692, 2689, '暗示護身術', UNIX_TIMESTAMP()),
+	(746, 5740, '幻体発生装置', UNIX_TIMESTAMP()),
+	(571, 6564, '嵐翼飛行装置', UNIX_TIMESTAMP()),
+	(335, 334, '魔力集水器', UNIX_TIMESTAMP()),
+	(195, 194, '闇夜結晶球', UNIX_TIMESTAMP()),
+	(946, 5940, '円環催眠術', UNIX_TIMESTAMP()),
+	(900, 899, '声波制御器', UNIX_TIMESTAMP()),
+	(139, 3135, '神器再生術', UNIX_TIMESTAMP()),
+	(628, 1626, '直直三面角', UNIX_TIMESTAMP()),
+	(996, 3992, '撹拌許容率', UNIX_TIMESTAMP()),
+	(891, 5885, '健忘症候群', UNIX_TIMESTAMP()),
+	(359, 2356, '高分子合成', UNIX_TIMESTAMP()),
+	(465, 4460, '微生物培養', UNIX_TIMESTAMP()),
+	(748, 6741, '真宝石魔封', UNIX_TIMESTAMP()),
+	(371, 1369, '水晶騎士団', UNIX_TIMESTAMP()),
+	(631, 4626, '林檎摩天楼', UNIX_TIMESTAMP()),
+	(107, 3103, '音磁波変換', UNIX_TIMESTAMP()),
+	(480, 6473, '遺伝因子操術', UNIX_TIMESTAMP()),
+	(434, 4429, '光感応素材', UNIX_TIMESTAMP()),
+	(465, 2462, '磁気収束装置', UNIX_TIMESTAMP()),
+	(837, 1835, '音響双子実験', UNIX_TIMESTAMP()),
+	(334, 7326, '生体適応装置', UNIX_TIMESTAMP()),
+	(968, 1966, '深潜合二症', UNIX_TIMESTAMP()),
+	(595, 6588, '対等強制主義', UNIX_TIMESTAMP()),
+	(541, 5535, '酸化還元指数', UNIX_TIMESTAMP()),
+	(683, 4678, '文化適合度', UNIX_TIMESTAMP()),
+	(797, 1795, '複合乗算定理', UNIX_TIMESTAMP()),
+	(309, 2306, '効率最適化戦略', UNIX_TIMESTAMP()),
+	(914, 5908, '絶対変動定義', UNIX_TIMESTAMP()),
+	(735, 4730, '河川侵食速度', UNIX_TIMESTAMP()),
+	(903, 1901, '構築意図表現', UNIX_TIMESTAMP()),
+	(907, 1905, '空想潜在能力', UNIX_TIMESTAMP()),
+	(491, 2488, '疑似脳神経', UNIX_TIMESTAMP()),
+	(335, 1333, '宇宙時間軸', UNIX_TIMESTAMP()),
+	(457, 1455, '逆双子喪失', UNIX_TIMESTAMP()),
+	(706, 705, '核融合反応', UNIX_TIMESTAMP()),
+	(129, 128, '意識抽出術', UNIX_TIMESTAMP()),
+	(305, 3301, '脳神経抑制装置', UNIX_TIMESTAMP()),
+	(224, 6217, '熱帯農業予知機', UNIX_TIMESTAMP()),
+	(606, 6599, '音波周波数解析器', UNIX_TIMESTAMP()),
+	(54, 1052, '太陽線量計', UNIX_TIMESTAMP()),
+	(802, 1800, '網膜高解析度表示装置', UNIX_TIMESTAMP()),
+	(837, 836, '超音波振動子', UNIX_TIMESTAMP()),
+	(877, 2874, '心理言語処理器', UNIX_TIMESTAMP()),
+	(64, 2061, '地球共鳴調和', UNIX_TIMESTAMP()),
+	(742, 2739, '量子遺伝解析', UNIX_TIMESTAMP()),
+	(970, 969, '物質力場解析', UNIX_TIMESTAMP()),
+	(447, 446, '音声周波数解像度', UNIX_TIMESTAMP()),
+	(998, 4993, '光遮蔽装置', UNIX_TIMESTAMP()),
+	(533, 5527, '半永久保存薬', UNIX_TIMESTAMP()),
+	(700, 5694, '闇魔族研究', UNIX_TIMESTAMP()),
+	(99, 98, '時空共鳴装置', UNIX_TIMESTAMP()),
+	(888, 1886, '天結縁調律', UNIX_TIMESTAMP()),
+	(390, 4385, '古代生物振動子', UNIX_TIMESTAMP()),
+	(11, 10, '家族遺伝現象', UNIX_TIMESTAMP()),
+	(28, 5022, '水晶波動治療補助機', UNIX_TIMESTAMP()),
+	(445, 7437, '妖精遺伝子', UNIX_TIMESTAMP()),
+	(986, 3982, '禁術遺伝子研究', UNIX_TIMESTAMP()),
+	(560, 559, '神経連携術', UNIX_TIMESTAMP()),
+	(555, 6548, '心理干渉波', UNIX_TIMESTAMP()),
+	(377, 3373, '感情解析機械', UNIX_TIMESTAMP()),
+	(65, 1063, '意識転写機械', UNIX_TIMESTAMP()),
+	(860, 2857, '未来予測算術', UNIX_TIMESTAMP()),
+	(341, 6334, '空中航行体', UNIX_TIMESTAMP()),
+	(319, 318, '超感覚通信網', UNIX_TIMESTAMP()),
+	(41, 4036, '知覚高度測定器', UNIX_TIMESTAMP()),
+	(834, 3830, '生成力分析', UNIX_TIMESTAMP()),
+	(366, 7358, '空間干渉装置', UNIX_TIMESTAMP()),
+	(917, 2914, '潜在能力拡張装置', UNIX_TIMESTAMP()),
+	(293, 6286, '知覚拡張装置', UNIX_TIMESTAMP()),
+	(952, 4947, '那羅曼荼羅', UNIX_TIMESTAMP()),
+	(418, 4413, '般若波羅蜜', UNIX_TIMESTAMP()),
+	(829, 5823, '次元再構築', UNIX_TIMESTAMP()),
+	(956, 2953, '生態連鎖網', UNIX_TIMESTAMP()),
+	(309, 4304, '計算思考模範', UNIX_TIMESTAMP()),
+	(511, 4506, '明晰図書館', UNIX_TIMESTAMP()),
+	(727, 726, '再利用機能化', UNIX_TIMESTAMP()),
+	(698, 5692, '伝達電位抵抗', UNIX_TIMESTAMP()),
+	(422, 1420, '無界面解析', UNIX_TIMESTAMP()),
+	(754, 1752, '構造敵因子', UNIX_TIMESTAMP()),
+	(997, 1995, '細胞形態評価', UNIX_TIMESTAMP()),
+	(265, 7257, '転換年齢像', UNIX_TIMESTAMP()),
+	(258, 1256, '建材超擬態', UNIX_TIMESTAMP()),
+	(784, 6777, '多角度投影', UNIX_TIMESTAMP()),
+	(386, 7378, '空間情報傳遞', UNIX_TIMESTAMP()),
+	(725, 4720, '起動効率化', UNIX_TIMESTAMP()),
+	(784, 783, '機械振動吸収', UNIX_TIMESTAMP()),
+	(196, 5190, '熱伝達解析', UNIX_TIMESTAMP()),
+	(305, 5299, '構造安静化', UNIX_TIMESTAMP()),
+	(663, 2660, '燃料添加剤', UNIX_TIMESTAMP()),
+	(264, 3260, '光合成酵素', UNIX_TIMESTAMP()),
+	(642, 6635, '生育環境応答', UNIX_TIMESTAMP()),
+	(755, 3751, '生物相互作用', UNIX_TIMESTAMP()),
+	(294, 7286, '子孫継承力', UNIX_TIMESTAMP()),
+	(667, 2664, '非線形相互作用', UNIX_TIMESTAMP()),
+	(845, 3841, '発掘顕微鏡', UNIX_TIMESTAMP()),
+	(93, 5087, '天然材料分析器', UNIX_TIMESTAMP()),
+	(367, 3363, '生物組織融解装置', UNIX_TIMESTAMP()),
+	(779, 2776, '未来予知計算機', UNIX_TIMESTAMP()),
+	(349, 1347, '画像認識枕', UNIX_TIMESTAMP()),
+	(246, 4241, '自動運転指令装置', UNIX_TIMESTAMP()),
+	(288, 4283, '透明化薬剤', UNIX_TIMESTAMP()),
+	(136, 3132, '河川磁場計測器', UNIX_TIMESTAMP()),
+	(156, 1154, '固体分析機', UNIX_TIMESTAMP()),
+	(251, 4246, '超音速乱流流体', UNIX_TIMESTAMP()),
+	(144, 1142, '電荷透過係数', UNIX_TIMESTAMP()),
+	(189, 1187, '光速加速器', UNIX_TIMESTAMP()),
+	(58, 6051, '波動粒子相互作用挙動', UNIX_TIMESTAMP()),
+	(938, 6931, '溶解反応式定数', UNIX_TIMESTAMP()),
+	(827, 5821, '離島進化遺伝子', UNIX_TIMESTAMP()),
+	(255, 1253, '感覚再構築', UNIX_TIMESTAMP()),
+	(688, 2685, '記憶再構築', UNIX_TIMESTAMP()),
+	(693, 5687, '睡眠欠落症', UNIX_TIMESTAMP()),
+	(95, 7087, '生命信号検知器', UNIX_TIMESTAMP()),
+	(307, 3303, '加速度計測装置', UNIX_TIMESTAMP()),
+	(97, 96, '革命造形術', UNIX_TIMESTAMP()),
+	(706, 6699, '生体騒音分析', UNIX_TIMESTAMP()),
+	(312, 5306, '無機組織潤滑剤', UNIX_TIMESTAMP()),
+	(127, 7119, '感覚反射装置', UNIX_TIMESTAMP()),
+	(120, 1118, '宇宙星辰制御', UNIX_TIMESTAMP()),
+	(416, 7408, '空間共振装置', UNIX_TIMESTAMP()),
+	(783, 2780, '文明精神文化軌跡', UNIX_TIMESTAMP()),
+	(791, 4786, '超高分子合成材料', UNIX_TIMESTAMP()),
+	(962, 961, '知識連動神経網', UNIX_TIMESTAMP()),
+	(539, 5533, '微粒子分子信号', UNIX_TIMESTAMP()),
+	(659, 5653, '疑似次元風地図', UNIX_TIMESTAMP()),
+	(730, 5724, '細胞表面認識素子', UNIX_TIMESTAMP()),
+	(709, 4704, '意識合成共有体', UNIX_TIMESTAMP()),
+	(664, 5658, '逆脳反応状況分析', UNIX_TIMESTAMP()),
+	(402, 7394, '霧化発生器', UNIX_TIMESTAMP()),
+	(244, 7236, '衝撃吸収体', UNIX_TIMESTAMP()),
+	(732, 1730, '共鳴周波数', UNIX_TIMESTAMP()),
+	(205, 204, '無重力戦術', UNIX_TIMESTAMP()),
+	(771, 770, '記憶抹消装置', UNIX_TIMESTAMP()),
+	(691, 690, '生物統合通信', UNIX_TIMESTAMP()),
+	(306, 305, '創造思考機', UNIX_TIMESTAMP()),
+	(867, 2864, '情報公開制度', UNIX_TIMESTAMP()),
+	(222, 221, '仮想粒子生成', UNIX_TIMESTAMP()),
+	(90, 2087, '蒸気融合装置', UNIX_TIMESTAMP()),
+	(472, 471, '原風圏探査機', UNIX_TIMESTAMP()),
+	(494, 3490, '海霧変換装置', UNIX_TIMESTAMP()),
+	(978, 6971, '双輪心拍監視器', UNIX_TIMESTAMP()),
+	(893, 3889, '射精抑制処置装置', UNIX_TIMESTAMP()),
+	(370, 4365, '熱気炉冷却装置', UNIX_TIMESTAMP()),
+	(200, 199, '磁場制御器', UNIX_TIMESTAMP()),
+	(716, 715, '星間断層装置', UNIX_TIMESTAMP()),
+	(876, 2873, '奇点巡航装置', UNIX_TIMESTAMP()),
+	(22, 2019, '分子振動計測器', UNIX_TIMESTAMP()),
+	(760, 4755, '電磁波遮断装置', UNIX_TIMESTAMP()),
+	(87, 3083, '光束演算装置', UNIX_TIMESTAMP()),
+	(857, 1855, '星間光速推進機', UNIX_TIMESTAMP()),
+	(822, 5816, '原子核結合生成器', UNIX_TIMESTAMP()),
+	(363, 6356, '革命位相変換機', UNIX_TIMESTAMP()),
+	(878, 1876, '共鳴反応阻止装置', UNIX_TIMESTAMP()),
+	(156, 7148, '触媒変換装置', UNIX_TIMESTAMP()),
+	(123, 4118, '超音速霧化装置', UNIX_TIMESTAMP()),
+	(523, 5517, '情報解析器', UNIX_TIMESTAMP()),
+	(309, 3305, '空気凝固器', UNIX_TIMESTAMP()),
+	(875, 874, '燃焼誘導装置', UNIX_TIMESTAMP()),
+	(138, 3134, '電磁波遮断剤', UNIX_TIMESTAMP()),
+	(625, 3621, '音響干渉素子', UNIX_TIMESTAMP()),
+	(689, 2686, '経済重力場', UNIX_TIMESTAMP()),
+	(999, 998, '記憶再構築装置', UNIX_TIMESTAMP()),
+	(604, 5598, '空間同期装置', UNIX_TIMESTAMP()),
+	(641, 1639, '物理組織再生装置', UNIX_TIMESTAMP()),
+	(929, 4924, '知覚増幅器', UNIX_TIMESTAMP()),
+	(28, 3024, '逆位相駆動装置', UNIX_TIMESTAMP()),
+	(753, 6746, '綺羅屋風剣', UNIX_TIMESTAMP()),
+	(430, 2427, '瞬間移動闘技', UNIX_TIMESTAMP()),
+	(418, 4413, '無機光通信', UNIX_TIMESTAMP()),
+	(401, 5395, '脳波共鳴装置', UNIX_TIMESTAMP()),
+	(69, 7061, '情報纏集器', UNIX_TIMESTAMP()),
+	(279, 5273, '風力静電発電', UNIX_TIMESTAMP()),
+	(267, 7259, '記憶蒸留器', UNIX_TIMESTAMP()),
+	(250, 6243, '分散認識器', UNIX_TIMESTAMP()),
+	(373, 5367, '情報屑回収装置', UNIX_TIMESTAMP()),
+	(518, 4513, '無色光反射強度計', UNIX_TIMESTAMP()),
+	(472, 2469, '島状転位制御装置', UNIX_TIMESTAMP()),
+	(87, 7079, '無錫反射集束装置', UNIX_TIMESTAMP()),
+	(593, 3589, '内蔵造反反射装置', UNIX_TIMESTAMP()),
+	(324, 7316, '潜在意思通信装置', UNIX_TIMESTAMP()),
+	(533, 1531, '非対称魔術素子', UNIX_TIMESTAMP()),
+	(976, 2973, '超共振波動装置', UNIX_TIMESTAMP()),
+	(456, 4451, '無線明滅暗号', UNIX_TIMESTAMP()),
+	(413, 3409, '空間時移変換器', UNIX_TIMESTAMP()),
+	(961, 5955, '精神心理界面', UNIX_TIMESTAMP()),
+	(771, 3767, '超重力遮蔽物', UNIX_TIMESTAMP()),
+	(82, 6075, '赤外社会通信', UNIX_TIMESTAMP()),
+	(869, 1867, '超高密度液体結晶', UNIX_TIMESTAMP()),
+	(888, 5882, '電磁気反応制御装置', UNIX_TIMESTAMP()),
+	(294, 5288, '記憶増幅回路', UNIX_TIMESTAMP()),
+	(956, 1954, '高分子量同位体分析', UNIX_TIMESTAMP()),
+	(768, 5762, '超音波空間掃引装置', UNIX_TIMESTAMP()),
+	(114, 4109, '瞑想超遷気術', UNIX_TIMESTAMP()),
+	(316, 3312, '古代遺伝子組換', UNIX_TIMESTAMP()),
+	(56, 2053, '量子融合体', UNIX_TIMESTAMP()),
+	(620, 619, '生命創造装置', UNIX_TIMESTAMP()),
+	(971, 2968, '次元剪断器', UNIX_TIMESTAMP()),
+	(590, 2587, '創造協和器', UNIX_TIMESTAMP()),
+	(890, 889, '過去再生装置', UNIX_TIMESTAMP()),
+	(767, 1765, '禁断魔導書', UNIX_TIMESTAMP()),
+	(397, 4392, '無重力照明', UNIX_TIMESTAMP()),
+	(386, 2383, '反重力浮遊装置', UNIX_TIMESTAMP()),
+	(630, 4625, '薬剤処理精度', UNIX_TIMESTAMP()),
+	(874, 2871, '音響構造解析', UNIX_TIMESTAMP()),
+	(496, 2493, '遺伝情報解読', UNIX_TIMESTAMP()),
+	(895, 894, '心理映像再生', UNIX_TIMESTAMP()),
+	(68, 6061, '無重力発電', UNIX_TIMESTAMP()),
+	(165, 2162, '非線形波動分析', UNIX_TIMESTAMP()),
+	(809, 2806, '長寿遺伝子育種', UNIX_TIMESTAMP()),
+	(355, 4350, '信号着色処理', UNIX_TIMESTAMP()),
+	(990, 4985, '空中滞在誘導', UNIX_TIMESTAMP()),
+	(125, 6118, '多様体大脳認識', UNIX_TIMESTAMP()),
+	(962, 5956, '奇跡発生装置', UNIX_TIMESTAMP()),
+	(265, 2262, '時間加速補正', UNIX_TIMESTAMP()),
+	(112, 2109, '無意識操作装置', UNIX_TIMESTAMP()),
+	(672, 4667, '恒準式管理', UNIX_TIMESTAMP()),
+	(344, 5338, '無駄合理化', UNIX_TIMESTAMP()),
+	(908, 4903, '掐電般負荷', UNIX_TIMESTAMP()),
+	(922, 4917, '拡張選択集合', UNIX_TIMESTAMP()),
+	(510, 3506, '生物興味産業', UNIX_TIMESTAMP()),
+	(604, 1602, '実体関連構造解析', UNIX_TIMESTAMP()),
+	(394, 5388, '効率的再帰反応発現', UNIX_TIMESTAMP()),
+	(82, 2079, '細菌捕獲網', UNIX_TIMESTAMP()),
+	(288, 2285, '音波検証器', UNIX_TIMESTAMP()),
+	(863, 3859, '大気自動絮状装置', UNIX_TIMESTAMP()),
+	(437, 5431, '経済衛星網', UNIX_TIMESTAMP()),
+	(672, 3668, '衛生保全器', UNIX_TIMESTAMP()),
+	(220, 4215, '静電充電体', UNIX_TIMESTAMP()),
+	(756, 5750, '地域防疫戦略', UNIX_TIMESTAMP()),
+	(400, 399, '臓器再生医療', UNIX_TIMESTAMP()),
+	(617, 4612, '心霊感応力', UNIX_TIMESTAMP()),
+	(179, 5173, '生体融合器', UNIX_TIMESTAMP()),
+	(856, 6849, '時空干渉装置', UNIX_TIMESTAMP()),
+	(473, 2470, '量子生成器', UNIX_TIMESTAMP()),
+	(802, 5796, '遺伝子修復装置', UNIX_TIMESTAMP()),
+	(175, 7167, '精神透視術', UNIX_TIMESTAMP()),
+	(17, 1015, '原子再編機', UNIX_TIMESTAMP()),
+	(716, 4711, '細胞再生剤', UNIX_TIMESTAMP()),
+	(716, 6709, '亜音速飛行装置', UNIX_TIMESTAMP()),
+	(723, 4718, '超古代遺物', UNIX_TIMESTAMP()),
+	(902, 3898, '無重力空間', UNIX_TIMESTAMP()),
+	(218, 7210, '磁力浮上装置', UNIX_TIMESTAMP()),
+	(131, 1129, '逆転因果装置', UNIX_TIMESTAMP()),
+	(650, 6643, '超音波治療器', UNIX_TIMESTAMP()),
+	(216, 6209, '意識降下装置', UNIX_TIMESTAMP()),
+	(795, 2792, '未知生命体探査機', UNIX_TIMESTAMP()),
+	(668, 667, '空中重力操作装置', UNIX_TIMESTAMP()),
+	(402, 401, '風味発酵器', UNIX_TIMESTAMP()),
+	(45, 5039, '時空断裂装置', UNIX_TIMESTAMP()),
+	(815, 1813, '音色変換器', UNIX_TIMESTAMP()),
+	(783, 3779, '竜巻制御装置', UNIX_TIMESTAMP()),
+	(466, 1464, '電子花火舞台', UNIX_TIMESTAMP()),
+	(139, 138, '星間通信塔', UNIX_TIMESTAMP()),
+	(917, 1915, '古生物再生器', UNIX_TIMESTAMP()),
+	(870, 869, '心拍観測機', UNIX_TIMESTAMP()),
+	(36, 7028, '無重力農業装置', UNIX_TIMESTAMP()),
+	(582, 4577, '透明光通信機', UNIX_TIMESTAMP()),
+	(146, 3142, '羽化誘引剤', UNIX_TIMESTAMP()),
+	(828, 4823, '直感増幅装置', UNIX_TIMESTAMP()),
+	(408, 7400, '錬金機械鍛冶', UNIX_TIMESTAMP()),
+	(363, 6356, '神経衝撃術', UNIX_TIMESTAMP()),
+	(292, 7284, '真空冷凍装置', UNIX_TIMESTAMP()),
+	(901, 2898, '音楽共鳴器', UNIX_TIMESTAMP()),
+	(527, 4522, '知識干渉機器', UNIX_TIMESTAMP()),
+	(444, 7436, '遺伝子研究', UNIX_TIMESTAMP()),
+	(285, 1283, '非意識分析', UNIX_TIMESTAMP()),
+	(387, 4382, '社会動態分析', UNIX_TIMESTAMP()),
+	(302, 301, '地域環境調査', UNIX_TIMESTAMP()),
+	(32, 31, '非鉄微粒子', UNIX_TIMESTAMP()),
+	(540, 2537, '微生物相互作用', UNIX_TIMESTAMP()),
+	(412, 2409, '細胞連結蛋白', UNIX_TIMESTAMP()),
+	(262, 7254, '相関最適化', UNIX_TIMESTAMP()),
+	(724, 5718, '酸化状態推定', UNIX_TIMESTAMP()),
+	(140, 139, '断裂構造分析', UNIX_TIMESTAMP()),
+	(356, 2353, '炭水化物相互作用', UNIX_TIMESTAMP()),
+	(703, 6696, '芳香族炭化水素', UNIX_TIMESTAMP()),
+	(407, 406, '細胞骨格形成', UNIX_TIMESTAMP()),
+	(70, 69, '音楽感応器', UNIX_TIMESTAMP()),
+	(368, 1366, '映像電子化装置', UNIX_TIMESTAMP()),
+	(746, 745, '生命再生酵素', UNIX_TIMESTAMP()),
+	(812, 6805, '幻想翼装甲', UNIX_TIMESTAMP()),
+	(432, 1430, '時空曲線推算', UNIX_TIMESTAMP()),
+	(69, 6062, '天体転移装置', UNIX_TIMESTAMP()),
+	(500, 499, '量子透過窓', UNIX_TIMESTAMP()),
+	(987, 986, '神聖結晶体', UNIX_TIMESTAMP()),
+	(692, 6685, '姿勢制御装置', UNIX_TIMESTAMP()),
+	(913, 5907, '鏡像反応場', UNIX_TIMESTAMP()),
+	(669, 5663, '究極精神合体', UNIX_TIMESTAMP()),
+	(190, 5184, '宇宙時空潜航', UNIX_TIMESTAMP()),
+	(718, 4713, '平衡維持装置', UNIX_TIMESTAMP()),
+	(108, 7100, '精神再補充', UNIX_TIMESTAMP()),
+	(936, 3932, '超音波微動', UNIX_TIMESTAMP()),
+	(964, 5958, '新素材量子化', UNIX_TIMESTAMP()),
+	(541, 540, '色彩情報化', UNIX_TIMESTAMP()),
+	(662, 4657, '熱帯冷暖化', UNIX_TIMESTAMP()),
+	(452, 6445, '硬錐前子毛', UNIX_TIMESTAMP()),
+	(823, 2820, '順時硬分歩', UNIX_TIMESTAMP()),
+	(287, 4282, '植物細面再生', UNIX_TIMESTAMP()),
+	(281, 5275, '心拍減速症候群', UNIX_TIMESTAMP()),
+	(965, 4960, '磁気共鳴細胞分析', UNIX_TIMESTAMP()),
+	(508, 6501, '高分子補充発酵', UNIX_TIMESTAMP()),
+	(164, 6157, '脳神経調整軌跡', UNIX_TIMESTAMP()),
+	(734, 2731, '空気界面振動', UNIX_TIMESTAMP()),
+	(801, 4796, '有機膜微生物相互作用', UNIX_TIMESTAMP()),
+	(683, 1681, '微粒子電流制御装置', UNIX_TIMESTAMP()),
+	(310, 309, '衛生局制指数評価', UNIX_TIMESTAMP()),
+	(968, 967, '波動選択加速損失', UNIX_TIMESTAMP()),
+	(547, 2544, '流体絶縁暖房素子', UNIX_TIMESTAMP()),
+	(997, 3993, '構造振動強度評価', UNIX_TIMESTAMP()),
+	(387, 7379, '金字塔修復', UNIX_TIMESTAMP()),
+	(295, 3291, '心理共同体', UNIX_TIMESTAMP()),
+	(123, 3119, '創造方程式', UNIX_TIMESTAMP()),
+	(876, 5870, '超時空探索', UNIX_TIMESTAMP()),
+	(267, 4262, '古代文献解読', UNIX_TIMESTAMP()),
+	(787, 4782, '覚醒生命体', UNIX_TIMESTAMP()),
+	(170, 3166, '密度分布解析', UNIX_TIMESTAMP()),
+	(268, 1266, '音響行動解析', UNIX_TIMESTAMP()),
+	(973, 4968, '局所空色解析', UNIX_TIMESTAMP()),
+	(981, 3977, '音節構造化', UNIX_TIMESTAMP()),
+	(146, 1144, '心理空間分析', UNIX_TIMESTAMP()),
+	(626, 6619, '混成音響分析', UNIX_TIMESTAMP()),
+	(224, 4219, '同調脳崩壊', UNIX_TIMESTAMP()),
+	(966, 3962, '情報統合体', UNIX_TIMESTAMP()),
+	(120, 5114, '集合思考器', UNIX_TIMESTAMP()),
+	(306, 6299, '最適経路密度', UNIX_TIMESTAMP()),
+	(441, 6434, '脳波検知器', UNIX_TIMESTAMP()),
+	(26, 5020, '無線波無効化', UNIX_TIMESTAMP()),
+	(103, 2100, '磁化共鳴衛星', UNIX_TIMESTAMP()),
+	(491, 7483, '暗黒物質変換', UNIX_TIMESTAMP()),
+	(331, 4326, '情報磁力場', UNIX_TIMESTAMP()),
+	(746, 4741, '電磁拡散鏡', UNIX_TIMESTAMP()),
+	(60, 5054, '量子忘却装置', UNIX_TIMESTAMP()),
+	(465, 2462, '空間折畳装置', UNIX_TIMESTAMP()),
+	(493, 2490, '量子暗号通信', UNIX_TIMESTAMP()),
+	(767, 1765, '電磁遮蔽物質', UNIX_TIMESTAMP()),
+	(70, 5064, '表現生命力指標', UNIX_TIMESTAMP()),
+	(17, 5011, '社会行動可視化', UNIX_TIMESTAMP()),
+	(943, 4938, '顔面情報認識', UNIX_TIMESTAMP()),
+	(895, 4890, '蒸気圧減少装置', UNIX_TIMESTAMP()),
+	(260, 3256, '絶縁係数算出器', UNIX_TIMESTAMP()),
+	(661, 4656, '振動補償装置', UNIX_TIMESTAMP()),
+	(988, 5982, '熱伝導率測定器', UNIX_TIMESTAMP()),
+	(392, 7384, '腐食速度解析装置', UNIX_TIMESTAMP()),
+	(103, 102, '摩擦係数評価装置', UNIX_TIMESTAMP()),
+	(333, 3329, '高電圧絶縁材料', UNIX_TIMESTAMP()),
+	(569, 3565, '酸化還元反応速度係数', UNIX_TIMESTAMP()),
+	(634, 4629, '比熱容量測定装置', UNIX_TIMESTAMP()),
+	(960, 1958, '物質腐敗抑制装置', UNIX_TIMESTAMP()),
+	(207, 6200, '獲得効率計測器', UNIX_TIMESTAMP()),
+	(458, 3454, '自己修復材料', UNIX_TIMESTAMP()),
+	(316, 7308, '酸化還元電位測定器', UNIX_TIMESTAMP()),
+	(660, 6653, '分光透過率解析装置', UNIX_TIMESTAMP()),
+	(584, 5578, '電子伝導率測定器', UNIX_TIMESTAMP()),
+	(165, 4160, '熱透過値評価装置', UNIX_TIMESTAMP()),
+	(703, 3699, '逆衝撃加工', UNIX_TIMESTAMP()),
+	(216, 1214, '心音位相分析', UNIX_TIMESTAMP()),
+	(35, 7027, '量子原風化', UNIX_TIMESTAMP()),
+	(547, 6540, '空間相対積曲線', UNIX_TIMESTAMP()),
+	(876, 3872, '心理的嗅覚反転', UNIX_TIMESTAMP()),
+	(723, 2720, '寿命半減染色体', UNIX_TIMESTAMP()),
+	(70, 69, '幻覚融合効果', UNIX_TIMESTAMP()),
+	(257, 1255, '多重空間移動', UNIX_TIMESTAMP()),
+	(585, 1583, '震動神経叢', UNIX_TIMESTAMP()),
+	(879, 3875, '意識量子共鳴', UNIX_TIMESTAMP()),
+	(854, 2851, '複眼投射干渉', UNIX_TIMESTAMP()),
+	(505, 3501, '宇宙膨張効果', UNIX_TIMESTAMP()),
+	(913, 912, '地震活動予測指標', UNIX_TIMESTAMP()),
+	(921, 2918, '奇術症候群', UNIX_TIMESTAMP()),
+	(760, 6753, '時間逆転装置', UNIX_TIMESTAMP()),
+	(176, 5170, '宇宙充電所', UNIX_TIMESTAMP()),
+	(368, 2365, '情報解凍症', UNIX_TIMESTAMP()),
+	(590, 5584, '微細振動子', UNIX_TIMESTAMP()),
+	(76, 75, '対象猪突病', UNIX_TIMESTAMP()),
+	(953, 952, '超音波鱗片', UNIX_TIMESTAMP()),
+	(677, 5671, '冷凍遺伝子', UNIX_TIMESTAMP()),
+	(280, 3276, '超共鳴磁気', UNIX_TIMESTAMP()),
+	(83, 7075, '透明非鳥皮虫', UNIX_TIMESTAMP()),
+	(333, 1331, '心臓賦活素', UNIX_TIMESTAMP()),
+	(20, 1018, '調律方程式', UNIX_TIMESTAMP()),
+	(738, 1736, '溶解熱鉄塔', UNIX_TIMESTAMP()),
+	(924, 2921, '無次元圧力頭', UNIX_TIMESTAMP()),
+	(155, 7147, '球体広告追跡', UNIX_TIMESTAMP()),
+	(32, 7024, '非平衡振動子', UNIX_TIMESTAMP()),
+	(709, 708, '心室肺心室弁', UNIX_TIMESTAMP()),
+	(217, 4212, '植物適応燃焼', UNIX_TIMESTAMP()),
+	(392, 5386, '交感神経後軸', UNIX_TIMESTAMP()),
+	(874, 2871, '離散形磁気能力', UNIX_TIMESTAMP()),
+	(506, 505, '非常拡張記憶体', UNIX_TIMESTAMP()),
+	(810, 809, '空中柱状雲', UNIX_TIMESTAMP()),
+	(939, 4934, '無常紋構造', UNIX_TIMESTAMP()),
+	(133, 132, '陽電子励起', UNIX_TIMESTAMP()),
+	(621, 3617, '高密度梱包', UNIX_TIMESTAMP()),
+	(101, 2098, '電磁粒子誘導', UNIX_TIMESTAMP()),
+	(522, 2519, '相対共鳴軌道', UNIX_TIMESTAMP()),
+	(463, 3459, '透明転移層', UNIX_TIMESTAMP()),
+	(421, 7413, '直線一体変形', UNIX_TIMESTAMP()),
+	(515, 3511, '遺伝符号解析', UNIX_TIMESTAMP()),
+	(608, 6601, '磁気急速磨耗', UNIX_TIMESTAMP()),
+	(936, 6929, '物質逆転装置', UNIX_TIMESTAMP()),
+	(63, 3059, '疾走風連技', UNIX_TIMESTAMP()),
+	(526, 525, '音波解析装置', UNIX_TIMESTAMP()),
+	(950, 5944, '無限次元演算', UNIX_TIMESTAMP()),
+	(913, 4908, '微細分子加工器', UNIX_TIMESTAMP()),
+	(226, 3222, '空間転移装置', UNIX_TIMESTAMP()),
+	(639, 638, '非実体計算機', UNIX_TIMESTAMP()),
+	(195, 7187, '九曜守護陣', UNIX_TIMESTAMP()),
+	(186, 7178, '双子融合体', UNIX_TIMESTAMP()),
+	(130, 2127, '天使羽翼装置', UNIX_TIMESTAMP()),
+	(250, 1248, '潜在能力覚醒剤', UNIX_TIMESTAMP()),
+	(446, 445, '霊子制御術', UNIX_TIMESTAMP()),
+	(304, 4299, '星霊図書館', UNIX_TIMESTAMP()),
+	(699, 698, '時空演算術', UNIX_TIMESTAMP()),
+	(588, 587, '意識共有体', UNIX_TIMESTAMP()),
+	(357, 356, '満福感指標', UNIX_TIMESTAMP()),
+	(123, 4118, '美的共振器', UNIX_TIMESTAMP()),
+	(738, 2735, '潜在意味解析', UNIX_TIMESTAMP()),
+	(492, 1490, '類似具現化解析', UNIX_TIMESTAMP()),
+	(912, 5906, '無限可能定理', UNIX_TIMESTAMP()),
+	(175, 6168, '心因反射機構', UNIX_TIMESTAMP()),
+	(515, 6508, '社会結合促進策', UNIX_TIMESTAMP()),
+	(948, 1946, '思考強化器', UNIX_TIMESTAMP()),
+	(5, 3001, '意味体系分析', UNIX_TIMESTAMP()),
+	(196, 195, '夢浄尊輪功', UNIX_TIMESTAMP()),
+	(444, 7436, '天影琉璃氷', UNIX_TIMESTAMP()),
+	(162, 161, '蒸気動力機関', UNIX_TIMESTAMP()),
+	(13, 4008, '暗号脳波解析', UNIX_TIMESTAMP()),
+	(924, 3920, '無限光線発生装置', UNIX_TIMESTAMP()),
+	(921, 4916, '心理情報干渉器', UNIX_TIMESTAMP()),
+	(731, 5725, '希薄燃料注入器', UNIX_TIMESTAMP()),
+	(636, 4631, '創造力刺激装置', UNIX_TIMESTAMP()),
+	(573, 5567, '超音波構造検査器', UNIX_TIMESTAMP()),
+	(583, 6576, '可変質量加速装置', UNIX_TIMESTAMP()),
+	(953, 4948, '燃焼熱関数解析装置', UNIX_TIMESTAMP()),
+	(2, 1999, '音律修正装置', UNIX_TIMESTAMP()),
+	(16, 3012, '時空誘導器', UNIX_TIMESTAMP()),
+	(90, 3086, '触手感染現象', UNIX_TIMESTAMP()),
+	(435, 434, '空中浮遊粉末', UNIX_TIMESTAMP()),
+	(107, 1105, '百鬼夜行地図', UNIX_TIMESTAMP()),
+	(239, 6232, '記憶再編装置', UNIX_TIMESTAMP()),
+	(735, 4730, '悪魔降誕符', UNIX_TIMESTAMP()),
+	(732, 6725, '妖精触媒石', UNIX_TIMESTAMP()),
+	(367, 2364, '夢幻多重層', UNIX_TIMESTAMP()),
+	(222, 3218, '運命契約書', UNIX_TIMESTAMP()),
+	(365, 1363, '電脳連祭装置', UNIX_TIMESTAMP()),
+	(701, 4696, '神聖結界頭巾', UNIX_TIMESTAMP()),
+	(458, 6451, '龍門防御盾', UNIX_TIMESTAMP()),
+	(775, 4770, '詩篇念動機構', UNIX_TIMESTAMP()),
+	(571, 6564, '霊体結晶化剤', UNIX_TIMESTAMP()),
+	(925, 4920, '真実捜査器', UNIX_TIMESTAMP()),
+	(372, 3368, '神秘生物召喚渾身術', UNIX_TIMESTAMP()),
+	(235, 5229, '終末予知器', UNIX_TIMESTAMP()),
+	(691, 2688, '雲散霧消構造材料', UNIX_TIMESTAMP()),
+	(952, 1950, '傾斜抵抗圧力計', UNIX_TIMESTAMP()),
+	(586, 6579, '応力波動透過装置', UNIX_TIMESTAMP()),
+	(47, 5041, '磁気反転電子加速器', UNIX_TIMESTAMP()),
+	(994, 993, '斜向磁気共鳴回路', UNIX_TIMESTAMP()),
+	(217, 6210, '高分子自己復元触媒', UNIX_TIMESTAMP()),
+	(413, 2410, '無制御自律蒸発装置', UNIX_TIMESTAMP()),
+	(498, 4493, '回転誘起自己撹拌筐体', UNIX_TIMESTAMP()),
+	(126, 3122, '膜状誘電体散逸層', UNIX_TIMESTAMP()),
+	(354, 7346, '粒子状温度制御材料', UNIX_TIMESTAMP()),
+	(908, 6901, '記憶再生研究所', UNIX_TIMESTAMP()),
+	(548, 547, '電磁波干渉防止装置', UNIX_TIMESTAMP()),
+	(503, 502, '遺伝解析響', UNIX_TIMESTAMP()),
+	(501, 4496, '無重力力場', UNIX_TIMESTAMP()),
+	(63, 2060, '水晶転送装置', UNIX_TIMESTAMP()),
+	(877, 3873, '生体拡張装置', UNIX_TIMESTAMP()),
+	(355, 5349, '幻覚現象抑制装置', UNIX_TIMESTAMP()),
+	(371, 6364, '音速飛距離測定器', UNIX_TIMESTAMP()),
+	(547, 4542, '万能思考装置', UNIX_TIMESTAMP()),
+	(246, 3242, '透明幻影陣', UNIX_TIMESTAMP()),
+	(24, 7016, '絡糸固定装置', UNIX_TIMESTAMP()),
+	(700, 1698, '生体振動制御装置', UNIX_TIMESTAMP()),
+	(251, 5245, '量子粒子捕獲装置', UNIX_TIMESTAMP()),
+	(259, 4254, '声紋変換機', UNIX_TIMESTAMP()),
+	(792, 791, '情報魔術師', UNIX_TIMESTAMP()),
+	(143, 7135, '仮想行動界面', UNIX_TIMESTAMP()),
+	(669, 6662, '空腹衝動制御', UNIX_TIMESTAMP()),
+	(149, 7141, '微細結晶構造体', UNIX_TIMESTAMP()),
+	(900, 1898, '偽装空間視覚化', UNIX_TIMESTAMP()),
+	(444, 5438, '意識遠隔制御', UNIX_TIMESTAMP()),
+	(742, 741, '界面融合装置', UNIX_TIMESTAMP()),
+	(797, 2794, '振動共鳴封鎖', UNIX_TIMESTAMP()),
+	(490, 489, '電磁透明鏡面素材', UNIX_TIMESTAMP()),
+	(236, 6229, '高密度光応答粒子体', UNIX_TIMESTAMP()),
+	(457, 3453, '心相互通信', UNIX_TIMESTAMP()),
+	(889, 888, '精算積載術', UNIX_TIMESTAMP()),
+	(722, 2719, '憂鬱症候群', UNIX_TIMESTAMP()),
+	(178, 177, '水素結合解消装置', UNIX_TIMESTAMP()),
+	(415, 7407, '微細粒子転送装置', UNIX_TIMESTAMP()),
+	(86, 5080, '電子鎖定装置', UNIX_TIMESTAMP()),
+	(14, 1012, '超応力合成材料', UNIX_TIMESTAMP()),
+	(192, 4187, '自動神経解析', UNIX_TIMESTAMP()),
+	(191, 2188, '光子充電器', UNIX_TIMESTAMP()),
+	(391, 7383, '超音速水中移動装置', UNIX_TIMESTAMP()),
+	(648, 647, '有機生体補助装置', UNIX_TIMESTAMP()),
+	(554, 1552, '超伝導稀土合金', UNIX_TIMESTAMP()),
+	(62, 2059, '量子暗号解読装置', UNIX_TIMESTAMP()),
+	(12, 2009, '心臓鍼灸術', UNIX_TIMESTAMP()),
+	(244, 1242, '水界探査術', UNIX_TIMESTAMP()),
+	(672, 1670, '脳波偏向症候群', UNIX_TIMESTAMP()),
+	(340, 5334, '水蒸気帯域', UNIX_TIMESTAMP()),
+	(262, 1260, '世代連鎖効果', UNIX_TIMESTAMP()),
+	(388, 2385, '信頼鎖脱落概念', UNIX_TIMESTAMP()),
+	(667, 1665, '彫刻解体主義', UNIX_TIMESTAMP()),
+	(9, 6002, '地震活動率', UNIX_TIMESTAMP()),
+	(123, 2120, '発話文構造', UNIX_TIMESTAMP()),
+	(94, 3090, '認知評価指数', UNIX_TIMESTAMP()),
+	(665, 1663, '無調音階分析', UNIX_TIMESTAMP()),
+	(626, 1624, '液晶化装置', UNIX_TIMESTAMP()),
+	(514, 2511, '電漿磁装甲', UNIX_TIMESTAMP()),
+	(978, 5972, '暗黒生物体', UNIX_TIMESTAMP()),
+	(248, 3244, '心霊透明体', UNIX_TIMESTAMP()),
+	(123, 4118, '記録補完装置', UNIX_TIMESTAMP()),
+	(337, 3333, '反強力震動', UNIX_TIMESTAMP()),
+	(513, 2510, '精神同調機構', UNIX_TIMESTAMP()),
+	(570, 569, '微生物排斥素', UNIX_TIMESTAMP()),
+	(680, 3676, '解発式電磁鞭', UNIX_TIMESTAMP()),
+	(891, 890, '強制計測機関', UNIX_TIMESTAMP()),
+	(776, 5770, '超音速禁止圏', UNIX_TIMESTAMP()),
+	(240, 239, '高荷電束縛体', UNIX_TIMESTAMP()),
+	(155, 6148, '連続徴壁装置', UNIX_TIMESTAMP()),
+	(846, 5840, '双核結晶体', UNIX_TIMESTAMP()),
+	(871, 6864, '爆裂霧化機器', UNIX_TIMESTAMP()),
+	(739, 5733, '集合矢構造', UNIX_TIMESTAMP()),
+	(81, 5075, '独立操縦遊星', UNIX_TIMESTAMP()),
+	(179, 5173, '宇宙航行概念', UNIX_TIMESTAMP()),
+	(328, 327, '爆熱放電装甲', UNIX_TIMESTAMP()),
+	(94, 7086, '分割反応装置', UNIX_TIMESTAMP()),
+	(377, 6370, '光子反帰装置', UNIX_TIMESTAMP()),
+	(275, 5269, '魔力抽出術', UNIX_TIMESTAMP()),
+	(288, 4283, '心霊感知器', UNIX_TIMESTAMP()),
+	(595, 2592, '陽光治療術', UNIX_TIMESTAMP()),
+	(317, 3313, '星間磁気制御', UNIX_TIMESTAMP()),
+	(271, 4266, '時空干渉装置', UNIX_TIMESTAMP()),
+	(870, 2867, '情報共有魔方陣', UNIX_TIMESTAMP()),
+	(597, 596, '生命再構築術', UNIX_TIMESTAMP()),
+	(743, 1741, '記憶削除装置', UNIX_TIMESTAMP()),
+	(742, 741, '色彩感知能力', UNIX_TIMESTAMP()),
+	(782, 3778, '音波共鳴器', UNIX_TIMESTAMP()),
+	(229, 3225, '電子風習探知機', UNIX_TIMESTAMP()),
+	(347, 7339, '呪文解読機', UNIX_TIMESTAMP()),
+	(80, 2077, '地質予知装置', UNIX_TIMESTAMP()),
+	(221, 2218, '熱帯気候制御', UNIX_TIMESTAMP()),
+	(490, 3486, '高密度蓄電池', UNIX_TIMESTAMP()),
+	(890, 5884, '時空間転送機', UNIX_TIMESTAMP()),
+	(548, 1546, '心理動態解析', UNIX_TIMESTAMP()),
+	(876, 875, '環境適応型社会', UNIX_TIMESTAMP()),
+	(9, 5003, '永久抗体生成素子', UNIX_TIMESTAMP()),
+	(959, 1957, '量子物質選別装置', UNIX_TIMESTAMP()),
+	(710, 5704, '高分子光反応計測器', UNIX_TIMESTAMP()),
+	(283, 2280, '燃料増感転換器', UNIX_TIMESTAMP()),
+	(89, 3085, '催眠時空波刺激装置', UNIX_TIMESTAMP()),
+	(743, 4738, '多面電波通信機', UNIX_TIMESTAMP()),
+	(795, 794, '進化型仮想現実装置', UNIX_TIMESTAMP()),
+	(159, 3155, '地理秘書室', UNIX_TIMESTAMP()),
+	(538, 5532, '超音波洗浄機', UNIX_TIMESTAMP()),
+	(582, 6575, '蛍光顕微鏡', UNIX_TIMESTAMP()),
+	(739, 2736, '高分散触媒設計', UNIX_TIMESTAMP()),
+	(479, 4474, '超音速風洞実験', UNIX_TIMESTAMP()),
+	(30, 2027, '固体電池設計', UNIX_TIMESTAMP()),
+	(970, 3966, '機械赤血球', UNIX_TIMESTAMP()),
+	(492, 4487, '心電図検査', UNIX_TIMESTAMP()),
+	(366, 2363, '微生物分析', UNIX_TIMESTAMP()),
+	(923, 4918, '高分子合成', UNIX_TIMESTAMP()),
+	(64, 2061, '植物遺伝子改変', UNIX_TIMESTAMP()),
+	(867, 4862, '発光現象研究', UNIX_TIMESTAMP()),
+	(839, 5833, '古文書解読', UNIX_TIMESTAMP()),
+	(699, 2696, '物質安定化', UNIX_TIMESTAMP()),
+	(722, 2719, '霧海護身術', UNIX_TIMESTAMP()),
+	(484, 4479, '天地逆転術', UNIX_TIMESTAMP()),
+	(581, 4576, '水底探検術', UNIX_TIMESTAMP()),
+	(482, 6475, '熾天破碎拳', UNIX_TIMESTAMP()),
+	(776, 775, '絶対防御壁', UNIX_TIMESTAMP()),
+	(966, 6959, '経済動態相関指数', UNIX_TIMESTAMP()),
+	(99, 98, '心理霧状疾患', UNIX_TIMESTAMP()),
+	(449, 7441, '農業自動操作機', UNIX_TIMESTAMP()),
+	(747, 2744, '線形喧騒除去装置', UNIX_TIMESTAMP()),
+	(617, 616, '建築空間適合率', UNIX_TIMESTAMP()),
+	(191, 2188, '社会衛生統計指標', UNIX_TIMESTAMP()),
+	(48, 4043, '建築構造地震応答解析', UNIX_TIMESTAMP()),
+	(569, 2566, '音楽認知聴覚評価', UNIX_TIMESTAMP()),
+	(998, 6991, '曼荼羅結界', UNIX_TIMESTAMP()),
+	(351, 6344, '神秘狩猟士', UNIX_TIMESTAMP()),
+	(249, 5243, '反転魔方陣', UNIX_TIMESTAMP()),
+	(143, 7135, '宇宙図書館', UNIX_TIMESTAMP()),
+	(871, 1869, '高次元遷移', UNIX_TIMESTAMP()),
+	(460, 6453, '心意気炉心', UNIX_TIMESTAMP()),
+	(266, 265, '奇跡錬金術', UNIX_TIMESTAMP()),
+	(775, 5769, '天狗舞信号', UNIX_TIMESTAMP()),
+	(834, 833, '無重力操舵', UNIX_TIMESTAMP()),
+	(235, 7227, '唐草紋凱旋', UNIX_TIMESTAMP()),
+	(712, 2709, '心神喪失指数', UNIX_TIMESTAMP()),
+	(423, 5417, '非晶質膜形成装置', UNIX_TIMESTAMP()),
+	(968, 1966, '潜在磁場解析', UNIX_TIMESTAMP()),
+	(759, 758, '空間接近度計測', UNIX_TIMESTAMP()),
+	(971, 5965, '光熱動態解析', UNIX_TIMESTAMP()),
+	(469, 6462, '搬送物質軌跡予測', UNIX_TIMESTAMP()),
+	(669, 1667, '曲率応答率指数', UNIX_TIMESTAMP()),
+	(390, 2387, '環境振動測定装置', UNIX_TIMESTAMP()),
+	(930, 929, '複合多機能材料', UNIX_TIMESTAMP()),
+	(919, 5913, '高分子自己組織化', UNIX_TIMESTAMP()),
+	(669, 2666, '光生物応答器', UNIX_TIMESTAMP()),
+	(566, 4561, '熱障壁膜形成装置', UNIX_TIMESTAMP()),
+	(458, 2455, '歴史顕微鏡', UNIX_TIMESTAMP()),
+	(395, 2392, '動物適応境', UNIX_TIMESTAMP()),
+	(711, 710, '理創複雑系', UNIX_TIMESTAMP()),
+	(362, 3358, '文化発散度', UNIX_TIMESTAMP()),
+	(290, 7282, '言語複号機', UNIX_TIMESTAMP()),
+	(789, 2786, '経済収斂仮', UNIX_TIMESTAMP()),
+	(373, 7365, '社会深化場', UNIX_TIMESTAMP()),
+	(471, 7463, '倫理統合態', UNIX_TIMESTAMP()),
+	(258, 4253, '歌舞霊雄央', UNIX_TIMESTAMP()),
+	(87, 3083, '工芸感行政', UNIX_TIMESTAMP()),
+	(369, 7361, '自己同一視', UNIX_TIMESTAMP()),
+	(928, 3924, '分子交換反応', UNIX_TIMESTAMP()),
+	(958, 2955, '生態維持管理', UNIX_TIMESTAMP()),
+	(647, 1645, '宇宙加速器', UNIX_TIMESTAMP()),
+	(35, 4030, '最適制約条件', UNIX_TIMESTAMP()),
+	(865, 4860, '経済地域分析', UNIX_TIMESTAMP()),
+	(8, 1006, '集中組織化', UNIX_TIMESTAMP()),
+	(64, 63, '催眠関連症候群', UNIX_TIMESTAMP()),
+	(670, 2667, '連続地磁気', UNIX_TIMESTAMP()),
+	(517, 5511, '内分泌障害', UNIX_TIMESTAMP()),
+	(812, 3808, '知覚化物質', UNIX_TIMESTAMP()),
+	(342, 4337, '生体酸素需給比', UNIX_TIMESTAMP()),
+	(587, 4582, '遺伝子獲得', UNIX_TIMESTAMP()),
+	(577, 2574, '漸進的障害', UNIX_TIMESTAMP()),
+	(7, 4002, '中枢神経鎮静', UNIX_TIMESTAMP()),
+	(51, 1049, '製造業最適化', UNIX_TIMESTAMP()),
+	(119, 5113, '潜在記憶抑制', UNIX_TIMESTAMP()),
+	(343, 342, '積極的偏見', UNIX_TIMESTAMP()),
+	(8, 1006, '水晶状奇足', UNIX_TIMESTAMP()),
+	(158, 2155, '寒流遮断網', UNIX_TIMESTAMP()),
+	(805, 4800, '怯慄症候群', UNIX_TIMESTAMP()),
+	(976, 2973, '煉獣魔道師', UNIX_TIMESTAMP()),
+	(358, 3354, '地図記憶術', UNIX_TIMESTAMP()),
+	(191, 2188, '飛行運命予測', UNIX_TIMESTAMP()),
+	(432, 2429, '心拍解析装置', UNIX_TIMESTAMP()),
+	(347, 5341, '地球観測衛星', UNIX_TIMESTAMP()),
+	(399, 2396, '微細粒子超伝導', UNIX_TIMESTAMP()),
+	(328, 1326, '精神潜在影響研究', UNIX_TIMESTAMP()),
+	(628, 4623, '空中粒子集積装置', UNIX_TIMESTAMP()),
+	(239, 5233, '協奏的制御', UNIX_TIMESTAMP()),
+	(545, 6538, '最適強度最適化', UNIX_TIMESTAMP()),
+	(141, 4136, '霧幕発生装置', UNIX_TIMESTAMP()),
+	(755, 6748, '時空研究所', UNIX_TIMESTAMP()),
+	(838, 3834, '幻想調査団', UNIX_TIMESTAMP()),
+	(989, 2986, '微生物繁殖装置', UNIX_TIMESTAMP()),
+	(811, 3807, '無限連鎖反応', UNIX_TIMESTAMP()),
+	(703, 5697, '無重力装置', UNIX_TIMESTAMP()),
+	(45, 44, '未知生物研究所', UNIX_TIMESTAMP()),
+	(923, 1921, '超重力発生装置', UNIX_TIMESTAMP()),
+	(352, 1350, '宇宙漂流団', UNIX_TIMESTAMP()),
+	(446, 3442, '時間操作装置', UNIX_TIMESTAMP()),
+	(416, 5410, '精神制御装置', UNIX_TIMESTAMP()),
+	(98, 97, '古代文字解読', UNIX_TIMESTAMP()),
+	(543, 542, '非現実主義', UNIX_TIMESTAMP()),
+	(483, 6476, '超電磁暗号化装置', UNIX_TIMESTAMP()),
+	(65, 5059, '発光粒子増幅器', UNIX_TIMESTAMP()),
+	(425, 2422, '情報検知眼鏡', UNIX_TIMESTAMP()),
+	(935, 1933, '生命維持回路', UNIX_TIMESTAMP()),
+	(282, 5276, '量子共振変換機', UNIX_TIMESTAMP()),
+	(54, 3050, '無重力炉心', UNIX_TIMESTAMP()),
+	(969, 4964, '時間停止装置', UNIX_TIMESTAMP()),
+	(702, 2699, '遺伝子再編成装置', UNIX_TIMESTAMP()),
+	(748, 6741, '強誘電体反応器', UNIX_TIMESTAMP()),
+	(646, 6639, '空中浮遊装置', UNIX_TIMESTAMP()),
+	(305, 3301, '心脳連絡器', UNIX_TIMESTAMP()),
+	(30, 5024, '量子軌道制御', UNIX_TIMESTAMP()),
+	(691, 1689, '断片化行列', UNIX_TIMESTAMP()),
+	(690, 2687, '時空歪曲装置', UNIX_TIMESTAMP()),
+	(46, 5040, '陽子加速器', UNIX_TIMESTAMP()),
+	(601, 1599, '燃焼凍結装置', UNIX_TIMESTAMP()),
+	(811, 6804, '遺伝情報解析器', UNIX_TIMESTAMP()),
+	(374, 1372, '重力制御装置', UNIX_TIMESTAMP()),
+	(284, 6277, '高温超伝導体', UNIX_TIMESTAMP()),
+	(28, 7020, '閉域突破装置', UNIX_TIMESTAMP()),
+	(102, 5096, '時間反転機', UNIX_TIMESTAMP()),
+	(158, 6151, '物質合成装置', UNIX_TIMESTAMP()),
+	(442, 4437, '蛍光体光源', UNIX_TIMESTAMP()),
+	(330, 1328, '非線形経済分析', UNIX_TIMESTAMP()),
+	(859, 5853, '遺伝子情報暗号化', UNIX_TIMESTAMP()),
+	(618, 617, '空間風力計測', UNIX_TIMESTAMP()),
+	(162, 161, '環境資源最適化', UNIX_TIMESTAMP()),
+	(216, 5210, '精神調節器', UNIX_TIMESTAMP()),
+	(307, 2304, '生体再生装置', UNIX_TIMESTAMP()),
+	(609, 2606, '時間跳躍装置', UNIX_TIMESTAMP()),
+	(430, 6423, '認知拡張器', UNIX_TIMESTAMP()),
+	(282, 281, '空間転送装置', UNIX_TIMESTAMP()),
+	(550, 6543, '刺激感知器', UNIX_TIMESTAMP()),
+	(633, 6626, '重力中和装置', UNIX_TIMESTAMP()),
+	(240, 7232, '欲望抑制装置', UNIX_TIMESTAMP()),
+	(528, 2525, '夢制御装置', UNIX_TIMESTAMP()),
+	(552, 1550, '記憶除去装置', UNIX_TIMESTAMP()),
+	(506, 6499, '意思伝達装置', UNIX_TIMESTAMP()),
+	(695, 3691, '知識集積器', UNIX_TIMESTAMP()),
+	(431, 1429, '睡眠調整装置', UNIX_TIMESTAMP()),
+	(172, 4167, '隠密通信機', UNIX_TIMESTAMP()),
+	(845, 844, '物質変換装置', UNIX_TIMESTAMP()),
+	(936, 2933, '干渉回避装置', UNIX_TIMESTAMP()),
+	(177, 1175, '認識拡張装置', UNIX_TIMESTAMP()),
+	(837, 6830, '水晶反響層', UNIX_TIMESTAMP()),
+	(10, 2007, '麻痺分子配列', UNIX_TIMESTAMP()),
+	(151, 6144, '漸進融解度', UNIX_TIMESTAMP()),
+	(318, 7310, '奏鳴密度指標', UNIX_TIMESTAMP()),
+	(990, 4985, '潜在誤発散率', UNIX_TIMESTAMP()),
+	(925, 3921, '帰無領域幅', UNIX_TIMESTAMP()),
+	(419, 3415, '極臨界指数', UNIX_TIMESTAMP()),
+	(750, 6743, '無溶解加速度', UNIX_TIMESTAMP()),
+	(489, 488, '移動分配制', UNIX_TIMESTAMP()),
+	(777, 3773, '即変情操指標', UNIX_TIMESTAMP()),
+	(840, 5834, '相替抗響帯', UNIX_TIMESTAMP()),
+	(705, 1703, '常勢調和像', UNIX_TIMESTAMP()),
+	(353, 352, '応力走査定理', UNIX_TIMESTAMP()),
+	(822, 1820, '軸射電容能作用', UNIX_TIMESTAMP()),
+	(793, 1791, '電池供給橋接', UNIX_TIMESTAMP()),
+	(965, 3961, '窪洞拡散反応', UNIX_TIMESTAMP()),
+	(297, 1295, '出芽自作核喪失', UNIX_TIMESTAMP()),
+	(887, 886, '無重力爆発', UNIX_TIMESTAMP()),
+	(424, 6417, '視界遮断器', UNIX_TIMESTAMP()),
+	(666, 1664, '暗黒能力者', UNIX_TIMESTAMP()),
+	(515, 2512, '天動進化説', UNIX_TIMESTAMP()),
+	(680, 2677, '生物適應機構', UNIX_TIMESTAMP()),
+	(985, 984, '音韻律分析', UNIX_TIMESTAMP()),
+	(348, 347, '睡眠覚醒周期', UNIX_TIMESTAMP()),
+	(646, 1644, '宇宙時空計測', UNIX_TIMESTAMP()),
+	(444, 7436, '霊感行政政策', UNIX_TIMESTAMP()),
+	(334, 2331, '時間歪曲現象', UNIX_TIMESTAMP()),
+	(277, 276, '精神透視分析', UNIX_TIMESTAMP()),
+	(706, 5700, '氣道穴閉塞', UNIX_TIMESTAMP()),
+	(514, 4509, '逆重心計算機', UNIX_TIMESTAMP()),
+	(145, 1143, '心拍同期装置', UNIX_TIMESTAMP()),
+	(762, 761, '感情抑制薬', UNIX_TIMESTAMP()),
+	(751, 4746, '視神経障害', UNIX_TIMESTAMP()),
+	(673, 5667, '形態変形素材', UNIX_TIMESTAMP()),
+	(96, 2093, '光線反射装置', UNIX_TIMESTAMP()),
+	(277, 4272, '地殻変動指数', UNIX_TIMESTAMP()),
+	(5, 6997, '幻覚抑制装置', UNIX_TIMESTAMP()),
+	(581, 580, '抗菌忍耐素材', UNIX_TIMESTAMP()),
+	(955, 5949, '多重周波数発信器', UNIX_TIMESTAMP()),
+	(536, 4531, '空中浮遊体制', UNIX_TIMESTAMP()),
+	(870, 2867, '視床体温調整', UNIX_TIMESTAMP()),
+	(260, 3256, '高速摂氏計測器', UNIX_TIMESTAMP()),
+	(463, 3459, '空中磁力測定器', UNIX_TIMESTAMP()),
+	(187, 5181, '情報消去装置', UNIX_TIMESTAMP()),
+	(85, 3081, '高圧電気分解器', UNIX_TIMESTAMP()),
+	(903, 5897, '量子物質転送装置', UNIX_TIMESTAMP()),
+	(423, 2420, '精神増幅装置', UNIX_TIMESTAMP()),
+	(836, 835, '非破壊磁力顕微鏡', UNIX_TIMESTAMP()),
+	(842, 2839, '時空間曲率測定装置', UNIX_TIMESTAMP()),
+	(949, 1947, '多層眼底採光', UNIX_TIMESTAMP()),
+	(30, 1028, '脊髄節内連関', UNIX_TIMESTAMP()),
+	(177, 1175, '血小板固着褐変', UNIX_TIMESTAMP()),
+	(254, 253, '潜顎片生血流', UNIX_TIMESTAMP()),
+	(856, 1854, '管球圧黄斑', UNIX_TIMESTAMP()),
+	(26, 25, '白金菌根被蓋', UNIX_TIMESTAMP()),
+	(717, 2714, '温暖帯霜結晶', UNIX_TIMESTAMP()),
+	(52, 2049, '壁面綴合速度', UNIX_TIMESTAMP()),
+	(126, 3122, '波動凝胶連合', UNIX_TIMESTAMP()),
+	(271, 4266, '群精巣漿液塞栓', UNIX_TIMESTAMP()),
+	(866, 3862, '分娩仙腸葉靭帯', UNIX_TIMESTAMP()),
+	(223, 7215, '側継眼帯湾曲', UNIX_TIMESTAMP()),
+	(927, 3923, '片側通過感度', UNIX_TIMESTAMP()),
+	(178, 6171, '軟骨耐力突破', UNIX_TIMESTAMP()),
+	(34, 1032, '美術家迂撃', UNIX_TIMESTAMP()),
+	(646, 5640, '音声機構体', UNIX_TIMESTAMP()),
+	(316, 315, '基準比較路', UNIX_TIMESTAMP()),
+	(289, 1287, '装備層推逆', UNIX_TIMESTAMP()),
+	(923, 5917, '心神喪失病', UNIX_TIMESTAMP()),
+	(943, 942, '周波撹拌機', UNIX_TIMESTAMP()),
+	(43, 4038, '臍帯血貯蔵庫', UNIX_TIMESTAMP()),
+	(711, 6704, '多項非線形回帰', UNIX_TIMESTAMP()),
+	(370, 7362, '原因不明肺炎', UNIX_TIMESTAMP()),
+	(549, 5543, '活力再生装置', UNIX_TIMESTAMP()),
+	(60, 1058, '封印解除術', UNIX_TIMESTAMP()),
+	(83, 5077, '核融合燃料交換装置', UNIX_TIMESTAMP()),
+	(322, 3318, '経済覇権競争', UNIX_TIMESTAMP()),
+	(672, 4667, '迫撃砲遠隔制御式', UNIX_TIMESTAMP()),
+	(46, 45, '水銀蒸気船', UNIX_TIMESTAMP()),
+	(236, 235, '水晶状態反応', UNIX_TIMESTAMP()),
+	(369, 4364, '航空心地指数', UNIX_TIMESTAMP()),
+	(547, 4542, '電磁放散抑制装置', UNIX_TIMESTAMP()),
+	(24, 2021, '触覚分解解析', UNIX_TIMESTAMP()),
+	(129, 128, '多層愈醒担体', UNIX_TIMESTAMP()),
+	(228, 4223, '地球回帰飛行', UNIX_TIMESTAMP()),
+	(106, 4101, '電磁波経路解析', UNIX_TIMESTAMP()),
+	(640, 1638, '熱核融合放射', UNIX_TIMESTAMP()),
+	(736, 2733, '積層繊維材料', UNIX_TIMESTAMP()),
+	(777, 3773, '生体心拍読解', UNIX_TIMESTAMP()),
+	(154, 153, '動荷重局所低減', UNIX_TIMESTAMP()),
+	(548, 3544, '光導波路機械', UNIX_TIMESTAMP()),
+	(59, 4054, '分子環境界面', UNIX_TIMESTAMP()),
+	(384, 6377, '鮫皮聚合体', UNIX_TIMESTAMP()),
+	(820, 6813, '彩色短毛兎', UNIX_TIMESTAMP()),
+	(998, 3994, '新皮風神楽', UNIX_TIMESTAMP()),
+	(995, 2992, '量子物質制御装置', UNIX_TIMESTAMP()),
+	(462, 3458, '非可逆反転現象', UNIX_TIMESTAMP()),
+	(624, 2621, '時空干渉装置', UNIX_TIMESTAMP()),
+	(53, 2050, '生体情報解析装置', UNIX_TIMESTAMP()),
+	(50, 4045, '意識操作装置', UNIX_TIMESTAMP()),
+	(435, 6428, '電磁空間変換機', UNIX_TIMESTAMP()),
+	(55, 7047, '情報摂取衝動抑制装置', UNIX_TIMESTAMP()),
+	(290, 289, '自己進化生体機能強化装置', UNIX_TIMESTAMP()),
+	(310, 1308, '微生物採集器', UNIX_TIMESTAMP()),
+	(414, 3410, '音響映像復元', UNIX_TIMESTAMP()),
+	(164, 1162, '無線通信回転器', UNIX_TIMESTAMP()),
+	(222, 221, '量子物質合成機', UNIX_TIMESTAMP()),
+	(450, 6443, '生物遺伝暗号', UNIX_TIMESTAMP()),
+	(844, 4839, '架空重力創造装置', UNIX_TIMESTAMP()),
+	(860, 859, '音声指向干渉器', UNIX_TIMESTAMP()),
+	(689, 3685, '書記念館自動整理機', UNIX_TIMESTAMP()),
+	(898, 2895, '生体電磁脳波測定装置', UNIX_TIMESTAMP()),
+	(294, 4289, '鏡像計算機演算子', UNIX_TIMESTAMP()),
+	(431, 6424, '石器研削装置', UNIX_TIMESTAMP()),
+	(863, 3859, '水酸化金属合金', UNIX_TIMESTAMP()),
+	(756, 1754, '霊魂余滴現象', UNIX_TIMESTAMP()),
+	(976, 4971, '魔獣飼育術', UNIX_TIMESTAMP()),
+	(415, 6408, '音響霊感知装置', UNIX_TIMESTAMP()),
+	(807, 806, '大気静電浄化器', UNIX_TIMESTAMP()),
+	(908, 907, '遺伝子蒸留器', UNIX_TIMESTAMP()),
+	(492, 1490, '火山帽装着術', UNIX_TIMESTAMP()),
+	(937, 5931, '幻想波動制御装置', UNIX_TIMESTAMP()),
+	(490, 1488, '空間障壁化', UNIX_TIMESTAMP()),
+	(763, 2760, '量子計算機', UNIX_TIMESTAMP()),
+	(624, 3620, '遺伝子合成装置', UNIX_TIMESTAMP()),
+	(446, 2443, '水中音波検知', UNIX_TIMESTAMP()),
+	(574, 2571, '音楽脳波解析', UNIX_TIMESTAMP()),
+	(911, 6904, '生体透明化', UNIX_TIMESTAMP()),
+	(943, 5937, '電磁気兵器', UNIX_TIMESTAMP()),
+	(51, 3047, '地下空間開拓術', UNIX_TIMESTAMP()),
+	(606, 4601, '熱誘起酸解', UNIX_TIMESTAMP()),
+	(816, 1814, '拡張搬送比', UNIX_TIMESTAMP()),
+	(17, 16, '原子施設復旧', UNIX_TIMESTAMP()),
+	(259, 3255, '結晶構造説', UNIX_TIMESTAMP()),
+	(803, 5797, '重複階矛盾', UNIX_TIMESTAMP()),
+	(223, 5217, '単散凝固対', UNIX_TIMESTAMP()),
+	(914, 2911, '疑似拡散保存', UNIX_TIMESTAMP()),
+	(953, 952, '時空間推移', UNIX_TIMESTAMP()),
+	(186, 3182, '融合生命体', UNIX_TIMESTAMP()),
+	(855, 2852, '高熱抵抗金属', UNIX_TIMESTAMP()),
+	(82, 5076, '精神射出装置', UNIX_TIMESTAMP()),
+	(415, 6408, '元素変換結晶', UNIX_TIMESTAMP()),
+	(463, 6456, '透明化合物', UNIX_TIMESTAMP()),
+	(740, 1738, '音霊遺伝子', UNIX_TIMESTAMP()),
+	(965, 3961, '心意統合装置', UNIX_TIMESTAMP()),
+	(287, 7279, '逆転遺伝子', UNIX_TIMESTAMP()),
+	(390, 2387, '記憶情報外部化', UNIX_TIMESTAMP()),
+	(151, 4146, '霊脈探査機', UNIX_TIMESTAMP()),
+	(561, 2558, '時空交信装置', UNIX_TIMESTAMP()),
+	(977, 3973, '神聖結界構築術', UNIX_TIMESTAMP()),
+	(807, 2804, '量子干渉効果', UNIX_TIMESTAMP()),
+	(453, 3449, '奇跡発生装置', UNIX_TIMESTAMP()),
+	(957, 6950, '心霊写真鑑定士', UNIX_TIMESTAMP()),
+	(173, 2170, '超音波治療装置', UNIX_TIMESTAMP()),
+	(582, 6575, '催眠感情移譲装置', UNIX_TIMESTAMP()),
+	(677, 2674, '超高温超伝導材料', UNIX_TIMESTAMP()),
+	(114, 1112, '錬金術研究所', UNIX_TIMESTAMP()),
+	(817, 2814, '奈落召喚術', UNIX_TIMESTAMP()),
+	(12, 6005, '深層記憶抽出装置', UNIX_TIMESTAMP()),
+	(584, 5578, '神秘力場制御機械', UNIX_TIMESTAMP()),
+	(920, 3916, '触媒反応速度', UNIX_TIMESTAMP()),
+	(778, 3774, '原子構築力', UNIX_TIMESTAMP()),
+	(388, 1386, '宇宙膠着力', UNIX_TIMESTAMP()),
+	(248, 3244, '電位差圧力', UNIX_TIMESTAMP()),
+	(862, 861, '機械応力緩和', UNIX_TIMESTAMP()),
+	(292, 3288, '看護遠心力', UNIX_TIMESTAMP()),
+	(605, 604, '脳神経伝達量', UNIX_TIMESTAMP()),
+	(751, 6744, '電子寿命解析', UNIX_TIMESTAMP()),
+	(239, 5233, '電磁波透過率', UNIX_TIMESTAMP()),
+	(595, 6588, '風力抵抗係数', UNIX_TIMESTAMP()),
+	(34, 2031, '血液浄化量', UNIX_TIMESTAMP()),
+	(340, 1338, '土壌水分含有率', UNIX_TIMESTAMP()),
+	(110, 4105, '画像再構築能力', UNIX_TIMESTAMP()),
+	(991, 3987, '磁場放出量', UNIX_TIMESTAMP()),
+	(240, 6233, '光合成効率', UNIX_TIMESTAMP()),
+	(853, 4848, '石油粘度指数', UNIX_TIMESTAMP()),
+	(217, 1215, '空気浄化効果', UNIX_TIMESTAMP()),
+	(290, 3286, '赤外線放射能', UNIX_TIMESTAMP()),
+	(946, 5940, '圧電発電量', UNIX_TIMESTAMP()),
+	(323, 6316, '地質測定精度', UNIX_TIMESTAMP()),
+	(895, 4890, '核融合反応速度', UNIX_TIMESTAMP()),
+	(614, 2611, '公共交通負荷率', UNIX_TIMESTAMP()),
+	(94, 7086, '紫外線遮光能力', UNIX_TIMESTAMP()),
+	(161, 2158, '電荷濃度分布', UNIX_TIMESTAMP()),
+	(379, 5373, '蒸気炉核融合', UNIX_TIMESTAMP()),
+	(247, 2244, '易整理主義', UNIX_TIMESTAMP()),
+	(165, 6158, '土壌反復通知', UNIX_TIMESTAMP()),
+	(875, 2872, '組織風不正', UNIX_TIMESTAMP()),
+	(723, 6716, '働換期待値', UNIX_TIMESTAMP()),
+	(152, 6145, '機器画像潜在化', UNIX_TIMESTAMP()),
+	(88, 87, '交渉項継出', UNIX_TIMESTAMP()),
+	(925, 3921, '遺伝子進化速度', UNIX_TIMESTAMP()),
+	(784, 4779, '守情報濃度', UNIX_TIMESTAMP()),
+	(760, 4755, '寒熱対応設計', UNIX_TIMESTAMP()),
+	(287, 5281, '刺青強度試験', UNIX_TIMESTAMP()),
+	(168, 167, '外部病状羅絶', UNIX_TIMESTAMP()),
+	(842, 2839, '食糧自律制御', UNIX_TIMESTAMP()),
+	(576, 3572, '環境遠隔検査', UNIX_TIMESTAMP()),
+	(5, 4000, '量子精細化', UNIX_TIMESTAMP()),
+	(501, 5495, '磁気共鳴断層撮影', UNIX_TIMESTAMP()),
+	(578, 6571, '経済動態解析', UNIX_TIMESTAMP()),
+	(735, 5729, '遺伝情報暗号化', UNIX_TIMESTAMP()),
+	(938, 1936, '衛星光通信', UNIX_TIMESTAMP()),
+	(508, 6501, '農業生産効率化', UNIX_TIMESTAMP()),
+	(70, 3066, '無線量子通信', UNIX_TIMESTAMP()),
+	(367, 4362, '地球熱融資', UNIX_TIMESTAMP()),
+	(885, 2882, '黄金原石推算器', UNIX_TIMESTAMP()),
+	(18, 1016, '智辣謎構造', UNIX_TIMESTAMP()),
+	(372, 7364, '先祖代々篩合', UNIX_TIMESTAMP()),
+	(323, 1321, '地蔵目盲打', UNIX_TIMESTAMP()),
+	(794, 793, '絶対秘匣演算子', UNIX_TIMESTAMP()),
+	(437, 2434, '弩牙片手剣戟', UNIX_TIMESTAMP()),
+	(781, 1779, '乖奇魚群効果', UNIX_TIMESTAMP()),
+	(576, 575, '生物分子解析', UNIX_TIMESTAMP()),
+	(77, 4072, '物的文化研究', UNIX_TIMESTAMP()),
+	(239, 238, '歴史古代研究', UNIX_TIMESTAMP()),
+	(495, 7487, '蒸気制御装置', UNIX_TIMESTAMP()),
+	(943, 1941, '水銀蒸気導管', UNIX_TIMESTAMP()),
+	(37, 36, '集中音響配電盤', UNIX_TIMESTAMP()),
+	(45, 44, '光合成増強装置', UNIX_TIMESTAMP()),
+	(565, 4560, '脳波解析装置', UNIX_TIMESTAMP()),
+	(528, 5522, '声紋識別器', UNIX_TIMESTAMP()),
+	(13, 6006, '透明化合物分析機', UNIX_TIMESTAMP()),
+	(453, 5447, '上空気象衛星', UNIX_TIMESTAMP()),
+	(295, 294, '心理質量測定機', UNIX_TIMESTAMP()),
+	(102, 101, '機械風味分類器', UNIX_TIMESTAMP()),
+	(528, 6521, '飛行脳波転写装置', UNIX_TIMESTAMP()),
+	(818, 2815, '空間時間計測装置', UNIX_TIMESTAMP()),
+	(885, 884, '火星磁気探査機', UNIX_TIMESTAMP()),
+	(645, 644, '凝集時間動態計算', UNIX_TIMESTAMP()),
+	(819, 3815, '超弧展開装置', UNIX_TIMESTAMP()),
+	(128, 5122, '暗黒物質生成過程', UNIX_TIMESTAMP()),
+	(409, 5403, '時空捕獲装置', UNIX_TIMESTAMP()),
+	(452, 451, '心理差別解析', UNIX_TIMESTAMP()),
+	(383, 1381, '超高速微粒子加速器', UNIX_TIMESTAMP()),
+	(324, 1322, '電気生体操車', UNIX_TIMESTAMP()),
+	(446, 4441, '高密度物質合成', UNIX_TIMESTAMP()),
+	(957, 4952, '亀甲吸音構造', UNIX_TIMESTAMP()),
+	(388, 5382, '潜在重力調節装置', UNIX_TIMESTAMP()),
+	(294, 5288, '超音速損傷予測', UNIX_TIMESTAMP()),
+	(468, 7460, '電離覆当遠隔制御', UNIX_TIMESTAMP()),
+	(27, 6020, '心理陽電子暗号', UNIX_TIMESTAMP()),
+	(223, 7215, '光通信瞬時解放', UNIX_TIMESTAMP()),
+	(166, 5160, '生物回生装置', UNIX_TIMESTAMP()),
+	(63, 4058, '磁気共鳴自己増幅', UNIX_TIMESTAMP()),
+	(695, 5689, '行動相関研究', UNIX_TIMESTAMP()),
+	(877, 4872, '蒸熱網巻機', UNIX_TIMESTAMP()),
+	(179, 6172, '一滴清浄器', UNIX_TIMESTAMP()),
+	(290, 4285, '場干露光装置', UNIX_TIMESTAMP()),
+	(742, 2739, '心理因子解析模型', UNIX_TIMESTAMP()),
+	(429, 4424, '食品以降解凍乾燥器', UNIX_TIMESTAMP()),
+	(88, 1086, '悪夢記録再生装置', UNIX_TIMESTAMP()),
+	(813, 2810, '栓流断曲機器', UNIX_TIMESTAMP()),
+	(910, 6903, '森羅万象推測器', UNIX_TIMESTAMP()),
+	(149, 7141, '星雲回路模型', UNIX_TIMESTAMP()),
+	(836, 6829, '水晶減衰器', UNIX_TIMESTAMP()),
+	(272, 1270, '三次元演算子', UNIX_TIMESTAMP()),
+	(62, 5056, '電子交通管理装置', UNIX_TIMESTAMP()),
+	(639, 3635, '音響透明材料', UNIX_TIMESTAMP()),
+	(111, 110, '生体応答計測器', UNIX_TIMESTAMP()),
+	(334, 5328, '集中微粒子分散装置', UNIX_TIMESTAMP()),
+	(110, 1108, '分子構造解析装置', UNIX_TIMESTAMP()),
+	(826, 6819, '粒子体積測定装置', UNIX_TIMESTAMP()),
+	(774, 773, '光電効果測定装置', UNIX_TIMESTAMP()),
+	(199, 2196, '電気火花加工装置', UNIX_TIMESTAMP()),
+	(324, 1322, '超音波透過画像化装置', UNIX_TIMESTAMP()),
+	(427, 6420, '炳榮装愚巧', UNIX_TIMESTAMP()),
+	(130, 3126, '地質情報局', UNIX_TIMESTAMP()),
+	(113, 1111, '震度瞬間噴水', UNIX_TIMESTAMP()),
+	(682, 5676, '乾燥日時計', UNIX_TIMESTAMP()),
+	(195, 7187, '超感知策転', UNIX_TIMESTAMP()),
+	(399, 7391, '形而下偏差', UNIX_TIMESTAMP()),
+	(509, 6502, '地磁気変動', UNIX_TIMESTAMP()),
+	(114, 113, '直感受付機', UNIX_TIMESTAMP()),
+	(244, 2241, '音響遮断超伝導中間実験場', UNIX_TIMESTAMP()),
+	(933, 3929, '超高精度顕微鏡結晶撮像', UNIX_TIMESTAMP()),
+	(286, 285, '物質分併反応', UNIX_TIMESTAMP()),
+	(467, 466, '高次元交換', UNIX_TIMESTAMP()),
+	(890, 3886, '現象加速器', UNIX_TIMESTAMP()),
+	(118, 4113, '時間領域解析', UNIX_TIMESTAMP()),
+	(176, 5170, '光子乱反射', UNIX_TIMESTAMP()),
+	(317, 4312, '非対称共鳴', UNIX_TIMESTAMP()),
+	(617, 5611, '超音速酸素', UNIX_TIMESTAMP()),
+	(668, 2665, '注入統御体系', UNIX_TIMESTAMP()),
+	(24, 23, '昆虫干渉網', UNIX_TIMESTAMP()),
+	(482, 481, '筐体一條水欄', UNIX_TIMESTAMP()),
+	(106, 4101, '生物組織病態', UNIX_TIMESTAMP()),
+	(241, 7233, '心理意図関連制御', UNIX_TIMESTAMP()),
+	(48, 4043, '経済需給分析', UNIX_TIMESTAMP()),
+	(82, 4077, '社会認知選択', UNIX_TIMESTAMP()),
+	(392, 1390, '言語音響抽出', UNIX_TIMESTAMP()),
+	(214, 2211, '政治影響因子', UNIX_TIMESTAMP()),
+	(488, 2485, '音楽和声表現', UNIX_TIMESTAMP()),
+	(82, 4077, '美術表現技巧', UNIX_TIMESTAMP()),
+	(898, 6891, '経営戦略計画', UNIX_TIMESTAMP()),
+	(291, 5285, '神意抽出器', UNIX_TIMESTAMP()),
+	(996, 2993, '悪意解析装置', UNIX_TIMESTAMP()),
+	(233, 3229, '心脳通信機', UNIX_TIMESTAMP()),
+	(882, 4877, '反重力粒子', UNIX_TIMESTAMP()),
+	(735, 734, '時空共鳴装置', UNIX_TIMESTAMP()),
+	(257, 2254, '無限進化因子', UNIX_TIMESTAMP()),
+	(598, 597, '超音速転写機', UNIX_TIMESTAMP()),
+	(118, 3114, '暗黒情報共有網', UNIX_TIMESTAMP()),
+	(818, 817, '原子解体兵器', UNIX_TIMESTAMP()),
+	(413, 2410, '絶対存在領域', UNIX_TIMESTAMP()),
+	(402, 2399, '衛星軌道調整装置', UNIX_TIMESTAMP()),
+	(835, 1833, '幻像投影装置', UNIX_TIMESTAMP()),
+	(247, 2244, '無限次元演算機器', UNIX_TIMESTAMP()),
+	(950, 3946, '量子暗号通信装置', UNIX_TIMESTAMP()),
+	(269, 7261, '意識移植手術', UNIX_TIMESTAMP()),
+	(832, 6825, '可変重力制御装置', UNIX_TIMESTAMP()),
+	(242, 1240, '時間加速装置', UNIX_TIMESTAMP()),
+	(639, 2636, '絵画鑑賞館', UNIX_TIMESTAMP()),
+	(933, 2930, '彫刻展示場', UNIX_TIMESTAMP()),
+	(782, 3778, '結晶研究所', UNIX_TIMESTAMP()),
+	(920, 5914, '木彩器具斉', UNIX_TIMESTAMP()),
+	(374, 373, '火翅珊瑚罠', UNIX_TIMESTAMP()),
+	(74, 2071, '蓄音録逍遥', UNIX_TIMESTAMP()),
+	(59, 58, '音光庭朱露', UNIX_TIMESTAMP()),
+	(948, 2945, '薄緑結芙留', UNIX_TIMESTAMP()),
+	(836, 4831, '統合神経回路', UNIX_TIMESTAMP()),
+	(619, 6612, '遺伝子復元', UNIX_TIMESTAMP()),
+	(266, 265, '宇宙音収波器', UNIX_TIMESTAMP()),
+	(575, 5569, '超心理因子', UNIX_TIMESTAMP()),
+	(257, 256, '遺伝子解析', UNIX_TIMESTAMP()),
+	(289, 3285, '核磁気共鳴', UNIX_TIMESTAMP()),
+	(591, 3587, '情報保存研究', UNIX_TIMESTAMP()),
+	(275, 3271, '瑪瑙化現象', UNIX_TIMESTAMP()),
+	(777, 3773, '霧平野現象', UNIX_TIMESTAMP()),
+	(405, 1403, '百鬼夜行紋', UNIX_TIMESTAMP()),
+	(3, 3998, '規範関連行列', UNIX_TIMESTAMP()),
+	(528, 2525, '黒曜石製剣', UNIX_TIMESTAMP()),
+	(368, 2365, '透明化装置', UNIX_TIMESTAMP()),
+	(579, 2576, '集合催眠術', UNIX_TIMESTAMP()),
+	(360, 4355, '聖剣探査団', UNIX_TIMESTAMP()),
+	(978, 977, '追体験装置', UNIX_TIMESTAMP()),
+	(372, 1370, '山岳幻影術', UNIX_TIMESTAMP()),
+	(106, 4101, '念動力発電', UNIX_TIMESTAMP()),
+	(624, 2621, '義体復元手術', UNIX_TIMESTAMP()),
+	(367, 1365, '魔力注入装置', UNIX_TIMESTAMP()),
+	(159, 4154, '宇宙物資循環計画', UNIX_TIMESTAMP()),
+	(44, 6037, '自己再生量子材料', UNIX_TIMESTAMP()),
+	(852, 3848, '文字念写解析装置', UNIX_TIMESTAMP()),
+	(19, 7011, '戦闘以心流術', UNIX_TIMESTAMP()),
+	(228, 227, '古典文化研究', UNIX_TIMESTAMP()),
+	(867, 5861, '邪術封印術', UNIX_TIMESTAMP()),
+	(309, 1307, '神仏興信所', UNIX_TIMESTAMP()),
+	(673, 3669, '心象大理石', UNIX_TIMESTAMP()),
+	(214, 3210, '形状不動斧', UNIX_TIMESTAMP()),
+	(344, 3340, '観念氾濫聯', UNIX_TIMESTAMP()),
+	(803, 4798, '音魂次元器', UNIX_TIMESTAMP()),
+	(511, 5505, '空間溶水素', UNIX_TIMESTAMP()),
+	(922, 4917, '無形電支援', UNIX_TIMESTAMP()),
+	(968, 6961, '色彩演染巻', UNIX_TIMESTAMP()),
+	(135, 2132, '闇魚具象化', UNIX_TIMESTAMP()),
+	(782, 4777, '不可視快晴', UNIX_TIMESTAMP()),
+	(948, 1946, '獣化精霊碑', UNIX_TIMESTAMP()),
+	(428, 5422, '原初再帰定数', UNIX_TIMESTAMP()),
+	(330, 7322, '時間流射体', UNIX_TIMESTAMP()),
+	(687, 3683, '具現夢造影', UNIX_TIMESTAMP()),
+	(181, 1179, '怒濤小宇宙', UNIX_TIMESTAMP()),
+	(906, 2903, '遺伝子組換鋼', UNIX_TIMESTAMP()),
+	(495, 494, '情報統制装置', UNIX_TIMESTAMP()),
+	(877, 1875, '空中揚陸車', UNIX_TIMESTAMP()),
+	(840, 6833, '心霊透視機', UNIX_TIMESTAMP()),
+	(572, 5566, '多元宇宙推進器', UNIX_TIMESTAMP()),
+	(419, 7411, '超音波共振器', UNIX_TIMESTAMP()),
+	(463, 7455, '星間通信装置', UNIX_TIMESTAMP()),
+	(798, 6791, '環境再生装置', UNIX_TIMESTAMP()),
+	(538, 3534, '量子転送装置', UNIX_TIMESTAMP()),
+	(899, 1897, '精神波発生装置', UNIX_TIMESTAMP()),
+	(13, 1011, '時空曲率計測機', UNIX_TIMESTAMP()),
+	(40, 5034, '疑似重力発生器', UNIX_TIMESTAMP()),
+	(532, 6525, '遺伝子翻訳装置', UNIX_TIMESTAMP()),
+	(762, 3758, '心理検知分析', UNIX_TIMESTAMP()),
+	(446, 7438, '生体磁力結合', UNIX_TIMESTAMP()),
+	(664, 1662, '神経伝導制御', UNIX_TIMESTAMP()),
+	(249, 3245, '非線型微積書', UNIX_TIMESTAMP()),
+	(41, 7033, '元素感応放出', UNIX_TIMESTAMP()),
+	(789, 2786, '動物冷凍器機構', UNIX_TIMESTAMP()),
+	(392, 2389, '物質相対運動', UNIX_TIMESTAMP()),
+	(119, 5113, '実在鏡映構造', UNIX_TIMESTAMP()),
+	(757, 1755, '無機金属触媒', UNIX_TIMESTAMP()),
+	(694, 6687, '真剣再実行', UNIX_TIMESTAMP()),
+	(531, 5525, '金属剥離機', UNIX_TIMESTAMP()),
+	(595, 594, '液晶拡散器', UNIX_TIMESTAMP()),
+	(384, 1382, '高度片頁反射', UNIX_TIMESTAMP()),
+	(904, 6897, '天然味噌発酵装置', UNIX_TIMESTAMP()),
+	(567, 5561, '静電芳香剤', UNIX_TIMESTAMP()),
+	(97, 96, '降水抑制装置', UNIX_TIMESTAMP()),
+	(983, 2980, '宇宙微粒子調査機', UNIX_TIMESTAMP()),
+	(997, 3993, '紅茶蒸留器', UNIX_TIMESTAMP()),
+	(175, 7167, '脳神経末端伝達帯域', UNIX_TIMESTAMP()),
+	(124, 3120, '地底深層掘削機', UNIX_TIMESTAMP()),
+	(163, 6156, '音叉発振器', UNIX_TIMESTAMP()),
+	(100, 6093, '軽視角度多重反射', UNIX_TIMESTAMP()),
+	(946, 3942, '有機大腸菌除去剤', UNIX_TIMESTAMP()),
+	(171, 2168, '疑似感応素', UNIX_TIMESTAMP()),
+	(679, 4674, '電子改変策', UNIX_TIMESTAMP()),
+	(470, 4465, '炉底堆積物', UNIX_TIMESTAMP()),
+	(401, 400, '音波振動儀', UNIX_TIMESTAMP()),
+	(380, 5374, '念動反応装置', UNIX_TIMESTAMP()),
+	(264, 7256, '果霊探査器', UNIX_TIMESTAMP()),
+	(312, 6305, '轍線検査装置', UNIX_TIMESTAMP()),
+	(749, 5743, '念念浮遊装置', UNIX_TIMESTAMP()),
+	(940, 939, '屍骨探査機', UNIX_TIMESTAMP()),
+	(747, 3743, '音波分散器', UNIX_TIMESTAMP()),
+	(506, 1504, '新進研究校正', UNIX_TIMESTAMP()),
+	(303, 7295, '思考結晶化', UNIX_TIMESTAMP()),
+	(218, 5212, '知識閉経期', UNIX_TIMESTAMP()),
+	(381, 2378, '解析限界度', UNIX_TIMESTAMP()),
+	(814, 5808, '意味凍结化', UNIX_TIMESTAMP()),
+	(124, 1122, '機械透明度', UNIX_TIMESTAMP()),
+	(709, 2706, '知覚成本計算', UNIX_TIMESTAMP()),
+	(4, 3, '無味世界観', UNIX_TIMESTAMP()),
+	(30, 6023, '専低型社交', UNIX_TIMESTAMP()),
+	(318, 1316, '思考分析架総', UNIX_TIMESTAMP()),
+	(360, 359, '玉砕忘備録', UNIX_TIMESTAMP()),
+	(465, 6458, '曼荼羅点心', UNIX_TIMESTAMP()),
+	(140, 3136, '火星化合物', UNIX_TIMESTAMP()),
+	(129, 6122, '微粒分析装置', UNIX_TIMESTAMP()),
+	(800, 3796, '超音波接合機', UNIX_TIMESTAMP()),
+	(673, 672, '高分子薄膜', UNIX_TIMESTAMP()),
+	(427, 5421, '超伝導磁気効果', UNIX_TIMESTAMP()),
+	(388, 6381, '真空転位反応器', UNIX_TIMESTAMP()),
+	(291, 6284, '応力集中対策材', UNIX_TIMESTAMP()),
+	(386, 5380, '高精度温度制御装置', UNIX_TIMESTAMP()),
+	(535, 5529, '粒子加速光源', UNIX_TIMESTAMP()),
+	(193, 6186, '高圧再生水素燃料電池', UNIX_TIMESTAMP()),
+	(965, 4960, '高静水圧地中掘削機', UNIX_TIMESTAMP()),
+	(272, 2269, '加速肥料成分', UNIX_TIMESTAMP()),
+	(927, 3923, '熱膨張調整剤', UNIX_TIMESTAMP()),
+	(738, 3734, '高抵抗熱絶縁体', UNIX_TIMESTAMP()),
+	(183, 7175, '自己複製微生物', UNIX_TIMESTAMP()),
+	(150, 1148, '行星磁力共鳴器', UNIX_TIMESTAMP()),
+	(567, 1565, '細胞微小管束結合因子', UNIX_TIMESTAMP()),
+	(414, 3410, '脳神経伝達抑制剤', UNIX_TIMESTAMP()),
+	(41, 4036, '新陳代謝促進剤', UNIX_TIMESTAMP()),
+	(275, 5269, '歴世紀仕分', UNIX_TIMESTAMP()),
+	(568, 1566, '対文化受容', UNIX_TIMESTAMP()),
+	(150, 2147, '社会矛盾分析', UNIX_TIMESTAMP()),
+	(121, 2118, '歴史相対主義', UNIX_TIMESTAMP()),
+	(266, 4261, '行政効率化', UNIX_TIMESTAMP()),
+	(53, 52, '熔岩変容器', UNIX_TIMESTAMP()),
+	(815, 1813, '無極結晶体', UNIX_TIMESTAMP()),
+	(436, 7428, '青炎蔓延化', UNIX_TIMESTAMP()),
+	(746, 5740, '精霊召喚石', UNIX_TIMESTAMP()),
+	(58, 2055, '奏者瞬間移動', UNIX_TIMESTAMP()),
+	(948, 3944, '乱舞業火弾', UNIX_TIMESTAMP()),
+	(553, 6546, '魔導石生成装置', UNIX_TIMESTAMP()),
+	(835, 1833, '破滅廃棄装置', UNIX_TIMESTAMP()),
+	(69, 1067, '因果封印装置', UNIX_TIMESTAMP()),
+	(848, 1846, '妖花液噴霧器', UNIX_TIMESTAMP()),
+	(164, 6157, '神域瞑想室', UNIX_TIMESTAMP()),
+	(751, 6744, '時空歪曲装置', UNIX_TIMESTAMP()),
+	(116, 1114, '螺旋昇圧器', UNIX_TIMESTAMP()),
+	(60, 4055, '焦点調整装置', UNIX_TIMESTAMP()),
+	(876, 6869, '振動位相計測器', UNIX_TIMESTAMP()),
+	(138, 2135, '壁面増幅反射器', UNIX_TIMESTAMP()),
+	(294, 3290, '多層熱交換装置', UNIX_TIMESTAMP()),
+	(392, 4387, '高感度粒子探知器', UNIX_TIMESTAMP()),
+	(440, 5434, '高効率電力貯蔵装置', UNIX_TIMESTAMP()),
+	(393, 3389, '無色透明剤', UNIX_TIMESTAMP()),
+	(222, 2219, '激光粒子蒸散器', UNIX_TIMESTAMP()),
+	(373, 4368, '高温反応抑制装置', UNIX_TIMESTAMP()),
+	(327, 7319, '多重分光反射鏡', UNIX_TIMESTAMP()),
+	(327, 1325, '比色光源装置', UNIX_TIMESTAMP()),
+	(344, 4339, '安定化高周波発振器', UNIX_TIMESTAMP()),
+	(698, 6691, '確率密度測定器', UNIX_TIMESTAMP()),
+	(662, 4657, '高密度衝突検出装置', UNIX_TIMESTAMP()),
+	(270, 269, '作用力量子計測機', UNIX_TIMESTAMP()),
+	(476, 4471, '要素構築評価器', UNIX_TIMESTAMP()),
+	(10, 3006, '同期周波数発信器', UNIX_TIMESTAMP()),
+	(813, 6806, '電位差監視装置', UNIX_TIMESTAMP()),
+	(673, 5667, '高能効果分割器', UNIX_TIMESTAMP()),
+	(88, 6081, '五感離散化盾', UNIX_TIMESTAMP()),
+	(177, 3173, '衛星琴発信機', UNIX_TIMESTAMP()),
+	(220, 3216, '肺細胞圧縮機', UNIX_TIMESTAMP()),
+	(642, 1640, '虚構世界翻訳機能', UNIX_TIMESTAMP()),
+	(289, 5283, '九心解読器', UNIX_TIMESTAMP()),
+	(569, 5563, '遺伝子修復', UNIX_TIMESTAMP()),
+	(452, 451, '高圧開閉器', UNIX_TIMESTAMP()),
+	(411, 2408, '非意識的行動', UNIX_TIMESTAMP()),
+	(503, 502, '水晶瓶闘術', UNIX_TIMESTAMP()),
+	(548, 5542, '霊子制御術', UNIX_TIMESTAMP()),
+	(374, 7366, '緑藻誘導物資', UNIX_TIMESTAMP()),
+	(925, 4920, '冥界共振装置', UNIX_TIMESTAMP()),
+	(468, 4463, '原子分子配置策', UNIX_TIMESTAMP()),
+	(121, 6114, '加速逐次解析', UNIX_TIMESTAMP()),
+	(958, 2955, '隠匿式暗号通信', UNIX_TIMESTAMP()),
+	(671, 5665, '非線形電磁制御', UNIX_TIMESTAMP()),
+	(92, 4087, '計量多変量解析', UNIX_TIMESTAMP()),
+	(221, 4216, '足場抑制作用', UNIX_TIMESTAMP()),
+	(337, 3333, '植物栄養状態評価', UNIX_TIMESTAMP()),
+	(662, 661, '地球磁場逆転説', UNIX_TIMESTAMP()),
+	(527, 3523, '生体振動計', UNIX_TIMESTAMP()),
+	(822, 6815, '多変量解析', UNIX_TIMESTAMP()),
+	(872, 3868, '感情微振動', UNIX_TIMESTAMP()),
+	(569, 2566, '直進衝突防止', UNIX_TIMESTAMP()),
+	(800, 3796, '空間膨張機構', UNIX_TIMESTAMP()),
+	(998, 4993, '透明認識装置', UNIX_TIMESTAMP()),
+	(895, 3891, '密度重合体', UNIX_TIMESTAMP()),
+	(851, 3847, '拡散無限差', UNIX_TIMESTAMP()),
+	(568, 567, '反斜線統廃合', UNIX_TIMESTAMP()),
+	(377, 2374, '軌道並列化', UNIX_TIMESTAMP()),
+	(643, 2640, '核心面喪失', UNIX_TIMESTAMP()),
+	(209, 3205, '分子結晶抽出', UNIX_TIMESTAMP()),
+	(867, 2864, '界面融合効果', UNIX_TIMESTAMP()),
+	(658, 2655, '相転位放電', UNIX_TIMESTAMP()),
+	(470, 6463, '硫黄生成反応', UNIX_TIMESTAMP()),
+	(260, 1258, '多極導波路', UNIX_TIMESTAMP()),
+	(152, 5146, '隠埋深度制御', UNIX_TIMESTAMP()),
+	(518, 2515, '無重力飛行', UNIX_TIMESTAMP()),
+	(716, 6709, '高次元計算', UNIX_TIMESTAMP()),
+	(868, 867, '精神感知術', UNIX_TIMESTAMP()),
+	(895, 1893, '真理探求術', UNIX_TIMESTAMP()),
+	(714, 1712, '気候予測術', UNIX_TIMESTAMP()),
+	(162, 3158, '意識転送術', UNIX_TIMESTAMP()),
+	(811, 1809, '微生物拡散装置', UNIX_TIMESTAMP()),
+	(706, 6699, '動植物相関図', UNIX_TIMESTAMP()),
+	(969, 6962, '模擬意識転移装置', UNIX_TIMESTAMP()),
+	(996, 3992, '鉱物相関解析', UNIX_TIMESTAMP()),
+	(599, 3595, '霊魂探査器', UNIX_TIMESTAMP()),
+	(783, 4778, '霊子磁気共鳴', UNIX_TIMESTAMP()),
+	(791, 1789, '無限微分体', UNIX_TIMESTAMP()),
+	(118, 7110, '水晶微粒子', UNIX_TIMESTAMP()),
+	(378, 377, '形態同調装置', UNIX_TIMESTAMP()),
+	(61, 6054, '幻想擬似物質', UNIX_TIMESTAMP()),
+	(124, 5118, '魔力噴射装置', UNIX_TIMESTAMP()),
+	(584, 5578, '量子傍反応', UNIX_TIMESTAMP()),
+	(950, 949, '想念移転装置', UNIX_TIMESTAMP()),
+	(607, 2604, '磁界焦点化', UNIX_TIMESTAMP()),
+	(75, 2072, '幻影記録体', UNIX_TIMESTAMP()),
+	(410, 1408, '植物共振度', UNIX_TIMESTAMP()),
+	(160, 5154, '超技機心理器', UNIX_TIMESTAMP()),
+	(317, 2314, '時空融合中心', UNIX_TIMESTAMP()),
+	(890, 2887, '精神物質化', UNIX_TIMESTAMP()),
+	(89, 2086, '想念分析器', UNIX_TIMESTAMP()),
+	(403, 5397, '効用最大化', UNIX_TIMESTAMP()),
+	(16, 3012, '臨床症採集', UNIX_TIMESTAMP()),
+	(369, 4364, '血小板減少', UNIX_TIMESTAMP()),
+	(513, 2510, '美術史研究', UNIX_TIMESTAMP()),
+	(429, 2426, '農産物加工', UNIX_TIMESTAMP()),
+	(151, 3147, '星路結晶体', UNIX_TIMESTAMP()),
+	(247, 6240, '龍脈鑑定師', UNIX_TIMESTAMP()),
+	(731, 1729, '居合破魔刀', UNIX_TIMESTAMP()),
+	(537, 6530, '量子圧縮機', UNIX_TIMESTAMP()),
+	(612, 3608, '時空転移装置', UNIX_TIMESTAMP()),
+	(704, 5698, '心象現実変換装置', UNIX_TIMESTAMP()),
+	(253, 4248, '真空重力透明化装置', UNIX_TIMESTAMP()),
+	(829, 1827, '進化判定器', UNIX_TIMESTAMP()),
+	(708, 4703, '感情増幅装置', UNIX_TIMESTAMP()),
+	(474, 3470, '神経応答分析器', UNIX_TIMESTAMP()),
+	(589, 588, '音波振動強化装置', UNIX_TIMESTAMP()),
+	(666, 6659, '四次元視覚改革器', UNIX_TIMESTAMP()),
+	(178, 7170, '感情解析器', UNIX_TIMESTAMP()),
+	(585, 1583, '経済計量分析', UNIX_TIMESTAMP()),
+	(165, 3161, '美術史展覧会', UNIX_TIMESTAMP()),
+	(403, 6396, '超音速重力制御装置', UNIX_TIMESTAMP()),
+	(556, 5550, '分子複合物分析', UNIX_TIMESTAMP()),
+	(233, 1231, '光透過可視化装置', UNIX_TIMESTAMP()),
+	(17, 5011, '生体光合成反応阻害剤', UNIX_TIMESTAMP()),
+	(677, 1675, '自己複製無機材料', UNIX_TIMESTAMP()),
+	(578, 577, '周波数増加量子電子回路', UNIX_TIMESTAMP()),
+	(97, 96, '風力制動器', UNIX_TIMESTAMP()),
+	(848, 1846, '量子粒子共鳴', UNIX_TIMESTAMP()),
+	(577, 4572, '無機生命体', UNIX_TIMESTAMP()),
+	(183, 2180, '熱核反応炉', UNIX_TIMESTAMP()),
+	(900, 6893, '心理結界術', UNIX_TIMESTAMP()),
+	(915, 1913, '真空融接器', UNIX_TIMESTAMP()),
+	(890, 1888, '音波磁気検出', UNIX_TIMESTAMP()),
+	(565, 5559, '携帯雷撃弾', UNIX_TIMESTAMP()),
+	(769, 768, '古代符文解読', UNIX_TIMESTAMP()),
+	(447, 7439, '隕石鉱石採掘', UNIX_TIMESTAMP()),
+	(181, 1179, '生物機警装置', UNIX_TIMESTAMP()),
+	(617, 616, '擬似重力制御', UNIX_TIMESTAMP()),
+	(8, 4003, '気象降水量計', UNIX_TIMESTAMP()),
+	(482, 4477, '酸化還元電位', UNIX_TIMESTAMP()),
+	(191, 2188, '高次元演繹', UNIX_TIMESTAMP()),
+	(293, 1291, '超音速摩擦車', UNIX_TIMESTAMP()),
+	(896, 895, '磁鉄石合金', UNIX_TIMESTAMP()),
+	(430, 3426, '言語変換器', UNIX_TIMESTAMP()),
+	(960, 3956, '錬金術施設', UNIX_TIMESTAMP()),
+	(698, 1696, '結晶波真空', UNIX_TIMESTAMP()),
+	(842, 1840, '有機光合成', UNIX_TIMESTAMP()),
+	(530, 1528, '情報織物解析', UNIX_TIMESTAMP()),
+	(290, 1288, '電子空気調節', UNIX_TIMESTAMP()),
+	(493, 7485, '磁気浮上航空', UNIX_TIMESTAMP()),
+	(696, 5690, '仮想透明装置', UNIX_TIMESTAMP()),
+	(163, 5157, '惑星軌道制御', UNIX_TIMESTAMP()),
+	(77, 3073, '透過光干渉計', UNIX_TIMESTAMP()),
+	(665, 664, '融合酸化機', UNIX_TIMESTAMP()),
+	(941, 2938, '伝動雷装糸', UNIX_TIMESTAMP()),
+	(351, 5345, '微生物融解基質', UNIX_TIMESTAMP()),
+	(364, 7356, '圧縮懸濁液', UNIX_TIMESTAMP()),
+	(441, 4436, '環境複合被覆膜', UNIX_TIMESTAMP()),
+	(308, 3304, '構造破壊値', UNIX_TIMESTAMP()),
+	(842, 841, '知覚飛禽温息覚', UNIX_TIMESTAMP()),
+	(435, 3431, '応用昆布真空', UNIX_TIMESTAMP()),
+	(154, 1152, '拡散残音迷路', UNIX_TIMESTAMP()),
+	(221, 4216, '安定多重共鳴繊維', UNIX_TIMESTAMP()),
+	(161, 4156, '冒頭定常位相', UNIX_TIMESTAMP()),
+	(123, 4118, '漸進加速装置', UNIX_TIMESTAMP()),
+	(632, 5626, '奇摩伏塲急', UNIX_TIMESTAMP()),
+	(714, 2711, '精紡新繊維技巧', UNIX_TIMESTAMP()),
+	(584, 2581, '覚醒連結共重力', UNIX_TIMESTAMP()),
+	(979, 4974, '位相調幅波動', UNIX_TIMESTAMP()),
+	(736, 3732, '触覚映像化', UNIX_TIMESTAMP()),
+	(241, 240, '生体浸透率', UNIX_TIMESTAMP()),
+	(223, 1221, '空間遠心効果', UNIX_TIMESTAMP()),
+	(723, 6716, '心理透視術', UNIX_TIMESTAMP()),
+	(979, 978, '音楽伝達装置', UNIX_TIMESTAMP()),
+	(357, 5351, '記憶深層解析', UNIX_TIMESTAMP()),
+	(583, 4578, '遺伝子逆操作', UNIX_TIMESTAMP()),
+	(499, 6492, '時間断片化', UNIX_TIMESTAMP()),
+	(123, 6116, '知覚情報処理', UNIX_TIMESTAMP()),
+	(158, 2155, '無重力状態生成', UNIX_TIMESTAMP()),
+	(14, 2011, '行動言語相互変換', UNIX_TIMESTAMP()),
+	(326, 3322, '進化回帰仮説', UNIX_TIMESTAMP()),
+	(253, 6246, '移行書体解析', UNIX_TIMESTAMP()),
+	(489, 7481, '光遠隔通信', UNIX_TIMESTAMP()),
+	(682, 2679, '精神振動増幅', UNIX_TIMESTAMP()),
+	(149, 3145, '物体回転同期化', UNIX_TIMESTAMP()),
+	(590, 2587, '蒸気積層構造', UNIX_TIMESTAMP()),
+	(416, 6409, '磁気粒子散策', UNIX_TIMESTAMP()),
+	(168, 3164, '網膜軌跡分析', UNIX_TIMESTAMP()),
+	(722, 3718, '高密度空気浸透', UNIX_TIMESTAMP()),
+	(642, 4637, '伸縮耐久合金', UNIX_TIMESTAMP()),
+	(88, 6081, '半球熱交換', UNIX_TIMESTAMP()),
+	(302, 6295, '回折間接射影', UNIX_TIMESTAMP()),
+	(499, 498, '斜行磁気度測定', UNIX_TIMESTAMP()),
+	(522, 5516, '熱中油圧駆動', UNIX_TIMESTAMP()),
+	(705, 704, '集光熱量計算', UNIX_TIMESTAMP()),
+	(2, 3997, '三角高速加算', UNIX_TIMESTAMP()),
+	(844, 2841, '自律蒸散制御', UNIX_TIMESTAMP()),
+	(994, 993, '無錫投光効果', UNIX_TIMESTAMP()),
+	(878, 6871, '紅桜調香術', UNIX_TIMESTAMP()),
+	(525, 4520, '紫電舞剣術', UNIX_TIMESTAMP()),
+	(816, 3812, '地獄煉金術', UNIX_TIMESTAMP()),
+	(395, 3391, '白夜幻想術', UNIX_TIMESTAMP()),
+	(209, 6202, '精密演算器', UNIX_TIMESTAMP()),
+	(982, 1980, '高密構造体', UNIX_TIMESTAMP()),
+	(97, 2094, '磁気電位器', UNIX_TIMESTAMP()),
+	(139, 7131, '情報変換装置', UNIX_TIMESTAMP()),
+	(787, 2784, '動力制御器', UNIX_TIMESTAMP()),
+	(403, 402, '空間認識網', UNIX_TIMESTAMP()),
+	(366, 7358, '衝力抵抗剤', UNIX_TIMESTAMP()),
+	(778, 1776, '熱伝導係数', UNIX_TIMESTAMP()),
+	(512, 2509, '触媒再生剤', UNIX_TIMESTAMP()),
+	(131, 7123, '音波測定装置', UNIX_TIMESTAMP()),
+	(49, 6042, '画像認識器', UNIX_TIMESTAMP()),
+	(422, 6415, '動物光固体', UNIX_TIMESTAMP()),
+	(145, 5139, '磁場補正器', UNIX_TIMESTAMP()),
+	(67, 4062, '空気騒音減衰器', UNIX_TIMESTAMP()),
+	(940, 939, '水質浄化材', UNIX_TIMESTAMP()),
+	(940, 2937, '電磁波遮断装置', UNIX_TIMESTAMP()),
+	(13, 1011, '機械振動吸収体', UNIX_TIMESTAMP()),
+	(21, 4016, '霊脈探査機', UNIX_TIMESTAMP()),
+	(677, 4672, '神奈川効能晶', UNIX_TIMESTAMP()),
+	(54, 1052, '創造風動機', UNIX_TIMESTAMP()),
+	(875, 6868, '調律電気転送器', UNIX_TIMESTAMP()),
+	(438, 3434, '薬玉製造機', UNIX_TIMESTAMP()),
+	(29, 4024, '九箇星天盤', UNIX_TIMESTAMP()),
+	(141, 3137, '時空断層摩擦装置', UNIX_TIMESTAMP()),
+	(166, 3162, '遺伝暗号解読機', UNIX_TIMESTAMP()),
+	(988, 987, '意識拡張機器', UNIX_TIMESTAMP()),
+	(617, 1615, '宇宙結晶調和装置', UNIX_TIMESTAMP()),
+	(403, 7395, '定時律動補助装置', UNIX_TIMESTAMP()),
+	(932, 931, '有機反応合成', UNIX_TIMESTAMP()),
+	(689, 3685, '高濃度電流照射', UNIX_TIMESTAMP()),
+	(519, 2516, '乾地改良装置', UNIX_TIMESTAMP()),
+	(142, 2139, '発酵制御装置', UNIX_TIMESTAMP()),
+	(410, 3406, '入力変換装置', UNIX_TIMESTAMP()),
+	(273, 6266, '生態連鎖解析', UNIX_TIMESTAMP()),
+	(322, 5316, '体液循環補助装置', UNIX_TIMESTAMP()),
+	(458, 4453, '完全自動操縦器', UNIX_TIMESTAMP()),
+	(899, 5893, '電磁波遮断膜', UNIX_TIMESTAMP()),
+	(842, 3838, '社会風封制度', UNIX_TIMESTAMP()),
+	(781, 5775, '音響演算器', UNIX_TIMESTAMP()),
+	(181, 180, '薬物結晶析出装置', UNIX_TIMESTAMP()),
+	(611, 4606, '無酸素燃焼機構', UNIX_TIMESTAMP()),
+	(214, 2211, '社会心理曲面計測器', UNIX_TIMESTAMP()),
+	(123, 6116, '非比例指令伝達関数', UNIX_TIMESTAMP()),
+	(797, 4792, '水中音響故障診断装置', UNIX_TIMESTAMP()),
+	(220, 3216, '自己増殖有機結晶調製装置', UNIX_TIMESTAMP()),
+	(63, 7055, '超音速風洞実験器', UNIX_TIMESTAMP()),
+	(830, 2827, '培養細胞増殖器', UNIX_TIMESTAMP()),
+	(17, 2014, '凝縮運動量散乱分布測定器', UNIX_TIMESTAMP()),
+	(167, 2164, '相互振動共鳴再構成装置', UNIX_TIMESTAMP()),
+	(166, 4161, '創造言媒体', UNIX_TIMESTAMP()),
+	(334, 2331, '時空転送器', UNIX_TIMESTAMP()),
+	(811, 2808, '悪魔拘束網', UNIX_TIMESTAMP()),
+	(242, 1240, '百夜図書館', UNIX_TIMESTAMP()),
+	(242, 5236, '均衡魔力装置', UNIX_TIMESTAMP()),
+	(308, 6301, '非破壊評価', UNIX_TIMESTAMP()),
+	(272, 3268, '駆動無関心', UNIX_TIMESTAMP()),
+	(164, 7156, '記憶曖昧化', UNIX_TIMESTAMP()),
+	(743, 742, '精神振動子', UNIX_TIMESTAMP()),
+	(607, 5601, '心霊共鳴装置', UNIX_TIMESTAMP()),
+	(97, 7089, '霊子分子検知器', UNIX_TIMESTAMP()),
+	(199, 6192, '霊的共鳴器', UNIX_TIMESTAMP()),
+	(745, 5739, '時空瞬間移動装置', UNIX_TIMESTAMP()),
+	(102, 101, '対魔物体境界鏡', UNIX_TIMESTAMP()),
+	(978, 3974, '魔力急速生成器', UNIX_TIMESTAMP()),
+	(71, 70, '奇跡発生装置', UNIX_TIMESTAMP()),
+	(710, 3706, '反物質創造装置', UNIX_TIMESTAMP()),
+	(741, 740, '絶対防御結界', UNIX_TIMESTAMP()),
+	(593, 5587, '呪文解読暗号機', UNIX_TIMESTAMP()),
+	(345, 6338, '過去観測装置', UNIX_TIMESTAMP()),
+	(997, 5991, '時間逆転装置', UNIX_TIMESTAMP()),
+	(658, 5652, '平行世界探査機', UNIX_TIMESTAMP()),
+	(53, 6046, '瞬間結合装置', UNIX_TIMESTAMP()),
+	(235, 4230, '鋼鉄強度増強剤', UNIX_TIMESTAMP()),
+	(241, 4236, '超音速飛行装置', UNIX_TIMESTAMP()),
+	(925, 2922, '核融合反応', UNIX_TIMESTAMP()),
+	(251, 6244, '組織再生因子', UNIX_TIMESTAMP()),
+	(660, 4655, '電磁透明材料', UNIX_TIMESTAMP()),
+	(572, 1570, '精神共有装置', UNIX_TIMESTAMP()),
+	(923, 3919, '情報応答率', UNIX_TIMESTAMP()),
+	(852, 3848, '飛躍振動装置', UNIX_TIMESTAMP()),
+	(913, 912, '時差共鳴装置', UNIX_TIMESTAMP()),
+	(319, 6312, '計算規模指数', UNIX_TIMESTAMP()),
+	(727, 726, '透明膜形成剤', UNIX_TIMESTAMP()),
+	(182, 3178, '機巧対称化', UNIX_TIMESTAMP()),
+	(761, 6754, '構造調節葉', UNIX_TIMESTAMP()),
+	(28, 7020, '真空多重量子', UNIX_TIMESTAMP()),
+	(345, 1343, '媒体進化欠如', UNIX_TIMESTAMP()),
+	(564, 563, '位相交換推移', UNIX_TIMESTAMP()),
+	(387, 7379, '固定化態勢', UNIX_TIMESTAMP()),
+	(208, 6201, '希波解析図', UNIX_TIMESTAMP()),
+	(566, 4561, '位相結節化', UNIX_TIMESTAMP()),
+	(82, 4077, '非揮発存備', UNIX_TIMESTAMP()),
+	(156, 4151, '潜在循環変数', UNIX_TIMESTAMP()),
+	(194, 3190, '機械補力化', UNIX_TIMESTAMP()),
+	(304, 4299, '表裏鏡素子', UNIX_TIMESTAMP()),
+	(912, 6905, '自然響研究', UNIX_TIMESTAMP()),
+	(784, 3780, '無重力反応', UNIX_TIMESTAMP()),
+	(805, 6798, '宇宙熱噴射', UNIX_TIMESTAMP()),
+	(594, 593, '感覚強化装置', UNIX_TIMESTAMP()),
+	(982, 4977, '孤独強制鎮静', UNIX_TIMESTAMP()),
+	(261, 1259, '潜在思考解放', UNIX_TIMESTAMP()),
+	(630, 6623, '平衡維持機構', UNIX_TIMESTAMP()),
+	(315, 7307, '睡眠再構成', UNIX_TIMESTAMP()),
+	(904, 2901, '感情消去装置', UNIX_TIMESTAMP()),
+	(607, 5601, '星間移動通路', UNIX_TIMESTAMP()),
+	(959, 3955, '無効突破行為', UNIX_TIMESTAMP()),
+	(889, 888, '炎上防止装置', UNIX_TIMESTAMP()),
+	(44, 43, '思考増幅装置', UNIX_TIMESTAMP()),
+	(331, 6324, '感情調節装置', UNIX_TIMESTAMP()),
+	(487, 1485, '記憶軌跡鑑識', UNIX_TIMESTAMP()),
+	(846, 3842, '音楽分子構築', UNIX_TIMESTAMP()),
+	(268, 1266, '語彙解像度', UNIX_TIMESTAMP()),
+	(998, 2995, '星間操縦装置', UNIX_TIMESTAMP()),
+	(206, 6199, '時間加算機', UNIX_TIMESTAMP()),
+	(10, 4005, '幻覚遮断装置', UNIX_TIMESTAMP()),
+	(499, 6492, '天候意識化装置', UNIX_TIMESTAMP()),
+	(810, 2807, '認識結晶解析', UNIX_TIMESTAMP()),
+	(446, 4441, '複合感情反応', UNIX_TIMESTAMP()),
+	(721, 4716, '躁鬱反応検査', UNIX_TIMESTAMP()),
+	(394, 3390, '連動応答計算', UNIX_TIMESTAMP()),
+	(646, 5640, '円形検査器', UNIX_TIMESTAMP()),
+	(243, 7235, '星霊封印術', UNIX_TIMESTAMP()),
+	(713, 5707, '虚数精読技', UNIX_TIMESTAMP()),
+	(164, 1162, '心臓脈動分析', UNIX_TIMESTAMP()),
+	(597, 5591, '平抑平滑化', UNIX_TIMESTAMP()),
+	(348, 4343, '植物光合成反応', UNIX_TIMESTAMP()),
+	(463, 6456, '暗黒遺伝子研究', UNIX_TIMESTAMP()),
+	(894, 4889, '密閉包囲戦術', UNIX_TIMESTAMP()),
+	(249, 248, '宇宙浸食計画', UNIX_TIMESTAMP()),
+	(46, 4041, '猛獣使役術', UNIX_TIMESTAMP()),
+	(823, 3819, '鳥構造物解析', UNIX_TIMESTAMP()),
+	(806, 2803, '拡散電子顕微鏡', UNIX_TIMESTAMP()),
+	(148, 4143, '複合有機物', UNIX_TIMESTAMP()),
+	(460, 459, '非線形応答', UNIX_TIMESTAMP()),
+	(890, 1888, '生体情報解析', UNIX_TIMESTAMP()),
+	(170, 1168, '微小振動子', UNIX_TIMESTAMP()),
+	(225, 7217, '非可視空間', UNIX_TIMESTAMP()),
+	(351, 3347, '蒸発冷却装置', UNIX_TIMESTAMP()),
+	(464, 3460, '交換耐震構造', UNIX_TIMESTAMP()),
+	(393, 3389, '溶解度曲線', UNIX_TIMESTAMP()),
+	(904, 4899, '電荷転送素子', UNIX_TIMESTAMP()),
+	(387, 5381, '液体結晶化', UNIX_TIMESTAMP()),
+	(898, 4893, '高分子生成反応', UNIX_TIMESTAMP()),
+	(686, 4681, '超音波断層撮像', UNIX_TIMESTAMP()),
+	(264, 4259, '帯域通信速度', UNIX_TIMESTAMP()),
+	(123, 3119, '地磁極転倒', UNIX_TIMESTAMP()),
+	(771, 4766, '火山射出体', UNIX_TIMESTAMP()),
+	(135, 3131, '磁気分子状態', UNIX_TIMESTAMP()),
+	(470, 469, '鉱脈鉱石形成', UNIX_TIMESTAMP()),
+	(577, 2574, '核融合研究', UNIX_TIMESTAMP()),
+	(356, 3352, '蒼天功貫地', UNIX_TIMESTAMP()),
+	(103, 6096, '斬魔昏冥術', UNIX_TIMESTAMP()),
+	(701, 2698, '実現不可能点', UNIX_TIMESTAMP()),
+	(537, 6530, '経済水面効果', UNIX_TIMESTAMP()),
+	(48, 2045, '社会際契約', UNIX_TIMESTAMP()),
+	(216, 7208, '赤蝋地文字', UNIX_TIMESTAMP()),
+	(218, 2215, '二次連立在数', UNIX_TIMESTAMP()),
+	(584, 6577, '耳式自動書記', UNIX_TIMESTAMP()),
+	(758, 6751, '超音波分光', UNIX_TIMESTAMP()),
+	(100, 3096, '輻射光干渉', UNIX_TIMESTAMP()),
+	(725, 6718, '精密精神覚醒', UNIX_TIMESTAMP()),
+	(952, 5946, '細胞増殖剤', UNIX_TIMESTAMP()),
+	(501, 2498, '構造遺伝子解析', UNIX_TIMESTAMP()),
+	(209, 6202, '筋肉信号増幅', UNIX_TIMESTAMP()),
+	(929, 6922, '液体固化反応', UNIX_TIMESTAMP()),
+	(442, 6435, '水蒸気圧降下反応', UNIX_TIMESTAMP()),
+	(602, 3598, '集束光共鳴効果', UNIX_TIMESTAMP()),
+	(414, 2411, '結晶構造相転移', UNIX_TIMESTAMP()),
+	(161, 6154, '分子濃縮線電位差', UNIX_TIMESTAMP()),
+	(767, 5761, '株価息吹指標', UNIX_TIMESTAMP()),
+	(559, 5553, '回転速度音波共鳴', UNIX_TIMESTAMP()),
+	(811, 4806, '電子失活能力係数', UNIX_TIMESTAMP()),
+	(647, 646, '遺伝子組換速度', UNIX_TIMESTAMP()),
+	(776, 775, '反動物理反応力', UNIX_TIMESTAMP()),
+	(37, 1035, '血圧安定化効果因子', UNIX_TIMESTAMP()),
+	(59, 7051, '無重力環境適化反応', UNIX_TIMESTAMP()),
+	(344, 3340, '植物発芽抑制効果', UNIX_TIMESTAMP()),
+	(103, 5097, '衰退曲線増加因子', UNIX_TIMESTAMP()),
+	(742, 4737, '表面電極虫歯予防', UNIX_TIMESTAMP()),
+	(576, 1574, '飛沫拡散抑制率', UNIX_TIMESTAMP()),
+	(168, 1166, '車輪非接触回転', UNIX_TIMESTAMP()),
+	(997, 996, '熱伝導強度指数', UNIX_TIMESTAMP()),
+	(92, 3088, '四次元擬似空間', UNIX_TIMESTAMP()),
+	(875, 3871, '霊体隔離装置', UNIX_TIMESTAMP()),
+	(202, 1200, '古生物恐慌', UNIX_TIMESTAMP()),
+	(779, 3775, '重力逆転機', UNIX_TIMESTAMP()),
+	(142, 1140, '天体分光計', UNIX_TIMESTAMP()),
+	(413, 7405, '暗黒加速器', UNIX_TIMESTAMP()),
+	(866, 865, '力場防護服', UNIX_TIMESTAMP()),
+	(104, 3100, '磁力増強機', UNIX_TIMESTAMP()),
+	(735, 3731, '感情解析装置', UNIX_TIMESTAMP()),
+	(291, 4286, '無重力燃焼実験', UNIX_TIMESTAMP()),
+	(963, 1961, '壁面再生材料', UNIX_TIMESTAMP()),
+	(437, 4432, '空間膨張調整機', UNIX_TIMESTAMP()),
+	(649, 5643, '脳波制御装置', UNIX_TIMESTAMP()),
+	(441, 7433, '情報窒息現象', UNIX_TIMESTAMP()),
+	(540, 2537, '光子倍増回線', UNIX_TIMESTAMP()),
+	(277, 276, '心的天気予報', UNIX_TIMESTAMP()),
+	(374, 1372, '森林焦点内視鏡', UNIX_TIMESTAMP()),
+	(761, 760, '統計響度指標', UNIX_TIMESTAMP()),
+	(929, 3925, '知覚傾斜推理', UNIX_TIMESTAMP()),
+	(6, 3002, '暗黒天地融合', UNIX_TIMESTAMP()),
+	(200, 3196, '地獄演算子', UNIX_TIMESTAMP()),
+	(933, 6926, '心拍血圧反射', UNIX_TIMESTAMP()),
+	(64, 5058, '空間遠隔通信', UNIX_TIMESTAMP()),
+	(313, 4308, '高分子酵素触媒', UNIX_TIMESTAMP()),
+	(272, 6265, '動的最適制御', UNIX_TIMESTAMP()),
+	(867, 3863, '素粒子焦電効果', UNIX_TIMESTAMP()),
+	(231, 7223, '心理空間知覚', UNIX_TIMESTAMP()),
+	(829, 2826, '脳神経突起形態', UNIX_TIMESTAMP()),
+	(639, 4634, '画像非線形解析', UNIX_TIMESTAMP()),
+	(131, 7123, '記憶探査術', UNIX_TIMESTAMP()),
+	(574, 6567, '冥界交信術', UNIX_TIMESTAMP()),
+	(306, 3302, '想念貯蔵装置', UNIX_TIMESTAMP()),
+	(752, 2749, '石器像彫刻術', UNIX_TIMESTAMP()),
+	(173, 4168, '宇宙時空操術', UNIX_TIMESTAMP()),
+	(45, 3041, '無機質繁華', UNIX_TIMESTAMP()),
+	(41, 2038, '魔力注入器', UNIX_TIMESTAMP()),
+	(10, 1008, '重力制御装置', UNIX_TIMESTAMP()),
+	(75, 2072, '音波共振装置', UNIX_TIMESTAMP()),
+	(203, 4198, '量子蒸気機関', UNIX_TIMESTAMP()),
+	(187, 5181, '反物質反応炉', UNIX_TIMESTAMP()),
+	(355, 2352, '幻樹根絡術', UNIX_TIMESTAMP()),
+	(70, 69, '無空封砕術', UNIX_TIMESTAMP()),
+	(197, 3193, '径極波発生機', UNIX_TIMESTAMP()),
+	(506, 1504, '神聖装甲綺羅', UNIX_TIMESTAMP()),
+	(915, 3911, '生命体再構成研究', UNIX_TIMESTAMP()),
+	(668, 5662, '樹霊発揮術', UNIX_TIMESTAMP()),
+	(657, 1655, '金剛石魂術', UNIX_TIMESTAMP()),
+	(270, 5264, '水鏡記憶術', UNIX_TIMESTAMP()),
+	(195, 1193, '花菖蒲神通', UNIX_TIMESTAMP()),
+	(643, 642, '暗渠水路制御機構', UNIX_TIMESTAMP()),
+	(439, 1437, '耐震構造解析器', UNIX_TIMESTAMP()),
+	(166, 4161, '超高速融合装置', UNIX_TIMESTAMP()),
+	(836, 6829, '基準温度応答率評価指標', UNIX_TIMESTAMP()),
+	(608, 6601, '地下温湿度制御装置', UNIX_TIMESTAMP()),
+	(9, 1007, '自動空気対流制御装置', UNIX_TIMESTAMP()),
+	(87, 6080, '劣化計測指標', UNIX_TIMESTAMP()),
+	(367, 3363, '錠前暗記術', UNIX_TIMESTAMP()),
+	(708, 5702, '高精度計測装置', UNIX_TIMESTAMP()),
+	(588, 2585, '震界感知器', UNIX_TIMESTAMP()),
+	(152, 151, '磁束加速器', UNIX_TIMESTAMP()),
+	(101, 3097, '波動監視機', UNIX_TIMESTAMP()),
+	(998, 3994, '空間共鳴装置', UNIX_TIMESTAMP()),
+	(811, 2808, '物質位相変換機', UNIX_TIMESTAMP()),
+	(240, 2237, '量子優先検索', UNIX_TIMESTAMP()),
+	(978, 3974, '情動分析器', UNIX_TIMESTAMP()),
+	(8, 7, '宙軌道推進機', UNIX_TIMESTAMP()),
+	(80, 3076, '微生命拡散器', UNIX_TIMESTAMP()),
+	(702, 2699, '振動暗号化', UNIX_TIMESTAMP()),
+	(846, 2843, '魂彫突逡巡', UNIX_TIMESTAMP()),
+	(714, 713, '機械加速度', UNIX_TIMESTAMP()),
+	(58, 7050, '情報統合機', UNIX_TIMESTAMP()),
+	(719, 4714, '超音波複合材料', UNIX_TIMESTAMP()),
+	(596, 1594, '感染病画像診断', UNIX_TIMESTAMP()),
+	(552, 551, '音速超域航空機', UNIX_TIMESTAMP()),
+	(475, 7467, '超空間通信', UNIX_TIMESTAMP()),
+	(607, 3603, '逆融合反応', UNIX_TIMESTAMP()),
+	(532, 531, '念動力装置', UNIX_TIMESTAMP()),
+	(933, 1931, '量子風化現象', UNIX_TIMESTAMP()),
+	(632, 4627, '超眼球観察', UNIX_TIMESTAMP()),
+	(356, 3352, '空間歪曲装置', UNIX_TIMESTAMP()),
+	(698, 5692, '生体共鳴応用', UNIX_TIMESTAMP()),
+	(129, 4124, '宇宙意識転移', UNIX_TIMESTAMP()),
+	(124, 3120, '知覚拡張装置', UNIX_TIMESTAMP()),
+	(463, 4458, '超音波相互作用', UNIX_TIMESTAMP()),
+	(720, 6713, '半成音合成', UNIX_TIMESTAMP()),
+	(67, 4062, '巻貝共生関係', UNIX_TIMESTAMP()),
+	(101, 4096, '発酵抽出物', UNIX_TIMESTAMP()),
+	(111, 4106, '拡散反応速度', UNIX_TIMESTAMP()),
+	(525, 4520, '超新星爆発', UNIX_TIMESTAMP()),
+	(690, 1688, '電位差測定器', UNIX_TIMESTAMP()),
+	(354, 1352, '熱容量露骨度', UNIX_TIMESTAMP()),
+	(756, 6749, '径赤外線領域', UNIX_TIMESTAMP()),
+	(400, 3396, '環境改善新薬', UNIX_TIMESTAMP()),
+	(475, 4470, '加速場定理', UNIX_TIMESTAMP()),
+	(42, 2039, '凍結鏡面顕微鏡', UNIX_TIMESTAMP()),
+	(173, 7165, '行動間関係解析', UNIX_TIMESTAMP()),
+	(634, 6627, '病原遺伝子解析', UNIX_TIMESTAMP()),
+	(478, 5472, '遺伝情報伝達制御', UNIX_TIMESTAMP()),
+	(37, 5031, '線状分子検出器', UNIX_TIMESTAMP()),
+	(178, 177, '碧瑠璃閃光', UNIX_TIMESTAMP()),
+	(943, 2940, '情報降下躯', UNIX_TIMESTAMP()),
+	(111, 2108, '磁気変換序', UNIX_TIMESTAMP()),
+	(893, 1891, '音響環流体', UNIX_TIMESTAMP()),
+	(560, 4555, '心理構造波', UNIX_TIMESTAMP()),
+	(816, 4811, '時空連結器', UNIX_TIMESTAMP()),
+	(580, 4575, '記憶継続線', UNIX_TIMESTAMP()),
+	(747, 3743, '知覚分析値', UNIX_TIMESTAMP()),
+	(276, 7268, '観測干渉塔', UNIX_TIMESTAMP()),
+	(211, 1209, '投射評価因子', UNIX_TIMESTAMP()),
+	(767, 766, '生成乱数表', UNIX_TIMESTAMP()),
+	(840, 1838, '統合振動経路', UNIX_TIMESTAMP()),
+	(454, 1452, '創造思考機', UNIX_TIMESTAMP()),
+	(230, 5224, '分散反響鏡', UNIX_TIMESTAMP()),
+	(142, 2139, '精神共鳴領域', UNIX_TIMESTAMP()),
+	(350, 2347, '意図操作素子', UNIX_TIMESTAMP()),
+	(416, 7408, '無限連鎖構造', UNIX_TIMESTAMP()),
+	(917, 3913, '絶対音聴者', UNIX_TIMESTAMP()),
+	(881, 2878, '干渉面運動', UNIX_TIMESTAMP()),
+	(760, 1758, '円周波音波', UNIX_TIMESTAMP()),
+	(492, 5486, '視差観測器', UNIX_TIMESTAMP()),
+	(315, 5309, '絶対明度観測', UNIX_TIMESTAMP()),
+	(241, 4236, '生命再起動', UNIX_TIMESTAMP()),
+	(4, 6996, '意思境界器', UNIX_TIMESTAMP()),
+	(355, 3351, '超音速干渉', UNIX_TIMESTAMP()),
+	(203, 4198, '金属晶界合成', UNIX_TIMESTAMP()),
+	(926, 4921, '無限大氾濫', UNIX_TIMESTAMP()),
+	(381, 380, '超時空通信', UNIX_TIMESTAMP()),
+	(152, 2149, '不信陥穽取引', UNIX_TIMESTAMP()),
+	(365, 7357, '脳干振動制御', UNIX_TIMESTAMP()),
+	(371, 370, '環境動態基数', UNIX_TIMESTAMP()),
+	(938, 1936, '統合利益最適化', UNIX_TIMESTAMP()),
+	(895, 1893, '溶酸境界効果', UNIX_TIMESTAMP()),
+	(630, 5624, '遺伝報酬検出', UNIX_TIMESTAMP()),
+	(109, 1107, '地球軌道周遊衛星', UNIX_TIMESTAMP()),
+	(196, 195, '声音解析合成器', UNIX_TIMESTAMP()),
+	(711, 1709, '情報総合感染指数', UNIX_TIMESTAMP()),
+	(381, 4376, '暗黒物質量子状態', UNIX_TIMESTAMP()),
+	(251, 1249, '社会適応防衛機構', UNIX_TIMESTAMP()),
+	(320, 4315, '自律車両交通制御', UNIX_TIMESTAMP()),
+	(311, 7303, '物理運動集中研究', UNIX_TIMESTAMP()),
+	(851, 6844, '行動修正記録装置', UNIX_TIMESTAMP()),
+	(817, 6810, '粒状化装置', UNIX_TIMESTAMP()),
+	(999, 998, '融合誘電子', UNIX_TIMESTAMP()),
+	(155, 2152, '音響共鳴解析', UNIX_TIMESTAMP()),
+	(59, 2056, '可変溶湯装置', UNIX_TIMESTAMP()),
+	(977, 1975, '透過反核膜', UNIX_TIMESTAMP()),
+	(128, 3124, '地塊間圧縮', UNIX_TIMESTAMP()),
+	(382, 5376, '空中磁気超伝導', UNIX_TIMESTAMP()),
+	(900, 3896, '曲率現象抽出', UNIX_TIMESTAMP()),
+	(311, 310, '無重力界面制御', UNIX_TIMESTAMP()),
+	(665, 5659, '鉱石局所変質', UNIX_TIMESTAMP()),
+	(884, 1882, '連続自己転写', UNIX_TIMESTAMP()),
+	(886, 4881, '絶対硬度低減', UNIX_TIMESTAMP()),
+	(813, 1811, '反復瞬間核爆', UNIX_TIMESTAMP()),
+	(439, 7431, '記憶再描写装置', UNIX_TIMESTAMP()),
+	(917, 2914, '宇宙旱魃防御', UNIX_TIMESTAMP()),
+	(234, 3230, '起動電磁浮力装置', UNIX_TIMESTAMP()),
+	(370, 2367, '単一分散機械生廃中和', UNIX_TIMESTAMP()),
+	(932, 4927, '絶対色調換算', UNIX_TIMESTAMP()),
+	(795, 4790, '超弾力触媒', UNIX_TIMESTAMP()),
+	(941, 6934, '微細低周波', UNIX_TIMESTAMP()),
+	(308, 307, '直交多面体', UNIX_TIMESTAMP()),
+	(290, 7282, '混合遺伝子解析', UNIX_TIMESTAMP()),
+	(804, 3800, '可変磁位抵抗', UNIX_TIMESTAMP()),
+	(660, 2657, '透過感覚効果', UNIX_TIMESTAMP()),
+	(435, 5429, '断熱高圧槽', UNIX_TIMESTAMP()),
+	(250, 2247, '斜行透明亜鉛石', UNIX_TIMESTAMP()),
+	(202, 1200, '精密種子育成', UNIX_TIMESTAMP()),
+	(136, 4131, '機械非同期化', UNIX_TIMESTAMP()),
+	(14, 3010, '放射線暴露追跡', UNIX_TIMESTAMP()),
+	(438, 6431, '音声響導体', UNIX_TIMESTAMP()),
+	(31, 2028, '低損失高周波阻止層', UNIX_TIMESTAMP()),
+	(107, 4102, '光透明電力伝送', UNIX_TIMESTAMP()),
+	(793, 5787, '微細光反射分布計', UNIX_TIMESTAMP()),
+	(917, 4912, '降圧膜構造', UNIX_TIMESTAMP()),
+	(987, 986, '渦状対称点群', UNIX_TIMESTAMP()),
+	(263, 1261, '比加速度分布測定', UNIX_TIMESTAMP()),
+	(149, 5143, '真空集積体', UNIX_TIMESTAMP()),
+	(198, 197, '水晶脳波推定', UNIX_TIMESTAMP()),
+	(299, 5293, '反虹体構造', UNIX_TIMESTAMP()),
+	(674, 5668, '闊茄子色素', UNIX_TIMESTAMP()),
+	(949, 1947, '酸元素鑑定', UNIX_TIMESTAMP()),
+	(571, 6564, '冷運輸物質', UNIX_TIMESTAMP()),
+	(976, 975, '無構造空地', UNIX_TIMESTAMP()),
+	(41, 5035, '暗闇空間構造', UNIX_TIMESTAMP()),
+	(281, 280, '闇物質研究', UNIX_TIMESTAMP()),
+	(725, 4720, '超金属材料', UNIX_TIMESTAMP()),
+	(14, 3010, '凡例貴金属研究', UNIX_TIMESTAMP()),
+	(664, 5658, '植物遺伝子解析', UNIX_TIMESTAMP()),
+	(12, 3008, '形状最適化', UNIX_TIMESTAMP()),
+	(594, 3590, '高密度複合材料', UNIX_TIMESTAMP()),
+	(725, 3721, '生体生息空間', UNIX_TIMESTAMP()),
+	(423, 422, '暗黒物質検出器', UNIX_TIMESTAMP()),
+	(174, 2171, '神経回路再生医療', UNIX_TIMESTAMP()),
+	(390, 3386, '形状記憶金属', UNIX_TIMESTAMP()),
+	(964, 2961, '非統合素子', UNIX_TIMESTAMP()),
+	(647, 4642, '機械演算述語', UNIX_TIMESTAMP()),
+	(73, 5067, '波動電位解析', UNIX_TIMESTAMP()),
+	(786, 6779, '空間飛行解析', UNIX_TIMESTAMP()),
+	(386, 6379, '透過励起光検出', UNIX_TIMESTAMP()),
+	(138, 4133, '可変相互結合体', UNIX_TIMESTAMP()),
+	(849, 848, '気象現象制御', UNIX_TIMESTAMP()),
+	(577, 576, '生物進化算術', UNIX_TIMESTAMP()),
+	(154, 4149, '空間共有顕微鏡', UNIX_TIMESTAMP()),
+	(296, 4291, '電脈動装置', UNIX_TIMESTAMP()),
+	(7, 4002, '時間延伸器', UNIX_TIMESTAMP()),
+	(386, 6379, '創造力指数', UNIX_TIMESTAMP()),
+	(342, 6335, '脳電位測定', UNIX_TIMESTAMP()),
+	(95, 1093, '精神共振装置', UNIX_TIMESTAMP()),
+	(618, 3614, '薔薇騎士団', UNIX_TIMESTAMP()),
+	(2, 3997, '銀翼魔導師', UNIX_TIMESTAMP()),
+	(436, 3432, '桜花四刀流', UNIX_TIMESTAMP()),
+	(303, 4298, '拡張量子運算', UNIX_TIMESTAMP()),
+	(810, 6803, '複合暗号曲線', UNIX_TIMESTAMP()),
+	(546, 5540, '磁場共鳴制御装置', UNIX_TIMESTAMP()),
+	(140, 139, '精密放射線治療装置', UNIX_TIMESTAMP()),
+	(35, 1033, '宇宙踏査艇', UNIX_TIMESTAMP()),
+	(871, 870, '山地絶対帯', UNIX_TIMESTAMP()),
+	(681, 1679, '炉中研磨機', UNIX_TIMESTAMP()),
+	(876, 6869, '物質同位体推定', UNIX_TIMESTAMP()),
+	(64, 4059, '無機物質熱分解', UNIX_TIMESTAMP()),
+	(545, 544, '生体物質合成', UNIX_TIMESTAMP()),
+	(484, 5478, '界面転移反応', UNIX_TIMESTAMP()),
+	(531, 5525, '結晶構造解析', UNIX_TIMESTAMP()),
+	(210, 5204, '最適化反応制御', UNIX_TIMESTAMP()),
+	(724, 5718, '多元非線形解析', UNIX_TIMESTAMP()),
+	(650, 5644, '量子場生成', UNIX_TIMESTAMP()),
+	(175, 6168, '星間物質分布', UNIX_TIMESTAMP()),
+	(530, 3526, '生態系循環安定化', UNIX_TIMESTAMP()),
+	(148, 2145, '流体静力圧力', UNIX_TIMESTAMP()),
+	(256, 5250, '音響周波数帯域', UNIX_TIMESTAMP()),
+	(721, 5715, '生命体進化加速化', UNIX_TIMESTAMP()),
+	(517, 5511, '材料構造強度評価', UNIX_TIMESTAMP()),
+	(489, 488, '電磁波出力最適化', UNIX_TIMESTAMP()),
+	(198, 7190, '磁場共鳴制御', UNIX_TIMESTAMP()),
+	(515, 2512, '核分裂反応速度', UNIX_TIMESTAMP()),
+	(994, 3990, '地殻変動予測', UNIX_TIMESTAMP()),
+	(74, 7066, '炼狱之螺旋', UNIX_TIMESTAMP()),
+	(79, 7071, '無線塩複送', UNIX_TIMESTAMP()),
+	(62, 7054, '研究院燃耀', UNIX_TIMESTAMP()),
+	(446, 4441, '経済構造化', UNIX_TIMESTAMP()),
+	(336, 4331, '持続的競争優位', UNIX_TIMESTAMP()),
+	(829, 3825, '創造的破壊', UNIX_TIMESTAMP()),
+	(159, 7151, '精神通信術', UNIX_TIMESTAMP()),
+	(881, 4876, '星霊召喚術', UNIX_TIMESTAMP()),
+	(138, 6131, '空間操作術', UNIX_TIMESTAMP()),
+	(475, 6468, '明暗判定術', UNIX_TIMESTAMP()),
+	(872, 1870, '魔力増幅器', UNIX_TIMESTAMP()),
+	(105, 5099, '亜空間共振体', UNIX_TIMESTAMP()),
+	(488, 4483, '時空補正装置', UNIX_TIMESTAMP()),
+	(616, 3612, '意識暗示装置', UNIX_TIMESTAMP()),
+	(683, 4678, '四次元解析装置', UNIX_TIMESTAMP()),
+	(70, 69, '衝雷反應装置', UNIX_TIMESTAMP()),
+	(721, 4716, '反物質転換器', UNIX_TIMESTAMP()),
+	(750, 2747, '分子縮小光線', UNIX_TIMESTAMP()),
+	(127, 6120, '暗黒組織駆動炉', UNIX_TIMESTAMP()),
+	(632, 631, '心視現象解析装置', UNIX_TIMESTAMP()),
+	(896, 6889, '磁場透過加速器', UNIX_TIMESTAMP()),
+	(193, 5187, '気功感応装置', UNIX_TIMESTAMP()),
+	(163, 5157, '超音速分子制御器', UNIX_TIMESTAMP()),
+	(53, 6046, '電波噴射装置', UNIX_TIMESTAMP()),
+	(84, 4079, '潜在因子制御', UNIX_TIMESTAMP()),
+	(145, 7137, '超音速推進', UNIX_TIMESTAMP()),
+	(875, 4870, '魔力調節器', UNIX_TIMESTAMP()),
+	(818, 3814, '生命力吸収', UNIX_TIMESTAMP()),
+	(101, 2098, '風力式蓄電池', UNIX_TIMESTAMP()),
+	(508, 1506, '新書断片化', UNIX_TIMESTAMP()),
+	(661, 3657, '定住化装置', UNIX_TIMESTAMP()),
+	(188, 2185, '微細反射粉', UNIX_TIMESTAMP()),
+	(385, 7377, '無声害因子', UNIX_TIMESTAMP()),
+	(90, 3086, '旅行星固定装置', UNIX_TIMESTAMP()),
+	(970, 2967, '反物質境界', UNIX_TIMESTAMP()),
+	(725, 3721, '傍光子伝播', UNIX_TIMESTAMP()),
+	(63, 62, '連邦対地弾', UNIX_TIMESTAMP()),
+	(95, 94, '巡回移動子', UNIX_TIMESTAMP()),
+	(361, 6354, '航行港制覇', UNIX_TIMESTAMP()),
+	(896, 6889, '遺伝子連鎖体', UNIX_TIMESTAMP()),
+	(863, 3859, '時空転送機構', UNIX_TIMESTAMP()),
+	(749, 3745, '曲芸磁場発生器', UNIX_TIMESTAMP()),
+	(789, 5783, '無重力補正装置', UNIX_TIMESTAMP()),
+	(772, 2769, '細胞再生触媒', UNIX_TIMESTAMP()),
+	(836, 6829, '感情調整装置', UNIX_TIMESTAMP()),
+	(854, 6847, '電子透明化合物', UNIX_TIMESTAMP()),
+	(553, 5547, '分子情報記憶装置', UNIX_TIMESTAMP()),
+	(686, 5680, '無常反応効果器', UNIX_TIMESTAMP()),
+	(834, 4829, '遺伝子暗号解読装置', UNIX_TIMESTAMP()),
+	(771, 2768, '絶対温度測定器', UNIX_TIMESTAMP()),
+	(741, 6734, '静止粒子投射装置', UNIX_TIMESTAMP()),
+	(631, 2628, '地球表面酸素再編装置', UNIX_TIMESTAMP()),
+	(701, 5695, '空気分子遮断装置', UNIX_TIMESTAMP()),
+	(611, 1609, '無重力鉱山採掘機', UNIX_TIMESTAMP()),
+	(48, 5042, '遺伝体脳構造', UNIX_TIMESTAMP()),
+	(393, 5387, '認知信号処理', UNIX_TIMESTAMP()),
+	(741, 5735, '無用陀羅尼', UNIX_TIMESTAMP()),
+	(832, 1830, '生命融合研究', UNIX_TIMESTAMP()),
+	(618, 4613, '能動意識研究', UNIX_TIMESTAMP()),
+	(407, 7399, '古文書解読', UNIX_TIMESTAMP()),
+	(212, 5206, '遺伝子改良', UNIX_TIMESTAMP()),
+	(543, 542, '念動力応用', UNIX_TIMESTAMP()),
+	(885, 2882, '超能力測定', UNIX_TIMESTAMP()),
+	(419, 2416, '無理数装置', UNIX_TIMESTAMP()),
+	(173, 1171, '生命検知器', UNIX_TIMESTAMP()),
+	(616, 5610, '臨場感伝達装置', UNIX_TIMESTAMP()),
+	(450, 7442, '心理空間補完装置', UNIX_TIMESTAMP()),
+	(317, 316, '超高速選択反応路網', UNIX_TIMESTAMP()),
+	(87, 2084, '量子加速素子', UNIX_TIMESTAMP()),
+	(709, 1707, '機械健忘防止装置', UNIX_TIMESTAMP()),
+	(429, 2426, '音響転磁斜標', UNIX_TIMESTAMP()),
+	(737, 6730, '霊感蒸着装置', UNIX_TIMESTAMP()),
+	(254, 5248, '量子結晶計測器', UNIX_TIMESTAMP()),
+	(769, 768, '天体微調整装置', UNIX_TIMESTAMP()),
+	(338, 7330, '燃料電助二象徴', UNIX_TIMESTAMP()),
+	(910, 6903, '冥界航行装置', UNIX_TIMESTAMP()),
+	(319, 2316, '霊感投影幕', UNIX_TIMESTAMP()),
+	(555, 5549, '悪意解析器', UNIX_TIMESTAMP()),
+	(714, 4709, '宇宙磁螺跳', UNIX_TIMESTAMP()),
+	(233, 2230, '魔力貯蔵器', UNIX_TIMESTAMP()),
+	(736, 3732, '元素結晶抽出槽', UNIX_TIMESTAMP()),
+	(958, 3954, '生体進化分析', UNIX_TIMESTAMP()),
+	(515, 3511, '魍刍凜茵回', UNIX_TIMESTAMP()),
+	(96, 2093, '擾脕囁涔訐捨', UNIX_TIMESTAMP()),
+	(527, 3523, '竺醍牴爾爻', UNIX_TIMESTAMP()),
+	(442, 5436, '姥宍栢鑽縒', UNIX_TIMESTAMP()),
+	(190, 2187, '牆虣擓鯊駑', UNIX_TIMESTAMP()),
+	(81, 2078, '拪曟煏減揠', UNIX_TIMESTAMP()),
+	(216, 3212, '豾柅馕剴窲', UNIX_TIMESTAMP()),
+	(728, 6721, '涒蟉堃茞謐', UNIX_TIMESTAMP()),
+	(462, 3458, '寖俚壝蝵兀', UNIX_TIMESTAMP()),
+	(778, 6771, '殔儬埼鞠艄', UNIX_TIMESTAMP()),
+	(740, 2737, '曤靨嚴蟨翍', UNIX_TIMESTAMP()),
+	(648, 1646, '瀉禭侃嗀诶', UNIX_TIMESTAMP()),
+	(379, 378, '屟搛沱鹽風', UNIX_TIMESTAMP()),
+	(347, 346, '瓪咀溚瑐氈', UNIX_TIMESTAMP()),
+	(192, 7184, '無呫汢駆潖', UNIX_TIMESTAMP()),
+	(229, 7221, '瑙刴黥攕鋻', UNIX_TIMESTAMP()),
+	(723, 4718, '蜺葯慠峄鉆', UNIX_TIMESTAMP()),
+	(615, 614, '賒駂慵艻搝', UNIX_TIMESTAMP()),
+	(954, 5948, '超高密度絵画', UNIX_TIMESTAMP()),
+	(19, 2016, '超並列飛行制御', UNIX_TIMESTAMP()),
+	(954, 6947, '高次元仮想現実', UNIX_TIMESTAMP()),
+	(354, 6347, '復元遺伝暗号', UNIX_TIMESTAMP()),
+	(8, 7000, '無限次元情報融合', UNIX_TIMESTAMP()),
+	(491, 4486, '美魔濤綿嵐', UNIX_TIMESTAMP()),
+	(870, 1868, '音波加速魔方陣', UNIX_TIMESTAMP()),
+	(248, 7240, '原始産業再生燃料', UNIX_TIMESTAMP()),
+	(280, 5274, '社会心理適応指標', UNIX_TIMESTAMP()),
+	(852, 5846, '高気密弾力体', UNIX_TIMESTAMP()),
+	(6, 4001, '光電子放出効果', UNIX_TIMESTAMP()),
+	(847, 2844, '心理葉緑素欠損症', UNIX_TIMESTAMP()),
+	(319, 3315, '信号伝達機能遺伝子', UNIX_TIMESTAMP()),
+	(161, 5155, '多重共鳴反応速度', UNIX_TIMESTAMP()),
+	(715, 6708, '遺伝子環境相互作用', UNIX_TIMESTAMP()),
+	(247, 246, '表情形成遺伝子', UNIX_TIMESTAMP()),
+	(188, 2185, '蓄音機立動式朗読装置', UNIX_TIMESTAMP()),
+	(561, 2558, '無重力状態推進装置', UNIX_TIMESTAMP()),
+	(189, 188, '超高速量子通信方式', UNIX_TIMESTAMP()),
+	(172, 5166, '多元宇宙跳躍装置', UNIX_TIMESTAMP()),
+	(797, 2794, '神経伝達最適化装置', UNIX_TIMESTAMP()),
+	(354, 4349, '水中推進核駆動機関', UNIX_TIMESTAMP()),
+	(321, 4316, '超音波同位体分離機', UNIX_TIMESTAMP()),
+	(634, 3630, '量子重力反転装置', UNIX_TIMESTAMP()),
+	(755, 4750, '億倍増速加速器', UNIX_TIMESTAMP()),
+	(121, 4116, '正式波長強度増幅器', UNIX_TIMESTAMP()),
+	(509, 2506, '自己決定意識揺動装置', UNIX_TIMESTAMP()),
+	(378, 7370, '量子脳情報記憶装置', UNIX_TIMESTAMP()),
+	(683, 682, '絶対音速器', UNIX_TIMESTAMP()),
+	(834, 4829, '催涙補償網', UNIX_TIMESTAMP()),
+	(765, 3761, '海鳥直行巣', UNIX_TIMESTAMP()),
+	(838, 837, '氷底反転効果', UNIX_TIMESTAMP()),
+	(916, 915, '高次元接触素', UNIX_TIMESTAMP()),
+	(401, 2398, '旋風斬撃器', UNIX_TIMESTAMP()),
+	(470, 5464, '節分加速機', UNIX_TIMESTAMP()),
+	(469, 6462, '魂体転送装置', UNIX_TIMESTAMP()),
+	(154, 1152, '無限反転抵抗体', UNIX_TIMESTAMP()),
+	(716, 2713, '神経電流拡散装置', UNIX_TIMESTAMP()),
+	(5, 4000, '無重力鍛造機', UNIX_TIMESTAMP()),
+	(929, 6922, '木霊振動砲', UNIX_TIMESTAMP()),
+	(296, 295, '高次元信号解析', UNIX_TIMESTAMP()),
+	(160, 5154, '連続位相発電', UNIX_TIMESTAMP()),
+	(876, 3872, '間遊撃作戦', UNIX_TIMESTAMP()),
+	(669, 2666, '超音速同調回路', UNIX_TIMESTAMP()),
+	(523, 3519, '魂体映像探索', UNIX_TIMESTAMP()),
+	(215, 6208, '音波圧力制御', UNIX_TIMESTAMP()),
+	(837, 3833, '加速度反転装置', UNIX_TIMESTAMP()),
+	(321, 2318, '分子駆動装置', UNIX_TIMESTAMP()),
+	(93, 1091, '高密度光記録', UNIX_TIMESTAMP()),
+	(679, 5673, '磁気共鳴精密測定', UNIX_TIMESTAMP()),
+	(173, 5167, '輝度差倍増機', UNIX_TIMESTAMP()),
+	(457, 1455, '磁場分解装置', UNIX_TIMESTAMP()),
+	(245, 2242, '多層反射体', UNIX_TIMESTAMP()),
+	(669, 2666, '熱膨張抵抗素子', UNIX_TIMESTAMP()),
+	(930, 1928, '色覚適応感度曲線', UNIX_TIMESTAMP()),
+	(832, 1830, '遺伝情報伝達装置', UNIX_TIMESTAMP()),
+	(368, 7360, '光起雲作用', UNIX_TIMESTAMP()),
+	(522, 2519, '高次元多重回帰解析', UNIX_TIMESTAMP()),
+	(844, 843, '空魔蓮模悦', UNIX_TIMESTAMP()),
+	(593, 4588, '錯方位増幅器', UNIX_TIMESTAMP()),
+	(81, 2078, '生体分子冷却', UNIX_TIMESTAMP()),
+	(639, 638, '知覚共有体', UNIX_TIMESTAMP()),
+	(630, 3626, '濃縮反粒子燃料', UNIX_TIMESTAMP()),
+	(537, 5531, '超収束反機構', UNIX_TIMESTAMP()),
+	(80, 7072, '無重力結晶', UNIX_TIMESTAMP()),
+	(393, 5387, '細胞生体情報干渉', UNIX_TIMESTAMP()),
+	(280, 1278, '全波偏光装置', UNIX_TIMESTAMP()),
+	(817, 2814, '遠隔観察器', UNIX_TIMESTAMP()),
+	(185, 2182, '表面微小集合体', UNIX_TIMESTAMP()),
+	(211, 3207, '粒子衝撃推進装置', UNIX_TIMESTAMP()),
+	(210, 2207, '自己修復観測器', UNIX_TIMESTAMP()),
+	(807, 5801, '量子造語生成装置', UNIX_TIMESTAMP()),
+	(674, 3670, '銀河間転送装置', UNIX_TIMESTAMP()),
+	(540, 6533, '月光琉璃竜', UNIX_TIMESTAMP()),
+	(411, 1409, '影月交螳螂', UNIX_TIMESTAMP()),
+	(179, 5173, '暴動鎮圧機', UNIX_TIMESTAMP()),
+	(135, 4130, '複合電磁反応式', UNIX_TIMESTAMP()),
+	(682, 6675, '自己復元膜材料', UNIX_TIMESTAMP()),
+	(637, 6630, '高密度分子検知器', UNIX_TIMESTAMP()),
+	(410, 3406, '時空歪曲装置', UNIX_TIMESTAMP()),
+	(423, 7415, '直接連絡神経機構', UNIX_TIMESTAMP()),
+	(966, 1964, '無機超伝導体', UNIX_TIMESTAMP()),
+	(353, 1351, '高感度磁場探査装置', UNIX_TIMESTAMP()),
+	(494, 493, '複合遺伝情報暗号化', UNIX_TIMESTAMP()),
+	(333, 3329, '時間逆行計測器', UNIX_TIMESTAMP()),
+	(642, 6635, '環境生態識別', UNIX_TIMESTAMP()),
+	(160, 159, '統合情報資源', UNIX_TIMESTAMP()),
+	(977, 5971, '社会進歩指標', UNIX_TIMESTAMP()),
+	(19, 3015, '文化記憶化', UNIX_TIMESTAMP()),
+	(322, 6315, '創造力細胞', UNIX_TIMESTAMP()),
+	(810, 6803, '魔力濃度計', UNIX_TIMESTAMP()),
+	(349, 2346, '資源採掘宇宙艦', UNIX_TIMESTAMP()),
+	(953, 5947, '心霊探査機器', UNIX_TIMESTAMP()),
+	(296, 295, '黄金比探知機', UNIX_TIMESTAMP()),
+	(456, 4451, '水晶魔力研究', UNIX_TIMESTAMP()),
+	(693, 692, '幽鬼通信機', UNIX_TIMESTAMP()),
+	(781, 2778, '天気研究機関', UNIX_TIMESTAMP()),
+	(288, 287, '奇石探査装置', UNIX_TIMESTAMP()),
+	(853, 4848, '秘儀曼荼羅', UNIX_TIMESTAMP()),
+	(240, 3236, '嗅覚分析術', UNIX_TIMESTAMP()),
+	(621, 620, '感覚逆誤差推定', UNIX_TIMESTAMP()),
+	(46, 7038, '環境効果解析', UNIX_TIMESTAMP()),
+	(679, 1677, '飛躍的計算機制御', UNIX_TIMESTAMP()),
+	(775, 6768, '機械顕微鏡解析', UNIX_TIMESTAMP()),
+	(206, 5200, '量子操縦装置', UNIX_TIMESTAMP()),
+	(798, 1796, '高次元干渉器', UNIX_TIMESTAMP()),
+	(801, 3797, '神聖遺伝暗号', UNIX_TIMESTAMP()),
+	(645, 644, '超音速身体遺伝子組織', UNIX_TIMESTAMP()),
+	(848, 5842, '空間書記装置', UNIX_TIMESTAMP()),
+	(959, 958, '無意識遺伝情報解読', UNIX_TIMESTAMP()),
+	(105, 3101, '遺伝子操作機構', UNIX_TIMESTAMP()),
+	(978, 3974, '精神放射機', UNIX_TIMESTAMP()),
+	(447, 6440, '意思念動磁場', UNIX_TIMESTAMP()),
+	(833, 3829, '記憶情報解体砲', UNIX_TIMESTAMP()),
+	(723, 5717, '音波心臓注射器', UNIX_TIMESTAMP()),
+	(316, 4311, '意図念波鏡', UNIX_TIMESTAMP()),
+	(974, 1972, '霊山探査機', UNIX_TIMESTAMP()),
+	(490, 2487, '精神血中浄化装置', UNIX_TIMESTAMP()),
+	(936, 935, '社会風景研究', UNIX_TIMESTAMP()),
+	(176, 2173, '食品上質化', UNIX_TIMESTAMP()),
+	(148, 2145, '経済効率化', UNIX_TIMESTAMP()),
+	(883, 1881, '税務関連制度', UNIX_TIMESTAMP()),
+	(593, 2590, '経営戦略計画', UNIX_TIMESTAMP()),
+	(902, 901, '企業組織再編', UNIX_TIMESTAMP()),
+	(746, 3742, '航空宇宙機構', UNIX_TIMESTAMP()),
+	(992, 991, '地球温暖化対策', UNIX_TIMESTAMP()),
+	(413, 6406, '機動蒸気操舵', UNIX_TIMESTAMP()),
+	(800, 4795, '無尽転写術', UNIX_TIMESTAMP()),
+	(990, 4985, '物体共鳴構造', UNIX_TIMESTAMP()),
+	(975, 2972, '音波透過医療', UNIX_TIMESTAMP()),
+	(399, 4394, '氷渦形状制御', UNIX_TIMESTAMP()),
+	(255, 1253, '飛行霊子制御', UNIX_TIMESTAMP()),
+	(410, 7402, '反重力反応炉', UNIX_TIMESTAMP()),
+	(192, 6185, '高速相反制御', UNIX_TIMESTAMP()),
+	(937, 3933, '浮遊視線補正', UNIX_TIMESTAMP()),
+	(118, 5112, '無重力凝固合金', UNIX_TIMESTAMP()),
+	(67, 4062, '心応答遺伝子解析', UNIX_TIMESTAMP()),
+	(992, 3988, '未知生命体分類', UNIX_TIMESTAMP()),
+	(725, 6718, '千年精神転生装置', UNIX_TIMESTAMP()),
+	(348, 6341, '農業資源管理', UNIX_TIMESTAMP()),
+	(231, 5225, '生体模倣研究', UNIX_TIMESTAMP()),
+	(217, 216, '美術史研究', UNIX_TIMESTAMP()),
+	(760, 5754, '生態系回復指標', UNIX_TIMESTAMP()),
+	(431, 4426, '非定常磁気', UNIX_TIMESTAMP()),
+	(123, 5117, '無重力境界', UNIX_TIMESTAMP()),
+	(900, 3896, '反転構造体', UNIX_TIMESTAMP()),
+	(145, 4140, '料理研究所', UNIX_TIMESTAMP()),
+	(27, 1025, '農業研究所', UNIX_TIMESTAMP()),
+	(59, 58, '文化遺産保護機構', UNIX_TIMESTAMP()),
+	(339, 2336, '有機無機転換', UNIX_TIMESTAMP()),
+	(275, 3271, '遺伝子砂時計', UNIX_TIMESTAMP()),
+	(47, 5041, '離散領域定理', UNIX_TIMESTAMP()),
+	(705, 6698, '免疫相関結果', UNIX_TIMESTAMP()),
+	(377, 1375, '競争優位変換', UNIX_TIMESTAMP()),
+	(360, 2357, '深層題意解釈', UNIX_TIMESTAMP()),
+	(187, 7179, '交易貨幣大戦', UNIX_TIMESTAMP()),
+	(972, 1970, '気象層圏区分', UNIX_TIMESTAMP()),
+	(546, 4541, '感情閾値藝術', UNIX_TIMESTAMP()),
+	(358, 5352, '民主主義後期成果', UNIX_TIMESTAMP()),
+	(800, 6793, '信仰疲弊現象', UNIX_TIMESTAMP()),
+	(103, 102, '混在利用戦略', UNIX_TIMESTAMP()),
+	(112, 1110, '混雑緩和戦略', UNIX_TIMESTAMP()),
+	(722, 4717, '炭水素分岐構造', UNIX_TIMESTAMP()),
+	(456, 6449, '紡織制御機', UNIX_TIMESTAMP()),
+	(247, 246, '硬化温度調整', UNIX_TIMESTAMP()),
+	(342, 5336, '航空燃料噴射補充', UNIX_TIMESTAMP()),
+	(97, 5091, '高分子合成装置', UNIX_TIMESTAMP()),
+	(855, 1853, '発電振動抑制', UNIX_TIMESTAMP()),
+	(387, 6380, '網膜視覚方式', UNIX_TIMESTAMP()),
+	(974, 973, '自然言語構文解析', UNIX_TIMESTAMP()),
+	(397, 4392, '知能指令急速化', UNIX_TIMESTAMP()),
+	(368, 1366, '宇宙放射線防護装置', UNIX_TIMESTAMP()),
+	(337, 336, '応用数理尺度', UNIX_TIMESTAMP()),
+	(709, 2706, '音響震動抑制', UNIX_TIMESTAMP()),
+	(393, 3389, '薄膜表面挙動', UNIX_TIMESTAMP()),
+	(524, 5518, '高精細校正器', UNIX_TIMESTAMP()),
+	(958, 6951, '水晶軸曲率検定', UNIX_TIMESTAMP()),
+	(501, 2498, '電波噴進加速器', UNIX_TIMESTAMP()),
+	(993, 3989, '地殻応力解析器', UNIX_TIMESTAMP()),
+	(140, 139, '洗脳機能回路', UNIX_TIMESTAMP()),
+	(675, 3671, '磁界焦点化装置', UNIX_TIMESTAMP()),
+	(785, 3781, '複合熱量測定器', UNIX_TIMESTAMP()),
+	(556, 2553, '全方位空間同期装置', UNIX_TIMESTAMP()),
+	(274, 1272, '超音速振動分析', UNIX_TIMESTAMP()),
+	(613, 4608, '時空系列変換器', UNIX_TIMESTAMP()),
+	(346, 345, '反応解散効率指標', UNIX_TIMESTAMP()),
+	(925, 3921, '画期的分光感応材料', UNIX_TIMESTAMP()),
+	(422, 6415, '生体透明化処理装置', UNIX_TIMESTAMP()),
+	(911, 6904, '知的進化分析器', UNIX_TIMESTAMP()),
+	(777, 2774, '宇宙微粒子集撃装置', UNIX_TIMESTAMP()),
+	(305, 5299, '光波位相回復器', UNIX_TIMESTAMP()),
+	(243, 6236, '無極紙幣発行装置', UNIX_TIMESTAMP()),
+	(392, 5386, '透視消費者分析', UNIX_TIMESTAMP()),
+	(421, 1419, '情報網交通最適化', UNIX_TIMESTAMP()),
+	(79, 4074, '結晶凝縮装置', UNIX_TIMESTAMP()),
+	(14, 1012, '波動離散装置', UNIX_TIMESTAMP()),
+	(839, 5833, '軌道逆転装置', UNIX_TIMESTAMP()),
+	(382, 1380, '燃料軌道修正器', UNIX_TIMESTAMP()),
+	(413, 5407, '剣脚症候群', UNIX_TIMESTAMP()),
+	(374, 1372, '水中地殻変動', UNIX_TIMESTAMP()),
+	(519, 2516, '炎舌震動装置', UNIX_TIMESTAMP()),
+	(513, 6506, '闇網計算機', UNIX_TIMESTAMP()),
+	(935, 3931, '月面磁気反転', UNIX_TIMESTAMP()),
+	(15, 5009, '破魔打装置', UNIX_TIMESTAMP()),
+	(530, 3526, '複単球遺伝子', UNIX_TIMESTAMP()),
+	(341, 340, '魂剝離装置', UNIX_TIMESTAMP()),
+	(507, 506, '聖鐘適合者', UNIX_TIMESTAMP()),
+	(946, 3942, '静電絶縁体', UNIX_TIMESTAMP()),
+	(975, 6968, '粒子加速器', UNIX_TIMESTAMP()),
+	(939, 5933, '高分子材料', UNIX_TIMESTAMP()),
+	(502, 6495, '圧電振動子', UNIX_TIMESTAMP()),
+	(972, 971, '臨床音楽治療士', UNIX_TIMESTAMP()),
+	(110, 5104, '生命力測定装置', UNIX_TIMESTAMP()),
+	(925, 3921, '神経感染防止装置', UNIX_TIMESTAMP()),
+	(896, 3892, '着想促進剤', UNIX_TIMESTAMP()),
+	(108, 7100, '時間遅延波形分析装置', UNIX_TIMESTAMP()),
+	(613, 2610, '陶磁器製造', UNIX_TIMESTAMP()),
+	(383, 7375, '蒸気圧力発生機', UNIX_TIMESTAMP()),
+	(401, 2398, '電磁波制御装置', UNIX_TIMESTAMP()),
+	(689, 1687, '高濃度融解装置', UNIX_TIMESTAMP()),
+	(487, 486, '逆流回路制御機構', UNIX_TIMESTAMP()),
+	(203, 6196, '分子選別分析装置', UNIX_TIMESTAMP()),
+	(283, 282, '超音波超伝導転送線路', UNIX_TIMESTAMP()),
+	(393, 392, '空中浮揚安定制御', UNIX_TIMESTAMP()),
+	(755, 4750, '無邪気分野', UNIX_TIMESTAMP()),
+	(935, 1933, '情報貫通機', UNIX_TIMESTAMP()),
+	(402, 3398, '超音速美容術', UNIX_TIMESTAMP()),
+	(905, 2902, '相互非侵襲', UNIX_TIMESTAMP()),
+	(56, 4051, '最適経路化', UNIX_TIMESTAMP()),
+	(870, 869, '無機生物体', UNIX_TIMESTAMP()),
+	(85, 6078, '非可逆動力', UNIX_TIMESTAMP()),
+	(854, 853, '非間引反応', UNIX_TIMESTAMP()),
+	(246, 2243, '水晶気象計', UNIX_TIMESTAMP()),
+	(703, 5697, '精神駆動抽出装置', UNIX_TIMESTAMP()),
+	(544, 543, '超音波共鳴分析器', UNIX_TIMESTAMP()),
+	(562, 3558, '遺伝子進展計算機', UNIX_TIMESTAMP()),
+	(145, 7137, '自律制御蛍光灯', UNIX_TIMESTAMP()),
+	(183, 2180, '生体分解阻害剤', UNIX_TIMESTAMP()),
+	(219, 4214, '転移反射器', UNIX_TIMESTAMP()),
+	(326, 2323, '放射飛躍反応装置', UNIX_TIMESTAMP()),
+	(981, 980, '電気応力解析器', UNIX_TIMESTAMP()),
+	(779, 6772, '超音速物理捜査装置', UNIX_TIMESTAMP()),
+	(240, 239, '酸素生成析出機', UNIX_TIMESTAMP()),
+	(811, 4806, '時空透明化', UNIX_TIMESTAMP()),
+	(477, 7469, '高密度結晶', UNIX_TIMESTAMP()),
+	(494, 5488, '無限解像度', UNIX_TIMESTAMP()),
+	(16, 6009, '感情遺伝子', UNIX_TIMESTAMP()),
+	(120, 6113, '超高速重整', UNIX_TIMESTAMP()),
+	(595, 5589, '微構造応用', UNIX_TIMESTAMP()),
+	(620, 619, '睡眠質向上', UNIX_TIMESTAMP()),
+	(927, 4922, '鋼糸状欠陥', UNIX_TIMESTAMP()),
+	(36, 35, '超微細粒子創製', UNIX_TIMESTAMP()),
+	(44, 5038, '高分子凝集体', UNIX_TIMESTAMP()),
+	(834, 3830, '凹面反射効果', UNIX_TIMESTAMP()),
+	(250, 4245, '残留日射露光', UNIX_TIMESTAMP()),
+	(148, 1146, '混和再結晶化', UNIX_TIMESTAMP()),
+	(481, 7473, '超伝導降圧操触', UNIX_TIMESTAMP()),
+	(903, 4898, '水銀液晶化', UNIX_TIMESTAMP()),
+	(990, 6983, '界面逆流膜', UNIX_TIMESTAMP()),
+	(739, 1737, '無線遷移間隙', UNIX_TIMESTAMP()),
+	(846, 4841, '分析顕視監測', UNIX_TIMESTAMP()),
+	(428, 1426, '拡張破壊能率', UNIX_TIMESTAMP()),
+	(650, 5644, '酸素枝点注入', UNIX_TIMESTAMP()),
+	(266, 4261, '不凍糖凝固温度', UNIX_TIMESTAMP()),
+	(524, 3520, '絶対真空遮断', UNIX_TIMESTAMP()),
+	(251, 7243, '窒素注入抗酸化', UNIX_TIMESTAMP()),
+	(889, 888, '組織交換装置', UNIX_TIMESTAMP()),
+	(509, 6502, '流動阻害剤', UNIX_TIMESTAMP()),
+	(549, 5543, '踏面圧測定器', UNIX_TIMESTAMP()),
+	(284, 2281, '成果測定装置', UNIX_TIMESTAMP()),
+	(843, 5837, '衝撃吸収素子', UNIX_TIMESTAMP()),
+	(850, 5844, '超音波乗員', UNIX_TIMESTAMP()),
+	(57, 56, '傾斜変換器', UNIX_TIMESTAMP()),
+	(853, 1851, '固有振動体', UNIX_TIMESTAMP()),
+	(243, 6236, '配列制御装置', UNIX_TIMESTAMP()),
+	(593, 3589, '反射回転子', UNIX_TIMESTAMP()),
+	(698, 3694, '固形燃料安定化装置', UNIX_TIMESTAMP()),
+	(535, 6528, '速効滅菌機', UNIX_TIMESTAMP()),
+	(602, 2599, '超伝導加速器', UNIX_TIMESTAMP()),
+	(388, 1386, '発熱体排出装置', UNIX_TIMESTAMP()),
+	(644, 4639, '超能力書架', UNIX_TIMESTAMP()),
+	(152, 1150, '神聖錬金術', UNIX_TIMESTAMP()),
+	(976, 4971, '時間転移器', UNIX_TIMESTAMP()),
+	(396, 7388, '龍神流星撃', UNIX_TIMESTAMP()),
+	(258, 2255, '魔力共鳴装置', UNIX_TIMESTAMP()),
+	(98, 6091, '万華鏡頭脳', UNIX_TIMESTAMP()),
+	(486, 5480, '黄金蓄電装置', UNIX_TIMESTAMP()),
+	(405, 1403, '幻想通信網', UNIX_TIMESTAMP()),
+	(335, 7327, '風水解析器', UNIX_TIMESTAMP()),
+	(614, 1612, '超重力発生装置', UNIX_TIMESTAMP()),
+	(393, 7385, '深層洞掘機', UNIX_TIMESTAMP()),
+	(131, 3127, '魔物討伐槍', UNIX_TIMESTAMP()),
+	(26, 1024, '悪魔召喚陣', UNIX_TIMESTAMP()),
+	(521, 6514, '四大元素共鳴', UNIX_TIMESTAMP()),
+	(893, 1891, '妖刀制御装置', UNIX_TIMESTAMP()),
+	(109, 2106, '時空断絶装置', UNIX_TIMESTAMP()),
+	(138, 7130, '美術館防衛盾', UNIX_TIMESTAMP()),
+	(513, 5507, '太陽核融合機器', UNIX_TIMESTAMP()),
+	(334, 1332, '光波放心器', UNIX_TIMESTAMP()),
+	(598, 597, '化石化合物', UNIX_TIMESTAMP()),
+	(74, 7066, '植物相研究', UNIX_TIMESTAMP()),
+	(225, 4220, '地震見積領域', UNIX_TIMESTAMP()),
+	(320, 319, '公共影響評価', UNIX_TIMESTAMP()),
+	(473, 4468, '旧芸術研究', UNIX_TIMESTAMP()),
+	(861, 4856, '風景空間計画', UNIX_TIMESTAMP()),
+	(20, 6013, '建築構造物', UNIX_TIMESTAMP()),
+	(769, 3765, '地球生態系', UNIX_TIMESTAMP()),
+	(342, 7334, '暗黒騎士団', UNIX_TIMESTAMP()),
+	(12, 2009, '平行世界転移', UNIX_TIMESTAMP()),
+	(946, 945, '蓄音機器巨', UNIX_TIMESTAMP()),
+	(842, 6835, '蒸気磁気理', UNIX_TIMESTAMP()),
+	(655, 6648, '斜溝唱盤度', UNIX_TIMESTAMP()),
+	(772, 771, '行亜硝酸塩静', UNIX_TIMESTAMP()),
+	(274, 6267, '蒸気圧力石', UNIX_TIMESTAMP()),
+	(249, 5243, '音響共鳴讃', UNIX_TIMESTAMP()),
+	(468, 6461, '映像再生満', UNIX_TIMESTAMP()),
+	(431, 6424, '圧力容器補', UNIX_TIMESTAMP()),
+	(472, 6465, '遠心力電位決', UNIX_TIMESTAMP()),
+	(757, 1755, '凡座泡内温', UNIX_TIMESTAMP()),
+	(583, 2580, '自動車動力牙', UNIX_TIMESTAMP()),
+	(580, 6573, '金属平均磁填', UNIX_TIMESTAMP()),
+	(212, 2209, '曲面電力線展', UNIX_TIMESTAMP()),
+	(19, 5013, '消防鎮火器競', UNIX_TIMESTAMP()),
+	(719, 6712, '不倶戴天秩序', UNIX_TIMESTAMP()),
+	(303, 4298, '疫病超伝染体', UNIX_TIMESTAMP()),
+	(352, 1350, '電子震源原', UNIX_TIMESTAMP()),
+	(697, 6690, '神螺帰炉駆動装甲', UNIX_TIMESTAMP()),
+	(701, 5695, '地元終末裁定', UNIX_TIMESTAMP()),
+	(112, 1110, '超高速空輸術', UNIX_TIMESTAMP()),
+	(518, 4513, '天河開帳装置', UNIX_TIMESTAMP()),
+	(829, 6822, '計算機比重', UNIX_TIMESTAMP()),
+	(338, 4333, '透明亜鉛煉', UNIX_TIMESTAMP()),
+	(77, 76, '対角返波絶縁', UNIX_TIMESTAMP()),
+	(408, 4403, '動液変転器', UNIX_TIMESTAMP()),
+	(668, 4663, '物理空序焼', UNIX_TIMESTAMP()),
+	(28, 3024, '高圧変革器', UNIX_TIMESTAMP()),
+	(150, 4145, '漸化条件湾', UNIX_TIMESTAMP()),
+	(704, 2701, '等級相接続', UNIX_TIMESTAMP()),
+	(737, 1735, '中区傳火網', UNIX_TIMESTAMP()),
+	(542, 4537, '微小気候儀', UNIX_TIMESTAMP()),
+	(935, 934, '対配置炉領', UNIX_TIMESTAMP()),
+	(486, 1484, '曝露症候群', UNIX_TIMESTAMP()),
+	(765, 764, '経済動態指数', UNIX_TIMESTAMP()),
+	(354, 353, '音楽風景主義', UNIX_TIMESTAMP()),
+	(836, 4831, '農業政策研究', UNIX_TIMESTAMP()),
+	(648, 647, '悪魔退治術', UNIX_TIMESTAMP()),
+	(51, 3047, '超絶治癒術', UNIX_TIMESTAMP()),
+	(312, 2309, '身体相互作用', UNIX_TIMESTAMP()),
+	(949, 3945, '音響反射器', UNIX_TIMESTAMP()),
+	(905, 1903, '指示超伝導体', UNIX_TIMESTAMP()),
+	(835, 2832, '無重力遮断装置', UNIX_TIMESTAMP()),
+	(262, 7254, '遺伝情報暗号化', UNIX_TIMESTAMP()),
+	(631, 3627, '超高速伝達網', UNIX_TIMESTAMP()),
+	(781, 780, '記憶統合装置', UNIX_TIMESTAMP()),
+	(281, 4276, '反応制御因子', UNIX_TIMESTAMP()),
+	(844, 843, '量子粒子生成', UNIX_TIMESTAMP()),
+	(718, 5712, '空気浄化神経回路', UNIX_TIMESTAMP()),
+	(580, 5574, '宇宙微生物分類', UNIX_TIMESTAMP()),
+	(679, 678, '地球気象制御', UNIX_TIMESTAMP()),
+	(244, 7236, '知覚刺激点積', UNIX_TIMESTAMP()),
+	(339, 6332, '時空曲率測定', UNIX_TIMESTAMP()),
+	(223, 2220, '霊子情報産業', UNIX_TIMESTAMP()),
+	(253, 3249, '代謝加速機構', UNIX_TIMESTAMP()),
+	(664, 5658, '感応装甲研究', UNIX_TIMESTAMP()),
+	(88, 2085, '無重力装置', UNIX_TIMESTAMP()),
+	(188, 6181, '記憶抹消装置', UNIX_TIMESTAMP()),
+	(124, 1122, '透明化合金', UNIX_TIMESTAMP()),
+	(403, 1401, '幻覚投射装置', UNIX_TIMESTAMP()),
+	(480, 2477, '錯視光線発生装置', UNIX_TIMESTAMP()),
+	(151, 7143, '未来予知計', UNIX_TIMESTAMP()),
+	(378, 2375, '転生加速装置', UNIX_TIMESTAMP()),
+	(43, 3039, '高周波催眠装置', UNIX_TIMESTAMP()),
+	(430, 7422, '無重力食品', UNIX_TIMESTAMP()),
+	(259, 1257, '絶対音感増幅器', UNIX_TIMESTAMP()),
+	(681, 680, '超音波溶解装置', UNIX_TIMESTAMP()),
+	(377, 2374, '加速度現象', UNIX_TIMESTAMP()),
+	(325, 7317, '量子鳥観察', UNIX_TIMESTAMP()),
+	(325, 3321, '水没先端実験', UNIX_TIMESTAMP()),
+	(967, 6960, '環境創造遺産', UNIX_TIMESTAMP()),
+	(68, 67, '統計行列解析', UNIX_TIMESTAMP()),
+	(600, 5594, '生態加速化装置', UNIX_TIMESTAMP()),
+	(712, 1710, '情報粛正能力', UNIX_TIMESTAMP()),
+	(471, 470, '情報遮断装置', UNIX_TIMESTAMP()),
+	(186, 5180, '宇宙漂為力', UNIX_TIMESTAMP()),
+	(653, 2650, '冷凍相転移', UNIX_TIMESTAMP()),
+	(47, 5041, '心音補完機', UNIX_TIMESTAMP()),
+	(495, 2492, '音声分類器', UNIX_TIMESTAMP()),
+	(330, 329, '時空封鎖装置', UNIX_TIMESTAMP()),
+	(347, 4342, '心理応答刺激', UNIX_TIMESTAMP()),
+	(263, 1261, '超音速運動機', UNIX_TIMESTAMP()),
+	(918, 6911, '構造神経伝達', UNIX_TIMESTAMP()),
+	(735, 4730, '光反射情報量', UNIX_TIMESTAMP()),
+	(777, 4772, '形態再編作業', UNIX_TIMESTAMP()),
+	(360, 4355, '生物統制操作', UNIX_TIMESTAMP()),
+	(823, 6816, '細菌繁殖抑制', UNIX_TIMESTAMP()),
+	(784, 4779, '電磁波遮蔽装置', UNIX_TIMESTAMP()),
+	(536, 3532, '無重力状態施設', UNIX_TIMESTAMP()),
+	(986, 6979, '反応速度変動原理', UNIX_TIMESTAMP()),
+	(621, 620, '空間変形場', UNIX_TIMESTAMP()),
+	(990, 1988, '感染症予防衛生検査', UNIX_TIMESTAMP()),
+	(234, 233, '融合操縦術', UNIX_TIMESTAMP()),
+	(320, 319, '磁気増幅器', UNIX_TIMESTAMP()),
+	(149, 5143, '対称方程式', UNIX_TIMESTAMP()),
+	(329, 6322, '機械感知識', UNIX_TIMESTAMP()),
+	(398, 3394, '遺伝子表現解析', UNIX_TIMESTAMP()),
+	(674, 4669, '空気浮力装置', UNIX_TIMESTAMP()),
+	(394, 7386, '遠隔精神制御', UNIX_TIMESTAMP()),
+	(856, 1854, '励起波形解析', UNIX_TIMESTAMP()),
+	(347, 4342, '量子暗号化', UNIX_TIMESTAMP()),
+	(944, 943, '仮想物質解析', UNIX_TIMESTAMP()),
+	(893, 1891, '精神奇跡術', UNIX_TIMESTAMP()),
+	(470, 3466, '電磁核融合', UNIX_TIMESTAMP()),
+	(815, 6808, '非晶質材料', UNIX_TIMESTAMP()),
+	(827, 5821, '脳腫瘍治療装置', UNIX_TIMESTAMP()),
+	(101, 6094, '交替光視野', UNIX_TIMESTAMP()),
+	(343, 6336, '微分方程式', UNIX_TIMESTAMP()),
+	(762, 4757, '傷痍収縮素粒子', UNIX_TIMESTAMP()),
+	(826, 5820, '暗幕裂破術', UNIX_TIMESTAMP()),
+	(534, 1532, '縮混遺伝子', UNIX_TIMESTAMP()),
+	(171, 2168, '超緊急共振', UNIX_TIMESTAMP()),
+	(505, 2502, '幽体分離装置', UNIX_TIMESTAMP()),
+	(861, 5855, '妖精干渉装置', UNIX_TIMESTAMP()),
+	(361, 3357, '念動力制御', UNIX_TIMESTAMP()),
+	(556, 3552, '時間断層装置', UNIX_TIMESTAMP()),
+	(426, 3422, '暗黒生命術', UNIX_TIMESTAMP()),
+	(241, 2238, '死者蘇生装置', UNIX_TIMESTAMP()),
+	(296, 1294, '魔力増幅器', UNIX_TIMESTAMP()),
+	(439, 6432, '天体観測機', UNIX_TIMESTAMP()),
+	(377, 3373, '創造力測定器', UNIX_TIMESTAMP()),
+	(755, 5749, '心霊探査器', UNIX_TIMESTAMP()),
+	(61, 1059, '未来予測機', UNIX_TIMESTAMP()),
+	(47, 6040, '魔術図書館', UNIX_TIMESTAMP()),
+	(801, 4796, '平行世界探知器', UNIX_TIMESTAMP()),
+	(590, 6583, '集中力増強剤', UNIX_TIMESTAMP()),
+	(73, 6066, '霊波鑑定術', UNIX_TIMESTAMP()),
+	(962, 6955, '妖精語研究', UNIX_TIMESTAMP()),
+	(926, 925, '古代文字解読術', UNIX_TIMESTAMP()),
+	(456, 4451, '契約結界術', UNIX_TIMESTAMP()),
+	(679, 2676, '物質合成機', UNIX_TIMESTAMP()),
+	(682, 2679, '精神共鳴機', UNIX_TIMESTAMP()),
+	(751, 6744, '地球酵母株図鑑', UNIX_TIMESTAMP()),
+	(463, 7455, '音色解析装置', UNIX_TIMESTAMP()),
+	(61, 6054, '心象波形計測器', UNIX_TIMESTAMP()),
+	(395, 1393, '時空修復術', UNIX_TIMESTAMP()),
+	(572, 5566, '地球共振力', UNIX_TIMESTAMP()),
+	(32, 2029, '逢坂川暗号', UNIX_TIMESTAMP()),
+	(996, 2993, '無重力推進', UNIX_TIMESTAMP()),
+	(467, 3463, '鉄骨強化装甲', UNIX_TIMESTAMP()),
+	(702, 1700, '微生物転写酵素', UNIX_TIMESTAMP()),
+	(258, 4253, '冷凍遺伝子保存', UNIX_TIMESTAMP()),
+	(314, 5308, '磁気駆動機械', UNIX_TIMESTAMP()),
+	(203, 202, '衛星気象観測', UNIX_TIMESTAMP()),
+	(797, 3793, '高水圧飛沫発射装置', UNIX_TIMESTAMP()),
+	(108, 7100, '光合成促進抗酸化機構', UNIX_TIMESTAMP()),
+	(755, 1753, '航空機制御装置', UNIX_TIMESTAMP()),
+	(213, 4208, '電子音響増幅器', UNIX_TIMESTAMP()),
+	(894, 2891, '経済指標予測器', UNIX_TIMESTAMP()),
+	(248, 2245, '地球温暖化影響評価指標', UNIX_TIMESTAMP()),
+	(62, 1060, '石油探査機器', UNIX_TIMESTAMP()),
+	(909, 4904, '超音速風洞', UNIX_TIMESTAMP()),
+	(777, 2774, '歴史的認識', UNIX_TIMESTAMP()),
+	(415, 1413, '居住環境設計', UNIX_TIMESTAMP()),
+	(461, 3457, '新素材開発', UNIX_TIMESTAMP()),
+	(224, 223, '時空交替装置', UNIX_TIMESTAMP()),
+	(90, 6083, '音鳴式指紋認証', UNIX_TIMESTAMP()),
+	(732, 2729, '革命的生体増殖', UNIX_TIMESTAMP()),
+	(843, 2840, '幽聞情報網', UNIX_TIMESTAMP()),
+	(842, 3838, '平行次元加速', UNIX_TIMESTAMP()),
+	(756, 3752, '青春夢浮遊装置', UNIX_TIMESTAMP()),
+	(259, 258, '嵐眼探知機', UNIX_TIMESTAMP()),
+	(769, 768, '無限書籍館', UNIX_TIMESTAMP()),
+	(590, 6583, '夢伝承装置', UNIX_TIMESTAMP()),
+	(355, 1353, '電妖精装置', UNIX_TIMESTAMP()),
+	(113, 112, '次元図書館', UNIX_TIMESTAMP()),
+	(111, 4106, '移動力分散', UNIX_TIMESTAMP()),
+	(771, 770, '床面多相変換', UNIX_TIMESTAMP()),
+	(159, 3155, '睡眠周期調整器', UNIX_TIMESTAMP()),
+	(510, 6503, '機体演算量', UNIX_TIMESTAMP()),
+	(578, 6571, '記憶重合現象', UNIX_TIMESTAMP()),
+	(541, 540, '塗料付着率', UNIX_TIMESTAMP()),
+	(267, 5261, '道路衝突試験', UNIX_TIMESTAMP()),
+	(686, 4681, '霊感交感装置', UNIX_TIMESTAMP()),
+	(669, 1667, '補償推定量', UNIX_TIMESTAMP()),
+	(430, 5424, '視覚暗示種', UNIX_TIMESTAMP()),
+	(836, 3832, '差込熱効率', UNIX_TIMESTAMP()),
+	(592, 2589, '量子形状解析', UNIX_TIMESTAMP()),
+	(814, 1812, '情報掃引装置', UNIX_TIMESTAMP()),
+	(933, 4928, '高密度反射素材', UNIX_TIMESTAMP()),
+	(865, 2862, '超音速超重粒子', UNIX_TIMESTAMP()),
+	(169, 4164, '高電位磁気浮上装置', UNIX_TIMESTAMP()),
+	(236, 5230, '真空量子位相補完', UNIX_TIMESTAMP()),
+	(448, 6441, '光波分光透析', UNIX_TIMESTAMP()),
+	(867, 3863, '動体三次元幾何解析', UNIX_TIMESTAMP()),
+	(476, 2473, '分子構成相補模型', UNIX_TIMESTAMP()),
+	(519, 1517, '自己適応擬似意識領域', UNIX_TIMESTAMP()),
+	(711, 2708, '起動動体画像解析', UNIX_TIMESTAMP()),
+	(689, 3685, '高温超伝導空間共振体', UNIX_TIMESTAMP()),
+	(541, 1539, '龍神召喚術', UNIX_TIMESTAMP()),
+	(417, 6410, '斬月乱舞技', UNIX_TIMESTAMP()),
+	(788, 6781, '生物進化共存市場', UNIX_TIMESTAMP()),
+	(98, 3094, '系外生命探査船', UNIX_TIMESTAMP()),
+	(348, 3344, '量子粒子合成炉', UNIX_TIMESTAMP()),
+	(904, 903, '無重力行動制御装置', UNIX_TIMESTAMP()),
+	(966, 3962, '自然言語認識器', UNIX_TIMESTAMP()),
+	(111, 7103, '音楽心理感応装置', UNIX_TIMESTAMP()),
+	(980, 979, '心理融解術', UNIX_TIMESTAMP()),
+	(562, 4557, '転向演算子', UNIX_TIMESTAMP()),
+	(474, 6467, '意図透明言語', UNIX_TIMESTAMP()),
+	(530, 3526, '量子頭状態', UNIX_TIMESTAMP()),
+	(502, 4497, '式鼓動定理', UNIX_TIMESTAMP()),
+	(426, 425, '微生物燃料研究', UNIX_TIMESTAMP()),
+	(176, 4171, '無重力農業', UNIX_TIMESTAMP()),
+	(92, 5086, '角度補正機構', UNIX_TIMESTAMP()),
+	(799, 2796, '気象対策航空機', UNIX_TIMESTAMP()),
+	(960, 5954, '半透明合成材料', UNIX_TIMESTAMP()),
+	(764, 2761, '超高速水中探査装置', UNIX_TIMESTAMP()),
+	(598, 5592, '潜在意識深層解析', UNIX_TIMESTAMP()),
+	(675, 5669, '宇宙計画術', UNIX_TIMESTAMP()),
+	(605, 5599, '遺伝子解読機', UNIX_TIMESTAMP()),
+	(158, 7150, '量子換字装置', UNIX_TIMESTAMP()),
+	(249, 5243, '特殊洗浄液', UNIX_TIMESTAMP()),
+	(778, 4773, '仮想交通網', UNIX_TIMESTAMP()),
+	(526, 5520, '生命維持組織', UNIX_TIMESTAMP()),
+	(299, 4294, '光速演算器', UNIX_TIMESTAMP()),
+	(108, 5102, '水中浮上装置', UNIX_TIMESTAMP()),
+	(224, 4219, '電気閉鎖装置', UNIX_TIMESTAMP()),
+	(821, 5815, '微細粒子映像分析', UNIX_TIMESTAMP()),
+	(178, 1176, '無重力物質生成装置', UNIX_TIMESTAMP()),
+	(772, 6765, '時間片思考脳', UNIX_TIMESTAMP()),
+	(458, 4453, '移動速度調整装置', UNIX_TIMESTAMP()),
+	(531, 5525, '島営制措置', UNIX_TIMESTAMP()),
+	(567, 5561, '飛鳥式乳酸菌', UNIX_TIMESTAMP()),
+	(251, 5245, '水銀凝固反応', UNIX_TIMESTAMP()),
+	(634, 633, '機雷波動装甲', UNIX_TIMESTAMP()),
+	(889, 5883, '翼化不完全体', UNIX_TIMESTAMP()),
+	(689, 688, '珊瑚樹繊維', UNIX_TIMESTAMP()),
+	(561, 3557, '銀河巨星爆発', UNIX_TIMESTAMP()),
+	(365, 7357, '電波透過装置', UNIX_TIMESTAMP()),
+	(687, 1685, '幻獣騎士団', UNIX_TIMESTAMP()),
+	(889, 4884, '夢幻水晶球', UNIX_TIMESTAMP()),
+	(364, 7356, '脳感情認識機', UNIX_TIMESTAMP()),
+	(27, 2024, '量子時間軸', UNIX_TIMESTAMP()),
+	(399, 5393, '音波骨密度計', UNIX_TIMESTAMP()),
+	(190, 7182, '分子量子逆磁場', UNIX_TIMESTAMP()),
+	(328, 2325, '化合質検知器', UNIX_TIMESTAMP()),
+	(814, 813, '水質生体指標', UNIX_TIMESTAMP()),
+	(439, 438, '統計空気読書', UNIX_TIMESTAMP()),
+	(717, 3713, '音楽情報授産構築', UNIX_TIMESTAMP()),
+	(731, 730, '超伝導育成', UNIX_TIMESTAMP()),
+	(354, 1352, '生命遺伝操作', UNIX_TIMESTAMP()),
+	(516, 6509, '超音速浮遊', UNIX_TIMESTAMP()),
+	(963, 1961, '心理操作術', UNIX_TIMESTAMP()),
+	(170, 169, '量子連結装置', UNIX_TIMESTAMP()),
+	(174, 6167, '無重力転送装置', UNIX_TIMESTAMP()),
+	(615, 4610, '無敵体質創造', UNIX_TIMESTAMP()),
+	(894, 5888, '超高効率燃料', UNIX_TIMESTAMP()),
+	(589, 1587, '無重力力場計算', UNIX_TIMESTAMP()),
+	(208, 3204, '経済情報導入指数', UNIX_TIMESTAMP()),
+	(46, 4041, '自己修復分子構造', UNIX_TIMESTAMP()),
+	(698, 1696, '心脳機能増幅装置', UNIX_TIMESTAMP()),
+	(774, 773, '曲軸補正装置', UNIX_TIMESTAMP()),
+	(915, 6908, '時空干渉結晶', UNIX_TIMESTAMP()),
+	(713, 1711, '遺伝情報読取装置', UNIX_TIMESTAMP()),
+	(869, 4864, '無重力抗体', UNIX_TIMESTAMP()),
+	(276, 275, '水晶化応答器', UNIX_TIMESTAMP()),
+	(7, 6000, '熱帯照射装置', UNIX_TIMESTAMP()),
+	(973, 6966, '反物質同調器', UNIX_TIMESTAMP()),
+	(249, 4244, '植物感応装置', UNIX_TIMESTAMP()),
+	(514, 6507, '発電圧増幅律速器', UNIX_TIMESTAMP()),
+	(75, 7067, '原子粒子浄化機器', UNIX_TIMESTAMP()),
+	(229, 4224, '超音速時計発振器', UNIX_TIMESTAMP()),
+	(299, 3295, '天体進化模擬装置', UNIX_TIMESTAMP()),
+	(713, 3709, '量子転送安定器', UNIX_TIMESTAMP()),
+	(212, 7204, '放射線干渉反応器', UNIX_TIMESTAMP()),
+	(141, 3137, '電磁波反射調整機', UNIX_TIMESTAMP()),
+	(946, 4941, '生体磁場解析装置', UNIX_TIMESTAMP()),
+	(491, 5485, '強力熱線兵器', UNIX_TIMESTAMP()),
+	(868, 1866, '美輪平和装置', UNIX_TIMESTAMP()),
+	(196, 5190, '炎神訓練所', UNIX_TIMESTAMP()),
+	(784, 6777, '暴露遺伝子', UNIX_TIMESTAMP()),
+	(592, 5586, '蝉連鎖反応', UNIX_TIMESTAMP()),
+	(149, 3145, '宴川式電池', UNIX_TIMESTAMP()),
+	(427, 5421, '高密度接着剤', UNIX_TIMESTAMP()),
+	(465, 7457, '心拍同期装置', UNIX_TIMESTAMP()),
+	(570, 5564, '脳媒介物質', UNIX_TIMESTAMP()),
+	(926, 1924, '非定常帯域', UNIX_TIMESTAMP()),
+	(807, 806, '亜細威厚板', UNIX_TIMESTAMP()),
+	(557, 2554, '水辺振動器', UNIX_TIMESTAMP()),
+	(260, 6253, '感圧加速度', UNIX_TIMESTAMP()),
+	(815, 5809, '雷雷共振器', UNIX_TIMESTAMP()),
+	(177, 5171, '聴覚増幅器', UNIX_TIMESTAMP()),
+	(817, 3813, '結晶液態化', UNIX_TIMESTAMP()),
+	(568, 4563, '地震逆伝播', UNIX_TIMESTAMP()),
+	(576, 1574, '生体細胞再生治療', UNIX_TIMESTAMP()),
+	(90, 7082, '宇宙重力反転機構', UNIX_TIMESTAMP()),
+	(799, 5793, '遺伝子情報量計算', UNIX_TIMESTAMP()),
+	(870, 869, '光合成高効率化装置', UNIX_TIMESTAMP()),
+	(565, 4560, '惑星環状物質計量', UNIX_TIMESTAMP()),
+	(147, 1145, '風家型変換', UNIX_TIMESTAMP()),
+	(341, 7333, '経済動向指数', UNIX_TIMESTAMP()),
+	(406, 6399, '千変万化原理', UNIX_TIMESTAMP()),
+	(666, 2663, '超高速粒子加速器', UNIX_TIMESTAMP()),
+	(150, 149, '電磁波拡散曲面', UNIX_TIMESTAMP()),
+	(237, 1235, '応答型超音波断層撮影', UNIX_TIMESTAMP()),
+	(400, 399, '高効率化爆発反応材料', UNIX_TIMESTAMP()),
+	(940, 2937, '高温熱伝導金属融合材', UNIX_TIMESTAMP()),
+	(480, 5474, '新世代臓器培養装置', UNIX_TIMESTAMP()),
+	(448, 4443, '液体金属断熱体部材', UNIX_TIMESTAMP()),
+	(711, 4706, '拡張遺伝診断', UNIX_TIMESTAMP()),
+	(780, 2777, '双極統合医療', UNIX_TIMESTAMP()),
+	(58, 4053, '光子差動干渉計', UNIX_TIMESTAMP()),
+	(104, 6097, '生命波動検査', UNIX_TIMESTAMP()),
+	(752, 4747, '身体封印術', UNIX_TIMESTAMP()),
+	(706, 5700, '心理疲労回復装置', UNIX_TIMESTAMP()),
+	(655, 1653, '天地結節軸', UNIX_TIMESTAMP()),
+	(916, 5910, '平衡電力増幅器', UNIX_TIMESTAMP()),
+	(166, 165, '因果融合現象', UNIX_TIMESTAMP()),
+	(44, 1042, '空間転移装置', UNIX_TIMESTAMP()),
+	(665, 4660, '幻影覚醒効果', UNIX_TIMESTAMP()),
+	(853, 5847, '量子干渉原子集積体', UNIX_TIMESTAMP()),
+	(62, 1060, '精神移行装置', UNIX_TIMESTAMP()),
+	(551, 4546, '光速巡洋艦', UNIX_TIMESTAMP()),
+	(509, 6502, '時空膨張装置', UNIX_TIMESTAMP()),
+	(7, 6999, '伝承結晶石', UNIX_TIMESTAMP()),
+	(271, 2268, '平行宇宙切替機', UNIX_TIMESTAMP()),
+	(736, 1734, '神秘能力増幅杖', UNIX_TIMESTAMP()),
+	(381, 2378, '二元世界共振装置', UNIX_TIMESTAMP()),
+	(301, 3297, '超知覚感応装置', UNIX_TIMESTAMP()),
+	(335, 2332, '霊的賛同系統', UNIX_TIMESTAMP()),
+	(473, 5467, '意識共鳴転送装置', UNIX_TIMESTAMP()),
+	(261, 4256, '平行次元巡航機', UNIX_TIMESTAMP()),
+	(314, 6307, '非物質次元領域', UNIX_TIMESTAMP()),
+	(631, 5625, '磁気透明体', UNIX_TIMESTAMP()),
+	(824, 823, '非線形複合体', UNIX_TIMESTAMP()),
+	(7, 6, '液晶多重反射体', UNIX_TIMESTAMP()),
+	(668, 6661, '高分子変質体', UNIX_TIMESTAMP()),
+	(552, 3548, '機械遮蔽体', UNIX_TIMESTAMP()),
+	(473, 2470, '多端子増幅器', UNIX_TIMESTAMP()),
+	(467, 3463, '陽子雲生成器', UNIX_TIMESTAMP()),
+	(602, 4597, '単分散結晶', UNIX_TIMESTAMP()),
+	(501, 1499, '炎色反応装置', UNIX_TIMESTAMP()),
+	(187, 186, '弱電子散乱体', UNIX_TIMESTAMP()),
+	(26, 5020, '光一次電池', UNIX_TIMESTAMP()),
+	(980, 2977, '偏極集光体', UNIX_TIMESTAMP()),
+	(67, 66, '密集高速相転移', UNIX_TIMESTAMP()),
+	(77, 4072, '喪失量生成器', UNIX_TIMESTAMP()),
+	(816, 815, '溶媒整合体', UNIX_TIMESTAMP()),
+	(161, 4156, '汎波帯域増幅器', UNIX_TIMESTAMP()),
+	(506, 505, '遮熱形状記憶体', UNIX_TIMESTAMP()),
+	(711, 3707, '直交偏光波', UNIX_TIMESTAMP()),
+	(15, 1013, '生体分光器', UNIX_TIMESTAMP()),
+	(809, 2806, '硬化抗酸化体', UNIX_TIMESTAMP()),
+	(833, 5827, '揺光抵抗器', UNIX_TIMESTAMP()),
+	(773, 5767, '電気透明絶縁体', UNIX_TIMESTAMP()),
+	(171, 6164, '細胞分裂網', UNIX_TIMESTAMP()),
+	(245, 2242, '電位差抵抗器', UNIX_TIMESTAMP()),
+	(980, 4975, '聴覚鳴動装置', UNIX_TIMESTAMP()),
+	(208, 6201, '評価構造体', UNIX_TIMESTAMP()),
+	(382, 7374, '高密度電子', UNIX_TIMESTAMP()),
+	(814, 4809, '逆磁場加熱器', UNIX_TIMESTAMP()),
+	(804, 2801, '四次元格子', UNIX_TIMESTAMP()),
+	(791, 1789, '減気管理装置', UNIX_TIMESTAMP()),
+	(532, 6525, '封魔石凝固体', UNIX_TIMESTAMP()),
+	(552, 5546, '縮退分子光', UNIX_TIMESTAMP()),
+	(395, 6388, '密封流体物質', UNIX_TIMESTAMP()),
+	(485, 5479, '回折反射係数', UNIX_TIMESTAMP()),
+	(563, 2560, '核心燃料変換率', UNIX_TIMESTAMP()),
+	(331, 330, '脳神経回路図', UNIX_TIMESTAMP()),
+	(999, 4994, '動物行動発展研究所', UNIX_TIMESTAMP()),
+	(537, 3533, '閣倉縁之助', UNIX_TIMESTAMP()),
+	(912, 2909, '無線干渉機構', UNIX_TIMESTAMP()),
+	(830, 4825, '磁気共鳴加速器', UNIX_TIMESTAMP()),
+	(475, 2472, '影響電流迷宮', UNIX_TIMESTAMP()),
+	(106, 4101, '電子渦旋制御', UNIX_TIMESTAMP()),
+	(124, 7116, '熱圧縮線形化', UNIX_TIMESTAMP()),
+	(13, 6006, '微細振動態解析', UNIX_TIMESTAMP()),
+	(511, 4506, '高密度相互組織', UNIX_TIMESTAMP()),
+	(789, 5783, '逓減為副作用', UNIX_TIMESTAMP()),
+	(237, 4232, '素粒子疑似相関量', UNIX_TIMESTAMP()),
+	(266, 6259, '圧縮音響透過率', UNIX_TIMESTAMP()),
+	(143, 2140, '熱核融合反応器', UNIX_TIMESTAMP()),
+	(491, 7483, '高精度擬似四元刑', UNIX_TIMESTAMP()),
+	(984, 1982, '超音速電漿生成器', UNIX_TIMESTAMP()),
+	(920, 919, '先駆反応担体', UNIX_TIMESTAMP()),
+	(174, 1172, '界面潤滑触媒', UNIX_TIMESTAMP()),
+	(845, 4840, '非線形波粒子', UNIX_TIMESTAMP()),
+	(653, 3649, '臨界反応器', UNIX_TIMESTAMP()),
+	(95, 7087, '融合反応装置', UNIX_TIMESTAMP()),
+	(405, 7397, '時空転移装置', UNIX_TIMESTAMP()),
+	(219, 2216, '質子加速器', UNIX_TIMESTAMP()),
+	(609, 3605, '磁力制御装置', UNIX_TIMESTAMP()),
+	(828, 1826, '遺伝情報暗号化', UNIX_TIMESTAMP()),
+	(306, 4301, '空気布石装置', UNIX_TIMESTAMP()),
+	(757, 6750, '水中高速推進装置', UNIX_TIMESTAMP()),
+	(472, 2469, '量子粒子加速器', UNIX_TIMESTAMP()),
+	(601, 1599, '光合成増幅装置', UNIX_TIMESTAMP()),
+	(894, 1892, '真空降雪装置', UNIX_TIMESTAMP()),
+	(487, 7479, '記憶抹消装置', UNIX_TIMESTAMP()),
+	(823, 5817, '微生物進化実験装置', UNIX_TIMESTAMP()),
+	(954, 4949, '空間歪曲装置', UNIX_TIMESTAMP()),
+	(943, 6936, '高分子材料再生装置', UNIX_TIMESTAMP()),
+	(336, 6329, '有機物液状化生成装置', UNIX_TIMESTAMP()),
+	(400, 399, '新世界大道', UNIX_TIMESTAMP()),
+	(293, 1291, '神秘的霊気', UNIX_TIMESTAMP()),
+	(171, 5165, '非現実視点', UNIX_TIMESTAMP()),
+	(774, 2771, '非空間遷移', UNIX_TIMESTAMP()),
+	(262, 2259, '電子脳監視', UNIX_TIMESTAMP()),
+	(331, 4326, '精神電波装置', UNIX_TIMESTAMP()),
+	(444, 1442, '量子生命応用', UNIX_TIMESTAMP()),
+	(933, 2930, '音響絶対干渉', UNIX_TIMESTAMP()),
+	(246, 2243, '静電気浄化器', UNIX_TIMESTAMP()),
+	(515, 5509, '共鳴立体投影', UNIX_TIMESTAMP()),
+	(583, 582, '熱光電子化', UNIX_TIMESTAMP()),
+	(282, 3278, '透明化画像処理', UNIX_TIMESTAMP()),
+	(10, 2007, '分子結合下限', UNIX_TIMESTAMP()),
+	(213, 3209, '量子生態環境', UNIX_TIMESTAMP()),
+	(527, 3523, '記憶層青写真', UNIX_TIMESTAMP()),
+	(194, 3190, '磁気反動推進', UNIX_TIMESTAMP()),
+	(267, 1265, '音声精密解析', UNIX_TIMESTAMP()),
+	(539, 3535, '平行情報暗号化', UNIX_TIMESTAMP()),
+	(921, 920, '膜構造再生', UNIX_TIMESTAMP()),
+	(455, 7447, '微小衝突利用', UNIX_TIMESTAMP()),
+	(94, 2091, '重力場応答', UNIX_TIMESTAMP()),
+	(782, 5776, '電子軌道安定化', UNIX_TIMESTAMP()),
+	(546, 6539, '制御最適化', UNIX_TIMESTAMP()),
+	(282, 7274, '無意識相互', UNIX_TIMESTAMP()),
+	(61, 4056, '環流観測装置', UNIX_TIMESTAMP()),
+	(635, 4630, '超重力発生器', UNIX_TIMESTAMP()),
+	(810, 3806, '種子選別機', UNIX_TIMESTAMP()),
+	(448, 5442, '遺伝子暗号解読機', UNIX_TIMESTAMP()),
+	(543, 6536, '高電圧反応爆発装置', UNIX_TIMESTAMP()),
+	(614, 4609, '核融合燃料取扱装置', UNIX_TIMESTAMP()),
+	(990, 4985, '逆物質制御装置', UNIX_TIMESTAMP()),
+	(430, 2427, '液体金属製表面硬化材料', UNIX_TIMESTAMP()),
+	(326, 6319, '高速粒子束医療装置', UNIX_TIMESTAMP()),
+	(600, 2597, '心象演算術', UNIX_TIMESTAMP()),
+	(825, 4820, '量子統語解析', UNIX_TIMESTAMP()),
+	(912, 911, '神経干渉器', UNIX_TIMESTAMP()),
+	(288, 4283, '音波共振装置', UNIX_TIMESTAMP()),
+	(659, 2656, '分子応力制御', UNIX_TIMESTAMP()),
+	(670, 3666, '物質反重力素子', UNIX_TIMESTAMP()),
+	(817, 1815, '物象情報解析', UNIX_TIMESTAMP()),
+	(346, 345, '生体情報網', UNIX_TIMESTAMP()),
+	(25, 5019, '巨大神経回路網', UNIX_TIMESTAMP()),
+	(650, 4645, '遺伝情報選択装置', UNIX_TIMESTAMP()),
+	(680, 679, '心磁気波分析', UNIX_TIMESTAMP()),
+	(935, 1933, '藍江戸切子', UNIX_TIMESTAMP()),
+	(462, 6455, '月日金木水火土', UNIX_TIMESTAMP()),
+	(514, 6507, '蝙蝠紋銀尺', UNIX_TIMESTAMP()),
+	(501, 6494, '書花図屏風', UNIX_TIMESTAMP()),
+	(928, 2925, '染井吉野花筌', UNIX_TIMESTAMP()),
+	(716, 2713, '綿婦花唐草', UNIX_TIMESTAMP()),
+	(199, 6192, '地裂大車輪', UNIX_TIMESTAMP()),
+	(700, 5694, '曼荼羅代書', UNIX_TIMESTAMP()),
+	(165, 7157, '夢想心研究', UNIX_TIMESTAMP()),
+	(445, 4440, '天元素素構', UNIX_TIMESTAMP()),
+	(379, 2376, '心眼修練道', UNIX_TIMESTAMP()),
+	(8, 7, '無理全体数理', UNIX_TIMESTAMP()),
+	(849, 4844, '記憶対象輻射', UNIX_TIMESTAMP()),
+	(916, 2913, '音色修正術', UNIX_TIMESTAMP()),
+	(229, 7221, '未知物質解析装置', UNIX_TIMESTAMP()),
+	(892, 4887, '天体観測機能拡張装置', UNIX_TIMESTAMP()),
+	(873, 5867, '平行世界探査機', UNIX_TIMESTAMP()),
+	(791, 790, '精神波動装置', UNIX_TIMESTAMP()),
+	(722, 1720, '心理屈曲装置', UNIX_TIMESTAMP()),
+	(257, 256, '細胞再生加速器', UNIX_TIMESTAMP()),
+	(170, 1168, '量子干渉計測器', UNIX_TIMESTAMP()),
+	(469, 4464, '未来予知装置', UNIX_TIMESTAMP()),
+	(256, 6249, '核融合力場', UNIX_TIMESTAMP()),
+	(280, 5274, '空間歪曲素子', UNIX_TIMESTAMP()),
+	(596, 5590, '言語透明化', UNIX_TIMESTAMP()),
+	(25, 7017, '分子重複抑制網', UNIX_TIMESTAMP()),
+	(164, 2161, '内部霊感頻度', UNIX_TIMESTAMP()),
+	(768, 2765, '心理統合系', UNIX_TIMESTAMP()),
+	(305, 4300, '音響粒子加速', UNIX_TIMESTAMP()),
+	(980, 5974, '運命揺籃操作', UNIX_TIMESTAMP()),
+	(803, 3799, '心音情報解析', UNIX_TIMESTAMP()),
+	(845, 3841, '熱核転送反応', UNIX_TIMESTAMP()),
+	(992, 2989, '対外鎖状自己律制', UNIX_TIMESTAMP()),
+	(641, 1639, '透明量子反応器', UNIX_TIMESTAMP()),
+	(569, 1567, '左脳共感化', UNIX_TIMESTAMP()),
+	(122, 6115, '超電磁共鳴励起', UNIX_TIMESTAMP()),
+	(54, 4049, '共鳴生態圏応答', UNIX_TIMESTAMP()),
+	(569, 2566, '創発的知能構造', UNIX_TIMESTAMP()),
+	(483, 3479, '知識構造化指標', UNIX_TIMESTAMP()),
+	(910, 1908, '認知感触解析', UNIX_TIMESTAMP()),
+	(65, 6058, '量子行列相似化', UNIX_TIMESTAMP()),
+	(186, 185, '内部調整選別', UNIX_TIMESTAMP()),
+	(489, 7481, '直感情報暗号化', UNIX_TIMESTAMP()),
+	(51, 3047, '共鳴安定化装置', UNIX_TIMESTAMP()),
+	(478, 477, '快速光合成反応解析', UNIX_TIMESTAMP()),
+	(300, 299, '生体治療術', UNIX_TIMESTAMP()),
+	(548, 5542, '超音波遠隔操作術', UNIX_TIMESTAMP()),
+	(191, 4186, '水晶霊視術', UNIX_TIMESTAMP()),
+	(179, 4174, '霊的接続研究', UNIX_TIMESTAMP()),
+	(151, 1149, '幻影操作術', UNIX_TIMESTAMP()),
+	(625, 624, '逆転圧力軎', UNIX_TIMESTAMP()),
+	(107, 106, '動体運動励起波', UNIX_TIMESTAMP()),
+	(132, 131, '擬態融合現象', UNIX_TIMESTAMP()),
+	(233, 5227, '円環共振器', UNIX_TIMESTAMP()),
+	(269, 7261, '因果曝露定理', UNIX_TIMESTAMP()),
+	(338, 2335, '出力核分配', UNIX_TIMESTAMP()),
+	(942, 2939, '知識高度階梯化', UNIX_TIMESTAMP()),
+	(655, 5649, '発散滑翔装置', UNIX_TIMESTAMP()),
+	(311, 5305, '時空透過絶縁体', UNIX_TIMESTAMP()),
+	(591, 4586, '曖昧応用関数', UNIX_TIMESTAMP()),
+	(792, 1790, '澄浪比例弁', UNIX_TIMESTAMP()),
+	(296, 5290, '曲率凝固針', UNIX_TIMESTAMP()),
+	(975, 974, '燃焼共振補助', UNIX_TIMESTAMP()),
+	(326, 325, '真空漸増装置', UNIX_TIMESTAMP()),
+	(666, 6659, '逃逸有理波', UNIX_TIMESTAMP()),
+	(162, 2159, '加速擴散機', UNIX_TIMESTAMP()),
+	(944, 6937, '減衰弧面器', UNIX_TIMESTAMP()),
+	(726, 2723, '推進散照器', UNIX_TIMESTAMP()),
+	(898, 1896, '遺伝子融合', UNIX_TIMESTAMP()),
+	(808, 2805, '億光年通信', UNIX_TIMESTAMP()),
+	(558, 5552, '高次元波動', UNIX_TIMESTAMP()),
+	(418, 417, '精神共振器', UNIX_TIMESTAMP()),
+	(606, 4601, '超音速時間逆転装置', UNIX_TIMESTAMP()),
+	(958, 4953, '非順序原理', UNIX_TIMESTAMP()),
+	(738, 3734, '超惑星探査', UNIX_TIMESTAMP()),
+	(928, 1926, '脳波反映器', UNIX_TIMESTAMP()),
+	(1, 6993, '生体情報解析機', UNIX_TIMESTAMP()),
+	(200, 6193, '高密度結晶器', UNIX_TIMESTAMP()),
+	(589, 4584, '遺伝子再構成装置', UNIX_TIMESTAMP()),
+	(243, 1241, '超音速振動増幅器', UNIX_TIMESTAMP()),
+	(741, 4736, '超音波透視装置', UNIX_TIMESTAMP()),
+	(135, 6128, '電子感応拡散濾過', UNIX_TIMESTAMP()),
+	(232, 5226, '強力磁力放射機', UNIX_TIMESTAMP()),
+	(940, 939, '中世農業研究', UNIX_TIMESTAMP()),
+	(726, 4721, '思考導航術', UNIX_TIMESTAMP()),
+	(772, 4767, '蒸気圧測定器', UNIX_TIMESTAMP()),
+	(101, 4096, '音波制御装置', UNIX_TIMESTAMP()),
+	(965, 1963, '地殻示談研究', UNIX_TIMESTAMP()),
+	(707, 2704, '空中気象計器', UNIX_TIMESTAMP()),
+	(207, 2204, '塩基電位計', UNIX_TIMESTAMP()),
+	(967, 966, '楽器製作術', UNIX_TIMESTAMP()),
+	(372, 1370, '庭園設計術', UNIX_TIMESTAMP()),
+	(589, 2586, '反転禁止動態', UNIX_TIMESTAMP()),
+	(101, 7093, '空間分割原子', UNIX_TIMESTAMP()),
+	(809, 1807, '超伝導力抵抗', UNIX_TIMESTAMP()),
+	(316, 315, '情報消滅原子', UNIX_TIMESTAMP()),
+	(909, 4904, '無重力核融合', UNIX_TIMESTAMP()),
+	(276, 4271, '維持注力場', UNIX_TIMESTAMP()),
+	(912, 4907, '国際規準波動', UNIX_TIMESTAMP()),
+	(873, 5867, '概念認識中枢', UNIX_TIMESTAMP()),
+	(22, 6015, '力場補強装置', UNIX_TIMESTAMP()),
+	(176, 1174, '構造波反転', UNIX_TIMESTAMP()),
+	(691, 690, '共鳴分子加速器', UNIX_TIMESTAMP()),
+	(697, 2694, '内視鏡手術', UNIX_TIMESTAMP()),
+	(785, 4780, '蜂群行動体', UNIX_TIMESTAMP()),
+	(733, 2730, '暗黙記録装置', UNIX_TIMESTAMP()),
+	(160, 159, '有翼伝播体', UNIX_TIMESTAMP()),
+	(77, 2074, '地縛霊召喚', UNIX_TIMESTAMP()),
+	(741, 5735, '自己調和境界', UNIX_TIMESTAMP()),
+	(28, 4023, '無質量物質', UNIX_TIMESTAMP()),
+	(870, 5864, '平行現実系', UNIX_TIMESTAMP()),
+	(731, 5725, '無待機反応器', UNIX_TIMESTAMP()),
+	(821, 4816, '完全隠蔽構造', UNIX_TIMESTAMP()),
+	(756, 5750, '逆転閉経路', UNIX_TIMESTAMP()),
+	(341, 5335, '絶対領域錯視', UNIX_TIMESTAMP()),
+	(920, 2917, '階層的磁場制御', UNIX_TIMESTAMP()),
+	(418, 4413, '超低温突出装置', UNIX_TIMESTAMP()),
+	(643, 5637, '無重力通信系', UNIX_TIMESTAMP()),
+	(862, 4857, '高反応糖化物質', UNIX_TIMESTAMP()),
+	(996, 6989, '宇宙潜航艦', UNIX_TIMESTAMP()),
+	(573, 3569, '冥界図書館', UNIX_TIMESTAMP()),
+	(156, 4151, '秘伝薬製作', UNIX_TIMESTAMP()),
+	(793, 6786, '水蒸気圧力計', UNIX_TIMESTAMP()),
+	(415, 7407, '精霊召喚術', UNIX_TIMESTAMP()),
+	(804, 803, '流星石精製', UNIX_TIMESTAMP()),
+	(425, 1423, '天体占星術', UNIX_TIMESTAMP()),
+	(954, 1952, '電磁波治療', UNIX_TIMESTAMP()),
+	(809, 4804, '孔雀折紙術', UNIX_TIMESTAMP()),
+	(439, 4434, '指南針製作', UNIX_TIMESTAMP()),
+	(449, 2446, '幻視装置開発', UNIX_TIMESTAMP()),
+	(130, 6123, '石仏修復術', UNIX_TIMESTAMP()),
+	(914, 2911, '空気浄化装置', UNIX_TIMESTAMP()),
+	(166, 4161, '化粧品品質評価', UNIX_TIMESTAMP()),
+	(492, 5486, '木材接着剤', UNIX_TIMESTAMP()),
+	(3, 4997, '飛行機材料', UNIX_TIMESTAMP()),
+	(937, 4932, '電子格納素子', UNIX_TIMESTAMP()),
+	(912, 3908, '石油製品分析', UNIX_TIMESTAMP()),
+	(247, 6240, '金属熱処理', UNIX_TIMESTAMP()),
+	(367, 2364, '衛星風速計', UNIX_TIMESTAMP()),
+	(812, 2809, '電力配送網', UNIX_TIMESTAMP()),
+	(174, 6167, '血管内治療', UNIX_TIMESTAMP()),
+	(81, 5075, '元素周期表', UNIX_TIMESTAMP()),
+	(347, 3343, '反響音測定', UNIX_TIMESTAMP()),
+	(89, 3085, '水晶層尺度', UNIX_TIMESTAMP()),
+	(372, 371, '宇宙時空間移動装置', UNIX_TIMESTAMP()),
+	(463, 3459, '空気液化処理装置', UNIX_TIMESTAMP()),
+	(63, 62, '核融合制御機', UNIX_TIMESTAMP()),
+	(263, 5257, '精神感応器', UNIX_TIMESTAMP()),
+	(893, 1891, '生物進化曲線', UNIX_TIMESTAMP()),
+	(193, 2190, '生体憑依装置', UNIX_TIMESTAMP()),
+	(751, 1749, '時空歪曲装置', UNIX_TIMESTAMP()),
+	(525, 1523, '加速進化進行剤', UNIX_TIMESTAMP()),
+	(774, 5768, '地球環境変動指数', UNIX_TIMESTAMP()),
+	(94, 3090, '天体暗黒物質研究所', UNIX_TIMESTAMP()),
+	(164, 1162, '天然廃棄物再生装置', UNIX_TIMESTAMP()),
+	(901, 4896, '星間通信機器', UNIX_TIMESTAMP()),
+	(281, 6274, '昼夜循環調整装置', UNIX_TIMESTAMP()),
+	(8, 7000, '永久体重無力化装置', UNIX_TIMESTAMP()),
+	(184, 6177, '統計精度評価索引', UNIX_TIMESTAMP()),
+	(337, 1335, '空港抗菌飛行禁止区域', UNIX_TIMESTAMP()),
+	(250, 2247, '水銀抑制致死装置', UNIX_TIMESTAMP()),
+	(665, 6658, '血中酸素再利用補助装置', UNIX_TIMESTAMP()),
+	(479, 2476, '蒸気圧調整装置', UNIX_TIMESTAMP()),
+	(544, 1542, '水中無線通信装置', UNIX_TIMESTAMP()),
+	(191, 4186, '結晶骨格材料', UNIX_TIMESTAMP()),
+	(219, 1217, '火花放電検査器', UNIX_TIMESTAMP()),
+	(139, 1137, '熱伝導率測定器', UNIX_TIMESTAMP()),
+	(646, 5640, '共鳴周波数調整器', UNIX_TIMESTAMP()),
+	(179, 178, '液体拡散防止剤', UNIX_TIMESTAMP()),
+	(166, 2163, '電力供給制御盤', UNIX_TIMESTAMP()),
+	(317, 2314, '高速分子反応測定装置', UNIX_TIMESTAMP()),
+	(577, 4572, '反射率計測器', UNIX_TIMESTAMP()),
+	(388, 2385, '草原再生装置', UNIX_TIMESTAMP()),
+	(510, 509, '高濃度分散安定材', UNIX_TIMESTAMP()),
+	(201, 3197, '半導体磁力制御素子', UNIX_TIMESTAMP()),
+	(331, 7323, '植物細胞増殖培養装置', UNIX_TIMESTAMP()),
+	(368, 367, '懐中時計鳶', UNIX_TIMESTAMP()),
+	(877, 2874, '音響楽器磁気', UNIX_TIMESTAMP()),
+	(797, 2794, '懐中電灯橋', UNIX_TIMESTAMP()),
+	(219, 2216, '硫黄食器研磨', UNIX_TIMESTAMP()),
+	(897, 4892, '緑茶陶器筆', UNIX_TIMESTAMP()),
+	(567, 4562, '焦点距離滑走路', UNIX_TIMESTAMP()),
+	(887, 6880, '山茶花油絵具', UNIX_TIMESTAMP()),
+	(135, 6128, '対訳元小説', UNIX_TIMESTAMP()),
+	(851, 850, '銀河系酵素', UNIX_TIMESTAMP()),
+	(394, 7386, '眺望調整器', UNIX_TIMESTAMP()),
+	(764, 1762, '動物分類鏡', UNIX_TIMESTAMP()),
+	(312, 6305, '機械振動試験計', UNIX_TIMESTAMP()),
+	(792, 5786, '核磁気共鳴分析', UNIX_TIMESTAMP()),
+	(324, 6317, '地震測定装置', UNIX_TIMESTAMP()),
+	(93, 92, '気象観測器具', UNIX_TIMESTAMP()),
+	(940, 2937, '高分子合成触媒', UNIX_TIMESTAMP()),
+	(109, 4104, '環境環作放線菌', UNIX_TIMESTAMP()),
+	(630, 5624, '地層探査装置', UNIX_TIMESTAMP()),
+	(766, 3762, '循環器画像解析', UNIX_TIMESTAMP()),
+	(220, 2217, '微生物群集解析', UNIX_TIMESTAMP()),
+	(692, 5686, '空中補給装置', UNIX_TIMESTAMP()),
+	(452, 6445, '超音速音波制御', UNIX_TIMESTAMP()),
+	(350, 3346, '基質改変薬', UNIX_TIMESTAMP()),
+	(764, 1762, '分子構造解析機', UNIX_TIMESTAMP()),
+	(27, 3023, '超熱電流制御', UNIX_TIMESTAMP()),
+	(521, 6514, '非線形量子通信', UNIX_TIMESTAMP()),
+	(655, 654, '放射線透明化装置', UNIX_TIMESTAMP()),
+	(563, 3559, '超重力効果', UNIX_TIMESTAMP()),
+	(396, 5390, '超音速遮蔽膜', UNIX_TIMESTAMP()),
+	(95, 5089, '結晶体機構', UNIX_TIMESTAMP()),
+	(765, 764, '高収束光源', UNIX_TIMESTAMP()),
+	(537, 2534, '量子効果波', UNIX_TIMESTAMP()),
+	(999, 6992, '分子結晶構造', UNIX_TIMESTAMP()),
+	(265, 2262, '熱電界効果', UNIX_TIMESTAMP()),
+	(131, 4126, '非可逆反応', UNIX_TIMESTAMP()),
+	(646, 5640, '国際通信通量', UNIX_TIMESTAMP()),
+	(723, 1721, '磁気生体信号', UNIX_TIMESTAMP()),
+	(544, 543, '高周波電力供給', UNIX_TIMESTAMP()),
+	(347, 5341, '塵埃吸引装置', UNIX_TIMESTAMP()),
+	(108, 2105, '深層探索機', UNIX_TIMESTAMP()),
+	(113, 3109, '無重力窓口', UNIX_TIMESTAMP()),
+	(416, 6409, '蒸気駆動機', UNIX_TIMESTAMP()),
+	(95, 6088, '磁石拡張材', UNIX_TIMESTAMP()),
+	(655, 1653, '心霊共鳴器', UNIX_TIMESTAMP()),
+	(213, 3209, '幻想創造構造体', UNIX_TIMESTAMP()),
+	(777, 4772, '超従属生物', UNIX_TIMESTAMP()),
+	(702, 6695, '酸素分解装置', UNIX_TIMESTAMP()),
+	(25, 6018, '触媒反応体', UNIX_TIMESTAMP()),
+	(40, 3036, '絶対絶命防御組織', UNIX_TIMESTAMP()),
+	(994, 6987, '神聖共振器', UNIX_TIMESTAMP()),
+	(791, 6784, '自律選択器', UNIX_TIMESTAMP()),
+	(781, 780, '呪力制御装置', UNIX_TIMESTAMP()),
+	(604, 2601, '退廃抑制乳剤', UNIX_TIMESTAMP()),
+	(322, 321, '記憶遷移装置', UNIX_TIMESTAMP()),
+	(621, 2618, '恒星飛行器', UNIX_TIMESTAMP()),
+	(304, 1302, '行動螺旋反応器', UNIX_TIMESTAMP()),
+	(140, 139, '火竜黒薙刀', UNIX_TIMESTAMP()),
+	(257, 2254, '固晶潜水艇', UNIX_TIMESTAMP()),
+	(506, 505, '心拍即時計測', UNIX_TIMESTAMP()),
+	(944, 943, '空気軟体化', UNIX_TIMESTAMP()),
+	(845, 5839, '量子加速器', UNIX_TIMESTAMP()),
+	(292, 7284, '光熱防御薬剤', UNIX_TIMESTAMP()),
+	(986, 6979, '金属細密鍛造', UNIX_TIMESTAMP()),
+	(808, 3804, '生物貯蔵冷凍', UNIX_TIMESTAMP()),
+	(227, 226, '化粧品眉墨', UNIX_TIMESTAMP()),
+	(500, 7492, '裁縫道具仕立', UNIX_TIMESTAMP()),
+	(291, 2288, '家具具足加工', UNIX_TIMESTAMP()),
+	(931, 6924, '彫塑土器焼成', UNIX_TIMESTAMP()),
+	(325, 1323, '布製縫甲冑', UNIX_TIMESTAMP()),
+	(845, 844, '細工仕上美容', UNIX_TIMESTAMP()),
+	(254, 5248, '結晶化蝋炭', UNIX_TIMESTAMP()),
+	(7, 6999, '製本紙表紙', UNIX_TIMESTAMP()),
+	(30, 1028, '印刷板縁取', UNIX_TIMESTAMP()),
+	(665, 664, '工業製品火金造', UNIX_TIMESTAMP()),
+	(29, 1027, '映像神経圧縮', UNIX_TIMESTAMP()),
+	(599, 598, '統計図表解析', UNIX_TIMESTAMP()),
+	(766, 5760, '消費動向指数', UNIX_TIMESTAMP()),
+	(286, 3282, '地方自治制度', UNIX_TIMESTAMP()),
+	(859, 5853, '分子構造解析', UNIX_TIMESTAMP()),
+	(995, 5989, '意識興奮度', UNIX_TIMESTAMP()),
+	(386, 2383, '歴史時代区分', UNIX_TIMESTAMP()),
+	(817, 3813, '免疫応答力', UNIX_TIMESTAMP()),
+	(121, 7113, '標本偏差値', UNIX_TIMESTAMP()),
+	(940, 1938, '自然環境指数', UNIX_TIMESTAMP()),
+	(861, 5855, '社会階層構造', UNIX_TIMESTAMP()),
+	(440, 6433, '最適化問題', UNIX_TIMESTAMP()),
+	(111, 3107, '音声周波数分析', UNIX_TIMESTAMP()),
+	(235, 6228, '信仰心発現形式', UNIX_TIMESTAMP()),
+	(753, 5747, '火山噴火予知', UNIX_TIMESTAMP()),
+	(82, 2079, '生物情報解明機器', UNIX_TIMESTAMP()),
+	(766, 4761, '遺伝子操作装置', UNIX_TIMESTAMP()),
+	(103, 2100, '高速反応材料', UNIX_TIMESTAMP()),
+	(20, 2017, '生体合成材料', UNIX_TIMESTAMP()),
+	(556, 4551, '意識転送装置', UNIX_TIMESTAMP()),
+	(478, 4473, '超音速反応器', UNIX_TIMESTAMP()),
+	(386, 7378, '潜在意識制御装置', UNIX_TIMESTAMP()),
+	(697, 1695, '光合成触媒', UNIX_TIMESTAMP()),
+	(576, 6569, '蒸発加速装置', UNIX_TIMESTAMP()),
+	(592, 4587, '高次元計測器', UNIX_TIMESTAMP()),
+	(156, 2153, '無限時空器', UNIX_TIMESTAMP()),
+	(218, 3214, '超進化遺伝子', UNIX_TIMESTAMP()),
+	(658, 3654, '反重力装置', UNIX_TIMESTAMP()),
+	(540, 3536, '光子分析装置', UNIX_TIMESTAMP()),
+	(181, 2178, '空中浮遊振動器', UNIX_TIMESTAMP()),
+	(993, 2990, '非可視素粒子', UNIX_TIMESTAMP()),
+	(919, 4914, '未知惑星探査機', UNIX_TIMESTAMP()),
+	(622, 2619, '溶解酸素分析', UNIX_TIMESTAMP()),
+	(644, 3640, '拡散相転移', UNIX_TIMESTAMP()),
+	(478, 2475, '蒸気圧力試験', UNIX_TIMESTAMP()),
+	(175, 4170, '高分子凝集体', UNIX_TIMESTAMP()),
+	(44, 2041, '層流模様生成', UNIX_TIMESTAMP()),
+	(857, 856, '側翼気流制御', UNIX_TIMESTAMP()),
+	(669, 1667, '静的潜水器物理', UNIX_TIMESTAMP()),
+	(207, 1205, '視覚顕微鏡', UNIX_TIMESTAMP()),
+	(578, 5572, '天空騎士団', UNIX_TIMESTAMP()),
+	(182, 6175, '水陸両用兵器', UNIX_TIMESTAMP()),
+	(30, 7022, '弓術士監修', UNIX_TIMESTAMP()),
+	(789, 788, '電磁波干渉', UNIX_TIMESTAMP()),
+	(889, 3885, '遺伝子情報解析', UNIX_TIMESTAMP()),
+	(104, 3100, '超伝導磁場制御', UNIX_TIMESTAMP()),
+	(74, 4069, '知識集約化', UNIX_TIMESTAMP()),
+	(27, 7019, '集積回路設計', UNIX_TIMESTAMP()),
+	(772, 1770, '火力発電機器', UNIX_TIMESTAMP()),
+	(877, 5871, '暗黒界分離', UNIX_TIMESTAMP()),
+	(200, 199, '心理共和国', UNIX_TIMESTAMP()),
+	(9, 6002, '干渉現象機', UNIX_TIMESTAMP()),
+	(687, 686, '無差拡散体', UNIX_TIMESTAMP()),
+	(329, 2326, '潜在空間索引', UNIX_TIMESTAMP()),
+	(135, 1133, '超越粒子機', UNIX_TIMESTAMP()),
+	(881, 6874, '等方鏡面反射', UNIX_TIMESTAMP()),
+	(329, 328, '音声頻度共鳴', UNIX_TIMESTAMP()),
+	(625, 1623, '建物比重解析', UNIX_TIMESTAMP()),
+	(8, 7, '量子加速装置', UNIX_TIMESTAMP()),
+	(65, 1063, '自律連結器', UNIX_TIMESTAMP()),
+	(569, 4564, '磁気歪曲体', UNIX_TIMESTAMP()),
+	(973, 972, '植物栄養体', UNIX_TIMESTAMP()),
+	(201, 3197, '逐次化合装置', UNIX_TIMESTAMP()),
+	(169, 2166, '孤立行動体', UNIX_TIMESTAMP()),
+	(642, 4637, '精神共鳴桜', UNIX_TIMESTAMP()),
+	(406, 1404, '心電記録装置', UNIX_TIMESTAMP()),
+	(598, 2595, '伝達選択体', UNIX_TIMESTAMP()),
+	(909, 5903, '有機化物合成装置', UNIX_TIMESTAMP()),
+	(899, 4894, '食品香料創発機構', UNIX_TIMESTAMP()),
+	(339, 7331, '断続的磁化脈動現象', UNIX_TIMESTAMP()),
+	(811, 5805, '糖尿病免疫調節', UNIX_TIMESTAMP()),
+	(838, 6831, '地球外生命体', UNIX_TIMESTAMP()),
+	(848, 847, '光速瞬間移動', UNIX_TIMESTAMP()),
+	(44, 6037, '共鳴共振器', UNIX_TIMESTAMP()),
+	(471, 6464, '精神制御装置', UNIX_TIMESTAMP()),
+	(423, 5417, '記憶再現装置', UNIX_TIMESTAMP()),
+	(421, 3417, '暗黒変換術', UNIX_TIMESTAMP()),
+	(581, 2578, '蒸気冷凍機', UNIX_TIMESTAMP()),
+	(452, 7444, '水晶振動子', UNIX_TIMESTAMP()),
+	(682, 5676, '魔力再利用装置', UNIX_TIMESTAMP()),
+	(202, 3198, '重力逆転装置', UNIX_TIMESTAMP()),
+	(678, 4673, '透明超伝導体', UNIX_TIMESTAMP()),
+	(190, 4185, '反物質蓄積器', UNIX_TIMESTAMP()),
+	(125, 3121, '神秘的磁場', UNIX_TIMESTAMP()),
+	(890, 4885, '炎熱分析機', UNIX_TIMESTAMP()),
+	(810, 3806, '高次元位相器', UNIX_TIMESTAMP()),
+	(957, 956, '超赤外線発生器', UNIX_TIMESTAMP()),
+	(551, 5545, '高反応合金', UNIX_TIMESTAMP()),
+	(816, 6809, '心霊観測装置', UNIX_TIMESTAMP()),
+	(182, 7174, '空間認識機能', UNIX_TIMESTAMP()),
+	(741, 1739, '力場展開装置', UNIX_TIMESTAMP()),
+	(135, 2132, '磁場乱流装置', UNIX_TIMESTAMP()),
+	(602, 3598, '物質軌跡解析機', UNIX_TIMESTAMP()),
+	(231, 4226, '自動空中航行機', UNIX_TIMESTAMP()),
+	(816, 1814, '臨界光出力器', UNIX_TIMESTAMP()),
+	(292, 1290, '時空移動門', UNIX_TIMESTAMP()),
+	(340, 339, '葺網蓄音機', UNIX_TIMESTAMP()),
+	(189, 2186, '心技一体警棒', UNIX_TIMESTAMP()),
+	(952, 1950, '紙巻電気摩擦', UNIX_TIMESTAMP()),
+	(305, 6298, '心象風力発電', UNIX_TIMESTAMP()),
+	(420, 4415, '空間感知陣列', UNIX_TIMESTAMP()),
+	(214, 213, '遺伝情報解読器', UNIX_TIMESTAMP()),
+	(522, 1520, '演算精度補完装置', UNIX_TIMESTAMP()),
+	(350, 5344, '磁気隔壁素子', UNIX_TIMESTAMP()),
+	(792, 3788, '生体相互接続装置', UNIX_TIMESTAMP()),
+	(695, 3691, '高分子物質合成酵素', UNIX_TIMESTAMP()),
+	(190, 1188, '熱低減転写剤', UNIX_TIMESTAMP()),
+	(192, 5186, '回折光調整器', UNIX_TIMESTAMP()),
+	(934, 4929, '超音波震動振動子', UNIX_TIMESTAMP()),
+	(702, 2699, '神経伝達量子', UNIX_TIMESTAMP()),
+	(545, 4540, '電子感応調整素子', UNIX_TIMESTAMP()),
+	(680, 4675, '電位変化抑制材', UNIX_TIMESTAMP()),
+	(328, 7320, '電波干渉部材', UNIX_TIMESTAMP()),
+	(180, 7172, '気体検知応答率', UNIX_TIMESTAMP()),
+	(362, 3358, '摩擦軽減合金', UNIX_TIMESTAMP()),
+	(362, 5356, '逆磁場発生体', UNIX_TIMESTAMP()),
+	(247, 2244, '液体充填材', UNIX_TIMESTAMP()),
+	(528, 1526, '分子結合解離制御素子', UNIX_TIMESTAMP()),
+	(471, 2468, '心理映像装置', UNIX_TIMESTAMP()),
+	(714, 1712, '量子念動力', UNIX_TIMESTAMP()),
+	(502, 5496, '磁気情報変換器', UNIX_TIMESTAMP()),
+	(676, 4671, '無限次元記憶', UNIX_TIMESTAMP()),
+	(972, 2969, '空想転移監視装置', UNIX_TIMESTAMP()),
+	(162, 7154, '無意識潜在力', UNIX_TIMESTAMP()),
+	(135, 2132, '形式知覚器', UNIX_TIMESTAMP()),
+	(573, 1571, '錯視補正機能', UNIX_TIMESTAMP()),
+	(833, 1831, '空中分子通信', UNIX_TIMESTAMP()),
+	(677, 676, '超振動発生器', UNIX_TIMESTAMP()),
+	(231, 3227, '真空時空接続装置', UNIX_TIMESTAMP()),
+	(593, 3589, '無重力物質', UNIX_TIMESTAMP()),
+	(820, 3816, '電磁気偏向', UNIX_TIMESTAMP()),
+	(492, 3488, '環境共通語', UNIX_TIMESTAMP()),
+	(60, 2057, '情報外部化', UNIX_TIMESTAMP()),
+	(533, 3529, '悪影響中和', UNIX_TIMESTAMP()),
+	(773, 2770, '可移転意識', UNIX_TIMESTAMP()),
+	(110, 6103, '空間曲線化', UNIX_TIMESTAMP()),
+	(71, 2068, '仮想物質生成', UNIX_TIMESTAMP()),
+	(815, 4810, '奈落降魔術', UNIX_TIMESTAMP()),
+	(275, 1273, '崩岳封魔術', UNIX_TIMESTAMP()),
+	(383, 6376, '狂想曲思考', UNIX_TIMESTAMP()),
+	(316, 7308, '機械透明化', UNIX_TIMESTAMP()),
+	(761, 4756, '素粒子拡散', UNIX_TIMESTAMP()),
+	(351, 2348, '有機運算術', UNIX_TIMESTAMP()),
+	(527, 3523, '遺伝子書記化', UNIX_TIMESTAMP()),
+	(262, 4257, '言語連鎖構造', UNIX_TIMESTAMP()),
+	(380, 1378, '健康診断意識', UNIX_TIMESTAMP()),
+	(508, 1506, '心理感応素子', UNIX_TIMESTAMP()),
+	(769, 4764, '地球埋蔵熱利用', UNIX_TIMESTAMP()),
+	(613, 3609, '生体観察術', UNIX_TIMESTAMP()),
+	(787, 3783, '自律復元材料', UNIX_TIMESTAMP()),
+	(462, 2459, '宇宙微粒重力計', UNIX_TIMESTAMP()),
+	(340, 339, '分子構築合成', UNIX_TIMESTAMP()),
+	(959, 6952, '無線磁場伝搬', UNIX_TIMESTAMP()),
+	(337, 4332, '多元化意識統治', UNIX_TIMESTAMP()),
+	(78, 4073, '生物発光発電', UNIX_TIMESTAMP()),
+	(668, 4663, '非対称制御系', UNIX_TIMESTAMP()),
+	(607, 4602, '新陳代謝増強剤', UNIX_TIMESTAMP()),
+	(656, 1654, '平衡騎士団', UNIX_TIMESTAMP()),
+	(652, 3648, '幻覚検知術', UNIX_TIMESTAMP()),
+	(344, 5338, '反射経路分析', UNIX_TIMESTAMP()),
+	(83, 3079, '対比美革命', UNIX_TIMESTAMP()),
+	(798, 3794, '生体伝導研究', UNIX_TIMESTAMP()),
+	(772, 3768, '概念空間計算', UNIX_TIMESTAMP()),
+	(93, 6086, '磁核共鳴機', UNIX_TIMESTAMP()),
+	(369, 5363, '薬事規制基準', UNIX_TIMESTAMP()),
+	(736, 5730, '星間通信網', UNIX_TIMESTAMP()),
+	(365, 3361, '心理認知解析', UNIX_TIMESTAMP()),
+	(670, 5664, '物理反応制御', UNIX_TIMESTAMP()),
+	(157, 156, '眼球生体認証', UNIX_TIMESTAMP()),
+	(126, 4121, '知覚情報処理', UNIX_TIMESTAMP()),
+	(731, 730, '音響透明素材', UNIX_TIMESTAMP()),
+	(241, 6234, '生体活力促進', UNIX_TIMESTAMP()),
+	(317, 1315, '空中物体検知', UNIX_TIMESTAMP()),
+	(642, 5636, '高分子経時劣化', UNIX_TIMESTAMP()),
+	(132, 6125, '潜在領域探査', UNIX_TIMESTAMP()),
+	(189, 1187, '熱帯生態系保護', UNIX_TIMESTAMP()),
+	(366, 3362, '遺伝情報転写', UNIX_TIMESTAMP()),
+	(954, 5948, '文字解読術', UNIX_TIMESTAMP()),
+	(975, 1973, '音波共鳴魔導', UNIX_TIMESTAMP()),
+	(620, 5614, '深海採取機', UNIX_TIMESTAMP()),
+	(727, 2724, '菌糸細胞栄養', UNIX_TIMESTAMP()),
+	(200, 3196, '真空断熱装置', UNIX_TIMESTAMP()),
+	(581, 5575, '鉱物高速精製', UNIX_TIMESTAMP()),
+	(36, 3032, '新種植物遺伝子', UNIX_TIMESTAMP()),
+	(133, 132, '情報空間解析', UNIX_TIMESTAMP()),
+	(191, 190, '地磁気制御装置', UNIX_TIMESTAMP()),
+	(851, 1849, '操脳行動制御', UNIX_TIMESTAMP()),
+	(130, 5124, '宇宙微生物研究', UNIX_TIMESTAMP()),
+	(657, 4652, '蒸発重合反応', UNIX_TIMESTAMP()),
+	(630, 629, '光子放射誘導', UNIX_TIMESTAMP()),
+	(245, 4240, '双子酵素作用', UNIX_TIMESTAMP()),
+	(409, 3405, '酸化還元電位計', UNIX_TIMESTAMP()),
+	(267, 4262, '電子伝達酵素', UNIX_TIMESTAMP()),
+	(442, 2439, '固体荷電濃度定理', UNIX_TIMESTAMP()),
+	(756, 2753, '溶媒極化現象', UNIX_TIMESTAMP()),
+	(380, 379, '心理共感感能力', UNIX_TIMESTAMP()),
+	(539, 538, '言語翻訳精度評価', UNIX_TIMESTAMP()),
+	(184, 183, '社会統合効果評価', UNIX_TIMESTAMP()),
+	(414, 1412, '患者満足度調査', UNIX_TIMESTAMP()),
+	(435, 2432, '建築耐震設計基準', UNIX_TIMESTAMP()),
+	(79, 7071, '経営戦略展開計画', UNIX_TIMESTAMP()),
+	(390, 5384, '仮想現実空間構築', UNIX_TIMESTAMP()),
+	(904, 1902, '文化交流促進政策', UNIX_TIMESTAMP()),
+	(115, 2112, '農業生産経営管理', UNIX_TIMESTAMP()),
+	(906, 6899, '照明効果最適化', UNIX_TIMESTAMP()),
+	(887, 3883, '心理行動複合体', UNIX_TIMESTAMP()),
+	(386, 4381, '生物統合通信', UNIX_TIMESTAMP()),
+	(551, 5545, '空間時空構造', UNIX_TIMESTAMP()),
+	(588, 2585, '機動戦闘車', UNIX_TIMESTAMP()),
+	(16, 6009, '金属酸化物', UNIX_TIMESTAMP()),
+	(171, 6164, '原料製造機', UNIX_TIMESTAMP()),
+	(839, 1837, '形態素解析', UNIX_TIMESTAMP()),
+	(137, 3133, '熱電変換効率', UNIX_TIMESTAMP()),
+	(496, 5490, '遺伝融合現象', UNIX_TIMESTAMP()),
+	(361, 2358, '病理転写酵素', UNIX_TIMESTAMP()),
+	(67, 5061, '証拠反複条項', UNIX_TIMESTAMP()),
+	(546, 5540, '最適割当定理', UNIX_TIMESTAMP()),
+	(820, 819, '量子相対抗力', UNIX_TIMESTAMP()),
+	(489, 4484, '地殻浮動仮説', UNIX_TIMESTAMP()),
+	(437, 6430, '議会政治広報', UNIX_TIMESTAMP()),
+	(293, 6286, '建築式構造主義', UNIX_TIMESTAMP()),
+	(50, 7042, '言語獲得過程', UNIX_TIMESTAMP()),
+	(260, 7252, '集団動態行動', UNIX_TIMESTAMP()),
+	(326, 325, '史前遺産保護策', UNIX_TIMESTAMP()),
+	(69, 68, '視覚認知効果', UNIX_TIMESTAMP()),
+	(957, 2954, '空間位相推移装置', UNIX_TIMESTAMP()),
+	(577, 4572, '物質相対位相波動', UNIX_TIMESTAMP()),
+	(99, 2096, '生物遺伝脈波解析', UNIX_TIMESTAMP()),
+	(684, 4679, '繊維加護補強材', UNIX_TIMESTAMP()),
+	(261, 260, '巨大磁力導電体', UNIX_TIMESTAMP()),
+	(604, 1602, '植物組織生長調節装置', UNIX_TIMESTAMP()),
+	(182, 6175, '分子炉材料反応制御', UNIX_TIMESTAMP()),
+	(379, 7371, '地磁気斑点数', UNIX_TIMESTAMP()),
+	(340, 1338, '眼球振盪運動', UNIX_TIMESTAMP()),
+	(538, 3534, '量子気候予測', UNIX_TIMESTAMP()),
+	(346, 2343, '自己調整抽象化', UNIX_TIMESTAMP()),
+	(904, 2901, '自動車潜伏応力評価', UNIX_TIMESTAMP()),
+	(615, 1613, '潜在意識装置', UNIX_TIMESTAMP()),
+	(658, 3654, '脳力集団通信', UNIX_TIMESTAMP()),
+	(297, 296, '機械新陳代謝効率', UNIX_TIMESTAMP()),
+	(623, 5617, '燃料極寿命', UNIX_TIMESTAMP()),
+	(703, 4698, '波動基準点', UNIX_TIMESTAMP()),
+	(315, 5309, '結晶構造体', UNIX_TIMESTAMP()),
+	(311, 6304, '地殻平静期', UNIX_TIMESTAMP()),
+	(104, 3100, '軌道安定化', UNIX_TIMESTAMP()),
+	(831, 6824, '陶磁器製造', UNIX_TIMESTAMP()),
+	(459, 6452, '遺伝子研究', UNIX_TIMESTAMP()),
+	(299, 5293, '無重力装置', UNIX_TIMESTAMP()),
+	(430, 3426, '超音速弾道弾', UNIX_TIMESTAMP()),
+	(899, 6892, '高密度反応炉', UNIX_TIMESTAMP()),
+	(4, 3000, '量子転送通信', UNIX_TIMESTAMP()),
+	(435, 3431, '脳内想起装置', UNIX_TIMESTAMP()),
+	(426, 1424, '遺伝子編集剤', UNIX_TIMESTAMP()),
+	(284, 2281, '創造的書記機械', UNIX_TIMESTAMP()),
+	(79, 6072, '時空断層装置', UNIX_TIMESTAMP()),
+	(423, 5417, '炭素合成触媒', UNIX_TIMESTAMP()),
+	(274, 3270, '集中波動発振装置', UNIX_TIMESTAMP()),
+	(188, 187, '拡張現実画像処理', UNIX_TIMESTAMP()),
+	(336, 2333, '未来予知装置', UNIX_TIMESTAMP()),
+	(296, 7288, '無損失電力伝送', UNIX_TIMESTAMP()),
+	(278, 7270, '環状演算子', UNIX_TIMESTAMP()),
+	(228, 7220, '磁気巨亜鉛遅延', UNIX_TIMESTAMP()),
+	(805, 3801, '重力場生成器', UNIX_TIMESTAMP()),
+	(243, 6236, '経済構造分析', UNIX_TIMESTAMP()),
+	(687, 686, '卵発現遺伝', UNIX_TIMESTAMP()),
+	(205, 7197, '宇宙背景放射線', UNIX_TIMESTAMP()),
+	(188, 187, '自伝紙幣計算', UNIX_TIMESTAMP()),
+	(207, 4202, '壮大昇華概念', UNIX_TIMESTAMP()),
+	(605, 6598, '焼結顕微鏡観察', UNIX_TIMESTAMP()),
+	(103, 7095, '多元重系適応', UNIX_TIMESTAMP()),
+	(437, 4432, '電漿試料反射', UNIX_TIMESTAMP()),
+	(815, 2812, '珍種交配遺伝子', UNIX_TIMESTAMP()),
+	(510, 509, '模倣加速器', UNIX_TIMESTAMP()),
+	(488, 6481, '葉緑素電子伝達', UNIX_TIMESTAMP()),
+	(945, 5939, '千里眼視覚化', UNIX_TIMESTAMP()),
+	(171, 7163, '宇宙微粒子干渉', UNIX_TIMESTAMP()),
+	(674, 2671, '超短期効果分析', UNIX_TIMESTAMP()),
+	(423, 5417, '動体空間測定装置', UNIX_TIMESTAMP()),
+	(805, 6798, '無機元素検出器', UNIX_TIMESTAMP()),
+	(647, 5641, '心拍波生体識別', UNIX_TIMESTAMP()),
+	(312, 3308, '自律制御機構', UNIX_TIMESTAMP()),
+	(954, 6947, '遺伝情報解析', UNIX_TIMESTAMP()),
+	(937, 5931, '天然高分子合成', UNIX_TIMESTAMP()),
+	(695, 1693, '生体信号解析', UNIX_TIMESTAMP()),
+	(371, 2368, '音響精度評価', UNIX_TIMESTAMP()),
+	(366, 7358, '農業生産経済', UNIX_TIMESTAMP()),
+	(277, 1275, '分子傷害評価', UNIX_TIMESTAMP()),
+	(186, 1184, '核融合反応炉', UNIX_TIMESTAMP()),
+	(423, 2420, '宇宙船推進装置', UNIX_TIMESTAMP()),
+	(167, 3163, '磁気浮上装置', UNIX_TIMESTAMP()),
+	(522, 521, '電磁波遮断器', UNIX_TIMESTAMP()),
+	(892, 2889, '時空曲率制御装置', UNIX_TIMESTAMP()),
+	(648, 5642, '生物進化加速器', UNIX_TIMESTAMP()),
+	(542, 2539, '惑星環境再生装置', UNIX_TIMESTAMP()),
+	(262, 2259, '直立二輪自動車', UNIX_TIMESTAMP()),
+	(898, 3894, '深海探査機', UNIX_TIMESTAMP()),
+	(451, 5445, '筋肉増強装置', UNIX_TIMESTAMP()),
+	(981, 980, '暗黒牙時計', UNIX_TIMESTAMP()),
+	(747, 3743, '念動力結晶', UNIX_TIMESTAMP()),
+	(639, 1637, '産業連関表', UNIX_TIMESTAMP()),
+	(570, 2567, '酸化還元反応', UNIX_TIMESTAMP()),
+	(171, 3167, '磁気共鳴現象', UNIX_TIMESTAMP()),
+	(194, 193, '武士道精神', UNIX_TIMESTAMP()),
+	(675, 3671, '水蒸気循環', UNIX_TIMESTAMP()),
+	(317, 4312, '遺伝子組織', UNIX_TIMESTAMP()),
+	(436, 6429, '認知再構築', UNIX_TIMESTAMP()),
+	(8, 2005, '高分子合成', UNIX_TIMESTAMP()),
+	(53, 3049, '蒸気錬金術', UNIX_TIMESTAMP()),
+	(5, 4999, '非定常振動', UNIX_TIMESTAMP()),
+	(92, 2089, '心理懐中電灯', UNIX_TIMESTAMP()),
+	(878, 4873, '音響双方向伝搬', UNIX_TIMESTAMP()),
+	(742, 4737, '素粒子生成過程', UNIX_TIMESTAMP()),
+	(143, 6136, '静電気力制御', UNIX_TIMESTAMP()),
+	(733, 6726, '映像信号解析', UNIX_TIMESTAMP()),
+	(479, 2476, '音楽感染症研究', UNIX_TIMESTAMP()),
+	(745, 1743, '脳神経応答測定', UNIX_TIMESTAMP()),
+	(628, 627, '地形風力発電概念設計', UNIX_TIMESTAMP()),
+	(199, 6192, '免疫遺伝子治療', UNIX_TIMESTAMP()),
+	(983, 5977, '航続距離係数', UNIX_TIMESTAMP()),
+	(797, 3793, '心理情報解析', UNIX_TIMESTAMP()),
+	(788, 4783, '転送効果指数', UNIX_TIMESTAMP()),
+	(561, 560, '交差共鳴响', UNIX_TIMESTAMP()),
+	(207, 206, '単独選策支援', UNIX_TIMESTAMP()),
+	(706, 4701, '意味再構築機構', UNIX_TIMESTAMP()),
+	(698, 2695, '顕在脈絡推察', UNIX_TIMESTAMP()),
+	(12, 3008, '多元評価行列', UNIX_TIMESTAMP()),
+	(175, 6168, '統合電子査察', UNIX_TIMESTAMP()),
+	(719, 6712, '逐次空間触媒', UNIX_TIMESTAMP()),
+	(80, 7072, '文化生産指標', UNIX_TIMESTAMP()),
+	(979, 978, '標準曲線定義', UNIX_TIMESTAMP()),
+	(178, 3174, '個体経済分析', UNIX_TIMESTAMP()),
+	(282, 3278, '情報断絶検出器', UNIX_TIMESTAMP()),
+	(63, 62, '微生物薬剤体系', UNIX_TIMESTAMP()),
+	(665, 2662, '直感形態記憶', UNIX_TIMESTAMP()),
+	(155, 154, '環境保全指標', UNIX_TIMESTAMP()),
+	(469, 7461, '予測増産確率', UNIX_TIMESTAMP()),
+	(762, 4757, '複合規制要件', UNIX_TIMESTAMP()),
+	(102, 5096, '可視化繊維', UNIX_TIMESTAMP()),
+	(142, 4137, '潜在記憶合成装置', UNIX_TIMESTAMP()),
+	(989, 988, '磁気応用制御材料', UNIX_TIMESTAMP()),
+	(376, 375, '時空歪曲装置', UNIX_TIMESTAMP()),
+	(473, 5467, '心拍認証機構', UNIX_TIMESTAMP()),
+	(549, 6542, '情報瞬間転送術', UNIX_TIMESTAMP()),
+	(488, 3484, '高次元量子変換器', UNIX_TIMESTAMP()),
+	(445, 5439, '認識増幅装置', UNIX_TIMESTAMP()),
+	(728, 3724, '星間通信連結器', UNIX_TIMESTAMP()),
+	(189, 4184, '非可逆精神回路', UNIX_TIMESTAMP()),
+	(32, 7024, '記憶再構築解析機', UNIX_TIMESTAMP()),
+	(367, 7359, '仮想粒子生成装置', UNIX_TIMESTAMP()),
+	(686, 685, '創造行列制御器', UNIX_TIMESTAMP()),
+	(335, 334, '無限情報蓄積装置', UNIX_TIMESTAMP()),
+	(150, 4145, '積層化連続分析', UNIX_TIMESTAMP()),
+	(708, 1706, '微視的共融相互作用', UNIX_TIMESTAMP()),
+	(940, 5934, '経済多体効果', UNIX_TIMESTAMP()),
+	(592, 2589, '発散形態生成', UNIX_TIMESTAMP()),
+	(516, 3512, '分子間陽電子干渉', UNIX_TIMESTAMP()),
+	(640, 4635, '有機化合物動態解析', UNIX_TIMESTAMP()),
+	(167, 166, '忘却図書館', UNIX_TIMESTAMP()),
+	(401, 1399, '高周波発生器', UNIX_TIMESTAMP()),
+	(55, 7047, '超重力境界', UNIX_TIMESTAMP()),
+	(833, 1831, '電子応答頻度', UNIX_TIMESTAMP()),
+	(594, 6587, '精霊発生装置', UNIX_TIMESTAMP()),
+	(408, 2405, '粒子位相変換', UNIX_TIMESTAMP()),
+	(664, 663, '思考波追従', UNIX_TIMESTAMP()),
+	(67, 5061, '微生物駆除', UNIX_TIMESTAMP()),
+	(921, 2918, '時空転移術', UNIX_TIMESTAMP()),
+	(634, 2631, '薬剤直感処方術', UNIX_TIMESTAMP()),
+	(220, 219, '量子光電子媒体', UNIX_TIMESTAMP()),
+	(211, 2208, '分子電漿加速器', UNIX_TIMESTAMP()),
+	(580, 5574, '仮想生態計画', UNIX_TIMESTAMP()),
+	(953, 3949, '磁気反転超伝導効果', UNIX_TIMESTAMP()),
+	(643, 2640, '振動固有値解析', UNIX_TIMESTAMP()),
+	(774, 1772, '地質画像解析', UNIX_TIMESTAMP()),
+	(658, 657, '音響変調方式', UNIX_TIMESTAMP()),
+	(958, 5952, '電磁波誘起反応', UNIX_TIMESTAMP()),
+	(205, 6198, '多重環境分析', UNIX_TIMESTAMP()),
+	(658, 657, '磁気遮蔽装置', UNIX_TIMESTAMP()),
+	(812, 3808, '色彩感知芯片', UNIX_TIMESTAMP()),
+	(7, 6999, '噴霧生体燃料', UNIX_TIMESTAMP()),
+	(96, 95, '音楽神経接続網', UNIX_TIMESTAMP()),
+	(907, 1905, '熱力共振装置', UNIX_TIMESTAMP()),
+	(757, 5751, '記憶回路抑制', UNIX_TIMESTAMP()),
+	(887, 5881, '蓄音魔方陣', UNIX_TIMESTAMP()),
+	(344, 343, '遺伝情報窓口', UNIX_TIMESTAMP()),
+	(804, 3800, '水晶力場発生器', UNIX_TIMESTAMP()),
+	(502, 6495, '心霊脳波測定機', UNIX_TIMESTAMP()),
+	(886, 885, '量子負荷通信', UNIX_TIMESTAMP()),
+	(847, 2844, '霊元気指数', UNIX_TIMESTAMP()),
+	(274, 6267, '太陽圏採掘', UNIX_TIMESTAMP()),
+	(554, 6547, '魔革命盟章', UNIX_TIMESTAMP()),
+	(424, 4419, '時空遺伝子', UNIX_TIMESTAMP()),
+	(808, 2805, '地震変動暦', UNIX_TIMESTAMP()),
+	(28, 6021, '脳内視線装置', UNIX_TIMESTAMP()),
+	(569, 5563, '機械集合網', UNIX_TIMESTAMP()),
+	(214, 2211, '尾行障害壁', UNIX_TIMESTAMP()),
+	(400, 4395, '行動炭素指標', UNIX_TIMESTAMP()),
+	(501, 5495, '光造津波防壁', UNIX_TIMESTAMP()),
+	(976, 4971, '創造共振機器', UNIX_TIMESTAMP()),
+	(885, 884, '震動精密機構', UNIX_TIMESTAMP()),
+	(617, 2614, '歴史拠点化', UNIX_TIMESTAMP()),
+	(512, 511, '共愛創造主義', UNIX_TIMESTAMP()),
+	(994, 1992, '文化大衝突', UNIX_TIMESTAMP()),
+	(187, 2184, '全球通信網', UNIX_TIMESTAMP()),
+	(408, 2405, '心理超感應', UNIX_TIMESTAMP()),
+	(44, 3040, '未知維度領域', UNIX_TIMESTAMP()),
+	(305, 3301, '高次元融和', UNIX_TIMESTAMP()),
+	(685, 6678, '知識共有化', UNIX_TIMESTAMP()),
+	(931, 4926, '環境持続主義', UNIX_TIMESTAMP()),
+	(384, 6377, '新興生命分野', UNIX_TIMESTAMP()),
+	(54, 5048, '自己多元化', UNIX_TIMESTAMP()),
+	(271, 4266, '意識転換装置', UNIX_TIMESTAMP()),
+	(432, 1430, '無限因数分解定理', UNIX_TIMESTAMP()),
+	(189, 6182, '反書記的記録', UNIX_TIMESTAMP()),
+	(505, 3501, '南極環状気象', UNIX_TIMESTAMP()),
+	(238, 1236, '戦略的顧客獲得', UNIX_TIMESTAMP()),
+	(599, 6592, '植物生産効率化', UNIX_TIMESTAMP()),
+	(694, 4689, '自由意思降霊', UNIX_TIMESTAMP()),
+	(791, 1789, '黒百合血盟', UNIX_TIMESTAMP()),
+	(43, 4038, '無限列車航', UNIX_TIMESTAMP()),
+	(20, 6013, '富士山隠者', UNIX_TIMESTAMP()),
+	(672, 1670, '情星芸術術', UNIX_TIMESTAMP()),
+	(407, 406, '航空衝突予測装置', UNIX_TIMESTAMP()),
+	(878, 1876, '因果関係解析計測器', UNIX_TIMESTAMP()),
+	(904, 6897, '海洋地殻掘削装置', UNIX_TIMESTAMP()),
+	(596, 2593, '超高速粒子加速器', UNIX_TIMESTAMP()),
+	(851, 3847, '量子情報処理装置', UNIX_TIMESTAMP()),
+	(152, 7144, '脳波周波数分析器', UNIX_TIMESTAMP()),
+	(312, 311, '光量子暗号通信装置', UNIX_TIMESTAMP()),
+	(392, 1390, '熱核融合燃料供給装置', UNIX_TIMESTAMP()),
+	(961, 960, '超電導磁気浮上器', UNIX_TIMESTAMP()),
+	(917, 5911, '分子自己組織化材料', UNIX_TIMESTAMP()),
+	(649, 6642, '無重力生物', UNIX_TIMESTAMP()),
+	(120, 3116, '遺伝子操作', UNIX_TIMESTAMP()),
+	(174, 5168, '超磁力装置', UNIX_TIMESTAMP()),
+	(835, 2832, '潜在能力覚醒', UNIX_TIMESTAMP()),
+	(703, 1701, '新元素合成', UNIX_TIMESTAMP()),
+	(923, 4918, '閉空間収束', UNIX_TIMESTAMP()),
+	(45, 3041, '環境再生装置', UNIX_TIMESTAMP()),
+	(912, 1910, '交通混雑指数', UNIX_TIMESTAMP()),
+	(901, 1899, '音響測定器', UNIX_TIMESTAMP()),
+	(283, 5277, '宇宙衛星通信', UNIX_TIMESTAMP()),
+	(452, 451, '金属加工装置', UNIX_TIMESTAMP()),
+	(60, 3056, '医療画像解析', UNIX_TIMESTAMP()),
+	(270, 5264, '電力供給安定化', UNIX_TIMESTAMP()),
+	(33, 5027, '航空機燃費改善装置', UNIX_TIMESTAMP()),
+	(353, 4348, '地下水資源評価', UNIX_TIMESTAMP()),
+	(459, 3455, '素材強度試験装置', UNIX_TIMESTAMP()),
+	(377, 2374, '原子震動子', UNIX_TIMESTAMP()),
+	(825, 6818, '精神匹配計算機', UNIX_TIMESTAMP()),
+	(468, 7460, '位相反転装置', UNIX_TIMESTAMP()),
+	(179, 2176, '集合共振装置', UNIX_TIMESTAMP()),
+	(418, 417, '心霊共鳴器', UNIX_TIMESTAMP()),
+	(32, 31, '一瞬穿越装置', UNIX_TIMESTAMP()),
+	(406, 405, '音波音楽尺', UNIX_TIMESTAMP()),
+	(142, 4137, '情報構築統合装置', UNIX_TIMESTAMP()),
+	(400, 4395, '思考波増幅器', UNIX_TIMESTAMP()),
+	(46, 5040, '未来予知機', UNIX_TIMESTAMP()),
+	(834, 5828, '機械巡回体', UNIX_TIMESTAMP()),
+	(478, 7470, '生体情報再生装置', UNIX_TIMESTAMP()),
+	(281, 7273, '三次元情報画素', UNIX_TIMESTAMP()),
+	(564, 3560, '悪魔化合物', UNIX_TIMESTAMP()),
+	(437, 4432, '電磁複合体', UNIX_TIMESTAMP()),
+	(593, 3589, '大醤油合成', UNIX_TIMESTAMP()),
+	(439, 7431, '神経軌跡分析', UNIX_TIMESTAMP()),
+	(555, 6548, '風磁場制御', UNIX_TIMESTAMP()),
+	(646, 2643, '時空断面構築', UNIX_TIMESTAMP()),
+	(32, 6025, '分子破壊光線', UNIX_TIMESTAMP()),
+	(406, 4401, '精神感応装置', UNIX_TIMESTAMP()),
+	(360, 1358, '発火中央制御', UNIX_TIMESTAMP()),
+	(320, 1318, '元素反応速度', UNIX_TIMESTAMP()),
+	(594, 2591, '固体磁力位相', UNIX_TIMESTAMP()),
+	(192, 4187, '逐次探索回路', UNIX_TIMESTAMP()),
+	(191, 5185, '電子軌道配列', UNIX_TIMESTAMP()),
+	(437, 4432, '星間振動共鳴', UNIX_TIMESTAMP()),
+	(405, 2402, '超伝導量子計算', UNIX_TIMESTAMP()),
+	(296, 4291, '物質相転移', UNIX_TIMESTAMP()),
+	(503, 1501, '石膏模型組織切片', UNIX_TIMESTAMP()),
+	(873, 1871, '透鏡植物培養', UNIX_TIMESTAMP()),
+	(937, 1935, '心理扇動装置', UNIX_TIMESTAMP()),
+	(816, 6809, '絶縁体加工装置', UNIX_TIMESTAMP()),
+	(816, 2813, '星光分子研究', UNIX_TIMESTAMP()),
+	(990, 4985, '霧粒子除去装置', UNIX_TIMESTAMP()),
+	(636, 2633, '光沢形態分析', UNIX_TIMESTAMP()),
+	(669, 6662, '気象風化指数', UNIX_TIMESTAMP()),
+	(635, 4630, '地震波振幅解析', UNIX_TIMESTAMP()),
+	(841, 6834, '電界行動計測器', UNIX_TIMESTAMP()),
+	(17, 16, '超音波群遅延評価', UNIX_TIMESTAMP()),
+	(917, 6910, '核分裂加速器励起', UNIX_TIMESTAMP()),
+	(542, 4537, '社會心理學', UNIX_TIMESTAMP()),
+	(758, 4753, '視覺藝術學', UNIX_TIMESTAMP()),
+	(231, 5225, '表演藝術學', UNIX_TIMESTAMP()),
+	(195, 4190, '心理治療學', UNIX_TIMESTAMP()),
+	(637, 2634, '認知神經科學', UNIX_TIMESTAMP()),
+	(51, 1049, '語言治療學', UNIX_TIMESTAMP()),
+	(78, 3074, '超音速軌道', UNIX_TIMESTAMP()),
+	(305, 3301, '液状熱輸送', UNIX_TIMESTAMP()),
+	(15, 7007, '半伏電子炭', UNIX_TIMESTAMP()),
+	(167, 166, '分子再構築', UNIX_TIMESTAMP()),
+	(957, 3953, '情報衛星皮膜', UNIX_TIMESTAMP()),
+	(530, 4525, '行動劣化装置', UNIX_TIMESTAMP()),
+	(892, 1890, '超音波解析', UNIX_TIMESTAMP()),
+	(908, 1906, '意識拡張装置', UNIX_TIMESTAMP()),
+	(60, 6053, '遺伝子再配列', UNIX_TIMESTAMP()),
+	(836, 835, '視神経接続', UNIX_TIMESTAMP()),
+	(448, 6441, '超高速位相制御', UNIX_TIMESTAMP()),
+	(10, 3006, '線維生合成', UNIX_TIMESTAMP()),
+	(940, 939, '宇宙物質捕捉', UNIX_TIMESTAMP()),
+	(490, 489, '解離誘起遷移', UNIX_TIMESTAMP()),
+	(693, 1691, '萬華鏡方式', UNIX_TIMESTAMP()),
+	(993, 1991, '真空相転移', UNIX_TIMESTAMP()),
+	(550, 6543, '宇宙飛行災害', UNIX_TIMESTAMP()),
+	(735, 5729, '水晶体反転', UNIX_TIMESTAMP()),
+	(236, 3232, '遺伝子組換代謝', UNIX_TIMESTAMP()),
+	(182, 1180, '無機有機化合物転換', UNIX_TIMESTAMP()),
+	(919, 3915, '複利複合確率解析', UNIX_TIMESTAMP()),
+	(738, 6731, '微生物転匯', UNIX_TIMESTAMP()),
+	(27, 3023, '高次元拡射', UNIX_TIMESTAMP()),
+	(938, 4933, '妖力固縛器', UNIX_TIMESTAMP()),
+	(170, 169, '神霊発動機', UNIX_TIMESTAMP()),
+	(403, 6396, '心素密封瓶', UNIX_TIMESTAMP()),
+	(18, 6011, '拡散硬質鋼', UNIX_TIMESTAMP()),
+	(437, 5431, '非対称嵌合', UNIX_TIMESTAMP()),
+	(558, 3554, '蒸気注入装置', UNIX_TIMESTAMP()),
+	(335, 5329, '情報優先行列', UNIX_TIMESTAMP()),
+	(507, 1505, '時空衝撃波', UNIX_TIMESTAMP()),
+	(935, 6928, '高密度風化剤', UNIX_TIMESTAMP()),
+	(628, 6621, '生物圧制御装置', UNIX_TIMESTAMP()),
+	(97, 96, '機械式感覚増幅器', UNIX_TIMESTAMP()),
+	(731, 6724, '磁気反応層', UNIX_TIMESTAMP()),
+	(725, 724, '量子分子結合膜', UNIX_TIMESTAMP()),
+	(405, 6398, '分子再構成装置', UNIX_TIMESTAMP()),
+	(30, 29, '初代形態決定応答体', UNIX_TIMESTAMP()),
+	(856, 3852, '透明断熱反応膜', UNIX_TIMESTAMP()),
+	(806, 4801, '精微測定術', UNIX_TIMESTAMP()),
+	(279, 278, '積分拡張定理', UNIX_TIMESTAMP()),
+	(46, 4041, '反応分類定理', UNIX_TIMESTAMP()),
+	(544, 3540, '鍛造凝固応答', UNIX_TIMESTAMP()),
+	(463, 4458, '共分散逆行列近似', UNIX_TIMESTAMP()),
+	(850, 849, '補完体面微分', UNIX_TIMESTAMP()),
+	(159, 158, '波動方程式近似', UNIX_TIMESTAMP()),
+	(87, 7079, '音声信号解析', UNIX_TIMESTAMP()),
+	(123, 5117, '加工振動制御', UNIX_TIMESTAMP()),
+	(50, 6043, '画像錯視効果', UNIX_TIMESTAMP()),
+	(625, 2622, '構造変形挙動', UNIX_TIMESTAMP()),
+	(801, 4796, '組織創造力指標', UNIX_TIMESTAMP()),
+	(475, 5469, '食文化遺産保護', UNIX_TIMESTAMP()),
+	(436, 4431, '経済成長予測指標', UNIX_TIMESTAMP()),
+	(159, 7151, '交通効率化戦略', UNIX_TIMESTAMP()),
+	(154, 7146, '災害復興支援活動', UNIX_TIMESTAMP()),
+	(99, 4094, '国際安全協力体制', UNIX_TIMESTAMP()),
+	(771, 3767, '産業競争力強化策', UNIX_TIMESTAMP()),
+	(690, 1688, '煙突修繕術', UNIX_TIMESTAMP()),
+	(422, 2419, '熱風機関車', UNIX_TIMESTAMP()),
+	(651, 4646, '枯山水庭園', UNIX_TIMESTAMP()),
+	(112, 7104, '自己組織化', UNIX_TIMESTAMP()),
+	(869, 3865, '量子状態変換', UNIX_TIMESTAMP()),
+	(322, 321, '光拡散反射', UNIX_TIMESTAMP()),
+	(657, 656, '神経回路計算', UNIX_TIMESTAMP()),
+	(995, 994, '経済動向予測', UNIX_TIMESTAMP()),
+	(972, 4967, '未踏領域創造', UNIX_TIMESTAMP()),
+	(762, 761, '宇宙軌道車', UNIX_TIMESTAMP()),
+	(752, 1750, '無重力装置', UNIX_TIMESTAMP()),
+	(441, 3437, '観測微粒子', UNIX_TIMESTAMP()),
+	(296, 1294, '感知能力指数', UNIX_TIMESTAMP()),
+	(665, 6658, '生物進化因子', UNIX_TIMESTAMP()),
+	(227, 1225, '無機物再構造', UNIX_TIMESTAMP()),
+	(380, 379, '呼吸循環器', UNIX_TIMESTAMP()),
+	(618, 1616, '核融合反応器', UNIX_TIMESTAMP()),
+	(110, 3106, '映像情報処理', UNIX_TIMESTAMP()),
+	(973, 972, '集積回路回復機', UNIX_TIMESTAMP()),
+	(131, 1129, '物質変換反応器', UNIX_TIMESTAMP()),
+	(800, 2797, '反射防止加工', UNIX_TIMESTAMP()),
+	(512, 6505, '操作次元化', UNIX_TIMESTAMP()),
+	(273, 5267, '集中思考量', UNIX_TIMESTAMP()),
+	(373, 5367, '行動選択領域', UNIX_TIMESTAMP()),
+	(229, 5223, '知覚調整感度', UNIX_TIMESTAMP()),
+	(954, 2951, '統合情報率', UNIX_TIMESTAMP()),
+	(165, 2162, '実行機能評価', UNIX_TIMESTAMP()),
+	(138, 137, '洞察力指標', UNIX_TIMESTAMP()),
+	(223, 4218, '時間感知度', UNIX_TIMESTAMP()),
+	(493, 3489, '判断推進力', UNIX_TIMESTAMP()),
+	(69, 5063, '講演者共感能力', UNIX_TIMESTAMP()),
+	(579, 6572, '関連対象感度', UNIX_TIMESTAMP()),
+	(126, 7118, '積極的信念力', UNIX_TIMESTAMP()),
+	(185, 5179, '理解加速度', UNIX_TIMESTAMP()),
+	(562, 561, '環境知覚指数', UNIX_TIMESTAMP()),
+	(649, 1647, '生命相互作用', UNIX_TIMESTAMP()),
+	(902, 4897, '粒子加速器', UNIX_TIMESTAMP()),
+	(930, 929, '局所酸素供給', UNIX_TIMESTAMP()),
+	(639, 5633, '波動方程式', UNIX_TIMESTAMP()),
+	(246, 4241, '蒼龍鍼灸術', UNIX_TIMESTAMP()),
+	(188, 4183, '白鷺調合術', UNIX_TIMESTAMP()),
+	(515, 514, '翠葉美容術', UNIX_TIMESTAMP()),
+	(768, 5762, '銀焔鉄鍋術', UNIX_TIMESTAMP()),
+	(580, 5574, '白音雅楽術', UNIX_TIMESTAMP()),
+	(687, 3683, '紫炎煉金術', UNIX_TIMESTAMP()),
+	(851, 3847, '瑪瑙周天術', UNIX_TIMESTAMP()),
+	(792, 5786, '钢铁淬火術', UNIX_TIMESTAMP()),
+	(599, 6592, '翡翠深山術', UNIX_TIMESTAMP()),
+	(451, 1449, '朱雀炎舞術', UNIX_TIMESTAMP()),
+	(634, 5628, '宝石彫金術', UNIX_TIMESTAMP()),
+	(280, 1278, '桜花茶会術', UNIX_TIMESTAMP()),
+	(371, 4366, '白光探知術', UNIX_TIMESTAMP()),
+	(146, 3142, '瑠璃月読術', UNIX_TIMESTAMP()),
+	(816, 5810, '鳳凰睡眠術', UNIX_TIMESTAMP()),
+	(184, 1182, '無言自動化', UNIX_TIMESTAMP()),
+	(596, 6589, '有用害虫対策', UNIX_TIMESTAMP()),
+	(272, 6265, '標伝達制御', UNIX_TIMESTAMP()),
+	(302, 1300, '角六柱構造', UNIX_TIMESTAMP()),
+	(497, 496, '転送収益化', UNIX_TIMESTAMP()),
+	(245, 7237, '意思境界面', UNIX_TIMESTAMP()),
+	(762, 2759, '作業加反言', UNIX_TIMESTAMP()),
+	(158, 157, '星間通信装置', UNIX_TIMESTAMP()),
+	(470, 5464, '蒸気機関分析', UNIX_TIMESTAMP()),
+	(822, 3818, '大気圧縮制御装置', UNIX_TIMESTAMP()),
+	(533, 1531, '地殻構造解析', UNIX_TIMESTAMP()),
+	(991, 990, '大範度群集', UNIX_TIMESTAMP()),
+	(433, 5427, '緩和加速子', UNIX_TIMESTAMP()),
+	(836, 6829, '場所集積素子', UNIX_TIMESTAMP()),
+	(775, 5769, '突然適合素', UNIX_TIMESTAMP()),
+	(820, 6813, '汎用衝撃材', UNIX_TIMESTAMP()),
+	(952, 5946, '腹内発生因子', UNIX_TIMESTAMP()),
+	(482, 4477, '比熱調整管', UNIX_TIMESTAMP()),
+	(470, 4465, '省燃料噴射', UNIX_TIMESTAMP()),
+	(428, 2425, '分子配置静電子', UNIX_TIMESTAMP()),
+	(312, 7304, '等間隔伝達材', UNIX_TIMESTAMP()),
+	(100, 7092, '密植凝着成', UNIX_TIMESTAMP()),
+	(939, 5933, '不可逆相転移', UNIX_TIMESTAMP()),
+	(826, 6819, '薄氷結晶断面', UNIX_TIMESTAMP()),
+	(176, 3172, '機構展示器', UNIX_TIMESTAMP()),
+	(562, 3558, '漂白構造体', UNIX_TIMESTAMP()),
+	(890, 1888, '気化復水循環', UNIX_TIMESTAMP()),
+	(802, 801, '微粒子解析計測装置', UNIX_TIMESTAMP()),
+	(455, 1453, '静電放電抗酸化剤', UNIX_TIMESTAMP()),
+	(391, 390, '次元解析触媒', UNIX_TIMESTAMP()),
+	(910, 4905, '鉄鋼炭素繊維複合材', UNIX_TIMESTAMP()),
+	(9, 1007, '環境保護多層被覆膜', UNIX_TIMESTAMP()),
+	(265, 5259, '高効率太陽電池材料', UNIX_TIMESTAMP()),
+	(672, 5666, '高速輸送静電浮上車両', UNIX_TIMESTAMP()),
+	(700, 3696, '超音波撹拌混合機', UNIX_TIMESTAMP()),
+	(984, 1982, '強力放射線防護材料', UNIX_TIMESTAMP()),
+	(567, 2564, '星界網構築', UNIX_TIMESTAMP()),
+	(720, 6713, '絶対値封式', UNIX_TIMESTAMP()),
+	(368, 3364, '聖魔帝終焉計画', UNIX_TIMESTAMP()),
+	(844, 1842, '宝石合成反応', UNIX_TIMESTAMP()),
+	(3, 4997, '音響拡大抑制装置', UNIX_TIMESTAMP()),
+	(497, 5491, '生体拡散効果', UNIX_TIMESTAMP()),
+	(247, 246, '分光酵素解析', UNIX_TIMESTAMP()),
+	(196, 195, '電波吸収力', UNIX_TIMESTAMP()),
+	(203, 202, '磁化定量分析', UNIX_TIMESTAMP()),
+	(80, 79, '物理曲線制御', UNIX_TIMESTAMP()),
+	(898, 5892, '液体無重力模擬', UNIX_TIMESTAMP()),
+	(687, 1685, '量子行列集積', UNIX_TIMESTAMP()),
+	(568, 567, '超音波円運動制御', UNIX_TIMESTAMP()),
+	(617, 5611, '光触媒分子組織', UNIX_TIMESTAMP()),
+	(708, 6701, '防腐防錆特殊塗装', UNIX_TIMESTAMP()),
+	(886, 6879, '斜階格子結晶構造', UNIX_TIMESTAMP()),
+	(473, 1471, '有機化膜電解質', UNIX_TIMESTAMP()),
+	(694, 6687, '磁気熱誘導発電', UNIX_TIMESTAMP()),
+	(667, 6660, '時空転送装置', UNIX_TIMESTAMP()),
+	(319, 318, '透明光通信', UNIX_TIMESTAMP()),
+	(786, 3782, '心霊遮断装置', UNIX_TIMESTAMP()),
+	(159, 4154, '精神統合医療', UNIX_TIMESTAMP()),
+	(876, 875, '超能力計測装置', UNIX_TIMESTAMP()),
+	(852, 2849, '心理武器開発', UNIX_TIMESTAMP()),
+	(327, 4322, '形態転換術', UNIX_TIMESTAMP()),
+	(772, 6765, '無界粒子加速器', UNIX_TIMESTAMP()),
+	(232, 231, '古代文字解読', UNIX_TIMESTAMP()),
+	(312, 5306, '絶対防御盾', UNIX_TIMESTAMP()),
+	(382, 381, '基本粒子発見', UNIX_TIMESTAMP()),
+	(365, 1363, '結晶構造解析', UNIX_TIMESTAMP()),
+	(562, 1560, '言語史研究', UNIX_TIMESTAMP()),
+	(903, 1901, '磁気応力計測', UNIX_TIMESTAMP()),
+	(322, 3318, '遺伝子分析', UNIX_TIMESTAMP()),
+	(860, 2857, '社会動態解析', UNIX_TIMESTAMP()),
+	(712, 6705, '火山活動予知', UNIX_TIMESTAMP()),
+	(560, 2557, '生体情報処理', UNIX_TIMESTAMP()),
+	(536, 4531, '電波脳駆動装置', UNIX_TIMESTAMP()),
+	(551, 3547, '宇宙精神連合体', UNIX_TIMESTAMP()),
+	(953, 5947, '無重力遺伝子操作', UNIX_TIMESTAMP()),
+	(457, 456, '超高速情報処理器', UNIX_TIMESTAMP()),
+	(401, 4396, '高密度物質変換器', UNIX_TIMESTAMP()),
+	(338, 6331, '次元門推進機', UNIX_TIMESTAMP()),
+	(556, 4551, '非可視光線発生装置', UNIX_TIMESTAMP()),
+	(175, 7167, '空間相対結合器', UNIX_TIMESTAMP()),
+	(877, 5871, '超音波透視装置', UNIX_TIMESTAMP()),
+	(140, 139, '空中浮遊磁気体', UNIX_TIMESTAMP()),
+	(578, 2575, '透明度計算機', UNIX_TIMESTAMP()),
+	(468, 467, '潜在意識計測装置', UNIX_TIMESTAMP()),
+	(14, 13, '情報料金算出装置', UNIX_TIMESTAMP()),
+	(198, 5192, '音響位相逆転装置', UNIX_TIMESTAMP()),
+	(565, 3561, '映像音声同期器', UNIX_TIMESTAMP()),
+	(927, 926, '光熱量推定器', UNIX_TIMESTAMP()),
+	(154, 153, '溶接熱伝導率測定機', UNIX_TIMESTAMP()),
+	(686, 4681, '画像圧縮効率計算器', UNIX_TIMESTAMP()),
+	(7, 6, '血圧安定化装置', UNIX_TIMESTAMP()),
+	(216, 215, '細胞生存率検査装置', UNIX_TIMESTAMP()),
+	(253, 5247, '露光範囲固定装置', UNIX_TIMESTAMP()),
+	(606, 4601, '静的摩擦力推定器', UNIX_TIMESTAMP()),
+	(125, 2122, '経済攻撃術', UNIX_TIMESTAMP()),
+	(211, 2208, '空間転相装置', UNIX_TIMESTAMP()),
+	(187, 1185, '精神共鳴体', UNIX_TIMESTAMP()),
+	(211, 2208, '心拍応答指数', UNIX_TIMESTAMP()),
+	(910, 6903, '記憶合成装置', UNIX_TIMESTAMP()),
+	(265, 264, '時空間歪曲装置', UNIX_TIMESTAMP()),
+	(226, 2223, '光子排斥効果', UNIX_TIMESTAMP()),
+	(185, 7177, '環境応答統計', UNIX_TIMESTAMP()),
+	(281, 2278, '知識解体装置', UNIX_TIMESTAMP()),
+	(616, 2613, '空間危険予知', UNIX_TIMESTAMP()),
+	(608, 4603, '無機物転換', UNIX_TIMESTAMP()),
+	(432, 3428, '空間時網膜', UNIX_TIMESTAMP()),
+	(539, 6532, '生物免疫修復', UNIX_TIMESTAMP()),
+	(344, 343, '反応速度定数', UNIX_TIMESTAMP()),
+	(899, 898, '電磁波多重変調', UNIX_TIMESTAMP()),
+	(488, 487, '圧力酸素照射', UNIX_TIMESTAMP()),
+	(690, 6683, '可視光通信化', UNIX_TIMESTAMP()),
+	(68, 4063, '分子透過脳血管', UNIX_TIMESTAMP()),
+	(920, 2917, '燃料変換効率', UNIX_TIMESTAMP()),
+	(112, 5106, '非安定粒子飛散', UNIX_TIMESTAMP()),
+	(490, 7482, '電子旅行時間', UNIX_TIMESTAMP()),
+	(282, 6275, '彗星角速度', UNIX_TIMESTAMP()),
+	(648, 3644, '物体重力監視', UNIX_TIMESTAMP()),
+	(731, 1729, '遺伝子組織通信', UNIX_TIMESTAMP()),
+	(305, 5299, '光子衝突反応', UNIX_TIMESTAMP()),
+	(318, 3314, '磁気骨髄濃度', UNIX_TIMESTAMP()),
+	(7, 6000, '知覚強化弾', UNIX_TIMESTAMP()),
+	(871, 1869, '多元精神融合', UNIX_TIMESTAMP()),
+	(765, 4760, '生体収録器', UNIX_TIMESTAMP()),
+	(791, 1789, '空気圧電動機', UNIX_TIMESTAMP()),
+	(454, 5448, '常駐反射鏡', UNIX_TIMESTAMP()),
+	(743, 1741, '余計自律衛星', UNIX_TIMESTAMP()),
+	(882, 881, '内部圧力網羅', UNIX_TIMESTAMP()),
+	(994, 6987, '電子冷却振動', UNIX_TIMESTAMP()),
+	(560, 2557, '蒸気動力触媒', UNIX_TIMESTAMP()),
+	(40, 2037, '静脈作業術', UNIX_TIMESTAMP()),
+	(808, 807, '惑星転位機', UNIX_TIMESTAMP()),
+	(300, 299, '精神神経計測器', UNIX_TIMESTAMP()),
+	(468, 2465, '行星界面装置', UNIX_TIMESTAMP()),
+	(913, 3909, '半幻想通信機', UNIX_TIMESTAMP()),
+	(878, 5872, '光速透過装置', UNIX_TIMESTAMP()),
+	(229, 4224, '量子加速器', UNIX_TIMESTAMP()),
+	(105, 2102, '電磁干渉装置', UNIX_TIMESTAMP()),
+	(873, 2870, '時空連結装置', UNIX_TIMESTAMP()),
+	(545, 4540, '生体可変装置', UNIX_TIMESTAMP()),
+	(513, 3509, '物質精製装置', UNIX_TIMESTAMP()),
+	(709, 3705, '超音波振動子', UNIX_TIMESTAMP()),
+	(774, 773, '偏光顕微鏡', UNIX_TIMESTAMP()),
+	(228, 3224, '非線形回帰模型', UNIX_TIMESTAMP()),
+	(304, 303, '朱雀門東里', UNIX_TIMESTAMP()),
+	(492, 3488, '経済絶対量', UNIX_TIMESTAMP()),
+	(794, 6787, '磁気共鳴装置', UNIX_TIMESTAMP()),
+	(162, 1160, '超高速物質合成', UNIX_TIMESTAMP()),
+	(606, 6599, '複合形態分析', UNIX_TIMESTAMP()),
+	(831, 6824, '熱核融合反応器', UNIX_TIMESTAMP()),
+	(529, 6522, '超次元相転移', UNIX_TIMESTAMP()),
+	(94, 5088, '神聖精神波動', UNIX_TIMESTAMP()),
+	(479, 6472, '最適化分子合成', UNIX_TIMESTAMP()),
+	(788, 3784, '情報潜在体', UNIX_TIMESTAMP()),
+	(159, 1157, '時間空間複合体', UNIX_TIMESTAMP()),
+	(251, 2248, '蒸気煉金燃焼', UNIX_TIMESTAMP()),
+	(973, 6966, '水中滑走軌道', UNIX_TIMESTAMP()),
+	(782, 1780, '風力逆流発電', UNIX_TIMESTAMP()),
+	(583, 582, '暗黒共鳴現象', UNIX_TIMESTAMP()),
+	(108, 5102, '磁場電子遊離', UNIX_TIMESTAMP()),
+	(943, 6936, '生体示量修復', UNIX_TIMESTAMP()),
+	(734, 6727, '刀剣魔動化', UNIX_TIMESTAMP()),
+	(212, 2209, '電磁停滞環境', UNIX_TIMESTAMP()),
+	(517, 4512, '生物吸音行列', UNIX_TIMESTAMP()),
+	(355, 5349, '有機結晶精製', UNIX_TIMESTAMP()),
+	(574, 4569, '岩石環状振動', UNIX_TIMESTAMP()),
+	(178, 5172, '幻影回折現象', UNIX_TIMESTAMP()),
+	(142, 4137, '音声転換変動', UNIX_TIMESTAMP()),
+	(209, 208, '熱帯低圧嵐形成', UNIX_TIMESTAMP()),
+	(747, 4742, '陽電子消散共鳴', UNIX_TIMESTAMP()),
+	(444, 5438, '海洋重力制御', UNIX_TIMESTAMP()),
+	(905, 3901, '音響脳震盪', UNIX_TIMESTAMP()),
+	(308, 4303, '電子器具機能管理術', UNIX_TIMESTAMP()),
+	(132, 1130, '生物発光反応触媒', UNIX_TIMESTAMP()),
+	(265, 264, '映像表現感情解析器', UNIX_TIMESTAMP()),
+	(656, 3652, '金属合金深層表面処理装置', UNIX_TIMESTAMP()),
+	(225, 4220, '建築構造安全診断装置', UNIX_TIMESTAMP()),
+	(254, 253, '水中通信無線伝送方式', UNIX_TIMESTAMP()),
+	(20, 19, '機械操作自動化装置', UNIX_TIMESTAMP()),
+	(254, 253, '文芸芸術史', UNIX_TIMESTAMP()),
+	(323, 1321, '水晶洞察術', UNIX_TIMESTAMP()),
+	(473, 3469, '文字演算機', UNIX_TIMESTAMP()),
+	(580, 3576, '音波気功術', UNIX_TIMESTAMP()),
+	(207, 6200, '放射物理術', UNIX_TIMESTAMP()),
+	(324, 5318, '龍神対話術', UNIX_TIMESTAMP()),
+	(756, 755, '民俗解明機構', UNIX_TIMESTAMP()),
+	(777, 4772, '感応器医療術', UNIX_TIMESTAMP()),
+	(79, 78, '漢詩武研究', UNIX_TIMESTAMP()),
+	(471, 1469, '木霊文明研究', UNIX_TIMESTAMP()),
+	(597, 596, '金属鑑定術', UNIX_TIMESTAMP()),
+	(685, 5679, '古代薬研究', UNIX_TIMESTAMP()),
+	(930, 4925, '鉱石探知機', UNIX_TIMESTAMP()),
+	(382, 4377, '迷宮修復術', UNIX_TIMESTAMP()),
+	(251, 2248, '精神支援術', UNIX_TIMESTAMP()),
+	(340, 3336, '高周波結晶装置', UNIX_TIMESTAMP()),
+	(69, 2066, '逆電子工程', UNIX_TIMESTAMP()),
+	(515, 2512, '遺伝子構造解析', UNIX_TIMESTAMP()),
+	(745, 2742, '量子相転移現象', UNIX_TIMESTAMP()),
+	(179, 3175, '食品成分分解能力', UNIX_TIMESTAMP()),
+	(219, 218, '血液循環補完装置', UNIX_TIMESTAMP()),
+	(291, 3287, '高感度音響受信器', UNIX_TIMESTAMP()),
+	(158, 3154, '燃料電池反応器', UNIX_TIMESTAMP()),
+	(956, 2953, '小型動力推進器', UNIX_TIMESTAMP()),
+	(205, 2202, '高度空域温熱制御', UNIX_TIMESTAMP()),
+	(979, 978, '昼夜逆転体内時計', UNIX_TIMESTAMP()),
+	(772, 2769, '睡眠座椅子', UNIX_TIMESTAMP()),
+	(660, 2657, '悪霊捕獲器', UNIX_TIMESTAMP()),
+	(484, 483, '影分身投影', UNIX_TIMESTAMP()),
+	(239, 2236, '龍神殲滅矢', UNIX_TIMESTAMP()),
+	(953, 5947, '幽体移動術', UNIX_TIMESTAMP()),
+	(88, 6081, '元素合成研究', UNIX_TIMESTAMP()),
+	(311, 3307, '超光波暗号', UNIX_TIMESTAMP()),
+	(773, 2770, '天地通連理', UNIX_TIMESTAMP()),
+	(180, 7172, '幽閉生体研究', UNIX_TIMESTAMP()),
+	(71, 2068, '歴史時間軸', UNIX_TIMESTAMP()),
+	(378, 4373, '放射授精装置', UNIX_TIMESTAMP()),
+	(475, 1473, '超音速鉄道', UNIX_TIMESTAMP()),
+	(597, 3593, '心理再編成装置', UNIX_TIMESTAMP()),
+	(136, 1134, '生体拡張装置', UNIX_TIMESTAMP()),
+	(893, 892, '無量光譜計', UNIX_TIMESTAMP()),
+	(74, 7066, '時空歪曲装置', UNIX_TIMESTAMP()),
+	(536, 1534, '高分子合成装置', UNIX_TIMESTAMP()),
+	(448, 5442, '無気力思考支援装置', UNIX_TIMESTAMP()),
+	(90, 4085, '磁場生成器', UNIX_TIMESTAMP()),
+	(440, 1438, '財務損益計算書', UNIX_TIMESTAMP()),
+	(125, 1123, '契約的責任', UNIX_TIMESTAMP()),
+	(982, 4977, '衆議院選挙', UNIX_TIMESTAMP()),
+	(430, 3426, '意識操作術', UNIX_TIMESTAMP()),
+	(851, 5845, '兵器分子化', UNIX_TIMESTAMP()),
+	(190, 4185, '地球資源生態', UNIX_TIMESTAMP()),
+	(748, 6741, '超能力増幅器', UNIX_TIMESTAMP()),
+	(638, 5632, '文化遺産転移', UNIX_TIMESTAMP()),
+	(736, 6729, '生命創生分野', UNIX_TIMESTAMP()),
+	(481, 3477, '財務機関認証', UNIX_TIMESTAMP()),
+	(730, 2727, '含塩防音材', UNIX_TIMESTAMP()),
+	(65, 5059, '灼葬研究会', UNIX_TIMESTAMP()),
+	(4, 2001, '星間幕検知器', UNIX_TIMESTAMP()),
+	(789, 4784, '魔動反射装置', UNIX_TIMESTAMP()),
+	(530, 1528, '念力貯蔵装置', UNIX_TIMESTAMP()),
+	(879, 1877, '時空逆行装置', UNIX_TIMESTAMP()),
+	(948, 1946, '音波忍者訓練器', UNIX_TIMESTAMP()),
+	(81, 7073, '真空極端冷凍機', UNIX_TIMESTAMP()),
+	(646, 6639, '妖精器深層解析', UNIX_TIMESTAMP()),
+	(642, 3638, '幻想空間紋章', UNIX_TIMESTAMP()),
+	(444, 1442, '幽体回路調整機', UNIX_TIMESTAMP()),
+	(94, 3090, '超融合合金素材', UNIX_TIMESTAMP()),
+	(528, 5522, '神聖魔封印網', UNIX_TIMESTAMP()),
+	(913, 3909, '幽世界霊域測定機', UNIX_TIMESTAMP()),
+	(148, 147, '超時空輪舞装置', UNIX_TIMESTAMP()),
+	(851, 6844, '魔力障壁発動装置', UNIX_TIMESTAMP()),
+	(67, 4062, '遺伝情報総調査', UNIX_TIMESTAMP()),
+	(978, 6971, '感覚情報融合', UNIX_TIMESTAMP()),
+	(644, 1642, '輻輳症候群', UNIX_TIMESTAMP()),
+	(469, 6462, '時空間操舵', UNIX_TIMESTAMP()),
+	(86, 6079, '隠匿行動種', UNIX_TIMESTAMP()),
+	(408, 6401, '液状解明丸', UNIX_TIMESTAMP()),
+	(478, 7470, '精巧化珍種', UNIX_TIMESTAMP()),
+	(356, 7348, '思念共振装置', UNIX_TIMESTAMP()),
+	(760, 6753, '燃料注入器', UNIX_TIMESTAMP()),
+	(724, 6717, '機械剪断器', UNIX_TIMESTAMP()),
+	(321, 3317, '高速揚力係数', UNIX_TIMESTAMP()),
+	(296, 7288, '経済循環指数', UNIX_TIMESTAMP()),
+	(790, 2787, '上昇型気象', UNIX_TIMESTAMP()),
+	(825, 3821, '疑似心象現象', UNIX_TIMESTAMP()),
+	(696, 6689, '蒸気機関車', UNIX_TIMESTAMP()),
+	(123, 2120, '衝突回避装置', UNIX_TIMESTAMP()),
+	(500, 5494, '電子束加速器', UNIX_TIMESTAMP()),
+	(522, 5516, '微細表面構造', UNIX_TIMESTAMP()),
+	(226, 2223, '高密度導電板', UNIX_TIMESTAMP()),
+	(186, 5180, '電気火花放電装置', UNIX_TIMESTAMP()),
+	(104, 103, '音波抗菌撹拌器', UNIX_TIMESTAMP()),
+	(996, 2993, '経済競争力指標', UNIX_TIMESTAMP()),
+	(656, 1654, '電磁波無効化装置', UNIX_TIMESTAMP()),
+	(771, 770, '基因再結合', UNIX_TIMESTAMP()),
+	(831, 6824, '魔力中継体', UNIX_TIMESTAMP()),
+	(157, 1155, '幻光放射機', UNIX_TIMESTAMP()),
+	(697, 696, '生命積層体', UNIX_TIMESTAMP()),
+	(649, 2646, '時空結界網', UNIX_TIMESTAMP()),
+	(270, 7262, '霊気培養槽', UNIX_TIMESTAMP()),
+	(612, 2609, '心理透視眼', UNIX_TIMESTAMP()),
+	(393, 6386, '情報応酬転送装置', UNIX_TIMESTAMP()),
+	(403, 5397, '知識集合体', UNIX_TIMESTAMP()),
+	(213, 1211, '量子干渉鏡', UNIX_TIMESTAMP()),
+	(756, 2753, '歴史再構成装置', UNIX_TIMESTAMP()),
+	(457, 3453, '超極限装甲', UNIX_TIMESTAMP()),
+	(773, 2770, '情報超融合網', UNIX_TIMESTAMP()),
+	(957, 6950, '物質再構成装置', UNIX_TIMESTAMP()),
+	(440, 3436, '知覚情報取得機', UNIX_TIMESTAMP()),
+	(685, 6678, '精神触媒液', UNIX_TIMESTAMP()),
+	(253, 7245, '星界望遠鏡', UNIX_TIMESTAMP()),
+	(358, 6351, '空中微粒子', UNIX_TIMESTAMP()),
+	(660, 2657, '絶対鑑定符', UNIX_TIMESTAMP()),
+	(810, 2807, '生化情報網', UNIX_TIMESTAMP()),
+	(738, 5732, '超禁物元素', UNIX_TIMESTAMP()),
+	(142, 141, '記憶回廊装置', UNIX_TIMESTAMP()),
+	(275, 274, '情報悪化因子', UNIX_TIMESTAMP()),
+	(193, 7185, '共振協同器', UNIX_TIMESTAMP()),
+	(701, 6694, '認識限界領域', UNIX_TIMESTAMP()),
+	(71, 4066, '生命因果連鎖', UNIX_TIMESTAMP()),
+	(933, 3929, '視覚情報解析機', UNIX_TIMESTAMP()),
+	(986, 985, '創造欺瞞装置', UNIX_TIMESTAMP()),
+	(648, 6641, '知識強制執行装置', UNIX_TIMESTAMP()),
+	(233, 7225, '力場融合結晶', UNIX_TIMESTAMP()),
+	(961, 3957, '心霊波動共鳴装置', UNIX_TIMESTAMP()),
+	(142, 1140, '物質変換加速器', UNIX_TIMESTAMP()),
+	(419, 418, '仮想現実疑似体験機', UNIX_TIMESTAMP()),
+	(322, 7314, '時空断片生成機', UNIX_TIMESTAMP()),
+	(813, 4808, '現実構造暴露装置', UNIX_TIMESTAMP()),
+	(345, 7337, '磁気干渉機器', UNIX_TIMESTAMP()),
+	(517, 4512, '空中浮揚装置', UNIX_TIMESTAMP()),
+	(706, 3702, '空間音響剤', UNIX_TIMESTAMP()),
+	(554, 2551, '因果分析器', UNIX_TIMESTAMP()),
+	(372, 1370, '心理映像録器', UNIX_TIMESTAMP()),
+	(388, 7380, '暗号解読器', UNIX_TIMESTAMP()),
+	(269, 6262, '量子加速器', UNIX_TIMESTAMP()),
+	(203, 4198, '生体振動計', UNIX_TIMESTAMP()),
+	(891, 2888, '遺伝情報採集器', UNIX_TIMESTAMP()),
+	(979, 5973, '無重力補助機', UNIX_TIMESTAMP()),
+	(436, 435, '無線給電器', UNIX_TIMESTAMP()),
+	(677, 6670, '原子核融合炉', UNIX_TIMESTAMP()),
+	(853, 6846, '超短波探知機', UNIX_TIMESTAMP()),
+	(916, 5910, '形態変換装置', UNIX_TIMESTAMP()),
+	(52, 51, '漢字録感知', UNIX_TIMESTAMP()),
+	(652, 3648, '社会層位演算', UNIX_TIMESTAMP()),
+	(569, 568, '炉心再構成装置', UNIX_TIMESTAMP()),
+	(780, 2777, '超電導反射鏡', UNIX_TIMESTAMP()),
+	(99, 3095, '熱帯生態系保護区', UNIX_TIMESTAMP()),
+	(343, 5337, '空中含浸合成材料', UNIX_TIMESTAMP()),
+	(174, 4169, '宇宙微粒子捕集装置', UNIX_TIMESTAMP()),
+	(860, 3856, '自動水質調整装置', UNIX_TIMESTAMP()),
+	(743, 6736, '惑星磁気圏探査機', UNIX_TIMESTAMP()),
+	(862, 3858, '空中生命維持装置', UNIX_TIMESTAMP()),
+	(527, 5521, '機械風防帯', UNIX_TIMESTAMP()),
+	(929, 6922, '加速種産生装置', UNIX_TIMESTAMP()),
+	(115, 4110, '超音速分子軌道計算', UNIX_TIMESTAMP()),
+	(966, 3962, '天体遠隔制御装置', UNIX_TIMESTAMP()),
+	(905, 2902, '心理視覚思考分析器', UNIX_TIMESTAMP()),
+	(266, 7258, '音楽感染力指標', UNIX_TIMESTAMP()),
+	(336, 335, '工業設計処理能力指数', UNIX_TIMESTAMP()),
+	(919, 6912, '生体光合成光効率評価', UNIX_TIMESTAMP()),
+	(693, 692, '仮想現実化', UNIX_TIMESTAMP()),
+	(23, 2020, '光波微分方程式', UNIX_TIMESTAMP()),
+	(285, 3281, '超重力崩壊', UNIX_TIMESTAMP()),
+	(569, 3565, '電子分子結合', UNIX_TIMESTAMP()),
+	(605, 3601, '爆風衝撃波', UNIX_TIMESTAMP()),
+	(265, 6258, '神経回路対消滅', UNIX_TIMESTAMP()),
+	(918, 2915, '生物進化逆転', UNIX_TIMESTAMP()),
+	(297, 4292, '無重力状態', UNIX_TIMESTAMP()),
+	(192, 4187, '鉱石精製反応', UNIX_TIMESTAMP()),
+	(255, 1253, '戦術隠匿装置', UNIX_TIMESTAMP()),
+	(287, 1285, '超音速降下哨', UNIX_TIMESTAMP()),
+	(32, 1030, '摩擦温度計', UNIX_TIMESTAMP()),
+	(436, 4431, '万能解読器', UNIX_TIMESTAMP()),
+	(507, 4502, '色彩変換鏡', UNIX_TIMESTAMP()),
+	(659, 5653, '霊感索敵装置', UNIX_TIMESTAMP()),
+	(263, 3259, '曲率機能液晶', UNIX_TIMESTAMP()),
+	(613, 1611, '記憶喪失治療装置', UNIX_TIMESTAMP()),
+	(312, 2309, '地震透過観測器', UNIX_TIMESTAMP()),
+	(969, 3965, '静電気繊維素材', UNIX_TIMESTAMP()),
+	(404, 403, '高出力魚雷発射機', UNIX_TIMESTAMP()),
+	(377, 2374, '非可逆時間認識装置', UNIX_TIMESTAMP()),
+	(994, 6987, '超伝導光導波路', UNIX_TIMESTAMP()),
+	(37, 2034, '創造的脳神経思考', UNIX_TIMESTAMP()),
+	(70, 3066, '非結合高年度石氷素', UNIX_TIMESTAMP()),
+	(248, 2245, '内消失形分容器', UNIX_TIMESTAMP()),
+	(147, 1145, '逐次共波塊膠質化', UNIX_TIMESTAMP()),
+	(551, 5545, '写像化対数衡度', UNIX_TIMESTAMP()),
+	(494, 3490, '減速結晶領域', UNIX_TIMESTAMP()),
+	(273, 5267, '保持許容界', UNIX_TIMESTAMP()),
+	(937, 936, '残存脅果吸力', UNIX_TIMESTAMP()),
+	(479, 5473, '行間除菌処理', UNIX_TIMESTAMP()),
+	(458, 5452, '比較次化曲情報率', UNIX_TIMESTAMP()),
+	(880, 6873, '抑圧微減録比', UNIX_TIMESTAMP()),
+	(181, 3177, '維持絶強度', UNIX_TIMESTAMP()),
+	(170, 169, '心電図解析器', UNIX_TIMESTAMP()),
+	(827, 4822, '呼吸音分析器', UNIX_TIMESTAMP()),
+	(318, 6311, '天体観測望遠鏡', UNIX_TIMESTAMP()),
+	(460, 4455, '精神分析医療装置', UNIX_TIMESTAMP()),
+	(249, 6242, '産業廃棄物処理装置', UNIX_TIMESTAMP()),
+	(104, 103, '動物行動解析装置', UNIX_TIMESTAMP()),
+	(494, 6487, '地球磁場計測装置', UNIX_TIMESTAMP()),
+	(211, 6204, '構造材料破壊試験機', UNIX_TIMESTAMP()),
+	(56, 1054, '飛行物体自動警戒監視器', UNIX_TIMESTAMP()),
+	(299, 1297, '気泡構造体', UNIX_TIMESTAMP()),
+	(132, 2129, '蛍光検知素子', UNIX_TIMESTAMP()),
+	(824, 823, '星塵加速器', UNIX_TIMESTAMP()),
+	(687, 1685, '空気屈折体', UNIX_TIMESTAMP()),
+	(409, 1407, '蒸気液化装置', UNIX_TIMESTAMP()),
+	(127, 7119, '生体電位計', UNIX_TIMESTAMP()),
+	(911, 1909, '影像形成器', UNIX_TIMESTAMP()),
+	(142, 5136, '海底地殻探査機', UNIX_TIMESTAMP()),
+	(322, 321, '筋電位分析器', UNIX_TIMESTAMP()),
+	(73, 5067, '脳抑制装置', UNIX_TIMESTAMP()),
+	(564, 563, '地磁気通信器', UNIX_TIMESTAMP()),
+	(385, 384, '反響応答計', UNIX_TIMESTAMP()),
+	(129, 7121, '水中露光撮影機', UNIX_TIMESTAMP()),
+	(19, 7011, '心理温度計', UNIX_TIMESTAMP()),
+	(703, 2700, '音波反射鏡', UNIX_TIMESTAMP()),
+	(771, 2768, '地底溶岩研究', UNIX_TIMESTAMP()),
+	(439, 6432, '睡眠量定量器', UNIX_TIMESTAMP()),
+	(848, 6841, '水銀融点温度計', UNIX_TIMESTAMP()),
+	(70, 1068, '化粧品接着剤', UNIX_TIMESTAMP()),
+	(868, 4863, '心臓時間計', UNIX_TIMESTAMP()),
+	(520, 4515, '地球引力計', UNIX_TIMESTAMP()),
+	(279, 5273, '家具静電対策', UNIX_TIMESTAMP()),
+	(419, 2416, '音響計測器', UNIX_TIMESTAMP()),
+	(726, 4721, '空間体温計', UNIX_TIMESTAMP()),
+	(106, 3102, '脳神経活動研究', UNIX_TIMESTAMP()),
+	(134, 6127, '社会心理分析', UNIX_TIMESTAMP()),
+	(638, 6631, '音響状態推定', UNIX_TIMESTAMP()),
+	(221, 5215, '自然資源管理', UNIX_TIMESTAMP()),
+	(316, 2313, '遺伝子多型解析', UNIX_TIMESTAMP()),
+	(787, 2784, '経済生産力指数', UNIX_TIMESTAMP()),
+	(654, 3650, '森林環境保護', UNIX_TIMESTAMP()),
+	(255, 3251, '歴史文化遺産保護', UNIX_TIMESTAMP()),
+	(295, 2292, '研究倫理規範', UNIX_TIMESTAMP()),
+	(205, 7197, '星界探査衛星', UNIX_TIMESTAMP()),
+	(437, 1435, '古訓思索術', UNIX_TIMESTAMP()),
+	(207, 206, '稲作運験経済', UNIX_TIMESTAMP()),
+	(57, 4052, '心理選択機構', UNIX_TIMESTAMP()),
+	(935, 1933, '知識階層構造化', UNIX_TIMESTAMP()),
+	(821, 5815, '神社景観計画', UNIX_TIMESTAMP()),
+	(438, 7430, '言語類似度評価', UNIX_TIMESTAMP()),
+	(74, 6067, '宇宙微生物研究', UNIX_TIMESTAMP()),
+	(346, 6339, '高密度農業施設', UNIX_TIMESTAMP()),
+	(352, 3348, '地震誘発効果予測', UNIX_TIMESTAMP()),
+	(75, 2072, '火砕流指示基盤', UNIX_TIMESTAMP()),
+	(584, 6577, '航空燃料復元器', UNIX_TIMESTAMP()),
+	(770, 2767, '自動土石流検知装置', UNIX_TIMESTAMP()),
+	(2, 2998, '檜皮病早期警戒装置', UNIX_TIMESTAMP()),
+	(320, 5314, '非破壊崩壊検査装置', UNIX_TIMESTAMP()),
+	(856, 2853, '微生物脱窒装置', UNIX_TIMESTAMP()),
+	(776, 3772, '樹木自動成長監視器', UNIX_TIMESTAMP()),
+	(748, 2745, '超音速角膜', UNIX_TIMESTAMP()),
+	(162, 2159, '目覚鳴放電', UNIX_TIMESTAMP()),
+	(822, 1820, '音速蓄電球', UNIX_TIMESTAMP()),
+	(341, 4336, '神話錠治療', UNIX_TIMESTAMP()),
+	(641, 6634, '磁気浮上器', UNIX_TIMESTAMP()),
+	(714, 3710, '潜在連結素子', UNIX_TIMESTAMP()),
+	(998, 3994, '超音速安定化装置', UNIX_TIMESTAMP()),
+	(889, 6882, '透過化合物', UNIX_TIMESTAMP()),
+	(471, 4466, '衝突避難船', UNIX_TIMESTAMP()),
+	(353, 6346, '放射線予知機', UNIX_TIMESTAMP()),
+	(238, 5232, '積極融合材料', UNIX_TIMESTAMP()),
+	(978, 977, '無重力制御', UNIX_TIMESTAMP()),
+	(226, 1224, '完全抗菌繊維', UNIX_TIMESTAMP()),
+	(50, 1048, '爆震制御装置', UNIX_TIMESTAMP()),
+	(682, 681, '十二単現宝珠', UNIX_TIMESTAMP()),
+	(12, 2009, '超音波軌道制御', UNIX_TIMESTAMP()),
+	(62, 7054, '水素核融合', UNIX_TIMESTAMP()),
+	(94, 5088, '粒子加速器', UNIX_TIMESTAMP()),
+	(790, 2787, '電子顕微鏡', UNIX_TIMESTAMP()),
+	(832, 2829, '遺伝子操作', UNIX_TIMESTAMP()),
+	(39, 1037, '無重力環境', UNIX_TIMESTAMP()),
+	(345, 6338, '原子結合陣', UNIX_TIMESTAMP()),
+	(634, 3630, '欲望拡大装置', UNIX_TIMESTAMP()),
+	(100, 3096, '超次元暗号', UNIX_TIMESTAMP()),
+	(587, 2584, '巨大粒子加速器', UNIX_TIMESTAMP()),
+	(694, 5688, '音波共鳴制御', UNIX_TIMESTAMP()),
+	(919, 3915, '空気水研究', UNIX_TIMESTAMP()),
+	(542, 2539, '無重力磁気浮上', UNIX_TIMESTAMP()),
+	(214, 2211, '高度圧縮質素材', UNIX_TIMESTAMP()),
+	(897, 896, '透明光波鏡', UNIX_TIMESTAMP()),
+	(983, 2980, '風力発電機', UNIX_TIMESTAMP()),
+	(329, 5323, '穀物乾燥機', UNIX_TIMESTAMP()),
+	(697, 3693, '鉄道車両制御装置', UNIX_TIMESTAMP()),
+	(202, 1200, '血圧安定剤', UNIX_TIMESTAMP()),
+	(637, 6630, '水中探査機', UNIX_TIMESTAMP()),
+	(989, 1987, '音波解析器', UNIX_TIMESTAMP()),
+	(763, 5757, '葉酸添加剤', UNIX_TIMESTAMP()),
+	(654, 1652, '自動運転農業機械', UNIX_TIMESTAMP()),
+	(728, 727, '空気洗浄装置', UNIX_TIMESTAMP()),
+	(772, 771, '高速粉砕機', UNIX_TIMESTAMP()),
+	(738, 3734, '動物行動計測器', UNIX_TIMESTAMP()),
+	(492, 1490, '創造的思考', UNIX_TIMESTAMP()),
+	(942, 3938, '霊気造形術', UNIX_TIMESTAMP()),
+	(559, 4554, '磁気伝導体', UNIX_TIMESTAMP()),
+	(376, 375, '静水圧平衡', UNIX_TIMESTAMP()),
+	(374, 5368, '心理免疫術', UNIX_TIMESTAMP()),
+	(672, 5666, '視硬脳動脈', UNIX_TIMESTAMP()),
+	(936, 1934, '線維成長因子', UNIX_TIMESTAMP()),
+	(624, 5618, '暗号化仕様', UNIX_TIMESTAMP()),
+	(485, 5479, '溢流安定化', UNIX_TIMESTAMP()),
+	(971, 4966, '機械実生産', UNIX_TIMESTAMP()),
+	(781, 1779, '究極連鎖術', UNIX_TIMESTAMP()),
+	(29, 2026, '黒曜石装束', UNIX_TIMESTAMP()),
+	(713, 6706, '可視光通信', UNIX_TIMESTAMP()),
+	(696, 1694, '花唄調律術', UNIX_TIMESTAMP()),
+	(427, 4422, '電磁気転写機', UNIX_TIMESTAMP()),
+	(55, 4050, '結晶凝縮術', UNIX_TIMESTAMP()),
+	(421, 2418, '真理分析数', UNIX_TIMESTAMP()),
+	(406, 2403, '水芸術演算', UNIX_TIMESTAMP()),
+	(264, 7256, '光反射言語', UNIX_TIMESTAMP()),
+	(857, 1855, '記憶潜在相材料', UNIX_TIMESTAMP()),
+	(563, 4558, '脈拍発振装置', UNIX_TIMESTAMP()),
+	(495, 7487, '幻想投影装置', UNIX_TIMESTAMP()),
+	(549, 548, '精神共振量子', UNIX_TIMESTAMP()),
+	(421, 2418, '心霊鮮度計測器', UNIX_TIMESTAMP()),
+	(888, 6881, '生物共振体', UNIX_TIMESTAMP()),
+	(275, 2272, '精神結合装置', UNIX_TIMESTAMP()),
+	(467, 1465, '空間逆転光源', UNIX_TIMESTAMP()),
+	(302, 7294, '量子融針装置', UNIX_TIMESTAMP()),
+	(744, 5738, '形体変形合成器', UNIX_TIMESTAMP()),
+	(237, 4232, '音響干渉反転装置', UNIX_TIMESTAMP()),
+	(936, 5930, '時空連動分光装置', UNIX_TIMESTAMP()),
+	(34, 7026, '逆位相念動共振機構', UNIX_TIMESTAMP()),
+	(894, 5888, '超電磁大爆発生成装置', UNIX_TIMESTAMP()),
+	(412, 411, '酸素再結合光線突起装置', UNIX_TIMESTAMP()),
+	(49, 7041, '空間微分子', UNIX_TIMESTAMP()),
+	(894, 6887, '心象抽象化', UNIX_TIMESTAMP()),
+	(53, 6046, '音響共鳴構造', UNIX_TIMESTAMP()),
+	(422, 3418, '細胞再生因子', UNIX_TIMESTAMP()),
+	(444, 7436, '加速融合装置', UNIX_TIMESTAMP()),
+	(448, 4443, '量子干渉機構', UNIX_TIMESTAMP()),
+	(599, 6592, '偏極光放電器', UNIX_TIMESTAMP()),
+	(341, 6334, '遺伝情報符号化', UNIX_TIMESTAMP()),
+	(119, 1117, '意識拡散反応', UNIX_TIMESTAMP()),
+	(221, 3217, '複合進化遺伝子', UNIX_TIMESTAMP()),
+	(468, 5462, '精神電磁共鳴波', UNIX_TIMESTAMP()),
+	(280, 7272, '時空歪曲装置', UNIX_TIMESTAMP()),
+	(815, 6808, '発光光触媒分子', UNIX_TIMESTAMP()),
+	(971, 2968, '華麗綺羅術', UNIX_TIMESTAMP()),
+	(423, 422, '神秘渓谷探査', UNIX_TIMESTAMP()),
+	(301, 6294, '超次元結晶', UNIX_TIMESTAMP()),
+	(537, 3533, '心理的転位', UNIX_TIMESTAMP()),
+	(798, 1796, '生命維持合金', UNIX_TIMESTAMP()),
+	(659, 2656, '無重力霊気', UNIX_TIMESTAMP()),
+	(42, 41, '昆虫映像通信', UNIX_TIMESTAMP()),
+	(493, 1491, '宇宙影響力', UNIX_TIMESTAMP()),
+	(332, 5326, '振動粒子遮断', UNIX_TIMESTAMP()),
+	(882, 5876, '神秘物体識別', UNIX_TIMESTAMP()),
+	(241, 7233, '共鳴遠隔通信', UNIX_TIMESTAMP()),
+	(244, 3240, '超結晶読心術', UNIX_TIMESTAMP()),
+	(628, 4623, '伝達質子加速', UNIX_TIMESTAMP()),
+	(778, 4773, '冥府共振遮断', UNIX_TIMESTAMP()),
+	(943, 6936, '心象風化加速', UNIX_TIMESTAMP()),
+	(302, 4297, '悪魔遠隔侵食', UNIX_TIMESTAMP()),
+	(41, 2038, '超新星星団解明', UNIX_TIMESTAMP()),
+	(510, 509, '超音波結晶操作', UNIX_TIMESTAMP()),
+	(707, 6700, '天文事象解析', UNIX_TIMESTAMP()),
+	(220, 3216, '転移次元突破', UNIX_TIMESTAMP()),
+	(689, 6682, '放射鎮痛剤', UNIX_TIMESTAMP()),
+	(973, 3969, '万華鏡煙花', UNIX_TIMESTAMP()),
+	(68, 2065, '幻獣降霊術', UNIX_TIMESTAMP()),
+	(43, 1041, '鉱脈探査機', UNIX_TIMESTAMP()),
+	(354, 2351, '空気浄化装置', UNIX_TIMESTAMP()),
+	(622, 3618, '反重力推進器', UNIX_TIMESTAMP()),
+	(996, 1994, '遺伝子書庫', UNIX_TIMESTAMP()),
+	(203, 202, '音響周波数変換器', UNIX_TIMESTAMP()),
+	(646, 2643, '物質分散指数計測器', UNIX_TIMESTAMP()),
+	(678, 677, '生体内組織再生促進剤', UNIX_TIMESTAMP()),
+	(664, 1662, '差圧負荷重視最適制御器', UNIX_TIMESTAMP()),
+	(492, 6485, '高電圧電力供給回路', UNIX_TIMESTAMP()),
+	(418, 5412, '音響共振周波数検出器', UNIX_TIMESTAMP()),
+	(767, 3763, '液体循環冷却装置', UNIX_TIMESTAMP()),
+	(793, 792, '無線信号干渉除去器', UNIX_TIMESTAMP()),
+	(97, 7089, '高速粒子衝突検出器', UNIX_TIMESTAMP()),
+	(477, 6470, '磁場整理誘導器', UNIX_TIMESTAMP()),
+	(334, 6327, '無理解言語', UNIX_TIMESTAMP()),
+	(443, 4438, '能力増幅装置', UNIX_TIMESTAMP()),
+	(171, 170, '意識補正装置', UNIX_TIMESTAMP()),
+	(10, 9, '紀元逆行衝突術', UNIX_TIMESTAMP()),
+	(15, 14, '島津式量子測定器', UNIX_TIMESTAMP()),
+	(481, 4476, '星雲鎖交換装置', UNIX_TIMESTAMP()),
+	(983, 1981, '幻想暗号解読器', UNIX_TIMESTAMP()),
+	(371, 1369, '臨界磁気保存装置', UNIX_TIMESTAMP()),
+	(682, 2679, '空間振動制御器', UNIX_TIMESTAMP()),
+	(239, 6232, '階層光導波解析', UNIX_TIMESTAMP()),
+	(431, 430, '彩光分子構造解析', UNIX_TIMESTAMP()),
+	(653, 6646, '道徳感応言語生成器', UNIX_TIMESTAMP()),
+	(577, 1575, '神経回路端末機', UNIX_TIMESTAMP()),
+	(447, 7439, '高速粒子拡散反応', UNIX_TIMESTAMP()),
+	(666, 5660, '固体気体相転移現象', UNIX_TIMESTAMP()),
+	(257, 5251, '静止大気圧補正機能', UNIX_TIMESTAMP()),
+	(242, 2239, '地殻電気輻射観測器', UNIX_TIMESTAMP()),
+	(793, 2790, '直感第六感', UNIX_TIMESTAMP()),
+	(59, 3055, '言語視覚化', UNIX_TIMESTAMP()),
+	(437, 3433, '脳神経音楽', UNIX_TIMESTAMP()),
+	(896, 5890, '高度生態農業', UNIX_TIMESTAMP()),
+	(424, 423, '精神融合術', UNIX_TIMESTAMP()),
+	(411, 2408, '超古代算術', UNIX_TIMESTAMP()),
+	(3, 4997, '蒼鉱石煉成', UNIX_TIMESTAMP()),
+	(241, 2238, '片手剣拳術', UNIX_TIMESTAMP()),
+	(635, 634, '農業生態計', UNIX_TIMESTAMP()),
+	(770, 5764, '文化継承技芸', UNIX_TIMESTAMP()),
+	(105, 2102, '陽燃電磁粒子加速装置', UNIX_TIMESTAMP()),
+	(357, 2354, '神速念動術', UNIX_TIMESTAMP()),
+	(747, 2744, '未来視脳波抄録機', UNIX_TIMESTAMP()),
+	(492, 2489, '永劫時空歪曲機', UNIX_TIMESTAMP()),
+	(86, 1084, '超意味情報処理装置', UNIX_TIMESTAMP()),
+	(403, 5397, '理念拡散光線銃', UNIX_TIMESTAMP()),
+	(729, 5723, '魔術融合呼吸術', UNIX_TIMESTAMP()),
+	(176, 7168, '概念封印領域創出機', UNIX_TIMESTAMP()),
+	(695, 2692, '心霊感知共鳴装置', UNIX_TIMESTAMP()),
+	(670, 4665, '真相析明暗号解読器', UNIX_TIMESTAMP()),
+	(385, 6378, '心象風読訓練装置', UNIX_TIMESTAMP()),
+	(249, 4244, '心音周波感地図', UNIX_TIMESTAMP()),
+	(224, 3220, '生体半透明光増幅素材', UNIX_TIMESTAMP()),
+	(576, 575, '空間意識鏡面生成装置', UNIX_TIMESTAMP()),
+	(697, 3693, '翡翠碌版本', UNIX_TIMESTAMP()),
+	(790, 2787, '蒼龍錬金術', UNIX_TIMESTAMP()),
+	(772, 5766, '翡翠孔雀舌', UNIX_TIMESTAMP()),
+	(601, 4596, '暗影百鬼夜行', UNIX_TIMESTAMP()),
+	(172, 7164, '植物生長調節剤', UNIX_TIMESTAMP()),
+	(605, 4600, '通貨流通市場分析', UNIX_TIMESTAMP()),
+	(637, 4632, '消費行動心理指標', UNIX_TIMESTAMP()),
+	(482, 481, '臓器移植免疫抑制', UNIX_TIMESTAMP()),
+	(258, 7250, '地質時間尺度', UNIX_TIMESTAMP()),
+	(691, 1689, '写実主義絵画運動', UNIX_TIMESTAMP()),
+	(455, 6448, '抽象表現主義彫刻', UNIX_TIMESTAMP()),
+	(421, 4416, '平行四辺形型器物', UNIX_TIMESTAMP()),
+	(67, 4062, '抽象象形文字', UNIX_TIMESTAMP()),
+	(386, 3382, '直径差分布器', UNIX_TIMESTAMP()),
+	(700, 5694, '無限阻止系', UNIX_TIMESTAMP()),
+	(35, 6028, '金融衝動指数', UNIX_TIMESTAMP()),
+	(401, 3397, '抑圧価値指数', UNIX_TIMESTAMP()),
+	(209, 6202, '解消時間構造', UNIX_TIMESTAMP()),
+	(371, 7363, '媒慈発光効果', UNIX_TIMESTAMP()),
+	(172, 4167, '自律調節構造', UNIX_TIMESTAMP()),
+	(984, 983, '補完層状態', UNIX_TIMESTAMP()),
+	(547, 3543, '火焔式反応装置', UNIX_TIMESTAMP()),
+	(858, 5852, '傾斜遮光系', UNIX_TIMESTAMP()),
+	(252, 6245, '陽冷板効果', UNIX_TIMESTAMP()),
+	(911, 2908, '南向型再生式環境装置', UNIX_TIMESTAMP()),
+	(558, 1556, '機械破壊強度指数', UNIX_TIMESTAMP()),
+	(843, 1841, '同期合成糸状構造体', UNIX_TIMESTAMP()),
+	(219, 7211, '新世代多面体曲面断面設計', UNIX_TIMESTAMP()),
+	(612, 6605, '軌道周波数調整回路', UNIX_TIMESTAMP()),
+	(297, 6290, '巨視的時空振動現象', UNIX_TIMESTAMP()),
+	(110, 4105, '超高速衝撃波振動解析', UNIX_TIMESTAMP()),
+	(712, 1710, '粒子安定器', UNIX_TIMESTAMP()),
+	(582, 4577, '無溶滓合金', UNIX_TIMESTAMP()),
+	(95, 94, '電子空間共有', UNIX_TIMESTAMP()),
+	(445, 3441, '機動治療薬', UNIX_TIMESTAMP()),
+	(286, 7278, '原子動力機', UNIX_TIMESTAMP()),
+	(255, 3251, '波状信号分析', UNIX_TIMESTAMP()),
+	(628, 1626, '精神結晶体', UNIX_TIMESTAMP()),
+	(342, 3338, '蜃気楼変換', UNIX_TIMESTAMP()),
+	(906, 4901, '次元通行器', UNIX_TIMESTAMP()),
+	(267, 2264, '魔力循環装置', UNIX_TIMESTAMP()),
+	(708, 4703, '精神領域探査器', UNIX_TIMESTAMP()),
+	(16, 1014, '悪霊検知器', UNIX_TIMESTAMP()),
+	(475, 1473, '魔術補正装置', UNIX_TIMESTAMP()),
+	(428, 1426, '巨大結晶体', UNIX_TIMESTAMP()),
+	(889, 6882, '時空転移装置', UNIX_TIMESTAMP()),
+	(241, 240, '超重力制御器', UNIX_TIMESTAMP()),
+	(72, 2069, '顕微内視鏡', UNIX_TIMESTAMP()),
+	(259, 6252, '神秘化合物', UNIX_TIMESTAMP()),
+	(141, 1139, '心霊探知機', UNIX_TIMESTAMP()),
+	(780, 779, '幻覚抑制剤', UNIX_TIMESTAMP()),
+	(19, 4014, '透明化装置', UNIX_TIMESTAMP()),
+	(130, 1128, '運命予測器', UNIX_TIMESTAMP()),
+	(965, 5959, '精神疾患予防薬', UNIX_TIMESTAMP()),
+	(799, 5793, '時空歪曲実験', UNIX_TIMESTAMP()),
+	(724, 5718, '生命再生装置', UNIX_TIMESTAMP()),
+	(758, 1756, '量子暗号解読機', UNIX_TIMESTAMP()),
+	(150, 1148, '遺伝子編集装置', UNIX_TIMESTAMP()),
+	(337, 7329, '統合三次元映像圧縮', UNIX_TIMESTAMP()),
+	(895, 2892, '建築構造体画像診断', UNIX_TIMESTAMP()),
+	(77, 4072, '公共政策評価指標', UNIX_TIMESTAMP()),
+	(774, 6767, '言語曲線分析', UNIX_TIMESTAMP()),
+	(315, 314, '飛散粉塵監視装置', UNIX_TIMESTAMP()),
+	(145, 2142, '脳内実体通信', UNIX_TIMESTAMP()),
+	(742, 741, '超音波照射', UNIX_TIMESTAMP()),
+	(35, 4030, '遺伝子操作', UNIX_TIMESTAMP()),
+	(837, 6830, '腸内微生物団', UNIX_TIMESTAMP()),
+	(354, 3350, '自動翻訳機械', UNIX_TIMESTAMP()),
+	(500, 3496, '非現実変動', UNIX_TIMESTAMP()),
+	(359, 1357, '音楽分析素子', UNIX_TIMESTAMP()),
+	(809, 6802, '薬物代謝速度', UNIX_TIMESTAMP()),
+	(804, 2801, '真空融接機', UNIX_TIMESTAMP()),
+	(718, 5712, '海洋観測船', UNIX_TIMESTAMP()),
+	(547, 4542, '光触媒反応器', UNIX_TIMESTAMP()),
+	(258, 2255, '幻覚発生源', UNIX_TIMESTAMP()),
+	(39, 5033, '燃焼評価指標', UNIX_TIMESTAMP()),
+	(257, 6250, '直接回路経路', UNIX_TIMESTAMP()),
+	(185, 1183, '非線形安定領域', UNIX_TIMESTAMP()),
+	(385, 6378, '高熱容量材料', UNIX_TIMESTAMP()),
+	(673, 2670, '地震応答係数', UNIX_TIMESTAMP()),
+	(409, 408, '等方比例則', UNIX_TIMESTAMP()),
+	(16, 7008, '具体流規定', UNIX_TIMESTAMP()),
+	(70, 7062, '表面張力効果', UNIX_TIMESTAMP()),
+	(109, 3105, '露点温度制御', UNIX_TIMESTAMP()),
+	(688, 1686, '反射効果温度', UNIX_TIMESTAMP()),
+	(228, 5222, '有毒成分除去装置', UNIX_TIMESTAMP()),
+	(290, 7282, '円周接触面', UNIX_TIMESTAMP()),
+	(785, 784, '熱電伝導測定装置', UNIX_TIMESTAMP()),
+	(490, 4485, '非可逆機構割合', UNIX_TIMESTAMP()),
+	(509, 508, '軟組織損傷指標', UNIX_TIMESTAMP()),
+	(125, 6118, '逆言語処理', UNIX_TIMESTAMP()),
+	(587, 3583, '水素化鉱物生成', UNIX_TIMESTAMP()),
+	(259, 3255, '意識高度化検知', UNIX_TIMESTAMP()),
+	(901, 6894, '巨大信号収集鋳物', UNIX_TIMESTAMP()),
+	(431, 430, '無重力分子研究', UNIX_TIMESTAMP()),
+	(646, 6639, '心理微動分析', UNIX_TIMESTAMP()),
+	(266, 5260, '技巧遊戯暗号', UNIX_TIMESTAMP()),
+	(257, 4252, '制約反転転送操作', UNIX_TIMESTAMP()),
+	(853, 2850, '不完全意識量子化', UNIX_TIMESTAMP()),
+	(936, 935, '飛行姿勢安定装置', UNIX_TIMESTAMP()),
+	(499, 5493, '心霊感知術', UNIX_TIMESTAMP()),
+	(81, 3077, '時空転移術', UNIX_TIMESTAMP()),
+	(322, 6315, '悪魔調伏術', UNIX_TIMESTAMP()),
+	(245, 5239, '思念操作装置', UNIX_TIMESTAMP()),
+	(631, 5625, '幻覚発生装置', UNIX_TIMESTAMP()),
+	(76, 3072, '超磁力場制御', UNIX_TIMESTAMP()),
+	(30, 5024, '念動力制御装置', UNIX_TIMESTAMP()),
+	(440, 439, '心理空間解析', UNIX_TIMESTAMP()),
+	(988, 4983, '自己再生装置', UNIX_TIMESTAMP()),
+	(721, 3717, '水晶結晶病', UNIX_TIMESTAMP()),
+	(9, 2006, '生体認証装置', UNIX_TIMESTAMP()),
+	(438, 1436, '記憶結晶化', UNIX_TIMESTAMP()),
+	(356, 7348, '情報選別機', UNIX_TIMESTAMP()),
+	(699, 4694, '環境負荷指標', UNIX_TIMESTAMP()),
+	(73, 3069, '複合高分子', UNIX_TIMESTAMP()),
+	(831, 2828, '回転加工器', UNIX_TIMESTAMP()),
+	(599, 2596, '社会圧力解析', UNIX_TIMESTAMP()),
+	(723, 1721, '会議盛況度', UNIX_TIMESTAMP()),
+	(173, 3169, '高濃度有機肥料', UNIX_TIMESTAMP()),
+	(184, 1182, '空間音響測定器', UNIX_TIMESTAMP()),
+	(381, 6374, '調和微分方程式', UNIX_TIMESTAMP()),
+	(910, 1908, '暁気流結晶', UNIX_TIMESTAMP()),
+	(876, 4871, '七星風化石', UNIX_TIMESTAMP()),
+	(122, 4117, '黄昏彩琥珀', UNIX_TIMESTAMP()),
+	(327, 2324, '海王星碧珊瑚', UNIX_TIMESTAMP()),
+	(234, 4229, '薬剤輪収集', UNIX_TIMESTAMP()),
+	(653, 2650, '模擬抽出反応', UNIX_TIMESTAMP()),
+	(163, 2160, '常在窓波数', UNIX_TIMESTAMP()),
+	(402, 3398, '統合遺伝圧', UNIX_TIMESTAMP()),
+	(703, 3699, '漁獲密度広域化', UNIX_TIMESTAMP()),
+	(644, 1642, '高効率破砕剤', UNIX_TIMESTAMP()),
+	(996, 1994, '地衣類相互作用', UNIX_TIMESTAMP()),
+	(133, 5127, '出典相互統制', UNIX_TIMESTAMP()),
+	(518, 1516, '加速腐食機構', UNIX_TIMESTAMP()),
+	(483, 7475, '無音固相限界', UNIX_TIMESTAMP()),
+	(197, 3193, '水竜昇降監視', UNIX_TIMESTAMP()),
+	(310, 3306, '風圧束縛系', UNIX_TIMESTAMP()),
+	(997, 1995, '高再現顕微鏡', UNIX_TIMESTAMP()),
+	(28, 7020, '優位回収染料', UNIX_TIMESTAMP()),
+	(213, 1211, '機能高合成酵素', UNIX_TIMESTAMP()),
+	(260, 5254, '物質吸収率換算', UNIX_TIMESTAMP()),
+	(34, 5028, '情報障害度評価', UNIX_TIMESTAMP()),
+	(362, 6355, '根拠回帰分析', UNIX_TIMESTAMP()),
+	(76, 5070, '投光抵抗握取', UNIX_TIMESTAMP()),
+	(290, 2287, '有深影響物種', UNIX_TIMESTAMP()),
+	(189, 1187, '密封透明断面', UNIX_TIMESTAMP()),
+	(553, 6546, '逆行分化阻害', UNIX_TIMESTAMP()),
+	(663, 5657, '意思関連発振', UNIX_TIMESTAMP()),
+	(656, 2653, '非線形粒子圧縮', UNIX_TIMESTAMP()),
+	(579, 1577, '自律動機能', UNIX_TIMESTAMP()),
+	(99, 4094, '交感抑制効果', UNIX_TIMESTAMP()),
+	(508, 5502, '順応補完能力', UNIX_TIMESTAMP()),
+	(614, 2611, '客観関与思考', UNIX_TIMESTAMP()),
+	(470, 6463, '状態包括制御', UNIX_TIMESTAMP()),
+	(959, 6952, '焦点集中回復現象', UNIX_TIMESTAMP()),
+	(849, 848, '柔軟情報融合', UNIX_TIMESTAMP()),
+	(42, 1040, '意識形成制御', UNIX_TIMESTAMP()),
+	(190, 2187, '記憶再構築曲線', UNIX_TIMESTAMP()),
+	(308, 6301, '知覚応答機', UNIX_TIMESTAMP()),
+	(661, 6654, '近接関心領域', UNIX_TIMESTAMP()),
+	(280, 6273, '間接疑似認知', UNIX_TIMESTAMP()),
+	(45, 5039, '積極技巧化能力', UNIX_TIMESTAMP()),
+	(133, 5127, '行動変容回路', UNIX_TIMESTAMP()),
+	(186, 7178, '永遠錬金術', UNIX_TIMESTAMP()),
+	(454, 3450, '念願保管術', UNIX_TIMESTAMP()),
+	(898, 6891, '紫電特殊戦術', UNIX_TIMESTAMP()),
+	(241, 4236, '霞双角芸術', UNIX_TIMESTAMP()),
+	(541, 3537, '無線生態系計測装置', UNIX_TIMESTAMP()),
+	(190, 3186, '時空間展開装置', UNIX_TIMESTAMP()),
+	(515, 514, '高分子分解加速装置', UNIX_TIMESTAMP()),
+	(571, 2568, '自律航空支援装置', UNIX_TIMESTAMP()),
+	(925, 5919, '熱反射膜原子構造体', UNIX_TIMESTAMP()),
+	(805, 3801, '生体解析断層装置', UNIX_TIMESTAMP()),
+	(681, 5675, '量子情報暗号変換器', UNIX_TIMESTAMP()),
+	(938, 3934, '超伝導光導波路', UNIX_TIMESTAMP()),
+	(301, 4296, '睡眠再生装置', UNIX_TIMESTAMP()),
+	(486, 4481, '量子電子拘束回路', UNIX_TIMESTAMP()),
+	(869, 5863, '星域映像装置', UNIX_TIMESTAMP()),
+	(850, 6843, '魔力逆流装置', UNIX_TIMESTAMP()),
+	(801, 1799, '音波界面制御装置', UNIX_TIMESTAMP()),
+	(100, 7092, '時空裂隙誘引機', UNIX_TIMESTAMP()),
+	(277, 6270, '記憶再構築装置', UNIX_TIMESTAMP()),
+	(96, 95, '精神波遮断装置', UNIX_TIMESTAMP()),
+	(962, 3958, '水晶共振器', UNIX_TIMESTAMP()),
+	(658, 3654, '意識拡張装置', UNIX_TIMESTAMP()),
+	(198, 5192, '反重力航行装置', UNIX_TIMESTAMP()),
+	(342, 341, '伝送門誘導装置', UNIX_TIMESTAMP()),
+	(21, 3017, '生体情報解析機', UNIX_TIMESTAMP()),
+	(671, 6664, '地震制御装置', UNIX_TIMESTAMP()),
+	(585, 584, '量子暗号化装置', UNIX_TIMESTAMP()),
+	(916, 4911, '磁力生成装置', UNIX_TIMESTAMP()),
+	(590, 4585, '情報精製機', UNIX_TIMESTAMP()),
+	(578, 5572, '息吹再生装置', UNIX_TIMESTAMP()),
+	(160, 4155, '重力制御装置', UNIX_TIMESTAMP()),
+	(692, 2689, '火炎放射器', UNIX_TIMESTAMP()),
+	(498, 7490, '投射体追跡装置', UNIX_TIMESTAMP()),
+	(630, 1628, '微粒子加速器', UNIX_TIMESTAMP()),
+	(381, 2378, '意識伝達機械', UNIX_TIMESTAMP()),
+	(805, 804, '時間軸忘却', UNIX_TIMESTAMP()),
+	(337, 1335, '社会認識能力検査', UNIX_TIMESTAMP()),
+	(111, 110, '心理的適応能力', UNIX_TIMESTAMP()),
+	(629, 2626, '廃棄物再生成反応器', UNIX_TIMESTAMP()),
+	(681, 4676, '抑制型量子放射反応装置', UNIX_TIMESTAMP()),
+	(966, 3962, '廃熱再生冷却器', UNIX_TIMESTAMP()),
+	(881, 1879, '高次元斜板構造体', UNIX_TIMESTAMP()),
+	(661, 660, '準同位元素加速器', UNIX_TIMESTAMP()),
+	(401, 3397, '漸進係数推算器', UNIX_TIMESTAMP()),
+	(152, 1150, '非可逆温度変換器', UNIX_TIMESTAMP()),
+	(512, 3508, '磁場振動共鳴増幅器', UNIX_TIMESTAMP()),
+	(170, 3166, '加速腐食試験装置', UNIX_TIMESTAMP()),
+	(344, 343, '椅子パイプ', UNIX_TIMESTAMP());
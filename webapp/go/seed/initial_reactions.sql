@@ -0,0 +1,1003 @@
+INSERT INTO reactions (emoji_name, user_id, livestream_id, created_at)
+VALUES
+	('table_tennis_paddle_and_ball', 855, 5849, UNIX_TIMESTAMP()),
+	('arrow_right', 273, 5267, UNIX_TIMESTAMP()),
+	('six_pointed_star', 90, 5084, UNIX_TIMESTAMP()),
+	('man-getting-massage', 579, 1577, UNIX_TIMESTAMP()),
+	('knot', 934, 6927, UNIX_TIMESTAMP()),
+	('black_small_square', 372, 4367, UNIX_TIMESTAMP()),
+	('flag-sd', 456, 1454, UNIX_TIMESTAMP()),
+	('dagger_knife', 33, 32, UNIX_TIMESTAMP()),
+	('high_heel', 186, 7178, UNIX_TIMESTAMP()),
+	('eye-in-speech-bubble', 912, 1910, UNIX_TIMESTAMP()),
+	('tiger2', 710, 2707, UNIX_TIMESTAMP()),
+	('female-factory-worker', 945, 944, UNIX_TIMESTAMP()),
+	('art', 205, 1203, UNIX_TIMESTAMP()),
+	('female_fairy', 217, 7209, UNIX_TIMESTAMP()),
+	('flag-th', 748, 1746, UNIX_TIMESTAMP()),
+	('golfer', 75, 4070, UNIX_TIMESTAMP()),
+	('left-facing_fist', 312, 311, UNIX_TIMESTAMP()),
+	('face_with_head_bandage', 807, 3803, UNIX_TIMESTAMP()),
+	('six', 720, 5714, UNIX_TIMESTAMP()),
+	('heavy_plus_sign', 417, 3413, UNIX_TIMESTAMP()),
+	('woman-boy', 314, 3310, UNIX_TIMESTAMP()),
+	('necktie', 254, 2251, UNIX_TIMESTAMP()),
+	('fr', 734, 3730, UNIX_TIMESTAMP()),
+	('flag-no', 423, 6416, UNIX_TIMESTAMP()),
+	('airplane_departure', 576, 1574, UNIX_TIMESTAMP()),
+	('bank', 868, 6861, UNIX_TIMESTAMP()),
+	('flag-sx', 329, 328, UNIX_TIMESTAMP()),
+	('coffin', 773, 3769, UNIX_TIMESTAMP()),
+	('last_quarter_moon', 360, 359, UNIX_TIMESTAMP()),
+	('ru', 59, 6052, UNIX_TIMESTAMP()),
+	('male-detective', 436, 2433, UNIX_TIMESTAMP()),
+	('hotsprings', 95, 6088, UNIX_TIMESTAMP()),
+	('table_tennis_paddle_and_ball', 580, 1578, UNIX_TIMESTAMP()),
+	('runner', 243, 242, UNIX_TIMESTAMP()),
+	('child', 453, 5447, UNIX_TIMESTAMP()),
+	('hot_pepper', 640, 1638, UNIX_TIMESTAMP()),
+	('ok', 857, 5851, UNIX_TIMESTAMP()),
+	('relieved', 119, 3115, UNIX_TIMESTAMP()),
+	('ambulance', 550, 549, UNIX_TIMESTAMP()),
+	('low_brightness', 37, 1035, UNIX_TIMESTAMP()),
+	('roller_skate', 627, 5621, UNIX_TIMESTAMP()),
+	('pineapple', 25, 24, UNIX_TIMESTAMP()),
+	('flag-vg', 231, 7223, UNIX_TIMESTAMP()),
+	('flag-rw', 961, 2958, UNIX_TIMESTAMP()),
+	('rhinoceros', 778, 6771, UNIX_TIMESTAMP()),
+	('flag-bo', 860, 2857, UNIX_TIMESTAMP()),
+	('white_frowning_face', 795, 2792, UNIX_TIMESTAMP()),
+	('teapot', 101, 5095, UNIX_TIMESTAMP()),
+	('man_in_motorized_wheelchair', 598, 4593, UNIX_TIMESTAMP()),
+	('male_vampire', 300, 3296, UNIX_TIMESTAMP()),
+	('merman', 225, 5219, UNIX_TIMESTAMP()),
+	('bamboo', 180, 7172, UNIX_TIMESTAMP()),
+	('ice_skate', 485, 7477, UNIX_TIMESTAMP()),
+	('judge', 167, 3163, UNIX_TIMESTAMP()),
+	('woman-raising-hand', 347, 1345, UNIX_TIMESTAMP()),
+	('receipt', 927, 926, UNIX_TIMESTAMP()),
+	('apple', 981, 980, UNIX_TIMESTAMP()),
+	('crossed_flags', 945, 6938, UNIX_TIMESTAMP()),
+	('small_blue_diamond', 822, 1820, UNIX_TIMESTAMP()),
+	('point_up', 704, 6697, UNIX_TIMESTAMP()),
+	('star2', 240, 6233, UNIX_TIMESTAMP()),
+	('flag-ne', 681, 680, UNIX_TIMESTAMP()),
+	('shallow_pan_of_food', 215, 5209, UNIX_TIMESTAMP()),
+	('flag-gp', 638, 3634, UNIX_TIMESTAMP()),
+	('aerial_tramway', 240, 6233, UNIX_TIMESTAMP()),
+	('kiwifruit', 234, 7226, UNIX_TIMESTAMP()),
+	('sparkle', 413, 4408, UNIX_TIMESTAMP()),
+	('stethoscope', 81, 2078, UNIX_TIMESTAMP()),
+	('motorized_wheelchair', 195, 2192, UNIX_TIMESTAMP()),
+	('female-farmer', 291, 5285, UNIX_TIMESTAMP()),
+	('light_rail', 945, 944, UNIX_TIMESTAMP()),
+	('flag-bb', 988, 5982, UNIX_TIMESTAMP()),
+	('exploding_head', 110, 1108, UNIX_TIMESTAMP()),
+	('floppy_disk', 278, 277, UNIX_TIMESTAMP()),
+	('flag-gf', 690, 5684, UNIX_TIMESTAMP()),
+	('female-farmer', 394, 5388, UNIX_TIMESTAMP()),
+	('wind_chime', 710, 709, UNIX_TIMESTAMP()),
+	('person_in_lotus_position', 169, 3165, UNIX_TIMESTAMP()),
+	('raised_hands', 686, 3682, UNIX_TIMESTAMP()),
+	('sa', 638, 1636, UNIX_TIMESTAMP()),
+	('bicyclist', 726, 725, UNIX_TIMESTAMP()),
+	('drooling_face', 460, 6453, UNIX_TIMESTAMP()),
+	('monkey_face', 68, 4063, UNIX_TIMESTAMP()),
+	('female-pilot', 87, 2084, UNIX_TIMESTAMP()),
+	('airplane_arriving', 555, 3551, UNIX_TIMESTAMP()),
+	('flag-az', 186, 3182, UNIX_TIMESTAMP()),
+	('golf', 312, 3308, UNIX_TIMESTAMP()),
+	('mens', 332, 6325, UNIX_TIMESTAMP()),
+	('umbrella_on_ground', 24, 6017, UNIX_TIMESTAMP()),
+	('chart_with_downwards_trend', 598, 2595, UNIX_TIMESTAMP()),
+	('clock830', 47, 6040, UNIX_TIMESTAMP()),
+	('ru', 357, 4352, UNIX_TIMESTAMP()),
+	('mountain', 112, 1110, UNIX_TIMESTAMP()),
+	('bat', 124, 1122, UNIX_TIMESTAMP()),
+	('cry', 482, 7474, UNIX_TIMESTAMP()),
+	('arrow_lower_right', 3, 2000, UNIX_TIMESTAMP()),
+	('pretzel', 298, 3294, UNIX_TIMESTAMP()),
+	('information_desk_person', 342, 6335, UNIX_TIMESTAMP()),
+	('tired_face', 982, 3978, UNIX_TIMESTAMP()),
+	('icecream', 218, 6211, UNIX_TIMESTAMP()),
+	('flag-ki', 287, 6280, UNIX_TIMESTAMP()),
+	('stethoscope', 835, 4830, UNIX_TIMESTAMP()),
+	('open_file_folder', 346, 3342, UNIX_TIMESTAMP()),
+	('mechanical_leg', 923, 2920, UNIX_TIMESTAMP()),
+	('woman-pouting', 593, 3589, UNIX_TIMESTAMP()),
+	('heart_on_fire', 533, 4528, UNIX_TIMESTAMP()),
+	('red_circle', 782, 3778, UNIX_TIMESTAMP()),
+	('magic_wand', 100, 6093, UNIX_TIMESTAMP()),
+	('boat', 632, 2629, UNIX_TIMESTAMP()),
+	('small_airplane', 323, 4318, UNIX_TIMESTAMP()),
+	('four', 296, 2293, UNIX_TIMESTAMP()),
+	('flag-sa', 103, 5097, UNIX_TIMESTAMP()),
+	('hot_pepper', 701, 3697, UNIX_TIMESTAMP()),
+	('disguised_face', 798, 4793, UNIX_TIMESTAMP()),
+	('volleyball', 971, 5965, UNIX_TIMESTAMP()),
+	('copyright', 515, 5509, UNIX_TIMESTAMP()),
+	('man-girl-boy', 428, 3424, UNIX_TIMESTAMP()),
+	('goat', 9, 5003, UNIX_TIMESTAMP()),
+	('small_red_triangle', 292, 4287, UNIX_TIMESTAMP()),
+	('man-golfing', 131, 130, UNIX_TIMESTAMP()),
+	('bat', 493, 1491, UNIX_TIMESTAMP()),
+	('shield', 28, 27, UNIX_TIMESTAMP()),
+	('page_facing_up', 216, 215, UNIX_TIMESTAMP()),
+	('hut', 392, 4387, UNIX_TIMESTAMP()),
+	('flying_saucer', 339, 7331, UNIX_TIMESTAMP()),
+	('trophy', 189, 6182, UNIX_TIMESTAMP()),
+	('flag-sg', 664, 2661, UNIX_TIMESTAMP()),
+	('clock3', 283, 6276, UNIX_TIMESTAMP()),
+	('cityscape', 740, 6733, UNIX_TIMESTAMP()),
+	('scorpion', 189, 4184, UNIX_TIMESTAMP()),
+	('face_with_spiral_eyes', 159, 158, UNIX_TIMESTAMP()),
+	('potable_water', 849, 4844, UNIX_TIMESTAMP()),
+	('lightning', 156, 6149, UNIX_TIMESTAMP()),
+	('basket', 135, 5129, UNIX_TIMESTAMP()),
+	('bed', 477, 6470, UNIX_TIMESTAMP()),
+	('a', 738, 737, UNIX_TIMESTAMP()),
+	('bear', 460, 6453, UNIX_TIMESTAMP()),
+	('nine', 928, 6921, UNIX_TIMESTAMP()),
+	('bangbang', 915, 3911, UNIX_TIMESTAMP()),
+	('upside_down_face', 448, 5442, UNIX_TIMESTAMP()),
+	('wind_blowing_face', 27, 5021, UNIX_TIMESTAMP()),
+	('flag-ao', 509, 3505, UNIX_TIMESTAMP()),
+	('flag-si', 622, 4617, UNIX_TIMESTAMP()),
+	('teapot', 143, 4138, UNIX_TIMESTAMP()),
+	('alien', 837, 836, UNIX_TIMESTAMP()),
+	('card_index', 630, 3626, UNIX_TIMESTAMP()),
+	('panda_face', 828, 4823, UNIX_TIMESTAMP()),
+	('sunrise', 360, 1358, UNIX_TIMESTAMP()),
+	('stuck_out_tongue_winking_eye', 846, 5840, UNIX_TIMESTAMP()),
+	('arrow_up', 613, 5607, UNIX_TIMESTAMP()),
+	('busts_in_silhouette', 382, 5376, UNIX_TIMESTAMP()),
+	('bird', 469, 1467, UNIX_TIMESTAMP()),
+	('dollar', 729, 728, UNIX_TIMESTAMP()),
+	('m', 333, 1331, UNIX_TIMESTAMP()),
+	('space_invader', 587, 6580, UNIX_TIMESTAMP()),
+	('takeout_box', 99, 2096, UNIX_TIMESTAMP()),
+	('desktop_computer', 194, 7186, UNIX_TIMESTAMP()),
+	('cucumber', 651, 650, UNIX_TIMESTAMP()),
+	('deciduous_tree', 276, 5270, UNIX_TIMESTAMP()),
+	('man', 141, 5135, UNIX_TIMESTAMP()),
+	('izakaya_lantern', 680, 679, UNIX_TIMESTAMP()),
+	('surfer', 611, 2608, UNIX_TIMESTAMP()),
+	('flag-kg', 92, 91, UNIX_TIMESTAMP()),
+	('man-getting-massage', 631, 4626, UNIX_TIMESTAMP()),
+	('file_folder', 236, 4231, UNIX_TIMESTAMP()),
+	('standing_person', 687, 3683, UNIX_TIMESTAMP()),
+	('lotion_bottle', 486, 5480, UNIX_TIMESTAMP()),
+	('tiger', 441, 6434, UNIX_TIMESTAMP()),
+	('ninja', 40, 7032, UNIX_TIMESTAMP()),
+	('lower_left_paintbrush', 322, 3318, UNIX_TIMESTAMP()),
+	('barber', 15, 1013, UNIX_TIMESTAMP()),
+	('woman-kiss-woman', 515, 6508, UNIX_TIMESTAMP()),
+	('barber', 367, 366, UNIX_TIMESTAMP()),
+	('salt', 696, 6689, UNIX_TIMESTAMP()),
+	('man-cartwheeling', 167, 2164, UNIX_TIMESTAMP()),
+	('ice_cream', 927, 4922, UNIX_TIMESTAMP()),
+	('zzz', 31, 6024, UNIX_TIMESTAMP()),
+	('blond-haired-woman', 991, 6984, UNIX_TIMESTAMP()),
+	('peace_symbol', 886, 2883, UNIX_TIMESTAMP()),
+	('motor_scooter', 87, 2084, UNIX_TIMESTAMP()),
+	('boy', 973, 5967, UNIX_TIMESTAMP()),
+	('firecracker', 222, 2219, UNIX_TIMESTAMP()),
+	('woman-kiss-woman', 519, 518, UNIX_TIMESTAMP()),
+	('crescent_moon', 70, 5064, UNIX_TIMESTAMP()),
+	('newspaper', 912, 4907, UNIX_TIMESTAMP()),
+	('lipstick', 468, 5462, UNIX_TIMESTAMP()),
+	('infinity', 191, 6184, UNIX_TIMESTAMP()),
+	('sake', 725, 5719, UNIX_TIMESTAMP()),
+	('flag-dg', 633, 6626, UNIX_TIMESTAMP()),
+	('city_sunrise', 676, 6669, UNIX_TIMESTAMP()),
+	('woman-heart-woman', 780, 3776, UNIX_TIMESTAMP()),
+	('tropical_fish', 168, 2165, UNIX_TIMESTAMP()),
+	('expressionless', 559, 1557, UNIX_TIMESTAMP()),
+	('first_quarter_moon_with_face', 119, 6112, UNIX_TIMESTAMP()),
+	('eject', 964, 3960, UNIX_TIMESTAMP()),
+	('radio', 434, 6427, UNIX_TIMESTAMP()),
+	('flag-kw', 805, 3801, UNIX_TIMESTAMP()),
+	('thought_balloon', 895, 2892, UNIX_TIMESTAMP()),
+	('japanese_goblin', 663, 5657, UNIX_TIMESTAMP()),
+	('snail', 169, 168, UNIX_TIMESTAMP()),
+	('waning_gibbous_moon', 233, 1231, UNIX_TIMESTAMP()),
+	('garlic', 380, 6373, UNIX_TIMESTAMP()),
+	('helicopter', 662, 6655, UNIX_TIMESTAMP()),
+	('flag-sg', 969, 1967, UNIX_TIMESTAMP()),
+	('clubs', 326, 6319, UNIX_TIMESTAMP()),
+	('woman-woman-boy', 669, 3665, UNIX_TIMESTAMP()),
+	('flag-ng', 650, 3646, UNIX_TIMESTAMP()),
+	('star_of_david', 161, 3157, UNIX_TIMESTAMP()),
+	('curling_stone', 663, 3659, UNIX_TIMESTAMP()),
+	('man-gesturing-no', 860, 6853, UNIX_TIMESTAMP()),
+	('supervillain', 103, 5097, UNIX_TIMESTAMP()),
+	('parachute', 500, 3496, UNIX_TIMESTAMP()),
+	('flag-om', 978, 977, UNIX_TIMESTAMP()),
+	('boxing_glove', 812, 3808, UNIX_TIMESTAMP()),
+	('diya_lamp', 778, 1776, UNIX_TIMESTAMP()),
+	('woman-girl', 808, 807, UNIX_TIMESTAMP()),
+	('pregnant_woman', 208, 7200, UNIX_TIMESTAMP()),
+	('boar', 899, 3895, UNIX_TIMESTAMP()),
+	('loud_sound', 751, 750, UNIX_TIMESTAMP()),
+	('badminton_racquet_and_shuttlecock', 448, 447, UNIX_TIMESTAMP()),
+	('man-bouncing-ball', 547, 6540, UNIX_TIMESTAMP()),
+	('frowning', 62, 61, UNIX_TIMESTAMP()),
+	('mouse', 211, 1209, UNIX_TIMESTAMP()),
+	('elevator', 313, 4308, UNIX_TIMESTAMP()),
+	('crystal_ball', 243, 1241, UNIX_TIMESTAMP()),
+	('man-man-boy-boy', 293, 4288, UNIX_TIMESTAMP()),
+	('male-detective', 665, 664, UNIX_TIMESTAMP()),
+	('man-man-boy-boy', 768, 6761, UNIX_TIMESTAMP()),
+	('heart_eyes', 42, 5036, UNIX_TIMESTAMP()),
+	('flag-ni', 336, 4331, UNIX_TIMESTAMP()),
+	('sleepy', 860, 3856, UNIX_TIMESTAMP()),
+	('mountain_cableway', 310, 7302, UNIX_TIMESTAMP()),
+	('woman-wrestling', 229, 3225, UNIX_TIMESTAMP()),
+	('u7121', 153, 1151, UNIX_TIMESTAMP()),
+	('bone', 347, 7339, UNIX_TIMESTAMP()),
+	('fax', 184, 3180, UNIX_TIMESTAMP()),
+	('cinema', 110, 7102, UNIX_TIMESTAMP()),
+	('flag-md', 827, 4822, UNIX_TIMESTAMP()),
+	('baguette_bread', 566, 2563, UNIX_TIMESTAMP()),
+	('flag-cm', 679, 5673, UNIX_TIMESTAMP()),
+	('male-firefighter', 94, 7086, UNIX_TIMESTAMP()),
+	('rotating_light', 38, 6031, UNIX_TIMESTAMP()),
+	('female-guard', 645, 644, UNIX_TIMESTAMP()),
+	('curry', 486, 1484, UNIX_TIMESTAMP()),
+	('ladybug', 397, 3393, UNIX_TIMESTAMP()),
+	('umbrella', 506, 5500, UNIX_TIMESTAMP()),
+	('cherry_blossom', 594, 6587, UNIX_TIMESTAMP()),
+	('man-man-boy', 841, 840, UNIX_TIMESTAMP()),
+	('man-mountain-biking', 77, 4072, UNIX_TIMESTAMP()),
+	('u5272', 502, 5496, UNIX_TIMESTAMP()),
+	('fried_shrimp', 201, 200, UNIX_TIMESTAMP()),
+	('sandwich', 942, 2939, UNIX_TIMESTAMP()),
+	('candle', 555, 4550, UNIX_TIMESTAMP()),
+	('flag-pg', 983, 3979, UNIX_TIMESTAMP()),
+	('woman-tipping-hand', 880, 6873, UNIX_TIMESTAMP()),
+	('man-kiss-man', 580, 6573, UNIX_TIMESTAMP()),
+	('envelope_with_arrow', 202, 2199, UNIX_TIMESTAMP()),
+	('flashlight', 994, 4989, UNIX_TIMESTAMP()),
+	('first_quarter_moon', 396, 395, UNIX_TIMESTAMP()),
+	('pineapple', 137, 3133, UNIX_TIMESTAMP()),
+	('stuffed_flatbread', 923, 5917, UNIX_TIMESTAMP()),
+	('ledger', 558, 5552, UNIX_TIMESTAMP()),
+	('ice_skate', 317, 5311, UNIX_TIMESTAMP()),
+	('flag-mr', 498, 1496, UNIX_TIMESTAMP()),
+	('capricorn', 354, 5348, UNIX_TIMESTAMP()),
+	('flatbread', 639, 1637, UNIX_TIMESTAMP()),
+	('interrobang', 231, 3227, UNIX_TIMESTAMP()),
+	('clock330', 148, 4143, UNIX_TIMESTAMP()),
+	('virgo', 246, 3242, UNIX_TIMESTAMP()),
+	('smiling_face_with_3_hearts', 845, 5839, UNIX_TIMESTAMP()),
+	('black_right_pointing_triangle_with_double_vertical_bar', 901, 1899, UNIX_TIMESTAMP()),
+	('man-woman-girl-girl', 340, 4335, UNIX_TIMESTAMP()),
+	('exploding_head', 122, 2119, UNIX_TIMESTAMP()),
+	('aquarius', 456, 2453, UNIX_TIMESTAMP()),
+	('oncoming_automobile', 769, 1767, UNIX_TIMESTAMP()),
+	('mailbox_with_mail', 775, 774, UNIX_TIMESTAMP()),
+	('womans_clothes', 289, 2286, UNIX_TIMESTAMP()),
+	('flag-gy', 728, 5722, UNIX_TIMESTAMP()),
+	('printer', 891, 890, UNIX_TIMESTAMP()),
+	('football', 477, 7469, UNIX_TIMESTAMP()),
+	('man-shrugging', 304, 3300, UNIX_TIMESTAMP()),
+	('shield', 250, 6243, UNIX_TIMESTAMP()),
+	('city_sunset', 59, 4054, UNIX_TIMESTAMP()),
+	('flag-tj', 825, 4820, UNIX_TIMESTAMP()),
+	('clinking_glasses', 603, 2600, UNIX_TIMESTAMP()),
+	('person_with_pouting_face', 725, 6718, UNIX_TIMESTAMP()),
+	('football', 144, 143, UNIX_TIMESTAMP()),
+	('flag-mv', 233, 4228, UNIX_TIMESTAMP()),
+	('cupcake', 498, 2495, UNIX_TIMESTAMP()),
+	('headphones', 970, 6963, UNIX_TIMESTAMP()),
+	('page_facing_up', 634, 3630, UNIX_TIMESTAMP()),
+	('bullettrain_front', 141, 4136, UNIX_TIMESTAMP()),
+	('socks', 531, 5525, UNIX_TIMESTAMP()),
+	('watermelon', 27, 4022, UNIX_TIMESTAMP()),
+	('hot_face', 977, 5971, UNIX_TIMESTAMP()),
+	('keyboard', 241, 240, UNIX_TIMESTAMP()),
+	('tv', 169, 4164, UNIX_TIMESTAMP()),
+	('cheese_wedge', 336, 6329, UNIX_TIMESTAMP()),
+	('fireworks', 497, 6490, UNIX_TIMESTAMP()),
+	('spades', 491, 2488, UNIX_TIMESTAMP()),
+	('trumpet', 449, 6442, UNIX_TIMESTAMP()),
+	('banjo', 691, 2688, UNIX_TIMESTAMP()),
+	('left_right_arrow', 353, 5347, UNIX_TIMESTAMP()),
+	('flag-ma', 713, 3709, UNIX_TIMESTAMP()),
+	('confounded', 856, 1854, UNIX_TIMESTAMP()),
+	('face_with_monocle', 884, 3880, UNIX_TIMESTAMP()),
+	('bow', 4, 2001, UNIX_TIMESTAMP()),
+	('game_die', 600, 2597, UNIX_TIMESTAMP()),
+	('flag-kw', 215, 1213, UNIX_TIMESTAMP()),
+	('man-golfing', 432, 5426, UNIX_TIMESTAMP()),
+	('man-tipping-hand', 736, 735, UNIX_TIMESTAMP()),
+	('broken_heart', 316, 1314, UNIX_TIMESTAMP()),
+	('mountain_cableway', 211, 2208, UNIX_TIMESTAMP()),
+	('barber', 893, 5887, UNIX_TIMESTAMP()),
+	('moneybag', 677, 1675, UNIX_TIMESTAMP()),
+	('astonished', 602, 1600, UNIX_TIMESTAMP()),
+	('flag-sv', 620, 6613, UNIX_TIMESTAMP()),
+	('roller_skate', 1, 2997, UNIX_TIMESTAMP()),
+	('flag-gy', 882, 4877, UNIX_TIMESTAMP()),
+	('baby_chick', 690, 6683, UNIX_TIMESTAMP()),
+	('incoming_envelope', 776, 6769, UNIX_TIMESTAMP()),
+	('flag-zm', 327, 3323, UNIX_TIMESTAMP()),
+	('kite', 402, 2399, UNIX_TIMESTAMP()),
+	('watch', 506, 1504, UNIX_TIMESTAMP()),
+	('luggage', 18, 4013, UNIX_TIMESTAMP()),
+	('white_large_square', 143, 3139, UNIX_TIMESTAMP()),
+	('anguished', 102, 3098, UNIX_TIMESTAMP()),
+	('football', 721, 6714, UNIX_TIMESTAMP()),
+	('female_vampire', 350, 5344, UNIX_TIMESTAMP()),
+	('flag-py', 690, 689, UNIX_TIMESTAMP()),
+	('skull', 42, 5036, UNIX_TIMESTAMP()),
+	('speech_balloon', 518, 3514, UNIX_TIMESTAMP()),
+	('children_crossing', 257, 3253, UNIX_TIMESTAMP()),
+	('hocho', 873, 872, UNIX_TIMESTAMP()),
+	('tram', 25, 1023, UNIX_TIMESTAMP()),
+	('first_quarter_moon', 985, 1983, UNIX_TIMESTAMP()),
+	('man_with_turban', 842, 4837, UNIX_TIMESTAMP()),
+	('frog', 407, 6400, UNIX_TIMESTAMP()),
+	('flag-sj', 723, 3719, UNIX_TIMESTAMP()),
+	('crescent_moon', 902, 901, UNIX_TIMESTAMP()),
+	('drum_with_drumsticks', 176, 2173, UNIX_TIMESTAMP()),
+	('lemon', 988, 5982, UNIX_TIMESTAMP()),
+	('rabbit2', 89, 7081, UNIX_TIMESTAMP()),
+	('call_me_hand', 749, 2746, UNIX_TIMESTAMP()),
+	('email', 185, 6178, UNIX_TIMESTAMP()),
+	('surfer', 188, 6181, UNIX_TIMESTAMP()),
+	('flag-no', 690, 2687, UNIX_TIMESTAMP()),
+	('radio_button', 661, 3657, UNIX_TIMESTAMP()),
+	('ballot_box_with_check', 47, 1045, UNIX_TIMESTAMP()),
+	('man-playing-water-polo', 341, 7333, UNIX_TIMESTAMP()),
+	('diya_lamp', 586, 3582, UNIX_TIMESTAMP()),
+	('flag-mo', 983, 2980, UNIX_TIMESTAMP()),
+	('man-shrugging', 508, 3504, UNIX_TIMESTAMP()),
+	('black_medium_small_square', 281, 1279, UNIX_TIMESTAMP()),
+	('mostly_sunny', 997, 4992, UNIX_TIMESTAMP()),
+	('bear', 620, 2617, UNIX_TIMESTAMP()),
+	('hindu_temple', 586, 585, UNIX_TIMESTAMP()),
+	('person_in_tuxedo', 99, 2096, UNIX_TIMESTAMP()),
+	('flag-pk', 747, 746, UNIX_TIMESTAMP()),
+	('shopping_bags', 436, 4431, UNIX_TIMESTAMP()),
+	('waning_gibbous_moon', 763, 5757, UNIX_TIMESTAMP()),
+	('stethoscope', 499, 5493, UNIX_TIMESTAMP()),
+	('satellite_antenna', 786, 785, UNIX_TIMESTAMP()),
+	('fallen_leaf', 546, 3542, UNIX_TIMESTAMP()),
+	('flag-in', 155, 3151, UNIX_TIMESTAMP()),
+	('face_exhaling', 397, 7389, UNIX_TIMESTAMP()),
+	('flag-re', 5, 4000, UNIX_TIMESTAMP()),
+	('cry', 799, 6792, UNIX_TIMESTAMP()),
+	('keycap_ten', 8, 7, UNIX_TIMESTAMP()),
+	('sunglasses', 164, 2161, UNIX_TIMESTAMP()),
+	('fox_face', 824, 823, UNIX_TIMESTAMP()),
+	('nose', 567, 6560, UNIX_TIMESTAMP()),
+	('flag-bl', 979, 2976, UNIX_TIMESTAMP()),
+	('farmer', 606, 3602, UNIX_TIMESTAMP()),
+	('woman-mountain-biking', 428, 5422, UNIX_TIMESTAMP()),
+	('classical_building', 728, 5722, UNIX_TIMESTAMP()),
+	('shopping_bags', 804, 4799, UNIX_TIMESTAMP()),
+	('white_large_square', 955, 6948, UNIX_TIMESTAMP()),
+	('earth_asia', 512, 1510, UNIX_TIMESTAMP()),
+	('snowboarder', 163, 6156, UNIX_TIMESTAMP()),
+	('trumpet', 969, 3965, UNIX_TIMESTAMP()),
+	('frog', 748, 5742, UNIX_TIMESTAMP()),
+	('u6307', 127, 2124, UNIX_TIMESTAMP()),
+	('light_rail', 424, 5418, UNIX_TIMESTAMP()),
+	('kiwifruit', 738, 4733, UNIX_TIMESTAMP()),
+	('flag-ca', 552, 4547, UNIX_TIMESTAMP()),
+	('flag-hr', 71, 70, UNIX_TIMESTAMP()),
+	('o2', 964, 4959, UNIX_TIMESTAMP()),
+	('lying_face', 993, 4988, UNIX_TIMESTAMP()),
+	('bear', 778, 5772, UNIX_TIMESTAMP()),
+	('sleuth_or_spy', 427, 2424, UNIX_TIMESTAMP()),
+	('satellite_antenna', 598, 2595, UNIX_TIMESTAMP()),
+	('fire_engine', 293, 3289, UNIX_TIMESTAMP()),
+	('headstone', 908, 6901, UNIX_TIMESTAMP()),
+	('factory_worker', 325, 2322, UNIX_TIMESTAMP()),
+	('call_me_hand', 841, 4836, UNIX_TIMESTAMP()),
+	('flag-sa', 251, 7243, UNIX_TIMESTAMP()),
+	('whale', 52, 5046, UNIX_TIMESTAMP()),
+	('flag-om', 121, 1119, UNIX_TIMESTAMP()),
+	('sari', 963, 3959, UNIX_TIMESTAMP()),
+	('house_buildings', 588, 3584, UNIX_TIMESTAMP()),
+	('trolleybus', 313, 2310, UNIX_TIMESTAMP()),
+	('oncoming_automobile', 375, 374, UNIX_TIMESTAMP()),
+	('crown', 283, 4278, UNIX_TIMESTAMP()),
+	('flag-io', 567, 5561, UNIX_TIMESTAMP()),
+	('purse', 932, 2929, UNIX_TIMESTAMP()),
+	('heart_eyes', 977, 976, UNIX_TIMESTAMP()),
+	('linked_paperclips', 699, 5693, UNIX_TIMESTAMP()),
+	('flag-mm', 753, 1751, UNIX_TIMESTAMP()),
+	('rainbow', 701, 700, UNIX_TIMESTAMP()),
+	('taurus', 23, 7015, UNIX_TIMESTAMP()),
+	('rice', 291, 3287, UNIX_TIMESTAMP()),
+	('ladder', 826, 6819, UNIX_TIMESTAMP()),
+	('hugging_face', 459, 7451, UNIX_TIMESTAMP()),
+	('flag-be', 813, 1811, UNIX_TIMESTAMP()),
+	('military_helmet', 266, 5260, UNIX_TIMESTAMP()),
+	('flag-my', 831, 4826, UNIX_TIMESTAMP()),
+	('pig2', 376, 6369, UNIX_TIMESTAMP()),
+	('tiger2', 583, 5577, UNIX_TIMESTAMP()),
+	('soap', 743, 742, UNIX_TIMESTAMP()),
+	('worried', 999, 2996, UNIX_TIMESTAMP()),
+	('desktop_computer', 824, 823, UNIX_TIMESTAMP()),
+	('100', 813, 6806, UNIX_TIMESTAMP()),
+	('factory', 436, 1434, UNIX_TIMESTAMP()),
+	('flag-eg', 765, 2762, UNIX_TIMESTAMP()),
+	('round_pushpin', 900, 2897, UNIX_TIMESTAMP()),
+	('clock2', 691, 3687, UNIX_TIMESTAMP()),
+	('dragon', 243, 5237, UNIX_TIMESTAMP()),
+	('broom', 483, 482, UNIX_TIMESTAMP()),
+	('station', 990, 1988, UNIX_TIMESTAMP()),
+	('mermaid', 260, 259, UNIX_TIMESTAMP()),
+	('church', 956, 4951, UNIX_TIMESTAMP()),
+	('doughnut', 476, 4471, UNIX_TIMESTAMP()),
+	('man-playing-handball', 312, 7304, UNIX_TIMESTAMP()),
+	('mans_shoe', 831, 1829, UNIX_TIMESTAMP()),
+	('ant', 955, 954, UNIX_TIMESTAMP()),
+	('sari', 950, 6943, UNIX_TIMESTAMP()),
+	('person_in_tuxedo', 341, 5335, UNIX_TIMESTAMP()),
+	('angry', 985, 5979, UNIX_TIMESTAMP()),
+	('sos', 609, 2606, UNIX_TIMESTAMP()),
+	('man-bowing', 378, 5372, UNIX_TIMESTAMP()),
+	('wedding', 194, 193, UNIX_TIMESTAMP()),
+	('face_with_head_bandage', 445, 444, UNIX_TIMESTAMP()),
+	('zombie', 537, 2534, UNIX_TIMESTAMP()),
+	('sheep', 789, 5783, UNIX_TIMESTAMP()),
+	('black_heart', 422, 4417, UNIX_TIMESTAMP()),
+	('women-with-bunny-ears-partying', 711, 1709, UNIX_TIMESTAMP()),
+	('dna', 565, 564, UNIX_TIMESTAMP()),
+	('fly', 91, 90, UNIX_TIMESTAMP()),
+	('flag-lv', 581, 6574, UNIX_TIMESTAMP()),
+	('zombie', 228, 227, UNIX_TIMESTAMP()),
+	('toothbrush', 531, 5525, UNIX_TIMESTAMP()),
+	('ladybug', 714, 6707, UNIX_TIMESTAMP()),
+	('male-scientist', 931, 930, UNIX_TIMESTAMP()),
+	('frog', 232, 4227, UNIX_TIMESTAMP()),
+	('flag-mh', 531, 3527, UNIX_TIMESTAMP()),
+	('camera_with_flash', 711, 1709, UNIX_TIMESTAMP()),
+	('fr', 690, 3686, UNIX_TIMESTAMP()),
+	('man-lifting-weights', 15, 3011, UNIX_TIMESTAMP()),
+	('stars', 466, 4461, UNIX_TIMESTAMP()),
+	('left_speech_bubble', 302, 301, UNIX_TIMESTAMP()),
+	('cut_of_meat', 493, 6486, UNIX_TIMESTAMP()),
+	('radio', 588, 6581, UNIX_TIMESTAMP()),
+	('arrow_forward', 954, 953, UNIX_TIMESTAMP()),
+	('woman_with_veil', 197, 196, UNIX_TIMESTAMP()),
+	('tm', 630, 3626, UNIX_TIMESTAMP()),
+	('peacock', 822, 1820, UNIX_TIMESTAMP()),
+	('thought_balloon', 40, 2037, UNIX_TIMESTAMP()),
+	('nerd_face', 397, 6390, UNIX_TIMESTAMP()),
+	('fried_egg', 696, 6689, UNIX_TIMESTAMP()),
+	('shamrock', 774, 2771, UNIX_TIMESTAMP()),
+	('fried_egg', 155, 4150, UNIX_TIMESTAMP()),
+	('heartbeat', 84, 7076, UNIX_TIMESTAMP()),
+	('sagittarius', 300, 7292, UNIX_TIMESTAMP()),
+	('flag-sk', 156, 4151, UNIX_TIMESTAMP()),
+	('male-construction-worker', 64, 5058, UNIX_TIMESTAMP()),
+	('compression', 604, 3600, UNIX_TIMESTAMP()),
+	('deaf_person', 901, 3897, UNIX_TIMESTAMP()),
+	('dark_sunglasses', 350, 3346, UNIX_TIMESTAMP()),
+	('zzz', 323, 1321, UNIX_TIMESTAMP()),
+	('flag-eu', 274, 6267, UNIX_TIMESTAMP()),
+	('flag-cl', 54, 2051, UNIX_TIMESTAMP()),
+	('car', 66, 2063, UNIX_TIMESTAMP()),
+	('linked_paperclips', 12, 6005, UNIX_TIMESTAMP()),
+	('man-girl-boy', 189, 1187, UNIX_TIMESTAMP()),
+	('rolling_on_the_floor_laughing', 675, 674, UNIX_TIMESTAMP()),
+	('wheel_of_dharma', 155, 4150, UNIX_TIMESTAMP()),
+	('flag-xk', 212, 7204, UNIX_TIMESTAMP()),
+	('upside_down_face', 983, 4978, UNIX_TIMESTAMP()),
+	('dango', 840, 4835, UNIX_TIMESTAMP()),
+	('giraffe_face', 44, 2041, UNIX_TIMESTAMP()),
+	('swan', 60, 59, UNIX_TIMESTAMP()),
+	('pig_nose', 74, 6067, UNIX_TIMESTAMP()),
+	('cucumber', 55, 1053, UNIX_TIMESTAMP()),
+	('beers', 815, 1813, UNIX_TIMESTAMP()),
+	('flag-id', 157, 3153, UNIX_TIMESTAMP()),
+	('globe_with_meridians', 247, 4242, UNIX_TIMESTAMP()),
+	('man_in_lotus_position', 605, 3601, UNIX_TIMESTAMP()),
+	('thought_balloon', 119, 7111, UNIX_TIMESTAMP()),
+	('flag-at', 365, 7357, UNIX_TIMESTAMP()),
+	('softball', 578, 577, UNIX_TIMESTAMP()),
+	('arrow_lower_left', 877, 2874, UNIX_TIMESTAMP()),
+	('notebook_with_decorative_cover', 443, 4438, UNIX_TIMESTAMP()),
+	('wolf', 195, 2192, UNIX_TIMESTAMP()),
+	('butter', 183, 7175, UNIX_TIMESTAMP()),
+	('garlic', 149, 2146, UNIX_TIMESTAMP()),
+	('flag-ml', 411, 4406, UNIX_TIMESTAMP()),
+	('u6307', 13, 6006, UNIX_TIMESTAMP()),
+	('eggplant', 91, 5085, UNIX_TIMESTAMP()),
+	('tropical_drink', 42, 4037, UNIX_TIMESTAMP()),
+	('flag-sa', 28, 7020, UNIX_TIMESTAMP()),
+	('izakaya_lantern', 311, 310, UNIX_TIMESTAMP()),
+	('green_salad', 877, 2874, UNIX_TIMESTAMP()),
+	('auto_rickshaw', 442, 7434, UNIX_TIMESTAMP()),
+	('admission_tickets', 900, 2897, UNIX_TIMESTAMP()),
+	('flag-tn', 484, 2481, UNIX_TIMESTAMP()),
+	('u7a7a', 547, 5541, UNIX_TIMESTAMP()),
+	('virgo', 336, 5330, UNIX_TIMESTAMP()),
+	('barber', 163, 5157, UNIX_TIMESTAMP()),
+	('female_elf', 646, 3642, UNIX_TIMESTAMP()),
+	('white_haired_woman', 50, 5044, UNIX_TIMESTAMP()),
+	('flag-sv', 95, 94, UNIX_TIMESTAMP()),
+	('shopping_trolley', 334, 1332, UNIX_TIMESTAMP()),
+	('mag_right', 403, 4398, UNIX_TIMESTAMP()),
+	('triangular_flag_on_post', 396, 395, UNIX_TIMESTAMP()),
+	('hotsprings', 608, 2605, UNIX_TIMESTAMP()),
+	('woman-wearing-turban', 69, 68, UNIX_TIMESTAMP()),
+	('gift', 663, 3659, UNIX_TIMESTAMP()),
+	('person_climbing', 193, 7185, UNIX_TIMESTAMP()),
+	('diamond_shape_with_a_dot_inside', 149, 2146, UNIX_TIMESTAMP()),
+	('warning', 628, 6621, UNIX_TIMESTAMP()),
+	('hospital', 213, 4208, UNIX_TIMESTAMP()),
+	('white_check_mark', 733, 732, UNIX_TIMESTAMP()),
+	('hankey', 899, 6892, UNIX_TIMESTAMP()),
+	('sos', 484, 6477, UNIX_TIMESTAMP()),
+	('man_standing', 123, 122, UNIX_TIMESTAMP()),
+	('computer', 348, 2345, UNIX_TIMESTAMP()),
+	('red_circle', 357, 6350, UNIX_TIMESTAMP()),
+	('yo-yo', 590, 589, UNIX_TIMESTAMP()),
+	('cut_of_meat', 798, 3794, UNIX_TIMESTAMP()),
+	('large_brown_square', 544, 3540, UNIX_TIMESTAMP()),
+	('pisces', 514, 513, UNIX_TIMESTAMP()),
+	('tanabata_tree', 397, 5391, UNIX_TIMESTAMP()),
+	('shrimp', 126, 5120, UNIX_TIMESTAMP()),
+	('loud_sound', 728, 727, UNIX_TIMESTAMP()),
+	('golf', 703, 5697, UNIX_TIMESTAMP()),
+	('flag-ag', 714, 5708, UNIX_TIMESTAMP()),
+	('flag-bj', 409, 408, UNIX_TIMESTAMP()),
+	('flag-ai', 31, 1029, UNIX_TIMESTAMP()),
+	('rice_cracker', 719, 718, UNIX_TIMESTAMP()),
+	('100', 59, 1057, UNIX_TIMESTAMP()),
+	('woman-raising-hand', 797, 796, UNIX_TIMESTAMP()),
+	('flag-lk', 830, 6823, UNIX_TIMESTAMP()),
+	('baseball', 131, 5125, UNIX_TIMESTAMP()),
+	('hospital', 70, 5064, UNIX_TIMESTAMP()),
+	('woman-walking', 20, 3016, UNIX_TIMESTAMP()),
+	('gear', 408, 2405, UNIX_TIMESTAMP()),
+	('book', 922, 921, UNIX_TIMESTAMP()),
+	('flag-nr', 924, 923, UNIX_TIMESTAMP()),
+	('ribbon', 526, 4521, UNIX_TIMESTAMP()),
+	('medical_symbol', 651, 6644, UNIX_TIMESTAMP()),
+	('female_supervillain', 402, 3398, UNIX_TIMESTAMP()),
+	('heart_eyes', 614, 4609, UNIX_TIMESTAMP()),
+	('lips', 841, 2838, UNIX_TIMESTAMP()),
+	('flag-cf', 574, 5568, UNIX_TIMESTAMP()),
+	('woman-surfing', 428, 5422, UNIX_TIMESTAMP()),
+	('post_office', 564, 1562, UNIX_TIMESTAMP()),
+	('crying_cat_face', 481, 7473, UNIX_TIMESTAMP()),
+	('flag-ic', 939, 1937, UNIX_TIMESTAMP()),
+	('pleading_face', 776, 3772, UNIX_TIMESTAMP()),
+	('blue_book', 300, 6293, UNIX_TIMESTAMP()),
+	('calling', 700, 2697, UNIX_TIMESTAMP()),
+	('flag-sz', 547, 2544, UNIX_TIMESTAMP()),
+	('compression', 86, 85, UNIX_TIMESTAMP()),
+	('joy', 805, 4800, UNIX_TIMESTAMP()),
+	('standing_person', 49, 6042, UNIX_TIMESTAMP()),
+	('left_speech_bubble', 995, 6988, UNIX_TIMESTAMP()),
+	('warning', 887, 3883, UNIX_TIMESTAMP()),
+	('compass', 328, 3324, UNIX_TIMESTAMP()),
+	('flag-ve', 764, 2761, UNIX_TIMESTAMP()),
+	('iphone', 850, 1848, UNIX_TIMESTAMP()),
+	('female_sign', 362, 361, UNIX_TIMESTAMP()),
+	('disappointed_relieved', 665, 6658, UNIX_TIMESTAMP()),
+	('mountain_bicyclist', 406, 7398, UNIX_TIMESTAMP()),
+	('right_anger_bubble', 184, 7176, UNIX_TIMESTAMP()),
+	('kite', 638, 1636, UNIX_TIMESTAMP()),
+	('loop', 370, 369, UNIX_TIMESTAMP()),
+	('takeout_box', 833, 4828, UNIX_TIMESTAMP()),
+	('table_tennis_paddle_and_ball', 274, 7266, UNIX_TIMESTAMP()),
+	('flag-sg', 473, 472, UNIX_TIMESTAMP()),
+	('seedling', 797, 6790, UNIX_TIMESTAMP()),
+	('male_vampire', 537, 1535, UNIX_TIMESTAMP()),
+	('leopard', 787, 6780, UNIX_TIMESTAMP()),
+	('flag-bs', 979, 1977, UNIX_TIMESTAMP()),
+	('traffic_light', 833, 6826, UNIX_TIMESTAMP()),
+	('flag-aw', 416, 1414, UNIX_TIMESTAMP()),
+	('stuffed_flatbread', 467, 7459, UNIX_TIMESTAMP()),
+	('stew', 435, 7427, UNIX_TIMESTAMP()),
+	('roller_skate', 483, 2480, UNIX_TIMESTAMP()),
+	('lock', 115, 1113, UNIX_TIMESTAMP()),
+	('cherries', 551, 4546, UNIX_TIMESTAMP()),
+	('feet', 282, 4277, UNIX_TIMESTAMP()),
+	('man_in_motorized_wheelchair', 301, 7293, UNIX_TIMESTAMP()),
+	('incoming_envelope', 187, 2184, UNIX_TIMESTAMP()),
+	('convenience_store', 46, 1044, UNIX_TIMESTAMP()),
+	('hourglass', 912, 3908, UNIX_TIMESTAMP()),
+	('ledger', 61, 5055, UNIX_TIMESTAMP()),
+	('left_speech_bubble', 589, 2586, UNIX_TIMESTAMP()),
+	('mag_right', 414, 4409, UNIX_TIMESTAMP()),
+	('snake', 321, 7313, UNIX_TIMESTAMP()),
+	('hourglass_flowing_sand', 362, 361, UNIX_TIMESTAMP()),
+	('mortar_board', 493, 1491, UNIX_TIMESTAMP()),
+	('exclamation', 913, 4908, UNIX_TIMESTAMP()),
+	('+1', 77, 5071, UNIX_TIMESTAMP()),
+	('racing_car', 661, 6654, UNIX_TIMESTAMP()),
+	('grey_exclamation', 245, 5239, UNIX_TIMESTAMP()),
+	('flag-no', 647, 3643, UNIX_TIMESTAMP()),
+	('flag-gh', 888, 3884, UNIX_TIMESTAMP()),
+	('flag-nr', 302, 5296, UNIX_TIMESTAMP()),
+	('face_in_clouds', 192, 1190, UNIX_TIMESTAMP()),
+	('flag-scotland', 35, 2032, UNIX_TIMESTAMP()),
+	('boot', 993, 2990, UNIX_TIMESTAMP()),
+	('sparkles', 961, 960, UNIX_TIMESTAMP()),
+	('kaaba', 585, 1583, UNIX_TIMESTAMP()),
+	('sunrise', 791, 4786, UNIX_TIMESTAMP()),
+	('diamond_shape_with_a_dot_inside', 476, 2473, UNIX_TIMESTAMP()),
+	('studio_microphone', 797, 3793, UNIX_TIMESTAMP()),
+	('link', 332, 7324, UNIX_TIMESTAMP()),
+	('beginner', 983, 982, UNIX_TIMESTAMP()),
+	('clock8', 448, 447, UNIX_TIMESTAMP()),
+	('ring', 90, 1088, UNIX_TIMESTAMP()),
+	('trident', 14, 5008, UNIX_TIMESTAMP()),
+	('registered', 678, 4673, UNIX_TIMESTAMP()),
+	('cactus', 684, 5678, UNIX_TIMESTAMP()),
+	('flag-ic', 163, 162, UNIX_TIMESTAMP()),
+	('ramen', 910, 909, UNIX_TIMESTAMP()),
+	('female-factory-worker', 859, 6852, UNIX_TIMESTAMP()),
+	('small_red_triangle_down', 308, 5302, UNIX_TIMESTAMP()),
+	('dango', 488, 7480, UNIX_TIMESTAMP()),
+	('cloud', 189, 5183, UNIX_TIMESTAMP()),
+	('ledger', 942, 3938, UNIX_TIMESTAMP()),
+	('spider', 964, 3960, UNIX_TIMESTAMP()),
+	('dna', 736, 4731, UNIX_TIMESTAMP()),
+	('llama', 280, 4275, UNIX_TIMESTAMP()),
+	('lower_left_paintbrush', 266, 2263, UNIX_TIMESTAMP()),
+	('tulip', 498, 4493, UNIX_TIMESTAMP()),
+	('clock7', 496, 7488, UNIX_TIMESTAMP()),
+	('briefcase', 246, 4241, UNIX_TIMESTAMP()),
+	('one', 715, 5709, UNIX_TIMESTAMP()),
+	('flag-cl', 53, 3049, UNIX_TIMESTAMP()),
+	('leaves', 521, 6514, UNIX_TIMESTAMP()),
+	('boot', 616, 5610, UNIX_TIMESTAMP()),
+	('palm_tree', 762, 6755, UNIX_TIMESTAMP()),
+	('face_with_rolling_eyes', 383, 3379, UNIX_TIMESTAMP()),
+	('pinching_hand', 441, 440, UNIX_TIMESTAMP()),
+	('flag-io', 281, 7273, UNIX_TIMESTAMP()),
+	('open_hands', 14, 7006, UNIX_TIMESTAMP()),
+	('flag-ve', 487, 2484, UNIX_TIMESTAMP()),
+	('heavy_minus_sign', 767, 1765, UNIX_TIMESTAMP()),
+	('smiling_face_with_tear', 712, 6705, UNIX_TIMESTAMP()),
+	('mechanical_arm', 403, 2400, UNIX_TIMESTAMP()),
+	('wilted_flower', 282, 6275, UNIX_TIMESTAMP()),
+	('zipper_mouth_face', 349, 2346, UNIX_TIMESTAMP()),
+	('turtle', 176, 4171, UNIX_TIMESTAMP()),
+	('bow', 680, 6673, UNIX_TIMESTAMP()),
+	('candy', 890, 6883, UNIX_TIMESTAMP()),
+	('man-shrugging', 589, 5583, UNIX_TIMESTAMP()),
+	('ox', 736, 1734, UNIX_TIMESTAMP()),
+	('mag', 374, 4369, UNIX_TIMESTAMP()),
+	('hippopotamus', 615, 614, UNIX_TIMESTAMP()),
+	('clock430', 716, 4711, UNIX_TIMESTAMP()),
+	('vs', 155, 7147, UNIX_TIMESTAMP()),
+	('female-factory-worker', 500, 4495, UNIX_TIMESTAMP()),
+	('rock', 801, 4796, UNIX_TIMESTAMP()),
+	('woman_in_manual_wheelchair', 350, 5344, UNIX_TIMESTAMP()),
+	('fist', 206, 1204, UNIX_TIMESTAMP()),
+	('cucumber', 756, 5750, UNIX_TIMESTAMP()),
+	('flag-ag', 988, 4983, UNIX_TIMESTAMP()),
+	('bikini', 596, 4591, UNIX_TIMESTAMP()),
+	('keyboard', 956, 955, UNIX_TIMESTAMP()),
+	('date', 716, 1714, UNIX_TIMESTAMP()),
+	('ballot_box_with_check', 205, 204, UNIX_TIMESTAMP()),
+	('sparkling_heart', 24, 4019, UNIX_TIMESTAMP()),
+	('m', 795, 1793, UNIX_TIMESTAMP()),
+	('snowflake', 299, 6292, UNIX_TIMESTAMP()),
+	('credit_card', 806, 6799, UNIX_TIMESTAMP()),
+	('male_superhero', 609, 2606, UNIX_TIMESTAMP()),
+	('ophiuchus', 880, 2877, UNIX_TIMESTAMP()),
+	('rabbit', 668, 667, UNIX_TIMESTAMP()),
+	('bullettrain_front', 316, 1314, UNIX_TIMESTAMP()),
+	('face_with_monocle', 837, 4832, UNIX_TIMESTAMP()),
+	('woman-tipping-hand', 302, 2299, UNIX_TIMESTAMP()),
+	('u7a7a', 619, 4614, UNIX_TIMESTAMP()),
+	('flag-bq', 422, 3418, UNIX_TIMESTAMP()),
+	('flag-kp', 280, 1278, UNIX_TIMESTAMP()),
+	('woman-heart-man', 638, 1636, UNIX_TIMESTAMP()),
+	('glass_of_milk', 176, 7168, UNIX_TIMESTAMP()),
+	('woman', 146, 2143, UNIX_TIMESTAMP()),
+	('face_with_spiral_eyes', 189, 5183, UNIX_TIMESTAMP()),
+	('keycap_star', 901, 2898, UNIX_TIMESTAMP()),
+	('man-swimming', 815, 814, UNIX_TIMESTAMP()),
+	('fire', 398, 6391, UNIX_TIMESTAMP()),
+	('nut_and_bolt', 69, 2066, UNIX_TIMESTAMP()),
+	('wave', 307, 3303, UNIX_TIMESTAMP()),
+	('anger', 571, 2568, UNIX_TIMESTAMP()),
+	('alarm_clock', 858, 3854, UNIX_TIMESTAMP()),
+	('funeral_urn', 43, 1041, UNIX_TIMESTAMP()),
+	('swimmer', 37, 6030, UNIX_TIMESTAMP()),
+	('flag-ax', 661, 1659, UNIX_TIMESTAMP()),
+	('thought_balloon', 264, 1262, UNIX_TIMESTAMP()),
+	('vibration_mode', 638, 637, UNIX_TIMESTAMP()),
+	('man-shrugging', 796, 795, UNIX_TIMESTAMP()),
+	('large_green_square', 80, 7072, UNIX_TIMESTAMP()),
+	('slightly_smiling_face', 916, 5910, UNIX_TIMESTAMP()),
+	('woman-pouting', 634, 4629, UNIX_TIMESTAMP()),
+	('bed', 260, 4255, UNIX_TIMESTAMP()),
+	('axe', 993, 6986, UNIX_TIMESTAMP()),
+	('old_key', 825, 2822, UNIX_TIMESTAMP()),
+	('hamburger', 643, 5637, UNIX_TIMESTAMP()),
+	('flag-fo', 65, 4060, UNIX_TIMESTAMP()),
+	('truck', 41, 7033, UNIX_TIMESTAMP()),
+	('garlic', 656, 2653, UNIX_TIMESTAMP()),
+	('moon', 781, 1779, UNIX_TIMESTAMP()),
+	('flag-gf', 517, 2514, UNIX_TIMESTAMP()),
+	('flag-me', 462, 1460, UNIX_TIMESTAMP()),
+	('o2', 398, 3394, UNIX_TIMESTAMP()),
+	('snow_cloud', 254, 2251, UNIX_TIMESTAMP()),
+	('seal', 138, 3134, UNIX_TIMESTAMP()),
+	('mahjong', 110, 3106, UNIX_TIMESTAMP()),
+	('barely_sunny', 649, 3645, UNIX_TIMESTAMP()),
+	('ice_hockey_stick_and_puck', 476, 1474, UNIX_TIMESTAMP()),
+	('teddy_bear', 445, 4440, UNIX_TIMESTAMP()),
+	('stew', 184, 4179, UNIX_TIMESTAMP()),
+	('flag-dk', 420, 5414, UNIX_TIMESTAMP()),
+	('woman_in_lotus_position', 885, 2882, UNIX_TIMESTAMP()),
+	('frog', 392, 6385, UNIX_TIMESTAMP()),
+	('es', 888, 4883, UNIX_TIMESTAMP()),
+	('world_map', 317, 2314, UNIX_TIMESTAMP()),
+	('clock430', 353, 6346, UNIX_TIMESTAMP()),
+	('cat2', 370, 1368, UNIX_TIMESTAMP()),
+	('woman-playing-water-polo', 1, 4995, UNIX_TIMESTAMP()),
+	('man-man-boy-boy', 266, 1264, UNIX_TIMESTAMP()),
+	('flag-ae', 355, 1353, UNIX_TIMESTAMP()),
+	('kissing_heart', 624, 2621, UNIX_TIMESTAMP()),
+	('slightly_frowning_face', 354, 353, UNIX_TIMESTAMP()),
+	('vhs', 16, 2013, UNIX_TIMESTAMP()),
+	('us', 510, 509, UNIX_TIMESTAMP()),
+	('persevere', 930, 1928, UNIX_TIMESTAMP()),
+	('mushroom', 828, 4823, UNIX_TIMESTAMP()),
+	('woman_with_veil', 580, 4575, UNIX_TIMESTAMP()),
+	('inbox_tray', 63, 1061, UNIX_TIMESTAMP()),
+	('grey_question', 797, 4792, UNIX_TIMESTAMP()),
+	('woman_in_steamy_room', 210, 4205, UNIX_TIMESTAMP()),
+	('oden', 78, 6071, UNIX_TIMESTAMP()),
+	('fairy', 653, 1651, UNIX_TIMESTAMP()),
+	('toothbrush', 257, 4252, UNIX_TIMESTAMP()),
+	('face_palm', 644, 5638, UNIX_TIMESTAMP()),
+	('sandwich', 920, 5914, UNIX_TIMESTAMP()),
+	('screwdriver', 838, 4833, UNIX_TIMESTAMP()),
+	('spoon', 498, 7490, UNIX_TIMESTAMP()),
+	('money_with_wings', 757, 4752, UNIX_TIMESTAMP()),
+	('flag-gq', 107, 3103, UNIX_TIMESTAMP()),
+	('flag-cc', 964, 6957, UNIX_TIMESTAMP()),
+	('mrs_claus', 480, 479, UNIX_TIMESTAMP()),
+	('flag-ad', 110, 109, UNIX_TIMESTAMP()),
+	('waning_crescent_moon', 539, 4534, UNIX_TIMESTAMP()),
+	('flag-gd', 556, 5550, UNIX_TIMESTAMP()),
+	('bacon', 410, 2407, UNIX_TIMESTAMP()),
+	('star', 32, 6025, UNIX_TIMESTAMP()),
+	('female_fairy', 468, 1466, UNIX_TIMESTAMP()),
+	('wc', 105, 3101, UNIX_TIMESTAMP()),
+	('lips', 586, 585, UNIX_TIMESTAMP()),
+	('student', 913, 1911, UNIX_TIMESTAMP()),
+	('nail_care', 245, 2242, UNIX_TIMESTAMP()),
+	('grey_exclamation', 237, 3233, UNIX_TIMESTAMP()),
+	('technologist', 321, 7313, UNIX_TIMESTAMP()),
+	('fire_engine', 365, 5359, UNIX_TIMESTAMP()),
+	('flag-gu', 711, 5705, UNIX_TIMESTAMP()),
+	('u7981', 704, 5698, UNIX_TIMESTAMP()),
+	('flag-et', 781, 2778, UNIX_TIMESTAMP()),
+	('mute', 990, 1988, UNIX_TIMESTAMP()),
+	('flag-mc', 984, 6977, UNIX_TIMESTAMP()),
+	('free', 999, 5993, UNIX_TIMESTAMP()),
+	('spiral_note_pad', 936, 1934, UNIX_TIMESTAMP()),
+	('cd', 919, 918, UNIX_TIMESTAMP()),
+	('syringe', 552, 3548, UNIX_TIMESTAMP()),
+	('flag-by', 685, 684, UNIX_TIMESTAMP()),
+	('man_in_lotus_position', 737, 4732, UNIX_TIMESTAMP()),
+	('flag-mh', 261, 4256, UNIX_TIMESTAMP()),
+	('flag-mm', 2, 2998, UNIX_TIMESTAMP()),
+	('female-detective', 308, 307, UNIX_TIMESTAMP()),
+	('bow', 744, 5738, UNIX_TIMESTAMP()),
+	('flag-io', 339, 338, UNIX_TIMESTAMP()),
+	('clown_face', 757, 756, UNIX_TIMESTAMP()),
+	('ballet_shoes', 996, 3992, UNIX_TIMESTAMP()),
+	('flag-hm', 816, 3812, UNIX_TIMESTAMP()),
+	('flag-vi', 264, 7256, UNIX_TIMESTAMP()),
+	('flag-at', 638, 1636, UNIX_TIMESTAMP()),
+	('flag-ax', 149, 7141, UNIX_TIMESTAMP()),
+	('poultry_leg', 319, 3315, UNIX_TIMESTAMP()),
+	('bed', 347, 5341, UNIX_TIMESTAMP()),
+	('ninja', 306, 2303, UNIX_TIMESTAMP()),
+	('aquarius', 730, 1728, UNIX_TIMESTAMP()),
+	('scooter', 90, 4085, UNIX_TIMESTAMP()),
+	('bear', 812, 811, UNIX_TIMESTAMP()),
+	('iphone', 618, 617, UNIX_TIMESTAMP()),
+	('flag-zm', 706, 5700, UNIX_TIMESTAMP()),
+	('no_entry_sign', 841, 840, UNIX_TIMESTAMP()),
+	('bow_and_arrow', 728, 6721, UNIX_TIMESTAMP()),
+	('man', 696, 4691, UNIX_TIMESTAMP()),
+	('flag-my', 647, 2644, UNIX_TIMESTAMP()),
+	('male-construction-worker', 872, 1870, UNIX_TIMESTAMP()),
+	('scissors', 207, 5201, UNIX_TIMESTAMP()),
+	('no_entry', 107, 4102, UNIX_TIMESTAMP()),
+	('unicorn_face', 714, 4709, UNIX_TIMESTAMP()),
+	('floppy_disk', 622, 5616, UNIX_TIMESTAMP()),
+	('fondue', 130, 6123, UNIX_TIMESTAMP()),
+	('merperson', 531, 530, UNIX_TIMESTAMP()),
+	('love_hotel', 335, 2332, UNIX_TIMESTAMP()),
+	('flag-sj', 753, 5747, UNIX_TIMESTAMP()),
+	('flag-gs', 142, 3138, UNIX_TIMESTAMP()),
+	('small_orange_diamond', 384, 6377, UNIX_TIMESTAMP()),
+	('mechanical_leg', 841, 6834, UNIX_TIMESTAMP()),
+	('pineapple', 879, 6872, UNIX_TIMESTAMP()),
+	('tent', 468, 7460, UNIX_TIMESTAMP()),
+	('white_medium_small_square', 644, 1642, UNIX_TIMESTAMP()),
+	('flag-fi', 909, 2906, UNIX_TIMESTAMP()),
+	('bearded_person', 35, 4030, UNIX_TIMESTAMP()),
+	('runner', 440, 439, UNIX_TIMESTAMP()),
+	('boomerang', 929, 2926, UNIX_TIMESTAMP()),
+	('dog2', 265, 264, UNIX_TIMESTAMP()),
+	('us', 410, 409, UNIX_TIMESTAMP()),
+	('heartpulse', 292, 3288, UNIX_TIMESTAMP()),
+	('u6708', 853, 6846, UNIX_TIMESTAMP()),
+	('worm', 595, 3591, UNIX_TIMESTAMP()),
+	('camera_with_flash', 775, 774, UNIX_TIMESTAMP()),
+	('bow', 692, 6685, UNIX_TIMESTAMP()),
+	('arrow_right_hook', 423, 7415, UNIX_TIMESTAMP()),
+	('potato', 20, 1018, UNIX_TIMESTAMP()),
+	('socks', 684, 4679, UNIX_TIMESTAMP()),
+	('mag_right', 752, 4747, UNIX_TIMESTAMP()),
+	('carousel_horse', 752, 6745, UNIX_TIMESTAMP()),
+	('sweat_drops', 34, 6027, UNIX_TIMESTAMP()),
+	('flag-ml', 62, 3058, UNIX_TIMESTAMP()),
+	('flag-qa', 139, 7131, UNIX_TIMESTAMP()),
+	('moon_cake', 666, 2663, UNIX_TIMESTAMP()),
+	('arrow_lower_left', 240, 239, UNIX_TIMESTAMP()),
+	('pinched_fingers', 5, 2002, UNIX_TIMESTAMP()),
+	('leaves', 904, 2901, UNIX_TIMESTAMP()),
+	('high_heel', 408, 3404, UNIX_TIMESTAMP()),
+	('euro', 17, 4012, UNIX_TIMESTAMP()),
+	('fries', 375, 7367, UNIX_TIMESTAMP()),
+	('person_in_steamy_room', 123, 3119, UNIX_TIMESTAMP()),
+	('chair', 836, 6829, UNIX_TIMESTAMP()),
+	('tractor', 658, 3654, UNIX_TIMESTAMP()),
+	('books', 880, 2877, UNIX_TIMESTAMP()),
+	('closed_umbrella', 33, 5027, UNIX_TIMESTAMP()),
+	('money_mouth_face', 855, 1853, UNIX_TIMESTAMP()),
+	('ballet_shoes', 296, 4291, UNIX_TIMESTAMP()),
+	('sun_with_face', 790, 6783, UNIX_TIMESTAMP()),
+	('scream', 152, 7144, UNIX_TIMESTAMP()),
+	('green_salad', 187, 7179, UNIX_TIMESTAMP()),
+	('star', 802, 4797, UNIX_TIMESTAMP()),
+	('polar_bear', 331, 1329, UNIX_TIMESTAMP()),
+	('boar', 871, 2868, UNIX_TIMESTAMP()),
+	('elevator', 326, 325, UNIX_TIMESTAMP()),
+	('door', 858, 3854, UNIX_TIMESTAMP()),
+	('supervillain', 864, 1862, UNIX_TIMESTAMP()),
+	('bug', 18, 17, UNIX_TIMESTAMP()),
+	('wastebasket', 185, 3181, UNIX_TIMESTAMP()),
+	('meat_on_bone', 890, 889, UNIX_TIMESTAMP()),
+	('astronaut', 302, 6295, UNIX_TIMESTAMP()),
+	('tokyo_tower', 347, 6340, UNIX_TIMESTAMP()),
+	('mage', 962, 4957, UNIX_TIMESTAMP()),
+	('pensive', 191, 3187, UNIX_TIMESTAMP()),
+	('person_with_ball', 154, 1152, UNIX_TIMESTAMP()),
+	('flag-cd', 241, 4236, UNIX_TIMESTAMP()),
+	('unicorn_face', 461, 5455, UNIX_TIMESTAMP()),
+	('clipboard', 65, 64, UNIX_TIMESTAMP()),
+	('leafy_green', 601, 600, UNIX_TIMESTAMP()),
+	('sneezing_face', 154, 3150, UNIX_TIMESTAMP()),
+	('wolf', 913, 912, UNIX_TIMESTAMP()),
+	('manual_wheelchair', 588, 2585, UNIX_TIMESTAMP()),
+	('blue_car', 351, 2348, UNIX_TIMESTAMP()),
+	('classical_building', 952, 1950, UNIX_TIMESTAMP()),
+	('test_tube', 786, 1784, UNIX_TIMESTAMP()),
+	('hushed', 952, 2949, UNIX_TIMESTAMP()),
+	('free', 159, 3155, UNIX_TIMESTAMP()),
+	('secret', 364, 6357, UNIX_TIMESTAMP()),
+	('clock1230', 893, 4888, UNIX_TIMESTAMP()),
+	('camera', 751, 3747, UNIX_TIMESTAMP()),
+	('nerd_face', 340, 5334, UNIX_TIMESTAMP()),
+	('child', 74, 7066, UNIX_TIMESTAMP()),
+	('open_file_folder', 105, 7097, UNIX_TIMESTAMP()),
+	('laughing', 380, 6373, UNIX_TIMESTAMP()),
+	('oncoming_bus', 950, 6943, UNIX_TIMESTAMP()),
+	('man-mountain-biking', 112, 4107, UNIX_TIMESTAMP()),
+	('trumpet', 775, 774, UNIX_TIMESTAMP()),
+	('rosette', 424, 5418, UNIX_TIMESTAMP()),
+	('pig_nose', 673, 4668, UNIX_TIMESTAMP()),
+	('flag-np', 739, 4734, UNIX_TIMESTAMP()),
+	('timer_clock', 514, 5508, UNIX_TIMESTAMP()),
+	('no_mouth', 927, 1925, UNIX_TIMESTAMP()),
+	('large_purple_square', 111, 4106, UNIX_TIMESTAMP()),
+	('bald_man', 129, 2126, UNIX_TIMESTAMP()),
+	('two_hearts', 886, 1884, UNIX_TIMESTAMP()),
+	('woman-boy', 151, 150, UNIX_TIMESTAMP()),
+	('car', 721, 6714, UNIX_TIMESTAMP()),
+	('u6708', 744, 1742, UNIX_TIMESTAMP()),
+	('tophat', 563, 3559, UNIX_TIMESTAMP()),
+	('test_tube', 743, 6736, UNIX_TIMESTAMP()),
+	('flag-tr', 843, 842, UNIX_TIMESTAMP()),
+	('pig_nose', 442, 7434, UNIX_TIMESTAMP()),
+	('woman-raising-hand', 273, 272, UNIX_TIMESTAMP()),
+	('pick', 416, 6409, UNIX_TIMESTAMP()),
+	('man_standing', 510, 1508, UNIX_TIMESTAMP()),
+	('crescent_moon', 263, 3259, UNIX_TIMESTAMP()),
+	('crossed_fingers', 621, 3617, UNIX_TIMESTAMP()),
+	('ferry', 74, 73, UNIX_TIMESTAMP()),
+	('eject', 366, 3362, UNIX_TIMESTAMP()),
+	('clock630', 997, 5991, UNIX_TIMESTAMP()),
+	('man-walking', 586, 2583, UNIX_TIMESTAMP()),
+	('baby_bottle', 372, 5366, UNIX_TIMESTAMP()),
+	('clock230', 166, 5160, UNIX_TIMESTAMP()),
+	('point_up', 18, 7010, UNIX_TIMESTAMP()),
+	('scales', 869, 1867, UNIX_TIMESTAMP()),
+	('merman', 88, 3084, UNIX_TIMESTAMP()),
+	('wheel_of_dharma', 123, 3119, UNIX_TIMESTAMP()),
+	('construction_worker', 852, 3848, UNIX_TIMESTAMP()),
+	('flag-gr', 941, 940, UNIX_TIMESTAMP()),
+	('bagel', 879, 4874, UNIX_TIMESTAMP()),
+	('flag-ci', 126, 125, UNIX_TIMESTAMP()),
+	('statue_of_liberty', 17, 2014, UNIX_TIMESTAMP()),
+	('transgender_symbol', 718, 4713, UNIX_TIMESTAMP()),
+	('luggage', 848, 2845, UNIX_TIMESTAMP()),
+	('seal', 367, 366, UNIX_TIMESTAMP()),
+	('flag-mt', 440, 2437, UNIX_TIMESTAMP()),
+	('capital_abcd', 352, 2349, UNIX_TIMESTAMP()),
+	('hatching_chick', 928, 5922, UNIX_TIMESTAMP()),
+	('carrot', 313, 5307, UNIX_TIMESTAMP()),
+	('dolls', 147, 7139, UNIX_TIMESTAMP()),
+	('baby', 351, 4346, UNIX_TIMESTAMP()),
+	('heavy_plus_sign', 207, 206, UNIX_TIMESTAMP()),
+	('statue_of_liberty', 971, 4966, UNIX_TIMESTAMP()),
+	('handball', 796, 3792, UNIX_TIMESTAMP()),
+	('flag-pg', 725, 3721, UNIX_TIMESTAMP()),
+	('accept', 980, 5974, UNIX_TIMESTAMP()),
+	('flag-sl', 75, 4070, UNIX_TIMESTAMP()),
+	('earth_americas', 998, 2995, UNIX_TIMESTAMP()),
+	('tumbler_glass', 50, 5044, UNIX_TIMESTAMP()),
+	('large_yellow_circle', 472, 471, UNIX_TIMESTAMP()),
+	('heart_eyes', 239, 1237, UNIX_TIMESTAMP()),
+	('right_anger_bubble', 941, 2938, UNIX_TIMESTAMP()),
+	('man-woman-boy-boy', 499, 7491, UNIX_TIMESTAMP()),
+	('flag-gs', 592, 591, UNIX_TIMESTAMP()),
+	('ballot_box_with_check', 13, 1011, UNIX_TIMESTAMP()),
+	('ice_cube', 231, 230, UNIX_TIMESTAMP()),
+	('-1', 946, 3942, UNIX_TIMESTAMP()),
+	('tokyo_tower', 341, 6334, UNIX_TIMESTAMP()),
+	('leo', 223, 7215, UNIX_TIMESTAMP()),
+	('dvd', 691, 2688, UNIX_TIMESTAMP()),
+	('paperclip', 948, 947, UNIX_TIMESTAMP()),
+	('flag-gh', 496, 4491, UNIX_TIMESTAMP()),
+	('man-bouncing-ball', 593, 2590, UNIX_TIMESTAMP()),
+	('flag-bi', 237, 7229, UNIX_TIMESTAMP()),
+	('man_standing', 616, 615, UNIX_TIMESTAMP()),
+	('straight_ruler', 903, 3899, UNIX_TIMESTAMP()),
+	('ice_skate', 945, 2942, UNIX_TIMESTAMP()),
+	('female-singer', 35, 34, UNIX_TIMESTAMP()),
+	('lower_left_paintbrush', 157, 2154, UNIX_TIMESTAMP()),
+	('cockroach', 436, 6429, UNIX_TIMESTAMP()),
+	('cactus', 11, 6004, UNIX_TIMESTAMP()),
+	('wolf', 359, 358, UNIX_TIMESTAMP()),
+	('camel', 436, 6429, UNIX_TIMESTAMP()),
+	('pancakes', 633, 6626, UNIX_TIMESTAMP()),
+	('o2', 216, 4211, UNIX_TIMESTAMP()),
+	('female-office-worker', 392, 391, UNIX_TIMESTAMP()),
+	('bellhop_bell', 390, 2387, UNIX_TIMESTAMP()),
+	('table_tennis_paddle_and_ball', 387, 4382, UNIX_TIMESTAMP()),
+	('koko', 221, 220, UNIX_TIMESTAMP()),
+	('clock12', 937, 2934, UNIX_TIMESTAMP()),
+	('leo', 647, 3643, UNIX_TIMESTAMP()),
+	('upside_down_face', 121, 2118, UNIX_TIMESTAMP()),
+	('people_holding_hands', 906, 905, UNIX_TIMESTAMP()),
+	('flag-cg', 969, 3965, UNIX_TIMESTAMP()),
+	('nut_and_bolt', 349, 7341, UNIX_TIMESTAMP()),
+	('phone', 190, 6183, UNIX_TIMESTAMP()),
+	('eye', 127, 1125, UNIX_TIMESTAMP()),
+	('flag-tm', 230, 7222, UNIX_TIMESTAMP()),
+	('cow', 86, 5080, UNIX_TIMESTAMP()),
+	('first_quarter_moon_with_face', 838, 837, UNIX_TIMESTAMP()),
+	('abcd', 898, 6891, UNIX_TIMESTAMP()),
+	('flag-zw', 832, 831, UNIX_TIMESTAMP()),
+	('kr', 468, 4463, UNIX_TIMESTAMP()),
+	('crescent_moon', 594, 2591, UNIX_TIMESTAMP()),
+	('flag-ch', 971, 6964, UNIX_TIMESTAMP()),
+	('beginner', 599, 4594, UNIX_TIMESTAMP()),
+	('dancers', 411, 3407, UNIX_TIMESTAMP()),
+	('six_pointed_star', 357, 2354, UNIX_TIMESTAMP()),
+	('control_knobs', 731, 2728, UNIX_TIMESTAMP()),
+	('+1', 731, 2728, UNIX_TIMESTAMP());
@@ -0,0 +1,8761 @@
+INSERT INTO reservation_slots (slot, start_at, end_at)
+VALUES
+	(5, 1700874000, 1700877600),
+	(5, 1700877600, 1700881200),
+	(5, 1700881200, 1700884800),
+	(5, 1700884800, 1700888400),
+	(5, 1700888400, 1700892000),
+	(5, 1700892000, 1700895600),
+	(5, 1700895600, 1700899200),
+	(5, 1700899200, 1700902800),
+	(5, 1700902800, 1700906400),
+	(5, 1700906400, 1700910000),
+	(5, 1700910000, 1700913600),
+	(5, 1700913600, 1700917200),
+	(5, 1700917200, 1700920800),
+	(5, 1700920800, 1700924400),
+	(5, 1700924400, 1700928000),
+	(5, 1700928000, 1700931600),
+	(5, 1700931600, 1700935200),
+	(5, 1700935200, 1700938800),
+	(5, 1700938800, 1700942400),
+	(5, 1700942400, 1700946000),
+	(5, 1700946000, 1700949600),
+	(5, 1700949600, 1700953200),
+	(5, 1700953200, 1700956800),
+	(5, 1700956800, 1700960400),
+	(5, 1700960400, 1700964000),
+	(5, 1700964000, 1700967600),
+	(5, 1700967600, 1700971200),
+	(5, 1700971200, 1700974800),
+	(5, 1700974800, 1700978400),
+	(5, 1700978400, 1700982000),
+	(5, 1700982000, 1700985600),
+	(5, 1700985600, 1700989200),
+	(5, 1700989200, 1700992800),
+	(5, 1700992800, 1700996400),
+	(5, 1700996400, 1701000000),
+	(5, 1701000000, 1701003600),
+	(5, 1701003600, 1701007200),
+	(5, 1701007200, 1701010800),
+	(5, 1701010800, 1701014400),
+	(5, 1701014400, 1701018000),
+	(5, 1701018000, 1701021600),
+	(5, 1701021600, 1701025200),
+	(5, 1701025200, 1701028800),
+	(5, 1701028800, 1701032400),
+	(5, 1701032400, 1701036000),
+	(5, 1701036000, 1701039600),
+	(5, 1701039600, 1701043200),
+	(5, 1701043200, 1701046800),
+	(5, 1701046800, 1701050400),
+	(5, 1701050400, 1701054000),
+	(5, 1701054000, 1701057600),
+	(5, 1701057600, 1701061200),
+	(5, 1701061200, 1701064800),
+	(5, 1701064800, 1701068400),
+	(5, 1701068400, 1701072000),
+	(5, 1701072000, 1701075600),
+	(5, 1701075600, 1701079200),
+	(5, 1701079200, 1701082800),
+	(5, 1701082800, 1701086400),
+	(5, 1701086400, 1701090000),
+	(5, 1701090000, 1701093600),
+	(5, 1701093600, 1701097200),
+	(5, 1701097200, 1701100800),
+	(5, 1701100800, 1701104400),
+	(5, 1701104400, 1701108000),
+	(5, 1701108000, 1701111600),
+	(5, 1701111600, 1701115200),
+	(5, 1701115200, 1701118800),
+	(5, 1701118800, 1701122400),
+	(5, 1701122400, 1701126000),
+	(5, 1701126000, 1701129600),
+	(5, 1701129600, 1701133200),
+	(5, 1701133200, 1701136800),
+	(5, 1701136800, 1701140400),
+	(5, 1701140400, 1701144000),
+	(5, 1701144000, 1701147600),
+	(5, 1701147600, 1701151200),
+	(5, 1701151200, 1701154800),
+	(5, 1701154800, 1701158400),
+	(5, 1701158400, 1701162000),
+	(5, 1701162000, 1701165600),
+	(5, 1701165600, 1701169200),
+	(5, 1701169200, 1701172800),
+	(5, 1701172800, 1701176400),
+	(5, 1701176400, 1701180000),
+	(5, 1701180000, 1701183600),
+	(5, 1701183600, 1701187200),
+	(5, 1701187200, 1701190800),
+	(5, 1701190800, 1701194400),
+	(5, 1701194400, 1701198000),
+	(5, 1701198000, 1701201600),
+	(5, 1701201600, 1701205200),
+	(5, 1701205200, 1701208800),
+	(5, 1701208800, 1701212400),
+	(5, 1701212400, 1701216000),
+	(5, 1701216000, 1701219600),
+	(5, 1701219600, 1701223200),
+	(5, 1701223200, 1701226800),
+	(5, 1701226800, 1701230400),
+	(5, 1701230400, 1701234000),
+	(5, 1701234000, 1701237600),
+	(5, 1701237600, 1701241200),
+	(5, 1701241200, 1701244800),
+	(5, 1701244800, 1701248400),
+	(5, 1701248400, 1701252000),
+	(5, 1701252000, 1701255600),
+	(5, 1701255600, 1701259200),
+	(5, 1701259200, 1701262800),
+	(5, 1701262800, 1701266400),
+	(5, 1701266400, 1701270000),
+	(5, 1701270000, 1701273600),
+	(5, 1701273600, 1701277200),
+	(5, 1701277200, 1701280800),
+	(5, 1701280800, 1701284400),
+	(5, 1701284400, 1701288000),
+	(5, 1701288000, 1701291600),
+	(5, 1701291600, 1701295200),
+	(5, 1701295200, 1701298800),
+	(5, 1701298800, 1701302400),
+	(5, 1701302400, 1701306000),
+	(5, 1701306000, 1701309600),
+	(5, 1701309600, 1701313200),
+	(5, 1701313200, 1701316800),
+	(5, 1701316800, 1701320400),
+	(5, 1701320400, 1701324000),
+	(5, 1701324000, 1701327600),
+	(5, 1701327600, 1701331200),
+	(5, 1701331200, 1701334800),
+	(5, 1701334800, 1701338400),
+	(5, 1701338400, 1701342000),
+	(5, 1701342000, 1701345600),
+	(5, 1701345600, 1701349200),
+	(5, 1701349200, 1701352800),
+	(5, 1701352800, 1701356400),
+	(5, 1701356400, 1701360000),
+	(5, 1701360000, 1701363600),
+	(5, 1701363600, 1701367200),
+	(5, 1701367200, 1701370800),
+	(5, 1701370800, 1701374400),
+	(5, 1701374400, 1701378000),
+	(5, 1701378000, 1701381600),
+	(5, 1701381600, 1701385200),
+	(5, 1701385200, 1701388800),
+	(5, 1701388800, 1701392400),
+	(5, 1701392400, 1701396000),
+	(5, 1701396000, 1701399600),
+	(5, 1701399600, 1701403200),
+	(5, 1701403200, 1701406800),
+	(5, 1701406800, 1701410400),
+	(5, 1701410400, 1701414000),
+	(5, 1701414000, 1701417600),
+	(5, 1701417600, 1701421200),
+	(5, 1701421200, 1701424800),
+	(5, 1701424800, 1701428400),
+	(5, 1701428400, 1701432000),
+	(5, 1701432000, 1701435600),
+	(5, 1701435600, 1701439200),
+	(5, 1701439200, 1701442800),
+	(5, 1701442800, 1701446400),
+	(5, 1701446400, 1701450000),
+	(5, 1701450000, 1701453600),
+	(5, 1701453600, 1701457200),
+	(5, 1701457200, 1701460800),
+	(5, 1701460800, 1701464400),
+	(5, 1701464400, 1701468000),
+	(5, 1701468000, 1701471600),
+	(5, 1701471600, 1701475200),
+	(5, 1701475200, 1701478800),
+	(5, 1701478800, 1701482400),
+	(5, 1701482400, 1701486000),
+	(5, 1701486000, 1701489600),
+	(5, 1701489600, 1701493200),
+	(5, 1701493200, 1701496800),
+	(5, 1701496800, 1701500400),
+	(5, 1701500400, 1701504000),
+	(5, 1701504000, 1701507600),
+	(5, 1701507600, 1701511200),
+	(5, 1701511200, 1701514800),
+	(5, 1701514800, 1701518400),
+	(5, 1701518400, 1701522000),
+	(5, 1701522000, 1701525600),
+	(5, 1701525600, 1701529200),
+	(5, 1701529200, 1701532800),
+	(5, 1701532800, 1701536400),
+	(5, 1701536400, 1701540000),
+	(5, 1701540000, 1701543600),
+	(5, 1701543600, 1701547200),
+	(5, 1701547200, 1701550800),
+	(5, 1701550800, 1701554400),
+	(5, 1701554400, 1701558000),
+	(5, 1701558000, 1701561600),
+	(5, 1701561600, 1701565200),
+	(5, 1701565200, 1701568800),
+	(5, 1701568800, 1701572400),
+	(5, 1701572400, 1701576000),
+	(5, 1701576000, 1701579600),
+	(5, 1701579600, 1701583200),
+	(5, 1701583200, 1701586800),
+	(5, 1701586800, 1701590400),
+	(5, 1701590400, 1701594000),
+	(5, 1701594000, 1701597600),
+	(5, 1701597600, 1701601200),
+	(5, 1701601200, 1701604800),
+	(5, 1701604800, 1701608400),
+	(5, 1701608400, 1701612000),
+	(5, 1701612000, 1701615600),
+	(5, 1701615600, 1701619200),
+	(5, 1701619200, 1701622800),
+	(5, 1701622800, 1701626400),
+	(5, 1701626400, 1701630000),
+	(5, 1701630000, 1701633600),
+	(5, 1701633600, 1701637200),
+	(5, 1701637200, 1701640800),
+	(5, 1701640800, 1701644400),
+	(5, 1701644400, 1701648000),
+	(5, 1701648000, 1701651600),
+	(5, 1701651600, 1701655200),
+	(5, 1701655200, 1701658800),
+	(5, 1701658800, 1701662400),
+	(5, 1701662400, 1701666000),
+	(5, 1701666000, 1701669600),
+	(5, 1701669600, 1701673200),
+	(5, 1701673200, 1701676800),
+	(5, 1701676800, 1701680400),
+	(5, 1701680400, 1701684000),
+	(5, 1701684000, 1701687600),
+	(5, 1701687600, 1701691200),
+	(5, 1701691200, 1701694800),
+	(5, 1701694800, 1701698400),
+	(5, 1701698400, 1701702000),
+	(5, 1701702000, 1701705600),
+	(5, 1701705600, 1701709200),
+	(5, 1701709200, 1701712800),
+	(5, 1701712800, 1701716400),
+	(5, 1701716400, 1701720000),
+	(5, 1701720000, 1701723600),
+	(5, 1701723600, 1701727200),
+	(5, 1701727200, 1701730800),
+	(5, 1701730800, 1701734400),
+	(5, 1701734400, 1701738000),
+	(5, 1701738000, 1701741600),
+	(5, 1701741600, 1701745200),
+	(5, 1701745200, 1701748800),
+	(5, 1701748800, 1701752400),
+	(5, 1701752400, 1701756000),
+	(5, 1701756000, 1701759600),
+	(5, 1701759600, 1701763200),
+	(5, 1701763200, 1701766800),
+	(5, 1701766800, 1701770400),
+	(5, 1701770400, 1701774000),
+	(5, 1701774000, 1701777600),
+	(5, 1701777600, 1701781200),
+	(5, 1701781200, 1701784800),
+	(5, 1701784800, 1701788400),
+	(5, 1701788400, 1701792000),
+	(5, 1701792000, 1701795600),
+	(5, 1701795600, 1701799200),
+	(5, 1701799200, 1701802800),
+	(5, 1701802800, 1701806400),
+	(5, 1701806400, 1701810000),
+	(5, 1701810000, 1701813600),
+	(5, 1701813600, 1701817200),
+	(5, 1701817200, 1701820800),
+	(5, 1701820800, 1701824400),
+	(5, 1701824400, 1701828000),
+	(5, 1701828000, 1701831600),
+	(5, 1701831600, 1701835200),
+	(5, 1701835200, 1701838800),
+	(5, 1701838800, 1701842400),
+	(5, 1701842400, 1701846000),
+	(5, 1701846000, 1701849600),
+	(5, 1701849600, 1701853200),
+	(5, 1701853200, 1701856800),
+	(5, 1701856800, 1701860400),
+	(5, 1701860400, 1701864000),
+	(5, 1701864000, 1701867600),
+	(5, 1701867600, 1701871200),
+	(5, 1701871200, 1701874800),
+	(5, 1701874800, 1701878400),
+	(5, 1701878400, 1701882000),
+	(5, 1701882000, 1701885600),
+	(5, 1701885600, 1701889200),
+	(5, 1701889200, 1701892800),
+	(5, 1701892800, 1701896400),
+	(5, 1701896400, 1701900000),
+	(5, 1701900000, 1701903600),
+	(5, 1701903600, 1701907200),
+	(5, 1701907200, 1701910800),
+	(5, 1701910800, 1701914400),
+	(5, 1701914400, 1701918000),
+	(5, 1701918000, 1701921600),
+	(5, 1701921600, 1701925200),
+	(5, 1701925200, 1701928800),
+	(5, 1701928800, 1701932400),
+	(5, 1701932400, 1701936000),
+	(5, 1701936000, 1701939600),
+	(5, 1701939600, 1701943200),
+	(5, 1701943200, 1701946800),
+	(5, 1701946800, 1701950400),
+	(5, 1701950400, 1701954000),
+	(5, 1701954000, 1701957600),
+	(5, 1701957600, 1701961200),
+	(5, 1701961200, 1701964800),
+	(5, 1701964800, 1701968400),
+	(5, 1701968400, 1701972000),
+	(5, 1701972000, 1701975600),
+	(5, 1701975600, 1701979200),
+	(5, 1701979200, 1701982800),
+	(5, 1701982800, 1701986400),
+	(5, 1701986400, 1701990000),
+	(5, 1701990000, 1701993600),
+	(5, 1701993600, 1701997200),
+	(5, 1701997200, 1702000800),
+	(5, 1702000800, 1702004400),
+	(5, 1702004400, 1702008000),
+	(5, 1702008000, 1702011600),
+	(5, 1702011600, 1702015200),
+	(5, 1702015200, 1702018800),
+	(5, 1702018800, 1702022400),
+	(5, 1702022400, 1702026000),
+	(5, 1702026000, 1702029600),
+	(5, 1702029600, 1702033200),
+	(5, 1702033200, 1702036800),
+	(5, 1702036800, 1702040400),
+	(5, 1702040400, 1702044000),
+	(5, 1702044000, 1702047600),
+	(5, 1702047600, 1702051200),
+	(5, 1702051200, 1702054800),
+	(5, 1702054800, 1702058400),
+	(5, 1702058400, 1702062000),
+	(5, 1702062000, 1702065600),
+	(5, 1702065600, 1702069200),
+	(5, 1702069200, 1702072800),
+	(5, 1702072800, 1702076400),
+	(5, 1702076400, 1702080000),
+	(5, 1702080000, 1702083600),
+	(5, 1702083600, 1702087200),
+	(5, 1702087200, 1702090800),
+	(5, 1702090800, 1702094400),
+	(5, 1702094400, 1702098000),
+	(5, 1702098000, 1702101600),
+	(5, 1702101600, 1702105200),
+	(5, 1702105200, 1702108800),
+	(5, 1702108800, 1702112400),
+	(5, 1702112400, 1702116000),
+	(5, 1702116000, 1702119600),
+	(5, 1702119600, 1702123200),
+	(5, 1702123200, 1702126800),
+	(5, 1702126800, 1702130400),
+	(5, 1702130400, 1702134000),
+	(5, 1702134000, 1702137600),
+	(5, 1702137600, 1702141200),
+	(5, 1702141200, 1702144800),
+	(5, 1702144800, 1702148400),
+	(5, 1702148400, 1702152000),
+	(5, 1702152000, 1702155600),
+	(5, 1702155600, 1702159200),
+	(5, 1702159200, 1702162800),
+	(5, 1702162800, 1702166400),
+	(5, 1702166400, 1702170000),
+	(5, 1702170000, 1702173600),
+	(5, 1702173600, 1702177200),
+	(5, 1702177200, 1702180800),
+	(5, 1702180800, 1702184400),
+	(5, 1702184400, 1702188000),
+	(5, 1702188000, 1702191600),
+	(5, 1702191600, 1702195200),
+	(5, 1702195200, 1702198800),
+	(5, 1702198800, 1702202400),
+	(5, 1702202400, 1702206000),
+	(5, 1702206000, 1702209600),
+	(5, 1702209600, 1702213200),
+	(5, 1702213200, 1702216800),
+	(5, 1702216800, 1702220400),
+	(5, 1702220400, 1702224000),
+	(5, 1702224000, 1702227600),
+	(5, 1702227600, 1702231200),
+	(5, 1702231200, 1702234800),
+	(5, 1702234800, 1702238400),
+	(5, 1702238400, 1702242000),
+	(5, 1702242000, 1702245600),
+	(5, 1702245600, 1702249200),
+	(5, 1702249200, 1702252800),
+	(5, 1702252800, 1702256400),
+	(5, 1702256400, 1702260000),
+	(5, 1702260000, 1702263600),
+	(5, 1702263600, 1702267200),
+	(5, 1702267200, 1702270800),
+	(5, 1702270800, 1702274400),
+	(5, 1702274400, 1702278000),
+	(5, 1702278000, 1702281600),
+	(5, 1702281600, 1702285200),
+	(5, 1702285200, 1702288800),
+	(5, 1702288800, 1702292400),
+	(5, 1702292400, 1702296000),
+	(5, 1702296000, 1702299600),
+	(5, 1702299600, 1702303200),
+	(5, 1702303200, 1702306800),
+	(5, 1702306800, 1702310400),
+	(5, 1702310400, 1702314000),
+	(5, 1702314000, 1702317600),
+	(5, 1702317600, 1702321200),
+	(5, 1702321200, 1702324800),
+	(5, 1702324800, 1702328400),
+	(5, 1702328400, 1702332000),
+	(5, 1702332000, 1702335600),
+	(5, 1702335600, 1702339200),
+	(5, 1702339200, 1702342800),
+	(5, 1702342800, 1702346400),
+	(5, 1702346400, 1702350000),
+	(5, 1702350000, 1702353600),
+	(5, 1702353600, 1702357200),
+	(5, 1702357200, 1702360800),
+	(5, 1702360800, 1702364400),
+	(5, 1702364400, 1702368000),
+	(5, 1702368000, 1702371600),
+	(5, 1702371600, 1702375200),
+	(5, 1702375200, 1702378800),
+	(5, 1702378800, 1702382400),
+	(5, 1702382400, 1702386000),
+	(5, 1702386000, 1702389600),
+	(5, 1702389600, 1702393200),
+	(5, 1702393200, 1702396800),
+	(5, 1702396800, 1702400400),
+	(5, 1702400400, 1702404000),
+	(5, 1702404000, 1702407600),
+	(5, 1702407600, 1702411200),
+	(5, 1702411200, 1702414800),
+	(5, 1702414800, 1702418400),
+	(5, 1702418400, 1702422000),
+	(5, 1702422000, 1702425600),
+	(5, 1702425600, 1702429200),
+	(5, 1702429200, 1702432800),
+	(5, 1702432800, 1702436400),
+	(5, 1702436400, 1702440000),
+	(5, 1702440000, 1702443600),
+	(5, 1702443600, 1702447200),
+	(5, 1702447200, 1702450800),
+	(5, 1702450800, 1702454400),
+	(5, 1702454400, 1702458000),
+	(5, 1702458000, 1702461600),
+	(5, 1702461600, 1702465200),
+	(5, 1702465200, 1702468800),
+	(5, 1702468800, 1702472400),
+	(5, 1702472400, 1702476000),
+	(5, 1702476000, 1702479600),
+	(5, 1702479600, 1702483200),
+	(5, 1702483200, 1702486800),
+	(5, 1702486800, 1702490400),
+	(5, 1702490400, 1702494000),
+	(5, 1702494000, 1702497600),
+	(5, 1702497600, 1702501200),
+	(5, 1702501200, 1702504800),
+	(5, 1702504800, 1702508400),
+	(5, 1702508400, 1702512000),
+	(5, 1702512000, 1702515600),
+	(5, 1702515600, 1702519200),
+	(5, 1702519200, 1702522800),
+	(5, 1702522800, 1702526400),
+	(5, 1702526400, 1702530000),
+	(5, 1702530000, 1702533600),
+	(5, 1702533600, 1702537200),
+	(5, 1702537200, 1702540800),
+	(5, 1702540800, 1702544400),
+	(5, 1702544400, 1702548000),
+	(5, 1702548000, 1702551600),
+	(5, 1702551600, 1702555200),
+	(5, 1702555200, 1702558800),
+	(5, 1702558800, 1702562400),
+	(5, 1702562400, 1702566000),
+	(5, 1702566000, 1702569600),
+	(5, 1702569600, 1702573200),
+	(5, 1702573200, 1702576800),
+	(5, 1702576800, 1702580400),
+	(5, 1702580400, 1702584000),
+	(5, 1702584000, 1702587600),
+	(5, 1702587600, 1702591200),
+	(5, 1702591200, 1702594800),
+	(5, 1702594800, 1702598400),
+	(5, 1702598400, 1702602000),
+	(5, 1702602000, 1702605600),
+	(5, 1702605600, 1702609200),
+	(5, 1702609200, 1702612800),
+	(5, 1702612800, 1702616400),
+	(5, 1702616400, 1702620000),
+	(5, 1702620000, 1702623600),
+	(5, 1702623600, 1702627200),
+	(5, 1702627200, 1702630800),
+	(5, 1702630800, 1702634400),
+	(5, 1702634400, 1702638000),
+	(5, 1702638000, 1702641600),
+	(5, 1702641600, 1702645200),
+	(5, 1702645200, 1702648800),
+	(5, 1702648800, 1702652400),
+	(5, 1702652400, 1702656000),
+	(5, 1702656000, 1702659600),
+	(5, 1702659600, 1702663200),
+	(5, 1702663200, 1702666800),
+	(5, 1702666800, 1702670400),
+	(5, 1702670400, 1702674000),
+	(5, 1702674000, 1702677600),
+	(5, 1702677600, 1702681200),
+	(5, 1702681200, 1702684800),
+	(5, 1702684800, 1702688400),
+	(5, 1702688400, 1702692000),
+	(5, 1702692000, 1702695600),
+	(5, 1702695600, 1702699200),
+	(5, 1702699200, 1702702800),
+	(5, 1702702800, 1702706400),
+	(5, 1702706400, 1702710000),
+	(5, 1702710000, 1702713600),
+	(5, 1702713600, 1702717200),
+	(5, 1702717200, 1702720800),
+	(5, 1702720800, 1702724400),
+	(5, 1702724400, 1702728000),
+	(5, 1702728000, 1702731600),
+	(5, 1702731600, 1702735200),
+	(5, 1702735200, 1702738800),
+	(5, 1702738800, 1702742400),
+	(5, 1702742400, 1702746000),
+	(5, 1702746000, 1702749600),
+	(5, 1702749600, 1702753200),
+	(5, 1702753200, 1702756800),
+	(5, 1702756800, 1702760400),
+	(5, 1702760400, 1702764000),
+	(5, 1702764000, 1702767600),
+	(5, 1702767600, 1702771200),
+	(5, 1702771200, 1702774800),
+	(5, 1702774800, 1702778400),
+	(5, 1702778400, 1702782000),
+	(5, 1702782000, 1702785600),
+	(5, 1702785600, 1702789200),
+	(5, 1702789200, 1702792800),
+	(5, 1702792800, 1702796400),
+	(5, 1702796400, 1702800000),
+	(5, 1702800000, 1702803600),
+	(5, 1702803600, 1702807200),
+	(5, 1702807200, 1702810800),
+	(5, 1702810800, 1702814400),
+	(5, 1702814400, 1702818000),
+	(5, 1702818000, 1702821600),
+	(5, 1702821600, 1702825200),
+	(5, 1702825200, 1702828800),
+	(5, 1702828800, 1702832400),
+	(5, 1702832400, 1702836000),
+	(5, 1702836000, 1702839600),
+	(5, 1702839600, 1702843200),
+	(5, 1702843200, 1702846800),
+	(5, 1702846800, 1702850400),
+	(5, 1702850400, 1702854000),
+	(5, 1702854000, 1702857600),
+	(5, 1702857600, 1702861200),
+	(5, 1702861200, 1702864800),
+	(5, 1702864800, 1702868400),
+	(5, 1702868400, 1702872000),
+	(5, 1702872000, 1702875600),
+	(5, 1702875600, 1702879200),
+	(5, 1702879200, 1702882800),
+	(5, 1702882800, 1702886400),
+	(5, 1702886400, 1702890000),
+	(5, 1702890000, 1702893600),
+	(5, 1702893600, 1702897200),
+	(5, 1702897200, 1702900800),
+	(5, 1702900800, 1702904400),
+	(5, 1702904400, 1702908000),
+	(5, 1702908000, 1702911600),
+	(5, 1702911600, 1702915200),
+	(5, 1702915200, 1702918800),
+	(5, 1702918800, 1702922400),
+	(5, 1702922400, 1702926000),
+	(5, 1702926000, 1702929600),
+	(5, 1702929600, 1702933200),
+	(5, 1702933200, 1702936800),
+	(5, 1702936800, 1702940400),
+	(5, 1702940400, 1702944000),
+	(5, 1702944000, 1702947600),
+	(5, 1702947600, 1702951200),
+	(5, 1702951200, 1702954800),
+	(5, 1702954800, 1702958400),
+	(5, 1702958400, 1702962000),
+	(5, 1702962000, 1702965600),
+	(5, 1702965600, 1702969200),
+	(5, 1702969200, 1702972800),
+	(5, 1702972800, 1702976400),
+	(5, 1702976400, 1702980000),
+	(5, 1702980000, 1702983600),
+	(5, 1702983600, 1702987200),
+	(5, 1702987200, 1702990800),
+	(5, 1702990800, 1702994400),
+	(5, 1702994400, 1702998000),
+	(5, 1702998000, 1703001600),
+	(5, 1703001600, 1703005200),
+	(5, 1703005200, 1703008800),
+	(5, 1703008800, 1703012400),
+	(5, 1703012400, 1703016000),
+	(5, 1703016000, 1703019600),
+	(5, 1703019600, 1703023200),
+	(5, 1703023200, 1703026800),
+	(5, 1703026800, 1703030400),
+	(5, 1703030400, 1703034000),
+	(5, 1703034000, 1703037600),
+	(5, 1703037600, 1703041200),
+	(5, 1703041200, 1703044800),
+	(5, 1703044800, 1703048400),
+	(5, 1703048400, 1703052000),
+	(5, 1703052000, 1703055600),
+	(5, 1703055600, 1703059200),
+	(5, 1703059200, 1703062800),
+	(5, 1703062800, 1703066400),
+	(5, 1703066400, 1703070000),
+	(5, 1703070000, 1703073600),
+	(5, 1703073600, 1703077200),
+	(5, 1703077200, 1703080800),
+	(5, 1703080800, 1703084400),
+	(5, 1703084400, 1703088000),
+	(5, 1703088000, 1703091600),
+	(5, 1703091600, 1703095200),
+	(5, 1703095200, 1703098800),
+	(5, 1703098800, 1703102400),
+	(5, 1703102400, 1703106000),
+	(5, 1703106000, 1703109600),
+	(5, 1703109600, 1703113200),
+	(5, 1703113200, 1703116800),
+	(5, 1703116800, 1703120400),
+	(5, 1703120400, 1703124000),
+	(5, 1703124000, 1703127600),
+	(5, 1703127600, 1703131200),
+	(5, 1703131200, 1703134800),
+	(5, 1703134800, 1703138400),
+	(5, 1703138400, 1703142000),
+	(5, 1703142000, 1703145600),
+	(5, 1703145600, 1703149200),
+	(5, 1703149200, 1703152800),
+	(5, 1703152800, 1703156400),
+	(5, 1703156400, 1703160000),
+	(5, 1703160000, 1703163600),
+	(5, 1703163600, 1703167200),
+	(5, 1703167200, 1703170800),
+	(5, 1703170800, 1703174400),
+	(5, 1703174400, 1703178000),
+	(5, 1703178000, 1703181600),
+	(5, 1703181600, 1703185200),
+	(5, 1703185200, 1703188800),
+	(5, 1703188800, 1703192400),
+	(5, 1703192400, 1703196000),
+	(5, 1703196000, 1703199600),
+	(5, 1703199600, 1703203200),
+	(5, 1703203200, 1703206800),
+	(5, 1703206800, 1703210400),
+	(5, 1703210400, 1703214000),
+	(5, 1703214000, 1703217600),
+	(5, 1703217600, 1703221200),
+	(5, 1703221200, 1703224800),
+	(5, 1703224800, 1703228400),
+	(5, 1703228400, 1703232000),
+	(5, 1703232000, 1703235600),
+	(5, 1703235600, 1703239200),
+	(5, 1703239200, 1703242800),
+	(5, 1703242800, 1703246400),
+	(5, 1703246400, 1703250000),
+	(5, 1703250000, 1703253600),
+	(5, 1703253600, 1703257200),
+	(5, 1703257200, 1703260800),
+	(5, 1703260800, 1703264400),
+	(5, 1703264400, 1703268000),
+	(5, 1703268000, 1703271600),
+	(5, 1703271600, 1703275200),
+	(5, 1703275200, 1703278800),
+	(5, 1703278800, 1703282400),
+	(5, 1703282400, 1703286000),
+	(5, 1703286000, 1703289600),
+	(5, 1703289600, 1703293200),
+	(5, 1703293200, 1703296800),
+	(5, 1703296800, 1703300400),
+	(5, 1703300400, 1703304000),
+	(5, 1703304000, 1703307600),
+	(5, 1703307600, 1703311200),
+	(5, 1703311200, 1703314800),
+	(5, 1703314800, 1703318400),
+	(5, 1703318400, 1703322000),
+	(5, 1703322000, 1703325600),
+	(5, 1703325600, 1703329200),
+	(5, 1703329200, 1703332800),
+	(5, 1703332800, 1703336400),
+	(5, 1703336400, 1703340000),
+	(5, 1703340000, 1703343600),
+	(5, 1703343600, 1703347200),
+	(5, 1703347200, 1703350800),
+	(5, 1703350800, 1703354400),
+	(5, 1703354400, 1703358000),
+	(5, 1703358000, 1703361600),
+	(5, 1703361600, 1703365200),
+	(5, 1703365200, 1703368800),
+	(5, 1703368800, 1703372400),
+	(5, 1703372400, 1703376000),
+	(5, 1703376000, 1703379600),
+	(5, 1703379600, 1703383200),
+	(5, 1703383200, 1703386800),
+	(5, 1703386800, 1703390400),
+	(5, 1703390400, 1703394000),
+	(5, 1703394000, 1703397600),
+	(5, 1703397600, 1703401200),
+	(5, 1703401200, 1703404800),
+	(5, 1703404800, 1703408400),
+	(5, 1703408400, 1703412000),
+	(5, 1703412000, 1703415600),
+	(5, 1703415600, 1703419200),
+	(5, 1703419200, 1703422800),
+	(5, 1703422800, 1703426400),
+	(5, 1703426400, 1703430000),
+	(5, 1703430000, 1703433600),
+	(5, 1703433600, 1703437200),
+	(5, 1703437200, 1703440800),
+	(5, 1703440800, 1703444400),
+	(5, 1703444400, 1703448000),
+	(5, 1703448000, 1703451600),
+	(5, 1703451600, 1703455200),
+	(5, 1703455200, 1703458800),
+	(5, 1703458800, 1703462400),
+	(5, 1703462400, 1703466000),
+	(5, 1703466000, 1703469600),
+	(5, 1703469600, 1703473200),
+	(5, 1703473200, 1703476800),
+	(5, 1703476800, 1703480400),
+	(5, 1703480400, 1703484000),
+	(5, 1703484000, 1703487600),
+	(5, 1703487600, 1703491200),
+	(5, 1703491200, 1703494800),
+	(5, 1703494800, 1703498400),
+	(5, 1703498400, 1703502000),
+	(5, 1703502000, 1703505600),
+	(5, 1703505600, 1703509200),
+	(5, 1703509200, 1703512800),
+	(5, 1703512800, 1703516400),
+	(5, 1703516400, 1703520000),
+	(5, 1703520000, 1703523600),
+	(5, 1703523600, 1703527200),
+	(5, 1703527200, 1703530800),
+	(5, 1703530800, 1703534400),
+	(5, 1703534400, 1703538000),
+	(5, 1703538000, 1703541600),
+	(5, 1703541600, 1703545200),
+	(5, 1703545200, 1703548800),
+	(5, 1703548800, 1703552400),
+	(5, 1703552400, 1703556000),
+	(5, 1703556000, 1703559600),
+	(5, 1703559600, 1703563200),
+	(5, 1703563200, 1703566800),
+	(5, 1703566800, 1703570400),
+	(5, 1703570400, 1703574000),
+	(5, 1703574000, 1703577600),
+	(5, 1703577600, 1703581200),
+	(5, 1703581200, 1703584800),
+	(5, 1703584800, 1703588400),
+	(5, 1703588400, 1703592000),
+	(5, 1703592000, 1703595600),
+	(5, 1703595600, 1703599200),
+	(5, 1703599200, 1703602800),
+	(5, 1703602800, 1703606400),
+	(5, 1703606400, 1703610000),
+	(5, 1703610000, 1703613600),
+	(5, 1703613600, 1703617200),
+	(5, 1703617200, 1703620800),
+	(5, 1703620800, 1703624400),
+	(5, 1703624400, 1703628000),
+	(5, 1703628000, 1703631600),
+	(5, 1703631600, 1703635200),
+	(5, 1703635200, 1703638800),
+	(5, 1703638800, 1703642400),
+	(5, 1703642400, 1703646000),
+	(5, 1703646000, 1703649600),
+	(5, 1703649600, 1703653200),
+	(5, 1703653200, 1703656800),
+	(5, 1703656800, 1703660400),
+	(5, 1703660400, 1703664000),
+	(5, 1703664000, 1703667600),
+	(5, 1703667600, 1703671200),
+	(5, 1703671200, 1703674800),
+	(5, 1703674800, 1703678400),
+	(5, 1703678400, 1703682000),
+	(5, 1703682000, 1703685600),
+	(5, 1703685600, 1703689200),
+	(5, 1703689200, 1703692800),
+	(5, 1703692800, 1703696400),
+	(5, 1703696400, 1703700000),
+	(5, 1703700000, 1703703600),
+	(5, 1703703600, 1703707200),
+	(5, 1703707200, 1703710800),
+	(5, 1703710800, 1703714400),
+	(5, 1703714400, 1703718000),
+	(5, 1703718000, 1703721600),
+	(5, 1703721600, 1703725200),
+	(5, 1703725200, 1703728800),
+	(5, 1703728800, 1703732400),
+	(5, 1703732400, 1703736000),
+	(5, 1703736000, 1703739600),
+	(5, 1703739600, 1703743200),
+	(5, 1703743200, 1703746800),
+	(5, 1703746800, 1703750400),
+	(5, 1703750400, 1703754000),
+	(5, 1703754000, 1703757600),
+	(5, 1703757600, 1703761200),
+	(5, 1703761200, 1703764800),
+	(5, 1703764800, 1703768400),
+	(5, 1703768400, 1703772000),
+	(5, 1703772000, 1703775600),
+	(5, 1703775600, 1703779200),
+	(5, 1703779200, 1703782800),
+	(5, 1703782800, 1703786400),
+	(5, 1703786400, 1703790000),
+	(5, 1703790000, 1703793600),
+	(5, 1703793600, 1703797200),
+	(5, 1703797200, 1703800800),
+	(5, 1703800800, 1703804400),
+	(5, 1703804400, 1703808000),
+	(5, 1703808000, 1703811600),
+	(5, 1703811600, 1703815200),
+	(5, 1703815200, 1703818800),
+	(5, 1703818800, 1703822400),
+	(5, 1703822400, 1703826000),
+	(5, 1703826000, 1703829600),
+	(5, 1703829600, 1703833200),
+	(5, 1703833200, 1703836800),
+	(5, 1703836800, 1703840400),
+	(5, 1703840400, 1703844000),
+	(5, 1703844000, 1703847600),
+	(5, 1703847600, 1703851200),
+	(5, 1703851200, 1703854800),
+	(5, 1703854800, 1703858400),
+	(5, 1703858400, 1703862000),
+	(5, 1703862000, 1703865600),
+	(5, 1703865600, 1703869200),
+	(5, 1703869200, 1703872800),
+	(5, 1703872800, 1703876400),
+	(5, 1703876400, 1703880000),
+	(5, 1703880000, 1703883600),
+	(5, 1703883600, 1703887200),
+	(5, 1703887200, 1703890800),
+	(5, 1703890800, 1703894400),
+	(5, 1703894400, 1703898000),
+	(5, 1703898000, 1703901600),
+	(5, 1703901600, 1703905200),
+	(5, 1703905200, 1703908800),
+	(5, 1703908800, 1703912400),
+	(5, 1703912400, 1703916000),
+	(5, 1703916000, 1703919600),
+	(5, 1703919600, 1703923200),
+	(5, 1703923200, 1703926800),
+	(5, 1703926800, 1703930400),
+	(5, 1703930400, 1703934000),
+	(5, 1703934000, 1703937600),
+	(5, 1703937600, 1703941200),
+	(5, 1703941200, 1703944800),
+	(5, 1703944800, 1703948400),
+	(5, 1703948400, 1703952000),
+	(5, 1703952000, 1703955600),
+	(5, 1703955600, 1703959200),
+	(5, 1703959200, 1703962800),
+	(5, 1703962800, 1703966400),
+	(5, 1703966400, 1703970000),
+	(5, 1703970000, 1703973600),
+	(5, 1703973600, 1703977200),
+	(5, 1703977200, 1703980800),
+	(5, 1703980800, 1703984400),
+	(5, 1703984400, 1703988000),
+	(5, 1703988000, 1703991600),
+	(5, 1703991600, 1703995200),
+	(5, 1703995200, 1703998800),
+	(5, 1703998800, 1704002400),
+	(5, 1704002400, 1704006000),
+	(5, 1704006000, 1704009600),
+	(5, 1704009600, 1704013200),
+	(5, 1704013200, 1704016800),
+	(5, 1704016800, 1704020400),
+	(5, 1704020400, 1704024000),
+	(5, 1704024000, 1704027600),
+	(5, 1704027600, 1704031200),
+	(5, 1704031200, 1704034800),
+	(5, 1704034800, 1704038400),
+	(5, 1704038400, 1704042000),
+	(5, 1704042000, 1704045600),
+	(5, 1704045600, 1704049200),
+	(5, 1704049200, 1704052800),
+	(5, 1704052800, 1704056400),
+	(5, 1704056400, 1704060000),
+	(5, 1704060000, 1704063600),
+	(5, 1704063600, 1704067200),
+	(5, 1704067200, 1704070800),
+	(5, 1704070800, 1704074400),
+	(5, 1704074400, 1704078000),
+	(5, 1704078000, 1704081600),
+	(5, 1704081600, 1704085200),
+	(5, 1704085200, 1704088800),
+	(5, 1704088800, 1704092400),
+	(5, 1704092400, 1704096000),
+	(5, 1704096000, 1704099600),
+	(5, 1704099600, 1704103200),
+	(5, 1704103200, 1704106800),
+	(5, 1704106800, 1704110400),
+	(5, 1704110400, 1704114000),
+	(5, 1704114000, 1704117600),
+	(5, 1704117600, 1704121200),
+	(5, 1704121200, 1704124800),
+	(5, 1704124800, 1704128400),
+	(5, 1704128400, 1704132000),
+	(5, 1704132000, 1704135600),
+	(5, 1704135600, 1704139200),
+	(5, 1704139200, 1704142800),
+	(5, 1704142800, 1704146400),
+	(5, 1704146400, 1704150000),
+	(5, 1704150000, 1704153600),
+	(5, 1704153600, 1704157200),
+	(5, 1704157200, 1704160800),
+	(5, 1704160800, 1704164400),
+	(5, 1704164400, 1704168000),
+	(5, 1704168000, 1704171600),
+	(5, 1704171600, 1704175200),
+	(5, 1704175200, 1704178800),
+	(5, 1704178800, 1704182400),
+	(5, 1704182400, 1704186000),
+	(5, 1704186000, 1704189600),
+	(5, 1704189600, 1704193200),
+	(5, 1704193200, 1704196800),
+	(5, 1704196800, 1704200400),
+	(5, 1704200400, 1704204000),
+	(5, 1704204000, 1704207600),
+	(5, 1704207600, 1704211200),
+	(5, 1704211200, 1704214800),
+	(5, 1704214800, 1704218400),
+	(5, 1704218400, 1704222000),
+	(5, 1704222000, 1704225600),
+	(5, 1704225600, 1704229200),
+	(5, 1704229200, 1704232800),
+	(5, 1704232800, 1704236400),
+	(5, 1704236400, 1704240000),
+	(5, 1704240000, 1704243600),
+	(5, 1704243600, 1704247200),
+	(5, 1704247200, 1704250800),
+	(5, 1704250800, 1704254400),
+	(5, 1704254400, 1704258000),
+	(5, 1704258000, 1704261600),
+	(5, 1704261600, 1704265200),
+	(5, 1704265200, 1704268800),
+	(5, 1704268800, 1704272400),
+	(5, 1704272400, 1704276000),
+	(5, 1704276000, 1704279600),
+	(5, 1704279600, 1704283200),
+	(5, 1704283200, 1704286800),
+	(5, 1704286800, 1704290400),
+	(5, 1704290400, 1704294000),
+	(5, 1704294000, 1704297600),
+	(5, 1704297600, 1704301200),
+	(5, 1704301200, 1704304800),
+	(5, 1704304800, 1704308400),
+	(5, 1704308400, 1704312000),
+	(5, 1704312000, 1704315600),
+	(5, 1704315600, 1704319200),
+	(5, 1704319200, 1704322800),
+	(5, 1704322800, 1704326400),
+	(5, 1704326400, 1704330000),
+	(5, 1704330000, 1704333600),
+	(5, 1704333600, 1704337200),
+	(5, 1704337200, 1704340800),
+	(5, 1704340800, 1704344400),
+	(5, 1704344400, 1704348000),
+	(5, 1704348000, 1704351600),
+	(5, 1704351600, 1704355200),
+	(5, 1704355200, 1704358800),
+	(5, 1704358800, 1704362400),
+	(5, 1704362400, 1704366000),
+	(5, 1704366000, 1704369600),
+	(5, 1704369600, 1704373200),
+	(5, 1704373200, 1704376800),
+	(5, 1704376800, 1704380400),
+	(5, 1704380400, 1704384000),
+	(5, 1704384000, 1704387600),
+	(5, 1704387600, 1704391200),
+	(5, 1704391200, 1704394800),
+	(5, 1704394800, 1704398400),
+	(5, 1704398400, 1704402000),
+	(5, 1704402000, 1704405600),
+	(5, 1704405600, 1704409200),
+	(5, 1704409200, 1704412800),
+	(5, 1704412800, 1704416400),
+	(5, 1704416400, 1704420000),
+	(5, 1704420000, 1704423600),
+	(5, 1704423600, 1704427200),
+	(5, 1704427200, 1704430800),
+	(5, 1704430800, 1704434400),
+	(5, 1704434400, 1704438000),
+	(5, 1704438000, 1704441600),
+	(5, 1704441600, 1704445200),
+	(5, 1704445200, 1704448800),
+	(5, 1704448800, 1704452400),
+	(5, 1704452400, 1704456000),
+	(5, 1704456000, 1704459600),
+	(5, 1704459600, 1704463200),
+	(5, 1704463200, 1704466800),
+	(5, 1704466800, 1704470400),
+	(5, 1704470400, 1704474000),
+	(5, 1704474000, 1704477600),
+	(5, 1704477600, 1704481200),
+	(5, 1704481200, 1704484800),
+	(5, 1704484800, 1704488400),
+	(5, 1704488400, 1704492000),
+	(5, 1704492000, 1704495600),
+	(5, 1704495600, 1704499200),
+	(5, 1704499200, 1704502800),
+	(5, 1704502800, 1704506400),
+	(5, 1704506400, 1704510000),
+	(5, 1704510000, 1704513600),
+	(5, 1704513600, 1704517200),
+	(5, 1704517200, 1704520800),
+	(5, 1704520800, 1704524400),
+	(5, 1704524400, 1704528000),
+	(5, 1704528000, 1704531600),
+	(5, 1704531600, 1704535200),
+	(5, 1704535200, 1704538800),
+	(5, 1704538800, 1704542400),
+	(5, 1704542400, 1704546000),
+	(5, 1704546000, 1704549600),
+	(5, 1704549600, 1704553200),
+	(5, 1704553200, 1704556800),
+	(5, 1704556800, 1704560400),
+	(5, 1704560400, 1704564000),
+	(5, 1704564000, 1704567600),
+	(5, 1704567600, 1704571200),
+	(5, 1704571200, 1704574800),
+	(5, 1704574800, 1704578400),
+	(5, 1704578400, 1704582000),
+	(5, 1704582000, 1704585600),
+	(5, 1704585600, 1704589200),
+	(5, 1704589200, 1704592800),
+	(5, 1704592800, 1704596400),
+	(5, 1704596400, 1704600000),
+	(5, 1704600000, 1704603600),
+	(5, 1704603600, 1704607200),
+	(5, 1704607200, 1704610800),
+	(5, 1704610800, 1704614400),
+	(5, 1704614400, 1704618000),
+	(5, 1704618000, 1704621600),
+	(5, 1704621600, 1704625200),
+	(5, 1704625200, 1704628800),
+	(5, 1704628800, 1704632400),
+	(5, 1704632400, 1704636000),
+	(5, 1704636000, 1704639600),
+	(5, 1704639600, 1704643200),
+	(5, 1704643200, 1704646800),
+	(5, 1704646800, 1704650400),
+	(5, 1704650400, 1704654000),
+	(5, 1704654000, 1704657600),
+	(5, 1704657600, 1704661200),
+	(5, 1704661200, 1704664800),
+	(5, 1704664800, 1704668400),
+	(5, 1704668400, 1704672000),
+	(5, 1704672000, 1704675600),
+	(5, 1704675600, 1704679200),
+	(5, 1704679200, 1704682800),
+	(5, 1704682800, 1704686400),
+	(5, 1704686400, 1704690000),
+	(5, 1704690000, 1704693600),
+	(5, 1704693600, 1704697200),
+	(5, 1704697200, 1704700800),
+	(5, 1704700800, 1704704400),
+	(5, 1704704400, 1704708000),
+	(5, 1704708000, 1704711600),
+	(5, 1704711600, 1704715200),
+	(5, 1704715200, 1704718800),
+	(5, 1704718800, 1704722400),
+	(5, 1704722400, 1704726000),
+	(5, 1704726000, 1704729600),
+	(5, 1704729600, 1704733200),
+	(5, 1704733200, 1704736800),
+	(5, 1704736800, 1704740400),
+	(5, 1704740400, 1704744000),
+	(5, 1704744000, 1704747600),
+	(5, 1704747600, 1704751200),
+	(5, 1704751200, 1704754800),
+	(5, 1704754800, 1704758400),
+	(5, 1704758400, 1704762000),
+	(5, 1704762000, 1704765600),
+	(5, 1704765600, 1704769200),
+	(5, 1704769200, 1704772800),
+	(5, 1704772800, 1704776400),
+	(5, 1704776400, 1704780000),
+	(5, 1704780000, 1704783600),
+	(5, 1704783600, 1704787200),
+	(5, 1704787200, 1704790800),
+	(5, 1704790800, 1704794400),
+	(5, 1704794400, 1704798000),
+	(5, 1704798000, 1704801600),
+	(5, 1704801600, 1704805200),
+	(5, 1704805200, 1704808800),
+	(5, 1704808800, 1704812400),
+	(5, 1704812400, 1704816000),
+	(5, 1704816000, 1704819600),
+	(5, 1704819600, 1704823200),
+	(5, 1704823200, 1704826800),
+	(5, 1704826800, 1704830400),
+	(5, 1704830400, 1704834000),
+	(5, 1704834000, 1704837600),
+	(5, 1704837600, 1704841200),
+	(5, 1704841200, 1704844800),
+	(5, 1704844800, 1704848400),
+	(5, 1704848400, 1704852000),
+	(5, 1704852000, 1704855600),
+	(5, 1704855600, 1704859200),
+	(5, 1704859200, 1704862800),
+	(5, 1704862800, 1704866400),
+	(5, 1704866400, 1704870000),
+	(5, 1704870000, 1704873600),
+	(5, 1704873600, 1704877200),
+	(5, 1704877200, 1704880800),
+	(5, 1704880800, 1704884400),
+	(5, 1704884400, 1704888000),
+	(5, 1704888000, 1704891600),
+	(5, 1704891600, 1704895200),
+	(5, 1704895200, 1704898800),
+	(5, 1704898800, 1704902400),
+	(5, 1704902400, 1704906000),
+	(5, 1704906000, 1704909600),
+	(5, 1704909600, 1704913200),
+	(5, 1704913200, 1704916800),
+	(5, 1704916800, 1704920400),
+	(5, 1704920400, 1704924000),
+	(5, 1704924000, 1704927600),
+	(5, 1704927600, 1704931200),
+	(5, 1704931200, 1704934800),
+	(5, 1704934800, 1704938400),
+	(5, 1704938400, 1704942000),
+	(5, 1704942000, 1704945600),
+	(5, 1704945600, 1704949200),
+	(5, 1704949200, 1704952800),
+	(5, 1704952800, 1704956400),
+	(5, 1704956400, 1704960000),
+	(5, 1704960000, 1704963600),
+	(5, 1704963600, 1704967200),
+	(5, 1704967200, 1704970800),
+	(5, 1704970800, 1704974400),
+	(5, 1704974400, 1704978000),
+	(5, 1704978000, 1704981600),
+	(5, 1704981600, 1704985200),
+	(5, 1704985200, 1704988800),
+	(5, 1704988800, 1704992400),
+	(5, 1704992400, 1704996000),
+	(5, 1704996000, 1704999600),
+	(5, 1704999600, 1705003200),
+	(5, 1705003200, 1705006800),
+	(5, 1705006800, 1705010400),
+	(5, 1705010400, 1705014000),
+	(5, 1705014000, 1705017600),
+	(5, 1705017600, 1705021200),
+	(5, 1705021200, 1705024800),
+	(5, 1705024800, 1705028400),
+	(5, 1705028400, 1705032000),
+	(5, 1705032000, 1705035600),
+	(5, 1705035600, 1705039200),
+	(5, 1705039200, 1705042800),
+	(5, 1705042800, 1705046400),
+	(5, 1705046400, 1705050000),
+	(5, 1705050000, 1705053600),
+	(5, 1705053600, 1705057200),
+	(5, 1705057200, 1705060800),
+	(5, 1705060800, 1705064400),
+	(5, 1705064400, 1705068000),
+	(5, 1705068000, 1705071600),
+	(5, 1705071600, 1705075200),
+	(5, 1705075200, 1705078800),
+	(5, 1705078800, 1705082400),
+	(5, 1705082400, 1705086000),
+	(5, 1705086000, 1705089600),
+	(5, 1705089600, 1705093200),
+	(5, 1705093200, 1705096800),
+	(5, 1705096800, 1705100400),
+	(5, 1705100400, 1705104000),
+	(5, 1705104000, 1705107600),
+	(5, 1705107600, 1705111200),
+	(5, 1705111200, 1705114800),
+	(5, 1705114800, 1705118400),
+	(5, 1705118400, 1705122000),
+	(5, 1705122000, 1705125600),
+	(5, 1705125600, 1705129200),
+	(5, 1705129200, 1705132800),
+	(5, 1705132800, 1705136400),
+	(5, 1705136400, 1705140000),
+	(5, 1705140000, 1705143600),
+	(5, 1705143600, 1705147200),
+	(5, 1705147200, 1705150800),
+	(5, 1705150800, 1705154400),
+	(5, 1705154400, 1705158000),
+	(5, 1705158000, 1705161600),
+	(5, 1705161600, 1705165200),
+	(5, 1705165200, 1705168800),
+	(5, 1705168800, 1705172400),
+	(5, 1705172400, 1705176000),
+	(5, 1705176000, 1705179600),
+	(5, 1705179600, 1705183200),
+	(5, 1705183200, 1705186800),
+	(5, 1705186800, 1705190400),
+	(5, 1705190400, 1705194000),
+	(5, 1705194000, 1705197600),
+	(5, 1705197600, 1705201200),
+	(5, 1705201200, 1705204800),
+	(5, 1705204800, 1705208400),
+	(5, 1705208400, 1705212000),
+	(5, 1705212000, 1705215600),
+	(5, 1705215600, 1705219200),
+	(5, 1705219200, 1705222800),
+	(5, 1705222800, 1705226400),
+	(5, 1705226400, 1705230000),
+	(5, 1705230000, 1705233600),
+	(5, 1705233600, 1705237200),
+	(5, 1705237200, 1705240800),
+	(5, 1705240800, 1705244400),
+	(5, 1705244400, 1705248000),
+	(5, 1705248000, 1705251600),
+	(5, 1705251600, 1705255200),
+	(5, 1705255200, 1705258800),
+	(5, 1705258800, 1705262400),
+	(5, 1705262400, 1705266000),
+	(5, 1705266000, 1705269600),
+	(5, 1705269600, 1705273200),
+	(5, 1705273200, 1705276800),
+	(5, 1705276800, 1705280400),
+	(5, 1705280400, 1705284000),
+	(5, 1705284000, 1705287600),
+	(5, 1705287600, 1705291200),
+	(5, 1705291200, 1705294800),
+	(5, 1705294800, 1705298400),
+	(5, 1705298400, 1705302000),
+	(5, 1705302000, 1705305600),
+	(5, 1705305600, 1705309200),
+	(5, 1705309200, 1705312800),
+	(5, 1705312800, 1705316400),
+	(5, 1705316400, 1705320000),
+	(5, 1705320000, 1705323600),
+	(5, 1705323600, 1705327200),
+	(5, 1705327200, 1705330800),
+	(5, 1705330800, 1705334400),
+	(5, 1705334400, 1705338000),
+	(5, 1705338000, 1705341600),
+	(5, 1705341600, 1705345200),
+	(5, 1705345200, 1705348800),
+	(5, 1705348800, 1705352400),
+	(5, 1705352400, 1705356000),
+	(5, 1705356000, 1705359600),
+	(5, 1705359600, 1705363200),
+	(5, 1705363200, 1705366800),
+	(5, 1705366800, 1705370400),
+	(5, 1705370400, 1705374000),
+	(5, 1705374000, 1705377600),
+	(5, 1705377600, 1705381200),
+	(5, 1705381200, 1705384800),
+	(5, 1705384800, 1705388400),
+	(5, 1705388400, 1705392000),
+	(5, 1705392000, 1705395600),
+	(5, 1705395600, 1705399200),
+	(5, 1705399200, 1705402800),
+	(5, 1705402800, 1705406400),
+	(5, 1705406400, 1705410000),
+	(5, 1705410000, 1705413600),
+	(5, 1705413600, 1705417200),
+	(5, 1705417200, 1705420800),
+	(5, 1705420800, 1705424400),
+	(5, 1705424400, 1705428000),
+	(5, 1705428000, 1705431600),
+	(5, 1705431600, 1705435200),
+	(5, 1705435200, 1705438800),
+	(5, 1705438800, 1705442400),
+	(5, 1705442400, 1705446000),
+	(5, 1705446000, 1705449600),
+	(5, 1705449600, 1705453200),
+	(5, 1705453200, 1705456800),
+	(5, 1705456800, 1705460400),
+	(5, 1705460400, 1705464000),
+	(5, 1705464000, 1705467600),
+	(5, 1705467600, 1705471200),
+	(5, 1705471200, 1705474800),
+	(5, 1705474800, 1705478400),
+	(5, 1705478400, 1705482000),
+	(5, 1705482000, 1705485600),
+	(5, 1705485600, 1705489200),
+	(5, 1705489200, 1705492800),
+	(5, 1705492800, 1705496400),
+	(5, 1705496400, 1705500000),
+	(5, 1705500000, 1705503600),
+	(5, 1705503600, 1705507200),
+	(5, 1705507200, 1705510800),
+	(5, 1705510800, 1705514400),
+	(5, 1705514400, 1705518000),
+	(5, 1705518000, 1705521600),
+	(5, 1705521600, 1705525200),
+	(5, 1705525200, 1705528800),
+	(5, 1705528800, 1705532400),
+	(5, 1705532400, 1705536000),
+	(5, 1705536000, 1705539600),
+	(5, 1705539600, 1705543200),
+	(5, 1705543200, 1705546800),
+	(5, 1705546800, 1705550400),
+	(5, 1705550400, 1705554000),
+	(5, 1705554000, 1705557600),
+	(5, 1705557600, 1705561200),
+	(5, 1705561200, 1705564800),
+	(5, 1705564800, 1705568400),
+	(5, 1705568400, 1705572000),
+	(5, 1705572000, 1705575600),
+	(5, 1705575600, 1705579200),
+	(5, 1705579200, 1705582800),
+	(5, 1705582800, 1705586400),
+	(5, 1705586400, 1705590000),
+	(5, 1705590000, 1705593600),
+	(5, 1705593600, 1705597200),
+	(5, 1705597200, 1705600800),
+	(5, 1705600800, 1705604400),
+	(5, 1705604400, 1705608000),
+	(5, 1705608000, 1705611600),
+	(5, 1705611600, 1705615200),
+	(5, 1705615200, 1705618800),
+	(5, 1705618800, 1705622400),
+	(5, 1705622400, 1705626000),
+	(5, 1705626000, 1705629600),
+	(5, 1705629600, 1705633200),
+	(5, 1705633200, 1705636800),
+	(5, 1705636800, 1705640400),
+	(5, 1705640400, 1705644000),
+	(5, 1705644000, 1705647600),
+	(5, 1705647600, 1705651200),
+	(5, 1705651200, 1705654800),
+	(5, 1705654800, 1705658400),
+	(5, 1705658400, 1705662000),
+	(5, 1705662000, 1705665600),
+	(5, 1705665600, 1705669200),
+	(5, 1705669200, 1705672800),
+	(5, 1705672800, 1705676400),
+	(5, 1705676400, 1705680000),
+	(5, 1705680000, 1705683600),
+	(5, 1705683600, 1705687200),
+	(5, 1705687200, 1705690800),
+	(5, 1705690800, 1705694400),
+	(5, 1705694400, 1705698000),
+	(5, 1705698000, 1705701600),
+	(5, 1705701600, 1705705200),
+	(5, 1705705200, 1705708800),
+	(5, 1705708800, 1705712400),
+	(5, 1705712400, 1705716000),
+	(5, 1705716000, 1705719600),
+	(5, 1705719600, 1705723200),
+	(5, 1705723200, 1705726800),
+	(5, 1705726800, 1705730400),
+	(5, 1705730400, 1705734000),
+	(5, 1705734000, 1705737600),
+	(5, 1705737600, 1705741200),
+	(5, 1705741200, 1705744800),
+	(5, 1705744800, 1705748400),
+	(5, 1705748400, 1705752000),
+	(5, 1705752000, 1705755600),
+	(5, 1705755600, 1705759200),
+	(5, 1705759200, 1705762800),
+	(5, 1705762800, 1705766400),
+	(5, 1705766400, 1705770000),
+	(5, 1705770000, 1705773600),
+	(5, 1705773600, 1705777200),
+	(5, 1705777200, 1705780800),
+	(5, 1705780800, 1705784400),
+	(5, 1705784400, 1705788000),
+	(5, 1705788000, 1705791600),
+	(5, 1705791600, 1705795200),
+	(5, 1705795200, 1705798800),
+	(5, 1705798800, 1705802400),
+	(5, 1705802400, 1705806000),
+	(5, 1705806000, 1705809600),
+	(5, 1705809600, 1705813200),
+	(5, 1705813200, 1705816800),
+	(5, 1705816800, 1705820400),
+	(5, 1705820400, 1705824000),
+	(5, 1705824000, 1705827600),
+	(5, 1705827600, 1705831200),
+	(5, 1705831200, 1705834800),
+	(5, 1705834800, 1705838400),
+	(5, 1705838400, 1705842000),
+	(5, 1705842000, 1705845600),
+	(5, 1705845600, 1705849200),
+	(5, 1705849200, 1705852800),
+	(5, 1705852800, 1705856400),
+	(5, 1705856400, 1705860000),
+	(5, 1705860000, 1705863600),
+	(5, 1705863600, 1705867200),
+	(5, 1705867200, 1705870800),
+	(5, 1705870800, 1705874400),
+	(5, 1705874400, 1705878000),
+	(5, 1705878000, 1705881600),
+	(5, 1705881600, 1705885200),
+	(5, 1705885200, 1705888800),
+	(5, 1705888800, 1705892400),
+	(5, 1705892400, 1705896000),
+	(5, 1705896000, 1705899600),
+	(5, 1705899600, 1705903200),
+	(5, 1705903200, 1705906800),
+	(5, 1705906800, 1705910400),
+	(5, 1705910400, 1705914000),
+	(5, 1705914000, 1705917600),
+	(5, 1705917600, 1705921200),
+	(5, 1705921200, 1705924800),
+	(5, 1705924800, 1705928400),
+	(5, 1705928400, 1705932000),
+	(5, 1705932000, 1705935600),
+	(5, 1705935600, 1705939200),
+	(5, 1705939200, 1705942800),
+	(5, 1705942800, 1705946400),
+	(5, 1705946400, 1705950000),
+	(5, 1705950000, 1705953600),
+	(5, 1705953600, 1705957200),
+	(5, 1705957200, 1705960800),
+	(5, 1705960800, 1705964400),
+	(5, 1705964400, 1705968000),
+	(5, 1705968000, 1705971600),
+	(5, 1705971600, 1705975200),
+	(5, 1705975200, 1705978800),
+	(5, 1705978800, 1705982400),
+	(5, 1705982400, 1705986000),
+	(5, 1705986000, 1705989600),
+	(5, 1705989600, 1705993200),
+	(5, 1705993200, 1705996800),
+	(5, 1705996800, 1706000400),
+	(5, 1706000400, 1706004000),
+	(5, 1706004000, 1706007600),
+	(5, 1706007600, 1706011200),
+	(5, 1706011200, 1706014800),
+	(5, 1706014800, 1706018400),
+	(5, 1706018400, 1706022000),
+	(5, 1706022000, 1706025600),
+	(5, 1706025600, 1706029200),
+	(5, 1706029200, 1706032800),
+	(5, 1706032800, 1706036400),
+	(5, 1706036400, 1706040000),
+	(5, 1706040000, 1706043600),
+	(5, 1706043600, 1706047200),
+	(5, 1706047200, 1706050800),
+	(5, 1706050800, 1706054400),
+	(5, 1706054400, 1706058000),
+	(5, 1706058000, 1706061600),
+	(5, 1706061600, 1706065200),
+	(5, 1706065200, 1706068800),
+	(5, 1706068800, 1706072400),
+	(5, 1706072400, 1706076000),
+	(5, 1706076000, 1706079600),
+	(5, 1706079600, 1706083200),
+	(5, 1706083200, 1706086800),
+	(5, 1706086800, 1706090400),
+	(5, 1706090400, 1706094000),
+	(5, 1706094000, 1706097600),
+	(5, 1706097600, 1706101200),
+	(5, 1706101200, 1706104800),
+	(5, 1706104800, 1706108400),
+	(5, 1706108400, 1706112000),
+	(5, 1706112000, 1706115600),
+	(5, 1706115600, 1706119200),
+	(5, 1706119200, 1706122800),
+	(5, 1706122800, 1706126400),
+	(5, 1706126400, 1706130000),
+	(5, 1706130000, 1706133600),
+	(5, 1706133600, 1706137200),
+	(5, 1706137200, 1706140800),
+	(5, 1706140800, 1706144400),
+	(5, 1706144400, 1706148000),
+	(5, 1706148000, 1706151600),
+	(5, 1706151600, 1706155200),
+	(5, 1706155200, 1706158800),
+	(5, 1706158800, 1706162400),
+	(5, 1706162400, 1706166000),
+	(5, 1706166000, 1706169600),
+	(5, 1706169600, 1706173200),
+	(5, 1706173200, 1706176800),
+	(5, 1706176800, 1706180400),
+	(5, 1706180400, 1706184000),
+	(5, 1706184000, 1706187600),
+	(5, 1706187600, 1706191200),
+	(5, 1706191200, 1706194800),
+	(5, 1706194800, 1706198400),
+	(5, 1706198400, 1706202000),
+	(5, 1706202000, 1706205600),
+	(5, 1706205600, 1706209200),
+	(5, 1706209200, 1706212800),
+	(5, 1706212800, 1706216400),
+	(5, 1706216400, 1706220000),
+	(5, 1706220000, 1706223600),
+	(5, 1706223600, 1706227200),
+	(5, 1706227200, 1706230800),
+	(5, 1706230800, 1706234400),
+	(5, 1706234400, 1706238000),
+	(5, 1706238000, 1706241600),
+	(5, 1706241600, 1706245200),
+	(5, 1706245200, 1706248800),
+	(5, 1706248800, 1706252400),
+	(5, 1706252400, 1706256000),
+	(5, 1706256000, 1706259600),
+	(5, 1706259600, 1706263200),
+	(5, 1706263200, 1706266800),
+	(5, 1706266800, 1706270400),
+	(5, 1706270400, 1706274000),
+	(5, 1706274000, 1706277600),
+	(5, 1706277600, 1706281200),
+	(5, 1706281200, 1706284800),
+	(5, 1706284800, 1706288400),
+	(5, 1706288400, 1706292000),
+	(5, 1706292000, 1706295600),
+	(5, 1706295600, 1706299200),
+	(5, 1706299200, 1706302800),
+	(5, 1706302800, 1706306400),
+	(5, 1706306400, 1706310000),
+	(5, 1706310000, 1706313600),
+	(5, 1706313600, 1706317200),
+	(5, 1706317200, 1706320800),
+	(5, 1706320800, 1706324400),
+	(5, 1706324400, 1706328000),
+	(5, 1706328000, 1706331600),
+	(5, 1706331600, 1706335200),
+	(5, 1706335200, 1706338800),
+	(5, 1706338800, 1706342400),
+	(5, 1706342400, 1706346000),
+	(5, 1706346000, 1706349600),
+	(5, 1706349600, 1706353200),
+	(5, 1706353200, 1706356800),
+	(5, 1706356800, 1706360400),
+	(5, 1706360400, 1706364000),
+	(5, 1706364000, 1706367600),
+	(5, 1706367600, 1706371200),
+	(5, 1706371200, 1706374800),
+	(5, 1706374800, 1706378400),
+	(5, 1706378400, 1706382000),
+	(5, 1706382000, 1706385600),
+	(5, 1706385600, 1706389200),
+	(5, 1706389200, 1706392800),
+	(5, 1706392800, 1706396400),
+	(5, 1706396400, 1706400000),
+	(5, 1706400000, 1706403600),
+	(5, 1706403600, 1706407200),
+	(5, 1706407200, 1706410800),
+	(5, 1706410800, 1706414400),
+	(5, 1706414400, 1706418000),
+	(5, 1706418000, 1706421600),
+	(5, 1706421600, 1706425200),
+	(5, 1706425200, 1706428800),
+	(5, 1706428800, 1706432400),
+	(5, 1706432400, 1706436000),
+	(5, 1706436000, 1706439600),
+	(5, 1706439600, 1706443200),
+	(5, 1706443200, 1706446800),
+	(5, 1706446800, 1706450400),
+	(5, 1706450400, 1706454000),
+	(5, 1706454000, 1706457600),
+	(5, 1706457600, 1706461200),
+	(5, 1706461200, 1706464800),
+	(5, 1706464800, 1706468400),
+	(5, 1706468400, 1706472000),
+	(5, 1706472000, 1706475600),
+	(5, 1706475600, 1706479200),
+	(5, 1706479200, 1706482800),
+	(5, 1706482800, 1706486400),
+	(5, 1706486400, 1706490000),
+	(5, 1706490000, 1706493600),
+	(5, 1706493600, 1706497200),
+	(5, 1706497200, 1706500800),
+	(5, 1706500800, 1706504400),
+	(5, 1706504400, 1706508000),
+	(5, 1706508000, 1706511600),
+	(5, 1706511600, 1706515200),
+	(5, 1706515200, 1706518800),
+	(5, 1706518800, 1706522400),
+	(5, 1706522400, 1706526000),
+	(5, 1706526000, 1706529600),
+	(5, 1706529600, 1706533200),
+	(5, 1706533200, 1706536800),
+	(5, 1706536800, 1706540400),
+	(5, 1706540400, 1706544000),
+	(5, 1706544000, 1706547600),
+	(5, 1706547600, 1706551200),
+	(5, 1706551200, 1706554800),
+	(5, 1706554800, 1706558400),
+	(5, 1706558400, 1706562000),
+	(5, 1706562000, 1706565600),
+	(5, 1706565600, 1706569200),
+	(5, 1706569200, 1706572800),
+	(5, 1706572800, 1706576400),
+	(5, 1706576400, 1706580000),
+	(5, 1706580000, 1706583600),
+	(5, 1706583600, 1706587200),
+	(5, 1706587200, 1706590800),
+	(5, 1706590800, 1706594400),
+	(5, 1706594400, 1706598000),
+	(5, 1706598000, 1706601600),
+	(5, 1706601600, 1706605200),
+	(5, 1706605200, 1706608800),
+	(5, 1706608800, 1706612400),
+	(5, 1706612400, 1706616000),
+	(5, 1706616000, 1706619600),
+	(5, 1706619600, 1706623200),
+	(5, 1706623200, 1706626800),
+	(5, 1706626800, 1706630400),
+	(5, 1706630400, 1706634000),
+	(5, 1706634000, 1706637600),
+	(5, 1706637600, 1706641200),
+	(5, 1706641200, 1706644800),
+	(5, 1706644800, 1706648400),
+	(5, 1706648400, 1706652000),
+	(5, 1706652000, 1706655600),
+	(5, 1706655600, 1706659200),
+	(5, 1706659200, 1706662800),
+	(5, 1706662800, 1706666400),
+	(5, 1706666400, 1706670000),
+	(5, 1706670000, 1706673600),
+	(5, 1706673600, 1706677200),
+	(5, 1706677200, 1706680800),
+	(5, 1706680800, 1706684400),
+	(5, 1706684400, 1706688000),
+	(5, 1706688000, 1706691600),
+	(5, 1706691600, 1706695200),
+	(5, 1706695200, 1706698800),
+	(5, 1706698800, 1706702400),
+	(5, 1706702400, 1706706000),
+	(5, 1706706000, 1706709600),
+	(5, 1706709600, 1706713200),
+	(5, 1706713200, 1706716800),
+	(5, 1706716800, 1706720400),
+	(5, 1706720400, 1706724000),
+	(5, 1706724000, 1706727600),
+	(5, 1706727600, 1706731200),
+	(5, 1706731200, 1706734800),
+	(5, 1706734800, 1706738400),
+	(5, 1706738400, 1706742000),
+	(5, 1706742000, 1706745600),
+	(5, 1706745600, 1706749200),
+	(5, 1706749200, 1706752800),
+	(5, 1706752800, 1706756400),
+	(5, 1706756400, 1706760000),
+	(5, 1706760000, 1706763600),
+	(5, 1706763600, 1706767200),
+	(5, 1706767200, 1706770800),
+	(5, 1706770800, 1706774400),
+	(5, 1706774400, 1706778000),
+	(5, 1706778000, 1706781600),
+	(5, 1706781600, 1706785200),
+	(5, 1706785200, 1706788800),
+	(5, 1706788800, 1706792400),
+	(5, 1706792400, 1706796000),
+	(5, 1706796000, 1706799600),
+	(5, 1706799600, 1706803200),
+	(5, 1706803200, 1706806800),
+	(5, 1706806800, 1706810400),
+	(5, 1706810400, 1706814000),
+	(5, 1706814000, 1706817600),
+	(5, 1706817600, 1706821200),
+	(5, 1706821200, 1706824800),
+	(5, 1706824800, 1706828400),
+	(5, 1706828400, 1706832000),
+	(5, 1706832000, 1706835600),
+	(5, 1706835600, 1706839200),
+	(5, 1706839200, 1706842800),
+	(5, 1706842800, 1706846400),
+	(5, 1706846400, 1706850000),
+	(5, 1706850000, 1706853600),
+	(5, 1706853600, 1706857200),
+	(5, 1706857200, 1706860800),
+	(5, 1706860800, 1706864400),
+	(5, 1706864400, 1706868000),
+	(5, 1706868000, 1706871600),
+	(5, 1706871600, 1706875200),
+	(5, 1706875200, 1706878800),
+	(5, 1706878800, 1706882400),
+	(5, 1706882400, 1706886000),
+	(5, 1706886000, 1706889600),
+	(5, 1706889600, 1706893200),
+	(5, 1706893200, 1706896800),
+	(5, 1706896800, 1706900400),
+	(5, 1706900400, 1706904000),
+	(5, 1706904000, 1706907600),
+	(5, 1706907600, 1706911200),
+	(5, 1706911200, 1706914800),
+	(5, 1706914800, 1706918400),
+	(5, 1706918400, 1706922000),
+	(5, 1706922000, 1706925600),
+	(5, 1706925600, 1706929200),
+	(5, 1706929200, 1706932800),
+	(5, 1706932800, 1706936400),
+	(5, 1706936400, 1706940000),
+	(5, 1706940000, 1706943600),
+	(5, 1706943600, 1706947200),
+	(5, 1706947200, 1706950800),
+	(5, 1706950800, 1706954400),
+	(5, 1706954400, 1706958000),
+	(5, 1706958000, 1706961600),
+	(5, 1706961600, 1706965200),
+	(5, 1706965200, 1706968800),
+	(5, 1706968800, 1706972400),
+	(5, 1706972400, 1706976000),
+	(5, 1706976000, 1706979600),
+	(5, 1706979600, 1706983200),
+	(5, 1706983200, 1706986800),
+	(5, 1706986800, 1706990400),
+	(5, 1706990400, 1706994000),
+	(5, 1706994000, 1706997600),
+	(5, 1706997600, 1707001200),
+	(5, 1707001200, 1707004800),
+	(5, 1707004800, 1707008400),
+	(5, 1707008400, 1707012000),
+	(5, 1707012000, 1707015600),
+	(5, 1707015600, 1707019200),
+	(5, 1707019200, 1707022800),
+	(5, 1707022800, 1707026400),
+	(5, 1707026400, 1707030000),
+	(5, 1707030000, 1707033600),
+	(5, 1707033600, 1707037200),
+	(5, 1707037200, 1707040800),
+	(5, 1707040800, 1707044400),
+	(5, 1707044400, 1707048000),
+	(5, 1707048000, 1707051600),
+	(5, 1707051600, 1707055200),
+	(5, 1707055200, 1707058800),
+	(5, 1707058800, 1707062400),
+	(5, 1707062400, 1707066000),
+	(5, 1707066000, 1707069600),
+	(5, 1707069600, 1707073200),
+	(5, 1707073200, 1707076800),
+	(5, 1707076800, 1707080400),
+	(5, 1707080400, 1707084000),
+	(5, 1707084000, 1707087600),
+	(5, 1707087600, 1707091200),
+	(5, 1707091200, 1707094800),
+	(5, 1707094800, 1707098400),
+	(5, 1707098400, 1707102000),
+	(5, 1707102000, 1707105600),
+	(5, 1707105600, 1707109200),
+	(5, 1707109200, 1707112800),
+	(5, 1707112800, 1707116400),
+	(5, 1707116400, 1707120000),
+	(5, 1707120000, 1707123600),
+	(5, 1707123600, 1707127200),
+	(5, 1707127200, 1707130800),
+	(5, 1707130800, 1707134400),
+	(5, 1707134400, 1707138000),
+	(5, 1707138000, 1707141600),
+	(5, 1707141600, 1707145200),
+	(5, 1707145200, 1707148800),
+	(5, 1707148800, 1707152400),
+	(5, 1707152400, 1707156000),
+	(5, 1707156000, 1707159600),
+	(5, 1707159600, 1707163200),
+	(5, 1707163200, 1707166800),
+	(5, 1707166800, 1707170400),
+	(5, 1707170400, 1707174000),
+	(5, 1707174000, 1707177600),
+	(5, 1707177600, 1707181200),
+	(5, 1707181200, 1707184800),
+	(5, 1707184800, 1707188400),
+	(5, 1707188400, 1707192000),
+	(5, 1707192000, 1707195600),
+	(5, 1707195600, 1707199200),
+	(5, 1707199200, 1707202800),
+	(5, 1707202800, 1707206400),
+	(5, 1707206400, 1707210000),
+	(5, 1707210000, 1707213600),
+	(5, 1707213600, 1707217200),
+	(5, 1707217200, 1707220800),
+	(5, 1707220800, 1707224400),
+	(5, 1707224400, 1707228000),
+	(5, 1707228000, 1707231600),
+	(5, 1707231600, 1707235200),
+	(5, 1707235200, 1707238800),
+	(5, 1707238800, 1707242400),
+	(5, 1707242400, 1707246000),
+	(5, 1707246000, 1707249600),
+	(5, 1707249600, 1707253200),
+	(5, 1707253200, 1707256800),
+	(5, 1707256800, 1707260400),
+	(5, 1707260400, 1707264000),
+	(5, 1707264000, 1707267600),
+	(5, 1707267600, 1707271200),
+	(5, 1707271200, 1707274800),
+	(5, 1707274800, 1707278400),
+	(5, 1707278400, 1707282000),
+	(5, 1707282000, 1707285600),
+	(5, 1707285600, 1707289200),
+	(5, 1707289200, 1707292800),
+	(5, 1707292800, 1707296400),
+	(5, 1707296400, 1707300000),
+	(5, 1707300000, 1707303600),
+	(5, 1707303600, 1707307200),
+	(5, 1707307200, 1707310800),
+	(5, 1707310800, 1707314400),
+	(5, 1707314400, 1707318000),
+	(5, 1707318000, 1707321600),
+	(5, 1707321600, 1707325200),
+	(5, 1707325200, 1707328800),
+	(5, 1707328800, 1707332400),
+	(5, 1707332400, 1707336000),
+	(5, 1707336000, 1707339600),
+	(5, 1707339600, 1707343200),
+	(5, 1707343200, 1707346800),
+	(5, 1707346800, 1707350400),
+	(5, 1707350400, 1707354000),
+	(5, 1707354000, 1707357600),
+	(5, 1707357600, 1707361200),
+	(5, 1707361200, 1707364800),
+	(5, 1707364800, 1707368400),
+	(5, 1707368400, 1707372000),
+	(5, 1707372000, 1707375600),
+	(5, 1707375600, 1707379200),
+	(5, 1707379200, 1707382800),
+	(5, 1707382800, 1707386400),
+	(5, 1707386400, 1707390000),
+	(5, 1707390000, 1707393600),
+	(5, 1707393600, 1707397200),
+	(5, 1707397200, 1707400800),
+	(5, 1707400800, 1707404400),
+	(5, 1707404400, 1707408000),
+	(5, 1707408000, 1707411600),
+	(5, 1707411600, 1707415200),
+	(5, 1707415200, 1707418800),
+	(5, 1707418800, 1707422400),
+	(5, 1707422400, 1707426000),
+	(5, 1707426000, 1707429600),
+	(5, 1707429600, 1707433200),
+	(5, 1707433200, 1707436800),
+	(5, 1707436800, 1707440400),
+	(5, 1707440400, 1707444000),
+	(5, 1707444000, 1707447600),
+	(5, 1707447600, 1707451200),
+	(5, 1707451200, 1707454800),
+	(5, 1707454800, 1707458400),
+	(5, 1707458400, 1707462000),
+	(5, 1707462000, 1707465600),
+	(5, 1707465600, 1707469200),
+	(5, 1707469200, 1707472800),
+	(5, 1707472800, 1707476400),
+	(5, 1707476400, 1707480000),
+	(5, 1707480000, 1707483600),
+	(5, 1707483600, 1707487200),
+	(5, 1707487200, 1707490800),
+	(5, 1707490800, 1707494400),
+	(5, 1707494400, 1707498000),
+	(5, 1707498000, 1707501600),
+	(5, 1707501600, 1707505200),
+	(5, 1707505200, 1707508800),
+	(5, 1707508800, 1707512400),
+	(5, 1707512400, 1707516000),
+	(5, 1707516000, 1707519600),
+	(5, 1707519600, 1707523200),
+	(5, 1707523200, 1707526800),
+	(5, 1707526800, 1707530400),
+	(5, 1707530400, 1707534000),
+	(5, 1707534000, 1707537600),
+	(5, 1707537600, 1707541200),
+	(5, 1707541200, 1707544800),
+	(5, 1707544800, 1707548400),
+	(5, 1707548400, 1707552000),
+	(5, 1707552000, 1707555600),
+	(5, 1707555600, 1707559200),
+	(5, 1707559200, 1707562800),
+	(5, 1707562800, 1707566400),
+	(5, 1707566400, 1707570000),
+	(5, 1707570000, 1707573600),
+	(5, 1707573600, 1707577200),
+	(5, 1707577200, 1707580800),
+	(5, 1707580800, 1707584400),
+	(5, 1707584400, 1707588000),
+	(5, 1707588000, 1707591600),
+	(5, 1707591600, 1707595200),
+	(5, 1707595200, 1707598800),
+	(5, 1707598800, 1707602400),
+	(5, 1707602400, 1707606000),
+	(5, 1707606000, 1707609600),
+	(5, 1707609600, 1707613200),
+	(5, 1707613200, 1707616800),
+	(5, 1707616800, 1707620400),
+	(5, 1707620400, 1707624000),
+	(5, 1707624000, 1707627600),
+	(5, 1707627600, 1707631200),
+	(5, 1707631200, 1707634800),
+	(5, 1707634800, 1707638400),
+	(5, 1707638400, 1707642000),
+	(5, 1707642000, 1707645600),
+	(5, 1707645600, 1707649200),
+	(5, 1707649200, 1707652800),
+	(5, 1707652800, 1707656400),
+	(5, 1707656400, 1707660000),
+	(5, 1707660000, 1707663600),
+	(5, 1707663600, 1707667200),
+	(5, 1707667200, 1707670800),
+	(5, 1707670800, 1707674400),
+	(5, 1707674400, 1707678000),
+	(5, 1707678000, 1707681600),
+	(5, 1707681600, 1707685200),
+	(5, 1707685200, 1707688800),
+	(5, 1707688800, 1707692400),
+	(5, 1707692400, 1707696000),
+	(5, 1707696000, 1707699600),
+	(5, 1707699600, 1707703200),
+	(5, 1707703200, 1707706800),
+	(5, 1707706800, 1707710400),
+	(5, 1707710400, 1707714000),
+	(5, 1707714000, 1707717600),
+	(5, 1707717600, 1707721200),
+	(5, 1707721200, 1707724800),
+	(5, 1707724800, 1707728400),
+	(5, 1707728400, 1707732000),
+	(5, 1707732000, 1707735600),
+	(5, 1707735600, 1707739200),
+	(5, 1707739200, 1707742800),
+	(5, 1707742800, 1707746400),
+	(5, 1707746400, 1707750000),
+	(5, 1707750000, 1707753600),
+	(5, 1707753600, 1707757200),
+	(5, 1707757200, 1707760800),
+	(5, 1707760800, 1707764400),
+	(5, 1707764400, 1707768000),
+	(5, 1707768000, 1707771600),
+	(5, 1707771600, 1707775200),
+	(5, 1707775200, 1707778800),
+	(5, 1707778800, 1707782400),
+	(5, 1707782400, 1707786000),
+	(5, 1707786000, 1707789600),
+	(5, 1707789600, 1707793200),
+	(5, 1707793200, 1707796800),
+	(5, 1707796800, 1707800400),
+	(5, 1707800400, 1707804000),
+	(5, 1707804000, 1707807600),
+	(5, 1707807600, 1707811200),
+	(5, 1707811200, 1707814800),
+	(5, 1707814800, 1707818400),
+	(5, 1707818400, 1707822000),
+	(5, 1707822000, 1707825600),
+	(5, 1707825600, 1707829200),
+	(5, 1707829200, 1707832800),
+	(5, 1707832800, 1707836400),
+	(5, 1707836400, 1707840000),
+	(5, 1707840000, 1707843600),
+	(5, 1707843600, 1707847200),
+	(5, 1707847200, 1707850800),
+	(5, 1707850800, 1707854400),
+	(5, 1707854400, 1707858000),
+	(5, 1707858000, 1707861600),
+	(5, 1707861600, 1707865200),
+	(5, 1707865200, 1707868800),
+	(5, 1707868800, 1707872400),
+	(5, 1707872400, 1707876000),
+	(5, 1707876000, 1707879600),
+	(5, 1707879600, 1707883200),
+	(5, 1707883200, 1707886800),
+	(5, 1707886800, 1707890400),
+	(5, 1707890400, 1707894000),
+	(5, 1707894000, 1707897600),
+	(5, 1707897600, 1707901200),
+	(5, 1707901200, 1707904800),
+	(5, 1707904800, 1707908400),
+	(5, 1707908400, 1707912000),
+	(5, 1707912000, 1707915600),
+	(5, 1707915600, 1707919200),
+	(5, 1707919200, 1707922800),
+	(5, 1707922800, 1707926400),
+	(5, 1707926400, 1707930000),
+	(5, 1707930000, 1707933600),
+	(5, 1707933600, 1707937200),
+	(5, 1707937200, 1707940800),
+	(5, 1707940800, 1707944400),
+	(5, 1707944400, 1707948000),
+	(5, 1707948000, 1707951600),
+	(5, 1707951600, 1707955200),
+	(5, 1707955200, 1707958800),
+	(5, 1707958800, 1707962400),
+	(5, 1707962400, 1707966000),
+	(5, 1707966000, 1707969600),
+	(5, 1707969600, 1707973200),
+	(5, 1707973200, 1707976800),
+	(5, 1707976800, 1707980400),
+	(5, 1707980400, 1707984000),
+	(5, 1707984000, 1707987600),
+	(5, 1707987600, 1707991200),
+	(5, 1707991200, 1707994800),
+	(5, 1707994800, 1707998400),
+	(5, 1707998400, 1708002000),
+	(5, 1708002000, 1708005600),
+	(5, 1708005600, 1708009200),
+	(5, 1708009200, 1708012800),
+	(5, 1708012800, 1708016400),
+	(5, 1708016400, 1708020000),
+	(5, 1708020000, 1708023600),
+	(5, 1708023600, 1708027200),
+	(5, 1708027200, 1708030800),
+	(5, 1708030800, 1708034400),
+	(5, 1708034400, 1708038000),
+	(5, 1708038000, 1708041600),
+	(5, 1708041600, 1708045200),
+	(5, 1708045200, 1708048800),
+	(5, 1708048800, 1708052400),
+	(5, 1708052400, 1708056000),
+	(5, 1708056000, 1708059600),
+	(5, 1708059600, 1708063200),
+	(5, 1708063200, 1708066800),
+	(5, 1708066800, 1708070400),
+	(5, 1708070400, 1708074000),
+	(5, 1708074000, 1708077600),
+	(5, 1708077600, 1708081200),
+	(5, 1708081200, 1708084800),
+	(5, 1708084800, 1708088400),
+	(5, 1708088400, 1708092000),
+	(5, 1708092000, 1708095600),
+	(5, 1708095600, 1708099200),
+	(5, 1708099200, 1708102800),
+	(5, 1708102800, 1708106400),
+	(5, 1708106400, 1708110000),
+	(5, 1708110000, 1708113600),
+	(5, 1708113600, 1708117200),
+	(5, 1708117200, 1708120800),
+	(5, 1708120800, 1708124400),
+	(5, 1708124400, 1708128000),
+	(5, 1708128000, 1708131600),
+	(5, 1708131600, 1708135200),
+	(5, 1708135200, 1708138800),
+	(5, 1708138800, 1708142400),
+	(5, 1708142400, 1708146000),
+	(5, 1708146000, 1708149600),
+	(5, 1708149600, 1708153200),
+	(5, 1708153200, 1708156800),
+	(5, 1708156800, 1708160400),
+	(5, 1708160400, 1708164000),
+	(5, 1708164000, 1708167600),
+	(5, 1708167600, 1708171200),
+	(5, 1708171200, 1708174800),
+	(5, 1708174800, 1708178400),
+	(5, 1708178400, 1708182000),
+	(5, 1708182000, 1708185600),
+	(5, 1708185600, 1708189200),
+	(5, 1708189200, 1708192800),
+	(5, 1708192800, 1708196400),
+	(5, 1708196400, 1708200000),
+	(5, 1708200000, 1708203600),
+	(5, 1708203600, 1708207200),
+	(5, 1708207200, 1708210800),
+	(5, 1708210800, 1708214400),
+	(5, 1708214400, 1708218000),
+	(5, 1708218000, 1708221600),
+	(5, 1708221600, 1708225200),
+	(5, 1708225200, 1708228800),
+	(5, 1708228800, 1708232400),
+	(5, 1708232400, 1708236000),
+	(5, 1708236000, 1708239600),
+	(5, 1708239600, 1708243200),
+	(5, 1708243200, 1708246800),
+	(5, 1708246800, 1708250400),
+	(5, 1708250400, 1708254000),
+	(5, 1708254000, 1708257600),
+	(5, 1708257600, 1708261200),
+	(5, 1708261200, 1708264800),
+	(5, 1708264800, 1708268400),
+	(5, 1708268400, 1708272000),
+	(5, 1708272000, 1708275600),
+	(5, 1708275600, 1708279200),
+	(5, 1708279200, 1708282800),
+	(5, 1708282800, 1708286400),
+	(5, 1708286400, 1708290000),
+	(5, 1708290000, 1708293600),
+	(5, 1708293600, 1708297200),
+	(5, 1708297200, 1708300800),
+	(5, 1708300800, 1708304400),
+	(5, 1708304400, 1708308000),
+	(5, 1708308000, 1708311600),
+	(5, 1708311600, 1708315200),
+	(5, 1708315200, 1708318800),
+	(5, 1708318800, 1708322400),
+	(5, 1708322400, 1708326000),
+	(5, 1708326000, 1708329600),
+	(5, 1708329600, 1708333200),
+	(5, 1708333200, 1708336800),
+	(5, 1708336800, 1708340400),
+	(5, 1708340400, 1708344000),
+	(5, 1708344000, 1708347600),
+	(5, 1708347600, 1708351200),
+	(5, 1708351200, 1708354800),
+	(5, 1708354800, 1708358400),
+	(5, 1708358400, 1708362000),
+	(5, 1708362000, 1708365600),
+	(5, 1708365600, 1708369200),
+	(5, 1708369200, 1708372800),
+	(5, 1708372800, 1708376400),
+	(5, 1708376400, 1708380000),
+	(5, 1708380000, 1708383600),
+	(5, 1708383600, 1708387200),
+	(5, 1708387200, 1708390800),
+	(5, 1708390800, 1708394400),
+	(5, 1708394400, 1708398000),
+	(5, 1708398000, 1708401600),
+	(5, 1708401600, 1708405200),
+	(5, 1708405200, 1708408800),
+	(5, 1708408800, 1708412400),
+	(5, 1708412400, 1708416000),
+	(5, 1708416000, 1708419600),
+	(5, 1708419600, 1708423200),
+	(5, 1708423200, 1708426800),
+	(5, 1708426800, 1708430400),
+	(5, 1708430400, 1708434000),
+	(5, 1708434000, 1708437600),
+	(5, 1708437600, 1708441200),
+	(5, 1708441200, 1708444800),
+	(5, 1708444800, 1708448400),
+	(5, 1708448400, 1708452000),
+	(5, 1708452000, 1708455600),
+	(5, 1708455600, 1708459200),
+	(5, 1708459200, 1708462800),
+	(5, 1708462800, 1708466400),
+	(5, 1708466400, 1708470000),
+	(5, 1708470000, 1708473600),
+	(5, 1708473600, 1708477200),
+	(5, 1708477200, 1708480800),
+	(5, 1708480800, 1708484400),
+	(5, 1708484400, 1708488000),
+	(5, 1708488000, 1708491600),
+	(5, 1708491600, 1708495200),
+	(5, 1708495200, 1708498800),
+	(5, 1708498800, 1708502400),
+	(5, 1708502400, 1708506000),
+	(5, 1708506000, 1708509600),
+	(5, 1708509600, 1708513200),
+	(5, 1708513200, 1708516800),
+	(5, 1708516800, 1708520400),
+	(5, 1708520400, 1708524000),
+	(5, 1708524000, 1708527600),
+	(5, 1708527600, 1708531200),
+	(5, 1708531200, 1708534800),
+	(5, 1708534800, 1708538400),
+	(5, 1708538400, 1708542000),
+	(5, 1708542000, 1708545600),
+	(5, 1708545600, 1708549200),
+	(5, 1708549200, 1708552800),
+	(5, 1708552800, 1708556400),
+	(5, 1708556400, 1708560000),
+	(5, 1708560000, 1708563600),
+	(5, 1708563600, 1708567200),
+	(5, 1708567200, 1708570800),
+	(5, 1708570800, 1708574400),
+	(5, 1708574400, 1708578000),
+	(5, 1708578000, 1708581600),
+	(5, 1708581600, 1708585200),
+	(5, 1708585200, 1708588800),
+	(5, 1708588800, 1708592400),
+	(5, 1708592400, 1708596000),
+	(5, 1708596000, 1708599600),
+	(5, 1708599600, 1708603200),
+	(5, 1708603200, 1708606800),
+	(5, 1708606800, 1708610400),
+	(5, 1708610400, 1708614000),
+	(5, 1708614000, 1708617600),
+	(5, 1708617600, 1708621200),
+	(5, 1708621200, 1708624800),
+	(5, 1708624800, 1708628400),
+	(5, 1708628400, 1708632000),
+	(5, 1708632000, 1708635600),
+	(5, 1708635600, 1708639200),
+	(5, 1708639200, 1708642800),
+	(5, 1708642800, 1708646400),
+	(5, 1708646400, 1708650000),
+	(5, 1708650000, 1708653600),
+	(5, 1708653600, 1708657200),
+	(5, 1708657200, 1708660800),
+	(5, 1708660800, 1708664400),
+	(5, 1708664400, 1708668000),
+	(5, 1708668000, 1708671600),
+	(5, 1708671600, 1708675200),
+	(5, 1708675200, 1708678800),
+	(5, 1708678800, 1708682400),
+	(5, 1708682400, 1708686000),
+	(5, 1708686000, 1708689600),
+	(5, 1708689600, 1708693200),
+	(5, 1708693200, 1708696800),
+	(5, 1708696800, 1708700400),
+	(5, 1708700400, 1708704000),
+	(5, 1708704000, 1708707600),
+	(5, 1708707600, 1708711200),
+	(5, 1708711200, 1708714800),
+	(5, 1708714800, 1708718400),
+	(5, 1708718400, 1708722000),
+	(5, 1708722000, 1708725600),
+	(5, 1708725600, 1708729200),
+	(5, 1708729200, 1708732800),
+	(5, 1708732800, 1708736400),
+	(5, 1708736400, 1708740000),
+	(5, 1708740000, 1708743600),
+	(5, 1708743600, 1708747200),
+	(5, 1708747200, 1708750800),
+	(5, 1708750800, 1708754400),
+	(5, 1708754400, 1708758000),
+	(5, 1708758000, 1708761600),
+	(5, 1708761600, 1708765200),
+	(5, 1708765200, 1708768800),
+	(5, 1708768800, 1708772400),
+	(5, 1708772400, 1708776000),
+	(5, 1708776000, 1708779600),
+	(5, 1708779600, 1708783200),
+	(5, 1708783200, 1708786800),
+	(5, 1708786800, 1708790400),
+	(5, 1708790400, 1708794000),
+	(5, 1708794000, 1708797600),
+	(5, 1708797600, 1708801200),
+	(5, 1708801200, 1708804800),
+	(5, 1708804800, 1708808400),
+	(5, 1708808400, 1708812000),
+	(5, 1708812000, 1708815600),
+	(5, 1708815600, 1708819200),
+	(5, 1708819200, 1708822800),
+	(5, 1708822800, 1708826400),
+	(5, 1708826400, 1708830000),
+	(5, 1708830000, 1708833600),
+	(5, 1708833600, 1708837200),
+	(5, 1708837200, 1708840800),
+	(5, 1708840800, 1708844400),
+	(5, 1708844400, 1708848000),
+	(5, 1708848000, 1708851600),
+	(5, 1708851600, 1708855200),
+	(5, 1708855200, 1708858800),
+	(5, 1708858800, 1708862400),
+	(5, 1708862400, 1708866000),
+	(5, 1708866000, 1708869600),
+	(5, 1708869600, 1708873200),
+	(5, 1708873200, 1708876800),
+	(5, 1708876800, 1708880400),
+	(5, 1708880400, 1708884000),
+	(5, 1708884000, 1708887600),
+	(5, 1708887600, 1708891200),
+	(5, 1708891200, 1708894800),
+	(5, 1708894800, 1708898400),
+	(5, 1708898400, 1708902000),
+	(5, 1708902000, 1708905600),
+	(5, 1708905600, 1708909200),
+	(5, 1708909200, 1708912800),
+	(5, 1708912800, 1708916400),
+	(5, 1708916400, 1708920000),
+	(5, 1708920000, 1708923600),
+	(5, 1708923600, 1708927200),
+	(5, 1708927200, 1708930800),
+	(5, 1708930800, 1708934400),
+	(5, 1708934400, 1708938000),
+	(5, 1708938000, 1708941600),
+	(5, 1708941600, 1708945200),
+	(5, 1708945200, 1708948800),
+	(5, 1708948800, 1708952400),
+	(5, 1708952400, 1708956000),
+	(5, 1708956000, 1708959600),
+	(5, 1708959600, 1708963200),
+	(5, 1708963200, 1708966800),
+	(5, 1708966800, 1708970400),
+	(5, 1708970400, 1708974000),
+	(5, 1708974000, 1708977600),
+	(5, 1708977600, 1708981200),
+	(5, 1708981200, 1708984800),
+	(5, 1708984800, 1708988400),
+	(5, 1708988400, 1708992000),
+	(5, 1708992000, 1708995600),
+	(5, 1708995600, 1708999200),
+	(5, 1708999200, 1709002800),
+	(5, 1709002800, 1709006400),
+	(5, 1709006400, 1709010000),
+	(5, 1709010000, 1709013600),
+	(5, 1709013600, 1709017200),
+	(5, 1709017200, 1709020800),
+	(5, 1709020800, 1709024400),
+	(5, 1709024400, 1709028000),
+	(5, 1709028000, 1709031600),
+	(5, 1709031600, 1709035200),
+	(5, 1709035200, 1709038800),
+	(5, 1709038800, 1709042400),
+	(5, 1709042400, 1709046000),
+	(5, 1709046000, 1709049600),
+	(5, 1709049600, 1709053200),
+	(5, 1709053200, 1709056800),
+	(5, 1709056800, 1709060400),
+	(5, 1709060400, 1709064000),
+	(5, 1709064000, 1709067600),
+	(5, 1709067600, 1709071200),
+	(5, 1709071200, 1709074800),
+	(5, 1709074800, 1709078400),
+	(5, 1709078400, 1709082000),
+	(5, 1709082000, 1709085600),
+	(5, 1709085600, 1709089200),
+	(5, 1709089200, 1709092800),
+	(5, 1709092800, 1709096400),
+	(5, 1709096400, 1709100000),
+	(5, 1709100000, 1709103600),
+	(5, 1709103600, 1709107200),
+	(5, 1709107200, 1709110800),
+	(5, 1709110800, 1709114400),
+	(5, 1709114400, 1709118000),
+	(5, 1709118000, 1709121600),
+	(5, 1709121600, 1709125200),
+	(5, 1709125200, 1709128800),
+	(5, 1709128800, 1709132400),
+	(5, 1709132400, 1709136000),
+	(5, 1709136000, 1709139600),
+	(5, 1709139600, 1709143200),
+	(5, 1709143200, 1709146800),
+	(5, 1709146800, 1709150400),
+	(5, 1709150400, 1709154000),
+	(5, 1709154000, 1709157600),
+	(5, 1709157600, 1709161200),
+	(5, 1709161200, 1709164800),
+	(5, 1709164800, 1709168400),
+	(5, 1709168400, 1709172000),
+	(5, 1709172000, 1709175600),
+	(5, 1709175600, 1709179200),
+	(5, 1709179200, 1709182800),
+	(5, 1709182800, 1709186400),
+	(5, 1709186400, 1709190000),
+	(5, 1709190000, 1709193600),
+	(5, 1709193600, 1709197200),
+	(5, 1709197200, 1709200800),
+	(5, 1709200800, 1709204400),
+	(5, 1709204400, 1709208000),
+	(5, 1709208000, 1709211600),
+	(5, 1709211600, 1709215200),
+	(5, 1709215200, 1709218800),
+	(5, 1709218800, 1709222400),
+	(5, 1709222400, 1709226000),
+	(5, 1709226000, 1709229600),
+	(5, 1709229600, 1709233200),
+	(5, 1709233200, 1709236800),
+	(5, 1709236800, 1709240400),
+	(5, 1709240400, 1709244000),
+	(5, 1709244000, 1709247600),
+	(5, 1709247600, 1709251200),
+	(5, 1709251200, 1709254800),
+	(5, 1709254800, 1709258400),
+	(5, 1709258400, 1709262000),
+	(5, 1709262000, 1709265600),
+	(5, 1709265600, 1709269200),
+	(5, 1709269200, 1709272800),
+	(5, 1709272800, 1709276400),
+	(5, 1709276400, 1709280000),
+	(5, 1709280000, 1709283600),
+	(5, 1709283600, 1709287200),
+	(5, 1709287200, 1709290800),
+	(5, 1709290800, 1709294400),
+	(5, 1709294400, 1709298000),
+	(5, 1709298000, 1709301600),
+	(5, 1709301600, 1709305200),
+	(5, 1709305200, 1709308800),
+	(5, 1709308800, 1709312400),
+	(5, 1709312400, 1709316000),
+	(5, 1709316000, 1709319600),
+	(5, 1709319600, 1709323200),
+	(5, 1709323200, 1709326800),
+	(5, 1709326800, 1709330400),
+	(5, 1709330400, 1709334000),
+	(5, 1709334000, 1709337600),
+	(5, 1709337600, 1709341200),
+	(5, 1709341200, 1709344800),
+	(5, 1709344800, 1709348400),
+	(5, 1709348400, 1709352000),
+	(5, 1709352000, 1709355600),
+	(5, 1709355600, 1709359200),
+	(5, 1709359200, 1709362800),
+	(5, 1709362800, 1709366400),
+	(5, 1709366400, 1709370000),
+	(5, 1709370000, 1709373600),
+	(5, 1709373600, 1709377200),
+	(5, 1709377200, 1709380800),
+	(5, 1709380800, 1709384400),
+	(5, 1709384400, 1709388000),
+	(5, 1709388000, 1709391600),
+	(5, 1709391600, 1709395200),
+	(5, 1709395200, 1709398800),
+	(5, 1709398800, 1709402400),
+	(5, 1709402400, 1709406000),
+	(5, 1709406000, 1709409600),
+	(5, 1709409600, 1709413200),
+	(5, 1709413200, 1709416800),
+	(5, 1709416800, 1709420400),
+	(5, 1709420400, 1709424000),
+	(5, 1709424000, 1709427600),
+	(5, 1709427600, 1709431200),
+	(5, 1709431200, 1709434800),
+	(5, 1709434800, 1709438400),
+	(5, 1709438400, 1709442000),
+	(5, 1709442000, 1709445600),
+	(5, 1709445600, 1709449200),
+	(5, 1709449200, 1709452800),
+	(5, 1709452800, 1709456400),
+	(5, 1709456400, 1709460000),
+	(5, 1709460000, 1709463600),
+	(5, 1709463600, 1709467200),
+	(5, 1709467200, 1709470800),
+	(5, 1709470800, 1709474400),
+	(5, 1709474400, 1709478000),
+	(5, 1709478000, 1709481600),
+	(5, 1709481600, 1709485200),
+	(5, 1709485200, 1709488800),
+	(5, 1709488800, 1709492400),
+	(5, 1709492400, 1709496000),
+	(5, 1709496000, 1709499600),
+	(5, 1709499600, 1709503200),
+	(5, 1709503200, 1709506800),
+	(5, 1709506800, 1709510400),
+	(5, 1709510400, 1709514000),
+	(5, 1709514000, 1709517600),
+	(5, 1709517600, 1709521200),
+	(5, 1709521200, 1709524800),
+	(5, 1709524800, 1709528400),
+	(5, 1709528400, 1709532000),
+	(5, 1709532000, 1709535600),
+	(5, 1709535600, 1709539200),
+	(5, 1709539200, 1709542800),
+	(5, 1709542800, 1709546400),
+	(5, 1709546400, 1709550000),
+	(5, 1709550000, 1709553600),
+	(5, 1709553600, 1709557200),
+	(5, 1709557200, 1709560800),
+	(5, 1709560800, 1709564400),
+	(5, 1709564400, 1709568000),
+	(5, 1709568000, 1709571600),
+	(5, 1709571600, 1709575200),
+	(5, 1709575200, 1709578800),
+	(5, 1709578800, 1709582400),
+	(5, 1709582400, 1709586000),
+	(5, 1709586000, 1709589600),
+	(5, 1709589600, 1709593200),
+	(5, 1709593200, 1709596800),
+	(5, 1709596800, 1709600400),
+	(5, 1709600400, 1709604000),
+	(5, 1709604000, 1709607600),
+	(5, 1709607600, 1709611200),
+	(5, 1709611200, 1709614800),
+	(5, 1709614800, 1709618400),
+	(5, 1709618400, 1709622000),
+	(5, 1709622000, 1709625600),
+	(5, 1709625600, 1709629200),
+	(5, 1709629200, 1709632800),
+	(5, 1709632800, 1709636400),
+	(5, 1709636400, 1709640000),
+	(5, 1709640000, 1709643600),
+	(5, 1709643600, 1709647200),
+	(5, 1709647200, 1709650800),
+	(5, 1709650800, 1709654400),
+	(5, 1709654400, 1709658000),
+	(5, 1709658000, 1709661600),
+	(5, 1709661600, 1709665200),
+	(5, 1709665200, 1709668800),
+	(5, 1709668800, 1709672400),
+	(5, 1709672400, 1709676000),
+	(5, 1709676000, 1709679600),
+	(5, 1709679600, 1709683200),
+	(5, 1709683200, 1709686800),
+	(5, 1709686800, 1709690400),
+	(5, 1709690400, 1709694000),
+	(5, 1709694000, 1709697600),
+	(5, 1709697600, 1709701200),
+	(5, 1709701200, 1709704800),
+	(5, 1709704800, 1709708400),
+	(5, 1709708400, 1709712000),
+	(5, 1709712000, 1709715600),
+	(5, 1709715600, 1709719200),
+	(5, 1709719200, 1709722800),
+	(5, 1709722800, 1709726400),
+	(5, 1709726400, 1709730000),
+	(5, 1709730000, 1709733600),
+	(5, 1709733600, 1709737200),
+	(5, 1709737200, 1709740800),
+	(5, 1709740800, 1709744400),
+	(5, 1709744400, 1709748000),
+	(5, 1709748000, 1709751600),
+	(5, 1709751600, 1709755200),
+	(5, 1709755200, 1709758800),
+	(5, 1709758800, 1709762400),
+	(5, 1709762400, 1709766000),
+	(5, 1709766000, 1709769600),
+	(5, 1709769600, 1709773200),
+	(5, 1709773200, 1709776800),
+	(5, 1709776800, 1709780400),
+	(5, 1709780400, 1709784000),
+	(5, 1709784000, 1709787600),
+	(5, 1709787600, 1709791200),
+	(5, 1709791200, 1709794800),
+	(5, 1709794800, 1709798400),
+	(5, 1709798400, 1709802000),
+	(5, 1709802000, 1709805600),
+	(5, 1709805600, 1709809200),
+	(5, 1709809200, 1709812800),
+	(5, 1709812800, 1709816400),
+	(5, 1709816400, 1709820000),
+	(5, 1709820000, 1709823600),
+	(5, 1709823600, 1709827200),
+	(5, 1709827200, 1709830800),
+	(5, 1709830800, 1709834400),
+	(5, 1709834400, 1709838000),
+	(5, 1709838000, 1709841600),
+	(5, 1709841600, 1709845200),
+	(5, 1709845200, 1709848800),
+	(5, 1709848800, 1709852400),
+	(5, 1709852400, 1709856000),
+	(5, 1709856000, 1709859600),
+	(5, 1709859600, 1709863200),
+	(5, 1709863200, 1709866800),
+	(5, 1709866800, 1709870400),
+	(5, 1709870400, 1709874000),
+	(5, 1709874000, 1709877600),
+	(5, 1709877600, 1709881200),
+	(5, 1709881200, 1709884800),
+	(5, 1709884800, 1709888400),
+	(5, 1709888400, 1709892000),
+	(5, 1709892000, 1709895600),
+	(5, 1709895600, 1709899200),
+	(5, 1709899200, 1709902800),
+	(5, 1709902800, 1709906400),
+	(5, 1709906400, 1709910000),
+	(5, 1709910000, 1709913600),
+	(5, 1709913600, 1709917200),
+	(5, 1709917200, 1709920800),
+	(5, 1709920800, 1709924400),
+	(5, 1709924400, 1709928000),
+	(5, 1709928000, 1709931600),
+	(5, 1709931600, 1709935200),
+	(5, 1709935200, 1709938800),
+	(5, 1709938800, 1709942400),
+	(5, 1709942400, 1709946000),
+	(5, 1709946000, 1709949600),
+	(5, 1709949600, 1709953200),
+	(5, 1709953200, 1709956800),
+	(5, 1709956800, 1709960400),
+	(5, 1709960400, 1709964000),
+	(5, 1709964000, 1709967600),
+	(5, 1709967600, 1709971200),
+	(5, 1709971200, 1709974800),
+	(5, 1709974800, 1709978400),
+	(5, 1709978400, 1709982000),
+	(5, 1709982000, 1709985600),
+	(5, 1709985600, 1709989200),
+	(5, 1709989200, 1709992800),
+	(5, 1709992800, 1709996400),
+	(5, 1709996400, 1710000000),
+	(5, 1710000000, 1710003600),
+	(5, 1710003600, 1710007200),
+	(5, 1710007200, 1710010800),
+	(5, 1710010800, 1710014400),
+	(5, 1710014400, 1710018000),
+	(5, 1710018000, 1710021600),
+	(5, 1710021600, 1710025200),
+	(5, 1710025200, 1710028800),
+	(5, 1710028800, 1710032400),
+	(5, 1710032400, 1710036000),
+	(5, 1710036000, 1710039600),
+	(5, 1710039600, 1710043200),
+	(5, 1710043200, 1710046800),
+	(5, 1710046800, 1710050400),
+	(5, 1710050400, 1710054000),
+	(5, 1710054000, 1710057600),
+	(5, 1710057600, 1710061200),
+	(5, 1710061200, 1710064800),
+	(5, 1710064800, 1710068400),
+	(5, 1710068400, 1710072000),
+	(5, 1710072000, 1710075600),
+	(5, 1710075600, 1710079200),
+	(5, 1710079200, 1710082800),
+	(5, 1710082800, 1710086400),
+	(5, 1710086400, 1710090000),
+	(5, 1710090000, 1710093600),
+	(5, 1710093600, 1710097200),
+	(5, 1710097200, 1710100800),
+	(5, 1710100800, 1710104400),
+	(5, 1710104400, 1710108000),
+	(5, 1710108000, 1710111600),
+	(5, 1710111600, 1710115200),
+	(5, 1710115200, 1710118800),
+	(5, 1710118800, 1710122400),
+	(5, 1710122400, 1710126000),
+	(5, 1710126000, 1710129600),
+	(5, 1710129600, 1710133200),
+	(5, 1710133200, 1710136800),
+	(5, 1710136800, 1710140400),
+	(5, 1710140400, 1710144000),
+	(5, 1710144000, 1710147600),
+	(5, 1710147600, 1710151200),
+	(5, 1710151200, 1710154800),
+	(5, 1710154800, 1710158400),
+	(5, 1710158400, 1710162000),
+	(5, 1710162000, 1710165600),
+	(5, 1710165600, 1710169200),
+	(5, 1710169200, 1710172800),
+	(5, 1710172800, 1710176400),
+	(5, 1710176400, 1710180000),
+	(5, 1710180000, 1710183600),
+	(5, 1710183600, 1710187200),
+	(5, 1710187200, 1710190800),
+	(5, 1710190800, 1710194400),
+	(5, 1710194400, 1710198000),
+	(5, 1710198000, 1710201600),
+	(5, 1710201600, 1710205200),
+	(5, 1710205200, 1710208800),
+	(5, 1710208800, 1710212400),
+	(5, 1710212400, 1710216000),
+	(5, 1710216000, 1710219600),
+	(5, 1710219600, 1710223200),
+	(5, 1710223200, 1710226800),
+	(5, 1710226800, 1710230400),
+	(5, 1710230400, 1710234000),
+	(5, 1710234000, 1710237600),
+	(5, 1710237600, 1710241200),
+	(5, 1710241200, 1710244800),
+	(5, 1710244800, 1710248400),
+	(5, 1710248400, 1710252000),
+	(5, 1710252000, 1710255600),
+	(5, 1710255600, 1710259200),
+	(5, 1710259200, 1710262800),
+	(5, 1710262800, 1710266400),
+	(5, 1710266400, 1710270000),
+	(5, 1710270000, 1710273600),
+	(5, 1710273600, 1710277200),
+	(5, 1710277200, 1710280800),
+	(5, 1710280800, 1710284400),
+	(5, 1710284400, 1710288000),
+	(5, 1710288000, 1710291600),
+	(5, 1710291600, 1710295200),
+	(5, 1710295200, 1710298800),
+	(5, 1710298800, 1710302400),
+	(5, 1710302400, 1710306000),
+	(5, 1710306000, 1710309600),
+	(5, 1710309600, 1710313200),
+	(5, 1710313200, 1710316800),
+	(5, 1710316800, 1710320400),
+	(5, 1710320400, 1710324000),
+	(5, 1710324000, 1710327600),
+	(5, 1710327600, 1710331200),
+	(5, 1710331200, 1710334800),
+	(5, 1710334800, 1710338400),
+	(5, 1710338400, 1710342000),
+	(5, 1710342000, 1710345600),
+	(5, 1710345600, 1710349200),
+	(5, 1710349200, 1710352800),
+	(5, 1710352800, 1710356400),
+	(5, 1710356400, 1710360000),
+	(5, 1710360000, 1710363600),
+	(5, 1710363600, 1710367200),
+	(5, 1710367200, 1710370800),
+	(5, 1710370800, 1710374400),
+	(5, 1710374400, 1710378000),
+	(5, 1710378000, 1710381600),
+	(5, 1710381600, 1710385200),
+	(5, 1710385200, 1710388800),
+	(5, 1710388800, 1710392400),
+	(5, 1710392400, 1710396000),
+	(5, 1710396000, 1710399600),
+	(5, 1710399600, 1710403200),
+	(5, 1710403200, 1710406800),
+	(5, 1710406800, 1710410400),
+	(5, 1710410400, 1710414000),
+	(5, 1710414000, 1710417600),
+	(5, 1710417600, 1710421200),
+	(5, 1710421200, 1710424800),
+	(5, 1710424800, 1710428400),
+	(5, 1710428400, 1710432000),
+	(5, 1710432000, 1710435600),
+	(5, 1710435600, 1710439200),
+	(5, 1710439200, 1710442800),
+	(5, 1710442800, 1710446400),
+	(5, 1710446400, 1710450000),
+	(5, 1710450000, 1710453600),
+	(5, 1710453600, 1710457200),
+	(5, 1710457200, 1710460800),
+	(5, 1710460800, 1710464400),
+	(5, 1710464400, 1710468000),
+	(5, 1710468000, 1710471600),
+	(5, 1710471600, 1710475200),
+	(5, 1710475200, 1710478800),
+	(5, 1710478800, 1710482400),
+	(5, 1710482400, 1710486000),
+	(5, 1710486000, 1710489600),
+	(5, 1710489600, 1710493200),
+	(5, 1710493200, 1710496800),
+	(5, 1710496800, 1710500400),
+	(5, 1710500400, 1710504000),
+	(5, 1710504000, 1710507600),
+	(5, 1710507600, 1710511200),
+	(5, 1710511200, 1710514800),
+	(5, 1710514800, 1710518400),
+	(5, 1710518400, 1710522000),
+	(5, 1710522000, 1710525600),
+	(5, 1710525600, 1710529200),
+	(5, 1710529200, 1710532800),
+	(5, 1710532800, 1710536400),
+	(5, 1710536400, 1710540000),
+	(5, 1710540000, 1710543600),
+	(5, 1710543600, 1710547200),
+	(5, 1710547200, 1710550800),
+	(5, 1710550800, 1710554400),
+	(5, 1710554400, 1710558000),
+	(5, 1710558000, 1710561600),
+	(5, 1710561600, 1710565200),
+	(5, 1710565200, 1710568800),
+	(5, 1710568800, 1710572400),
+	(5, 1710572400, 1710576000),
+	(5, 1710576000, 1710579600),
+	(5, 1710579600, 1710583200),
+	(5, 1710583200, 1710586800),
+	(5, 1710586800, 1710590400),
+	(5, 1710590400, 1710594000),
+	(5, 1710594000, 1710597600),
+	(5, 1710597600, 1710601200),
+	(5, 1710601200, 1710604800),
+	(5, 1710604800, 1710608400),
+	(5, 1710608400, 1710612000),
+	(5, 1710612000, 1710615600),
+	(5, 1710615600, 1710619200),
+	(5, 1710619200, 1710622800),
+	(5, 1710622800, 1710626400),
+	(5, 1710626400, 1710630000),
+	(5, 1710630000, 1710633600),
+	(5, 1710633600, 1710637200),
+	(5, 1710637200, 1710640800),
+	(5, 1710640800, 1710644400),
+	(5, 1710644400, 1710648000),
+	(5, 1710648000, 1710651600),
+	(5, 1710651600, 1710655200),
+	(5, 1710655200, 1710658800),
+	(5, 1710658800, 1710662400),
+	(5, 1710662400, 1710666000),
+	(5, 1710666000, 1710669600),
+	(5, 1710669600, 1710673200),
+	(5, 1710673200, 1710676800),
+	(5, 1710676800, 1710680400),
+	(5, 1710680400, 1710684000),
+	(5, 1710684000, 1710687600),
+	(5, 1710687600, 1710691200),
+	(5, 1710691200, 1710694800),
+	(5, 1710694800, 1710698400),
+	(5, 1710698400, 1710702000),
+	(5, 1710702000, 1710705600),
+	(5, 1710705600, 1710709200),
+	(5, 1710709200, 1710712800),
+	(5, 1710712800, 1710716400),
+	(5, 1710716400, 1710720000),
+	(5, 1710720000, 1710723600),
+	(5, 1710723600, 1710727200),
+	(5, 1710727200, 1710730800),
+	(5, 1710730800, 1710734400),
+	(5, 1710734400, 1710738000),
+	(5, 1710738000, 1710741600),
+	(5, 1710741600, 1710745200),
+	(5, 1710745200, 1710748800),
+	(5, 1710748800, 1710752400),
+	(5, 1710752400, 1710756000),
+	(5, 1710756000, 1710759600),
+	(5, 1710759600, 1710763200),
+	(5, 1710763200, 1710766800),
+	(5, 1710766800, 1710770400),
+	(5, 1710770400, 1710774000),
+	(5, 1710774000, 1710777600),
+	(5, 1710777600, 1710781200),
+	(5, 1710781200, 1710784800),
+	(5, 1710784800, 1710788400),
+	(5, 1710788400, 1710792000),
+	(5, 1710792000, 1710795600),
+	(5, 1710795600, 1710799200),
+	(5, 1710799200, 1710802800),
+	(5, 1710802800, 1710806400),
+	(5, 1710806400, 1710810000),
+	(5, 1710810000, 1710813600),
+	(5, 1710813600, 1710817200),
+	(5, 1710817200, 1710820800),
+	(5, 1710820800, 1710824400),
+	(5, 1710824400, 1710828000),
+	(5, 1710828000, 1710831600),
+	(5, 1710831600, 1710835200),
+	(5, 1710835200, 1710838800),
+	(5, 1710838800, 1710842400),
+	(5, 1710842400, 1710846000),
+	(5, 1710846000, 1710849600),
+	(5, 1710849600, 1710853200),
+	(5, 1710853200, 1710856800),
+	(5, 1710856800, 1710860400),
+	(5, 1710860400, 1710864000),
+	(5, 1710864000, 1710867600),
+	(5, 1710867600, 1710871200),
+	(5, 1710871200, 1710874800),
+	(5, 1710874800, 1710878400),
+	(5, 1710878400, 1710882000),
+	(5, 1710882000, 1710885600),
+	(5, 1710885600, 1710889200),
+	(5, 1710889200, 1710892800),
+	(5, 1710892800, 1710896400),
+	(5, 1710896400, 1710900000),
+	(5, 1710900000, 1710903600),
+	(5, 1710903600, 1710907200),
+	(5, 1710907200, 1710910800),
+	(5, 1710910800, 1710914400),
+	(5, 1710914400, 1710918000),
+	(5, 1710918000, 1710921600),
+	(5, 1710921600, 1710925200),
+	(5, 1710925200, 1710928800),
+	(5, 1710928800, 1710932400),
+	(5, 1710932400, 1710936000),
+	(5, 1710936000, 1710939600),
+	(5, 1710939600, 1710943200),
+	(5, 1710943200, 1710946800),
+	(5, 1710946800, 1710950400),
+	(5, 1710950400, 1710954000),
+	(5, 1710954000, 1710957600),
+	(5, 1710957600, 1710961200),
+	(5, 1710961200, 1710964800),
+	(5, 1710964800, 1710968400),
+	(5, 1710968400, 1710972000),
+	(5, 1710972000, 1710975600),
+	(5, 1710975600, 1710979200),
+	(5, 1710979200, 1710982800),
+	(5, 1710982800, 1710986400),
+	(5, 1710986400, 1710990000),
+	(5, 1710990000, 1710993600),
+	(5, 1710993600, 1710997200),
+	(5, 1710997200, 1711000800),
+	(5, 1711000800, 1711004400),
+	(5, 1711004400, 1711008000),
+	(5, 1711008000, 1711011600),
+	(5, 1711011600, 1711015200),
+	(5, 1711015200, 1711018800),
+	(5, 1711018800, 1711022400),
+	(5, 1711022400, 1711026000),
+	(5, 1711026000, 1711029600),
+	(5, 1711029600, 1711033200),
+	(5, 1711033200, 1711036800),
+	(5, 1711036800, 1711040400),
+	(5, 1711040400, 1711044000),
+	(5, 1711044000, 1711047600),
+	(5, 1711047600, 1711051200),
+	(5, 1711051200, 1711054800),
+	(5, 1711054800, 1711058400),
+	(5, 1711058400, 1711062000),
+	(5, 1711062000, 1711065600),
+	(5, 1711065600, 1711069200),
+	(5, 1711069200, 1711072800),
+	(5, 1711072800, 1711076400),
+	(5, 1711076400, 1711080000),
+	(5, 1711080000, 1711083600),
+	(5, 1711083600, 1711087200),
+	(5, 1711087200, 1711090800),
+	(5, 1711090800, 1711094400),
+	(5, 1711094400, 1711098000),
+	(5, 1711098000, 1711101600),
+	(5, 1711101600, 1711105200),
+	(5, 1711105200, 1711108800),
+	(5, 1711108800, 1711112400),
+	(5, 1711112400, 1711116000),
+	(5, 1711116000, 1711119600),
+	(5, 1711119600, 1711123200),
+	(5, 1711123200, 1711126800),
+	(5, 1711126800, 1711130400),
+	(5, 1711130400, 1711134000),
+	(5, 1711134000, 1711137600),
+	(5, 1711137600, 1711141200),
+	(5, 1711141200, 1711144800),
+	(5, 1711144800, 1711148400),
+	(5, 1711148400, 1711152000),
+	(5, 1711152000, 1711155600),
+	(5, 1711155600, 1711159200),
+	(5, 1711159200, 1711162800),
+	(5, 1711162800, 1711166400),
+	(5, 1711166400, 1711170000),
+	(5, 1711170000, 1711173600),
+	(5, 1711173600, 1711177200),
+	(5, 1711177200, 1711180800),
+	(5, 1711180800, 1711184400),
+	(5, 1711184400, 1711188000),
+	(5, 1711188000, 1711191600),
+	(5, 1711191600, 1711195200),
+	(5, 1711195200, 1711198800),
+	(5, 1711198800, 1711202400),
+	(5, 1711202400, 1711206000),
+	(5, 1711206000, 1711209600),
+	(5, 1711209600, 1711213200),
+	(5, 1711213200, 1711216800),
+	(5, 1711216800, 1711220400),
+	(5, 1711220400, 1711224000),
+	(5, 1711224000, 1711227600),
+	(5, 1711227600, 1711231200),
+	(5, 1711231200, 1711234800),
+	(5, 1711234800, 1711238400),
+	(5, 1711238400, 1711242000),
+	(5, 1711242000, 1711245600),
+	(5, 1711245600, 1711249200),
+	(5, 1711249200, 1711252800),
+	(5, 1711252800, 1711256400),
+	(5, 1711256400, 1711260000),
+	(5, 1711260000, 1711263600),
+	(5, 1711263600, 1711267200),
+	(5, 1711267200, 1711270800),
+	(5, 1711270800, 1711274400),
+	(5, 1711274400, 1711278000),
+	(5, 1711278000, 1711281600),
+	(5, 1711281600, 1711285200),
+	(5, 1711285200, 1711288800),
+	(5, 1711288800, 1711292400),
+	(5, 1711292400, 1711296000),
+	(5, 1711296000, 1711299600),
+	(5, 1711299600, 1711303200),
+	(5, 1711303200, 1711306800),
+	(5, 1711306800, 1711310400),
+	(5, 1711310400, 1711314000),
+	(5, 1711314000, 1711317600),
+	(5, 1711317600, 1711321200),
+	(5, 1711321200, 1711324800),
+	(5, 1711324800, 1711328400),
+	(5, 1711328400, 1711332000),
+	(5, 1711332000, 1711335600),
+	(5, 1711335600, 1711339200),
+	(5, 1711339200, 1711342800),
+	(5, 1711342800, 1711346400),
+	(5, 1711346400, 1711350000),
+	(5, 1711350000, 1711353600),
+	(5, 1711353600, 1711357200),
+	(5, 1711357200, 1711360800),
+	(5, 1711360800, 1711364400),
+	(5, 1711364400, 1711368000),
+	(5, 1711368000, 1711371600),
+	(5, 1711371600, 1711375200),
+	(5, 1711375200, 1711378800),
+	(5, 1711378800, 1711382400),
+	(5, 1711382400, 1711386000),
+	(5, 1711386000, 1711389600),
+	(5, 1711389600, 1711393200),
+	(5, 1711393200, 1711396800),
+	(5, 1711396800, 1711400400),
+	(5, 1711400400, 1711404000),
+	(5, 1711404000, 1711407600),
+	(5, 1711407600, 1711411200),
+	(5, 1711411200, 1711414800),
+	(5, 1711414800, 1711418400),
+	(5, 1711418400, 1711422000),
+	(5, 1711422000, 1711425600),
+	(5, 1711425600, 1711429200),
+	(5, 1711429200, 1711432800),
+	(5, 1711432800, 1711436400),
+	(5, 1711436400, 1711440000),
+	(5, 1711440000, 1711443600),
+	(5, 1711443600, 1711447200),
+	(5, 1711447200, 1711450800),
+	(5, 1711450800, 1711454400),
+	(5, 1711454400, 1711458000),
+	(5, 1711458000, 1711461600),
+	(5, 1711461600, 1711465200),
+	(5, 1711465200, 1711468800),
+	(5, 1711468800, 1711472400),
+	(5, 1711472400, 1711476000),
+	(5, 1711476000, 1711479600),
+	(5, 1711479600, 1711483200),
+	(5, 1711483200, 1711486800),
+	(5, 1711486800, 1711490400),
+	(5, 1711490400, 1711494000),
+	(5, 1711494000, 1711497600),
+	(5, 1711497600, 1711501200),
+	(5, 1711501200, 1711504800),
+	(5, 1711504800, 1711508400),
+	(5, 1711508400, 1711512000),
+	(5, 1711512000, 1711515600),
+	(5, 1711515600, 1711519200),
+	(5, 1711519200, 1711522800),
+	(5, 1711522800, 1711526400),
+	(5, 1711526400, 1711530000),
+	(5, 1711530000, 1711533600),
+	(5, 1711533600, 1711537200),
+	(5, 1711537200, 1711540800),
+	(5, 1711540800, 1711544400),
+	(5, 1711544400, 1711548000),
+	(5, 1711548000, 1711551600),
+	(5, 1711551600, 1711555200),
+	(5, 1711555200, 1711558800),
+	(5, 1711558800, 1711562400),
+	(5, 1711562400, 1711566000),
+	(5, 1711566000, 1711569600),
+	(5, 1711569600, 1711573200),
+	(5, 1711573200, 1711576800),
+	(5, 1711576800, 1711580400),
+	(5, 1711580400, 1711584000),
+	(5, 1711584000, 1711587600),
+	(5, 1711587600, 1711591200),
+	(5, 1711591200, 1711594800),
+	(5, 1711594800, 1711598400),
+	(5, 1711598400, 1711602000),
+	(5, 1711602000, 1711605600),
+	(5, 1711605600, 1711609200),
+	(5, 1711609200, 1711612800),
+	(5, 1711612800, 1711616400),
+	(5, 1711616400, 1711620000),
+	(5, 1711620000, 1711623600),
+	(5, 1711623600, 1711627200),
+	(5, 1711627200, 1711630800),
+	(5, 1711630800, 1711634400),
+	(5, 1711634400, 1711638000),
+	(5, 1711638000, 1711641600),
+	(5, 1711641600, 1711645200),
+	(5, 1711645200, 1711648800),
+	(5, 1711648800, 1711652400),
+	(5, 1711652400, 1711656000),
+	(5, 1711656000, 1711659600),
+	(5, 1711659600, 1711663200),
+	(5, 1711663200, 1711666800),
+	(5, 1711666800, 1711670400),
+	(5, 1711670400, 1711674000),
+	(5, 1711674000, 1711677600),
+	(5, 1711677600, 1711681200),
+	(5, 1711681200, 1711684800),
+	(5, 1711684800, 1711688400),
+	(5, 1711688400, 1711692000),
+	(5, 1711692000, 1711695600),
+	(5, 1711695600, 1711699200),
+	(5, 1711699200, 1711702800),
+	(5, 1711702800, 1711706400),
+	(5, 1711706400, 1711710000),
+	(5, 1711710000, 1711713600),
+	(5, 1711713600, 1711717200),
+	(5, 1711717200, 1711720800),
+	(5, 1711720800, 1711724400),
+	(5, 1711724400, 1711728000),
+	(5, 1711728000, 1711731600),
+	(5, 1711731600, 1711735200),
+	(5, 1711735200, 1711738800),
+	(5, 1711738800, 1711742400),
+	(5, 1711742400, 1711746000),
+	(5, 1711746000, 1711749600),
+	(5, 1711749600, 1711753200),
+	(5, 1711753200, 1711756800),
+	(5, 1711756800, 1711760400),
+	(5, 1711760400, 1711764000),
+	(5, 1711764000, 1711767600),
+	(5, 1711767600, 1711771200),
+	(5, 1711771200, 1711774800),
+	(5, 1711774800, 1711778400),
+	(5, 1711778400, 1711782000),
+	(5, 1711782000, 1711785600),
+	(5, 1711785600, 1711789200),
+	(5, 1711789200, 1711792800),
+	(5, 1711792800, 1711796400),
+	(5, 1711796400, 1711800000),
+	(5, 1711800000, 1711803600),
+	(5, 1711803600, 1711807200),
+	(5, 1711807200, 1711810800),
+	(5, 1711810800, 1711814400),
+	(5, 1711814400, 1711818000),
+	(5, 1711818000, 1711821600),
+	(5, 1711821600, 1711825200),
+	(5, 1711825200, 1711828800),
+	(5, 1711828800, 1711832400),
+	(5, 1711832400, 1711836000),
+	(5, 1711836000, 1711839600),
+	(5, 1711839600, 1711843200),
+	(5, 1711843200, 1711846800),
+	(5, 1711846800, 1711850400),
+	(5, 1711850400, 1711854000),
+	(5, 1711854000, 1711857600),
+	(5, 1711857600, 1711861200),
+	(5, 1711861200, 1711864800),
+	(5, 1711864800, 1711868400),
+	(5, 1711868400, 1711872000),
+	(5, 1711872000, 1711875600),
+	(5, 1711875600, 1711879200),
+	(5, 1711879200, 1711882800),
+	(5, 1711882800, 1711886400),
+	(5, 1711886400, 1711890000),
+	(5, 1711890000, 1711893600),
+	(5, 1711893600, 1711897200),
+	(5, 1711897200, 1711900800),
+	(5, 1711900800, 1711904400),
+	(5, 1711904400, 1711908000),
+	(5, 1711908000, 1711911600),
+	(5, 1711911600, 1711915200),
+	(5, 1711915200, 1711918800),
+	(5, 1711918800, 1711922400),
+	(5, 1711922400, 1711926000),
+	(5, 1711926000, 1711929600),
+	(5, 1711929600, 1711933200),
+	(5, 1711933200, 1711936800),
+	(5, 1711936800, 1711940400),
+	(5, 1711940400, 1711944000),
+	(5, 1711944000, 1711947600),
+	(5, 1711947600, 1711951200),
+	(5, 1711951200, 1711954800),
+	(5, 1711954800, 1711958400),
+	(5, 1711958400, 1711962000),
+	(5, 1711962000, 1711965600),
+	(5, 1711965600, 1711969200),
+	(5, 1711969200, 1711972800),
+	(5, 1711972800, 1711976400),
+	(5, 1711976400, 1711980000),
+	(5, 1711980000, 1711983600),
+	(5, 1711983600, 1711987200),
+	(5, 1711987200, 1711990800),
+	(5, 1711990800, 1711994400),
+	(5, 1711994400, 1711998000),
+	(5, 1711998000, 1712001600),
+	(5, 1712001600, 1712005200),
+	(5, 1712005200, 1712008800),
+	(5, 1712008800, 1712012400),
+	(5, 1712012400, 1712016000),
+	(5, 1712016000, 1712019600),
+	(5, 1712019600, 1712023200),
+	(5, 1712023200, 1712026800),
+	(5, 1712026800, 1712030400),
+	(5, 1712030400, 1712034000),
+	(5, 1712034000, 1712037600),
+	(5, 1712037600, 1712041200),
+	(5, 1712041200, 1712044800),
+	(5, 1712044800, 1712048400),
+	(5, 1712048400, 1712052000),
+	(5, 1712052000, 1712055600),
+	(5, 1712055600, 1712059200),
+	(5, 1712059200, 1712062800),
+	(5, 1712062800, 1712066400),
+	(5, 1712066400, 1712070000),
+	(5, 1712070000, 1712073600),
+	(5, 1712073600, 1712077200),
+	(5, 1712077200, 1712080800),
+	(5, 1712080800, 1712084400),
+	(5, 1712084400, 1712088000),
+	(5, 1712088000, 1712091600),
+	(5, 1712091600, 1712095200),
+	(5, 1712095200, 1712098800),
+	(5, 1712098800, 1712102400),
+	(5, 1712102400, 1712106000),
+	(5, 1712106000, 1712109600),
+	(5, 1712109600, 1712113200),
+	(5, 1712113200, 1712116800),
+	(5, 1712116800, 1712120400),
+	(5, 1712120400, 1712124000),
+	(5, 1712124000, 1712127600),
+	(5, 1712127600, 1712131200),
+	(5, 1712131200, 1712134800),
+	(5, 1712134800, 1712138400),
+	(5, 1712138400, 1712142000),
+	(5, 1712142000, 1712145600),
+	(5, 1712145600, 1712149200),
+	(5, 1712149200, 1712152800),
+	(5, 1712152800, 1712156400),
+	(5, 1712156400, 1712160000),
+	(5, 1712160000, 1712163600),
+	(5, 1712163600, 1712167200),
+	(5, 1712167200, 1712170800),
+	(5, 1712170800, 1712174400),
+	(5, 1712174400, 1712178000),
+	(5, 1712178000, 1712181600),
+	(5, 1712181600, 1712185200),
+	(5, 1712185200, 1712188800),
+	(5, 1712188800, 1712192400),
+	(5, 1712192400, 1712196000),
+	(5, 1712196000, 1712199600),
+	(5, 1712199600, 1712203200),
+	(5, 1712203200, 1712206800),
+	(5, 1712206800, 1712210400),
+	(5, 1712210400, 1712214000),
+	(5, 1712214000, 1712217600),
+	(5, 1712217600, 1712221200),
+	(5, 1712221200, 1712224800),
+	(5, 1712224800, 1712228400),
+	(5, 1712228400, 1712232000),
+	(5, 1712232000, 1712235600),
+	(5, 1712235600, 1712239200),
+	(5, 1712239200, 1712242800),
+	(5, 1712242800, 1712246400),
+	(5, 1712246400, 1712250000),
+	(5, 1712250000, 1712253600),
+	(5, 1712253600, 1712257200),
+	(5, 1712257200, 1712260800),
+	(5, 1712260800, 1712264400),
+	(5, 1712264400, 1712268000),
+	(5, 1712268000, 1712271600),
+	(5, 1712271600, 1712275200),
+	(5, 1712275200, 1712278800),
+	(5, 1712278800, 1712282400),
+	(5, 1712282400, 1712286000),
+	(5, 1712286000, 1712289600),
+	(5, 1712289600, 1712293200),
+	(5, 1712293200, 1712296800),
+	(5, 1712296800, 1712300400),
+	(5, 1712300400, 1712304000),
+	(5, 1712304000, 1712307600),
+	(5, 1712307600, 1712311200),
+	(5, 1712311200, 1712314800),
+	(5, 1712314800, 1712318400),
+	(5, 1712318400, 1712322000),
+	(5, 1712322000, 1712325600),
+	(5, 1712325600, 1712329200),
+	(5, 1712329200, 1712332800),
+	(5, 1712332800, 1712336400),
+	(5, 1712336400, 1712340000),
+	(5, 1712340000, 1712343600),
+	(5, 1712343600, 1712347200),
+	(5, 1712347200, 1712350800),
+	(5, 1712350800, 1712354400),
+	(5, 1712354400, 1712358000),
+	(5, 1712358000, 1712361600),
+	(5, 1712361600, 1712365200),
+	(5, 1712365200, 1712368800),
+	(5, 1712368800, 1712372400),
+	(5, 1712372400, 1712376000),
+	(5, 1712376000, 1712379600),
+	(5, 1712379600, 1712383200),
+	(5, 1712383200, 1712386800),
+	(5, 1712386800, 1712390400),
+	(5, 1712390400, 1712394000),
+	(5, 1712394000, 1712397600),
+	(5, 1712397600, 1712401200),
+	(5, 1712401200, 1712404800),
+	(5, 1712404800, 1712408400),
+	(5, 1712408400, 1712412000),
+	(5, 1712412000, 1712415600),
+	(5, 1712415600, 1712419200),
+	(5, 1712419200, 1712422800),
+	(5, 1712422800, 1712426400),
+	(5, 1712426400, 1712430000),
+	(5, 1712430000, 1712433600),
+	(5, 1712433600, 1712437200),
+	(5, 1712437200, 1712440800),
+	(5, 1712440800, 1712444400),
+	(5, 1712444400, 1712448000),
+	(5, 1712448000, 1712451600),
+	(5, 1712451600, 1712455200),
+	(5, 1712455200, 1712458800),
+	(5, 1712458800, 1712462400),
+	(5, 1712462400, 1712466000),
+	(5, 1712466000, 1712469600),
+	(5, 1712469600, 1712473200),
+	(5, 1712473200, 1712476800),
+	(5, 1712476800, 1712480400),
+	(5, 1712480400, 1712484000),
+	(5, 1712484000, 1712487600),
+	(5, 1712487600, 1712491200),
+	(5, 1712491200, 1712494800),
+	(5, 1712494800, 1712498400),
+	(5, 1712498400, 1712502000),
+	(5, 1712502000, 1712505600),
+	(5, 1712505600, 1712509200),
+	(5, 1712509200, 1712512800),
+	(5, 1712512800, 1712516400),
+	(5, 1712516400, 1712520000),
+	(5, 1712520000, 1712523600),
+	(5, 1712523600, 1712527200),
+	(5, 1712527200, 1712530800),
+	(5, 1712530800, 1712534400),
+	(5, 1712534400, 1712538000),
+	(5, 1712538000, 1712541600),
+	(5, 1712541600, 1712545200),
+	(5, 1712545200, 1712548800),
+	(5, 1712548800, 1712552400),
+	(5, 1712552400, 1712556000),
+	(5, 1712556000, 1712559600),
+	(5, 1712559600, 1712563200),
+	(5, 1712563200, 1712566800),
+	(5, 1712566800, 1712570400),
+	(5, 1712570400, 1712574000),
+	(5, 1712574000, 1712577600),
+	(5, 1712577600, 1712581200),
+	(5, 1712581200, 1712584800),
+	(5, 1712584800, 1712588400),
+	(5, 1712588400, 1712592000),
+	(5, 1712592000, 1712595600),
+	(5, 1712595600, 1712599200),
+	(5, 1712599200, 1712602800),
+	(5, 1712602800, 1712606400),
+	(5, 1712606400, 1712610000),
+	(5, 1712610000, 1712613600),
+	(5, 1712613600, 1712617200),
+	(5, 1712617200, 1712620800),
+	(5, 1712620800, 1712624400),
+	(5, 1712624400, 1712628000),
+	(5, 1712628000, 1712631600),
+	(5, 1712631600, 1712635200),
+	(5, 1712635200, 1712638800),
+	(5, 1712638800, 1712642400),
+	(5, 1712642400, 1712646000),
+	(5, 1712646000, 1712649600),
+	(5, 1712649600, 1712653200),
+	(5, 1712653200, 1712656800),
+	(5, 1712656800, 1712660400),
+	(5, 1712660400, 1712664000),
+	(5, 1712664000, 1712667600),
+	(5, 1712667600, 1712671200),
+	(5, 1712671200, 1712674800),
+	(5, 1712674800, 1712678400),
+	(5, 1712678400, 1712682000),
+	(5, 1712682000, 1712685600),
+	(5, 1712685600, 1712689200),
+	(5, 1712689200, 1712692800),
+	(5, 1712692800, 1712696400),
+	(5, 1712696400, 1712700000),
+	(5, 1712700000, 1712703600),
+	(5, 1712703600, 1712707200),
+	(5, 1712707200, 1712710800),
+	(5, 1712710800, 1712714400),
+	(5, 1712714400, 1712718000),
+	(5, 1712718000, 1712721600),
+	(5, 1712721600, 1712725200),
+	(5, 1712725200, 1712728800),
+	(5, 1712728800, 1712732400),
+	(5, 1712732400, 1712736000),
+	(5, 1712736000, 1712739600),
+	(5, 1712739600, 1712743200),
+	(5, 1712743200, 1712746800),
+	(5, 1712746800, 1712750400),
+	(5, 1712750400, 1712754000),
+	(5, 1712754000, 1712757600),
+	(5, 1712757600, 1712761200),
+	(5, 1712761200, 1712764800),
+	(5, 1712764800, 1712768400),
+	(5, 1712768400, 1712772000),
+	(5, 1712772000, 1712775600),
+	(5, 1712775600, 1712779200),
+	(5, 1712779200, 1712782800),
+	(5, 1712782800, 1712786400),
+	(5, 1712786400, 1712790000),
+	(5, 1712790000, 1712793600),
+	(5, 1712793600, 1712797200),
+	(5, 1712797200, 1712800800),
+	(5, 1712800800, 1712804400),
+	(5, 1712804400, 1712808000),
+	(5, 1712808000, 1712811600),
+	(5, 1712811600, 1712815200),
+	(5, 1712815200, 1712818800),
+	(5, 1712818800, 1712822400),
+	(5, 1712822400, 1712826000),
+	(5, 1712826000, 1712829600),
+	(5, 1712829600, 1712833200),
+	(5, 1712833200, 1712836800),
+	(5, 1712836800, 1712840400),
+	(5, 1712840400, 1712844000),
+	(5, 1712844000, 1712847600),
+	(5, 1712847600, 1712851200),
+	(5, 1712851200, 1712854800),
+	(5, 1712854800, 1712858400),
+	(5, 1712858400, 1712862000),
+	(5, 1712862000, 1712865600),
+	(5, 1712865600, 1712869200),
+	(5, 1712869200, 1712872800),
+	(5, 1712872800, 1712876400),
+	(5, 1712876400, 1712880000),
+	(5, 1712880000, 1712883600),
+	(5, 1712883600, 1712887200),
+	(5, 1712887200, 1712890800),
+	(5, 1712890800, 1712894400),
+	(5, 1712894400, 1712898000),
+	(5, 1712898000, 1712901600),
+	(5, 1712901600, 1712905200),
+	(5, 1712905200, 1712908800),
+	(5, 1712908800, 1712912400),
+	(5, 1712912400, 1712916000),
+	(5, 1712916000, 1712919600),
+	(5, 1712919600, 1712923200),
+	(5, 1712923200, 1712926800),
+	(5, 1712926800, 1712930400),
+	(5, 1712930400, 1712934000),
+	(5, 1712934000, 1712937600),
+	(5, 1712937600, 1712941200),
+	(5, 1712941200, 1712944800),
+	(5, 1712944800, 1712948400),
+	(5, 1712948400, 1712952000),
+	(5, 1712952000, 1712955600),
+	(5, 1712955600, 1712959200),
+	(5, 1712959200, 1712962800),
+	(5, 1712962800, 1712966400),
+	(5, 1712966400, 1712970000),
+	(5, 1712970000, 1712973600),
+	(5, 1712973600, 1712977200),
+	(5, 1712977200, 1712980800),
+	(5, 1712980800, 1712984400),
+	(5, 1712984400, 1712988000),
+	(5, 1712988000, 1712991600),
+	(5, 1712991600, 1712995200),
+	(5, 1712995200, 1712998800),
+	(5, 1712998800, 1713002400),
+	(5, 1713002400, 1713006000),
+	(5, 1713006000, 1713009600),
+	(5, 1713009600, 1713013200),
+	(5, 1713013200, 1713016800),
+	(5, 1713016800, 1713020400),
+	(5, 1713020400, 1713024000),
+	(5, 1713024000, 1713027600),
+	(5, 1713027600, 1713031200),
+	(5, 1713031200, 1713034800),
+	(5, 1713034800, 1713038400),
+	(5, 1713038400, 1713042000),
+	(5, 1713042000, 1713045600),
+	(5, 1713045600, 1713049200),
+	(5, 1713049200, 1713052800),
+	(5, 1713052800, 1713056400),
+	(5, 1713056400, 1713060000),
+	(5, 1713060000, 1713063600),
+	(5, 1713063600, 1713067200),
+	(5, 1713067200, 1713070800),
+	(5, 1713070800, 1713074400),
+	(5, 1713074400, 1713078000),
+	(5, 1713078000, 1713081600),
+	(5, 1713081600, 1713085200),
+	(5, 1713085200, 1713088800),
+	(5, 1713088800, 1713092400),
+	(5, 1713092400, 1713096000),
+	(5, 1713096000, 1713099600),
+	(5, 1713099600, 1713103200),
+	(5, 1713103200, 1713106800),
+	(5, 1713106800, 1713110400),
+	(5, 1713110400, 1713114000),
+	(5, 1713114000, 1713117600),
+	(5, 1713117600, 1713121200),
+	(5, 1713121200, 1713124800),
+	(5, 1713124800, 1713128400),
+	(5, 1713128400, 1713132000),
+	(5, 1713132000, 1713135600),
+	(5, 1713135600, 1713139200),
+	(5, 1713139200, 1713142800),
+	(5, 1713142800, 1713146400),
+	(5, 1713146400, 1713150000),
+	(5, 1713150000, 1713153600),
+	(5, 1713153600, 1713157200),
+	(5, 1713157200, 1713160800),
+	(5, 1713160800, 1713164400),
+	(5, 1713164400, 1713168000),
+	(5, 1713168000, 1713171600),
+	(5, 1713171600, 1713175200),
+	(5, 1713175200, 1713178800),
+	(5, 1713178800, 1713182400),
+	(5, 1713182400, 1713186000),
+	(5, 1713186000, 1713189600),
+	(5, 1713189600, 1713193200),
+	(5, 1713193200, 1713196800),
+	(5, 1713196800, 1713200400),
+	(5, 1713200400, 1713204000),
+	(5, 1713204000, 1713207600),
+	(5, 1713207600, 1713211200),
+	(5, 1713211200, 1713214800),
+	(5, 1713214800, 1713218400),
+	(5, 1713218400, 1713222000),
+	(5, 1713222000, 1713225600),
+	(5, 1713225600, 1713229200),
+	(5, 1713229200, 1713232800),
+	(5, 1713232800, 1713236400),
+	(5, 1713236400, 1713240000),
+	(5, 1713240000, 1713243600),
+	(5, 1713243600, 1713247200),
+	(5, 1713247200, 1713250800),
+	(5, 1713250800, 1713254400),
+	(5, 1713254400, 1713258000),
+	(5, 1713258000, 1713261600),
+	(5, 1713261600, 1713265200),
+	(5, 1713265200, 1713268800),
+	(5, 1713268800, 1713272400),
+	(5, 1713272400, 1713276000),
+	(5, 1713276000, 1713279600),
+	(5, 1713279600, 1713283200),
+	(5, 1713283200, 1713286800),
+	(5, 1713286800, 1713290400),
+	(5, 1713290400, 1713294000),
+	(5, 1713294000, 1713297600),
+	(5, 1713297600, 1713301200),
+	(5, 1713301200, 1713304800),
+	(5, 1713304800, 1713308400),
+	(5, 1713308400, 1713312000),
+	(5, 1713312000, 1713315600),
+	(5, 1713315600, 1713319200),
+	(5, 1713319200, 1713322800),
+	(5, 1713322800, 1713326400),
+	(5, 1713326400, 1713330000),
+	(5, 1713330000, 1713333600),
+	(5, 1713333600, 1713337200),
+	(5, 1713337200, 1713340800),
+	(5, 1713340800, 1713344400),
+	(5, 1713344400, 1713348000),
+	(5, 1713348000, 1713351600),
+	(5, 1713351600, 1713355200),
+	(5, 1713355200, 1713358800),
+	(5, 1713358800, 1713362400),
+	(5, 1713362400, 1713366000),
+	(5, 1713366000, 1713369600),
+	(5, 1713369600, 1713373200),
+	(5, 1713373200, 1713376800),
+	(5, 1713376800, 1713380400),
+	(5, 1713380400, 1713384000),
+	(5, 1713384000, 1713387600),
+	(5, 1713387600, 1713391200),
+	(5, 1713391200, 1713394800),
+	(5, 1713394800, 1713398400),
+	(5, 1713398400, 1713402000),
+	(5, 1713402000, 1713405600),
+	(5, 1713405600, 1713409200),
+	(5, 1713409200, 1713412800),
+	(5, 1713412800, 1713416400),
+	(5, 1713416400, 1713420000),
+	(5, 1713420000, 1713423600),
+	(5, 1713423600, 1713427200),
+	(5, 1713427200, 1713430800),
+	(5, 1713430800, 1713434400),
+	(5, 1713434400, 1713438000),
+	(5, 1713438000, 1713441600),
+	(5, 1713441600, 1713445200),
+	(5, 1713445200, 1713448800),
+	(5, 1713448800, 1713452400),
+	(5, 1713452400, 1713456000),
+	(5, 1713456000, 1713459600),
+	(5, 1713459600, 1713463200),
+	(5, 1713463200, 1713466800),
+	(5, 1713466800, 1713470400),
+	(5, 1713470400, 1713474000),
+	(5, 1713474000, 1713477600),
+	(5, 1713477600, 1713481200),
+	(5, 1713481200, 1713484800),
+	(5, 1713484800, 1713488400),
+	(5, 1713488400, 1713492000),
+	(5, 1713492000, 1713495600),
+	(5, 1713495600, 1713499200),
+	(5, 1713499200, 1713502800),
+	(5, 1713502800, 1713506400),
+	(5, 1713506400, 1713510000),
+	(5, 1713510000, 1713513600),
+	(5, 1713513600, 1713517200),
+	(5, 1713517200, 1713520800),
+	(5, 1713520800, 1713524400),
+	(5, 1713524400, 1713528000),
+	(5, 1713528000, 1713531600),
+	(5, 1713531600, 1713535200),
+	(5, 1713535200, 1713538800),
+	(5, 1713538800, 1713542400),
+	(5, 1713542400, 1713546000),
+	(5, 1713546000, 1713549600),
+	(5, 1713549600, 1713553200),
+	(5, 1713553200, 1713556800),
+	(5, 1713556800, 1713560400),
+	(5, 1713560400, 1713564000),
+	(5, 1713564000, 1713567600),
+	(5, 1713567600, 1713571200),
+	(5, 1713571200, 1713574800),
+	(5, 1713574800, 1713578400),
+	(5, 1713578400, 1713582000),
+	(5, 1713582000, 1713585600),
+	(5, 1713585600, 1713589200),
+	(5, 1713589200, 1713592800),
+	(5, 1713592800, 1713596400),
+	(5, 1713596400, 1713600000),
+	(5, 1713600000, 1713603600),
+	(5, 1713603600, 1713607200),
+	(5, 1713607200, 1713610800),
+	(5, 1713610800, 1713614400),
+	(5, 1713614400, 1713618000),
+	(5, 1713618000, 1713621600),
+	(5, 1713621600, 1713625200),
+	(5, 1713625200, 1713628800),
+	(5, 1713628800, 1713632400),
+	(5, 1713632400, 1713636000),
+	(5, 1713636000, 1713639600),
+	(5, 1713639600, 1713643200),
+	(5, 1713643200, 1713646800),
+	(5, 1713646800, 1713650400),
+	(5, 1713650400, 1713654000),
+	(5, 1713654000, 1713657600),
+	(5, 1713657600, 1713661200),
+	(5, 1713661200, 1713664800),
+	(5, 1713664800, 1713668400),
+	(5, 1713668400, 1713672000),
+	(5, 1713672000, 1713675600),
+	(5, 1713675600, 1713679200),
+	(5, 1713679200, 1713682800),
+	(5, 1713682800, 1713686400),
+	(5, 1713686400, 1713690000),
+	(5, 1713690000, 1713693600),
+	(5, 1713693600, 1713697200),
+	(5, 1713697200, 1713700800),
+	(5, 1713700800, 1713704400),
+	(5, 1713704400, 1713708000),
+	(5, 1713708000, 1713711600),
+	(5, 1713711600, 1713715200),
+	(5, 1713715200, 1713718800),
+	(5, 1713718800, 1713722400),
+	(5, 1713722400, 1713726000),
+	(5, 1713726000, 1713729600),
+	(5, 1713729600, 1713733200),
+	(5, 1713733200, 1713736800),
+	(5, 1713736800, 1713740400),
+	(5, 1713740400, 1713744000),
+	(5, 1713744000, 1713747600),
+	(5, 1713747600, 1713751200),
+	(5, 1713751200, 1713754800),
+	(5, 1713754800, 1713758400),
+	(5, 1713758400, 1713762000),
+	(5, 1713762000, 1713765600),
+	(5, 1713765600, 1713769200),
+	(5, 1713769200, 1713772800),
+	(5, 1713772800, 1713776400),
+	(5, 1713776400, 1713780000),
+	(5, 1713780000, 1713783600),
+	(5, 1713783600, 1713787200),
+	(5, 1713787200, 1713790800),
+	(5, 1713790800, 1713794400),
+	(5, 1713794400, 1713798000),
+	(5, 1713798000, 1713801600),
+	(5, 1713801600, 1713805200),
+	(5, 1713805200, 1713808800),
+	(5, 1713808800, 1713812400),
+	(5, 1713812400, 1713816000),
+	(5, 1713816000, 1713819600),
+	(5, 1713819600, 1713823200),
+	(5, 1713823200, 1713826800),
+	(5, 1713826800, 1713830400),
+	(5, 1713830400, 1713834000),
+	(5, 1713834000, 1713837600),
+	(5, 1713837600, 1713841200),
+	(5, 1713841200, 1713844800),
+	(5, 1713844800, 1713848400),
+	(5, 1713848400, 1713852000),
+	(5, 1713852000, 1713855600),
+	(5, 1713855600, 1713859200),
+	(5, 1713859200, 1713862800),
+	(5, 1713862800, 1713866400),
+	(5, 1713866400, 1713870000),
+	(5, 1713870000, 1713873600),
+	(5, 1713873600, 1713877200),
+	(5, 1713877200, 1713880800),
+	(5, 1713880800, 1713884400),
+	(5, 1713884400, 1713888000),
+	(5, 1713888000, 1713891600),
+	(5, 1713891600, 1713895200),
+	(5, 1713895200, 1713898800),
+	(5, 1713898800, 1713902400),
+	(5, 1713902400, 1713906000),
+	(5, 1713906000, 1713909600),
+	(5, 1713909600, 1713913200),
+	(5, 1713913200, 1713916800),
+	(5, 1713916800, 1713920400),
+	(5, 1713920400, 1713924000),
+	(5, 1713924000, 1713927600),
+	(5, 1713927600, 1713931200),
+	(5, 1713931200, 1713934800),
+	(5, 1713934800, 1713938400),
+	(5, 1713938400, 1713942000),
+	(5, 1713942000, 1713945600),
+	(5, 1713945600, 1713949200),
+	(5, 1713949200, 1713952800),
+	(5, 1713952800, 1713956400),
+	(5, 1713956400, 1713960000),
+	(5, 1713960000, 1713963600),
+	(5, 1713963600, 1713967200),
+	(5, 1713967200, 1713970800),
+	(5, 1713970800, 1713974400),
+	(5, 1713974400, 1713978000),
+	(5, 1713978000, 1713981600),
+	(5, 1713981600, 1713985200),
+	(5, 1713985200, 1713988800),
+	(5, 1713988800, 1713992400),
+	(5, 1713992400, 1713996000),
+	(5, 1713996000, 1713999600),
+	(5, 1713999600, 1714003200),
+	(5, 1714003200, 1714006800),
+	(5, 1714006800, 1714010400),
+	(5, 1714010400, 1714014000),
+	(5, 1714014000, 1714017600),
+	(5, 1714017600, 1714021200),
+	(5, 1714021200, 1714024800),
+	(5, 1714024800, 1714028400),
+	(5, 1714028400, 1714032000),
+	(5, 1714032000, 1714035600),
+	(5, 1714035600, 1714039200),
+	(5, 1714039200, 1714042800),
+	(5, 1714042800, 1714046400),
+	(5, 1714046400, 1714050000),
+	(5, 1714050000, 1714053600),
+	(5, 1714053600, 1714057200),
+	(5, 1714057200, 1714060800),
+	(5, 1714060800, 1714064400),
+	(5, 1714064400, 1714068000),
+	(5, 1714068000, 1714071600),
+	(5, 1714071600, 1714075200),
+	(5, 1714075200, 1714078800),
+	(5, 1714078800, 1714082400),
+	(5, 1714082400, 1714086000),
+	(5, 1714086000, 1714089600),
+	(5, 1714089600, 1714093200),
+	(5, 1714093200, 1714096800),
+	(5, 1714096800, 1714100400),
+	(5, 1714100400, 1714104000),
+	(5, 1714104000, 1714107600),
+	(5, 1714107600, 1714111200),
+	(5, 1714111200, 1714114800),
+	(5, 1714114800, 1714118400),
+	(5, 1714118400, 1714122000),
+	(5, 1714122000, 1714125600),
+	(5, 1714125600, 1714129200),
+	(5, 1714129200, 1714132800),
+	(5, 1714132800, 1714136400),
+	(5, 1714136400, 1714140000),
+	(5, 1714140000, 1714143600),
+	(5, 1714143600, 1714147200),
+	(5, 1714147200, 1714150800),
+	(5, 1714150800, 1714154400),
+	(5, 1714154400, 1714158000),
+	(5, 1714158000, 1714161600),
+	(5, 1714161600, 1714165200),
+	(5, 1714165200, 1714168800),
+	(5, 1714168800, 1714172400),
+	(5, 1714172400, 1714176000),
+	(5, 1714176000, 1714179600),
+	(5, 1714179600, 1714183200),
+	(5, 1714183200, 1714186800),
+	(5, 1714186800, 1714190400),
+	(5, 1714190400, 1714194000),
+	(5, 1714194000, 1714197600),
+	(5, 1714197600, 1714201200),
+	(5, 1714201200, 1714204800),
+	(5, 1714204800, 1714208400),
+	(5, 1714208400, 1714212000),
+	(5, 1714212000, 1714215600),
+	(5, 1714215600, 1714219200),
+	(5, 1714219200, 1714222800),
+	(5, 1714222800, 1714226400),
+	(5, 1714226400, 1714230000),
+	(5, 1714230000, 1714233600),
+	(5, 1714233600, 1714237200),
+	(5, 1714237200, 1714240800),
+	(5, 1714240800, 1714244400),
+	(5, 1714244400, 1714248000),
+	(5, 1714248000, 1714251600),
+	(5, 1714251600, 1714255200),
+	(5, 1714255200, 1714258800),
+	(5, 1714258800, 1714262400),
+	(5, 1714262400, 1714266000),
+	(5, 1714266000, 1714269600),
+	(5, 1714269600, 1714273200),
+	(5, 1714273200, 1714276800),
+	(5, 1714276800, 1714280400),
+	(5, 1714280400, 1714284000),
+	(5, 1714284000, 1714287600),
+	(5, 1714287600, 1714291200),
+	(5, 1714291200, 1714294800),
+	(5, 1714294800, 1714298400),
+	(5, 1714298400, 1714302000),
+	(5, 1714302000, 1714305600),
+	(5, 1714305600, 1714309200),
+	(5, 1714309200, 1714312800),
+	(5, 1714312800, 1714316400),
+	(5, 1714316400, 1714320000),
+	(5, 1714320000, 1714323600),
+	(5, 1714323600, 1714327200),
+	(5, 1714327200, 1714330800),
+	(5, 1714330800, 1714334400),
+	(5, 1714334400, 1714338000),
+	(5, 1714338000, 1714341600),
+	(5, 1714341600, 1714345200),
+	(5, 1714345200, 1714348800),
+	(5, 1714348800, 1714352400),
+	(5, 1714352400, 1714356000),
+	(5, 1714356000, 1714359600),
+	(5, 1714359600, 1714363200),
+	(5, 1714363200, 1714366800),
+	(5, 1714366800, 1714370400),
+	(5, 1714370400, 1714374000),
+	(5, 1714374000, 1714377600),
+	(5, 1714377600, 1714381200),
+	(5, 1714381200, 1714384800),
+	(5, 1714384800, 1714388400),
+	(5, 1714388400, 1714392000),
+	(5, 1714392000, 1714395600),
+	(5, 1714395600, 1714399200),
+	(5, 1714399200, 1714402800),
+	(5, 1714402800, 1714406400),
+	(5, 1714406400, 1714410000),
+	(5, 1714410000, 1714413600),
+	(5, 1714413600, 1714417200),
+	(5, 1714417200, 1714420800),
+	(5, 1714420800, 1714424400),
+	(5, 1714424400, 1714428000),
+	(5, 1714428000, 1714431600),
+	(5, 1714431600, 1714435200),
+	(5, 1714435200, 1714438800),
+	(5, 1714438800, 1714442400),
+	(5, 1714442400, 1714446000),
+	(5, 1714446000, 1714449600),
+	(5, 1714449600, 1714453200),
+	(5, 1714453200, 1714456800),
+	(5, 1714456800, 1714460400),
+	(5, 1714460400, 1714464000),
+	(5, 1714464000, 1714467600),
+	(5, 1714467600, 1714471200),
+	(5, 1714471200, 1714474800),
+	(5, 1714474800, 1714478400),
+	(5, 1714478400, 1714482000),
+	(5, 1714482000, 1714485600),
+	(5, 1714485600, 1714489200),
+	(5, 1714489200, 1714492800),
+	(5, 1714492800, 1714496400),
+	(5, 1714496400, 1714500000),
+	(5, 1714500000, 1714503600),
+	(5, 1714503600, 1714507200),
+	(5, 1714507200, 1714510800),
+	(5, 1714510800, 1714514400),
+	(5, 1714514400, 1714518000),
+	(5, 1714518000, 1714521600),
+	(5, 1714521600, 1714525200),
+	(5, 1714525200, 1714528800),
+	(5, 1714528800, 1714532400),
+	(5, 1714532400, 1714536000),
+	(5, 1714536000, 1714539600),
+	(5, 1714539600, 1714543200),
+	(5, 1714543200, 1714546800),
+	(5, 1714546800, 1714550400),
+	(5, 1714550400, 1714554000),
+	(5, 1714554000, 1714557600),
+	(5, 1714557600, 1714561200),
+	(5, 1714561200, 1714564800),
+	(5, 1714564800, 1714568400),
+	(5, 1714568400, 1714572000),
+	(5, 1714572000, 1714575600),
+	(5, 1714575600, 1714579200),
+	(5, 1714579200, 1714582800),
+	(5, 1714582800, 1714586400),
+	(5, 1714586400, 1714590000),
+	(5, 1714590000, 1714593600),
+	(5, 1714593600, 1714597200),
+	(5, 1714597200, 1714600800),
+	(5, 1714600800, 1714604400),
+	(5, 1714604400, 1714608000),
+	(5, 1714608000, 1714611600),
+	(5, 1714611600, 1714615200),
+	(5, 1714615200, 1714618800),
+	(5, 1714618800, 1714622400),
+	(5, 1714622400, 1714626000),
+	(5, 1714626000, 1714629600),
+	(5, 1714629600, 1714633200),
+	(5, 1714633200, 1714636800),
+	(5, 1714636800, 1714640400),
+	(5, 1714640400, 1714644000),
+	(5, 1714644000, 1714647600),
+	(5, 1714647600, 1714651200),
+	(5, 1714651200, 1714654800),
+	(5, 1714654800, 1714658400),
+	(5, 1714658400, 1714662000),
+	(5, 1714662000, 1714665600),
+	(5, 1714665600, 1714669200),
+	(5, 1714669200, 1714672800),
+	(5, 1714672800, 1714676400),
+	(5, 1714676400, 1714680000),
+	(5, 1714680000, 1714683600),
+	(5, 1714683600, 1714687200),
+	(5, 1714687200, 1714690800),
+	(5, 1714690800, 1714694400),
+	(5, 1714694400, 1714698000),
+	(5, 1714698000, 1714701600),
+	(5, 1714701600, 1714705200),
+	(5, 1714705200, 1714708800),
+	(5, 1714708800, 1714712400),
+	(5, 1714712400, 1714716000),
+	(5, 1714716000, 1714719600),
+	(5, 1714719600, 1714723200),
+	(5, 1714723200, 1714726800),
+	(5, 1714726800, 1714730400),
+	(5, 1714730400, 1714734000),
+	(5, 1714734000, 1714737600),
+	(5, 1714737600, 1714741200),
+	(5, 1714741200, 1714744800),
+	(5, 1714744800, 1714748400),
+	(5, 1714748400, 1714752000),
+	(5, 1714752000, 1714755600),
+	(5, 1714755600, 1714759200),
+	(5, 1714759200, 1714762800),
+	(5, 1714762800, 1714766400),
+	(5, 1714766400, 1714770000),
+	(5, 1714770000, 1714773600),
+	(5, 1714773600, 1714777200),
+	(5, 1714777200, 1714780800),
+	(5, 1714780800, 1714784400),
+	(5, 1714784400, 1714788000),
+	(5, 1714788000, 1714791600),
+	(5, 1714791600, 1714795200),
+	(5, 1714795200, 1714798800),
+	(5, 1714798800, 1714802400),
+	(5, 1714802400, 1714806000),
+	(5, 1714806000, 1714809600),
+	(5, 1714809600, 1714813200),
+	(5, 1714813200, 1714816800),
+	(5, 1714816800, 1714820400),
+	(5, 1714820400, 1714824000),
+	(5, 1714824000, 1714827600),
+	(5, 1714827600, 1714831200),
+	(5, 1714831200, 1714834800),
+	(5, 1714834800, 1714838400),
+	(5, 1714838400, 1714842000),
+	(5, 1714842000, 1714845600),
+	(5, 1714845600, 1714849200),
+	(5, 1714849200, 1714852800),
+	(5, 1714852800, 1714856400),
+	(5, 1714856400, 1714860000),
+	(5, 1714860000, 1714863600),
+	(5, 1714863600, 1714867200),
+	(5, 1714867200, 1714870800),
+	(5, 1714870800, 1714874400),
+	(5, 1714874400, 1714878000),
+	(5, 1714878000, 1714881600),
+	(5, 1714881600, 1714885200),
+	(5, 1714885200, 1714888800),
+	(5, 1714888800, 1714892400),
+	(5, 1714892400, 1714896000),
+	(5, 1714896000, 1714899600),
+	(5, 1714899600, 1714903200),
+	(5, 1714903200, 1714906800),
+	(5, 1714906800, 1714910400),
+	(5, 1714910400, 1714914000),
+	(5, 1714914000, 1714917600),
+	(5, 1714917600, 1714921200),
+	(5, 1714921200, 1714924800),
+	(5, 1714924800, 1714928400),
+	(5, 1714928400, 1714932000),
+	(5, 1714932000, 1714935600),
+	(5, 1714935600, 1714939200),
+	(5, 1714939200, 1714942800),
+	(5, 1714942800, 1714946400),
+	(5, 1714946400, 1714950000),
+	(5, 1714950000, 1714953600),
+	(5, 1714953600, 1714957200),
+	(5, 1714957200, 1714960800),
+	(5, 1714960800, 1714964400),
+	(5, 1714964400, 1714968000),
+	(5, 1714968000, 1714971600),
+	(5, 1714971600, 1714975200),
+	(5, 1714975200, 1714978800),
+	(5, 1714978800, 1714982400),
+	(5, 1714982400, 1714986000),
+	(5, 1714986000, 1714989600),
+	(5, 1714989600, 1714993200),
+	(5, 1714993200, 1714996800),
+	(5, 1714996800, 1715000400),
+	(5, 1715000400, 1715004000),
+	(5, 1715004000, 1715007600),
+	(5, 1715007600, 1715011200),
+	(5, 1715011200, 1715014800),
+	(5, 1715014800, 1715018400),
+	(5, 1715018400, 1715022000),
+	(5, 1715022000, 1715025600),
+	(5, 1715025600, 1715029200),
+	(5, 1715029200, 1715032800),
+	(5, 1715032800, 1715036400),
+	(5, 1715036400, 1715040000),
+	(5, 1715040000, 1715043600),
+	(5, 1715043600, 1715047200),
+	(5, 1715047200, 1715050800),
+	(5, 1715050800, 1715054400),
+	(5, 1715054400, 1715058000),
+	(5, 1715058000, 1715061600),
+	(5, 1715061600, 1715065200),
+	(5, 1715065200, 1715068800),
+	(5, 1715068800, 1715072400),
+	(5, 1715072400, 1715076000),
+	(5, 1715076000, 1715079600),
+	(5, 1715079600, 1715083200),
+	(5, 1715083200, 1715086800),
+	(5, 1715086800, 1715090400),
+	(5, 1715090400, 1715094000),
+	(5, 1715094000, 1715097600),
+	(5, 1715097600, 1715101200),
+	(5, 1715101200, 1715104800),
+	(5, 1715104800, 1715108400),
+	(5, 1715108400, 1715112000),
+	(5, 1715112000, 1715115600),
+	(5, 1715115600, 1715119200),
+	(5, 1715119200, 1715122800),
+	(5, 1715122800, 1715126400),
+	(5, 1715126400, 1715130000),
+	(5, 1715130000, 1715133600),
+	(5, 1715133600, 1715137200),
+	(5, 1715137200, 1715140800),
+	(5, 1715140800, 1715144400),
+	(5, 1715144400, 1715148000),
+	(5, 1715148000, 1715151600),
+	(5, 1715151600, 1715155200),
+	(5, 1715155200, 1715158800),
+	(5, 1715158800, 1715162400),
+	(5, 1715162400, 1715166000),
+	(5, 1715166000, 1715169600),
+	(5, 1715169600, 1715173200),
+	(5, 1715173200, 1715176800),
+	(5, 1715176800, 1715180400),
+	(5, 1715180400, 1715184000),
+	(5, 1715184000, 1715187600),
+	(5, 1715187600, 1715191200),
+	(5, 1715191200, 1715194800),
+	(5, 1715194800, 1715198400),
+	(5, 1715198400, 1715202000),
+	(5, 1715202000, 1715205600),
+	(5, 1715205600, 1715209200),
+	(5, 1715209200, 1715212800),
+	(5, 1715212800, 1715216400),
+	(5, 1715216400, 1715220000),
+	(5, 1715220000, 1715223600),
+	(5, 1715223600, 1715227200),
+	(5, 1715227200, 1715230800),
+	(5, 1715230800, 1715234400),
+	(5, 1715234400, 1715238000),
+	(5, 1715238000, 1715241600),
+	(5, 1715241600, 1715245200),
+	(5, 1715245200, 1715248800),
+	(5, 1715248800, 1715252400),
+	(5, 1715252400, 1715256000),
+	(5, 1715256000, 1715259600),
+	(5, 1715259600, 1715263200),
+	(5, 1715263200, 1715266800),
+	(5, 1715266800, 1715270400),
+	(5, 1715270400, 1715274000),
+	(5, 1715274000, 1715277600),
+	(5, 1715277600, 1715281200),
+	(5, 1715281200, 1715284800),
+	(5, 1715284800, 1715288400),
+	(5, 1715288400, 1715292000),
+	(5, 1715292000, 1715295600),
+	(5, 1715295600, 1715299200),
+	(5, 1715299200, 1715302800),
+	(5, 1715302800, 1715306400),
+	(5, 1715306400, 1715310000),
+	(5, 1715310000, 1715313600),
+	(5, 1715313600, 1715317200),
+	(5, 1715317200, 1715320800),
+	(5, 1715320800, 1715324400),
+	(5, 1715324400, 1715328000),
+	(5, 1715328000, 1715331600),
+	(5, 1715331600, 1715335200),
+	(5, 1715335200, 1715338800),
+	(5, 1715338800, 1715342400),
+	(5, 1715342400, 1715346000),
+	(5, 1715346000, 1715349600),
+	(5, 1715349600, 1715353200),
+	(5, 1715353200, 1715356800),
+	(5, 1715356800, 1715360400),
+	(5, 1715360400, 1715364000),
+	(5, 1715364000, 1715367600),
+	(5, 1715367600, 1715371200),
+	(5, 1715371200, 1715374800),
+	(5, 1715374800, 1715378400),
+	(5, 1715378400, 1715382000),
+	(5, 1715382000, 1715385600),
+	(5, 1715385600, 1715389200),
+	(5, 1715389200, 1715392800),
+	(5, 1715392800, 1715396400),
+	(5, 1715396400, 1715400000),
+	(5, 1715400000, 1715403600),
+	(5, 1715403600, 1715407200),
+	(5, 1715407200, 1715410800),
+	(5, 1715410800, 1715414400),
+	(5, 1715414400, 1715418000),
+	(5, 1715418000, 1715421600),
+	(5, 1715421600, 1715425200),
+	(5, 1715425200, 1715428800),
+	(5, 1715428800, 1715432400),
+	(5, 1715432400, 1715436000),
+	(5, 1715436000, 1715439600),
+	(5, 1715439600, 1715443200),
+	(5, 1715443200, 1715446800),
+	(5, 1715446800, 1715450400),
+	(5, 1715450400, 1715454000),
+	(5, 1715454000, 1715457600),
+	(5, 1715457600, 1715461200),
+	(5, 1715461200, 1715464800),
+	(5, 1715464800, 1715468400),
+	(5, 1715468400, 1715472000),
+	(5, 1715472000, 1715475600),
+	(5, 1715475600, 1715479200),
+	(5, 1715479200, 1715482800),
+	(5, 1715482800, 1715486400),
+	(5, 1715486400, 1715490000),
+	(5, 1715490000, 1715493600),
+	(5, 1715493600, 1715497200),
+	(5, 1715497200, 1715500800),
+	(5, 1715500800, 1715504400),
+	(5, 1715504400, 1715508000),
+	(5, 1715508000, 1715511600),
+	(5, 1715511600, 1715515200),
+	(5, 1715515200, 1715518800),
+	(5, 1715518800, 1715522400),
+	(5, 1715522400, 1715526000),
+	(5, 1715526000, 1715529600),
+	(5, 1715529600, 1715533200),
+	(5, 1715533200, 1715536800),
+	(5, 1715536800, 1715540400),
+	(5, 1715540400, 1715544000),
+	(5, 1715544000, 1715547600),
+	(5, 1715547600, 1715551200),
+	(5, 1715551200, 1715554800),
+	(5, 1715554800, 1715558400),
+	(5, 1715558400, 1715562000),
+	(5, 1715562000, 1715565600),
+	(5, 1715565600, 1715569200),
+	(5, 1715569200, 1715572800),
+	(5, 1715572800, 1715576400),
+	(5, 1715576400, 1715580000),
+	(5, 1715580000, 1715583600),
+	(5, 1715583600, 1715587200),
+	(5, 1715587200, 1715590800),
+	(5, 1715590800, 1715594400),
+	(5, 1715594400, 1715598000),
+	(5, 1715598000, 1715601600),
+	(5, 1715601600, 1715605200),
+	(5, 1715605200, 1715608800),
+	(5, 1715608800, 1715612400),
+	(5, 1715612400, 1715616000),
+	(5, 1715616000, 1715619600),
+	(5, 1715619600, 1715623200),
+	(5, 1715623200, 1715626800),
+	(5, 1715626800, 1715630400),
+	(5, 1715630400, 1715634000),
+	(5, 1715634000, 1715637600),
+	(5, 1715637600, 1715641200),
+	(5, 1715641200, 1715644800),
+	(5, 1715644800, 1715648400),
+	(5, 1715648400, 1715652000),
+	(5, 1715652000, 1715655600),
+	(5, 1715655600, 1715659200),
+	(5, 1715659200, 1715662800),
+	(5, 1715662800, 1715666400),
+	(5, 1715666400, 1715670000),
+	(5, 1715670000, 1715673600),
+	(5, 1715673600, 1715677200),
+	(5, 1715677200, 1715680800),
+	(5, 1715680800, 1715684400),
+	(5, 1715684400, 1715688000),
+	(5, 1715688000, 1715691600),
+	(5, 1715691600, 1715695200),
+	(5, 1715695200, 1715698800),
+	(5, 1715698800, 1715702400),
+	(5, 1715702400, 1715706000),
+	(5, 1715706000, 1715709600),
+	(5, 1715709600, 1715713200),
+	(5, 1715713200, 1715716800),
+	(5, 1715716800, 1715720400),
+	(5, 1715720400, 1715724000),
+	(5, 1715724000, 1715727600),
+	(5, 1715727600, 1715731200),
+	(5, 1715731200, 1715734800),
+	(5, 1715734800, 1715738400),
+	(5, 1715738400, 1715742000),
+	(5, 1715742000, 1715745600),
+	(5, 1715745600, 1715749200),
+	(5, 1715749200, 1715752800),
+	(5, 1715752800, 1715756400),
+	(5, 1715756400, 1715760000),
+	(5, 1715760000, 1715763600),
+	(5, 1715763600, 1715767200),
+	(5, 1715767200, 1715770800),
+	(5, 1715770800, 1715774400),
+	(5, 1715774400, 1715778000),
+	(5, 1715778000, 1715781600),
+	(5, 1715781600, 1715785200),
+	(5, 1715785200, 1715788800),
+	(5, 1715788800, 1715792400),
+	(5, 1715792400, 1715796000),
+	(5, 1715796000, 1715799600),
+	(5, 1715799600, 1715803200),
+	(5, 1715803200, 1715806800),
+	(5, 1715806800, 1715810400),
+	(5, 1715810400, 1715814000),
+	(5, 1715814000, 1715817600),
+	(5, 1715817600, 1715821200),
+	(5, 1715821200, 1715824800),
+	(5, 1715824800, 1715828400),
+	(5, 1715828400, 1715832000),
+	(5, 1715832000, 1715835600),
+	(5, 1715835600, 1715839200),
+	(5, 1715839200, 1715842800),
+	(5, 1715842800, 1715846400),
+	(5, 1715846400, 1715850000),
+	(5, 1715850000, 1715853600),
+	(5, 1715853600, 1715857200),
+	(5, 1715857200, 1715860800),
+	(5, 1715860800, 1715864400),
+	(5, 1715864400, 1715868000),
+	(5, 1715868000, 1715871600),
+	(5, 1715871600, 1715875200),
+	(5, 1715875200, 1715878800),
+	(5, 1715878800, 1715882400),
+	(5, 1715882400, 1715886000),
+	(5, 1715886000, 1715889600),
+	(5, 1715889600, 1715893200),
+	(5, 1715893200, 1715896800),
+	(5, 1715896800, 1715900400),
+	(5, 1715900400, 1715904000),
+	(5, 1715904000, 1715907600),
+	(5, 1715907600, 1715911200),
+	(5, 1715911200, 1715914800),
+	(5, 1715914800, 1715918400),
+	(5, 1715918400, 1715922000),
+	(5, 1715922000, 1715925600),
+	(5, 1715925600, 1715929200),
+	(5, 1715929200, 1715932800),
+	(5, 1715932800, 1715936400),
+	(5, 1715936400, 1715940000),
+	(5, 1715940000, 1715943600),
+	(5, 1715943600, 1715947200),
+	(5, 1715947200, 1715950800),
+	(5, 1715950800, 1715954400),
+	(5, 1715954400, 1715958000),
+	(5, 1715958000, 1715961600),
+	(5, 1715961600, 1715965200),
+	(5, 1715965200, 1715968800),
+	(5, 1715968800, 1715972400),
+	(5, 1715972400, 1715976000),
+	(5, 1715976000, 1715979600),
+	(5, 1715979600, 1715983200),
+	(5, 1715983200, 1715986800),
+	(5, 1715986800, 1715990400),
+	(5, 1715990400, 1715994000),
+	(5, 1715994000, 1715997600),
+	(5, 1715997600, 1716001200),
+	(5, 1716001200, 1716004800),
+	(5, 1716004800, 1716008400),
+	(5, 1716008400, 1716012000),
+	(5, 1716012000, 1716015600),
+	(5, 1716015600, 1716019200),
+	(5, 1716019200, 1716022800),
+	(5, 1716022800, 1716026400),
+	(5, 1716026400, 1716030000),
+	(5, 1716030000, 1716033600),
+	(5, 1716033600, 1716037200),
+	(5, 1716037200, 1716040800),
+	(5, 1716040800, 1716044400),
+	(5, 1716044400, 1716048000),
+	(5, 1716048000, 1716051600),
+	(5, 1716051600, 1716055200),
+	(5, 1716055200, 1716058800),
+	(5, 1716058800, 1716062400),
+	(5, 1716062400, 1716066000),
+	(5, 1716066000, 1716069600),
+	(5, 1716069600, 1716073200),
+	(5, 1716073200, 1716076800),
+	(5, 1716076800, 1716080400),
+	(5, 1716080400, 1716084000),
+	(5, 1716084000, 1716087600),
+	(5, 1716087600, 1716091200),
+	(5, 1716091200, 1716094800),
+	(5, 1716094800, 1716098400),
+	(5, 1716098400, 1716102000),
+	(5, 1716102000, 1716105600),
+	(5, 1716105600, 1716109200),
+	(5, 1716109200, 1716112800),
+	(5, 1716112800, 1716116400),
+	(5, 1716116400, 1716120000),
+	(5, 1716120000, 1716123600),
+	(5, 1716123600, 1716127200),
+	(5, 1716127200, 1716130800),
+	(5, 1716130800, 1716134400),
+	(5, 1716134400, 1716138000),
+	(5, 1716138000, 1716141600),
+	(5, 1716141600, 1716145200),
+	(5, 1716145200, 1716148800),
+	(5, 1716148800, 1716152400),
+	(5, 1716152400, 1716156000),
+	(5, 1716156000, 1716159600),
+	(5, 1716159600, 1716163200),
+	(5, 1716163200, 1716166800),
+	(5, 1716166800, 1716170400),
+	(5, 1716170400, 1716174000),
+	(5, 1716174000, 1716177600),
+	(5, 1716177600, 1716181200),
+	(5, 1716181200, 1716184800),
+	(5, 1716184800, 1716188400),
+	(5, 1716188400, 1716192000),
+	(5, 1716192000, 1716195600),
+	(5, 1716195600, 1716199200),
+	(5, 1716199200, 1716202800),
+	(5, 1716202800, 1716206400),
+	(5, 1716206400, 1716210000),
+	(5, 1716210000, 1716213600),
+	(5, 1716213600, 1716217200),
+	(5, 1716217200, 1716220800),
+	(5, 1716220800, 1716224400),
+	(5, 1716224400, 1716228000),
+	(5, 1716228000, 1716231600),
+	(5, 1716231600, 1716235200),
+	(5, 1716235200, 1716238800),
+	(5, 1716238800, 1716242400),
+	(5, 1716242400, 1716246000),
+	(5, 1716246000, 1716249600),
+	(5, 1716249600, 1716253200),
+	(5, 1716253200, 1716256800),
+	(5, 1716256800, 1716260400),
+	(5, 1716260400, 1716264000),
+	(5, 1716264000, 1716267600),
+	(5, 1716267600, 1716271200),
+	(5, 1716271200, 1716274800),
+	(5, 1716274800, 1716278400),
+	(5, 1716278400, 1716282000),
+	(5, 1716282000, 1716285600),
+	(5, 1716285600, 1716289200),
+	(5, 1716289200, 1716292800),
+	(5, 1716292800, 1716296400),
+	(5, 1716296400, 1716300000),
+	(5, 1716300000, 1716303600),
+	(5, 1716303600, 1716307200),
+	(5, 1716307200, 1716310800),
+	(5, 1716310800, 1716314400),
+	(5, 1716314400, 1716318000),
+	(5, 1716318000, 1716321600),
+	(5, 1716321600, 1716325200),
+	(5, 1716325200, 1716328800),
+	(5, 1716328800, 1716332400),
+	(5, 1716332400, 1716336000),
+	(5, 1716336000, 1716339600),
+	(5, 1716339600, 1716343200),
+	(5, 1716343200, 1716346800),
+	(5, 1716346800, 1716350400),
+	(5, 1716350400, 1716354000),
+	(5, 1716354000, 1716357600),
+	(5, 1716357600, 1716361200),
+	(5, 1716361200, 1716364800),
+	(5, 1716364800, 1716368400),
+	(5, 1716368400, 1716372000),
+	(5, 1716372000, 1716375600),
+	(5, 1716375600, 1716379200),
+	(5, 1716379200, 1716382800),
+	(5, 1716382800, 1716386400),
+	(5, 1716386400, 1716390000),
+	(5, 1716390000, 1716393600),
+	(5, 1716393600, 1716397200),
+	(5, 1716397200, 1716400800),
+	(5, 1716400800, 1716404400),
+	(5, 1716404400, 1716408000),
+	(5, 1716408000, 1716411600),
+	(5, 1716411600, 1716415200),
+	(5, 1716415200, 1716418800),
+	(5, 1716418800, 1716422400),
+	(5, 1716422400, 1716426000),
+	(5, 1716426000, 1716429600),
+	(5, 1716429600, 1716433200),
+	(5, 1716433200, 1716436800),
+	(5, 1716436800, 1716440400),
+	(5, 1716440400, 1716444000),
+	(5, 1716444000, 1716447600),
+	(5, 1716447600, 1716451200),
+	(5, 1716451200, 1716454800),
+	(5, 1716454800, 1716458400),
+	(5, 1716458400, 1716462000),
+	(5, 1716462000, 1716465600),
+	(5, 1716465600, 1716469200),
+	(5, 1716469200, 1716472800),
+	(5, 1716472800, 1716476400),
+	(5, 1716476400, 1716480000),
+	(5, 1716480000, 1716483600),
+	(5, 1716483600, 1716487200),
+	(5, 1716487200, 1716490800),
+	(5, 1716490800, 1716494400),
+	(5, 1716494400, 1716498000),
+	(5, 1716498000, 1716501600),
+	(5, 1716501600, 1716505200),
+	(5, 1716505200, 1716508800),
+	(5, 1716508800, 1716512400),
+	(5, 1716512400, 1716516000),
+	(5, 1716516000, 1716519600),
+	(5, 1716519600, 1716523200),
+	(5, 1716523200, 1716526800),
+	(5, 1716526800, 1716530400),
+	(5, 1716530400, 1716534000),
+	(5, 1716534000, 1716537600),
+	(5, 1716537600, 1716541200),
+	(5, 1716541200, 1716544800),
+	(5, 1716544800, 1716548400),
+	(5, 1716548400, 1716552000),
+	(5, 1716552000, 1716555600),
+	(5, 1716555600, 1716559200),
+	(5, 1716559200, 1716562800),
+	(5, 1716562800, 1716566400),
+	(5, 1716566400, 1716570000),
+	(5, 1716570000, 1716573600),
+	(5, 1716573600, 1716577200),
+	(5, 1716577200, 1716580800),
+	(5, 1716580800, 1716584400),
+	(5, 1716584400, 1716588000),
+	(5, 1716588000, 1716591600),
+	(5, 1716591600, 1716595200),
+	(5, 1716595200, 1716598800),
+	(5, 1716598800, 1716602400),
+	(5, 1716602400, 1716606000),
+	(5, 1716606000, 1716609600),
+	(5, 1716609600, 1716613200),
+	(5, 1716613200, 1716616800),
+	(5, 1716616800, 1716620400),
+	(5, 1716620400, 1716624000),
+	(5, 1716624000, 1716627600),
+	(5, 1716627600, 1716631200),
+	(5, 1716631200, 1716634800),
+	(5, 1716634800, 1716638400),
+	(5, 1716638400, 1716642000),
+	(5, 1716642000, 1716645600),
+	(5, 1716645600, 1716649200),
+	(5, 1716649200, 1716652800),
+	(5, 1716652800, 1716656400),
+	(5, 1716656400, 1716660000),
+	(5, 1716660000, 1716663600),
+	(5, 1716663600, 1716667200),
+	(5, 1716667200, 1716670800),
+	(5, 1716670800, 1716674400),
+	(5, 1716674400, 1716678000),
+	(5, 1716678000, 1716681600),
+	(5, 1716681600, 1716685200),
+	(5, 1716685200, 1716688800),
+	(5, 1716688800, 1716692400),
+	(5, 1716692400, 1716696000),
+	(5, 1716696000, 1716699600),
+	(5, 1716699600, 1716703200),
+	(5, 1716703200, 1716706800),
+	(5, 1716706800, 1716710400),
+	(5, 1716710400, 1716714000),
+	(5, 1716714000, 1716717600),
+	(5, 1716717600, 1716721200),
+	(5, 1716721200, 1716724800),
+	(5, 1716724800, 1716728400),
+	(5, 1716728400, 1716732000),
+	(5, 1716732000, 1716735600),
+	(5, 1716735600, 1716739200),
+	(5, 1716739200, 1716742800),
+	(5, 1716742800, 1716746400),
+	(5, 1716746400, 1716750000),
+	(5, 1716750000, 1716753600),
+	(5, 1716753600, 1716757200),
+	(5, 1716757200, 1716760800),
+	(5, 1716760800, 1716764400),
+	(5, 1716764400, 1716768000),
+	(5, 1716768000, 1716771600),
+	(5, 1716771600, 1716775200),
+	(5, 1716775200, 1716778800),
+	(5, 1716778800, 1716782400),
+	(5, 1716782400, 1716786000),
+	(5, 1716786000, 1716789600),
+	(5, 1716789600, 1716793200),
+	(5, 1716793200, 1716796800),
+	(5, 1716796800, 1716800400),
+	(5, 1716800400, 1716804000),
+	(5, 1716804000, 1716807600),
+	(5, 1716807600, 1716811200),
+	(5, 1716811200, 1716814800),
+	(5, 1716814800, 1716818400),
+	(5, 1716818400, 1716822000),
+	(5, 1716822000, 1716825600),
+	(5, 1716825600, 1716829200),
+	(5, 1716829200, 1716832800),
+	(5, 1716832800, 1716836400),
+	(5, 1716836400, 1716840000),
+	(5, 1716840000, 1716843600),
+	(5, 1716843600, 1716847200),
+	(5, 1716847200, 1716850800),
+	(5, 1716850800, 1716854400),
+	(5, 1716854400, 1716858000),
+	(5, 1716858000, 1716861600),
+	(5, 1716861600, 1716865200),
+	(5, 1716865200, 1716868800),
+	(5, 1716868800, 1716872400),
+	(5, 1716872400, 1716876000),
+	(5, 1716876000, 1716879600),
+	(5, 1716879600, 1716883200),
+	(5, 1716883200, 1716886800),
+	(5, 1716886800, 1716890400),
+	(5, 1716890400, 1716894000),
+	(5, 1716894000, 1716897600),
+	(5, 1716897600, 1716901200),
+	(5, 1716901200, 1716904800),
+	(5, 1716904800, 1716908400),
+	(5, 1716908400, 1716912000),
+	(5, 1716912000, 1716915600),
+	(5, 1716915600, 1716919200),
+	(5, 1716919200, 1716922800),
+	(5, 1716922800, 1716926400),
+	(5, 1716926400, 1716930000),
+	(5, 1716930000, 1716933600),
+	(5, 1716933600, 1716937200),
+	(5, 1716937200, 1716940800),
+	(5, 1716940800, 1716944400),
+	(5, 1716944400, 1716948000),
+	(5, 1716948000, 1716951600),
+	(5, 1716951600, 1716955200),
+	(5, 1716955200, 1716958800),
+	(5, 1716958800, 1716962400),
+	(5, 1716962400, 1716966000),
+	(5, 1716966000, 1716969600),
+	(5, 1716969600, 1716973200),
+	(5, 1716973200, 1716976800),
+	(5, 1716976800, 1716980400),
+	(5, 1716980400, 1716984000),
+	(5, 1716984000, 1716987600),
+	(5, 1716987600, 1716991200),
+	(5, 1716991200, 1716994800),
+	(5, 1716994800, 1716998400),
+	(5, 1716998400, 1717002000),
+	(5, 1717002000, 1717005600),
+	(5, 1717005600, 1717009200),
+	(5, 1717009200, 1717012800),
+	(5, 1717012800, 1717016400),
+	(5, 1717016400, 1717020000),
+	(5, 1717020000, 1717023600),
+	(5, 1717023600, 1717027200),
+	(5, 1717027200, 1717030800),
+	(5, 1717030800, 1717034400),
+	(5, 1717034400, 1717038000),
+	(5, 1717038000, 1717041600),
+	(5, 1717041600, 1717045200),
+	(5, 1717045200, 1717048800),
+	(5, 1717048800, 1717052400),
+	(5, 1717052400, 1717056000),
+	(5, 1717056000, 1717059600),
+	(5, 1717059600, 1717063200),
+	(5, 1717063200, 1717066800),
+	(5, 1717066800, 1717070400),
+	(5, 1717070400, 1717074000),
+	(5, 1717074000, 1717077600),
+	(5, 1717077600, 1717081200),
+	(5, 1717081200, 1717084800),
+	(5, 1717084800, 1717088400),
+	(5, 1717088400, 1717092000),
+	(5, 1717092000, 1717095600),
+	(5, 1717095600, 1717099200),
+	(5, 1717099200, 1717102800),
+	(5, 1717102800, 1717106400),
+	(5, 1717106400, 1717110000),
+	(5, 1717110000, 1717113600),
+	(5, 1717113600, 1717117200),
+	(5, 1717117200, 1717120800),
+	(5, 1717120800, 1717124400),
+	(5, 1717124400, 1717128000),
+	(5, 1717128000, 1717131600),
+	(5, 1717131600, 1717135200),
+	(5, 1717135200, 1717138800),
+	(5, 1717138800, 1717142400),
+	(5, 1717142400, 1717146000),
+	(5, 1717146000, 1717149600),
+	(5, 1717149600, 1717153200),
+	(5, 1717153200, 1717156800),
+	(5, 1717156800, 1717160400),
+	(5, 1717160400, 1717164000),
+	(5, 1717164000, 1717167600),
+	(5, 1717167600, 1717171200),
+	(5, 1717171200, 1717174800),
+	(5, 1717174800, 1717178400),
+	(5, 1717178400, 1717182000),
+	(5, 1717182000, 1717185600),
+	(5, 1717185600, 1717189200),
+	(5, 1717189200, 1717192800),
+	(5, 1717192800, 1717196400),
+	(5, 1717196400, 1717200000),
+	(5, 1717200000, 1717203600),
+	(5, 1717203600, 1717207200),
+	(5, 1717207200, 1717210800),
+	(5, 1717210800, 1717214400),
+	(5, 1717214400, 1717218000),
+	(5, 1717218000, 1717221600),
+	(5, 1717221600, 1717225200),
+	(5, 1717225200, 1717228800),
+	(5, 1717228800, 1717232400),
+	(5, 1717232400, 1717236000),
+	(5, 1717236000, 1717239600),
+	(5, 1717239600, 1717243200),
+	(5, 1717243200, 1717246800),
+	(5, 1717246800, 1717250400),
+	(5, 1717250400, 1717254000),
+	(5, 1717254000, 1717257600),
+	(5, 1717257600, 1717261200),
+	(5, 1717261200, 1717264800),
+	(5, 1717264800, 1717268400),
+	(5, 1717268400, 1717272000),
+	(5, 1717272000, 1717275600),
+	(5, 1717275600, 1717279200),
+	(5, 1717279200, 1717282800),
+	(5, 1717282800, 1717286400),
+	(5, 1717286400, 1717290000),
+	(5, 1717290000, 1717293600),
+	(5, 1717293600, 1717297200),
+	(5, 1717297200, 1717300800),
+	(5, 1717300800, 1717304400),
+	(5, 1717304400, 1717308000),
+	(5, 1717308000, 1717311600),
+	(5, 1717311600, 1717315200),
+	(5, 1717315200, 1717318800),
+	(5, 1717318800, 1717322400),
+	(5, 1717322400, 1717326000),
+	(5, 1717326000, 1717329600),
+	(5, 1717329600, 1717333200),
+	(5, 1717333200, 1717336800),
+	(5, 1717336800, 1717340400),
+	(5, 1717340400, 1717344000),
+	(5, 1717344000, 1717347600),
+	(5, 1717347600, 1717351200),
+	(5, 1717351200, 1717354800),
+	(5, 1717354800, 1717358400),
+	(5, 1717358400, 1717362000),
+	(5, 1717362000, 1717365600),
+	(5, 1717365600, 1717369200),
+	(5, 1717369200, 1717372800),
+	(5, 1717372800, 1717376400),
+	(5, 1717376400, 1717380000),
+	(5, 1717380000, 1717383600),
+	(5, 1717383600, 1717387200),
+	(5, 1717387200, 1717390800),
+	(5, 1717390800, 1717394400),
+	(5, 1717394400, 1717398000),
+	(5, 1717398000, 1717401600),
+	(5, 1717401600, 1717405200),
+	(5, 1717405200, 1717408800),
+	(5, 1717408800, 1717412400),
+	(5, 1717412400, 1717416000),
+	(5, 1717416000, 1717419600),
+	(5, 1717419600, 1717423200),
+	(5, 1717423200, 1717426800),
+	(5, 1717426800, 1717430400),
+	(5, 1717430400, 1717434000),
+	(5, 1717434000, 1717437600),
+	(5, 1717437600, 1717441200),
+	(5, 1717441200, 1717444800),
+	(5, 1717444800, 1717448400),
+	(5, 1717448400, 1717452000),
+	(5, 1717452000, 1717455600),
+	(5, 1717455600, 1717459200),
+	(5, 1717459200, 1717462800),
+	(5, 1717462800, 1717466400),
+	(5, 1717466400, 1717470000),
+	(5, 1717470000, 1717473600),
+	(5, 1717473600, 1717477200),
+	(5, 1717477200, 1717480800),
+	(5, 1717480800, 1717484400),
+	(5, 1717484400, 1717488000),
+	(5, 1717488000, 1717491600),
+	(5, 1717491600, 1717495200),
+	(5, 1717495200, 1717498800),
+	(5, 1717498800, 1717502400),
+	(5, 1717502400, 1717506000),
+	(5, 1717506000, 1717509600),
+	(5, 1717509600, 1717513200),
+	(5, 1717513200, 1717516800),
+	(5, 1717516800, 1717520400),
+	(5, 1717520400, 1717524000),
+	(5, 1717524000, 1717527600),
+	(5, 1717527600, 1717531200),
+	(5, 1717531200, 1717534800),
+	(5, 1717534800, 1717538400),
+	(5, 1717538400, 1717542000),
+	(5, 1717542000, 1717545600),
+	(5, 1717545600, 1717549200),
+	(5, 1717549200, 1717552800),
+	(5, 1717552800, 1717556400),
+	(5, 1717556400, 1717560000),
+	(5, 1717560000, 1717563600),
+	(5, 1717563600, 1717567200),
+	(5, 1717567200, 1717570800),
+	(5, 1717570800, 1717574400),
+	(5, 1717574400, 1717578000),
+	(5, 1717578000, 1717581600),
+	(5, 1717581600, 1717585200),
+	(5, 1717585200, 1717588800),
+	(5, 1717588800, 1717592400),
+	(5, 1717592400, 1717596000),
+	(5, 1717596000, 1717599600),
+	(5, 1717599600, 1717603200),
+	(5, 1717603200, 1717606800),
+	(5, 1717606800, 1717610400),
+	(5, 1717610400, 1717614000),
+	(5, 1717614000, 1717617600),
+	(5, 1717617600, 1717621200),
+	(5, 1717621200, 1717624800),
+	(5, 1717624800, 1717628400),
+	(5, 1717628400, 1717632000),
+	(5, 1717632000, 1717635600),
+	(5, 1717635600, 1717639200),
+	(5, 1717639200, 1717642800),
+	(5, 1717642800, 1717646400),
+	(5, 1717646400, 1717650000),
+	(5, 1717650000, 1717653600),
+	(5, 1717653600, 1717657200),
+	(5, 1717657200, 1717660800),
+	(5, 1717660800, 1717664400),
+	(5, 1717664400, 1717668000),
+	(5, 1717668000, 1717671600),
+	(5, 1717671600, 1717675200),
+	(5, 1717675200, 1717678800),
+	(5, 1717678800, 1717682400),
+	(5, 1717682400, 1717686000),
+	(5, 1717686000, 1717689600),
+	(5, 1717689600, 1717693200),
+	(5, 1717693200, 1717696800),
+	(5, 1717696800, 1717700400),
+	(5, 1717700400, 1717704000),
+	(5, 1717704000, 1717707600),
+	(5, 1717707600, 1717711200),
+	(5, 1717711200, 1717714800),
+	(5, 1717714800, 1717718400),
+	(5, 1717718400, 1717722000),
+	(5, 1717722000, 1717725600),
+	(5, 1717725600, 1717729200),
+	(5, 1717729200, 1717732800),
+	(5, 1717732800, 1717736400),
+	(5, 1717736400, 1717740000),
+	(5, 1717740000, 1717743600),
+	(5, 1717743600, 1717747200),
+	(5, 1717747200, 1717750800),
+	(5, 1717750800, 1717754400),
+	(5, 1717754400, 1717758000),
+	(5, 1717758000, 1717761600),
+	(5, 1717761600, 1717765200),
+	(5, 1717765200, 1717768800),
+	(5, 1717768800, 1717772400),
+	(5, 1717772400, 1717776000),
+	(5, 1717776000, 1717779600),
+	(5, 1717779600, 1717783200),
+	(5, 1717783200, 1717786800),
+	(5, 1717786800, 1717790400),
+	(5, 1717790400, 1717794000),
+	(5, 1717794000, 1717797600),
+	(5, 1717797600, 1717801200),
+	(5, 1717801200, 1717804800),
+	(5, 1717804800, 1717808400),
+	(5, 1717808400, 1717812000),
+	(5, 1717812000, 1717815600),
+	(5, 1717815600, 1717819200),
+	(5, 1717819200, 1717822800),
+	(5, 1717822800, 1717826400),
+	(5, 1717826400, 1717830000),
+	(5, 1717830000, 1717833600),
+	(5, 1717833600, 1717837200),
+	(5, 1717837200, 1717840800),
+	(5, 1717840800, 1717844400),
+	(5, 1717844400, 1717848000),
+	(5, 1717848000, 1717851600),
+	(5, 1717851600, 1717855200),
+	(5, 1717855200, 1717858800),
+	(5, 1717858800, 1717862400),
+	(5, 1717862400, 1717866000),
+	(5, 1717866000, 1717869600),
+	(5, 1717869600, 1717873200),
+	(5, 1717873200, 1717876800),
+	(5, 1717876800, 1717880400),
+	(5, 1717880400, 1717884000),
+	(5, 1717884000, 1717887600),
+	(5, 1717887600, 1717891200),
+	(5, 1717891200, 1717894800),
+	(5, 1717894800, 1717898400),
+	(5, 1717898400, 1717902000),
+	(5, 1717902000, 1717905600),
+	(5, 1717905600, 1717909200),
+	(5, 1717909200, 1717912800),
+	(5, 1717912800, 1717916400),
+	(5, 1717916400, 1717920000),
+	(5, 1717920000, 1717923600),
+	(5, 1717923600, 1717927200),
+	(5, 1717927200, 1717930800),
+	(5, 1717930800, 1717934400),
+	(5, 1717934400, 1717938000),
+	(5, 1717938000, 1717941600),
+	(5, 1717941600, 1717945200),
+	(5, 1717945200, 1717948800),
+	(5, 1717948800, 1717952400),
+	(5, 1717952400, 1717956000),
+	(5, 1717956000, 1717959600),
+	(5, 1717959600, 1717963200),
+	(5, 1717963200, 1717966800),
+	(5, 1717966800, 1717970400),
+	(5, 1717970400, 1717974000),
+	(5, 1717974000, 1717977600),
+	(5, 1717977600, 1717981200),
+	(5, 1717981200, 1717984800),
+	(5, 1717984800, 1717988400),
+	(5, 1717988400, 1717992000),
+	(5, 1717992000, 1717995600),
+	(5, 1717995600, 1717999200),
+	(5, 1717999200, 1718002800),
+	(5, 1718002800, 1718006400),
+	(5, 1718006400, 1718010000),
+	(5, 1718010000, 1718013600),
+	(5, 1718013600, 1718017200),
+	(5, 1718017200, 1718020800),
+	(5, 1718020800, 1718024400),
+	(5, 1718024400, 1718028000),
+	(5, 1718028000, 1718031600),
+	(5, 1718031600, 1718035200),
+	(5, 1718035200, 1718038800),
+	(5, 1718038800, 1718042400),
+	(5, 1718042400, 1718046000),
+	(5, 1718046000, 1718049600),
+	(5, 1718049600, 1718053200),
+	(5, 1718053200, 1718056800),
+	(5, 1718056800, 1718060400),
+	(5, 1718060400, 1718064000),
+	(5, 1718064000, 1718067600),
+	(5, 1718067600, 1718071200),
+	(5, 1718071200, 1718074800),
+	(5, 1718074800, 1718078400),
+	(5, 1718078400, 1718082000),
+	(5, 1718082000, 1718085600),
+	(5, 1718085600, 1718089200),
+	(5, 1718089200, 1718092800),
+	(5, 1718092800, 1718096400),
+	(5, 1718096400, 1718100000),
+	(5, 1718100000, 1718103600),
+	(5, 1718103600, 1718107200),
+	(5, 1718107200, 1718110800),
+	(5, 1718110800, 1718114400),
+	(5, 1718114400, 1718118000),
+	(5, 1718118000, 1718121600),
+	(5, 1718121600, 1718125200),
+	(5, 1718125200, 1718128800),
+	(5, 1718128800, 1718132400),
+	(5, 1718132400, 1718136000),
+	(5, 1718136000, 1718139600),
+	(5, 1718139600, 1718143200),
+	(5, 1718143200, 1718146800),
+	(5, 1718146800, 1718150400),
+	(5, 1718150400, 1718154000),
+	(5, 1718154000, 1718157600),
+	(5, 1718157600, 1718161200),
+	(5, 1718161200, 1718164800),
+	(5, 1718164800, 1718168400),
+	(5, 1718168400, 1718172000),
+	(5, 1718172000, 1718175600),
+	(5, 1718175600, 1718179200),
+	(5, 1718179200, 1718182800),
+	(5, 1718182800, 1718186400),
+	(5, 1718186400, 1718190000),
+	(5, 1718190000, 1718193600),
+	(5, 1718193600, 1718197200),
+	(5, 1718197200, 1718200800),
+	(5, 1718200800, 1718204400),
+	(5, 1718204400, 1718208000),
+	(5, 1718208000, 1718211600),
+	(5, 1718211600, 1718215200),
+	(5, 1718215200, 1718218800),
+	(5, 1718218800, 1718222400),
+	(5, 1718222400, 1718226000),
+	(5, 1718226000, 1718229600),
+	(5, 1718229600, 1718233200),
+	(5, 1718233200, 1718236800),
+	(5, 1718236800, 1718240400),
+	(5, 1718240400, 1718244000),
+	(5, 1718244000, 1718247600),
+	(5, 1718247600, 1718251200),
+	(5, 1718251200, 1718254800),
+	(5, 1718254800, 1718258400),
+	(5, 1718258400, 1718262000),
+	(5, 1718262000, 1718265600),
+	(5, 1718265600, 1718269200),
+	(5, 1718269200, 1718272800),
+	(5, 1718272800, 1718276400),
+	(5, 1718276400, 1718280000),
+	(5, 1718280000, 1718283600),
+	(5, 1718283600, 1718287200),
+	(5, 1718287200, 1718290800),
+	(5, 1718290800, 1718294400),
+	(5, 1718294400, 1718298000),
+	(5, 1718298000, 1718301600),
+	(5, 1718301600, 1718305200),
+	(5, 1718305200, 1718308800),
+	(5, 1718308800, 1718312400),
+	(5, 1718312400, 1718316000),
+	(5, 1718316000, 1718319600),
+	(5, 1718319600, 1718323200),
+	(5, 1718323200, 1718326800),
+	(5, 1718326800, 1718330400),
+	(5, 1718330400, 1718334000),
+	(5, 1718334000, 1718337600),
+	(5, 1718337600, 1718341200),
+	(5, 1718341200, 1718344800),
+	(5, 1718344800, 1718348400),
+	(5, 1718348400, 1718352000),
+	(5, 1718352000, 1718355600),
+	(5, 1718355600, 1718359200),
+	(5, 1718359200, 1718362800),
+	(5, 1718362800, 1718366400),
+	(5, 1718366400, 1718370000),
+	(5, 1718370000, 1718373600),
+	(5, 1718373600, 1718377200),
+	(5, 1718377200, 1718380800),
+	(5, 1718380800, 1718384400),
+	(5, 1718384400, 1718388000),
+	(5, 1718388000, 1718391600),
+	(5, 1718391600, 1718395200),
+	(5, 1718395200, 1718398800),
+	(5, 1718398800, 1718402400),
+	(5, 1718402400, 1718406000),
+	(5, 1718406000, 1718409600),
+	(5, 1718409600, 1718413200),
+	(5, 1718413200, 1718416800),
+	(5, 1718416800, 1718420400),
+	(5, 1718420400, 1718424000),
+	(5, 1718424000, 1718427600),
+	(5, 1718427600, 1718431200),
+	(5, 1718431200, 1718434800),
+	(5, 1718434800, 1718438400),
+	(5, 1718438400, 1718442000),
+	(5, 1718442000, 1718445600),
+	(5, 1718445600, 1718449200),
+	(5, 1718449200, 1718452800),
+	(5, 1718452800, 1718456400),
+	(5, 1718456400, 1718460000),
+	(5, 1718460000, 1718463600),
+	(5, 1718463600, 1718467200),
+	(5, 1718467200, 1718470800),
+	(5, 1718470800, 1718474400),
+	(5, 1718474400, 1718478000),
+	(5, 1718478000, 1718481600),
+	(5, 1718481600, 1718485200),
+	(5, 1718485200, 1718488800),
+	(5, 1718488800, 1718492400),
+	(5, 1718492400, 1718496000),
+	(5, 1718496000, 1718499600),
+	(5, 1718499600, 1718503200),
+	(5, 1718503200, 1718506800),
+	(5, 1718506800, 1718510400),
+	(5, 1718510400, 1718514000),
+	(5, 1718514000, 1718517600),
+	(5, 1718517600, 1718521200),
+	(5, 1718521200, 1718524800),
+	(5, 1718524800, 1718528400),
+	(5, 1718528400, 1718532000),
+	(5, 1718532000, 1718535600),
+	(5, 1718535600, 1718539200),
+	(5, 1718539200, 1718542800),
+	(5, 1718542800, 1718546400),
+	(5, 1718546400, 1718550000),
+	(5, 1718550000, 1718553600),
+	(5, 1718553600, 1718557200),
+	(5, 1718557200, 1718560800),
+	(5, 1718560800, 1718564400),
+	(5, 1718564400, 1718568000),
+	(5, 1718568000, 1718571600),
+	(5, 1718571600, 1718575200),
+	(5, 1718575200, 1718578800),
+	(5, 1718578800, 1718582400),
+	(5, 1718582400, 1718586000),
+	(5, 1718586000, 1718589600),
+	(5, 1718589600, 1718593200),
+	(5, 1718593200, 1718596800),
+	(5, 1718596800, 1718600400),
+	(5, 1718600400, 1718604000),
+	(5, 1718604000, 1718607600),
+	(5, 1718607600, 1718611200),
+	(5, 1718611200, 1718614800),
+	(5, 1718614800, 1718618400),
+	(5, 1718618400, 1718622000),
+	(5, 1718622000, 1718625600),
+	(5, 1718625600, 1718629200),
+	(5, 1718629200, 1718632800),
+	(5, 1718632800, 1718636400),
+	(5, 1718636400, 1718640000),
+	(5, 1718640000, 1718643600),
+	(5, 1718643600, 1718647200),
+	(5, 1718647200, 1718650800),
+	(5, 1718650800, 1718654400),
+	(5, 1718654400, 1718658000),
+	(5, 1718658000, 1718661600),
+	(5, 1718661600, 1718665200),
+	(5, 1718665200, 1718668800),
+	(5, 1718668800, 1718672400),
+	(5, 1718672400, 1718676000),
+	(5, 1718676000, 1718679600),
+	(5, 1718679600, 1718683200),
+	(5, 1718683200, 1718686800),
+	(5, 1718686800, 1718690400),
+	(5, 1718690400, 1718694000),
+	(5, 1718694000, 1718697600),
+	(5, 1718697600, 1718701200),
+	(5, 1718701200, 1718704800),
+	(5, 1718704800, 1718708400),
+	(5, 1718708400, 1718712000),
+	(5, 1718712000, 1718715600),
+	(5, 1718715600, 1718719200),
+	(5, 1718719200, 1718722800),
+	(5, 1718722800, 1718726400),
+	(5, 1718726400, 1718730000),
+	(5, 1718730000, 1718733600),
+	(5, 1718733600, 1718737200),
+	(5, 1718737200, 1718740800),
+	(5, 1718740800, 1718744400),
+	(5, 1718744400, 1718748000),
+	(5, 1718748000, 1718751600),
+	(5, 1718751600, 1718755200),
+	(5, 1718755200, 1718758800),
+	(5, 1718758800, 1718762400),
+	(5, 1718762400, 1718766000),
+	(5, 1718766000, 1718769600),
+	(5, 1718769600, 1718773200),
+	(5, 1718773200, 1718776800),
+	(5, 1718776800, 1718780400),
+	(5, 1718780400, 1718784000),
+	(5, 1718784000, 1718787600),
+	(5, 1718787600, 1718791200),
+	(5, 1718791200, 1718794800),
+	(5, 1718794800, 1718798400),
+	(5, 1718798400, 1718802000),
+	(5, 1718802000, 1718805600),
+	(5, 1718805600, 1718809200),
+	(5, 1718809200, 1718812800),
+	(5, 1718812800, 1718816400),
+	(5, 1718816400, 1718820000),
+	(5, 1718820000, 1718823600),
+	(5, 1718823600, 1718827200),
+	(5, 1718827200, 1718830800),
+	(5, 1718830800, 1718834400),
+	(5, 1718834400, 1718838000),
+	(5, 1718838000, 1718841600),
+	(5, 1718841600, 1718845200),
+	(5, 1718845200, 1718848800),
+	(5, 1718848800, 1718852400),
+	(5, 1718852400, 1718856000),
+	(5, 1718856000, 1718859600),
+	(5, 1718859600, 1718863200),
+	(5, 1718863200, 1718866800),
+	(5, 1718866800, 1718870400),
+	(5, 1718870400, 1718874000),
+	(5, 1718874000, 1718877600),
+	(5, 1718877600, 1718881200),
+	(5, 1718881200, 1718884800),
+	(5, 1718884800, 1718888400),
+	(5, 1718888400, 1718892000),
+	(5, 1718892000, 1718895600),
+	(5, 1718895600, 1718899200),
+	(5, 1718899200, 1718902800),
+	(5, 1718902800, 1718906400),
+	(5, 1718906400, 1718910000),
+	(5, 1718910000, 1718913600),
+	(5, 1718913600, 1718917200),
+	(5, 1718917200, 1718920800),
+	(5, 1718920800, 1718924400),
+	(5, 1718924400, 1718928000),
+	(5, 1718928000, 1718931600),
+	(5, 1718931600, 1718935200),
+	(5, 1718935200, 1718938800),
+	(5, 1718938800, 1718942400),
+	(5, 1718942400, 1718946000),
+	(5, 1718946000, 1718949600),
+	(5, 1718949600, 1718953200),
+	(5, 1718953200, 1718956800),
+	(5, 1718956800, 1718960400),
+	(5, 1718960400, 1718964000),
+	(5, 1718964000, 1718967600),
+	(5, 1718967600, 1718971200),
+	(5, 1718971200, 1718974800),
+	(5, 1718974800, 1718978400),
+	(5, 1718978400, 1718982000),
+	(5, 1718982000, 1718985600),
+	(5, 1718985600, 1718989200),
+	(5, 1718989200, 1718992800),
+	(5, 1718992800, 1718996400),
+	(5, 1718996400, 1719000000),
+	(5, 1719000000, 1719003600),
+	(5, 1719003600, 1719007200),
+	(5, 1719007200, 1719010800),
+	(5, 1719010800, 1719014400),
+	(5, 1719014400, 1719018000),
+	(5, 1719018000, 1719021600),
+	(5, 1719021600, 1719025200),
+	(5, 1719025200, 1719028800),
+	(5, 1719028800, 1719032400),
+	(5, 1719032400, 1719036000),
+	(5, 1719036000, 1719039600),
+	(5, 1719039600, 1719043200),
+	(5, 1719043200, 1719046800),
+	(5, 1719046800, 1719050400),
+	(5, 1719050400, 1719054000),
+	(5, 1719054000, 1719057600),
+	(5, 1719057600, 1719061200),
+	(5, 1719061200, 1719064800),
+	(5, 1719064800, 1719068400),
+	(5, 1719068400, 1719072000),
+	(5, 1719072000, 1719075600),
+	(5, 1719075600, 1719079200),
+	(5, 1719079200, 1719082800),
+	(5, 1719082800, 1719086400),
+	(5, 1719086400, 1719090000),
+	(5, 1719090000, 1719093600),
+	(5, 1719093600, 1719097200),
+	(5, 1719097200, 1719100800),
+	(5, 1719100800, 1719104400),
+	(5, 1719104400, 1719108000),
+	(5, 1719108000, 1719111600),
+	(5, 1719111600, 1719115200),
+	(5, 1719115200, 1719118800),
+	(5, 1719118800, 1719122400),
+	(5, 1719122400, 1719126000),
+	(5, 1719126000, 1719129600),
+	(5, 1719129600, 1719133200),
+	(5, 1719133200, 1719136800),
+	(5, 1719136800, 1719140400),
+	(5, 1719140400, 1719144000),
+	(5, 1719144000, 1719147600),
+	(5, 1719147600, 1719151200),
+	(5, 1719151200, 1719154800),
+	(5, 1719154800, 1719158400),
+	(5, 1719158400, 1719162000),
+	(5, 1719162000, 1719165600),
+	(5, 1719165600, 1719169200),
+	(5, 1719169200, 1719172800),
+	(5, 1719172800, 1719176400),
+	(5, 1719176400, 1719180000),
+	(5, 1719180000, 1719183600),
+	(5, 1719183600, 1719187200),
+	(5, 1719187200, 1719190800),
+	(5, 1719190800, 1719194400),
+	(5, 1719194400, 1719198000),
+	(5, 1719198000, 1719201600),
+	(5, 1719201600, 1719205200),
+	(5, 1719205200, 1719208800),
+	(5, 1719208800, 1719212400),
+	(5, 1719212400, 1719216000),
+	(5, 1719216000, 1719219600),
+	(5, 1719219600, 1719223200),
+	(5, 1719223200, 1719226800),
+	(5, 1719226800, 1719230400),
+	(5, 1719230400, 1719234000),
+	(5, 1719234000, 1719237600),
+	(5, 1719237600, 1719241200),
+	(5, 1719241200, 1719244800),
+	(5, 1719244800, 1719248400),
+	(5, 1719248400, 1719252000),
+	(5, 1719252000, 1719255600),
+	(5, 1719255600, 1719259200),
+	(5, 1719259200, 1719262800),
+	(5, 1719262800, 1719266400),
+	(5, 1719266400, 1719270000),
+	(5, 1719270000, 1719273600),
+	(5, 1719273600, 1719277200),
+	(5, 1719277200, 1719280800),
+	(5, 1719280800, 1719284400),
+	(5, 1719284400, 1719288000),
+	(5, 1719288000, 1719291600),
+	(5, 1719291600, 1719295200),
+	(5, 1719295200, 1719298800),
+	(5, 1719298800, 1719302400),
+	(5, 1719302400, 1719306000),
+	(5, 1719306000, 1719309600),
+	(5, 1719309600, 1719313200),
+	(5, 1719313200, 1719316800),
+	(5, 1719316800, 1719320400),
+	(5, 1719320400, 1719324000),
+	(5, 1719324000, 1719327600),
+	(5, 1719327600, 1719331200),
+	(5, 1719331200, 1719334800),
+	(5, 1719334800, 1719338400),
+	(5, 1719338400, 1719342000),
+	(5, 1719342000, 1719345600),
+	(5, 1719345600, 1719349200),
+	(5, 1719349200, 1719352800),
+	(5, 1719352800, 1719356400),
+	(5, 1719356400, 1719360000),
+	(5, 1719360000, 1719363600),
+	(5, 1719363600, 1719367200),
+	(5, 1719367200, 1719370800),
+	(5, 1719370800, 1719374400),
+	(5, 1719374400, 1719378000),
+	(5, 1719378000, 1719381600),
+	(5, 1719381600, 1719385200),
+	(5, 1719385200, 1719388800),
+	(5, 1719388800, 1719392400),
+	(5, 1719392400, 1719396000),
+	(5, 1719396000, 1719399600),
+	(5, 1719399600, 1719403200),
+	(5, 1719403200, 1719406800),
+	(5, 1719406800, 1719410400),
+	(5, 1719410400, 1719414000),
+	(5, 1719414000, 1719417600),
+	(5, 1719417600, 1719421200),
+	(5, 1719421200, 1719424800),
+	(5, 1719424800, 1719428400),
+	(5, 1719428400, 1719432000),
+	(5, 1719432000, 1719435600),
+	(5, 1719435600, 1719439200),
+	(5, 1719439200, 1719442800),
+	(5, 1719442800, 1719446400),
+	(5, 1719446400, 1719450000),
+	(5, 1719450000, 1719453600),
+	(5, 1719453600, 1719457200),
+	(5, 1719457200, 1719460800),
+	(5, 1719460800, 1719464400),
+	(5, 1719464400, 1719468000),
+	(5, 1719468000, 1719471600),
+	(5, 1719471600, 1719475200),
+	(5, 1719475200, 1719478800),
+	(5, 1719478800, 1719482400),
+	(5, 1719482400, 1719486000),
+	(5, 1719486000, 1719489600),
+	(5, 1719489600, 1719493200),
+	(5, 1719493200, 1719496800),
+	(5, 1719496800, 1719500400),
+	(5, 1719500400, 1719504000),
+	(5, 1719504000, 1719507600),
+	(5, 1719507600, 1719511200),
+	(5, 1719511200, 1719514800),
+	(5, 1719514800, 1719518400),
+	(5, 1719518400, 1719522000),
+	(5, 1719522000, 1719525600),
+	(5, 1719525600, 1719529200),
+	(5, 1719529200, 1719532800),
+	(5, 1719532800, 1719536400),
+	(5, 1719536400, 1719540000),
+	(5, 1719540000, 1719543600),
+	(5, 1719543600, 1719547200),
+	(5, 1719547200, 1719550800),
+	(5, 1719550800, 1719554400),
+	(5, 1719554400, 1719558000),
+	(5, 1719558000, 1719561600),
+	(5, 1719561600, 1719565200),
+	(5, 1719565200, 1719568800),
+	(5, 1719568800, 1719572400),
+	(5, 1719572400, 1719576000),
+	(5, 1719576000, 1719579600),
+	(5, 1719579600, 1719583200),
+	(5, 1719583200, 1719586800),
+	(5, 1719586800, 1719590400),
+	(5, 1719590400, 1719594000),
+	(5, 1719594000, 1719597600),
+	(5, 1719597600, 1719601200),
+	(5, 1719601200, 1719604800),
+	(5, 1719604800, 1719608400),
+	(5, 1719608400, 1719612000),
+	(5, 1719612000, 1719615600),
+	(5, 1719615600, 1719619200),
+	(5, 1719619200, 1719622800),
+	(5, 1719622800, 1719626400),
+	(5, 1719626400, 1719630000),
+	(5, 1719630000, 1719633600),
+	(5, 1719633600, 1719637200),
+	(5, 1719637200, 1719640800),
+	(5, 1719640800, 1719644400),
+	(5, 1719644400, 1719648000),
+	(5, 1719648000, 1719651600),
+	(5, 1719651600, 1719655200),
+	(5, 1719655200, 1719658800),
+	(5, 1719658800, 1719662400),
+	(5, 1719662400, 1719666000),
+	(5, 1719666000, 1719669600),
+	(5, 1719669600, 1719673200),
+	(5, 1719673200, 1719676800),
+	(5, 1719676800, 1719680400),
+	(5, 1719680400, 1719684000),
+	(5, 1719684000, 1719687600),
+	(5, 1719687600, 1719691200),
+	(5, 1719691200, 1719694800),
+	(5, 1719694800, 1719698400),
+	(5, 1719698400, 1719702000),
+	(5, 1719702000, 1719705600),
+	(5, 1719705600, 1719709200),
+	(5, 1719709200, 1719712800),
+	(5, 1719712800, 1719716400),
+	(5, 1719716400, 1719720000),
+	(5, 1719720000, 1719723600),
+	(5, 1719723600, 1719727200),
+	(5, 1719727200, 1719730800),
+	(5, 1719730800, 1719734400),
+	(5, 1719734400, 1719738000),
+	(5, 1719738000, 1719741600),
+	(5, 1719741600, 1719745200),
+	(5, 1719745200, 1719748800),
+	(5, 1719748800, 1719752400),
+	(5, 1719752400, 1719756000),
+	(5, 1719756000, 1719759600),
+	(5, 1719759600, 1719763200),
+	(5, 1719763200, 1719766800),
+	(5, 1719766800, 1719770400),
+	(5, 1719770400, 1719774000),
+	(5, 1719774000, 1719777600),
+	(5, 1719777600, 1719781200),
+	(5, 1719781200, 1719784800),
+	(5, 1719784800, 1719788400),
+	(5, 1719788400, 1719792000),
+	(5, 1719792000, 1719795600),
+	(5, 1719795600, 1719799200),
+	(5, 1719799200, 1719802800),
+	(5, 1719802800, 1719806400),
+	(5, 1719806400, 1719810000),
+	(5, 1719810000, 1719813600),
+	(5, 1719813600, 1719817200),
+	(5, 1719817200, 1719820800),
+	(5, 1719820800, 1719824400),
+	(5, 1719824400, 1719828000),
+	(5, 1719828000, 1719831600),
+	(5, 1719831600, 1719835200),
+	(5, 1719835200, 1719838800),
+	(5, 1719838800, 1719842400),
+	(5, 1719842400, 1719846000),
+	(5, 1719846000, 1719849600),
+	(5, 1719849600, 1719853200),
+	(5, 1719853200, 1719856800),
+	(5, 1719856800, 1719860400),
+	(5, 1719860400, 1719864000),
+	(5, 1719864000, 1719867600),
+	(5, 1719867600, 1719871200),
+	(5, 1719871200, 1719874800),
+	(5, 1719874800, 1719878400),
+	(5, 1719878400, 1719882000),
+	(5, 1719882000, 1719885600),
+	(5, 1719885600, 1719889200),
+	(5, 1719889200, 1719892800),
+	(5, 1719892800, 1719896400),
+	(5, 1719896400, 1719900000),
+	(5, 1719900000, 1719903600),
+	(5, 1719903600, 1719907200),
+	(5, 1719907200, 1719910800),
+	(5, 1719910800, 1719914400),
+	(5, 1719914400, 1719918000),
+	(5, 1719918000, 1719921600),
+	(5, 1719921600, 1719925200),
+	(5, 1719925200, 1719928800),
+	(5, 1719928800, 1719932400),
+	(5, 1719932400, 1719936000),
+	(5, 1719936000, 1719939600),
+	(5, 1719939600, 1719943200),
+	(5, 1719943200, 1719946800),
+	(5, 1719946800, 1719950400),
+	(5, 1719950400, 1719954000),
+	(5, 1719954000, 1719957600),
+	(5, 1719957600, 1719961200),
+	(5, 1719961200, 1719964800),
+	(5, 1719964800, 1719968400),
+	(5, 1719968400, 1719972000),
+	(5, 1719972000, 1719975600),
+	(5, 1719975600, 1719979200),
+	(5, 1719979200, 1719982800),
+	(5, 1719982800, 1719986400),
+	(5, 1719986400, 1719990000),
+	(5, 1719990000, 1719993600),
+	(5, 1719993600, 1719997200),
+	(5, 1719997200, 1720000800),
+	(5, 1720000800, 1720004400),
+	(5, 1720004400, 1720008000),
+	(5, 1720008000, 1720011600),
+	(5, 1720011600, 1720015200),
+	(5, 1720015200, 1720018800),
+	(5, 1720018800, 1720022400),
+	(5, 1720022400, 1720026000),
+	(5, 1720026000, 1720029600),
+	(5, 1720029600, 1720033200),
+	(5, 1720033200, 1720036800),
+	(5, 1720036800, 1720040400),
+	(5, 1720040400, 1720044000),
+	(5, 1720044000, 1720047600),
+	(5, 1720047600, 1720051200),
+	(5, 1720051200, 1720054800),
+	(5, 1720054800, 1720058400),
+	(5, 1720058400, 1720062000),
+	(5, 1720062000, 1720065600),
+	(5, 1720065600, 1720069200),
+	(5, 1720069200, 1720072800),
+	(5, 1720072800, 1720076400),
+	(5, 1720076400, 1720080000),
+	(5, 1720080000, 1720083600),
+	(5, 1720083600, 1720087200),
+	(5, 1720087200, 1720090800),
+	(5, 1720090800, 1720094400),
+	(5, 1720094400, 1720098000),
+	(5, 1720098000, 1720101600),
+	(5, 1720101600, 1720105200),
+	(5, 1720105200, 1720108800),
+	(5, 1720108800, 1720112400),
+	(5, 1720112400, 1720116000),
+	(5, 1720116000, 1720119600),
+	(5, 1720119600, 1720123200),
+	(5, 1720123200, 1720126800),
+	(5, 1720126800, 1720130400),
+	(5, 1720130400, 1720134000),
+	(5, 1720134000, 1720137600),
+	(5, 1720137600, 1720141200),
+	(5, 1720141200, 1720144800),
+	(5, 1720144800, 1720148400),
+	(5, 1720148400, 1720152000),
+	(5, 1720152000, 1720155600),
+	(5, 1720155600, 1720159200),
+	(5, 1720159200, 1720162800),
+	(5, 1720162800, 1720166400),
+	(5, 1720166400, 1720170000),
+	(5, 1720170000, 1720173600),
+	(5, 1720173600, 1720177200),
+	(5, 1720177200, 1720180800),
+	(5, 1720180800, 1720184400),
+	(5, 1720184400, 1720188000),
+	(5, 1720188000, 1720191600),
+	(5, 1720191600, 1720195200),
+	(5, 1720195200, 1720198800),
+	(5, 1720198800, 1720202400),
+	(5, 1720202400, 1720206000),
+	(5, 1720206000, 1720209600),
+	(5, 1720209600, 1720213200),
+	(5, 1720213200, 1720216800),
+	(5, 1720216800, 1720220400),
+	(5, 1720220400, 1720224000),
+	(5, 1720224000, 1720227600),
+	(5, 1720227600, 1720231200),
+	(5, 1720231200, 1720234800),
+	(5, 1720234800, 1720238400),
+	(5, 1720238400, 1720242000),
+	(5, 1720242000, 1720245600),
+	(5, 1720245600, 1720249200),
+	(5, 1720249200, 1720252800),
+	(5, 1720252800, 1720256400),
+	(5, 1720256400, 1720260000),
+	(5, 1720260000, 1720263600),
+	(5, 1720263600, 1720267200),
+	(5, 1720267200, 1720270800),
+	(5, 1720270800, 1720274400),
+	(5, 1720274400, 1720278000),
+	(5, 1720278000, 1720281600),
+	(5, 1720281600, 1720285200),
+	(5, 1720285200, 1720288800),
+	(5, 1720288800, 1720292400),
+	(5, 1720292400, 1720296000),
+	(5, 1720296000, 1720299600),
+	(5, 1720299600, 1720303200),
+	(5, 1720303200, 1720306800),
+	(5, 1720306800, 1720310400),
+	(5, 1720310400, 1720314000),
+	(5, 1720314000, 1720317600),
+	(5, 1720317600, 1720321200),
+	(5, 1720321200, 1720324800),
+	(5, 1720324800, 1720328400),
+	(5, 1720328400, 1720332000),
+	(5, 1720332000, 1720335600),
+	(5, 1720335600, 1720339200),
+	(5, 1720339200, 1720342800),
+	(5, 1720342800, 1720346400),
+	(5, 1720346400, 1720350000),
+	(5, 1720350000, 1720353600),
+	(5, 1720353600, 1720357200),
+	(5, 1720357200, 1720360800),
+	(5, 1720360800, 1720364400),
+	(5, 1720364400, 1720368000),
+	(5, 1720368000, 1720371600),
+	(5, 1720371600, 1720375200),
+	(5, 1720375200, 1720378800),
+	(5, 1720378800, 1720382400),
+	(5, 1720382400, 1720386000),
+	(5, 1720386000, 1720389600),
+	(5, 1720389600, 1720393200),
+	(5, 1720393200, 1720396800),
+	(5, 1720396800, 1720400400),
+	(5, 1720400400, 1720404000),
+	(5, 1720404000, 1720407600),
+	(5, 1720407600, 1720411200),
+	(5, 1720411200, 1720414800),
+	(5, 1720414800, 1720418400),
+	(5, 1720418400, 1720422000),
+	(5, 1720422000, 1720425600),
+	(5, 1720425600, 1720429200),
+	(5, 1720429200, 1720432800),
+	(5, 1720432800, 1720436400),
+	(5, 1720436400, 1720440000),
+	(5, 1720440000, 1720443600),
+	(5, 1720443600, 1720447200),
+	(5, 1720447200, 1720450800),
+	(5, 1720450800, 1720454400),
+	(5, 1720454400, 1720458000),
+	(5, 1720458000, 1720461600),
+	(5, 1720461600, 1720465200),
+	(5, 1720465200, 1720468800),
+	(5, 1720468800, 1720472400),
+	(5, 1720472400, 1720476000),
+	(5, 1720476000, 1720479600),
+	(5, 1720479600, 1720483200),
+	(5, 1720483200, 1720486800),
+	(5, 1720486800, 1720490400),
+	(5, 1720490400, 1720494000),
+	(5, 1720494000, 1720497600),
+	(5, 1720497600, 1720501200),
+	(5, 1720501200, 1720504800),
+	(5, 1720504800, 1720508400),
+	(5, 1720508400, 1720512000),
+	(5, 1720512000, 1720515600),
+	(5, 1720515600, 1720519200),
+	(5, 1720519200, 1720522800),
+	(5, 1720522800, 1720526400),
+	(5, 1720526400, 1720530000),
+	(5, 1720530000, 1720533600),
+	(5, 1720533600, 1720537200),
+	(5, 1720537200, 1720540800),
+	(5, 1720540800, 1720544400),
+	(5, 1720544400, 1720548000),
+	(5, 1720548000, 1720551600),
+	(5, 1720551600, 1720555200),
+	(5, 1720555200, 1720558800),
+	(5, 1720558800, 1720562400),
+	(5, 1720562400, 1720566000),
+	(5, 1720566000, 1720569600),
+	(5, 1720569600, 1720573200),
+	(5, 1720573200, 1720576800),
+	(5, 1720576800, 1720580400),
+	(5, 1720580400, 1720584000),
+	(5, 1720584000, 1720587600),
+	(5, 1720587600, 1720591200),
+	(5, 1720591200, 1720594800),
+	(5, 1720594800, 1720598400),
+	(5, 1720598400, 1720602000),
+	(5, 1720602000, 1720605600),
+	(5, 1720605600, 1720609200),
+	(5, 1720609200, 1720612800),
+	(5, 1720612800, 1720616400),
+	(5, 1720616400, 1720620000),
+	(5, 1720620000, 1720623600),
+	(5, 1720623600, 1720627200),
+	(5, 1720627200, 1720630800),
+	(5, 1720630800, 1720634400),
+	(5, 1720634400, 1720638000),
+	(5, 1720638000, 1720641600),
+	(5, 1720641600, 1720645200),
+	(5, 1720645200, 1720648800),
+	(5, 1720648800, 1720652400),
+	(5, 1720652400, 1720656000),
+	(5, 1720656000, 1720659600),
+	(5, 1720659600, 1720663200),
+	(5, 1720663200, 1720666800),
+	(5, 1720666800, 1720670400),
+	(5, 1720670400, 1720674000),
+	(5, 1720674000, 1720677600),
+	(5, 1720677600, 1720681200),
+	(5, 1720681200, 1720684800),
+	(5, 1720684800, 1720688400),
+	(5, 1720688400, 1720692000),
+	(5, 1720692000, 1720695600),
+	(5, 1720695600, 1720699200),
+	(5, 1720699200, 1720702800),
+	(5, 1720702800, 1720706400),
+	(5, 1720706400, 1720710000),
+	(5, 1720710000, 1720713600),
+	(5, 1720713600, 1720717200),
+	(5, 1720717200, 1720720800),
+	(5, 1720720800, 1720724400),
+	(5, 1720724400, 1720728000),
+	(5, 1720728000, 1720731600),
+	(5, 1720731600, 1720735200),
+	(5, 1720735200, 1720738800),
+	(5, 1720738800, 1720742400),
+	(5, 1720742400, 1720746000),
+	(5, 1720746000, 1720749600),
+	(5, 1720749600, 1720753200),
+	(5, 1720753200, 1720756800),
+	(5, 1720756800, 1720760400),
+	(5, 1720760400, 1720764000),
+	(5, 1720764000, 1720767600),
+	(5, 1720767600, 1720771200),
+	(5, 1720771200, 1720774800),
+	(5, 1720774800, 1720778400),
+	(5, 1720778400, 1720782000),
+	(5, 1720782000, 1720785600),
+	(5, 1720785600, 1720789200),
+	(5, 1720789200, 1720792800),
+	(5, 1720792800, 1720796400),
+	(5, 1720796400, 1720800000),
+	(5, 1720800000, 1720803600),
+	(5, 1720803600, 1720807200),
+	(5, 1720807200, 1720810800),
+	(5, 1720810800, 1720814400),
+	(5, 1720814400, 1720818000),
+	(5, 1720818000, 1720821600),
+	(5, 1720821600, 1720825200),
+	(5, 1720825200, 1720828800),
+	(5, 1720828800, 1720832400),
+	(5, 1720832400, 1720836000),
+	(5, 1720836000, 1720839600),
+	(5, 1720839600, 1720843200),
+	(5, 1720843200, 1720846800),
+	(5, 1720846800, 1720850400),
+	(5, 1720850400, 1720854000),
+	(5, 1720854000, 1720857600),
+	(5, 1720857600, 1720861200),
+	(5, 1720861200, 1720864800),
+	(5, 1720864800, 1720868400),
+	(5, 1720868400, 1720872000),
+	(5, 1720872000, 1720875600),
+	(5, 1720875600, 1720879200),
+	(5, 1720879200, 1720882800),
+	(5, 1720882800, 1720886400),
+	(5, 1720886400, 1720890000),
+	(5, 1720890000, 1720893600),
+	(5, 1720893600, 1720897200),
+	(5, 1720897200, 1720900800),
+	(5, 1720900800, 1720904400),
+	(5, 1720904400, 1720908000),
+	(5, 1720908000, 1720911600),
+	(5, 1720911600, 1720915200),
+	(5, 1720915200, 1720918800),
+	(5, 1720918800, 1720922400),
+	(5, 1720922400, 1720926000),
+	(5, 1720926000, 1720929600),
+	(5, 1720929600, 1720933200),
+	(5, 1720933200, 1720936800),
+	(5, 1720936800, 1720940400),
+	(5, 1720940400, 1720944000),
+	(5, 1720944000, 1720947600),
+	(5, 1720947600, 1720951200),
+	(5, 1720951200, 1720954800),
+	(5, 1720954800, 1720958400),
+	(5, 1720958400, 1720962000),
+	(5, 1720962000, 1720965600),
+	(5, 1720965600, 1720969200),
+	(5, 1720969200, 1720972800),
+	(5, 1720972800, 1720976400),
+	(5, 1720976400, 1720980000),
+	(5, 1720980000, 1720983600),
+	(5, 1720983600, 1720987200),
+	(5, 1720987200, 1720990800),
+	(5, 1720990800, 1720994400),
+	(5, 1720994400, 1720998000),
+	(5, 1720998000, 1721001600),
+	(5, 1721001600, 1721005200),
+	(5, 1721005200, 1721008800),
+	(5, 1721008800, 1721012400),
+	(5, 1721012400, 1721016000),
+	(5, 1721016000, 1721019600),
+	(5, 1721019600, 1721023200),
+	(5, 1721023200, 1721026800),
+	(5, 1721026800, 1721030400),
+	(5, 1721030400, 1721034000),
+	(5, 1721034000, 1721037600),
+	(5, 1721037600, 1721041200),
+	(5, 1721041200, 1721044800),
+	(5, 1721044800, 1721048400),
+	(5, 1721048400, 1721052000),
+	(5, 1721052000, 1721055600),
+	(5, 1721055600, 1721059200),
+	(5, 1721059200, 1721062800),
+	(5, 1721062800, 1721066400),
+	(5, 1721066400, 1721070000),
+	(5, 1721070000, 1721073600),
+	(5, 1721073600, 1721077200),
+	(5, 1721077200, 1721080800),
+	(5, 1721080800, 1721084400),
+	(5, 1721084400, 1721088000),
+	(5, 1721088000, 1721091600),
+	(5, 1721091600, 1721095200),
+	(5, 1721095200, 1721098800),
+	(5, 1721098800, 1721102400),
+	(5, 1721102400, 1721106000),
+	(5, 1721106000, 1721109600),
+	(5, 1721109600, 1721113200),
+	(5, 1721113200, 1721116800),
+	(5, 1721116800, 1721120400),
+	(5, 1721120400, 1721124000),
+	(5, 1721124000, 1721127600),
+	(5, 1721127600, 1721131200),
+	(5, 1721131200, 1721134800),
+	(5, 1721134800, 1721138400),
+	(5, 1721138400, 1721142000),
+	(5, 1721142000, 1721145600),
+	(5, 1721145600, 1721149200),
+	(5, 1721149200, 1721152800),
+	(5, 1721152800, 1721156400),
+	(5, 1721156400, 1721160000),
+	(5, 1721160000, 1721163600),
+	(5, 1721163600, 1721167200),
+	(5, 1721167200, 1721170800),
+	(5, 1721170800, 1721174400),
+	(5, 1721174400, 1721178000),
+	(5, 1721178000, 1721181600),
+	(5, 1721181600, 1721185200),
+	(5, 1721185200, 1721188800),
+	(5, 1721188800, 1721192400),
+	(5, 1721192400, 1721196000),
+	(5, 1721196000, 1721199600),
+	(5, 1721199600, 1721203200),
+	(5, 1721203200, 1721206800),
+	(5, 1721206800, 1721210400),
+	(5, 1721210400, 1721214000),
+	(5, 1721214000, 1721217600),
+	(5, 1721217600, 1721221200),
+	(5, 1721221200, 1721224800),
+	(5, 1721224800, 1721228400),
+	(5, 1721228400, 1721232000),
+	(5, 1721232000, 1721235600),
+	(5, 1721235600, 1721239200),
+	(5, 1721239200, 1721242800),
+	(5, 1721242800, 1721246400),
+	(5, 1721246400, 1721250000),
+	(5, 1721250000, 1721253600),
+	(5, 1721253600, 1721257200),
+	(5, 1721257200, 1721260800),
+	(5, 1721260800, 1721264400),
+	(5, 1721264400, 1721268000),
+	(5, 1721268000, 1721271600),
+	(5, 1721271600, 1721275200),
+	(5, 1721275200, 1721278800),
+	(5, 1721278800, 1721282400),
+	(5, 1721282400, 1721286000),
+	(5, 1721286000, 1721289600),
+	(5, 1721289600, 1721293200),
+	(5, 1721293200, 1721296800),
+	(5, 1721296800, 1721300400),
+	(5, 1721300400, 1721304000),
+	(5, 1721304000, 1721307600),
+	(5, 1721307600, 1721311200),
+	(5, 1721311200, 1721314800),
+	(5, 1721314800, 1721318400),
+	(5, 1721318400, 1721322000),
+	(5, 1721322000, 1721325600),
+	(5, 1721325600, 1721329200),
+	(5, 1721329200, 1721332800),
+	(5, 1721332800, 1721336400),
+	(5, 1721336400, 1721340000),
+	(5, 1721340000, 1721343600),
+	(5, 1721343600, 1721347200),
+	(5, 1721347200, 1721350800),
+	(5, 1721350800, 1721354400),
+	(5, 1721354400, 1721358000),
+	(5, 1721358000, 1721361600),
+	(5, 1721361600, 1721365200),
+	(5, 1721365200, 1721368800),
+	(5, 1721368800, 1721372400),
+	(5, 1721372400, 1721376000),
+	(5, 1721376000, 1721379600),
+	(5, 1721379600, 1721383200),
+	(5, 1721383200, 1721386800),
+	(5, 1721386800, 1721390400),
+	(5, 1721390400, 1721394000),
+	(5, 1721394000, 1721397600),
+	(5, 1721397600, 1721401200),
+	(5, 1721401200, 1721404800),
+	(5, 1721404800, 1721408400),
+	(5, 1721408400, 1721412000),
+	(5, 1721412000, 1721415600),
+	(5, 1721415600, 1721419200),
+	(5, 1721419200, 1721422800),
+	(5, 1721422800, 1721426400),
+	(5, 1721426400, 1721430000),
+	(5, 1721430000, 1721433600),
+	(5, 1721433600, 1721437200),
+	(5, 1721437200, 1721440800),
+	(5, 1721440800, 1721444400),
+	(5, 1721444400, 1721448000),
+	(5, 1721448000, 1721451600),
+	(5, 1721451600, 1721455200),
+	(5, 1721455200, 1721458800),
+	(5, 1721458800, 1721462400),
+	(5, 1721462400, 1721466000),
+	(5, 1721466000, 1721469600),
+	(5, 1721469600, 1721473200),
+	(5, 1721473200, 1721476800),
+	(5, 1721476800, 1721480400),
+	(5, 1721480400, 1721484000),
+	(5, 1721484000, 1721487600),
+	(5, 1721487600, 1721491200),
+	(5, 1721491200, 1721494800),
+	(5, 1721494800, 1721498400),
+	(5, 1721498400, 1721502000),
+	(5, 1721502000, 1721505600),
+	(5, 1721505600, 1721509200),
+	(5, 1721509200, 1721512800),
+	(5, 1721512800, 1721516400),
+	(5, 1721516400, 1721520000),
+	(5, 1721520000, 1721523600),
+	(5, 1721523600, 1721527200),
+	(5, 1721527200, 1721530800),
+	(5, 1721530800, 1721534400),
+	(5, 1721534400, 1721538000),
+	(5, 1721538000, 1721541600),
+	(5, 1721541600, 1721545200),
+	(5, 1721545200, 1721548800),
+	(5, 1721548800, 1721552400),
+	(5, 1721552400, 1721556000),
+	(5, 1721556000, 1721559600),
+	(5, 1721559600, 1721563200),
+	(5, 1721563200, 1721566800),
+	(5, 1721566800, 1721570400),
+	(5, 1721570400, 1721574000),
+	(5, 1721574000, 1721577600),
+	(5, 1721577600, 1721581200),
+	(5, 1721581200, 1721584800),
+	(5, 1721584800, 1721588400),
+	(5, 1721588400, 1721592000),
+	(5, 1721592000, 1721595600),
+	(5, 1721595600, 1721599200),
+	(5, 1721599200, 1721602800),
+	(5, 1721602800, 1721606400),
+	(5, 1721606400, 1721610000),
+	(5, 1721610000, 1721613600),
+	(5, 1721613600, 1721617200),
+	(5, 1721617200, 1721620800),
+	(5, 1721620800, 1721624400),
+	(5, 1721624400, 1721628000),
+	(5, 1721628000, 1721631600),
+	(5, 1721631600, 1721635200),
+	(5, 1721635200, 1721638800),
+	(5, 1721638800, 1721642400),
+	(5, 1721642400, 1721646000),
+	(5, 1721646000, 1721649600),
+	(5, 1721649600, 1721653200),
+	(5, 1721653200, 1721656800),
+	(5, 1721656800, 1721660400),
+	(5, 1721660400, 1721664000),
+	(5, 1721664000, 1721667600),
+	(5, 1721667600, 1721671200),
+	(5, 1721671200, 1721674800),
+	(5, 1721674800, 1721678400),
+	(5, 1721678400, 1721682000),
+	(5, 1721682000, 1721685600),
+	(5, 1721685600, 1721689200),
+	(5, 1721689200, 1721692800),
+	(5, 1721692800, 1721696400),
+	(5, 1721696400, 1721700000),
+	(5, 1721700000, 1721703600),
+	(5, 1721703600, 1721707200),
+	(5, 1721707200, 1721710800),
+	(5, 1721710800, 1721714400),
+	(5, 1721714400, 1721718000),
+	(5, 1721718000, 1721721600),
+	(5, 1721721600, 1721725200),
+	(5, 1721725200, 1721728800),
+	(5, 1721728800, 1721732400),
+	(5, 1721732400, 1721736000),
+	(5, 1721736000, 1721739600),
+	(5, 1721739600, 1721743200),
+	(5, 1721743200, 1721746800),
+	(5, 1721746800, 1721750400),
+	(5, 1721750400, 1721754000),
+	(5, 1721754000, 1721757600),
+	(5, 1721757600, 1721761200),
+	(5, 1721761200, 1721764800),
+	(5, 1721764800, 1721768400),
+	(5, 1721768400, 1721772000),
+	(5, 1721772000, 1721775600),
+	(5, 1721775600, 1721779200),
+	(5, 1721779200, 1721782800),
+	(5, 1721782800, 1721786400),
+	(5, 1721786400, 1721790000),
+	(5, 1721790000, 1721793600),
+	(5, 1721793600, 1721797200),
+	(5, 1721797200, 1721800800),
+	(5, 1721800800, 1721804400),
+	(5, 1721804400, 1721808000),
+	(5, 1721808000, 1721811600),
+	(5, 1721811600, 1721815200),
+	(5, 1721815200, 1721818800),
+	(5, 1721818800, 1721822400),
+	(5, 1721822400, 1721826000),
+	(5, 1721826000, 1721829600),
+	(5, 1721829600, 1721833200),
+	(5, 1721833200, 1721836800),
+	(5, 1721836800, 1721840400),
+	(5, 1721840400, 1721844000),
+	(5, 1721844000, 1721847600),
+	(5, 1721847600, 1721851200),
+	(5, 1721851200, 1721854800),
+	(5, 1721854800, 1721858400),
+	(5, 1721858400, 1721862000),
+	(5, 1721862000, 1721865600),
+	(5, 1721865600, 1721869200),
+	(5, 1721869200, 1721872800),
+	(5, 1721872800, 1721876400),
+	(5, 1721876400, 1721880000),
+	(5, 1721880000, 1721883600),
+	(5, 1721883600, 1721887200),
+	(5, 1721887200, 1721890800),
+	(5, 1721890800, 1721894400),
+	(5, 1721894400, 1721898000),
+	(5, 1721898000, 1721901600),
+	(5, 1721901600, 1721905200),
+	(5, 1721905200, 1721908800),
+	(5, 1721908800, 1721912400),
+	(5, 1721912400, 1721916000),
+	(5, 1721916000, 1721919600),
+	(5, 1721919600, 1721923200),
+	(5, 1721923200, 1721926800),
+	(5, 1721926800, 1721930400),
+	(5, 1721930400, 1721934000),
+	(5, 1721934000, 1721937600),
+	(5, 1721937600, 1721941200),
+	(5, 1721941200, 1721944800),
+	(5, 1721944800, 1721948400),
+	(5, 1721948400, 1721952000),
+	(5, 1721952000, 1721955600),
+	(5, 1721955600, 1721959200),
+	(5, 1721959200, 1721962800),
+	(5, 1721962800, 1721966400),
+	(5, 1721966400, 1721970000),
+	(5, 1721970000, 1721973600),
+	(5, 1721973600, 1721977200),
+	(5, 1721977200, 1721980800),
+	(5, 1721980800, 1721984400),
+	(5, 1721984400, 1721988000),
+	(5, 1721988000, 1721991600),
+	(5, 1721991600, 1721995200),
+	(5, 1721995200, 1721998800),
+	(5, 1721998800, 1722002400),
+	(5, 1722002400, 1722006000),
+	(5, 1722006000, 1722009600),
+	(5, 1722009600, 1722013200),
+	(5, 1722013200, 1722016800),
+	(5, 1722016800, 1722020400),
+	(5, 1722020400, 1722024000),
+	(5, 1722024000, 1722027600),
+	(5, 1722027600, 1722031200),
+	(5, 1722031200, 1722034800),
+	(5, 1722034800, 1722038400),
+	(5, 1722038400, 1722042000),
+	(5, 1722042000, 1722045600),
+	(5, 1722045600, 1722049200),
+	(5, 1722049200, 1722052800),
+	(5, 1722052800, 1722056400),
+	(5, 1722056400, 1722060000),
+	(5, 1722060000, 1722063600),
+	(5, 1722063600, 1722067200),
+	(5, 1722067200, 1722070800),
+	(5, 1722070800, 1722074400),
+	(5, 1722074400, 1722078000),
+	(5, 1722078000, 1722081600),
+	(5, 1722081600, 1722085200),
+	(5, 1722085200, 1722088800),
+	(5, 1722088800, 1722092400),
+	(5, 1722092400, 1722096000),
+	(5, 1722096000, 1722099600),
+	(5, 1722099600, 1722103200),
+	(5, 1722103200, 1722106800),
+	(5, 1722106800, 1722110400),
+	(5, 1722110400, 1722114000),
+	(5, 1722114000, 1722117600),
+	(5, 1722117600, 1722121200),
+	(5, 1722121200, 1722124800),
+	(5, 1722124800, 1722128400),
+	(5, 1722128400, 1722132000),
+	(5, 1722132000, 1722135600),
+	(5, 1722135600, 1722139200),
+	(5, 1722139200, 1722142800),
+	(5, 1722142800, 1722146400),
+	(5, 1722146400, 1722150000),
+	(5, 1722150000, 1722153600),
+	(5, 1722153600, 1722157200),
+	(5, 1722157200, 1722160800),
+	(5, 1722160800, 1722164400),
+	(5, 1722164400, 1722168000),
+	(5, 1722168000, 1722171600),
+	(5, 1722171600, 1722175200),
+	(5, 1722175200, 1722178800),
+	(5, 1722178800, 1722182400),
+	(5, 1722182400, 1722186000),
+	(5, 1722186000, 1722189600),
+	(5, 1722189600, 1722193200),
+	(5, 1722193200, 1722196800),
+	(5, 1722196800, 1722200400),
+	(5, 1722200400, 1722204000),
+	(5, 1722204000, 1722207600),
+	(5, 1722207600, 1722211200),
+	(5, 1722211200, 1722214800),
+	(5, 1722214800, 1722218400),
+	(5, 1722218400, 1722222000),
+	(5, 1722222000, 1722225600),
+	(5, 1722225600, 1722229200),
+	(5, 1722229200, 1722232800),
+	(5, 1722232800, 1722236400),
+	(5, 1722236400, 1722240000),
+	(5, 1722240000, 1722243600),
+	(5, 1722243600, 1722247200),
+	(5, 1722247200, 1722250800),
+	(5, 1722250800, 1722254400),
+	(5, 1722254400, 1722258000),
+	(5, 1722258000, 1722261600),
+	(5, 1722261600, 1722265200),
+	(5, 1722265200, 1722268800),
+	(5, 1722268800, 1722272400),
+	(5, 1722272400, 1722276000),
+	(5, 1722276000, 1722279600),
+	(5, 1722279600, 1722283200),
+	(5, 1722283200, 1722286800),
+	(5, 1722286800, 1722290400),
+	(5, 1722290400, 1722294000),
+	(5, 1722294000, 1722297600),
+	(5, 1722297600, 1722301200),
+	(5, 1722301200, 1722304800),
+	(5, 1722304800, 1722308400),
+	(5, 1722308400, 1722312000),
+	(5, 1722312000, 1722315600),
+	(5, 1722315600, 1722319200),
+	(5, 1722319200, 1722322800),
+	(5, 1722322800, 1722326400),
+	(5, 1722326400, 1722330000),
+	(5, 1722330000, 1722333600),
+	(5, 1722333600, 1722337200),
+	(5, 1722337200, 1722340800),
+	(5, 1722340800, 1722344400),
+	(5, 1722344400, 1722348000),
+	(5, 1722348000, 1722351600),
+	(5, 1722351600, 1722355200),
+	(5, 1722355200, 1722358800),
+	(5, 1722358800, 1722362400),
+	(5, 1722362400, 1722366000),
+	(5, 1722366000, 1722369600),
+	(5, 1722369600, 1722373200),
+	(5, 1722373200, 1722376800),
+	(5, 1722376800, 1722380400),
+	(5, 1722380400, 1722384000),
+	(5, 1722384000, 1722387600),
+	(5, 1722387600, 1722391200),
+	(5, 1722391200, 1722394800),
+	(5, 1722394800, 1722398400),
+	(5, 1722398400, 1722402000),
+	(5, 1722402000, 1722405600),
+	(5, 1722405600, 1722409200),
+	(5, 1722409200, 1722412800),
+	(5, 1722412800, 1722416400),
+	(5, 1722416400, 1722420000),
+	(5, 1722420000, 1722423600),
+	(5, 1722423600, 1722427200),
+	(5, 1722427200, 1722430800),
+	(5, 1722430800, 1722434400),
+	(5, 1722434400, 1722438000),
+	(5, 1722438000, 1722441600),
+	(5, 1722441600, 1722445200),
+	(5, 1722445200, 1722448800),
+	(5, 1722448800, 1722452400),
+	(5, 1722452400, 1722456000),
+	(5, 1722456000, 1722459600),
+	(5, 1722459600, 1722463200),
+	(5, 1722463200, 1722466800),
+	(5, 1722466800, 1722470400),
+	(5, 1722470400, 1722474000),
+	(5, 1722474000, 1722477600),
+	(5, 1722477600, 1722481200),
+	(5, 1722481200, 1722484800),
+	(5, 1722484800, 1722488400),
+	(5, 1722488400, 1722492000),
+	(5, 1722492000, 1722495600),
+	(5, 1722495600, 1722499200),
+	(5, 1722499200, 1722502800),
+	(5, 1722502800, 1722506400),
+	(5, 1722506400, 1722510000),
+	(5, 1722510000, 1722513600),
+	(5, 1722513600, 1722517200),
+	(5, 1722517200, 1722520800),
+	(5, 1722520800, 1722524400),
+	(5, 1722524400, 1722528000),
+	(5, 1722528000, 1722531600),
+	(5, 1722531600, 1722535200),
+	(5, 1722535200, 1722538800),
+	(5, 1722538800, 1722542400),
+	(5, 1722542400, 1722546000),
+	(5, 1722546000, 1722549600),
+	(5, 1722549600, 1722553200),
+	(5, 1722553200, 1722556800),
+	(5, 1722556800, 1722560400),
+	(5, 1722560400, 1722564000),
+	(5, 1722564000, 1722567600),
+	(5, 1722567600, 1722571200),
+	(5, 1722571200, 1722574800),
+	(5, 1722574800, 1722578400),
+	(5, 1722578400, 1722582000),
+	(5, 1722582000, 1722585600),
+	(5, 1722585600, 1722589200),
+	(5, 1722589200, 1722592800),
+	(5, 1722592800, 1722596400),
+	(5, 1722596400, 1722600000),
+	(5, 1722600000, 1722603600),
+	(5, 1722603600, 1722607200),
+	(5, 1722607200, 1722610800),
+	(5, 1722610800, 1722614400),
+	(5, 1722614400, 1722618000),
+	(5, 1722618000, 1722621600),
+	(5, 1722621600, 1722625200),
+	(5, 1722625200, 1722628800),
+	(5, 1722628800, 1722632400),
+	(5, 1722632400, 1722636000),
+	(5, 1722636000, 1722639600),
+	(5, 1722639600, 1722643200),
+	(5, 1722643200, 1722646800),
+	(5, 1722646800, 1722650400),
+	(5, 1722650400, 1722654000),
+	(5, 1722654000, 1722657600),
+	(5, 1722657600, 1722661200),
+	(5, 1722661200, 1722664800),
+	(5, 1722664800, 1722668400),
+	(5, 1722668400, 1722672000),
+	(5, 1722672000, 1722675600),
+	(5, 1722675600, 1722679200),
+	(5, 1722679200, 1722682800),
+	(5, 1722682800, 1722686400),
+	(5, 1722686400, 1722690000),
+	(5, 1722690000, 1722693600),
+	(5, 1722693600, 1722697200),
+	(5, 1722697200, 1722700800),
+	(5, 1722700800, 1722704400),
+	(5, 1722704400, 1722708000),
+	(5, 1722708000, 1722711600),
+	(5, 1722711600, 1722715200),
+	(5, 1722715200, 1722718800),
+	(5, 1722718800, 1722722400),
+	(5, 1722722400, 1722726000),
+	(5, 1722726000, 1722729600),
+	(5, 1722729600, 1722733200),
+	(5, 1722733200, 1722736800),
+	(5, 1722736800, 1722740400),
+	(5, 1722740400, 1722744000),
+	(5, 1722744000, 1722747600),
+	(5, 1722747600, 1722751200),
+	(5, 1722751200, 1722754800),
+	(5, 1722754800, 1722758400),
+	(5, 1722758400, 1722762000),
+	(5, 1722762000, 1722765600),
+	(5, 1722765600, 1722769200),
+	(5, 1722769200, 1722772800),
+	(5, 1722772800, 1722776400),
+	(5, 1722776400, 1722780000),
+	(5, 1722780000, 1722783600),
+	(5, 1722783600, 1722787200),
+	(5, 1722787200, 1722790800),
+	(5, 1722790800, 1722794400),
+	(5, 1722794400, 1722798000),
+	(5, 1722798000, 1722801600),
+	(5, 1722801600, 1722805200),
+	(5, 1722805200, 1722808800),
+	(5, 1722808800, 1722812400),
+	(5, 1722812400, 1722816000),
+	(5, 1722816000, 1722819600),
+	(5, 1722819600, 1722823200),
+	(5, 1722823200, 1722826800),
+	(5, 1722826800, 1722830400),
+	(5, 1722830400, 1722834000),
+	(5, 1722834000, 1722837600),
+	(5, 1722837600, 1722841200),
+	(5, 1722841200, 1722844800),
+	(5, 1722844800, 1722848400),
+	(5, 1722848400, 1722852000),
+	(5, 1722852000, 1722855600),
+	(5, 1722855600, 1722859200),
+	(5, 1722859200, 1722862800),
+	(5, 1722862800, 1722866400),
+	(5, 1722866400, 1722870000),
+	(5, 1722870000, 1722873600),
+	(5, 1722873600, 1722877200),
+	(5, 1722877200, 1722880800),
+	(5, 1722880800, 1722884400),
+	(5, 1722884400, 1722888000),
+	(5, 1722888000, 1722891600),
+	(5, 1722891600, 1722895200),
+	(5, 1722895200, 1722898800),
+	(5, 1722898800, 1722902400),
+	(5, 1722902400, 1722906000),
+	(5, 1722906000, 1722909600),
+	(5, 1722909600, 1722913200),
+	(5, 1722913200, 1722916800),
+	(5, 1722916800, 1722920400),
+	(5, 1722920400, 1722924000),
+	(5, 1722924000, 1722927600),
+	(5, 1722927600, 1722931200),
+	(5, 1722931200, 1722934800),
+	(5, 1722934800, 1722938400),
+	(5, 1722938400, 1722942000),
+	(5, 1722942000, 1722945600),
+	(5, 1722945600, 1722949200),
+	(5, 1722949200, 1722952800),
+	(5, 1722952800, 1722956400),
+	(5, 1722956400, 1722960000),
+	(5, 1722960000, 1722963600),
+	(5, 1722963600, 1722967200),
+	(5, 1722967200, 1722970800),
+	(5, 1722970800, 1722974400),
+	(5, 1722974400, 1722978000),
+	(5, 1722978000, 1722981600),
+	(5, 1722981600, 1722985200),
+	(5, 1722985200, 1722988800),
+	(5, 1722988800, 1722992400),
+	(5, 1722992400, 1722996000),
+	(5, 1722996000, 1722999600),
+	(5, 1722999600, 1723003200),
+	(5, 1723003200, 1723006800),
+	(5, 1723006800, 1723010400),
+	(5, 1723010400, 1723014000),
+	(5, 1723014000, 1723017600),
+	(5, 1723017600, 1723021200),
+	(5, 1723021200, 1723024800),
+	(5, 1723024800, 1723028400),
+	(5, 1723028400, 1723032000),
+	(5, 1723032000, 1723035600),
+	(5, 1723035600, 1723039200),
+	(5, 1723039200, 1723042800),
+	(5, 1723042800, 1723046400),
+	(5, 1723046400, 1723050000),
+	(5, 1723050000, 1723053600),
+	(5, 1723053600, 1723057200),
+	(5, 1723057200, 1723060800),
+	(5, 1723060800, 1723064400),
+	(5, 1723064400, 1723068000),
+	(5, 1723068000, 1723071600),
+	(5, 1723071600, 1723075200),
+	(5, 1723075200, 1723078800),
+	(5, 1723078800, 1723082400),
+	(5, 1723082400, 1723086000),
+	(5, 1723086000, 1723089600),
+	(5, 1723089600, 1723093200),
+	(5, 1723093200, 1723096800),
+	(5, 1723096800, 1723100400),
+	(5, 1723100400, 1723104000),
+	(5, 1723104000, 1723107600),
+	(5, 1723107600, 1723111200),
+	(5, 1723111200, 1723114800),
+	(5, 1723114800, 1723118400),
+	(5, 1723118400, 1723122000),
+	(5, 1723122000, 1723125600),
+	(5, 1723125600, 1723129200),
+	(5, 1723129200, 1723132800),
+	(5, 1723132800, 1723136400),
+	(5, 1723136400, 1723140000),
+	(5, 1723140000, 1723143600),
+	(5, 1723143600, 1723147200),
+	(5, 1723147200, 1723150800),
+	(5, 1723150800, 1723154400),
+	(5, 1723154400, 1723158000),
+	(5, 1723158000, 1723161600),
+	(5, 1723161600, 1723165200),
+	(5, 1723165200, 1723168800),
+	(5, 1723168800, 1723172400),
+	(5, 1723172400, 1723176000),
+	(5, 1723176000, 1723179600),
+	(5, 1723179600, 1723183200),
+	(5, 1723183200, 1723186800),
+	(5, 1723186800, 1723190400),
+	(5, 1723190400, 1723194000),
+	(5, 1723194000, 1723197600),
+	(5, 1723197600, 1723201200),
+	(5, 1723201200, 1723204800),
+	(5, 1723204800, 1723208400),
+	(5, 1723208400, 1723212000),
+	(5, 1723212000, 1723215600),
+	(5, 1723215600, 1723219200),
+	(5, 1723219200, 1723222800),
+	(5, 1723222800, 1723226400),
+	(5, 1723226400, 1723230000),
+	(5, 1723230000, 1723233600),
+	(5, 1723233600, 1723237200),
+	(5, 1723237200, 1723240800),
+	(5, 1723240800, 1723244400),
+	(5, 1723244400, 1723248000),
+	(5, 1723248000, 1723251600),
+	(5, 1723251600, 1723255200),
+	(5, 1723255200, 1723258800),
+	(5, 1723258800, 1723262400),
+	(5, 1723262400, 1723266000),
+	(5, 1723266000, 1723269600),
+	(5, 1723269600, 1723273200),
+	(5, 1723273200, 1723276800),
+	(5, 1723276800, 1723280400),
+	(5, 1723280400, 1723284000),
+	(5, 1723284000, 1723287600),
+	(5, 1723287600, 1723291200),
+	(5, 1723291200, 1723294800),
+	(5, 1723294800, 1723298400),
+	(5, 1723298400, 1723302000),
+	(5, 1723302000, 1723305600),
+	(5, 1723305600, 1723309200),
+	(5, 1723309200, 1723312800),
+	(5, 1723312800, 1723316400),
+	(5, 1723316400, 1723320000),
+	(5, 1723320000, 1723323600),
+	(5, 1723323600, 1723327200),
+	(5, 1723327200, 1723330800),
+	(5, 1723330800, 1723334400),
+	(5, 1723334400, 1723338000),
+	(5, 1723338000, 1723341600),
+	(5, 1723341600, 1723345200),
+	(5, 1723345200, 1723348800),
+	(5, 1723348800, 1723352400),
+	(5, 1723352400, 1723356000),
+	(5, 1723356000, 1723359600),
+	(5, 1723359600, 1723363200),
+	(5, 1723363200, 1723366800),
+	(5, 1723366800, 1723370400),
+	(5, 1723370400, 1723374000),
+	(5, 1723374000, 1723377600),
+	(5, 1723377600, 1723381200),
+	(5, 1723381200, 1723384800),
+	(5, 1723384800, 1723388400),
+	(5, 1723388400, 1723392000),
+	(5, 1723392000, 1723395600),
+	(5, 1723395600, 1723399200),
+	(5, 1723399200, 1723402800),
+	(5, 1723402800, 1723406400),
+	(5, 1723406400, 1723410000),
+	(5, 1723410000, 1723413600),
+	(5, 1723413600, 1723417200),
+	(5, 1723417200, 1723420800),
+	(5, 1723420800, 1723424400),
+	(5, 1723424400, 1723428000),
+	(5, 1723428000, 1723431600),
+	(5, 1723431600, 1723435200),
+	(5, 1723435200, 1723438800),
+	(5, 1723438800, 1723442400),
+	(5, 1723442400, 1723446000),
+	(5, 1723446000, 1723449600),
+	(5, 1723449600, 1723453200),
+	(5, 1723453200, 1723456800),
+	(5, 1723456800, 1723460400),
+	(5, 1723460400, 1723464000),
+	(5, 1723464000, 1723467600),
+	(5, 1723467600, 1723471200),
+	(5, 1723471200, 1723474800),
+	(5, 1723474800, 1723478400),
+	(5, 1723478400, 1723482000),
+	(5, 1723482000, 1723485600),
+	(5, 1723485600, 1723489200),
+	(5, 1723489200, 1723492800),
+	(5, 1723492800, 1723496400),
+	(5, 1723496400, 1723500000),
+	(5, 1723500000, 1723503600),
+	(5, 1723503600, 1723507200),
+	(5, 1723507200, 1723510800),
+	(5, 1723510800, 1723514400),
+	(5, 1723514400, 1723518000),
+	(5, 1723518000, 1723521600),
+	(5, 1723521600, 1723525200),
+	(5, 1723525200, 1723528800),
+	(5, 1723528800, 1723532400),
+	(5, 1723532400, 1723536000),
+	(5, 1723536000, 1723539600),
+	(5, 1723539600, 1723543200),
+	(5, 1723543200, 1723546800),
+	(5, 1723546800, 1723550400),
+	(5, 1723550400, 1723554000),
+	(5, 1723554000, 1723557600),
+	(5, 1723557600, 1723561200),
+	(5, 1723561200, 1723564800),
+	(5, 1723564800, 1723568400),
+	(5, 1723568400, 1723572000),
+	(5, 1723572000, 1723575600),
+	(5, 1723575600, 1723579200),
+	(5, 1723579200, 1723582800),
+	(5, 1723582800, 1723586400),
+	(5, 1723586400, 1723590000),
+	(5, 1723590000, 1723593600),
+	(5, 1723593600, 1723597200),
+	(5, 1723597200, 1723600800),
+	(5, 1723600800, 1723604400),
+	(5, 1723604400, 1723608000),
+	(5, 1723608000, 1723611600),
+	(5, 1723611600, 1723615200),
+	(5, 1723615200, 1723618800),
+	(5, 1723618800, 1723622400),
+	(5, 1723622400, 1723626000),
+	(5, 1723626000, 1723629600),
+	(5, 1723629600, 1723633200),
+	(5, 1723633200, 1723636800),
+	(5, 1723636800, 1723640400),
+	(5, 1723640400, 1723644000),
+	(5, 1723644000, 1723647600),
+	(5, 1723647600, 1723651200),
+	(5, 1723651200, 1723654800),
+	(5, 1723654800, 1723658400),
+	(5, 1723658400, 1723662000),
+	(5, 1723662000, 1723665600),
+	(5, 1723665600, 1723669200),
+	(5, 1723669200, 1723672800),
+	(5, 1723672800, 1723676400),
+	(5, 1723676400, 1723680000),
+	(5, 1723680000, 1723683600),
+	(5, 1723683600, 1723687200),
+	(5, 1723687200, 1723690800),
+	(5, 1723690800, 1723694400),
+	(5, 1723694400, 1723698000),
+	(5, 1723698000, 1723701600),
+	(5, 1723701600, 1723705200),
+	(5, 1723705200, 1723708800),
+	(5, 1723708800, 1723712400),
+	(5, 1723712400, 1723716000),
+	(5, 1723716000, 1723719600),
+	(5, 1723719600, 1723723200),
+	(5, 1723723200, 1723726800),
+	(5, 1723726800, 1723730400),
+	(5, 1723730400, 1723734000),
+	(5, 1723734000, 1723737600),
+	(5, 1723737600, 1723741200),
+	(5, 1723741200, 1723744800),
+	(5, 1723744800, 1723748400),
+	(5, 1723748400, 1723752000),
+	(5, 1723752000, 1723755600),
+	(5, 1723755600, 1723759200),
+	(5, 1723759200, 1723762800),
+	(5, 1723762800, 1723766400),
+	(5, 1723766400, 1723770000),
+	(5, 1723770000, 1723773600),
+	(5, 1723773600, 1723777200),
+	(5, 1723777200, 1723780800),
+	(5, 1723780800, 1723784400),
+	(5, 1723784400, 1723788000),
+	(5, 1723788000, 1723791600),
+	(5, 1723791600, 1723795200),
+	(5, 1723795200, 1723798800),
+	(5, 1723798800, 1723802400),
+	(5, 1723802400, 1723806000),
+	(5, 1723806000, 1723809600),
+	(5, 1723809600, 1723813200),
+	(5, 1723813200, 1723816800),
+	(5, 1723816800, 1723820400),
+	(5, 1723820400, 1723824000),
+	(5, 1723824000, 1723827600),
+	(5, 1723827600, 1723831200),
+	(5, 1723831200, 1723834800),
+	(5, 1723834800, 1723838400),
+	(5, 1723838400, 1723842000),
+	(5, 1723842000, 1723845600),
+	(5, 1723845600, 1723849200),
+	(5, 1723849200, 1723852800),
+	(5, 1723852800, 1723856400),
+	(5, 1723856400, 1723860000),
+	(5, 1723860000, 1723863600),
+	(5, 1723863600, 1723867200),
+	(5, 1723867200, 1723870800),
+	(5, 1723870800, 1723874400),
+	(5, 1723874400, 1723878000),
+	(5, 1723878000, 1723881600),
+	(5, 1723881600, 1723885200),
+	(5, 1723885200, 1723888800),
+	(5, 1723888800, 1723892400),
+	(5, 1723892400, 1723896000),
+	(5, 1723896000, 1723899600),
+	(5, 1723899600, 1723903200),
+	(5, 1723903200, 1723906800),
+	(5, 1723906800, 1723910400),
+	(5, 1723910400, 1723914000),
+	(5, 1723914000, 1723917600),
+	(5, 1723917600, 1723921200),
+	(5, 1723921200, 1723924800),
+	(5, 1723924800, 1723928400),
+	(5, 1723928400, 1723932000),
+	(5, 1723932000, 1723935600),
+	(5, 1723935600, 1723939200),
+	(5, 1723939200, 1723942800),
+	(5, 1723942800, 1723946400),
+	(5, 1723946400, 1723950000),
+	(5, 1723950000, 1723953600),
+	(5, 1723953600, 1723957200),
+	(5, 1723957200, 1723960800),
+	(5, 1723960800, 1723964400),
+	(5, 1723964400, 1723968000),
+	(5, 1723968000, 1723971600),
+	(5, 1723971600, 1723975200),
+	(5, 1723975200, 1723978800),
+	(5, 1723978800, 1723982400),
+	(5, 1723982400, 1723986000),
+	(5, 1723986000, 1723989600),
+	(5, 1723989600, 1723993200),
+	(5, 1723993200, 1723996800),
+	(5, 1723996800, 1724000400),
+	(5, 1724000400, 1724004000),
+	(5, 1724004000, 1724007600),
+	(5, 1724007600, 1724011200),
+	(5, 1724011200, 1724014800),
+	(5, 1724014800, 1724018400),
+	(5, 1724018400, 1724022000),
+	(5, 1724022000, 1724025600),
+	(5, 1724025600, 1724029200),
+	(5, 1724029200, 1724032800),
+	(5, 1724032800, 1724036400),
+	(5, 1724036400, 1724040000),
+	(5, 1724040000, 1724043600),
+	(5, 1724043600, 1724047200),
+	(5, 1724047200, 1724050800),
+	(5, 1724050800, 1724054400),
+	(5, 1724054400, 1724058000),
+	(5, 1724058000, 1724061600),
+	(5, 1724061600, 1724065200),
+	(5, 1724065200, 1724068800),
+	(5, 1724068800, 1724072400),
+	(5, 1724072400, 1724076000),
+	(5, 1724076000, 1724079600),
+	(5, 1724079600, 1724083200),
+	(5, 1724083200, 1724086800),
+	(5, 1724086800, 1724090400),
+	(5, 1724090400, 1724094000),
+	(5, 1724094000, 1724097600),
+	(5, 1724097600, 1724101200),
+	(5, 1724101200, 1724104800),
+	(5, 1724104800, 1724108400),
+	(5, 1724108400, 1724112000),
+	(5, 1724112000, 1724115600),
+	(5, 1724115600, 1724119200),
+	(5, 1724119200, 1724122800),
+	(5, 1724122800, 1724126400),
+	(5, 1724126400, 1724130000),
+	(5, 1724130000, 1724133600),
+	(5, 1724133600, 1724137200),
+	(5, 1724137200, 1724140800),
+	(5, 1724140800, 1724144400),
+	(5, 1724144400, 1724148000),
+	(5, 1724148000, 1724151600),
+	(5, 1724151600, 1724155200),
+	(5, 1724155200, 1724158800),
+	(5, 1724158800, 1724162400),
+	(5, 1724162400, 1724166000),
+	(5, 1724166000, 1724169600),
+	(5, 1724169600, 1724173200),
+	(5, 1724173200, 1724176800),
+	(5, 1724176800, 1724180400),
+	(5, 1724180400, 1724184000),
+	(5, 1724184000, 1724187600),
+	(5, 1724187600, 1724191200),
+	(5, 1724191200, 1724194800),
+	(5, 1724194800, 1724198400),
+	(5, 1724198400, 1724202000),
+	(5, 1724202000, 1724205600),
+	(5, 1724205600, 1724209200),
+	(5, 1724209200, 1724212800),
+	(5, 1724212800, 1724216400),
+	(5, 1724216400, 1724220000),
+	(5, 1724220000, 1724223600),
+	(5, 1724223600, 1724227200),
+	(5, 1724227200, 1724230800),
+	(5, 1724230800, 1724234400),
+	(5, 1724234400, 1724238000),
+	(5, 1724238000, 1724241600),
+	(5, 1724241600, 1724245200),
+	(5, 1724245200, 1724248800),
+	(5, 1724248800, 1724252400),
+	(5, 1724252400, 1724256000),
+	(5, 1724256000, 1724259600),
+	(5, 1724259600, 1724263200),
+	(5, 1724263200, 1724266800),
+	(5, 1724266800, 1724270400),
+	(5, 1724270400, 1724274000),
+	(5, 1724274000, 1724277600),
+	(5, 1724277600, 1724281200),
+	(5, 1724281200, 1724284800),
+	(5, 1724284800, 1724288400),
+	(5, 1724288400, 1724292000),
+	(5, 1724292000, 1724295600),
+	(5, 1724295600, 1724299200),
+	(5, 1724299200, 1724302800),
+	(5, 1724302800, 1724306400),
+	(5, 1724306400, 1724310000),
+	(5, 1724310000, 1724313600),
+	(5, 1724313600, 1724317200),
+	(5, 1724317200, 1724320800),
+	(5, 1724320800, 1724324400),
+	(5, 1724324400, 1724328000),
+	(5, 1724328000, 1724331600),
+	(5, 1724331600, 1724335200),
+	(5, 1724335200, 1724338800),
+	(5, 1724338800, 1724342400),
+	(5, 1724342400, 1724346000),
+	(5, 1724346000, 1724349600),
+	(5, 1724349600, 1724353200),
+	(5, 1724353200, 1724356800),
+	(5, 1724356800, 1724360400),
+	(5, 1724360400, 1724364000),
+	(5, 1724364000, 1724367600),
+	(5, 1724367600, 1724371200),
+	(5, 1724371200, 1724374800),
+	(5, 1724374800, 1724378400),
+	(5, 1724378400, 1724382000),
+	(5, 1724382000, 1724385600),
+	(5, 1724385600, 1724389200),
+	(5, 1724389200, 1724392800),
+	(5, 1724392800, 1724396400),
+	(5, 1724396400, 1724400000),
+	(5, 1724400000, 1724403600),
+	(5, 1724403600, 1724407200),
+	(5, 1724407200, 1724410800),
+	(5, 1724410800, 1724414400),
+	(5, 1724414400, 1724418000),
+	(5, 1724418000, 1724421600),
+	(5, 1724421600, 1724425200),
+	(5, 1724425200, 1724428800),
+	(5, 1724428800, 1724432400),
+	(5, 1724432400, 1724436000),
+	(5, 1724436000, 1724439600),
+	(5, 1724439600, 1724443200),
+	(5, 1724443200, 1724446800),
+	(5, 1724446800, 1724450400),
+	(5, 1724450400, 1724454000),
+	(5, 1724454000, 1724457600),
+	(5, 1724457600, 1724461200),
+	(5, 1724461200, 1724464800),
+	(5, 1724464800, 1724468400),
+	(5, 1724468400, 1724472000),
+	(5, 1724472000, 1724475600),
+	(5, 1724475600, 1724479200),
+	(5, 1724479200, 1724482800),
+	(5, 1724482800, 1724486400),
+	(5, 1724486400, 1724490000),
+	(5, 1724490000, 1724493600),
+	(5, 1724493600, 1724497200),
+	(5, 1724497200, 1724500800),
+	(5, 1724500800, 1724504400),
+	(5, 1724504400, 1724508000),
+	(5, 1724508000, 1724511600),
+	(5, 1724511600, 1724515200),
+	(5, 1724515200, 1724518800),
+	(5, 1724518800, 1724522400),
+	(5, 1724522400, 1724526000),
+	(5, 1724526000, 1724529600),
+	(5, 1724529600, 1724533200),
+	(5, 1724533200, 1724536800),
+	(5, 1724536800, 1724540400),
+	(5, 1724540400, 1724544000),
+	(5, 1724544000, 1724547600),
+	(5, 1724547600, 1724551200),
+	(5, 1724551200, 1724554800),
+	(5, 1724554800, 1724558400),
+	(5, 1724558400, 1724562000),
+	(5, 1724562000, 1724565600),
+	(5, 1724565600, 1724569200),
+	(5, 1724569200, 1724572800),
+	(5, 1724572800, 1724576400),
+	(5, 1724576400, 1724580000),
+	(5, 1724580000, 1724583600),
+	(5, 1724583600, 1724587200),
+	(5, 1724587200, 1724590800),
+	(5, 1724590800, 1724594400),
+	(5, 1724594400, 1724598000),
+	(5, 1724598000, 1724601600),
+	(5, 1724601600, 1724605200),
+	(5, 1724605200, 1724608800),
+	(5, 1724608800, 1724612400),
+	(5, 1724612400, 1724616000),
+	(5, 1724616000, 1724619600),
+	(5, 1724619600, 1724623200),
+	(5, 1724623200, 1724626800),
+	(5, 1724626800, 1724630400),
+	(5, 1724630400, 1724634000),
+	(5, 1724634000, 1724637600),
+	(5, 1724637600, 1724641200),
+	(5, 1724641200, 1724644800),
+	(5, 1724644800, 1724648400),
+	(5, 1724648400, 1724652000),
+	(5, 1724652000, 1724655600),
+	(5, 1724655600, 1724659200),
+	(5, 1724659200, 1724662800),
+	(5, 1724662800, 1724666400),
+	(5, 1724666400, 1724670000),
+	(5, 1724670000, 1724673600),
+	(5, 1724673600, 1724677200),
+	(5, 1724677200, 1724680800),
+	(5, 1724680800, 1724684400),
+	(5, 1724684400, 1724688000),
+	(5, 1724688000, 1724691600),
+	(5, 1724691600, 1724695200),
+	(5, 1724695200, 1724698800),
+	(5, 1724698800, 1724702400),
+	(5, 1724702400, 1724706000),
+	(5, 1724706000, 1724709600),
+	(5, 1724709600, 1724713200),
+	(5, 1724713200, 1724716800),
+	(5, 1724716800, 1724720400),
+	(5, 1724720400, 1724724000),
+	(5, 1724724000, 1724727600),
+	(5, 1724727600, 1724731200),
+	(5, 1724731200, 1724734800),
+	(5, 1724734800, 1724738400),
+	(5, 1724738400, 1724742000),
+	(5, 1724742000, 1724745600),
+	(5, 1724745600, 1724749200),
+	(5, 1724749200, 1724752800),
+	(5, 1724752800, 1724756400),
+	(5, 1724756400, 1724760000),
+	(5, 1724760000, 1724763600),
+	(5, 1724763600, 1724767200),
+	(5, 1724767200, 1724770800),
+	(5, 1724770800, 1724774400),
+	(5, 1724774400, 1724778000),
+	(5, 1724778000, 1724781600),
+	(5, 1724781600, 1724785200),
+	(5, 1724785200, 1724788800),
+	(5, 1724788800, 1724792400),
+	(5, 1724792400, 1724796000),
+	(5, 1724796000, 1724799600),
+	(5, 1724799600, 1724803200),
+	(5, 1724803200, 1724806800),
+	(5, 1724806800, 1724810400),
+	(5, 1724810400, 1724814000),
+	(5, 1724814000, 1724817600),
+	(5, 1724817600, 1724821200),
+	(5, 1724821200, 1724824800),
+	(5, 1724824800, 1724828400),
+	(5, 1724828400, 1724832000),
+	(5, 1724832000, 1724835600),
+	(5, 1724835600, 1724839200),
+	(5, 1724839200, 1724842800),
+	(5, 1724842800, 1724846400),
+	(5, 1724846400, 1724850000),
+	(5, 1724850000, 1724853600),
+	(5, 1724853600, 1724857200),
+	(5, 1724857200, 1724860800),
+	(5, 1724860800, 1724864400),
+	(5, 1724864400, 1724868000),
+	(5, 1724868000, 1724871600),
+	(5, 1724871600, 1724875200),
+	(5, 1724875200, 1724878800),
+	(5, 1724878800, 1724882400),
+	(5, 1724882400, 1724886000),
+	(5, 1724886000, 1724889600),
+	(5, 1724889600, 1724893200),
+	(5, 1724893200, 1724896800),
+	(5, 1724896800, 1724900400),
+	(5, 1724900400, 1724904000),
+	(5, 1724904000, 1724907600),
+	(5, 1724907600, 1724911200),
+	(5, 1724911200, 1724914800),
+	(5, 1724914800, 1724918400),
+	(5, 1724918400, 1724922000),
+	(5, 1724922000, 1724925600),
+	(5, 1724925600, 1724929200),
+	(5, 1724929200, 1724932800),
+	(5, 1724932800, 1724936400),
+	(5, 1724936400, 1724940000),
+	(5, 1724940000, 1724943600),
+	(5, 1724943600, 1724947200),
+	(5, 1724947200, 1724950800),
+	(5, 1724950800, 1724954400),
+	(5, 1724954400, 1724958000),
+	(5, 1724958000, 1724961600),
+	(5, 1724961600, 1724965200),
+	(5, 1724965200, 1724968800),
+	(5, 1724968800, 1724972400),
+	(5, 1724972400, 1724976000),
+	(5, 1724976000, 1724979600),
+	(5, 1724979600, 1724983200),
+	(5, 1724983200, 1724986800),
+	(5, 1724986800, 1724990400),
+	(5, 1724990400, 1724994000),
+	(5, 1724994000, 1724997600),
+	(5, 1724997600, 1725001200),
+	(5, 1725001200, 1725004800),
+	(5, 1725004800, 1725008400),
+	(5, 1725008400, 1725012000),
+	(5, 1725012000, 1725015600),
+	(5, 1725015600, 1725019200),
+	(5, 1725019200, 1725022800),
+	(5, 1725022800, 1725026400),
+	(5, 1725026400, 1725030000),
+	(5, 1725030000, 1725033600),
+	(5, 1725033600, 1725037200),
+	(5, 1725037200, 1725040800),
+	(5, 1725040800, 1725044400),
+	(5, 1725044400, 1725048000),
+	(5, 1725048000, 1725051600),
+	(5, 1725051600, 1725055200),
+	(5, 1725055200, 1725058800),
+	(5, 1725058800, 1725062400),
+	(5, 1725062400, 1725066000),
+	(5, 1725066000, 1725069600),
+	(5, 1725069600, 1725073200),
+	(5, 1725073200, 1725076800),
+	(5, 1725076800, 1725080400),
+	(5, 1725080400, 1725084000),
+	(5, 1725084000, 1725087600),
+	(5, 1725087600, 1725091200),
+	(5, 1725091200, 1725094800),
+	(5, 1725094800, 1725098400),
+	(5, 1725098400, 1725102000),
+	(5, 1725102000, 1725105600),
+	(5, 1725105600, 1725109200),
+	(5, 1725109200, 1725112800),
+	(5, 1725112800, 1725116400),
+	(5, 1725116400, 1725120000),
+	(5, 1725120000, 1725123600),
+	(5, 1725123600, 1725127200),
+	(5, 1725127200, 1725130800),
+	(5, 1725130800, 1725134400),
+	(5, 1725134400, 1725138000),
+	(5, 1725138000, 1725141600),
+	(5, 1725141600, 1725145200),
+	(5, 1725145200, 1725148800),
+	(5, 1725148800, 1725152400),
+	(5, 1725152400, 1725156000),
+	(5, 1725156000, 1725159600),
+	(5, 1725159600, 1725163200),
+	(5, 1725163200, 1725166800),
+	(5, 1725166800, 1725170400),
+	(5, 1725170400, 1725174000),
+	(5, 1725174000, 1725177600),
+	(5, 1725177600, 1725181200),
+	(5, 1725181200, 1725184800),
+	(5, 1725184800, 1725188400),
+	(5, 1725188400, 1725192000),
+	(5, 1725192000, 1725195600),
+	(5, 1725195600, 1725199200),
+	(5, 1725199200, 1725202800),
+	(5, 1725202800, 1725206400),
+	(5, 1725206400, 1725210000),
+	(5, 1725210000, 1725213600),
+	(5, 1725213600, 1725217200),
+	(5, 1725217200, 1725220800),
+	(5, 1725220800, 1725224400),
+	(5, 1725224400, 1725228000),
+	(5, 1725228000, 1725231600),
+	(5, 1725231600, 1725235200),
+	(5, 1725235200, 1725238800),
+	(5, 1725238800, 1725242400),
+	(5, 1725242400, 1725246000),
+	(5, 1725246000, 1725249600),
+	(5, 1725249600, 1725253200),
+	(5, 1725253200, 1725256800),
+	(5, 1725256800, 1725260400),
+	(5, 1725260400, 1725264000),
+	(5, 1725264000, 1725267600),
+	(5, 1725267600, 1725271200),
+	(5, 1725271200, 1725274800),
+	(5, 1725274800, 1725278400),
+	(5, 1725278400, 1725282000),
+	(5, 1725282000, 1725285600),
+	(5, 1725285600, 1725289200),
+	(5, 1725289200, 1725292800),
+	(5, 1725292800, 1725296400),
+	(5, 1725296400, 1725300000),
+	(5, 1725300000, 1725303600),
+	(5, 1725303600, 1725307200),
+	(5, 1725307200, 1725310800),
+	(5, 1725310800, 1725314400),
+	(5, 1725314400, 1725318000),
+	(5, 1725318000, 1725321600),
+	(5, 1725321600, 1725325200),
+	(5, 1725325200, 1725328800),
+	(5, 1725328800, 1725332400),
+	(5, 1725332400, 1725336000),
+	(5, 1725336000, 1725339600),
+	(5, 1725339600, 1725343200),
+	(5, 1725343200, 1725346800),
+	(5, 1725346800, 1725350400),
+	(5, 1725350400, 1725354000),
+	(5, 1725354000, 1725357600),
+	(5, 1725357600, 1725361200),
+	(5, 1725361200, 1725364800),
+	(5, 1725364800, 1725368400),
+	(5, 1725368400, 1725372000),
+	(5, 1725372000, 1725375600),
+	(5, 1725375600, 1725379200),
+	(5, 1725379200, 1725382800),
+	(5, 1725382800, 1725386400),
+	(5, 1725386400, 1725390000),
+	(5, 1725390000, 1725393600),
+	(5, 1725393600, 1725397200),
+	(5, 1725397200, 1725400800),
+	(5, 1725400800, 1725404400),
+	(5, 1725404400, 1725408000),
+	(5, 1725408000, 1725411600),
+	(5, 1725411600, 1725415200),
+	(5, 1725415200, 1725418800),
+	(5, 1725418800, 1725422400),
+	(5, 1725422400, 1725426000),
+	(5, 1725426000, 1725429600),
+	(5, 1725429600, 1725433200),
+	(5, 1725433200, 1725436800),
+	(5, 1725436800, 1725440400),
+	(5, 1725440400, 1725444000),
+	(5, 1725444000, 1725447600),
+	(5, 1725447600, 1725451200),
+	(5, 1725451200, 1725454800),
+	(5, 1725454800, 1725458400),
+	(5, 1725458400, 1725462000),
+	(5, 1725462000, 1725465600),
+	(5, 1725465600, 1725469200),
+	(5, 1725469200, 1725472800),
+	(5, 1725472800, 1725476400),
+	(5, 1725476400, 1725480000),
+	(5, 1725480000, 1725483600),
+	(5, 1725483600, 1725487200),
+	(5, 1725487200, 1725490800),
+	(5, 1725490800, 1725494400),
+	(5, 1725494400, 1725498000),
+	(5, 1725498000, 1725501600),
+	(5, 1725501600, 1725505200),
+	(5, 1725505200, 1725508800),
+	(5, 1725508800, 1725512400),
+	(5, 1725512400, 1725516000),
+	(5, 1725516000, 1725519600),
+	(5, 1725519600, 1725523200),
+	(5, 1725523200, 1725526800),
+	(5, 1725526800, 1725530400),
+	(5, 1725530400, 1725534000),
+	(5, 1725534000, 1725537600),
+	(5, 1725537600, 1725541200),
+	(5, 1725541200, 1725544800),
+	(5, 1725544800, 1725548400),
+	(5, 1725548400, 1725552000),
+	(5, 1725552000, 1725555600),
+	(5, 1725555600, 1725559200),
+	(5, 1725559200, 1725562800),
+	(5, 1725562800, 1725566400),
+	(5, 1725566400, 1725570000),
+	(5, 1725570000, 1725573600),
+	(5, 1725573600, 1725577200),
+	(5, 1725577200, 1725580800),
+	(5, 1725580800, 1725584400),
+	(5, 1725584400, 1725588000),
+	(5, 1725588000, 1725591600),
+	(5, 1725591600, 1725595200),
+	(5, 1725595200, 1725598800),
+	(5, 1725598800, 1725602400),
+	(5, 1725602400, 1725606000),
+	(5, 1725606000, 1725609600),
+	(5, 1725609600, 1725613200),
+	(5, 1725613200, 1725616800),
+	(5, 1725616800, 1725620400),
+	(5, 1725620400, 1725624000),
+	(5, 1725624000, 1725627600),
+	(5, 1725627600, 1725631200),
+	(5, 1725631200, 1725634800),
+	(5, 1725634800, 1725638400),
+	(5, 1725638400, 1725642000),
+	(5, 1725642000, 1725645600),
+	(5, 1725645600, 1725649200),
+	(5, 1725649200, 1725652800),
+	(5, 1725652800, 1725656400),
+	(5, 1725656400, 1725660000),
+	(5, 1725660000, 1725663600),
+	(5, 1725663600, 1725667200),
+	(5, 1725667200, 1725670800),
+	(5, 1725670800, 1725674400),
+	(5, 1725674400, 1725678000),
+	(5, 1725678000, 1725681600),
+	(5, 1725681600, 1725685200),
+	(5, 1725685200, 1725688800),
+	(5, 1725688800, 1725692400),
+	(5, 1725692400, 1725696000),
+	(5, 1725696000, 1725699600),
+	(5, 1725699600, 1725703200),
+	(5, 1725703200, 1725706800),
+	(5, 1725706800, 1725710400),
+	(5, 1725710400, 1725714000),
+	(5, 1725714000, 1725717600),
+	(5, 1725717600, 1725721200),
+	(5, 1725721200, 1725724800),
+	(5, 1725724800, 1725728400),
+	(5, 1725728400, 1725732000),
+	(5, 1725732000, 1725735600),
+	(5, 1725735600, 1725739200),
+	(5, 1725739200, 1725742800),
+	(5, 1725742800, 1725746400),
+	(5, 1725746400, 1725750000),
+	(5, 1725750000, 1725753600),
+	(5, 1725753600, 1725757200),
+	(5, 1725757200, 1725760800),
+	(5, 1725760800, 1725764400),
+	(5, 1725764400, 1725768000),
+	(5, 1725768000, 1725771600),
+	(5, 1725771600, 1725775200),
+	(5, 1725775200, 1725778800),
+	(5, 1725778800, 1725782400),
+	(5, 1725782400, 1725786000),
+	(5, 1725786000, 1725789600),
+	(5, 1725789600, 1725793200),
+	(5, 1725793200, 1725796800),
+	(5, 1725796800, 1725800400),
+	(5, 1725800400, 1725804000),
+	(5, 1725804000, 1725807600),
+	(5, 1725807600, 1725811200),
+	(5, 1725811200, 1725814800),
+	(5, 1725814800, 1725818400),
+	(5, 1725818400, 1725822000),
+	(5, 1725822000, 1725825600),
+	(5, 1725825600, 1725829200),
+	(5, 1725829200, 1725832800),
+	(5, 1725832800, 1725836400),
+	(5, 1725836400, 1725840000),
+	(5, 1725840000, 1725843600),
+	(5, 1725843600, 1725847200),
+	(5, 1725847200, 1725850800),
+	(5, 1725850800, 1725854400),
+	(5, 1725854400, 1725858000),
+	(5, 1725858000, 1725861600),
+	(5, 1725861600, 1725865200),
+	(5, 1725865200, 1725868800),
+	(5, 1725868800, 1725872400),
+	(5, 1725872400, 1725876000),
+	(5, 1725876000, 1725879600),
+	(5, 1725879600, 1725883200),
+	(5, 1725883200, 1725886800),
+	(5, 1725886800, 1725890400),
+	(5, 1725890400, 1725894000),
+	(5, 1725894000, 1725897600),
+	(5, 1725897600, 1725901200),
+	(5, 1725901200, 1725904800),
+	(5, 1725904800, 1725908400),
+	(5, 1725908400, 1725912000),
+	(5, 1725912000, 1725915600),
+	(5, 1725915600, 1725919200),
+	(5, 1725919200, 1725922800),
+	(5, 1725922800, 1725926400),
+	(5, 1725926400, 1725930000),
+	(5, 1725930000, 1725933600),
+	(5, 1725933600, 1725937200),
+	(5, 1725937200, 1725940800),
+	(5, 1725940800, 1725944400),
+	(5, 1725944400, 1725948000),
+	(5, 1725948000, 1725951600),
+	(5, 1725951600, 1725955200),
+	(5, 1725955200, 1725958800),
+	(5, 1725958800, 1725962400),
+	(5, 1725962400, 1725966000),
+	(5, 1725966000, 1725969600),
+	(5, 1725969600, 1725973200),
+	(5, 1725973200, 1725976800),
+	(5, 1725976800, 1725980400),
+	(5, 1725980400, 1725984000),
+	(5, 1725984000, 1725987600),
+	(5, 1725987600, 1725991200),
+	(5, 1725991200, 1725994800),
+	(5, 1725994800, 1725998400),
+	(5, 1725998400, 1726002000),
+	(5, 1726002000, 1726005600),
+	(5, 1726005600, 1726009200),
+	(5, 1726009200, 1726012800),
+	(5, 1726012800, 1726016400),
+	(5, 1726016400, 1726020000),
+	(5, 1726020000, 1726023600),
+	(5, 1726023600, 1726027200),
+	(5, 1726027200, 1726030800),
+	(5, 1726030800, 1726034400),
+	(5, 1726034400, 1726038000),
+	(5, 1726038000, 1726041600),
+	(5, 1726041600, 1726045200),
+	(5, 1726045200, 1726048800),
+	(5, 1726048800, 1726052400),
+	(5, 1726052400, 1726056000),
+	(5, 1726056000, 1726059600),
+	(5, 1726059600, 1726063200),
+	(5, 1726063200, 1726066800),
+	(5, 1726066800, 1726070400),
+	(5, 1726070400, 1726074000),
+	(5, 1726074000, 1726077600),
+	(5, 1726077600, 1726081200),
+	(5, 1726081200, 1726084800),
+	(5, 1726084800, 1726088400),
+	(5, 1726088400, 1726092000),
+	(5, 1726092000, 1726095600),
+	(5, 1726095600, 1726099200),
+	(5, 1726099200, 1726102800),
+	(5, 1726102800, 1726106400),
+	(5, 1726106400, 1726110000),
+	(5, 1726110000, 1726113600),
+	(5, 1726113600, 1726117200),
+	(5, 1726117200, 1726120800),
+	(5, 1726120800, 1726124400),
+	(5, 1726124400, 1726128000),
+	(5, 1726128000, 1726131600),
+	(5, 1726131600, 1726135200),
+	(5, 1726135200, 1726138800),
+	(5, 1726138800, 1726142400),
+	(5, 1726142400, 1726146000),
+	(5, 1726146000, 1726149600),
+	(5, 1726149600, 1726153200),
+	(5, 1726153200, 1726156800),
+	(5, 1726156800, 1726160400),
+	(5, 1726160400, 1726164000),
+	(5, 1726164000, 1726167600),
+	(5, 1726167600, 1726171200),
+	(5, 1726171200, 1726174800),
+	(5, 1726174800, 1726178400),
+	(5, 1726178400, 1726182000),
+	(5, 1726182000, 1726185600),
+	(5, 1726185600, 1726189200),
+	(5, 1726189200, 1726192800),
+	(5, 1726192800, 1726196400),
+	(5, 1726196400, 1726200000),
+	(5, 1726200000, 1726203600),
+	(5, 1726203600, 1726207200),
+	(5, 1726207200, 1726210800),
+	(5, 1726210800, 1726214400),
+	(5, 1726214400, 1726218000),
+	(5, 1726218000, 1726221600),
+	(5, 1726221600, 1726225200),
+	(5, 1726225200, 1726228800),
+	(5, 1726228800, 1726232400),
+	(5, 1726232400, 1726236000),
+	(5, 1726236000, 1726239600),
+	(5, 1726239600, 1726243200),
+	(5, 1726243200, 1726246800),
+	(5, 1726246800, 1726250400),
+	(5, 1726250400, 1726254000),
+	(5, 1726254000, 1726257600),
+	(5, 1726257600, 1726261200),
+	(5, 1726261200, 1726264800),
+	(5, 1726264800, 1726268400),
+	(5, 1726268400, 1726272000),
+	(5, 1726272000, 1726275600),
+	(5, 1726275600, 1726279200),
+	(5, 1726279200, 1726282800),
+	(5, 1726282800, 1726286400),
+	(5, 1726286400, 1726290000),
+	(5, 1726290000, 1726293600),
+	(5, 1726293600, 1726297200),
+	(5, 1726297200, 1726300800),
+	(5, 1726300800, 1726304400),
+	(5, 1726304400, 1726308000),
+	(5, 1726308000, 1726311600),
+	(5, 1726311600, 1726315200),
+	(5, 1726315200, 1726318800),
+	(5, 1726318800, 1726322400),
+	(5, 1726322400, 1726326000),
+	(5, 1726326000, 1726329600),
+	(5, 1726329600, 1726333200),
+	(5, 1726333200, 1726336800),
+	(5, 1726336800, 1726340400),
+	(5, 1726340400, 1726344000),
+	(5, 1726344000, 1726347600),
+	(5, 1726347600, 1726351200),
+	(5, 1726351200, 1726354800),
+	(5, 1726354800, 1726358400),
+	(5, 1726358400, 1726362000),
+	(5, 1726362000, 1726365600),
+	(5, 1726365600, 1726369200),
+	(5, 1726369200, 1726372800),
+	(5, 1726372800, 1726376400),
+	(5, 1726376400, 1726380000),
+	(5, 1726380000, 1726383600),
+	(5, 1726383600, 1726387200),
+	(5, 1726387200, 1726390800),
+	(5, 1726390800, 1726394400),
+	(5, 1726394400, 1726398000),
+	(5, 1726398000, 1726401600),
+	(5, 1726401600, 1726405200),
+	(5, 1726405200, 1726408800),
+	(5, 1726408800, 1726412400),
+	(5, 1726412400, 1726416000),
+	(5, 1726416000, 1726419600),
+	(5, 1726419600, 1726423200),
+	(5, 1726423200, 1726426800),
+	(5, 1726426800, 1726430400),
+	(5, 1726430400, 1726434000),
+	(5, 1726434000, 1726437600),
+	(5, 1726437600, 1726441200),
+	(5, 1726441200, 1726444800),
+	(5, 1726444800, 1726448400),
+	(5, 1726448400, 1726452000),
+	(5, 1726452000, 1726455600),
+	(5, 1726455600, 1726459200),
+	(5, 1726459200, 1726462800),
+	(5, 1726462800, 1726466400),
+	(5, 1726466400, 1726470000),
+	(5, 1726470000, 1726473600),
+	(5, 1726473600, 1726477200),
+	(5, 1726477200, 1726480800),
+	(5, 1726480800, 1726484400),
+	(5, 1726484400, 1726488000),
+	(5, 1726488000, 1726491600),
+	(5, 1726491600, 1726495200),
+	(5, 1726495200, 1726498800),
+	(5, 1726498800, 1726502400),
+	(5, 1726502400, 1726506000),
+	(5, 1726506000, 1726509600),
+	(5, 1726509600, 1726513200),
+	(5, 1726513200, 1726516800),
+	(5, 1726516800, 1726520400),
+	(5, 1726520400, 1726524000),
+	(5, 1726524000, 1726527600),
+	(5, 1726527600, 1726531200),
+	(5, 1726531200, 1726534800),
+	(5, 1726534800, 1726538400),
+	(5, 1726538400, 1726542000),
+	(5, 1726542000, 1726545600),
+	(5, 1726545600, 1726549200),
+	(5, 1726549200, 1726552800),
+	(5, 1726552800, 1726556400),
+	(5, 1726556400, 1726560000),
+	(5, 1726560000, 1726563600),
+	(5, 1726563600, 1726567200),
+	(5, 1726567200, 1726570800),
+	(5, 1726570800, 1726574400),
+	(5, 1726574400, 1726578000),
+	(5, 1726578000, 1726581600),
+	(5, 1726581600, 1726585200),
+	(5, 1726585200, 1726588800),
+	(5, 1726588800, 1726592400),
+	(5, 1726592400, 1726596000),
+	(5, 1726596000, 1726599600),
+	(5, 1726599600, 1726603200),
+	(5, 1726603200, 1726606800),
+	(5, 1726606800, 1726610400),
+	(5, 1726610400, 1726614000),
+	(5, 1726614000, 1726617600),
+	(5, 1726617600, 1726621200),
+	(5, 1726621200, 1726624800),
+	(5, 1726624800, 1726628400),
+	(5, 1726628400, 1726632000),
+	(5, 1726632000, 1726635600),
+	(5, 1726635600, 1726639200),
+	(5, 1726639200, 1726642800),
+	(5, 1726642800, 1726646400),
+	(5, 1726646400, 1726650000),
+	(5, 1726650000, 1726653600),
+	(5, 1726653600, 1726657200),
+	(5, 1726657200, 1726660800),
+	(5, 1726660800, 1726664400),
+	(5, 1726664400, 1726668000),
+	(5, 1726668000, 1726671600),
+	(5, 1726671600, 1726675200),
+	(5, 1726675200, 1726678800),
+	(5, 1726678800, 1726682400),
+	(5, 1726682400, 1726686000),
+	(5, 1726686000, 1726689600),
+	(5, 1726689600, 1726693200),
+	(5, 1726693200, 1726696800),
+	(5, 1726696800, 1726700400),
+	(5, 1726700400, 1726704000),
+	(5, 1726704000, 1726707600),
+	(5, 1726707600, 1726711200),
+	(5, 1726711200, 1726714800),
+	(5, 1726714800, 1726718400),
+	(5, 1726718400, 1726722000),
+	(5, 1726722000, 1726725600),
+	(5, 1726725600, 1726729200),
+	(5, 1726729200, 1726732800),
+	(5, 1726732800, 1726736400),
+	(5, 1726736400, 1726740000),
+	(5, 1726740000, 1726743600),
+	(5, 1726743600, 1726747200),
+	(5, 1726747200, 1726750800),
+	(5, 1726750800, 1726754400),
+	(5, 1726754400, 1726758000),
+	(5, 1726758000, 1726761600),
+	(5, 1726761600, 1726765200),
+	(5, 1726765200, 1726768800),
+	(5, 1726768800, 1726772400),
+	(5, 1726772400, 1726776000),
+	(5, 1726776000, 1726779600),
+	(5, 1726779600, 1726783200),
+	(5, 1726783200, 1726786800),
+	(5, 1726786800, 1726790400),
+	(5, 1726790400, 1726794000),
+	(5, 1726794000, 1726797600),
+	(5, 1726797600, 1726801200),
+	(5, 1726801200, 1726804800),
+	(5, 1726804800, 1726808400),
+	(5, 1726808400, 1726812000),
+	(5, 1726812000, 1726815600),
+	(5, 1726815600, 1726819200),
+	(5, 1726819200, 1726822800),
+	(5, 1726822800, 1726826400),
+	(5, 1726826400, 1726830000),
+	(5, 1726830000, 1726833600),
+	(5, 1726833600, 1726837200),
+	(5, 1726837200, 1726840800),
+	(5, 1726840800, 1726844400),
+	(5, 1726844400, 1726848000),
+	(5, 1726848000, 1726851600),
+	(5, 1726851600, 1726855200),
+	(5, 1726855200, 1726858800),
+	(5, 1726858800, 1726862400),
+	(5, 1726862400, 1726866000),
+	(5, 1726866000, 1726869600),
+	(5, 1726869600, 1726873200),
+	(5, 1726873200, 1726876800),
+	(5, 1726876800, 1726880400),
+	(5, 1726880400, 1726884000),
+	(5, 1726884000, 1726887600),
+	(5, 1726887600, 1726891200),
+	(5, 1726891200, 1726894800),
+	(5, 1726894800, 1726898400),
+	(5, 1726898400, 1726902000),
+	(5, 1726902000, 1726905600),
+	(5, 1726905600, 1726909200),
+	(5, 1726909200, 1726912800),
+	(5, 1726912800, 1726916400),
+	(5, 1726916400, 1726920000),
+	(5, 1726920000, 1726923600),
+	(5, 1726923600, 1726927200),
+	(5, 1726927200, 1726930800),
+	(5, 1726930800, 1726934400),
+	(5, 1726934400, 1726938000),
+	(5, 1726938000, 1726941600),
+	(5, 1726941600, 1726945200),
+	(5, 1726945200, 1726948800),
+	(5, 1726948800, 1726952400),
+	(5, 1726952400, 1726956000),
+	(5, 1726956000, 1726959600),
+	(5, 1726959600, 1726963200),
+	(5, 1726963200, 1726966800),
+	(5, 1726966800, 1726970400),
+	(5, 1726970400, 1726974000),
+	(5, 1726974000, 1726977600),
+	(5, 1726977600, 1726981200),
+	(5, 1726981200, 1726984800),
+	(5, 1726984800, 1726988400),
+	(5, 1726988400, 1726992000),
+	(5, 1726992000, 1726995600),
+	(5, 1726995600, 1726999200),
+	(5, 1726999200, 1727002800),
+	(5, 1727002800, 1727006400),
+	(5, 1727006400, 1727010000),
+	(5, 1727010000, 1727013600),
+	(5, 1727013600, 1727017200),
+	(5, 1727017200, 1727020800),
+	(5, 1727020800, 1727024400),
+	(5, 1727024400, 1727028000),
+	(5, 1727028000, 1727031600),
+	(5, 1727031600, 1727035200),
+	(5, 1727035200, 1727038800),
+	(5, 1727038800, 1727042400),
+	(5, 1727042400, 1727046000),
+	(5, 1727046000, 1727049600),
+	(5, 1727049600, 1727053200),
+	(5, 1727053200, 1727056800),
+	(5, 1727056800, 1727060400),
+	(5, 1727060400, 1727064000),
+	(5, 1727064000, 1727067600),
+	(5, 1727067600, 1727071200),
+	(5, 1727071200, 1727074800),
+	(5, 1727074800, 1727078400),
+	(5, 1727078400, 1727082000),
+	(5, 1727082000, 1727085600),
+	(5, 1727085600, 1727089200),
+	(5, 1727089200, 1727092800),
+	(5, 1727092800, 1727096400),
+	(5, 1727096400, 1727100000),
+	(5, 1727100000, 1727103600),
+	(5, 1727103600, 1727107200),
+	(5, 1727107200, 1727110800),
+	(5, 1727110800, 1727114400),
+	(5, 1727114400, 1727118000),
+	(5, 1727118000, 1727121600),
+	(5, 1727121600, 1727125200),
+	(5, 1727125200, 1727128800),
+	(5, 1727128800, 1727132400),
+	(5, 1727132400, 1727136000),
+	(5, 1727136000, 1727139600),
+	(5, 1727139600, 1727143200),
+	(5, 1727143200, 1727146800),
+	(5, 1727146800, 1727150400),
+	(5, 1727150400, 1727154000),
+	(5, 1727154000, 1727157600),
+	(5, 1727157600, 1727161200),
+	(5, 1727161200, 1727164800),
+	(5, 1727164800, 1727168400),
+	(5, 1727168400, 1727172000),
+	(5, 1727172000, 1727175600),
+	(5, 1727175600, 1727179200),
+	(5, 1727179200, 1727182800),
+	(5, 1727182800, 1727186400),
+	(5, 1727186400, 1727190000),
+	(5, 1727190000, 1727193600),
+	(5, 1727193600, 1727197200),
+	(5, 1727197200, 1727200800),
+	(5, 1727200800, 1727204400),
+	(5, 1727204400, 1727208000),
+	(5, 1727208000, 1727211600),
+	(5, 1727211600, 1727215200),
+	(5, 1727215200, 1727218800),
+	(5, 1727218800, 1727222400),
+	(5, 1727222400, 1727226000),
+	(5, 1727226000, 1727229600),
+	(5, 1727229600, 1727233200),
+	(5, 1727233200, 1727236800),
+	(5, 1727236800, 1727240400),
+	(5, 1727240400, 1727244000),
+	(5, 1727244000, 1727247600),
+	(5, 1727247600, 1727251200),
+	(5, 1727251200, 1727254800),
+	(5, 1727254800, 1727258400),
+	(5, 1727258400, 1727262000),
+	(5, 1727262000, 1727265600),
+	(5, 1727265600, 1727269200),
+	(5, 1727269200, 1727272800),
+	(5, 1727272800, 1727276400),
+	(5, 1727276400, 1727280000),
+	(5, 1727280000, 1727283600),
+	(5, 1727283600, 1727287200),
+	(5, 1727287200, 1727290800),
+	(5, 1727290800, 1727294400),
+	(5, 1727294400, 1727298000),
+	(5, 1727298000, 1727301600),
+	(5, 1727301600, 1727305200),
+	(5, 1727305200, 1727308800),
+	(5, 1727308800, 1727312400),
+	(5, 1727312400, 1727316000),
+	(5, 1727316000, 1727319600),
+	(5, 1727319600, 1727323200),
+	(5, 1727323200, 1727326800),
+	(5, 1727326800, 1727330400),
+	(5, 1727330400, 1727334000),
+	(5, 1727334000, 1727337600),
+	(5, 1727337600, 1727341200),
+	(5, 1727341200, 1727344800),
+	(5, 1727344800, 1727348400),
+	(5, 1727348400, 1727352000),
+	(5, 1727352000, 1727355600),
+	(5, 1727355600, 1727359200),
+	(5, 1727359200, 1727362800),
+	(5, 1727362800, 1727366400),
+	(5, 1727366400, 1727370000),
+	(5, 1727370000, 1727373600),
+	(5, 1727373600, 1727377200),
+	(5, 1727377200, 1727380800),
+	(5, 1727380800, 1727384400),
+	(5, 1727384400, 1727388000),
+	(5, 1727388000, 1727391600),
+	(5, 1727391600, 1727395200),
+	(5, 1727395200, 1727398800),
+	(5, 1727398800, 1727402400),
+	(5, 1727402400, 1727406000),
+	(5, 1727406000, 1727409600),
+	(5, 1727409600, 1727413200),
+	(5, 1727413200, 1727416800),
+	(5, 1727416800, 1727420400),
+	(5, 1727420400, 1727424000),
+	(5, 1727424000, 1727427600),
+	(5, 1727427600, 1727431200),
+	(5, 1727431200, 1727434800),
+	(5, 1727434800, 1727438400),
+	(5, 1727438400, 1727442000),
+	(5, 1727442000, 1727445600),
+	(5, 1727445600, 1727449200),
+	(5, 1727449200, 1727452800),
+	(5, 1727452800, 1727456400),
+	(5, 1727456400, 1727460000),
+	(5, 1727460000, 1727463600),
+	(5, 1727463600, 1727467200),
+	(5, 1727467200, 1727470800),
+	(5, 1727470800, 1727474400),
+	(5, 1727474400, 1727478000),
+	(5, 1727478000, 1727481600),
+	(5, 1727481600, 1727485200),
+	(5, 1727485200, 1727488800),
+	(5, 1727488800, 1727492400),
+	(5, 1727492400, 1727496000),
+	(5, 1727496000, 1727499600),
+	(5, 1727499600, 1727503200),
+	(5, 1727503200, 1727506800),
+	(5, 1727506800, 1727510400),
+	(5, 1727510400, 1727514000),
+	(5, 1727514000, 1727517600),
+	(5, 1727517600, 1727521200),
+	(5, 1727521200, 1727524800),
+	(5, 1727524800, 1727528400),
+	(5, 1727528400, 1727532000),
+	(5, 1727532000, 1727535600),
+	(5, 1727535600, 1727539200),
+	(5, 1727539200, 1727542800),
+	(5, 1727542800, 1727546400),
+	(5, 1727546400, 1727550000),
+	(5, 1727550000, 1727553600),
+	(5, 1727553600, 1727557200),
+	(5, 1727557200, 1727560800),
+	(5, 1727560800, 1727564400),
+	(5, 1727564400, 1727568000),
+	(5, 1727568000, 1727571600),
+	(5, 1727571600, 1727575200),
+	(5, 1727575200, 1727578800),
+	(5, 1727578800, 1727582400),
+	(5, 1727582400, 1727586000),
+	(5, 1727586000, 1727589600),
+	(5, 1727589600, 1727593200),
+	(5, 1727593200, 1727596800),
+	(5, 1727596800, 1727600400),
+	(5, 1727600400, 1727604000),
+	(5, 1727604000, 1727607600),
+	(5, 1727607600, 1727611200),
+	(5, 1727611200, 1727614800),
+	(5, 1727614800, 1727618400),
+	(5, 1727618400, 1727622000),
+	(5, 1727622000, 1727625600),
+	(5, 1727625600, 1727629200),
+	(5, 1727629200, 1727632800),
+	(5, 1727632800, 1727636400),
+	(5, 1727636400, 1727640000),
+	(5, 1727640000, 1727643600),
+	(5, 1727643600, 1727647200),
+	(5, 1727647200, 1727650800),
+	(5, 1727650800, 1727654400),
+	(5, 1727654400, 1727658000),
+	(5, 1727658000, 1727661600),
+	(5, 1727661600, 1727665200),
+	(5, 1727665200, 1727668800),
+	(5, 1727668800, 1727672400),
+	(5, 1727672400, 1727676000),
+	(5, 1727676000, 1727679600),
+	(5, 1727679600, 1727683200),
+	(5, 1727683200, 1727686800),
+	(5, 1727686800, 1727690400),
+	(5, 1727690400, 1727694000),
+	(5, 1727694000, 1727697600),
+	(5, 1727697600, 1727701200),
+	(5, 1727701200, 1727704800),
+	(5, 1727704800, 1727708400),
+	(5, 1727708400, 1727712000),
+	(5, 1727712000, 1727715600),
+	(5, 1727715600, 1727719200),
+	(5, 1727719200, 1727722800),
+	(5, 1727722800, 1727726400),
+	(5, 1727726400, 1727730000),
+	(5, 1727730000, 1727733600),
+	(5, 1727733600, 1727737200),
+	(5, 1727737200, 1727740800),
+	(5, 1727740800, 1727744400),
+	(5, 1727744400, 1727748000),
+	(5, 1727748000, 1727751600),
+	(5, 1727751600, 1727755200),
+	(5, 1727755200, 1727758800),
+	(5, 1727758800, 1727762400),
+	(5, 1727762400, 1727766000),
+	(5, 1727766000, 1727769600),
+	(5, 1727769600, 1727773200),
+	(5, 1727773200, 1727776800),
+	(5, 1727776800, 1727780400),
+	(5, 1727780400, 1727784000),
+	(5, 1727784000, 1727787600),
+	(5, 1727787600, 1727791200),
+	(5, 1727791200, 1727794800),
+	(5, 1727794800, 1727798400),
+	(5, 1727798400, 1727802000),
+	(5, 1727802000, 1727805600),
+	(5, 1727805600, 1727809200),
+	(5, 1727809200, 1727812800),
+	(5, 1727812800, 1727816400),
+	(5, 1727816400, 1727820000),
+	(5, 1727820000, 1727823600),
+	(5, 1727823600, 1727827200),
+	(5, 1727827200, 1727830800),
+	(5, 1727830800, 1727834400),
+	(5, 1727834400, 1727838000),
+	(5, 1727838000, 1727841600),
+	(5, 1727841600, 1727845200),
+	(5, 1727845200, 1727848800),
+	(5, 1727848800, 1727852400),
+	(5, 1727852400, 1727856000),
+	(5, 1727856000, 1727859600),
+	(5, 1727859600, 1727863200),
+	(5, 1727863200, 1727866800),
+	(5, 1727866800, 1727870400),
+	(5, 1727870400, 1727874000),
+	(5, 1727874000, 1727877600),
+	(5, 1727877600, 1727881200),
+	(5, 1727881200, 1727884800),
+	(5, 1727884800, 1727888400),
+	(5, 1727888400, 1727892000),
+	(5, 1727892000, 1727895600),
+	(5, 1727895600, 1727899200),
+	(5, 1727899200, 1727902800),
+	(5, 1727902800, 1727906400),
+	(5, 1727906400, 1727910000),
+	(5, 1727910000, 1727913600),
+	(5, 1727913600, 1727917200),
+	(5, 1727917200, 1727920800),
+	(5, 1727920800, 1727924400),
+	(5, 1727924400, 1727928000),
+	(5, 1727928000, 1727931600),
+	(5, 1727931600, 1727935200),
+	(5, 1727935200, 1727938800),
+	(5, 1727938800, 1727942400),
+	(5, 1727942400, 1727946000),
+	(5, 1727946000, 1727949600),
+	(5, 1727949600, 1727953200),
+	(5, 1727953200, 1727956800),
+	(5, 1727956800, 1727960400),
+	(5, 1727960400, 1727964000),
+	(5, 1727964000, 1727967600),
+	(5, 1727967600, 1727971200),
+	(5, 1727971200, 1727974800),
+	(5, 1727974800, 1727978400),
+	(5, 1727978400, 1727982000),
+	(5, 1727982000, 1727985600),
+	(5, 1727985600, 1727989200),
+	(5, 1727989200, 1727992800),
+	(5, 1727992800, 1727996400),
+	(5, 1727996400, 1728000000),
+	(5, 1728000000, 1728003600),
+	(5, 1728003600, 1728007200),
+	(5, 1728007200, 1728010800),
+	(5, 1728010800, 1728014400),
+	(5, 1728014400, 1728018000),
+	(5, 1728018000, 1728021600),
+	(5, 1728021600, 1728025200),
+	(5, 1728025200, 1728028800),
+	(5, 1728028800, 1728032400),
+	(5, 1728032400, 1728036000),
+	(5, 1728036000, 1728039600),
+	(5, 1728039600, 1728043200),
+	(5, 1728043200, 1728046800),
+	(5, 1728046800, 1728050400),
+	(5, 1728050400, 1728054000),
+	(5, 1728054000, 1728057600),
+	(5, 1728057600, 1728061200),
+	(5, 1728061200, 1728064800),
+	(5, 1728064800, 1728068400),
+	(5, 1728068400, 1728072000),
+	(5, 1728072000, 1728075600),
+	(5, 1728075600, 1728079200),
+	(5, 1728079200, 1728082800),
+	(5, 1728082800, 1728086400),
+	(5, 1728086400, 1728090000),
+	(5, 1728090000, 1728093600),
+	(5, 1728093600, 1728097200),
+	(5, 1728097200, 1728100800),
+	(5, 1728100800, 1728104400),
+	(5, 1728104400, 1728108000),
+	(5, 1728108000, 1728111600),
+	(5, 1728111600, 1728115200),
+	(5, 1728115200, 1728118800),
+	(5, 1728118800, 1728122400),
+	(5, 1728122400, 1728126000),
+	(5, 1728126000, 1728129600),
+	(5, 1728129600, 1728133200),
+	(5, 1728133200, 1728136800),
+	(5, 1728136800, 1728140400),
+	(5, 1728140400, 1728144000),
+	(5, 1728144000, 1728147600),
+	(5, 1728147600, 1728151200),
+	(5, 1728151200, 1728154800),
+	(5, 1728154800, 1728158400),
+	(5, 1728158400, 1728162000),
+	(5, 1728162000, 1728165600),
+	(5, 1728165600, 1728169200),
+	(5, 1728169200, 1728172800),
+	(5, 1728172800, 1728176400),
+	(5, 1728176400, 1728180000),
+	(5, 1728180000, 1728183600),
+	(5, 1728183600, 1728187200),
+	(5, 1728187200, 1728190800),
+	(5, 1728190800, 1728194400),
+	(5, 1728194400, 1728198000),
+	(5, 1728198000, 1728201600),
+	(5, 1728201600, 1728205200),
+	(5, 1728205200, 1728208800),
+	(5, 1728208800, 1728212400),
+	(5, 1728212400, 1728216000),
+	(5, 1728216000, 1728219600),
+	(5, 1728219600, 1728223200),
+	(5, 1728223200, 1728226800),
+	(5, 1728226800, 1728230400),
+	(5, 1728230400, 1728234000),
+	(5, 1728234000, 1728237600),
+	(5, 1728237600, 1728241200),
+	(5, 1728241200, 1728244800),
+	(5, 1728244800, 1728248400),
+	(5, 1728248400, 1728252000),
+	(5, 1728252000, 1728255600),
+	(5, 1728255600, 1728259200),
+	(5, 1728259200, 1728262800),
+	(5, 1728262800, 1728266400),
+	(5, 1728266400, 1728270000),
+	(5, 1728270000, 1728273600),
+	(5, 1728273600, 1728277200),
+	(5, 1728277200, 1728280800),
+	(5, 1728280800, 1728284400),
+	(5, 1728284400, 1728288000),
+	(5, 1728288000, 1728291600),
+	(5, 1728291600, 1728295200),
+	(5, 1728295200, 1728298800),
+	(5, 1728298800, 1728302400),
+	(5, 1728302400, 1728306000),
+	(5, 1728306000, 1728309600),
+	(5, 1728309600, 1728313200),
+	(5, 1728313200, 1728316800),
+	(5, 1728316800, 1728320400),
+	(5, 1728320400, 1728324000),
+	(5, 1728324000, 1728327600),
+	(5, 1728327600, 1728331200),
+	(5, 1728331200, 1728334800),
+	(5, 1728334800, 1728338400),
+	(5, 1728338400, 1728342000),
+	(5, 1728342000, 1728345600),
+	(5, 1728345600, 1728349200),
+	(5, 1728349200, 1728352800),
+	(5, 1728352800, 1728356400),
+	(5, 1728356400, 1728360000),
+	(5, 1728360000, 1728363600),
+	(5, 1728363600, 1728367200),
+	(5, 1728367200, 1728370800),
+	(5, 1728370800, 1728374400),
+	(5, 1728374400, 1728378000),
+	(5, 1728378000, 1728381600),
+	(5, 1728381600, 1728385200),
+	(5, 1728385200, 1728388800),
+	(5, 1728388800, 1728392400),
+	(5, 1728392400, 1728396000),
+	(5, 1728396000, 1728399600),
+	(5, 1728399600, 1728403200),
+	(5, 1728403200, 1728406800),
+	(5, 1728406800, 1728410400),
+	(5, 1728410400, 1728414000),
+	(5, 1728414000, 1728417600),
+	(5, 1728417600, 1728421200),
+	(5, 1728421200, 1728424800),
+	(5, 1728424800, 1728428400),
+	(5, 1728428400, 1728432000),
+	(5, 1728432000, 1728435600),
+	(5, 1728435600, 1728439200),
+	(5, 1728439200, 1728442800),
+	(5, 1728442800, 1728446400),
+	(5, 1728446400, 1728450000),
+	(5, 1728450000, 1728453600),
+	(5, 1728453600, 1728457200),
+	(5, 1728457200, 1728460800),
+	(5, 1728460800, 1728464400),
+	(5, 1728464400, 1728468000),
+	(5, 1728468000, 1728471600),
+	(5, 1728471600, 1728475200),
+	(5, 1728475200, 1728478800),
+	(5, 1728478800, 1728482400),
+	(5, 1728482400, 1728486000),
+	(5, 1728486000, 1728489600),
+	(5, 1728489600, 1728493200),
+	(5, 1728493200, 1728496800),
+	(5, 1728496800, 1728500400),
+	(5, 1728500400, 1728504000),
+	(5, 1728504000, 1728507600),
+	(5, 1728507600, 1728511200),
+	(5, 1728511200, 1728514800),
+	(5, 1728514800, 1728518400),
+	(5, 1728518400, 1728522000),
+	(5, 1728522000, 1728525600),
+	(5, 1728525600, 1728529200),
+	(5, 1728529200, 1728532800),
+	(5, 1728532800, 1728536400),
+	(5, 1728536400, 1728540000),
+	(5, 1728540000, 1728543600),
+	(5, 1728543600, 1728547200),
+	(5, 1728547200, 1728550800),
+	(5, 1728550800, 1728554400),
+	(5, 1728554400, 1728558000),
+	(5, 1728558000, 1728561600),
+	(5, 1728561600, 1728565200),
+	(5, 1728565200, 1728568800),
+	(5, 1728568800, 1728572400),
+	(5, 1728572400, 1728576000),
+	(5, 1728576000, 1728579600),
+	(5, 1728579600, 1728583200),
+	(5, 1728583200, 1728586800),
+	(5, 1728586800, 1728590400),
+	(5, 1728590400, 1728594000),
+	(5, 1728594000, 1728597600),
+	(5, 1728597600, 1728601200),
+	(5, 1728601200, 1728604800),
+	(5, 1728604800, 1728608400),
+	(5, 1728608400, 1728612000),
+	(5, 1728612000, 1728615600),
+	(5, 1728615600, 1728619200),
+	(5, 1728619200, 1728622800),
+	(5, 1728622800, 1728626400),
+	(5, 1728626400, 1728630000),
+	(5, 1728630000, 1728633600),
+	(5, 1728633600, 1728637200),
+	(5, 1728637200, 1728640800),
+	(5, 1728640800, 1728644400),
+	(5, 1728644400, 1728648000),
+	(5, 1728648000, 1728651600),
+	(5, 1728651600, 1728655200),
+	(5, 1728655200, 1728658800),
+	(5, 1728658800, 1728662400),
+	(5, 1728662400, 1728666000),
+	(5, 1728666000, 1728669600),
+	(5, 1728669600, 1728673200),
+	(5, 1728673200, 1728676800),
+	(5, 1728676800, 1728680400),
+	(5, 1728680400, 1728684000),
+	(5, 1728684000, 1728687600),
+	(5, 1728687600, 1728691200),
+	(5, 1728691200, 1728694800),
+	(5, 1728694800, 1728698400),
+	(5, 1728698400, 1728702000),
+	(5, 1728702000, 1728705600),
+	(5, 1728705600, 1728709200),
+	(5, 1728709200, 1728712800),
+	(5, 1728712800, 1728716400),
+	(5, 1728716400, 1728720000),
+	(5, 1728720000, 1728723600),
+	(5, 1728723600, 1728727200),
+	(5, 1728727200, 1728730800),
+	(5, 1728730800, 1728734400),
+	(5, 1728734400, 1728738000),
+	(5, 1728738000, 1728741600),
+	(5, 1728741600, 1728745200),
+	(5, 1728745200, 1728748800),
+	(5, 1728748800, 1728752400),
+	(5, 1728752400, 1728756000),
+	(5, 1728756000, 1728759600),
+	(5, 1728759600, 1728763200),
+	(5, 1728763200, 1728766800),
+	(5, 1728766800, 1728770400),
+	(5, 1728770400, 1728774000),
+	(5, 1728774000, 1728777600),
+	(5, 1728777600, 1728781200),
+	(5, 1728781200, 1728784800),
+	(5, 1728784800, 1728788400),
+	(5, 1728788400, 1728792000),
+	(5, 1728792000, 1728795600),
+	(5, 1728795600, 1728799200),
+	(5, 1728799200, 1728802800),
+	(5, 1728802800, 1728806400),
+	(5, 1728806400, 1728810000),
+	(5, 1728810000, 1728813600),
+	(5, 1728813600, 1728817200),
+	(5, 1728817200, 1728820800),
+	(5, 1728820800, 1728824400),
+	(5, 1728824400, 1728828000),
+	(5, 1728828000, 1728831600),
+	(5, 1728831600, 1728835200),
+	(5, 1728835200, 1728838800),
+	(5, 1728838800, 1728842400),
+	(5, 1728842400, 1728846000),
+	(5, 1728846000, 1728849600),
+	(5, 1728849600, 1728853200),
+	(5, 1728853200, 1728856800),
+	(5, 1728856800, 1728860400),
+	(5, 1728860400, 1728864000),
+	(5, 1728864000, 1728867600),
+	(5, 1728867600, 1728871200),
+	(5, 1728871200, 1728874800),
+	(5, 1728874800, 1728878400),
+	(5, 1728878400, 1728882000),
+	(5, 1728882000, 1728885600),
+	(5, 1728885600, 1728889200),
+	(5, 1728889200, 1728892800),
+	(5, 1728892800, 1728896400),
+	(5, 1728896400, 1728900000),
+	(5, 1728900000, 1728903600),
+	(5, 1728903600, 1728907200),
+	(5, 1728907200, 1728910800),
+	(5, 1728910800, 1728914400),
+	(5, 1728914400, 1728918000),
+	(5, 1728918000, 1728921600),
+	(5, 1728921600, 1728925200),
+	(5, 1728925200, 1728928800),
+	(5, 1728928800, 1728932400),
+	(5, 1728932400, 1728936000),
+	(5, 1728936000, 1728939600),
+	(5, 1728939600, 1728943200),
+	(5, 1728943200, 1728946800),
+	(5, 1728946800, 1728950400),
+	(5, 1728950400, 1728954000),
+	(5, 1728954000, 1728957600),
+	(5, 1728957600, 1728961200),
+	(5, 1728961200, 1728964800),
+	(5, 1728964800, 1728968400),
+	(5, 1728968400, 1728972000),
+	(5, 1728972000, 1728975600),
+	(5, 1728975600, 1728979200),
+	(5, 1728979200, 1728982800),
+	(5, 1728982800, 1728986400),
+	(5, 1728986400, 1728990000),
+	(5, 1728990000, 1728993600),
+	(5, 1728993600, 1728997200),
+	(5, 1728997200, 1729000800),
+	(5, 1729000800, 1729004400),
+	(5, 1729004400, 1729008000),
+	(5, 1729008000, 1729011600),
+	(5, 1729011600, 1729015200),
+	(5, 1729015200, 1729018800),
+	(5, 1729018800, 1729022400),
+	(5, 1729022400, 1729026000),
+	(5, 1729026000, 1729029600),
+	(5, 1729029600, 1729033200),
+	(5, 1729033200, 1729036800),
+	(5, 1729036800, 1729040400),
+	(5, 1729040400, 1729044000),
+	(5, 1729044000, 1729047600),
+	(5, 1729047600, 1729051200),
+	(5, 1729051200, 1729054800),
+	(5, 1729054800, 1729058400),
+	(5, 1729058400, 1729062000),
+	(5, 1729062000, 1729065600),
+	(5, 1729065600, 1729069200),
+	(5, 1729069200, 1729072800),
+	(5, 1729072800, 1729076400),
+	(5, 1729076400, 1729080000),
+	(5, 1729080000, 1729083600),
+	(5, 1729083600, 1729087200),
+	(5, 1729087200, 1729090800),
+	(5, 1729090800, 1729094400),
+	(5, 1729094400, 1729098000),
+	(5, 1729098000, 1729101600),
+	(5, 1729101600, 1729105200),
+	(5, 1729105200, 1729108800),
+	(5, 1729108800, 1729112400),
+	(5, 1729112400, 1729116000),
+	(5, 1729116000, 1729119600),
+	(5, 1729119600, 1729123200),
+	(5, 1729123200, 1729126800),
+	(5, 1729126800, 1729130400),
+	(5, 1729130400, 1729134000),
+	(5, 1729134000, 1729137600),
+	(5, 1729137600, 1729141200),
+	(5, 1729141200, 1729144800),
+	(5, 1729144800, 1729148400),
+	(5, 1729148400, 1729152000),
+	(5, 1729152000, 1729155600),
+	(5, 1729155600, 1729159200),
+	(5, 1729159200, 1729162800),
+	(5, 1729162800, 1729166400),
+	(5, 1729166400, 1729170000),
+	(5, 1729170000, 1729173600),
+	(5, 1729173600, 1729177200),
+	(5, 1729177200, 1729180800),
+	(5, 1729180800, 1729184400),
+	(5, 1729184400, 1729188000),
+	(5, 1729188000, 1729191600),
+	(5, 1729191600, 1729195200),
+	(5, 1729195200, 1729198800),
+	(5, 1729198800, 1729202400),
+	(5, 1729202400, 1729206000),
+	(5, 1729206000, 1729209600),
+	(5, 1729209600, 1729213200),
+	(5, 1729213200, 1729216800),
+	(5, 1729216800, 1729220400),
+	(5, 1729220400, 1729224000),
+	(5, 1729224000, 1729227600),
+	(5, 1729227600, 1729231200),
+	(5, 1729231200, 1729234800),
+	(5, 1729234800, 1729238400),
+	(5, 1729238400, 1729242000),
+	(5, 1729242000, 1729245600),
+	(5, 1729245600, 1729249200),
+	(5, 1729249200, 1729252800),
+	(5, 1729252800, 1729256400),
+	(5, 1729256400, 1729260000),
+	(5, 1729260000, 1729263600),
+	(5, 1729263600, 1729267200),
+	(5, 1729267200, 1729270800),
+	(5, 1729270800, 1729274400),
+	(5, 1729274400, 1729278000),
+	(5, 1729278000, 1729281600),
+	(5, 1729281600, 1729285200),
+	(5, 1729285200, 1729288800),
+	(5, 1729288800, 1729292400),
+	(5, 1729292400, 1729296000),
+	(5, 1729296000, 1729299600),
+	(5, 1729299600, 1729303200),
+	(5, 1729303200, 1729306800),
+	(5, 1729306800, 1729310400),
+	(5, 1729310400, 1729314000),
+	(5, 1729314000, 1729317600),
+	(5, 1729317600, 1729321200),
+	(5, 1729321200, 1729324800),
+	(5, 1729324800, 1729328400),
+	(5, 1729328400, 1729332000),
+	(5, 1729332000, 1729335600),
+	(5, 1729335600, 1729339200),
+	(5, 1729339200, 1729342800),
+	(5, 1729342800, 1729346400),
+	(5, 1729346400, 1729350000),
+	(5, 1729350000, 1729353600),
+	(5, 1729353600, 1729357200),
+	(5, 1729357200, 1729360800),
+	(5, 1729360800, 1729364400),
+	(5, 1729364400, 1729368000),
+	(5, 1729368000, 1729371600),
+	(5, 1729371600, 1729375200),
+	(5, 1729375200, 1729378800),
+	(5, 1729378800, 1729382400),
+	(5, 1729382400, 1729386000),
+	(5, 1729386000, 1729389600),
+	(5, 1729389600, 1729393200),
+	(5, 1729393200, 1729396800),
+	(5, 1729396800, 1729400400),
+	(5, 1729400400, 1729404000),
+	(5, 1729404000, 1729407600),
+	(5, 1729407600, 1729411200),
+	(5, 1729411200, 1729414800),
+	(5, 1729414800, 1729418400),
+	(5, 1729418400, 1729422000),
+	(5, 1729422000, 1729425600),
+	(5, 1729425600, 1729429200),
+	(5, 1729429200, 1729432800),
+	(5, 1729432800, 1729436400),
+	(5, 1729436400, 1729440000),
+	(5, 1729440000, 1729443600),
+	(5, 1729443600, 1729447200),
+	(5, 1729447200, 1729450800),
+	(5, 1729450800, 1729454400),
+	(5, 1729454400, 1729458000),
+	(5, 1729458000, 1729461600),
+	(5, 1729461600, 1729465200),
+	(5, 1729465200, 1729468800),
+	(5, 1729468800, 1729472400),
+	(5, 1729472400, 1729476000),
+	(5, 1729476000, 1729479600),
+	(5, 1729479600, 1729483200),
+	(5, 1729483200, 1729486800),
+	(5, 1729486800, 1729490400),
+	(5, 1729490400, 1729494000),
+	(5, 1729494000, 1729497600),
+	(5, 1729497600, 1729501200),
+	(5, 1729501200, 1729504800),
+	(5, 1729504800, 1729508400),
+	(5, 1729508400, 1729512000),
+	(5, 1729512000, 1729515600),
+	(5, 1729515600, 1729519200),
+	(5, 1729519200, 1729522800),
+	(5, 1729522800, 1729526400),
+	(5, 1729526400, 1729530000),
+	(5, 1729530000, 1729533600),
+	(5, 1729533600, 1729537200),
+	(5, 1729537200, 1729540800),
+	(5, 1729540800, 1729544400),
+	(5, 1729544400, 1729548000),
+	(5, 1729548000, 1729551600),
+	(5, 1729551600, 1729555200),
+	(5, 1729555200, 1729558800),
+	(5, 1729558800, 1729562400),
+	(5, 1729562400, 1729566000),
+	(5, 1729566000, 1729569600),
+	(5, 1729569600, 1729573200),
+	(5, 1729573200, 1729576800),
+	(5, 1729576800, 1729580400),
+	(5, 1729580400, 1729584000),
+	(5, 1729584000, 1729587600),
+	(5, 1729587600, 1729591200),
+	(5, 1729591200, 1729594800),
+	(5, 1729594800, 1729598400),
+	(5, 1729598400, 1729602000),
+	(5, 1729602000, 1729605600),
+	(5, 1729605600, 1729609200),
+	(5, 1729609200, 1729612800),
+	(5, 1729612800, 1729616400),
+	(5, 1729616400, 1729620000),
+	(5, 1729620000, 1729623600),
+	(5, 1729623600, 1729627200),
+	(5, 1729627200, 1729630800),
+	(5, 1729630800, 1729634400),
+	(5, 1729634400, 1729638000),
+	(5, 1729638000, 1729641600),
+	(5, 1729641600, 1729645200),
+	(5, 1729645200, 1729648800),
+	(5, 1729648800, 1729652400),
+	(5, 1729652400, 1729656000),
+	(5, 1729656000, 1729659600),
+	(5, 1729659600, 1729663200),
+	(5, 1729663200, 1729666800),
+	(5, 1729666800, 1729670400),
+	(5, 1729670400, 1729674000),
+	(5, 1729674000, 1729677600),
+	(5, 1729677600, 1729681200),
+	(5, 1729681200, 1729684800),
+	(5, 1729684800, 1729688400),
+	(5, 1729688400, 1729692000),
+	(5, 1729692000, 1729695600),
+	(5, 1729695600, 1729699200),
+	(5, 1729699200, 1729702800),
+	(5, 1729702800, 1729706400),
+	(5, 1729706400, 1729710000),
+	(5, 1729710000, 1729713600),
+	(5, 1729713600, 1729717200),
+	(5, 1729717200, 1729720800),
+	(5, 1729720800, 1729724400),
+	(5, 1729724400, 1729728000),
+	(5, 1729728000, 1729731600),
+	(5, 1729731600, 1729735200),
+	(5, 1729735200, 1729738800),
+	(5, 1729738800, 1729742400),
+	(5, 1729742400, 1729746000),
+	(5, 1729746000, 1729749600),
+	(5, 1729749600, 1729753200),
+	(5, 1729753200, 1729756800),
+	(5, 1729756800, 1729760400),
+	(5, 1729760400, 1729764000),
+	(5, 1729764000, 1729767600),
+	(5, 1729767600, 1729771200),
+	(5, 1729771200, 1729774800),
+	(5, 1729774800, 1729778400),
+	(5, 1729778400, 1729782000),
+	(5, 1729782000, 1729785600),
+	(5, 1729785600, 1729789200),
+	(5, 1729789200, 1729792800),
+	(5, 1729792800, 1729796400),
+	(5, 1729796400, 1729800000),
+	(5, 1729800000, 1729803600),
+	(5, 1729803600, 1729807200),
+	(5, 1729807200, 1729810800),
+	(5, 1729810800, 1729814400),
+	(5, 1729814400, 1729818000),
+	(5, 1729818000, 1729821600),
+	(5, 1729821600, 1729825200),
+	(5, 1729825200, 1729828800),
+	(5, 1729828800, 1729832400),
+	(5, 1729832400, 1729836000),
+	(5, 1729836000, 1729839600),
+	(5, 1729839600, 1729843200),
+	(5, 1729843200, 1729846800),
+	(5, 1729846800, 1729850400),
+	(5, 1729850400, 1729854000),
+	(5, 1729854000, 1729857600),
+	(5, 1729857600, 1729861200),
+	(5, 1729861200, 1729864800),
+	(5, 1729864800, 1729868400),
+	(5, 1729868400, 1729872000),
+	(5, 1729872000, 1729875600),
+	(5, 1729875600, 1729879200),
+	(5, 1729879200, 1729882800),
+	(5, 1729882800, 1729886400),
+	(5, 1729886400, 1729890000),
+	(5, 1729890000, 1729893600),
+	(5, 1729893600, 1729897200),
+	(5, 1729897200, 1729900800),
+	(5, 1729900800, 1729904400),
+	(5, 1729904400, 1729908000),
+	(5, 1729908000, 1729911600),
+	(5, 1729911600, 1729915200),
+	(5, 1729915200, 1729918800),
+	(5, 1729918800, 1729922400),
+	(5, 1729922400, 1729926000),
+	(5, 1729926000, 1729929600),
+	(5, 1729929600, 1729933200),
+	(5, 1729933200, 1729936800),
+	(5, 1729936800, 1729940400),
+	(5, 1729940400, 1729944000),
+	(5, 1729944000, 1729947600),
+	(5, 1729947600, 1729951200),
+	(5, 1729951200, 1729954800),
+	(5, 1729954800, 1729958400),
+	(5, 1729958400, 1729962000),
+	(5, 1729962000, 1729965600),
+	(5, 1729965600, 1729969200),
+	(5, 1729969200, 1729972800),
+	(5, 1729972800, 1729976400),
+	(5, 1729976400, 1729980000),
+	(5, 1729980000, 1729983600),
+	(5, 1729983600, 1729987200),
+	(5, 1729987200, 1729990800),
+	(5, 1729990800, 1729994400),
+	(5, 1729994400, 1729998000),
+	(5, 1729998000, 1730001600),
+	(5, 1730001600, 1730005200),
+	(5, 1730005200, 1730008800),
+	(5, 1730008800, 1730012400),
+	(5, 1730012400, 1730016000),
+	(5, 1730016000, 1730019600),
+	(5, 1730019600, 1730023200),
+	(5, 1730023200, 1730026800),
+	(5, 1730026800, 1730030400),
+	(5, 1730030400, 1730034000),
+	(5, 1730034000, 1730037600),
+	(5, 1730037600, 1730041200),
+	(5, 1730041200, 1730044800),
+	(5, 1730044800, 1730048400),
+	(5, 1730048400, 1730052000),
+	(5, 1730052000, 1730055600),
+	(5, 1730055600, 1730059200),
+	(5, 1730059200, 1730062800),
+	(5, 1730062800, 1730066400),
+	(5, 1730066400, 1730070000),
+	(5, 1730070000, 1730073600),
+	(5, 1730073600, 1730077200),
+	(5, 1730077200, 1730080800),
+	(5, 1730080800, 1730084400),
+	(5, 1730084400, 1730088000),
+	(5, 1730088000, 1730091600),
+	(5, 1730091600, 1730095200),
+	(5, 1730095200, 1730098800),
+	(5, 1730098800, 1730102400),
+	(5, 1730102400, 1730106000),
+	(5, 1730106000, 1730109600),
+	(5, 1730109600, 1730113200),
+	(5, 1730113200, 1730116800),
+	(5, 1730116800, 1730120400),
+	(5, 1730120400, 1730124000),
+	(5, 1730124000, 1730127600),
+	(5, 1730127600, 1730131200),
+	(5, 1730131200, 1730134800),
+	(5, 1730134800, 1730138400),
+	(5, 1730138400, 1730142000),
+	(5, 1730142000, 1730145600),
+	(5, 1730145600, 1730149200),
+	(5, 1730149200, 1730152800),
+	(5, 1730152800, 1730156400),
+	(5, 1730156400, 1730160000),
+	(5, 1730160000, 1730163600),
+	(5, 1730163600, 1730167200),
+	(5, 1730167200, 1730170800),
+	(5, 1730170800, 1730174400),
+	(5, 1730174400, 1730178000),
+	(5, 1730178000, 1730181600),
+	(5, 1730181600, 1730185200),
+	(5, 1730185200, 1730188800),
+	(5, 1730188800, 1730192400),
+	(5, 1730192400, 1730196000),
+	(5, 1730196000, 1730199600),
+	(5, 1730199600, 1730203200),
+	(5, 1730203200, 1730206800),
+	(5, 1730206800, 1730210400),
+	(5, 1730210400, 1730214000),
+	(5, 1730214000, 1730217600),
+	(5, 1730217600, 1730221200),
+	(5, 1730221200, 1730224800),
+	(5, 1730224800, 1730228400),
+	(5, 1730228400, 1730232000),
+	(5, 1730232000, 1730235600),
+	(5, 1730235600, 1730239200),
+	(5, 1730239200, 1730242800),
+	(5, 1730242800, 1730246400),
+	(5, 1730246400, 1730250000),
+	(5, 1730250000, 1730253600),
+	(5, 1730253600, 1730257200),
+	(5, 1730257200, 1730260800),
+	(5, 1730260800, 1730264400),
+	(5, 1730264400, 1730268000),
+	(5, 1730268000, 1730271600),
+	(5, 1730271600, 1730275200),
+	(5, 1730275200, 1730278800),
+	(5, 1730278800, 1730282400),
+	(5, 1730282400, 1730286000),
+	(5, 1730286000, 1730289600),
+	(5, 1730289600, 1730293200),
+	(5, 1730293200, 1730296800),
+	(5, 1730296800, 1730300400),
+	(5, 1730300400, 1730304000),
+	(5, 1730304000, 1730307600),
+	(5, 1730307600, 1730311200),
+	(5, 1730311200, 1730314800),
+	(5, 1730314800, 1730318400),
+	(5, 1730318400, 1730322000),
+	(5, 1730322000, 1730325600),
+	(5, 1730325600, 1730329200),
+	(5, 1730329200, 1730332800),
+	(5, 1730332800, 1730336400),
+	(5, 1730336400, 1730340000),
+	(5, 1730340000, 1730343600),
+	(5, 1730343600, 1730347200),
+	(5, 1730347200, 1730350800),
+	(5, 1730350800, 1730354400),
+	(5, 1730354400, 1730358000),
+	(5, 1730358000, 1730361600),
+	(5, 1730361600, 1730365200),
+	(5, 1730365200, 1730368800),
+	(5, 1730368800, 1730372400),
+	(5, 1730372400, 1730376000),
+	(5, 1730376000, 1730379600),
+	(5, 1730379600, 1730383200),
+	(5, 1730383200, 1730386800),
+	(5, 1730386800, 1730390400),
+	(5, 1730390400, 1730394000),
+	(5, 1730394000, 1730397600),
+	(5, 1730397600, 1730401200),
+	(5, 1730401200, 1730404800),
+	(5, 1730404800, 1730408400),
+	(5, 1730408400, 1730412000),
+	(5, 1730412000, 1730415600),
+	(5, 1730415600, 1730419200),
+	(5, 1730419200, 1730422800),
+	(5, 1730422800, 1730426400),
+	(5, 1730426400, 1730430000),
+	(5, 1730430000, 1730433600),
+	(5, 1730433600, 1730437200),
+	(5, 1730437200, 1730440800),
+	(5, 1730440800, 1730444400),
+	(5, 1730444400, 1730448000),
+	(5, 1730448000, 1730451600),
+	(5, 1730451600, 1730455200),
+	(5, 1730455200, 1730458800),
+	(5, 1730458800, 1730462400),
+	(5, 1730462400, 1730466000),
+	(5, 1730466000, 1730469600),
+	(5, 1730469600, 1730473200),
+	(5, 1730473200, 1730476800),
+	(5, 1730476800, 1730480400),
+	(5, 1730480400, 1730484000),
+	(5, 1730484000, 1730487600),
+	(5, 1730487600, 1730491200),
+	(5, 1730491200, 1730494800),
+	(5, 1730494800, 1730498400),
+	(5, 1730498400, 1730502000),
+	(5, 1730502000, 1730505600),
+	(5, 1730505600, 1730509200),
+	(5, 1730509200, 1730512800),
+	(5, 1730512800, 1730516400),
+	(5, 1730516400, 1730520000),
+	(5, 1730520000, 1730523600),
+	(5, 1730523600, 1730527200),
+	(5, 1730527200, 1730530800),
+	(5, 1730530800, 1730534400),
+	(5, 1730534400, 1730538000),
+	(5, 1730538000, 1730541600),
+	(5, 1730541600, 1730545200),
+	(5, 1730545200, 1730548800),
+	(5, 1730548800, 1730552400),
+	(5, 1730552400, 1730556000),
+	(5, 1730556000, 1730559600),
+	(5, 1730559600, 1730563200),
+	(5, 1730563200, 1730566800),
+	(5, 1730566800, 1730570400),
+	(5, 1730570400, 1730574000),
+	(5, 1730574000, 1730577600),
+	(5, 1730577600, 1730581200),
+	(5, 1730581200, 1730584800),
+	(5, 1730584800, 1730588400),
+	(5, 1730588400, 1730592000),
+	(5, 1730592000, 1730595600),
+	(5, 1730595600, 1730599200),
+	(5, 1730599200, 1730602800),
+	(5, 1730602800, 1730606400),
+	(5, 1730606400, 1730610000),
+	(5, 1730610000, 1730613600),
+	(5, 1730613600, 1730617200),
+	(5, 1730617200, 1730620800),
+	(5, 1730620800, 1730624400),
+	(5, 1730624400, 1730628000),
+	(5, 1730628000, 1730631600),
+	(5, 1730631600, 1730635200),
+	(5, 1730635200, 1730638800),
+	(5, 1730638800, 1730642400),
+	(5, 1730642400, 1730646000),
+	(5, 1730646000, 1730649600),
+	(5, 1730649600, 1730653200),
+	(5, 1730653200, 1730656800),
+	(5, 1730656800, 1730660400),
+	(5, 1730660400, 1730664000),
+	(5, 1730664000, 1730667600),
+	(5, 1730667600, 1730671200),
+	(5, 1730671200, 1730674800),
+	(5, 1730674800, 1730678400),
+	(5, 1730678400, 1730682000),
+	(5, 1730682000, 1730685600),
+	(5, 1730685600, 1730689200),
+	(5, 1730689200, 1730692800),
+	(5, 1730692800, 1730696400),
+	(5, 1730696400, 1730700000),
+	(5, 1730700000, 1730703600),
+	(5, 1730703600, 1730707200),
+	(5, 1730707200, 1730710800),
+	(5, 1730710800, 1730714400),
+	(5, 1730714400, 1730718000),
+	(5, 1730718000, 1730721600),
+	(5, 1730721600, 1730725200),
+	(5, 1730725200, 1730728800),
+	(5, 1730728800, 1730732400),
+	(5, 1730732400, 1730736000),
+	(5, 1730736000, 1730739600),
+	(5, 1730739600, 1730743200),
+	(5, 1730743200, 1730746800),
+	(5, 1730746800, 1730750400),
+	(5, 1730750400, 1730754000),
+	(5, 1730754000, 1730757600),
+	(5, 1730757600, 1730761200),
+	(5, 1730761200, 1730764800),
+	(5, 1730764800, 1730768400),
+	(5, 1730768400, 1730772000),
+	(5, 1730772000, 1730775600),
+	(5, 1730775600, 1730779200),
+	(5, 1730779200, 1730782800),
+	(5, 1730782800, 1730786400),
+	(5, 1730786400, 1730790000),
+	(5, 1730790000, 1730793600),
+	(5, 1730793600, 1730797200),
+	(5, 1730797200, 1730800800),
+	(5, 1730800800, 1730804400),
+	(5, 1730804400, 1730808000),
+	(5, 1730808000, 1730811600),
+	(5, 1730811600, 1730815200),
+	(5, 1730815200, 1730818800),
+	(5, 1730818800, 1730822400),
+	(5, 1730822400, 1730826000),
+	(5, 1730826000, 1730829600),
+	(5, 1730829600, 1730833200),
+	(5, 1730833200, 1730836800),
+	(5, 1730836800, 1730840400),
+	(5, 1730840400, 1730844000),
+	(5, 1730844000, 1730847600),
+	(5, 1730847600, 1730851200),
+	(5, 1730851200, 1730854800),
+	(5, 1730854800, 1730858400),
+	(5, 1730858400, 1730862000),
+	(5, 1730862000, 1730865600),
+	(5, 1730865600, 1730869200),
+	(5, 1730869200, 1730872800),
+	(5, 1730872800, 1730876400),
+	(5, 1730876400, 1730880000),
+	(5, 1730880000, 1730883600),
+	(5, 1730883600, 1730887200),
+	(5, 1730887200, 1730890800),
+	(5, 1730890800, 1730894400),
+	(5, 1730894400, 1730898000),
+	(5, 1730898000, 1730901600),
+	(5, 1730901600, 1730905200),
+	(5, 1730905200, 1730908800),
+	(5, 1730908800, 1730912400),
+	(5, 1730912400, 1730916000),
+	(5, 1730916000, 1730919600),
+	(5, 1730919600, 1730923200),
+	(5, 1730923200, 1730926800),
+	(5, 1730926800, 1730930400),
+	(5, 1730930400, 1730934000),
+	(5, 1730934000, 1730937600),
+	(5, 1730937600, 1730941200),
+	(5, 1730941200, 1730944800),
+	(5, 1730944800, 1730948400),
+	(5, 1730948400, 1730952000),
+	(5, 1730952000, 1730955600),
+	(5, 1730955600, 1730959200),
+	(5, 1730959200, 1730962800),
+	(5, 1730962800, 1730966400),
+	(5, 1730966400, 1730970000),
+	(5, 1730970000, 1730973600),
+	(5, 1730973600, 1730977200),
+	(5, 1730977200, 1730980800),
+	(5, 1730980800, 1730984400),
+	(5, 1730984400, 1730988000),
+	(5, 1730988000, 1730991600),
+	(5, 1730991600, 1730995200),
+	(5, 1730995200, 1730998800),
+	(5, 1730998800, 1731002400),
+	(5, 1731002400, 1731006000),
+	(5, 1731006000, 1731009600),
+	(5, 1731009600, 1731013200),
+	(5, 1731013200, 1731016800),
+	(5, 1731016800, 1731020400),
+	(5, 1731020400, 1731024000),
+	(5, 1731024000, 1731027600),
+	(5, 1731027600, 1731031200),
+	(5, 1731031200, 1731034800),
+	(5, 1731034800, 1731038400),
+	(5, 1731038400, 1731042000),
+	(5, 1731042000, 1731045600),
+	(5, 1731045600, 1731049200),
+	(5, 1731049200, 1731052800),
+	(5, 1731052800, 1731056400),
+	(5, 1731056400, 1731060000),
+	(5, 1731060000, 1731063600),
+	(5, 1731063600, 1731067200),
+	(5, 1731067200, 1731070800),
+	(5, 1731070800, 1731074400),
+	(5, 1731074400, 1731078000),
+	(5, 1731078000, 1731081600),
+	(5, 1731081600, 1731085200),
+	(5, 1731085200, 1731088800),
+	(5, 1731088800, 1731092400),
+	(5, 1731092400, 1731096000),
+	(5, 1731096000, 1731099600),
+	(5, 1731099600, 1731103200),
+	(5, 1731103200, 1731106800),
+	(5, 1731106800, 1731110400),
+	(5, 1731110400, 1731114000),
+	(5, 1731114000, 1731117600),
+	(5, 1731117600, 1731121200),
+	(5, 1731121200, 1731124800),
+	(5, 1731124800, 1731128400),
+	(5, 1731128400, 1731132000),
+	(5, 1731132000, 1731135600),
+	(5, 1731135600, 1731139200),
+	(5, 1731139200, 1731142800),
+	(5, 1731142800, 1731146400),
+	(5, 1731146400, 1731150000),
+	(5, 1731150000, 1731153600),
+	(5, 1731153600, 1731157200),
+	(5, 1731157200, 1731160800),
+	(5, 1731160800, 1731164400),
+	(5, 1731164400, 1731168000),
+	(5, 1731168000, 1731171600),
+	(5, 1731171600, 1731175200),
+	(5, 1731175200, 1731178800),
+	(5, 1731178800, 1731182400),
+	(5, 1731182400, 1731186000),
+	(5, 1731186000, 1731189600),
+	(5, 1731189600, 1731193200),
+	(5, 1731193200, 1731196800),
+	(5, 1731196800, 1731200400),
+	(5, 1731200400, 1731204000),
+	(5, 1731204000, 1731207600),
+	(5, 1731207600, 1731211200),
+	(5, 1731211200, 1731214800),
+	(5, 1731214800, 1731218400),
+	(5, 1731218400, 1731222000),
+	(5, 1731222000, 1731225600),
+	(5, 1731225600, 1731229200),
+	(5, 1731229200, 1731232800),
+	(5, 1731232800, 1731236400),
+	(5, 1731236400, 1731240000),
+	(5, 1731240000, 1731243600),
+	(5, 1731243600, 1731247200),
+	(5, 1731247200, 1731250800),
+	(5, 1731250800, 1731254400),
+	(5, 1731254400, 1731258000),
+	(5, 1731258000, 1731261600),
+	(5, 1731261600, 1731265200),
+	(5, 1731265200, 1731268800),
+	(5, 1731268800, 1731272400),
+	(5, 1731272400, 1731276000),
+	(5, 1731276000, 1731279600),
+	(5, 1731279600, 1731283200),
+	(5, 1731283200, 1731286800),
+	(5, 1731286800, 1731290400),
+	(5, 1731290400, 1731294000),
+	(5, 1731294000, 1731297600),
+	(5, 1731297600, 1731301200),
+	(5, 1731301200, 1731304800),
+	(5, 1731304800, 1731308400),
+	(5, 1731308400, 1731312000),
+	(5, 1731312000, 1731315600),
+	(5, 1731315600, 1731319200),
+	(5, 1731319200, 1731322800),
+	(5, 1731322800, 1731326400),
+	(5, 1731326400, 1731330000),
+	(5, 1731330000, 1731333600),
+	(5, 1731333600, 1731337200),
+	(5, 1731337200, 1731340800),
+	(5, 1731340800, 1731344400),
+	(5, 1731344400, 1731348000),
+	(5, 1731348000, 1731351600),
+	(5, 1731351600, 1731355200),
+	(5, 1731355200, 1731358800),
+	(5, 1731358800, 1731362400),
+	(5, 1731362400, 1731366000),
+	(5, 1731366000, 1731369600),
+	(5, 1731369600, 1731373200),
+	(5, 1731373200, 1731376800),
+	(5, 1731376800, 1731380400),
+	(5, 1731380400, 1731384000),
+	(5, 1731384000, 1731387600),
+	(5, 1731387600, 1731391200),
+	(5, 1731391200, 1731394800),
+	(5, 1731394800, 1731398400),
+	(5, 1731398400, 1731402000),
+	(5, 1731402000, 1731405600),
+	(5, 1731405600, 1731409200),
+	(5, 1731409200, 1731412800),
+	(5, 1731412800, 1731416400),
+	(5, 1731416400, 1731420000),
+	(5, 1731420000, 1731423600),
+	(5, 1731423600, 1731427200),
+	(5, 1731427200, 1731430800),
+	(5, 1731430800, 1731434400),
+	(5, 1731434400, 1731438000),
+	(5, 1731438000, 1731441600),
+	(5, 1731441600, 1731445200),
+	(5, 1731445200, 1731448800),
+	(5, 1731448800, 1731452400),
+	(5, 1731452400, 1731456000),
+	(5, 1731456000, 1731459600),
+	(5, 1731459600, 1731463200),
+	(5, 1731463200, 1731466800),
+	(5, 1731466800, 1731470400),
+	(5, 1731470400, 1731474000),
+	(5, 1731474000, 1731477600),
+	(5, 1731477600, 1731481200),
+	(5, 1731481200, 1731484800),
+	(5, 1731484800, 1731488400),
+	(5, 1731488400, 1731492000),
+	(5, 1731492000, 1731495600),
+	(5, 1731495600, 1731499200),
+	(5, 1731499200, 1731502800),
+	(5, 1731502800, 1731506400),
+	(5, 1731506400, 1731510000),
+	(5, 1731510000, 1731513600),
+	(5, 1731513600, 1731517200),
+	(5, 1731517200, 1731520800),
+	(5, 1731520800, 1731524400),
+	(5, 1731524400, 1731528000),
+	(5, 1731528000, 1731531600),
+	(5, 1731531600, 1731535200),
+	(5, 1731535200, 1731538800),
+	(5, 1731538800, 1731542400),
+	(5, 1731542400, 1731546000),
+	(5, 1731546000, 1731549600),
+	(5, 1731549600, 1731553200),
+	(5, 1731553200, 1731556800),
+	(5, 1731556800, 1731560400),
+	(5, 1731560400, 1731564000),
+	(5, 1731564000, 1731567600),
+	(5, 1731567600, 1731571200),
+	(5, 1731571200, 1731574800),
+	(5, 1731574800, 1731578400),
+	(5, 1731578400, 1731582000),
+	(5, 1731582000, 1731585600),
+	(5, 1731585600, 1731589200),
+	(5, 1731589200, 1731592800),
+	(5, 1731592800, 1731596400),
+	(5, 1731596400, 1731600000),
+	(5, 1731600000, 1731603600),
+	(5, 1731603600, 1731607200),
+	(5, 1731607200, 1731610800),
+	(5, 1731610800, 1731614400),
+	(5, 1731614400, 1731618000),
+	(5, 1731618000, 1731621600),
+	(5, 1731621600, 1731625200),
+	(5, 1731625200, 1731628800),
+	(5, 1731628800, 1731632400),
+	(5, 1731632400, 1731636000),
+	(5, 1731636000, 1731639600),
+	(5, 1731639600, 1731643200),
+	(5, 1731643200, 1731646800),
+	(5, 1731646800, 1731650400),
+	(5, 1731650400, 1731654000),
+	(5, 1731654000, 1731657600),
+	(5, 1731657600, 1731661200),
+	(5, 1731661200, 1731664800),
+	(5, 1731664800, 1731668400),
+	(5, 1731668400, 1731672000),
+	(5, 1731672000, 1731675600),
+	(5, 1731675600, 1731679200),
+	(5, 1731679200, 1731682800),
+	(5, 1731682800, 1731686400),
+	(5, 1731686400, 1731690000),
+	(5, 1731690000, 1731693600),
+	(5, 1731693600, 1731697200),
+	(5, 1731697200, 1731700800),
+	(5, 1731700800, 1731704400),
+	(5, 1731704400, 1731708000),
+	(5, 1731708000, 1731711600),
+	(5, 1731711600, 1731715200),
+	(5, 1731715200, 1731718800),
+	(5, 1731718800, 1731722400),
+	(5, 1731722400, 1731726000),
+	(5, 1731726000, 1731729600),
+	(5, 1731729600, 1731733200),
+	(5, 1731733200, 1731736800),
+	(5, 1731736800, 1731740400),
+	(5, 1731740400, 1731744000),
+	(5, 1731744000, 1731747600),
+	(5, 1731747600, 1731751200),
+	(5, 1731751200, 1731754800),
+	(5, 1731754800, 1731758400),
+	(5, 1731758400, 1731762000),
+	(5, 1731762000, 1731765600),
+	(5, 1731765600, 1731769200),
+	(5, 1731769200, 1731772800),
+	(5, 1731772800, 1731776400),
+	(5, 1731776400, 1731780000),
+	(5, 1731780000, 1731783600),
+	(5, 1731783600, 1731787200),
+	(5, 1731787200, 1731790800),
+	(5, 1731790800, 1731794400),
+	(5, 1731794400, 1731798000),
+	(5, 1731798000, 1731801600),
+	(5, 1731801600, 1731805200),
+	(5, 1731805200, 1731808800),
+	(5, 1731808800, 1731812400),
+	(5, 1731812400, 1731816000),
+	(5, 1731816000, 1731819600),
+	(5, 1731819600, 1731823200),
+	(5, 1731823200, 1731826800),
+	(5, 1731826800, 1731830400),
+	(5, 1731830400, 1731834000),
+	(5, 1731834000, 1731837600),
+	(5, 1731837600, 1731841200),
+	(5, 1731841200, 1731844800),
+	(5, 1731844800, 1731848400),
+	(5, 1731848400, 1731852000),
+	(5, 1731852000, 1731855600),
+	(5, 1731855600, 1731859200),
+	(5, 1731859200, 1731862800),
+	(5, 1731862800, 1731866400),
+	(5, 1731866400, 1731870000),
+	(5, 1731870000, 1731873600),
+	(5, 1731873600, 1731877200),
+	(5, 1731877200, 1731880800),
+	(5, 1731880800, 1731884400),
+	(5, 1731884400, 1731888000),
+	(5, 1731888000, 1731891600),
+	(5, 1731891600, 1731895200),
+	(5, 1731895200, 1731898800),
+	(5, 1731898800, 1731902400),
+	(5, 1731902400, 1731906000),
+	(5, 1731906000, 1731909600),
+	(5, 1731909600, 1731913200),
+	(5, 1731913200, 1731916800),
+	(5, 1731916800, 1731920400),
+	(5, 1731920400, 1731924000),
+	(5, 1731924000, 1731927600),
+	(5, 1731927600, 1731931200),
+	(5, 1731931200, 1731934800),
+	(5, 1731934800, 1731938400),
+	(5, 1731938400, 1731942000),
+	(5, 1731942000, 1731945600),
+	(5, 1731945600, 1731949200),
+	(5, 1731949200, 1731952800),
+	(5, 1731952800, 1731956400),
+	(5, 1731956400, 1731960000),
+	(5, 1731960000, 1731963600),
+	(5, 1731963600, 1731967200),
+	(5, 1731967200, 1731970800),
+	(5, 1731970800, 1731974400),
+	(5, 1731974400, 1731978000),
+	(5, 1731978000, 1731981600),
+	(5, 1731981600, 1731985200),
+	(5, 1731985200, 1731988800),
+	(5, 1731988800, 1731992400),
+	(5, 1731992400, 1731996000),
+	(5, 1731996000, 1731999600),
+	(5, 1731999600, 1732003200),
+	(5, 1732003200, 1732006800),
+	(5, 1732006800, 1732010400),
+	(5, 1732010400, 1732014000),
+	(5, 1732014000, 1732017600),
+	(5, 1732017600, 1732021200),
+	(5, 1732021200, 1732024800),
+	(5, 1732024800, 1732028400),
+	(5, 1732028400, 1732032000),
+	(5, 1732032000, 1732035600),
+	(5, 1732035600, 1732039200),
+	(5, 1732039200, 1732042800),
+	(5, 1732042800, 1732046400),
+	(5, 1732046400, 1732050000),
+	(5, 1732050000, 1732053600),
+	(5, 1732053600, 1732057200),
+	(5, 1732057200, 1732060800),
+	(5, 1732060800, 1732064400),
+	(5, 1732064400, 1732068000),
+	(5, 1732068000, 1732071600),
+	(5, 1732071600, 1732075200),
+	(5, 1732075200, 1732078800),
+	(5, 1732078800, 1732082400),
+	(5, 1732082400, 1732086000),
+	(5, 1732086000, 1732089600),
+	(5, 1732089600, 1732093200),
+	(5, 1732093200, 1732096800),
+	(5, 1732096800, 1732100400),
+	(5, 1732100400, 1732104000),
+	(5, 1732104000, 1732107600),
+	(5, 1732107600, 1732111200),
+	(5, 1732111200, 1732114800),
+	(5, 1732114800, 1732118400),
+	(5, 1732118400, 1732122000),
+	(5, 1732122000, 1732125600),
+	(5, 1732125600, 1732129200),
+	(5, 1732129200, 1732132800),
+	(5, 1732132800, 1732136400),
+	(5, 1732136400, 1732140000),
+	(5, 1732140000, 1732143600),
+	(5, 1732143600, 1732147200),
+	(5, 1732147200, 1732150800),
+	(5, 1732150800, 1732154400),
+	(5, 1732154400, 1732158000),
+	(5, 1732158000, 1732161600),
+	(5, 1732161600, 1732165200),
+	(5, 1732165200, 1732168800),
+	(5, 1732168800, 1732172400),
+	(5, 1732172400, 1732176000),
+	(5, 1732176000, 1732179600),
+	(5, 1732179600, 1732183200),
+	(5, 1732183200, 1732186800),
+	(5, 1732186800, 1732190400),
+	(5, 1732190400, 1732194000),
+	(5, 1732194000, 1732197600),
+	(5, 1732197600, 1732201200),
+	(5, 1732201200, 1732204800),
+	(5, 1732204800, 1732208400),
+	(5, 1732208400, 1732212000),
+	(5, 1732212000, 1732215600),
+	(5, 1732215600, 1732219200),
+	(5, 1732219200, 1732222800),
+	(5, 1732222800, 1732226400),
+	(5, 1732226400, 1732230000),
+	(5, 1732230000, 1732233600),
+	(5, 1732233600, 1732237200),
+	(5, 1732237200, 1732240800),
+	(5, 1732240800, 1732244400),
+	(5, 1732244400, 1732248000),
+	(5, 1732248000, 1732251600),
+	(5, 1732251600, 1732255200),
+	(5, 1732255200, 1732258800),
+	(5, 1732258800, 1732262400),
+	(5, 1732262400, 1732266000),
+	(5, 1732266000, 1732269600),
+	(5, 1732269600, 1732273200),
+	(5, 1732273200, 1732276800),
+	(5, 1732276800, 1732280400),
+	(5, 1732280400, 1732284000),
+	(5, 1732284000, 1732287600),
+	(5, 1732287600, 1732291200),
+	(5, 1732291200, 1732294800),
+	(5, 1732294800, 1732298400),
+	(5, 1732298400, 1732302000),
+	(5, 1732302000, 1732305600),
+	(5, 1732305600, 1732309200),
+	(5, 1732309200, 1732312800),
+	(5, 1732312800, 1732316400),
+	(5, 1732316400, 1732320000),
+	(5, 1732320000, 1732323600),
+	(5, 1732323600, 1732327200),
+	(5, 1732327200, 1732330800),
+	(5, 1732330800, 1732334400),
+	(5, 1732334400, 1732338000),
+	(5, 1732338000, 1732341600),
+	(5, 1732341600, 1732345200),
+	(5, 1732345200, 1732348800),
+	(5, 1732348800, 1732352400),
+	(5, 1732352400, 1732356000),
+	(5, 1732356000, 1732359600),
+	(5, 1732359600, 1732363200),
+	(5, 1732363200, 1732366800),
+	(5, 1732366800, 1732370400),
+	(5, 1732370400, 1732374000),
+	(5, 1732374000, 1732377600),
+	(5, 1732377600, 1732381200),
+	(5, 1732381200, 1732384800),
+	(5, 1732384800, 1732388400),
+	(5, 1732388400, 1732392000),
+	(5, 1732392000, 1732395600),
+	(5, 1732395600, 1732399200),
+	(5, 1732399200, 1732402800),
+	(5, 1732402800, 1732406400);
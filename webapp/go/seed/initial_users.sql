@@ -0,0 +1,2002 @@
+-- NOTE: パスワードは `test`
+INSERT INTO users (id, name, display_name, description, password) VALUES (1, 'test001', '検証用ユーザ', '社内検証用', '$2a$04$LBt4Dc0Uu3HE0c.8KVMtbOnXwd4PHCboGxa2I57RmJFQVba/B0U8a');
+INSERT INTO themes (user_id, dark_mode) VALUES (1, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (2, 'ayamazaki0', '秋響', '普段音響技術者をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ayamazaki.example.com/\nメールアドレス: ayamazaki@example.com\n', '$2a$04$GvL4Wts8RiOULOE9qAYZWeX6pqOaW9F3MoWrqiAtMt3K.tepN85Xq');
+INSERT INTO themes (user_id, dark_mode) VALUES (2, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (3, 'yoshidamiki0', 'ひまわりひめ', '普段演歌歌手をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yoshidamiki.example.com/\nメールアドレス: yoshidamiki@example.com\n', '$2a$04$OHdW6thFVfbi6tPhy5lXGeiFAShOFDz0xFVG2iSjM0yZ.jnAa0Uqq');
+INSERT INTO themes (user_id, dark_mode) VALUES (3, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (4, 'hidekimurakami0', 'みつばみつばもち', '普段建築家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hidekimurakami.example.com/\nメールアドレス: hidekimurakami@example.com\n', '$2a$04$0PulOoA5M9qCiCU4oL0O1OqnrGD./.dGLQPwuTf9SfioljG1ImG7.');
+INSERT INTO themes (user_id, dark_mode) VALUES (4, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (5, 'akemikobayashi0', '朝霧', '普段寿司職人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://akemikobayashi.example.com/\nメールアドレス: akemikobayashi@example.com\n', '$2a$04$lBKwG4y37PBniflzaf/iY.9KYWo2ZBd6JSfvgT9UG0XA80uSShAJW');
+INSERT INTO themes (user_id, dark_mode) VALUES (5, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (6, 'eishikawa0', 'きらきらきらり', '普段アナウンサーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://eishikawa.example.com/\nメールアドレス: eishikawa@example.com\n', '$2a$04$pEqzGw.XCz0voYRH3QMJTO59V1WsTacBbGb/K2UETzzAEqaz6xU96');
+INSERT INTO themes (user_id, dark_mode) VALUES (6, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (7, 'tomoya100', 'こはるきりもち', '普段行政書士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tomoya10.example.com/\nメールアドレス: tomoya10@example.com\n', '$2a$04$j./lZgNj/fSYZqg7O7BDue3T7Ijeun6VVUizLRGFMheTUMIqcYK2y');
+INSERT INTO themes (user_id, dark_mode) VALUES (7, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (8, 'kobayashiminoru0', '陽射し', '普段薬剤師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kobayashiminoru.example.com/\nメールアドレス: kobayashiminoru@example.com\n', '$2a$04$sLLGF.m9gbfbm5pTeUjpvuW89BW.yNEylciEbeGzsacMmbCN1DnSC');
+INSERT INTO themes (user_id, dark_mode) VALUES (8, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (9, 'yamadakaori0', 'あんこあんこか', '普段裁判官をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yamadakaori.example.com/\nメールアドレス: yamadakaori@example.com\n', '$2a$04$uu1XhQxhkzIJsJ7Tzw8qfuH/wivo4hkEYkFKOP0xKulBk2nxZPEqS');
+INSERT INTO themes (user_id, dark_mode) VALUES (9, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (10, 'tanakahanako0', '温もり', '普段花火師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tanakahanako.example.com/\nメールアドレス: tanakahanako@example.com\n', '$2a$04$qUPj6ngqW37MzPM4LsJ7QOBsEW7qF0fUVyE4T3QJYH4AKVozbNC9q');
+INSERT INTO themes (user_id, dark_mode) VALUES (10, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (11, 'taro660', 'ほしぞらほうとう', '普段イラストレーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://taro66.example.com/\nメールアドレス: taro66@example.com\n', '$2a$04$ah/ZrA65mvjSG9M2zRSUcegW1d1d.lzyMkKl0leUmnkmTEBGogPp.');
+INSERT INTO themes (user_id, dark_mode) VALUES (11, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (12, 'hashimotokenichi0', '絆', '普段ゲームクリエイターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hashimotokenichi.example.com/\nメールアドレス: hashimotokenichi@example.com\n', '$2a$04$sdAySrC/p9LWk5J.r5LM.udKeDM02c0eSXn3GFCrJ0uMUOmDlQDy2');
+INSERT INTO themes (user_id, dark_mode) VALUES (12, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (13, 'yuta330', '海風せんべい', '普段航海士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yuta33.example.com/\nメールアドレス: yuta33@example.com\n', '$2a$04$uHf5EFSsbhKGqYandZv/Z.kwJ.UHjKBL5qD9hr8us.PEoIZTxNQrq');
+INSERT INTO themes (user_id, dark_mode) VALUES (13, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (14, 'kenichinakamura0', '千秋', '普段公認会計士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kenichinakamura.example.com/\nメールアドレス: kenichinakamura@example.com\n', '$2a$04$2HuLJJa1611votr5CHKX/OS3xK1fAREGgbmLKSWo4Ewf5bzDGvCwi');
+INSERT INTO themes (user_id, dark_mode) VALUES (14, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (15, 'taichikato0', 'ももももももも', '普段司法書士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://taichikato.example.com/\nメールアドレス: taichikato@example.com\n', '$2a$04$LTNRQBExFHY8lQCn44lo/ehB/NRpBIpddcfgIVLGGUYtvJmzJ3/9O');
+INSERT INTO themes (user_id, dark_mode) VALUES (15, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (16, 'wfujita0', 'はっぱはんぺん', '普段花火師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://wfujita.example.com/\nメールアドレス: wfujita@example.com\n', '$2a$04$vJVvUq12hZbehFmPzP.c8OnRsuSErg/5.1dLO7hq3pPFlvIQgaobu');
+INSERT INTO themes (user_id, dark_mode) VALUES (16, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (17, 'saitotakuma0', 'こっぷくりん', '普段ウェブデザイナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://saitotakuma.example.com/\nメールアドレス: saitotakuma@example.com\n', '$2a$04$zoGEpfPL0Ypk8OengyTkvuOdM0QVy30SWsY1C7y1aBvWBICJYu51y');
+INSERT INTO themes (user_id, dark_mode) VALUES (17, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (18, 'maayafujiwara0', 'さくらんぼさくり', '普段航海士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://maayafujiwara.example.com/\nメールアドレス: maayafujiwara@example.com\n', '$2a$04$NFEv49zphsEdwCNLi6QYz.FbSSEyNqGuIsJ9hNj.nbXE5Z624plBm');
+INSERT INTO themes (user_id, dark_mode) VALUES (18, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (19, 'akira680', '常磐', '普段薬剤師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://akira68.example.com/\nメールアドレス: akira68@example.com\n', '$2a$04$mAbZO/nJE.f8CjUSmm/IE.hqbx6XvnWlJmbNNi0ikU4p4mRcSDfuy');
+INSERT INTO themes (user_id, dark_mode) VALUES (19, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (20, 'vmaeda0', '月華', '普段バスガイドをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://vmaeda.example.com/\nメールアドレス: vmaeda@example.com\n', '$2a$04$aOtXqzAbj22O492SA6pp4euu5zHmRfxxNoG.Yp4qQf9O2NiKpmVJu');
+INSERT INTO themes (user_id, dark_mode) VALUES (20, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (21, 'jnakamura0', '星降るプリン', '普段作曲家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://jnakamura.example.com/\nメールアドレス: jnakamura@example.com\n', '$2a$04$/0I5Z3FbgSF8C7k8qSfp3.ytDI6zVKZQ0u1qb4OeREpHxQzl6CTFW');
+INSERT INTO themes (user_id, dark_mode) VALUES (21, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (22, 'suzukitsubasa0', 'なまなまなまがし', '普段アナウンサーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://suzukitsubasa.example.com/\nメールアドレス: suzukitsubasa@example.com\n', '$2a$04$b9YqfFmp0r1WI.OGkygre./9HgRDPIFojEZZerozLRkDmQI1Fgz9a');
+INSERT INTO themes (user_id, dark_mode) VALUES (22, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (23, 'yoshidatomoya0', 'こくこくこくとう', '普段花火師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yoshidatomoya.example.com/\nメールアドレス: yoshidatomoya@example.com\n', '$2a$04$kdb7klvhAf6bAf.prygvoungTlvAxg6KZkBOYyo4W6Zi3sU3I495m');
+INSERT INTO themes (user_id, dark_mode) VALUES (23, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (24, 'qendo0', 'こいのぼりこがねもち', '普段アイドルをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://qendo.example.com/\nメールアドレス: qendo@example.com\n', '$2a$04$AXKaWtL0qEK/8xW.80xPMey9ariOJJgDcAxIsh9/aZ0fp7NSIC8cq');
+INSERT INTO themes (user_id, dark_mode) VALUES (24, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (25, 'haruka030', 'もちもちどら焼き', '普段経営者をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://haruka03.example.com/\nメールアドレス: haruka03@example.com\n', '$2a$04$rJ7mfRo9Aw9VW5mcgkH/se52xwvhBDUVDZEKjsrpxkUsp5ITv2zOS');
+INSERT INTO themes (user_id, dark_mode) VALUES (25, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (26, 'saitotakuma1', 'ゆぶらゆぶらん', '普段行政書士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://saitotakuma.example.com/\nメールアドレス: saitotakuma@example.com\n', '$2a$04$xPHuVYa.1gExp.Xh3kUESOhgaBBHfbTwutEYI8rrOzwOIWM.xLHg.');
+INSERT INTO themes (user_id, dark_mode) VALUES (26, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (27, 'bsuzuki0', 'はなはなはなもち', '普段花火師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://bsuzuki.example.com/\nメールアドレス: bsuzuki@example.com\n', '$2a$04$se4XG.MHrkFFuSXB/aSX1uNxqY0LdYnetRSqOvTrrgFtuB9UP84TK');
+INSERT INTO themes (user_id, dark_mode) VALUES (27, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (28, 'shohei720', 'はにはにはにもち', '普段アートディレクターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://shohei72.example.com/\nメールアドレス: shohei72@example.com\n', '$2a$04$As9ZsAB/qUV3RHXTPW0LDOecwR.GqPIhbBHyXmgTHxVOE3xE/8G8i');
+INSERT INTO themes (user_id, dark_mode) VALUES (28, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (29, 'naoko980', 'くまのみくじゅつ', '普段救急救命士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://naoko98.example.com/\nメールアドレス: naoko98@example.com\n', '$2a$04$a2FyUeWJPpgE//4QaPNUSePy8ie9/KM46MavQwzvTx32r05ZE2vPC');
+INSERT INTO themes (user_id, dark_mode) VALUES (29, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (30, 'suzukiryohei0', 'ももももち', '普段絵本作家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://suzukiryohei.example.com/\nメールアドレス: suzukiryohei@example.com\n', '$2a$04$1eF6i15wMQvGByE78X53Su7GiDOGKGBGtLuESNq.QS5YwXsICaIx6');
+INSERT INTO themes (user_id, dark_mode) VALUES (30, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (31, 'kobayashisayuri0', 'ふくろうのふくれんじ', '普段運転士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kobayashisayuri.example.com/\nメールアドレス: kobayashisayuri@example.com\n', '$2a$04$dAHk5cZpQrHAyj1mw5.HW.W15pHZm5nwY0uPmN/4hknber.FNS.m.');
+INSERT INTO themes (user_id, dark_mode) VALUES (31, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (32, 'ykobayashi0', 'つむつむつむぎもち', '普段脚本家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ykobayashi.example.com/\nメールアドレス: ykobayashi@example.com\n', '$2a$04$nvAPnhFN3DbDGykNeY9u6e7uZx/RH8ID7JhwXIjNImjsPzZeTJQXu');
+INSERT INTO themes (user_id, dark_mode) VALUES (32, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (33, 'asuzuki0', '彩雲葛餅', '普段農家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://asuzuki.example.com/\nメールアドレス: asuzuki@example.com\n', '$2a$04$te4Lr4UAR1nJRlW.n17STuSR3HDjx1wCvnEKyQso4x/tlpWtmOtaa');
+INSERT INTO themes (user_id, dark_mode) VALUES (33, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (34, 'sotaro880', 'いちごいちごかんきんこうせ', '普段占い師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://sotaro88.example.com/\nメールアドレス: sotaro88@example.com\n', '$2a$04$6CW3TBbvY3ja/vT.gfdDS.m0M1BmgxjZRE7Xyunk6BFDEkbqlaqn6');
+INSERT INTO themes (user_id, dark_mode) VALUES (34, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (35, 'nyamaguchi0', '彩雲葛餅', '普段裁判官をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://nyamaguchi.example.com/\nメールアドレス: nyamaguchi@example.com\n', '$2a$04$dSbZPImycO340SYKNxZ/Q.Gwbz9g7hIJ7Cnddjqptv6Zmf6HVgyzC');
+INSERT INTO themes (user_id, dark_mode) VALUES (35, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (36, 'momokohashimoto0', 'たいようたね', '普段イラストレーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://momokohashimoto.example.com/\nメールアドレス: momokohashimoto@example.com\n', '$2a$04$aGeuD2L8RycBjr3DqWbZUOs2w90DCowMfOFLpkW8sabWl1s3FmRoK');
+INSERT INTO themes (user_id, dark_mode) VALUES (36, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (37, 'suzukinaoki0', '春風', '普段救急救命士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://suzukinaoki.example.com/\nメールアドレス: suzukinaoki@example.com\n', '$2a$04$jlMvCu6723IQhhKTEgwZWeRA0MoVuDp5Jm1TXkr4VYaunzWewwI0G');
+INSERT INTO themes (user_id, dark_mode) VALUES (37, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (38, 'wgoto0', 'おおきなおはぎ', '普段測量士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://wgoto.example.com/\nメールアドレス: wgoto@example.com\n', '$2a$04$P3AbZWSr0kefGE86Jzy.rOK3eTvN1XZA4R5igmI.qgmuj8gvV29J.');
+INSERT INTO themes (user_id, dark_mode) VALUES (38, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (39, 'tomoya540', '夏光', '普段調理師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tomoya54.example.com/\nメールアドレス: tomoya54@example.com\n', '$2a$04$di0xhcmFLQYhttsk59.KIec.lYk2Pg2IzidWkst1sdYRjFPfIZE26');
+INSERT INTO themes (user_id, dark_mode) VALUES (39, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (40, 'fujitayoichi0', 'りんりんりんごあめ', '普段医療事務員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://fujitayoichi.example.com/\nメールアドレス: fujitayoichi@example.com\n', '$2a$04$Kyjwam4jnpx3kgsHO2LI1uNeXehTyAOKx6bLS/P2vfUimriVhpxoi');
+INSERT INTO themes (user_id, dark_mode) VALUES (40, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (41, 'kaorikato0', 'きんとききんとかん', '普段小説家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kaorikato.example.com/\nメールアドレス: kaorikato@example.com\n', '$2a$04$cGmoHuamN4w.LvrIqU1mwO8BKvYlZbmxZQFGRmSDCMRAmRCXt1B7.');
+INSERT INTO themes (user_id, dark_mode) VALUES (41, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (42, 'chiyo810', 'はにかみはっさく', '普段運転士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://chiyo81.example.com/\nメールアドレス: chiyo81@example.com\n', '$2a$04$YT65ydKjNDzNXvxK2C3NCeR2MNydvke77NqauyRGtknDidvbDng2W');
+INSERT INTO themes (user_id, dark_mode) VALUES (42, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (43, 'yito0', 'ふじふじふじもち', '普段行政書士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yito.example.com/\nメールアドレス: yito@example.com\n', '$2a$04$VcfyEdo7EZPDyAXcbt/f9.kJH2of1qAn1wbfMsQZ/WBfgN8JH4hLC');
+INSERT INTO themes (user_id, dark_mode) VALUES (43, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (44, 'tomoyakato0', 'ゆずゆずゆずれもん', '普段バスガイドをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tomoyakato.example.com/\nメールアドレス: tomoyakato@example.com\n', '$2a$04$ycsZAgjpnNgA671FYbukYeT6LCor5Db/92e3gqRdJM6cYpaHam4K6');
+INSERT INTO themes (user_id, dark_mode) VALUES (44, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (45, 'ryosukeabe0', '雲の形わらびもち', '普段アニメーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ryosukeabe.example.com/\nメールアドレス: ryosukeabe@example.com\n', '$2a$04$DJwI/7petpqGJCRmDSG5nuQnYchTl20az2rsgxu8Uc7wAImKveQCu');
+INSERT INTO themes (user_id, dark_mode) VALUES (45, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (46, 'smatsumoto0', '夜更け', '普段高等学校教員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://smatsumoto.example.com/\nメールアドレス: smatsumoto@example.com\n', '$2a$04$UwZb6IFm4tgS1pLvujdaV.sK3Gq9zN2IJyIuGjqtMyuSL/dZXfqjK');
+INSERT INTO themes (user_id, dark_mode) VALUES (46, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (47, 'vwatanabe0', 'ぱりぱりぱいす', '普段介護ヘルパーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://vwatanabe.example.com/\nメールアドレス: vwatanabe@example.com\n', '$2a$04$p.G7Rfg50hqn6oFyNHEFCesVkulf1gIMikz8y8uqA1MGDoswG8R7y');
+INSERT INTO themes (user_id, dark_mode) VALUES (47, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (48, 'sayuri650', 'うさぎうさぎうさぎもち', '普段演歌歌手をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://sayuri65.example.com/\nメールアドレス: sayuri65@example.com\n', '$2a$04$PUrpyrjNpP70jWQyFIS90OhOzVk3PRTdtDSn97v5GlHG5//.Pc8ma');
+INSERT INTO themes (user_id, dark_mode) VALUES (48, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (49, 'takahashinaoto0', 'ふわふわふわらり', '普段検察官をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://takahashinaoto.example.com/\nメールアドレス: takahashinaoto@example.com\n', '$2a$04$/xwDxJw0l7il8nerRB7Ey.zwvtCMT3HP8ha6Sil9l6ZNL8eYuTSd6');
+INSERT INTO themes (user_id, dark_mode) VALUES (49, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (50, 'hiroshisuzuki0', 'すももすももち', '普段検察官をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hiroshisuzuki.example.com/\nメールアドレス: hiroshisuzuki@example.com\n', '$2a$04$Bfs9ObF/qjudHK.wofdamOQpZWHt3yPiU2qsUEDwprjtPcPNYqGqu');
+INSERT INTO themes (user_id, dark_mode) VALUES (50, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (51, 'xyamamoto0', 'かくかくかくいも', '普段絵本作家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://xyamamoto.example.com/\nメールアドレス: xyamamoto@example.com\n', '$2a$04$GWjJPRhvDfqpEvp8bp7dW.ug.BHMx9em4WL8BSRPuMXj/ylhr8TmW');
+INSERT INTO themes (user_id, dark_mode) VALUES (51, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (52, 'osaito0', '星月夜', '普段作曲家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://osaito.example.com/\nメールアドレス: osaito@example.com\n', '$2a$04$Wyg2OV0Eg7eSCnoFqtJLgujNnkduv2.4hrKlyiAANYCN0uIq/Df0O');
+INSERT INTO themes (user_id, dark_mode) VALUES (52, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (53, 'esato0', 'たけのこたけのこもち', '普段配管工をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://esato.example.com/\nメールアドレス: esato@example.com\n', '$2a$04$t4O0rPbs5QVsxI9VFAXoyeWsJ/rHAdpFs4ChmoeOn4WStCBmHc9dC');
+INSERT INTO themes (user_id, dark_mode) VALUES (53, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (54, 'oshimizu0', 'せせせせり', '普段漫画家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://oshimizu.example.com/\nメールアドレス: oshimizu@example.com\n', '$2a$04$p555Qy6kgTbVo67k29l9VuWeqo1vPANms6He5BGOgn2ypqolyR3Re');
+INSERT INTO themes (user_id, dark_mode) VALUES (54, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (55, 'yamadamituru0', '神秘の森', '普段営業をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yamadamituru.example.com/\nメールアドレス: yamadamituru@example.com\n', '$2a$04$0puas75g4hIjKHehCQqrMe1GCX8szA3.I6oCpQ3.tK3kudFHCLcRO');
+INSERT INTO themes (user_id, dark_mode) VALUES (55, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (56, 'wmori0', 'こころきり', '普段映画監督をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://wmori.example.com/\nメールアドレス: wmori@example.com\n', '$2a$04$Mfrba/tPNPbWeMHJ67dVG.mbMrbkI/J3QQrZpKr7H4.oYt.cWZ1Ui');
+INSERT INTO themes (user_id, dark_mode) VALUES (56, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (57, 'saitorei0', 'さくらんさくらか', '普段モデルをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://saitorei.example.com/\nメールアドレス: saitorei@example.com\n', '$2a$04$7ZWkDLISNMr//oWec9KFceiQBYZ6czBLle3kvgjL9ek5B/FA82wD6');
+INSERT INTO themes (user_id, dark_mode) VALUES (57, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (58, 'kimuramiki0', 'てんてんたい焼き', '普段航海士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kimuramiki.example.com/\nメールアドレス: kimuramiki@example.com\n', '$2a$04$TMA.ea7HW0wmcLE0vd6hQ.PsJ1mZ4niDzhZN1gi7yiWPoPnawgsrm');
+INSERT INTO themes (user_id, dark_mode) VALUES (58, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (59, 'sasakiyosuke0', 'みずみずみずむし', '普段電気工事士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://sasakiyosuke.example.com/\nメールアドレス: sasakiyosuke@example.com\n', '$2a$04$807xAItPSriQOKMQ9iBW5OBfqwuWei.fJIhMJ3nlP9B9MX5yC/yo6');
+INSERT INTO themes (user_id, dark_mode) VALUES (59, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (60, 'kumiko410', 'つやつやつやぼん', '普段音楽家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kumiko41.example.com/\nメールアドレス: kumiko41@example.com\n', '$2a$04$0PccloP90oBYVZSg1GLWFO0FJ7hRVaK6/NL2dFfEtFjoe8gUYiuN6');
+INSERT INTO themes (user_id, dark_mode) VALUES (60, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (61, 'qkobayashi0', 'こころのこがねもち', '普段映画監督をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://qkobayashi.example.com/\nメールアドレス: qkobayashi@example.com\n', '$2a$04$q3ocvbPjvd5Sp1MYZwHciOn3yzDEQWVkpxkeoDzywyHlJMQX8CmXq');
+INSERT INTO themes (user_id, dark_mode) VALUES (61, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (62, 'akondo0', 'つつじつつじつぶ', '普段経営者をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://akondo.example.com/\nメールアドレス: akondo@example.com\n', '$2a$04$C5kAIMdMDC9Fcap9LTzBK.4NEn8gIGZgPWzelYFLddPbIni7MZETG');
+INSERT INTO themes (user_id, dark_mode) VALUES (62, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (63, 'ywatanabe0', '粋', '普段小説家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ywatanabe.example.com/\nメールアドレス: ywatanabe@example.com\n', '$2a$04$RbZ/5L2foJkNG2EgXMUblOgZ6xmEdxGTxQjZqyu4lCaqGR9yuCr3y');
+INSERT INTO themes (user_id, dark_mode) VALUES (63, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (64, 'otanaoki0', 'いちごいろは', '普段エステティシャンをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://otanaoki.example.com/\nメールアドレス: otanaoki@example.com\n', '$2a$04$DpI07.7ql.45WjIfl28LP.8nI005.krxyHLudkIdX0CKmcW4OCh6m');
+INSERT INTO themes (user_id, dark_mode) VALUES (64, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (65, 'naotosasaki0', '空飛ぶ鶴', '普段公務員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://naotosasaki.example.com/\nメールアドレス: naotosasaki@example.com\n', '$2a$04$.GkE/OUCwvZ2gheBHs7q4uOf/YMfAj9PNpYn6jCwrOEx16dspw2qa');
+INSERT INTO themes (user_id, dark_mode) VALUES (65, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (66, 'momokosuzuki0', 'きらきらきらり', '普段検察官をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://momokosuzuki.example.com/\nメールアドレス: momokosuzuki@example.com\n', '$2a$04$3EUNF8B0yBBbGUWMH0jo1.2p.tlXVGi3Q3cdvU8rJuHgmJo4QwOQG');
+INSERT INTO themes (user_id, dark_mode) VALUES (66, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (67, 'maayayoshida0', 'うりうりういろう', '普段イラストレーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://maayayoshida.example.com/\nメールアドレス: maayayoshida@example.com\n', '$2a$04$s.JWzrfBPfrBq5gyPL7sv.ldvADiNe2US9iKWtL.qY5qm1zZeAFge');
+INSERT INTO themes (user_id, dark_mode) VALUES (67, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (68, 'hashimotoasuka0', 'ぱりぱりぱいす', '普段音楽家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hashimotoasuka.example.com/\nメールアドレス: hashimotoasuka@example.com\n', '$2a$04$WE0FHGKNQ0HAEBZ8Xi9NDe32IIIyjTv9X3yuBQhvbS5U9BrylEMEW');
+INSERT INTO themes (user_id, dark_mode) VALUES (68, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (69, 'maayanakagawa0', '桜花', '普段コピーライターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://maayanakagawa.example.com/\nメールアドレス: maayanakagawa@example.com\n', '$2a$04$NTzkO97hOWpI1U3mx.odt.LreHylHcR9RjZkBPhSqbnJ.U6jOhI2a');
+INSERT INTO themes (user_id, dark_mode) VALUES (69, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (70, 'ltanaka0', '風情', '普段アーティストをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ltanaka.example.com/\nメールアドレス: ltanaka@example.com\n', '$2a$04$Z27Z/RwqmDnOXnXFiqbNBOZN.D.GqX0VV/AZQUgUQ7kqfA5MMBn7m');
+INSERT INTO themes (user_id, dark_mode) VALUES (70, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (71, 'jyamada0', '星座', '普段公認会計士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://jyamada.example.com/\nメールアドレス: jyamada@example.com\n', '$2a$04$KMktrTFWS6MIiy9InssireRwhpPrhWbgjy0eQqyoNIKaboUvqJ6.K');
+INSERT INTO themes (user_id, dark_mode) VALUES (71, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (72, 'yasuhiro650', 'ふなふなふなわり', '普段ゲームクリエイターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yasuhiro65.example.com/\nメールアドレス: yasuhiro65@example.com\n', '$2a$04$xpe.xozmfHv7Z/2jNRuaee1bD8IXeEi4WDXM4zF76kcJ0UCZK0aLW');
+INSERT INTO themes (user_id, dark_mode) VALUES (72, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (73, 'hiroshitanaka0', 'ひとやすみ', '普段大学教授をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hiroshitanaka.example.com/\nメールアドレス: hiroshitanaka@example.com\n', '$2a$04$5CnWWFdt4IauwyC4BjqTtuDrLiQ7Jkvi1MkaOiojVvQ1vMQER.p.W');
+INSERT INTO themes (user_id, dark_mode) VALUES (73, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (74, 'yamashitaakemi0', 'さくらんさくらかんき', '普段航海士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yamashitaakemi.example.com/\nメールアドレス: yamashitaakemi@example.com\n', '$2a$04$GO61jM8wsg0iiiPC6KWmZe05mDmajliBl0GtxRLCnK2eq5Mo/.C/O');
+INSERT INTO themes (user_id, dark_mode) VALUES (74, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (75, 'hidekiishikawa0', 'うきうきういろう', '普段ウェブデザイナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hidekiishikawa.example.com/\nメールアドレス: hidekiishikawa@example.com\n', '$2a$04$nBWPPUXpkUpHWWZ7kJYR2OLxhYsiLuAVeDsDCO1WcQZEQ3Q8O9jFC');
+INSERT INTO themes (user_id, dark_mode) VALUES (75, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (76, 'kanatakahashi0', '古道', '普段調理師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kanatakahashi.example.com/\nメールアドレス: kanatakahashi@example.com\n', '$2a$04$T5oIWCDo9kr3TdZy8Ek1TegBsy3rzF0u/9HrJex1uRdED8xwE8Hhy');
+INSERT INTO themes (user_id, dark_mode) VALUES (76, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (77, 'akemiito0', 'さくらさくらんぼ', '普段公認会計士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://akemiito.example.com/\nメールアドレス: akemiito@example.com\n', '$2a$04$uyoIa6HiiwtLEadlWJBcLeWrQ02LtC9hBtKy99UWDMr1fekNacxBS');
+INSERT INTO themes (user_id, dark_mode) VALUES (77, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (78, 'manabu800', 'つきみだいふく', '普段歯科医師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://manabu80.example.com/\nメールアドレス: manabu80@example.com\n', '$2a$04$wNDY/crOYymoNs8G1gyRNO36/YSWS/aL8Mcv.h2nDm9..TFoJ7hCa');
+INSERT INTO themes (user_id, dark_mode) VALUES (78, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (79, 'shota790', 'きりきりきりふく', '普段調理師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://shota79.example.com/\nメールアドレス: shota79@example.com\n', '$2a$04$kajn7WCr5lgnPy73ZudHxOy.XPkhEMTCPwIW.ANUhrqTViKKrWwbm');
+INSERT INTO themes (user_id, dark_mode) VALUES (79, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (80, 'naokoaoki0', 'そばそばそばかす', '普段営業をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://naokoaoki.example.com/\nメールアドレス: naokoaoki@example.com\n', '$2a$04$EhXqaOrq7tGcR61FKb1vxOVNSeLubLpFoRJDXxERMvUQZH5a.tT/q');
+INSERT INTO themes (user_id, dark_mode) VALUES (80, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (81, 'gyamamoto0', '夕日柿', '普段モデルをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://gyamamoto.example.com/\nメールアドレス: gyamamoto@example.com\n', '$2a$04$5Lh81d1JW1AeHNueEENUVumXWpa6qhU.QRn0YJ1XFWRWg69Tc4PkG');
+INSERT INTO themes (user_id, dark_mode) VALUES (81, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (82, 'xyamaguchi0', 'うめうめうめかんきんこう', '普段YouTuberをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://xyamaguchi.example.com/\nメールアドレス: xyamaguchi@example.com\n', '$2a$04$uj2kC5wJNTrXKzFGTBfdhOKC.DYwJ6tejgVWdkZbzVXp/wY7AHW6m');
+INSERT INTO themes (user_id, dark_mode) VALUES (82, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (83, 'katokumiko0', 'ころころこまねこ', '普段配管工をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://katokumiko.example.com/\nメールアドレス: katokumiko@example.com\n', '$2a$04$mOWpE7fPGGnKrMDLz0o71u6FPNfIu/60SvKe9CNTKAAQ4cGd48a1m');
+INSERT INTO themes (user_id, dark_mode) VALUES (83, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (84, 'xyamada0', '蜜柑糖', '普段花火師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://xyamada.example.com/\nメールアドレス: xyamada@example.com\n', '$2a$04$evS0vE3fQ4gtple5.lY6BOatdNX6jKG6eQlpbRtYQDNBQxbVRoiFW');
+INSERT INTO themes (user_id, dark_mode) VALUES (84, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (85, 'qyamashita0', '夏光', '普段裁判官をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://qyamashita.example.com/\nメールアドレス: qyamashita@example.com\n', '$2a$04$4g7GzmasnxaXMkyaniG3AuuyP/TS0sq1x5oTi9KphY.59juebN51W');
+INSERT INTO themes (user_id, dark_mode) VALUES (85, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (86, 'pwatanabe0', 'つるんとしたると', '普段コピーライターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://pwatanabe.example.com/\nメールアドレス: pwatanabe@example.com\n', '$2a$04$q20IW8IrwmTn9BWDq349ROYis2Tr3iQ3dvagCKd21VSKSlB1bLNla');
+INSERT INTO themes (user_id, dark_mode) VALUES (86, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (87, 'ryoheiishikawa0', 'つぶつぶつぶかんきんこうせ', '普段音楽家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ryoheiishikawa.example.com/\nメールアドレス: ryoheiishikawa@example.com\n', '$2a$04$W1M2RwbskgN4Xcj3SfoNreWw4p6TbM7cbhQHE5vxbDxQDEefwUxny');
+INSERT INTO themes (user_id, dark_mode) VALUES (87, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (88, 'nakamuraharuka0', 'ちょこんまんじゅう', '普段司法書士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://nakamuraharuka.example.com/\nメールアドレス: nakamuraharuka@example.com\n', '$2a$04$15IR25zhI4AmeMXNpzRC9eXFostWbJzQ0AkT7qD4.E5VvgwVIeKJq');
+INSERT INTO themes (user_id, dark_mode) VALUES (88, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (89, 'pfukuda0', 'ぷくぷくぷりん', '普段歯科医師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://pfukuda.example.com/\nメールアドレス: pfukuda@example.com\n', '$2a$04$34kAlIoUPVRvakcqe/xy8.YXw0R3Xq6c91qsch0rfR/MfiBTNkaTi');
+INSERT INTO themes (user_id, dark_mode) VALUES (89, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (90, 'saitoyosuke0', '秋声', '普段航海士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://saitoyosuke.example.com/\nメールアドレス: saitoyosuke@example.com\n', '$2a$04$lAp9YLJjs/1DljHgTubJBuL5r/.2NZDMnTylFpYBsdYeaznUcDkJ.');
+INSERT INTO themes (user_id, dark_mode) VALUES (90, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (91, 'morijun0', '灯り', '普段薬剤師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://morijun.example.com/\nメールアドレス: morijun@example.com\n', '$2a$04$ywZmBv6LQYbWGpA3yxaMcOIEQn1G.czkAd5uEK7fTeq3lfoaMoVLW');
+INSERT INTO themes (user_id, dark_mode) VALUES (91, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (92, 'hiroshiokamoto0', 'くすくすくりん', '普段裁判官をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hiroshiokamoto.example.com/\nメールアドレス: hiroshiokamoto@example.com\n', '$2a$04$w5.MtTa6RDKEbD9j7XnUnOAYBcpug2XAVrjLgFg3wrlRHppbO52Gy');
+INSERT INTO themes (user_id, dark_mode) VALUES (92, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (93, 'kazuyahayashi0', 'みずみずしいみずあめ', '普段占い師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kazuyahayashi.example.com/\nメールアドレス: kazuyahayashi@example.com\n', '$2a$04$0z1ni/zkE6a9Sfsaq3vb6O.Xma1va8H3h5/IfFVBH0MxrUI.OZRNm');
+INSERT INTO themes (user_id, dark_mode) VALUES (93, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (94, 'anakajima0', 'そばそばそばかす', '普段イラストレーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://anakajima.example.com/\nメールアドレス: anakajima@example.com\n', '$2a$04$f1BJVM5MCc6MpOOEbCtJGOI8xY3Alas0Ov8fVNuYej8b1idv59cCW');
+INSERT INTO themes (user_id, dark_mode) VALUES (94, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (95, 'maaya110', 'ほたるほたるいか', '普段救急救命士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://maaya11.example.com/\nメールアドレス: maaya11@example.com\n', '$2a$04$JNkWqunO87FabYimlZkUqOyC02iDIbfQB35m.PKW7N/x2U8uqCEEW');
+INSERT INTO themes (user_id, dark_mode) VALUES (95, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (96, 'kazuya250', 'ゆぶらゆぶらん', '普段ゲームクリエイターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kazuya25.example.com/\nメールアドレス: kazuya25@example.com\n', '$2a$04$cyjbSBfNcFM.VG2ovtnqg.xlmhsOaEy.yygmJ7hlNIM2STJ4Wgh5.');
+INSERT INTO themes (user_id, dark_mode) VALUES (96, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (97, 'kondoakira0', 'まみまみまみれもち', '普段エステティシャンをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kondoakira.example.com/\nメールアドレス: kondoakira@example.com\n', '$2a$04$qziCjx2Kh1JpW/bYzjKFc.v7jrvki3LJjpAxZqnlRJN.qn.NBT492');
+INSERT INTO themes (user_id, dark_mode) VALUES (97, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (98, 'atsushi920', '風鈴', '普段映画監督をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://atsushi92.example.com/\nメールアドレス: atsushi92@example.com\n', '$2a$04$6/rZQ./QnEYIa/XCChrIlOtQ.62Z0ZSRmWR27AmuY43XKPXcn8UmW');
+INSERT INTO themes (user_id, dark_mode) VALUES (98, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (99, 'hanakokondo0', 'うさぎうさぎうさぎもち', '普段公務員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hanakokondo.example.com/\nメールアドレス: hanakokondo@example.com\n', '$2a$04$4R3oaiwgL3jFDx4XkSPgWeK.uk35cdQm.wKhYVFSFMREEtEUtuPZu');
+INSERT INTO themes (user_id, dark_mode) VALUES (99, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (100, 'naoki220', 'ふくふくふくろすけ', '普段調理師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://naoki22.example.com/\nメールアドレス: naoki22@example.com\n', '$2a$04$/v16fIbxYBiHvtEmtjgydeJ/fUI2H0OhCgNdTReh5WZUtHYvubDDi');
+INSERT INTO themes (user_id, dark_mode) VALUES (100, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (101, 'hiroshi180', 'しろいしろっぷ', '普段イラストレーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hiroshi18.example.com/\nメールアドレス: hiroshi18@example.com\n', '$2a$04$0BRufcJy.4FPaIDjWxsKz.5JBQQMoGZzVniBFU0s7jaC.8pHSiuaK');
+INSERT INTO themes (user_id, dark_mode) VALUES (101, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (102, 'jun820', 'きんとききんとかんきんこ', '普段作曲家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://jun82.example.com/\nメールアドレス: jun82@example.com\n', '$2a$04$GvL4Wts8RiOULOE9qAYZWeX6pqOaW9F3MoWrqiAtMt3K.tepN85Xq');
+INSERT INTO themes (user_id, dark_mode) VALUES (102, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (103, 'onakamura0', 'こころこころこもち', '普段救急救命士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://onakamura.example.com/\nメールアドレス: onakamura@example.com\n', '$2a$04$OHdW6thFVfbi6tPhy5lXGeiFAShOFDz0xFVG2iSjM0yZ.jnAa0Uqq');
+INSERT INTO themes (user_id, dark_mode) VALUES (103, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (104, 'takuma040', 'あんこあんこか', '普段アーティストをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://takuma04.example.com/\nメールアドレス: takuma04@example.com\n', '$2a$04$0PulOoA5M9qCiCU4oL0O1OqnrGD./.dGLQPwuTf9SfioljG1ImG7.');
+INSERT INTO themes (user_id, dark_mode) VALUES (104, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (105, 'kobayashiyasuhiro0', 'ちゅうりっぷちゅるん', '普段航海士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kobayashiyasuhiro.example.com/\nメールアドレス: kobayashiyasuhiro@example.com\n', '$2a$04$lBKwG4y37PBniflzaf/iY.9KYWo2ZBd6JSfvgT9UG0XA80uSShAJW');
+INSERT INTO themes (user_id, dark_mode) VALUES (105, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (106, 'reiwatanabe0', 'いちごいちごいち', '普段エステティシャンをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://reiwatanabe.example.com/\nメールアドレス: reiwatanabe@example.com\n', '$2a$04$pEqzGw.XCz0voYRH3QMJTO59V1WsTacBbGb/K2UETzzAEqaz6xU96');
+INSERT INTO themes (user_id, dark_mode) VALUES (106, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (107, 'hideki630', '空色わらび', '普段介護ヘルパーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hideki63.example.com/\nメールアドレス: hideki63@example.com\n', '$2a$04$j./lZgNj/fSYZqg7O7BDue3T7Ijeun6VVUizLRGFMheTUMIqcYK2y');
+INSERT INTO themes (user_id, dark_mode) VALUES (107, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (108, 'yutayoshida0', 'さくらんぼもなか', '普段行政書士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yutayoshida.example.com/\nメールアドレス: yutayoshida@example.com\n', '$2a$04$sLLGF.m9gbfbm5pTeUjpvuW89BW.yNEylciEbeGzsacMmbCN1DnSC');
+INSERT INTO themes (user_id, dark_mode) VALUES (108, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (109, 'yutamori0', 'きんとききんとかんきんこうせいさ', '普段薬剤師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yutamori.example.com/\nメールアドレス: yutamori@example.com\n', '$2a$04$uu1XhQxhkzIJsJ7Tzw8qfuH/wivo4hkEYkFKOP0xKulBk2nxZPEqS');
+INSERT INTO themes (user_id, dark_mode) VALUES (109, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (110, 'ryosukeyamamoto0', '夕闇', '普段配管工をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ryosukeyamamoto.example.com/\nメールアドレス: ryosukeyamamoto@example.com\n', '$2a$04$qUPj6ngqW37MzPM4LsJ7QOBsEW7qF0fUVyE4T3QJYH4AKVozbNC9q');
+INSERT INTO themes (user_id, dark_mode) VALUES (110, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (111, 'manabuota0', '宵闇', '普段脚本家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://manabuota.example.com/\nメールアドレス: manabuota@example.com\n', '$2a$04$ah/ZrA65mvjSG9M2zRSUcegW1d1d.lzyMkKl0leUmnkmTEBGogPp.');
+INSERT INTO themes (user_id, dark_mode) VALUES (111, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (112, 'nsakamoto0', 'しっとりしっくり', '普段アニメーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://nsakamoto.example.com/\nメールアドレス: nsakamoto@example.com\n', '$2a$04$sdAySrC/p9LWk5J.r5LM.udKeDM02c0eSXn3GFCrJ0uMUOmDlQDy2');
+INSERT INTO themes (user_id, dark_mode) VALUES (112, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (113, 'wtanaka0', 'りんりんりんご', '普段調理師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://wtanaka.example.com/\nメールアドレス: wtanaka@example.com\n', '$2a$04$uHf5EFSsbhKGqYandZv/Z.kwJ.UHjKBL5qD9hr8us.PEoIZTxNQrq');
+INSERT INTO themes (user_id, dark_mode) VALUES (113, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (114, 'inakamura0', 'いちごいがり', '普段測量士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://inakamura.example.com/\nメールアドレス: inakamura@example.com\n', '$2a$04$2HuLJJa1611votr5CHKX/OS3xK1fAREGgbmLKSWo4Ewf5bzDGvCwi');
+INSERT INTO themes (user_id, dark_mode) VALUES (114, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (115, 'ymori0', '神秘の夜ふわふわ', '普段司法書士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ymori.example.com/\nメールアドレス: ymori@example.com\n', '$2a$04$LTNRQBExFHY8lQCn44lo/ehB/NRpBIpddcfgIVLGGUYtvJmzJ3/9O');
+INSERT INTO themes (user_id, dark_mode) VALUES (115, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (116, 'nfujii0', 'しっとりしっくり', '普段裁判官をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://nfujii.example.com/\nメールアドレス: nfujii@example.com\n', '$2a$04$vJVvUq12hZbehFmPzP.c8OnRsuSErg/5.1dLO7hq3pPFlvIQgaobu');
+INSERT INTO themes (user_id, dark_mode) VALUES (116, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (117, 'osamu950', 'ちょっぴりちょこれーと', '普段小説家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://osamu95.example.com/\nメールアドレス: osamu95@example.com\n', '$2a$04$zoGEpfPL0Ypk8OengyTkvuOdM0QVy30SWsY1C7y1aBvWBICJYu51y');
+INSERT INTO themes (user_id, dark_mode) VALUES (117, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (118, 'yuinakagawa0', 'こっぷくりん', '普段ゲームクリエイターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yuinakagawa.example.com/\nメールアドレス: yuinakagawa@example.com\n', '$2a$04$NFEv49zphsEdwCNLi6QYz.FbSSEyNqGuIsJ9hNj.nbXE5Z624plBm');
+INSERT INTO themes (user_id, dark_mode) VALUES (118, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (119, 'uhayashi0', '桜前線', '普段脚本家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://uhayashi.example.com/\nメールアドレス: uhayashi@example.com\n', '$2a$04$mAbZO/nJE.f8CjUSmm/IE.hqbx6XvnWlJmbNNi0ikU4p4mRcSDfuy');
+INSERT INTO themes (user_id, dark_mode) VALUES (119, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (120, 'momoko070', '暗夜', '普段アーティストをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://momoko07.example.com/\nメールアドレス: momoko07@example.com\n', '$2a$04$aOtXqzAbj22O492SA6pp4euu5zHmRfxxNoG.Yp4qQf9O2NiKpmVJu');
+INSERT INTO themes (user_id, dark_mode) VALUES (120, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (121, 'myamada0', 'つるんとしたると', '普段ウェディングプランナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://myamada.example.com/\nメールアドレス: myamada@example.com\n', '$2a$04$/0I5Z3FbgSF8C7k8qSfp3.ytDI6zVKZQ0u1qb4OeREpHxQzl6CTFW');
+INSERT INTO themes (user_id, dark_mode) VALUES (121, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (122, 'zyoshida0', 'いちごいちごかん', '普段電気工事士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://zyoshida.example.com/\nメールアドレス: zyoshida@example.com\n', '$2a$04$b9YqfFmp0r1WI.OGkygre./9HgRDPIFojEZZerozLRkDmQI1Fgz9a');
+INSERT INTO themes (user_id, dark_mode) VALUES (122, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (123, 'watanabesatomi0', 'ふくろうのふくれんじ', '普段寿司職人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://watanabesatomi.example.com/\nメールアドレス: watanabesatomi@example.com\n', '$2a$04$kdb7klvhAf6bAf.prygvoungTlvAxg6KZkBOYyo4W6Zi3sU3I495m');
+INSERT INTO themes (user_id, dark_mode) VALUES (123, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (124, 'watanabemanabu0', '月夜', '普段調理師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://watanabemanabu.example.com/\nメールアドレス: watanabemanabu@example.com\n', '$2a$04$AXKaWtL0qEK/8xW.80xPMey9ariOJJgDcAxIsh9/aZ0fp7NSIC8cq');
+INSERT INTO themes (user_id, dark_mode) VALUES (124, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (125, 'momokosuzuki1', 'ちょうちょうちょこ', '普段モデルをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://momokosuzuki.example.com/\nメールアドレス: momokosuzuki@example.com\n', '$2a$04$rJ7mfRo9Aw9VW5mcgkH/se52xwvhBDUVDZEKjsrpxkUsp5ITv2zOS');
+INSERT INTO themes (user_id, dark_mode) VALUES (125, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (126, 'jokada0', '朝焼け', '普段演歌歌手をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://jokada.example.com/\nメールアドレス: jokada@example.com\n', '$2a$04$xPHuVYa.1gExp.Xh3kUESOhgaBBHfbTwutEYI8rrOzwOIWM.xLHg.');
+INSERT INTO themes (user_id, dark_mode) VALUES (126, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (127, 'hanako640', 'くすくすくりん', '普段小説家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hanako64.example.com/\nメールアドレス: hanako64@example.com\n', '$2a$04$se4XG.MHrkFFuSXB/aSX1uNxqY0LdYnetRSqOvTrrgFtuB9UP84TK');
+INSERT INTO themes (user_id, dark_mode) VALUES (127, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (128, 'rmatsumoto0', 'ちょうちょうちょこ', '普段建築家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://rmatsumoto.example.com/\nメールアドレス: rmatsumoto@example.com\n', '$2a$04$As9ZsAB/qUV3RHXTPW0LDOecwR.GqPIhbBHyXmgTHxVOE3xE/8G8i');
+INSERT INTO themes (user_id, dark_mode) VALUES (128, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (129, 'maayasasaki0', 'ちょっぴりちょこれーと', '普段営業をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://maayasasaki.example.com/\nメールアドレス: maayasasaki@example.com\n', '$2a$04$a2FyUeWJPpgE//4QaPNUSePy8ie9/KM46MavQwzvTx32r05ZE2vPC');
+INSERT INTO themes (user_id, dark_mode) VALUES (129, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (130, 'ryohei450', '都風', '普段映画監督をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ryohei45.example.com/\nメールアドレス: ryohei45@example.com\n', '$2a$04$1eF6i15wMQvGByE78X53Su7GiDOGKGBGtLuESNq.QS5YwXsICaIx6');
+INSERT INTO themes (user_id, dark_mode) VALUES (130, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (131, 'takuma570', 'さくさくさっくりん', '普段イラストレーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://takuma57.example.com/\nメールアドレス: takuma57@example.com\n', '$2a$04$dAHk5cZpQrHAyj1mw5.HW.W15pHZm5nwY0uPmN/4hknber.FNS.m.');
+INSERT INTO themes (user_id, dark_mode) VALUES (131, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (132, 'yokotanaka0', 'こころこころこもち', '普段検察官をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yokotanaka.example.com/\nメールアドレス: yokotanaka@example.com\n', '$2a$04$nvAPnhFN3DbDGykNeY9u6e7uZx/RH8ID7JhwXIjNImjsPzZeTJQXu');
+INSERT INTO themes (user_id, dark_mode) VALUES (132, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (133, 'mai270', '麗し', '普段エンジニア演奏家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://mai27.example.com/\nメールアドレス: mai27@example.com\n', '$2a$04$te4Lr4UAR1nJRlW.n17STuSR3HDjx1wCvnEKyQso4x/tlpWtmOtaa');
+INSERT INTO themes (user_id, dark_mode) VALUES (133, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (134, 'rikasakamoto0', '秋雨', '普段検察官をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://rikasakamoto.example.com/\nメールアドレス: rikasakamoto@example.com\n', '$2a$04$6CW3TBbvY3ja/vT.gfdDS.m0M1BmgxjZRE7Xyunk6BFDEkbqlaqn6');
+INSERT INTO themes (user_id, dark_mode) VALUES (134, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (135, 'taro150', 'あんこあんこかんきんこうせいさ', '普段裁判官をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://taro15.example.com/\nメールアドレス: taro15@example.com\n', '$2a$04$dSbZPImycO340SYKNxZ/Q.Gwbz9g7hIJ7Cnddjqptv6Zmf6HVgyzC');
+INSERT INTO themes (user_id, dark_mode) VALUES (135, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (136, 'suzukimomoko0', '菜の花', '普段電気工事士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://suzukimomoko.example.com/\nメールアドレス: suzukimomoko@example.com\n', '$2a$04$aGeuD2L8RycBjr3DqWbZUOs2w90DCowMfOFLpkW8sabWl1s3FmRoK');
+INSERT INTO themes (user_id, dark_mode) VALUES (136, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (137, 'watanabeyoichi0', '湯の花', '普段グラフィックデザイナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://watanabeyoichi.example.com/\nメールアドレス: watanabeyoichi@example.com\n', '$2a$04$jlMvCu6723IQhhKTEgwZWeRA0MoVuDp5Jm1TXkr4VYaunzWewwI0G');
+INSERT INTO themes (user_id, dark_mode) VALUES (137, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (138, 'yuki620', '秋風パイ', '普段栄養士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yuki62.example.com/\nメールアドレス: yuki62@example.com\n', '$2a$04$P3AbZWSr0kefGE86Jzy.rOK3eTvN1XZA4R5igmI.qgmuj8gvV29J.');
+INSERT INTO themes (user_id, dark_mode) VALUES (138, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (139, 'tsubasainoue0', 'ふくろうのふくれんじ', '普段ゲームクリエイターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tsubasainoue.example.com/\nメールアドレス: tsubasainoue@example.com\n', '$2a$04$di0xhcmFLQYhttsk59.KIec.lYk2Pg2IzidWkst1sdYRjFPfIZE26');
+INSERT INTO themes (user_id, dark_mode) VALUES (139, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (140, 'yokotakahashi0', 'くすくすくりん', '普段モデルをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yokotakahashi.example.com/\nメールアドレス: yokotakahashi@example.com\n', '$2a$04$Kyjwam4jnpx3kgsHO2LI1uNeXehTyAOKx6bLS/P2vfUimriVhpxoi');
+INSERT INTO themes (user_id, dark_mode) VALUES (140, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (141, 'yumikohayashi0', 'こももこもち', '普段行政書士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yumikohayashi.example.com/\nメールアドレス: yumikohayashi@example.com\n', '$2a$04$cGmoHuamN4w.LvrIqU1mwO8BKvYlZbmxZQFGRmSDCMRAmRCXt1B7.');
+INSERT INTO themes (user_id, dark_mode) VALUES (141, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (142, 'yamaguchiyumiko0', '麗し', '普段演歌歌手をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yamaguchiyumiko.example.com/\nメールアドレス: yamaguchiyumiko@example.com\n', '$2a$04$YT65ydKjNDzNXvxK2C3NCeR2MNydvke77NqauyRGtknDidvbDng2W');
+INSERT INTO themes (user_id, dark_mode) VALUES (142, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (143, 'kkato0', 'うめうめうめかんきんこう', '普段検察官をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kkato.example.com/\nメールアドレス: kkato@example.com\n', '$2a$04$VcfyEdo7EZPDyAXcbt/f9.kJH2of1qAn1wbfMsQZ/WBfgN8JH4hLC');
+INSERT INTO themes (user_id, dark_mode) VALUES (143, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (144, 'minoruyoshida0', 'たいたいたいやき', '普段演歌歌手をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://minoruyoshida.example.com/\nメールアドレス: minoruyoshida@example.com\n', '$2a$04$ycsZAgjpnNgA671FYbukYeT6LCor5Db/92e3gqRdJM6cYpaHam4K6');
+INSERT INTO themes (user_id, dark_mode) VALUES (144, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (145, 'tmurakami0', 'あんこあんこかんき', '普段電気工事士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tmurakami.example.com/\nメールアドレス: tmurakami@example.com\n', '$2a$04$DJwI/7petpqGJCRmDSG5nuQnYchTl20az2rsgxu8Uc7wAImKveQCu');
+INSERT INTO themes (user_id, dark_mode) VALUES (145, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (146, 'hasegawanaoto0', 'ふんわりふんわか', '普段アーティストをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hasegawanaoto.example.com/\nメールアドレス: hasegawanaoto@example.com\n', '$2a$04$UwZb6IFm4tgS1pLvujdaV.sK3Gq9zN2IJyIuGjqtMyuSL/dZXfqjK');
+INSERT INTO themes (user_id, dark_mode) VALUES (146, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (147, 'kumikowatanabe0', 'たくたくたくあん', '普段経営者をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kumikowatanabe.example.com/\nメールアドレス: kumikowatanabe@example.com\n', '$2a$04$p.G7Rfg50hqn6oFyNHEFCesVkulf1gIMikz8y8uqA1MGDoswG8R7y');
+INSERT INTO themes (user_id, dark_mode) VALUES (147, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (148, 'lota0', 'すずすずすずらん', '普段ウェブデザイナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://lota.example.com/\nメールアドレス: lota@example.com\n', '$2a$04$PUrpyrjNpP70jWQyFIS90OhOzVk3PRTdtDSn97v5GlHG5//.Pc8ma');
+INSERT INTO themes (user_id, dark_mode) VALUES (148, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (149, 'yoichi440', '空の旅', '普段高等学校教員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yoichi44.example.com/\nメールアドレス: yoichi44@example.com\n', '$2a$04$/xwDxJw0l7il8nerRB7Ey.zwvtCMT3HP8ha6Sil9l6ZNL8eYuTSd6');
+INSERT INTO themes (user_id, dark_mode) VALUES (149, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (150, 'sayurikondo0', 'ゆずゆずゆずもち', '普段漫画家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://sayurikondo.example.com/\nメールアドレス: sayurikondo@example.com\n', '$2a$04$Bfs9ObF/qjudHK.wofdamOQpZWHt3yPiU2qsUEDwprjtPcPNYqGqu');
+INSERT INTO themes (user_id, dark_mode) VALUES (150, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (151, 'xogawa0', '桜吹雪', '普段YouTuberをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://xogawa.example.com/\nメールアドレス: xogawa@example.com\n', '$2a$04$GWjJPRhvDfqpEvp8bp7dW.ug.BHMx9em4WL8BSRPuMXj/ylhr8TmW');
+INSERT INTO themes (user_id, dark_mode) VALUES (151, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (152, 'naoki250', 'まんまるもち', '普段映画監督をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://naoki25.example.com/\nメールアドレス: naoki25@example.com\n', '$2a$04$Wyg2OV0Eg7eSCnoFqtJLgujNnkduv2.4hrKlyiAANYCN0uIq/Df0O');
+INSERT INTO themes (user_id, dark_mode) VALUES (152, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (153, 'eokada0', 'くるみくるみん', '普段ウェブデザイナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://eokada.example.com/\nメールアドレス: eokada@example.com\n', '$2a$04$t4O0rPbs5QVsxI9VFAXoyeWsJ/rHAdpFs4ChmoeOn4WStCBmHc9dC');
+INSERT INTO themes (user_id, dark_mode) VALUES (153, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (154, 'satomiyamamoto0', 'つぶつぶつぶあん', '普段配管工をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://satomiyamamoto.example.com/\nメールアドレス: satomiyamamoto@example.com\n', '$2a$04$p555Qy6kgTbVo67k29l9VuWeqo1vPANms6He5BGOgn2ypqolyR3Re');
+INSERT INTO themes (user_id, dark_mode) VALUES (154, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (155, 'asukamaeda0', 'ぽんぽんぽっぺ', '普段測量士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://asukamaeda.example.com/\nメールアドレス: asukamaeda@example.com\n', '$2a$04$0puas75g4hIjKHehCQqrMe1GCX8szA3.I6oCpQ3.tK3kudFHCLcRO');
+INSERT INTO themes (user_id, dark_mode) VALUES (155, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (156, 'momokowatanabe0', 'みずみずしいみずようかん', '普段公認会計士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://momokowatanabe.example.com/\nメールアドレス: momokowatanabe@example.com\n', '$2a$04$Mfrba/tPNPbWeMHJ67dVG.mbMrbkI/J3QQrZpKr7H4.oYt.cWZ1Ui');
+INSERT INTO themes (user_id, dark_mode) VALUES (156, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (157, 'nanamisuzuki0', '初夢', '普段アーティストをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://nanamisuzuki.example.com/\nメールアドレス: nanamisuzuki@example.com\n', '$2a$04$7ZWkDLISNMr//oWec9KFceiQBYZ6czBLle3kvgjL9ek5B/FA82wD6');
+INSERT INTO themes (user_id, dark_mode) VALUES (157, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (158, 'gotomanabu0', 'うめうめうめね', '普段ゲームクリエイターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://gotomanabu.example.com/\nメールアドレス: gotomanabu@example.com\n', '$2a$04$TMA.ea7HW0wmcLE0vd6hQ.PsJ1mZ4niDzhZN1gi7yiWPoPnawgsrm');
+INSERT INTO themes (user_id, dark_mode) VALUES (158, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (159, 'maiyamazaki0', 'ははははちみつもち', '普段薬剤師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://maiyamazaki.example.com/\nメールアドレス: maiyamazaki@example.com\n', '$2a$04$807xAItPSriQOKMQ9iBW5OBfqwuWei.fJIhMJ3nlP9B9MX5yC/yo6');
+INSERT INTO themes (user_id, dark_mode) VALUES (159, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (160, 'junito0', 'はなやかはなびら', '普段配管工をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://junito.example.com/\nメールアドレス: junito@example.com\n', '$2a$04$0PccloP90oBYVZSg1GLWFO0FJ7hRVaK6/NL2dFfEtFjoe8gUYiuN6');
+INSERT INTO themes (user_id, dark_mode) VALUES (160, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (161, 'aokikazuya0', 'きんきんきんぴら', '普段バスガイドをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://aokikazuya.example.com/\nメールアドレス: aokikazuya@example.com\n', '$2a$04$q3ocvbPjvd5Sp1MYZwHciOn3yzDEQWVkpxkeoDzywyHlJMQX8CmXq');
+INSERT INTO themes (user_id, dark_mode) VALUES (161, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (162, 'shohei040', '花筵', '普段栄養士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://shohei04.example.com/\nメールアドレス: shohei04@example.com\n', '$2a$04$C5kAIMdMDC9Fcap9LTzBK.4NEn8gIGZgPWzelYFLddPbIni7MZETG');
+INSERT INTO themes (user_id, dark_mode) VALUES (162, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (163, 'momokotanaka0', '新緑', '普段運転士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://momokotanaka.example.com/\nメールアドレス: momokotanaka@example.com\n', '$2a$04$RbZ/5L2foJkNG2EgXMUblOgZ6xmEdxGTxQjZqyu4lCaqGR9yuCr3y');
+INSERT INTO themes (user_id, dark_mode) VALUES (163, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (164, 'atsushimatsumoto0', 'きんときんとん', '普段お笑い芸人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://atsushimatsumoto.example.com/\nメールアドレス: atsushimatsumoto@example.com\n', '$2a$04$DpI07.7ql.45WjIfl28LP.8nI005.krxyHLudkIdX0CKmcW4OCh6m');
+INSERT INTO themes (user_id, dark_mode) VALUES (164, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (165, 'taichifukuda0', '午後の紅茶', '普段栄養士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://taichifukuda.example.com/\nメールアドレス: taichifukuda@example.com\n', '$2a$04$.GkE/OUCwvZ2gheBHs7q4uOf/YMfAj9PNpYn6jCwrOEx16dspw2qa');
+INSERT INTO themes (user_id, dark_mode) VALUES (165, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (166, 'haruka630', 'もちもちどら焼き', '普段建築家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://haruka63.example.com/\nメールアドレス: haruka63@example.com\n', '$2a$04$3EUNF8B0yBBbGUWMH0jo1.2p.tlXVGi3Q3cdvU8rJuHgmJo4QwOQG');
+INSERT INTO themes (user_id, dark_mode) VALUES (166, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (167, 'tanakashohei0', 'めろんめろんケーキ', '普段栄養士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tanakashohei.example.com/\nメールアドレス: tanakashohei@example.com\n', '$2a$04$s.JWzrfBPfrBq5gyPL7sv.ldvADiNe2US9iKWtL.qY5qm1zZeAFge');
+INSERT INTO themes (user_id, dark_mode) VALUES (167, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (168, 'qnishimura0', 'こはくのこ', '普段イラストレーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://qnishimura.example.com/\nメールアドレス: qnishimura@example.com\n', '$2a$04$WE0FHGKNQ0HAEBZ8Xi9NDe32IIIyjTv9X3yuBQhvbS5U9BrylEMEW');
+INSERT INTO themes (user_id, dark_mode) VALUES (168, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (169, 'mkondo0', '空色だんご', '普段医師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://mkondo.example.com/\nメールアドレス: mkondo@example.com\n', '$2a$04$NTzkO97hOWpI1U3mx.odt.LreHylHcR9RjZkBPhSqbnJ.U6jOhI2a');
+INSERT INTO themes (user_id, dark_mode) VALUES (169, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (170, 'kimurayui0', 'こころのこがねもち', '普段経営者をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kimurayui.example.com/\nメールアドレス: kimurayui@example.com\n', '$2a$04$Z27Z/RwqmDnOXnXFiqbNBOZN.D.GqX0VV/AZQUgUQ7kqfA5MMBn7m');
+INSERT INTO themes (user_id, dark_mode) VALUES (170, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (171, 'tomoyanakajima0', 'せせせせり', '普段高等学校教員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tomoyanakajima.example.com/\nメールアドレス: tomoyanakajima@example.com\n', '$2a$04$KMktrTFWS6MIiy9InssireRwhpPrhWbgjy0eQqyoNIKaboUvqJ6.K');
+INSERT INTO themes (user_id, dark_mode) VALUES (171, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (172, 'naoko310', 'おりひめおりもの', '普段アナウンサーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://naoko31.example.com/\nメールアドレス: naoko31@example.com\n', '$2a$04$xpe.xozmfHv7Z/2jNRuaee1bD8IXeEi4WDXM4zF76kcJ0UCZK0aLW');
+INSERT INTO themes (user_id, dark_mode) VALUES (172, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (173, 'yukiokada0', '梅雨入り', '普段アイドルをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yukiokada.example.com/\nメールアドレス: yukiokada@example.com\n', '$2a$04$5CnWWFdt4IauwyC4BjqTtuDrLiQ7Jkvi1MkaOiojVvQ1vMQER.p.W');
+INSERT INTO themes (user_id, dark_mode) VALUES (173, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (174, 'tsubasasuzuki0', '午前', '普段お笑い芸人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tsubasasuzuki.example.com/\nメールアドレス: tsubasasuzuki@example.com\n', '$2a$04$GO61jM8wsg0iiiPC6KWmZe05mDmajliBl0GtxRLCnK2eq5Mo/.C/O');
+INSERT INTO themes (user_id, dark_mode) VALUES (174, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (175, 'yutatakahashi0', '清流', '普段救急救命士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yutatakahashi.example.com/\nメールアドレス: yutatakahashi@example.com\n', '$2a$04$nBWPPUXpkUpHWWZ7kJYR2OLxhYsiLuAVeDsDCO1WcQZEQ3Q8O9jFC');
+INSERT INTO themes (user_id, dark_mode) VALUES (175, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (176, 'kyosuke140', 'きびきびきびだんご', '普段アナウンサーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kyosuke14.example.com/\nメールアドレス: kyosuke14@example.com\n', '$2a$04$T5oIWCDo9kr3TdZy8Ek1TegBsy3rzF0u/9HrJex1uRdED8xwE8Hhy');
+INSERT INTO themes (user_id, dark_mode) VALUES (176, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (177, 'tomoya190', 'きなきなきなこもち', '普段イラストレーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tomoya19.example.com/\nメールアドレス: tomoya19@example.com\n', '$2a$04$uyoIa6HiiwtLEadlWJBcLeWrQ02LtC9hBtKy99UWDMr1fekNacxBS');
+INSERT INTO themes (user_id, dark_mode) VALUES (177, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (178, 'ryohei610', 'きらきらきらりん', '普段ウェディングプランナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ryohei61.example.com/\nメールアドレス: ryohei61@example.com\n', '$2a$04$wNDY/crOYymoNs8G1gyRNO36/YSWS/aL8Mcv.h2nDm9..TFoJ7hCa');
+INSERT INTO themes (user_id, dark_mode) VALUES (178, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (179, 'fukudahiroshi0', 'つぶつぶつぶやき', '普段裁判官をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://fukudahiroshi.example.com/\nメールアドレス: fukudahiroshi@example.com\n', '$2a$04$kajn7WCr5lgnPy73ZudHxOy.XPkhEMTCPwIW.ANUhrqTViKKrWwbm');
+INSERT INTO themes (user_id, dark_mode) VALUES (179, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (180, 'tyamaguchi0', 'ぷるんぷるんプリン', '普段医師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tyamaguchi.example.com/\nメールアドレス: tyamaguchi@example.com\n', '$2a$04$EhXqaOrq7tGcR61FKb1vxOVNSeLubLpFoRJDXxERMvUQZH5a.tT/q');
+INSERT INTO themes (user_id, dark_mode) VALUES (180, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (181, 'rsasaki0', 'はるはるはるかんきんこうせ', '普段エステティシャンをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://rsasaki.example.com/\nメールアドレス: rsasaki@example.com\n', '$2a$04$5Lh81d1JW1AeHNueEENUVumXWpa6qhU.QRn0YJ1XFWRWg69Tc4PkG');
+INSERT INTO themes (user_id, dark_mode) VALUES (181, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (182, 'satoyoichi0', '霰', '普段絵本作家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://satoyoichi.example.com/\nメールアドレス: satoyoichi@example.com\n', '$2a$04$uj2kC5wJNTrXKzFGTBfdhOKC.DYwJ6tejgVWdkZbzVXp/wY7AHW6m');
+INSERT INTO themes (user_id, dark_mode) VALUES (182, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (183, 'rikawatanabe0', 'つぶつぶつぶかんきんこ', '普段寿司職人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://rikawatanabe.example.com/\nメールアドレス: rikawatanabe@example.com\n', '$2a$04$mOWpE7fPGGnKrMDLz0o71u6FPNfIu/60SvKe9CNTKAAQ4cGd48a1m');
+INSERT INTO themes (user_id, dark_mode) VALUES (183, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (184, 'vokada0', '紅白花餅', '普段大学教授をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://vokada.example.com/\nメールアドレス: vokada@example.com\n', '$2a$04$evS0vE3fQ4gtple5.lY6BOatdNX6jKG6eQlpbRtYQDNBQxbVRoiFW');
+INSERT INTO themes (user_id, dark_mode) VALUES (184, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (185, 'mituru070', '千秋', '普段運転士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://mituru07.example.com/\nメールアドレス: mituru07@example.com\n', '$2a$04$4g7GzmasnxaXMkyaniG3AuuyP/TS0sq1x5oTi9KphY.59juebN51W');
+INSERT INTO themes (user_id, dark_mode) VALUES (185, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (186, 'nakajimayui0', '夜明け', '普段医師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://nakajimayui.example.com/\nメールアドレス: nakajimayui@example.com\n', '$2a$04$q20IW8IrwmTn9BWDq349ROYis2Tr3iQ3dvagCKd21VSKSlB1bLNla');
+INSERT INTO themes (user_id, dark_mode) VALUES (186, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (187, 'nanamiota0', 'はなはなはんなり', '普段ウェディングプランナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://nanamiota.example.com/\nメールアドレス: nanamiota@example.com\n', '$2a$04$W1M2RwbskgN4Xcj3SfoNreWw4p6TbM7cbhQHE5vxbDxQDEefwUxny');
+INSERT INTO themes (user_id, dark_mode) VALUES (187, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (188, 'smatsuda0', '雲海', '普段大学教授をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://smatsuda.example.com/\nメールアドレス: smatsuda@example.com\n', '$2a$04$15IR25zhI4AmeMXNpzRC9eXFostWbJzQ0AkT7qD4.E5VvgwVIeKJq');
+INSERT INTO themes (user_id, dark_mode) VALUES (188, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (189, 'suzukijun0', '春雷', '普段小説家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://suzukijun.example.com/\nメールアドレス: suzukijun@example.com\n', '$2a$04$34kAlIoUPVRvakcqe/xy8.YXw0R3Xq6c91qsch0rfR/MfiBTNkaTi');
+INSERT INTO themes (user_id, dark_mode) VALUES (189, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (190, 'saitosayuri0', '暗夜', '普段電気工事士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://saitosayuri.example.com/\nメールアドレス: saitosayuri@example.com\n', '$2a$04$lAp9YLJjs/1DljHgTubJBuL5r/.2NZDMnTylFpYBsdYeaznUcDkJ.');
+INSERT INTO themes (user_id, dark_mode) VALUES (190, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (191, 'haruka730', 'みずみずしいみずあめ', '普段絵本作家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://haruka73.example.com/\nメールアドレス: haruka73@example.com\n', '$2a$04$ywZmBv6LQYbWGpA3yxaMcOIEQn1G.czkAd5uEK7fTeq3lfoaMoVLW');
+INSERT INTO themes (user_id, dark_mode) VALUES (191, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (192, 'yamamotoakemi0', '夕闇', '普段アニメーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yamamotoakemi.example.com/\nメールアドレス: yamamotoakemi@example.com\n', '$2a$04$w5.MtTa6RDKEbD9j7XnUnOAYBcpug2XAVrjLgFg3wrlRHppbO52Gy');
+INSERT INTO themes (user_id, dark_mode) VALUES (192, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (193, 'satoyosuke0', 'もももももち', '普段エンジニア演奏家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://satoyosuke.example.com/\nメールアドレス: satoyosuke@example.com\n', '$2a$04$0z1ni/zkE6a9Sfsaq3vb6O.Xma1va8H3h5/IfFVBH0MxrUI.OZRNm');
+INSERT INTO themes (user_id, dark_mode) VALUES (193, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (194, 'maayasato0', '雲海', '普段測量士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://maayasato.example.com/\nメールアドレス: maayasato@example.com\n', '$2a$04$f1BJVM5MCc6MpOOEbCtJGOI8xY3Alas0Ov8fVNuYej8b1idv59cCW');
+INSERT INTO themes (user_id, dark_mode) VALUES (194, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (195, 'nanami830', 'つぶつぶつぶあん', '普段脚本家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://nanami83.example.com/\nメールアドレス: nanami83@example.com\n', '$2a$04$JNkWqunO87FabYimlZkUqOyC02iDIbfQB35m.PKW7N/x2U8uqCEEW');
+INSERT INTO themes (user_id, dark_mode) VALUES (195, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (196, 'kanaokamoto0', '夜長', '普段行政書士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kanaokamoto.example.com/\nメールアドレス: kanaokamoto@example.com\n', '$2a$04$cyjbSBfNcFM.VG2ovtnqg.xlmhsOaEy.yygmJ7hlNIM2STJ4Wgh5.');
+INSERT INTO themes (user_id, dark_mode) VALUES (196, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (197, 'usuzuki0', 'ぴよぴよぴっち', '普段調理師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://usuzuki.example.com/\nメールアドレス: usuzuki@example.com\n', '$2a$04$qziCjx2Kh1JpW/bYzjKFc.v7jrvki3LJjpAxZqnlRJN.qn.NBT492');
+INSERT INTO themes (user_id, dark_mode) VALUES (197, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (198, 'manabusasaki0', 'いしいしいしもち', '普段エステティシャンをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://manabusasaki.example.com/\nメールアドレス: manabusasaki@example.com\n', '$2a$04$6/rZQ./QnEYIa/XCChrIlOtQ.62Z0ZSRmWR27AmuY43XKPXcn8UmW');
+INSERT INTO themes (user_id, dark_mode) VALUES (198, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (199, 'kenichi870', '美味', '普段アーティストをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kenichi87.example.com/\nメールアドレス: kenichi87@example.com\n', '$2a$04$4R3oaiwgL3jFDx4XkSPgWeK.uk35cdQm.wKhYVFSFMREEtEUtuPZu');
+INSERT INTO themes (user_id, dark_mode) VALUES (199, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (200, 'tomoyasato0', 'みみみるく', '普段映画監督をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tomoyasato.example.com/\nメールアドレス: tomoyasato@example.com\n', '$2a$04$/v16fIbxYBiHvtEmtjgydeJ/fUI2H0OhCgNdTReh5WZUtHYvubDDi');
+INSERT INTO themes (user_id, dark_mode) VALUES (200, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (201, 'mituruhayashi0', '青草', '普段大学教授をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://mituruhayashi.example.com/\nメールアドレス: mituruhayashi@example.com\n', '$2a$04$0BRufcJy.4FPaIDjWxsKz.5JBQQMoGZzVniBFU0s7jaC.8pHSiuaK');
+INSERT INTO themes (user_id, dark_mode) VALUES (201, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (202, 'junnishimura0', '明け方', '普段大学教授をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://junnishimura.example.com/\nメールアドレス: junnishimura@example.com\n', '$2a$04$GvL4Wts8RiOULOE9qAYZWeX6pqOaW9F3MoWrqiAtMt3K.tepN85Xq');
+INSERT INTO themes (user_id, dark_mode) VALUES (202, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (203, 'fujiwaramituru0', 'あんこあんこかんきんこうせ', '普段調理師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://fujiwaramituru.example.com/\nメールアドレス: fujiwaramituru@example.com\n', '$2a$04$OHdW6thFVfbi6tPhy5lXGeiFAShOFDz0xFVG2iSjM0yZ.jnAa0Uqq');
+INSERT INTO themes (user_id, dark_mode) VALUES (203, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (204, 'yoshidamai0', '空色わらび', '普段アートディレクターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yoshidamai.example.com/\nメールアドレス: yoshidamai@example.com\n', '$2a$04$0PulOoA5M9qCiCU4oL0O1OqnrGD./.dGLQPwuTf9SfioljG1ImG7.');
+INSERT INTO themes (user_id, dark_mode) VALUES (204, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (205, 'yosuke350', '暖簾', '普段測量士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yosuke35.example.com/\nメールアドレス: yosuke35@example.com\n', '$2a$04$lBKwG4y37PBniflzaf/iY.9KYWo2ZBd6JSfvgT9UG0XA80uSShAJW');
+INSERT INTO themes (user_id, dark_mode) VALUES (205, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (206, 'xwatanabe0', 'かえるのかりんとう', '普段医師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://xwatanabe.example.com/\nメールアドレス: xwatanabe@example.com\n', '$2a$04$pEqzGw.XCz0voYRH3QMJTO59V1WsTacBbGb/K2UETzzAEqaz6xU96');
+INSERT INTO themes (user_id, dark_mode) VALUES (206, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (207, 'naokimatsumoto0', 'ちょこれーとちょう', '普段航海士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://naokimatsumoto.example.com/\nメールアドレス: naokimatsumoto@example.com\n', '$2a$04$j./lZgNj/fSYZqg7O7BDue3T7Ijeun6VVUizLRGFMheTUMIqcYK2y');
+INSERT INTO themes (user_id, dark_mode) VALUES (207, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (208, 'kenichi170', '暗夜', '普段薬剤師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kenichi17.example.com/\nメールアドレス: kenichi17@example.com\n', '$2a$04$sLLGF.m9gbfbm5pTeUjpvuW89BW.yNEylciEbeGzsacMmbCN1DnSC');
+INSERT INTO themes (user_id, dark_mode) VALUES (208, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (209, 'naoko340', 'きらきらきんとん', '普段農家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://naoko34.example.com/\nメールアドレス: naoko34@example.com\n', '$2a$04$uu1XhQxhkzIJsJ7Tzw8qfuH/wivo4hkEYkFKOP0xKulBk2nxZPEqS');
+INSERT INTO themes (user_id, dark_mode) VALUES (209, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (210, 'nakajimasotaro0', 'すいすいすいせん', '普段介護ヘルパーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://nakajimasotaro.example.com/\nメールアドレス: nakajimasotaro@example.com\n', '$2a$04$qUPj6ngqW37MzPM4LsJ7QOBsEW7qF0fUVyE4T3QJYH4AKVozbNC9q');
+INSERT INTO themes (user_id, dark_mode) VALUES (210, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (211, 'eito0', 'こまこまこまぶた', '普段医師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://eito.example.com/\nメールアドレス: eito@example.com\n', '$2a$04$ah/ZrA65mvjSG9M2zRSUcegW1d1d.lzyMkKl0leUmnkmTEBGogPp.');
+INSERT INTO themes (user_id, dark_mode) VALUES (211, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (212, 'kenichi470', 'ふわふわふくろう', '普段イラストレーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kenichi47.example.com/\nメールアドレス: kenichi47@example.com\n', '$2a$04$sdAySrC/p9LWk5J.r5LM.udKeDM02c0eSXn3GFCrJ0uMUOmDlQDy2');
+INSERT INTO themes (user_id, dark_mode) VALUES (212, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (213, 'qabe0', '霜夜', '普段ウェブデザイナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://qabe.example.com/\nメールアドレス: qabe@example.com\n', '$2a$04$uHf5EFSsbhKGqYandZv/Z.kwJ.UHjKBL5qD9hr8us.PEoIZTxNQrq');
+INSERT INTO themes (user_id, dark_mode) VALUES (213, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (214, 'junkondo0', 'くうくうくうりもち', '普段救急救命士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://junkondo.example.com/\nメールアドレス: junkondo@example.com\n', '$2a$04$2HuLJJa1611votr5CHKX/OS3xK1fAREGgbmLKSWo4Ewf5bzDGvCwi');
+INSERT INTO themes (user_id, dark_mode) VALUES (214, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (215, 'yosukewatanabe0', '潮風', '普段大学教授をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yosukewatanabe.example.com/\nメールアドレス: yosukewatanabe@example.com\n', '$2a$04$LTNRQBExFHY8lQCn44lo/ehB/NRpBIpddcfgIVLGGUYtvJmzJ3/9O');
+INSERT INTO themes (user_id, dark_mode) VALUES (215, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (216, 'ryosuke040', 'ひなたひなあられ', '普段お笑い芸人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ryosuke04.example.com/\nメールアドレス: ryosuke04@example.com\n', '$2a$04$vJVvUq12hZbehFmPzP.c8OnRsuSErg/5.1dLO7hq3pPFlvIQgaobu');
+INSERT INTO themes (user_id, dark_mode) VALUES (216, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (217, 'itosotaro0', 'さくらんさくらかんきん', '普段アートディレクターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://itosotaro.example.com/\nメールアドレス: itosotaro@example.com\n', '$2a$04$zoGEpfPL0Ypk8OengyTkvuOdM0QVy30SWsY1C7y1aBvWBICJYu51y');
+INSERT INTO themes (user_id, dark_mode) VALUES (217, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (218, 'kimuramikako0', 'つつじつつじつぶ', '普段作曲家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kimuramikako.example.com/\nメールアドレス: kimuramikako@example.com\n', '$2a$04$NFEv49zphsEdwCNLi6QYz.FbSSEyNqGuIsJ9hNj.nbXE5Z624plBm');
+INSERT INTO themes (user_id, dark_mode) VALUES (218, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (219, 'reiogawa0', 'ほしぞらほうとう', '普段占い師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://reiogawa.example.com/\nメールアドレス: reiogawa@example.com\n', '$2a$04$mAbZO/nJE.f8CjUSmm/IE.hqbx6XvnWlJmbNNi0ikU4p4mRcSDfuy');
+INSERT INTO themes (user_id, dark_mode) VALUES (219, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (220, 'myamada1', '夏祭り煎餅', '普段グラフィックデザイナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://myamada.example.com/\nメールアドレス: myamada@example.com\n', '$2a$04$aOtXqzAbj22O492SA6pp4euu5zHmRfxxNoG.Yp4qQf9O2NiKpmVJu');
+INSERT INTO themes (user_id, dark_mode) VALUES (220, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (221, 'yamazakisayuri0', '夜明け', '普段医師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yamazakisayuri.example.com/\nメールアドレス: yamazakisayuri@example.com\n', '$2a$04$/0I5Z3FbgSF8C7k8qSfp3.ytDI6zVKZQ0u1qb4OeREpHxQzl6CTFW');
+INSERT INTO themes (user_id, dark_mode) VALUES (221, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (222, 'gotoakemi0', 'つぶつぶたんぽぽ', '普段漫画家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://gotoakemi.example.com/\nメールアドレス: gotoakemi@example.com\n', '$2a$04$b9YqfFmp0r1WI.OGkygre./9HgRDPIFojEZZerozLRkDmQI1Fgz9a');
+INSERT INTO themes (user_id, dark_mode) VALUES (222, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (223, 'shohei660', 'きらきらきらり', '普段運転士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://shohei66.example.com/\nメールアドレス: shohei66@example.com\n', '$2a$04$kdb7klvhAf6bAf.prygvoungTlvAxg6KZkBOYyo4W6Zi3sU3I495m');
+INSERT INTO themes (user_id, dark_mode) VALUES (223, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (224, 'phasegawa0', 'さくらさくらんぼ', '普段アートディレクターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://phasegawa.example.com/\nメールアドレス: phasegawa@example.com\n', '$2a$04$AXKaWtL0qEK/8xW.80xPMey9ariOJJgDcAxIsh9/aZ0fp7NSIC8cq');
+INSERT INTO themes (user_id, dark_mode) VALUES (224, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (225, 'kana990', 'きんとききんとかんきんこうせ', '普段行政書士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kana99.example.com/\nメールアドレス: kana99@example.com\n', '$2a$04$rJ7mfRo9Aw9VW5mcgkH/se52xwvhBDUVDZEKjsrpxkUsp5ITv2zOS');
+INSERT INTO themes (user_id, dark_mode) VALUES (225, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (226, 'yosuke710', 'なごみねりきり', '普段アニメーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yosuke71.example.com/\nメールアドレス: yosuke71@example.com\n', '$2a$04$xPHuVYa.1gExp.Xh3kUESOhgaBBHfbTwutEYI8rrOzwOIWM.xLHg.');
+INSERT INTO themes (user_id, dark_mode) VALUES (226, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (227, 'rika420', 'ふんわりふんわりん', '普段モデルをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://rika42.example.com/\nメールアドレス: rika42@example.com\n', '$2a$04$se4XG.MHrkFFuSXB/aSX1uNxqY0LdYnetRSqOvTrrgFtuB9UP84TK');
+INSERT INTO themes (user_id, dark_mode) VALUES (227, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (228, 'ikedajun0', 'うめうめうめかんきんこうせ', '普段ウェディングプランナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ikedajun.example.com/\nメールアドレス: ikedajun@example.com\n', '$2a$04$As9ZsAB/qUV3RHXTPW0LDOecwR.GqPIhbBHyXmgTHxVOE3xE/8G8i');
+INSERT INTO themes (user_id, dark_mode) VALUES (228, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (229, 'takuma250', '金魚すくい', '普段薬剤師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://takuma25.example.com/\nメールアドレス: takuma25@example.com\n', '$2a$04$a2FyUeWJPpgE//4QaPNUSePy8ie9/KM46MavQwzvTx32r05ZE2vPC');
+INSERT INTO themes (user_id, dark_mode) VALUES (229, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (230, 'yoichihayashi0', '早朝', '普段絵本作家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yoichihayashi.example.com/\nメールアドレス: yoichihayashi@example.com\n', '$2a$04$1eF6i15wMQvGByE78X53Su7GiDOGKGBGtLuESNq.QS5YwXsICaIx6');
+INSERT INTO themes (user_id, dark_mode) VALUES (230, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (231, 'yoichi441', '癒し', '普段航海士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yoichi44.example.com/\nメールアドレス: yoichi44@example.com\n', '$2a$04$dAHk5cZpQrHAyj1mw5.HW.W15pHZm5nwY0uPmN/4hknber.FNS.m.');
+INSERT INTO themes (user_id, dark_mode) VALUES (231, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (232, 'zmiura0', 'さくらんぼもなか', '普段お笑い芸人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://zmiura.example.com/\nメールアドレス: zmiura@example.com\n', '$2a$04$nvAPnhFN3DbDGykNeY9u6e7uZx/RH8ID7JhwXIjNImjsPzZeTJQXu');
+INSERT INTO themes (user_id, dark_mode) VALUES (232, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (233, 'ftakahashi0', 'もももももも', '普段栄養士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ftakahashi.example.com/\nメールアドレス: ftakahashi@example.com\n', '$2a$04$te4Lr4UAR1nJRlW.n17STuSR3HDjx1wCvnEKyQso4x/tlpWtmOtaa');
+INSERT INTO themes (user_id, dark_mode) VALUES (233, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (234, 'oinoue0', 'さくらんさくらかんきんこうせい', '普段アートディレクターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://oinoue.example.com/\nメールアドレス: oinoue@example.com\n', '$2a$04$6CW3TBbvY3ja/vT.gfdDS.m0M1BmgxjZRE7Xyunk6BFDEkbqlaqn6');
+INSERT INTO themes (user_id, dark_mode) VALUES (234, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (235, 'osamu920', 'さらさらさらすり', '普段音響技術者をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://osamu92.example.com/\nメールアドレス: osamu92@example.com\n', '$2a$04$dSbZPImycO340SYKNxZ/Q.Gwbz9g7hIJ7Cnddjqptv6Zmf6HVgyzC');
+INSERT INTO themes (user_id, dark_mode) VALUES (235, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (236, 'takahashikumiko0', 'つぶつぶつぶやき', '普段栄養士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://takahashikumiko.example.com/\nメールアドレス: takahashikumiko@example.com\n', '$2a$04$aGeuD2L8RycBjr3DqWbZUOs2w90DCowMfOFLpkW8sabWl1s3FmRoK');
+INSERT INTO themes (user_id, dark_mode) VALUES (236, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (237, 'tsubasamaeda0', '花火大福', '普段公務員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tsubasamaeda.example.com/\nメールアドレス: tsubasamaeda@example.com\n', '$2a$04$jlMvCu6723IQhhKTEgwZWeRA0MoVuDp5Jm1TXkr4VYaunzWewwI0G');
+INSERT INTO themes (user_id, dark_mode) VALUES (237, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (238, 'ryosuke220', 'ふわりふくらし', '普段YouTuberをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ryosuke22.example.com/\nメールアドレス: ryosuke22@example.com\n', '$2a$04$P3AbZWSr0kefGE86Jzy.rOK3eTvN1XZA4R5igmI.qgmuj8gvV29J.');
+INSERT INTO themes (user_id, dark_mode) VALUES (238, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (239, 'yoichimurakami0', 'つまつまつまみ', '普段栄養士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yoichimurakami.example.com/\nメールアドレス: yoichimurakami@example.com\n', '$2a$04$di0xhcmFLQYhttsk59.KIec.lYk2Pg2IzidWkst1sdYRjFPfIZE26');
+INSERT INTO themes (user_id, dark_mode) VALUES (239, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (240, 'abeyumiko0', '群青', '普段裁判官をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://abeyumiko.example.com/\nメールアドレス: abeyumiko@example.com\n', '$2a$04$Kyjwam4jnpx3kgsHO2LI1uNeXehTyAOKx6bLS/P2vfUimriVhpxoi');
+INSERT INTO themes (user_id, dark_mode) VALUES (240, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (241, 'tishii0', 'きりきりきりもち', '普段ウェディングプランナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tishii.example.com/\nメールアドレス: tishii@example.com\n', '$2a$04$cGmoHuamN4w.LvrIqU1mwO8BKvYlZbmxZQFGRmSDCMRAmRCXt1B7.');
+INSERT INTO themes (user_id, dark_mode) VALUES (241, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (242, 'vkato0', 'なごみねりきり', '普段医師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://vkato.example.com/\nメールアドレス: vkato@example.com\n', '$2a$04$YT65ydKjNDzNXvxK2C3NCeR2MNydvke77NqauyRGtknDidvbDng2W');
+INSERT INTO themes (user_id, dark_mode) VALUES (242, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (243, 'mituru710', '満開', '普段占い師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://mituru71.example.com/\nメールアドレス: mituru71@example.com\n', '$2a$04$VcfyEdo7EZPDyAXcbt/f9.kJH2of1qAn1wbfMsQZ/WBfgN8JH4hLC');
+INSERT INTO themes (user_id, dark_mode) VALUES (243, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (244, 'asukamurakami0', '午前中', '普段調理師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://asukamurakami.example.com/\nメールアドレス: asukamurakami@example.com\n', '$2a$04$ycsZAgjpnNgA671FYbukYeT6LCor5Db/92e3gqRdJM6cYpaHam4K6');
+INSERT INTO themes (user_id, dark_mode) VALUES (244, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (245, 'yuiwatanabe0', 'りんりんりんりんもち', '普段ウェディングプランナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yuiwatanabe.example.com/\nメールアドレス: yuiwatanabe@example.com\n', '$2a$04$DJwI/7petpqGJCRmDSG5nuQnYchTl20az2rsgxu8Uc7wAImKveQCu');
+INSERT INTO themes (user_id, dark_mode) VALUES (245, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (246, 'jokamoto0', 'ほたる', '普段イラストレーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://jokamoto.example.com/\nメールアドレス: jokamoto@example.com\n', '$2a$04$UwZb6IFm4tgS1pLvujdaV.sK3Gq9zN2IJyIuGjqtMyuSL/dZXfqjK');
+INSERT INTO themes (user_id, dark_mode) VALUES (246, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (247, 'akirafujiwara0', 'ひよこひよしつ', '普段高等学校教員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://akirafujiwara.example.com/\nメールアドレス: akirafujiwara@example.com\n', '$2a$04$p.G7Rfg50hqn6oFyNHEFCesVkulf1gIMikz8y8uqA1MGDoswG8R7y');
+INSERT INTO themes (user_id, dark_mode) VALUES (247, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (248, 'lgoto0', '美景', '普段作曲家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://lgoto.example.com/\nメールアドレス: lgoto@example.com\n', '$2a$04$PUrpyrjNpP70jWQyFIS90OhOzVk3PRTdtDSn97v5GlHG5//.Pc8ma');
+INSERT INTO themes (user_id, dark_mode) VALUES (248, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (249, 'akiratakahashi0', 'つぶつぶつぶかんき', '普段アニメーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://akiratakahashi.example.com/\nメールアドレス: akiratakahashi@example.com\n', '$2a$04$/xwDxJw0l7il8nerRB7Ey.zwvtCMT3HP8ha6Sil9l6ZNL8eYuTSd6');
+INSERT INTO themes (user_id, dark_mode) VALUES (249, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (250, 'lhashimoto0', '霧雨みたらし', '普段大学教授をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://lhashimoto.example.com/\nメールアドレス: lhashimoto@example.com\n', '$2a$04$Bfs9ObF/qjudHK.wofdamOQpZWHt3yPiU2qsUEDwprjtPcPNYqGqu');
+INSERT INTO themes (user_id, dark_mode) VALUES (250, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (251, 'ogawarika0', 'はるはるはるか', '普段気象予報士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ogawarika.example.com/\nメールアドレス: ogawarika@example.com\n', '$2a$04$GWjJPRhvDfqpEvp8bp7dW.ug.BHMx9em4WL8BSRPuMXj/ylhr8TmW');
+INSERT INTO themes (user_id, dark_mode) VALUES (251, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (252, 'manabu620', 'さくらんぼさくり', '普段アナウンサーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://manabu62.example.com/\nメールアドレス: manabu62@example.com\n', '$2a$04$Wyg2OV0Eg7eSCnoFqtJLgujNnkduv2.4hrKlyiAANYCN0uIq/Df0O');
+INSERT INTO themes (user_id, dark_mode) VALUES (252, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (253, 'osamuyamaguchi0', '夏木立', '普段建築家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://osamuyamaguchi.example.com/\nメールアドレス: osamuyamaguchi@example.com\n', '$2a$04$t4O0rPbs5QVsxI9VFAXoyeWsJ/rHAdpFs4ChmoeOn4WStCBmHc9dC');
+INSERT INTO themes (user_id, dark_mode) VALUES (253, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (254, 'fujiwararei0', 'びわびわびわもち', '普段YouTuberをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://fujiwararei.example.com/\nメールアドレス: fujiwararei@example.com\n', '$2a$04$p555Qy6kgTbVo67k29l9VuWeqo1vPANms6He5BGOgn2ypqolyR3Re');
+INSERT INTO themes (user_id, dark_mode) VALUES (254, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (255, 'yosukewatanabe1', 'はちみつはちもつ', '普段漫画家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yosukewatanabe.example.com/\nメールアドレス: yosukewatanabe@example.com\n', '$2a$04$0puas75g4hIjKHehCQqrMe1GCX8szA3.I6oCpQ3.tK3kudFHCLcRO');
+INSERT INTO themes (user_id, dark_mode) VALUES (255, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (256, 'asuka500', '雲海', '普段脚本家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://asuka50.example.com/\nメールアドレス: asuka50@example.com\n', '$2a$04$Mfrba/tPNPbWeMHJ67dVG.mbMrbkI/J3QQrZpKr7H4.oYt.cWZ1Ui');
+INSERT INTO themes (user_id, dark_mode) VALUES (256, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (257, 'otamaaya0', 'あまいあまん', '普段公認会計士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://otamaaya.example.com/\nメールアドレス: otamaaya@example.com\n', '$2a$04$7ZWkDLISNMr//oWec9KFceiQBYZ6czBLle3kvgjL9ek5B/FA82wD6');
+INSERT INTO themes (user_id, dark_mode) VALUES (257, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (258, 'okamotonaoko0', 'ふんわりふんすけ', '普段検察官をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://okamotonaoko.example.com/\nメールアドレス: okamotonaoko@example.com\n', '$2a$04$TMA.ea7HW0wmcLE0vd6hQ.PsJ1mZ4niDzhZN1gi7yiWPoPnawgsrm');
+INSERT INTO themes (user_id, dark_mode) VALUES (258, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (259, 'tokamoto0', 'うめうめうめたん', '普段音響技術者をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tokamoto.example.com/\nメールアドレス: tokamoto@example.com\n', '$2a$04$807xAItPSriQOKMQ9iBW5OBfqwuWei.fJIhMJ3nlP9B9MX5yC/yo6');
+INSERT INTO themes (user_id, dark_mode) VALUES (259, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (260, 'saitomituru0', 'もちもちみかん', '普段司法書士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://saitomituru.example.com/\nメールアドレス: saitomituru@example.com\n', '$2a$04$0PccloP90oBYVZSg1GLWFO0FJ7hRVaK6/NL2dFfEtFjoe8gUYiuN6');
+INSERT INTO themes (user_id, dark_mode) VALUES (260, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (261, 'suzukitakuma0', '風景', '普段配管工をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://suzukitakuma.example.com/\nメールアドレス: suzukitakuma@example.com\n', '$2a$04$q3ocvbPjvd5Sp1MYZwHciOn3yzDEQWVkpxkeoDzywyHlJMQX8CmXq');
+INSERT INTO themes (user_id, dark_mode) VALUES (261, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (262, 'suzukimaaya0', '日中', '普段コピーライターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://suzukimaaya.example.com/\nメールアドレス: suzukimaaya@example.com\n', '$2a$04$C5kAIMdMDC9Fcap9LTzBK.4NEn8gIGZgPWzelYFLddPbIni7MZETG');
+INSERT INTO themes (user_id, dark_mode) VALUES (262, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (263, 'nanamitanaka0', 'かんかんかんろ', '普段作曲家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://nanamitanaka.example.com/\nメールアドレス: nanamitanaka@example.com\n', '$2a$04$RbZ/5L2foJkNG2EgXMUblOgZ6xmEdxGTxQjZqyu4lCaqGR9yuCr3y');
+INSERT INTO themes (user_id, dark_mode) VALUES (263, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (264, 'suzukikana0', '天の川せんべい', '普段アニメーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://suzukikana.example.com/\nメールアドレス: suzukikana@example.com\n', '$2a$04$DpI07.7ql.45WjIfl28LP.8nI005.krxyHLudkIdX0CKmcW4OCh6m');
+INSERT INTO themes (user_id, dark_mode) VALUES (264, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (265, 'sakamotoshohei0', 'きらきらきらりん', '普段経営者をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://sakamotoshohei.example.com/\nメールアドレス: sakamotoshohei@example.com\n', '$2a$04$.GkE/OUCwvZ2gheBHs7q4uOf/YMfAj9PNpYn6jCwrOEx16dspw2qa');
+INSERT INTO themes (user_id, dark_mode) VALUES (265, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (266, 'kanayamamoto0', 'きらきらきんとん', '普段調理師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kanayamamoto.example.com/\nメールアドレス: kanayamamoto@example.com\n', '$2a$04$3EUNF8B0yBBbGUWMH0jo1.2p.tlXVGi3Q3cdvU8rJuHgmJo4QwOQG');
+INSERT INTO themes (user_id, dark_mode) VALUES (266, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (267, 'xtakahashi0', 'いちごいちごう', '普段ウェブデザイナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://xtakahashi.example.com/\nメールアドレス: xtakahashi@example.com\n', '$2a$04$s.JWzrfBPfrBq5gyPL7sv.ldvADiNe2US9iKWtL.qY5qm1zZeAFge');
+INSERT INTO themes (user_id, dark_mode) VALUES (267, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (268, 'wsuzuki0', '紫雲', '普段グラフィックデザイナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://wsuzuki.example.com/\nメールアドレス: wsuzuki@example.com\n', '$2a$04$WE0FHGKNQ0HAEBZ8Xi9NDe32IIIyjTv9X3yuBQhvbS5U9BrylEMEW');
+INSERT INTO themes (user_id, dark_mode) VALUES (268, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (269, 'taichi990', 'ほんわかほうじ', '普段アナウンサーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://taichi99.example.com/\nメールアドレス: taichi99@example.com\n', '$2a$04$NTzkO97hOWpI1U3mx.odt.LreHylHcR9RjZkBPhSqbnJ.U6jOhI2a');
+INSERT INTO themes (user_id, dark_mode) VALUES (269, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (270, 'saitoyuki0', 'かりかりかりんとう', '普段アナウンサーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://saitoyuki.example.com/\nメールアドレス: saitoyuki@example.com\n', '$2a$04$Z27Z/RwqmDnOXnXFiqbNBOZN.D.GqX0VV/AZQUgUQ7kqfA5MMBn7m');
+INSERT INTO themes (user_id, dark_mode) VALUES (270, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (271, 'rei050', 'こころのきなこ', '普段配管工をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://rei05.example.com/\nメールアドレス: rei05@example.com\n', '$2a$04$KMktrTFWS6MIiy9InssireRwhpPrhWbgjy0eQqyoNIKaboUvqJ6.K');
+INSERT INTO themes (user_id, dark_mode) VALUES (271, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (272, 'nmaeda0', 'おりおりおりもん', '普段音楽家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://nmaeda.example.com/\nメールアドレス: nmaeda@example.com\n', '$2a$04$xpe.xozmfHv7Z/2jNRuaee1bD8IXeEi4WDXM4zF76kcJ0UCZK0aLW');
+INSERT INTO themes (user_id, dark_mode) VALUES (272, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (273, 'csuzuki0', 'たねたねたねもち', '普段小説家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://csuzuki.example.com/\nメールアドレス: csuzuki@example.com\n', '$2a$04$5CnWWFdt4IauwyC4BjqTtuDrLiQ7Jkvi1MkaOiojVvQ1vMQER.p.W');
+INSERT INTO themes (user_id, dark_mode) VALUES (273, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (274, 'takahashiatsushi0', '梅しぐれ', '普段寿司職人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://takahashiatsushi.example.com/\nメールアドレス: takahashiatsushi@example.com\n', '$2a$04$GO61jM8wsg0iiiPC6KWmZe05mDmajliBl0GtxRLCnK2eq5Mo/.C/O');
+INSERT INTO themes (user_id, dark_mode) VALUES (274, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (275, 'yukifukuda0', 'あずまや', '普段エステティシャンをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yukifukuda.example.com/\nメールアドレス: yukifukuda@example.com\n', '$2a$04$nBWPPUXpkUpHWWZ7kJYR2OLxhYsiLuAVeDsDCO1WcQZEQ3Q8O9jFC');
+INSERT INTO themes (user_id, dark_mode) VALUES (275, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (276, 'yumiko680', 'はちみつはっぱ', '普段映画監督をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yumiko68.example.com/\nメールアドレス: yumiko68@example.com\n', '$2a$04$T5oIWCDo9kr3TdZy8Ek1TegBsy3rzF0u/9HrJex1uRdED8xwE8Hhy');
+INSERT INTO themes (user_id, dark_mode) VALUES (276, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (277, 'yamadayoko0', 'ほたるほたるいか', '普段歯科医師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yamadayoko.example.com/\nメールアドレス: yamadayoko@example.com\n', '$2a$04$uyoIa6HiiwtLEadlWJBcLeWrQ02LtC9hBtKy99UWDMr1fekNacxBS');
+INSERT INTO themes (user_id, dark_mode) VALUES (277, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (278, 'abeosamu0', 'さくらんぼさくっと', '普段アーティストをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://abeosamu.example.com/\nメールアドレス: abeosamu@example.com\n', '$2a$04$wNDY/crOYymoNs8G1gyRNO36/YSWS/aL8Mcv.h2nDm9..TFoJ7hCa');
+INSERT INTO themes (user_id, dark_mode) VALUES (278, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (279, 'taro210', '旅路', '普段作曲家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://taro21.example.com/\nメールアドレス: taro21@example.com\n', '$2a$04$kajn7WCr5lgnPy73ZudHxOy.XPkhEMTCPwIW.ANUhrqTViKKrWwbm');
+INSERT INTO themes (user_id, dark_mode) VALUES (279, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (280, 'katomai0', 'こころのこがねもち', '普段絵本作家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://katomai.example.com/\nメールアドレス: katomai@example.com\n', '$2a$04$EhXqaOrq7tGcR61FKb1vxOVNSeLubLpFoRJDXxERMvUQZH5a.tT/q');
+INSERT INTO themes (user_id, dark_mode) VALUES (280, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (281, 'hasegawakumiko0', 'きらきらきらり', '普段アートディレクターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hasegawakumiko.example.com/\nメールアドレス: hasegawakumiko@example.com\n', '$2a$04$5Lh81d1JW1AeHNueEENUVumXWpa6qhU.QRn0YJ1XFWRWg69Tc4PkG');
+INSERT INTO themes (user_id, dark_mode) VALUES (281, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (282, 'vhashimoto0', 'ふんわりふんわか', '普段アナウンサーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://vhashimoto.example.com/\nメールアドレス: vhashimoto@example.com\n', '$2a$04$uj2kC5wJNTrXKzFGTBfdhOKC.DYwJ6tejgVWdkZbzVXp/wY7AHW6m');
+INSERT INTO themes (user_id, dark_mode) VALUES (282, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (283, 'tanakamai0', '桜咲く羊羹', '普段医療事務員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tanakamai.example.com/\nメールアドレス: tanakamai@example.com\n', '$2a$04$mOWpE7fPGGnKrMDLz0o71u6FPNfIu/60SvKe9CNTKAAQ4cGd48a1m');
+INSERT INTO themes (user_id, dark_mode) VALUES (283, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (284, 'hmori0', 'ふんわりふんわか', '普段医療事務員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hmori.example.com/\nメールアドレス: hmori@example.com\n', '$2a$04$evS0vE3fQ4gtple5.lY6BOatdNX6jKG6eQlpbRtYQDNBQxbVRoiFW');
+INSERT INTO themes (user_id, dark_mode) VALUES (284, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (285, 'naokoyamaguchi0', 'たけのこたけのこもち', '普段測量士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://naokoyamaguchi.example.com/\nメールアドレス: naokoyamaguchi@example.com\n', '$2a$04$4g7GzmasnxaXMkyaniG3AuuyP/TS0sq1x5oTi9KphY.59juebN51W');
+INSERT INTO themes (user_id, dark_mode) VALUES (285, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (286, 'yamaguchiyuta0', 'さくらんぼさくら', '普段エンジニア演奏家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yamaguchiyuta.example.com/\nメールアドレス: yamaguchiyuta@example.com\n', '$2a$04$q20IW8IrwmTn9BWDq349ROYis2Tr3iQ3dvagCKd21VSKSlB1bLNla');
+INSERT INTO themes (user_id, dark_mode) VALUES (286, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (287, 'iyamamoto0', 'ももももももじ', '普段営業をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://iyamamoto.example.com/\nメールアドレス: iyamamoto@example.com\n', '$2a$04$W1M2RwbskgN4Xcj3SfoNreWw4p6TbM7cbhQHE5vxbDxQDEefwUxny');
+INSERT INTO themes (user_id, dark_mode) VALUES (287, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (288, 'yutanakajima0', 'さくさくさっくる', '普段絵本作家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yutanakajima.example.com/\nメールアドレス: yutanakajima@example.com\n', '$2a$04$15IR25zhI4AmeMXNpzRC9eXFostWbJzQ0AkT7qD4.E5VvgwVIeKJq');
+INSERT INTO themes (user_id, dark_mode) VALUES (288, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (289, 'kyosukesasaki0', 'かりかりかりんとう', '普段YouTuberをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kyosukesasaki.example.com/\nメールアドレス: kyosukesasaki@example.com\n', '$2a$04$34kAlIoUPVRvakcqe/xy8.YXw0R3Xq6c91qsch0rfR/MfiBTNkaTi');
+INSERT INTO themes (user_id, dark_mode) VALUES (289, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (290, 'satosatomi0', '雪花', '普段絵本作家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://satosatomi.example.com/\nメールアドレス: satosatomi@example.com\n', '$2a$04$lAp9YLJjs/1DljHgTubJBuL5r/.2NZDMnTylFpYBsdYeaznUcDkJ.');
+INSERT INTO themes (user_id, dark_mode) VALUES (290, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (291, 'kkato1', '風味豊かな塩あめ', '普段司法書士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kkato.example.com/\nメールアドレス: kkato@example.com\n', '$2a$04$ywZmBv6LQYbWGpA3yxaMcOIEQn1G.czkAd5uEK7fTeq3lfoaMoVLW');
+INSERT INTO themes (user_id, dark_mode) VALUES (291, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (292, 'kumiko980', '月影だんご', '普段配管工をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kumiko98.example.com/\nメールアドレス: kumiko98@example.com\n', '$2a$04$w5.MtTa6RDKEbD9j7XnUnOAYBcpug2XAVrjLgFg3wrlRHppbO52Gy');
+INSERT INTO themes (user_id, dark_mode) VALUES (292, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (293, 'jishii0', 'きんとききんとかんきんこうせい', '普段歯科医師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://jishii.example.com/\nメールアドレス: jishii@example.com\n', '$2a$04$0z1ni/zkE6a9Sfsaq3vb6O.Xma1va8H3h5/IfFVBH0MxrUI.OZRNm');
+INSERT INTO themes (user_id, dark_mode) VALUES (293, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (294, 'yutafujiwara0', 'はるはるはるまき', '普段占い師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yutafujiwara.example.com/\nメールアドレス: yutafujiwara@example.com\n', '$2a$04$f1BJVM5MCc6MpOOEbCtJGOI8xY3Alas0Ov8fVNuYej8b1idv59cCW');
+INSERT INTO themes (user_id, dark_mode) VALUES (294, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (295, 'fukudamomoko0', 'せんせんせんべい', '普段小説家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://fukudamomoko.example.com/\nメールアドレス: fukudamomoko@example.com\n', '$2a$04$JNkWqunO87FabYimlZkUqOyC02iDIbfQB35m.PKW7N/x2U8uqCEEW');
+INSERT INTO themes (user_id, dark_mode) VALUES (295, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (296, 'kumikokobayashi0', '銀の糸', '普段小説家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kumikokobayashi.example.com/\nメールアドレス: kumikokobayashi@example.com\n', '$2a$04$cyjbSBfNcFM.VG2ovtnqg.xlmhsOaEy.yygmJ7hlNIM2STJ4Wgh5.');
+INSERT INTO themes (user_id, dark_mode) VALUES (296, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (297, 'naoki350', 'めろんめろんケーキ', '普段行政書士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://naoki35.example.com/\nメールアドレス: naoki35@example.com\n', '$2a$04$qziCjx2Kh1JpW/bYzjKFc.v7jrvki3LJjpAxZqnlRJN.qn.NBT492');
+INSERT INTO themes (user_id, dark_mode) VALUES (297, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (298, 'sayuri230', '星降るプリン', '普段航海士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://sayuri23.example.com/\nメールアドレス: sayuri23@example.com\n', '$2a$04$6/rZQ./QnEYIa/XCChrIlOtQ.62Z0ZSRmWR27AmuY43XKPXcn8UmW');
+INSERT INTO themes (user_id, dark_mode) VALUES (298, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (299, 'morijun1', 'きんとききんとかんき', '普段調理師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://morijun.example.com/\nメールアドレス: morijun@example.com\n', '$2a$04$4R3oaiwgL3jFDx4XkSPgWeK.uk35cdQm.wKhYVFSFMREEtEUtuPZu');
+INSERT INTO themes (user_id, dark_mode) VALUES (299, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (300, 'mituru840', '真夜中', '普段音楽家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://mituru84.example.com/\nメールアドレス: mituru84@example.com\n', '$2a$04$/v16fIbxYBiHvtEmtjgydeJ/fUI2H0OhCgNdTReh5WZUtHYvubDDi');
+INSERT INTO themes (user_id, dark_mode) VALUES (300, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (301, 'eyoshida0', 'もちもちみるく', '普段エステティシャンをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://eyoshida.example.com/\nメールアドレス: eyoshida@example.com\n', '$2a$04$0BRufcJy.4FPaIDjWxsKz.5JBQQMoGZzVniBFU0s7jaC.8pHSiuaK');
+INSERT INTO themes (user_id, dark_mode) VALUES (301, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (302, 'taichi460', '正午', '普段営業をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://taichi46.example.com/\nメールアドレス: taichi46@example.com\n', '$2a$04$GvL4Wts8RiOULOE9qAYZWeX6pqOaW9F3MoWrqiAtMt3K.tepN85Xq');
+INSERT INTO themes (user_id, dark_mode) VALUES (302, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (303, 'esasaki0', 'たんぽぽたいやき', '普段運転士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://esasaki.example.com/\nメールアドレス: esasaki@example.com\n', '$2a$04$OHdW6thFVfbi6tPhy5lXGeiFAShOFDz0xFVG2iSjM0yZ.jnAa0Uqq');
+INSERT INTO themes (user_id, dark_mode) VALUES (303, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (304, 'yumikonakamura0', 'きらきらきらりん', '普段モデルをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yumikonakamura.example.com/\nメールアドレス: yumikonakamura@example.com\n', '$2a$04$0PulOoA5M9qCiCU4oL0O1OqnrGD./.dGLQPwuTf9SfioljG1ImG7.');
+INSERT INTO themes (user_id, dark_mode) VALUES (304, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (305, 'akemi540', 'みずみずしいみずもち', '普段大学教授をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://akemi54.example.com/\nメールアドレス: akemi54@example.com\n', '$2a$04$lBKwG4y37PBniflzaf/iY.9KYWo2ZBd6JSfvgT9UG0XA80uSShAJW');
+INSERT INTO themes (user_id, dark_mode) VALUES (305, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (306, 'kazuya770', 'かえるかえるかすてら', '普段調理師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kazuya77.example.com/\nメールアドレス: kazuya77@example.com\n', '$2a$04$pEqzGw.XCz0voYRH3QMJTO59V1WsTacBbGb/K2UETzzAEqaz6xU96');
+INSERT INTO themes (user_id, dark_mode) VALUES (306, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (307, 'suzukiharuka0', 'みずみずみずむし', '普段裁判官をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://suzukiharuka.example.com/\nメールアドレス: suzukiharuka@example.com\n', '$2a$04$j./lZgNj/fSYZqg7O7BDue3T7Ijeun6VVUizLRGFMheTUMIqcYK2y');
+INSERT INTO themes (user_id, dark_mode) VALUES (307, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (308, 'harukagoto0', '暁', '普段エンジニア演奏家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://harukagoto.example.com/\nメールアドレス: harukagoto@example.com\n', '$2a$04$sLLGF.m9gbfbm5pTeUjpvuW89BW.yNEylciEbeGzsacMmbCN1DnSC');
+INSERT INTO themes (user_id, dark_mode) VALUES (308, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (309, 'vkato1', 'かくかくかくいも', '普段作曲家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://vkato.example.com/\nメールアドレス: vkato@example.com\n', '$2a$04$uu1XhQxhkzIJsJ7Tzw8qfuH/wivo4hkEYkFKOP0xKulBk2nxZPEqS');
+INSERT INTO themes (user_id, dark_mode) VALUES (309, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (310, 'bmatsumoto0', 'すみれすもも', '普段イラストレーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://bmatsumoto.example.com/\nメールアドレス: bmatsumoto@example.com\n', '$2a$04$qUPj6ngqW37MzPM4LsJ7QOBsEW7qF0fUVyE4T3QJYH4AKVozbNC9q');
+INSERT INTO themes (user_id, dark_mode) VALUES (310, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (311, 'yamazakikaori0', 'ゆめゆめゆり', '普段グラフィックデザイナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yamazakikaori.example.com/\nメールアドレス: yamazakikaori@example.com\n', '$2a$04$ah/ZrA65mvjSG9M2zRSUcegW1d1d.lzyMkKl0leUmnkmTEBGogPp.');
+INSERT INTO themes (user_id, dark_mode) VALUES (311, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (312, 'yamashitasatomi0', '野草', '普段コピーライターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yamashitasatomi.example.com/\nメールアドレス: yamashitasatomi@example.com\n', '$2a$04$sdAySrC/p9LWk5J.r5LM.udKeDM02c0eSXn3GFCrJ0uMUOmDlQDy2');
+INSERT INTO themes (user_id, dark_mode) VALUES (312, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (313, 'fujiimikako0', '天空飴', '普段小説家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://fujiimikako.example.com/\nメールアドレス: fujiimikako@example.com\n', '$2a$04$uHf5EFSsbhKGqYandZv/Z.kwJ.UHjKBL5qD9hr8us.PEoIZTxNQrq');
+INSERT INTO themes (user_id, dark_mode) VALUES (313, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (314, 'ekato0', 'あいあいアイス', '普段公務員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ekato.example.com/\nメールアドレス: ekato@example.com\n', '$2a$04$2HuLJJa1611votr5CHKX/OS3xK1fAREGgbmLKSWo4Ewf5bzDGvCwi');
+INSERT INTO themes (user_id, dark_mode) VALUES (314, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (315, 'asukaito0', 'ちゅるちゅるちゅーび', '普段絵本作家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://asukaito.example.com/\nメールアドレス: asukaito@example.com\n', '$2a$04$LTNRQBExFHY8lQCn44lo/ehB/NRpBIpddcfgIVLGGUYtvJmzJ3/9O');
+INSERT INTO themes (user_id, dark_mode) VALUES (315, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (316, 'aokimiki0', '春暖', '普段経営者をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://aokimiki.example.com/\nメールアドレス: aokimiki@example.com\n', '$2a$04$vJVvUq12hZbehFmPzP.c8OnRsuSErg/5.1dLO7hq3pPFlvIQgaobu');
+INSERT INTO themes (user_id, dark_mode) VALUES (316, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (317, 'katotaichi0', 'うさぎうさぎうさぎもち', '普段ウェディングプランナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://katotaichi.example.com/\nメールアドレス: katotaichi@example.com\n', '$2a$04$zoGEpfPL0Ypk8OengyTkvuOdM0QVy30SWsY1C7y1aBvWBICJYu51y');
+INSERT INTO themes (user_id, dark_mode) VALUES (317, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (318, 'harukasasaki0', '花びら', '普段司法書士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://harukasasaki.example.com/\nメールアドレス: harukasasaki@example.com\n', '$2a$04$NFEv49zphsEdwCNLi6QYz.FbSSEyNqGuIsJ9hNj.nbXE5Z624plBm');
+INSERT INTO themes (user_id, dark_mode) VALUES (318, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (319, 'manabuyamamoto0', '朝顔', '普段運転士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://manabuyamamoto.example.com/\nメールアドレス: manabuyamamoto@example.com\n', '$2a$04$mAbZO/nJE.f8CjUSmm/IE.hqbx6XvnWlJmbNNi0ikU4p4mRcSDfuy');
+INSERT INTO themes (user_id, dark_mode) VALUES (319, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (320, 'etanaka0', 'つぶつぶつぶかんきんこうせ', '普段検察官をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://etanaka.example.com/\nメールアドレス: etanaka@example.com\n', '$2a$04$aOtXqzAbj22O492SA6pp4euu5zHmRfxxNoG.Yp4qQf9O2NiKpmVJu');
+INSERT INTO themes (user_id, dark_mode) VALUES (320, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (321, 'nakagawamituru0', 'ひよこひよこみ', '普段検察官をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://nakagawamituru.example.com/\nメールアドレス: nakagawamituru@example.com\n', '$2a$04$/0I5Z3FbgSF8C7k8qSfp3.ytDI6zVKZQ0u1qb4OeREpHxQzl6CTFW');
+INSERT INTO themes (user_id, dark_mode) VALUES (321, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (322, 'hiroshikobayashi0', '五光', '普段運転士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hiroshikobayashi.example.com/\nメールアドレス: hiroshikobayashi@example.com\n', '$2a$04$b9YqfFmp0r1WI.OGkygre./9HgRDPIFojEZZerozLRkDmQI1Fgz9a');
+INSERT INTO themes (user_id, dark_mode) VALUES (322, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (323, 'satomi900', 'おりおりおりもん', '普段ウェブデザイナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://satomi90.example.com/\nメールアドレス: satomi90@example.com\n', '$2a$04$kdb7klvhAf6bAf.prygvoungTlvAxg6KZkBOYyo4W6Zi3sU3I495m');
+INSERT INTO themes (user_id, dark_mode) VALUES (323, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (324, 'akira180', 'はなはなはなびら', '普段漫画家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://akira18.example.com/\nメールアドレス: akira18@example.com\n', '$2a$04$AXKaWtL0qEK/8xW.80xPMey9ariOJJgDcAxIsh9/aZ0fp7NSIC8cq');
+INSERT INTO themes (user_id, dark_mode) VALUES (324, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (325, 'manabu710', '秋桜まんじゅう', '普段医師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://manabu71.example.com/\nメールアドレス: manabu71@example.com\n', '$2a$04$rJ7mfRo9Aw9VW5mcgkH/se52xwvhBDUVDZEKjsrpxkUsp5ITv2zOS');
+INSERT INTO themes (user_id, dark_mode) VALUES (325, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (326, 'minoru160', '正午', '普段演歌歌手をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://minoru16.example.com/\nメールアドレス: minoru16@example.com\n', '$2a$04$xPHuVYa.1gExp.Xh3kUESOhgaBBHfbTwutEYI8rrOzwOIWM.xLHg.');
+INSERT INTO themes (user_id, dark_mode) VALUES (326, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (327, 'fujiwarataro0', 'ゆらゆらゆらりん', '普段アナウンサーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://fujiwarataro.example.com/\nメールアドレス: fujiwarataro@example.com\n', '$2a$04$se4XG.MHrkFFuSXB/aSX1uNxqY0LdYnetRSqOvTrrgFtuB9UP84TK');
+INSERT INTO themes (user_id, dark_mode) VALUES (327, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (328, 'tomoya240', '常磐', '普段ウェディングプランナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tomoya24.example.com/\nメールアドレス: tomoya24@example.com\n', '$2a$04$As9ZsAB/qUV3RHXTPW0LDOecwR.GqPIhbBHyXmgTHxVOE3xE/8G8i');
+INSERT INTO themes (user_id, dark_mode) VALUES (328, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (329, 'taichinakajima0', 'あいあいあいもち', '普段コピーライターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://taichinakajima.example.com/\nメールアドレス: taichinakajima@example.com\n', '$2a$04$a2FyUeWJPpgE//4QaPNUSePy8ie9/KM46MavQwzvTx32r05ZE2vPC');
+INSERT INTO themes (user_id, dark_mode) VALUES (329, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (330, 'atsushitakahashi0', '霧雨', '普段アーティストをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://atsushitakahashi.example.com/\nメールアドレス: atsushitakahashi@example.com\n', '$2a$04$1eF6i15wMQvGByE78X53Su7GiDOGKGBGtLuESNq.QS5YwXsICaIx6');
+INSERT INTO themes (user_id, dark_mode) VALUES (330, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (331, 'yoichi150', 'ほおずきほたる', '普段演歌歌手をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yoichi15.example.com/\nメールアドレス: yoichi15@example.com\n', '$2a$04$dAHk5cZpQrHAyj1mw5.HW.W15pHZm5nwY0uPmN/4hknber.FNS.m.');
+INSERT INTO themes (user_id, dark_mode) VALUES (331, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (332, 'fito0', '冬日', '普段モデルをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://fito.example.com/\nメールアドレス: fito@example.com\n', '$2a$04$nvAPnhFN3DbDGykNeY9u6e7uZx/RH8ID7JhwXIjNImjsPzZeTJQXu');
+INSERT INTO themes (user_id, dark_mode) VALUES (332, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (333, 'satomitakahashi0', 'はなはなはなびら', '普段医療事務員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://satomitakahashi.example.com/\nメールアドレス: satomitakahashi@example.com\n', '$2a$04$te4Lr4UAR1nJRlW.n17STuSR3HDjx1wCvnEKyQso4x/tlpWtmOtaa');
+INSERT INTO themes (user_id, dark_mode) VALUES (333, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (334, 'akemi230', 'うさぎうしろ', '普段ウェディングプランナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://akemi23.example.com/\nメールアドレス: akemi23@example.com\n', '$2a$04$6CW3TBbvY3ja/vT.gfdDS.m0M1BmgxjZRE7Xyunk6BFDEkbqlaqn6');
+INSERT INTO themes (user_id, dark_mode) VALUES (334, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (335, 'akato0', 'あんこあんこかんき', '普段公認会計士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://akato.example.com/\nメールアドレス: akato@example.com\n', '$2a$04$dSbZPImycO340SYKNxZ/Q.Gwbz9g7hIJ7Cnddjqptv6Zmf6HVgyzC');
+INSERT INTO themes (user_id, dark_mode) VALUES (335, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (336, 'asuka250', 'たらたらたんやく', '普段映画監督をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://asuka25.example.com/\nメールアドレス: asuka25@example.com\n', '$2a$04$aGeuD2L8RycBjr3DqWbZUOs2w90DCowMfOFLpkW8sabWl1s3FmRoK');
+INSERT INTO themes (user_id, dark_mode) VALUES (336, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (337, 'atsushiinoue0', 'めろんめろんケーキ', '普段営業をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://atsushiinoue.example.com/\nメールアドレス: atsushiinoue@example.com\n', '$2a$04$jlMvCu6723IQhhKTEgwZWeRA0MoVuDp5Jm1TXkr4VYaunzWewwI0G');
+INSERT INTO themes (user_id, dark_mode) VALUES (337, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (338, 'sasakirei0', 'ごまごまごませんべい', '普段モデルをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://sasakirei.example.com/\nメールアドレス: sasakirei@example.com\n', '$2a$04$P3AbZWSr0kefGE86Jzy.rOK3eTvN1XZA4R5igmI.qgmuj8gvV29J.');
+INSERT INTO themes (user_id, dark_mode) VALUES (338, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (339, 'yamadamiki0', 'たくたくたくあん', '普段漫画家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yamadamiki.example.com/\nメールアドレス: yamadamiki@example.com\n', '$2a$04$di0xhcmFLQYhttsk59.KIec.lYk2Pg2IzidWkst1sdYRjFPfIZE26');
+INSERT INTO themes (user_id, dark_mode) VALUES (339, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (340, 'taro890', 'ふわふわ夏', '普段検察官をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://taro89.example.com/\nメールアドレス: taro89@example.com\n', '$2a$04$Kyjwam4jnpx3kgsHO2LI1uNeXehTyAOKx6bLS/P2vfUimriVhpxoi');
+INSERT INTO themes (user_id, dark_mode) VALUES (340, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (341, 'yamamotohiroshi0', 'さくさくさっくりん', '普段アートディレクターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yamamotohiroshi.example.com/\nメールアドレス: yamamotohiroshi@example.com\n', '$2a$04$cGmoHuamN4w.LvrIqU1mwO8BKvYlZbmxZQFGRmSDCMRAmRCXt1B7.');
+INSERT INTO themes (user_id, dark_mode) VALUES (341, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (342, 'vtakahashi0', '虹色', '普段コピーライターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://vtakahashi.example.com/\nメールアドレス: vtakahashi@example.com\n', '$2a$04$YT65ydKjNDzNXvxK2C3NCeR2MNydvke77NqauyRGtknDidvbDng2W');
+INSERT INTO themes (user_id, dark_mode) VALUES (342, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (343, 'yoichiyoshida0', '美味', '普段脚本家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yoichiyoshida.example.com/\nメールアドレス: yoichiyoshida@example.com\n', '$2a$04$VcfyEdo7EZPDyAXcbt/f9.kJH2of1qAn1wbfMsQZ/WBfgN8JH4hLC');
+INSERT INTO themes (user_id, dark_mode) VALUES (343, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (344, 'nishimurashohei0', 'あまあまあまいも', '普段YouTuberをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://nishimurashohei.example.com/\nメールアドレス: nishimurashohei@example.com\n', '$2a$04$ycsZAgjpnNgA671FYbukYeT6LCor5Db/92e3gqRdJM6cYpaHam4K6');
+INSERT INTO themes (user_id, dark_mode) VALUES (344, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (345, 'zsasaki0', 'さくらさくらさくらん', '普段作曲家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://zsasaki.example.com/\nメールアドレス: zsasaki@example.com\n', '$2a$04$DJwI/7petpqGJCRmDSG5nuQnYchTl20az2rsgxu8Uc7wAImKveQCu');
+INSERT INTO themes (user_id, dark_mode) VALUES (345, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (346, 'shimizumaaya0', 'ちゅるんちゅるん', '普段行政書士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://shimizumaaya.example.com/\nメールアドレス: shimizumaaya@example.com\n', '$2a$04$UwZb6IFm4tgS1pLvujdaV.sK3Gq9zN2IJyIuGjqtMyuSL/dZXfqjK');
+INSERT INTO themes (user_id, dark_mode) VALUES (346, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (347, 'inoueosamu0', '星流し団子', '普段測量士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://inoueosamu.example.com/\nメールアドレス: inoueosamu@example.com\n', '$2a$04$p.G7Rfg50hqn6oFyNHEFCesVkulf1gIMikz8y8uqA1MGDoswG8R7y');
+INSERT INTO themes (user_id, dark_mode) VALUES (347, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (348, 'hsato0', '夕べ', '普段栄養士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hsato.example.com/\nメールアドレス: hsato@example.com\n', '$2a$04$PUrpyrjNpP70jWQyFIS90OhOzVk3PRTdtDSn97v5GlHG5//.Pc8ma');
+INSERT INTO themes (user_id, dark_mode) VALUES (348, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (349, 'kimuraharuka0', 'ころころこまねこ', '普段作曲家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kimuraharuka.example.com/\nメールアドレス: kimuraharuka@example.com\n', '$2a$04$/xwDxJw0l7il8nerRB7Ey.zwvtCMT3HP8ha6Sil9l6ZNL8eYuTSd6');
+INSERT INTO themes (user_id, dark_mode) VALUES (349, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (350, 'takumayoshida0', 'ねこのてねりきり', '普段配管工をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://takumayoshida.example.com/\nメールアドレス: takumayoshida@example.com\n', '$2a$04$Bfs9ObF/qjudHK.wofdamOQpZWHt3yPiU2qsUEDwprjtPcPNYqGqu');
+INSERT INTO themes (user_id, dark_mode) VALUES (350, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (351, 'ikedahideki0', '神秘の森', '普段音楽家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ikedahideki.example.com/\nメールアドレス: ikedahideki@example.com\n', '$2a$04$GWjJPRhvDfqpEvp8bp7dW.ug.BHMx9em4WL8BSRPuMXj/ylhr8TmW');
+INSERT INTO themes (user_id, dark_mode) VALUES (351, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (352, 'kobayashitsubasa0', 'ひよこひよこみ', '普段営業をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kobayashitsubasa.example.com/\nメールアドレス: kobayashitsubasa@example.com\n', '$2a$04$Wyg2OV0Eg7eSCnoFqtJLgujNnkduv2.4hrKlyiAANYCN0uIq/Df0O');
+INSERT INTO themes (user_id, dark_mode) VALUES (352, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (353, 'jmurakami0', '木漏れ日', '普段ウェブデザイナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://jmurakami.example.com/\nメールアドレス: jmurakami@example.com\n', '$2a$04$t4O0rPbs5QVsxI9VFAXoyeWsJ/rHAdpFs4ChmoeOn4WStCBmHc9dC');
+INSERT INTO themes (user_id, dark_mode) VALUES (353, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (354, 'kaori320', '潮風', '普段絵本作家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kaori32.example.com/\nメールアドレス: kaori32@example.com\n', '$2a$04$p555Qy6kgTbVo67k29l9VuWeqo1vPANms6He5BGOgn2ypqolyR3Re');
+INSERT INTO themes (user_id, dark_mode) VALUES (354, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (355, 'suzukimiki0', 'ふんわりふんわかんきんこ', '普段小説家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://suzukimiki.example.com/\nメールアドレス: suzukimiki@example.com\n', '$2a$04$0puas75g4hIjKHehCQqrMe1GCX8szA3.I6oCpQ3.tK3kudFHCLcRO');
+INSERT INTO themes (user_id, dark_mode) VALUES (355, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (356, 'gtanaka0', '明朝', '普段栄養士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://gtanaka.example.com/\nメールアドレス: gtanaka@example.com\n', '$2a$04$Mfrba/tPNPbWeMHJ67dVG.mbMrbkI/J3QQrZpKr7H4.oYt.cWZ1Ui');
+INSERT INTO themes (user_id, dark_mode) VALUES (356, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (357, 'shota220', 'うめうめうめかんきんこうせ', '普段小説家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://shota22.example.com/\nメールアドレス: shota22@example.com\n', '$2a$04$7ZWkDLISNMr//oWec9KFceiQBYZ6czBLle3kvgjL9ek5B/FA82wD6');
+INSERT INTO themes (user_id, dark_mode) VALUES (357, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (358, 'fyamada0', 'わらびわらびもち', '普段大学教授をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://fyamada.example.com/\nメールアドレス: fyamada@example.com\n', '$2a$04$TMA.ea7HW0wmcLE0vd6hQ.PsJ1mZ4niDzhZN1gi7yiWPoPnawgsrm');
+INSERT INTO themes (user_id, dark_mode) VALUES (358, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (359, 'nishimuraminoru0', 'めろんめろんケーキ', '普段アニメーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://nishimuraminoru.example.com/\nメールアドレス: nishimuraminoru@example.com\n', '$2a$04$807xAItPSriQOKMQ9iBW5OBfqwuWei.fJIhMJ3nlP9B9MX5yC/yo6');
+INSERT INTO themes (user_id, dark_mode) VALUES (359, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (360, 'ttanaka0', 'ときめきトフィー', '普段経営者をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ttanaka.example.com/\nメールアドレス: ttanaka@example.com\n', '$2a$04$0PccloP90oBYVZSg1GLWFO0FJ7hRVaK6/NL2dFfEtFjoe8gUYiuN6');
+INSERT INTO themes (user_id, dark_mode) VALUES (360, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (361, 'satomi280', 'やわらかやわらび', '普段公認会計士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://satomi28.example.com/\nメールアドレス: satomi28@example.com\n', '$2a$04$q3ocvbPjvd5Sp1MYZwHciOn3yzDEQWVkpxkeoDzywyHlJMQX8CmXq');
+INSERT INTO themes (user_id, dark_mode) VALUES (361, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (362, 'maaya390', 'ふわふわふわりん', '普段エステティシャンをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://maaya39.example.com/\nメールアドレス: maaya39@example.com\n', '$2a$04$C5kAIMdMDC9Fcap9LTzBK.4NEn8gIGZgPWzelYFLddPbIni7MZETG');
+INSERT INTO themes (user_id, dark_mode) VALUES (362, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (363, 'snakamura0', 'うさぎうさぎうさぎもち', '普段イラストレーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://snakamura.example.com/\nメールアドレス: snakamura@example.com\n', '$2a$04$RbZ/5L2foJkNG2EgXMUblOgZ6xmEdxGTxQjZqyu4lCaqGR9yuCr3y');
+INSERT INTO themes (user_id, dark_mode) VALUES (363, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (364, 'okamototsubasa0', '麗し', '普段音響技術者をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://okamototsubasa.example.com/\nメールアドレス: okamototsubasa@example.com\n', '$2a$04$DpI07.7ql.45WjIfl28LP.8nI005.krxyHLudkIdX0CKmcW4OCh6m');
+INSERT INTO themes (user_id, dark_mode) VALUES (364, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (365, 'etakahashi0', '海の声', '普段救急救命士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://etakahashi.example.com/\nメールアドレス: etakahashi@example.com\n', '$2a$04$.GkE/OUCwvZ2gheBHs7q4uOf/YMfAj9PNpYn6jCwrOEx16dspw2qa');
+INSERT INTO themes (user_id, dark_mode) VALUES (365, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (366, 'taro500', '月明かり', '普段薬剤師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://taro50.example.com/\nメールアドレス: taro50@example.com\n', '$2a$04$3EUNF8B0yBBbGUWMH0jo1.2p.tlXVGi3Q3cdvU8rJuHgmJo4QwOQG');
+INSERT INTO themes (user_id, dark_mode) VALUES (366, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (367, 'yasuhirotakahashi0', 'むしむしむしようかん', '普段歯科医師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yasuhirotakahashi.example.com/\nメールアドレス: yasuhirotakahashi@example.com\n', '$2a$04$s.JWzrfBPfrBq5gyPL7sv.ldvADiNe2US9iKWtL.qY5qm1zZeAFge');
+INSERT INTO themes (user_id, dark_mode) VALUES (367, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (368, 'uhasegawa0', 'まりまりまりもち', '普段アーティストをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://uhasegawa.example.com/\nメールアドレス: uhasegawa@example.com\n', '$2a$04$WE0FHGKNQ0HAEBZ8Xi9NDe32IIIyjTv9X3yuBQhvbS5U9BrylEMEW');
+INSERT INTO themes (user_id, dark_mode) VALUES (368, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (369, 'miki470', 'さくらんさくらんぼ', '普段気象予報士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://miki47.example.com/\nメールアドレス: miki47@example.com\n', '$2a$04$NTzkO97hOWpI1U3mx.odt.LreHylHcR9RjZkBPhSqbnJ.U6jOhI2a');
+INSERT INTO themes (user_id, dark_mode) VALUES (369, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (370, 'ryosukeito0', 'つぶつぶつぶかんきんこ', '普段電気工事士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ryosukeito.example.com/\nメールアドレス: ryosukeito@example.com\n', '$2a$04$Z27Z/RwqmDnOXnXFiqbNBOZN.D.GqX0VV/AZQUgUQ7kqfA5MMBn7m');
+INSERT INTO themes (user_id, dark_mode) VALUES (370, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (371, 'kanasaito0', '雷光だんご', '普段映画監督をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kanasaito.example.com/\nメールアドレス: kanasaito@example.com\n', '$2a$04$KMktrTFWS6MIiy9InssireRwhpPrhWbgjy0eQqyoNIKaboUvqJ6.K');
+INSERT INTO themes (user_id, dark_mode) VALUES (371, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (372, 'dyamashita0', 'はなはなはなびら', '普段音響技術者をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://dyamashita.example.com/\nメールアドレス: dyamashita@example.com\n', '$2a$04$xpe.xozmfHv7Z/2jNRuaee1bD8IXeEi4WDXM4zF76kcJ0UCZK0aLW');
+INSERT INTO themes (user_id, dark_mode) VALUES (372, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (373, 'taichi180', 'あいあいあんぱん', '普段高等学校教員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://taichi18.example.com/\nメールアドレス: taichi18@example.com\n', '$2a$04$5CnWWFdt4IauwyC4BjqTtuDrLiQ7Jkvi1MkaOiojVvQ1vMQER.p.W');
+INSERT INTO themes (user_id, dark_mode) VALUES (373, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (374, 'iyamazaki0', 'はるはるはるえ', '普段検察官をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://iyamazaki.example.com/\nメールアドレス: iyamazaki@example.com\n', '$2a$04$GO61jM8wsg0iiiPC6KWmZe05mDmajliBl0GtxRLCnK2eq5Mo/.C/O');
+INSERT INTO themes (user_id, dark_mode) VALUES (374, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (375, 'yui950', '未来', '普段公務員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yui95.example.com/\nメールアドレス: yui95@example.com\n', '$2a$04$nBWPPUXpkUpHWWZ7kJYR2OLxhYsiLuAVeDsDCO1WcQZEQ3Q8O9jFC');
+INSERT INTO themes (user_id, dark_mode) VALUES (375, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (376, 'kumikomaeda0', 'いちごいちごかんきんこうせい', '普段歯科医師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kumikomaeda.example.com/\nメールアドレス: kumikomaeda@example.com\n', '$2a$04$T5oIWCDo9kr3TdZy8Ek1TegBsy3rzF0u/9HrJex1uRdED8xwE8Hhy');
+INSERT INTO themes (user_id, dark_mode) VALUES (376, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (377, 'yuiwatanabe1', '大和', '普段アイドルをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yuiwatanabe.example.com/\nメールアドレス: yuiwatanabe@example.com\n', '$2a$04$uyoIa6HiiwtLEadlWJBcLeWrQ02LtC9hBtKy99UWDMr1fekNacxBS');
+INSERT INTO themes (user_id, dark_mode) VALUES (377, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (378, 'suzukishota0', 'いちごいちごかん', '普段医療事務員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://suzukishota.example.com/\nメールアドレス: suzukishota@example.com\n', '$2a$04$wNDY/crOYymoNs8G1gyRNO36/YSWS/aL8Mcv.h2nDm9..TFoJ7hCa');
+INSERT INTO themes (user_id, dark_mode) VALUES (378, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (379, 'vsuzuki0', 'ちびちびまんじゅう', '普段アートディレクターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://vsuzuki.example.com/\nメールアドレス: vsuzuki@example.com\n', '$2a$04$kajn7WCr5lgnPy73ZudHxOy.XPkhEMTCPwIW.ANUhrqTViKKrWwbm');
+INSERT INTO themes (user_id, dark_mode) VALUES (379, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (380, 'tanakahiroshi0', '仙女の舞いゼリー', '普段大学教授をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tanakahiroshi.example.com/\nメールアドレス: tanakahiroshi@example.com\n', '$2a$04$EhXqaOrq7tGcR61FKb1vxOVNSeLubLpFoRJDXxERMvUQZH5a.tT/q');
+INSERT INTO themes (user_id, dark_mode) VALUES (380, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (381, 'yosukekimura0', 'お月さまもち', '普段小説家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yosukekimura.example.com/\nメールアドレス: yosukekimura@example.com\n', '$2a$04$5Lh81d1JW1AeHNueEENUVumXWpa6qhU.QRn0YJ1XFWRWg69Tc4PkG');
+INSERT INTO themes (user_id, dark_mode) VALUES (381, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (382, 'saitoryosuke0', 'そばそばそばかす', '普段演歌歌手をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://saitoryosuke.example.com/\nメールアドレス: saitoryosuke@example.com\n', '$2a$04$uj2kC5wJNTrXKzFGTBfdhOKC.DYwJ6tejgVWdkZbzVXp/wY7AHW6m');
+INSERT INTO themes (user_id, dark_mode) VALUES (382, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (383, 'ymori1', '癒し', '普段寿司職人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ymori.example.com/\nメールアドレス: ymori@example.com\n', '$2a$04$mOWpE7fPGGnKrMDLz0o71u6FPNfIu/60SvKe9CNTKAAQ4cGd48a1m');
+INSERT INTO themes (user_id, dark_mode) VALUES (383, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (384, 'xnakajima0', '菜の花', '普段バスガイドをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://xnakajima.example.com/\nメールアドレス: xnakajima@example.com\n', '$2a$04$evS0vE3fQ4gtple5.lY6BOatdNX6jKG6eQlpbRtYQDNBQxbVRoiFW');
+INSERT INTO themes (user_id, dark_mode) VALUES (384, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (385, 'zyamazaki0', 'いちごいがり', '普段栄養士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://zyamazaki.example.com/\nメールアドレス: zyamazaki@example.com\n', '$2a$04$4g7GzmasnxaXMkyaniG3AuuyP/TS0sq1x5oTi9KphY.59juebN51W');
+INSERT INTO themes (user_id, dark_mode) VALUES (385, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (386, 'mikakoyamamoto0', 'ふくろうのふくれんじ', '普段農家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://mikakoyamamoto.example.com/\nメールアドレス: mikakoyamamoto@example.com\n', '$2a$04$q20IW8IrwmTn9BWDq349ROYis2Tr3iQ3dvagCKd21VSKSlB1bLNla');
+INSERT INTO themes (user_id, dark_mode) VALUES (386, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (387, 'tanakahideki0', '竹の子', '普段電気工事士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tanakahideki.example.com/\nメールアドレス: tanakahideki@example.com\n', '$2a$04$W1M2RwbskgN4Xcj3SfoNreWw4p6TbM7cbhQHE5vxbDxQDEefwUxny');
+INSERT INTO themes (user_id, dark_mode) VALUES (387, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (388, 'ekato1', 'たいたいたいやき', '普段農家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ekato.example.com/\nメールアドレス: ekato@example.com\n', '$2a$04$15IR25zhI4AmeMXNpzRC9eXFostWbJzQ0AkT7qD4.E5VvgwVIeKJq');
+INSERT INTO themes (user_id, dark_mode) VALUES (388, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (389, 'momokomori0', 'あまあまあまいも', '普段アニメーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://momokomori.example.com/\nメールアドレス: momokomori@example.com\n', '$2a$04$34kAlIoUPVRvakcqe/xy8.YXw0R3Xq6c91qsch0rfR/MfiBTNkaTi');
+INSERT INTO themes (user_id, dark_mode) VALUES (389, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (390, 'tsubasa260', 'ほっぺのほうじ茶', '普段電気工事士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tsubasa26.example.com/\nメールアドレス: tsubasa26@example.com\n', '$2a$04$lAp9YLJjs/1DljHgTubJBuL5r/.2NZDMnTylFpYBsdYeaznUcDkJ.');
+INSERT INTO themes (user_id, dark_mode) VALUES (390, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (391, 'mikako290', 'はんぺんはんぺんもち', '普段モデルをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://mikako29.example.com/\nメールアドレス: mikako29@example.com\n', '$2a$04$ywZmBv6LQYbWGpA3yxaMcOIEQn1G.czkAd5uEK7fTeq3lfoaMoVLW');
+INSERT INTO themes (user_id, dark_mode) VALUES (391, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (392, 'yoko160', '朝霧', '普段絵本作家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yoko16.example.com/\nメールアドレス: yoko16@example.com\n', '$2a$04$w5.MtTa6RDKEbD9j7XnUnOAYBcpug2XAVrjLgFg3wrlRHppbO52Gy');
+INSERT INTO themes (user_id, dark_mode) VALUES (392, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (393, 'yuki480', 'きんきんきんとん', '普段行政書士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yuki48.example.com/\nメールアドレス: yuki48@example.com\n', '$2a$04$0z1ni/zkE6a9Sfsaq3vb6O.Xma1va8H3h5/IfFVBH0MxrUI.OZRNm');
+INSERT INTO themes (user_id, dark_mode) VALUES (393, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (394, 'hasegawakumiko1', 'もちもちもちあずき', '普段バスガイドをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hasegawakumiko.example.com/\nメールアドレス: hasegawakumiko@example.com\n', '$2a$04$f1BJVM5MCc6MpOOEbCtJGOI8xY3Alas0Ov8fVNuYej8b1idv59cCW');
+INSERT INTO themes (user_id, dark_mode) VALUES (394, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (395, 'itokana0', 'うめうめうめぼし', '普段小説家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://itokana.example.com/\nメールアドレス: itokana@example.com\n', '$2a$04$JNkWqunO87FabYimlZkUqOyC02iDIbfQB35m.PKW7N/x2U8uqCEEW');
+INSERT INTO themes (user_id, dark_mode) VALUES (395, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (396, 'fkobayashi0', 'もちもちどら焼き', '普段映画監督をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://fkobayashi.example.com/\nメールアドレス: fkobayashi@example.com\n', '$2a$04$cyjbSBfNcFM.VG2ovtnqg.xlmhsOaEy.yygmJ7hlNIM2STJ4Wgh5.');
+INSERT INTO themes (user_id, dark_mode) VALUES (396, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (397, 'tomoya630', 'はるはるはるかんきんこうせいさ', '普段アニメーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tomoya63.example.com/\nメールアドレス: tomoya63@example.com\n', '$2a$04$qziCjx2Kh1JpW/bYzjKFc.v7jrvki3LJjpAxZqnlRJN.qn.NBT492');
+INSERT INTO themes (user_id, dark_mode) VALUES (397, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (398, 'yuki730', '明るみ', '普段音楽家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yuki73.example.com/\nメールアドレス: yuki73@example.com\n', '$2a$04$6/rZQ./QnEYIa/XCChrIlOtQ.62Z0ZSRmWR27AmuY43XKPXcn8UmW');
+INSERT INTO themes (user_id, dark_mode) VALUES (398, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (399, 'vyamaguchi0', 'くずくずくずまんじゅう', '普段医療事務員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://vyamaguchi.example.com/\nメールアドレス: vyamaguchi@example.com\n', '$2a$04$4R3oaiwgL3jFDx4XkSPgWeK.uk35cdQm.wKhYVFSFMREEtEUtuPZu');
+INSERT INTO themes (user_id, dark_mode) VALUES (399, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (400, 'tshimizu0', 'ほわほわせんべい', '普段農家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tshimizu.example.com/\nメールアドレス: tshimizu@example.com\n', '$2a$04$/v16fIbxYBiHvtEmtjgydeJ/fUI2H0OhCgNdTReh5WZUtHYvubDDi');
+INSERT INTO themes (user_id, dark_mode) VALUES (400, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (401, 'pota0', '夕凪', '普段寿司職人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://pota.example.com/\nメールアドレス: pota@example.com\n', '$2a$04$0BRufcJy.4FPaIDjWxsKz.5JBQQMoGZzVniBFU0s7jaC.8pHSiuaK');
+INSERT INTO themes (user_id, dark_mode) VALUES (401, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (402, 'hiroshi960', '桜ももち', '普段司法書士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hiroshi96.example.com/\nメールアドレス: hiroshi96@example.com\n', '$2a$04$GvL4Wts8RiOULOE9qAYZWeX6pqOaW9F3MoWrqiAtMt3K.tepN85Xq');
+INSERT INTO themes (user_id, dark_mode) VALUES (402, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (403, 'katoyui0', 'さわやかさわらび', '普段建築家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://katoyui.example.com/\nメールアドレス: katoyui@example.com\n', '$2a$04$OHdW6thFVfbi6tPhy5lXGeiFAShOFDz0xFVG2iSjM0yZ.jnAa0Uqq');
+INSERT INTO themes (user_id, dark_mode) VALUES (403, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (404, 'mkondo1', '早朝', '普段バスガイドをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://mkondo.example.com/\nメールアドレス: mkondo@example.com\n', '$2a$04$0PulOoA5M9qCiCU4oL0O1OqnrGD./.dGLQPwuTf9SfioljG1ImG7.');
+INSERT INTO themes (user_id, dark_mode) VALUES (404, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (405, 'rika500', '暖かい風', '普段高等学校教員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://rika50.example.com/\nメールアドレス: rika50@example.com\n', '$2a$04$lBKwG4y37PBniflzaf/iY.9KYWo2ZBd6JSfvgT9UG0XA80uSShAJW');
+INSERT INTO themes (user_id, dark_mode) VALUES (405, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (406, 'einoue0', 'ひまわりひまわりん', '普段小説家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://einoue.example.com/\nメールアドレス: einoue@example.com\n', '$2a$04$pEqzGw.XCz0voYRH3QMJTO59V1WsTacBbGb/K2UETzzAEqaz6xU96');
+INSERT INTO themes (user_id, dark_mode) VALUES (406, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (407, 'yamashitayumiko0', '夜風', '普段音響技術者をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yamashitayumiko.example.com/\nメールアドレス: yamashitayumiko@example.com\n', '$2a$04$j./lZgNj/fSYZqg7O7BDue3T7Ijeun6VVUizLRGFMheTUMIqcYK2y');
+INSERT INTO themes (user_id, dark_mode) VALUES (407, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (408, 'kazuya680', '露草', '普段アートディレクターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kazuya68.example.com/\nメールアドレス: kazuya68@example.com\n', '$2a$04$sLLGF.m9gbfbm5pTeUjpvuW89BW.yNEylciEbeGzsacMmbCN1DnSC');
+INSERT INTO themes (user_id, dark_mode) VALUES (408, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (409, 'asukakobayashi0', 'うめうめうめかんきんこうせいさ', '普段アートディレクターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://asukakobayashi.example.com/\nメールアドレス: asukakobayashi@example.com\n', '$2a$04$uu1XhQxhkzIJsJ7Tzw8qfuH/wivo4hkEYkFKOP0xKulBk2nxZPEqS');
+INSERT INTO themes (user_id, dark_mode) VALUES (409, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (410, 'miki320', 'こころこころもち', '普段音楽家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://miki32.example.com/\nメールアドレス: miki32@example.com\n', '$2a$04$qUPj6ngqW37MzPM4LsJ7QOBsEW7qF0fUVyE4T3QJYH4AKVozbNC9q');
+INSERT INTO themes (user_id, dark_mode) VALUES (410, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (411, 'yumikosuzuki0', '千秋', '普段漫画家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yumikosuzuki.example.com/\nメールアドレス: yumikosuzuki@example.com\n', '$2a$04$ah/ZrA65mvjSG9M2zRSUcegW1d1d.lzyMkKl0leUmnkmTEBGogPp.');
+INSERT INTO themes (user_id, dark_mode) VALUES (411, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (412, 'naotoito0', 'つぶつぶつぶやき', '普段測量士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://naotoito.example.com/\nメールアドレス: naotoito@example.com\n', '$2a$04$sdAySrC/p9LWk5J.r5LM.udKeDM02c0eSXn3GFCrJ0uMUOmDlQDy2');
+INSERT INTO themes (user_id, dark_mode) VALUES (412, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (413, 'esuzuki0', 'つぶつぶつぶつぶ', '普段歯科医師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://esuzuki.example.com/\nメールアドレス: esuzuki@example.com\n', '$2a$04$uHf5EFSsbhKGqYandZv/Z.kwJ.UHjKBL5qD9hr8us.PEoIZTxNQrq');
+INSERT INTO themes (user_id, dark_mode) VALUES (413, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (414, 'hiroshiaoki0', 'めろんめろんケーキ', '普段ゲームクリエイターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hiroshiaoki.example.com/\nメールアドレス: hiroshiaoki@example.com\n', '$2a$04$2HuLJJa1611votr5CHKX/OS3xK1fAREGgbmLKSWo4Ewf5bzDGvCwi');
+INSERT INTO themes (user_id, dark_mode) VALUES (414, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (415, 'vishikawa0', '風車まんじゅう', '普段絵本作家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://vishikawa.example.com/\nメールアドレス: vishikawa@example.com\n', '$2a$04$LTNRQBExFHY8lQCn44lo/ehB/NRpBIpddcfgIVLGGUYtvJmzJ3/9O');
+INSERT INTO themes (user_id, dark_mode) VALUES (415, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (416, 'akiraito0', '桜ももち', '普段占い師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://akiraito.example.com/\nメールアドレス: akiraito@example.com\n', '$2a$04$vJVvUq12hZbehFmPzP.c8OnRsuSErg/5.1dLO7hq3pPFlvIQgaobu');
+INSERT INTO themes (user_id, dark_mode) VALUES (416, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (417, 'mikako020', 'ちいさなちんすこう', '普段運転士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://mikako02.example.com/\nメールアドレス: mikako02@example.com\n', '$2a$04$zoGEpfPL0Ypk8OengyTkvuOdM0QVy30SWsY1C7y1aBvWBICJYu51y');
+INSERT INTO themes (user_id, dark_mode) VALUES (417, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (418, 'miurasatomi0', '麗し', '普段ウェディングプランナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://miurasatomi.example.com/\nメールアドレス: miurasatomi@example.com\n', '$2a$04$NFEv49zphsEdwCNLi6QYz.FbSSEyNqGuIsJ9hNj.nbXE5Z624plBm');
+INSERT INTO themes (user_id, dark_mode) VALUES (418, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (419, 'etakahashi1', '香風', '普段配管工をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://etakahashi.example.com/\nメールアドレス: etakahashi@example.com\n', '$2a$04$mAbZO/nJE.f8CjUSmm/IE.hqbx6XvnWlJmbNNi0ikU4p4mRcSDfuy');
+INSERT INTO themes (user_id, dark_mode) VALUES (419, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (420, 'tarosato0', 'ねんねんねんりん', '普段占い師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tarosato.example.com/\nメールアドレス: tarosato@example.com\n', '$2a$04$aOtXqzAbj22O492SA6pp4euu5zHmRfxxNoG.Yp4qQf9O2NiKpmVJu');
+INSERT INTO themes (user_id, dark_mode) VALUES (420, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (421, 'matsumotomanabu0', 'いちごいちごいち', '普段営業をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://matsumotomanabu.example.com/\nメールアドレス: matsumotomanabu@example.com\n', '$2a$04$/0I5Z3FbgSF8C7k8qSfp3.ytDI6zVKZQ0u1qb4OeREpHxQzl6CTFW');
+INSERT INTO themes (user_id, dark_mode) VALUES (421, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (422, 'naoko560', 'かんかんかんろ', '普段漫画家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://naoko56.example.com/\nメールアドレス: naoko56@example.com\n', '$2a$04$b9YqfFmp0r1WI.OGkygre./9HgRDPIFojEZZerozLRkDmQI1Fgz9a');
+INSERT INTO themes (user_id, dark_mode) VALUES (422, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (423, 'kaori570', 'うめうめうめかんきんこうせいさ', '普段薬剤師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kaori57.example.com/\nメールアドレス: kaori57@example.com\n', '$2a$04$kdb7klvhAf6bAf.prygvoungTlvAxg6KZkBOYyo4W6Zi3sU3I495m');
+INSERT INTO themes (user_id, dark_mode) VALUES (423, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (424, 'minoru210', 'ひとしずくひとみ', '普段行政書士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://minoru21.example.com/\nメールアドレス: minoru21@example.com\n', '$2a$04$AXKaWtL0qEK/8xW.80xPMey9ariOJJgDcAxIsh9/aZ0fp7NSIC8cq');
+INSERT INTO themes (user_id, dark_mode) VALUES (424, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (425, 'jsuzuki0', 'はるはるはるかんきんこ', '普段薬剤師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://jsuzuki.example.com/\nメールアドレス: jsuzuki@example.com\n', '$2a$04$rJ7mfRo9Aw9VW5mcgkH/se52xwvhBDUVDZEKjsrpxkUsp5ITv2zOS');
+INSERT INTO themes (user_id, dark_mode) VALUES (425, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (426, 'tanakashohei1', '大輪', '普段歯科医師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tanakashohei.example.com/\nメールアドレス: tanakashohei@example.com\n', '$2a$04$xPHuVYa.1gExp.Xh3kUESOhgaBBHfbTwutEYI8rrOzwOIWM.xLHg.');
+INSERT INTO themes (user_id, dark_mode) VALUES (426, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (427, 'tyamada0', 'ゆぶらゆぶらん', '普段ウェブデザイナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tyamada.example.com/\nメールアドレス: tyamada@example.com\n', '$2a$04$se4XG.MHrkFFuSXB/aSX1uNxqY0LdYnetRSqOvTrrgFtuB9UP84TK');
+INSERT INTO themes (user_id, dark_mode) VALUES (427, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (428, 'kenichi160', 'あんこあんこかんきんこ', '普段音響技術者をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kenichi16.example.com/\nメールアドレス: kenichi16@example.com\n', '$2a$04$As9ZsAB/qUV3RHXTPW0LDOecwR.GqPIhbBHyXmgTHxVOE3xE/8G8i');
+INSERT INTO themes (user_id, dark_mode) VALUES (428, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (429, 'shotayamada0', 'つくつくつくねもち', '普段アーティストをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://shotayamada.example.com/\nメールアドレス: shotayamada@example.com\n', '$2a$04$a2FyUeWJPpgE//4QaPNUSePy8ie9/KM46MavQwzvTx32r05ZE2vPC');
+INSERT INTO themes (user_id, dark_mode) VALUES (429, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (430, 'hiroshi070', 'くりくりくりこ', '普段測量士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hiroshi07.example.com/\nメールアドレス: hiroshi07@example.com\n', '$2a$04$1eF6i15wMQvGByE78X53Su7GiDOGKGBGtLuESNq.QS5YwXsICaIx6');
+INSERT INTO themes (user_id, dark_mode) VALUES (430, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (431, 'hidekisakamoto0', 'きらきらきんとん', '普段救急救命士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hidekisakamoto.example.com/\nメールアドレス: hidekisakamoto@example.com\n', '$2a$04$dAHk5cZpQrHAyj1mw5.HW.W15pHZm5nwY0uPmN/4hknber.FNS.m.');
+INSERT INTO themes (user_id, dark_mode) VALUES (431, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (432, 'naokiikeda0', 'ふんわりふぎゅう', '普段航海士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://naokiikeda.example.com/\nメールアドレス: naokiikeda@example.com\n', '$2a$04$nvAPnhFN3DbDGykNeY9u6e7uZx/RH8ID7JhwXIjNImjsPzZeTJQXu');
+INSERT INTO themes (user_id, dark_mode) VALUES (432, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (433, 'nakagawamaaya0', '梅雨入り', '普段救急救命士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://nakagawamaaya.example.com/\nメールアドレス: nakagawamaaya@example.com\n', '$2a$04$te4Lr4UAR1nJRlW.n17STuSR3HDjx1wCvnEKyQso4x/tlpWtmOtaa');
+INSERT INTO themes (user_id, dark_mode) VALUES (433, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (434, 'hiroshitanaka1', 'さくらんさくらかんきんこうせいさ', '普段エンジニア演奏家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hiroshitanaka.example.com/\nメールアドレス: hiroshitanaka@example.com\n', '$2a$04$6CW3TBbvY3ja/vT.gfdDS.m0M1BmgxjZRE7Xyunk6BFDEkbqlaqn6');
+INSERT INTO themes (user_id, dark_mode) VALUES (434, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (435, 'yamashitamaaya0', 'もももももも', '普段エンジニア演奏家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yamashitamaaya.example.com/\nメールアドレス: yamashitamaaya@example.com\n', '$2a$04$dSbZPImycO340SYKNxZ/Q.Gwbz9g7hIJ7Cnddjqptv6Zmf6HVgyzC');
+INSERT INTO themes (user_id, dark_mode) VALUES (435, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (436, 'momokoabe0', 'ももももももも', '普段漫画家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://momokoabe.example.com/\nメールアドレス: momokoabe@example.com\n', '$2a$04$aGeuD2L8RycBjr3DqWbZUOs2w90DCowMfOFLpkW8sabWl1s3FmRoK');
+INSERT INTO themes (user_id, dark_mode) VALUES (436, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (437, 'vito0', 'ふわふわふりかけ', '普段配管工をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://vito.example.com/\nメールアドレス: vito@example.com\n', '$2a$04$jlMvCu6723IQhhKTEgwZWeRA0MoVuDp5Jm1TXkr4VYaunzWewwI0G');
+INSERT INTO themes (user_id, dark_mode) VALUES (437, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (438, 'sakamotomikako0', 'さくらんさくらんぼ', '普段行政書士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://sakamotomikako.example.com/\nメールアドレス: sakamotomikako@example.com\n', '$2a$04$P3AbZWSr0kefGE86Jzy.rOK3eTvN1XZA4R5igmI.qgmuj8gvV29J.');
+INSERT INTO themes (user_id, dark_mode) VALUES (438, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (439, 'asaito0', '古道', '普段占い師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://asaito.example.com/\nメールアドレス: asaito@example.com\n', '$2a$04$di0xhcmFLQYhttsk59.KIec.lYk2Pg2IzidWkst1sdYRjFPfIZE26');
+INSERT INTO themes (user_id, dark_mode) VALUES (439, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (440, 'ryosukewatanabe0', 'ごまごまごませんべい', '普段寿司職人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ryosukewatanabe.example.com/\nメールアドレス: ryosukewatanabe@example.com\n', '$2a$04$Kyjwam4jnpx3kgsHO2LI1uNeXehTyAOKx6bLS/P2vfUimriVhpxoi');
+INSERT INTO themes (user_id, dark_mode) VALUES (440, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (441, 'nanami140', 'ぽんかんぽんたろう', '普段栄養士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://nanami14.example.com/\nメールアドレス: nanami14@example.com\n', '$2a$04$cGmoHuamN4w.LvrIqU1mwO8BKvYlZbmxZQFGRmSDCMRAmRCXt1B7.');
+INSERT INTO themes (user_id, dark_mode) VALUES (441, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (442, 'tanakahanako1', 'つぶつぶつぶかんきん', '普段建築家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tanakahanako.example.com/\nメールアドレス: tanakahanako@example.com\n', '$2a$04$YT65ydKjNDzNXvxK2C3NCeR2MNydvke77NqauyRGtknDidvbDng2W');
+INSERT INTO themes (user_id, dark_mode) VALUES (442, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (443, 'rei390', '宵時', '普段バスガイドをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://rei39.example.com/\nメールアドレス: rei39@example.com\n', '$2a$04$VcfyEdo7EZPDyAXcbt/f9.kJH2of1qAn1wbfMsQZ/WBfgN8JH4hLC');
+INSERT INTO themes (user_id, dark_mode) VALUES (443, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (444, 'rito0', 'きらきらきんとん', '普段YouTuberをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://rito.example.com/\nメールアドレス: rito@example.com\n', '$2a$04$ycsZAgjpnNgA671FYbukYeT6LCor5Db/92e3gqRdJM6cYpaHam4K6');
+INSERT INTO themes (user_id, dark_mode) VALUES (444, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (445, 'akemiinoue0', '潮風', '普段演歌歌手をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://akemiinoue.example.com/\nメールアドレス: akemiinoue@example.com\n', '$2a$04$DJwI/7petpqGJCRmDSG5nuQnYchTl20az2rsgxu8Uc7wAImKveQCu');
+INSERT INTO themes (user_id, dark_mode) VALUES (445, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (446, 'jendo0', '天の川せんべい', '普段ゲームクリエイターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://jendo.example.com/\nメールアドレス: jendo@example.com\n', '$2a$04$UwZb6IFm4tgS1pLvujdaV.sK3Gq9zN2IJyIuGjqtMyuSL/dZXfqjK');
+INSERT INTO themes (user_id, dark_mode) VALUES (446, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (447, 'cwatanabe0', 'はなやかわらびもち', '普段大学教授をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://cwatanabe.example.com/\nメールアドレス: cwatanabe@example.com\n', '$2a$04$p.G7Rfg50hqn6oFyNHEFCesVkulf1gIMikz8y8uqA1MGDoswG8R7y');
+INSERT INTO themes (user_id, dark_mode) VALUES (447, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (448, 'rikakobayashi0', '天使の微笑みマシュマロ', '普段アーティストをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://rikakobayashi.example.com/\nメールアドレス: rikakobayashi@example.com\n', '$2a$04$PUrpyrjNpP70jWQyFIS90OhOzVk3PRTdtDSn97v5GlHG5//.Pc8ma');
+INSERT INTO themes (user_id, dark_mode) VALUES (448, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (449, 'kaoriokamoto0', '空色ゼリー', '普段ウェブデザイナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kaoriokamoto.example.com/\nメールアドレス: kaoriokamoto@example.com\n', '$2a$04$/xwDxJw0l7il8nerRB7Ey.zwvtCMT3HP8ha6Sil9l6ZNL8eYuTSd6');
+INSERT INTO themes (user_id, dark_mode) VALUES (449, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (450, 'momoko350', 'つぶつぶつぶかんきんこ', '普段運転士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://momoko35.example.com/\nメールアドレス: momoko35@example.com\n', '$2a$04$Bfs9ObF/qjudHK.wofdamOQpZWHt3yPiU2qsUEDwprjtPcPNYqGqu');
+INSERT INTO themes (user_id, dark_mode) VALUES (450, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (451, 'sayuri130', 'さくさくさくらん', '普段アイドルをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://sayuri13.example.com/\nメールアドレス: sayuri13@example.com\n', '$2a$04$GWjJPRhvDfqpEvp8bp7dW.ug.BHMx9em4WL8BSRPuMXj/ylhr8TmW');
+INSERT INTO themes (user_id, dark_mode) VALUES (451, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (452, 'kaoritanaka0', '夕方', '普段漫画家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kaoritanaka.example.com/\nメールアドレス: kaoritanaka@example.com\n', '$2a$04$Wyg2OV0Eg7eSCnoFqtJLgujNnkduv2.4hrKlyiAANYCN0uIq/Df0O');
+INSERT INTO themes (user_id, dark_mode) VALUES (452, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (453, 'mikakokobayashi0', '雪だるまもち', '普段公認会計士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://mikakokobayashi.example.com/\nメールアドレス: mikakokobayashi@example.com\n', '$2a$04$t4O0rPbs5QVsxI9VFAXoyeWsJ/rHAdpFs4ChmoeOn4WStCBmHc9dC');
+INSERT INTO themes (user_id, dark_mode) VALUES (453, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (454, 'naoto500', 'はるはるはるかん', '普段占い師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://naoto50.example.com/\nメールアドレス: naoto50@example.com\n', '$2a$04$p555Qy6kgTbVo67k29l9VuWeqo1vPANms6He5BGOgn2ypqolyR3Re');
+INSERT INTO themes (user_id, dark_mode) VALUES (454, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (455, 'suzukinaoto0', 'ふくろうのふくれんじ', '普段救急救命士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://suzukinaoto.example.com/\nメールアドレス: suzukinaoto@example.com\n', '$2a$04$0puas75g4hIjKHehCQqrMe1GCX8szA3.I6oCpQ3.tK3kudFHCLcRO');
+INSERT INTO themes (user_id, dark_mode) VALUES (455, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (456, 'myamamoto0', '星夜', '普段和紙職人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://myamamoto.example.com/\nメールアドレス: myamamoto@example.com\n', '$2a$04$Mfrba/tPNPbWeMHJ67dVG.mbMrbkI/J3QQrZpKr7H4.oYt.cWZ1Ui');
+INSERT INTO themes (user_id, dark_mode) VALUES (456, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (457, 'yuta090', '星屑', '普段公務員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yuta09.example.com/\nメールアドレス: yuta09@example.com\n', '$2a$04$7ZWkDLISNMr//oWec9KFceiQBYZ6czBLle3kvgjL9ek5B/FA82wD6');
+INSERT INTO themes (user_id, dark_mode) VALUES (457, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (458, 'asato0', '湯の花', '普段アイドルをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://asato.example.com/\nメールアドレス: asato@example.com\n', '$2a$04$TMA.ea7HW0wmcLE0vd6hQ.PsJ1mZ4niDzhZN1gi7yiWPoPnawgsrm');
+INSERT INTO themes (user_id, dark_mode) VALUES (458, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (459, 'chiyo580', 'うさぎうさぎうさぎもち', '普段バスガイドをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://chiyo58.example.com/\nメールアドレス: chiyo58@example.com\n', '$2a$04$807xAItPSriQOKMQ9iBW5OBfqwuWei.fJIhMJ3nlP9B9MX5yC/yo6');
+INSERT INTO themes (user_id, dark_mode) VALUES (459, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (460, 'lmatsumoto0', 'つぶつぶたね', '普段漫画家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://lmatsumoto.example.com/\nメールアドレス: lmatsumoto@example.com\n', '$2a$04$0PccloP90oBYVZSg1GLWFO0FJ7hRVaK6/NL2dFfEtFjoe8gUYiuN6');
+INSERT INTO themes (user_id, dark_mode) VALUES (460, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (461, 'kaori190', 'さとさとさといも', '普段建築家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kaori19.example.com/\nメールアドレス: kaori19@example.com\n', '$2a$04$q3ocvbPjvd5Sp1MYZwHciOn3yzDEQWVkpxkeoDzywyHlJMQX8CmXq');
+INSERT INTO themes (user_id, dark_mode) VALUES (461, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (462, 'mai860', '桜吹雪', '普段営業をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://mai86.example.com/\nメールアドレス: mai86@example.com\n', '$2a$04$C5kAIMdMDC9Fcap9LTzBK.4NEn8gIGZgPWzelYFLddPbIni7MZETG');
+INSERT INTO themes (user_id, dark_mode) VALUES (462, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (463, 'naokokimura0', 'あんずタルト', '普段モデルをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://naokokimura.example.com/\nメールアドレス: naokokimura@example.com\n', '$2a$04$RbZ/5L2foJkNG2EgXMUblOgZ6xmEdxGTxQjZqyu4lCaqGR9yuCr3y');
+INSERT INTO themes (user_id, dark_mode) VALUES (463, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (464, 'tomoya400', '露草', '普段建築家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tomoya40.example.com/\nメールアドレス: tomoya40@example.com\n', '$2a$04$DpI07.7ql.45WjIfl28LP.8nI005.krxyHLudkIdX0CKmcW4OCh6m');
+INSERT INTO themes (user_id, dark_mode) VALUES (464, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (465, 'dota0', 'みんみんみんと', '普段経営者をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://dota.example.com/\nメールアドレス: dota@example.com\n', '$2a$04$.GkE/OUCwvZ2gheBHs7q4uOf/YMfAj9PNpYn6jCwrOEx16dspw2qa');
+INSERT INTO themes (user_id, dark_mode) VALUES (465, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (466, 'osamu590', 'さくらんさくらかんきんこう', '普段小説家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://osamu59.example.com/\nメールアドレス: osamu59@example.com\n', '$2a$04$3EUNF8B0yBBbGUWMH0jo1.2p.tlXVGi3Q3cdvU8rJuHgmJo4QwOQG');
+INSERT INTO themes (user_id, dark_mode) VALUES (466, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (467, 'takahashitomoya0', '天使の羽あめ', '普段音楽家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://takahashitomoya.example.com/\nメールアドレス: takahashitomoya@example.com\n', '$2a$04$s.JWzrfBPfrBq5gyPL7sv.ldvADiNe2US9iKWtL.qY5qm1zZeAFge');
+INSERT INTO themes (user_id, dark_mode) VALUES (467, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (468, 'shimizuakemi0', '花紫陽花', '普段和紙職人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://shimizuakemi.example.com/\nメールアドレス: shimizuakemi@example.com\n', '$2a$04$WE0FHGKNQ0HAEBZ8Xi9NDe32IIIyjTv9X3yuBQhvbS5U9BrylEMEW');
+INSERT INTO themes (user_id, dark_mode) VALUES (468, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (469, 'endokenichi0', 'しっとりしっくり', '普段グラフィックデザイナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://endokenichi.example.com/\nメールアドレス: endokenichi@example.com\n', '$2a$04$NTzkO97hOWpI1U3mx.odt.LreHylHcR9RjZkBPhSqbnJ.U6jOhI2a');
+INSERT INTO themes (user_id, dark_mode) VALUES (469, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (470, 'yoshidaryohei0', 'ぴよぴよぴー', '普段建築家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yoshidaryohei.example.com/\nメールアドレス: yoshidaryohei@example.com\n', '$2a$04$Z27Z/RwqmDnOXnXFiqbNBOZN.D.GqX0VV/AZQUgUQ7kqfA5MMBn7m');
+INSERT INTO themes (user_id, dark_mode) VALUES (470, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (471, 'ryosukemurakami0', 'さやさやさやえんどう', '普段花火師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ryosukemurakami.example.com/\nメールアドレス: ryosukemurakami@example.com\n', '$2a$04$KMktrTFWS6MIiy9InssireRwhpPrhWbgjy0eQqyoNIKaboUvqJ6.K');
+INSERT INTO themes (user_id, dark_mode) VALUES (471, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (472, 'reitanaka0', 'さくさくさくらもち', '普段音響技術者をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://reitanaka.example.com/\nメールアドレス: reitanaka@example.com\n', '$2a$04$xpe.xozmfHv7Z/2jNRuaee1bD8IXeEi4WDXM4zF76kcJ0UCZK0aLW');
+INSERT INTO themes (user_id, dark_mode) VALUES (472, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (473, 'akemi910', 'すとすとすとり', '普段薬剤師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://akemi91.example.com/\nメールアドレス: akemi91@example.com\n', '$2a$04$5CnWWFdt4IauwyC4BjqTtuDrLiQ7Jkvi1MkaOiojVvQ1vMQER.p.W');
+INSERT INTO themes (user_id, dark_mode) VALUES (473, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (474, 'enakamura0', 'きらきらきんとん', '普段電気工事士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://enakamura.example.com/\nメールアドレス: enakamura@example.com\n', '$2a$04$GO61jM8wsg0iiiPC6KWmZe05mDmajliBl0GtxRLCnK2eq5Mo/.C/O');
+INSERT INTO themes (user_id, dark_mode) VALUES (474, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (475, 'ohayashi0', '古今', '普段裁判官をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ohayashi.example.com/\nメールアドレス: ohayashi@example.com\n', '$2a$04$nBWPPUXpkUpHWWZ7kJYR2OLxhYsiLuAVeDsDCO1WcQZEQ3Q8O9jFC');
+INSERT INTO themes (user_id, dark_mode) VALUES (475, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (476, 'takumasuzuki0', 'きんとききんとかん', '普段大学教授をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://takumasuzuki.example.com/\nメールアドレス: takumasuzuki@example.com\n', '$2a$04$T5oIWCDo9kr3TdZy8Ek1TegBsy3rzF0u/9HrJex1uRdED8xwE8Hhy');
+INSERT INTO themes (user_id, dark_mode) VALUES (476, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (477, 'suzukijun1', 'うさぎうさぎうさぎもち', '普段医師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://suzukijun.example.com/\nメールアドレス: suzukijun@example.com\n', '$2a$04$uyoIa6HiiwtLEadlWJBcLeWrQ02LtC9hBtKy99UWDMr1fekNacxBS');
+INSERT INTO themes (user_id, dark_mode) VALUES (477, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (478, 'bsaito0', 'きらきらきらびやか', '普段グラフィックデザイナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://bsaito.example.com/\nメールアドレス: bsaito@example.com\n', '$2a$04$wNDY/crOYymoNs8G1gyRNO36/YSWS/aL8Mcv.h2nDm9..TFoJ7hCa');
+INSERT INTO themes (user_id, dark_mode) VALUES (478, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (479, 'maifujiwara0', 'ねんねんねんりん', '普段行政書士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://maifujiwara.example.com/\nメールアドレス: maifujiwara@example.com\n', '$2a$04$kajn7WCr5lgnPy73ZudHxOy.XPkhEMTCPwIW.ANUhrqTViKKrWwbm');
+INSERT INTO themes (user_id, dark_mode) VALUES (479, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (480, 'ikedayoko0', '空色ゼリー', '普段花火師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ikedayoko.example.com/\nメールアドレス: ikedayoko@example.com\n', '$2a$04$EhXqaOrq7tGcR61FKb1vxOVNSeLubLpFoRJDXxERMvUQZH5a.tT/q');
+INSERT INTO themes (user_id, dark_mode) VALUES (480, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (481, 'kimurakenichi0', '静寂', '普段調理師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kimurakenichi.example.com/\nメールアドレス: kimurakenichi@example.com\n', '$2a$04$5Lh81d1JW1AeHNueEENUVumXWpa6qhU.QRn0YJ1XFWRWg69Tc4PkG');
+INSERT INTO themes (user_id, dark_mode) VALUES (481, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (482, 'kyosuke210', 'やわらかやわらび', '普段和紙職人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kyosuke21.example.com/\nメールアドレス: kyosuke21@example.com\n', '$2a$04$uj2kC5wJNTrXKzFGTBfdhOKC.DYwJ6tejgVWdkZbzVXp/wY7AHW6m');
+INSERT INTO themes (user_id, dark_mode) VALUES (482, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (483, 'shota870', 'ゆずれいゆずもち', '普段アニメーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://shota87.example.com/\nメールアドレス: shota87@example.com\n', '$2a$04$mOWpE7fPGGnKrMDLz0o71u6FPNfIu/60SvKe9CNTKAAQ4cGd48a1m');
+INSERT INTO themes (user_id, dark_mode) VALUES (483, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (484, 'fyamaguchi0', '夕暮れ', '普段アナウンサーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://fyamaguchi.example.com/\nメールアドレス: fyamaguchi@example.com\n', '$2a$04$evS0vE3fQ4gtple5.lY6BOatdNX6jKG6eQlpbRtYQDNBQxbVRoiFW');
+INSERT INTO themes (user_id, dark_mode) VALUES (484, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (485, 'miki730', 'うさぎもち', '普段調理師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://miki73.example.com/\nメールアドレス: miki73@example.com\n', '$2a$04$4g7GzmasnxaXMkyaniG3AuuyP/TS0sq1x5oTi9KphY.59juebN51W');
+INSERT INTO themes (user_id, dark_mode) VALUES (485, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (486, 'sayurihashimoto0', 'うさぎうさぎうさぎもち', '普段測量士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://sayurihashimoto.example.com/\nメールアドレス: sayurihashimoto@example.com\n', '$2a$04$q20IW8IrwmTn9BWDq349ROYis2Tr3iQ3dvagCKd21VSKSlB1bLNla');
+INSERT INTO themes (user_id, dark_mode) VALUES (486, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (487, 'naoki460', 'しろくまようかん', '普段小説家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://naoki46.example.com/\nメールアドレス: naoki46@example.com\n', '$2a$04$W1M2RwbskgN4Xcj3SfoNreWw4p6TbM7cbhQHE5vxbDxQDEefwUxny');
+INSERT INTO themes (user_id, dark_mode) VALUES (487, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (488, 'satoyumiko0', '夜明け', '普段気象予報士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://satoyumiko.example.com/\nメールアドレス: satoyumiko@example.com\n', '$2a$04$15IR25zhI4AmeMXNpzRC9eXFostWbJzQ0AkT7qD4.E5VvgwVIeKJq');
+INSERT INTO themes (user_id, dark_mode) VALUES (488, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (489, 'minoruhashimoto0', 'おひさまおりほん', '普段栄養士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://minoruhashimoto.example.com/\nメールアドレス: minoruhashimoto@example.com\n', '$2a$04$34kAlIoUPVRvakcqe/xy8.YXw0R3Xq6c91qsch0rfR/MfiBTNkaTi');
+INSERT INTO themes (user_id, dark_mode) VALUES (489, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (490, 'nanamimurakami0', '風光明媚', '普段モデルをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://nanamimurakami.example.com/\nメールアドレス: nanamimurakami@example.com\n', '$2a$04$lAp9YLJjs/1DljHgTubJBuL5r/.2NZDMnTylFpYBsdYeaznUcDkJ.');
+INSERT INTO themes (user_id, dark_mode) VALUES (490, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (491, 'mai600', '麦わら帽子', '普段コピーライターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://mai60.example.com/\nメールアドレス: mai60@example.com\n', '$2a$04$ywZmBv6LQYbWGpA3yxaMcOIEQn1G.czkAd5uEK7fTeq3lfoaMoVLW');
+INSERT INTO themes (user_id, dark_mode) VALUES (491, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (492, 'taro340', 'さとさとさとうきび', '普段音楽家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://taro34.example.com/\nメールアドレス: taro34@example.com\n', '$2a$04$w5.MtTa6RDKEbD9j7XnUnOAYBcpug2XAVrjLgFg3wrlRHppbO52Gy');
+INSERT INTO themes (user_id, dark_mode) VALUES (492, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (493, 'yosuke950', 'ちゅるちゅるちゅーび', '普段医師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yosuke95.example.com/\nメールアドレス: yosuke95@example.com\n', '$2a$04$0z1ni/zkE6a9Sfsaq3vb6O.Xma1va8H3h5/IfFVBH0MxrUI.OZRNm');
+INSERT INTO themes (user_id, dark_mode) VALUES (493, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (494, 'rokada0', '山桜', '普段農家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://rokada.example.com/\nメールアドレス: rokada@example.com\n', '$2a$04$f1BJVM5MCc6MpOOEbCtJGOI8xY3Alas0Ov8fVNuYej8b1idv59cCW');
+INSERT INTO themes (user_id, dark_mode) VALUES (494, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (495, 'nanami710', 'ときめきトフィー', '普段医療事務員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://nanami71.example.com/\nメールアドレス: nanami71@example.com\n', '$2a$04$JNkWqunO87FabYimlZkUqOyC02iDIbfQB35m.PKW7N/x2U8uqCEEW');
+INSERT INTO themes (user_id, dark_mode) VALUES (495, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (496, 'zishikawa0', 'ふわふわふわりん', '普段経営者をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://zishikawa.example.com/\nメールアドレス: zishikawa@example.com\n', '$2a$04$cyjbSBfNcFM.VG2ovtnqg.xlmhsOaEy.yygmJ7hlNIM2STJ4Wgh5.');
+INSERT INTO themes (user_id, dark_mode) VALUES (496, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (497, 'yutawatanabe0', 'うめうめうめね', '普段救急救命士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yutawatanabe.example.com/\nメールアドレス: yutawatanabe@example.com\n', '$2a$04$qziCjx2Kh1JpW/bYzjKFc.v7jrvki3LJjpAxZqnlRJN.qn.NBT492');
+INSERT INTO themes (user_id, dark_mode) VALUES (497, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (498, 'suzukiosamu0', 'あんこあんこか', '普段配管工をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://suzukiosamu.example.com/\nメールアドレス: suzukiosamu@example.com\n', '$2a$04$6/rZQ./QnEYIa/XCChrIlOtQ.62Z0ZSRmWR27AmuY43XKPXcn8UmW');
+INSERT INTO themes (user_id, dark_mode) VALUES (498, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (499, 'mituru130', '木の葉もち', '普段医師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://mituru13.example.com/\nメールアドレス: mituru13@example.com\n', '$2a$04$4R3oaiwgL3jFDx4XkSPgWeK.uk35cdQm.wKhYVFSFMREEtEUtuPZu');
+INSERT INTO themes (user_id, dark_mode) VALUES (499, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (500, 'taichi000', 'ほんほんほんわり', '普段医療事務員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://taichi00.example.com/\nメールアドレス: taichi00@example.com\n', '$2a$04$/v16fIbxYBiHvtEmtjgydeJ/fUI2H0OhCgNdTReh5WZUtHYvubDDi');
+INSERT INTO themes (user_id, dark_mode) VALUES (500, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (501, 'chiyoyamamoto0', '花筏', '普段歯科医師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://chiyoyamamoto.example.com/\nメールアドレス: chiyoyamamoto@example.com\n', '$2a$04$0BRufcJy.4FPaIDjWxsKz.5JBQQMoGZzVniBFU0s7jaC.8pHSiuaK');
+INSERT INTO themes (user_id, dark_mode) VALUES (501, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (502, 'yoshidakyosuke0', 'しっしっしっぽり', '普段映画監督をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yoshidakyosuke.example.com/\nメールアドレス: yoshidakyosuke@example.com\n', '$2a$04$GvL4Wts8RiOULOE9qAYZWeX6pqOaW9F3MoWrqiAtMt3K.tepN85Xq');
+INSERT INTO themes (user_id, dark_mode) VALUES (502, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (503, 'vyamazaki0', 'きんきんきんつば', '普段作曲家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://vyamazaki.example.com/\nメールアドレス: vyamazaki@example.com\n', '$2a$04$OHdW6thFVfbi6tPhy5lXGeiFAShOFDz0xFVG2iSjM0yZ.jnAa0Uqq');
+INSERT INTO themes (user_id, dark_mode) VALUES (503, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (504, 'wwatanabe0', 'たんぽぽだんご', '普段音響技術者をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://wwatanabe.example.com/\nメールアドレス: wwatanabe@example.com\n', '$2a$04$0PulOoA5M9qCiCU4oL0O1OqnrGD./.dGLQPwuTf9SfioljG1ImG7.');
+INSERT INTO themes (user_id, dark_mode) VALUES (504, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (505, 'ykimura0', '梅しぐれ', '普段医療事務員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ykimura.example.com/\nメールアドレス: ykimura@example.com\n', '$2a$04$lBKwG4y37PBniflzaf/iY.9KYWo2ZBd6JSfvgT9UG0XA80uSShAJW');
+INSERT INTO themes (user_id, dark_mode) VALUES (505, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (506, 'tanakahideki1', 'うめうめうめき', '普段モデルをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tanakahideki.example.com/\nメールアドレス: tanakahideki@example.com\n', '$2a$04$pEqzGw.XCz0voYRH3QMJTO59V1WsTacBbGb/K2UETzzAEqaz6xU96');
+INSERT INTO themes (user_id, dark_mode) VALUES (506, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (507, 'minoruito0', '冬景', '普段栄養士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://minoruito.example.com/\nメールアドレス: minoruito@example.com\n', '$2a$04$j./lZgNj/fSYZqg7O7BDue3T7Ijeun6VVUizLRGFMheTUMIqcYK2y');
+INSERT INTO themes (user_id, dark_mode) VALUES (507, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (508, 'shotamatsumoto0', 'はるはるはるかんきんこ', '普段エンジニア演奏家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://shotamatsumoto.example.com/\nメールアドレス: shotamatsumoto@example.com\n', '$2a$04$sLLGF.m9gbfbm5pTeUjpvuW89BW.yNEylciEbeGzsacMmbCN1DnSC');
+INSERT INTO themes (user_id, dark_mode) VALUES (508, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (509, 'watanabehiroshi0', 'しいたけしいたけもち', '普段エンジニア演奏家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://watanabehiroshi.example.com/\nメールアドレス: watanabehiroshi@example.com\n', '$2a$04$uu1XhQxhkzIJsJ7Tzw8qfuH/wivo4hkEYkFKOP0xKulBk2nxZPEqS');
+INSERT INTO themes (user_id, dark_mode) VALUES (509, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (510, 'taichi560', '美和', '普段アニメーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://taichi56.example.com/\nメールアドレス: taichi56@example.com\n', '$2a$04$qUPj6ngqW37MzPM4LsJ7QOBsEW7qF0fUVyE4T3QJYH4AKVozbNC9q');
+INSERT INTO themes (user_id, dark_mode) VALUES (510, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (511, 'nanami090', '梅雷', '普段和紙職人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://nanami09.example.com/\nメールアドレス: nanami09@example.com\n', '$2a$04$ah/ZrA65mvjSG9M2zRSUcegW1d1d.lzyMkKl0leUmnkmTEBGogPp.');
+INSERT INTO themes (user_id, dark_mode) VALUES (511, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (512, 'akira800', 'きんきんきんせん', '普段小説家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://akira80.example.com/\nメールアドレス: akira80@example.com\n', '$2a$04$sdAySrC/p9LWk5J.r5LM.udKeDM02c0eSXn3GFCrJ0uMUOmDlQDy2');
+INSERT INTO themes (user_id, dark_mode) VALUES (512, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (513, 'suzukiyasuhiro0', 'お月さまもち', '普段バスガイドをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://suzukiyasuhiro.example.com/\nメールアドレス: suzukiyasuhiro@example.com\n', '$2a$04$uHf5EFSsbhKGqYandZv/Z.kwJ.UHjKBL5qD9hr8us.PEoIZTxNQrq');
+INSERT INTO themes (user_id, dark_mode) VALUES (513, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (514, 'momokofukuda0', 'ねこのぱふぱふ', '普段大学教授をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://momokofukuda.example.com/\nメールアドレス: momokofukuda@example.com\n', '$2a$04$2HuLJJa1611votr5CHKX/OS3xK1fAREGgbmLKSWo4Ewf5bzDGvCwi');
+INSERT INTO themes (user_id, dark_mode) VALUES (514, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (515, 'kana350', 'おおきなおはな', '普段行政書士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kana35.example.com/\nメールアドレス: kana35@example.com\n', '$2a$04$LTNRQBExFHY8lQCn44lo/ehB/NRpBIpddcfgIVLGGUYtvJmzJ3/9O');
+INSERT INTO themes (user_id, dark_mode) VALUES (515, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (516, 'tanakanaoto0', '夕暮れ', '普段コピーライターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tanakanaoto.example.com/\nメールアドレス: tanakanaoto@example.com\n', '$2a$04$vJVvUq12hZbehFmPzP.c8OnRsuSErg/5.1dLO7hq3pPFlvIQgaobu');
+INSERT INTO themes (user_id, dark_mode) VALUES (516, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (517, 'akemi800', '潮風', '普段公認会計士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://akemi80.example.com/\nメールアドレス: akemi80@example.com\n', '$2a$04$zoGEpfPL0Ypk8OengyTkvuOdM0QVy30SWsY1C7y1aBvWBICJYu51y');
+INSERT INTO themes (user_id, dark_mode) VALUES (517, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (518, 'tanakakenichi0', '霧の朝もち', '普段YouTuberをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tanakakenichi.example.com/\nメールアドレス: tanakakenichi@example.com\n', '$2a$04$NFEv49zphsEdwCNLi6QYz.FbSSEyNqGuIsJ9hNj.nbXE5Z624plBm');
+INSERT INTO themes (user_id, dark_mode) VALUES (518, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (519, 'oyoshida0', 'さくらさくらんぼ', '普段YouTuberをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://oyoshida.example.com/\nメールアドレス: oyoshida@example.com\n', '$2a$04$mAbZO/nJE.f8CjUSmm/IE.hqbx6XvnWlJmbNNi0ikU4p4mRcSDfuy');
+INSERT INTO themes (user_id, dark_mode) VALUES (519, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (520, 'yutamatsumoto0', '夏木立', '普段エステティシャンをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yutamatsumoto.example.com/\nメールアドレス: yutamatsumoto@example.com\n', '$2a$04$aOtXqzAbj22O492SA6pp4euu5zHmRfxxNoG.Yp4qQf9O2NiKpmVJu');
+INSERT INTO themes (user_id, dark_mode) VALUES (520, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (521, 'hiroshisuzuki1', 'たんぽぽたね', '普段寿司職人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hiroshisuzuki.example.com/\nメールアドレス: hiroshisuzuki@example.com\n', '$2a$04$/0I5Z3FbgSF8C7k8qSfp3.ytDI6zVKZQ0u1qb4OeREpHxQzl6CTFW');
+INSERT INTO themes (user_id, dark_mode) VALUES (521, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (522, 'yamaguchikazuya0', 'まみまみまみれもち', '普段農家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yamaguchikazuya.example.com/\nメールアドレス: yamaguchikazuya@example.com\n', '$2a$04$b9YqfFmp0r1WI.OGkygre./9HgRDPIFojEZZerozLRkDmQI1Fgz9a');
+INSERT INTO themes (user_id, dark_mode) VALUES (522, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (523, 'yoko800', 'きもちきもちもち', '普段エンジニア演奏家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yoko80.example.com/\nメールアドレス: yoko80@example.com\n', '$2a$04$kdb7klvhAf6bAf.prygvoungTlvAxg6KZkBOYyo4W6Zi3sU3I495m');
+INSERT INTO themes (user_id, dark_mode) VALUES (523, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (524, 'lnakamura0', 'ほのかほうじ茶', '普段脚本家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://lnakamura.example.com/\nメールアドレス: lnakamura@example.com\n', '$2a$04$AXKaWtL0qEK/8xW.80xPMey9ariOJJgDcAxIsh9/aZ0fp7NSIC8cq');
+INSERT INTO themes (user_id, dark_mode) VALUES (524, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (525, 'esato1', '夕刻', '普段測量士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://esato.example.com/\nメールアドレス: esato@example.com\n', '$2a$04$rJ7mfRo9Aw9VW5mcgkH/se52xwvhBDUVDZEKjsrpxkUsp5ITv2zOS');
+INSERT INTO themes (user_id, dark_mode) VALUES (525, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (526, 'atsushitakahashi1', 'うめうめうめち', '普段お笑い芸人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://atsushitakahashi.example.com/\nメールアドレス: atsushitakahashi@example.com\n', '$2a$04$xPHuVYa.1gExp.Xh3kUESOhgaBBHfbTwutEYI8rrOzwOIWM.xLHg.');
+INSERT INTO themes (user_id, dark_mode) VALUES (526, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (527, 'eshimizu0', '月夜花', '普段作曲家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://eshimizu.example.com/\nメールアドレス: eshimizu@example.com\n', '$2a$04$se4XG.MHrkFFuSXB/aSX1uNxqY0LdYnetRSqOvTrrgFtuB9UP84TK');
+INSERT INTO themes (user_id, dark_mode) VALUES (527, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (528, 'reitanaka1', 'きんきんきんもち', '普段営業をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://reitanaka.example.com/\nメールアドレス: reitanaka@example.com\n', '$2a$04$As9ZsAB/qUV3RHXTPW0LDOecwR.GqPIhbBHyXmgTHxVOE3xE/8G8i');
+INSERT INTO themes (user_id, dark_mode) VALUES (528, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (529, 'yuki500', '空に浮かぶ雲マシュマロ', '普段公認会計士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yuki50.example.com/\nメールアドレス: yuki50@example.com\n', '$2a$04$a2FyUeWJPpgE//4QaPNUSePy8ie9/KM46MavQwzvTx32r05ZE2vPC');
+INSERT INTO themes (user_id, dark_mode) VALUES (529, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (530, 'tanakashohei2', 'こまこまこまぶた', '普段歯科医師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tanakashohei.example.com/\nメールアドレス: tanakashohei@example.com\n', '$2a$04$1eF6i15wMQvGByE78X53Su7GiDOGKGBGtLuESNq.QS5YwXsICaIx6');
+INSERT INTO themes (user_id, dark_mode) VALUES (530, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (531, 'akiranishimura0', '美和', '普段演歌歌手をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://akiranishimura.example.com/\nメールアドレス: akiranishimura@example.com\n', '$2a$04$dAHk5cZpQrHAyj1mw5.HW.W15pHZm5nwY0uPmN/4hknber.FNS.m.');
+INSERT INTO themes (user_id, dark_mode) VALUES (531, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (532, 'naokiyamamoto0', '灯り', '普段配管工をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://naokiyamamoto.example.com/\nメールアドレス: naokiyamamoto@example.com\n', '$2a$04$nvAPnhFN3DbDGykNeY9u6e7uZx/RH8ID7JhwXIjNImjsPzZeTJQXu');
+INSERT INTO themes (user_id, dark_mode) VALUES (532, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (533, 'shimizuatsushi0', '煌めき', '普段アニメーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://shimizuatsushi.example.com/\nメールアドレス: shimizuatsushi@example.com\n', '$2a$04$te4Lr4UAR1nJRlW.n17STuSR3HDjx1wCvnEKyQso4x/tlpWtmOtaa');
+INSERT INTO themes (user_id, dark_mode) VALUES (533, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (534, 'winoue0', '朝顔', '普段ウェディングプランナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://winoue.example.com/\nメールアドレス: winoue@example.com\n', '$2a$04$6CW3TBbvY3ja/vT.gfdDS.m0M1BmgxjZRE7Xyunk6BFDEkbqlaqn6');
+INSERT INTO themes (user_id, dark_mode) VALUES (534, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (535, 'hiroshifujita0', 'かわいらぎく', '普段ウェディングプランナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hiroshifujita.example.com/\nメールアドレス: hiroshifujita@example.com\n', '$2a$04$dSbZPImycO340SYKNxZ/Q.Gwbz9g7hIJ7Cnddjqptv6Zmf6HVgyzC');
+INSERT INTO themes (user_id, dark_mode) VALUES (535, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (536, 'mikako380', 'そらそらそらまめ', '普段映画監督をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://mikako38.example.com/\nメールアドレス: mikako38@example.com\n', '$2a$04$aGeuD2L8RycBjr3DqWbZUOs2w90DCowMfOFLpkW8sabWl1s3FmRoK');
+INSERT INTO themes (user_id, dark_mode) VALUES (536, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (537, 'gotoshohei0', 'くるみくるみん', '普段作曲家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://gotoshohei.example.com/\nメールアドレス: gotoshohei@example.com\n', '$2a$04$jlMvCu6723IQhhKTEgwZWeRA0MoVuDp5Jm1TXkr4VYaunzWewwI0G');
+INSERT INTO themes (user_id, dark_mode) VALUES (537, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (538, 'yumikoyamamoto0', '花粉', '普段配管工をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yumikoyamamoto.example.com/\nメールアドレス: yumikoyamamoto@example.com\n', '$2a$04$P3AbZWSr0kefGE86Jzy.rOK3eTvN1XZA4R5igmI.qgmuj8gvV29J.');
+INSERT INTO themes (user_id, dark_mode) VALUES (538, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (539, 'gmatsuda0', 'もちもちみるく', '普段薬剤師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://gmatsuda.example.com/\nメールアドレス: gmatsuda@example.com\n', '$2a$04$di0xhcmFLQYhttsk59.KIec.lYk2Pg2IzidWkst1sdYRjFPfIZE26');
+INSERT INTO themes (user_id, dark_mode) VALUES (539, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (540, 'kumikokobayashi1', '明治維新', '普段YouTuberをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kumikokobayashi.example.com/\nメールアドレス: kumikokobayashi@example.com\n', '$2a$04$Kyjwam4jnpx3kgsHO2LI1uNeXehTyAOKx6bLS/P2vfUimriVhpxoi');
+INSERT INTO themes (user_id, dark_mode) VALUES (540, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (541, 'tsubasatakahashi0', 'きらきらきらりん', '普段イラストレーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tsubasatakahashi.example.com/\nメールアドレス: tsubasatakahashi@example.com\n', '$2a$04$cGmoHuamN4w.LvrIqU1mwO8BKvYlZbmxZQFGRmSDCMRAmRCXt1B7.');
+INSERT INTO themes (user_id, dark_mode) VALUES (541, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (542, 'yyamamoto0', 'いちごいちごかん', '普段測量士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yyamamoto.example.com/\nメールアドレス: yyamamoto@example.com\n', '$2a$04$YT65ydKjNDzNXvxK2C3NCeR2MNydvke77NqauyRGtknDidvbDng2W');
+INSERT INTO themes (user_id, dark_mode) VALUES (542, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (543, 'nakamurajun0', 'もりもりもりもち', '普段調理師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://nakamurajun.example.com/\nメールアドレス: nakamurajun@example.com\n', '$2a$04$VcfyEdo7EZPDyAXcbt/f9.kJH2of1qAn1wbfMsQZ/WBfgN8JH4hLC');
+INSERT INTO themes (user_id, dark_mode) VALUES (543, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (544, 'yasuhirokato0', '青草', '普段行政書士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yasuhirokato.example.com/\nメールアドレス: yasuhirokato@example.com\n', '$2a$04$ycsZAgjpnNgA671FYbukYeT6LCor5Db/92e3gqRdJM6cYpaHam4K6');
+INSERT INTO themes (user_id, dark_mode) VALUES (544, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (545, 'vsuzuki1', '藤花', '普段イラストレーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://vsuzuki.example.com/\nメールアドレス: vsuzuki@example.com\n', '$2a$04$DJwI/7petpqGJCRmDSG5nuQnYchTl20az2rsgxu8Uc7wAImKveQCu');
+INSERT INTO themes (user_id, dark_mode) VALUES (545, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (546, 'kobayashihideki0', 'さつまさつまもち', '普段営業をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kobayashihideki.example.com/\nメールアドレス: kobayashihideki@example.com\n', '$2a$04$UwZb6IFm4tgS1pLvujdaV.sK3Gq9zN2IJyIuGjqtMyuSL/dZXfqjK');
+INSERT INTO themes (user_id, dark_mode) VALUES (546, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (547, 'murakamiosamu0', '癒し', '普段測量士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://murakamiosamu.example.com/\nメールアドレス: murakamiosamu@example.com\n', '$2a$04$p.G7Rfg50hqn6oFyNHEFCesVkulf1gIMikz8y8uqA1MGDoswG8R7y');
+INSERT INTO themes (user_id, dark_mode) VALUES (547, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (548, 'mikako340', '麦わら帽子', '普段公認会計士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://mikako34.example.com/\nメールアドレス: mikako34@example.com\n', '$2a$04$PUrpyrjNpP70jWQyFIS90OhOzVk3PRTdtDSn97v5GlHG5//.Pc8ma');
+INSERT INTO themes (user_id, dark_mode) VALUES (548, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (549, 'takumaokada0', 'ぱりぱりぱいす', '普段高等学校教員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://takumaokada.example.com/\nメールアドレス: takumaokada@example.com\n', '$2a$04$/xwDxJw0l7il8nerRB7Ey.zwvtCMT3HP8ha6Sil9l6ZNL8eYuTSd6');
+INSERT INTO themes (user_id, dark_mode) VALUES (549, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (550, 'osamusato0', '群青', '普段絵本作家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://osamusato.example.com/\nメールアドレス: osamusato@example.com\n', '$2a$04$Bfs9ObF/qjudHK.wofdamOQpZWHt3yPiU2qsUEDwprjtPcPNYqGqu');
+INSERT INTO themes (user_id, dark_mode) VALUES (550, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (551, 'aogawa0', 'はなはなはんなり', '普段調理師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://aogawa.example.com/\nメールアドレス: aogawa@example.com\n', '$2a$04$GWjJPRhvDfqpEvp8bp7dW.ug.BHMx9em4WL8BSRPuMXj/ylhr8TmW');
+INSERT INTO themes (user_id, dark_mode) VALUES (551, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (552, 'ikedayosuke0', 'うりうりういろう', '普段絵本作家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ikedayosuke.example.com/\nメールアドレス: ikedayosuke@example.com\n', '$2a$04$Wyg2OV0Eg7eSCnoFqtJLgujNnkduv2.4hrKlyiAANYCN0uIq/Df0O');
+INSERT INTO themes (user_id, dark_mode) VALUES (552, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (553, 'sakamotoyoichi0', '大輪', '普段建築家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://sakamotoyoichi.example.com/\nメールアドレス: sakamotoyoichi@example.com\n', '$2a$04$t4O0rPbs5QVsxI9VFAXoyeWsJ/rHAdpFs4ChmoeOn4WStCBmHc9dC');
+INSERT INTO themes (user_id, dark_mode) VALUES (553, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (554, 'yamadakaori1', 'まりまりまりもち', '普段バスガイドをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yamadakaori.example.com/\nメールアドレス: yamadakaori@example.com\n', '$2a$04$p555Qy6kgTbVo67k29l9VuWeqo1vPANms6He5BGOgn2ypqolyR3Re');
+INSERT INTO themes (user_id, dark_mode) VALUES (554, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (555, 'ykobayashi1', 'ひまわりほうじ茶', '普段小説家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ykobayashi.example.com/\nメールアドレス: ykobayashi@example.com\n', '$2a$04$0puas75g4hIjKHehCQqrMe1GCX8szA3.I6oCpQ3.tK3kudFHCLcRO');
+INSERT INTO themes (user_id, dark_mode) VALUES (555, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (556, 'fukudananami0', 'うめうめうめたん', '普段歯科医師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://fukudananami.example.com/\nメールアドレス: fukudananami@example.com\n', '$2a$04$Mfrba/tPNPbWeMHJ67dVG.mbMrbkI/J3QQrZpKr7H4.oYt.cWZ1Ui');
+INSERT INTO themes (user_id, dark_mode) VALUES (556, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (557, 'yasuhirohashimoto0', 'みずみずしいみずもち', '普段歯科医師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yasuhirohashimoto.example.com/\nメールアドレス: yasuhirohashimoto@example.com\n', '$2a$04$7ZWkDLISNMr//oWec9KFceiQBYZ6czBLle3kvgjL9ek5B/FA82wD6');
+INSERT INTO themes (user_id, dark_mode) VALUES (557, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (558, 'akemi670', '夕べ', '普段調理師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://akemi67.example.com/\nメールアドレス: akemi67@example.com\n', '$2a$04$TMA.ea7HW0wmcLE0vd6hQ.PsJ1mZ4niDzhZN1gi7yiWPoPnawgsrm');
+INSERT INTO themes (user_id, dark_mode) VALUES (558, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (559, 'rhasegawa0', 'こももこもち', '普段栄養士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://rhasegawa.example.com/\nメールアドレス: rhasegawa@example.com\n', '$2a$04$807xAItPSriQOKMQ9iBW5OBfqwuWei.fJIhMJ3nlP9B9MX5yC/yo6');
+INSERT INTO themes (user_id, dark_mode) VALUES (559, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (560, 'naoto740', 'ながながながいも', '普段経営者をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://naoto74.example.com/\nメールアドレス: naoto74@example.com\n', '$2a$04$0PccloP90oBYVZSg1GLWFO0FJ7hRVaK6/NL2dFfEtFjoe8gUYiuN6');
+INSERT INTO themes (user_id, dark_mode) VALUES (560, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (561, 'satoasuka0', 'こはくのこりんとう', '普段モデルをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://satoasuka.example.com/\nメールアドレス: satoasuka@example.com\n', '$2a$04$q3ocvbPjvd5Sp1MYZwHciOn3yzDEQWVkpxkeoDzywyHlJMQX8CmXq');
+INSERT INTO themes (user_id, dark_mode) VALUES (561, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (562, 'lyamashita0', '蛍光', '普段小説家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://lyamashita.example.com/\nメールアドレス: lyamashita@example.com\n', '$2a$04$C5kAIMdMDC9Fcap9LTzBK.4NEn8gIGZgPWzelYFLddPbIni7MZETG');
+INSERT INTO themes (user_id, dark_mode) VALUES (562, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (563, 'kumiko030', 'もちもちもちあずき', '普段アイドルをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kumiko03.example.com/\nメールアドレス: kumiko03@example.com\n', '$2a$04$RbZ/5L2foJkNG2EgXMUblOgZ6xmEdxGTxQjZqyu4lCaqGR9yuCr3y');
+INSERT INTO themes (user_id, dark_mode) VALUES (563, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (564, 'qikeda0', 'お月さまもち', '普段ウェディングプランナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://qikeda.example.com/\nメールアドレス: qikeda@example.com\n', '$2a$04$DpI07.7ql.45WjIfl28LP.8nI005.krxyHLudkIdX0CKmcW4OCh6m');
+INSERT INTO themes (user_id, dark_mode) VALUES (564, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (565, 'ptanaka0', 'つつじつつじつぶ', '普段公認会計士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ptanaka.example.com/\nメールアドレス: ptanaka@example.com\n', '$2a$04$.GkE/OUCwvZ2gheBHs7q4uOf/YMfAj9PNpYn6jCwrOEx16dspw2qa');
+INSERT INTO themes (user_id, dark_mode) VALUES (565, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (566, 'fmatsuda0', '天の川せんべい', '普段バスガイドをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://fmatsuda.example.com/\nメールアドレス: fmatsuda@example.com\n', '$2a$04$3EUNF8B0yBBbGUWMH0jo1.2p.tlXVGi3Q3cdvU8rJuHgmJo4QwOQG');
+INSERT INTO themes (user_id, dark_mode) VALUES (566, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (567, 'akiramaeda0', '暁の光水あめ', '普段音楽家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://akiramaeda.example.com/\nメールアドレス: akiramaeda@example.com\n', '$2a$04$s.JWzrfBPfrBq5gyPL7sv.ldvADiNe2US9iKWtL.qY5qm1zZeAFge');
+INSERT INTO themes (user_id, dark_mode) VALUES (567, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (568, 'yoichiyamamoto0', 'はなはなはなびら', '普段医療事務員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yoichiyamamoto.example.com/\nメールアドレス: yoichiyamamoto@example.com\n', '$2a$04$WE0FHGKNQ0HAEBZ8Xi9NDe32IIIyjTv9X3yuBQhvbS5U9BrylEMEW');
+INSERT INTO themes (user_id, dark_mode) VALUES (568, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (569, 'gsuzuki0', 'ふんわりふんわかんきんこう', '普段イラストレーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://gsuzuki.example.com/\nメールアドレス: gsuzuki@example.com\n', '$2a$04$NTzkO97hOWpI1U3mx.odt.LreHylHcR9RjZkBPhSqbnJ.U6jOhI2a');
+INSERT INTO themes (user_id, dark_mode) VALUES (569, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (570, 'naokiikeda1', 'こいのぼりこがねもち', '普段イラストレーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://naokiikeda.example.com/\nメールアドレス: naokiikeda@example.com\n', '$2a$04$Z27Z/RwqmDnOXnXFiqbNBOZN.D.GqX0VV/AZQUgUQ7kqfA5MMBn7m');
+INSERT INTO themes (user_id, dark_mode) VALUES (570, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (571, 'abekenichi0', '蓮の露', '普段ウェディングプランナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://abekenichi.example.com/\nメールアドレス: abekenichi@example.com\n', '$2a$04$KMktrTFWS6MIiy9InssireRwhpPrhWbgjy0eQqyoNIKaboUvqJ6.K');
+INSERT INTO themes (user_id, dark_mode) VALUES (571, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (572, 'maiyamamoto0', '陽射し', '普段測量士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://maiyamamoto.example.com/\nメールアドレス: maiyamamoto@example.com\n', '$2a$04$xpe.xozmfHv7Z/2jNRuaee1bD8IXeEi4WDXM4zF76kcJ0UCZK0aLW');
+INSERT INTO themes (user_id, dark_mode) VALUES (572, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (573, 'shimizukumiko0', 'つぶつぶつくし', '普段営業をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://shimizukumiko.example.com/\nメールアドレス: shimizukumiko@example.com\n', '$2a$04$5CnWWFdt4IauwyC4BjqTtuDrLiQ7Jkvi1MkaOiojVvQ1vMQER.p.W');
+INSERT INTO themes (user_id, dark_mode) VALUES (573, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (574, 'zsato0', 'ちょっぴりちょこれーと', '普段エステティシャンをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://zsato.example.com/\nメールアドレス: zsato@example.com\n', '$2a$04$GO61jM8wsg0iiiPC6KWmZe05mDmajliBl0GtxRLCnK2eq5Mo/.C/O');
+INSERT INTO themes (user_id, dark_mode) VALUES (574, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (575, 'manabu050', 'ふくふくふわりん', '普段音響技術者をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://manabu05.example.com/\nメールアドレス: manabu05@example.com\n', '$2a$04$nBWPPUXpkUpHWWZ7kJYR2OLxhYsiLuAVeDsDCO1WcQZEQ3Q8O9jFC');
+INSERT INTO themes (user_id, dark_mode) VALUES (575, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (576, 'shoheinishimura0', 'まつりまつりもち', '普段映画監督をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://shoheinishimura.example.com/\nメールアドレス: shoheinishimura@example.com\n', '$2a$04$T5oIWCDo9kr3TdZy8Ek1TegBsy3rzF0u/9HrJex1uRdED8xwE8Hhy');
+INSERT INTO themes (user_id, dark_mode) VALUES (576, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (577, 'gyoshida0', '曙光', '普段裁判官をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://gyoshida.example.com/\nメールアドレス: gyoshida@example.com\n', '$2a$04$uyoIa6HiiwtLEadlWJBcLeWrQ02LtC9hBtKy99UWDMr1fekNacxBS');
+INSERT INTO themes (user_id, dark_mode) VALUES (577, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (578, 'chiyo790', 'ぽんかんぽんたろう', '普段救急救命士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://chiyo79.example.com/\nメールアドレス: chiyo79@example.com\n', '$2a$04$wNDY/crOYymoNs8G1gyRNO36/YSWS/aL8Mcv.h2nDm9..TFoJ7hCa');
+INSERT INTO themes (user_id, dark_mode) VALUES (578, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (579, 'hanakookada0', 'たいたいたいやき', '普段検察官をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hanakookada.example.com/\nメールアドレス: hanakookada@example.com\n', '$2a$04$kajn7WCr5lgnPy73ZudHxOy.XPkhEMTCPwIW.ANUhrqTViKKrWwbm');
+INSERT INTO themes (user_id, dark_mode) VALUES (579, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (580, 'xota0', '青草', '普段医療事務員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://xota.example.com/\nメールアドレス: xota@example.com\n', '$2a$04$EhXqaOrq7tGcR61FKb1vxOVNSeLubLpFoRJDXxERMvUQZH5a.tT/q');
+INSERT INTO themes (user_id, dark_mode) VALUES (580, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (581, 'manabusakamoto0', '風情', '普段エンジニア演奏家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://manabusakamoto.example.com/\nメールアドレス: manabusakamoto@example.com\n', '$2a$04$5Lh81d1JW1AeHNueEENUVumXWpa6qhU.QRn0YJ1XFWRWg69Tc4PkG');
+INSERT INTO themes (user_id, dark_mode) VALUES (581, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (582, 'jsato0', '霧の朝もち', '普段占い師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://jsato.example.com/\nメールアドレス: jsato@example.com\n', '$2a$04$uj2kC5wJNTrXKzFGTBfdhOKC.DYwJ6tejgVWdkZbzVXp/wY7AHW6m');
+INSERT INTO themes (user_id, dark_mode) VALUES (582, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (583, 'kenichi840', 'ちんちんちんすこう', '普段YouTuberをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kenichi84.example.com/\nメールアドレス: kenichi84@example.com\n', '$2a$04$mOWpE7fPGGnKrMDLz0o71u6FPNfIu/60SvKe9CNTKAAQ4cGd48a1m');
+INSERT INTO themes (user_id, dark_mode) VALUES (583, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (584, 'kobayashishota0', 'さやさやさやえんどう', '普段公務員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kobayashishota.example.com/\nメールアドレス: kobayashishota@example.com\n', '$2a$04$evS0vE3fQ4gtple5.lY6BOatdNX6jKG6eQlpbRtYQDNBQxbVRoiFW');
+INSERT INTO themes (user_id, dark_mode) VALUES (584, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (585, 'ainoue0', '夕暮れ時', '普段裁判官をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ainoue.example.com/\nメールアドレス: ainoue@example.com\n', '$2a$04$4g7GzmasnxaXMkyaniG3AuuyP/TS0sq1x5oTi9KphY.59juebN51W');
+INSERT INTO themes (user_id, dark_mode) VALUES (585, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (586, 'takuma380', '星座', '普段測量士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://takuma38.example.com/\nメールアドレス: takuma38@example.com\n', '$2a$04$q20IW8IrwmTn9BWDq349ROYis2Tr3iQ3dvagCKd21VSKSlB1bLNla');
+INSERT INTO themes (user_id, dark_mode) VALUES (586, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (587, 'dikeda0', '暖かい風', '普段行政書士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://dikeda.example.com/\nメールアドレス: dikeda@example.com\n', '$2a$04$W1M2RwbskgN4Xcj3SfoNreWw4p6TbM7cbhQHE5vxbDxQDEefwUxny');
+INSERT INTO themes (user_id, dark_mode) VALUES (587, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (588, 'dtanaka0', '夕日柿', '普段測量士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://dtanaka.example.com/\nメールアドレス: dtanaka@example.com\n', '$2a$04$15IR25zhI4AmeMXNpzRC9eXFostWbJzQ0AkT7qD4.E5VvgwVIeKJq');
+INSERT INTO themes (user_id, dark_mode) VALUES (588, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (589, 'miturutakahashi0', '風景', '普段航海士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://miturutakahashi.example.com/\nメールアドレス: miturutakahashi@example.com\n', '$2a$04$34kAlIoUPVRvakcqe/xy8.YXw0R3Xq6c91qsch0rfR/MfiBTNkaTi');
+INSERT INTO themes (user_id, dark_mode) VALUES (589, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (590, 'fmurakami0', 'ひとしずく', '普段農家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://fmurakami.example.com/\nメールアドレス: fmurakami@example.com\n', '$2a$04$lAp9YLJjs/1DljHgTubJBuL5r/.2NZDMnTylFpYBsdYeaznUcDkJ.');
+INSERT INTO themes (user_id, dark_mode) VALUES (590, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (591, 'ishiiakira0', '風車まんじゅう', '普段電気工事士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ishiiakira.example.com/\nメールアドレス: ishiiakira@example.com\n', '$2a$04$ywZmBv6LQYbWGpA3yxaMcOIEQn1G.czkAd5uEK7fTeq3lfoaMoVLW');
+INSERT INTO themes (user_id, dark_mode) VALUES (591, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (592, 'maayaokamoto0', 'さくらんぼさくりん', '普段航海士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://maayaokamoto.example.com/\nメールアドレス: maayaokamoto@example.com\n', '$2a$04$w5.MtTa6RDKEbD9j7XnUnOAYBcpug2XAVrjLgFg3wrlRHppbO52Gy');
+INSERT INTO themes (user_id, dark_mode) VALUES (592, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (593, 'asaito1', 'みずうみみずし', '普段運転士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://asaito.example.com/\nメールアドレス: asaito@example.com\n', '$2a$04$0z1ni/zkE6a9Sfsaq3vb6O.Xma1va8H3h5/IfFVBH0MxrUI.OZRNm');
+INSERT INTO themes (user_id, dark_mode) VALUES (593, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (594, 'atsushi470', '大地', '普段ゲームクリエイターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://atsushi47.example.com/\nメールアドレス: atsushi47@example.com\n', '$2a$04$f1BJVM5MCc6MpOOEbCtJGOI8xY3Alas0Ov8fVNuYej8b1idv59cCW');
+INSERT INTO themes (user_id, dark_mode) VALUES (594, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (595, 'ekobayashi0', 'ふわふわふわもち', '普段和紙職人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ekobayashi.example.com/\nメールアドレス: ekobayashi@example.com\n', '$2a$04$JNkWqunO87FabYimlZkUqOyC02iDIbfQB35m.PKW7N/x2U8uqCEEW');
+INSERT INTO themes (user_id, dark_mode) VALUES (595, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (596, 'ukobayashi0', 'さらさらさるたん', '普段気象予報士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ukobayashi.example.com/\nメールアドレス: ukobayashi@example.com\n', '$2a$04$cyjbSBfNcFM.VG2ovtnqg.xlmhsOaEy.yygmJ7hlNIM2STJ4Wgh5.');
+INSERT INTO themes (user_id, dark_mode) VALUES (596, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (597, 'asaito2', '風流', '普段農家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://asaito.example.com/\nメールアドレス: asaito@example.com\n', '$2a$04$qziCjx2Kh1JpW/bYzjKFc.v7jrvki3LJjpAxZqnlRJN.qn.NBT492');
+INSERT INTO themes (user_id, dark_mode) VALUES (597, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (598, 'hayashirei0', 'いちごいちごかん', '普段和紙職人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hayashirei.example.com/\nメールアドレス: hayashirei@example.com\n', '$2a$04$6/rZQ./QnEYIa/XCChrIlOtQ.62Z0ZSRmWR27AmuY43XKPXcn8UmW');
+INSERT INTO themes (user_id, dark_mode) VALUES (598, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (599, 'suzukisatomi0', '然然', '普段映画監督をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://suzukisatomi.example.com/\nメールアドレス: suzukisatomi@example.com\n', '$2a$04$4R3oaiwgL3jFDx4XkSPgWeK.uk35cdQm.wKhYVFSFMREEtEUtuPZu');
+INSERT INTO themes (user_id, dark_mode) VALUES (599, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (600, 'yumiko100', 'ちゅるんちゅるん', '普段行政書士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yumiko10.example.com/\nメールアドレス: yumiko10@example.com\n', '$2a$04$/v16fIbxYBiHvtEmtjgydeJ/fUI2H0OhCgNdTReh5WZUtHYvubDDi');
+INSERT INTO themes (user_id, dark_mode) VALUES (600, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (601, 'okadahanako0', '秋声', '普段運転士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://okadahanako.example.com/\nメールアドレス: okadahanako@example.com\n', '$2a$04$0BRufcJy.4FPaIDjWxsKz.5JBQQMoGZzVniBFU0s7jaC.8pHSiuaK');
+INSERT INTO themes (user_id, dark_mode) VALUES (601, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (602, 'mtanaka0', 'もももももんじゃ', '普段アニメーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://mtanaka.example.com/\nメールアドレス: mtanaka@example.com\n', '$2a$04$GvL4Wts8RiOULOE9qAYZWeX6pqOaW9F3MoWrqiAtMt3K.tepN85Xq');
+INSERT INTO themes (user_id, dark_mode) VALUES (602, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (603, 'hidekiyamamoto0', 'さくさくさくらんぼ', '普段小説家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hidekiyamamoto.example.com/\nメールアドレス: hidekiyamamoto@example.com\n', '$2a$04$OHdW6thFVfbi6tPhy5lXGeiFAShOFDz0xFVG2iSjM0yZ.jnAa0Uqq');
+INSERT INTO themes (user_id, dark_mode) VALUES (603, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (604, 'yamamotomituru0', 'いちごいちごかんきんこうせ', '普段医師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yamamotomituru.example.com/\nメールアドレス: yamamotomituru@example.com\n', '$2a$04$0PulOoA5M9qCiCU4oL0O1OqnrGD./.dGLQPwuTf9SfioljG1ImG7.');
+INSERT INTO themes (user_id, dark_mode) VALUES (604, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (605, 'kimuratomoya0', '夕凪', '普段介護ヘルパーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kimuratomoya.example.com/\nメールアドレス: kimuratomoya@example.com\n', '$2a$04$lBKwG4y37PBniflzaf/iY.9KYWo2ZBd6JSfvgT9UG0XA80uSShAJW');
+INSERT INTO themes (user_id, dark_mode) VALUES (605, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (606, 'mikako780', '霰', '普段行政書士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://mikako78.example.com/\nメールアドレス: mikako78@example.com\n', '$2a$04$pEqzGw.XCz0voYRH3QMJTO59V1WsTacBbGb/K2UETzzAEqaz6xU96');
+INSERT INTO themes (user_id, dark_mode) VALUES (606, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (607, 'pgoto0', '秋月', '普段運転士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://pgoto.example.com/\nメールアドレス: pgoto@example.com\n', '$2a$04$j./lZgNj/fSYZqg7O7BDue3T7Ijeun6VVUizLRGFMheTUMIqcYK2y');
+INSERT INTO themes (user_id, dark_mode) VALUES (607, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (608, 'harukawatanabe0', 'ぶどうぶどうぶん', '普段脚本家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://harukawatanabe.example.com/\nメールアドレス: harukawatanabe@example.com\n', '$2a$04$sLLGF.m9gbfbm5pTeUjpvuW89BW.yNEylciEbeGzsacMmbCN1DnSC');
+INSERT INTO themes (user_id, dark_mode) VALUES (608, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (609, 'zkimura0', 'すももすももち', '普段電気工事士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://zkimura.example.com/\nメールアドレス: zkimura@example.com\n', '$2a$04$uu1XhQxhkzIJsJ7Tzw8qfuH/wivo4hkEYkFKOP0xKulBk2nxZPEqS');
+INSERT INTO themes (user_id, dark_mode) VALUES (609, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (610, 'taichikimura0', 'ひとやすみひとしずく', '普段和紙職人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://taichikimura.example.com/\nメールアドレス: taichikimura@example.com\n', '$2a$04$qUPj6ngqW37MzPM4LsJ7QOBsEW7qF0fUVyE4T3QJYH4AKVozbNC9q');
+INSERT INTO themes (user_id, dark_mode) VALUES (610, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (611, 'moriminoru0', 'あまあまあまん', '普段グラフィックデザイナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://moriminoru.example.com/\nメールアドレス: moriminoru@example.com\n', '$2a$04$ah/ZrA65mvjSG9M2zRSUcegW1d1d.lzyMkKl0leUmnkmTEBGogPp.');
+INSERT INTO themes (user_id, dark_mode) VALUES (611, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (612, 'thayashi0', '雲のかたち', '普段漫画家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://thayashi.example.com/\nメールアドレス: thayashi@example.com\n', '$2a$04$sdAySrC/p9LWk5J.r5LM.udKeDM02c0eSXn3GFCrJ0uMUOmDlQDy2');
+INSERT INTO themes (user_id, dark_mode) VALUES (612, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (613, 'akira740', 'こはくのこ', '普段花火師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://akira74.example.com/\nメールアドレス: akira74@example.com\n', '$2a$04$uHf5EFSsbhKGqYandZv/Z.kwJ.UHjKBL5qD9hr8us.PEoIZTxNQrq');
+INSERT INTO themes (user_id, dark_mode) VALUES (613, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (614, 'suzukiharuka1', 'みなりみなりもち', '普段漫画家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://suzukiharuka.example.com/\nメールアドレス: suzukiharuka@example.com\n', '$2a$04$2HuLJJa1611votr5CHKX/OS3xK1fAREGgbmLKSWo4Ewf5bzDGvCwi');
+INSERT INTO themes (user_id, dark_mode) VALUES (614, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (615, 'yuta030', 'ふんわりふんわ', '普段航海士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yuta03.example.com/\nメールアドレス: yuta03@example.com\n', '$2a$04$LTNRQBExFHY8lQCn44lo/ehB/NRpBIpddcfgIVLGGUYtvJmzJ3/9O');
+INSERT INTO themes (user_id, dark_mode) VALUES (615, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (616, 'fyamamoto0', 'はっぱはっぱもち', '普段航海士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://fyamamoto.example.com/\nメールアドレス: fyamamoto@example.com\n', '$2a$04$vJVvUq12hZbehFmPzP.c8OnRsuSErg/5.1dLO7hq3pPFlvIQgaobu');
+INSERT INTO themes (user_id, dark_mode) VALUES (616, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (617, 'watanaberyosuke0', 'きらきらきらりん', '普段アーティストをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://watanaberyosuke.example.com/\nメールアドレス: watanaberyosuke@example.com\n', '$2a$04$zoGEpfPL0Ypk8OengyTkvuOdM0QVy30SWsY1C7y1aBvWBICJYu51y');
+INSERT INTO themes (user_id, dark_mode) VALUES (617, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (618, 'maayamatsumoto0', 'つぶつぶつぶあん', '普段営業をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://maayamatsumoto.example.com/\nメールアドレス: maayamatsumoto@example.com\n', '$2a$04$NFEv49zphsEdwCNLi6QYz.FbSSEyNqGuIsJ9hNj.nbXE5Z624plBm');
+INSERT INTO themes (user_id, dark_mode) VALUES (618, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (619, 'yoichiendo0', 'りんごりんごりんごもち', '普段エンジニア演奏家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yoichiendo.example.com/\nメールアドレス: yoichiendo@example.com\n', '$2a$04$mAbZO/nJE.f8CjUSmm/IE.hqbx6XvnWlJmbNNi0ikU4p4mRcSDfuy');
+INSERT INTO themes (user_id, dark_mode) VALUES (619, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (620, 'satokaori0', 'はっぴーはんぺん', '普段音楽家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://satokaori.example.com/\nメールアドレス: satokaori@example.com\n', '$2a$04$aOtXqzAbj22O492SA6pp4euu5zHmRfxxNoG.Yp4qQf9O2NiKpmVJu');
+INSERT INTO themes (user_id, dark_mode) VALUES (620, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (621, 'gmatsumoto0', 'くるみくるくる', '普段測量士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://gmatsumoto.example.com/\nメールアドレス: gmatsumoto@example.com\n', '$2a$04$/0I5Z3FbgSF8C7k8qSfp3.ytDI6zVKZQ0u1qb4OeREpHxQzl6CTFW');
+INSERT INTO themes (user_id, dark_mode) VALUES (621, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (622, 'rika250', '秋雨', '普段公務員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://rika25.example.com/\nメールアドレス: rika25@example.com\n', '$2a$04$b9YqfFmp0r1WI.OGkygre./9HgRDPIFojEZZerozLRkDmQI1Fgz9a');
+INSERT INTO themes (user_id, dark_mode) VALUES (622, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (623, 'jsasaki0', 'さくらんさくらかんき', '普段大学教授をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://jsasaki.example.com/\nメールアドレス: jsasaki@example.com\n', '$2a$04$kdb7klvhAf6bAf.prygvoungTlvAxg6KZkBOYyo4W6Zi3sU3I495m');
+INSERT INTO themes (user_id, dark_mode) VALUES (623, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (624, 'yutasaito0', 'うめうめうめぼしもち', '普段営業をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yutasaito.example.com/\nメールアドレス: yutasaito@example.com\n', '$2a$04$AXKaWtL0qEK/8xW.80xPMey9ariOJJgDcAxIsh9/aZ0fp7NSIC8cq');
+INSERT INTO themes (user_id, dark_mode) VALUES (624, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (625, 'kazuya810', 'あんこあんこう', '普段電気工事士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kazuya81.example.com/\nメールアドレス: kazuya81@example.com\n', '$2a$04$rJ7mfRo9Aw9VW5mcgkH/se52xwvhBDUVDZEKjsrpxkUsp5ITv2zOS');
+INSERT INTO themes (user_id, dark_mode) VALUES (625, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (626, 'manabukobayashi0', 'あまあまあまんじゅう', '普段航海士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://manabukobayashi.example.com/\nメールアドレス: manabukobayashi@example.com\n', '$2a$04$xPHuVYa.1gExp.Xh3kUESOhgaBBHfbTwutEYI8rrOzwOIWM.xLHg.');
+INSERT INTO themes (user_id, dark_mode) VALUES (626, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (627, 'hashimotomikako0', '宵時', '普段寿司職人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hashimotomikako.example.com/\nメールアドレス: hashimotomikako@example.com\n', '$2a$04$se4XG.MHrkFFuSXB/aSX1uNxqY0LdYnetRSqOvTrrgFtuB9UP84TK');
+INSERT INTO themes (user_id, dark_mode) VALUES (627, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (628, 'fujiwarahideki0', '空の旅', '普段グラフィックデザイナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://fujiwarahideki.example.com/\nメールアドレス: fujiwarahideki@example.com\n', '$2a$04$As9ZsAB/qUV3RHXTPW0LDOecwR.GqPIhbBHyXmgTHxVOE3xE/8G8i');
+INSERT INTO themes (user_id, dark_mode) VALUES (628, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (629, 'yoichi730', 'あざやかあんず', '普段公務員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yoichi73.example.com/\nメールアドレス: yoichi73@example.com\n', '$2a$04$a2FyUeWJPpgE//4QaPNUSePy8ie9/KM46MavQwzvTx32r05ZE2vPC');
+INSERT INTO themes (user_id, dark_mode) VALUES (629, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (630, 'takahashiryosuke0', 'まみまみまみれもち', '普段ゲームクリエイターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://takahashiryosuke.example.com/\nメールアドレス: takahashiryosuke@example.com\n', '$2a$04$1eF6i15wMQvGByE78X53Su7GiDOGKGBGtLuESNq.QS5YwXsICaIx6');
+INSERT INTO themes (user_id, dark_mode) VALUES (630, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (631, 'tyamaguchi1', '星垂れ', '普段高等学校教員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tyamaguchi.example.com/\nメールアドレス: tyamaguchi@example.com\n', '$2a$04$dAHk5cZpQrHAyj1mw5.HW.W15pHZm5nwY0uPmN/4hknber.FNS.m.');
+INSERT INTO themes (user_id, dark_mode) VALUES (631, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (632, 'otaminoru0', '花火', '普段アートディレクターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://otaminoru.example.com/\nメールアドレス: otaminoru@example.com\n', '$2a$04$nvAPnhFN3DbDGykNeY9u6e7uZx/RH8ID7JhwXIjNImjsPzZeTJQXu');
+INSERT INTO themes (user_id, dark_mode) VALUES (632, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (633, 'momoko990', 'ゆめゆめゆり', '普段イラストレーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://momoko99.example.com/\nメールアドレス: momoko99@example.com\n', '$2a$04$te4Lr4UAR1nJRlW.n17STuSR3HDjx1wCvnEKyQso4x/tlpWtmOtaa');
+INSERT INTO themes (user_id, dark_mode) VALUES (633, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (634, 'kazuyahasegawa0', 'あんこあんこかんきんこうせい', '普段裁判官をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kazuyahasegawa.example.com/\nメールアドレス: kazuyahasegawa@example.com\n', '$2a$04$6CW3TBbvY3ja/vT.gfdDS.m0M1BmgxjZRE7Xyunk6BFDEkbqlaqn6');
+INSERT INTO themes (user_id, dark_mode) VALUES (634, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (635, 'mai770', 'みずみずしいみずあめ', '普段営業をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://mai77.example.com/\nメールアドレス: mai77@example.com\n', '$2a$04$dSbZPImycO340SYKNxZ/Q.Gwbz9g7hIJ7Cnddjqptv6Zmf6HVgyzC');
+INSERT INTO themes (user_id, dark_mode) VALUES (635, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (636, 'aishii0', 'もろもろもろこし', '普段漫画家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://aishii.example.com/\nメールアドレス: aishii@example.com\n', '$2a$04$aGeuD2L8RycBjr3DqWbZUOs2w90DCowMfOFLpkW8sabWl1s3FmRoK');
+INSERT INTO themes (user_id, dark_mode) VALUES (636, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (637, 'pokada0', '百華', '普段運転士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://pokada.example.com/\nメールアドレス: pokada@example.com\n', '$2a$04$jlMvCu6723IQhhKTEgwZWeRA0MoVuDp5Jm1TXkr4VYaunzWewwI0G');
+INSERT INTO themes (user_id, dark_mode) VALUES (637, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (638, 'cyamaguchi0', 'あんこあんこかんきんこう', '普段エンジニア演奏家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://cyamaguchi.example.com/\nメールアドレス: cyamaguchi@example.com\n', '$2a$04$P3AbZWSr0kefGE86Jzy.rOK3eTvN1XZA4R5igmI.qgmuj8gvV29J.');
+INSERT INTO themes (user_id, dark_mode) VALUES (638, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (639, 'akemi500', 'ぎんぎんぎんなん', '普段救急救命士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://akemi50.example.com/\nメールアドレス: akemi50@example.com\n', '$2a$04$di0xhcmFLQYhttsk59.KIec.lYk2Pg2IzidWkst1sdYRjFPfIZE26');
+INSERT INTO themes (user_id, dark_mode) VALUES (639, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (640, 'jtanaka0', 'あんこあんこかんきんこうせ', '普段調理師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://jtanaka.example.com/\nメールアドレス: jtanaka@example.com\n', '$2a$04$Kyjwam4jnpx3kgsHO2LI1uNeXehTyAOKx6bLS/P2vfUimriVhpxoi');
+INSERT INTO themes (user_id, dark_mode) VALUES (640, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (641, 'tnakagawa0', '時雨ドロップ', '普段栄養士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tnakagawa.example.com/\nメールアドレス: tnakagawa@example.com\n', '$2a$04$cGmoHuamN4w.LvrIqU1mwO8BKvYlZbmxZQFGRmSDCMRAmRCXt1B7.');
+INSERT INTO themes (user_id, dark_mode) VALUES (641, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (642, 'kondoyoko0', 'ちびちびまんじゅう', '普段グラフィックデザイナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kondoyoko.example.com/\nメールアドレス: kondoyoko@example.com\n', '$2a$04$YT65ydKjNDzNXvxK2C3NCeR2MNydvke77NqauyRGtknDidvbDng2W');
+INSERT INTO themes (user_id, dark_mode) VALUES (642, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (643, 'yoichiogawa0', 'つつじつつじつぶ', '普段グラフィックデザイナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yoichiogawa.example.com/\nメールアドレス: yoichiogawa@example.com\n', '$2a$04$VcfyEdo7EZPDyAXcbt/f9.kJH2of1qAn1wbfMsQZ/WBfgN8JH4hLC');
+INSERT INTO themes (user_id, dark_mode) VALUES (643, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (644, 'hayashikenichi0', '鳥渡', '普段映画監督をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hayashikenichi.example.com/\nメールアドレス: hayashikenichi@example.com\n', '$2a$04$ycsZAgjpnNgA671FYbukYeT6LCor5Db/92e3gqRdJM6cYpaHam4K6');
+INSERT INTO themes (user_id, dark_mode) VALUES (644, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (645, 'nanami990', 'まるまるまるいもち', '普段航海士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://nanami99.example.com/\nメールアドレス: nanami99@example.com\n', '$2a$04$DJwI/7petpqGJCRmDSG5nuQnYchTl20az2rsgxu8Uc7wAImKveQCu');
+INSERT INTO themes (user_id, dark_mode) VALUES (645, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (646, 'hiroshiendo0', '感慨', '普段営業をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hiroshiendo.example.com/\nメールアドレス: hiroshiendo@example.com\n', '$2a$04$UwZb6IFm4tgS1pLvujdaV.sK3Gq9zN2IJyIuGjqtMyuSL/dZXfqjK');
+INSERT INTO themes (user_id, dark_mode) VALUES (646, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (647, 'akirasuzuki0', '透明', '普段グラフィックデザイナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://akirasuzuki.example.com/\nメールアドレス: akirasuzuki@example.com\n', '$2a$04$p.G7Rfg50hqn6oFyNHEFCesVkulf1gIMikz8y8uqA1MGDoswG8R7y');
+INSERT INTO themes (user_id, dark_mode) VALUES (647, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (648, 'maaya140', '夕暮れ', '普段農家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://maaya14.example.com/\nメールアドレス: maaya14@example.com\n', '$2a$04$PUrpyrjNpP70jWQyFIS90OhOzVk3PRTdtDSn97v5GlHG5//.Pc8ma');
+INSERT INTO themes (user_id, dark_mode) VALUES (648, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (649, 'itomomoko0', 'かさかさかりんとう', '普段イラストレーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://itomomoko.example.com/\nメールアドレス: itomomoko@example.com\n', '$2a$04$/xwDxJw0l7il8nerRB7Ey.zwvtCMT3HP8ha6Sil9l6ZNL8eYuTSd6');
+INSERT INTO themes (user_id, dark_mode) VALUES (649, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (650, 'satorika0', 'さくらんぼもなか', '普段公認会計士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://satorika.example.com/\nメールアドレス: satorika@example.com\n', '$2a$04$Bfs9ObF/qjudHK.wofdamOQpZWHt3yPiU2qsUEDwprjtPcPNYqGqu');
+INSERT INTO themes (user_id, dark_mode) VALUES (650, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (651, 'yoko110', 'たいようたぬき', '普段和紙職人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yoko11.example.com/\nメールアドレス: yoko11@example.com\n', '$2a$04$GWjJPRhvDfqpEvp8bp7dW.ug.BHMx9em4WL8BSRPuMXj/ylhr8TmW');
+INSERT INTO themes (user_id, dark_mode) VALUES (651, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (652, 'wyamazaki0', 'ぷにぷにゼリー', '普段漫画家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://wyamazaki.example.com/\nメールアドレス: wyamazaki@example.com\n', '$2a$04$Wyg2OV0Eg7eSCnoFqtJLgujNnkduv2.4hrKlyiAANYCN0uIq/Df0O');
+INSERT INTO themes (user_id, dark_mode) VALUES (652, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (653, 'yoko111', '秋桜まんじゅう', '普段運転士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yoko11.example.com/\nメールアドレス: yoko11@example.com\n', '$2a$04$t4O0rPbs5QVsxI9VFAXoyeWsJ/rHAdpFs4ChmoeOn4WStCBmHc9dC');
+INSERT INTO themes (user_id, dark_mode) VALUES (653, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (654, 'osato0', '夜更け', '普段アニメーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://osato.example.com/\nメールアドレス: osato@example.com\n', '$2a$04$p555Qy6kgTbVo67k29l9VuWeqo1vPANms6He5BGOgn2ypqolyR3Re');
+INSERT INTO themes (user_id, dark_mode) VALUES (654, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (655, 'aokitaro0', 'さくらんさくらかんきん', '普段ゲームクリエイターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://aokitaro.example.com/\nメールアドレス: aokitaro@example.com\n', '$2a$04$0puas75g4hIjKHehCQqrMe1GCX8szA3.I6oCpQ3.tK3kudFHCLcRO');
+INSERT INTO themes (user_id, dark_mode) VALUES (655, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (656, 'gnakamura0', '新緑', '普段気象予報士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://gnakamura.example.com/\nメールアドレス: gnakamura@example.com\n', '$2a$04$Mfrba/tPNPbWeMHJ67dVG.mbMrbkI/J3QQrZpKr7H4.oYt.cWZ1Ui');
+INSERT INTO themes (user_id, dark_mode) VALUES (656, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (657, 'kobayashiyoko0', 'さくらんさくらかん', '普段エステティシャンをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kobayashiyoko.example.com/\nメールアドレス: kobayashiyoko@example.com\n', '$2a$04$7ZWkDLISNMr//oWec9KFceiQBYZ6czBLle3kvgjL9ek5B/FA82wD6');
+INSERT INTO themes (user_id, dark_mode) VALUES (657, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (658, 'suzukinanami0', 'はにかみはっさく', '普段高等学校教員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://suzukinanami.example.com/\nメールアドレス: suzukinanami@example.com\n', '$2a$04$TMA.ea7HW0wmcLE0vd6hQ.PsJ1mZ4niDzhZN1gi7yiWPoPnawgsrm');
+INSERT INTO themes (user_id, dark_mode) VALUES (658, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (659, 'yosukeishikawa0', 'つぶつぶつぶあん', '普段農家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yosukeishikawa.example.com/\nメールアドレス: yosukeishikawa@example.com\n', '$2a$04$807xAItPSriQOKMQ9iBW5OBfqwuWei.fJIhMJ3nlP9B9MX5yC/yo6');
+INSERT INTO themes (user_id, dark_mode) VALUES (659, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (660, 'manabu450', 'うさぎうさぎうさぎもち', '普段薬剤師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://manabu45.example.com/\nメールアドレス: manabu45@example.com\n', '$2a$04$0PccloP90oBYVZSg1GLWFO0FJ7hRVaK6/NL2dFfEtFjoe8gUYiuN6');
+INSERT INTO themes (user_id, dark_mode) VALUES (660, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (661, 'naoki630', 'もちもちみるく', '普段アーティストをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://naoki63.example.com/\nメールアドレス: naoki63@example.com\n', '$2a$04$q3ocvbPjvd5Sp1MYZwHciOn3yzDEQWVkpxkeoDzywyHlJMQX8CmXq');
+INSERT INTO themes (user_id, dark_mode) VALUES (661, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (662, 'kimuranaoki0', 'すずらんすいせん', '普段調理師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kimuranaoki.example.com/\nメールアドレス: kimuranaoki@example.com\n', '$2a$04$C5kAIMdMDC9Fcap9LTzBK.4NEn8gIGZgPWzelYFLddPbIni7MZETG');
+INSERT INTO themes (user_id, dark_mode) VALUES (662, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (663, 'kumiko770', 'ぺっぺっぺんた', '普段グラフィックデザイナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kumiko77.example.com/\nメールアドレス: kumiko77@example.com\n', '$2a$04$RbZ/5L2foJkNG2EgXMUblOgZ6xmEdxGTxQjZqyu4lCaqGR9yuCr3y');
+INSERT INTO themes (user_id, dark_mode) VALUES (663, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (664, 'moriyuta0', 'ふんわりふんわかんきん', '普段エンジニア演奏家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://moriyuta.example.com/\nメールアドレス: moriyuta@example.com\n', '$2a$04$DpI07.7ql.45WjIfl28LP.8nI005.krxyHLudkIdX0CKmcW4OCh6m');
+INSERT INTO themes (user_id, dark_mode) VALUES (664, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (665, 'tsubasa300', '紅葉かりんとう', '普段司法書士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tsubasa30.example.com/\nメールアドレス: tsubasa30@example.com\n', '$2a$04$.GkE/OUCwvZ2gheBHs7q4uOf/YMfAj9PNpYn6jCwrOEx16dspw2qa');
+INSERT INTO themes (user_id, dark_mode) VALUES (665, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (666, 'hidekinakamura0', '蛍光', '普段ウェディングプランナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hidekinakamura.example.com/\nメールアドレス: hidekinakamura@example.com\n', '$2a$04$3EUNF8B0yBBbGUWMH0jo1.2p.tlXVGi3Q3cdvU8rJuHgmJo4QwOQG');
+INSERT INTO themes (user_id, dark_mode) VALUES (666, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (667, 'rei080', 'きんとききんとかんきん', '普段アーティストをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://rei08.example.com/\nメールアドレス: rei08@example.com\n', '$2a$04$s.JWzrfBPfrBq5gyPL7sv.ldvADiNe2US9iKWtL.qY5qm1zZeAFge');
+INSERT INTO themes (user_id, dark_mode) VALUES (667, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (668, 'shoheiyoshida0', 'くるくるくるみ', '普段絵本作家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://shoheiyoshida.example.com/\nメールアドレス: shoheiyoshida@example.com\n', '$2a$04$WE0FHGKNQ0HAEBZ8Xi9NDe32IIIyjTv9X3yuBQhvbS5U9BrylEMEW');
+INSERT INTO themes (user_id, dark_mode) VALUES (668, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (669, 'omatsumoto0', 'たんたんたんご', '普段YouTuberをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://omatsumoto.example.com/\nメールアドレス: omatsumoto@example.com\n', '$2a$04$NTzkO97hOWpI1U3mx.odt.LreHylHcR9RjZkBPhSqbnJ.U6jOhI2a');
+INSERT INTO themes (user_id, dark_mode) VALUES (669, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (670, 'haruka260', '青海波', '普段救急救命士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://haruka26.example.com/\nメールアドレス: haruka26@example.com\n', '$2a$04$Z27Z/RwqmDnOXnXFiqbNBOZN.D.GqX0VV/AZQUgUQ7kqfA5MMBn7m');
+INSERT INTO themes (user_id, dark_mode) VALUES (670, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (671, 'takuma790', 'さくさくさくらん', '普段ゲームクリエイターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://takuma79.example.com/\nメールアドレス: takuma79@example.com\n', '$2a$04$KMktrTFWS6MIiy9InssireRwhpPrhWbgjy0eQqyoNIKaboUvqJ6.K');
+INSERT INTO themes (user_id, dark_mode) VALUES (671, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (672, 'satoryosuke0', '木漏れ日', '普段モデルをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://satoryosuke.example.com/\nメールアドレス: satoryosuke@example.com\n', '$2a$04$xpe.xozmfHv7Z/2jNRuaee1bD8IXeEi4WDXM4zF76kcJ0UCZK0aLW');
+INSERT INTO themes (user_id, dark_mode) VALUES (672, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (673, 'maedamaaya0', 'さくらんさくらんぼ', '普段公務員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://maedamaaya.example.com/\nメールアドレス: maedamaaya@example.com\n', '$2a$04$5CnWWFdt4IauwyC4BjqTtuDrLiQ7Jkvi1MkaOiojVvQ1vMQER.p.W');
+INSERT INTO themes (user_id, dark_mode) VALUES (673, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (674, 'mikakosasaki0', 'はなはなはなびら', '普段薬剤師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://mikakosasaki.example.com/\nメールアドレス: mikakosasaki@example.com\n', '$2a$04$GO61jM8wsg0iiiPC6KWmZe05mDmajliBl0GtxRLCnK2eq5Mo/.C/O');
+INSERT INTO themes (user_id, dark_mode) VALUES (674, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (675, 'vmiura0', '空飛ぶ鶴', '普段アイドルをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://vmiura.example.com/\nメールアドレス: vmiura@example.com\n', '$2a$04$nBWPPUXpkUpHWWZ7kJYR2OLxhYsiLuAVeDsDCO1WcQZEQ3Q8O9jFC');
+INSERT INTO themes (user_id, dark_mode) VALUES (675, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (676, 'rika570', 'こころのこがねもち', '普段漫画家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://rika57.example.com/\nメールアドレス: rika57@example.com\n', '$2a$04$T5oIWCDo9kr3TdZy8Ek1TegBsy3rzF0u/9HrJex1uRdED8xwE8Hhy');
+INSERT INTO themes (user_id, dark_mode) VALUES (676, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (677, 'myamaguchi0', 'ちょこんまんじゅう', '普段気象予報士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://myamaguchi.example.com/\nメールアドレス: myamaguchi@example.com\n', '$2a$04$uyoIa6HiiwtLEadlWJBcLeWrQ02LtC9hBtKy99UWDMr1fekNacxBS');
+INSERT INTO themes (user_id, dark_mode) VALUES (677, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (678, 'minoru110', 'ちんちんちんすこう', '普段アートディレクターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://minoru11.example.com/\nメールアドレス: minoru11@example.com\n', '$2a$04$wNDY/crOYymoNs8G1gyRNO36/YSWS/aL8Mcv.h2nDm9..TFoJ7hCa');
+INSERT INTO themes (user_id, dark_mode) VALUES (678, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (679, 'ishikawakana0', 'すずすずすいか', '普段アートディレクターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ishikawakana.example.com/\nメールアドレス: ishikawakana@example.com\n', '$2a$04$kajn7WCr5lgnPy73ZudHxOy.XPkhEMTCPwIW.ANUhrqTViKKrWwbm');
+INSERT INTO themes (user_id, dark_mode) VALUES (679, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (680, 'yoko300', 'ふわふわふりかけ', '普段漫画家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yoko30.example.com/\nメールアドレス: yoko30@example.com\n', '$2a$04$EhXqaOrq7tGcR61FKb1vxOVNSeLubLpFoRJDXxERMvUQZH5a.tT/q');
+INSERT INTO themes (user_id, dark_mode) VALUES (680, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (681, 'tsuzuki0', '海の声', '普段コピーライターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tsuzuki.example.com/\nメールアドレス: tsuzuki@example.com\n', '$2a$04$5Lh81d1JW1AeHNueEENUVumXWpa6qhU.QRn0YJ1XFWRWg69Tc4PkG');
+INSERT INTO themes (user_id, dark_mode) VALUES (681, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (682, 'kenichiwatanabe0', 'たけのこたけのこもち', '普段経営者をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kenichiwatanabe.example.com/\nメールアドレス: kenichiwatanabe@example.com\n', '$2a$04$uj2kC5wJNTrXKzFGTBfdhOKC.DYwJ6tejgVWdkZbzVXp/wY7AHW6m');
+INSERT INTO themes (user_id, dark_mode) VALUES (682, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (683, 'naokosakamoto0', '月明かり', '普段行政書士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://naokosakamoto.example.com/\nメールアドレス: naokosakamoto@example.com\n', '$2a$04$mOWpE7fPGGnKrMDLz0o71u6FPNfIu/60SvKe9CNTKAAQ4cGd48a1m');
+INSERT INTO themes (user_id, dark_mode) VALUES (683, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (684, 'fujitataichi0', '夜長', '普段ウェディングプランナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://fujitataichi.example.com/\nメールアドレス: fujitataichi@example.com\n', '$2a$04$evS0vE3fQ4gtple5.lY6BOatdNX6jKG6eQlpbRtYQDNBQxbVRoiFW');
+INSERT INTO themes (user_id, dark_mode) VALUES (684, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (685, 'naokigoto0', 'いちごいちごこ', '普段アニメーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://naokigoto.example.com/\nメールアドレス: naokigoto@example.com\n', '$2a$04$4g7GzmasnxaXMkyaniG3AuuyP/TS0sq1x5oTi9KphY.59juebN51W');
+INSERT INTO themes (user_id, dark_mode) VALUES (685, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (686, 'yuta210', '夜空', '普段YouTuberをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yuta21.example.com/\nメールアドレス: yuta21@example.com\n', '$2a$04$q20IW8IrwmTn9BWDq349ROYis2Tr3iQ3dvagCKd21VSKSlB1bLNla');
+INSERT INTO themes (user_id, dark_mode) VALUES (686, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (687, 'sgoto0', 'うめうめうめぼし', '普段介護ヘルパーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://sgoto.example.com/\nメールアドレス: sgoto@example.com\n', '$2a$04$W1M2RwbskgN4Xcj3SfoNreWw4p6TbM7cbhQHE5vxbDxQDEefwUxny');
+INSERT INTO themes (user_id, dark_mode) VALUES (687, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (688, 'momokoishii0', '月夜の甘露', '普段エンジニア演奏家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://momokoishii.example.com/\nメールアドレス: momokoishii@example.com\n', '$2a$04$15IR25zhI4AmeMXNpzRC9eXFostWbJzQ0AkT7qD4.E5VvgwVIeKJq');
+INSERT INTO themes (user_id, dark_mode) VALUES (688, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (689, 'yoshidahideki0', '昼下がり', '普段医療事務員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yoshidahideki.example.com/\nメールアドレス: yoshidahideki@example.com\n', '$2a$04$34kAlIoUPVRvakcqe/xy8.YXw0R3Xq6c91qsch0rfR/MfiBTNkaTi');
+INSERT INTO themes (user_id, dark_mode) VALUES (689, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (690, 'takumainoue0', 'すずすずすいか', '普段ウェブデザイナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://takumainoue.example.com/\nメールアドレス: takumainoue@example.com\n', '$2a$04$lAp9YLJjs/1DljHgTubJBuL5r/.2NZDMnTylFpYBsdYeaznUcDkJ.');
+INSERT INTO themes (user_id, dark_mode) VALUES (690, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (691, 'suzukiyuta0', 'ほんわかほうじ', '普段絵本作家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://suzukiyuta.example.com/\nメールアドレス: suzukiyuta@example.com\n', '$2a$04$ywZmBv6LQYbWGpA3yxaMcOIEQn1G.czkAd5uEK7fTeq3lfoaMoVLW');
+INSERT INTO themes (user_id, dark_mode) VALUES (691, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (692, 'manabu370', '甘露日和', '普段イラストレーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://manabu37.example.com/\nメールアドレス: manabu37@example.com\n', '$2a$04$w5.MtTa6RDKEbD9j7XnUnOAYBcpug2XAVrjLgFg3wrlRHppbO52Gy');
+INSERT INTO themes (user_id, dark_mode) VALUES (692, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (693, 'asuka120', 'ゆりゆりゆりもち', '普段漫画家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://asuka12.example.com/\nメールアドレス: asuka12@example.com\n', '$2a$04$0z1ni/zkE6a9Sfsaq3vb6O.Xma1va8H3h5/IfFVBH0MxrUI.OZRNm');
+INSERT INTO themes (user_id, dark_mode) VALUES (693, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (694, 'manabu621', 'しっしっしっぽり', '普段経営者をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://manabu62.example.com/\nメールアドレス: manabu62@example.com\n', '$2a$04$f1BJVM5MCc6MpOOEbCtJGOI8xY3Alas0Ov8fVNuYej8b1idv59cCW');
+INSERT INTO themes (user_id, dark_mode) VALUES (694, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (695, 'gogawa0', 'ぽってりぽんたん', '普段調理師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://gogawa.example.com/\nメールアドレス: gogawa@example.com\n', '$2a$04$JNkWqunO87FabYimlZkUqOyC02iDIbfQB35m.PKW7N/x2U8uqCEEW');
+INSERT INTO themes (user_id, dark_mode) VALUES (695, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (696, 'yamashitaosamu0', 'うめうめうめぼし', '普段和紙職人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yamashitaosamu.example.com/\nメールアドレス: yamashitaosamu@example.com\n', '$2a$04$cyjbSBfNcFM.VG2ovtnqg.xlmhsOaEy.yygmJ7hlNIM2STJ4Wgh5.');
+INSERT INTO themes (user_id, dark_mode) VALUES (696, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (697, 'jnakamura1', 'さくらんさくらかんきんこう', '普段占い師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://jnakamura.example.com/\nメールアドレス: jnakamura@example.com\n', '$2a$04$qziCjx2Kh1JpW/bYzjKFc.v7jrvki3LJjpAxZqnlRJN.qn.NBT492');
+INSERT INTO themes (user_id, dark_mode) VALUES (697, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (698, 'atakahashi0', 'ぎんぎらぎんなん', '普段検察官をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://atakahashi.example.com/\nメールアドレス: atakahashi@example.com\n', '$2a$04$6/rZQ./QnEYIa/XCChrIlOtQ.62Z0ZSRmWR27AmuY43XKPXcn8UmW');
+INSERT INTO themes (user_id, dark_mode) VALUES (698, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (699, 'osamukobayashi0', 'つぶつぶつぶあん', '普段司法書士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://osamukobayashi.example.com/\nメールアドレス: osamukobayashi@example.com\n', '$2a$04$4R3oaiwgL3jFDx4XkSPgWeK.uk35cdQm.wKhYVFSFMREEtEUtuPZu');
+INSERT INTO themes (user_id, dark_mode) VALUES (699, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (700, 'satomisato0', 'ゆずゆずゆずもち', '普段医師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://satomisato.example.com/\nメールアドレス: satomisato@example.com\n', '$2a$04$/v16fIbxYBiHvtEmtjgydeJ/fUI2H0OhCgNdTReh5WZUtHYvubDDi');
+INSERT INTO themes (user_id, dark_mode) VALUES (700, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (701, 'satorika1', '美和', '普段建築家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://satorika.example.com/\nメールアドレス: satorika@example.com\n', '$2a$04$0BRufcJy.4FPaIDjWxsKz.5JBQQMoGZzVniBFU0s7jaC.8pHSiuaK');
+INSERT INTO themes (user_id, dark_mode) VALUES (701, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (702, 'tanakahiroshi1', 'はるはるはるかんき', '普段介護ヘルパーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tanakahiroshi.example.com/\nメールアドレス: tanakahiroshi@example.com\n', '$2a$04$GvL4Wts8RiOULOE9qAYZWeX6pqOaW9F3MoWrqiAtMt3K.tepN85Xq');
+INSERT INTO themes (user_id, dark_mode) VALUES (702, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (703, 'satoyuta0', 'はなびはなびく', '普段裁判官をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://satoyuta.example.com/\nメールアドレス: satoyuta@example.com\n', '$2a$04$OHdW6thFVfbi6tPhy5lXGeiFAShOFDz0xFVG2iSjM0yZ.jnAa0Uqq');
+INSERT INTO themes (user_id, dark_mode) VALUES (703, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (704, 'mai710', '日中', '普段アナウンサーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://mai71.example.com/\nメールアドレス: mai71@example.com\n', '$2a$04$0PulOoA5M9qCiCU4oL0O1OqnrGD./.dGLQPwuTf9SfioljG1ImG7.');
+INSERT INTO themes (user_id, dark_mode) VALUES (704, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (705, 'nakamurakaori0', 'みずみずしいみずあめ', '普段和紙職人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://nakamurakaori.example.com/\nメールアドレス: nakamurakaori@example.com\n', '$2a$04$lBKwG4y37PBniflzaf/iY.9KYWo2ZBd6JSfvgT9UG0XA80uSShAJW');
+INSERT INTO themes (user_id, dark_mode) VALUES (705, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (706, 'naoko440', '神秘の夜ふわふわ', '普段公務員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://naoko44.example.com/\nメールアドレス: naoko44@example.com\n', '$2a$04$pEqzGw.XCz0voYRH3QMJTO59V1WsTacBbGb/K2UETzzAEqaz6xU96');
+INSERT INTO themes (user_id, dark_mode) VALUES (706, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (707, 'jokamoto1', '萩の月', '普段営業をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://jokamoto.example.com/\nメールアドレス: jokamoto@example.com\n', '$2a$04$j./lZgNj/fSYZqg7O7BDue3T7Ijeun6VVUizLRGFMheTUMIqcYK2y');
+INSERT INTO themes (user_id, dark_mode) VALUES (707, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (708, 'kobayashirei0', 'やわらかやわらび', '普段絵本作家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kobayashirei.example.com/\nメールアドレス: kobayashirei@example.com\n', '$2a$04$sLLGF.m9gbfbm5pTeUjpvuW89BW.yNEylciEbeGzsacMmbCN1DnSC');
+INSERT INTO themes (user_id, dark_mode) VALUES (708, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (709, 'kyosukewatanabe0', 'うめうめうめぼし', '普段農家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kyosukewatanabe.example.com/\nメールアドレス: kyosukewatanabe@example.com\n', '$2a$04$uu1XhQxhkzIJsJ7Tzw8qfuH/wivo4hkEYkFKOP0xKulBk2nxZPEqS');
+INSERT INTO themes (user_id, dark_mode) VALUES (709, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (710, 'yamaguchijun0', '雲のかたち', '普段調理師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yamaguchijun.example.com/\nメールアドレス: yamaguchijun@example.com\n', '$2a$04$qUPj6ngqW37MzPM4LsJ7QOBsEW7qF0fUVyE4T3QJYH4AKVozbNC9q');
+INSERT INTO themes (user_id, dark_mode) VALUES (710, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (711, 'yoshidahiroshi0', 'しいしいしいらせん', '普段栄養士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yoshidahiroshi.example.com/\nメールアドレス: yoshidahiroshi@example.com\n', '$2a$04$ah/ZrA65mvjSG9M2zRSUcegW1d1d.lzyMkKl0leUmnkmTEBGogPp.');
+INSERT INTO themes (user_id, dark_mode) VALUES (711, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (712, 'miurahanako0', 'きくきくきくらげ', '普段行政書士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://miurahanako.example.com/\nメールアドレス: miurahanako@example.com\n', '$2a$04$sdAySrC/p9LWk5J.r5LM.udKeDM02c0eSXn3GFCrJ0uMUOmDlQDy2');
+INSERT INTO themes (user_id, dark_mode) VALUES (712, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (713, 'tsubasa790', '桜咲く羊羹', '普段裁判官をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tsubasa79.example.com/\nメールアドレス: tsubasa79@example.com\n', '$2a$04$uHf5EFSsbhKGqYandZv/Z.kwJ.UHjKBL5qD9hr8us.PEoIZTxNQrq');
+INSERT INTO themes (user_id, dark_mode) VALUES (713, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (714, 'hiroshitakahashi0', 'ふんわりふんわりん', '普段歯科医師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hiroshitakahashi.example.com/\nメールアドレス: hiroshitakahashi@example.com\n', '$2a$04$2HuLJJa1611votr5CHKX/OS3xK1fAREGgbmLKSWo4Ewf5bzDGvCwi');
+INSERT INTO themes (user_id, dark_mode) VALUES (714, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (715, 'yukishimizu0', 'みずみずみずむし', '普段絵本作家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yukishimizu.example.com/\nメールアドレス: yukishimizu@example.com\n', '$2a$04$LTNRQBExFHY8lQCn44lo/ehB/NRpBIpddcfgIVLGGUYtvJmzJ3/9O');
+INSERT INTO themes (user_id, dark_mode) VALUES (715, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (716, 'inouenaoki0', 'かえるのかりんとう', '普段公務員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://inouenaoki.example.com/\nメールアドレス: inouenaoki@example.com\n', '$2a$04$vJVvUq12hZbehFmPzP.c8OnRsuSErg/5.1dLO7hq3pPFlvIQgaobu');
+INSERT INTO themes (user_id, dark_mode) VALUES (716, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (717, 'yuinakamura0', 'きなきなきなこもち', '普段YouTuberをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yuinakamura.example.com/\nメールアドレス: yuinakamura@example.com\n', '$2a$04$zoGEpfPL0Ypk8OengyTkvuOdM0QVy30SWsY1C7y1aBvWBICJYu51y');
+INSERT INTO themes (user_id, dark_mode) VALUES (717, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (718, 'hashimotoakemi0', '夜中', '普段栄養士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hashimotoakemi.example.com/\nメールアドレス: hashimotoakemi@example.com\n', '$2a$04$NFEv49zphsEdwCNLi6QYz.FbSSEyNqGuIsJ9hNj.nbXE5Z624plBm');
+INSERT INTO themes (user_id, dark_mode) VALUES (718, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (719, 'jwatanabe0', 'ころころこまねこ', '普段測量士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://jwatanabe.example.com/\nメールアドレス: jwatanabe@example.com\n', '$2a$04$mAbZO/nJE.f8CjUSmm/IE.hqbx6XvnWlJmbNNi0ikU4p4mRcSDfuy');
+INSERT INTO themes (user_id, dark_mode) VALUES (719, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (720, 'yosuke890', '天使の微笑みマシュマロ', '普段ウェブデザイナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yosuke89.example.com/\nメールアドレス: yosuke89@example.com\n', '$2a$04$aOtXqzAbj22O492SA6pp4euu5zHmRfxxNoG.Yp4qQf9O2NiKpmVJu');
+INSERT INTO themes (user_id, dark_mode) VALUES (720, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (721, 'mikisato0', 'つぶつぶつぶやき', '普段エステティシャンをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://mikisato.example.com/\nメールアドレス: mikisato@example.com\n', '$2a$04$/0I5Z3FbgSF8C7k8qSfp3.ytDI6zVKZQ0u1qb4OeREpHxQzl6CTFW');
+INSERT INTO themes (user_id, dark_mode) VALUES (721, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (722, 'naotoinoue0', 'すわりすわりん', '普段介護ヘルパーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://naotoinoue.example.com/\nメールアドレス: naotoinoue@example.com\n', '$2a$04$b9YqfFmp0r1WI.OGkygre./9HgRDPIFojEZZerozLRkDmQI1Fgz9a');
+INSERT INTO themes (user_id, dark_mode) VALUES (722, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (723, 'vinoue0', 'つぶつぶつぶやき', '普段ウェブデザイナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://vinoue.example.com/\nメールアドレス: vinoue@example.com\n', '$2a$04$kdb7klvhAf6bAf.prygvoungTlvAxg6KZkBOYyo4W6Zi3sU3I495m');
+INSERT INTO themes (user_id, dark_mode) VALUES (723, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (724, 'takuma170', 'くるくるくるみもち', '普段救急救命士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://takuma17.example.com/\nメールアドレス: takuma17@example.com\n', '$2a$04$AXKaWtL0qEK/8xW.80xPMey9ariOJJgDcAxIsh9/aZ0fp7NSIC8cq');
+INSERT INTO themes (user_id, dark_mode) VALUES (724, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (725, 'yamashitatsubasa0', 'たんぽぽたねん', '普段営業をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yamashitatsubasa.example.com/\nメールアドレス: yamashitatsubasa@example.com\n', '$2a$04$rJ7mfRo9Aw9VW5mcgkH/se52xwvhBDUVDZEKjsrpxkUsp5ITv2zOS');
+INSERT INTO themes (user_id, dark_mode) VALUES (725, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (726, 'mituru180', '青葉', '普段公認会計士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://mituru18.example.com/\nメールアドレス: mituru18@example.com\n', '$2a$04$xPHuVYa.1gExp.Xh3kUESOhgaBBHfbTwutEYI8rrOzwOIWM.xLHg.');
+INSERT INTO themes (user_id, dark_mode) VALUES (726, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (727, 'ttanaka1', 'ひまわりひまわりん', '普段公認会計士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ttanaka.example.com/\nメールアドレス: ttanaka@example.com\n', '$2a$04$se4XG.MHrkFFuSXB/aSX1uNxqY0LdYnetRSqOvTrrgFtuB9UP84TK');
+INSERT INTO themes (user_id, dark_mode) VALUES (727, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (728, 'ogawataichi0', '美玉', '普段公務員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ogawataichi.example.com/\nメールアドレス: ogawataichi@example.com\n', '$2a$04$As9ZsAB/qUV3RHXTPW0LDOecwR.GqPIhbBHyXmgTHxVOE3xE/8G8i');
+INSERT INTO themes (user_id, dark_mode) VALUES (728, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (729, 'rsakamoto0', 'はしはしはしわせんべい', '普段公認会計士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://rsakamoto.example.com/\nメールアドレス: rsakamoto@example.com\n', '$2a$04$a2FyUeWJPpgE//4QaPNUSePy8ie9/KM46MavQwzvTx32r05ZE2vPC');
+INSERT INTO themes (user_id, dark_mode) VALUES (729, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (730, 'satoakemi0', '甘露', '普段測量士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://satoakemi.example.com/\nメールアドレス: satoakemi@example.com\n', '$2a$04$1eF6i15wMQvGByE78X53Su7GiDOGKGBGtLuESNq.QS5YwXsICaIx6');
+INSERT INTO themes (user_id, dark_mode) VALUES (730, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (731, 'lito0', 'つりつりつりがね', '普段農家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://lito.example.com/\nメールアドレス: lito@example.com\n', '$2a$04$dAHk5cZpQrHAyj1mw5.HW.W15pHZm5nwY0uPmN/4hknber.FNS.m.');
+INSERT INTO themes (user_id, dark_mode) VALUES (731, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (732, 'suzukimaaya1', 'まんまるまんじゅう', '普段絵本作家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://suzukimaaya.example.com/\nメールアドレス: suzukimaaya@example.com\n', '$2a$04$nvAPnhFN3DbDGykNeY9u6e7uZx/RH8ID7JhwXIjNImjsPzZeTJQXu');
+INSERT INTO themes (user_id, dark_mode) VALUES (732, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (733, 'nakamurarei0', '梅雨餅', '普段公認会計士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://nakamurarei.example.com/\nメールアドレス: nakamurarei@example.com\n', '$2a$04$te4Lr4UAR1nJRlW.n17STuSR3HDjx1wCvnEKyQso4x/tlpWtmOtaa');
+INSERT INTO themes (user_id, dark_mode) VALUES (733, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (734, 'momokofujiwara0', 'あまあまあまずら', '普段エンジニア演奏家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://momokofujiwara.example.com/\nメールアドレス: momokofujiwara@example.com\n', '$2a$04$6CW3TBbvY3ja/vT.gfdDS.m0M1BmgxjZRE7Xyunk6BFDEkbqlaqn6');
+INSERT INTO themes (user_id, dark_mode) VALUES (734, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (735, 'yoko310', 'さくさくさくらんぼ', '普段小説家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yoko31.example.com/\nメールアドレス: yoko31@example.com\n', '$2a$04$dSbZPImycO340SYKNxZ/Q.Gwbz9g7hIJ7Cnddjqptv6Zmf6HVgyzC');
+INSERT INTO themes (user_id, dark_mode) VALUES (735, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (736, 'fujiwaramanabu0', 'ほおずきほたる', '普段電気工事士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://fujiwaramanabu.example.com/\nメールアドレス: fujiwaramanabu@example.com\n', '$2a$04$aGeuD2L8RycBjr3DqWbZUOs2w90DCowMfOFLpkW8sabWl1s3FmRoK');
+INSERT INTO themes (user_id, dark_mode) VALUES (736, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (737, 'takahashiosamu0', 'きらきらきんとき', '普段公認会計士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://takahashiosamu.example.com/\nメールアドレス: takahashiosamu@example.com\n', '$2a$04$jlMvCu6723IQhhKTEgwZWeRA0MoVuDp5Jm1TXkr4VYaunzWewwI0G');
+INSERT INTO themes (user_id, dark_mode) VALUES (737, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (738, 'nakamurakazuya0', '黄昏', '普段寿司職人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://nakamurakazuya.example.com/\nメールアドレス: nakamurakazuya@example.com\n', '$2a$04$P3AbZWSr0kefGE86Jzy.rOK3eTvN1XZA4R5igmI.qgmuj8gvV29J.');
+INSERT INTO themes (user_id, dark_mode) VALUES (738, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (739, 'nnakamura0', 'くうくうくうりもち', '普段映画監督をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://nnakamura.example.com/\nメールアドレス: nnakamura@example.com\n', '$2a$04$di0xhcmFLQYhttsk59.KIec.lYk2Pg2IzidWkst1sdYRjFPfIZE26');
+INSERT INTO themes (user_id, dark_mode) VALUES (739, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (740, 'akirasuzuki1', 'さとさとさとうきび', '普段大学教授をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://akirasuzuki.example.com/\nメールアドレス: akirasuzuki@example.com\n', '$2a$04$Kyjwam4jnpx3kgsHO2LI1uNeXehTyAOKx6bLS/P2vfUimriVhpxoi');
+INSERT INTO themes (user_id, dark_mode) VALUES (740, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (741, 'watanabenanami0', 'ゆずゆずゆずれもん', '普段高等学校教員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://watanabenanami.example.com/\nメールアドレス: watanabenanami@example.com\n', '$2a$04$cGmoHuamN4w.LvrIqU1mwO8BKvYlZbmxZQFGRmSDCMRAmRCXt1B7.');
+INSERT INTO themes (user_id, dark_mode) VALUES (741, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (742, 'rikaito0', 'ころころこまねこ', '普段漫画家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://rikaito.example.com/\nメールアドレス: rikaito@example.com\n', '$2a$04$YT65ydKjNDzNXvxK2C3NCeR2MNydvke77NqauyRGtknDidvbDng2W');
+INSERT INTO themes (user_id, dark_mode) VALUES (742, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (743, 'juntanaka0', 'ひとしずく', '普段演歌歌手をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://juntanaka.example.com/\nメールアドレス: juntanaka@example.com\n', '$2a$04$VcfyEdo7EZPDyAXcbt/f9.kJH2of1qAn1wbfMsQZ/WBfgN8JH4hLC');
+INSERT INTO themes (user_id, dark_mode) VALUES (743, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (744, 'tanakamiki0', 'はるはるはるかんきんこ', '普段介護ヘルパーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tanakamiki.example.com/\nメールアドレス: tanakamiki@example.com\n', '$2a$04$ycsZAgjpnNgA671FYbukYeT6LCor5Db/92e3gqRdJM6cYpaHam4K6');
+INSERT INTO themes (user_id, dark_mode) VALUES (744, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (745, 'ekato2', '古道', '普段経営者をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ekato.example.com/\nメールアドレス: ekato@example.com\n', '$2a$04$DJwI/7petpqGJCRmDSG5nuQnYchTl20az2rsgxu8Uc7wAImKveQCu');
+INSERT INTO themes (user_id, dark_mode) VALUES (745, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (746, 'xnishimura0', '菜の花', '普段絵本作家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://xnishimura.example.com/\nメールアドレス: xnishimura@example.com\n', '$2a$04$UwZb6IFm4tgS1pLvujdaV.sK3Gq9zN2IJyIuGjqtMyuSL/dZXfqjK');
+INSERT INTO themes (user_id, dark_mode) VALUES (746, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (747, 'ihayashi0', '銀の糸', '普段占い師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ihayashi.example.com/\nメールアドレス: ihayashi@example.com\n', '$2a$04$p.G7Rfg50hqn6oFyNHEFCesVkulf1gIMikz8y8uqA1MGDoswG8R7y');
+INSERT INTO themes (user_id, dark_mode) VALUES (747, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (748, 'atsushi921', '天使の微笑みマシュマロ', '普段配管工をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://atsushi92.example.com/\nメールアドレス: atsushi92@example.com\n', '$2a$04$PUrpyrjNpP70jWQyFIS90OhOzVk3PRTdtDSn97v5GlHG5//.Pc8ma');
+INSERT INTO themes (user_id, dark_mode) VALUES (748, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (749, 'mikakoishikawa0', '日中', '普段音響技術者をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://mikakoishikawa.example.com/\nメールアドレス: mikakoishikawa@example.com\n', '$2a$04$/xwDxJw0l7il8nerRB7Ey.zwvtCMT3HP8ha6Sil9l6ZNL8eYuTSd6');
+INSERT INTO themes (user_id, dark_mode) VALUES (749, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (750, 'junmatsuda0', 'あんこあんこかんき', '普段脚本家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://junmatsuda.example.com/\nメールアドレス: junmatsuda@example.com\n', '$2a$04$Bfs9ObF/qjudHK.wofdamOQpZWHt3yPiU2qsUEDwprjtPcPNYqGqu');
+INSERT INTO themes (user_id, dark_mode) VALUES (750, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (751, 'abejun0', 'いちごいちごかんきんこうせ', '普段航海士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://abejun.example.com/\nメールアドレス: abejun@example.com\n', '$2a$04$GWjJPRhvDfqpEvp8bp7dW.ug.BHMx9em4WL8BSRPuMXj/ylhr8TmW');
+INSERT INTO themes (user_id, dark_mode) VALUES (751, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (752, 'katoshota0', '夕闇', '普段アイドルをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://katoshota.example.com/\nメールアドレス: katoshota@example.com\n', '$2a$04$Wyg2OV0Eg7eSCnoFqtJLgujNnkduv2.4hrKlyiAANYCN0uIq/Df0O');
+INSERT INTO themes (user_id, dark_mode) VALUES (752, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (753, 'shotaishikawa0', '霰', '普段エンジニア演奏家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://shotaishikawa.example.com/\nメールアドレス: shotaishikawa@example.com\n', '$2a$04$t4O0rPbs5QVsxI9VFAXoyeWsJ/rHAdpFs4ChmoeOn4WStCBmHc9dC');
+INSERT INTO themes (user_id, dark_mode) VALUES (753, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (754, 'yukikimura0', '冬木立', '普段公認会計士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yukikimura.example.com/\nメールアドレス: yukikimura@example.com\n', '$2a$04$p555Qy6kgTbVo67k29l9VuWeqo1vPANms6He5BGOgn2ypqolyR3Re');
+INSERT INTO themes (user_id, dark_mode) VALUES (754, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (755, 'xishii0', 'むらさきむらさきもち', '普段ゲームクリエイターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://xishii.example.com/\nメールアドレス: xishii@example.com\n', '$2a$04$0puas75g4hIjKHehCQqrMe1GCX8szA3.I6oCpQ3.tK3kudFHCLcRO');
+INSERT INTO themes (user_id, dark_mode) VALUES (755, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (756, 'rei240', 'くうくうくうりもち', '普段アートディレクターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://rei24.example.com/\nメールアドレス: rei24@example.com\n', '$2a$04$Mfrba/tPNPbWeMHJ67dVG.mbMrbkI/J3QQrZpKr7H4.oYt.cWZ1Ui');
+INSERT INTO themes (user_id, dark_mode) VALUES (756, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (757, 'yutakobayashi0', '画布', '普段公務員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yutakobayashi.example.com/\nメールアドレス: yutakobayashi@example.com\n', '$2a$04$7ZWkDLISNMr//oWec9KFceiQBYZ6czBLle3kvgjL9ek5B/FA82wD6');
+INSERT INTO themes (user_id, dark_mode) VALUES (757, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (758, 'kazuya380', 'おまんまる', '普段イラストレーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kazuya38.example.com/\nメールアドレス: kazuya38@example.com\n', '$2a$04$TMA.ea7HW0wmcLE0vd6hQ.PsJ1mZ4niDzhZN1gi7yiWPoPnawgsrm');
+INSERT INTO themes (user_id, dark_mode) VALUES (758, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (759, 'nanamikobayashi0', 'ちんちんちんすこう', '普段アニメーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://nanamikobayashi.example.com/\nメールアドレス: nanamikobayashi@example.com\n', '$2a$04$807xAItPSriQOKMQ9iBW5OBfqwuWei.fJIhMJ3nlP9B9MX5yC/yo6');
+INSERT INTO themes (user_id, dark_mode) VALUES (759, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (760, 'zyamada0', 'ゆめゆめゆべし', '普段農家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://zyamada.example.com/\nメールアドレス: zyamada@example.com\n', '$2a$04$0PccloP90oBYVZSg1GLWFO0FJ7hRVaK6/NL2dFfEtFjoe8gUYiuN6');
+INSERT INTO themes (user_id, dark_mode) VALUES (760, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (761, 'inouehanako0', 'ゆずれいゆずもち', '普段和紙職人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://inouehanako.example.com/\nメールアドレス: inouehanako@example.com\n', '$2a$04$q3ocvbPjvd5Sp1MYZwHciOn3yzDEQWVkpxkeoDzywyHlJMQX8CmXq');
+INSERT INTO themes (user_id, dark_mode) VALUES (761, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (762, 'yutaota0', 'つぶつぶつぶやき', '普段イラストレーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yutaota.example.com/\nメールアドレス: yutaota@example.com\n', '$2a$04$C5kAIMdMDC9Fcap9LTzBK.4NEn8gIGZgPWzelYFLddPbIni7MZETG');
+INSERT INTO themes (user_id, dark_mode) VALUES (762, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (763, 'satomikato0', 'まみまみまみれもち', '普段アイドルをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://satomikato.example.com/\nメールアドレス: satomikato@example.com\n', '$2a$04$RbZ/5L2foJkNG2EgXMUblOgZ6xmEdxGTxQjZqyu4lCaqGR9yuCr3y');
+INSERT INTO themes (user_id, dark_mode) VALUES (763, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (764, 'yoshidayasuhiro0', 'そばそばそばかす', '普段航海士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yoshidayasuhiro.example.com/\nメールアドレス: yoshidayasuhiro@example.com\n', '$2a$04$DpI07.7ql.45WjIfl28LP.8nI005.krxyHLudkIdX0CKmcW4OCh6m');
+INSERT INTO themes (user_id, dark_mode) VALUES (764, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (765, 'akemiikeda0', 'きんきんきんもち', '普段経営者をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://akemiikeda.example.com/\nメールアドレス: akemiikeda@example.com\n', '$2a$04$.GkE/OUCwvZ2gheBHs7q4uOf/YMfAj9PNpYn6jCwrOEx16dspw2qa');
+INSERT INTO themes (user_id, dark_mode) VALUES (765, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (766, 'yoichikobayashi0', '温もり', '普段運転士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yoichikobayashi.example.com/\nメールアドレス: yoichikobayashi@example.com\n', '$2a$04$3EUNF8B0yBBbGUWMH0jo1.2p.tlXVGi3Q3cdvU8rJuHgmJo4QwOQG');
+INSERT INTO themes (user_id, dark_mode) VALUES (766, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (767, 'msuzuki0', 'ねんねんねんりん', '普段映画監督をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://msuzuki.example.com/\nメールアドレス: msuzuki@example.com\n', '$2a$04$s.JWzrfBPfrBq5gyPL7sv.ldvADiNe2US9iKWtL.qY5qm1zZeAFge');
+INSERT INTO themes (user_id, dark_mode) VALUES (767, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (768, 'ssato0', '花紫陽花', '普段薬剤師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ssato.example.com/\nメールアドレス: ssato@example.com\n', '$2a$04$WE0FHGKNQ0HAEBZ8Xi9NDe32IIIyjTv9X3yuBQhvbS5U9BrylEMEW');
+INSERT INTO themes (user_id, dark_mode) VALUES (768, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (769, 'qnakamura0', 'ほたるほたるいか', '普段アナウンサーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://qnakamura.example.com/\nメールアドレス: qnakamura@example.com\n', '$2a$04$NTzkO97hOWpI1U3mx.odt.LreHylHcR9RjZkBPhSqbnJ.U6jOhI2a');
+INSERT INTO themes (user_id, dark_mode) VALUES (769, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (770, 'ryoheikobayashi0', 'まんまるまめだいふく', '普段農家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ryoheikobayashi.example.com/\nメールアドレス: ryoheikobayashi@example.com\n', '$2a$04$Z27Z/RwqmDnOXnXFiqbNBOZN.D.GqX0VV/AZQUgUQ7kqfA5MMBn7m');
+INSERT INTO themes (user_id, dark_mode) VALUES (770, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (771, 'naoki880', 'つまつまつまみ', '普段ウェブデザイナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://naoki88.example.com/\nメールアドレス: naoki88@example.com\n', '$2a$04$KMktrTFWS6MIiy9InssireRwhpPrhWbgjy0eQqyoNIKaboUvqJ6.K');
+INSERT INTO themes (user_id, dark_mode) VALUES (771, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (772, 'sakamotosotaro0', '銀河あめ', '普段ゲームクリエイターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://sakamotosotaro.example.com/\nメールアドレス: sakamotosotaro@example.com\n', '$2a$04$xpe.xozmfHv7Z/2jNRuaee1bD8IXeEi4WDXM4zF76kcJ0UCZK0aLW');
+INSERT INTO themes (user_id, dark_mode) VALUES (772, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (773, 'haruka350', '時雨ドロップ', '普段栄養士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://haruka35.example.com/\nメールアドレス: haruka35@example.com\n', '$2a$04$5CnWWFdt4IauwyC4BjqTtuDrLiQ7Jkvi1MkaOiojVvQ1vMQER.p.W');
+INSERT INTO themes (user_id, dark_mode) VALUES (773, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (774, 'satonaoki0', 'くりくりくりこ', '普段占い師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://satonaoki.example.com/\nメールアドレス: satonaoki@example.com\n', '$2a$04$GO61jM8wsg0iiiPC6KWmZe05mDmajliBl0GtxRLCnK2eq5Mo/.C/O');
+INSERT INTO themes (user_id, dark_mode) VALUES (774, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (775, 'jun430', 'うるうるうるまき', '普段コピーライターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://jun43.example.com/\nメールアドレス: jun43@example.com\n', '$2a$04$nBWPPUXpkUpHWWZ7kJYR2OLxhYsiLuAVeDsDCO1WcQZEQ3Q8O9jFC');
+INSERT INTO themes (user_id, dark_mode) VALUES (775, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (776, 'sasakimai0', '常磐', '普段行政書士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://sasakimai.example.com/\nメールアドレス: sasakimai@example.com\n', '$2a$04$T5oIWCDo9kr3TdZy8Ek1TegBsy3rzF0u/9HrJex1uRdED8xwE8Hhy');
+INSERT INTO themes (user_id, dark_mode) VALUES (776, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (777, 'naoko880', 'はるはるはるえ', '普段コピーライターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://naoko88.example.com/\nメールアドレス: naoko88@example.com\n', '$2a$04$uyoIa6HiiwtLEadlWJBcLeWrQ02LtC9hBtKy99UWDMr1fekNacxBS');
+INSERT INTO themes (user_id, dark_mode) VALUES (777, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (778, 'rikakobayashi1', 'かわいいかりんとう', '普段YouTuberをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://rikakobayashi.example.com/\nメールアドレス: rikakobayashi@example.com\n', '$2a$04$wNDY/crOYymoNs8G1gyRNO36/YSWS/aL8Mcv.h2nDm9..TFoJ7hCa');
+INSERT INTO themes (user_id, dark_mode) VALUES (778, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (779, 'nanamikondo0', '古道', '普段音楽家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://nanamikondo.example.com/\nメールアドレス: nanamikondo@example.com\n', '$2a$04$kajn7WCr5lgnPy73ZudHxOy.XPkhEMTCPwIW.ANUhrqTViKKrWwbm');
+INSERT INTO themes (user_id, dark_mode) VALUES (779, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (780, 'yoshidamai1', 'さくらんぼすもも', '普段公務員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yoshidamai.example.com/\nメールアドレス: yoshidamai@example.com\n', '$2a$04$EhXqaOrq7tGcR61FKb1vxOVNSeLubLpFoRJDXxERMvUQZH5a.tT/q');
+INSERT INTO themes (user_id, dark_mode) VALUES (780, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (781, 'nakajimarei0', 'たけたけたけびし', '普段経営者をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://nakajimarei.example.com/\nメールアドレス: nakajimarei@example.com\n', '$2a$04$5Lh81d1JW1AeHNueEENUVumXWpa6qhU.QRn0YJ1XFWRWg69Tc4PkG');
+INSERT INTO themes (user_id, dark_mode) VALUES (781, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (782, 'morimanabu0', 'ぴよぴよぴっち', '普段経営者をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://morimanabu.example.com/\nメールアドレス: morimanabu@example.com\n', '$2a$04$uj2kC5wJNTrXKzFGTBfdhOKC.DYwJ6tejgVWdkZbzVXp/wY7AHW6m');
+INSERT INTO themes (user_id, dark_mode) VALUES (782, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (783, 'tanakasayuri0', 'つぶつぶつぶあん', '普段医師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tanakasayuri.example.com/\nメールアドレス: tanakasayuri@example.com\n', '$2a$04$mOWpE7fPGGnKrMDLz0o71u6FPNfIu/60SvKe9CNTKAAQ4cGd48a1m');
+INSERT INTO themes (user_id, dark_mode) VALUES (783, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (784, 'momoko040', '明け方', '普段イラストレーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://momoko04.example.com/\nメールアドレス: momoko04@example.com\n', '$2a$04$evS0vE3fQ4gtple5.lY6BOatdNX6jKG6eQlpbRtYQDNBQxbVRoiFW');
+INSERT INTO themes (user_id, dark_mode) VALUES (784, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (785, 'kaori580', 'ひとやすみひとしずく', '普段音響技術者をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kaori58.example.com/\nメールアドレス: kaori58@example.com\n', '$2a$04$4g7GzmasnxaXMkyaniG3AuuyP/TS0sq1x5oTi9KphY.59juebN51W');
+INSERT INTO themes (user_id, dark_mode) VALUES (785, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (786, 'haruka100', 'すももすももち', '普段脚本家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://haruka10.example.com/\nメールアドレス: haruka10@example.com\n', '$2a$04$q20IW8IrwmTn9BWDq349ROYis2Tr3iQ3dvagCKd21VSKSlB1bLNla');
+INSERT INTO themes (user_id, dark_mode) VALUES (786, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (787, 'akemi600', 'いちごいちえ', '普段小説家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://akemi60.example.com/\nメールアドレス: akemi60@example.com\n', '$2a$04$W1M2RwbskgN4Xcj3SfoNreWw4p6TbM7cbhQHE5vxbDxQDEefwUxny');
+INSERT INTO themes (user_id, dark_mode) VALUES (787, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (788, 'miki170', 'たねたねたねもち', '普段絵本作家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://miki17.example.com/\nメールアドレス: miki17@example.com\n', '$2a$04$15IR25zhI4AmeMXNpzRC9eXFostWbJzQ0AkT7qD4.E5VvgwVIeKJq');
+INSERT INTO themes (user_id, dark_mode) VALUES (788, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (789, 'okamotokyosuke0', 'めろんめろんケーキ', '普段バスガイドをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://okamotokyosuke.example.com/\nメールアドレス: okamotokyosuke@example.com\n', '$2a$04$34kAlIoUPVRvakcqe/xy8.YXw0R3Xq6c91qsch0rfR/MfiBTNkaTi');
+INSERT INTO themes (user_id, dark_mode) VALUES (789, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (790, 'kondonaoto0', 'さくらさくらんぼ', '普段小説家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kondonaoto.example.com/\nメールアドレス: kondonaoto@example.com\n', '$2a$04$lAp9YLJjs/1DljHgTubJBuL5r/.2NZDMnTylFpYBsdYeaznUcDkJ.');
+INSERT INTO themes (user_id, dark_mode) VALUES (790, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (791, 'tsubasa610', 'さくらんさくらんぼ', '普段栄養士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tsubasa61.example.com/\nメールアドレス: tsubasa61@example.com\n', '$2a$04$ywZmBv6LQYbWGpA3yxaMcOIEQn1G.czkAd5uEK7fTeq3lfoaMoVLW');
+INSERT INTO themes (user_id, dark_mode) VALUES (791, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (792, 'ryoheiyamada0', 'にじにじにじせんべい', '普段アナウンサーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ryoheiyamada.example.com/\nメールアドレス: ryoheiyamada@example.com\n', '$2a$04$w5.MtTa6RDKEbD9j7XnUnOAYBcpug2XAVrjLgFg3wrlRHppbO52Gy');
+INSERT INTO themes (user_id, dark_mode) VALUES (792, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (793, 'naoki090', 'さくらんさくらかんきんこうせ', '普段イラストレーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://naoki09.example.com/\nメールアドレス: naoki09@example.com\n', '$2a$04$0z1ni/zkE6a9Sfsaq3vb6O.Xma1va8H3h5/IfFVBH0MxrUI.OZRNm');
+INSERT INTO themes (user_id, dark_mode) VALUES (793, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (794, 'yamazakimikako0', '花びら', '普段調理師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yamazakimikako.example.com/\nメールアドレス: yamazakimikako@example.com\n', '$2a$04$f1BJVM5MCc6MpOOEbCtJGOI8xY3Alas0Ov8fVNuYej8b1idv59cCW');
+INSERT INTO themes (user_id, dark_mode) VALUES (794, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (795, 'minorusato0', 'わかわかわかもち', '普段公務員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://minorusato.example.com/\nメールアドレス: minorusato@example.com\n', '$2a$04$JNkWqunO87FabYimlZkUqOyC02iDIbfQB35m.PKW7N/x2U8uqCEEW');
+INSERT INTO themes (user_id, dark_mode) VALUES (795, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (796, 'chiyofujii0', 'めろんめろんケーキ', '普段YouTuberをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://chiyofujii.example.com/\nメールアドレス: chiyofujii@example.com\n', '$2a$04$cyjbSBfNcFM.VG2ovtnqg.xlmhsOaEy.yygmJ7hlNIM2STJ4Wgh5.');
+INSERT INTO themes (user_id, dark_mode) VALUES (796, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (797, 'takuma300', '水晶寒天', '普段絵本作家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://takuma30.example.com/\nメールアドレス: takuma30@example.com\n', '$2a$04$qziCjx2Kh1JpW/bYzjKFc.v7jrvki3LJjpAxZqnlRJN.qn.NBT492');
+INSERT INTO themes (user_id, dark_mode) VALUES (797, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (798, 'taroyamamoto0', '神秘の森', '普段測量士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://taroyamamoto.example.com/\nメールアドレス: taroyamamoto@example.com\n', '$2a$04$6/rZQ./QnEYIa/XCChrIlOtQ.62Z0ZSRmWR27AmuY43XKPXcn8UmW');
+INSERT INTO themes (user_id, dark_mode) VALUES (798, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (799, 'suzukichiyo0', '金銀糖子', '普段エンジニア演奏家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://suzukichiyo.example.com/\nメールアドレス: suzukichiyo@example.com\n', '$2a$04$4R3oaiwgL3jFDx4XkSPgWeK.uk35cdQm.wKhYVFSFMREEtEUtuPZu');
+INSERT INTO themes (user_id, dark_mode) VALUES (799, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (800, 'wishikawa0', 'きんぎょきんつば', '普段経営者をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://wishikawa.example.com/\nメールアドレス: wishikawa@example.com\n', '$2a$04$/v16fIbxYBiHvtEmtjgydeJ/fUI2H0OhCgNdTReh5WZUtHYvubDDi');
+INSERT INTO themes (user_id, dark_mode) VALUES (800, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (801, 'reinakamura0', '午後', '普段経営者をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://reinakamura.example.com/\nメールアドレス: reinakamura@example.com\n', '$2a$04$0BRufcJy.4FPaIDjWxsKz.5JBQQMoGZzVniBFU0s7jaC.8pHSiuaK');
+INSERT INTO themes (user_id, dark_mode) VALUES (801, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (802, 'ayamazaki1', '風流', '普段建築家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ayamazaki.example.com/\nメールアドレス: ayamazaki@example.com\n', '$2a$04$GvL4Wts8RiOULOE9qAYZWeX6pqOaW9F3MoWrqiAtMt3K.tepN85Xq');
+INSERT INTO themes (user_id, dark_mode) VALUES (802, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (803, 'cogawa0', '風鈴', '普段経営者をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://cogawa.example.com/\nメールアドレス: cogawa@example.com\n', '$2a$04$OHdW6thFVfbi6tPhy5lXGeiFAShOFDz0xFVG2iSjM0yZ.jnAa0Uqq');
+INSERT INTO themes (user_id, dark_mode) VALUES (803, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (804, 'hasegawamituru0', 'まみまみまみれもち', '普段医師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hasegawamituru.example.com/\nメールアドレス: hasegawamituru@example.com\n', '$2a$04$0PulOoA5M9qCiCU4oL0O1OqnrGD./.dGLQPwuTf9SfioljG1ImG7.');
+INSERT INTO themes (user_id, dark_mode) VALUES (804, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (805, 'cyamaguchi1', 'ぽんぽんぽんかん', '普段ウェディングプランナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://cyamaguchi.example.com/\nメールアドレス: cyamaguchi@example.com\n', '$2a$04$lBKwG4y37PBniflzaf/iY.9KYWo2ZBd6JSfvgT9UG0XA80uSShAJW');
+INSERT INTO themes (user_id, dark_mode) VALUES (805, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (806, 'hasegawamomoko0', 'まりまりまりもち', '普段アーティストをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hasegawamomoko.example.com/\nメールアドレス: hasegawamomoko@example.com\n', '$2a$04$pEqzGw.XCz0voYRH3QMJTO59V1WsTacBbGb/K2UETzzAEqaz6xU96');
+INSERT INTO themes (user_id, dark_mode) VALUES (806, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (807, 'suzukishohei0', 'かりんとうかりん', '普段漫画家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://suzukishohei.example.com/\nメールアドレス: suzukishohei@example.com\n', '$2a$04$j./lZgNj/fSYZqg7O7BDue3T7Ijeun6VVUizLRGFMheTUMIqcYK2y');
+INSERT INTO themes (user_id, dark_mode) VALUES (807, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (808, 'qyamazaki0', 'さくらんぼもなか', '普段占い師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://qyamazaki.example.com/\nメールアドレス: qyamazaki@example.com\n', '$2a$04$sLLGF.m9gbfbm5pTeUjpvuW89BW.yNEylciEbeGzsacMmbCN1DnSC');
+INSERT INTO themes (user_id, dark_mode) VALUES (808, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (809, 'kyosuke370', 'すわりすわりん', '普段大学教授をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kyosuke37.example.com/\nメールアドレス: kyosuke37@example.com\n', '$2a$04$uu1XhQxhkzIJsJ7Tzw8qfuH/wivo4hkEYkFKOP0xKulBk2nxZPEqS');
+INSERT INTO themes (user_id, dark_mode) VALUES (809, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (810, 'mgoto0', '雪月夜', '普段和紙職人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://mgoto.example.com/\nメールアドレス: mgoto@example.com\n', '$2a$04$qUPj6ngqW37MzPM4LsJ7QOBsEW7qF0fUVyE4T3QJYH4AKVozbNC9q');
+INSERT INTO themes (user_id, dark_mode) VALUES (810, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (811, 'yasuhiroogawa0', 'こはるきりもち', '普段農家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yasuhiroogawa.example.com/\nメールアドレス: yasuhiroogawa@example.com\n', '$2a$04$ah/ZrA65mvjSG9M2zRSUcegW1d1d.lzyMkKl0leUmnkmTEBGogPp.');
+INSERT INTO themes (user_id, dark_mode) VALUES (811, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (812, 'maaya250', 'つつじつつじつぶ', '普段気象予報士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://maaya25.example.com/\nメールアドレス: maaya25@example.com\n', '$2a$04$sdAySrC/p9LWk5J.r5LM.udKeDM02c0eSXn3GFCrJ0uMUOmDlQDy2');
+INSERT INTO themes (user_id, dark_mode) VALUES (812, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (813, 'kimurayumiko0', 'たらたらたんやく', '普段アーティストをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kimurayumiko.example.com/\nメールアドレス: kimurayumiko@example.com\n', '$2a$04$uHf5EFSsbhKGqYandZv/Z.kwJ.UHjKBL5qD9hr8us.PEoIZTxNQrq');
+INSERT INTO themes (user_id, dark_mode) VALUES (813, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (814, 'yoko460', '花紫陽花', '普段モデルをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yoko46.example.com/\nメールアドレス: yoko46@example.com\n', '$2a$04$2HuLJJa1611votr5CHKX/OS3xK1fAREGgbmLKSWo4Ewf5bzDGvCwi');
+INSERT INTO themes (user_id, dark_mode) VALUES (814, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (815, 'atsushiyamaguchi0', '月夜花', '普段エステティシャンをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://atsushiyamaguchi.example.com/\nメールアドレス: atsushiyamaguchi@example.com\n', '$2a$04$LTNRQBExFHY8lQCn44lo/ehB/NRpBIpddcfgIVLGGUYtvJmzJ3/9O');
+INSERT INTO themes (user_id, dark_mode) VALUES (815, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (816, 'yamadaasuka0', '金木犀', '普段和紙職人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yamadaasuka.example.com/\nメールアドレス: yamadaasuka@example.com\n', '$2a$04$vJVvUq12hZbehFmPzP.c8OnRsuSErg/5.1dLO7hq3pPFlvIQgaobu');
+INSERT INTO themes (user_id, dark_mode) VALUES (816, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (817, 'wyamaguchi0', '然然', '普段公認会計士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://wyamaguchi.example.com/\nメールアドレス: wyamaguchi@example.com\n', '$2a$04$zoGEpfPL0Ypk8OengyTkvuOdM0QVy30SWsY1C7y1aBvWBICJYu51y');
+INSERT INTO themes (user_id, dark_mode) VALUES (817, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (818, 'katoyuta0', 'てんてんたい焼き', '普段花火師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://katoyuta.example.com/\nメールアドレス: katoyuta@example.com\n', '$2a$04$NFEv49zphsEdwCNLi6QYz.FbSSEyNqGuIsJ9hNj.nbXE5Z624plBm');
+INSERT INTO themes (user_id, dark_mode) VALUES (818, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (819, 'yoichi660', 'うるうるうめ', '普段調理師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yoichi66.example.com/\nメールアドレス: yoichi66@example.com\n', '$2a$04$mAbZO/nJE.f8CjUSmm/IE.hqbx6XvnWlJmbNNi0ikU4p4mRcSDfuy');
+INSERT INTO themes (user_id, dark_mode) VALUES (819, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (820, 'lkato0', '月夜花', '普段測量士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://lkato.example.com/\nメールアドレス: lkato@example.com\n', '$2a$04$aOtXqzAbj22O492SA6pp4euu5zHmRfxxNoG.Yp4qQf9O2NiKpmVJu');
+INSERT INTO themes (user_id, dark_mode) VALUES (820, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (821, 'tomoyatakahashi0', 'くうくうくうりもち', '普段薬剤師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tomoyatakahashi.example.com/\nメールアドレス: tomoyatakahashi@example.com\n', '$2a$04$/0I5Z3FbgSF8C7k8qSfp3.ytDI6zVKZQ0u1qb4OeREpHxQzl6CTFW');
+INSERT INTO themes (user_id, dark_mode) VALUES (821, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (822, 'rika370', 'つむつむつむぎもち', '普段演歌歌手をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://rika37.example.com/\nメールアドレス: rika37@example.com\n', '$2a$04$b9YqfFmp0r1WI.OGkygre./9HgRDPIFojEZZerozLRkDmQI1Fgz9a');
+INSERT INTO themes (user_id, dark_mode) VALUES (822, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (823, 'inouenaoto0', 'きりきりきりふく', '普段気象予報士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://inouenaoto.example.com/\nメールアドレス: inouenaoto@example.com\n', '$2a$04$kdb7klvhAf6bAf.prygvoungTlvAxg6KZkBOYyo4W6Zi3sU3I495m');
+INSERT INTO themes (user_id, dark_mode) VALUES (823, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (824, 'kondoshota0', 'ももももち', '普段大学教授をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kondoshota.example.com/\nメールアドレス: kondoshota@example.com\n', '$2a$04$AXKaWtL0qEK/8xW.80xPMey9ariOJJgDcAxIsh9/aZ0fp7NSIC8cq');
+INSERT INTO themes (user_id, dark_mode) VALUES (824, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (825, 'taro820', 'つまつまつまみ', '普段公認会計士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://taro82.example.com/\nメールアドレス: taro82@example.com\n', '$2a$04$rJ7mfRo9Aw9VW5mcgkH/se52xwvhBDUVDZEKjsrpxkUsp5ITv2zOS');
+INSERT INTO themes (user_id, dark_mode) VALUES (825, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (826, 'maisasaki0', 'うさぎうさぎうさぎもち', '普段行政書士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://maisasaki.example.com/\nメールアドレス: maisasaki@example.com\n', '$2a$04$xPHuVYa.1gExp.Xh3kUESOhgaBBHfbTwutEYI8rrOzwOIWM.xLHg.');
+INSERT INTO themes (user_id, dark_mode) VALUES (826, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (827, 'gyoshida1', 'むしむしむしようかん', '普段絵本作家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://gyoshida.example.com/\nメールアドレス: gyoshida@example.com\n', '$2a$04$se4XG.MHrkFFuSXB/aSX1uNxqY0LdYnetRSqOvTrrgFtuB9UP84TK');
+INSERT INTO themes (user_id, dark_mode) VALUES (827, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (828, 'manabu770', 'ももももももじ', '普段エステティシャンをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://manabu77.example.com/\nメールアドレス: manabu77@example.com\n', '$2a$04$As9ZsAB/qUV3RHXTPW0LDOecwR.GqPIhbBHyXmgTHxVOE3xE/8G8i');
+INSERT INTO themes (user_id, dark_mode) VALUES (828, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (829, 'matsudayoichi0', '夏木立', '普段営業をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://matsudayoichi.example.com/\nメールアドレス: matsudayoichi@example.com\n', '$2a$04$a2FyUeWJPpgE//4QaPNUSePy8ie9/KM46MavQwzvTx32r05ZE2vPC');
+INSERT INTO themes (user_id, dark_mode) VALUES (829, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (830, 'nakajimamiki0', '香風', '普段音響技術者をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://nakajimamiki.example.com/\nメールアドレス: nakajimamiki@example.com\n', '$2a$04$1eF6i15wMQvGByE78X53Su7GiDOGKGBGtLuESNq.QS5YwXsICaIx6');
+INSERT INTO themes (user_id, dark_mode) VALUES (830, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (831, 'watanabeyoko0', 'つぶつぶたんぽぽ', '普段アーティストをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://watanabeyoko.example.com/\nメールアドレス: watanabeyoko@example.com\n', '$2a$04$dAHk5cZpQrHAyj1mw5.HW.W15pHZm5nwY0uPmN/4hknber.FNS.m.');
+INSERT INTO themes (user_id, dark_mode) VALUES (831, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (832, 'vwatanabe1', 'ぽんぽんぽんかん', '普段コピーライターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://vwatanabe.example.com/\nメールアドレス: vwatanabe@example.com\n', '$2a$04$nvAPnhFN3DbDGykNeY9u6e7uZx/RH8ID7JhwXIjNImjsPzZeTJQXu');
+INSERT INTO themes (user_id, dark_mode) VALUES (832, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (833, 'yamaguchinaoto0', '紅白花餅', '普段電気工事士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yamaguchinaoto.example.com/\nメールアドレス: yamaguchinaoto@example.com\n', '$2a$04$te4Lr4UAR1nJRlW.n17STuSR3HDjx1wCvnEKyQso4x/tlpWtmOtaa');
+INSERT INTO themes (user_id, dark_mode) VALUES (833, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (834, 'saitojun0', 'すやすやせんべい', '普段公認会計士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://saitojun.example.com/\nメールアドレス: saitojun@example.com\n', '$2a$04$6CW3TBbvY3ja/vT.gfdDS.m0M1BmgxjZRE7Xyunk6BFDEkbqlaqn6');
+INSERT INTO themes (user_id, dark_mode) VALUES (834, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (835, 'naotomori0', '甘露', '普段高等学校教員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://naotomori.example.com/\nメールアドレス: naotomori@example.com\n', '$2a$04$dSbZPImycO340SYKNxZ/Q.Gwbz9g7hIJ7Cnddjqptv6Zmf6HVgyzC');
+INSERT INTO themes (user_id, dark_mode) VALUES (835, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (836, 'lito1', '昼', '普段歯科医師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://lito.example.com/\nメールアドレス: lito@example.com\n', '$2a$04$aGeuD2L8RycBjr3DqWbZUOs2w90DCowMfOFLpkW8sabWl1s3FmRoK');
+INSERT INTO themes (user_id, dark_mode) VALUES (836, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (837, 'ryoheiikeda0', 'ふくろうのふくれんじ', '普段農家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ryoheiikeda.example.com/\nメールアドレス: ryoheiikeda@example.com\n', '$2a$04$jlMvCu6723IQhhKTEgwZWeRA0MoVuDp5Jm1TXkr4VYaunzWewwI0G');
+INSERT INTO themes (user_id, dark_mode) VALUES (837, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (838, 'yuisasaki0', 'くすくすくりん', '普段ゲームクリエイターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yuisasaki.example.com/\nメールアドレス: yuisasaki@example.com\n', '$2a$04$P3AbZWSr0kefGE86Jzy.rOK3eTvN1XZA4R5igmI.qgmuj8gvV29J.');
+INSERT INTO themes (user_id, dark_mode) VALUES (838, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (839, 'yishikawa0', 'ふんわりふんわ', '普段建築家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yishikawa.example.com/\nメールアドレス: yishikawa@example.com\n', '$2a$04$di0xhcmFLQYhttsk59.KIec.lYk2Pg2IzidWkst1sdYRjFPfIZE26');
+INSERT INTO themes (user_id, dark_mode) VALUES (839, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (840, 'yamazakimituru0', '梅しぐれ', '普段検察官をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yamazakimituru.example.com/\nメールアドレス: yamazakimituru@example.com\n', '$2a$04$Kyjwam4jnpx3kgsHO2LI1uNeXehTyAOKx6bLS/P2vfUimriVhpxoi');
+INSERT INTO themes (user_id, dark_mode) VALUES (840, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (841, 'yumiko050', 'こころ', '普段ウェディングプランナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yumiko05.example.com/\nメールアドレス: yumiko05@example.com\n', '$2a$04$cGmoHuamN4w.LvrIqU1mwO8BKvYlZbmxZQFGRmSDCMRAmRCXt1B7.');
+INSERT INTO themes (user_id, dark_mode) VALUES (841, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (842, 'okadasayuri0', 'うめうめうめぼしもち', '普段エステティシャンをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://okadasayuri.example.com/\nメールアドレス: okadasayuri@example.com\n', '$2a$04$YT65ydKjNDzNXvxK2C3NCeR2MNydvke77NqauyRGtknDidvbDng2W');
+INSERT INTO themes (user_id, dark_mode) VALUES (842, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (843, 'skobayashi0', '霰', '普段アーティストをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://skobayashi.example.com/\nメールアドレス: skobayashi@example.com\n', '$2a$04$VcfyEdo7EZPDyAXcbt/f9.kJH2of1qAn1wbfMsQZ/WBfgN8JH4hLC');
+INSERT INTO themes (user_id, dark_mode) VALUES (843, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (844, 'rika740', '空色わらび', '普段ゲームクリエイターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://rika74.example.com/\nメールアドレス: rika74@example.com\n', '$2a$04$ycsZAgjpnNgA671FYbukYeT6LCor5Db/92e3gqRdJM6cYpaHam4K6');
+INSERT INTO themes (user_id, dark_mode) VALUES (844, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (845, 'yoko600', 'あまあまあんこ', '普段花火師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yoko60.example.com/\nメールアドレス: yoko60@example.com\n', '$2a$04$DJwI/7petpqGJCRmDSG5nuQnYchTl20az2rsgxu8Uc7wAImKveQCu');
+INSERT INTO themes (user_id, dark_mode) VALUES (845, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (846, 'wsato0', '清流', '普段医療事務員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://wsato.example.com/\nメールアドレス: wsato@example.com\n', '$2a$04$UwZb6IFm4tgS1pLvujdaV.sK3Gq9zN2IJyIuGjqtMyuSL/dZXfqjK');
+INSERT INTO themes (user_id, dark_mode) VALUES (846, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (847, 'taichi181', 'ふんわりおはぎ', '普段行政書士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://taichi18.example.com/\nメールアドレス: taichi18@example.com\n', '$2a$04$p.G7Rfg50hqn6oFyNHEFCesVkulf1gIMikz8y8uqA1MGDoswG8R7y');
+INSERT INTO themes (user_id, dark_mode) VALUES (847, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (848, 'hiroshi800', '雲海', '普段お笑い芸人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hiroshi80.example.com/\nメールアドレス: hiroshi80@example.com\n', '$2a$04$PUrpyrjNpP70jWQyFIS90OhOzVk3PRTdtDSn97v5GlHG5//.Pc8ma');
+INSERT INTO themes (user_id, dark_mode) VALUES (848, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (849, 'yoko420', 'かえるのかりんとう', '普段アーティストをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yoko42.example.com/\nメールアドレス: yoko42@example.com\n', '$2a$04$/xwDxJw0l7il8nerRB7Ey.zwvtCMT3HP8ha6Sil9l6ZNL8eYuTSd6');
+INSERT INTO themes (user_id, dark_mode) VALUES (849, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (850, 'naokokondo0', 'いちいちいちじく', '普段司法書士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://naokokondo.example.com/\nメールアドレス: naokokondo@example.com\n', '$2a$04$Bfs9ObF/qjudHK.wofdamOQpZWHt3yPiU2qsUEDwprjtPcPNYqGqu');
+INSERT INTO themes (user_id, dark_mode) VALUES (850, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (851, 'sakamotorika0', 'ちょこれーとちょう', '普段お笑い芸人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://sakamotorika.example.com/\nメールアドレス: sakamotorika@example.com\n', '$2a$04$GWjJPRhvDfqpEvp8bp7dW.ug.BHMx9em4WL8BSRPuMXj/ylhr8TmW');
+INSERT INTO themes (user_id, dark_mode) VALUES (851, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (852, 'hiroshiyoshida0', 'さくさくさくらもち', '普段花火師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hiroshiyoshida.example.com/\nメールアドレス: hiroshiyoshida@example.com\n', '$2a$04$Wyg2OV0Eg7eSCnoFqtJLgujNnkduv2.4hrKlyiAANYCN0uIq/Df0O');
+INSERT INTO themes (user_id, dark_mode) VALUES (852, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (853, 'manabusato0', '美玉', '普段アニメーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://manabusato.example.com/\nメールアドレス: manabusato@example.com\n', '$2a$04$t4O0rPbs5QVsxI9VFAXoyeWsJ/rHAdpFs4ChmoeOn4WStCBmHc9dC');
+INSERT INTO themes (user_id, dark_mode) VALUES (853, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (854, 'rei340', 'こころ', '普段アートディレクターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://rei34.example.com/\nメールアドレス: rei34@example.com\n', '$2a$04$p555Qy6kgTbVo67k29l9VuWeqo1vPANms6He5BGOgn2ypqolyR3Re');
+INSERT INTO themes (user_id, dark_mode) VALUES (854, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (855, 'jsato1', '銀杏', '普段配管工をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://jsato.example.com/\nメールアドレス: jsato@example.com\n', '$2a$04$0puas75g4hIjKHehCQqrMe1GCX8szA3.I6oCpQ3.tK3kudFHCLcRO');
+INSERT INTO themes (user_id, dark_mode) VALUES (855, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (856, 'satomikako0', 'ゆめゆめゆべし', '普段営業をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://satomikako.example.com/\nメールアドレス: satomikako@example.com\n', '$2a$04$Mfrba/tPNPbWeMHJ67dVG.mbMrbkI/J3QQrZpKr7H4.oYt.cWZ1Ui');
+INSERT INTO themes (user_id, dark_mode) VALUES (856, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (857, 'murakamihiroshi0', '未来', '普段医療事務員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://murakamihiroshi.example.com/\nメールアドレス: murakamihiroshi@example.com\n', '$2a$04$7ZWkDLISNMr//oWec9KFceiQBYZ6czBLle3kvgjL9ek5B/FA82wD6');
+INSERT INTO themes (user_id, dark_mode) VALUES (857, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (858, 'asukaishii0', '梅雷', '普段アニメーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://asukaishii.example.com/\nメールアドレス: asukaishii@example.com\n', '$2a$04$TMA.ea7HW0wmcLE0vd6hQ.PsJ1mZ4niDzhZN1gi7yiWPoPnawgsrm');
+INSERT INTO themes (user_id, dark_mode) VALUES (858, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (859, 'kana550', 'やわらかやわらび', '普段建築家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kana55.example.com/\nメールアドレス: kana55@example.com\n', '$2a$04$807xAItPSriQOKMQ9iBW5OBfqwuWei.fJIhMJ3nlP9B9MX5yC/yo6');
+INSERT INTO themes (user_id, dark_mode) VALUES (859, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (860, 'fyamaguchi1', 'ふくふくふくらんで', '普段音楽家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://fyamaguchi.example.com/\nメールアドレス: fyamaguchi@example.com\n', '$2a$04$0PccloP90oBYVZSg1GLWFO0FJ7hRVaK6/NL2dFfEtFjoe8gUYiuN6');
+INSERT INTO themes (user_id, dark_mode) VALUES (860, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (861, 'miki190', 'もももももち', '普段アートディレクターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://miki19.example.com/\nメールアドレス: miki19@example.com\n', '$2a$04$q3ocvbPjvd5Sp1MYZwHciOn3yzDEQWVkpxkeoDzywyHlJMQX8CmXq');
+INSERT INTO themes (user_id, dark_mode) VALUES (861, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (862, 'dkimura0', 'ふなふなふなむし', '普段アニメーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://dkimura.example.com/\nメールアドレス: dkimura@example.com\n', '$2a$04$C5kAIMdMDC9Fcap9LTzBK.4NEn8gIGZgPWzelYFLddPbIni7MZETG');
+INSERT INTO themes (user_id, dark_mode) VALUES (862, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (863, 'ynakamura0', '冬木立', '普段薬剤師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ynakamura.example.com/\nメールアドレス: ynakamura@example.com\n', '$2a$04$RbZ/5L2foJkNG2EgXMUblOgZ6xmEdxGTxQjZqyu4lCaqGR9yuCr3y');
+INSERT INTO themes (user_id, dark_mode) VALUES (863, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (864, 'endomomoko0', '冬景', '普段歯科医師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://endomomoko.example.com/\nメールアドレス: endomomoko@example.com\n', '$2a$04$DpI07.7ql.45WjIfl28LP.8nI005.krxyHLudkIdX0CKmcW4OCh6m');
+INSERT INTO themes (user_id, dark_mode) VALUES (864, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (865, 'itoyumiko0', 'すやすやせんべい', '普段アナウンサーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://itoyumiko.example.com/\nメールアドレス: itoyumiko@example.com\n', '$2a$04$.GkE/OUCwvZ2gheBHs7q4uOf/YMfAj9PNpYn6jCwrOEx16dspw2qa');
+INSERT INTO themes (user_id, dark_mode) VALUES (865, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (866, 'yamashitayosuke0', '花筵', '普段YouTuberをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yamashitayosuke.example.com/\nメールアドレス: yamashitayosuke@example.com\n', '$2a$04$3EUNF8B0yBBbGUWMH0jo1.2p.tlXVGi3Q3cdvU8rJuHgmJo4QwOQG');
+INSERT INTO themes (user_id, dark_mode) VALUES (866, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (867, 'yokokondo0', '夜明け前', '普段配管工をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yokokondo.example.com/\nメールアドレス: yokokondo@example.com\n', '$2a$04$s.JWzrfBPfrBq5gyPL7sv.ldvADiNe2US9iKWtL.qY5qm1zZeAFge');
+INSERT INTO themes (user_id, dark_mode) VALUES (867, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (868, 'tsubasa470', 'お花おはぎ', '普段運転士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tsubasa47.example.com/\nメールアドレス: tsubasa47@example.com\n', '$2a$04$WE0FHGKNQ0HAEBZ8Xi9NDe32IIIyjTv9X3yuBQhvbS5U9BrylEMEW');
+INSERT INTO themes (user_id, dark_mode) VALUES (868, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (869, 'matsudanaoko0', 'さくらんぼもなか', '普段医師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://matsudanaoko.example.com/\nメールアドレス: matsudanaoko@example.com\n', '$2a$04$NTzkO97hOWpI1U3mx.odt.LreHylHcR9RjZkBPhSqbnJ.U6jOhI2a');
+INSERT INTO themes (user_id, dark_mode) VALUES (869, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (870, 'miuraatsushi0', '美味', '普段経営者をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://miuraatsushi.example.com/\nメールアドレス: miuraatsushi@example.com\n', '$2a$04$Z27Z/RwqmDnOXnXFiqbNBOZN.D.GqX0VV/AZQUgUQ7kqfA5MMBn7m');
+INSERT INTO themes (user_id, dark_mode) VALUES (870, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (871, 'miurataichi0', 'もももももももち', '普段音響技術者をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://miurataichi.example.com/\nメールアドレス: miurataichi@example.com\n', '$2a$04$KMktrTFWS6MIiy9InssireRwhpPrhWbgjy0eQqyoNIKaboUvqJ6.K');
+INSERT INTO themes (user_id, dark_mode) VALUES (871, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (872, 'manabusato1', 'しいたけしいたけもち', '普段モデルをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://manabusato.example.com/\nメールアドレス: manabusato@example.com\n', '$2a$04$xpe.xozmfHv7Z/2jNRuaee1bD8IXeEi4WDXM4zF76kcJ0UCZK0aLW');
+INSERT INTO themes (user_id, dark_mode) VALUES (872, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (873, 'uhasegawa1', 'ひよこひよきん', '普段配管工をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://uhasegawa.example.com/\nメールアドレス: uhasegawa@example.com\n', '$2a$04$5CnWWFdt4IauwyC4BjqTtuDrLiQ7Jkvi1MkaOiojVvQ1vMQER.p.W');
+INSERT INTO themes (user_id, dark_mode) VALUES (873, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (874, 'nanamimaeda0', 'りんごりんごりんごもち', '普段司法書士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://nanamimaeda.example.com/\nメールアドレス: nanamimaeda@example.com\n', '$2a$04$GO61jM8wsg0iiiPC6KWmZe05mDmajliBl0GtxRLCnK2eq5Mo/.C/O');
+INSERT INTO themes (user_id, dark_mode) VALUES (874, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (875, 'katotsubasa0', 'はなももはなび', '普段グラフィックデザイナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://katotsubasa.example.com/\nメールアドレス: katotsubasa@example.com\n', '$2a$04$nBWPPUXpkUpHWWZ7kJYR2OLxhYsiLuAVeDsDCO1WcQZEQ3Q8O9jFC');
+INSERT INTO themes (user_id, dark_mode) VALUES (875, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (876, 'eyamazaki0', 'せせせせり', '普段アーティストをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://eyamazaki.example.com/\nメールアドレス: eyamazaki@example.com\n', '$2a$04$T5oIWCDo9kr3TdZy8Ek1TegBsy3rzF0u/9HrJex1uRdED8xwE8Hhy');
+INSERT INTO themes (user_id, dark_mode) VALUES (876, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (877, 'wyamazaki1', '風車まんじゅう', '普段裁判官をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://wyamazaki.example.com/\nメールアドレス: wyamazaki@example.com\n', '$2a$04$uyoIa6HiiwtLEadlWJBcLeWrQ02LtC9hBtKy99UWDMr1fekNacxBS');
+INSERT INTO themes (user_id, dark_mode) VALUES (877, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (878, 'junyamada0', 'ふんわりふんすけ', '普段映画監督をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://junyamada.example.com/\nメールアドレス: junyamada@example.com\n', '$2a$04$wNDY/crOYymoNs8G1gyRNO36/YSWS/aL8Mcv.h2nDm9..TFoJ7hCa');
+INSERT INTO themes (user_id, dark_mode) VALUES (878, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (879, 'akemigoto0', '紅葉狩', '普段小説家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://akemigoto.example.com/\nメールアドレス: akemigoto@example.com\n', '$2a$04$kajn7WCr5lgnPy73ZudHxOy.XPkhEMTCPwIW.ANUhrqTViKKrWwbm');
+INSERT INTO themes (user_id, dark_mode) VALUES (879, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (880, 'uokada0', 'たんぽぽたいやき', '普段経営者をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://uokada.example.com/\nメールアドレス: uokada@example.com\n', '$2a$04$EhXqaOrq7tGcR61FKb1vxOVNSeLubLpFoRJDXxERMvUQZH5a.tT/q');
+INSERT INTO themes (user_id, dark_mode) VALUES (880, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (881, 'nishii0', 'しいしいしいらせん', '普段測量士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://nishii.example.com/\nメールアドレス: nishii@example.com\n', '$2a$04$5Lh81d1JW1AeHNueEENUVumXWpa6qhU.QRn0YJ1XFWRWg69Tc4PkG');
+INSERT INTO themes (user_id, dark_mode) VALUES (881, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (882, 'takumatanaka0', '旅路', '普段営業をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://takumatanaka.example.com/\nメールアドレス: takumatanaka@example.com\n', '$2a$04$uj2kC5wJNTrXKzFGTBfdhOKC.DYwJ6tejgVWdkZbzVXp/wY7AHW6m');
+INSERT INTO themes (user_id, dark_mode) VALUES (882, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (883, 'itomai0', 'ちょうちょうちょこもち', '普段調理師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://itomai.example.com/\nメールアドレス: itomai@example.com\n', '$2a$04$mOWpE7fPGGnKrMDLz0o71u6FPNfIu/60SvKe9CNTKAAQ4cGd48a1m');
+INSERT INTO themes (user_id, dark_mode) VALUES (883, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (884, 'hidekimaeda0', '雪解け', '普段小説家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hidekimaeda.example.com/\nメールアドレス: hidekimaeda@example.com\n', '$2a$04$evS0vE3fQ4gtple5.lY6BOatdNX6jKG6eQlpbRtYQDNBQxbVRoiFW');
+INSERT INTO themes (user_id, dark_mode) VALUES (884, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (885, 'osuzuki0', 'すいすいすいせん', '普段占い師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://osuzuki.example.com/\nメールアドレス: osuzuki@example.com\n', '$2a$04$4g7GzmasnxaXMkyaniG3AuuyP/TS0sq1x5oTi9KphY.59juebN51W');
+INSERT INTO themes (user_id, dark_mode) VALUES (885, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (886, 'harukasuzuki0', 'こはくこはくうり', '普段医療事務員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://harukasuzuki.example.com/\nメールアドレス: harukasuzuki@example.com\n', '$2a$04$q20IW8IrwmTn9BWDq349ROYis2Tr3iQ3dvagCKd21VSKSlB1bLNla');
+INSERT INTO themes (user_id, dark_mode) VALUES (886, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (887, 'watanabeyuta0', '夕刻', '普段お笑い芸人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://watanabeyuta.example.com/\nメールアドレス: watanabeyuta@example.com\n', '$2a$04$W1M2RwbskgN4Xcj3SfoNreWw4p6TbM7cbhQHE5vxbDxQDEefwUxny');
+INSERT INTO themes (user_id, dark_mode) VALUES (887, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (888, 'kobayashikaori0', 'くまくまくりん', '普段介護ヘルパーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kobayashikaori.example.com/\nメールアドレス: kobayashikaori@example.com\n', '$2a$04$15IR25zhI4AmeMXNpzRC9eXFostWbJzQ0AkT7qD4.E5VvgwVIeKJq');
+INSERT INTO themes (user_id, dark_mode) VALUES (888, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (889, 'usuzuki1', '木の葉もち', '普段アートディレクターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://usuzuki.example.com/\nメールアドレス: usuzuki@example.com\n', '$2a$04$34kAlIoUPVRvakcqe/xy8.YXw0R3Xq6c91qsch0rfR/MfiBTNkaTi');
+INSERT INTO themes (user_id, dark_mode) VALUES (889, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (890, 'gotokazuya0', 'お花見だんご', '普段ウェブデザイナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://gotokazuya.example.com/\nメールアドレス: gotokazuya@example.com\n', '$2a$04$lAp9YLJjs/1DljHgTubJBuL5r/.2NZDMnTylFpYBsdYeaznUcDkJ.');
+INSERT INTO themes (user_id, dark_mode) VALUES (890, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (891, 'kondotaichi0', 'きらきらきらりん', '普段和紙職人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kondotaichi.example.com/\nメールアドレス: kondotaichi@example.com\n', '$2a$04$ywZmBv6LQYbWGpA3yxaMcOIEQn1G.czkAd5uEK7fTeq3lfoaMoVLW');
+INSERT INTO themes (user_id, dark_mode) VALUES (891, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (892, 'nakamurasatomi0', 'おまんまる', '普段医師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://nakamurasatomi.example.com/\nメールアドレス: nakamurasatomi@example.com\n', '$2a$04$w5.MtTa6RDKEbD9j7XnUnOAYBcpug2XAVrjLgFg3wrlRHppbO52Gy');
+INSERT INTO themes (user_id, dark_mode) VALUES (892, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (893, 'ltakahashi0', 'ふくふくふくらんで', '普段お笑い芸人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ltakahashi.example.com/\nメールアドレス: ltakahashi@example.com\n', '$2a$04$0z1ni/zkE6a9Sfsaq3vb6O.Xma1va8H3h5/IfFVBH0MxrUI.OZRNm');
+INSERT INTO themes (user_id, dark_mode) VALUES (893, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (894, 'jun240', '夜半', '普段エステティシャンをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://jun24.example.com/\nメールアドレス: jun24@example.com\n', '$2a$04$f1BJVM5MCc6MpOOEbCtJGOI8xY3Alas0Ov8fVNuYej8b1idv59cCW');
+INSERT INTO themes (user_id, dark_mode) VALUES (894, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (895, 'sayuri860', '香風', '普段公務員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://sayuri86.example.com/\nメールアドレス: sayuri86@example.com\n', '$2a$04$JNkWqunO87FabYimlZkUqOyC02iDIbfQB35m.PKW7N/x2U8uqCEEW');
+INSERT INTO themes (user_id, dark_mode) VALUES (895, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (896, 'jmurakami1', 'ほんわかほうじ', '普段薬剤師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://jmurakami.example.com/\nメールアドレス: jmurakami@example.com\n', '$2a$04$cyjbSBfNcFM.VG2ovtnqg.xlmhsOaEy.yygmJ7hlNIM2STJ4Wgh5.');
+INSERT INTO themes (user_id, dark_mode) VALUES (896, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (897, 'ftakahashi1', 'いちごいちごかんきん', '普段公認会計士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ftakahashi.example.com/\nメールアドレス: ftakahashi@example.com\n', '$2a$04$qziCjx2Kh1JpW/bYzjKFc.v7jrvki3LJjpAxZqnlRJN.qn.NBT492');
+INSERT INTO themes (user_id, dark_mode) VALUES (897, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (898, 'nanami660', 'たねたねたねもち', '普段電気工事士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://nanami66.example.com/\nメールアドレス: nanami66@example.com\n', '$2a$04$6/rZQ./QnEYIa/XCChrIlOtQ.62Z0ZSRmWR27AmuY43XKPXcn8UmW');
+INSERT INTO themes (user_id, dark_mode) VALUES (898, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (899, 'otaasuka0', 'むぎゅむぎゅ餅', '普段映画監督をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://otaasuka.example.com/\nメールアドレス: otaasuka@example.com\n', '$2a$04$4R3oaiwgL3jFDx4XkSPgWeK.uk35cdQm.wKhYVFSFMREEtEUtuPZu');
+INSERT INTO themes (user_id, dark_mode) VALUES (899, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (900, 'oito0', '秋響', '普段医師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://oito.example.com/\nメールアドレス: oito@example.com\n', '$2a$04$/v16fIbxYBiHvtEmtjgydeJ/fUI2H0OhCgNdTReh5WZUtHYvubDDi');
+INSERT INTO themes (user_id, dark_mode) VALUES (900, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (901, 'satomi720', 'つぶつぶつぶあん', '普段高等学校教員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://satomi72.example.com/\nメールアドレス: satomi72@example.com\n', '$2a$04$0BRufcJy.4FPaIDjWxsKz.5JBQQMoGZzVniBFU0s7jaC.8pHSiuaK');
+INSERT INTO themes (user_id, dark_mode) VALUES (901, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (902, 'ryosuke680', '陽炎', '普段花火師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ryosuke68.example.com/\nメールアドレス: ryosuke68@example.com\n', '$2a$04$GvL4Wts8RiOULOE9qAYZWeX6pqOaW9F3MoWrqiAtMt3K.tepN85Xq');
+INSERT INTO themes (user_id, dark_mode) VALUES (902, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (903, 'minoru370', '水晶寒天', '普段アートディレクターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://minoru37.example.com/\nメールアドレス: minoru37@example.com\n', '$2a$04$OHdW6thFVfbi6tPhy5lXGeiFAShOFDz0xFVG2iSjM0yZ.jnAa0Uqq');
+INSERT INTO themes (user_id, dark_mode) VALUES (903, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (904, 'matsumotokumiko0', 'ごまごまごませんべい', '普段寿司職人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://matsumotokumiko.example.com/\nメールアドレス: matsumotokumiko@example.com\n', '$2a$04$0PulOoA5M9qCiCU4oL0O1OqnrGD./.dGLQPwuTf9SfioljG1ImG7.');
+INSERT INTO themes (user_id, dark_mode) VALUES (904, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (905, 'sishii0', 'きらきらきらりん', '普段医療事務員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://sishii.example.com/\nメールアドレス: sishii@example.com\n', '$2a$04$lBKwG4y37PBniflzaf/iY.9KYWo2ZBd6JSfvgT9UG0XA80uSShAJW');
+INSERT INTO themes (user_id, dark_mode) VALUES (905, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (906, 'maedanaoko0', 'さとさとさといも', '普段グラフィックデザイナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://maedanaoko.example.com/\nメールアドレス: maedanaoko@example.com\n', '$2a$04$pEqzGw.XCz0voYRH3QMJTO59V1WsTacBbGb/K2UETzzAEqaz6xU96');
+INSERT INTO themes (user_id, dark_mode) VALUES (906, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (907, 'chiyo370', 'せんせんせんべい', '普段調理師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://chiyo37.example.com/\nメールアドレス: chiyo37@example.com\n', '$2a$04$j./lZgNj/fSYZqg7O7BDue3T7Ijeun6VVUizLRGFMheTUMIqcYK2y');
+INSERT INTO themes (user_id, dark_mode) VALUES (907, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (908, 'sasakitakuma0', 'こころのこがねもち', '普段バスガイドをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://sasakitakuma.example.com/\nメールアドレス: sasakitakuma@example.com\n', '$2a$04$sLLGF.m9gbfbm5pTeUjpvuW89BW.yNEylciEbeGzsacMmbCN1DnSC');
+INSERT INTO themes (user_id, dark_mode) VALUES (908, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (909, 'reiikeda0', '暖かい風', '普段アートディレクターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://reiikeda.example.com/\nメールアドレス: reiikeda@example.com\n', '$2a$04$uu1XhQxhkzIJsJ7Tzw8qfuH/wivo4hkEYkFKOP0xKulBk2nxZPEqS');
+INSERT INTO themes (user_id, dark_mode) VALUES (909, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (910, 'chiyo540', '暁', '普段アーティストをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://chiyo54.example.com/\nメールアドレス: chiyo54@example.com\n', '$2a$04$qUPj6ngqW37MzPM4LsJ7QOBsEW7qF0fUVyE4T3QJYH4AKVozbNC9q');
+INSERT INTO themes (user_id, dark_mode) VALUES (910, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (911, 'yamamotosayuri0', '天空飴', '普段建築家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yamamotosayuri.example.com/\nメールアドレス: yamamotosayuri@example.com\n', '$2a$04$ah/ZrA65mvjSG9M2zRSUcegW1d1d.lzyMkKl0leUmnkmTEBGogPp.');
+INSERT INTO themes (user_id, dark_mode) VALUES (911, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (912, 'jota0', 'かりかりかりんとう', '普段バスガイドをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://jota.example.com/\nメールアドレス: jota@example.com\n', '$2a$04$sdAySrC/p9LWk5J.r5LM.udKeDM02c0eSXn3GFCrJ0uMUOmDlQDy2');
+INSERT INTO themes (user_id, dark_mode) VALUES (912, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (913, 'bsasaki0', '花火', '普段映画監督をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://bsasaki.example.com/\nメールアドレス: bsasaki@example.com\n', '$2a$04$uHf5EFSsbhKGqYandZv/Z.kwJ.UHjKBL5qD9hr8us.PEoIZTxNQrq');
+INSERT INTO themes (user_id, dark_mode) VALUES (913, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (914, 'bmori0', 'たけのこたけのこもち', '普段裁判官をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://bmori.example.com/\nメールアドレス: bmori@example.com\n', '$2a$04$2HuLJJa1611votr5CHKX/OS3xK1fAREGgbmLKSWo4Ewf5bzDGvCwi');
+INSERT INTO themes (user_id, dark_mode) VALUES (914, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (915, 'itojun0', '落雁', '普段アーティストをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://itojun.example.com/\nメールアドレス: itojun@example.com\n', '$2a$04$LTNRQBExFHY8lQCn44lo/ehB/NRpBIpddcfgIVLGGUYtvJmzJ3/9O');
+INSERT INTO themes (user_id, dark_mode) VALUES (915, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (916, 'atsushikondo0', 'きんぎょきんつば', '普段占い師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://atsushikondo.example.com/\nメールアドレス: atsushikondo@example.com\n', '$2a$04$vJVvUq12hZbehFmPzP.c8OnRsuSErg/5.1dLO7hq3pPFlvIQgaobu');
+INSERT INTO themes (user_id, dark_mode) VALUES (916, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (917, 'jun320', 'ふんわりふんわかん', '普段調理師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://jun32.example.com/\nメールアドレス: jun32@example.com\n', '$2a$04$zoGEpfPL0Ypk8OengyTkvuOdM0QVy30SWsY1C7y1aBvWBICJYu51y');
+INSERT INTO themes (user_id, dark_mode) VALUES (917, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (918, 'osamusasaki0', '青草', '普段行政書士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://osamusasaki.example.com/\nメールアドレス: osamusasaki@example.com\n', '$2a$04$NFEv49zphsEdwCNLi6QYz.FbSSEyNqGuIsJ9hNj.nbXE5Z624plBm');
+INSERT INTO themes (user_id, dark_mode) VALUES (918, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (919, 'suzukimaaya2', '笑顔', '普段小説家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://suzukimaaya.example.com/\nメールアドレス: suzukimaaya@example.com\n', '$2a$04$mAbZO/nJE.f8CjUSmm/IE.hqbx6XvnWlJmbNNi0ikU4p4mRcSDfuy');
+INSERT INTO themes (user_id, dark_mode) VALUES (919, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (920, 'shimizuhideki0', 'さくらんぼさくら', '普段配管工をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://shimizuhideki.example.com/\nメールアドレス: shimizuhideki@example.com\n', '$2a$04$aOtXqzAbj22O492SA6pp4euu5zHmRfxxNoG.Yp4qQf9O2NiKpmVJu');
+INSERT INTO themes (user_id, dark_mode) VALUES (920, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (921, 'endotomoya0', 'はなやかはなむけ', '普段建築家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://endotomoya.example.com/\nメールアドレス: endotomoya@example.com\n', '$2a$04$/0I5Z3FbgSF8C7k8qSfp3.ytDI6zVKZQ0u1qb4OeREpHxQzl6CTFW');
+INSERT INTO themes (user_id, dark_mode) VALUES (921, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (922, 'yamaguchimanabu0', 'ふなふなふなむし', '普段ゲームクリエイターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yamaguchimanabu.example.com/\nメールアドレス: yamaguchimanabu@example.com\n', '$2a$04$b9YqfFmp0r1WI.OGkygre./9HgRDPIFojEZZerozLRkDmQI1Fgz9a');
+INSERT INTO themes (user_id, dark_mode) VALUES (922, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (923, 'rhashimoto0', 'こっこっこみち', '普段和紙職人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://rhashimoto.example.com/\nメールアドレス: rhashimoto@example.com\n', '$2a$04$kdb7klvhAf6bAf.prygvoungTlvAxg6KZkBOYyo4W6Zi3sU3I495m');
+INSERT INTO themes (user_id, dark_mode) VALUES (923, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (924, 'rei590', '紅花', '普段運転士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://rei59.example.com/\nメールアドレス: rei59@example.com\n', '$2a$04$AXKaWtL0qEK/8xW.80xPMey9ariOJJgDcAxIsh9/aZ0fp7NSIC8cq');
+INSERT INTO themes (user_id, dark_mode) VALUES (924, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (925, 'yosuke490', '夕闇', '普段営業をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yosuke49.example.com/\nメールアドレス: yosuke49@example.com\n', '$2a$04$rJ7mfRo9Aw9VW5mcgkH/se52xwvhBDUVDZEKjsrpxkUsp5ITv2zOS');
+INSERT INTO themes (user_id, dark_mode) VALUES (925, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (926, 'tanakaryohei0', '寒椿', '普段建築家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tanakaryohei.example.com/\nメールアドレス: tanakaryohei@example.com\n', '$2a$04$xPHuVYa.1gExp.Xh3kUESOhgaBBHfbTwutEYI8rrOzwOIWM.xLHg.');
+INSERT INTO themes (user_id, dark_mode) VALUES (926, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (927, 'yukinakamura0', '夜明け前', '普段占い師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yukinakamura.example.com/\nメールアドレス: yukinakamura@example.com\n', '$2a$04$se4XG.MHrkFFuSXB/aSX1uNxqY0LdYnetRSqOvTrrgFtuB9UP84TK');
+INSERT INTO themes (user_id, dark_mode) VALUES (927, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (928, 'akira580', '午後の紅茶', '普段薬剤師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://akira58.example.com/\nメールアドレス: akira58@example.com\n', '$2a$04$As9ZsAB/qUV3RHXTPW0LDOecwR.GqPIhbBHyXmgTHxVOE3xE/8G8i');
+INSERT INTO themes (user_id, dark_mode) VALUES (928, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (929, 'minoru400', 'さくらさくらさくらん', '普段栄養士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://minoru40.example.com/\nメールアドレス: minoru40@example.com\n', '$2a$04$a2FyUeWJPpgE//4QaPNUSePy8ie9/KM46MavQwzvTx32r05ZE2vPC');
+INSERT INTO themes (user_id, dark_mode) VALUES (929, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (930, 'yamaguchimomoko0', 'きんとききんとかんき', '普段作曲家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yamaguchimomoko.example.com/\nメールアドレス: yamaguchimomoko@example.com\n', '$2a$04$1eF6i15wMQvGByE78X53Su7GiDOGKGBGtLuESNq.QS5YwXsICaIx6');
+INSERT INTO themes (user_id, dark_mode) VALUES (930, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (931, 'hanako410', 'もももももも', '普段測量士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hanako41.example.com/\nメールアドレス: hanako41@example.com\n', '$2a$04$dAHk5cZpQrHAyj1mw5.HW.W15pHZm5nwY0uPmN/4hknber.FNS.m.');
+INSERT INTO themes (user_id, dark_mode) VALUES (931, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (932, 'hyamamoto0', '風光明媚', '普段航海士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hyamamoto.example.com/\nメールアドレス: hyamamoto@example.com\n', '$2a$04$nvAPnhFN3DbDGykNeY9u6e7uZx/RH8ID7JhwXIjNImjsPzZeTJQXu');
+INSERT INTO themes (user_id, dark_mode) VALUES (932, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (933, 'maaya910', 'はくはくはくりもち', '普段経営者をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://maaya91.example.com/\nメールアドレス: maaya91@example.com\n', '$2a$04$te4Lr4UAR1nJRlW.n17STuSR3HDjx1wCvnEKyQso4x/tlpWtmOtaa');
+INSERT INTO themes (user_id, dark_mode) VALUES (933, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (934, 'sayurikobayashi0', 'つぶつぶつぶやき', '普段グラフィックデザイナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://sayurikobayashi.example.com/\nメールアドレス: sayurikobayashi@example.com\n', '$2a$04$6CW3TBbvY3ja/vT.gfdDS.m0M1BmgxjZRE7Xyunk6BFDEkbqlaqn6');
+INSERT INTO themes (user_id, dark_mode) VALUES (934, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (935, 'matsumotonaoko0', 'つくつくつくねもち', '普段薬剤師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://matsumotonaoko.example.com/\nメールアドレス: matsumotonaoko@example.com\n', '$2a$04$dSbZPImycO340SYKNxZ/Q.Gwbz9g7hIJ7Cnddjqptv6Zmf6HVgyzC');
+INSERT INTO themes (user_id, dark_mode) VALUES (935, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (936, 'rei250', 'はっぱのはなびら', '普段音響技術者をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://rei25.example.com/\nメールアドレス: rei25@example.com\n', '$2a$04$aGeuD2L8RycBjr3DqWbZUOs2w90DCowMfOFLpkW8sabWl1s3FmRoK');
+INSERT INTO themes (user_id, dark_mode) VALUES (936, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (937, 'momoko980', 'さくらんさくらんぼ', '普段和紙職人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://momoko98.example.com/\nメールアドレス: momoko98@example.com\n', '$2a$04$jlMvCu6723IQhhKTEgwZWeRA0MoVuDp5Jm1TXkr4VYaunzWewwI0G');
+INSERT INTO themes (user_id, dark_mode) VALUES (937, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (938, 'asuka370', 'いちごいちごう', '普段音響技術者をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://asuka37.example.com/\nメールアドレス: asuka37@example.com\n', '$2a$04$P3AbZWSr0kefGE86Jzy.rOK3eTvN1XZA4R5igmI.qgmuj8gvV29J.');
+INSERT INTO themes (user_id, dark_mode) VALUES (938, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (939, 'asuka100', 'かさかさかりんとう', '普段脚本家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://asuka10.example.com/\nメールアドレス: asuka10@example.com\n', '$2a$04$di0xhcmFLQYhttsk59.KIec.lYk2Pg2IzidWkst1sdYRjFPfIZE26');
+INSERT INTO themes (user_id, dark_mode) VALUES (939, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (940, 'yukiito0', 'つきみだいふく', '普段高等学校教員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yukiito.example.com/\nメールアドレス: yukiito@example.com\n', '$2a$04$Kyjwam4jnpx3kgsHO2LI1uNeXehTyAOKx6bLS/P2vfUimriVhpxoi');
+INSERT INTO themes (user_id, dark_mode) VALUES (940, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (941, 'mai500', 'ほっこりほうじ茶', '普段お笑い芸人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://mai50.example.com/\nメールアドレス: mai50@example.com\n', '$2a$04$cGmoHuamN4w.LvrIqU1mwO8BKvYlZbmxZQFGRmSDCMRAmRCXt1B7.');
+INSERT INTO themes (user_id, dark_mode) VALUES (941, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (942, 'manabutanaka0', 'こころこころもち', '普段音楽家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://manabutanaka.example.com/\nメールアドレス: manabutanaka@example.com\n', '$2a$04$YT65ydKjNDzNXvxK2C3NCeR2MNydvke77NqauyRGtknDidvbDng2W');
+INSERT INTO themes (user_id, dark_mode) VALUES (942, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (943, 'taichinakamura0', 'もちもちみるく', '普段高等学校教員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://taichinakamura.example.com/\nメールアドレス: taichinakamura@example.com\n', '$2a$04$VcfyEdo7EZPDyAXcbt/f9.kJH2of1qAn1wbfMsQZ/WBfgN8JH4hLC');
+INSERT INTO themes (user_id, dark_mode) VALUES (943, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (944, 'csato0', 'いちごいちえ', '普段ウェブデザイナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://csato.example.com/\nメールアドレス: csato@example.com\n', '$2a$04$ycsZAgjpnNgA671FYbukYeT6LCor5Db/92e3gqRdJM6cYpaHam4K6');
+INSERT INTO themes (user_id, dark_mode) VALUES (944, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (945, 'mikiyoshida0', 'はなびはなびく', '普段花火師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://mikiyoshida.example.com/\nメールアドレス: mikiyoshida@example.com\n', '$2a$04$DJwI/7petpqGJCRmDSG5nuQnYchTl20az2rsgxu8Uc7wAImKveQCu');
+INSERT INTO themes (user_id, dark_mode) VALUES (945, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (946, 'fujiiatsushi0', '紅葉羊羹', '普段お笑い芸人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://fujiiatsushi.example.com/\nメールアドレス: fujiiatsushi@example.com\n', '$2a$04$UwZb6IFm4tgS1pLvujdaV.sK3Gq9zN2IJyIuGjqtMyuSL/dZXfqjK');
+INSERT INTO themes (user_id, dark_mode) VALUES (946, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (947, 'hayashinaoko0', 'ふんわりふんわかんきんこうせ', '普段漫画家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hayashinaoko.example.com/\nメールアドレス: hayashinaoko@example.com\n', '$2a$04$p.G7Rfg50hqn6oFyNHEFCesVkulf1gIMikz8y8uqA1MGDoswG8R7y');
+INSERT INTO themes (user_id, dark_mode) VALUES (947, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (948, 'kaorikobayashi0', '金木犀', '普段花火師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kaorikobayashi.example.com/\nメールアドレス: kaorikobayashi@example.com\n', '$2a$04$PUrpyrjNpP70jWQyFIS90OhOzVk3PRTdtDSn97v5GlHG5//.Pc8ma');
+INSERT INTO themes (user_id, dark_mode) VALUES (948, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (949, 'lfujii0', 'つぶつぶつくし', '普段お笑い芸人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://lfujii.example.com/\nメールアドレス: lfujii@example.com\n', '$2a$04$/xwDxJw0l7il8nerRB7Ey.zwvtCMT3HP8ha6Sil9l6ZNL8eYuTSd6');
+INSERT INTO themes (user_id, dark_mode) VALUES (949, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (950, 'wyamaguchi1', 'ももももももか', '普段音響技術者をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://wyamaguchi.example.com/\nメールアドレス: wyamaguchi@example.com\n', '$2a$04$Bfs9ObF/qjudHK.wofdamOQpZWHt3yPiU2qsUEDwprjtPcPNYqGqu');
+INSERT INTO themes (user_id, dark_mode) VALUES (950, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (951, 'satomifujiwara0', 'まんまるもち', '普段アートディレクターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://satomifujiwara.example.com/\nメールアドレス: satomifujiwara@example.com\n', '$2a$04$GWjJPRhvDfqpEvp8bp7dW.ug.BHMx9em4WL8BSRPuMXj/ylhr8TmW');
+INSERT INTO themes (user_id, dark_mode) VALUES (951, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (952, 'gyamashita0', 'みずみずしいみずあめ', '普段バスガイドをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://gyamashita.example.com/\nメールアドレス: gyamashita@example.com\n', '$2a$04$Wyg2OV0Eg7eSCnoFqtJLgujNnkduv2.4hrKlyiAANYCN0uIq/Df0O');
+INSERT INTO themes (user_id, dark_mode) VALUES (952, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (953, 'ryohei850', 'こはるこはく', '普段YouTuberをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ryohei85.example.com/\nメールアドレス: ryohei85@example.com\n', '$2a$04$t4O0rPbs5QVsxI9VFAXoyeWsJ/rHAdpFs4ChmoeOn4WStCBmHc9dC');
+INSERT INTO themes (user_id, dark_mode) VALUES (953, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (954, 'dnakamura0', 'ふくふくふわりん', '普段栄養士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://dnakamura.example.com/\nメールアドレス: dnakamura@example.com\n', '$2a$04$p555Qy6kgTbVo67k29l9VuWeqo1vPANms6He5BGOgn2ypqolyR3Re');
+INSERT INTO themes (user_id, dark_mode) VALUES (954, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (955, 'rika040', 'めろんめろんケーキ', '普段大学教授をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://rika04.example.com/\nメールアドレス: rika04@example.com\n', '$2a$04$0puas75g4hIjKHehCQqrMe1GCX8szA3.I6oCpQ3.tK3kudFHCLcRO');
+INSERT INTO themes (user_id, dark_mode) VALUES (955, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (956, 'satoyuta1', '空色', '普段電気工事士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://satoyuta.example.com/\nメールアドレス: satoyuta@example.com\n', '$2a$04$Mfrba/tPNPbWeMHJ67dVG.mbMrbkI/J3QQrZpKr7H4.oYt.cWZ1Ui');
+INSERT INTO themes (user_id, dark_mode) VALUES (956, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (957, 'ynishimura0', '新緑', '普段建築家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ynishimura.example.com/\nメールアドレス: ynishimura@example.com\n', '$2a$04$7ZWkDLISNMr//oWec9KFceiQBYZ6czBLle3kvgjL9ek5B/FA82wD6');
+INSERT INTO themes (user_id, dark_mode) VALUES (957, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (958, 'tsubasa240', '美玉', '普段アイドルをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tsubasa24.example.com/\nメールアドレス: tsubasa24@example.com\n', '$2a$04$TMA.ea7HW0wmcLE0vd6hQ.PsJ1mZ4niDzhZN1gi7yiWPoPnawgsrm');
+INSERT INTO themes (user_id, dark_mode) VALUES (958, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (959, 'yukigoto0', 'ふくふくふくろすけ', '普段お笑い芸人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yukigoto.example.com/\nメールアドレス: yukigoto@example.com\n', '$2a$04$807xAItPSriQOKMQ9iBW5OBfqwuWei.fJIhMJ3nlP9B9MX5yC/yo6');
+INSERT INTO themes (user_id, dark_mode) VALUES (959, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (960, 'satomi200', '冬日', '普段演歌歌手をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://satomi20.example.com/\nメールアドレス: satomi20@example.com\n', '$2a$04$0PccloP90oBYVZSg1GLWFO0FJ7hRVaK6/NL2dFfEtFjoe8gUYiuN6');
+INSERT INTO themes (user_id, dark_mode) VALUES (960, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (961, 'tsubasashimizu0', 'いちいちいちじく', '普段アーティストをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tsubasashimizu.example.com/\nメールアドレス: tsubasashimizu@example.com\n', '$2a$04$q3ocvbPjvd5Sp1MYZwHciOn3yzDEQWVkpxkeoDzywyHlJMQX8CmXq');
+INSERT INTO themes (user_id, dark_mode) VALUES (961, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (962, 'suzukiyumiko0', 'うさぎもち', '普段イラストレーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://suzukiyumiko.example.com/\nメールアドレス: suzukiyumiko@example.com\n', '$2a$04$C5kAIMdMDC9Fcap9LTzBK.4NEn8gIGZgPWzelYFLddPbIni7MZETG');
+INSERT INTO themes (user_id, dark_mode) VALUES (962, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (963, 'nakamurataichi0', 'きらきらきらり', '普段映画監督をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://nakamurataichi.example.com/\nメールアドレス: nakamurataichi@example.com\n', '$2a$04$RbZ/5L2foJkNG2EgXMUblOgZ6xmEdxGTxQjZqyu4lCaqGR9yuCr3y');
+INSERT INTO themes (user_id, dark_mode) VALUES (963, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (964, 'yumiko070', 'なまなまなまがし', '普段運転士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yumiko07.example.com/\nメールアドレス: yumiko07@example.com\n', '$2a$04$DpI07.7ql.45WjIfl28LP.8nI005.krxyHLudkIdX0CKmcW4OCh6m');
+INSERT INTO themes (user_id, dark_mode) VALUES (964, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (965, 'shotafujiwara0', '陽射し', '普段電気工事士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://shotafujiwara.example.com/\nメールアドレス: shotafujiwara@example.com\n', '$2a$04$.GkE/OUCwvZ2gheBHs7q4uOf/YMfAj9PNpYn6jCwrOEx16dspw2qa');
+INSERT INTO themes (user_id, dark_mode) VALUES (965, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (966, 'maedataro0', '桜花', '普段脚本家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://maedataro.example.com/\nメールアドレス: maedataro@example.com\n', '$2a$04$3EUNF8B0yBBbGUWMH0jo1.2p.tlXVGi3Q3cdvU8rJuHgmJo4QwOQG');
+INSERT INTO themes (user_id, dark_mode) VALUES (966, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (967, 'maifujita0', 'かめかめかりんとう', '普段音楽家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://maifujita.example.com/\nメールアドレス: maifujita@example.com\n', '$2a$04$s.JWzrfBPfrBq5gyPL7sv.ldvADiNe2US9iKWtL.qY5qm1zZeAFge');
+INSERT INTO themes (user_id, dark_mode) VALUES (967, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (968, 'fujiwarayuki0', 'うめうめうめたん', '普段高等学校教員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://fujiwarayuki.example.com/\nメールアドレス: fujiwarayuki@example.com\n', '$2a$04$WE0FHGKNQ0HAEBZ8Xi9NDe32IIIyjTv9X3yuBQhvbS5U9BrylEMEW');
+INSERT INTO themes (user_id, dark_mode) VALUES (968, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (969, 'shohei240', '雪だるまもち', '普段アートディレクターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://shohei24.example.com/\nメールアドレス: shohei24@example.com\n', '$2a$04$NTzkO97hOWpI1U3mx.odt.LreHylHcR9RjZkBPhSqbnJ.U6jOhI2a');
+INSERT INTO themes (user_id, dark_mode) VALUES (969, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (970, 'aokirika0', '宵時', '普段気象予報士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://aokirika.example.com/\nメールアドレス: aokirika@example.com\n', '$2a$04$Z27Z/RwqmDnOXnXFiqbNBOZN.D.GqX0VV/AZQUgUQ7kqfA5MMBn7m');
+INSERT INTO themes (user_id, dark_mode) VALUES (970, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (971, 'hanako580', 'きらきらきらりん', '普段高等学校教員をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hanako58.example.com/\nメールアドレス: hanako58@example.com\n', '$2a$04$KMktrTFWS6MIiy9InssireRwhpPrhWbgjy0eQqyoNIKaboUvqJ6.K');
+INSERT INTO themes (user_id, dark_mode) VALUES (971, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (972, 'rgoto0', 'たんたんたんぽぽもち', '普段ウェディングプランナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://rgoto.example.com/\nメールアドレス: rgoto@example.com\n', '$2a$04$xpe.xozmfHv7Z/2jNRuaee1bD8IXeEi4WDXM4zF76kcJ0UCZK0aLW');
+INSERT INTO themes (user_id, dark_mode) VALUES (972, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (973, 'yuta100', 'つぶつぶつぶつぶ', '普段アニメーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yuta10.example.com/\nメールアドレス: yuta10@example.com\n', '$2a$04$5CnWWFdt4IauwyC4BjqTtuDrLiQ7Jkvi1MkaOiojVvQ1vMQER.p.W');
+INSERT INTO themes (user_id, dark_mode) VALUES (973, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (974, 'yosukeishikawa1', 'こころのこがねもち', '普段経営者をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yosukeishikawa.example.com/\nメールアドレス: yosukeishikawa@example.com\n', '$2a$04$GO61jM8wsg0iiiPC6KWmZe05mDmajliBl0GtxRLCnK2eq5Mo/.C/O');
+INSERT INTO themes (user_id, dark_mode) VALUES (974, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (975, 'dokada0', '幻想的な夜空クッキー', '普段音楽家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://dokada.example.com/\nメールアドレス: dokada@example.com\n', '$2a$04$nBWPPUXpkUpHWWZ7kJYR2OLxhYsiLuAVeDsDCO1WcQZEQ3Q8O9jFC');
+INSERT INTO themes (user_id, dark_mode) VALUES (975, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (976, 'kimurahanako0', 'ぎんぎらぎんなん', '普段アナウンサーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kimurahanako.example.com/\nメールアドレス: kimurahanako@example.com\n', '$2a$04$T5oIWCDo9kr3TdZy8Ek1TegBsy3rzF0u/9HrJex1uRdED8xwE8Hhy');
+INSERT INTO themes (user_id, dark_mode) VALUES (976, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (977, 'wnakagawa0', '雲のかたち', '普段和紙職人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://wnakagawa.example.com/\nメールアドレス: wnakagawa@example.com\n', '$2a$04$uyoIa6HiiwtLEadlWJBcLeWrQ02LtC9hBtKy99UWDMr1fekNacxBS');
+INSERT INTO themes (user_id, dark_mode) VALUES (977, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (978, 'yukiyoshida0', 'ふくふくふくらんで', '普段アーティストをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://yukiyoshida.example.com/\nメールアドレス: yukiyoshida@example.com\n', '$2a$04$wNDY/crOYymoNs8G1gyRNO36/YSWS/aL8Mcv.h2nDm9..TFoJ7hCa');
+INSERT INTO themes (user_id, dark_mode) VALUES (978, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (979, 'naokitanaka0', '月夜', '普段モデルをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://naokitanaka.example.com/\nメールアドレス: naokitanaka@example.com\n', '$2a$04$kajn7WCr5lgnPy73ZudHxOy.XPkhEMTCPwIW.ANUhrqTViKKrWwbm');
+INSERT INTO themes (user_id, dark_mode) VALUES (979, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (980, 'ysaito0', 'きりきりきりふく', '普段建築家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ysaito.example.com/\nメールアドレス: ysaito@example.com\n', '$2a$04$EhXqaOrq7tGcR61FKb1vxOVNSeLubLpFoRJDXxERMvUQZH5a.tT/q');
+INSERT INTO themes (user_id, dark_mode) VALUES (980, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (981, 'miturusato0', 'いちごいちごこ', '普段裁判官をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://miturusato.example.com/\nメールアドレス: miturusato@example.com\n', '$2a$04$5Lh81d1JW1AeHNueEENUVumXWpa6qhU.QRn0YJ1XFWRWg69Tc4PkG');
+INSERT INTO themes (user_id, dark_mode) VALUES (981, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (982, 'naoki870', 'ふくふくふくらんで', '普段モデルをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://naoki87.example.com/\nメールアドレス: naoki87@example.com\n', '$2a$04$uj2kC5wJNTrXKzFGTBfdhOKC.DYwJ6tejgVWdkZbzVXp/wY7AHW6m');
+INSERT INTO themes (user_id, dark_mode) VALUES (982, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (983, 'chiyo310', '都風', '普段エステティシャンをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://chiyo31.example.com/\nメールアドレス: chiyo31@example.com\n', '$2a$04$mOWpE7fPGGnKrMDLz0o71u6FPNfIu/60SvKe9CNTKAAQ4cGd48a1m');
+INSERT INTO themes (user_id, dark_mode) VALUES (983, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (984, 'naokitanaka1', 'うめうめうめかんきんこうせ', '普段検察官をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://naokitanaka.example.com/\nメールアドレス: naokitanaka@example.com\n', '$2a$04$evS0vE3fQ4gtple5.lY6BOatdNX6jKG6eQlpbRtYQDNBQxbVRoiFW');
+INSERT INTO themes (user_id, dark_mode) VALUES (984, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (985, 'chiyonakamura0', '神秘の夜ふわふわ', '普段アーティストをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://chiyonakamura.example.com/\nメールアドレス: chiyonakamura@example.com\n', '$2a$04$4g7GzmasnxaXMkyaniG3AuuyP/TS0sq1x5oTi9KphY.59juebN51W');
+INSERT INTO themes (user_id, dark_mode) VALUES (985, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (986, 'msaito0', 'はるか', '普段モデルをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://msaito.example.com/\nメールアドレス: msaito@example.com\n', '$2a$04$q20IW8IrwmTn9BWDq349ROYis2Tr3iQ3dvagCKd21VSKSlB1bLNla');
+INSERT INTO themes (user_id, dark_mode) VALUES (986, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (987, 'jsuzuki1', 'ひまわりほうじ茶', '普段グラフィックデザイナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://jsuzuki.example.com/\nメールアドレス: jsuzuki@example.com\n', '$2a$04$W1M2RwbskgN4Xcj3SfoNreWw4p6TbM7cbhQHE5vxbDxQDEefwUxny');
+INSERT INTO themes (user_id, dark_mode) VALUES (987, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (988, 'suzukihanako0', '午前', '普段調理師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://suzukihanako.example.com/\nメールアドレス: suzukihanako@example.com\n', '$2a$04$15IR25zhI4AmeMXNpzRC9eXFostWbJzQ0AkT7qD4.E5VvgwVIeKJq');
+INSERT INTO themes (user_id, dark_mode) VALUES (988, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (989, 'dokada1', 'いちごいがり', '普段エンジニア演奏家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://dokada.example.com/\nメールアドレス: dokada@example.com\n', '$2a$04$34kAlIoUPVRvakcqe/xy8.YXw0R3Xq6c91qsch0rfR/MfiBTNkaTi');
+INSERT INTO themes (user_id, dark_mode) VALUES (989, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (990, 'rei850', '河童', '普段小説家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://rei85.example.com/\nメールアドレス: rei85@example.com\n', '$2a$04$lAp9YLJjs/1DljHgTubJBuL5r/.2NZDMnTylFpYBsdYeaznUcDkJ.');
+INSERT INTO themes (user_id, dark_mode) VALUES (990, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (991, 'kenichihashimoto0', 'ぽんぽんぽんかん', '普段ウェブデザイナーをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://kenichihashimoto.example.com/\nメールアドレス: kenichihashimoto@example.com\n', '$2a$04$ywZmBv6LQYbWGpA3yxaMcOIEQn1G.czkAd5uEK7fTeq3lfoaMoVLW');
+INSERT INTO themes (user_id, dark_mode) VALUES (991, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (992, 'fnakamura0', 'まめまめまめだいふく', '普段脚本家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://fnakamura.example.com/\nメールアドレス: fnakamura@example.com\n', '$2a$04$w5.MtTa6RDKEbD9j7XnUnOAYBcpug2XAVrjLgFg3wrlRHppbO52Gy');
+INSERT INTO themes (user_id, dark_mode) VALUES (992, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (993, 'hasegawatakuma0', 'さらさらさらすり', '普段検察官をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://hasegawatakuma.example.com/\nメールアドレス: hasegawatakuma@example.com\n', '$2a$04$0z1ni/zkE6a9Sfsaq3vb6O.Xma1va8H3h5/IfFVBH0MxrUI.OZRNm');
+INSERT INTO themes (user_id, dark_mode) VALUES (993, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (994, 'lyamamoto0', 'ははははちみつもち', '普段映画監督をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://lyamamoto.example.com/\nメールアドレス: lyamamoto@example.com\n', '$2a$04$f1BJVM5MCc6MpOOEbCtJGOI8xY3Alas0Ov8fVNuYej8b1idv59cCW');
+INSERT INTO themes (user_id, dark_mode) VALUES (994, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (995, 'takuma600', 'うさぎうしろ', '普段気象予報士をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://takuma60.example.com/\nメールアドレス: takuma60@example.com\n', '$2a$04$JNkWqunO87FabYimlZkUqOyC02iDIbfQB35m.PKW7N/x2U8uqCEEW');
+INSERT INTO themes (user_id, dark_mode) VALUES (995, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (996, 'manabutakahashi0', '乾燥梅', '普段イラストレーターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://manabutakahashi.example.com/\nメールアドレス: manabutakahashi@example.com\n', '$2a$04$cyjbSBfNcFM.VG2ovtnqg.xlmhsOaEy.yygmJ7hlNIM2STJ4Wgh5.');
+INSERT INTO themes (user_id, dark_mode) VALUES (996, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (997, 'ryosukesakamoto0', '宵闇', '普段お笑い芸人をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://ryosukesakamoto.example.com/\nメールアドレス: ryosukesakamoto@example.com\n', '$2a$04$qziCjx2Kh1JpW/bYzjKFc.v7jrvki3LJjpAxZqnlRJN.qn.NBT492');
+INSERT INTO themes (user_id, dark_mode) VALUES (997, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (998, 'itoharuka0', 'うめうめうめぼし', '普段ゲームクリエイターをしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://itoharuka.example.com/\nメールアドレス: itoharuka@example.com\n', '$2a$04$6/rZQ./QnEYIa/XCChrIlOtQ.62Z0ZSRmWR27AmuY43XKPXcn8UmW');
+INSERT INTO themes (user_id, dark_mode) VALUES (998, true);
+INSERT INTO users (id, name, display_name, description, password) VALUES (999, 'satomi130', '宵の明星', '普段花火師をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://satomi13.example.com/\nメールアドレス: satomi13@example.com\n', '$2a$04$4R3oaiwgL3jFDx4XkSPgWeK.uk35cdQm.wKhYVFSFMREEtEUtuPZu');
+INSERT INTO themes (user_id, dark_mode) VALUES (999, false);
+INSERT INTO users (id, name, display_name, description, password) VALUES (1000, 'tomoya450', 'おまんまる', '普段脚本家をしています。\nよろしくおねがいします！\n\n連絡は以下からお願いします。\n\nウェブサイト: http://tomoya45.example.com/\nメールアドレス: tomoya45@example.com\n', '$2a$04$/v16fIbxYBiHvtEmtjgydeJ/fUI2H0OhCgNdTReh5WZUtHYvubDDi');
+INSERT INTO themes (user_id, dark_mode) VALUES (1000, false);
+
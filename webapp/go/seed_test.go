@@ -0,0 +1,24 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// 埋め込んだ初期データがsql/のファイルと同じであること (違う場合は go generate で取り込み直す)
+func TestSeedFilesMatchSQLDir(t *testing.T) {
+	for _, name := range seedFileNames {
+		want, err := os.ReadFile(filepath.Join("..", "sql", name))
+		if err != nil {
+			t.Fatalf("failed to read ../sql/%s: %v", name, err)
+		}
+		got, err := readSeed(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != string(want) {
+			t.Errorf("seed/%s differs from ../sql/%s (run go generate)", name, name)
+		}
+	}
+}
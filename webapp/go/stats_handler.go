@@ -3,20 +3,23 @@ package main
 import (
 	"database/sql"
 	"errors"
-	"github.com/jmoiron/sqlx"
 	"net/http"
 	"sort"
 	"strconv"
+	"time"
 
 	"github.com/labstack/echo/v4"
 )
 
 type LivestreamStatistics struct {
-	Rank           int64 `json:"rank"`
-	ViewersCount   int64 `json:"viewers_count"`
-	TotalReactions int64 `json:"total_reactions"`
-	TotalReports   int64 `json:"total_reports"`
-	MaxTip         int64 `json:"max_tip"`
+	Rank                  int64 `json:"rank"`
+	ViewersCount          int64 `json:"viewers_count"`
+	ConcurrentViewers     int64 `json:"concurrent_viewers"`
+	PeakConcurrentViewers int64 `json:"peak_concurrent_viewers"`
+	UniqueViewers         int64 `json:"unique_viewers"`
+	TotalReactions        int64 `json:"total_reactions"`
+	TotalReports          int64 `json:"total_reports"`
+	MaxTip                int64 `json:"max_tip"`
 }
 
 type LivestreamRankingEntry struct {
@@ -72,14 +75,14 @@ func getUserStatisticsHandler(c echo.Context) error {
 	// ユーザごとに、紐づく配信について、累計リアクション数、累計ライブコメント数、累計売上金額を算出
 	// また、現在の合計視聴者数もだす
 
-	tx, err := dbConn.BeginTxx(ctx, nil)
+	tx, err := store.BeginReplicaRead(ctx)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to begin transaction: "+err.Error())
 	}
 	defer tx.Rollback()
 
-	var user UserModel
-	if err := tx.GetContext(ctx, &user, "SELECT * FROM users WHERE name = ?", username); err != nil {
+	user, err := tx.Users().GetByName(ctx, username)
+	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return echo.NewHTTPError(http.StatusBadRequest, "not found user that has the given username")
 		} else {
@@ -88,28 +91,8 @@ func getUserStatisticsHandler(c echo.Context) error {
 	}
 
 	// ランク算出
-	query := `
-	SELECT 
-		u.id AS user_id,
-		u.name AS username,
-		COUNT(r.id) AS reactions,
-		IFNULL(SUM(lc.tip), 0) AS tips
-	FROM users u
-	LEFT JOIN livestreams l ON l.user_id = u.id
-	LEFT JOIN reactions r ON r.livestream_id = l.id
-	LEFT JOIN livecomments lc ON lc.livestream_id = l.id
-	GROUP BY u.id
-	ORDER BY (COUNT(r.id) + IFNULL(SUM(lc.tip), 0)) DESC;
-`
-
-	var results []struct {
-		UserID    int64  `db:"user_id"`
-		Username  string `db:"username"`
-		Reactions int64  `db:"reactions"`
-		Tips      int64  `db:"tips"`
-	}
-
-	if err := tx.SelectContext(ctx, &results, query); err != nil {
+	results, err := tx.Users().ListScores(ctx)
+	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to fetch rankings: "+err.Error())
 	}
 
@@ -134,27 +117,22 @@ func getUserStatisticsHandler(c echo.Context) error {
 	}
 
 	// リアクション数
-	var totalReactions int64
-	query = `SELECT COUNT(*) FROM users u 
-    INNER JOIN livestreams l ON l.user_id = u.id 
-    INNER JOIN reactions r ON r.livestream_id = l.id
-    WHERE u.name = ?
-	`
-	if err := tx.GetContext(ctx, &totalReactions, query, username); err != nil && !errors.Is(err, sql.ErrNoRows) {
+	totalReactions, err := tx.Reactions().CountByStreamerName(ctx, username)
+	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to count total reactions: "+err.Error())
 	}
 
 	// ライブコメント数、チップ合計
 	var totalLivecomments int64
 	var totalTip int64
-	var livestreams []*LivestreamModel
-	if err := tx.SelectContext(ctx, &livestreams, "SELECT * FROM livestreams WHERE user_id = ?", user.ID); err != nil && !errors.Is(err, sql.ErrNoRows) {
+	livestreams, err := tx.Livestreams().ListByUserID(ctx, user.ID)
+	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get livestreams: "+err.Error())
 	}
 
 	for _, livestream := range livestreams {
-		var livecomments []*LivecommentModel
-		if err := tx.SelectContext(ctx, &livecomments, "SELECT * FROM livecomments WHERE livestream_id = ?", livestream.ID); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		livecomments, err := tx.Livecomments().ListByLivestreamID(ctx, livestream.ID, noLimit)
+		if err != nil {
 			return echo.NewHTTPError(http.StatusInternalServerError, "failed to get livecomments: "+err.Error())
 		}
 
@@ -167,26 +145,16 @@ func getUserStatisticsHandler(c echo.Context) error {
 	// 合計視聴者数
 	var viewersCount int64
 	for _, livestream := range livestreams {
-		var cnt int64
-		if err := tx.GetContext(ctx, &cnt, "SELECT COUNT(*) FROM livestream_viewers_history WHERE livestream_id = ?", livestream.ID); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		cnt, err := tx.Viewers().CountHistory(ctx, livestream.ID)
+		if err != nil {
 			return echo.NewHTTPError(http.StatusInternalServerError, "failed to get livestream_view_history: "+err.Error())
 		}
 		viewersCount += cnt
 	}
 
 	// お気に入り絵文字
-	var favoriteEmoji string
-	query = `
-	SELECT r.emoji_name
-	FROM users u
-	INNER JOIN livestreams l ON l.user_id = u.id
-	INNER JOIN reactions r ON r.livestream_id = l.id
-	WHERE u.name = ?
-	GROUP BY emoji_name
-	ORDER BY COUNT(*) DESC, emoji_name DESC
-	LIMIT 1
-	`
-	if err := tx.GetContext(ctx, &favoriteEmoji, query, username); err != nil && !errors.Is(err, sql.ErrNoRows) {
+	favoriteEmoji, err := tx.Reactions().FavoriteEmojiByStreamerName(ctx, username)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to find favorite emoji: "+err.Error())
 	}
 
@@ -214,14 +182,13 @@ func getLivestreamStatisticsHandler(c echo.Context) error {
 	}
 	livestreamID := int64(id)
 
-	tx, err := dbConn.BeginTxx(ctx, nil)
+	tx, err := store.BeginReplicaRead(ctx)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to begin transaction: "+err.Error())
 	}
 	defer tx.Rollback()
 
-	var livestream LivestreamModel
-	if err := tx.GetContext(ctx, &livestream, "SELECT * FROM livestreams WHERE id = ?", livestreamID); err != nil {
+	if _, err := tx.Livestreams().Get(ctx, livestreamID); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return echo.NewHTTPError(http.StatusBadRequest, "cannot get stats of not found livestream")
 		} else {
@@ -229,8 +196,8 @@ func getLivestreamStatisticsHandler(c echo.Context) error {
 		}
 	}
 
-	var livestreams []*LivestreamModel
-	if err := tx.SelectContext(ctx, &livestreams, "SELECT * FROM livestreams"); err != nil && !errors.Is(err, sql.ErrNoRows) {
+	livestreams, err := tx.Livestreams().List(ctx)
+	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get livestreams: "+err.Error())
 	}
 
@@ -240,31 +207,8 @@ func getLivestreamStatisticsHandler(c echo.Context) error {
 		livestreamIDs[i] = livestream.ID
 	}
 
-	query := `
-	SELECT 
-		l.id AS livestream_id,
-		COUNT(r.id) AS reactions,
-		IFNULL(SUM(lc.tip), 0) AS total_tips
-	FROM livestreams l
-	LEFT JOIN reactions r ON l.id = r.livestream_id
-	LEFT JOIN livecomments lc ON l.id = lc.livestream_id
-	WHERE l.id IN (?)
-	GROUP BY l.id
-	`
-
-	var results []struct {
-		LivestreamID int64 `db:"livestream_id"`
-		Reactions    int64 `db:"reactions"`
-		TotalTips    int64 `db:"total_tips"`
-	}
-
-	query, args, err := sqlx.In(query, livestreamIDs)
+	results, err := tx.Livestreams().ListScores(ctx, livestreamIDs)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "failed to build query: "+err.Error())
-	}
-	query = tx.Rebind(query)
-
-	if err := tx.SelectContext(ctx, &results, query, args...); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to fetch rankings: "+err.Error())
 	}
 
@@ -289,26 +233,38 @@ func getLivestreamStatisticsHandler(c echo.Context) error {
 	}
 
 	// 視聴者数算出
-	var viewersCount int64
-	if err := tx.GetContext(ctx, &viewersCount, `SELECT COUNT(*) FROM livestreams l INNER JOIN livestream_viewers_history h ON h.livestream_id = l.id WHERE l.id = ?`, livestreamID); err != nil && !errors.Is(err, sql.ErrNoRows) {
+	viewersCount, err := tx.Viewers().CountHistory(ctx, livestreamID)
+	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to count livestream viewers: "+err.Error())
 	}
 
+	// ユニーク視聴者数 (退出した視聴者も含む)
+	uniqueViewers, err := tx.Viewers().CountUnique(ctx, livestreamID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to count livestream unique viewers: "+err.Error())
+	}
+
+	// 同時視聴者数、最大同時視聴者数 (heartbeatベース)
+	concurrentViewers, peakConcurrentViewers, err := presence.Stats(ctx, tx, livestreamID, time.Now())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to count concurrent viewers: "+err.Error())
+	}
+
 	// 最大チップ額
-	var maxTip int64
-	if err := tx.GetContext(ctx, &maxTip, `SELECT IFNULL(MAX(tip), 0) FROM livestreams l INNER JOIN livecomments l2 ON l2.livestream_id = l.id WHERE l.id = ?`, livestreamID); err != nil && !errors.Is(err, sql.ErrNoRows) {
+	maxTip, err := tx.Livecomments().MaxTip(ctx, livestreamID)
+	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to find maximum tip livecomment: "+err.Error())
 	}
 
 	// リアクション数
-	var totalReactions int64
-	if err := tx.GetContext(ctx, &totalReactions, "SELECT COUNT(*) FROM livestreams l INNER JOIN reactions r ON r.livestream_id = l.id WHERE l.id = ?", livestreamID); err != nil && !errors.Is(err, sql.ErrNoRows) {
+	totalReactions, err := tx.Reactions().CountByLivestreamID(ctx, livestreamID)
+	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to count total reactions: "+err.Error())
 	}
 
 	// スパム報告数
-	var totalReports int64
-	if err := tx.GetContext(ctx, &totalReports, `SELECT COUNT(*) FROM livestreams l INNER JOIN livecomment_reports r ON r.livestream_id = l.id WHERE l.id = ?`, livestreamID); err != nil && !errors.Is(err, sql.ErrNoRows) {
+	totalReports, err := tx.Reports().CountByLivestreamID(ctx, livestreamID)
+	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to count total spam reports: "+err.Error())
 	}
 
@@ -317,10 +273,13 @@ func getLivestreamStatisticsHandler(c echo.Context) error {
 	}
 
 	return c.JSON(http.StatusOK, LivestreamStatistics{
-		Rank:           rank,
-		ViewersCount:   viewersCount,
-		MaxTip:         maxTip,
-		TotalReactions: totalReactions,
-		TotalReports:   totalReports,
+		Rank:                  rank,
+		ViewersCount:          viewersCount,
+		ConcurrentViewers:     concurrentViewers,
+		PeakConcurrentViewers: peakConcurrentViewers,
+		UniqueViewers:         uniqueViewers,
+		MaxTip:                maxTip,
+		TotalReactions:        totalReactions,
+		TotalReports:          totalReports,
 	})
 }
@@ -0,0 +1,395 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+const (
+	storeBackendMySQL  = "mysql"
+	storeBackendMemory = "memory"
+
+	// noLimit はLIMITを付けずに取得する
+	noLimit = -1
+)
+
+// errDuplicateEntry はインメモリのストアで一意制約に違反した場合のエラー (MySQLのError 1062に相当)
+var errDuplicateEntry = errors.New("duplicate entry")
+
+// isDuplicateEntry は一意制約の違反かどうかを返す
+func isDuplicateEntry(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.Is(err, errDuplicateEntry) || errors.As(err, &mysqlErr) && mysqlErr.Number == 1062
+}
+
+// Store はデータの保存先
+// MySQLの実装と、MySQLなしでローカル開発するためのインメモリの実装がある
+type Store interface {
+	// Begin はトランザクションを開始する (CommitかRollbackを必ず呼ぶこと)
+	Begin(ctx context.Context) (Tx, error)
+	// BeginReadOnly は読み取り専用のトランザクションを開始する
+	BeginReadOnly(ctx context.Context) (Tx, error)
+	// BeginReplicaRead は読み取り専用のハンドラのためのトランザクションを開始する
+	// レプリカがあればレプリカから読むので、直前の他のリクエストの書き込みが見えないことがある
+	BeginReplicaRead(ctx context.Context) (Tx, error)
+	// Reset は全データを消して初期データを読み込み直す (/api/initialize)
+	// 処理ごとの所要時間をtimerに記録する
+	Reset(ctx context.Context, timer *initializeTimer) error
+	// Lock はトランザクションとは別に、名前ごとの排他ロックを取る (MySQLではGET_LOCKなので全インスタンスで排他になる)
+	// 返された関数でロックを外す
+	Lock(ctx context.Context, name string) (func(), error)
+	Ping(ctx context.Context) error
+	Close() error
+}
+
+// Tx はトランザクション内で使うリポジトリを返す
+// 見つからない行はsql.ErrNoRowsで返す
+type Tx interface {
+	Users() UserRepository
+	Themes() ThemeRepository
+	Icons() IconRepository
+	Tags() TagRepository
+	Livestreams() LivestreamRepository
+	Viewers() ViewerRepository
+	Reservations() ReservationRepository
+	Livecomments() LivecommentRepository
+	Reports() ReportRepository
+	Reactions() ReactionRepository
+	NGWords() NGWordRepository
+	Moderation() ModerationRepository
+	AccessTokens() AccessTokenRepository
+	DNSOutbox() DNSOutboxRepository
+	StaffGrants() StaffGrantRepository
+	Commit() error
+	Rollback() error
+}
+
+type UserRepository interface {
+	Get(ctx context.Context, id int64) (UserModel, error)
+	// GetForUpdate は行をロックして取得する (SELECT ... FOR UPDATE)
+	GetForUpdate(ctx context.Context, id int64) (UserModel, error)
+	GetByName(ctx context.Context, name string) (UserModel, error)
+	// FindNameCaseInsensitive は大文字小文字を区別せずに一致するユーザの名前を返す
+	FindNameCaseInsensitive(ctx context.Context, name string) (string, error)
+	ListByIDs(ctx context.Context, ids []int64) ([]UserModel, error)
+	List(ctx context.Context) ([]UserModel, error)
+	// ListActiveNames は退会していないユーザの名前を返す
+	ListActiveNames(ctx context.Context) ([]string, error)
+	// ActiveNameExists は退会していないユーザがいるかを大文字小文字を区別せずに返す (DNSのラベルとの照合に使う)
+	ActiveNameExists(ctx context.Context, name string) (bool, error)
+	// ListScores はユーザごとに、配信へのリアクション数とチップの合計を返す
+	ListScores(ctx context.Context) ([]UserScoreModel, error)
+	// Create は追加したユーザのIDを返す (名前が重複する場合はエラー)
+	Create(ctx context.Context, user UserModel) (int64, error)
+	// Deactivate は退会済みにして個人情報を消す
+	Deactivate(ctx context.Context, id int64, name string, displayName string, deletedAt int64) error
+	// ListPage はidがafterIDより大きいユーザをidの昇順で返す
+	ListPage(ctx context.Context, afterID int64, limit int) ([]UserModel, error)
+	SetRole(ctx context.Context, id int64, role Role) error
+	// SetSuspendedAt は利用停止の日時を設定する (Validがfalseなら停止を解除する)
+	SetSuspendedAt(ctx context.Context, id int64, suspendedAt sql.NullInt64) error
+}
+
+type ThemeRepository interface {
+	GetByUserID(ctx context.Context, userID int64) (ThemeModel, error)
+	ListByUserIDs(ctx context.Context, userIDs []int64) ([]ThemeModel, error)
+	List(ctx context.Context) ([]ThemeModel, error)
+	Create(ctx context.Context, theme ThemeModel) (int64, error)
+	DeleteByUserID(ctx context.Context, userID int64) error
+}
+
+type IconRepository interface {
+	GetHashByUserID(ctx context.Context, userID int64) (string, error)
+	ListHashesByUserIDs(ctx context.Context, userIDs []int64) ([]IconModel, error)
+	ListHashes(ctx context.Context) ([]IconModel, error)
+	// Replace はユーザのアイコンを差し替え、新しい行のIDを返す (画像本体はIconStoreに保存する)
+	Replace(ctx context.Context, userID int64, imageHash string, contentType string) (int64, error)
+	DeleteByUserID(ctx context.Context, userID int64) error
+	// HashExists は画像を参照しているユーザがいるかどうか
+	HashExists(ctx context.Context, imageHash string) (bool, error)
+
+	// 以下はIconStore導入前にiconsテーブルのimageカラムに保存された画像
+	GetLegacyImage(ctx context.Context, imageHash string) (IconModel, error)
+	ListLegacyIDs(ctx context.Context) ([]int64, error)
+	GetLegacy(ctx context.Context, id int64) (IconModel, error)
+	PurgeLegacyImage(ctx context.Context, id int64) error
+
+	// 以下はicon_imagesテーブル (ISUCON13_ICON_STORE=mysql) の画像
+	// PutImageは既にあれば書き直す
+	PutImage(ctx context.Context, key iconCacheKey, icon *cachedIcon) error
+	GetImage(ctx context.Context, key iconCacheKey) (*cachedIcon, error)
+	DeleteImage(ctx context.Context, key iconCacheKey) error
+}
+
+type TagRepository interface {
+	List(ctx context.Context) ([]TagModel, error)
+	ListByIDs(ctx context.Context, ids []int64) ([]TagModel, error)
+	ListIDsByName(ctx context.Context, name string) ([]int64, error)
+	Get(ctx context.Context, id int64) (TagModel, error)
+	// Create は追加したタグのIDを返す (名前が重複する場合はエラー)
+	Create(ctx context.Context, name string) (int64, error)
+	// Delete はタグと、配信に付いたそのタグを削除する
+	Delete(ctx context.Context, id int64) error
+}
+
+type LivestreamRepository interface {
+	Get(ctx context.Context, id int64) (LivestreamModel, error)
+	Exists(ctx context.Context, id int64) (bool, error)
+	ListByIDs(ctx context.Context, ids []int64) ([]LivestreamModel, error)
+	// ListByUserID はidの昇順で返す
+	ListByUserID(ctx context.Context, userID int64) ([]LivestreamModel, error)
+	// ListPageByUserID はidがafterIDより大きいものをidの昇順でlimit件まで返す (エクスポート用)
+	ListPageByUserID(ctx context.Context, userID int64, afterID int64, limit int) ([]LivestreamModel, error)
+	List(ctx context.Context) ([]LivestreamModel, error)
+	// ListLatest はidの降順で返す
+	ListLatest(ctx context.Context, limit int) ([]LivestreamModel, error)
+	// ListStartingAfterForUpdate はまだ始まっていない配信をロックして取得する
+	ListStartingAfterForUpdate(ctx context.Context, userID int64, now int64) ([]LivestreamModel, error)
+	// ListScores は配信ごとに、リアクション数とチップの合計を返す
+	ListScores(ctx context.Context, ids []int64) ([]LivestreamScoreModel, error)
+	Create(ctx context.Context, livestream LivestreamModel) (int64, error)
+	// Delete は配信と、配信に紐づくタグ・NGワード・ライブコメントなどをすべて削除する
+	Delete(ctx context.Context, id int64) error
+
+	AddTag(ctx context.Context, livestreamID int64, tagID int64) error
+	// ListTags はlivestream_tagsのidの昇順で返す
+	ListTags(ctx context.Context, livestreamIDs []int64) ([]LivestreamTagModel, error)
+	// ListTagged はタグの付いた配信をlivestream_idの降順で返す
+	ListTagged(ctx context.Context, tagIDs []int64) ([]LivestreamTagModel, error)
+}
+
+// ViewerRepository は視聴履歴 (退出すると消える) とユニーク視聴者 (消えない)
+type ViewerRepository interface {
+	AddHistory(ctx context.Context, viewer LivestreamViewerModel) error
+	// AddUnique は既に視聴したことがあれば何もしない
+	AddUnique(ctx context.Context, viewer LivestreamViewerModel) error
+	DeleteHistory(ctx context.Context, userID int64, livestreamID int64) error
+	DeleteHistoryByUserID(ctx context.Context, userID int64) error
+	// HistoryExists はenterしてからexitしていないかを返す
+	HistoryExists(ctx context.Context, userID int64, livestreamID int64) (bool, error)
+	CountHistory(ctx context.Context, livestreamID int64) (int64, error)
+	CountUnique(ctx context.Context, livestreamID int64) (int64, error)
+	// ListHistoryPageByUserID はidがafterIDより大きいものをidの昇順でlimit件まで返す
+	ListHistoryPageByUserID(ctx context.Context, userID int64, afterID int64, limit int) ([]LivestreamViewerHistoryModel, error)
+
+	// 以下はheartbeatによる視聴者の最終確認時刻 (livestream_presence) と最大同時視聴者数
+	TouchPresence(ctx context.Context, livestreamID int64, userID int64, seenAt int64) error
+	// PresenceExists はsince以降に確認した視聴者かどうかを返す
+	PresenceExists(ctx context.Context, livestreamID int64, userID int64, since int64) (bool, error)
+	DeletePresence(ctx context.Context, livestreamID int64, userID int64) error
+	// CountPresence はsince以降に確認した視聴者の数を返す
+	CountPresence(ctx context.Context, livestreamID int64, since int64) (int64, error)
+	// DeleteExpiredPresence はbeforeより前に確認したきりの視聴者を削除する
+	DeleteExpiredPresence(ctx context.Context, before int64) error
+	// RecordPeakPresence は最大同時視聴者数をcountまで引き上げる (下がることはない)
+	RecordPeakPresence(ctx context.Context, livestreamID int64, count int64) error
+	// GetPeakPresence は記録がなければ0を返す
+	GetPeakPresence(ctx context.Context, livestreamID int64) (int64, error)
+}
+
+// ReservationRepository は配信予約枠
+// 期間 [startAt, endAt] に含まれる枠をまとめて扱う
+type ReservationRepository interface {
+	// ListForUpdate は期間内の枠をロックして取得する (並列な予約のoverbooking防止)
+	ListForUpdate(ctx context.Context, startAt int64, endAt int64) ([]ReservationSlotModel, error)
+	// GetSlot は開始・終了時刻がちょうど一致する枠の残り数を返す
+	GetSlot(ctx context.Context, startAt int64, endAt int64) (int64, error)
+	// Reserve は期間内の枠を1つずつ減らす
+	Reserve(ctx context.Context, startAt int64, endAt int64) error
+	// Release は期間内の枠を1つずつ戻す
+	Release(ctx context.Context, startAt int64, endAt int64) error
+	// List は期間内の枠をstart_atの昇順で返す
+	List(ctx context.Context, startAt int64, endAt int64) ([]ReservationSlotModel, error)
+	// AddCapacity は期間内の枠の残り数をdeltaだけ増減する (0未満にはならない)
+	AddCapacity(ctx context.Context, startAt int64, endAt int64, delta int64) error
+}
+
+type LivecommentRepository interface {
+	Get(ctx context.Context, id int64) (LivecommentModel, error)
+	ListByIDs(ctx context.Context, ids []int64) ([]LivecommentModel, error)
+	// ListByLivestreamID はcreated_atの降順で返す
+	ListByLivestreamID(ctx context.Context, livestreamID int64, limit int) ([]LivecommentModel, error)
+	List(ctx context.Context) ([]LivecommentModel, error)
+	// ListPageByUserID はidがafterIDより大きいものをidの昇順でlimit件まで返す (tipOnlyならチップ付きのものだけ)
+	ListPageByUserID(ctx context.Context, userID int64, tipOnly bool, afterID int64, limit int) ([]LivecommentModel, error)
+	// ListTipsReceivedPage は配信者の配信に送られたチップ付きのライブコメントのうち、
+	// idがafterIDより大きいものをidの昇順でlimit件まで返す
+	ListTipsReceivedPage(ctx context.Context, streamerID int64, afterID int64, limit int) ([]LivecommentModel, error)
+	MaxTip(ctx context.Context, livestreamID int64) (int64, error)
+	TotalTip(ctx context.Context) (int64, error)
+	// ListSupporters はチップ合計額の降順 (同額ならuser_idの昇順) で返す
+	ListSupporters(ctx context.Context, scope supporterScope, limit int) ([]supporterTotalModel, error)
+	SupporterTotal(ctx context.Context, scope supporterScope, userID int64) (int64, error)
+	// CountSupportersAbove はListSupportersの順でuserIDより上位のサポーター数を返す
+	CountSupportersAbove(ctx context.Context, scope supporterScope, totalTip int64, userID int64) (int64, error)
+	Create(ctx context.Context, livecomment LivecommentModel) (int64, error)
+	Delete(ctx context.Context, id int64) error
+	// DeleteByUserID は退会したユーザのライブコメントを削除する
+	// チップ付きのライブコメントは売上の集計に使うので、本文だけ消して残す
+	DeleteByUserID(ctx context.Context, userID int64) error
+}
+
+type ReportRepository interface {
+	// ListByLivestreamID はidの昇順で返す
+	ListByLivestreamID(ctx context.Context, livestreamID int64) ([]LivecommentReportModel, error)
+	// ListPageByUserID はidがafterIDより大きいものをidの昇順でlimit件まで返す
+	ListPageByUserID(ctx context.Context, userID int64, afterID int64, limit int) ([]LivecommentReportModel, error)
+	CountByLivestreamID(ctx context.Context, livestreamID int64) (int64, error)
+	Create(ctx context.Context, report LivecommentReportModel) (int64, error)
+	DeleteByUserID(ctx context.Context, userID int64) error
+}
+
+type ReactionRepository interface {
+	// ListByLivestreamID はcreated_atの降順で返す
+	ListByLivestreamID(ctx context.Context, livestreamID int64, limit int) ([]ReactionModel, error)
+	// ListPageByUserID はidがafterIDより大きいものをidの昇順でlimit件まで返す
+	ListPageByUserID(ctx context.Context, userID int64, afterID int64, limit int) ([]ReactionModel, error)
+	CountByLivestreamID(ctx context.Context, livestreamID int64) (int64, error)
+	// CountByStreamerName は配信者の全配信へのリアクション数を返す
+	CountByStreamerName(ctx context.Context, name string) (int64, error)
+	// FavoriteEmojiByStreamerName は配信者の配信で最も多く使われた絵文字を返す (同数なら名前の降順で先頭)
+	FavoriteEmojiByStreamerName(ctx context.Context, name string) (string, error)
+	Create(ctx context.Context, reaction ReactionModel) (int64, error)
+	DeleteByUserID(ctx context.Context, userID int64) error
+}
+
+type NGWordRepository interface {
+	// ListByUserAndLivestream はcreated_atの降順で返す
+	ListByUserAndLivestream(ctx context.Context, userID int64, livestreamID int64) ([]NGWord, error)
+	ListByLivestreamID(ctx context.Context, livestreamID int64) ([]NGWord, error)
+	// ListPageByUserID はidがafterIDより大きいものをidの昇順でlimit件まで返す
+	ListPageByUserID(ctx context.Context, userID int64, afterID int64, limit int) ([]NGWord, error)
+	// Matches はtextがwordを含むかを返す (MySQLのLIKE '%word%' と同じ判定)
+	Matches(ctx context.Context, text string, word string) (bool, error)
+	Create(ctx context.Context, ngword NGWord) (int64, error)
+}
+
+// ModerationRepository は配信者ごとのモデレータ・BANと、モデレーション操作の記録
+type ModerationRepository interface {
+	// ListModerators はidの昇順で返す
+	ListModerators(ctx context.Context, streamerID int64) ([]ChannelModeratorModel, error)
+	IsModerator(ctx context.Context, streamerID int64, userID int64) (bool, error)
+	// AddModerator は既にモデレータの場合はエラー
+	AddModerator(ctx context.Context, moderator ChannelModeratorModel) (int64, error)
+	RemoveModerator(ctx context.Context, streamerID int64, userID int64) error
+	// ListBans はidの昇順で返す
+	ListBans(ctx context.Context, streamerID int64) ([]ChannelBanModel, error)
+	IsBanned(ctx context.Context, streamerID int64, userID int64) (bool, error)
+	// Ban は既にBANされている場合はエラー
+	Ban(ctx context.Context, ban ChannelBanModel) (int64, error)
+	Unban(ctx context.Context, streamerID int64, userID int64) error
+	AddLog(ctx context.Context, log ModerationLogModel) (int64, error)
+	// ListLogs はidの降順で返す
+	ListLogs(ctx context.Context, streamerID int64, limit int) ([]ModerationLogModel, error)
+	// DeleteByUserID は退会したユーザのモデレータの任命 (任命した側・された側とも) と、配信者として設定したBANを削除する
+	// 操作の記録は残す
+	DeleteByUserID(ctx context.Context, userID int64) error
+}
+
+// AccessTokenRepository は個人用アクセストークン (token_hashで照合する)
+type AccessTokenRepository interface {
+	Get(ctx context.Context, id int64) (AccessTokenModel, error)
+	GetByHash(ctx context.Context, tokenHash string) (AccessTokenModel, error)
+	// ListByUserID は取り消していないトークンをidの昇順で返す
+	ListByUserID(ctx context.Context, userID int64) ([]AccessTokenModel, error)
+	// Create は追加したトークンのIDを返す (ハッシュが重複する場合はエラー)
+	Create(ctx context.Context, token AccessTokenModel) (int64, error)
+	Revoke(ctx context.Context, id int64, revokedAt int64) error
+	// RevokeByUserID はユーザのトークンをすべて取り消す
+	RevokeByUserID(ctx context.Context, userID int64, revokedAt int64) error
+	SetLastUsedAt(ctx context.Context, id int64, lastUsedAt int64) error
+}
+
+type DNSOutboxRepository interface {
+	Enqueue(ctx context.Context, action string, name string) (int64, error)
+	// Claim はエントリをlockedUntilまで借りて返す
+	// 他のトランザクションがロックしているか他のワーカーが借りている場合、
+	// 再送の時刻 (next_attempt_at) になっていない場合、デッドレターの場合はsql.ErrNoRowsを返す
+	// 同じ名前の古いエントリ (デッドレターを除く) が残っている間も、順番が入れ替わらないようsql.ErrNoRowsを返す
+	Claim(ctx context.Context, id int64, now int64, lockedUntil int64) (DNSOutboxModel, error)
+	// RecordFailure は失敗した回数と原因を記録して貸し出しを終える (deadAtが有効ならデッドレターにする)
+	RecordFailure(ctx context.Context, id int64, attempts int64, lastError string, nextAttemptAt int64, deadAt sql.NullInt64) error
+	Delete(ctx context.Context, id int64) error
+	// ListDueIDs はcreatedAt以前に作られ、nowの時点で再送できるエントリのIDを昇順で返す
+	ListDueIDs(ctx context.Context, createdAt int64, now int64) ([]int64, error)
+	// ExistsByName はデッドレターを除いて、nameのエントリが残っているかを返す
+	ExistsByName(ctx context.Context, name string) (bool, error)
+	CountDead(ctx context.Context) (int64, error)
+	// DeleteDeadByName はnameのデッドレターを削除する (reconcileで反映し直したもの)
+	DeleteDeadByName(ctx context.Context, name string) error
+}
+
+// StaffGrantRepository は/api/initializeで消さないテーブルなので、Resetの後も残る
+type StaffGrantRepository interface {
+	// Upsert は名前ごとに権限を記録する (hashedPasswordが無効なら記録済みのものを残す)
+	Upsert(ctx context.Context, grant StaffGrantModel) error
+	DeleteByName(ctx context.Context, name string) error
+	// List はidの昇順で返す
+	List(ctx context.Context) ([]StaffGrantModel, error)
+}
+
+type UserScoreModel struct {
+	UserID    int64  `db:"user_id"`
+	Username  string `db:"username"`
+	Reactions int64  `db:"reactions"`
+	Tips      int64  `db:"tips"`
+}
+
+type LivestreamScoreModel struct {
+	LivestreamID int64 `db:"livestream_id"`
+	Reactions    int64 `db:"reactions"`
+	TotalTips    int64 `db:"total_tips"`
+}
+
+var store Store
+
+// withTx はfを1つのトランザクションで実行し、エラーがなければコミットする
+func withTx(ctx context.Context, f func(tx Tx) error) error {
+	tx, err := store.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if err := f(tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// withReadOnlyTx はfを読み取り専用のトランザクションで実行する
+func withReadOnlyTx(ctx context.Context, f func(tx Tx) error) error {
+	tx, err := store.BeginReadOnly(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if err := f(tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// newStore はappConfig.Store.Backendで指定されたストアを作る
+func newStore(cfg StoreConfig) (Store, error) {
+	switch cfg.Backend {
+	case storeBackendMySQL:
+		db, err := connectDB()
+		if err != nil {
+			return nil, err
+		}
+		replicas, err := newReplicaPool(appConfig.Database)
+		if err != nil {
+			db.Close()
+			return nil, err
+		}
+		return &mysqlStore{db: db, replicas: replicas}, nil
+	case storeBackendMemory:
+		return newMemoryStore()
+	default:
+		return nil, fmt.Errorf("unknown store backend '%s' (mysql or memory)", cfg.Backend)
+	}
+}
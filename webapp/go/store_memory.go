@@ -0,0 +1,1935 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// memoryStore はMySQLなしでローカル開発するためのインメモリの実装
+// 並び順・一意制約・予約枠の増減はSQL版と同じになるようにしている
+//
+// 書き込むトランザクションは最初の書き込み (またはFOR UPDATE相当の読み取り) の時点でtxMuを取り、
+// Commit/Rollbackまで他の書き込みを待たせる。読み取りはtxMuを取らないので、
+// 他のトランザクションのコミット前の変更が見える (READ UNCOMMITTED相当)。
+// DNSの反映のようにトランザクション中に同期的に別のトランザクションで読み取る処理があるため、
+// 読み取りまで直列化するとデッドロックする
+type memoryStore struct {
+	// mu はdataを守る (操作ごとに取る)
+	mu sync.RWMutex
+	// txMu は書き込むトランザクションを直列化する
+	txMu sync.Mutex
+	// locks はLockで取る名前付きロック (使われている間だけ残す)
+	locksMu sync.Mutex
+	locks   map[string]*memoryNamedLock
+
+	data *memoryData
+}
+
+type memoryData struct {
+	users            *memoryTable[UserModel]
+	themes           *memoryTable[ThemeModel]
+	icons            *memoryTable[IconModel]
+	iconImages       map[iconCacheKey]cachedIcon
+	tags             *memoryTable[TagModel]
+	livestreams      *memoryTable[LivestreamModel]
+	livestreamTags   *memoryTable[LivestreamTagModel]
+	viewersHistory   *memoryTable[memoryViewerRow]
+	uniqueViewers    map[[2]int64]LivestreamViewerModel
+	presence         map[[2]int64]int64 // [livestream_id, user_id] -> last_seen_at
+	presencePeaks    map[int64]int64
+	reservationSlots *memoryTable[ReservationSlotModel]
+	livecomments     *memoryTable[LivecommentModel]
+	reports          *memoryTable[LivecommentReportModel]
+	reactions        *memoryTable[ReactionModel]
+	ngWords          *memoryTable[NGWord]
+	moderators       *memoryTable[ChannelModeratorModel]
+	bans             *memoryTable[ChannelBanModel]
+	moderationLogs   *memoryTable[ModerationLogModel]
+	accessTokens     *memoryTable[AccessTokenModel]
+	dnsOutbox        *memoryTable[DNSOutboxModel]
+	staffGrants      *memoryTable[StaffGrantModel]
+}
+
+// memoryViewerRow はlivestream_viewers_historyの行 (LivestreamViewerModelにはidがない)
+type memoryViewerRow struct {
+	ID int64
+	LivestreamViewerModel
+}
+
+func newMemoryData() *memoryData {
+	return &memoryData{
+		users:            newMemoryTable[UserModel](),
+		themes:           newMemoryTable[ThemeModel](),
+		icons:            newMemoryTable[IconModel](),
+		iconImages:       make(map[iconCacheKey]cachedIcon),
+		tags:             newMemoryTable[TagModel](),
+		livestreams:      newMemoryTable[LivestreamModel](),
+		livestreamTags:   newMemoryTable[LivestreamTagModel](),
+		viewersHistory:   newMemoryTable[memoryViewerRow](),
+		uniqueViewers:    make(map[[2]int64]LivestreamViewerModel),
+		presence:         make(map[[2]int64]int64),
+		presencePeaks:    make(map[int64]int64),
+		reservationSlots: newMemoryTable[ReservationSlotModel](),
+		livecomments:     newMemoryTable[LivecommentModel](),
+		reports:          newMemoryTable[LivecommentReportModel](),
+		reactions:        newMemoryTable[ReactionModel](),
+		ngWords:          newMemoryTable[NGWord](),
+		moderators:       newMemoryTable[ChannelModeratorModel](),
+		bans:             newMemoryTable[ChannelBanModel](),
+		moderationLogs:   newMemoryTable[ModerationLogModel](),
+		accessTokens:     newMemoryTable[AccessTokenModel](),
+		dnsOutbox:        newMemoryTable[DNSOutboxModel](),
+		staffGrants:      newMemoryTable[StaffGrantModel](),
+	}
+}
+
+// memoryTable はAUTO_INCREMENTの主キーを持つテーブル
+type memoryTable[T any] struct {
+	rows map[int64]T
+	// lastID はロールバックしても戻さない (MySQLのAUTO_INCREMENTと同じ)
+	lastID int64
+}
+
+func newMemoryTable[T any]() *memoryTable[T] {
+	return &memoryTable[T]{rows: make(map[int64]T)}
+}
+
+func (t *memoryTable[T]) nextID() int64 {
+	t.lastID++
+	return t.lastID
+}
+
+// useID は明示的に指定されたidで追加する場合に、以降の採番がそれより後になるようにする
+func (t *memoryTable[T]) useID(id int64) {
+	if id > t.lastID {
+		t.lastID = id
+	}
+}
+
+func (t *memoryTable[T]) get(id int64) (T, error) {
+	row, ok := t.rows[id]
+	if !ok {
+		return row, sql.ErrNoRows
+	}
+	return row, nil
+}
+
+// selectRows は条件に合う行をidの昇順で返す
+func (t *memoryTable[T]) selectRows(where func(T) bool) []T {
+	ids := make([]int64, 0, len(t.rows))
+	for id, row := range t.rows {
+		if where == nil || where(row) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	rows := make([]T, len(ids))
+	for i, id := range ids {
+		rows[i] = t.rows[id]
+	}
+	return rows
+}
+
+// selectByIDs はidsに含まれる行を返す (存在しないidは無視する)
+func (t *memoryTable[T]) selectByIDs(ids []int64) []T {
+	rows := make([]T, 0, len(ids))
+	seen := make(map[int64]struct{}, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		if row, ok := t.rows[id]; ok {
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+func (t *memoryTable[T]) count(where func(T) bool) int64 {
+	var n int64
+	for _, row := range t.rows {
+		if where(row) {
+			n++
+		}
+	}
+	return n
+}
+
+func newMemoryStore() (*memoryStore, error) {
+	data, err := loadMemorySeed()
+	if err != nil {
+		return nil, err
+	}
+	return &memoryStore{data: data}, nil
+}
+
+type memoryNamedLock struct {
+	mu   sync.Mutex
+	refs int
+}
+
+func (s *memoryStore) Lock(ctx context.Context, name string) (func(), error) {
+	s.locksMu.Lock()
+	if s.locks == nil {
+		s.locks = make(map[string]*memoryNamedLock)
+	}
+	l, ok := s.locks[name]
+	if !ok {
+		l = &memoryNamedLock{}
+		s.locks[name] = l
+	}
+	l.refs++
+	s.locksMu.Unlock()
+
+	l.mu.Lock()
+	return func() {
+		l.mu.Unlock()
+		s.locksMu.Lock()
+		defer s.locksMu.Unlock()
+		if l.refs--; l.refs == 0 {
+			delete(s.locks, name)
+		}
+	}, nil
+}
+
+func (s *memoryStore) Begin(ctx context.Context) (Tx, error) {
+	return &memoryTx{s: s}, nil
+}
+
+func (s *memoryStore) BeginReadOnly(ctx context.Context) (Tx, error) {
+	return &memoryTx{s: s, readOnly: true}, nil
+}
+
+// BeginReplicaRead はレプリカがないので、BeginReadOnlyと同じ
+func (s *memoryStore) BeginReplicaRead(ctx context.Context) (Tx, error) {
+	return s.BeginReadOnly(ctx)
+}
+
+// Reset は初期データを読み込み直す
+func (s *memoryStore) Reset(ctx context.Context, timer *initializeTimer) error {
+	var data *memoryData
+	err := timer.measure(ctx, "parse_seed", func(ctx context.Context) error {
+		var err error
+		data, err = loadMemorySeed()
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	s.txMu.Lock()
+	defer s.txMu.Unlock()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// staff_grantsは初期化しない (MySQLと同じ)
+	data.staffGrants = s.data.staffGrants
+	s.data = data
+	return nil
+}
+
+func (s *memoryStore) Ping(ctx context.Context) error {
+	return nil
+}
+
+func (s *memoryStore) Close() error {
+	return nil
+}
+
+var errReadOnlyTx = errors.New("cannot write in a read-only transaction")
+
+type memoryTx struct {
+	s        *memoryStore
+	readOnly bool
+	// locked はtxMuを取っているか
+	locked bool
+	done   bool
+	// undo はRollbackで逆順に適用する
+	undo []func()
+}
+
+// lock は書き込みの前にtxMuを取る
+func (t *memoryTx) lock() error {
+	if t.done {
+		return sql.ErrTxDone
+	}
+	if t.readOnly {
+		return errReadOnlyTx
+	}
+	if !t.locked {
+		t.s.txMu.Lock()
+		t.locked = true
+	}
+	return nil
+}
+
+func (t *memoryTx) read(f func(d *memoryData) error) error {
+	if t.done {
+		return sql.ErrTxDone
+	}
+	t.s.mu.RLock()
+	defer t.s.mu.RUnlock()
+	return f(t.s.data)
+}
+
+// readForUpdate はSELECT ... FOR UPDATEに相当する
+func (t *memoryTx) readForUpdate(f func(d *memoryData) error) error {
+	if err := t.lock(); err != nil {
+		return err
+	}
+	return t.read(f)
+}
+
+func (t *memoryTx) write(f func(d *memoryData) error) error {
+	if err := t.lock(); err != nil {
+		return err
+	}
+	t.s.mu.Lock()
+	defer t.s.mu.Unlock()
+	return f(t.s.data)
+}
+
+func (t *memoryTx) finish() {
+	t.done = true
+	t.undo = nil
+	if t.locked {
+		t.locked = false
+		t.s.txMu.Unlock()
+	}
+}
+
+func (t *memoryTx) Commit() error {
+	if t.done {
+		return sql.ErrTxDone
+	}
+	t.finish()
+	return nil
+}
+
+func (t *memoryTx) Rollback() error {
+	if t.done {
+		return sql.ErrTxDone
+	}
+	if len(t.undo) > 0 {
+		t.s.mu.Lock()
+		for i := len(t.undo) - 1; i >= 0; i-- {
+			t.undo[i]()
+		}
+		t.s.mu.Unlock()
+	}
+	t.finish()
+	return nil
+}
+
+// putRow は行を追加・更新し、Rollbackで元に戻せるようにする
+func putRow[K comparable, V any](t *memoryTx, rows map[K]V, key K, row V) {
+	prev, existed := rows[key]
+	rows[key] = row
+	t.undo = append(t.undo, func() {
+		if existed {
+			rows[key] = prev
+		} else {
+			delete(rows, key)
+		}
+	})
+}
+
+func deleteRow[K comparable, V any](t *memoryTx, rows map[K]V, key K) {
+	prev, existed := rows[key]
+	if !existed {
+		return
+	}
+	delete(rows, key)
+	t.undo = append(t.undo, func() {
+		rows[key] = prev
+	})
+}
+
+// deleteWhere は条件に合う行をすべて削除する
+func deleteWhere[T any](t *memoryTx, table *memoryTable[T], where func(T) bool) {
+	for id, row := range table.rows {
+		if where(row) {
+			deleteRow(t, table.rows, id)
+		}
+	}
+}
+
+func (t *memoryTx) Users() UserRepository               { return memoryUserRepository{t} }
+func (t *memoryTx) Themes() ThemeRepository             { return memoryThemeRepository{t} }
+func (t *memoryTx) Icons() IconRepository               { return memoryIconRepository{t} }
+func (t *memoryTx) Tags() TagRepository                 { return memoryTagRepository{t} }
+func (t *memoryTx) Livestreams() LivestreamRepository   { return memoryLivestreamRepository{t} }
+func (t *memoryTx) Viewers() ViewerRepository           { return memoryViewerRepository{t} }
+func (t *memoryTx) Reservations() ReservationRepository { return memoryReservationRepository{t} }
+func (t *memoryTx) Livecomments() LivecommentRepository { return memoryLivecommentRepository{t} }
+func (t *memoryTx) Reports() ReportRepository           { return memoryReportRepository{t} }
+func (t *memoryTx) Reactions() ReactionRepository       { return memoryReactionRepository{t} }
+func (t *memoryTx) NGWords() NGWordRepository           { return memoryNGWordRepository{t} }
+func (t *memoryTx) Moderation() ModerationRepository    { return memoryModerationRepository{t} }
+func (t *memoryTx) AccessTokens() AccessTokenRepository { return memoryAccessTokenRepository{t} }
+func (t *memoryTx) DNSOutbox() DNSOutboxRepository      { return memoryDNSOutboxRepository{t} }
+func (t *memoryTx) StaffGrants() StaffGrantRepository   { return memoryStaffGrantRepository{t} }
+
+// sortByCreatedAtDesc はidの昇順に並んだ行をcreated_atの降順に並べ替え、limit件に絞る
+func sortByCreatedAtDesc[T any](rows []T, createdAt func(T) int64, limit int) []T {
+	sort.SliceStable(rows, func(i, j int) bool { return createdAt(rows[i]) > createdAt(rows[j]) })
+	return limitRows(rows, limit)
+}
+
+// limitRows はlimit件に絞る
+func limitRows[T any](rows []T, limit int) []T {
+	if limit != noLimit && len(rows) > limit {
+		rows = rows[:limit]
+	}
+	return rows
+}
+
+type memoryUserRepository struct{ t *memoryTx }
+
+func (r memoryUserRepository) Get(ctx context.Context, id int64) (user UserModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		user, err = d.users.get(id)
+		return err
+	})
+	return user, err
+}
+
+func (r memoryUserRepository) GetForUpdate(ctx context.Context, id int64) (user UserModel, err error) {
+	err = r.t.readForUpdate(func(d *memoryData) error {
+		user, err = d.users.get(id)
+		return err
+	})
+	return user, err
+}
+
+func (r memoryUserRepository) GetByName(ctx context.Context, name string) (user UserModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		user, err = d.userByName(name)
+		return err
+	})
+	return user, err
+}
+
+func (d *memoryData) userByName(name string) (UserModel, error) {
+	for _, user := range d.users.rows {
+		if user.Name == name {
+			return user, nil
+		}
+	}
+	return UserModel{}, sql.ErrNoRows
+}
+
+func (r memoryUserRepository) FindNameCaseInsensitive(ctx context.Context, name string) (existing string, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		lower := strings.ToLower(name)
+		for _, user := range d.users.selectRows(nil) {
+			if strings.ToLower(user.Name) == lower {
+				existing = user.Name
+				return nil
+			}
+		}
+		return sql.ErrNoRows
+	})
+	return existing, err
+}
+
+func (r memoryUserRepository) ListByIDs(ctx context.Context, ids []int64) (users []UserModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		users = d.users.selectByIDs(ids)
+		return nil
+	})
+	return users, err
+}
+
+func (r memoryUserRepository) List(ctx context.Context) (users []UserModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		users = d.users.selectRows(nil)
+		return nil
+	})
+	return users, err
+}
+
+func (r memoryUserRepository) ListActiveNames(ctx context.Context) (names []string, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		for _, user := range d.users.selectRows(func(u UserModel) bool { return !u.DeletedAt.Valid }) {
+			names = append(names, user.Name)
+		}
+		return nil
+	})
+	return names, err
+}
+
+func (r memoryUserRepository) ActiveNameExists(ctx context.Context, name string) (exists bool, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		lower := strings.ToLower(name)
+		for _, user := range d.users.rows {
+			if strings.ToLower(user.Name) == lower && !user.DeletedAt.Valid {
+				exists = true
+				break
+			}
+		}
+		return nil
+	})
+	return exists, err
+}
+
+// ListScores はSQL版のLEFT JOINと同じく、リアクションとライブコメントの直積で数える
+// (リアクション数はライブコメント数倍、チップはリアクション数倍になる)
+func (r memoryUserRepository) ListScores(ctx context.Context) (scores []UserScoreModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		byLivestream := d.livestreamScores(nil)
+		users := d.users.selectRows(nil)
+		index := make(map[int64]int, len(users))
+		scores = make([]UserScoreModel, len(users))
+		for i, user := range users {
+			scores[i] = UserScoreModel{UserID: user.ID, Username: user.Name}
+			index[user.ID] = i
+		}
+		for _, livestream := range d.livestreams.rows {
+			i, ok := index[livestream.UserID]
+			if !ok {
+				continue
+			}
+			score := byLivestream[livestream.ID]
+			scores[i].Reactions += score.Reactions
+			scores[i].Tips += score.TotalTips
+		}
+		sort.SliceStable(scores, func(i, j int) bool {
+			return scores[i].Reactions+scores[i].Tips > scores[j].Reactions+scores[j].Tips
+		})
+		return nil
+	})
+	return scores, err
+}
+
+// livestreamScores は配信ごとのスコアを集計する (idsがnilなら全配信)
+func (d *memoryData) livestreamScores(ids []int64) map[int64]LivestreamScoreModel {
+	var target map[int64]struct{}
+	if ids != nil {
+		target = make(map[int64]struct{}, len(ids))
+		for _, id := range ids {
+			target[id] = struct{}{}
+		}
+	}
+	include := func(livestreamID int64) bool {
+		if target == nil {
+			return true
+		}
+		_, ok := target[livestreamID]
+		return ok
+	}
+
+	reactions := make(map[int64]int64)
+	for _, reaction := range d.reactions.rows {
+		if include(reaction.LivestreamID) {
+			reactions[reaction.LivestreamID]++
+		}
+	}
+	comments := make(map[int64]int64)
+	tips := make(map[int64]int64)
+	for _, livecomment := range d.livecomments.rows {
+		if include(livecomment.LivestreamID) {
+			comments[livecomment.LivestreamID]++
+			tips[livecomment.LivestreamID] += livecomment.Tip
+		}
+	}
+
+	scores := make(map[int64]LivestreamScoreModel)
+	for id := range d.livestreams.rows {
+		if !include(id) {
+			continue
+		}
+		scores[id] = LivestreamScoreModel{
+			LivestreamID: id,
+			Reactions:    reactions[id] * max(comments[id], 1),
+			TotalTips:    tips[id] * max(reactions[id], 1),
+		}
+	}
+	return scores
+}
+
+func (r memoryUserRepository) Create(ctx context.Context, user UserModel) (id int64, err error) {
+	err = r.t.write(func(d *memoryData) error {
+		// uniq_user_name と idx_users_name_lower
+		lower := strings.ToLower(user.Name)
+		for _, u := range d.users.rows {
+			if u.Name == user.Name || strings.ToLower(u.Name) == lower {
+				return errDuplicateEntry
+			}
+		}
+		id = d.users.nextID()
+		user.ID = id
+		if user.Role == "" {
+			user.Role = RoleViewer
+		}
+		putRow(r.t, d.users.rows, id, user)
+		return nil
+	})
+	return id, err
+}
+
+func (r memoryUserRepository) Deactivate(ctx context.Context, id int64, name string, displayName string, deletedAt int64) error {
+	return r.t.write(func(d *memoryData) error {
+		user, ok := d.users.rows[id]
+		if !ok {
+			return nil
+		}
+		user.Name = name
+		user.DisplayName = displayName
+		user.Description = ""
+		user.HashedPassword = ""
+		user.DeletedAt = sql.NullInt64{Int64: deletedAt, Valid: true}
+		putRow(r.t, d.users.rows, id, user)
+		return nil
+	})
+}
+
+func (r memoryUserRepository) ListPage(ctx context.Context, afterID int64, limit int) (users []UserModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		users = limitRows(d.users.selectRows(func(u UserModel) bool { return u.ID > afterID }), limit)
+		return nil
+	})
+	return users, err
+}
+
+func (r memoryUserRepository) SetRole(ctx context.Context, id int64, role Role) error {
+	return r.t.write(func(d *memoryData) error {
+		user, ok := d.users.rows[id]
+		if !ok {
+			return nil
+		}
+		user.Role = role
+		putRow(r.t, d.users.rows, id, user)
+		return nil
+	})
+}
+
+func (r memoryUserRepository) SetSuspendedAt(ctx context.Context, id int64, suspendedAt sql.NullInt64) error {
+	return r.t.write(func(d *memoryData) error {
+		user, ok := d.users.rows[id]
+		if !ok {
+			return nil
+		}
+		user.SuspendedAt = suspendedAt
+		putRow(r.t, d.users.rows, id, user)
+		return nil
+	})
+}
+
+type memoryThemeRepository struct{ t *memoryTx }
+
+func (r memoryThemeRepository) GetByUserID(ctx context.Context, userID int64) (theme ThemeModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		themes := d.themes.selectRows(func(t ThemeModel) bool { return t.UserID == userID })
+		if len(themes) == 0 {
+			return sql.ErrNoRows
+		}
+		theme = themes[0]
+		return nil
+	})
+	return theme, err
+}
+
+func (r memoryThemeRepository) ListByUserIDs(ctx context.Context, userIDs []int64) (themes []ThemeModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		target := int64Set(userIDs)
+		themes = d.themes.selectRows(func(t ThemeModel) bool { _, ok := target[t.UserID]; return ok })
+		return nil
+	})
+	return themes, err
+}
+
+func (r memoryThemeRepository) List(ctx context.Context) (themes []ThemeModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		themes = d.themes.selectRows(nil)
+		return nil
+	})
+	return themes, err
+}
+
+func (r memoryThemeRepository) Create(ctx context.Context, theme ThemeModel) (id int64, err error) {
+	err = r.t.write(func(d *memoryData) error {
+		id = d.themes.nextID()
+		theme.ID = id
+		putRow(r.t, d.themes.rows, id, theme)
+		return nil
+	})
+	return id, err
+}
+
+func (r memoryThemeRepository) DeleteByUserID(ctx context.Context, userID int64) error {
+	return r.t.write(func(d *memoryData) error {
+		deleteWhere(r.t, d.themes, func(t ThemeModel) bool { return t.UserID == userID })
+		return nil
+	})
+}
+
+func int64Set(ids []int64) map[int64]struct{} {
+	set := make(map[int64]struct{}, len(ids))
+	for _, id := range ids {
+		set[id] = struct{}{}
+	}
+	return set
+}
+
+type memoryIconRepository struct{ t *memoryTx }
+
+func (r memoryIconRepository) GetHashByUserID(ctx context.Context, userID int64) (imageHash string, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		icons := d.icons.selectRows(func(i IconModel) bool { return i.UserID == userID })
+		if len(icons) == 0 {
+			return sql.ErrNoRows
+		}
+		imageHash = icons[0].ImageHash
+		return nil
+	})
+	return imageHash, err
+}
+
+func (r memoryIconRepository) ListHashesByUserIDs(ctx context.Context, userIDs []int64) (icons []IconModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		target := int64Set(userIDs)
+		icons = d.icons.selectRows(func(i IconModel) bool { _, ok := target[i.UserID]; return ok })
+		return nil
+	})
+	return icons, err
+}
+
+func (r memoryIconRepository) ListHashes(ctx context.Context) (icons []IconModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		icons = d.icons.selectRows(nil)
+		return nil
+	})
+	return icons, err
+}
+
+func (r memoryIconRepository) Replace(ctx context.Context, userID int64, imageHash string, contentType string) (id int64, err error) {
+	err = r.t.write(func(d *memoryData) error {
+		deleteWhere(r.t, d.icons, func(i IconModel) bool { return i.UserID == userID })
+		id = d.icons.nextID()
+		putRow(r.t, d.icons.rows, id, IconModel{
+			ID:          id,
+			UserID:      userID,
+			ImageHash:   imageHash,
+			ContentType: contentType,
+		})
+		return nil
+	})
+	return id, err
+}
+
+func (r memoryIconRepository) DeleteByUserID(ctx context.Context, userID int64) error {
+	return r.t.write(func(d *memoryData) error {
+		deleteWhere(r.t, d.icons, func(i IconModel) bool { return i.UserID == userID })
+		return nil
+	})
+}
+
+func (r memoryIconRepository) HashExists(ctx context.Context, imageHash string) (exists bool, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		exists = d.icons.count(func(i IconModel) bool { return i.ImageHash == imageHash }) > 0
+		return nil
+	})
+	return exists, err
+}
+
+func (r memoryIconRepository) GetLegacyImage(ctx context.Context, imageHash string) (icon IconModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		icons := d.icons.selectRows(func(i IconModel) bool { return i.ImageHash == imageHash && len(i.Image) > 0 })
+		if len(icons) == 0 {
+			return sql.ErrNoRows
+		}
+		icon = icons[0]
+		return nil
+	})
+	return icon, err
+}
+
+func (r memoryIconRepository) ListLegacyIDs(ctx context.Context) (ids []int64, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		for _, icon := range d.icons.selectRows(func(i IconModel) bool { return len(i.Image) > 0 }) {
+			ids = append(ids, icon.ID)
+		}
+		return nil
+	})
+	return ids, err
+}
+
+func (r memoryIconRepository) GetLegacy(ctx context.Context, id int64) (icon IconModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		icon, err = d.icons.get(id)
+		return err
+	})
+	return icon, err
+}
+
+func (r memoryIconRepository) PurgeLegacyImage(ctx context.Context, id int64) error {
+	return r.t.write(func(d *memoryData) error {
+		icon, ok := d.icons.rows[id]
+		if !ok {
+			return nil
+		}
+		icon.Image = nil
+		putRow(r.t, d.icons.rows, id, icon)
+		return nil
+	})
+}
+
+func (r memoryIconRepository) PutImage(ctx context.Context, key iconCacheKey, icon *cachedIcon) error {
+	return r.t.write(func(d *memoryData) error {
+		putRow(r.t, d.iconImages, key, *icon)
+		return nil
+	})
+}
+
+func (r memoryIconRepository) GetImage(ctx context.Context, key iconCacheKey) (icon *cachedIcon, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		image, ok := d.iconImages[key]
+		if !ok {
+			return sql.ErrNoRows
+		}
+		icon = &image
+		return nil
+	})
+	return icon, err
+}
+
+func (r memoryIconRepository) DeleteImage(ctx context.Context, key iconCacheKey) error {
+	return r.t.write(func(d *memoryData) error {
+		deleteRow(r.t, d.iconImages, key)
+		return nil
+	})
+}
+
+type memoryTagRepository struct{ t *memoryTx }
+
+func (r memoryTagRepository) List(ctx context.Context) (tags []TagModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		tags = d.tags.selectRows(nil)
+		return nil
+	})
+	return tags, err
+}
+
+func (r memoryTagRepository) ListByIDs(ctx context.Context, ids []int64) (tags []TagModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		tags = d.tags.selectByIDs(ids)
+		return nil
+	})
+	return tags, err
+}
+
+func (r memoryTagRepository) ListIDsByName(ctx context.Context, name string) (ids []int64, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		for _, tag := range d.tags.selectRows(func(t TagModel) bool { return t.Name == name }) {
+			ids = append(ids, tag.ID)
+		}
+		return nil
+	})
+	return ids, err
+}
+
+func (r memoryTagRepository) Get(ctx context.Context, id int64) (tag TagModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		tag, err = d.tags.get(id)
+		return err
+	})
+	return tag, err
+}
+
+func (r memoryTagRepository) Create(ctx context.Context, name string) (id int64, err error) {
+	err = r.t.write(func(d *memoryData) error {
+		// uniq_tag_name
+		for _, tag := range d.tags.rows {
+			if tag.Name == name {
+				return errDuplicateEntry
+			}
+		}
+		id = d.tags.nextID()
+		putRow(r.t, d.tags.rows, id, TagModel{ID: id, Name: name})
+		return nil
+	})
+	return id, err
+}
+
+func (r memoryTagRepository) Delete(ctx context.Context, id int64) error {
+	return r.t.write(func(d *memoryData) error {
+		deleteWhere(r.t, d.livestreamTags, func(t LivestreamTagModel) bool { return t.TagID == id })
+		deleteRow(r.t, d.tags.rows, id)
+		return nil
+	})
+}
+
+type memoryLivestreamRepository struct{ t *memoryTx }
+
+func (r memoryLivestreamRepository) Get(ctx context.Context, id int64) (livestream LivestreamModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		livestream, err = d.livestreams.get(id)
+		return err
+	})
+	return livestream, err
+}
+
+func (r memoryLivestreamRepository) Exists(ctx context.Context, id int64) (exists bool, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		_, exists = d.livestreams.rows[id]
+		return nil
+	})
+	return exists, err
+}
+
+func (r memoryLivestreamRepository) ListByIDs(ctx context.Context, ids []int64) (livestreams []LivestreamModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		livestreams = d.livestreams.selectByIDs(ids)
+		return nil
+	})
+	return livestreams, err
+}
+
+func (r memoryLivestreamRepository) ListByUserID(ctx context.Context, userID int64) (livestreams []LivestreamModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		livestreams = d.livestreams.selectRows(func(l LivestreamModel) bool { return l.UserID == userID })
+		return nil
+	})
+	return livestreams, err
+}
+
+func (r memoryLivestreamRepository) ListPageByUserID(ctx context.Context, userID int64, afterID int64, limit int) (livestreams []LivestreamModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		livestreams = limitRows(d.livestreams.selectRows(func(l LivestreamModel) bool { return l.UserID == userID && l.ID > afterID }), limit)
+		return nil
+	})
+	return livestreams, err
+}
+
+func (r memoryLivestreamRepository) List(ctx context.Context) (livestreams []LivestreamModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		livestreams = d.livestreams.selectRows(nil)
+		return nil
+	})
+	return livestreams, err
+}
+
+func (r memoryLivestreamRepository) ListLatest(ctx context.Context, limit int) (livestreams []LivestreamModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		rows := d.livestreams.selectRows(nil)
+		for i := len(rows) - 1; i >= 0 && (limit == noLimit || len(livestreams) < limit); i-- {
+			livestreams = append(livestreams, rows[i])
+		}
+		return nil
+	})
+	return livestreams, err
+}
+
+func (r memoryLivestreamRepository) ListStartingAfterForUpdate(ctx context.Context, userID int64, now int64) (livestreams []LivestreamModel, err error) {
+	err = r.t.readForUpdate(func(d *memoryData) error {
+		livestreams = d.livestreams.selectRows(func(l LivestreamModel) bool { return l.UserID == userID && l.StartAt > now })
+		return nil
+	})
+	return livestreams, err
+}
+
+func (r memoryLivestreamRepository) ListScores(ctx context.Context, ids []int64) (scores []LivestreamScoreModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		byID := d.livestreamScores(ids)
+		for _, livestream := range d.livestreams.selectByIDs(ids) {
+			scores = append(scores, byID[livestream.ID])
+		}
+		sort.Slice(scores, func(i, j int) bool { return scores[i].LivestreamID < scores[j].LivestreamID })
+		return nil
+	})
+	return scores, err
+}
+
+func (r memoryLivestreamRepository) Create(ctx context.Context, livestream LivestreamModel) (id int64, err error) {
+	err = r.t.write(func(d *memoryData) error {
+		id = d.livestreams.nextID()
+		livestream.ID = id
+		putRow(r.t, d.livestreams.rows, id, livestream)
+		return nil
+	})
+	return id, err
+}
+
+func (r memoryLivestreamRepository) Delete(ctx context.Context, id int64) error {
+	return r.t.write(func(d *memoryData) error {
+		deleteWhere(r.t, d.livestreamTags, func(t LivestreamTagModel) bool { return t.LivestreamID == id })
+		deleteWhere(r.t, d.ngWords, func(w NGWord) bool { return w.LivestreamID == id })
+		deleteWhere(r.t, d.reports, func(rp LivecommentReportModel) bool { return rp.LivestreamID == id })
+		deleteWhere(r.t, d.livecomments, func(lc LivecommentModel) bool { return lc.LivestreamID == id })
+		deleteWhere(r.t, d.reactions, func(rc ReactionModel) bool { return rc.LivestreamID == id })
+		deleteWhere(r.t, d.viewersHistory, func(v memoryViewerRow) bool { return v.LivestreamID == id })
+		for key := range d.uniqueViewers {
+			if key[0] == id {
+				deleteRow(r.t, d.uniqueViewers, key)
+			}
+		}
+		for key := range d.presence {
+			if key[0] == id {
+				deleteRow(r.t, d.presence, key)
+			}
+		}
+		deleteRow(r.t, d.presencePeaks, id)
+		deleteRow(r.t, d.livestreams.rows, id)
+		return nil
+	})
+}
+
+func (r memoryLivestreamRepository) AddTag(ctx context.Context, livestreamID int64, tagID int64) error {
+	return r.t.write(func(d *memoryData) error {
+		id := d.livestreamTags.nextID()
+		putRow(r.t, d.livestreamTags.rows, id, LivestreamTagModel{
+			ID:           id,
+			LivestreamID: livestreamID,
+			TagID:        tagID,
+		})
+		return nil
+	})
+}
+
+func (r memoryLivestreamRepository) ListTags(ctx context.Context, livestreamIDs []int64) (livestreamTags []LivestreamTagModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		target := int64Set(livestreamIDs)
+		livestreamTags = d.livestreamTags.selectRows(func(t LivestreamTagModel) bool { _, ok := target[t.LivestreamID]; return ok })
+		return nil
+	})
+	return livestreamTags, err
+}
+
+func (r memoryLivestreamRepository) ListTagged(ctx context.Context, tagIDs []int64) (livestreamTags []LivestreamTagModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		target := int64Set(tagIDs)
+		livestreamTags = d.livestreamTags.selectRows(func(t LivestreamTagModel) bool { _, ok := target[t.TagID]; return ok })
+		sort.SliceStable(livestreamTags, func(i, j int) bool { return livestreamTags[i].LivestreamID > livestreamTags[j].LivestreamID })
+		return nil
+	})
+	return livestreamTags, err
+}
+
+type memoryViewerRepository struct{ t *memoryTx }
+
+func (r memoryViewerRepository) AddHistory(ctx context.Context, viewer LivestreamViewerModel) error {
+	return r.t.write(func(d *memoryData) error {
+		id := d.viewersHistory.nextID()
+		putRow(r.t, d.viewersHistory.rows, id, memoryViewerRow{ID: id, LivestreamViewerModel: viewer})
+		return nil
+	})
+}
+
+func (r memoryViewerRepository) AddUnique(ctx context.Context, viewer LivestreamViewerModel) error {
+	return r.t.write(func(d *memoryData) error {
+		// INSERT IGNORE
+		key := [2]int64{viewer.LivestreamID, viewer.UserID}
+		if _, ok := d.uniqueViewers[key]; ok {
+			return nil
+		}
+		putRow(r.t, d.uniqueViewers, key, viewer)
+		return nil
+	})
+}
+
+func (r memoryViewerRepository) DeleteHistory(ctx context.Context, userID int64, livestreamID int64) error {
+	return r.t.write(func(d *memoryData) error {
+		deleteWhere(r.t, d.viewersHistory, func(v memoryViewerRow) bool { return v.UserID == userID && v.LivestreamID == livestreamID })
+		return nil
+	})
+}
+
+func (r memoryViewerRepository) DeleteHistoryByUserID(ctx context.Context, userID int64) error {
+	return r.t.write(func(d *memoryData) error {
+		deleteWhere(r.t, d.viewersHistory, func(v memoryViewerRow) bool { return v.UserID == userID })
+		return nil
+	})
+}
+
+func (r memoryViewerRepository) HistoryExists(ctx context.Context, userID int64, livestreamID int64) (exists bool, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		exists = d.viewersHistory.count(func(v memoryViewerRow) bool { return v.UserID == userID && v.LivestreamID == livestreamID }) > 0
+		return nil
+	})
+	return exists, err
+}
+
+func (r memoryViewerRepository) CountHistory(ctx context.Context, livestreamID int64) (count int64, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		count = d.viewersHistory.count(func(v memoryViewerRow) bool { return v.LivestreamID == livestreamID })
+		return nil
+	})
+	return count, err
+}
+
+func (r memoryViewerRepository) CountUnique(ctx context.Context, livestreamID int64) (count int64, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		for key := range d.uniqueViewers {
+			if key[0] == livestreamID {
+				count++
+			}
+		}
+		return nil
+	})
+	return count, err
+}
+
+func (r memoryViewerRepository) ListHistoryPageByUserID(ctx context.Context, userID int64, afterID int64, limit int) (viewers []LivestreamViewerHistoryModel, err error) {
+	viewers = []LivestreamViewerHistoryModel{}
+	err = r.t.read(func(d *memoryData) error {
+		for _, row := range limitRows(d.viewersHistory.selectRows(func(v memoryViewerRow) bool { return v.UserID == userID && v.ID > afterID }), limit) {
+			viewers = append(viewers, LivestreamViewerHistoryModel{ID: row.ID, LivestreamViewerModel: row.LivestreamViewerModel})
+		}
+		return nil
+	})
+	return viewers, err
+}
+
+func (r memoryViewerRepository) TouchPresence(ctx context.Context, livestreamID int64, userID int64, seenAt int64) error {
+	return r.t.write(func(d *memoryData) error {
+		key := [2]int64{livestreamID, userID}
+		putRow(r.t, d.presence, key, max(d.presence[key], seenAt))
+		return nil
+	})
+}
+
+func (r memoryViewerRepository) PresenceExists(ctx context.Context, livestreamID int64, userID int64, since int64) (exists bool, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		seenAt, ok := d.presence[[2]int64{livestreamID, userID}]
+		exists = ok && seenAt >= since
+		return nil
+	})
+	return exists, err
+}
+
+func (r memoryViewerRepository) DeletePresence(ctx context.Context, livestreamID int64, userID int64) error {
+	return r.t.write(func(d *memoryData) error {
+		deleteRow(r.t, d.presence, [2]int64{livestreamID, userID})
+		return nil
+	})
+}
+
+func (r memoryViewerRepository) CountPresence(ctx context.Context, livestreamID int64, since int64) (count int64, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		for key, seenAt := range d.presence {
+			if key[0] == livestreamID && seenAt >= since {
+				count++
+			}
+		}
+		return nil
+	})
+	return count, err
+}
+
+func (r memoryViewerRepository) DeleteExpiredPresence(ctx context.Context, before int64) error {
+	return r.t.write(func(d *memoryData) error {
+		for key, seenAt := range d.presence {
+			if seenAt < before {
+				deleteRow(r.t, d.presence, key)
+			}
+		}
+		return nil
+	})
+}
+
+func (r memoryViewerRepository) RecordPeakPresence(ctx context.Context, livestreamID int64, count int64) error {
+	return r.t.write(func(d *memoryData) error {
+		if count > d.presencePeaks[livestreamID] {
+			putRow(r.t, d.presencePeaks, livestreamID, count)
+		}
+		return nil
+	})
+}
+
+func (r memoryViewerRepository) GetPeakPresence(ctx context.Context, livestreamID int64) (peak int64, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		peak = d.presencePeaks[livestreamID]
+		return nil
+	})
+	return peak, err
+}
+
+type memoryReservationRepository struct{ t *memoryTx }
+
+func slotWithin(startAt, endAt int64) func(ReservationSlotModel) bool {
+	return func(s ReservationSlotModel) bool { return s.StartAt >= startAt && s.EndAt <= endAt }
+}
+
+func (r memoryReservationRepository) ListForUpdate(ctx context.Context, startAt int64, endAt int64) (slots []ReservationSlotModel, err error) {
+	err = r.t.readForUpdate(func(d *memoryData) error {
+		slots = d.reservationSlots.selectRows(slotWithin(startAt, endAt))
+		return nil
+	})
+	return slots, err
+}
+
+func (r memoryReservationRepository) GetSlot(ctx context.Context, startAt int64, endAt int64) (slot int64, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		slots := d.reservationSlots.selectRows(func(s ReservationSlotModel) bool { return s.StartAt == startAt && s.EndAt == endAt })
+		if len(slots) == 0 {
+			return sql.ErrNoRows
+		}
+		slot = slots[0].Slot
+		return nil
+	})
+	return slot, err
+}
+
+func (r memoryReservationRepository) Reserve(ctx context.Context, startAt int64, endAt int64) error {
+	return r.addSlot(startAt, endAt, -1)
+}
+
+func (r memoryReservationRepository) Release(ctx context.Context, startAt int64, endAt int64) error {
+	return r.addSlot(startAt, endAt, 1)
+}
+
+func (r memoryReservationRepository) List(ctx context.Context, startAt int64, endAt int64) (slots []ReservationSlotModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		slots = d.reservationSlots.selectRows(slotWithin(startAt, endAt))
+		sort.SliceStable(slots, func(i, j int) bool { return slots[i].StartAt < slots[j].StartAt })
+		return nil
+	})
+	return slots, err
+}
+
+func (r memoryReservationRepository) AddCapacity(ctx context.Context, startAt int64, endAt int64, delta int64) error {
+	return r.t.write(func(d *memoryData) error {
+		for _, slot := range d.reservationSlots.selectRows(slotWithin(startAt, endAt)) {
+			slot.Slot = max(slot.Slot+delta, 0)
+			putRow(r.t, d.reservationSlots.rows, slot.ID, slot)
+		}
+		return nil
+	})
+}
+
+func (r memoryReservationRepository) addSlot(startAt, endAt, delta int64) error {
+	return r.t.write(func(d *memoryData) error {
+		for _, slot := range d.reservationSlots.selectRows(slotWithin(startAt, endAt)) {
+			slot.Slot += delta
+			putRow(r.t, d.reservationSlots.rows, slot.ID, slot)
+		}
+		return nil
+	})
+}
+
+type memoryLivecommentRepository struct{ t *memoryTx }
+
+func (r memoryLivecommentRepository) Get(ctx context.Context, id int64) (livecomment LivecommentModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		livecomment, err = d.livecomments.get(id)
+		return err
+	})
+	return livecomment, err
+}
+
+func (r memoryLivecommentRepository) ListByIDs(ctx context.Context, ids []int64) (livecomments []LivecommentModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		livecomments = d.livecomments.selectByIDs(ids)
+		return nil
+	})
+	return livecomments, err
+}
+
+func (r memoryLivecommentRepository) ListByLivestreamID(ctx context.Context, livestreamID int64, limit int) (livecomments []LivecommentModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		rows := d.livecomments.selectRows(func(lc LivecommentModel) bool { return lc.LivestreamID == livestreamID })
+		livecomments = sortByCreatedAtDesc(rows, func(lc LivecommentModel) int64 { return lc.CreatedAt }, limit)
+		return nil
+	})
+	return livecomments, err
+}
+
+func (r memoryLivecommentRepository) List(ctx context.Context) (livecomments []LivecommentModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		livecomments = d.livecomments.selectRows(nil)
+		return nil
+	})
+	return livecomments, err
+}
+
+func (r memoryLivecommentRepository) ListPageByUserID(ctx context.Context, userID int64, tipOnly bool, afterID int64, limit int) (livecomments []LivecommentModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		livecomments = limitRows(d.livecomments.selectRows(func(lc LivecommentModel) bool {
+			return lc.UserID == userID && lc.ID > afterID && (!tipOnly || lc.Tip > 0)
+		}), limit)
+		return nil
+	})
+	return livecomments, err
+}
+
+func (r memoryLivecommentRepository) ListTipsReceivedPage(ctx context.Context, streamerID int64, afterID int64, limit int) (livecomments []LivecommentModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		livecomments = limitRows(d.livecomments.selectRows(func(lc LivecommentModel) bool {
+			if lc.Tip <= 0 || lc.ID <= afterID {
+				return false
+			}
+			livestream, ok := d.livestreams.rows[lc.LivestreamID]
+			return ok && livestream.UserID == streamerID
+		}), limit)
+		return nil
+	})
+	return livecomments, err
+}
+
+func (r memoryLivecommentRepository) MaxTip(ctx context.Context, livestreamID int64) (maxTip int64, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		for _, lc := range d.livecomments.rows {
+			if lc.LivestreamID == livestreamID && lc.Tip > maxTip {
+				maxTip = lc.Tip
+			}
+		}
+		return nil
+	})
+	return maxTip, err
+}
+
+func (r memoryLivecommentRepository) TotalTip(ctx context.Context) (totalTip int64, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		for _, lc := range d.livecomments.rows {
+			totalTip += lc.Tip
+		}
+		return nil
+	})
+	return totalTip, err
+}
+
+// supporterTotals はscopeに含まれるチップの合計をユーザごとに集計し、ランキングの順に並べる
+func (d *memoryData) supporterTotals(scope supporterScope) []supporterTotalModel {
+	byUser := make(map[int64]int64)
+	for _, lc := range d.livecomments.rows {
+		if lc.Tip <= 0 {
+			continue
+		}
+		if scope.livestreamID != 0 && lc.LivestreamID != scope.livestreamID {
+			continue
+		}
+		if scope.livestreamID == 0 {
+			livestream, ok := d.livestreams.rows[lc.LivestreamID]
+			if !ok || livestream.UserID != scope.streamerID {
+				continue
+			}
+		}
+		byUser[lc.UserID] += lc.Tip
+	}
+
+	totals := make([]supporterTotalModel, 0, len(byUser))
+	for userID, total := range byUser {
+		totals = append(totals, supporterTotalModel{UserID: userID, TotalTip: total})
+	}
+	sort.Slice(totals, func(i, j int) bool { return supporterAbove(totals[i], totals[j]) })
+	return totals
+}
+
+// supporterAbove はaがbより上位か (チップ合計額の降順、同額ならuser_idの昇順)
+func supporterAbove(a, b supporterTotalModel) bool {
+	if a.TotalTip != b.TotalTip {
+		return a.TotalTip > b.TotalTip
+	}
+	return a.UserID < b.UserID
+}
+
+func (r memoryLivecommentRepository) ListSupporters(ctx context.Context, scope supporterScope, limit int) (totals []supporterTotalModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		totals = d.supporterTotals(scope)
+		if len(totals) > limit {
+			totals = totals[:limit]
+		}
+		return nil
+	})
+	return totals, err
+}
+
+func (r memoryLivecommentRepository) SupporterTotal(ctx context.Context, scope supporterScope, userID int64) (total int64, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		for _, supporter := range d.supporterTotals(scope) {
+			if supporter.UserID == userID {
+				total = supporter.TotalTip
+			}
+		}
+		return nil
+	})
+	return total, err
+}
+
+func (r memoryLivecommentRepository) CountSupportersAbove(ctx context.Context, scope supporterScope, totalTip int64, userID int64) (higher int64, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		me := supporterTotalModel{UserID: userID, TotalTip: totalTip}
+		for _, supporter := range d.supporterTotals(scope) {
+			if supporterAbove(supporter, me) {
+				higher++
+			}
+		}
+		return nil
+	})
+	return higher, err
+}
+
+func (r memoryLivecommentRepository) Create(ctx context.Context, livecomment LivecommentModel) (id int64, err error) {
+	err = r.t.write(func(d *memoryData) error {
+		id = d.livecomments.nextID()
+		livecomment.ID = id
+		putRow(r.t, d.livecomments.rows, id, livecomment)
+		return nil
+	})
+	return id, err
+}
+
+func (r memoryLivecommentRepository) Delete(ctx context.Context, id int64) error {
+	return r.t.write(func(d *memoryData) error {
+		deleteRow(r.t, d.livecomments.rows, id)
+		return nil
+	})
+}
+
+func (r memoryLivecommentRepository) DeleteByUserID(ctx context.Context, userID int64) error {
+	return r.t.write(func(d *memoryData) error {
+		deleteWhere(r.t, d.reports, func(rp LivecommentReportModel) bool {
+			lc, ok := d.livecomments.rows[rp.LivecommentID]
+			return ok && lc.UserID == userID && lc.Tip == 0
+		})
+		deleteWhere(r.t, d.livecomments, func(lc LivecommentModel) bool { return lc.UserID == userID && lc.Tip == 0 })
+		for _, lc := range d.livecomments.selectRows(func(lc LivecommentModel) bool { return lc.UserID == userID }) {
+			lc.Comment = ""
+			putRow(r.t, d.livecomments.rows, lc.ID, lc)
+		}
+		return nil
+	})
+}
+
+type memoryReportRepository struct{ t *memoryTx }
+
+func (r memoryReportRepository) ListByLivestreamID(ctx context.Context, livestreamID int64) (reports []LivecommentReportModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		reports = d.reports.selectRows(func(rp LivecommentReportModel) bool { return rp.LivestreamID == livestreamID })
+		return nil
+	})
+	return reports, err
+}
+
+func (r memoryReportRepository) ListPageByUserID(ctx context.Context, userID int64, afterID int64, limit int) (reports []LivecommentReportModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		reports = limitRows(d.reports.selectRows(func(rp LivecommentReportModel) bool { return rp.UserID == userID && rp.ID > afterID }), limit)
+		return nil
+	})
+	return reports, err
+}
+
+func (r memoryReportRepository) CountByLivestreamID(ctx context.Context, livestreamID int64) (count int64, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		if _, ok := d.livestreams.rows[livestreamID]; !ok {
+			return nil
+		}
+		count = d.reports.count(func(rp LivecommentReportModel) bool { return rp.LivestreamID == livestreamID })
+		return nil
+	})
+	return count, err
+}
+
+func (r memoryReportRepository) Create(ctx context.Context, report LivecommentReportModel) (id int64, err error) {
+	err = r.t.write(func(d *memoryData) error {
+		id = d.reports.nextID()
+		report.ID = id
+		putRow(r.t, d.reports.rows, id, report)
+		return nil
+	})
+	return id, err
+}
+
+func (r memoryReportRepository) DeleteByUserID(ctx context.Context, userID int64) error {
+	return r.t.write(func(d *memoryData) error {
+		deleteWhere(r.t, d.reports, func(rp LivecommentReportModel) bool { return rp.UserID == userID })
+		return nil
+	})
+}
+
+type memoryReactionRepository struct{ t *memoryTx }
+
+func (r memoryReactionRepository) ListByLivestreamID(ctx context.Context, livestreamID int64, limit int) (reactions []ReactionModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		rows := d.reactions.selectRows(func(rc ReactionModel) bool { return rc.LivestreamID == livestreamID })
+		reactions = sortByCreatedAtDesc(rows, func(rc ReactionModel) int64 { return rc.CreatedAt }, limit)
+		return nil
+	})
+	return reactions, err
+}
+
+func (r memoryReactionRepository) ListPageByUserID(ctx context.Context, userID int64, afterID int64, limit int) (reactions []ReactionModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		reactions = limitRows(d.reactions.selectRows(func(rc ReactionModel) bool { return rc.UserID == userID && rc.ID > afterID }), limit)
+		return nil
+	})
+	return reactions, err
+}
+
+func (r memoryReactionRepository) CountByLivestreamID(ctx context.Context, livestreamID int64) (count int64, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		if _, ok := d.livestreams.rows[livestreamID]; !ok {
+			return nil
+		}
+		count = d.reactions.count(func(rc ReactionModel) bool { return rc.LivestreamID == livestreamID })
+		return nil
+	})
+	return count, err
+}
+
+// streamerReactions は配信者の全配信へのリアクションを返す
+func (d *memoryData) streamerReactions(name string) []ReactionModel {
+	user, err := d.userByName(name)
+	if err != nil {
+		return nil
+	}
+	return d.reactions.selectRows(func(rc ReactionModel) bool {
+		livestream, ok := d.livestreams.rows[rc.LivestreamID]
+		return ok && livestream.UserID == user.ID
+	})
+}
+
+func (r memoryReactionRepository) CountByStreamerName(ctx context.Context, name string) (count int64, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		count = int64(len(d.streamerReactions(name)))
+		return nil
+	})
+	return count, err
+}
+
+func (r memoryReactionRepository) FavoriteEmojiByStreamerName(ctx context.Context, name string) (emojiName string, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		counts := make(map[string]int64)
+		for _, reaction := range d.streamerReactions(name) {
+			counts[reaction.EmojiName]++
+		}
+		if len(counts) == 0 {
+			return sql.ErrNoRows
+		}
+		var best int64
+		for emoji, count := range counts {
+			if count > best || (count == best && emoji > emojiName) {
+				emojiName, best = emoji, count
+			}
+		}
+		return nil
+	})
+	return emojiName, err
+}
+
+func (r memoryReactionRepository) Create(ctx context.Context, reaction ReactionModel) (id int64, err error) {
+	err = r.t.write(func(d *memoryData) error {
+		id = d.reactions.nextID()
+		reaction.ID = id
+		putRow(r.t, d.reactions.rows, id, reaction)
+		return nil
+	})
+	return id, err
+}
+
+func (r memoryReactionRepository) DeleteByUserID(ctx context.Context, userID int64) error {
+	return r.t.write(func(d *memoryData) error {
+		deleteWhere(r.t, d.reactions, func(rc ReactionModel) bool { return rc.UserID == userID })
+		return nil
+	})
+}
+
+type memoryNGWordRepository struct{ t *memoryTx }
+
+func (r memoryNGWordRepository) ListByUserAndLivestream(ctx context.Context, userID int64, livestreamID int64) (ngwords []NGWord, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		rows := d.ngWords.selectRows(func(w NGWord) bool { return w.UserID == userID && w.LivestreamID == livestreamID })
+		ngwords = sortByCreatedAtDesc(rows, func(w NGWord) int64 { return w.CreatedAt }, noLimit)
+		return nil
+	})
+	return ngwords, err
+}
+
+func (r memoryNGWordRepository) ListByLivestreamID(ctx context.Context, livestreamID int64) (ngwords []NGWord, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		ngwords = d.ngWords.selectRows(func(w NGWord) bool { return w.LivestreamID == livestreamID })
+		return nil
+	})
+	return ngwords, err
+}
+
+func (r memoryNGWordRepository) ListPageByUserID(ctx context.Context, userID int64, afterID int64, limit int) (ngwords []NGWord, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		ngwords = limitRows(d.ngWords.selectRows(func(w NGWord) bool { return w.UserID == userID && w.ID > afterID }), limit)
+		return nil
+	})
+	return ngwords, err
+}
+
+func (r memoryNGWordRepository) Matches(ctx context.Context, text string, word string) (bool, error) {
+	return likeMatch([]rune(text), []rune("%"+word+"%")), nil
+}
+
+// likeMatch はMySQLのLIKEと同じく、%を任意の文字列、_を任意の1文字、\をエスケープとして照合する
+// 接続の照合順序 (utf8mb4_0900_ai_ci) に合わせて大文字小文字は区別しない
+func likeMatch(text, pattern []rune) bool {
+	ti, pi := 0, 0
+	// 最後に見た%の位置 (一致しなかったら%に食わせる文字を1つ増やしてやり直す)
+	starP, starT := -1, 0
+	for ti < len(text) {
+		if pi < len(pattern) {
+			switch p := pattern[pi]; p {
+			case '%':
+				starP, starT = pi, ti
+				pi++
+				continue
+			case '_':
+				ti++
+				pi++
+				continue
+			default:
+				n := 1
+				if p == '\\' && pi+1 < len(pattern) {
+					p = pattern[pi+1]
+					n = 2
+				}
+				if unicode.ToLower(p) == unicode.ToLower(text[ti]) {
+					ti++
+					pi += n
+					continue
+				}
+			}
+		}
+		if starP < 0 {
+			return false
+		}
+		starT++
+		ti, pi = starT, starP+1
+	}
+	for pi < len(pattern) && pattern[pi] == '%' {
+		pi++
+	}
+	return pi == len(pattern)
+}
+
+func (r memoryNGWordRepository) Create(ctx context.Context, ngword NGWord) (id int64, err error) {
+	err = r.t.write(func(d *memoryData) error {
+		id = d.ngWords.nextID()
+		ngword.ID = id
+		putRow(r.t, d.ngWords.rows, id, ngword)
+		return nil
+	})
+	return id, err
+}
+
+type memoryModerationRepository struct{ t *memoryTx }
+
+func (r memoryModerationRepository) ListModerators(ctx context.Context, streamerID int64) (moderators []ChannelModeratorModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		moderators = d.moderators.selectRows(func(m ChannelModeratorModel) bool { return m.StreamerID == streamerID })
+		return nil
+	})
+	return moderators, err
+}
+
+func (r memoryModerationRepository) IsModerator(ctx context.Context, streamerID int64, userID int64) (exists bool, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		exists = d.moderators.count(func(m ChannelModeratorModel) bool { return m.StreamerID == streamerID && m.UserID == userID }) > 0
+		return nil
+	})
+	return exists, err
+}
+
+func (r memoryModerationRepository) AddModerator(ctx context.Context, moderator ChannelModeratorModel) (id int64, err error) {
+	err = r.t.write(func(d *memoryData) error {
+		// uniq_channel_moderator
+		if d.moderators.count(func(m ChannelModeratorModel) bool {
+			return m.StreamerID == moderator.StreamerID && m.UserID == moderator.UserID
+		}) > 0 {
+			return errDuplicateEntry
+		}
+		id = d.moderators.nextID()
+		moderator.ID = id
+		putRow(r.t, d.moderators.rows, id, moderator)
+		return nil
+	})
+	return id, err
+}
+
+func (r memoryModerationRepository) RemoveModerator(ctx context.Context, streamerID int64, userID int64) error {
+	return r.t.write(func(d *memoryData) error {
+		deleteWhere(r.t, d.moderators, func(m ChannelModeratorModel) bool { return m.StreamerID == streamerID && m.UserID == userID })
+		return nil
+	})
+}
+
+func (r memoryModerationRepository) ListBans(ctx context.Context, streamerID int64) (bans []ChannelBanModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		bans = d.bans.selectRows(func(b ChannelBanModel) bool { return b.StreamerID == streamerID })
+		return nil
+	})
+	return bans, err
+}
+
+func (r memoryModerationRepository) IsBanned(ctx context.Context, streamerID int64, userID int64) (exists bool, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		exists = d.bans.count(func(b ChannelBanModel) bool { return b.StreamerID == streamerID && b.UserID == userID }) > 0
+		return nil
+	})
+	return exists, err
+}
+
+func (r memoryModerationRepository) Ban(ctx context.Context, ban ChannelBanModel) (id int64, err error) {
+	err = r.t.write(func(d *memoryData) error {
+		// uniq_channel_ban
+		if d.bans.count(func(b ChannelBanModel) bool { return b.StreamerID == ban.StreamerID && b.UserID == ban.UserID }) > 0 {
+			return errDuplicateEntry
+		}
+		id = d.bans.nextID()
+		ban.ID = id
+		putRow(r.t, d.bans.rows, id, ban)
+		return nil
+	})
+	return id, err
+}
+
+func (r memoryModerationRepository) Unban(ctx context.Context, streamerID int64, userID int64) error {
+	return r.t.write(func(d *memoryData) error {
+		deleteWhere(r.t, d.bans, func(b ChannelBanModel) bool { return b.StreamerID == streamerID && b.UserID == userID })
+		return nil
+	})
+}
+
+func (r memoryModerationRepository) AddLog(ctx context.Context, log ModerationLogModel) (id int64, err error) {
+	err = r.t.write(func(d *memoryData) error {
+		id = d.moderationLogs.nextID()
+		log.ID = id
+		putRow(r.t, d.moderationLogs.rows, id, log)
+		return nil
+	})
+	return id, err
+}
+
+func (r memoryModerationRepository) ListLogs(ctx context.Context, streamerID int64, limit int) (logs []ModerationLogModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		rows := d.moderationLogs.selectRows(func(l ModerationLogModel) bool { return l.StreamerID == streamerID })
+		// idの降順
+		for i, j := 0, len(rows)-1; i < j; i, j = i+1, j-1 {
+			rows[i], rows[j] = rows[j], rows[i]
+		}
+		logs = limitRows(rows, limit)
+		return nil
+	})
+	return logs, err
+}
+
+func (r memoryModerationRepository) DeleteByUserID(ctx context.Context, userID int64) error {
+	return r.t.write(func(d *memoryData) error {
+		deleteWhere(r.t, d.moderators, func(m ChannelModeratorModel) bool { return m.StreamerID == userID || m.UserID == userID })
+		deleteWhere(r.t, d.bans, func(b ChannelBanModel) bool { return b.StreamerID == userID })
+		return nil
+	})
+}
+
+type memoryAccessTokenRepository struct{ t *memoryTx }
+
+func (r memoryAccessTokenRepository) Get(ctx context.Context, id int64) (token AccessTokenModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		token, err = d.accessTokens.get(id)
+		return err
+	})
+	return token, err
+}
+
+func (r memoryAccessTokenRepository) GetByHash(ctx context.Context, tokenHash string) (token AccessTokenModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		rows := d.accessTokens.selectRows(func(t AccessTokenModel) bool { return t.TokenHash == tokenHash })
+		if len(rows) == 0 {
+			return sql.ErrNoRows
+		}
+		token = rows[0]
+		return nil
+	})
+	return token, err
+}
+
+func (r memoryAccessTokenRepository) ListByUserID(ctx context.Context, userID int64) (tokens []AccessTokenModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		tokens = d.accessTokens.selectRows(func(t AccessTokenModel) bool { return t.UserID == userID && !t.RevokedAt.Valid })
+		return nil
+	})
+	return tokens, err
+}
+
+func (r memoryAccessTokenRepository) Create(ctx context.Context, token AccessTokenModel) (id int64, err error) {
+	err = r.t.write(func(d *memoryData) error {
+		// uniq_access_token_hash
+		if d.accessTokens.count(func(t AccessTokenModel) bool { return t.TokenHash == token.TokenHash }) > 0 {
+			return errDuplicateEntry
+		}
+		id = d.accessTokens.nextID()
+		token.ID = id
+		putRow(r.t, d.accessTokens.rows, id, token)
+		return nil
+	})
+	return id, err
+}
+
+func (r memoryAccessTokenRepository) Revoke(ctx context.Context, id int64, revokedAt int64) error {
+	return r.t.write(func(d *memoryData) error {
+		token, ok := d.accessTokens.rows[id]
+		if !ok || token.RevokedAt.Valid {
+			return nil
+		}
+		token.RevokedAt = sql.NullInt64{Int64: revokedAt, Valid: true}
+		putRow(r.t, d.accessTokens.rows, id, token)
+		return nil
+	})
+}
+
+func (r memoryAccessTokenRepository) RevokeByUserID(ctx context.Context, userID int64, revokedAt int64) error {
+	return r.t.write(func(d *memoryData) error {
+		for _, token := range d.accessTokens.selectRows(func(t AccessTokenModel) bool { return t.UserID == userID && !t.RevokedAt.Valid }) {
+			token.RevokedAt = sql.NullInt64{Int64: revokedAt, Valid: true}
+			putRow(r.t, d.accessTokens.rows, token.ID, token)
+		}
+		return nil
+	})
+}
+
+func (r memoryAccessTokenRepository) SetLastUsedAt(ctx context.Context, id int64, lastUsedAt int64) error {
+	return r.t.write(func(d *memoryData) error {
+		token, ok := d.accessTokens.rows[id]
+		if !ok {
+			return nil
+		}
+		token.LastUsedAt = sql.NullInt64{Int64: lastUsedAt, Valid: true}
+		putRow(r.t, d.accessTokens.rows, id, token)
+		return nil
+	})
+}
+
+type memoryDNSOutboxRepository struct{ t *memoryTx }
+
+func (r memoryDNSOutboxRepository) Enqueue(ctx context.Context, action string, name string) (id int64, err error) {
+	err = r.t.write(func(d *memoryData) error {
+		id = d.dnsOutbox.nextID()
+		putRow(r.t, d.dnsOutbox.rows, id, DNSOutboxModel{
+			ID:        id,
+			Name:      name,
+			Action:    action,
+			CreatedAt: time.Now().Unix(),
+		})
+		return nil
+	})
+	return id, err
+}
+
+// Claim は書き込みが直列化されているので、他のトランザクションが終わるまで待ってから借りる
+// (待っている間に削除されていればsql.ErrNoRowsになり、SKIP LOCKEDと同じ結果になる)
+func (r memoryDNSOutboxRepository) Claim(ctx context.Context, id int64, now int64, lockedUntil int64) (entry DNSOutboxModel, err error) {
+	err = r.t.write(func(d *memoryData) error {
+		var ok bool
+		entry, ok = d.dnsOutbox.rows[id]
+		if !ok || entry.DeadAt.Valid || entry.LockedUntil > now || entry.NextAttemptAt > now {
+			return sql.ErrNoRows
+		}
+		if d.dnsOutbox.count(func(e DNSOutboxModel) bool { return e.Name == entry.Name && e.ID < id && !e.DeadAt.Valid }) > 0 {
+			return sql.ErrNoRows
+		}
+		entry.LockedUntil = lockedUntil
+		putRow(r.t, d.dnsOutbox.rows, id, entry)
+		return nil
+	})
+	if err != nil {
+		return DNSOutboxModel{}, err
+	}
+	return entry, nil
+}
+
+func (r memoryDNSOutboxRepository) RecordFailure(ctx context.Context, id int64, attempts int64, lastError string, nextAttemptAt int64, deadAt sql.NullInt64) error {
+	return r.t.write(func(d *memoryData) error {
+		entry, ok := d.dnsOutbox.rows[id]
+		if !ok {
+			return nil
+		}
+		entry.Attempts = attempts
+		entry.LastError = sql.NullString{String: lastError, Valid: true}
+		entry.NextAttemptAt = nextAttemptAt
+		entry.DeadAt = deadAt
+		entry.LockedUntil = 0
+		putRow(r.t, d.dnsOutbox.rows, id, entry)
+		return nil
+	})
+}
+
+func (r memoryDNSOutboxRepository) Delete(ctx context.Context, id int64) error {
+	return r.t.write(func(d *memoryData) error {
+		deleteRow(r.t, d.dnsOutbox.rows, id)
+		return nil
+	})
+}
+
+func (r memoryDNSOutboxRepository) ListDueIDs(ctx context.Context, createdAt int64, now int64) (ids []int64, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		due := func(e DNSOutboxModel) bool {
+			return !e.DeadAt.Valid && e.NextAttemptAt <= now && e.LockedUntil <= now && e.CreatedAt <= createdAt
+		}
+		for _, entry := range d.dnsOutbox.selectRows(due) {
+			ids = append(ids, entry.ID)
+		}
+		return nil
+	})
+	return ids, err
+}
+
+func (r memoryDNSOutboxRepository) ExistsByName(ctx context.Context, name string) (exists bool, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		exists = d.dnsOutbox.count(func(e DNSOutboxModel) bool { return e.Name == name && !e.DeadAt.Valid }) > 0
+		return nil
+	})
+	return exists, err
+}
+
+func (r memoryDNSOutboxRepository) CountDead(ctx context.Context) (count int64, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		count = d.dnsOutbox.count(func(e DNSOutboxModel) bool { return e.DeadAt.Valid })
+		return nil
+	})
+	return count, err
+}
+
+func (r memoryDNSOutboxRepository) DeleteDeadByName(ctx context.Context, name string) error {
+	return r.t.write(func(d *memoryData) error {
+		deleteWhere(r.t, d.dnsOutbox, func(e DNSOutboxModel) bool { return e.Name == name && e.DeadAt.Valid })
+		return nil
+	})
+}
+
+type memoryStaffGrantRepository struct{ t *memoryTx }
+
+func (r memoryStaffGrantRepository) Upsert(ctx context.Context, grant StaffGrantModel) error {
+	return r.t.write(func(d *memoryData) error {
+		for id, existing := range d.staffGrants.rows {
+			if existing.Name != grant.Name {
+				continue
+			}
+			existing.Role = grant.Role
+			if grant.HashedPassword.Valid {
+				existing.HashedPassword = grant.HashedPassword
+			}
+			putRow(r.t, d.staffGrants.rows, id, existing)
+			return nil
+		}
+		grant.ID = d.staffGrants.nextID()
+		putRow(r.t, d.staffGrants.rows, grant.ID, grant)
+		return nil
+	})
+}
+
+func (r memoryStaffGrantRepository) DeleteByName(ctx context.Context, name string) error {
+	return r.t.write(func(d *memoryData) error {
+		deleteWhere(r.t, d.staffGrants, func(g StaffGrantModel) bool { return g.Name == name })
+		return nil
+	})
+}
+
+func (r memoryStaffGrantRepository) List(ctx context.Context) (grants []StaffGrantModel, err error) {
+	err = r.t.read(func(d *memoryData) error {
+		grants = d.staffGrants.selectRows(nil)
+		return nil
+	})
+	return grants, err
+}
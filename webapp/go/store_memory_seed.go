@@ -0,0 +1,337 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// loadMemorySeed は埋め込んだ初期データのINSERT文を読み込む
+func loadMemorySeed() (*memoryData, error) {
+	d := newMemoryData()
+	now := time.Now().Unix()
+	for _, name := range seedFileNames {
+		src, err := readSeed(name)
+		if err != nil {
+			return nil, err
+		}
+		p := &seedParser{src: src, now: now}
+		err = p.parse(func(table string, row seedRow) error {
+			return d.insertSeed(table, row)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to load seed %s: %w", name, err)
+		}
+	}
+	return d, nil
+}
+
+// seedRow はカラム名から値への対応 (値はすべて文字列で持つ)
+type seedRow map[string]string
+
+func (r seedRow) int(column string) int64 {
+	n, _ := strconv.ParseInt(r[column], 10, 64)
+	return n
+}
+
+// seedID はidカラムが指定されていればそれを、なければ新しく採番したidを返す
+func seedID[T any](table *memoryTable[T], row seedRow) int64 {
+	if _, ok := row["id"]; ok {
+		id := row.int("id")
+		table.useID(id)
+		return id
+	}
+	return table.nextID()
+}
+
+func (d *memoryData) insertSeed(table string, row seedRow) error {
+	switch table {
+	case "users":
+		id := seedID(d.users, row)
+		d.users.rows[id] = UserModel{
+			ID:             id,
+			Name:           row["name"],
+			DisplayName:    row["display_name"],
+			Description:    row["description"],
+			HashedPassword: row["password"],
+			Role:           RoleViewer,
+		}
+	case "themes":
+		id := seedID(d.themes, row)
+		d.themes.rows[id] = ThemeModel{
+			ID:       id,
+			UserID:   row.int("user_id"),
+			DarkMode: row.int("dark_mode") != 0,
+		}
+	case "livestreams":
+		id := seedID(d.livestreams, row)
+		d.livestreams.rows[id] = LivestreamModel{
+			ID:           id,
+			UserID:       row.int("user_id"),
+			Title:        row["title"],
+			Description:  row["description"],
+			PlaylistUrl:  row["playlist_url"],
+			ThumbnailUrl: row["thumbnail_url"],
+			StartAt:      row.int("start_at"),
+			EndAt:        row.int("end_at"),
+		}
+	case "tags":
+		id := seedID(d.tags, row)
+		d.tags.rows[id] = TagModel{
+			ID:   id,
+			Name: row["name"],
+		}
+	case "livestream_tags":
+		id := seedID(d.livestreamTags, row)
+		d.livestreamTags.rows[id] = LivestreamTagModel{
+			ID:           id,
+			LivestreamID: row.int("livestream_id"),
+			TagID:        row.int("tag_id"),
+		}
+	case "reservation_slots":
+		id := seedID(d.reservationSlots, row)
+		d.reservationSlots.rows[id] = ReservationSlotModel{
+			ID:      id,
+			Slot:    row.int("slot"),
+			StartAt: row.int("start_at"),
+			EndAt:   row.int("end_at"),
+		}
+	case "reactions":
+		id := seedID(d.reactions, row)
+		d.reactions.rows[id] = ReactionModel{
+			ID:           id,
+			EmojiName:    row["emoji_name"],
+			UserID:       row.int("user_id"),
+			LivestreamID: row.int("livestream_id"),
+			CreatedAt:    row.int("created_at"),
+		}
+	case "ng_words":
+		id := seedID(d.ngWords, row)
+		d.ngWords.rows[id] = NGWord{
+			ID:           id,
+			UserID:       row.int("user_id"),
+			LivestreamID: row.int("livestream_id"),
+			Word:         row["word"],
+			CreatedAt:    row.int("created_at"),
+		}
+	case "livecomments":
+		id := seedID(d.livecomments, row)
+		d.livecomments.rows[id] = LivecommentModel{
+			ID:           id,
+			UserID:       row.int("user_id"),
+			LivestreamID: row.int("livestream_id"),
+			Comment:      row["comment"],
+			Tip:          row.int("tip"),
+			CreatedAt:    row.int("created_at"),
+		}
+	default:
+		return fmt.Errorf("unknown table '%s'", table)
+	}
+	return nil
+}
+
+// seedParser は初期データのSQLファイルに出てくる
+// INSERT INTO table (columns) VALUES (...), (...); の形の文だけを読む
+type seedParser struct {
+	src string
+	pos int
+	// now はUNIX_TIMESTAMP()の値
+	now int64
+}
+
+func (p *seedParser) parse(insert func(table string, row seedRow) error) error {
+	return p.parseRows(func(table string, columns []string, values []string) error {
+		row := make(seedRow, len(columns))
+		for i, column := range columns {
+			row[column] = values[i]
+		}
+		return insert(table, row)
+	})
+}
+
+// parseRows は1行ごとにカラム名と値を (INSERT文に書かれた順のまま) 渡す
+func (p *seedParser) parseRows(insert func(table string, columns []string, values []string) error) error {
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.src) {
+			return nil
+		}
+		if err := p.keyword("INSERT"); err != nil {
+			return err
+		}
+		if err := p.keyword("INTO"); err != nil {
+			return err
+		}
+		table := p.word()
+		columns, err := p.list(p.word)
+		if err != nil {
+			return err
+		}
+		if err := p.keyword("VALUES"); err != nil {
+			return err
+		}
+		for {
+			values, err := p.list(p.value)
+			if err != nil {
+				return err
+			}
+			if len(values) != len(columns) {
+				return p.errorf("got %d values for %d columns", len(values), len(columns))
+			}
+			if err := insert(table, columns, values); err != nil {
+				return err
+			}
+			p.skipSpace()
+			if p.consume(',') {
+				continue
+			}
+			p.consume(';')
+			break
+		}
+	}
+}
+
+func (p *seedParser) errorf(format string, args ...any) error {
+	line := strings.Count(p.src[:p.pos], "\n") + 1
+	return fmt.Errorf("line %d: %s", line, fmt.Sprintf(format, args...))
+}
+
+// skipSpace は空白とコメントを読み飛ばす
+func (p *seedParser) skipSpace() {
+	for p.pos < len(p.src) {
+		switch {
+		case strings.ContainsRune(" \t\r\n", rune(p.src[p.pos])):
+			p.pos++
+		case strings.HasPrefix(p.src[p.pos:], "--"):
+			if i := strings.IndexByte(p.src[p.pos:], '\n'); i >= 0 {
+				p.pos += i + 1
+			} else {
+				p.pos = len(p.src)
+			}
+		case strings.HasPrefix(p.src[p.pos:], "/*"):
+			if i := strings.Index(p.src[p.pos:], "*/"); i >= 0 {
+				p.pos += i + 2
+			} else {
+				p.pos = len(p.src)
+			}
+		default:
+			return
+		}
+	}
+}
+
+func (p *seedParser) consume(c byte) bool {
+	p.skipSpace()
+	if p.pos < len(p.src) && p.src[p.pos] == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *seedParser) word() string {
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		if c != '_' && c != '-' && c != '.' && (c < '0' || c > '9') && (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') {
+			break
+		}
+		p.pos++
+	}
+	return strings.Trim(p.src[start:p.pos], "`")
+}
+
+func (p *seedParser) keyword(keyword string) error {
+	if w := p.word(); !strings.EqualFold(w, keyword) {
+		return p.errorf("expected %s but got '%s'", keyword, w)
+	}
+	return nil
+}
+
+// list は (a, b, ...) を読む
+func (p *seedParser) list(item func() string) ([]string, error) {
+	if !p.consume('(') {
+		return nil, p.errorf("expected '('")
+	}
+	var items []string
+	for {
+		p.skipSpace()
+		if p.pos < len(p.src) && (p.src[p.pos] == '\'' || p.src[p.pos] == '"') {
+			s, err := p.quoted()
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, s)
+		} else {
+			items = append(items, item())
+		}
+		if p.consume(',') {
+			continue
+		}
+		if !p.consume(')') {
+			return nil, p.errorf("expected ')'")
+		}
+		return items, nil
+	}
+}
+
+// value は文字列以外の値 (数値、真偽値、UNIX_TIMESTAMP()) を読む
+func (p *seedParser) value() string {
+	w := p.word()
+	switch strings.ToUpper(w) {
+	case "TRUE":
+		return "1"
+	case "FALSE":
+		return "0"
+	case "UNIX_TIMESTAMP":
+		p.consume('(')
+		p.consume(')')
+		return strconv.FormatInt(p.now, 10)
+	}
+	return w
+}
+
+// quoted はMySQLの文字列リテラルを読む (バックスラッシュのエスケープと引用符の重ね書き)
+func (p *seedParser) quoted() (string, error) {
+	quote := p.src[p.pos]
+	p.pos++
+	var b strings.Builder
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		switch {
+		case c == '\\' && p.pos+1 < len(p.src):
+			p.pos += 2
+			switch e := p.src[p.pos-1]; e {
+			case 'n':
+				b.WriteByte('\n')
+			case 't':
+				b.WriteByte('\t')
+			case 'r':
+				b.WriteByte('\r')
+			case '0':
+				b.WriteByte(0)
+			case 'Z':
+				b.WriteByte(0x1a)
+			case '%', '_':
+				b.WriteByte('\\')
+				b.WriteByte(e)
+			default:
+				b.WriteByte(e)
+			}
+		case c == quote:
+			p.pos++
+			if p.pos < len(p.src) && p.src[p.pos] == quote {
+				b.WriteByte(quote)
+				p.pos++
+				continue
+			}
+			return b.String(), nil
+		default:
+			b.WriteByte(c)
+			p.pos++
+		}
+	}
+	return "", p.errorf("unterminated string")
+}
@@ -0,0 +1,1038 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// mysqlStore はMySQLに保存する実装
+type mysqlStore struct {
+	db *sqlx.DB
+	// replicas はレプリカが指定されていなければnil
+	replicas *replicaPool
+}
+
+func (s *mysqlStore) Begin(ctx context.Context) (Tx, error) {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &mysqlTx{tx: tx}, nil
+}
+
+func (s *mysqlStore) BeginReadOnly(ctx context.Context) (Tx, error) {
+	tx, err := s.db.BeginTxx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	return &mysqlTx{tx: tx}, nil
+}
+
+// BeginReplicaRead はレプリカが使えればレプリカで、そうでなければプライマリで読み取り専用のトランザクションを開始する
+func (s *mysqlStore) BeginReplicaRead(ctx context.Context) (Tx, error) {
+	tx, err := s.replicas.beginReplicaRead(ctx)
+	if err != nil || tx != nil {
+		return tx, err
+	}
+	return s.BeginReadOnly(ctx)
+}
+
+// Reset はテーブルを空にして初期データを流し込む (スナップショットがあればそこから戻す)
+func (s *mysqlStore) Reset(ctx context.Context, timer *initializeTimer) error {
+	return resetMySQL(ctx, s.db, timer)
+}
+
+// storeLockTimeout はLockで待つ秒数
+const storeLockTimeout = 10
+
+// Lock はGET_LOCKで名前付きロックを取る
+// GET_LOCKはセッション単位なので、外すまでコネクションを持ち続ける
+func (s *mysqlStore) Lock(ctx context.Context, name string) (func(), error) {
+	conn, err := s.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var locked sql.NullInt64
+	if err := conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, ?)", name, storeLockTimeout).Scan(&locked); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to get lock %s: %w", name, err)
+	}
+	if !locked.Valid || locked.Int64 != 1 {
+		conn.Close()
+		return nil, fmt.Errorf("failed to get lock %s: timed out", name)
+	}
+	return func() {
+		conn.ExecContext(context.Background(), "SELECT RELEASE_LOCK(?)", name)
+		conn.Close()
+	}, nil
+}
+
+func (s *mysqlStore) Ping(ctx context.Context) error {
+	return s.db.PingContext(ctx)
+}
+
+func (s *mysqlStore) Close() error {
+	if s.replicas != nil {
+		return errors.Join(s.replicas.Close(), s.db.Close())
+	}
+	return s.db.Close()
+}
+
+type mysqlTx struct {
+	tx *sqlx.Tx
+	// replica はレプリカで開始したトランザクションかどうか
+	replica bool
+}
+
+func (t *mysqlTx) Users() UserRepository               { return mysqlUserRepository{t.tx} }
+func (t *mysqlTx) Themes() ThemeRepository             { return mysqlThemeRepository{t.tx} }
+func (t *mysqlTx) Icons() IconRepository               { return mysqlIconRepository{t.tx} }
+func (t *mysqlTx) Tags() TagRepository                 { return mysqlTagRepository{t.tx} }
+func (t *mysqlTx) Livestreams() LivestreamRepository   { return mysqlLivestreamRepository{t.tx} }
+func (t *mysqlTx) Viewers() ViewerRepository           { return mysqlViewerRepository{t.tx} }
+func (t *mysqlTx) Reservations() ReservationRepository { return mysqlReservationRepository{t.tx} }
+func (t *mysqlTx) Livecomments() LivecommentRepository { return mysqlLivecommentRepository{t.tx} }
+func (t *mysqlTx) Reports() ReportRepository           { return mysqlReportRepository{t.tx} }
+func (t *mysqlTx) Reactions() ReactionRepository       { return mysqlReactionRepository{t.tx} }
+func (t *mysqlTx) NGWords() NGWordRepository           { return mysqlNGWordRepository{t.tx} }
+func (t *mysqlTx) Moderation() ModerationRepository    { return mysqlModerationRepository{t.tx} }
+func (t *mysqlTx) AccessTokens() AccessTokenRepository { return mysqlAccessTokenRepository{t.tx} }
+func (t *mysqlTx) DNSOutbox() DNSOutboxRepository      { return mysqlDNSOutboxRepository{t.tx} }
+func (t *mysqlTx) StaffGrants() StaffGrantRepository   { return mysqlStaffGrantRepository{t.tx} }
+func (t *mysqlTx) Commit() error                       { return t.tx.Commit() }
+func (t *mysqlTx) Rollback() error                     { return t.tx.Rollback() }
+
+// selectIn はqueryの (?) をidsに展開して実行する
+func selectIn(ctx context.Context, tx *sqlx.Tx, dest any, query string, ids []int64) error {
+	query, args, err := sqlx.In(query, ids)
+	if err != nil {
+		return err
+	}
+	return tx.SelectContext(ctx, dest, tx.Rebind(query), args...)
+}
+
+// insert はINSERTを実行して追加した行のIDを返す
+func insert(ctx context.Context, tx *sqlx.Tx, query string, arg any) (int64, error) {
+	rs, err := tx.NamedExecContext(ctx, query, arg)
+	if err != nil {
+		return 0, err
+	}
+	return rs.LastInsertId()
+}
+
+func withLimit(query string, limit int) string {
+	if limit == noLimit {
+		return query
+	}
+	return query + fmt.Sprintf(" LIMIT %d", limit)
+}
+
+type mysqlUserRepository struct{ tx *sqlx.Tx }
+
+func (r mysqlUserRepository) Get(ctx context.Context, id int64) (UserModel, error) {
+	var user UserModel
+	err := r.tx.GetContext(ctx, &user, "SELECT * FROM users WHERE id = ?", id)
+	return user, err
+}
+
+func (r mysqlUserRepository) GetForUpdate(ctx context.Context, id int64) (UserModel, error) {
+	var user UserModel
+	err := r.tx.GetContext(ctx, &user, "SELECT * FROM users WHERE id = ? FOR UPDATE", id)
+	return user, err
+}
+
+func (r mysqlUserRepository) GetByName(ctx context.Context, name string) (UserModel, error) {
+	var user UserModel
+	err := r.tx.GetContext(ctx, &user, "SELECT * FROM users WHERE name = ?", name)
+	return user, err
+}
+
+func (r mysqlUserRepository) FindNameCaseInsensitive(ctx context.Context, name string) (string, error) {
+	var existing string
+	err := r.tx.GetContext(ctx, &existing, "SELECT name FROM users WHERE LOWER(name) = LOWER(?) LIMIT 1", name)
+	return existing, err
+}
+
+func (r mysqlUserRepository) ListByIDs(ctx context.Context, ids []int64) ([]UserModel, error) {
+	var users []UserModel
+	err := selectIn(ctx, r.tx, &users, "SELECT * FROM users WHERE id IN (?)", ids)
+	return users, err
+}
+
+func (r mysqlUserRepository) List(ctx context.Context) ([]UserModel, error) {
+	var users []UserModel
+	err := r.tx.SelectContext(ctx, &users, "SELECT * FROM users")
+	return users, err
+}
+
+func (r mysqlUserRepository) ListActiveNames(ctx context.Context) ([]string, error) {
+	var names []string
+	err := r.tx.SelectContext(ctx, &names, "SELECT name FROM users WHERE deleted_at IS NULL")
+	return names, err
+}
+
+func (r mysqlUserRepository) ActiveNameExists(ctx context.Context, name string) (bool, error) {
+	var exists bool
+	err := r.tx.GetContext(ctx, &exists, "SELECT EXISTS (SELECT 1 FROM users WHERE LOWER(name) = LOWER(?) AND deleted_at IS NULL)", name)
+	return exists, err
+}
+
+func (r mysqlUserRepository) ListScores(ctx context.Context) ([]UserScoreModel, error) {
+	query := `
+	SELECT
+		u.id AS user_id,
+		u.name AS username,
+		COUNT(r.id) AS reactions,
+		IFNULL(SUM(lc.tip), 0) AS tips
+	FROM users u
+	LEFT JOIN livestreams l ON l.user_id = u.id
+	LEFT JOIN reactions r ON r.livestream_id = l.id
+	LEFT JOIN livecomments lc ON lc.livestream_id = l.id
+	GROUP BY u.id
+	ORDER BY (COUNT(r.id) + IFNULL(SUM(lc.tip), 0)) DESC;
+`
+	var scores []UserScoreModel
+	err := r.tx.SelectContext(ctx, &scores, query)
+	return scores, err
+}
+
+func (r mysqlUserRepository) Create(ctx context.Context, user UserModel) (int64, error) {
+	return insert(ctx, r.tx, "INSERT INTO users (name, display_name, description, password) VALUES(:name, :display_name, :description, :password)", user)
+}
+
+func (r mysqlUserRepository) Deactivate(ctx context.Context, id int64, name string, displayName string, deletedAt int64) error {
+	_, err := r.tx.ExecContext(ctx, "UPDATE users SET name = ?, display_name = ?, description = '', password = '', deleted_at = ? WHERE id = ?", name, displayName, deletedAt, id)
+	return err
+}
+
+func (r mysqlUserRepository) ListPage(ctx context.Context, afterID int64, limit int) ([]UserModel, error) {
+	var users []UserModel
+	err := r.tx.SelectContext(ctx, &users, withLimit("SELECT * FROM users WHERE id > ? ORDER BY id", limit), afterID)
+	return users, err
+}
+
+func (r mysqlUserRepository) SetRole(ctx context.Context, id int64, role Role) error {
+	_, err := r.tx.ExecContext(ctx, "UPDATE users SET role = ? WHERE id = ?", role, id)
+	return err
+}
+
+func (r mysqlUserRepository) SetSuspendedAt(ctx context.Context, id int64, suspendedAt sql.NullInt64) error {
+	_, err := r.tx.ExecContext(ctx, "UPDATE users SET suspended_at = ? WHERE id = ?", suspendedAt, id)
+	return err
+}
+
+type mysqlThemeRepository struct{ tx *sqlx.Tx }
+
+func (r mysqlThemeRepository) GetByUserID(ctx context.Context, userID int64) (ThemeModel, error) {
+	var theme ThemeModel
+	err := r.tx.GetContext(ctx, &theme, "SELECT * FROM themes WHERE user_id = ?", userID)
+	return theme, err
+}
+
+func (r mysqlThemeRepository) ListByUserIDs(ctx context.Context, userIDs []int64) ([]ThemeModel, error) {
+	var themes []ThemeModel
+	err := selectIn(ctx, r.tx, &themes, "SELECT * FROM themes WHERE user_id IN (?)", userIDs)
+	return themes, err
+}
+
+func (r mysqlThemeRepository) List(ctx context.Context) ([]ThemeModel, error) {
+	var themes []ThemeModel
+	err := r.tx.SelectContext(ctx, &themes, "SELECT * FROM themes")
+	return themes, err
+}
+
+func (r mysqlThemeRepository) Create(ctx context.Context, theme ThemeModel) (int64, error) {
+	return insert(ctx, r.tx, "INSERT INTO themes (user_id, dark_mode) VALUES(:user_id, :dark_mode)", theme)
+}
+
+func (r mysqlThemeRepository) DeleteByUserID(ctx context.Context, userID int64) error {
+	_, err := r.tx.ExecContext(ctx, "DELETE FROM themes WHERE user_id = ?", userID)
+	return err
+}
+
+type mysqlIconRepository struct{ tx *sqlx.Tx }
+
+func (r mysqlIconRepository) GetHashByUserID(ctx context.Context, userID int64) (string, error) {
+	var imageHash string
+	err := r.tx.GetContext(ctx, &imageHash, "SELECT image_hash FROM icons WHERE user_id = ?", userID)
+	return imageHash, err
+}
+
+func (r mysqlIconRepository) ListHashesByUserIDs(ctx context.Context, userIDs []int64) ([]IconModel, error) {
+	var icons []IconModel
+	err := selectIn(ctx, r.tx, &icons, "SELECT user_id, image_hash FROM icons WHERE user_id IN (?)", userIDs)
+	return icons, err
+}
+
+func (r mysqlIconRepository) ListHashes(ctx context.Context) ([]IconModel, error) {
+	var icons []IconModel
+	err := r.tx.SelectContext(ctx, &icons, "SELECT user_id, image_hash FROM icons")
+	return icons, err
+}
+
+func (r mysqlIconRepository) Replace(ctx context.Context, userID int64, imageHash string, contentType string) (int64, error) {
+	if err := r.DeleteByUserID(ctx, userID); err != nil {
+		return 0, err
+	}
+	// 画像本体はIconStoreに保存するので、imageカラムは空にしておく
+	rs, err := r.tx.ExecContext(ctx, "INSERT INTO icons (user_id, image, image_hash, content_type) VALUES (?, '', ?, ?)", userID, imageHash, contentType)
+	if err != nil {
+		return 0, err
+	}
+	return rs.LastInsertId()
+}
+
+func (r mysqlIconRepository) DeleteByUserID(ctx context.Context, userID int64) error {
+	_, err := r.tx.ExecContext(ctx, "DELETE FROM icons WHERE user_id = ?", userID)
+	return err
+}
+
+func (r mysqlIconRepository) HashExists(ctx context.Context, imageHash string) (bool, error) {
+	var exists bool
+	err := r.tx.GetContext(ctx, &exists, "SELECT EXISTS (SELECT 1 FROM icons WHERE image_hash = ?)", imageHash)
+	return exists, err
+}
+
+func (r mysqlIconRepository) GetLegacyImage(ctx context.Context, imageHash string) (IconModel, error) {
+	var icon IconModel
+	err := r.tx.GetContext(ctx, &icon, "SELECT content_type, image FROM icons WHERE image_hash = ? AND LENGTH(image) > 0 LIMIT 1", imageHash)
+	return icon, err
+}
+
+func (r mysqlIconRepository) ListLegacyIDs(ctx context.Context) ([]int64, error) {
+	var ids []int64
+	err := r.tx.SelectContext(ctx, &ids, "SELECT id FROM icons WHERE LENGTH(image) > 0 ORDER BY id")
+	return ids, err
+}
+
+func (r mysqlIconRepository) GetLegacy(ctx context.Context, id int64) (IconModel, error) {
+	var icon IconModel
+	err := r.tx.GetContext(ctx, &icon, "SELECT image_hash, image FROM icons WHERE id = ?", id)
+	return icon, err
+}
+
+func (r mysqlIconRepository) PurgeLegacyImage(ctx context.Context, id int64) error {
+	_, err := r.tx.ExecContext(ctx, "UPDATE icons SET image = '' WHERE id = ?", id)
+	return err
+}
+
+func (r mysqlIconRepository) PutImage(ctx context.Context, key iconCacheKey, icon *cachedIcon) error {
+	_, err := r.tx.ExecContext(ctx, "INSERT INTO icon_images (image_hash, size, content_type, image) VALUES (?, ?, ?, ?) ON DUPLICATE KEY UPDATE content_type = VALUES(content_type), image = VALUES(image)", key.Hash, key.Size, icon.ContentType, icon.Image)
+	return err
+}
+
+func (r mysqlIconRepository) GetImage(ctx context.Context, key iconCacheKey) (*cachedIcon, error) {
+	var icon IconModel
+	if err := r.tx.GetContext(ctx, &icon, "SELECT content_type, image FROM icon_images WHERE image_hash = ? AND size = ?", key.Hash, key.Size); err != nil {
+		return nil, err
+	}
+	return &cachedIcon{
+		ContentType: icon.ContentType,
+		Image:       icon.Image,
+	}, nil
+}
+
+func (r mysqlIconRepository) DeleteImage(ctx context.Context, key iconCacheKey) error {
+	_, err := r.tx.ExecContext(ctx, "DELETE FROM icon_images WHERE image_hash = ? AND size = ?", key.Hash, key.Size)
+	return err
+}
+
+type mysqlTagRepository struct{ tx *sqlx.Tx }
+
+func (r mysqlTagRepository) List(ctx context.Context) ([]TagModel, error) {
+	var tags []TagModel
+	err := r.tx.SelectContext(ctx, &tags, "SELECT * FROM tags")
+	return tags, err
+}
+
+func (r mysqlTagRepository) ListByIDs(ctx context.Context, ids []int64) ([]TagModel, error) {
+	var tags []TagModel
+	err := selectIn(ctx, r.tx, &tags, "SELECT * FROM tags WHERE id IN (?)", ids)
+	return tags, err
+}
+
+func (r mysqlTagRepository) ListIDsByName(ctx context.Context, name string) ([]int64, error) {
+	var ids []int64
+	err := r.tx.SelectContext(ctx, &ids, "SELECT id FROM tags WHERE name = ?", name)
+	return ids, err
+}
+
+func (r mysqlTagRepository) Get(ctx context.Context, id int64) (TagModel, error) {
+	var tag TagModel
+	err := r.tx.GetContext(ctx, &tag, "SELECT * FROM tags WHERE id = ?", id)
+	return tag, err
+}
+
+func (r mysqlTagRepository) Create(ctx context.Context, name string) (int64, error) {
+	rs, err := r.tx.ExecContext(ctx, "INSERT INTO tags (name) VALUES (?)", name)
+	if err != nil {
+		return 0, err
+	}
+	return rs.LastInsertId()
+}
+
+func (r mysqlTagRepository) Delete(ctx context.Context, id int64) error {
+	if _, err := r.tx.ExecContext(ctx, "DELETE FROM livestream_tags WHERE tag_id = ?", id); err != nil {
+		return err
+	}
+	_, err := r.tx.ExecContext(ctx, "DELETE FROM tags WHERE id = ?", id)
+	return err
+}
+
+type mysqlLivestreamRepository struct{ tx *sqlx.Tx }
+
+func (r mysqlLivestreamRepository) Get(ctx context.Context, id int64) (LivestreamModel, error) {
+	var livestream LivestreamModel
+	err := r.tx.GetContext(ctx, &livestream, "SELECT * FROM livestreams WHERE id = ?", id)
+	return livestream, err
+}
+
+func (r mysqlLivestreamRepository) Exists(ctx context.Context, id int64) (bool, error) {
+	var exists bool
+	err := r.tx.GetContext(ctx, &exists, "SELECT EXISTS(SELECT 1 FROM livestreams WHERE id = ?)", id)
+	return exists, err
+}
+
+func (r mysqlLivestreamRepository) ListByIDs(ctx context.Context, ids []int64) ([]LivestreamModel, error) {
+	var livestreams []LivestreamModel
+	err := selectIn(ctx, r.tx, &livestreams, "SELECT * FROM livestreams WHERE id IN (?)", ids)
+	return livestreams, err
+}
+
+func (r mysqlLivestreamRepository) ListByUserID(ctx context.Context, userID int64) ([]LivestreamModel, error) {
+	livestreams := []LivestreamModel{}
+	err := r.tx.SelectContext(ctx, &livestreams, "SELECT * FROM livestreams WHERE user_id = ? ORDER BY id", userID)
+	return livestreams, err
+}
+
+func (r mysqlLivestreamRepository) ListPageByUserID(ctx context.Context, userID int64, afterID int64, limit int) ([]LivestreamModel, error) {
+	livestreams := []LivestreamModel{}
+	err := r.tx.SelectContext(ctx, &livestreams, withLimit("SELECT * FROM livestreams WHERE user_id = ? AND id > ? ORDER BY id", limit), userID, afterID)
+	return livestreams, err
+}
+
+func (r mysqlLivestreamRepository) List(ctx context.Context) ([]LivestreamModel, error) {
+	var livestreams []LivestreamModel
+	err := r.tx.SelectContext(ctx, &livestreams, "SELECT * FROM livestreams")
+	return livestreams, err
+}
+
+func (r mysqlLivestreamRepository) ListLatest(ctx context.Context, limit int) ([]LivestreamModel, error) {
+	var livestreams []LivestreamModel
+	err := r.tx.SelectContext(ctx, &livestreams, withLimit("SELECT * FROM livestreams ORDER BY id DESC", limit))
+	return livestreams, err
+}
+
+func (r mysqlLivestreamRepository) ListStartingAfterForUpdate(ctx context.Context, userID int64, now int64) ([]LivestreamModel, error) {
+	var livestreams []LivestreamModel
+	err := r.tx.SelectContext(ctx, &livestreams, "SELECT * FROM livestreams WHERE user_id = ? AND start_at > ? FOR UPDATE", userID, now)
+	return livestreams, err
+}
+
+func (r mysqlLivestreamRepository) ListScores(ctx context.Context, ids []int64) ([]LivestreamScoreModel, error) {
+	query := `
+	SELECT
+		l.id AS livestream_id,
+		COUNT(r.id) AS reactions,
+		IFNULL(SUM(lc.tip), 0) AS total_tips
+	FROM livestreams l
+	LEFT JOIN reactions r ON l.id = r.livestream_id
+	LEFT JOIN livecomments lc ON l.id = lc.livestream_id
+	WHERE l.id IN (?)
+	GROUP BY l.id
+	`
+	var scores []LivestreamScoreModel
+	err := selectIn(ctx, r.tx, &scores, query, ids)
+	return scores, err
+}
+
+func (r mysqlLivestreamRepository) Create(ctx context.Context, livestream LivestreamModel) (int64, error) {
+	return insert(ctx, r.tx, "INSERT INTO livestreams (user_id, title, description, playlist_url, thumbnail_url, start_at, end_at) VALUES(:user_id, :title, :description, :playlist_url, :thumbnail_url, :start_at, :end_at)", livestream)
+}
+
+func (r mysqlLivestreamRepository) Delete(ctx context.Context, id int64) error {
+	for _, query := range []string{
+		"DELETE FROM livestream_tags WHERE livestream_id = ?",
+		"DELETE FROM ng_words WHERE livestream_id = ?",
+		"DELETE FROM livecomment_reports WHERE livestream_id = ?",
+		"DELETE FROM livecomments WHERE livestream_id = ?",
+		"DELETE FROM reactions WHERE livestream_id = ?",
+		"DELETE FROM livestream_viewers_history WHERE livestream_id = ?",
+		"DELETE FROM livestream_unique_viewers WHERE livestream_id = ?",
+		"DELETE FROM livestream_presence WHERE livestream_id = ?",
+		"DELETE FROM livestream_presence_peaks WHERE livestream_id = ?",
+		"DELETE FROM livestreams WHERE id = ?",
+	} {
+		if _, err := r.tx.ExecContext(ctx, query, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r mysqlLivestreamRepository) AddTag(ctx context.Context, livestreamID int64, tagID int64) error {
+	_, err := r.tx.NamedExecContext(ctx, "INSERT INTO livestream_tags (livestream_id, tag_id) VALUES (:livestream_id, :tag_id)", &LivestreamTagModel{
+		LivestreamID: livestreamID,
+		TagID:        tagID,
+	})
+	return err
+}
+
+func (r mysqlLivestreamRepository) ListTags(ctx context.Context, livestreamIDs []int64) ([]LivestreamTagModel, error) {
+	var livestreamTags []LivestreamTagModel
+	err := selectIn(ctx, r.tx, &livestreamTags, "SELECT * FROM livestream_tags WHERE livestream_id IN (?) ORDER BY id", livestreamIDs)
+	return livestreamTags, err
+}
+
+func (r mysqlLivestreamRepository) ListTagged(ctx context.Context, tagIDs []int64) ([]LivestreamTagModel, error) {
+	if len(tagIDs) == 0 {
+		return nil, nil
+	}
+	var livestreamTags []LivestreamTagModel
+	err := selectIn(ctx, r.tx, &livestreamTags, "SELECT * FROM livestream_tags WHERE tag_id IN (?) ORDER BY livestream_id DESC", tagIDs)
+	return livestreamTags, err
+}
+
+type mysqlViewerRepository struct{ tx *sqlx.Tx }
+
+func (r mysqlViewerRepository) AddHistory(ctx context.Context, viewer LivestreamViewerModel) error {
+	_, err := r.tx.NamedExecContext(ctx, "INSERT INTO livestream_viewers_history (user_id, livestream_id, created_at) VALUES(:user_id, :livestream_id, :created_at)", viewer)
+	return err
+}
+
+func (r mysqlViewerRepository) AddUnique(ctx context.Context, viewer LivestreamViewerModel) error {
+	_, err := r.tx.NamedExecContext(ctx, "INSERT IGNORE INTO livestream_unique_viewers (livestream_id, user_id, created_at) VALUES(:livestream_id, :user_id, :created_at)", viewer)
+	return err
+}
+
+func (r mysqlViewerRepository) DeleteHistory(ctx context.Context, userID int64, livestreamID int64) error {
+	_, err := r.tx.ExecContext(ctx, "DELETE FROM livestream_viewers_history WHERE user_id = ? AND livestream_id = ?", userID, livestreamID)
+	return err
+}
+
+func (r mysqlViewerRepository) DeleteHistoryByUserID(ctx context.Context, userID int64) error {
+	_, err := r.tx.ExecContext(ctx, "DELETE FROM livestream_viewers_history WHERE user_id = ?", userID)
+	return err
+}
+
+func (r mysqlViewerRepository) HistoryExists(ctx context.Context, userID int64, livestreamID int64) (bool, error) {
+	var exists bool
+	err := r.tx.GetContext(ctx, &exists, "SELECT EXISTS (SELECT 1 FROM livestream_viewers_history WHERE user_id = ? AND livestream_id = ?)", userID, livestreamID)
+	return exists, err
+}
+
+func (r mysqlViewerRepository) CountHistory(ctx context.Context, livestreamID int64) (int64, error) {
+	var count int64
+	err := r.tx.GetContext(ctx, &count, "SELECT COUNT(*) FROM livestream_viewers_history WHERE livestream_id = ?", livestreamID)
+	return count, err
+}
+
+func (r mysqlViewerRepository) CountUnique(ctx context.Context, livestreamID int64) (int64, error) {
+	var count int64
+	err := r.tx.GetContext(ctx, &count, "SELECT COUNT(*) FROM livestream_unique_viewers WHERE livestream_id = ?", livestreamID)
+	return count, err
+}
+
+func (r mysqlViewerRepository) ListHistoryPageByUserID(ctx context.Context, userID int64, afterID int64, limit int) ([]LivestreamViewerHistoryModel, error) {
+	viewers := []LivestreamViewerHistoryModel{}
+	err := r.tx.SelectContext(ctx, &viewers, withLimit("SELECT id, user_id, livestream_id, created_at FROM livestream_viewers_history WHERE user_id = ? AND id > ? ORDER BY id", limit), userID, afterID)
+	return viewers, err
+}
+
+func (r mysqlViewerRepository) TouchPresence(ctx context.Context, livestreamID int64, userID int64, seenAt int64) error {
+	_, err := r.tx.ExecContext(ctx, "INSERT INTO livestream_presence (livestream_id, user_id, last_seen_at) VALUES (?, ?, ?) ON DUPLICATE KEY UPDATE last_seen_at = GREATEST(last_seen_at, VALUES(last_seen_at))", livestreamID, userID, seenAt)
+	return err
+}
+
+func (r mysqlViewerRepository) PresenceExists(ctx context.Context, livestreamID int64, userID int64, since int64) (bool, error) {
+	var exists bool
+	err := r.tx.GetContext(ctx, &exists, "SELECT EXISTS (SELECT 1 FROM livestream_presence WHERE livestream_id = ? AND user_id = ? AND last_seen_at >= ?)", livestreamID, userID, since)
+	return exists, err
+}
+
+func (r mysqlViewerRepository) DeletePresence(ctx context.Context, livestreamID int64, userID int64) error {
+	_, err := r.tx.ExecContext(ctx, "DELETE FROM livestream_presence WHERE livestream_id = ? AND user_id = ?", livestreamID, userID)
+	return err
+}
+
+func (r mysqlViewerRepository) CountPresence(ctx context.Context, livestreamID int64, since int64) (int64, error) {
+	var count int64
+	err := r.tx.GetContext(ctx, &count, "SELECT COUNT(*) FROM livestream_presence WHERE livestream_id = ? AND last_seen_at >= ?", livestreamID, since)
+	return count, err
+}
+
+func (r mysqlViewerRepository) DeleteExpiredPresence(ctx context.Context, before int64) error {
+	_, err := r.tx.ExecContext(ctx, "DELETE FROM livestream_presence WHERE last_seen_at < ?", before)
+	return err
+}
+
+func (r mysqlViewerRepository) RecordPeakPresence(ctx context.Context, livestreamID int64, count int64) error {
+	_, err := r.tx.ExecContext(ctx, "INSERT INTO livestream_presence_peaks (livestream_id, peak) VALUES (?, ?) ON DUPLICATE KEY UPDATE peak = GREATEST(peak, VALUES(peak))", livestreamID, count)
+	return err
+}
+
+func (r mysqlViewerRepository) GetPeakPresence(ctx context.Context, livestreamID int64) (int64, error) {
+	var peak int64
+	err := r.tx.GetContext(ctx, &peak, "SELECT peak FROM livestream_presence_peaks WHERE livestream_id = ?", livestreamID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	return peak, err
+}
+
+type mysqlReservationRepository struct{ tx *sqlx.Tx }
+
+func (r mysqlReservationRepository) ListForUpdate(ctx context.Context, startAt int64, endAt int64) ([]ReservationSlotModel, error) {
+	var slots []ReservationSlotModel
+	err := r.tx.SelectContext(ctx, &slots, "SELECT * FROM reservation_slots WHERE start_at >= ? AND end_at <= ? FOR UPDATE", startAt, endAt)
+	return slots, err
+}
+
+func (r mysqlReservationRepository) GetSlot(ctx context.Context, startAt int64, endAt int64) (int64, error) {
+	var slot int64
+	err := r.tx.GetContext(ctx, &slot, "SELECT slot FROM reservation_slots WHERE start_at = ? AND end_at = ?", startAt, endAt)
+	return slot, err
+}
+
+func (r mysqlReservationRepository) Reserve(ctx context.Context, startAt int64, endAt int64) error {
+	_, err := r.tx.ExecContext(ctx, "UPDATE reservation_slots SET slot = slot - 1 WHERE start_at >= ? AND end_at <= ?", startAt, endAt)
+	return err
+}
+
+func (r mysqlReservationRepository) Release(ctx context.Context, startAt int64, endAt int64) error {
+	_, err := r.tx.ExecContext(ctx, "UPDATE reservation_slots SET slot = slot + 1 WHERE start_at >= ? AND end_at <= ?", startAt, endAt)
+	return err
+}
+
+func (r mysqlReservationRepository) List(ctx context.Context, startAt int64, endAt int64) ([]ReservationSlotModel, error) {
+	var slots []ReservationSlotModel
+	err := r.tx.SelectContext(ctx, &slots, "SELECT * FROM reservation_slots WHERE start_at >= ? AND end_at <= ? ORDER BY start_at", startAt, endAt)
+	return slots, err
+}
+
+func (r mysqlReservationRepository) AddCapacity(ctx context.Context, startAt int64, endAt int64, delta int64) error {
+	_, err := r.tx.ExecContext(ctx, "UPDATE reservation_slots SET slot = GREATEST(slot + ?, 0) WHERE start_at >= ? AND end_at <= ?", delta, startAt, endAt)
+	return err
+}
+
+type mysqlLivecommentRepository struct{ tx *sqlx.Tx }
+
+func (r mysqlLivecommentRepository) Get(ctx context.Context, id int64) (LivecommentModel, error) {
+	var livecomment LivecommentModel
+	err := r.tx.GetContext(ctx, &livecomment, "SELECT * FROM livecomments WHERE id = ?", id)
+	return livecomment, err
+}
+
+func (r mysqlLivecommentRepository) ListByIDs(ctx context.Context, ids []int64) ([]LivecommentModel, error) {
+	var livecomments []LivecommentModel
+	err := selectIn(ctx, r.tx, &livecomments, "SELECT * FROM livecomments WHERE id IN (?)", ids)
+	return livecomments, err
+}
+
+func (r mysqlLivecommentRepository) ListByLivestreamID(ctx context.Context, livestreamID int64, limit int) ([]LivecommentModel, error) {
+	livecomments := []LivecommentModel{}
+	err := r.tx.SelectContext(ctx, &livecomments, withLimit("SELECT * FROM livecomments WHERE livestream_id = ? ORDER BY created_at DESC", limit), livestreamID)
+	return livecomments, err
+}
+
+func (r mysqlLivecommentRepository) List(ctx context.Context) ([]LivecommentModel, error) {
+	var livecomments []LivecommentModel
+	err := r.tx.SelectContext(ctx, &livecomments, "SELECT * FROM livecomments")
+	return livecomments, err
+}
+
+func (r mysqlLivecommentRepository) ListPageByUserID(ctx context.Context, userID int64, tipOnly bool, afterID int64, limit int) ([]LivecommentModel, error) {
+	query := "SELECT * FROM livecomments WHERE user_id = ? AND id > ? ORDER BY id"
+	if tipOnly {
+		query = "SELECT * FROM livecomments WHERE user_id = ? AND id > ? AND tip > 0 ORDER BY id"
+	}
+	livecomments := []LivecommentModel{}
+	err := r.tx.SelectContext(ctx, &livecomments, withLimit(query, limit), userID, afterID)
+	return livecomments, err
+}
+
+func (r mysqlLivecommentRepository) ListTipsReceivedPage(ctx context.Context, streamerID int64, afterID int64, limit int) ([]LivecommentModel, error) {
+	livecomments := []LivecommentModel{}
+	err := r.tx.SelectContext(ctx, &livecomments, withLimit("SELECT lc.* FROM livecomments lc INNER JOIN livestreams l ON l.id = lc.livestream_id WHERE l.user_id = ? AND lc.id > ? AND lc.tip > 0 ORDER BY lc.id", limit), streamerID, afterID)
+	return livecomments, err
+}
+
+func (r mysqlLivecommentRepository) MaxTip(ctx context.Context, livestreamID int64) (int64, error) {
+	var maxTip int64
+	err := r.tx.GetContext(ctx, &maxTip, `SELECT IFNULL(MAX(tip), 0) FROM livestreams l INNER JOIN livecomments l2 ON l2.livestream_id = l.id WHERE l.id = ?`, livestreamID)
+	return maxTip, err
+}
+
+func (r mysqlLivecommentRepository) TotalTip(ctx context.Context) (int64, error) {
+	var totalTip int64
+	err := r.tx.GetContext(ctx, &totalTip, "SELECT IFNULL(SUM(tip), 0) FROM livecomments")
+	return totalTip, err
+}
+
+// supporterFrom は集計対象のライブコメントを絞り込むFROM句とその引数
+func supporterFrom(scope supporterScope) (string, int64) {
+	if scope.livestreamID != 0 {
+		return "FROM livecomments lc WHERE lc.livestream_id = ? AND lc.tip > 0", scope.livestreamID
+	}
+	return "FROM livecomments lc INNER JOIN livestreams l ON l.id = lc.livestream_id WHERE l.user_id = ? AND lc.tip > 0", scope.streamerID
+}
+
+func (r mysqlLivecommentRepository) ListSupporters(ctx context.Context, scope supporterScope, limit int) ([]supporterTotalModel, error) {
+	from, arg := supporterFrom(scope)
+	var totals []supporterTotalModel
+	query := "SELECT lc.user_id AS user_id, SUM(lc.tip) AS total_tip " + from + " GROUP BY lc.user_id ORDER BY total_tip DESC, user_id ASC LIMIT ?"
+	err := r.tx.SelectContext(ctx, &totals, query, arg, limit)
+	return totals, err
+}
+
+func (r mysqlLivecommentRepository) SupporterTotal(ctx context.Context, scope supporterScope, userID int64) (int64, error) {
+	from, arg := supporterFrom(scope)
+	var total int64
+	err := r.tx.GetContext(ctx, &total, "SELECT IFNULL(SUM(lc.tip), 0) "+from+" AND lc.user_id = ?", arg, userID)
+	return total, err
+}
+
+func (r mysqlLivecommentRepository) CountSupportersAbove(ctx context.Context, scope supporterScope, totalTip int64, userID int64) (int64, error) {
+	from, arg := supporterFrom(scope)
+	var higher int64
+	query := "SELECT COUNT(*) FROM (SELECT lc.user_id AS user_id, SUM(lc.tip) AS total_tip " + from + " GROUP BY lc.user_id) t WHERE t.total_tip > ? OR (t.total_tip = ? AND t.user_id < ?)"
+	err := r.tx.GetContext(ctx, &higher, query, arg, totalTip, totalTip, userID)
+	return higher, err
+}
+
+func (r mysqlLivecommentRepository) Create(ctx context.Context, livecomment LivecommentModel) (int64, error) {
+	return insert(ctx, r.tx, "INSERT INTO livecomments (user_id, livestream_id, comment, tip, created_at) VALUES (:user_id, :livestream_id, :comment, :tip, :created_at)", livecomment)
+}
+
+func (r mysqlLivecommentRepository) Delete(ctx context.Context, id int64) error {
+	_, err := r.tx.ExecContext(ctx, "DELETE FROM livecomments WHERE id = ?", id)
+	return err
+}
+
+func (r mysqlLivecommentRepository) DeleteByUserID(ctx context.Context, userID int64) error {
+	for _, query := range []string{
+		"DELETE r FROM livecomment_reports r INNER JOIN livecomments l ON l.id = r.livecomment_id WHERE l.user_id = ? AND l.tip = 0",
+		"DELETE FROM livecomments WHERE user_id = ? AND tip = 0",
+		"UPDATE livecomments SET comment = '' WHERE user_id = ?",
+	} {
+		if _, err := r.tx.ExecContext(ctx, query, userID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type mysqlReportRepository struct{ tx *sqlx.Tx }
+
+func (r mysqlReportRepository) ListByLivestreamID(ctx context.Context, livestreamID int64) ([]LivecommentReportModel, error) {
+	var reports []LivecommentReportModel
+	err := r.tx.SelectContext(ctx, &reports, "SELECT * FROM livecomment_reports WHERE livestream_id = ?", livestreamID)
+	return reports, err
+}
+
+func (r mysqlReportRepository) ListPageByUserID(ctx context.Context, userID int64, afterID int64, limit int) ([]LivecommentReportModel, error) {
+	reports := []LivecommentReportModel{}
+	err := r.tx.SelectContext(ctx, &reports, withLimit("SELECT * FROM livecomment_reports WHERE user_id = ? AND id > ? ORDER BY id", limit), userID, afterID)
+	return reports, err
+}
+
+func (r mysqlReportRepository) CountByLivestreamID(ctx context.Context, livestreamID int64) (int64, error) {
+	var count int64
+	err := r.tx.GetContext(ctx, &count, `SELECT COUNT(*) FROM livestreams l INNER JOIN livecomment_reports r ON r.livestream_id = l.id WHERE l.id = ?`, livestreamID)
+	return count, err
+}
+
+func (r mysqlReportRepository) Create(ctx context.Context, report LivecommentReportModel) (int64, error) {
+	return insert(ctx, r.tx, "INSERT INTO livecomment_reports(user_id, livestream_id, livecomment_id, created_at) VALUES (:user_id, :livestream_id, :livecomment_id, :created_at)", report)
+}
+
+func (r mysqlReportRepository) DeleteByUserID(ctx context.Context, userID int64) error {
+	_, err := r.tx.ExecContext(ctx, "DELETE FROM livecomment_reports WHERE user_id = ?", userID)
+	return err
+}
+
+type mysqlReactionRepository struct{ tx *sqlx.Tx }
+
+func (r mysqlReactionRepository) ListByLivestreamID(ctx context.Context, livestreamID int64, limit int) ([]ReactionModel, error) {
+	reactions := []ReactionModel{}
+	err := r.tx.SelectContext(ctx, &reactions, withLimit("SELECT * FROM reactions WHERE livestream_id = ? ORDER BY created_at DESC", limit), livestreamID)
+	return reactions, err
+}
+
+func (r mysqlReactionRepository) ListPageByUserID(ctx context.Context, userID int64, afterID int64, limit int) ([]ReactionModel, error) {
+	reactions := []ReactionModel{}
+	err := r.tx.SelectContext(ctx, &reactions, withLimit("SELECT * FROM reactions WHERE user_id = ? AND id > ? ORDER BY id", limit), userID, afterID)
+	return reactions, err
+}
+
+func (r mysqlReactionRepository) CountByLivestreamID(ctx context.Context, livestreamID int64) (int64, error) {
+	var count int64
+	err := r.tx.GetContext(ctx, &count, "SELECT COUNT(*) FROM livestreams l INNER JOIN reactions r ON r.livestream_id = l.id WHERE l.id = ?", livestreamID)
+	return count, err
+}
+
+func (r mysqlReactionRepository) CountByStreamerName(ctx context.Context, name string) (int64, error) {
+	query := `SELECT COUNT(*) FROM users u
+    INNER JOIN livestreams l ON l.user_id = u.id
+    INNER JOIN reactions r ON r.livestream_id = l.id
+    WHERE u.name = ?
+	`
+	var count int64
+	err := r.tx.GetContext(ctx, &count, query, name)
+	return count, err
+}
+
+func (r mysqlReactionRepository) FavoriteEmojiByStreamerName(ctx context.Context, name string) (string, error) {
+	query := `
+	SELECT r.emoji_name
+	FROM users u
+	INNER JOIN livestreams l ON l.user_id = u.id
+	INNER JOIN reactions r ON r.livestream_id = l.id
+	WHERE u.name = ?
+	GROUP BY emoji_name
+	ORDER BY COUNT(*) DESC, emoji_name DESC
+	LIMIT 1
+	`
+	var emojiName string
+	err := r.tx.GetContext(ctx, &emojiName, query, name)
+	return emojiName, err
+}
+
+func (r mysqlReactionRepository) Create(ctx context.Context, reaction ReactionModel) (int64, error) {
+	return insert(ctx, r.tx, "INSERT INTO reactions (user_id, livestream_id, emoji_name, created_at) VALUES (:user_id, :livestream_id, :emoji_name, :created_at)", reaction)
+}
+
+func (r mysqlReactionRepository) DeleteByUserID(ctx context.Context, userID int64) error {
+	_, err := r.tx.ExecContext(ctx, "DELETE FROM reactions WHERE user_id = ?", userID)
+	return err
+}
+
+type mysqlNGWordRepository struct{ tx *sqlx.Tx }
+
+func (r mysqlNGWordRepository) ListByUserAndLivestream(ctx context.Context, userID int64, livestreamID int64) ([]NGWord, error) {
+	var ngwords []NGWord
+	err := r.tx.SelectContext(ctx, &ngwords, "SELECT * FROM ng_words WHERE user_id = ? AND livestream_id = ? ORDER BY created_at DESC", userID, livestreamID)
+	return ngwords, err
+}
+
+func (r mysqlNGWordRepository) ListByLivestreamID(ctx context.Context, livestreamID int64) ([]NGWord, error) {
+	var ngwords []NGWord
+	err := r.tx.SelectContext(ctx, &ngwords, "SELECT * FROM ng_words WHERE livestream_id = ?", livestreamID)
+	return ngwords, err
+}
+
+func (r mysqlNGWordRepository) ListPageByUserID(ctx context.Context, userID int64, afterID int64, limit int) ([]NGWord, error) {
+	ngwords := []NGWord{}
+	err := r.tx.SelectContext(ctx, &ngwords, withLimit("SELECT * FROM ng_words WHERE user_id = ? AND id > ? ORDER BY id", limit), userID, afterID)
+	return ngwords, err
+}
+
+func (r mysqlNGWordRepository) Matches(ctx context.Context, text string, word string) (bool, error) {
+	query := `
+	SELECT COUNT(*)
+	FROM
+	(SELECT ? AS text) AS texts
+	INNER JOIN
+	(SELECT CONCAT('%', ?, '%')	AS pattern) AS patterns
+	ON texts.text LIKE patterns.pattern;
+	`
+	var hit int
+	if err := r.tx.GetContext(ctx, &hit, query, text, word); err != nil {
+		return false, err
+	}
+	return hit >= 1, nil
+}
+
+func (r mysqlNGWordRepository) Create(ctx context.Context, ngword NGWord) (int64, error) {
+	return insert(ctx, r.tx, "INSERT INTO ng_words(user_id, livestream_id, word, created_at) VALUES (:user_id, :livestream_id, :word, :created_at)", ngword)
+}
+
+type mysqlModerationRepository struct{ tx *sqlx.Tx }
+
+func (r mysqlModerationRepository) ListModerators(ctx context.Context, streamerID int64) ([]ChannelModeratorModel, error) {
+	moderators := []ChannelModeratorModel{}
+	err := r.tx.SelectContext(ctx, &moderators, "SELECT * FROM channel_moderators WHERE streamer_id = ? ORDER BY id", streamerID)
+	return moderators, err
+}
+
+func (r mysqlModerationRepository) IsModerator(ctx context.Context, streamerID int64, userID int64) (bool, error) {
+	var exists bool
+	err := r.tx.GetContext(ctx, &exists, "SELECT EXISTS (SELECT 1 FROM channel_moderators WHERE streamer_id = ? AND user_id = ?)", streamerID, userID)
+	return exists, err
+}
+
+func (r mysqlModerationRepository) AddModerator(ctx context.Context, moderator ChannelModeratorModel) (int64, error) {
+	return insert(ctx, r.tx, "INSERT INTO channel_moderators (streamer_id, user_id, created_at) VALUES (:streamer_id, :user_id, :created_at)", moderator)
+}
+
+func (r mysqlModerationRepository) RemoveModerator(ctx context.Context, streamerID int64, userID int64) error {
+	_, err := r.tx.ExecContext(ctx, "DELETE FROM channel_moderators WHERE streamer_id = ? AND user_id = ?", streamerID, userID)
+	return err
+}
+
+func (r mysqlModerationRepository) ListBans(ctx context.Context, streamerID int64) ([]ChannelBanModel, error) {
+	bans := []ChannelBanModel{}
+	err := r.tx.SelectContext(ctx, &bans, "SELECT * FROM channel_bans WHERE streamer_id = ? ORDER BY id", streamerID)
+	return bans, err
+}
+
+func (r mysqlModerationRepository) IsBanned(ctx context.Context, streamerID int64, userID int64) (bool, error) {
+	var exists bool
+	err := r.tx.GetContext(ctx, &exists, "SELECT EXISTS (SELECT 1 FROM channel_bans WHERE streamer_id = ? AND user_id = ?)", streamerID, userID)
+	return exists, err
+}
+
+func (r mysqlModerationRepository) Ban(ctx context.Context, ban ChannelBanModel) (int64, error) {
+	return insert(ctx, r.tx, "INSERT INTO channel_bans (streamer_id, user_id, banned_by, created_at) VALUES (:streamer_id, :user_id, :banned_by, :created_at)", ban)
+}
+
+func (r mysqlModerationRepository) Unban(ctx context.Context, streamerID int64, userID int64) error {
+	_, err := r.tx.ExecContext(ctx, "DELETE FROM channel_bans WHERE streamer_id = ? AND user_id = ?", streamerID, userID)
+	return err
+}
+
+func (r mysqlModerationRepository) AddLog(ctx context.Context, log ModerationLogModel) (int64, error) {
+	return insert(ctx, r.tx, "INSERT INTO moderation_logs (streamer_id, livestream_id, moderator_id, action, target_id, detail, created_at) VALUES (:streamer_id, :livestream_id, :moderator_id, :action, :target_id, :detail, :created_at)", log)
+}
+
+func (r mysqlModerationRepository) ListLogs(ctx context.Context, streamerID int64, limit int) ([]ModerationLogModel, error) {
+	logs := []ModerationLogModel{}
+	err := r.tx.SelectContext(ctx, &logs, withLimit("SELECT * FROM moderation_logs WHERE streamer_id = ? ORDER BY id DESC", limit), streamerID)
+	return logs, err
+}
+
+func (r mysqlModerationRepository) DeleteByUserID(ctx context.Context, userID int64) error {
+	if _, err := r.tx.ExecContext(ctx, "DELETE FROM channel_moderators WHERE streamer_id = ? OR user_id = ?", userID, userID); err != nil {
+		return err
+	}
+	_, err := r.tx.ExecContext(ctx, "DELETE FROM channel_bans WHERE streamer_id = ?", userID)
+	return err
+}
+
+type mysqlAccessTokenRepository struct{ tx *sqlx.Tx }
+
+func (r mysqlAccessTokenRepository) Get(ctx context.Context, id int64) (AccessTokenModel, error) {
+	var token AccessTokenModel
+	err := r.tx.GetContext(ctx, &token, "SELECT * FROM access_tokens WHERE id = ?", id)
+	return token, err
+}
+
+func (r mysqlAccessTokenRepository) GetByHash(ctx context.Context, tokenHash string) (AccessTokenModel, error) {
+	var token AccessTokenModel
+	err := r.tx.GetContext(ctx, &token, "SELECT * FROM access_tokens WHERE token_hash = ?", tokenHash)
+	return token, err
+}
+
+func (r mysqlAccessTokenRepository) ListByUserID(ctx context.Context, userID int64) ([]AccessTokenModel, error) {
+	tokens := []AccessTokenModel{}
+	err := r.tx.SelectContext(ctx, &tokens, "SELECT * FROM access_tokens WHERE user_id = ? AND revoked_at IS NULL ORDER BY id", userID)
+	return tokens, err
+}
+
+func (r mysqlAccessTokenRepository) Create(ctx context.Context, token AccessTokenModel) (int64, error) {
+	return insert(ctx, r.tx, "INSERT INTO access_tokens (user_id, name, token_hash, token_prefix, scopes, rate_limit, created_at, expires_at) VALUES (:user_id, :name, :token_hash, :token_prefix, :scopes, :rate_limit, :created_at, :expires_at)", token)
+}
+
+func (r mysqlAccessTokenRepository) Revoke(ctx context.Context, id int64, revokedAt int64) error {
+	_, err := r.tx.ExecContext(ctx, "UPDATE access_tokens SET revoked_at = ? WHERE id = ? AND revoked_at IS NULL", revokedAt, id)
+	return err
+}
+
+func (r mysqlAccessTokenRepository) RevokeByUserID(ctx context.Context, userID int64, revokedAt int64) error {
+	_, err := r.tx.ExecContext(ctx, "UPDATE access_tokens SET revoked_at = ? WHERE user_id = ? AND revoked_at IS NULL", revokedAt, userID)
+	return err
+}
+
+func (r mysqlAccessTokenRepository) SetLastUsedAt(ctx context.Context, id int64, lastUsedAt int64) error {
+	_, err := r.tx.ExecContext(ctx, "UPDATE access_tokens SET last_used_at = ? WHERE id = ?", lastUsedAt, id)
+	return err
+}
+
+type mysqlDNSOutboxRepository struct{ tx *sqlx.Tx }
+
+func (r mysqlDNSOutboxRepository) Enqueue(ctx context.Context, action string, name string) (int64, error) {
+	rs, err := r.tx.ExecContext(ctx, "INSERT INTO dns_outbox (name, action, attempts, created_at) VALUES (?, ?, 0, ?)", name, action, time.Now().Unix())
+	if err != nil {
+		return 0, err
+	}
+	return rs.LastInsertId()
+}
+
+func (r mysqlDNSOutboxRepository) Claim(ctx context.Context, id int64, now int64, lockedUntil int64) (DNSOutboxModel, error) {
+	var entry DNSOutboxModel
+	err := r.tx.GetContext(ctx, &entry, "SELECT * FROM dns_outbox WHERE id = ? AND dead_at IS NULL AND locked_until <= ? AND next_attempt_at <= ? FOR UPDATE SKIP LOCKED", id, now, now)
+	if err != nil {
+		return DNSOutboxModel{}, err
+	}
+	var blocked bool
+	if err := r.tx.GetContext(ctx, &blocked, "SELECT EXISTS (SELECT 1 FROM dns_outbox WHERE name = ? AND id < ? AND dead_at IS NULL)", entry.Name, id); err != nil {
+		return DNSOutboxModel{}, err
+	}
+	if blocked {
+		return DNSOutboxModel{}, sql.ErrNoRows
+	}
+	if _, err := r.tx.ExecContext(ctx, "UPDATE dns_outbox SET locked_until = ? WHERE id = ?", lockedUntil, id); err != nil {
+		return DNSOutboxModel{}, err
+	}
+	entry.LockedUntil = lockedUntil
+	return entry, nil
+}
+
+func (r mysqlDNSOutboxRepository) RecordFailure(ctx context.Context, id int64, attempts int64, lastError string, nextAttemptAt int64, deadAt sql.NullInt64) error {
+	_, err := r.tx.ExecContext(ctx, "UPDATE dns_outbox SET attempts = ?, last_error = ?, next_attempt_at = ?, dead_at = ?, locked_until = 0 WHERE id = ?", attempts, lastError, nextAttemptAt, deadAt, id)
+	return err
+}
+
+func (r mysqlDNSOutboxRepository) Delete(ctx context.Context, id int64) error {
+	_, err := r.tx.ExecContext(ctx, "DELETE FROM dns_outbox WHERE id = ?", id)
+	return err
+}
+
+func (r mysqlDNSOutboxRepository) ListDueIDs(ctx context.Context, createdAt int64, now int64) ([]int64, error) {
+	var ids []int64
+	err := r.tx.SelectContext(ctx, &ids, "SELECT id FROM dns_outbox WHERE dead_at IS NULL AND next_attempt_at <= ? AND locked_until <= ? AND created_at <= ? ORDER BY id", now, now, createdAt)
+	return ids, err
+}
+
+func (r mysqlDNSOutboxRepository) ExistsByName(ctx context.Context, name string) (bool, error) {
+	var exists bool
+	err := r.tx.GetContext(ctx, &exists, "SELECT EXISTS (SELECT 1 FROM dns_outbox WHERE name = ? AND dead_at IS NULL)", name)
+	return exists, err
+}
+
+func (r mysqlDNSOutboxRepository) CountDead(ctx context.Context) (int64, error) {
+	var count int64
+	err := r.tx.GetContext(ctx, &count, "SELECT COUNT(*) FROM dns_outbox WHERE dead_at IS NOT NULL")
+	return count, err
+}
+
+func (r mysqlDNSOutboxRepository) DeleteDeadByName(ctx context.Context, name string) error {
+	_, err := r.tx.ExecContext(ctx, "DELETE FROM dns_outbox WHERE name = ? AND dead_at IS NOT NULL", name)
+	return err
+}
+
+type mysqlStaffGrantRepository struct{ tx *sqlx.Tx }
+
+func (r mysqlStaffGrantRepository) Upsert(ctx context.Context, grant StaffGrantModel) error {
+	_, err := r.tx.ExecContext(ctx,
+		"INSERT INTO staff_grants (name, role, hashed_password, created_at) VALUES (?, ?, ?, ?) "+
+			"ON DUPLICATE KEY UPDATE role = VALUES(role), hashed_password = COALESCE(VALUES(hashed_password), hashed_password)",
+		grant.Name, grant.Role, grant.HashedPassword, grant.CreatedAt)
+	return err
+}
+
+func (r mysqlStaffGrantRepository) DeleteByName(ctx context.Context, name string) error {
+	_, err := r.tx.ExecContext(ctx, "DELETE FROM staff_grants WHERE name = ?", name)
+	return err
+}
+
+func (r mysqlStaffGrantRepository) List(ctx context.Context) ([]StaffGrantModel, error) {
+	var grants []StaffGrantModel
+	err := r.tx.SelectContext(ctx, &grants, "SELECT * FROM staff_grants ORDER BY id")
+	return grants, err
+}
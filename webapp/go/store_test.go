@@ -0,0 +1,402 @@
+package main
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// openTestMySQL はISUCON13_TEST_MYSQL_DSNで指定した空のデータベースのテーブルをすべて削除して返す
+// 指定されていなければnilを返す
+func openTestMySQL(t *testing.T) *mysqlStore {
+	t.Helper()
+	dsn := os.Getenv("ISUCON13_TEST_MYSQL_DSN")
+	if dsn == "" {
+		return nil
+	}
+	conf, err := mysql.ParseDSN(dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := openDB(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &mysqlStore{db: db}
+	t.Cleanup(func() { s.Close() })
+
+	ctx := context.Background()
+	var tables []string
+	if err := db.SelectContext(ctx, &tables, "SELECT table_name FROM information_schema.tables WHERE table_schema = DATABASE()"); err != nil {
+		t.Fatal(err)
+	}
+	for _, table := range tables {
+		if _, err := db.ExecContext(ctx, "DROP TABLE `"+table+"`"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return s
+}
+
+// forEachStore は空のストアごとにfを実行する
+// インメモリのストアは常に、MySQLはISUCON13_TEST_MYSQL_DSNが指定されていればマイグレーションを適用して使う
+func forEachStore(t *testing.T, f func(t *testing.T)) {
+	t.Helper()
+	origStore := store
+	t.Cleanup(func() { store = origStore })
+
+	t.Run(storeBackendMemory, func(t *testing.T) {
+		store = &memoryStore{data: newMemoryData()}
+		f(t)
+	})
+	t.Run(storeBackendMySQL, func(t *testing.T) {
+		s := openTestMySQL(t)
+		if s == nil {
+			t.Skip("ISUCON13_TEST_MYSQL_DSN is not set")
+		}
+		ctx := context.Background()
+		m, release, err := newMigrator(ctx, s, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = m.up(ctx)
+		release()
+		if err != nil {
+			t.Fatal(err)
+		}
+		store = s
+		f(t)
+	})
+}
+
+// addTestSlot はreservation_slotsに枠を追加する (リポジトリには追加するメソッドがない)
+func addTestSlot(t *testing.T, slot ReservationSlotModel) {
+	t.Helper()
+	switch s := store.(type) {
+	case *memoryStore:
+		slot.ID = s.data.reservationSlots.nextID()
+		s.data.reservationSlots.rows[slot.ID] = slot
+	case *mysqlStore:
+		if _, err := s.db.Exec("INSERT INTO reservation_slots (slot, start_at, end_at) VALUES (?, ?, ?)", slot.Slot, slot.StartAt, slot.EndAt); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestStoreListByCreatedAtDesc(t *testing.T) {
+	const userID, livestreamID = 1, 1
+	tests := []struct {
+		name   string
+		create func(ctx context.Context, tx Tx, createdAt int64) error
+		list   func(ctx context.Context, tx Tx) ([]int64, error)
+		want   []int64
+	}{
+		{
+			name: "livecomments",
+			create: func(ctx context.Context, tx Tx, createdAt int64) error {
+				_, err := tx.Livecomments().Create(ctx, LivecommentModel{UserID: userID, LivestreamID: livestreamID, Comment: "hello", CreatedAt: createdAt})
+				return err
+			},
+			list: func(ctx context.Context, tx Tx) ([]int64, error) {
+				livecomments, err := tx.Livecomments().ListByLivestreamID(ctx, livestreamID, noLimit)
+				return createdAts(livecomments, func(lc LivecommentModel) int64 { return lc.CreatedAt }), err
+			},
+			want: []int64{30, 20, 10},
+		},
+		{
+			name: "livecomments with limit",
+			create: func(ctx context.Context, tx Tx, createdAt int64) error {
+				_, err := tx.Livecomments().Create(ctx, LivecommentModel{UserID: userID, LivestreamID: livestreamID, Comment: "hello", CreatedAt: createdAt})
+				return err
+			},
+			list: func(ctx context.Context, tx Tx) ([]int64, error) {
+				livecomments, err := tx.Livecomments().ListByLivestreamID(ctx, livestreamID, 2)
+				return createdAts(livecomments, func(lc LivecommentModel) int64 { return lc.CreatedAt }), err
+			},
+			want: []int64{30, 20},
+		},
+		{
+			name: "reactions",
+			create: func(ctx context.Context, tx Tx, createdAt int64) error {
+				_, err := tx.Reactions().Create(ctx, ReactionModel{UserID: userID, LivestreamID: livestreamID, EmojiName: "smile", CreatedAt: createdAt})
+				return err
+			},
+			list: func(ctx context.Context, tx Tx) ([]int64, error) {
+				reactions, err := tx.Reactions().ListByLivestreamID(ctx, livestreamID, noLimit)
+				return createdAts(reactions, func(r ReactionModel) int64 { return r.CreatedAt }), err
+			},
+			want: []int64{30, 20, 10},
+		},
+		{
+			name: "ng words",
+			create: func(ctx context.Context, tx Tx, createdAt int64) error {
+				_, err := tx.NGWords().Create(ctx, NGWord{UserID: userID, LivestreamID: livestreamID, Word: "spam", CreatedAt: createdAt})
+				return err
+			},
+			list: func(ctx context.Context, tx Tx) ([]int64, error) {
+				ngwords, err := tx.NGWords().ListByUserAndLivestream(ctx, userID, livestreamID)
+				return createdAts(ngwords, func(w NGWord) int64 { return w.CreatedAt }), err
+			},
+			want: []int64{30, 20, 10},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			forEachStore(t, func(t *testing.T) {
+				ctx := context.Background()
+				err := withTx(ctx, func(tx Tx) error {
+					// idの順とcreated_atの順を変えておく
+					for _, createdAt := range []int64{20, 10, 30} {
+						if err := tt.create(ctx, tx, createdAt); err != nil {
+							return err
+						}
+					}
+					return nil
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+				var got []int64
+				err = withReadOnlyTx(ctx, func(tx Tx) error {
+					got, err = tt.list(ctx, tx)
+					return err
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("created_at = %v, want %v", got, tt.want)
+				}
+			})
+		})
+	}
+}
+
+func createdAts[T any](rows []T, createdAt func(T) int64) []int64 {
+	var values []int64
+	for _, row := range rows {
+		values = append(values, createdAt(row))
+	}
+	return values
+}
+
+func TestStoreUniqueNames(t *testing.T) {
+	createUser := func(ctx context.Context, tx Tx, name string) error {
+		_, err := tx.Users().Create(ctx, UserModel{Name: name, DisplayName: name, HashedPassword: "x"})
+		return err
+	}
+	createTag := func(ctx context.Context, tx Tx, name string) error {
+		_, err := tx.Tags().Create(ctx, name)
+		return err
+	}
+	tests := []struct {
+		name     string
+		create   func(ctx context.Context, tx Tx, name string) error
+		existing string
+		newName  string
+		wantDup  bool
+	}{
+		{name: "same user name", create: createUser, existing: "alice", newName: "alice", wantDup: true},
+		{name: "user names that differ only in case", create: createUser, existing: "alice", newName: "Alice", wantDup: true},
+		{name: "different user names", create: createUser, existing: "alice", newName: "alice2", wantDup: false},
+		{name: "same tag name", create: createTag, existing: "Go", newName: "Go", wantDup: true},
+		// tagsはutf8mb4_binなので大文字小文字を区別する
+		{name: "tag names that differ only in case", create: createTag, existing: "Go", newName: "go", wantDup: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			forEachStore(t, func(t *testing.T) {
+				ctx := context.Background()
+				if err := withTx(ctx, func(tx Tx) error { return tt.create(ctx, tx, tt.existing) }); err != nil {
+					t.Fatal(err)
+				}
+				err := withTx(ctx, func(tx Tx) error { return tt.create(ctx, tx, tt.newName) })
+				if tt.wantDup {
+					if !isDuplicateEntry(err) {
+						t.Errorf("creating %q after %q must be a duplicate entry (got %v)", tt.newName, tt.existing, err)
+					}
+				} else if err != nil {
+					t.Errorf("creating %q after %q must succeed (got %v)", tt.newName, tt.existing, err)
+				}
+			})
+		})
+	}
+}
+
+func TestStoreReservationSlots(t *testing.T) {
+	forEachStore(t, func(t *testing.T) {
+		ctx := context.Background()
+		for _, startAt := range []int64{0, 3600, 7200} {
+			addTestSlot(t, ReservationSlotModel{Slot: 2, StartAt: startAt, EndAt: startAt + 3600})
+		}
+
+		steps := []struct {
+			name           string
+			reserve        bool
+			startAt, endAt int64
+			want           []int64
+		}{
+			{name: "reserve first two hours", reserve: true, startAt: 0, endAt: 7200, want: []int64{1, 1, 2}},
+			{name: "reserve last two hours", reserve: true, startAt: 3600, endAt: 10800, want: []int64{1, 0, 1}},
+			{name: "cancel first two hours", reserve: false, startAt: 0, endAt: 7200, want: []int64{2, 1, 1}},
+			{name: "cancel last two hours", reserve: false, startAt: 3600, endAt: 10800, want: []int64{2, 2, 2}},
+		}
+		for _, step := range steps {
+			err := withTx(ctx, func(tx Tx) error {
+				if _, err := tx.Reservations().ListForUpdate(ctx, step.startAt, step.endAt); err != nil {
+					return err
+				}
+				if step.reserve {
+					return tx.Reservations().Reserve(ctx, step.startAt, step.endAt)
+				}
+				return tx.Reservations().Release(ctx, step.startAt, step.endAt)
+			})
+			if err != nil {
+				t.Fatalf("%s: %v", step.name, err)
+			}
+
+			var slots []ReservationSlotModel
+			err = withReadOnlyTx(ctx, func(tx Tx) error {
+				slots, err = tx.Reservations().List(ctx, 0, 10800)
+				return err
+			})
+			if err != nil {
+				t.Fatalf("%s: %v", step.name, err)
+			}
+			got := make([]int64, len(slots))
+			for i, slot := range slots {
+				got[i] = slot.Slot
+			}
+			if !reflect.DeepEqual(got, step.want) {
+				t.Errorf("%s: slots = %v, want %v", step.name, got, step.want)
+			}
+		}
+	})
+}
+
+// 配信を削除すると、配信に紐づく行がすべて消え、他の配信の行は残ること
+func TestStoreDeleteLivestreamCascades(t *testing.T) {
+	const userID = 1
+	counts := []struct {
+		name  string
+		count func(ctx context.Context, tx Tx, livestreamID int64) (int64, error)
+	}{
+		{"livestreams", func(ctx context.Context, tx Tx, livestreamID int64) (int64, error) {
+			exists, err := tx.Livestreams().Exists(ctx, livestreamID)
+			if exists {
+				return 1, err
+			}
+			return 0, err
+		}},
+		{"livestream_tags", func(ctx context.Context, tx Tx, livestreamID int64) (int64, error) {
+			tags, err := tx.Livestreams().ListTags(ctx, []int64{livestreamID})
+			return int64(len(tags)), err
+		}},
+		{"ng_words", func(ctx context.Context, tx Tx, livestreamID int64) (int64, error) {
+			ngwords, err := tx.NGWords().ListByLivestreamID(ctx, livestreamID)
+			return int64(len(ngwords)), err
+		}},
+		{"livecomments", func(ctx context.Context, tx Tx, livestreamID int64) (int64, error) {
+			livecomments, err := tx.Livecomments().ListByLivestreamID(ctx, livestreamID, noLimit)
+			return int64(len(livecomments)), err
+		}},
+		{"livecomment_reports", func(ctx context.Context, tx Tx, livestreamID int64) (int64, error) {
+			return tx.Reports().CountByLivestreamID(ctx, livestreamID)
+		}},
+		{"reactions", func(ctx context.Context, tx Tx, livestreamID int64) (int64, error) {
+			return tx.Reactions().CountByLivestreamID(ctx, livestreamID)
+		}},
+		{"livestream_viewers_history", func(ctx context.Context, tx Tx, livestreamID int64) (int64, error) {
+			return tx.Viewers().CountHistory(ctx, livestreamID)
+		}},
+		{"livestream_unique_viewers", func(ctx context.Context, tx Tx, livestreamID int64) (int64, error) {
+			return tx.Viewers().CountUnique(ctx, livestreamID)
+		}},
+		{"livestream_presence", func(ctx context.Context, tx Tx, livestreamID int64) (int64, error) {
+			return tx.Viewers().CountPresence(ctx, livestreamID, 0)
+		}},
+		{"livestream_presence_peaks", func(ctx context.Context, tx Tx, livestreamID int64) (int64, error) {
+			return tx.Viewers().GetPeakPresence(ctx, livestreamID)
+		}},
+	}
+
+	forEachStore(t, func(t *testing.T) {
+		ctx := context.Background()
+		var deleted, kept int64
+		err := withTx(ctx, func(tx Tx) error {
+			tagID, err := tx.Tags().Create(ctx, "tag")
+			if err != nil {
+				return err
+			}
+			for _, id := range []*int64{&deleted, &kept} {
+				livestreamID, err := tx.Livestreams().Create(ctx, LivestreamModel{UserID: userID, Title: "title", StartAt: 0, EndAt: 3600})
+				if err != nil {
+					return err
+				}
+				*id = livestreamID
+				if err := tx.Livestreams().AddTag(ctx, livestreamID, tagID); err != nil {
+					return err
+				}
+				if _, err := tx.NGWords().Create(ctx, NGWord{UserID: userID, LivestreamID: livestreamID, Word: "spam", CreatedAt: 1}); err != nil {
+					return err
+				}
+				livecommentID, err := tx.Livecomments().Create(ctx, LivecommentModel{UserID: userID, LivestreamID: livestreamID, Comment: "hello", CreatedAt: 1})
+				if err != nil {
+					return err
+				}
+				if _, err := tx.Reports().Create(ctx, LivecommentReportModel{UserID: userID, LivestreamID: livestreamID, LivecommentID: livecommentID, CreatedAt: 1}); err != nil {
+					return err
+				}
+				if _, err := tx.Reactions().Create(ctx, ReactionModel{UserID: userID, LivestreamID: livestreamID, EmojiName: "smile", CreatedAt: 1}); err != nil {
+					return err
+				}
+				viewer := LivestreamViewerModel{UserID: userID, LivestreamID: livestreamID, CreatedAt: 1}
+				if err := tx.Viewers().AddHistory(ctx, viewer); err != nil {
+					return err
+				}
+				if err := tx.Viewers().AddUnique(ctx, viewer); err != nil {
+					return err
+				}
+				if err := tx.Viewers().TouchPresence(ctx, livestreamID, userID, 1); err != nil {
+					return err
+				}
+				if err := tx.Viewers().RecordPeakPresence(ctx, livestreamID, 1); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := withTx(ctx, func(tx Tx) error { return tx.Livestreams().Delete(ctx, deleted) }); err != nil {
+			t.Fatal(err)
+		}
+
+		err = withReadOnlyTx(ctx, func(tx Tx) error {
+			for _, c := range counts {
+				n, err := c.count(ctx, tx, deleted)
+				if err != nil {
+					return err
+				}
+				if n != 0 {
+					t.Errorf("%s of the deleted livestream = %d, want 0", c.name, n)
+				}
+				if n, err = c.count(ctx, tx, kept); err != nil {
+					return err
+				}
+				if n != 1 {
+					t.Errorf("%s of the other livestream = %d, want 1", c.name, n)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+}
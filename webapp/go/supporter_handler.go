@@ -0,0 +1,223 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo-contrib/session"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	defaultSupportersLimit = 10
+	maxSupportersLimit     = 100
+)
+
+type Supporter struct {
+	Rank     int64 `json:"rank"`
+	User     User  `json:"user"`
+	TotalTip int64 `json:"total_tip"`
+}
+
+type SupportersResponse struct {
+	Supporters []Supporter `json:"supporters"`
+	// リクエストしたユーザ自身の順位 (チップを送っていなければnull)
+	Me *Supporter `json:"me"`
+}
+
+type supporterTotalModel struct {
+	UserID   int64 `db:"user_id"`
+	TotalTip int64 `db:"total_tip"`
+}
+
+// supporterScope はランキングの集計対象となるライブコメントを絞り込む条件
+// livestreamIDが0でなければその配信、そうでなければstreamerIDの配信者の全配信が対象
+type supporterScope struct {
+	livestreamID int64
+	streamerID   int64
+}
+
+// 配信ごとのサポーターランキング
+// GET /api/livestream/:livestream_id/supporters
+func getLivestreamSupportersHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	if err := verifyUserSession(c); err != nil {
+		// echo.NewHTTPErrorが返っているのでそのまま出力
+		return err
+	}
+
+	livestreamID, err := strconv.Atoi(c.Param("livestream_id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "livestream_id in path must be integer")
+	}
+
+	limit, err := supportersLimit(c)
+	if err != nil {
+		return err
+	}
+
+	tx, err := store.BeginReplicaRead(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to begin transaction: "+err.Error())
+	}
+	defer tx.Rollback()
+
+	livestreamModel, err := tx.Livestreams().Get(ctx, int64(livestreamID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return echo.NewHTTPError(http.StatusNotFound, "livestream not found")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get livestream: "+err.Error())
+	}
+
+	scope := supporterScope{livestreamID: livestreamModel.ID}
+	res, err := buildSupportersResponse(ctx, tx, scope, sessionUserID(c), limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get supporters: "+err.Error())
+	}
+
+	if err := tx.Commit(); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to commit: "+err.Error())
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// 配信者ごとのサポーターランキング (配信者の全配信のチップを合算)
+// GET /api/user/:username/supporters
+func getUserSupportersHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	if err := verifyUserSession(c); err != nil {
+		// echo.NewHTTPErrorが返っているのでそのまま出力
+		return err
+	}
+
+	username := c.Param("username")
+
+	limit, err := supportersLimit(c)
+	if err != nil {
+		return err
+	}
+
+	tx, err := store.BeginReplicaRead(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to begin transaction: "+err.Error())
+	}
+	defer tx.Rollback()
+
+	streamer, err := tx.Users().GetByName(ctx, username)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return echo.NewHTTPError(http.StatusNotFound, "not found user that has the given username")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get user: "+err.Error())
+	}
+
+	scope := supporterScope{streamerID: streamer.ID}
+	res, err := buildSupportersResponse(ctx, tx, scope, sessionUserID(c), limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get supporters: "+err.Error())
+	}
+
+	if err := tx.Commit(); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to commit: "+err.Error())
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func supportersLimit(c echo.Context) (int, error) {
+	if c.QueryParam("limit") == "" {
+		return defaultSupportersLimit, nil
+	}
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil || limit < 1 || limit > maxSupportersLimit {
+		return 0, echo.NewHTTPError(http.StatusBadRequest, "limit query parameter must be between 1 and "+strconv.Itoa(maxSupportersLimit))
+	}
+	return limit, nil
+}
+
+// sessionUserID はverifyUserSession済みのリクエストからユーザIDを取り出す
+func sessionUserID(c echo.Context) int64 {
+	// error already checked
+	sess, _ := session.Get(defaultSessionIDKey, c)
+	// existence already checked
+	return sess.Values[defaultUserIDKey].(int64)
+}
+
+// buildSupportersResponse はチップ合計額の降順 (同額ならuser_idの昇順) でランキングを作る
+func buildSupportersResponse(ctx context.Context, tx Tx, scope supporterScope, viewerID int64, limit int) (SupportersResponse, error) {
+	totals, err := tx.Livecomments().ListSupporters(ctx, scope, limit)
+	if err != nil {
+		return SupportersResponse{}, err
+	}
+
+	loader := newBatchLoader(tx)
+	userIDs := make([]int64, len(totals))
+	for i := range totals {
+		userIDs[i] = totals[i].UserID
+	}
+	if err := loader.loadUsers(ctx, userIDs); err != nil {
+		return SupportersResponse{}, err
+	}
+
+	res := SupportersResponse{
+		Supporters: make([]Supporter, len(totals)),
+	}
+	for i := range totals {
+		supporter, err := fillSupporterResponse(loader, int64(i+1), totals[i])
+		if err != nil {
+			return SupportersResponse{}, err
+		}
+		res.Supporters[i] = supporter
+		if totals[i].UserID == viewerID {
+			res.Me = &res.Supporters[i]
+		}
+	}
+	if res.Me != nil {
+		return res, nil
+	}
+
+	// 上位に入っていない場合は、自分より上位のサポーター数から順位を求める
+	myTotal, err := tx.Livecomments().SupporterTotal(ctx, scope, viewerID)
+	if err != nil {
+		return SupportersResponse{}, err
+	}
+	if myTotal == 0 {
+		return res, nil
+	}
+
+	higher, err := tx.Livecomments().CountSupportersAbove(ctx, scope, myTotal, viewerID)
+	if err != nil {
+		return SupportersResponse{}, err
+	}
+
+	if err := loader.loadUsers(ctx, []int64{viewerID}); err != nil {
+		return SupportersResponse{}, err
+	}
+	me, err := fillSupporterResponse(loader, higher+1, supporterTotalModel{UserID: viewerID, TotalTip: myTotal})
+	if err != nil {
+		return SupportersResponse{}, err
+	}
+	res.Me = &me
+
+	return res, nil
+}
+
+func fillSupporterResponse(loader *batchLoader, rank int64, total supporterTotalModel) (Supporter, error) {
+	user, err := loader.user(total.UserID)
+	if err != nil {
+		return Supporter{}, err
+	}
+
+	return Supporter{
+		Rank:     rank,
+		User:     user,
+		TotalTip: total.TotalTip,
+	}, nil
+}
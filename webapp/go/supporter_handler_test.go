@@ -0,0 +1,32 @@
+package main
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestSupportersLimit(t *testing.T) {
+	tests := []struct {
+		query   string
+		want    int
+		wantErr bool
+	}{
+		{"", defaultSupportersLimit, false},
+		{"?limit=1", 1, false},
+		{"?limit=100", maxSupportersLimit, false},
+		{"?limit=0", 0, true},
+		{"?limit=101", 0, true},
+		{"?limit=1000000000", 0, true},
+		{"?limit=abc", 0, true},
+	}
+	e := echo.New()
+	for _, tt := range tests {
+		c := e.NewContext(httptest.NewRequest("GET", "/api/user/test001/supporters"+tt.query, nil), httptest.NewRecorder())
+		got, err := supportersLimit(c)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("supportersLimit(%q) = %d, %v", tt.query, got, err)
+		}
+	}
+}
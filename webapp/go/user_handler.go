@@ -7,13 +7,13 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
-	"os/exec"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/gorilla/sessions"
-	"github.com/jmoiron/sqlx"
 	"github.com/labstack/echo-contrib/session"
 	"github.com/labstack/echo/v4"
 	"golang.org/x/crypto/bcrypt"
@@ -24,17 +24,25 @@ const (
 	defaultSessionExpiresKey = "EXPIRES"
 	defaultUserIDKey         = "USERID"
 	defaultUsernameKey       = "USERNAME"
-	bcryptDefaultCost        = bcrypt.MinCost
 )
 
 var fallbackImage = "../img/NoImage.jpg"
 
+// NoImage.jpgのsha256
+const fallbackImageHash = "d9f8294e9d895f81ce62e73dc7d5dff862a4fa40bd4e0fecf53f7526a8edcac0"
+
 type UserModel struct {
-	ID             int64  `db:"id"`
-	Name           string `db:"name"`
-	DisplayName    string `db:"display_name"`
-	Description    string `db:"description"`
-	HashedPassword string `db:"password"`
+	ID             int64  `db:"id" json:"id"`
+	Name           string `db:"name" json:"name"`
+	DisplayName    string `db:"display_name" json:"display_name"`
+	Description    string `db:"description" json:"description"`
+	HashedPassword string `db:"password" json:"-"`
+	// 退会済みの場合は退会日時
+	DeletedAt sql.NullInt64 `db:"deleted_at" json:"-"`
+	// Role はプラットフォームでの権限 (viewer, moderator, admin)
+	Role Role `db:"role" json:"-"`
+	// 利用停止中の場合は停止した日時
+	SuspendedAt sql.NullInt64 `db:"suspended_at" json:"-"`
 }
 
 type User struct {
@@ -52,9 +60,9 @@ type Theme struct {
 }
 
 type ThemeModel struct {
-	ID       int64 `db:"id"`
-	UserID   int64 `db:"user_id"`
-	DarkMode bool  `db:"dark_mode"`
+	ID       int64 `db:"id" json:"id"`
+	UserID   int64 `db:"user_id" json:"user_id"`
+	DarkMode bool  `db:"dark_mode" json:"dark_mode"`
 }
 
 type PostUserRequest struct {
@@ -84,38 +92,122 @@ type PostIconResponse struct {
 	ID int64 `json:"id"`
 }
 
+type IconModel struct {
+	ID          int64  `db:"id"`
+	UserID      int64  `db:"user_id"`
+	ImageHash   string `db:"image_hash"`
+	ContentType string `db:"content_type"`
+	Image       []byte `db:"image"`
+}
+
 func getIconHandler(c echo.Context) error {
 	ctx := c.Request().Context()
 
 	username := c.Param("username")
 
-	tx, err := dbConn.BeginTxx(ctx, nil)
+	size, err := iconSizeParam(c)
+	if err != nil {
+		return err
+	}
+
+	tx, err := store.BeginReplicaRead(ctx)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to begin transaction: "+err.Error())
 	}
 	defer tx.Rollback()
 
-	var user UserModel
-	if err := tx.GetContext(ctx, &user, "SELECT * FROM users WHERE name = ?", username); err != nil {
+	user, err := tx.Users().GetByName(ctx, username)
+	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return echo.NewHTTPError(http.StatusNotFound, "not found user that has the given username")
 		}
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get user: "+err.Error())
 	}
 
-	image, ok := iconImageCache.Load(user.ID)
-	if !ok {
-		if err := tx.GetContext(ctx, &image, "SELECT image FROM icons WHERE user_id = ?", user.ID); err != nil {
-			if errors.Is(err, sql.ErrNoRows) {
-				return c.File(fallbackImage)
-			} else {
-				return echo.NewHTTPError(http.StatusInternalServerError, "failed to get user icon: "+err.Error())
-			}
+	imageHash, err := getIconHash(ctx, tx, user.ID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get user icon hash: "+err.Error())
+	}
+
+	if err := tx.Commit(); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to commit: "+err.Error())
+	}
+
+	// URLはユーザ名なので中身が変わりうる。キャッシュはさせるが毎回ETagで再検証させる
+	etag := iconETag(imageHash, size)
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-cache")
+	c.Response().Header().Set("ETag", etag)
+	if etagMatches(c.Request().Header.Get("If-None-Match"), etag) {
+		return c.NoContent(http.StatusNotModified)
+	}
+
+	if imageHash == fallbackImageHash {
+		return c.File(fallbackImage)
+	}
+
+	icon, err := loadIcon(ctx, iconCacheKey{Hash: imageHash, Size: size})
+	if err != nil {
+		if errors.Is(err, errIconNotFound) {
+			return c.File(fallbackImage)
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get user icon: "+err.Error())
+	}
+
+	return c.Blob(http.StatusOK, icon.ContentType, icon.Image)
+}
+
+// ハッシュ指定のアイコン取得API
+// 中身がハッシュで一意に決まるため、1年間キャッシュさせる
+// GET /api/icon/:hash
+func getIconByHashHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	imageHash := c.Param("hash")
+	if !isIconHash(imageHash) {
+		return echo.NewHTTPError(http.StatusBadRequest, "hash in path must be sha256 hex string")
+	}
+
+	size, err := iconSizeParam(c)
+	if err != nil {
+		return err
+	}
+
+	etag := iconETag(imageHash, size)
+	if etagMatches(c.Request().Header.Get("If-None-Match"), etag) {
+		c.Response().Header().Set(echo.HeaderCacheControl, iconImmutableCacheControl)
+		c.Response().Header().Set("ETag", etag)
+		return c.NoContent(http.StatusNotModified)
+	}
+
+	if imageHash == fallbackImageHash {
+		c.Response().Header().Set(echo.HeaderCacheControl, iconImmutableCacheControl)
+		c.Response().Header().Set("ETag", etag)
+		return c.File(fallbackImage)
+	}
+
+	icon, err := loadIcon(ctx, iconCacheKey{Hash: imageHash, Size: size})
+	if err != nil {
+		if errors.Is(err, errIconNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "not found icon that has the given hash")
 		}
-		iconImageCache.Store(user.ID, image)
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get icon: "+err.Error())
 	}
 
-	return c.Blob(http.StatusOK, "image/jpeg", image)
+	c.Response().Header().Set(echo.HeaderCacheControl, iconImmutableCacheControl)
+	c.Response().Header().Set("ETag", etag)
+	return c.Blob(http.StatusOK, icon.ContentType, icon.Image)
+}
+
+// ?size= が指定された場合は縮小版を返す (0は元画像)
+func iconSizeParam(c echo.Context) (int, error) {
+	if c.QueryParam("size") == "" {
+		return 0, nil
+	}
+	size, err := strconv.Atoi(c.QueryParam("size"))
+	if err != nil || !isIconVariantSize(size) {
+		return 0, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("size query parameter must be one of %v", iconVariantSizes))
+	}
+	return size, nil
 }
 
 func postIconHandler(c echo.Context) error {
@@ -136,33 +228,39 @@ func postIconHandler(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "failed to decode the request body as json")
 	}
 
-	tx, err := dbConn.BeginTxx(ctx, nil)
+	icon, err := processIcon(req.Image)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "failed to begin transaction: "+err.Error())
-	}
-	defer tx.Rollback()
-
-	if _, err := tx.ExecContext(ctx, "DELETE FROM icons WHERE user_id = ?", userID); err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete old user icon: "+err.Error())
+		if errors.Is(err, errInvalidIcon) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to process icon: "+err.Error())
 	}
 
-	hash := sha256.Sum256(req.Image)
+	hash := sha256.Sum256(icon.Image)
 	imageHashString := hex.EncodeToString(hash[:])
-	rs, err := tx.ExecContext(ctx, "INSERT INTO icons (user_id, image, image_hash) VALUES (?, ?, ?)", userID, req.Image, imageHashString)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "failed to insert new user icon: "+err.Error())
-	}
 
-	iconID, err := rs.LastInsertId()
+	iconID, oldImageHash, err := replaceUserIcon(ctx, userID, imageHashString, icon)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get last inserted icon id: "+err.Error())
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to replace user icon: "+err.Error())
 	}
 
-	if err := tx.Commit(); err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "failed to commit: "+err.Error())
+	publishCacheInvalidation(c, cacheTopicIcon, strconv.FormatInt(userID, 10))
+
+	// 差し替え前の画像を誰も参照していなければ削除する (失敗してもアイコンの変更は完了しているのでログのみ)
+	if oldImageHash != "" && oldImageHash != imageHashString {
+		released, err := releaseIcon(ctx, oldImageHash)
+		if err != nil {
+			requestLogger(c).Warn("failed to delete icon image", "icon_hash", oldImageHash, "error", err)
+		}
+		if released {
+			publishCacheInvalidation(c, cacheTopicIconImage, oldImageHash)
+		}
 	}
 
-	iconImageCache.Store(userID, Copy(req.Image))
+	iconImageCache.Store(iconCacheKey{Hash: imageHashString}, &cachedIcon{
+		ContentType: icon.ContentType,
+		Image:       Copy(icon.Image),
+	})
 	iconImageHashCache.Store(userID, imageHashString)
 
 	return c.JSON(http.StatusCreated, &PostIconResponse{
@@ -183,14 +281,13 @@ func getMeHandler(c echo.Context) error {
 	// existence already checked
 	userID := sess.Values[defaultUserIDKey].(int64)
 
-	tx, err := dbConn.BeginTxx(ctx, nil)
+	tx, err := store.BeginReplicaRead(ctx)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to begin transaction: "+err.Error())
 	}
 	defer tx.Rollback()
 
-	userModel := UserModel{}
-	err = tx.GetContext(ctx, &userModel, "SELECT * FROM users WHERE id = ?", userID)
+	userModel, err := tx.Users().Get(ctx, userID)
 	if errors.Is(err, sql.ErrNoRows) {
 		return echo.NewHTTPError(http.StatusNotFound, "not found user that has the userid in session")
 	}
@@ -221,21 +318,21 @@ func registerHandler(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "failed to decode the request body as json")
 	}
 
-	if req.Name == "pipe" {
-		return echo.NewHTTPError(http.StatusBadRequest, "the username 'pipe' is reserved")
-	}
-
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcryptDefaultCost)
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), appConfig.Auth.BcryptCost)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to generate hashed password: "+err.Error())
 	}
 
-	tx, err := dbConn.BeginTxx(ctx, nil)
+	tx, err := store.Begin(ctx)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to begin transaction: "+err.Error())
 	}
 	defer tx.Rollback()
 
+	if err := validateUsername(ctx, tx, req.Name); err != nil {
+		return err
+	}
+
 	userModel := UserModel{
 		Name:           req.Name,
 		DisplayName:    req.DisplayName,
@@ -243,28 +340,25 @@ func registerHandler(c echo.Context) error {
 		HashedPassword: string(hashedPassword),
 	}
 
-	result, err := tx.NamedExecContext(ctx, "INSERT INTO users (name, display_name, description, password) VALUES(:name, :display_name, :description, :password)", userModel)
+	userID, err := tx.Users().Create(ctx, userModel)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to insert user: "+err.Error())
 	}
 
-	userID, err := result.LastInsertId()
-	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get last inserted user id: "+err.Error())
-	}
-
 	userModel.ID = userID
 
 	themeModel := ThemeModel{
 		UserID:   userID,
 		DarkMode: req.Theme.DarkMode,
 	}
-	if _, err := tx.NamedExecContext(ctx, "INSERT INTO themes (user_id, dark_mode) VALUES(:user_id, :dark_mode)", themeModel); err != nil {
+	if _, err := tx.Themes().Create(ctx, themeModel); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to insert user theme: "+err.Error())
 	}
 
-	if out, err := exec.Command("pdnsutil", "add-record", "u.isucon.dev", req.Name, "A", "0", powerDNSSubdomainAddress).CombinedOutput(); err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, string(out)+": "+err.Error())
+	// DNSレコードはコミット後に登録する
+	outboxID, err := enqueueDNSOutbox(ctx, tx, dnsOutboxActionAdd, req.Name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to enqueue dns record: "+err.Error())
 	}
 
 	user, err := fillUserResponse(ctx, tx, userModel)
@@ -276,6 +370,11 @@ func registerHandler(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to commit: "+err.Error())
 	}
 
+	// 失敗してもoutboxに残っていれば再送されるので、ユーザ登録自体は成功とする
+	if err := deliverDNSOutbox(ctx, outboxID); err != nil {
+		requestLogger(c).Warn("failed to provision dns record (will retry)", "name", req.Name, "error", err)
+	}
+
 	return c.JSON(http.StatusCreated, user)
 }
 
@@ -290,15 +389,14 @@ func loginHandler(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "failed to decode the request body as json")
 	}
 
-	tx, err := dbConn.BeginTxx(ctx, nil)
+	tx, err := store.Begin(ctx)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to begin transaction: "+err.Error())
 	}
 	defer tx.Rollback()
 
-	userModel := UserModel{}
 	// usernameはUNIQUEなので、whereで一意に特定できる
-	err = tx.GetContext(ctx, &userModel, "SELECT * FROM users WHERE name = ?", req.Username)
+	userModel, err := tx.Users().GetByName(ctx, req.Username)
 	if errors.Is(err, sql.ErrNoRows) {
 		return echo.NewHTTPError(http.StatusUnauthorized, "invalid username or password")
 	}
@@ -317,6 +415,9 @@ func loginHandler(c echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to compare hash and password: "+err.Error())
 	}
+	if userModel.SuspendedAt.Valid {
+		return echo.NewHTTPError(http.StatusForbidden, "the user has been suspended")
+	}
 
 	sessionEndAt := time.Now().Add(1 * time.Hour)
 
@@ -328,7 +429,7 @@ func loginHandler(c echo.Context) error {
 	}
 
 	sess.Options = &sessions.Options{
-		Domain: "u.isucon.dev",
+		Domain: appConfig.Session.CookieDomain,
 		MaxAge: int(60000),
 		Path:   "/",
 	}
@@ -355,14 +456,14 @@ func getUserHandler(c echo.Context) error {
 
 	username := c.Param("username")
 
-	tx, err := dbConn.BeginTxx(ctx, nil)
+	tx, err := store.BeginReplicaRead(ctx)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to begin transaction: "+err.Error())
 	}
 	defer tx.Rollback()
 
-	userModel := UserModel{}
-	if err := tx.GetContext(ctx, &userModel, "SELECT * FROM users WHERE name = ?", username); err != nil {
+	userModel, err := tx.Users().GetByName(ctx, username)
+	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return echo.NewHTTPError(http.StatusNotFound, "not found user that has the given username")
 		}
@@ -382,6 +483,11 @@ func getUserHandler(c echo.Context) error {
 }
 
 func verifyUserSession(c echo.Context) error {
+	// Authorization: Bearerのアクセストークンはセッションの代わりに使える
+	if token, ok := bearerToken(c.Request()); ok {
+		return verifyAccessToken(c, token)
+	}
+
 	sess, err := session.Get(defaultSessionIDKey, c)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusUnauthorized, "failed to get session")
@@ -402,37 +508,90 @@ func verifyUserSession(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusUnauthorized, "session has expired")
 	}
 
-	return nil
+	_, err = verifySessionUser(c.Request().Context(), sess.Values[defaultUserIDKey].(int64))
+	return err
 }
 
-func fillUserResponse(ctx context.Context, tx *sqlx.Tx, userModel UserModel) (User, error) {
-	themeModel := ThemeModel{}
-	if err := tx.GetContext(ctx, &themeModel, "SELECT * FROM themes WHERE user_id = ?", userModel.ID); err != nil {
+// verifySessionUser は退会・利用停止したユーザのセッション (アクセストークン) を拒否する
+func verifySessionUser(ctx context.Context, userID int64) (UserModel, error) {
+	userModel, err := getSessionUser(ctx, userID)
+	if errors.Is(err, sql.ErrNoRows) || err == nil && userModel.DeletedAt.Valid {
+		return UserModel{}, echo.NewHTTPError(http.StatusUnauthorized, "the user has been deleted")
+	}
+	if err != nil {
+		return UserModel{}, echo.NewHTTPError(http.StatusInternalServerError, "failed to get user: "+err.Error())
+	}
+	if userModel.SuspendedAt.Valid {
+		return UserModel{}, echo.NewHTTPError(http.StatusForbidden, "the user has been suspended")
+	}
+	return userModel, nil
+}
+
+func fillUserResponse(ctx context.Context, tx Tx, userModel UserModel) (User, error) {
+	users, err := fillUserResponses(ctx, newBatchLoader(tx), []UserModel{userModel})
+	if err != nil {
 		return User{}, err
 	}
+	return users[0], nil
+}
 
-	imageHash, ok := iconImageHashCache.Load(userModel.ID)
-	if !ok {
-		if err := tx.GetContext(ctx, &imageHash, "SELECT image_hash FROM icons WHERE user_id = ?", userModel.ID); err != nil {
-			if !errors.Is(err, sql.ErrNoRows) {
-				return User{}, err
-			}
-			imageHash = "d9f8294e9d895f81ce62e73dc7d5dff862a4fa40bd4e0fecf53f7526a8edcac0"
+// fillUserResponses はテーマとアイコンのハッシュをまとめて取得してレスポンスを作る
+func fillUserResponses(ctx context.Context, loader *batchLoader, userModels []UserModel) ([]User, error) {
+	ctx, span := tracer.Start(ctx, "fillUserResponses")
+	defer span.End()
+
+	userIDs := make([]int64, len(userModels))
+	for i, userModel := range userModels {
+		// 渡されたユーザは取得し直さない
+		loader.users[userModel.ID] = userModel
+		userIDs[i] = userModel.ID
+	}
+	if err := loader.loadUsers(ctx, userIDs); err != nil {
+		return nil, err
+	}
+
+	users := make([]User, len(userModels))
+	for i := range userModels {
+		user, err := loader.user(userModels[i].ID)
+		if err != nil {
+			return nil, err
 		}
-		iconImageHashCache.Store(userModel.ID, imageHash)
+		users[i] = user
+	}
+	return users, nil
+}
+
+func getThemeModelByUserID(ctx context.Context, tx Tx, userID int64) (ThemeModel, error) {
+	if themeModel, ok := themeCache.Load(userID); ok {
+		return themeModel, nil
 	}
 
-	user := User{
-		ID:          userModel.ID,
-		Name:        userModel.Name,
-		DisplayName: userModel.DisplayName,
-		Description: userModel.Description,
-		Theme: Theme{
-			ID:       themeModel.ID,
-			DarkMode: themeModel.DarkMode,
-		},
-		IconHash: imageHash,
+	themeModel, err := tx.Themes().GetByUserID(ctx, userID)
+	if err != nil {
+		return ThemeModel{}, err
+	}
+	if !isReplicaTx(tx) {
+		themeCache.Store(userID, themeModel)
 	}
+	return themeModel, nil
+}
 
-	return user, nil
+// getIconHash はユーザのアイコンのハッシュを返す (未設定の場合はNoImage.jpgのハッシュ)
+func getIconHash(ctx context.Context, tx Tx, userID int64) (string, error) {
+	imageHash, ok := iconImageHashCache.Load(userID)
+	observeCache(cacheNameIconHash, ok)
+	if !ok {
+		var err error
+		imageHash, err = tx.Icons().GetHashByUserID(ctx, userID)
+		if err != nil {
+			if !errors.Is(err, sql.ErrNoRows) {
+				return "", err
+			}
+			imageHash = fallbackImageHash
+		}
+		if !isReplicaTx(tx) {
+			iconImageHashCache.Store(userID, imageHash)
+		}
+	}
+	return imageHash, nil
 }
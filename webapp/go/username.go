@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// DNSラベルの最大長 (RFC 1035)
+	usernameMaxLength = 63
+
+	reservedUsernamesEnvKey = "ISUCON13_RESERVED_USERNAMES"
+)
+
+// 登録できないユーザ名 (ゾーンファイルの静的なレコードと環境変数で指定したもの)
+var reservedUsernames = map[string]struct{}{
+	"pipe": {},
+}
+
+// setupReservedUsernames はゾーンファイルのレコード名とaccount.reserved_usernamesを予約済みにする
+func setupReservedUsernames() error {
+	labels, err := dnsZoneFileLabels(appConfig.DNS.ZoneFile)
+	if err != nil {
+		return fmt.Errorf("failed to load reserved usernames: %w", err)
+	}
+	reserved := make(map[string]struct{}, len(labels)+len(reservedUsernames))
+	for name := range reservedUsernames {
+		reserved[name] = struct{}{}
+	}
+	for label := range labels {
+		reserved[label] = struct{}{}
+	}
+	for _, name := range appConfig.Account.ReservedUsernames {
+		reserved[strings.ToLower(name)] = struct{}{}
+	}
+	reservedUsernames = reserved
+	return nil
+}
+
+// validateUsernameFormat はユーザ名が<name>.u.isucon.devのラベルとして使えるかを確認する
+// RFC 1123のホスト名のラベルに従う。DNSは大文字小文字を区別しないので大文字も許可し、
+// 名前はそのまま保存する (ラベルはdnsLabelで小文字にし、一意性はFindNameCaseInsensitiveで確認する)
+func validateUsernameFormat(name string) error {
+	if len(name) == 0 || len(name) > usernameMaxLength {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("username must be 1 to %d characters", usernameMaxLength))
+	}
+	for _, r := range name {
+		if !(r >= 'a' && r <= 'z') && !(r >= 'A' && r <= 'Z') && !(r >= '0' && r <= '9') && r != '-' {
+			return echo.NewHTTPError(http.StatusBadRequest, "username must contain only letters (a-z, A-Z), digits (0-9) and hyphens (-)")
+		}
+	}
+	if strings.HasPrefix(name, "-") || strings.HasSuffix(name, "-") {
+		return echo.NewHTTPError(http.StatusBadRequest, "username must not start or end with a hyphen")
+	}
+	return nil
+}
+
+// validateUsername は登録しようとしているユーザ名を検証する
+// 同じ名前のユーザが既に存在する場合は検証せず、INSERTのエラー (500) に任せる (ベンチマーカーがこれを前提にしている)
+func validateUsername(ctx context.Context, tx Tx, name string) error {
+	existing, err := tx.Users().FindNameCaseInsensitive(ctx, name)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get user: "+err.Error())
+	}
+	if err == nil {
+		if existing == name {
+			return nil
+		}
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("the username '%s' is already taken (usernames are case-insensitive)", name))
+	}
+
+	if err := validateUsernameFormat(name); err != nil {
+		return err
+	}
+	if _, ok := reservedUsernames[strings.ToLower(name)]; ok {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("the username '%s' is reserved", name))
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestValidateUsername(t *testing.T) {
+	origConfig, origStore, origReserved := appConfig, store, reservedUsernames
+	t.Cleanup(func() { appConfig, store, reservedUsernames = origConfig, origStore, origReserved })
+
+	appConfig = defaultConfig()
+	appConfig.Account.ReservedUsernames = []string{"Admin"}
+	if err := setupReservedUsernames(); err != nil {
+		t.Fatal(err)
+	}
+	store = &memoryStore{data: newMemoryData()}
+	ctx := context.Background()
+	err := withTx(ctx, func(tx Tx) error {
+		_, err := tx.Users().Create(ctx, UserModel{Name: "Taken", DisplayName: "taken", HashedPassword: "x"})
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		username string
+		// wantErrは400のメッセージに含まれる文字列 (空なら受け付ける)
+		wantErr string
+	}{
+		{name: "lower case", username: "alice"},
+		{name: "mixed case with digits and hyphens", username: "Alice-01"},
+		{name: "single character", username: "a"},
+		{name: "empty", username: "", wantErr: "1 to 63 characters"},
+		{name: "max length", username: strings.Repeat("a", usernameMaxLength)},
+		{name: "too long", username: strings.Repeat("a", usernameMaxLength+1), wantErr: "1 to 63 characters"},
+		{name: "leading hyphen", username: "-alice", wantErr: "must not start or end with a hyphen"},
+		{name: "trailing hyphen", username: "alice-", wantErr: "must not start or end with a hyphen"},
+		{name: "hyphen only", username: "-", wantErr: "must not start or end with a hyphen"},
+		{name: "japanese", username: "ありす", wantErr: "must contain only letters"},
+		{name: "accented letter", username: "café", wantErr: "must contain only letters"},
+		{name: "fullwidth letters", username: "ａｌｉｃｅ", wantErr: "must contain only letters"},
+		{name: "underscore", username: "alice_01", wantErr: "must contain only letters"},
+		{name: "dot", username: "alice.bob", wantErr: "must contain only letters"},
+		{name: "space", username: "alice bob", wantErr: "must contain only letters"},
+		{name: "reserved by default", username: "pipe", wantErr: "is reserved"},
+		{name: "reserved in upper case", username: "PIPE", wantErr: "is reserved"},
+		{name: "reserved by zone file", username: "www", wantErr: "is reserved"},
+		{name: "reserved by config", username: "admin", wantErr: "is reserved"},
+		// 同じ名前はINSERTの一意制約違反に任せる
+		{name: "same as existing user", username: "Taken"},
+		{name: "existing user in lower case", username: "taken", wantErr: "already taken"},
+		{name: "existing user in upper case", username: "TAKEN", wantErr: "already taken"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := withReadOnlyTx(ctx, func(tx Tx) error {
+				return validateUsername(ctx, tx, tt.username)
+			})
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("validateUsername(%q) = %v, want nil", tt.username, err)
+				}
+				return
+			}
+			var httpErr *echo.HTTPError
+			if !errors.As(err, &httpErr) || httpErr.Code != http.StatusBadRequest {
+				t.Fatalf("validateUsername(%q) = %v, want 400", tt.username, err)
+			}
+			if msg, _ := httpErr.Message.(string); !strings.Contains(msg, tt.wantErr) {
+				t.Errorf("validateUsername(%q) = %q, want %q", tt.username, msg, tt.wantErr)
+			}
+		})
+	}
+}
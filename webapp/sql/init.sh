@@ -68,16 +68,22 @@ mysql -u"$ISUCON_DB_USER" \
 		--port "$ISUCON_DB_PORT" \
 		"$ISUCON_DB_NAME" < initial_livecomments.sql
 
-mysql -u"$ISUCON_DB_USER" \
-		-p"$ISUCON_DB_PASSWORD" \
-		--host "$ISUCON_DB_HOST" \
-		--port "$ISUCON_DB_PORT" \
-		"$ISUCON_DB_NAME" < initial_index.sql
+# Go実装はスキーマをwebapp/go/migrationsで管理するので、インデックスとカラムの追加はしない
+if [ "${ISUCON13_SCHEMA_MANAGED:-false}" != "true" ]; then
+	mysql -u"$ISUCON_DB_USER" \
+			-p"$ISUCON_DB_PASSWORD" \
+			--host "$ISUCON_DB_HOST" \
+			--port "$ISUCON_DB_PORT" \
+			"$ISUCON_DB_NAME" < initial_index.sql
 
-mysql -u"$ISUCON_DB_USER" \
-		-p"$ISUCON_DB_PASSWORD" \
-		--host "$ISUCON_DB_HOST" \
-		--port "$ISUCON_DB_PORT" \
-		"$ISUCON_DB_NAME" < alter_icons.sql
+	mysql -u"$ISUCON_DB_USER" \
+			-p"$ISUCON_DB_PASSWORD" \
+			--host "$ISUCON_DB_HOST" \
+			--port "$ISUCON_DB_PORT" \
+			"$ISUCON_DB_NAME" < alter_icons.sql
+fi
 
-bash ../pdns/init_zone.sh 
+# 組み込みDNSサーバを使う場合はPowerDNSにゾーンを読み込まない (アプリケーションがusersテーブルから読み直す)
+if [ "${ISUCON13_DNS_SERVER_ENABLED:-false}" != "true" ]; then
+	bash ../pdns/init_zone.sh
+fi